@@ -0,0 +1,386 @@
+package main
+
+import (
+	"archive/zip"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/alnah/fla/internal/adapters/memory"
+	"github.com/alnah/fla/internal/config"
+	"github.com/alnah/fla/internal/domain/backup"
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/policy"
+	"github.com/alnah/fla/internal/domain/search"
+	"github.com/alnah/fla/internal/domain/shared"
+	"github.com/alnah/fla/internal/domain/subscription"
+	"github.com/alnah/fla/internal/domain/user"
+	"github.com/alnah/fla/internal/email"
+)
+
+const (
+	MActorRequired   string = "This command needs an account: pass -as <username>."
+	MIndexMissing    string = "Search index has not been built yet."
+	MRecordMissing   string = "No earlier run completed this request."
+	MDataUnreadable  string = "Blog archive %s could not be read."
+	MDataUnwritable  string = "Blog archive %s could not be written."
+	MOutboxUnwritten string = "Message could not be written to the outbox."
+	MProviderMissing string = "Email provider %s is not available from the command line; use outbox."
+)
+
+// operator is the account the tool loads and saves the archive as. It never
+// runs commands: those act as the -as account, so permissions still apply.
+var operator = user.User{ID: "fla-operator", Roles: []user.Role{user.RoleAdmin}, Status: user.AccountStatusActive}
+
+// blog is the state a command works on: in-memory stores filled from the archive.
+// Commands set changed when they write, so the archive is saved after them.
+type blog struct {
+	path          string
+	clock         kernel.Clock
+	actor         *user.User // nil when no -as account was given
+	changed       bool
+	users         *memory.UserStore
+	categories    *memory.CategoryStore
+	tags          *memory.TagStore
+	posts         *memory.PostStore
+	subscriptions *memory.SubscriptionStore
+	suppressions  *memory.SuppressionStore // Kept in a JSON file next to the archive
+}
+
+// openBlog restores the archive at path; a missing archive is an empty blog.
+func openBlog(path string, clock kernel.Clock) (*blog, error) {
+	const op = "openBlog"
+
+	categories := memory.NewCategoryStore()
+	b := &blog{
+		path:          path,
+		clock:         clock,
+		users:         memory.NewUserStore(),
+		categories:    categories,
+		tags:          memory.NewTagStore(),
+		posts:         memory.NewPostStore(categories),
+		subscriptions: memory.NewSubscriptionStore(),
+		suppressions:  memory.NewSuppressionStore(),
+	}
+
+	if err := b.loadSuppressions(); err != nil {
+		return nil, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	archive, err := zip.OpenReader(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return b, nil
+	}
+	if err != nil {
+		return nil, &kernel.Error{Code: kernel.EInternal, Message: fmt.Sprintf(MDataUnreadable, path), Operation: op, Cause: err}
+	}
+	defer archive.Close()
+
+	if _, err := b.backups().Restore(archive, operator); err != nil {
+		return nil, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return b, nil
+}
+
+func (b *blog) backups() *backup.BackupService {
+	return backup.NewBackupService(b.users, b.categories, b.tags, b.posts, b.subscriptions, b.clock)
+}
+
+// save writes the blog to a temporary archive next to path, then renames it
+// over path, so an interrupted save leaves the previous archive intact.
+func (b *blog) save() error {
+	const op = "blog.save"
+
+	unwritable := func(err error) error {
+		return &kernel.Error{Code: kernel.EInternal, Message: fmt.Sprintf(MDataUnwritable, b.path), Operation: op, Cause: err}
+	}
+
+	file, err := os.CreateTemp(filepath.Dir(b.path), filepath.Base(b.path)+".*.tmp")
+	if err != nil {
+		return unwritable(err)
+	}
+	defer os.Remove(file.Name())
+
+	archive := zip.NewWriter(file)
+	if _, err := b.backups().Backup(archive, operator); err != nil {
+		file.Close()
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+	if err := archive.Close(); err != nil {
+		file.Close()
+		return unwritable(err)
+	}
+	if err := file.Close(); err != nil {
+		return unwritable(err)
+	}
+
+	if err := os.Rename(file.Name(), b.path); err != nil {
+		return unwritable(err)
+	}
+	return b.saveSuppressions()
+}
+
+// suppressionsPath returns the suppression list file, next to the archive.
+// The list is not an aggregate of backup archives, so it is kept apart.
+func (b *blog) suppressionsPath() string {
+	return strings.TrimSuffix(b.path, filepath.Ext(b.path)) + ".suppressions.json"
+}
+
+// loadSuppressions fills the suppression store from its file; a missing file is an empty list.
+func (b *blog) loadSuppressions() error {
+	const op = "blog.loadSuppressions"
+
+	path := b.suppressionsPath()
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return &kernel.Error{Code: kernel.EInternal, Message: fmt.Sprintf(MDataUnreadable, path), Operation: op, Cause: err}
+	}
+
+	var suppressions []subscription.Suppression
+	if err := json.Unmarshal(data, &suppressions); err != nil {
+		return &kernel.Error{Code: kernel.EInternal, Message: fmt.Sprintf(MDataUnreadable, path), Operation: op, Cause: err}
+	}
+	for _, s := range suppressions {
+		if err := s.Validate(); err != nil {
+			return &kernel.Error{Operation: op, Cause: err}
+		}
+		if err := b.suppressions.AddSuppression(s); err != nil {
+			return &kernel.Error{Operation: op, Cause: err}
+		}
+	}
+	return nil
+}
+
+// saveSuppressions writes the suppression list the same way save writes the archive.
+func (b *blog) saveSuppressions() error {
+	const op = "blog.saveSuppressions"
+
+	path := b.suppressionsPath()
+	unwritable := func(err error) error {
+		return &kernel.Error{Code: kernel.EInternal, Message: fmt.Sprintf(MDataUnwritable, path), Operation: op, Cause: err}
+	}
+
+	suppressions, err := b.suppressions.GetSuppressions()
+	if err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+	data, err := json.MarshalIndent(suppressions, "", "  ")
+	if err != nil {
+		return unwritable(err)
+	}
+
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		return unwritable(err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		os.Remove(tmp)
+		return unwritable(err)
+	}
+	return nil
+}
+
+// account looks up the -as account; an empty username means none.
+func (b *blog) account(username string) (*user.User, error) {
+	const op = "blog.account"
+
+	if username == "" {
+		return nil, nil
+	}
+
+	u, err := b.users.GetUserByUsername(shared.Username(username))
+	if err != nil {
+		return nil, &kernel.Error{Operation: op, Cause: err}
+	}
+	u.Scopes = []string{policy.AllScopes} // Archives hold no category ownerships, so editors are site-wide
+	return u, nil
+}
+
+// requireActor returns the -as account, for commands that act on someone's behalf.
+func (b *blog) requireActor() (*user.User, error) {
+	if b.actor == nil {
+		return nil, &kernel.Error{Code: kernel.EForbidden, Message: MActorRequired, Operation: "blog.requireActor"}
+	}
+	return b.actor, nil
+}
+
+// randomID returns 16 random hex characters, enough to never collide on one blog.
+func randomID() string {
+	buf := make([]byte, 8)
+	_, _ = rand.Read(buf)
+	return hex.EncodeToString(buf)
+}
+
+// fileIndexStore keeps the search index as a JSON file.
+type fileIndexStore struct {
+	path string
+}
+
+func (s fileIndexStore) SaveIndex(index search.Index) error {
+	const op = "fileIndexStore.SaveIndex"
+
+	data, err := json.Marshal(index)
+	if err != nil {
+		return &kernel.Error{Code: kernel.EInternal, Operation: op, Cause: err}
+	}
+	if err := os.WriteFile(s.path, data, 0o644); err != nil {
+		return &kernel.Error{Code: kernel.EInternal, Operation: op, Cause: err}
+	}
+	return nil
+}
+
+func (s fileIndexStore) LoadIndex() (search.Index, error) {
+	const op = "fileIndexStore.LoadIndex"
+
+	data, err := os.ReadFile(s.path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return search.Index{}, &kernel.Error{Code: kernel.ENotFound, Message: MIndexMissing, Operation: op}
+	}
+	if err != nil {
+		return search.Index{}, &kernel.Error{Code: kernel.EInternal, Operation: op, Cause: err}
+	}
+
+	var index search.Index
+	if err := json.Unmarshal(data, &index); err != nil {
+		return search.Index{}, &kernel.Error{Code: kernel.EInternal, Operation: op, Cause: err}
+	}
+	return index, nil
+}
+
+// fileIdempotencyStore keeps idempotency records as a JSON file, so that
+// running a command again does not repeat what an earlier run completed.
+type fileIdempotencyStore struct {
+	path string
+}
+
+func (s fileIdempotencyStore) GetRecord(scope string, key kernel.IdempotencyKey) (*kernel.IdempotencyRecord, error) {
+	const op = "fileIdempotencyStore.GetRecord"
+
+	records, err := s.load()
+	if err != nil {
+		return nil, &kernel.Error{Operation: op, Cause: err}
+	}
+	for _, r := range records {
+		if r.Scope == scope && r.Key == key {
+			return &r, nil
+		}
+	}
+	return nil, &kernel.Error{Code: kernel.ENotFound, Message: MRecordMissing, Operation: op}
+}
+
+// ReserveRecord is atomic only within one process; commands run one at a time.
+func (s fileIdempotencyStore) ReserveRecord(record kernel.IdempotencyRecord) (*kernel.IdempotencyRecord, error) {
+	const op = "fileIdempotencyStore.ReserveRecord"
+
+	records, err := s.load()
+	if err != nil {
+		return nil, &kernel.Error{Operation: op, Cause: err}
+	}
+	for _, r := range records {
+		if r.Scope == record.Scope && r.Key == record.Key && !r.IsExpired(record.CreatedAt) {
+			return &r, nil
+		}
+	}
+
+	if err := s.save(records, record); err != nil {
+		return nil, &kernel.Error{Operation: op, Cause: err}
+	}
+	return nil, nil
+}
+
+func (s fileIdempotencyStore) SaveRecord(record kernel.IdempotencyRecord) error {
+	const op = "fileIdempotencyStore.SaveRecord"
+
+	records, err := s.load()
+	if err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+	if err := s.save(records, record); err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+	return nil
+}
+
+// save writes the records with record replacing any of the same scope and key.
+func (s fileIdempotencyStore) save(records []kernel.IdempotencyRecord, record kernel.IdempotencyRecord) error {
+	const op = "fileIdempotencyStore.save"
+
+	records = slices.DeleteFunc(records, func(r kernel.IdempotencyRecord) bool {
+		return r.Scope == record.Scope && r.Key == record.Key
+	})
+	records = append(records, record)
+
+	data, err := json.MarshalIndent(records, "", "  ")
+	if err != nil {
+		return &kernel.Error{Code: kernel.EInternal, Operation: op, Cause: err}
+	}
+	if err := os.WriteFile(s.path, data, 0o644); err != nil {
+		return &kernel.Error{Code: kernel.EInternal, Operation: op, Cause: err}
+	}
+	return nil
+}
+
+func (s fileIdempotencyStore) load() ([]kernel.IdempotencyRecord, error) {
+	const op = "fileIdempotencyStore.load"
+
+	data, err := os.ReadFile(s.path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, &kernel.Error{Code: kernel.EInternal, Operation: op, Cause: err}
+	}
+
+	var records []kernel.IdempotencyRecord
+	if err := json.Unmarshal(data, &records); err != nil {
+		return nil, &kernel.Error{Code: kernel.EInternal, Operation: op, Cause: err}
+	}
+	return records, nil
+}
+
+// newSender returns the sender for the configured provider.
+func newSender(c config.EmailConfig) (email.Sender, error) {
+	const op = "newSender"
+
+	if c.Provider != config.ProviderOutbox {
+		return nil, &kernel.Error{Code: kernel.EInvalid, Message: fmt.Sprintf(MProviderMissing, c.Provider), Operation: op}
+	}
+	if err := os.MkdirAll(c.Outbox, 0o755); err != nil {
+		return nil, &kernel.Error{Code: kernel.EInternal, Message: MOutboxUnwritten, Operation: op, Cause: err}
+	}
+	return &outboxSender{dir: c.Outbox}, nil
+}
+
+// outboxSender writes each message to a directory instead of sending it,
+// as <n>-<recipient>.txt and .html, for review before a real provider is wired.
+type outboxSender struct {
+	dir  string
+	sent int
+}
+
+func (s *outboxSender) Send(m email.Message) error {
+	const op = "outboxSender.Send"
+
+	s.sent++
+	name := filepath.Join(s.dir, fmt.Sprintf("%03d-%s", s.sent, strings.ReplaceAll(m.To.String(), "@", "_at_")))
+	header := fmt.Sprintf("To: %s\nSubject: %s\n\n", m.To, m.Subject)
+
+	if err := os.WriteFile(name+".txt", []byte(header+m.Text), 0o644); err != nil {
+		return &kernel.Error{Code: kernel.EInternal, Message: MOutboxUnwritten, Operation: op, Cause: err}
+	}
+	if err := os.WriteFile(name+".html", []byte(m.HTML), 0o644); err != nil {
+		return &kernel.Error{Code: kernel.EInternal, Message: MOutboxUnwritten, Operation: op, Cause: err}
+	}
+	return nil
+}
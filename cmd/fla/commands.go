@@ -0,0 +1,526 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/category"
+	"github.com/alnah/fla/internal/domain/importer"
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/post"
+	"github.com/alnah/fla/internal/domain/search"
+	"github.com/alnah/fla/internal/domain/shared"
+	"github.com/alnah/fla/internal/domain/user"
+	"github.com/alnah/fla/internal/email"
+)
+
+const (
+	MFirstAccountAdmin   string = "The first account must be an admin."
+	MAccountForbidden    string = "Only admins can create accounts."
+	MExportForbidden     string = "Only editors and admins can export posts."
+	MDigestForbidden     string = "Only admins can send the digest."
+	MImportFormatUnknown string = "Unknown import format %q: use wordpress, ghost, or markdown."
+	MImportFileCount     string = "The %s format imports exactly one file."
+	MExportUnwritable    string = "Post could not be written to %s."
+)
+
+// newFlags creates a subcommand flag set that reports mistakes on stderr.
+func newFlags(a *app, name, usage string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(a.stderr)
+	fs.Usage = func() {
+		fmt.Fprintf(a.stderr, "Usage: fla %s\n", usage)
+		fs.PrintDefaults()
+	}
+	return fs
+}
+
+// parseFlags parses args and checks required flags are set.
+func parseFlags(fs *flag.FlagSet, args []string, required ...string) error {
+	if err := fs.Parse(args); err != nil {
+		return errUsage
+	}
+
+	for _, name := range required {
+		if fs.Lookup(name).Value.String() == "" {
+			fmt.Fprintf(fs.Output(), "fla %s: -%s is required\n", fs.Name(), name)
+			fs.Usage()
+			return errUsage
+		}
+	}
+	return nil
+}
+
+type userOutput struct {
+	ID       string   `json:"id"`
+	Username string   `json:"username"`
+	Email    string   `json:"email"`
+	Roles    []string `json:"roles"`
+}
+
+// createUser creates an account. With no account yet, it runs without -as
+// and must create an admin, who then creates everyone else.
+func createUser(a *app, b *blog, args []string) (any, error) {
+	const op = "createUser"
+
+	fs := newFlags(a, "create-user", "create-user -username name -email address [-role author,editor] [flags]")
+	username := fs.String("username", "", "login name")
+	address := fs.String("email", "", "email address")
+	roles := fs.String("role", user.RoleAuthor.String(), "comma-separated roles")
+	firstName := fs.String("first-name", "", "first name")
+	lastName := fs.String("last-name", "", "last name")
+	locale := fs.String("locale", "", "interface language")
+	if err := parseFlags(fs, args, "username", "email"); err != nil {
+		return nil, err
+	}
+
+	params := user.NewUserParams{
+		UserID:           kernel.ID[user.User](a.newID()),
+		Username:         shared.Username(*username),
+		Email:            shared.Email(*address),
+		FirstName:        shared.FirstName(*firstName),
+		LastName:         shared.LastName(*lastName),
+		LocalePreference: shared.Locale(*locale),
+		Clock:            b.clock,
+	}
+	for _, role := range strings.Split(*roles, ",") {
+		params.Roles = append(params.Roles, user.Role(strings.TrimSpace(role)))
+	}
+
+	existing, err := b.users.GetAllUsers()
+	if err != nil {
+		return nil, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	switch {
+	case len(existing) == 0:
+		if !slices.Contains(params.Roles, user.RoleAdmin) {
+			return nil, &kernel.Error{Code: kernel.EInvalid, Message: MFirstAccountAdmin, Operation: op}
+		}
+	case b.actor == nil:
+		return nil, &kernel.Error{Code: kernel.EForbidden, Message: MActorRequired, Operation: op}
+	case !user.IsActiveAdmin(b.actor):
+		return nil, &kernel.Error{Code: kernel.EForbidden, Message: MAccountForbidden, Operation: op}
+	}
+
+	u, err := user.NewUser(params)
+	if err != nil {
+		return nil, &kernel.Error{Operation: op, Cause: err}
+	}
+	if err := b.users.CreateUser(u); err != nil {
+		return nil, &kernel.Error{Operation: op, Cause: err}
+	}
+	b.changed = true
+
+	out := userOutput{ID: u.ID.String(), Username: u.Username.String(), Email: u.Email.String()}
+	for _, role := range u.Roles {
+		out.Roles = append(out.Roles, role.String())
+	}
+	return out, nil
+}
+
+type categoryOutput struct {
+	ID   string `json:"id"`
+	Slug string `json:"slug"`
+	Name string `json:"name"`
+	Path string `json:"path"`
+}
+
+// createCategory creates a category, under the category at -parent when given.
+func createCategory(a *app, b *blog, args []string) (any, error) {
+	const op = "createCategory"
+
+	fs := newFlags(a, "create-category", "create-category -name name [-parent a1/reading] [flags]")
+	name := fs.String("name", "", "category name; the slug derives from it")
+	parent := fs.String("parent", "", "path of the parent category, e.g. a1/comprehension-ecrite")
+	description := fs.String("description", "", "meta description")
+	sortOrder := fs.Int("sort", 0, "position among siblings")
+	if err := parseFlags(fs, args, "name"); err != nil {
+		return nil, err
+	}
+
+	actor, err := b.requireActor()
+	if err != nil {
+		return nil, err
+	}
+	if !actor.CanManageCategories() {
+		return nil, &kernel.Error{Code: kernel.EForbidden, Message: category.MCategoryManageForbidden, Operation: op}
+	}
+
+	params := category.NewCategoryParams{
+		CategoryID:  kernel.ID[category.Category](a.newID()),
+		Name:        category.CategoryName(*name),
+		CreatedBy:   actor.ID,
+		Description: shared.Description(*description),
+		SortOrder:   *sortOrder,
+		Clock:       b.clock,
+	}
+	if *parent != "" {
+		p, err := b.categories.FindByPath(strings.Split(strings.Trim(*parent, "/"), "/"))
+		if err != nil {
+			return nil, &kernel.Error{Operation: op, Cause: err}
+		}
+		params.ParentID = &p.CategoryID
+	}
+
+	c, err := category.NewCategory(params)
+	if err != nil {
+		return nil, &kernel.Error{Operation: op, Cause: err}
+	}
+	if err := b.categories.Create(c); err != nil {
+		return nil, &kernel.Error{Operation: op, Cause: err}
+	}
+	b.changed = true
+
+	path, err := b.categories.BuildPath(c.CategoryID)
+	if err != nil {
+		return nil, &kernel.Error{Operation: op, Cause: err}
+	}
+	return categoryOutput{ID: c.CategoryID.String(), Slug: c.Slug.String(), Name: c.Name.String(), Path: path.String()}, nil
+}
+
+type importOutput struct {
+	DryRun     bool                         `json:"dryRun"`
+	Categories int                          `json:"categories"`
+	Tags       int                          `json:"tags"`
+	Posts      int                          `json:"posts"`
+	Reports    []*importer.ValidationReport `json:"reports"`
+}
+
+// importPosts imports a WordPress or Ghost export, or Markdown files.
+// Nothing is written unless every file is free of blocking findings.
+func importPosts(a *app, b *blog, args []string) (any, error) {
+	const op = "importPosts"
+
+	fs := newFlags(a, "import", "import -format wordpress|ghost|markdown [-dry-run] file...")
+	format := fs.String("format", "", "wordpress, ghost, or markdown")
+	dryRun := fs.Bool("dry-run", false, "validate and report without writing")
+	if err := parseFlags(fs, args, "format"); err != nil {
+		return nil, err
+	}
+	if fs.NArg() == 0 {
+		fs.Usage()
+		return nil, errUsage
+	}
+
+	actor, err := b.requireActor()
+	if err != nil {
+		return nil, err
+	}
+
+	var out importOutput
+	switch *format {
+	case importer.FormatWordPress.String(), importer.FormatGhost.String():
+		out, err = importExport(b, actor, importer.Format(*format), fs.Args(), *dryRun)
+	case importer.MarkdownSource:
+		out, err = importMarkdown(b, actor, fs.Args(), *dryRun)
+	default:
+		err = &kernel.Error{Code: kernel.EInvalid, Message: fmt.Sprintf(MImportFormatUnknown, *format)}
+	}
+	if err != nil {
+		if out.Reports != nil {
+			return out, &kernel.Error{Operation: op, Cause: err}
+		}
+		return nil, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	b.changed = !*dryRun && out.Categories+out.Tags+out.Posts > 0
+	return out, nil
+}
+
+// importExport runs a WordPress or Ghost file through the import service.
+func importExport(b *blog, actor *user.User, format importer.Format, files []string, dryRun bool) (importOutput, error) {
+	const op = "importExport"
+
+	out := importOutput{DryRun: dryRun}
+	if len(files) != 1 {
+		return out, &kernel.Error{Code: kernel.EInvalid, Message: fmt.Sprintf(MImportFileCount, format), Operation: op}
+	}
+
+	file, err := os.Open(files[0])
+	if err != nil {
+		return out, &kernel.Error{Code: kernel.EInvalid, Message: err.Error(), Operation: op}
+	}
+	defer file.Close()
+
+	parse := importer.ParseWordPress
+	if format == importer.FormatGhost {
+		parse = importer.ParseGhost
+	}
+	export, err := parse(files[0], file)
+	if err != nil {
+		return out, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	service := importer.NewImportService(b.users, b.categories, b.tags, b.posts, b.clock)
+	plan, err := service.DryRun(export, actor)
+	if err != nil {
+		return out, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	out.Categories, out.Tags, out.Posts = len(plan.Categories), len(plan.Tags), len(plan.Posts)
+	out.Reports = []*importer.ValidationReport{plan.Report}
+	if !plan.Ready() {
+		return out, &kernel.Error{Code: kernel.EInvalid, Message: importer.MImportHasErrors, Operation: op}
+	}
+
+	if !dryRun {
+		if err := service.Commit(plan, actor); err != nil {
+			return out, &kernel.Error{Operation: op, Cause: err}
+		}
+	}
+	return out, nil
+}
+
+// importMarkdown creates one post per Markdown file, after all of them pass validation.
+func importMarkdown(b *blog, actor *user.User, files []string, dryRun bool) (importOutput, error) {
+	const op = "importMarkdown"
+
+	out := importOutput{DryRun: dryRun, Reports: []*importer.ValidationReport{}}
+	if !user.IsActiveAdmin(actor) {
+		return importOutput{}, &kernel.Error{Code: kernel.EForbidden, Message: importer.MImportForbidden, Operation: op}
+	}
+
+	service := importer.NewMarkdownService(b.categories, b.tags, b.clock)
+	var posts []post.Post
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return out, &kernel.Error{Code: kernel.EInvalid, Message: err.Error(), Operation: op}
+		}
+
+		params, report, err := service.Import(file, data)
+		if err != nil {
+			return out, &kernel.Error{Operation: op, Cause: err}
+		}
+		out.Reports = append(out.Reports, report)
+		if report.HasErrors() {
+			continue
+		}
+
+		p, err := post.NewPost(params)
+		if err != nil {
+			return out, &kernel.Error{Operation: op, Cause: err}
+		}
+		posts = append(posts, p)
+	}
+
+	if len(posts) < len(files) {
+		return out, &kernel.Error{Code: kernel.EInvalid, Message: importer.MImportHasErrors, Operation: op}
+	}
+
+	out.Posts = len(posts)
+	if dryRun {
+		return out, nil
+	}
+	for _, p := range posts {
+		if err := b.posts.Create(p); err != nil {
+			return out, &kernel.Error{Operation: op, Cause: err}
+		}
+	}
+	return out, nil
+}
+
+type exportOutput struct {
+	Files []string `json:"files"`
+}
+
+// exportPosts writes every post as Markdown under -out, one directory per category.
+func exportPosts(a *app, b *blog, args []string) (any, error) {
+	const op = "exportPosts"
+
+	fs := newFlags(a, "export", "export -out directory")
+	dir := fs.String("out", "", "directory to write the Markdown files to")
+	if err := parseFlags(fs, args, "out"); err != nil {
+		return nil, err
+	}
+
+	actor, err := b.requireActor()
+	if err != nil {
+		return nil, err
+	}
+	if !actor.IsActive() || !actor.HasAnyRole(user.RoleAdmin, user.RoleEditor) {
+		return nil, &kernel.Error{Code: kernel.EForbidden, Message: MExportForbidden, Operation: op}
+	}
+
+	service := importer.NewMarkdownService(b.categories, b.tags, b.clock)
+	out := exportOutput{Files: []string{}}
+	for page := 1; ; page++ {
+		list, err := b.posts.Find(post.NewQuery().Page(page, shared.MaxPageLimit))
+		if err != nil {
+			return nil, &kernel.Error{Operation: op, Cause: err}
+		}
+
+		for _, p := range list.Posts {
+			file, err := exportPost(service, b.categories, p, *dir)
+			if err != nil {
+				return nil, &kernel.Error{Operation: op, Cause: err}
+			}
+			out.Files = append(out.Files, file)
+		}
+
+		if !list.Pagination.HasNextPage() {
+			break
+		}
+	}
+
+	return out, nil
+}
+
+func exportPost(service *importer.MarkdownService, categories category.CategoryPathBuilder, p post.Post, dir string) (string, error) {
+	const op = "exportPost"
+
+	data, err := service.Export(p)
+	if err != nil {
+		return "", &kernel.Error{Operation: op, Cause: err}
+	}
+
+	path, err := categories.BuildPath(p.Category.CategoryID)
+	if err != nil {
+		return "", &kernel.Error{Operation: op, Cause: err}
+	}
+
+	file := filepath.Join(dir, filepath.FromSlash(importer.MarkdownPath(p, path)))
+	if err := os.MkdirAll(filepath.Dir(file), 0o755); err != nil {
+		return "", &kernel.Error{Code: kernel.EInternal, Message: fmt.Sprintf(MExportUnwritable, file), Operation: op, Cause: err}
+	}
+	if err := os.WriteFile(file, data, 0o644); err != nil {
+		return "", &kernel.Error{Code: kernel.EInternal, Message: fmt.Sprintf(MExportUnwritable, file), Operation: op, Cause: err}
+	}
+	return file, nil
+}
+
+type publishedOutput struct {
+	ID          string    `json:"id"`
+	Slug        string    `json:"slug"`
+	PublishedAt time.Time `json:"publishedAt"`
+}
+
+type failureOutput struct {
+	ID      string `json:"id"`
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+type publishOutput struct {
+	Published []publishedOutput `json:"published"`
+	Failed    []failureOutput   `json:"failed"`
+	Pending   int               `json:"pending"`
+}
+
+// publishScheduled publishes due posts. Posts that fail are listed and stay
+// scheduled for the next run; they do not change the exit code.
+func publishScheduled(a *app, b *blog, args []string) (any, error) {
+	const op = "publishScheduled"
+
+	fs := newFlags(a, "publish-scheduled", "publish-scheduled")
+	if err := parseFlags(fs, args); err != nil {
+		return nil, err
+	}
+
+	actor, err := b.requireActor()
+	if err != nil {
+		return nil, err
+	}
+
+	run, err := post.NewSchedulerService(b.posts, b.clock).PublishDue(actor)
+	if err != nil {
+		return nil, &kernel.Error{Operation: op, Cause: err}
+	}
+	b.changed = len(run.Published) > 0
+
+	out := publishOutput{Published: []publishedOutput{}, Failed: []failureOutput{}, Pending: run.Pending}
+	for _, p := range run.Published {
+		out.Published = append(out.Published, publishedOutput{ID: p.PostID.String(), Slug: p.Slug.String(), PublishedAt: *p.PublishedAt})
+	}
+	for _, f := range run.Failed {
+		out.Failed = append(out.Failed, failureOutput{ID: f.PostID.String(), Code: kernel.ErrorCode(f.Err), Message: kernel.ErrorMessage(f.Err)})
+	}
+	return out, nil
+}
+
+type digestOutput struct {
+	Posts       int             `json:"posts"`
+	Sent        int             `json:"sent"`
+	Skipped     int             `json:"skipped"`
+	Suppressed  int             `json:"suppressed"`
+	AlreadySent int             `json:"alreadySent"`
+	Failed      []failureOutput `json:"failed"`
+}
+
+// sendDigest sends the weekly digest through the configured email provider.
+func sendDigest(a *app, b *blog, args []string) (any, error) {
+	const op = "sendDigest"
+
+	fs := newFlags(a, "send-digest", "send-digest")
+	if err := parseFlags(fs, args); err != nil {
+		return nil, err
+	}
+
+	actor, err := b.requireActor()
+	if err != nil {
+		return nil, err
+	}
+	if !user.IsActiveAdmin(actor) {
+		return nil, &kernel.Error{Code: kernel.EForbidden, Message: MDigestForbidden, Operation: op}
+	}
+
+	site, err := a.config.SiteInfo()
+	if err != nil {
+		return nil, &kernel.Error{Operation: op, Cause: err}
+	}
+	sender, err := newSender(a.config.Email)
+	if err != nil {
+		return nil, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	sent := fileIdempotencyStore{path: filepath.Join(a.config.Email.Outbox, "sent.json")}
+	service := email.NewDigestService(b.subscriptions, b.suppressions, b.posts, b.categories, email.NewTemplateRenderer(), sender, sent, site, b.clock)
+	run, err := service.SendWeekly()
+	if err != nil {
+		return nil, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	out := digestOutput{Posts: run.Posts, Sent: run.Sent, Skipped: run.Skipped, Suppressed: run.Suppressed, AlreadySent: run.AlreadySent, Failed: []failureOutput{}}
+	for _, f := range run.Failed {
+		out.Failed = append(out.Failed, failureOutput{ID: f.SubscriptionID.String(), Code: kernel.ErrorCode(f.Err), Message: kernel.ErrorMessage(f.Err)})
+	}
+	return out, nil
+}
+
+type indexOutput struct {
+	Path      string    `json:"path"`
+	BuiltAt   time.Time `json:"builtAt"`
+	Documents int       `json:"documents"`
+	Terms     int       `json:"terms"`
+}
+
+// rebuildSearchIndex rebuilds the search index into a JSON file at storage.search_index,
+// by default next to the archive.
+func rebuildSearchIndex(a *app, b *blog, args []string) (any, error) {
+	const op = "rebuildSearchIndex"
+
+	defaultPath := a.config.Storage.SearchIndex
+	if defaultPath == "" {
+		defaultPath = strings.TrimSuffix(b.path, filepath.Ext(b.path)) + ".search.json"
+	}
+
+	fs := newFlags(a, "rebuild-search-index", "rebuild-search-index [-index file]")
+	path := fs.String("index", defaultPath, "index file to write")
+	if err := parseFlags(fs, args); err != nil {
+		return nil, err
+	}
+
+	store := fileIndexStore{path: *path}
+	index, err := search.NewIndexService(b.posts, b.categories, store, b.clock).Rebuild()
+	if err != nil {
+		return nil, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return indexOutput{Path: *path, BuiltAt: index.BuiltAt, Documents: len(index.Documents), Terms: index.Terms()}, nil
+}
@@ -0,0 +1,99 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+var fixtureNow = time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+
+type stubClock struct {
+	t time.Time
+}
+
+func (s *stubClock) Now() time.Time { return s.t }
+
+// harness runs commands against one archive in a temporary directory,
+// with a fixed clock and sequential IDs.
+type harness struct {
+	t     *testing.T
+	dir   string
+	data  string
+	clock *stubClock
+	ids   int
+}
+
+func newHarness(t *testing.T) *harness {
+	t.Helper()
+	dir := t.TempDir()
+	return &harness{t: t, dir: dir, data: filepath.Join(dir, "fla.zip"), clock: &stubClock{fixtureNow}}
+}
+
+// run executes fla with args after the -data flag and returns exit code, stdout, and stderr.
+func (h *harness) run(args ...string) (int, string, string) {
+	h.t.Helper()
+
+	var stdout, stderr bytes.Buffer
+	a := &app{stdout: &stdout, stderr: &stderr, clock: h.clock, newID: func() string {
+		h.ids++
+		return fmt.Sprintf("id-%d", h.ids)
+	}}
+	code := a.run(append([]string{"-data", h.data}, args...))
+	return code, stdout.String(), stderr.String()
+}
+
+// mustRun executes fla and fails the test unless it exits with ExitOK.
+func (h *harness) mustRun(args ...string) string {
+	h.t.Helper()
+
+	code, stdout, stderr := h.run(args...)
+	if code != ExitOK {
+		h.t.Fatalf("fla %s: exit %d\n%s", strings.Join(args, " "), code, stderr)
+	}
+	return stdout
+}
+
+// open loads the archive the way commands do, for seeding and inspecting state.
+func (h *harness) open() *blog {
+	h.t.Helper()
+
+	b, err := openBlog(h.data, h.clock)
+	assertNoError(h.t, err)
+	return b
+}
+
+func decode[T any](t *testing.T, data string) T {
+	t.Helper()
+
+	var out T
+	if err := json.Unmarshal([]byte(data), &out); err != nil {
+		t.Fatalf("invalid JSON output: %v\n%s", err, data)
+	}
+	return out
+}
+
+func assertNoError(t *testing.T, err error) {
+	t.Helper()
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+}
+
+func assertExit(t *testing.T, got, want int, stderr string) {
+	t.Helper()
+	if got != want {
+		t.Errorf("exit code: got %d, want %d\n%s", got, want, stderr)
+	}
+}
+
+func assertErrorOutput(t *testing.T, stderr, code string) {
+	t.Helper()
+	if got := decode[errorOutput](t, stderr); got.Code != code {
+		t.Errorf("error code: got %q, want %q", got.Code, code)
+	}
+}
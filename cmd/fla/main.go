@@ -0,0 +1,218 @@
+// Command fla manages a blog from the command line: accounts, categories,
+// imports and exports, scheduled publishing, the weekly digest, and the search index.
+//
+// Usage:
+//
+//	fla [-config fla.toml] [-set key=value]... [-data fla.zip] [-as username] <command> [flags]
+//
+// Settings come from package config: the -config file (fla.toml when present),
+// FLA_* environment variables, then -set overrides. The blog is kept in a backup
+// archive (see package backup), read before each command and rewritten after
+// commands that change it. Results are printed as JSON on stdout; errors as JSON
+// on stderr, with an exit code per kernel error code.
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"time"
+
+	"github.com/alnah/fla/internal/config"
+	"github.com/alnah/fla/internal/domain/kernel"
+)
+
+// Exit codes. Domain failures map from their kernel error code.
+const (
+	ExitOK        = 0
+	ExitInternal  = 1
+	ExitUsage     = 2
+	ExitInvalid   = 3
+	ExitNotFound  = 4
+	ExitConflict  = 5
+	ExitForbidden = 6
+)
+
+var exitCodes = map[string]int{
+	kernel.EInternal:  ExitInternal,
+	kernel.EInvalid:   ExitInvalid,
+	kernel.ENotFound:  ExitNotFound,
+	kernel.EConflict:  ExitConflict,
+	kernel.EForbidden: ExitForbidden,
+}
+
+// DefaultConfigFile is read when -config is not given, if it exists.
+const DefaultConfigFile = "fla.toml"
+
+// errUsage reports a command line mistake; flag parsing has already printed the details.
+var errUsage = errors.New("usage")
+
+// command is one subcommand. It returns the result to print; a result returned
+// with an error (such as an import report) is printed before the error.
+type command struct {
+	name    string
+	summary string
+	run     func(a *app, b *blog, args []string) (any, error)
+}
+
+var commands = []command{
+	{"create-user", "Create an account; the first one must be an admin.", createUser},
+	{"create-category", "Create a category, optionally under a parent path.", createCategory},
+	{"import", "Import WordPress, Ghost, or Markdown files.", importPosts},
+	{"export", "Export posts as Markdown files.", exportPosts},
+	{"publish-scheduled", "Publish scheduled posts that are due.", publishScheduled},
+	{"send-digest", "Send the weekly digest to its subscribers.", sendDigest},
+	{"rebuild-search-index", "Rebuild the search index from published posts.", rebuildSearchIndex},
+}
+
+// systemClock reads the system time in UTC.
+type systemClock struct{}
+
+func (systemClock) Now() time.Time { return time.Now().UTC() }
+
+// app holds what commands need from the process, so tests can replace it.
+type app struct {
+	stdout io.Writer
+	stderr io.Writer
+	clock  kernel.Clock
+	newID  func() string
+	env    []string      // Environment, as os.Environ returns it
+	config config.Config // Loaded before the command runs
+}
+
+func main() {
+	a := &app{stdout: os.Stdout, stderr: os.Stderr, clock: systemClock{}, newID: randomID, env: os.Environ()}
+	os.Exit(a.run(os.Args[1:]))
+}
+
+// run executes one command line and returns the process exit code.
+func (a *app) run(args []string) int {
+	global := flag.NewFlagSet("fla", flag.ContinueOnError)
+	global.SetOutput(a.stderr)
+	configFile := global.String("config", "", "settings file (default "+DefaultConfigFile+" when present)")
+	overrides := config.Overrides{}
+	global.Var(overrides, "set", "override a setting, e.g. -set site.base_url=https://fla.example (repeatable)")
+	data := global.String("data", "", "blog archive to read and update (default storage.data_file)")
+	as := global.String("as", "", "username of the account running the command")
+	global.Usage = func() { a.usage(global) }
+
+	if err := global.Parse(args); err != nil {
+		return ExitUsage
+	}
+	if global.NArg() == 0 {
+		a.usage(global)
+		return ExitUsage
+	}
+
+	name := global.Arg(0)
+	i := slices.IndexFunc(commands, func(c command) bool { return c.name == name })
+	if i < 0 {
+		fmt.Fprintf(a.stderr, "fla: unknown command %q\n", name)
+		a.usage(global)
+		return ExitUsage
+	}
+	cmd := commands[i]
+
+	if err := a.loadConfig(*configFile, overrides); err != nil {
+		return a.fail(err)
+	}
+	if *data == "" {
+		*data = a.config.Storage.DataFile
+	}
+
+	b, err := openBlog(*data, a.clock)
+	if err != nil {
+		return a.fail(err)
+	}
+	if b.actor, err = b.account(*as); err != nil {
+		return a.fail(err)
+	}
+
+	result, err := cmd.run(a, b, global.Args()[1:])
+	if errors.Is(err, errUsage) {
+		return ExitUsage
+	}
+	if err != nil {
+		if result != nil {
+			a.print(result)
+		}
+		return a.fail(err)
+	}
+
+	if b.changed {
+		if err := b.save(); err != nil {
+			return a.fail(err)
+		}
+	}
+
+	return a.print(result)
+}
+
+// loadConfig loads settings from path, or from DefaultConfigFile if it exists.
+func (a *app) loadConfig(path string, overrides config.Overrides) error {
+	if path == "" {
+		if _, err := os.Stat(DefaultConfigFile); err == nil {
+			path = DefaultConfigFile
+		}
+	}
+
+	c, err := config.Load(path, a.env, overrides)
+	if err != nil {
+		return err
+	}
+	a.config = c
+	return nil
+}
+
+func (a *app) usage(global *flag.FlagSet) {
+	fmt.Fprintln(a.stderr, "Usage: fla [-config fla.toml] [-set key=value]... [-data fla.zip] [-as username] <command> [flags]")
+	fmt.Fprintln(a.stderr, "\nCommands:")
+	for _, c := range commands {
+		fmt.Fprintf(a.stderr, "  %-22s %s\n", c.name, c.summary)
+	}
+	fmt.Fprintln(a.stderr, "\nGlobal flags:")
+	global.PrintDefaults()
+}
+
+// print writes a command result as indented JSON.
+func (a *app) print(result any) int {
+	encoder := json.NewEncoder(a.stdout)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(result); err != nil {
+		return a.fail(err)
+	}
+	return ExitOK
+}
+
+// errorOutput is what failing commands print on stderr. Detail carries the
+// operation trace, which is for the operator running the command.
+type errorOutput struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+	Detail  string `json:"detail"`
+}
+
+// fail prints the error and returns the exit code of its kernel error code.
+func (a *app) fail(err error) int {
+	encoder := json.NewEncoder(a.stderr)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	_ = encoder.Encode(errorOutput{
+		Code:    kernel.ErrorCode(err),
+		Message: kernel.ErrorMessage(err),
+		Detail:  err.Error(),
+	})
+	return exitCode(err)
+}
+
+func exitCode(err error) int {
+	if code, ok := exitCodes[kernel.ErrorCode(err)]; ok {
+		return code
+	}
+	return ExitInternal
+}
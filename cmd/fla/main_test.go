@@ -0,0 +1,286 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alnah/fla/internal/adapters/memory"
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/post"
+	"github.com/alnah/fla/internal/domain/shared"
+	"github.com/alnah/fla/internal/domain/subscription"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+const lesson = `---
+id: market
+title: Faire ses courses au marché
+author: id-1
+status: published
+category: a1/sports
+published_at: 2026-03-01T09:00:00Z
+---
+` + "Le samedi, je vais au marché avec mon équipe de football. Nous achetons des fruits et des légumes. "
+
+func writeFile(t *testing.T, dir, name, content string) string {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	assertNoError(t, os.WriteFile(path, []byte(content), 0o644))
+	return path
+}
+
+func TestRun_Accounts(t *testing.T) {
+	h := newHarness(t)
+
+	code, _, stderr := h.run("create-user", "-username", "marie", "-email", "marie@example.com")
+	assertExit(t, code, ExitInvalid, stderr)
+	assertErrorOutput(t, stderr, kernel.EInvalid)
+
+	created := decode[userOutput](t, h.mustRun("create-user", "-username", "marie", "-email", "marie@example.com", "-role", "admin"))
+	if created.ID != "id-2" || created.Roles[0] != "admin" {
+		t.Errorf("got %+v", created)
+	}
+
+	tests := []struct {
+		name string
+		args []string
+		want int
+	}{
+		{"later accounts need -as", []string{"create-user", "-username", "paul", "-email", "paul@example.com"}, ExitForbidden},
+		{"unknown -as account", []string{"-as", "nobody", "create-user", "-username", "paul", "-email", "paul@example.com"}, ExitNotFound},
+		{"taken username", []string{"-as", "marie", "create-user", "-username", "marie", "-email", "other@example.com"}, ExitConflict},
+		{"invalid email", []string{"-as", "marie", "create-user", "-username", "paul", "-email", "paul"}, ExitInvalid},
+		{"missing flag", []string{"-as", "marie", "create-user", "-username", "paul"}, ExitUsage},
+		{"unknown command", []string{"delete-everything"}, ExitUsage},
+		{"no command", nil, ExitUsage},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code, _, stderr := h.run(tt.args...)
+			assertExit(t, code, tt.want, stderr)
+		})
+	}
+
+	t.Run("admins create accounts that persist", func(t *testing.T) {
+		h.mustRun("-as", "marie", "create-user", "-username", "paul", "-email", "paul@example.com", "-role", "author")
+
+		code, _, stderr := h.run("-as", "paul", "create-user", "-username", "lea", "-email", "lea@example.com")
+		assertExit(t, code, ExitForbidden, stderr)
+
+		users, err := h.open().users.GetAllUsers()
+		assertNoError(t, err)
+		if len(users) != 2 {
+			t.Errorf("got %d users, want 2", len(users))
+		}
+	})
+}
+
+func TestRun_Categories(t *testing.T) {
+	h := newHarness(t)
+	h.mustRun("create-user", "-username", "marie", "-email", "marie@example.com", "-role", "admin")
+	h.mustRun("-as", "marie", "create-user", "-username", "paul", "-email", "paul@example.com")
+
+	h.mustRun("-as", "marie", "create-category", "-name", "A1")
+	created := decode[categoryOutput](t, h.mustRun("-as", "marie", "create-category", "-name", "Compréhension écrite", "-parent", "a1"))
+	if created.Path != "a1/comprehension-ecrite" {
+		t.Errorf("path: got %q", created.Path)
+	}
+
+	tests := []struct {
+		name string
+		args []string
+		want int
+	}{
+		{"unknown parent", []string{"-as", "marie", "create-category", "-name", "Sports", "-parent", "b2"}, ExitNotFound},
+		{"slug taken among siblings", []string{"-as", "marie", "create-category", "-name", "A1"}, ExitConflict},
+		{"authors cannot manage categories", []string{"-as", "paul", "create-category", "-name", "A2"}, ExitForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code, _, stderr := h.run(tt.args...)
+			assertExit(t, code, tt.want, stderr)
+		})
+	}
+}
+
+func TestRun_ContentLifecycle(t *testing.T) {
+	h := newHarness(t)
+	h.mustRun("create-user", "-username", "marie", "-email", "marie@example.com", "-role", "admin,editor")
+	h.mustRun("-as", "marie", "create-category", "-name", "A1")
+	h.mustRun("-as", "marie", "create-category", "-name", "Sports", "-parent", "a1")
+	file := writeFile(t, h.dir, "market.md", lesson+strings.Repeat("Nous parlons en français. ", 10))
+
+	t.Run("dry run writes nothing", func(t *testing.T) {
+		out := decode[importOutput](t, h.mustRun("-as", "marie", "import", "-format", "markdown", "-dry-run", file))
+
+		if out.Posts != 1 || !out.DryRun {
+			t.Errorf("got %+v", out)
+		}
+		if list, _ := h.open().posts.Find(post.NewQuery()); len(list.Posts) != 0 {
+			t.Error("expected no post written")
+		}
+	})
+
+	t.Run("suspended admins cannot import", func(t *testing.T) {
+		h.mustRun("-as", "marie", "create-user", "-username", "paul", "-email", "paul@example.com", "-role", "admin")
+		b := h.open()
+		marie, err := b.users.GetUserByUsername("marie")
+		assertNoError(t, err)
+		paul, err := b.users.GetUserByUsername("paul")
+		assertNoError(t, err)
+		suspended, err := paul.Suspend("Compromised account", marie)
+		assertNoError(t, err)
+		b.users = memory.NewUserStore()
+		assertNoError(t, b.users.CreateUser(*marie))
+		assertNoError(t, b.users.CreateUser(suspended))
+		assertNoError(t, b.save())
+
+		code, _, stderr := h.run("-as", "paul", "import", "-format", "markdown", file)
+
+		assertExit(t, code, ExitForbidden, stderr)
+	})
+
+	t.Run("invalid files print their report and block the import", func(t *testing.T) {
+		broken := writeFile(t, h.dir, "broken.md", strings.Replace(lesson, "a1/sports", "b2/sports", 1))
+
+		code, stdout, stderr := h.run("-as", "marie", "import", "-format", "markdown", file, broken)
+
+		assertExit(t, code, ExitInvalid, stderr)
+		out := decode[importOutput](t, stdout)
+		if len(out.Reports) != 2 || !out.Reports[1].HasErrors() {
+			t.Errorf("got %+v", out)
+		}
+	})
+
+	t.Run("import, export, and index", func(t *testing.T) {
+		h.mustRun("-as", "marie", "import", "-format", "markdown", file)
+
+		exported := decode[exportOutput](t, h.mustRun("-as", "marie", "export", "-out", filepath.Join(h.dir, "content")))
+		want := filepath.Join(h.dir, "content", "a1", "sports", "faire-ses-courses-au-marche.md")
+		if len(exported.Files) != 1 || exported.Files[0] != want {
+			t.Fatalf("files: got %v", exported.Files)
+		}
+
+		index := decode[indexOutput](t, h.mustRun("rebuild-search-index"))
+		if index.Documents != 1 || index.Path != filepath.Join(h.dir, "fla.search.json") {
+			t.Errorf("got %+v", index)
+		}
+
+		results, err := fileIndexStore{path: index.Path}.LoadIndex()
+		assertNoError(t, err)
+		if got := results.Search("equipe football", 10); len(got) != 1 || got[0].Path != "a1/sports/faire-ses-courses-au-marche" {
+			t.Errorf("search: got %+v", got)
+		}
+	})
+
+	t.Run("publishes approved scheduled posts once due", func(t *testing.T) {
+		at := fixtureNow.Add(time.Hour)
+		scheduled := strings.NewReplacer("id: market", "id: cinema", "Faire ses courses au marché", "Une soirée au cinéma",
+			"status: published", "status: scheduled", "2026-03-01T09:00:00Z", at.Format(time.RFC3339)).Replace(lesson)
+		h.mustRun("-as", "marie", "import", "-format", "markdown", writeFile(t, h.dir, "cinema.md", scheduled+strings.Repeat("Au cinéma. ", 20)))
+
+		h.clock.t = at
+		out := decode[publishOutput](t, h.mustRun("-as", "marie", "publish-scheduled"))
+		if len(out.Published) != 0 || len(out.Failed) != 1 || out.Failed[0].Code != kernel.EInvalid {
+			t.Fatalf("unapproved: got %+v", out)
+		}
+
+		b := h.open()
+		p, err := b.posts.GetByID("cinema")
+		assertNoError(t, err)
+		approver := kernel.ID[user.User]("id-1")
+		p.ApprovedBy, p.ApprovedAt = &approver, &at
+		assertNoError(t, b.posts.Update(*p))
+		assertNoError(t, b.save())
+
+		out = decode[publishOutput](t, h.mustRun("-as", "marie", "publish-scheduled"))
+		if len(out.Published) != 1 || out.Published[0].Slug != "une-soiree-au-cinema" {
+			t.Errorf("approved: got %+v", out)
+		}
+
+		p, err = h.open().posts.GetByID("cinema")
+		assertNoError(t, err)
+		if p.Status != post.StatusPublished {
+			t.Errorf("status: got %s", p.Status)
+		}
+	})
+
+	t.Run("sends the digest to the outbox", func(t *testing.T) {
+		b := h.open()
+		for _, name := range []string{"lea", "tom"} {
+			s, err := subscription.NewSubscription(subscription.NewSubscriptionParams{
+				SubscriptionID: kernel.ID[subscription.Subscription](name),
+				FirstName:      "Léa",
+				Email:          shared.Email(name + "@example.com"),
+				Preferences:    &subscription.Preferences{Locale: shared.DefaultLocale, Frequency: subscription.FrequencyWeeklyDigest},
+				Consent:        subscription.Consent{Source: subscription.SourceSignupForm, FormURL: "https://fla.example.com/newsletter", TextVersion: "2024-05-01", GivenAt: h.clock.Now()},
+				Clock:          h.clock,
+			})
+			assertNoError(t, err)
+			assertNoError(t, b.subscriptions.Create(s))
+		}
+		blocked, err := subscription.NewSuppression("tom@example.com", subscription.ReasonComplaint, "", "", h.clock)
+		assertNoError(t, err)
+		assertNoError(t, b.suppressions.AddSuppression(blocked))
+		assertNoError(t, b.save())
+
+		h.clock.t = h.clock.t.Add(time.Minute)
+		outbox := filepath.Join(h.dir, "outbox")
+		out := decode[digestOutput](t, h.mustRun("-set", "site.base_url=https://fla.example.com/", "-set", "email.outbox="+outbox, "-as", "marie", "send-digest"))
+		if out.Posts != 2 || out.Sent != 1 || out.Suppressed != 1 {
+			t.Fatalf("got %+v", out)
+		}
+
+		message, err := os.ReadFile(filepath.Join(outbox, "001-lea_at_example.com.txt"))
+		assertNoError(t, err)
+		if !strings.Contains(string(message), "https://fla.example.com/a1/sports/une-soiree-au-cinema") {
+			t.Errorf("message:\n%s", message)
+		}
+
+		again := decode[digestOutput](t, h.mustRun("-set", "site.base_url=https://fla.example.com/", "-set", "email.outbox="+outbox, "-as", "marie", "send-digest"))
+		if again.Sent != 0 || again.AlreadySent != 1 || again.Suppressed != 1 {
+			t.Errorf("second run: got %+v", again)
+		}
+	})
+}
+
+func TestRun_Config(t *testing.T) {
+	h := newHarness(t)
+	h.mustRun("create-user", "-username", "marie", "-email", "marie@example.com", "-role", "admin")
+
+	t.Run("the archive location comes from settings", func(t *testing.T) {
+		data := filepath.Join(h.dir, "from-env.zip")
+		var stdout, stderr strings.Builder
+		a := &app{stdout: &stdout, stderr: &stderr, clock: h.clock, newID: randomID, env: []string{"FLA_STORAGE_DATA_FILE=" + data}}
+
+		code := a.run([]string{"create-user", "-username", "marie", "-email", "marie@example.com", "-role", "admin"})
+
+		assertExit(t, code, ExitOK, stderr.String())
+		if _, err := os.Stat(data); err != nil {
+			t.Errorf("expected the archive at %s: %v", data, err)
+		}
+	})
+
+	tests := []struct {
+		name string
+		args []string
+		want int
+	}{
+		{"invalid settings stop every command", []string{"-set", "pagination.max_limit=0", "rebuild-search-index"}, ExitInvalid},
+		{"unknown settings are usage errors", []string{"-set", "site.colour=blue", "rebuild-search-index"}, ExitUsage},
+		{"missing settings file", []string{"-config", filepath.Join(h.dir, "missing.toml"), "rebuild-search-index"}, ExitNotFound},
+		{"providers without a sender", []string{"-set", "email.provider=ses", "-set", "email.api_key=key", "-as", "marie", "send-digest"}, ExitInvalid},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code, _, stderr := h.run(tt.args...)
+			assertExit(t, code, tt.want, stderr)
+		})
+	}
+}
@@ -0,0 +1,225 @@
+// Package chaos injects faults into adapters to exercise resilience paths in integration tests.
+// Wrap a real or in-memory adapter, configure error rates and latency, and assert that
+// fan-out, workers, and retries still converge.
+package chaos
+
+import (
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+)
+
+const (
+	MFaultInjected string = "Injected fault in %s."
+	MRateInvalid   string = "Fault rate must be between 0 and 1."
+	MLatencyNeg    string = "Fault latency cannot be negative."
+)
+
+// Rule describes the faults applied to one operation.
+type Rule struct {
+	ErrorRate   float64       // Probability [0, 1] that a call fails
+	Latency     time.Duration // Fixed delay added before every call
+	Jitter      time.Duration // Extra random delay in [0, Jitter)
+	PartialRate float64       // Probability [0, 1] that each item of a batch fails
+}
+
+// Validate ensures rates are probabilities and delays are non-negative.
+func (r Rule) Validate() error {
+	const op = "Rule.Validate"
+
+	if r.ErrorRate < 0 || r.ErrorRate > 1 || r.PartialRate < 0 || r.PartialRate > 1 {
+		return &kernel.Error{Code: kernel.EInvalid, Message: MRateInvalid, Operation: op}
+	}
+
+	if r.Latency < 0 || r.Jitter < 0 {
+		return &kernel.Error{Code: kernel.EInvalid, Message: MLatencyNeg, Operation: op}
+	}
+
+	return nil
+}
+
+// Config holds the default rule and per-operation overrides.
+// Operations are named like the wrapped methods, e.g. "PostReader.GetByID".
+type Config struct {
+	Default Rule
+	Rules   map[string]Rule
+	Seed    int64 // Same seed, same faults: keeps failing tests reproducible
+}
+
+// Validate ensures every rule is valid.
+func (c Config) Validate() error {
+	const op = "Config.Validate"
+
+	if err := c.Default.Validate(); err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	for _, rule := range c.Rules {
+		if err := rule.Validate(); err != nil {
+			return &kernel.Error{Operation: op, Cause: err}
+		}
+	}
+
+	return nil
+}
+
+// Sleeper waits for injected latency; swapped for a recorder in unit tests.
+type Sleeper func(d time.Duration)
+
+// Injector decides, per call, whether to delay and whether to fail.
+// Safe for concurrent use by fan-out workers.
+type Injector struct {
+	config Config
+	sleep  Sleeper
+
+	mu      sync.Mutex
+	rng     *rand.Rand
+	enabled bool
+	calls   map[string]int
+	faults  map[string]int
+}
+
+// NewInjector creates an enabled injector. A nil sleeper uses time.Sleep.
+func NewInjector(config Config, sleep Sleeper) (*Injector, error) {
+	const op = "NewInjector"
+
+	if err := config.Validate(); err != nil {
+		return nil, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if sleep == nil {
+		sleep = time.Sleep
+	}
+
+	return &Injector{
+		config:  config,
+		sleep:   sleep,
+		rng:     rand.New(rand.NewSource(config.Seed)),
+		enabled: true,
+		calls:   make(map[string]int),
+		faults:  make(map[string]int),
+	}, nil
+}
+
+// SetEnabled toggles fault injection, e.g. to let a retry phase succeed.
+func (i *Injector) SetEnabled(enabled bool) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.enabled = enabled
+}
+
+// Before runs at the start of a wrapped call: applies latency, then maybe fails.
+func (i *Injector) Before(operation string) error {
+	delay, fail := i.decide(operation)
+
+	if delay > 0 {
+		i.sleep(delay)
+	}
+
+	if fail {
+		return fault(operation)
+	}
+
+	return nil
+}
+
+// FailedItems returns the indexes of batch items that should fail for this call.
+func (i *Injector) FailedItems(operation string, n int) []int {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	rule := i.rule(operation)
+	if !i.enabled || rule.PartialRate == 0 {
+		return nil
+	}
+
+	var failed []int
+	for idx := range n {
+		if i.rng.Float64() < rule.PartialRate {
+			failed = append(failed, idx)
+		}
+	}
+	i.faults[operation] += len(failed)
+
+	return failed
+}
+
+// Calls returns how many times an operation was invoked through the injector.
+func (i *Injector) Calls(operation string) int {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	return i.calls[operation]
+}
+
+// Faults returns how many faults were injected for an operation, batch items included.
+func (i *Injector) Faults(operation string) int {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	return i.faults[operation]
+}
+
+// decide records the call and draws its delay and failure under the lock.
+func (i *Injector) decide(operation string) (time.Duration, bool) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	i.calls[operation]++
+	rule := i.rule(operation)
+	if !i.enabled {
+		return 0, false
+	}
+
+	delay := rule.Latency
+	if rule.Jitter > 0 {
+		delay += time.Duration(i.rng.Int63n(int64(rule.Jitter)))
+	}
+
+	fail := rule.ErrorRate > 0 && i.rng.Float64() < rule.ErrorRate
+	if fail {
+		i.faults[operation]++
+	}
+
+	return delay, fail
+}
+
+func (i *Injector) rule(operation string) Rule {
+	if rule, ok := i.config.Rules[operation]; ok {
+		return rule
+	}
+	return i.config.Default
+}
+
+// Do runs fn behind the injector, failing before fn is reached when a fault is injected.
+func Do[T any](i *Injector, operation string, fn func() (T, error)) (T, error) {
+	if err := i.Before(operation); err != nil {
+		var zero T
+		return zero, err
+	}
+	return fn()
+}
+
+// IsInjected reports whether an error was produced by the injector.
+// Walks the kernel error chain so wrapped faults are recognized too.
+func IsInjected(err error) bool {
+	e, ok := err.(*kernel.Error)
+	for ok {
+		if e.Operation == faultOperation {
+			return true
+		}
+		e, ok = e.Cause.(*kernel.Error)
+	}
+	return false
+}
+
+const faultOperation = "chaos.Fault"
+
+func fault(operation string) error {
+	return &kernel.Error{
+		Code:      kernel.EInternal,
+		Message:   fmt.Sprintf(MFaultInjected, operation),
+		Operation: faultOperation,
+	}
+}
@@ -0,0 +1,132 @@
+package chaos_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/alnah/fla/internal/adapters/chaos"
+	"github.com/alnah/fla/internal/domain/kernel"
+)
+
+func TestNewInjector(t *testing.T) {
+	invalid := []chaos.Config{
+		{Default: chaos.Rule{ErrorRate: -0.1}},
+		{Default: chaos.Rule{ErrorRate: 1.5}},
+		{Default: chaos.Rule{PartialRate: 2}},
+		{Default: chaos.Rule{Latency: -time.Second}},
+		{Rules: map[string]chaos.Rule{"PostReader.GetByID": {Jitter: -time.Millisecond}}},
+	}
+
+	for _, config := range invalid {
+		_, err := chaos.NewInjector(config, nil)
+
+		assertError(t, err)
+		assertErrorCode(t, err, kernel.EInvalid)
+	}
+}
+
+func TestInjector_Before(t *testing.T) {
+	t.Run("always fails at rate 1 and never at rate 0", func(t *testing.T) {
+		injector, err := chaos.NewInjector(chaos.Config{
+			Default: chaos.Rule{ErrorRate: 1},
+			Rules:   map[string]chaos.Rule{"healthy": {}},
+		}, nil)
+		assertNoError(t, err)
+
+		for range 10 {
+			err := injector.Before("broken")
+			assertError(t, err)
+			assertErrorCode(t, err, kernel.EInternal)
+			if !chaos.IsInjected(err) {
+				t.Error("expected injected fault")
+			}
+			assertNoError(t, injector.Before("healthy"))
+		}
+
+		if injector.Calls("broken") != 10 || injector.Faults("broken") != 10 || injector.Faults("healthy") != 0 {
+			t.Errorf("calls %d, faults %d", injector.Calls("broken"), injector.Faults("broken"))
+		}
+	})
+
+	t.Run("is reproducible for a seed", func(t *testing.T) {
+		run := func() []bool {
+			injector, _ := chaos.NewInjector(chaos.Config{Default: chaos.Rule{ErrorRate: 0.5}, Seed: 42}, nil)
+			results := make([]bool, 20)
+			for i := range results {
+				results[i] = injector.Before("op") != nil
+			}
+			return results
+		}
+
+		a, b := run(), run()
+		for i := range a {
+			if a[i] != b[i] {
+				t.Fatalf("call %d differs between runs", i)
+			}
+		}
+	})
+
+	t.Run("applies latency with jitter", func(t *testing.T) {
+		var slept []time.Duration
+		injector, _ := chaos.NewInjector(chaos.Config{
+			Default: chaos.Rule{Latency: 100 * time.Millisecond, Jitter: 50 * time.Millisecond},
+		}, func(d time.Duration) { slept = append(slept, d) })
+
+		for range 5 {
+			assertNoError(t, injector.Before("op"))
+		}
+
+		for _, d := range slept {
+			if d < 100*time.Millisecond || d >= 150*time.Millisecond {
+				t.Errorf("delay %v out of range", d)
+			}
+		}
+		if len(slept) != 5 {
+			t.Errorf("slept %d times, want 5", len(slept))
+		}
+	})
+
+	t.Run("can be disabled", func(t *testing.T) {
+		injector, _ := chaos.NewInjector(chaos.Config{Default: chaos.Rule{ErrorRate: 1}}, nil)
+
+		injector.SetEnabled(false)
+
+		assertNoError(t, injector.Before("op"))
+		if injector.Calls("op") != 1 {
+			t.Error("calls must be counted while disabled")
+		}
+	})
+}
+
+func TestIsInjected(t *testing.T) {
+	injector, _ := chaos.NewInjector(chaos.Config{Default: chaos.Rule{ErrorRate: 1}}, nil)
+	fault := injector.Before("op")
+
+	wrapped := &kernel.Error{Operation: "Service.Do", Cause: fault}
+
+	if !chaos.IsInjected(wrapped) {
+		t.Error("expected wrapped fault to be recognized")
+	}
+	if chaos.IsInjected(errors.New("real failure")) || chaos.IsInjected(nil) {
+		t.Error("expected real errors not to be recognized")
+	}
+}
+
+func TestDo(t *testing.T) {
+	injector, _ := chaos.NewInjector(chaos.Config{Rules: map[string]chaos.Rule{"down": {ErrorRate: 1}}}, nil)
+	called := 0
+	fn := func() (int, error) { called++; return 7, nil }
+
+	got, err := chaos.Do(injector, "up", fn)
+	assertNoError(t, err)
+	if got != 7 {
+		t.Errorf("got %d", got)
+	}
+
+	_, err = chaos.Do(injector, "down", fn)
+	assertError(t, err)
+	if called != 1 {
+		t.Errorf("fn must not run on injected fault, called %d times", called)
+	}
+}
@@ -0,0 +1,86 @@
+package chaos
+
+import (
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/notification"
+	"github.com/alnah/fla/internal/domain/post"
+	"github.com/alnah/fla/internal/domain/shared"
+	"github.com/alnah/fla/internal/domain/subscription"
+)
+
+// PostReader injects faults into post lookups.
+type PostReader struct {
+	Next     post.PostReader
+	Injector *Injector
+}
+
+func (r PostReader) GetByID(postID kernel.ID[post.Post]) (*post.Post, error) {
+	return Do(r.Injector, "PostReader.GetByID", func() (*post.Post, error) {
+		return r.Next.GetByID(postID)
+	})
+}
+
+func (r PostReader) GetBySlug(slug shared.Slug) (*post.Post, error) {
+	return Do(r.Injector, "PostReader.GetBySlug", func() (*post.Post, error) {
+		return r.Next.GetBySlug(slug)
+	})
+}
+
+// SubscriptionWriter injects faults into subscription persistence.
+type SubscriptionWriter struct {
+	Next     subscription.SubscriptionWriter
+	Injector *Injector
+}
+
+func (w SubscriptionWriter) Create(s subscription.Subscription) error {
+	return w.call("SubscriptionWriter.Create", func() error { return w.Next.Create(s) })
+}
+
+func (w SubscriptionWriter) Update(s subscription.Subscription) error {
+	return w.call("SubscriptionWriter.Update", func() error { return w.Next.Update(s) })
+}
+
+func (w SubscriptionWriter) Delete(subscriptionID kernel.ID[subscription.Subscription]) error {
+	return w.call("SubscriptionWriter.Delete", func() error { return w.Next.Delete(subscriptionID) })
+}
+
+func (w SubscriptionWriter) call(operation string, fn func() error) error {
+	if err := w.Injector.Before(operation); err != nil {
+		return err
+	}
+	return fn()
+}
+
+// NotificationSender injects faults into notification delivery, the typical fan-out edge.
+type NotificationSender struct {
+	Next     notification.Sender
+	Injector *Injector
+}
+
+func (s NotificationSender) Send(message notification.Message) error {
+	if err := s.Injector.Before("Sender.Send"); err != nil {
+		return err
+	}
+	return s.Next.Send(message)
+}
+
+// Batch splits a batch into items to process and items that fail with an injected fault.
+// Models partial failures of bulk APIs (e.g. an ESP accepting only part of a send).
+func Batch[T any](i *Injector, operation string, items []T) (accepted []T, rejected []T) {
+	failed := i.FailedItems(operation, len(items))
+	if len(failed) == 0 {
+		return items, nil
+	}
+
+	next := 0
+	for idx, item := range items {
+		if next < len(failed) && failed[next] == idx {
+			rejected = append(rejected, item)
+			next++
+			continue
+		}
+		accepted = append(accepted, item)
+	}
+
+	return accepted, rejected
+}
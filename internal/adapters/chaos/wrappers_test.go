@@ -0,0 +1,94 @@
+package chaos_test
+
+import (
+	"testing"
+
+	"github.com/alnah/fla/internal/adapters/chaos"
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/notification"
+	"github.com/alnah/fla/internal/domain/post"
+	"github.com/alnah/fla/internal/domain/shared"
+)
+
+type memoryPosts struct{}
+
+func (memoryPosts) GetByID(id kernel.ID[post.Post]) (*post.Post, error) {
+	return &post.Post{PostID: id}, nil
+}
+
+func (memoryPosts) GetBySlug(slug shared.Slug) (*post.Post, error) {
+	return &post.Post{Slug: slug}, nil
+}
+
+type countingSender struct{ sent int }
+
+func (s *countingSender) Send(notification.Message) error {
+	s.sent++
+	return nil
+}
+
+func TestPostReader(t *testing.T) {
+	injector, _ := chaos.NewInjector(chaos.Config{
+		Rules: map[string]chaos.Rule{"PostReader.GetBySlug": {ErrorRate: 1}},
+	}, nil)
+	reader := chaos.PostReader{Next: memoryPosts{}, Injector: injector}
+
+	got, err := reader.GetByID("post-1")
+	assertNoError(t, err)
+	if got.PostID != "post-1" {
+		t.Errorf("got %v", got.PostID)
+	}
+
+	_, err = reader.GetBySlug("jouer-au-football")
+	assertError(t, err)
+	if !chaos.IsInjected(err) {
+		t.Error("expected injected fault")
+	}
+}
+
+func TestNotificationSender(t *testing.T) {
+	injector, _ := chaos.NewInjector(chaos.Config{Default: chaos.Rule{ErrorRate: 0.5}, Seed: 1}, nil)
+	next := &countingSender{}
+	sender := chaos.NotificationSender{Next: next, Injector: injector}
+
+	failures := 0
+	for range 100 {
+		if err := sender.Send(notification.Message{}); err != nil {
+			failures++
+		}
+	}
+
+	if failures == 0 || failures == 100 || failures+next.sent != 100 {
+		t.Errorf("failures %d, sent %d", failures, next.sent)
+	}
+	if injector.Faults("Sender.Send") != failures {
+		t.Errorf("faults: got %d, want %d", injector.Faults("Sender.Send"), failures)
+	}
+}
+
+func TestBatch(t *testing.T) {
+	items := []string{"a", "b", "c", "d", "e", "f", "g", "h"}
+
+	t.Run("splits batch at partial rate", func(t *testing.T) {
+		injector, _ := chaos.NewInjector(chaos.Config{Default: chaos.Rule{PartialRate: 0.5}, Seed: 3}, nil)
+
+		accepted, rejected := chaos.Batch(injector, "ESP.SendBatch", items)
+
+		if len(accepted)+len(rejected) != len(items) {
+			t.Errorf("lost items: %v + %v", accepted, rejected)
+		}
+		if injector.Faults("ESP.SendBatch") != len(rejected) {
+			t.Errorf("faults: got %d, want %d", injector.Faults("ESP.SendBatch"), len(rejected))
+		}
+	})
+
+	t.Run("accepts all without partial rate", func(t *testing.T) {
+		injector, _ := chaos.NewInjector(chaos.Config{}, nil)
+
+		accepted, rejected := chaos.Batch(injector, "ESP.SendBatch", items)
+
+		if len(accepted) != len(items) || rejected != nil {
+			t.Errorf("got %v / %v", accepted, rejected)
+		}
+	})
+}
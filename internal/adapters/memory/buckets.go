@@ -0,0 +1,82 @@
+package memory
+
+import (
+	"sync"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/ratelimit"
+)
+
+// BucketSweepEvery is how often saving a bucket also drops the others that
+// refilled to full while idle.
+const BucketSweepEvery time.Duration = time.Minute
+
+// BucketStore keeps rate limit buckets. It is not part of backups:
+// buckets are short-lived and start over full after a restart.
+// Full buckets are dropped, since a missing bucket counts as full, so one
+// visit per address does not grow the store forever.
+type BucketStore struct {
+	mu        sync.RWMutex
+	buckets   map[ratelimit.Key]ratelimit.Bucket
+	policies  ratelimit.Policies
+	clock     kernel.Clock
+	lastSweep time.Time
+}
+
+// NewBucketStore creates an empty bucket store sizing buckets with the
+// limiter's policies.
+func NewBucketStore(policies ratelimit.Policies, clock kernel.Clock) *BucketStore {
+	return &BucketStore{
+		buckets:  make(map[ratelimit.Key]ratelimit.Bucket),
+		policies: policies,
+		clock:    clock,
+	}
+}
+
+func (s *BucketStore) GetBucket(key ratelimit.Key) (*ratelimit.Bucket, error) {
+	const op = "BucketStore.GetBucket"
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	b, ok := s.buckets[key]
+	if !ok {
+		return nil, notFound("Bucket", op)
+	}
+	return &b, nil
+}
+
+func (s *BucketStore) SaveBucket(key ratelimit.Key, bucket ratelimit.Bucket) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.clock.Now()
+	if now.Sub(s.lastSweep) >= BucketSweepEvery {
+		s.sweep(now)
+		s.lastSweep = now
+	}
+
+	if s.isFull(key, bucket, now) {
+		delete(s.buckets, key)
+		return nil
+	}
+	s.buckets[key] = bucket
+	return nil
+}
+
+// sweep drops the buckets that refilled to full since they were saved.
+func (s *BucketStore) sweep(now time.Time) {
+	for key, bucket := range s.buckets {
+		if s.isFull(key, bucket, now) {
+			delete(s.buckets, key)
+		}
+	}
+}
+
+// isFull reports whether the bucket holds its whole capacity at now.
+// Buckets without a policy are kept.
+func (s *BucketStore) isFull(key ratelimit.Key, bucket ratelimit.Bucket, now time.Time) bool {
+	policy, ok := s.policies[ratelimit.Rule{Action: key.Action, Kind: key.Subject.Kind}]
+	return ok && bucket.Refill(policy, now).Tokens >= policy.Capacity
+}
@@ -0,0 +1,236 @@
+package memory
+
+import (
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/alnah/fla/internal/domain/category"
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/shared"
+)
+
+// CategoryStore keeps the category tree. Slugs are unique among siblings,
+// and a category's parent must exist before it.
+type CategoryStore struct {
+	mu         sync.RWMutex
+	categories map[kernel.ID[category.Category]]category.Category
+}
+
+// NewCategoryStore creates an empty category store.
+func NewCategoryStore() *CategoryStore {
+	return &CategoryStore{categories: make(map[kernel.ID[category.Category]]category.Category)}
+}
+
+func (s *CategoryStore) GetByID(categoryID kernel.ID[category.Category]) (*category.Category, error) {
+	const op = "CategoryStore.GetByID"
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	c, ok := s.categories[categoryID]
+	if !ok {
+		return nil, notFound("Category", op)
+	}
+	return &c, nil
+}
+
+// GetAll returns every category ordered by ID.
+func (s *CategoryStore) GetAll() ([]category.Category, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return sorted(s.categories), nil
+}
+
+func (s *CategoryStore) GetChildren(categoryID kernel.ID[category.Category]) ([]category.Category, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.children(&categoryID), nil
+}
+
+func (s *CategoryStore) GetRootCategories() ([]category.Category, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.children(nil), nil
+}
+
+func (s *CategoryStore) BuildPath(categoryID kernel.ID[category.Category]) (category.CategoryPath, error) {
+	const op = "CategoryStore.BuildPath"
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	path, ok := s.path(categoryID)
+	if !ok {
+		return nil, notFound("Category", op)
+	}
+	return path, nil
+}
+
+func (s *CategoryStore) BuildPaths(categoryIDs []kernel.ID[category.Category]) (map[kernel.ID[category.Category]]category.CategoryPath, error) {
+	const op = "CategoryStore.BuildPaths"
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	paths := make(map[kernel.ID[category.Category]]category.CategoryPath, len(categoryIDs))
+	for _, id := range categoryIDs {
+		path, ok := s.path(id)
+		if !ok {
+			return nil, notFound("Category", op)
+		}
+		paths[id] = path
+	}
+	return paths, nil
+}
+
+// path walks up from a category to its root; the caller holds the lock.
+func (s *CategoryStore) path(categoryID kernel.ID[category.Category]) (category.CategoryPath, bool) {
+	var path category.CategoryPath
+	for id := &categoryID; id != nil; {
+		c, ok := s.categories[*id]
+		if !ok || len(path) > category.MaxCategoryDepth { // The depth guard protects against corrupt cycles
+			return nil, false
+		}
+		path = append(category.CategoryPath{c}, path...)
+		id = c.ParentID
+	}
+	return path, true
+}
+
+func (s *CategoryStore) FindByPath(pathSegments []string) (*category.Category, error) {
+	const op = "CategoryStore.FindByPath"
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var found *category.Category
+	for _, segment := range pathSegments {
+		var parentID *kernel.ID[category.Category]
+		if found != nil {
+			parentID = &found.CategoryID
+		}
+
+		children := s.children(parentID)
+		i := slices.IndexFunc(children, func(c category.Category) bool {
+			return c.Slug.String() == strings.ToLower(segment)
+		})
+		if i < 0 {
+			return nil, notFound("Category", op)
+		}
+		found = &children[i]
+	}
+
+	if found == nil {
+		return nil, notFound("Category", op)
+	}
+	return found, nil
+}
+
+func (s *CategoryStore) IsSlugUniqueInParent(slug shared.Slug, parentID *kernel.ID[category.Category]) (bool, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return !slices.ContainsFunc(s.children(parentID), func(c category.Category) bool {
+		return c.Slug == slug
+	}), nil
+}
+
+func (s *CategoryStore) Create(c category.Category) error {
+	const op = "CategoryStore.Create"
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.categories[c.CategoryID]; ok {
+		return exists("Category", c.CategoryID.String(), op)
+	}
+	if err := s.checkPlacement(c, op); err != nil {
+		return err
+	}
+
+	s.categories[c.CategoryID] = c
+	return nil
+}
+
+func (s *CategoryStore) Update(c category.Category) error {
+	const op = "CategoryStore.Update"
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.categories[c.CategoryID]; !ok {
+		return notFound("Category", op)
+	}
+	if err := s.checkPlacement(c, op); err != nil {
+		return err
+	}
+
+	s.categories[c.CategoryID] = c
+	return nil
+}
+
+func (s *CategoryStore) Delete(categoryID kernel.ID[category.Category]) error {
+	const op = "CategoryStore.Delete"
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.categories[categoryID]; !ok {
+		return notFound("Category", op)
+	}
+
+	delete(s.categories, categoryID)
+	return nil
+}
+
+// UpdateSortOrders saves every order or none: unknown categories fail the whole batch.
+func (s *CategoryStore) UpdateSortOrders(categories []category.Category) error {
+	const op = "CategoryStore.UpdateSortOrders"
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, c := range categories {
+		if _, ok := s.categories[c.CategoryID]; !ok {
+			return notFound("Category", op)
+		}
+	}
+
+	for _, c := range categories {
+		stored := s.categories[c.CategoryID]
+		stored.SortOrder = c.SortOrder
+		s.categories[c.CategoryID] = stored
+	}
+	return nil
+}
+
+// children returns the categories under a parent, or the roots for nil, in display order.
+func (s *CategoryStore) children(parentID *kernel.ID[category.Category]) []category.Category {
+	var out []category.Category
+	for _, c := range sorted(s.categories) {
+		if (parentID == nil && c.ParentID == nil) || (parentID != nil && c.ParentID != nil && *c.ParentID == *parentID) {
+			out = append(out, c)
+		}
+	}
+	category.SortCategories(out)
+	return out
+}
+
+func (s *CategoryStore) checkPlacement(c category.Category, op string) error {
+	if c.ParentID != nil {
+		if _, ok := s.categories[*c.ParentID]; !ok {
+			return notFound("Parent category", op)
+		}
+	}
+
+	for _, sibling := range s.children(c.ParentID) {
+		if sibling.CategoryID != c.CategoryID && sibling.Slug == c.Slug {
+			return taken("category", "slug", c.Slug.String(), op)
+		}
+	}
+	return nil
+}
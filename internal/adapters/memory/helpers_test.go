@@ -0,0 +1,95 @@
+package memory_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alnah/fla/internal/adapters/memory"
+	"github.com/alnah/fla/internal/domain/category"
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/post"
+	"github.com/alnah/fla/internal/domain/shared"
+	"github.com/alnah/fla/internal/domain/subscription"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+var fixtureNow = time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+
+type stubClock struct {
+	t time.Time
+}
+
+func (s *stubClock) Now() time.Time { return s.t }
+
+func newCategory(t *testing.T, id, name string, parentID *kernel.ID[category.Category]) category.Category {
+	t.Helper()
+
+	c, err := category.NewCategory(category.NewCategoryParams{
+		CategoryID: kernel.ID[category.Category](id),
+		Name:       category.CategoryName(name),
+		ParentID:   parentID,
+		CreatedBy:  "marie",
+		Clock:      &stubClock{fixtureNow},
+	})
+	assertNoError(t, err)
+	return c
+}
+
+// newTree creates a1 > comprehension-ecrite > sports and a2.
+func newTree(t *testing.T) *memory.CategoryStore {
+	t.Helper()
+
+	store := memory.NewCategoryStore()
+	a1 := newCategory(t, "a1", "A1", nil)
+	reading := newCategory(t, "reading", "Compréhension écrite", &a1.CategoryID)
+	sports := newCategory(t, "sports", "Sports", &reading.CategoryID)
+	a2 := newCategory(t, "a2", "A2", nil)
+
+	for _, c := range []category.Category{a1, reading, sports, a2} {
+		assertNoError(t, store.Create(c))
+	}
+	return store
+}
+
+func newPost(t *testing.T, id, title string, c category.Category, status post.Status, publishedAt *time.Time) post.Post {
+	t.Helper()
+
+	p, err := post.NewPost(post.NewPostParams{
+		PostID:      kernel.ID[post.Post](id),
+		Owner:       kernel.ID[user.User]("marie"),
+		Title:       shared.Title(title),
+		Content:     post.PostContent(strings.Repeat("Le samedi, je vais au marché. ", 12)),
+		Status:      status,
+		Category:    c,
+		PublishedAt: publishedAt,
+		Clock:       &stubClock{fixtureNow},
+	})
+	assertNoError(t, err)
+	return p
+}
+
+func assertNoError(t *testing.T, err error) {
+	t.Helper()
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+}
+
+func assertErrorCode(t *testing.T, err error, want string) {
+	t.Helper()
+	got := kernel.ErrorCode(err)
+	if got != want {
+		t.Errorf("error code: got %q, want %q", got, want)
+	}
+}
+
+// testConsent returns a signup form consent given at the clock's time.
+func testConsent(clock kernel.Clock) subscription.Consent {
+	return subscription.Consent{
+		Source:      subscription.SourceSignupForm,
+		FormURL:     "https://fla.example/newsletter",
+		TextVersion: "2024-05-01",
+		GivenAt:     clock.Now(),
+	}
+}
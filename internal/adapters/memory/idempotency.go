@@ -0,0 +1,57 @@
+package memory
+
+import (
+	"sync"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+)
+
+type idempotencyKey struct {
+	scope string
+	key   kernel.IdempotencyKey
+}
+
+// IdempotencyStore keeps the records of idempotent requests, by scope and key.
+// Expired records are left in place; kernel.Idempotent ignores them.
+type IdempotencyStore struct {
+	mu      sync.RWMutex
+	records map[idempotencyKey]kernel.IdempotencyRecord
+}
+
+// NewIdempotencyStore creates an empty idempotency store.
+func NewIdempotencyStore() *IdempotencyStore {
+	return &IdempotencyStore{records: make(map[idempotencyKey]kernel.IdempotencyRecord)}
+}
+
+func (s *IdempotencyStore) GetRecord(scope string, key kernel.IdempotencyKey) (*kernel.IdempotencyRecord, error) {
+	const op = "IdempotencyStore.GetRecord"
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	r, ok := s.records[idempotencyKey{scope, key}]
+	if !ok {
+		return nil, notFound("Idempotency record", op)
+	}
+	return &r, nil
+}
+
+func (s *IdempotencyStore) ReserveRecord(record kernel.IdempotencyRecord) (*kernel.IdempotencyRecord, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	k := idempotencyKey{record.Scope, record.Key}
+	if existing, ok := s.records[k]; ok && !existing.IsExpired(record.CreatedAt) {
+		return &existing, nil
+	}
+	s.records[k] = record
+	return nil, nil
+}
+
+func (s *IdempotencyStore) SaveRecord(record kernel.IdempotencyRecord) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.records[idempotencyKey{record.Scope, record.Key}] = record
+	return nil
+}
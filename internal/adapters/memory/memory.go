@@ -0,0 +1,41 @@
+// Package memory implements the domain repositories in process memory.
+// Stores are safe for concurrent use and hold copies, so callers never share
+// state with them. They back the command line tool, which loads and saves them
+// as backup archives, and serve as reference implementations of the ports.
+package memory
+
+import (
+	"cmp"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+)
+
+const (
+	MRecordNotFound string = "%s not found."
+	MRecordExists   string = "%s %s already exists."
+	MValueTaken     string = "Another %s already uses the %s %s."
+)
+
+func notFound(kind, op string) error {
+	return &kernel.Error{Code: kernel.ENotFound, Message: fmt.Sprintf(MRecordNotFound, kind), Operation: op}
+}
+
+func exists(kind, id, op string) error {
+	return &kernel.Error{Code: kernel.EConflict, Message: fmt.Sprintf(MRecordExists, kind, id), Operation: op}
+}
+
+func taken(kind, field, value, op string) error {
+	return &kernel.Error{Code: kernel.EConflict, Message: fmt.Sprintf(MValueTaken, kind, field, value), Operation: op}
+}
+
+// sorted returns the values of a map ordered by key, so listings are stable.
+func sorted[K cmp.Ordered, V any](m map[K]V) []V {
+	out := make([]V, 0, len(m))
+	for _, k := range slices.Sorted(maps.Keys(m)) {
+		out = append(out, m[k])
+	}
+	return out
+}
@@ -0,0 +1,392 @@
+package memory_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/alnah/fla/internal/adapters/memory"
+	"github.com/alnah/fla/internal/domain/backup"
+	"github.com/alnah/fla/internal/domain/category"
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/post"
+	"github.com/alnah/fla/internal/domain/ratelimit"
+	"github.com/alnah/fla/internal/domain/shared"
+	"github.com/alnah/fla/internal/domain/subscription"
+	"github.com/alnah/fla/internal/domain/tag"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+func TestUserStore(t *testing.T) {
+	store := memory.NewUserStore()
+	marie, err := user.NewUser(user.NewUserParams{UserID: "marie", Username: "marie", Email: "marie@example.com", Roles: []user.Role{user.RoleAuthor}, Clock: &stubClock{fixtureNow}})
+	assertNoError(t, err)
+	assertNoError(t, store.CreateUser(marie))
+
+	t.Run("finds accounts by username and email, ignoring case", func(t *testing.T) {
+		byName, err := store.GetUserByUsername("Marie")
+		assertNoError(t, err)
+		byEmail, err := store.GetUserByEmail("MARIE@example.com")
+		assertNoError(t, err)
+
+		if byName.ID != "marie" || byEmail.ID != "marie" {
+			t.Errorf("got %s and %s", byName.ID, byEmail.ID)
+		}
+	})
+
+	t.Run("rejects a taken ID, username, or email", func(t *testing.T) {
+		for _, u := range []user.User{
+			marie,
+			{ID: "other", Username: "MARIE", Email: "other@example.com"},
+			{ID: "other", Username: "other", Email: "Marie@Example.com"},
+		} {
+			assertErrorCode(t, store.CreateUser(u), kernel.EConflict)
+		}
+	})
+
+	t.Run("reports unknown accounts as not found", func(t *testing.T) {
+		_, err := store.GetUserByID("nobody")
+		assertErrorCode(t, err, kernel.ENotFound)
+	})
+}
+
+func TestCategoryStore(t *testing.T) {
+	store := newTree(t)
+
+	t.Run("builds paths from the root and finds categories by them", func(t *testing.T) {
+		path, err := store.BuildPath("sports")
+		assertNoError(t, err)
+		if got := path.String(); got != "a1/comprehension-ecrite/sports" {
+			t.Errorf("path: got %q", got)
+		}
+
+		found, err := store.FindByPath([]string{"a1", "comprehension-ecrite", "sports"})
+		assertNoError(t, err)
+		if found.CategoryID != "sports" {
+			t.Errorf("found %s", found.CategoryID)
+		}
+
+		_, err = store.FindByPath([]string{"a2", "comprehension-ecrite"})
+		assertErrorCode(t, err, kernel.ENotFound)
+	})
+
+	t.Run("lists roots and children in display order", func(t *testing.T) {
+		roots, err := store.GetRootCategories()
+		assertNoError(t, err)
+		if len(roots) != 2 || roots[0].CategoryID != "a1" || roots[1].CategoryID != "a2" {
+			t.Errorf("roots: got %v", roots)
+		}
+
+		children, err := store.GetChildren("a1")
+		assertNoError(t, err)
+		if len(children) != 1 || children[0].CategoryID != "reading" {
+			t.Errorf("children: got %v", children)
+		}
+	})
+
+	t.Run("keeps slugs unique among siblings only", func(t *testing.T) {
+		a1 := kernel.ID[category.Category]("a1")
+		a2 := kernel.ID[category.Category]("a2")
+
+		assertErrorCode(t, store.Create(newCategory(t, "other-a1", "A1", nil)), kernel.EConflict)
+		assertNoError(t, store.Create(newCategory(t, "a2-sports", "Sports", &a2)))
+
+		unique, err := store.IsSlugUniqueInParent("comprehension-ecrite", &a1)
+		assertNoError(t, err)
+		if unique {
+			t.Error("expected slug taken under a1")
+		}
+	})
+
+	t.Run("requires the parent to exist", func(t *testing.T) {
+		missing := kernel.ID[category.Category]("missing")
+
+		assertErrorCode(t, store.Create(newCategory(t, "orphan", "Orphelin", &missing)), kernel.ENotFound)
+	})
+}
+
+func TestPostStore(t *testing.T) {
+	categories := newTree(t)
+	sports, _ := categories.GetByID("sports")
+	a2, _ := categories.GetByID("a2")
+
+	early := fixtureNow.AddDate(0, 0, -2)
+	late := fixtureNow.AddDate(0, 0, -1)
+	soon := fixtureNow.AddDate(0, 0, 1)
+	later := fixtureNow.AddDate(0, 0, 2)
+
+	store := memory.NewPostStore(categories)
+	for _, p := range []post.Post{
+		newPost(t, "football", "Jouer au football", *sports, post.StatusPublished, &early),
+		newPost(t, "tennis", "Regarder le tennis", *sports, post.StatusPublished, &late),
+		newPost(t, "cuisine", "Cuisiner en famille", *a2, post.StatusPublished, &late),
+		newPost(t, "velo", "Faire du vélo le dimanche", *sports, post.StatusScheduled, &later),
+		newPost(t, "natation", "Nager à la piscine", *sports, post.StatusScheduled, &soon),
+		newPost(t, "brouillon", "Brouillon sur la danse", *sports, post.StatusDraft, nil),
+	} {
+		assertNoError(t, store.Create(p))
+	}
+
+	t.Run("finds posts in a category subtree, newest first, by page", func(t *testing.T) {
+		list, err := store.Find(post.PublishedQuery().InCategory("a1").Page(1, 1))
+		assertNoError(t, err)
+
+		if len(list.Posts) != 1 || list.Posts[0].PostID != "tennis" {
+			t.Fatalf("page 1: got %v", list.Posts)
+		}
+		if list.Pagination.TotalItems != 2 || !list.Pagination.HasNextPage() {
+			t.Errorf("pagination: got %+v", list.Pagination)
+		}
+	})
+
+	t.Run("lists scheduled posts earliest first", func(t *testing.T) {
+		scheduled, err := store.GetScheduledPosts()
+		assertNoError(t, err)
+
+		if len(scheduled) != 2 || scheduled[0].PostID != "natation" || scheduled[1].PostID != "velo" {
+			t.Errorf("got %v", scheduled)
+		}
+	})
+
+	t.Run("keeps slugs unique", func(t *testing.T) {
+		copied := newPost(t, "copie", "Jouer au football", *a2, post.StatusDraft, nil)
+		assertErrorCode(t, store.Create(copied), kernel.EConflict)
+
+		unique, err := store.IsSlugUnique("jouer-au-football", nil)
+		assertNoError(t, err)
+		excluded := kernel.ID[post.Post]("football")
+		own, err := store.IsSlugUnique("jouer-au-football", &excluded)
+		assertNoError(t, err)
+		if unique || !own {
+			t.Errorf("unique: got %t, excluding itself: got %t", unique, own)
+		}
+	})
+
+	t.Run("keeps summaries in step with content", func(t *testing.T) {
+		p, err := store.GetByID("tennis")
+		assertNoError(t, err)
+		before, err := store.GetSummary("tennis")
+		assertNoError(t, err)
+
+		p.Content += " Le tennis se joue aussi en double."
+		assertNoError(t, store.Update(*p))
+
+		after, err := store.GetSummary("tennis")
+		assertNoError(t, err)
+		if after.WordCount != before.WordCount+7 || !after.IsCurrentFor(p.Content) {
+			t.Errorf("got %d words, had %d", after.WordCount, before.WordCount)
+		}
+	})
+
+	t.Run("counts published posts per category", func(t *testing.T) {
+		counts, err := store.CountPublishedByCategory()
+		assertNoError(t, err)
+
+		if counts["sports"] != 2 || counts["a2"] != 1 || counts["a1"] != 0 {
+			t.Errorf("got %v", counts)
+		}
+	})
+
+	t.Run("lists featured posts, newest first", func(t *testing.T) {
+		for id, until := range map[kernel.ID[post.Post]]*time.Time{"football": nil, "tennis": &later, "cuisine": &early, "velo": nil} {
+			p, err := store.GetByID(id)
+			assertNoError(t, err)
+			p.Featured, p.FeaturedUntil = true, until
+			assertNoError(t, store.Update(*p))
+		}
+
+		all, err := store.GetFeaturedPosts(fixtureNow)
+		assertNoError(t, err)
+		inA2, err := store.GetFeaturedPostsByCategory("a2", fixtureNow)
+		assertNoError(t, err)
+
+		if len(all) != 2 || all[0].PostID != "tennis" || all[1].PostID != "football" {
+			t.Errorf("all: got %v", all)
+		}
+		if len(inA2) != 0 {
+			t.Errorf("a2: got %v, want none once featuring ended", inA2)
+		}
+	})
+
+	t.Run("moves posts between categories", func(t *testing.T) {
+		moved, err := store.ReassignPosts("a2", "sports")
+		assertNoError(t, err)
+
+		count, err := store.CountPostsInCategory("sports")
+		assertNoError(t, err)
+		if moved != 1 || count != 6 {
+			t.Errorf("moved %d, sports holds %d", moved, count)
+		}
+	})
+}
+
+func TestSubscriptionStore(t *testing.T) {
+	store := memory.NewSubscriptionStore()
+	clock := &stubClock{fixtureNow}
+
+	lea, err := subscription.NewSubscription(subscription.NewSubscriptionParams{SubscriptionID: "lea", Email: "lea@example.com", Clock: clock, Consent: testConsent(clock)})
+	assertNoError(t, err)
+	tom, err := subscription.NewSubscription(subscription.NewSubscriptionParams{SubscriptionID: "tom", Email: "tom@example.com", Clock: clock, Consent: testConsent(clock)})
+	assertNoError(t, err)
+	tom, err = tom.Unsubscribe()
+	assertNoError(t, err)
+
+	assertNoError(t, store.Create(lea))
+	assertNoError(t, store.Create(tom))
+
+	active, err := store.GetActiveSubscriptions()
+	assertNoError(t, err)
+	if len(active) != 1 || active[0].SubscriptionID != "lea" {
+		t.Errorf("active: got %v", active)
+	}
+
+	duplicate := lea
+	duplicate.SubscriptionID = "lea-2"
+	duplicate.Email = "LEA@example.com"
+	assertErrorCode(t, store.Create(duplicate), kernel.EConflict)
+}
+
+func TestSuppressionStore(t *testing.T) {
+	store := memory.NewSuppressionStore()
+	clock := &stubClock{fixtureNow}
+
+	entry, err := subscription.NewSuppression("a.n.a@gmail.com", subscription.ReasonHardBounce, "", "", clock)
+	assertNoError(t, err)
+	assertNoError(t, store.AddSuppression(entry))
+	assertErrorCode(t, store.AddSuppression(entry), kernel.EConflict)
+
+	suppressed, err := store.IsSuppressed("Ana+news@gmail.com")
+	assertNoError(t, err)
+	if !suppressed {
+		t.Error("expected the inbox suppressed whatever its spelling")
+	}
+
+	assertNoError(t, store.RemoveSuppression("ana@gmail.com"))
+	assertErrorCode(t, store.RemoveSuppression("ana@gmail.com"), kernel.ENotFound)
+	if all, _ := store.GetSuppressions(); len(all) != 0 {
+		t.Errorf("got %v", all)
+	}
+}
+
+func TestBucketStore(t *testing.T) {
+	clock := &stubClock{fixtureNow}
+	policies := ratelimit.Policies{
+		{Action: ratelimit.ActionSearch, Kind: ratelimit.SubjectIP}: {Capacity: 1, RefillEvery: time.Second},
+	}
+	store := memory.NewBucketStore(policies, clock)
+	ip, err := ratelimit.IPSubject("203.0.113.7")
+	assertNoError(t, err)
+
+	limiter, err := ratelimit.NewLimiterService(store, policies, clock)
+	assertNoError(t, err)
+
+	_, err = limiter.Allow(ratelimit.ActionSearch, ip)
+	assertNoError(t, err)
+	_, err = limiter.Allow(ratelimit.ActionSearch, ip)
+	assertErrorCode(t, err, kernel.EConflict)
+
+	_, err = store.GetBucket(ratelimit.Key{Action: ratelimit.ActionFeedback, Subject: ip})
+	assertErrorCode(t, err, kernel.ENotFound)
+
+	t.Run("drops buckets that refilled while idle", func(t *testing.T) {
+		other, err := ratelimit.IPSubject("198.51.100.4")
+		assertNoError(t, err)
+		clock.t = clock.t.Add(memory.BucketSweepEvery)
+		_, err = limiter.Allow(ratelimit.ActionSearch, other)
+		assertNoError(t, err)
+
+		_, err = store.GetBucket(ratelimit.Key{Action: ratelimit.ActionSearch, Subject: ip})
+		assertErrorCode(t, err, kernel.ENotFound)
+		_, err = store.GetBucket(ratelimit.Key{Action: ratelimit.ActionSearch, Subject: other})
+		assertNoError(t, err)
+	})
+
+	t.Run("does not keep full buckets", func(t *testing.T) {
+		key := ratelimit.Key{Action: ratelimit.ActionSearch, Subject: ip}
+
+		assertNoError(t, store.SaveBucket(key, ratelimit.NewBucket(policies[ratelimit.Rule{Action: ratelimit.ActionSearch, Kind: ratelimit.SubjectIP}], clock.t)))
+
+		_, err := store.GetBucket(key)
+		assertErrorCode(t, err, kernel.ENotFound)
+	})
+}
+
+func TestIdempotencyStore(t *testing.T) {
+	store := memory.NewIdempotencyStore()
+	record := kernel.IdempotencyRecord{Scope: "post.publish", Key: "publish-0001", Fingerprint: "lesson", ResultID: "lesson", CreatedAt: fixtureNow}
+	assertNoError(t, store.SaveRecord(record))
+
+	got, err := store.GetRecord("post.publish", "publish-0001")
+	assertNoError(t, err)
+	if *got != record {
+		t.Errorf("got %+v", got)
+	}
+
+	_, err = store.GetRecord("subscription.create", "publish-0001")
+	assertErrorCode(t, err, kernel.ENotFound)
+
+	t.Run("reserves free keys only", func(t *testing.T) {
+		existing, err := store.ReserveRecord(kernel.IdempotencyRecord{Scope: "post.publish", Key: "publish-0001", Pending: true, CreatedAt: fixtureNow})
+		assertNoError(t, err)
+		if existing == nil || *existing != record {
+			t.Errorf("got %+v, want the stored record", existing)
+		}
+
+		reservation := kernel.IdempotencyRecord{Scope: "post.publish", Key: "publish-0002", Pending: true, CreatedAt: fixtureNow}
+		existing, err = store.ReserveRecord(reservation)
+		assertNoError(t, err)
+		if got, _ := store.GetRecord("post.publish", "publish-0002"); existing != nil || got == nil || *got != reservation {
+			t.Errorf("got %+v, stored %+v", existing, got)
+		}
+	})
+}
+
+// The stores satisfy the backup ports, so a backup restores into empty stores unchanged.
+func TestStores_BackupRoundTrip(t *testing.T) {
+	categories := newTree(t)
+	sports, _ := categories.GetByID("sports")
+	published := fixtureNow.AddDate(0, 0, -1)
+
+	users := memory.NewUserStore()
+	marie, err := user.NewUser(user.NewUserParams{UserID: "marie", Username: "marie", Email: "marie@example.com", Roles: []user.Role{user.RoleAuthor}, Clock: &stubClock{fixtureNow}})
+	assertNoError(t, err)
+	assertNoError(t, users.CreateUser(marie))
+
+	tags := memory.NewTagStore()
+	football, err := tag.NewTag(tag.Tag{TagID: "football", Name: "Football", CreatedBy: "marie", CreatedAt: fixtureNow})
+	assertNoError(t, err)
+	assertNoError(t, tags.Create(football))
+
+	posts := memory.NewPostStore(categories)
+	lesson := newPost(t, "football", "Jouer au football", *sports, post.StatusPublished, &published)
+	lesson.Tags = post.PostTags{"football"}
+	assertNoError(t, posts.Create(lesson))
+
+	subscriptions := memory.NewSubscriptionStore()
+	admin := user.User{ID: "admin", Roles: []user.Role{user.RoleAdmin}}
+
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	_, err = backup.NewBackupService(users, categories, tags, posts, subscriptions, &stubClock{fixtureNow}).Backup(w, admin)
+	assertNoError(t, err)
+	assertNoError(t, w.Close())
+
+	archive, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	assertNoError(t, err)
+
+	restoredCategories := memory.NewCategoryStore()
+	restoredPosts := memory.NewPostStore(restoredCategories)
+	service := backup.NewBackupService(memory.NewUserStore(), restoredCategories, memory.NewTagStore(), restoredPosts, memory.NewSubscriptionStore(), &stubClock{fixtureNow})
+	report, err := service.Restore(archive, admin)
+	assertNoError(t, err)
+	if report.HasErrors() {
+		t.Fatalf("unexpected findings: %+v", report.Findings)
+	}
+
+	restored, err := restoredPosts.GetBySlug(shared.Slug("jouer-au-football"))
+	assertNoError(t, err)
+	if restored.Category.CategoryID != "sports" || !restored.Tags.Contains("football") {
+		t.Errorf("restored post: got %v", restored)
+	}
+}
@@ -0,0 +1,285 @@
+package memory
+
+import (
+	"sync"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/category"
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/post"
+	"github.com/alnah/fla/internal/domain/shared"
+)
+
+// PostStore keeps posts, unique by ID and slug, each with its content summary.
+// Category filters of queries resolve through the category store the posts are filed under.
+type PostStore struct {
+	mu         sync.RWMutex
+	posts      map[kernel.ID[post.Post]]post.Post
+	summaries  map[kernel.ID[post.Post]]post.PostSummary
+	categories category.CategoryPathBuilder
+}
+
+// NewPostStore creates an empty post store reading category paths from categories.
+func NewPostStore(categories category.CategoryPathBuilder) *PostStore {
+	return &PostStore{
+		posts:      make(map[kernel.ID[post.Post]]post.Post),
+		summaries:  make(map[kernel.ID[post.Post]]post.PostSummary),
+		categories: categories,
+	}
+}
+
+func (s *PostStore) GetByID(postID kernel.ID[post.Post]) (*post.Post, error) {
+	const op = "PostStore.GetByID"
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	p, ok := s.posts[postID]
+	if !ok {
+		return nil, notFound("Post", op)
+	}
+	return &p, nil
+}
+
+func (s *PostStore) GetBySlug(slug shared.Slug) (*post.Post, error) {
+	const op = "PostStore.GetBySlug"
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for _, p := range s.posts {
+		if p.Slug == slug {
+			return &p, nil
+		}
+	}
+	return nil, notFound("Post", op)
+}
+
+// Find answers queries with Query.Matches and Query.SortPosts, the in-memory reference semantics.
+func (s *PostStore) Find(query post.Query) (post.PostsList, error) {
+	const op = "PostStore.Find"
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var matched []post.Post
+	for _, p := range s.posts {
+		var path category.CategoryPath
+		if query.CategorySubtree != nil {
+			var err error
+			if path, err = s.categories.BuildPath(p.Category.CategoryID); err != nil {
+				return post.PostsList{}, &kernel.Error{Operation: op, Cause: err}
+			}
+		}
+		if query.Matches(p, path) {
+			matched = append(matched, p)
+		}
+	}
+	query.SortPosts(matched, s.summaries)
+
+	pagination, err := shared.NewPagination(query.Pagination.Page, query.Pagination.Limit, len(matched))
+	if err != nil {
+		return post.PostsList{}, &kernel.Error{Operation: op, Cause: err}
+	}
+	start := min(pagination.Offset(), len(matched))
+	end := min(start+pagination.Limit, len(matched))
+
+	return post.NewPostsList(matched[start:end], pagination), nil
+}
+
+// GetScheduledPosts returns scheduled posts, earliest publication first.
+func (s *PostStore) GetScheduledPosts() ([]post.Post, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	query := post.NewQuery().WithStatuses(post.StatusScheduled).SortBy(shared.Asc(post.SortFieldPublishedAt))
+
+	var scheduled []post.Post
+	for _, p := range s.posts {
+		if query.Matches(p, nil) {
+			scheduled = append(scheduled, p)
+		}
+	}
+	query.SortPosts(scheduled, s.summaries)
+	return scheduled, nil
+}
+
+// GetFingerprints returns the content fingerprint of every post, kept in its summary.
+func (s *PostStore) GetFingerprints() (map[kernel.ID[post.Post]]post.Fingerprint, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	fingerprints := make(map[kernel.ID[post.Post]]post.Fingerprint, len(s.summaries))
+	for id, summary := range s.summaries {
+		fingerprints[id] = summary.Fingerprint
+	}
+	return fingerprints, nil
+}
+
+// GetFeaturedPosts returns the posts featured at now, most recently published first.
+func (s *PostStore) GetFeaturedPosts(now time.Time) ([]post.Post, error) {
+	return s.featured(now, nil), nil
+}
+
+// GetFeaturedPostsByCategory returns the posts featured at now directly under the
+// category, most recently published first.
+func (s *PostStore) GetFeaturedPostsByCategory(categoryID kernel.ID[category.Category], now time.Time) ([]post.Post, error) {
+	return s.featured(now, &categoryID), nil
+}
+
+func (s *PostStore) featured(now time.Time, categoryID *kernel.ID[category.Category]) []post.Post {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var featured []post.Post
+	for _, p := range s.posts {
+		if p.IsFeaturedAt(now) && (categoryID == nil || p.Category.CategoryID == *categoryID) {
+			featured = append(featured, p)
+		}
+	}
+	post.NewQuery().SortPosts(featured, s.summaries)
+	return featured
+}
+
+func (s *PostStore) GetSummary(postID kernel.ID[post.Post]) (post.PostSummary, error) {
+	const op = "PostStore.GetSummary"
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	summary, ok := s.summaries[postID]
+	if !ok {
+		return post.PostSummary{}, notFound("Post", op)
+	}
+	return summary, nil
+}
+
+func (s *PostStore) IsSlugUnique(slug shared.Slug, excludeID *kernel.ID[post.Post]) (bool, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for _, p := range s.posts {
+		if p.Slug == slug && (excludeID == nil || p.PostID != *excludeID) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func (s *PostStore) Create(p post.Post) error {
+	const op = "PostStore.Create"
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.posts[p.PostID]; ok {
+		return exists("Post", p.PostID.String(), op)
+	}
+	if err := s.checkSlug(p, op); err != nil {
+		return err
+	}
+
+	s.save(p)
+	return nil
+}
+
+func (s *PostStore) Update(p post.Post) error {
+	const op = "PostStore.Update"
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.posts[p.PostID]; !ok {
+		return notFound("Post", op)
+	}
+	if err := s.checkSlug(p, op); err != nil {
+		return err
+	}
+
+	s.save(p)
+	return nil
+}
+
+func (s *PostStore) Delete(postID kernel.ID[post.Post]) error {
+	const op = "PostStore.Delete"
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.posts[postID]; !ok {
+		return notFound("Post", op)
+	}
+
+	delete(s.posts, postID)
+	delete(s.summaries, postID)
+	return nil
+}
+
+func (s *PostStore) CountPostsInCategory(categoryID kernel.ID[category.Category]) (int, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	count := 0
+	for _, p := range s.posts {
+		if p.Category.CategoryID == categoryID {
+			count++
+		}
+	}
+	return count, nil
+}
+
+// CountPublishedByCategory counts published posts filed directly under each category.
+func (s *PostStore) CountPublishedByCategory() (map[kernel.ID[category.Category]]int, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	counts := make(map[kernel.ID[category.Category]]int)
+	for _, p := range s.posts {
+		if p.IsPublished() {
+			counts[p.Category.CategoryID]++
+		}
+	}
+	return counts, nil
+}
+
+// ReassignPosts files every post of one category under another.
+// The target is read once, so moved posts carry its current state.
+func (s *PostStore) ReassignPosts(from, to kernel.ID[category.Category]) (int, error) {
+	const op = "PostStore.ReassignPosts"
+
+	path, err := s.categories.BuildPath(to)
+	if err != nil {
+		return 0, &kernel.Error{Operation: op, Cause: err}
+	}
+	target := path[len(path)-1]
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	moved := 0
+	for id, p := range s.posts {
+		if p.Category.CategoryID == from {
+			p.Category = target
+			s.posts[id] = p
+			moved++
+		}
+	}
+	return moved, nil
+}
+
+// save stores a post, summarizing it again only when its content changed.
+func (s *PostStore) save(p post.Post) {
+	s.posts[p.PostID] = p
+	if !s.summaries[p.PostID].IsCurrentFor(p.Content) {
+		s.summaries[p.PostID] = p.Summary()
+	}
+}
+
+func (s *PostStore) checkSlug(p post.Post, op string) error {
+	for _, other := range s.posts {
+		if other.PostID != p.PostID && other.Slug == p.Slug {
+			return taken("post", "slug", p.Slug.String(), op)
+		}
+	}
+	return nil
+}
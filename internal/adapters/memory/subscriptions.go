@@ -0,0 +1,123 @@
+package memory
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/shared"
+	"github.com/alnah/fla/internal/domain/subscription"
+)
+
+// SubscriptionStore keeps newsletter subscriptions, unique by ID and email.
+// Emails compare case-insensitively.
+type SubscriptionStore struct {
+	mu            sync.RWMutex
+	subscriptions map[kernel.ID[subscription.Subscription]]subscription.Subscription
+}
+
+// NewSubscriptionStore creates an empty subscription store.
+func NewSubscriptionStore() *SubscriptionStore {
+	return &SubscriptionStore{subscriptions: make(map[kernel.ID[subscription.Subscription]]subscription.Subscription)}
+}
+
+func (s *SubscriptionStore) GetByID(subscriptionID kernel.ID[subscription.Subscription]) (*subscription.Subscription, error) {
+	const op = "SubscriptionStore.GetByID"
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	sub, ok := s.subscriptions[subscriptionID]
+	if !ok {
+		return nil, &kernel.Error{Code: kernel.ENotFound, Message: subscription.MSubscriptionNotFound, Operation: op}
+	}
+	return &sub, nil
+}
+
+func (s *SubscriptionStore) GetByEmail(email shared.Email) (*subscription.Subscription, error) {
+	const op = "SubscriptionStore.GetByEmail"
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for _, sub := range s.subscriptions {
+		if strings.EqualFold(sub.Email.String(), email.String()) {
+			return &sub, nil
+		}
+	}
+	return nil, &kernel.Error{Code: kernel.ENotFound, Message: subscription.MSubscriptionNotFound, Operation: op}
+}
+
+func (s *SubscriptionStore) ExistsByEmail(email shared.Email) (bool, error) {
+	_, err := s.GetByEmail(email)
+	return err == nil, nil
+}
+
+// GetActiveSubscriptions returns subscriptions that can receive emails, ordered by ID.
+func (s *SubscriptionStore) GetActiveSubscriptions() ([]subscription.Subscription, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var active []subscription.Subscription
+	for _, sub := range sorted(s.subscriptions) {
+		if sub.CanReceiveEmails() {
+			active = append(active, sub)
+		}
+	}
+	return active, nil
+}
+
+// GetAllSubscriptions returns every subscription ordered by ID.
+func (s *SubscriptionStore) GetAllSubscriptions() ([]subscription.Subscription, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return sorted(s.subscriptions), nil
+}
+
+func (s *SubscriptionStore) Create(sub subscription.Subscription) error {
+	const op = "SubscriptionStore.Create"
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.subscriptions[sub.SubscriptionID]; ok {
+		return exists("Subscription", sub.SubscriptionID.String(), op)
+	}
+	for _, other := range s.subscriptions {
+		if strings.EqualFold(other.Email.String(), sub.Email.String()) {
+			return &kernel.Error{Code: kernel.EConflict, Message: subscription.MSubscriptionEmailExists, Operation: op}
+		}
+	}
+
+	s.subscriptions[sub.SubscriptionID] = sub
+	return nil
+}
+
+func (s *SubscriptionStore) Update(sub subscription.Subscription) error {
+	const op = "SubscriptionStore.Update"
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.subscriptions[sub.SubscriptionID]; !ok {
+		return &kernel.Error{Code: kernel.ENotFound, Message: subscription.MSubscriptionNotFound, Operation: op}
+	}
+
+	s.subscriptions[sub.SubscriptionID] = sub
+	return nil
+}
+
+func (s *SubscriptionStore) Delete(subscriptionID kernel.ID[subscription.Subscription]) error {
+	const op = "SubscriptionStore.Delete"
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.subscriptions[subscriptionID]; !ok {
+		return &kernel.Error{Code: kernel.ENotFound, Message: subscription.MSubscriptionNotFound, Operation: op}
+	}
+
+	delete(s.subscriptions, subscriptionID)
+	return nil
+}
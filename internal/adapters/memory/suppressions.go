@@ -0,0 +1,66 @@
+package memory
+
+import (
+	"sync"
+
+	"github.com/alnah/fla/internal/domain/shared"
+	"github.com/alnah/fla/internal/domain/subscription"
+)
+
+// SuppressionStore keeps the suppression list, keyed by canonical address so
+// every spelling of an inbox matches its entry.
+type SuppressionStore struct {
+	mu           sync.RWMutex
+	suppressions map[shared.Email]subscription.Suppression
+}
+
+// NewSuppressionStore creates an empty suppression store.
+func NewSuppressionStore() *SuppressionStore {
+	return &SuppressionStore{suppressions: make(map[shared.Email]subscription.Suppression)}
+}
+
+func (s *SuppressionStore) IsSuppressed(email shared.Email) (bool, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	_, ok := s.suppressions[email.Canonical()]
+	return ok, nil
+}
+
+// GetSuppressions returns every entry ordered by canonical address.
+func (s *SuppressionStore) GetSuppressions() ([]subscription.Suppression, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return sorted(s.suppressions), nil
+}
+
+func (s *SuppressionStore) AddSuppression(suppression subscription.Suppression) error {
+	const op = "SuppressionStore.AddSuppression"
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := suppression.Email.Canonical()
+	if _, ok := s.suppressions[key]; ok {
+		return exists("Suppression", suppression.Email.String(), op)
+	}
+
+	s.suppressions[key] = suppression
+	return nil
+}
+
+func (s *SuppressionStore) RemoveSuppression(email shared.Email) error {
+	const op = "SuppressionStore.RemoveSuppression"
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := email.Canonical()
+	if _, ok := s.suppressions[key]; !ok {
+		return notFound("Suppression", op)
+	}
+
+	delete(s.suppressions, key)
+	return nil
+}
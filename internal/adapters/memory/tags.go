@@ -0,0 +1,112 @@
+package memory
+
+import (
+	"sync"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/shared"
+	"github.com/alnah/fla/internal/domain/tag"
+)
+
+// TagStore keeps tags, unique by ID and slug.
+type TagStore struct {
+	mu   sync.RWMutex
+	tags map[kernel.ID[tag.Tag]]tag.Tag
+}
+
+// NewTagStore creates an empty tag store.
+func NewTagStore() *TagStore {
+	return &TagStore{tags: make(map[kernel.ID[tag.Tag]]tag.Tag)}
+}
+
+func (s *TagStore) GetByID(tagID kernel.ID[tag.Tag]) (*tag.Tag, error) {
+	const op = "TagStore.GetByID"
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	t, ok := s.tags[tagID]
+	if !ok {
+		return nil, notFound("Tag", op)
+	}
+	return &t, nil
+}
+
+func (s *TagStore) GetBySlug(slug shared.Slug) (*tag.Tag, error) {
+	const op = "TagStore.GetBySlug"
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for _, t := range s.tags {
+		if t.Slug == slug {
+			return &t, nil
+		}
+	}
+	return nil, notFound("Tag", op)
+}
+
+// GetAll returns every tag ordered by ID.
+func (s *TagStore) GetAll() ([]tag.Tag, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return sorted(s.tags), nil
+}
+
+func (s *TagStore) Create(t tag.Tag) error {
+	const op = "TagStore.Create"
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.tags[t.TagID]; ok {
+		return exists("Tag", t.TagID.String(), op)
+	}
+	if err := s.checkSlug(t, op); err != nil {
+		return err
+	}
+
+	s.tags[t.TagID] = t
+	return nil
+}
+
+func (s *TagStore) Update(t tag.Tag) error {
+	const op = "TagStore.Update"
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.tags[t.TagID]; !ok {
+		return notFound("Tag", op)
+	}
+	if err := s.checkSlug(t, op); err != nil {
+		return err
+	}
+
+	s.tags[t.TagID] = t
+	return nil
+}
+
+func (s *TagStore) Delete(tagID kernel.ID[tag.Tag]) error {
+	const op = "TagStore.Delete"
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.tags[tagID]; !ok {
+		return notFound("Tag", op)
+	}
+
+	delete(s.tags, tagID)
+	return nil
+}
+
+func (s *TagStore) checkSlug(t tag.Tag, op string) error {
+	for _, other := range s.tags {
+		if other.TagID != t.TagID && other.Slug == t.Slug {
+			return taken("tag", "slug", t.Slug.String(), op)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,93 @@
+package memory
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/shared"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+// UserStore keeps accounts, unique by ID, username, and email.
+// Usernames and emails compare case-insensitively.
+type UserStore struct {
+	mu    sync.RWMutex
+	users map[kernel.ID[user.User]]user.User
+}
+
+// NewUserStore creates an empty user store.
+func NewUserStore() *UserStore {
+	return &UserStore{users: make(map[kernel.ID[user.User]]user.User)}
+}
+
+func (s *UserStore) GetUserByID(userID kernel.ID[user.User]) (*user.User, error) {
+	const op = "UserStore.GetUserByID"
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	u, ok := s.users[userID]
+	if !ok {
+		return nil, notFound("User", op)
+	}
+	return &u, nil
+}
+
+func (s *UserStore) GetUserByUsername(username shared.Username) (*user.User, error) {
+	const op = "UserStore.GetUserByUsername"
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for _, u := range s.users {
+		if strings.EqualFold(u.Username.String(), username.String()) {
+			return &u, nil
+		}
+	}
+	return nil, notFound("User", op)
+}
+
+func (s *UserStore) GetUserByEmail(email shared.Email) (*user.User, error) {
+	const op = "UserStore.GetUserByEmail"
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for _, u := range s.users {
+		if strings.EqualFold(u.Email.String(), email.String()) {
+			return &u, nil
+		}
+	}
+	return nil, notFound("User", op)
+}
+
+// GetAllUsers returns every account ordered by ID.
+func (s *UserStore) GetAllUsers() ([]user.User, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return sorted(s.users), nil
+}
+
+func (s *UserStore) CreateUser(u user.User) error {
+	const op = "UserStore.CreateUser"
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.users[u.ID]; ok {
+		return exists("User", u.ID.String(), op)
+	}
+	for _, other := range s.users {
+		if strings.EqualFold(other.Username.String(), u.Username.String()) {
+			return taken("account", "username", u.Username.String(), op)
+		}
+		if strings.EqualFold(other.Email.String(), u.Email.String()) {
+			return taken("account", "email", u.Email.String(), op)
+		}
+	}
+
+	s.users[u.ID] = u
+	return nil
+}
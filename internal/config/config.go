@@ -0,0 +1,238 @@
+// Package config loads the settings fla runs with. Values are layered: built-in
+// defaults, then a TOML file, then FLA_* environment variables, then command line
+// overrides; the result is validated once, at startup, before anything uses it.
+package config
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/seo"
+	"github.com/alnah/fla/internal/domain/shared"
+)
+
+const (
+	MLocaleNotSupported   string = "Default locale %s must be listed in locales.supported."
+	MPaginationInvalid    string = "Pagination limits must satisfy %d <= default_limit <= max_limit <= %d."
+	MEmailProviderUnknown string = "Unknown email provider %q: use outbox, postmark, or ses."
+	MEmailAPIKeyMissing   string = "Email provider %s needs email.api_key."
+	MEmailOutboxMissing   string = "The outbox email provider needs email.outbox."
+	MIntervalTooShort     string = "%s must be at least %s."
+	MStoragePathMissing   string = "Missing storage.data_file."
+)
+
+const (
+	DefaultBaseURL       = "http://localhost:8080"
+	DefaultDataFile      = "fla.zip"
+	MinSchedulerInterval = time.Minute // Shorter intervals only add load
+)
+
+// Email providers.
+const (
+	ProviderOutbox   = "outbox" // Writes messages to a directory, for development and review
+	ProviderPostmark = "postmark"
+	ProviderSES      = "ses"
+)
+
+var providers = []string{ProviderOutbox, ProviderPostmark, ProviderSES}
+
+// Config holds every setting, grouped as in the TOML file.
+type Config struct {
+	Site       SiteConfig
+	Locales    LocaleConfig
+	Pagination PaginationConfig
+	Email      EmailConfig
+	Scheduler  SchedulerConfig
+	Storage    StorageConfig
+	Robots     RobotsConfig
+}
+
+// SiteConfig describes the public website, for absolute links in emails and feeds.
+type SiteConfig struct {
+	Name          string
+	BaseURL       string
+	ReservedSlugs []shared.Slug // Paths the site's routes take, refused as post and category slugs
+	ImageHosts    []string      // Hosts posts may load images from, "*.example.com" for subdomains; empty allows any
+}
+
+// LocaleConfig lists the languages the blog serves.
+type LocaleConfig struct {
+	Default   shared.Locale
+	Supported []shared.Locale
+}
+
+// PaginationConfig bounds listing pages; both stay within the domain page limits.
+type PaginationConfig struct {
+	DefaultLimit int
+	MaxLimit     int
+}
+
+// EmailConfig selects the email provider and holds its credentials.
+type EmailConfig struct {
+	Provider string
+	APIKey   string // Required by every provider but outbox; prefer FLA_EMAIL_API_KEY to the file
+	From     shared.Email
+	Outbox   string // Directory the outbox provider writes to
+}
+
+// SchedulerConfig sets how often background jobs run.
+type SchedulerConfig struct {
+	PublishInterval time.Duration // Publishing of due scheduled posts
+	DigestInterval  time.Duration // Weekly digest sends
+}
+
+// StorageConfig locates the files the blog is kept in.
+type StorageConfig struct {
+	DataFile    string // Backup archive holding the blog
+	SearchIndex string // Empty means next to the data file
+}
+
+// RobotsConfig tells crawlers what they may visit; see seo.RobotsPolicy.
+type RobotsConfig struct {
+	Disallow           []string // Paths closed to every crawler, e.g. "/admin"
+	BlockedAgents      []string // Crawlers refused the whole site, e.g. "GPTBot"
+	NoFollowCategories []string // Category paths whose posts' links get nofollow, e.g. "a1/lecture"
+}
+
+// Default returns the configuration used when nothing overrides it, fit for
+// local development: a localhost base URL and emails written to a directory.
+func Default() Config {
+	return Config{
+		Site: SiteConfig{
+			Name:          "fla",
+			BaseURL:       DefaultBaseURL,
+			ReservedSlugs: slices.Clone(shared.DefaultReservedSlugs),
+		},
+		Locales: LocaleConfig{
+			Default:   shared.DefaultLocale,
+			Supported: slices.Clone(shared.SupportedLocales),
+		},
+		Pagination: PaginationConfig{DefaultLimit: shared.DefaultPageLimit, MaxLimit: shared.MaxPageLimit},
+		Email:      EmailConfig{Provider: ProviderOutbox, Outbox: "outbox"},
+		Scheduler:  SchedulerConfig{PublishInterval: 5 * time.Minute, DigestInterval: 7 * 24 * time.Hour},
+		Storage:    StorageConfig{DataFile: DefaultDataFile},
+		Robots:     RobotsConfig{Disallow: []string{"/admin", "/api"}},
+	}
+}
+
+// Validate checks every setting, so misconfiguration stops the program at startup.
+func (c Config) Validate() error {
+	const op = "Config.Validate"
+
+	if _, err := c.SiteInfo(); err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+	if err := c.SlugPolicy(c.Locales.Default).Validate(); err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	for _, locale := range c.Locales.Supported {
+		if err := locale.Validate(); err != nil {
+			return &kernel.Error{Operation: op, Cause: err}
+		}
+	}
+	if !slices.Contains(c.Locales.Supported, c.Locales.Default) {
+		return &kernel.Error{Code: kernel.EInvalid, Message: fmt.Sprintf(MLocaleNotSupported, c.Locales.Default), Operation: op}
+	}
+
+	p := c.Pagination
+	if p.DefaultLimit < shared.MinPageLimit || p.DefaultLimit > p.MaxLimit || p.MaxLimit > shared.MaxPageLimit {
+		return &kernel.Error{
+			Code:      kernel.EInvalid,
+			Message:   fmt.Sprintf(MPaginationInvalid, shared.MinPageLimit, shared.MaxPageLimit),
+			Operation: op,
+		}
+	}
+
+	if err := c.Email.validate(); err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	intervals := []struct {
+		key   string
+		value time.Duration
+	}{
+		{"scheduler.publish_interval", c.Scheduler.PublishInterval},
+		{"scheduler.digest_interval", c.Scheduler.DigestInterval},
+	}
+	for _, interval := range intervals {
+		if interval.value < MinSchedulerInterval {
+			return &kernel.Error{
+				Code:      kernel.EInvalid,
+				Message:   fmt.Sprintf(MIntervalTooShort, interval.key, MinSchedulerInterval),
+				Operation: op,
+			}
+		}
+	}
+
+	if c.Storage.DataFile == "" {
+		return &kernel.Error{Code: kernel.EInvalid, Message: MStoragePathMissing, Operation: op}
+	}
+
+	if _, err := c.RobotsPolicy(); err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return nil
+}
+
+func (e EmailConfig) validate() error {
+	const op = "EmailConfig.validate"
+
+	if !slices.Contains(providers, e.Provider) {
+		return &kernel.Error{Code: kernel.EInvalid, Message: fmt.Sprintf(MEmailProviderUnknown, e.Provider), Operation: op}
+	}
+
+	if e.Provider == ProviderOutbox && e.Outbox == "" {
+		return &kernel.Error{Code: kernel.EInvalid, Message: MEmailOutboxMissing, Operation: op}
+	}
+	if e.Provider != ProviderOutbox && e.APIKey == "" {
+		return &kernel.Error{Code: kernel.EInvalid, Message: fmt.Sprintf(MEmailAPIKeyMissing, e.Provider), Operation: op}
+	}
+
+	if e.From != "" {
+		if err := e.From.Validate(); err != nil {
+			return &kernel.Error{Operation: op, Cause: err}
+		}
+	}
+
+	return nil
+}
+
+// SiteInfo returns the site as the domain describes it, with a normalized base URL.
+func (c Config) SiteInfo() (shared.Site, error) {
+	return shared.NewSite(c.Site.Name, c.Site.BaseURL, c.Locales.Default)
+}
+
+// SlugPolicy returns the slug rules for content in a locale, with the configured reserved slugs.
+func (c Config) SlugPolicy(locale shared.Locale) shared.SlugPolicy {
+	return shared.SlugPolicy{Locale: locale, Reserved: slices.Clone(c.Site.ReservedSlugs)}
+}
+
+// ImageHostPolicy returns the hosts posts may load images from.
+func (c Config) ImageHostPolicy() kernel.HostPolicy {
+	return kernel.NewHostPolicy(c.Site.ImageHosts...)
+}
+
+// RobotsPolicy returns the crawler rules served as robots.txt and meta robots tags.
+func (c Config) RobotsPolicy() (seo.RobotsPolicy, error) {
+	const op = "Config.RobotsPolicy"
+
+	site, err := c.SiteInfo()
+	if err != nil {
+		return seo.RobotsPolicy{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	policy := seo.RobotsPolicy{
+		Site:               site,
+		Disallow:           slices.Clone(c.Robots.Disallow),
+		BlockedAgents:      slices.Clone(c.Robots.BlockedAgents),
+		NoFollowCategories: slices.Clone(c.Robots.NoFollowCategories),
+	}
+	if err := policy.Validate(); err != nil {
+		return seo.RobotsPolicy{}, &kernel.Error{Operation: op, Cause: err}
+	}
+	return policy, nil
+}
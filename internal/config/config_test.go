@@ -0,0 +1,227 @@
+package config_test
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alnah/fla/internal/config"
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/shared"
+)
+
+const sample = `# fla settings
+[site]
+name = "Français facile"
+base_url = "https://fla.example.com/" # trailing slash is dropped
+
+[locales]
+default = 'fr-FR'
+supported = ["fr-FR", "en-US",]
+
+[pagination]
+default_limit = 20
+
+[scheduler]
+publish_interval = "1m"
+`
+
+func TestLoad(t *testing.T) {
+	t.Run("defaults alone are valid", func(t *testing.T) {
+		c, err := config.Load("", nil, nil)
+
+		assertNoError(t, err)
+		if c.Storage.DataFile != config.DefaultDataFile || c.Email.Provider != config.ProviderOutbox {
+			t.Errorf("got %+v", c)
+		}
+	})
+
+	t.Run("file, then environment, then overrides", func(t *testing.T) {
+		path := writeConfig(t, sample)
+		environ := []string{
+			"FLA_PAGINATION_DEFAULT_LIMIT=30",
+			"FLA_SITE_NAME=From env",
+			"FLA_EMAIL_PROVIDER=postmark",
+			"FLA_EMAIL_API_KEY=secret",
+			"HOME=/root",
+		}
+		overrides := config.Overrides{}
+		assertNoError(t, overrides.Set("site.name=From flag"))
+
+		c, err := config.Load(path, environ, overrides)
+
+		assertNoError(t, err)
+		if c.Site.Name != "From flag" {
+			t.Errorf("site.name: got %q", c.Site.Name)
+		}
+		if c.Pagination.DefaultLimit != 30 || c.Pagination.MaxLimit != shared.MaxPageLimit {
+			t.Errorf("pagination: got %+v", c.Pagination)
+		}
+		if c.Email.Provider != config.ProviderPostmark || c.Email.APIKey != "secret" {
+			t.Errorf("email: got %+v", c.Email)
+		}
+		if !slices.Equal(c.Locales.Supported, []shared.Locale{shared.LocaleFrenchFR, shared.LocaleEnglishUS}) {
+			t.Errorf("locales: got %v", c.Locales.Supported)
+		}
+		if c.Scheduler.PublishInterval != time.Minute || c.Scheduler.DigestInterval != 7*24*time.Hour {
+			t.Errorf("scheduler: got %+v", c.Scheduler)
+		}
+
+		site, err := c.SiteInfo()
+		assertNoError(t, err)
+		if site.BaseURL != "https://fla.example.com" || site.Locale != shared.LocaleFrenchFR {
+			t.Errorf("site: got %v", site)
+		}
+	})
+
+	t.Run("lists split on commas outside files", func(t *testing.T) {
+		c, err := config.Load("", []string{"FLA_LOCALES_SUPPORTED=en-US, pt-BR"}, nil)
+
+		assertNoError(t, err)
+		if !slices.Equal(c.Locales.Supported, []shared.Locale{shared.LocaleEnglishUS, shared.LocalePortugueseBR}) {
+			t.Errorf("got %v", c.Locales.Supported)
+		}
+	})
+
+	errorTests := []struct {
+		name     string
+		file     string
+		environ  []string
+		override string
+		code     string
+		message  string
+	}{
+		{"missing file", "", nil, "", kernel.ENotFound, "could not be read"},
+		{"syntax error names the line", "[site]\nname = \"fla\"\nbase_url\n", nil, "", kernel.EInvalid, "line 3: expected key = value"},
+		{"unknown key names the line", "[site]\ncolor = \"blue\"\n", nil, "", kernel.EInvalid, "line 2: Unknown config key site.color."},
+		{"wrong type names the line", "[pagination]\nmax_limit = \"many\"\n", nil, "", kernel.EInvalid, "line 2: Config key pagination.max_limit expects an integer."},
+		{"bad duration in file", "[scheduler]\ndigest_interval = \"weekly\"\n", nil, "", kernel.EInvalid, "expects a duration"},
+		{"bad environment value names the variable", sample, []string{"FLA_PAGINATION_MAX_LIMIT=lots"}, "", kernel.EInvalid, "FLA_PAGINATION_MAX_LIMIT"},
+		{"bad override names the key", sample, nil, "scheduler.publish_interval=soon", kernel.EInvalid, "Override scheduler.publish_interval"},
+		{"validation runs last", sample, nil, "pagination.max_limit=10", kernel.EInvalid, "Pagination limits"},
+		{"relative robots path", sample, nil, "robots.disallow=admin", kernel.EInvalid, "must start with /"},
+	}
+
+	for _, tt := range errorTests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "missing.toml")
+			if tt.file != "" {
+				path = writeConfig(t, tt.file)
+			}
+			overrides := config.Overrides{}
+			if tt.override != "" {
+				assertNoError(t, overrides.Set(tt.override))
+			}
+
+			_, err := config.Load(path, tt.environ, overrides)
+
+			assertErrorCode(t, err, tt.code)
+			if !strings.Contains(kernel.ErrorMessage(err), tt.message) {
+				t.Errorf("message: got %q, want it to contain %q", kernel.ErrorMessage(err), tt.message)
+			}
+		})
+	}
+}
+
+func TestConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(c *config.Config)
+		valid  bool
+	}{
+		{"defaults", func(c *config.Config) {}, true},
+		{"relative base URL", func(c *config.Config) { c.Site.BaseURL = "fla.example.com" }, false},
+		{"missing site name", func(c *config.Config) { c.Site.Name = "" }, false},
+		{"malformed reserved slug", func(c *config.Config) { c.Site.ReservedSlugs = []shared.Slug{"Admin"} }, false},
+		{"unsupported locale", func(c *config.Config) { c.Locales.Supported = append(c.Locales.Supported, "de-DE") }, false},
+		{"default locale not served", func(c *config.Config) { c.Locales.Supported = []shared.Locale{shared.LocaleFrenchFR} }, false},
+		{"default limit above max", func(c *config.Config) { c.Pagination.DefaultLimit = c.Pagination.MaxLimit + 1 }, false},
+		{"max limit above domain max", func(c *config.Config) { c.Pagination.MaxLimit = shared.MaxPageLimit + 1 }, false},
+		{"zero default limit", func(c *config.Config) { c.Pagination.DefaultLimit = 0 }, false},
+		{"unknown provider", func(c *config.Config) { c.Email.Provider = "carrier-pigeon" }, false},
+		{"provider without API key", func(c *config.Config) { c.Email.Provider = config.ProviderSES }, false},
+		{"provider with API key", func(c *config.Config) { c.Email.Provider, c.Email.APIKey = config.ProviderSES, "key" }, true},
+		{"outbox without directory", func(c *config.Config) { c.Email.Outbox = "" }, false},
+		{"invalid sender", func(c *config.Config) { c.Email.From = "not-an-email" }, false},
+		{"interval too short", func(c *config.Config) { c.Scheduler.PublishInterval = time.Second }, false},
+		{"missing data file", func(c *config.Config) { c.Storage.DataFile = "" }, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := config.Default()
+			tt.modify(&c)
+
+			err := c.Validate()
+
+			if tt.valid {
+				assertNoError(t, err)
+			} else {
+				assertErrorCode(t, err, kernel.EInvalid)
+			}
+		})
+	}
+}
+
+func TestConfig_Save(t *testing.T) {
+	c := config.Default()
+	assertNoError(t, c.Set("site.name", `Le "bon" français`))
+	assertNoError(t, c.Set("email.from", "bonjour@fla.example.com"))
+	path := filepath.Join(t.TempDir(), "fla.toml")
+
+	assertNoError(t, c.Save(path))
+
+	data, err := os.ReadFile(path)
+	assertNoError(t, err)
+	assertSnapshot(t, "saved", string(data))
+
+	info, err := os.Stat(path)
+	assertNoError(t, err)
+	if info.Mode().Perm() != 0o600 {
+		t.Errorf("permissions: got %v", info.Mode().Perm())
+	}
+
+	loaded, err := config.Load(path, nil, nil)
+	assertNoError(t, err)
+	for _, key := range config.Keys() {
+		want, _ := c.Get(key)
+		got, _ := loaded.Get(key)
+		if got != want {
+			t.Errorf("%s: got %q, want %q", key, got, want)
+		}
+	}
+
+	t.Run("refuses invalid settings", func(t *testing.T) {
+		c.Pagination.MaxLimit = 0
+		assertErrorCode(t, c.Save(path), kernel.EInvalid)
+	})
+}
+
+func TestOverrides(t *testing.T) {
+	overrides := config.Overrides{}
+	fs := flag.NewFlagSet("fla", flag.ContinueOnError)
+	fs.SetOutput(&strings.Builder{})
+	fs.Var(overrides, "set", "override a config key")
+
+	assertNoError(t, fs.Parse([]string{"-set", "site.name=fla", "-set", "email.outbox=/tmp/mail=box"}))
+	if overrides["site.name"] != "fla" || overrides["email.outbox"] != "/tmp/mail=box" {
+		t.Errorf("got %v", overrides)
+	}
+
+	if err := fs.Parse([]string{"-set", "site.colour=blue"}); err == nil {
+		t.Error("expected unknown keys rejected")
+	}
+	if err := fs.Parse([]string{"-set", "site.name"}); err == nil {
+		t.Error("expected missing value rejected")
+	}
+}
+
+func TestEnvName(t *testing.T) {
+	if got := config.EnvName("site.base_url"); got != "FLA_SITE_BASE_URL" {
+		t.Errorf("got %q", got)
+	}
+}
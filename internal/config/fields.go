@@ -0,0 +1,224 @@
+package config
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/shared"
+)
+
+const (
+	MKeyUnknown   string = "Unknown config key %s."
+	MValueInvalid string = "Config key %s expects %s."
+)
+
+// kind is the type of a setting, which decides how its text form is parsed.
+type kind int
+
+const (
+	kindString   kind = iota
+	kindInt           // Integer
+	kindDuration      // Go duration, e.g. "15m" or "168h"
+	kindList          // Comma-separated in environment variables and flags
+)
+
+var kindNames = map[kind]string{
+	kindString:   "text",
+	kindInt:      "an integer",
+	kindDuration: "a duration such as 15m",
+	kindList:     "a list of text",
+}
+
+// field is one setting, addressed by its dotted key, e.g. "site.base_url".
+// The part before the dot is its TOML table.
+type field struct {
+	key  string
+	kind kind
+	get  func(c *Config) any // string, int64, time.Duration, or []string by kind
+	set  func(c *Config, v any)
+}
+
+// fields lists every setting, in the order Save writes them.
+var fields = []field{
+	stringField("site.name", func(c *Config) *string { return &c.Site.Name }),
+	stringField("site.base_url", func(c *Config) *string { return &c.Site.BaseURL }),
+	listField("site.reserved_slugs", func(c *Config) *[]shared.Slug { return &c.Site.ReservedSlugs }),
+	listField("site.image_hosts", func(c *Config) *[]string { return &c.Site.ImageHosts }),
+	stringField("locales.default", func(c *Config) *shared.Locale { return &c.Locales.Default }),
+	listField("locales.supported", func(c *Config) *[]shared.Locale { return &c.Locales.Supported }),
+	intField("pagination.default_limit", func(c *Config) *int { return &c.Pagination.DefaultLimit }),
+	intField("pagination.max_limit", func(c *Config) *int { return &c.Pagination.MaxLimit }),
+	stringField("email.provider", func(c *Config) *string { return &c.Email.Provider }),
+	stringField("email.api_key", func(c *Config) *string { return &c.Email.APIKey }),
+	stringField("email.from", func(c *Config) *shared.Email { return &c.Email.From }),
+	stringField("email.outbox", func(c *Config) *string { return &c.Email.Outbox }),
+	durationField("scheduler.publish_interval", func(c *Config) *time.Duration { return &c.Scheduler.PublishInterval }),
+	durationField("scheduler.digest_interval", func(c *Config) *time.Duration { return &c.Scheduler.DigestInterval }),
+	stringField("storage.data_file", func(c *Config) *string { return &c.Storage.DataFile }),
+	stringField("storage.search_index", func(c *Config) *string { return &c.Storage.SearchIndex }),
+	listField("robots.disallow", func(c *Config) *[]string { return &c.Robots.Disallow }),
+	listField("robots.blocked_agents", func(c *Config) *[]string { return &c.Robots.BlockedAgents }),
+	listField("robots.nofollow_categories", func(c *Config) *[]string { return &c.Robots.NoFollowCategories }),
+}
+
+func stringField[T ~string](key string, at func(c *Config) *T) field {
+	return field{
+		key:  key,
+		kind: kindString,
+		get:  func(c *Config) any { return string(*at(c)) },
+		set:  func(c *Config, v any) { *at(c) = T(v.(string)) },
+	}
+}
+
+func intField(key string, at func(c *Config) *int) field {
+	return field{
+		key:  key,
+		kind: kindInt,
+		get:  func(c *Config) any { return int64(*at(c)) },
+		set:  func(c *Config, v any) { *at(c) = int(v.(int64)) },
+	}
+}
+
+func durationField(key string, at func(c *Config) *time.Duration) field {
+	return field{
+		key:  key,
+		kind: kindDuration,
+		get:  func(c *Config) any { return *at(c) },
+		set:  func(c *Config, v any) { *at(c) = v.(time.Duration) },
+	}
+}
+
+func listField[T ~string](key string, at func(c *Config) *[]T) field {
+	return field{
+		key:  key,
+		kind: kindList,
+		get: func(c *Config) any {
+			out := make([]string, len(*at(c)))
+			for i, item := range *at(c) {
+				out[i] = string(item)
+			}
+			return out
+		},
+		set: func(c *Config, v any) {
+			items := v.([]string)
+			out := make([]T, len(items))
+			for i, item := range items {
+				out[i] = T(item)
+			}
+			*at(c) = out
+		},
+	}
+}
+
+// Keys returns every config key, in file order.
+func Keys() []string {
+	keys := make([]string, len(fields))
+	for i, f := range fields {
+		keys[i] = f.key
+	}
+	return keys
+}
+
+func lookup(key string) (field, error) {
+	for _, f := range fields {
+		if f.key == key {
+			return f, nil
+		}
+	}
+	return field{}, &kernel.Error{Code: kernel.EInvalid, Message: fmt.Sprintf(MKeyUnknown, key), Operation: "config.lookup"}
+}
+
+// Set parses a setting from its text form, as written in environment variables
+// and flags: durations like "15m", lists comma-separated.
+func (c *Config) Set(key, text string) error {
+	const op = "Config.Set"
+
+	f, err := lookup(key)
+	if err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	var v any
+	switch f.kind {
+	case kindString:
+		v = text
+	case kindInt:
+		v, err = strconv.ParseInt(strings.TrimSpace(text), 10, 0)
+	case kindDuration:
+		v, err = time.ParseDuration(strings.TrimSpace(text))
+	case kindList:
+		var items []string
+		for _, item := range strings.Split(text, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				items = append(items, item)
+			}
+		}
+		v = items
+	}
+	if err != nil {
+		return &kernel.Error{Code: kernel.EInvalid, Message: fmt.Sprintf(MValueInvalid, key, kindNames[f.kind]), Operation: op}
+	}
+
+	f.set(c, v)
+	return nil
+}
+
+// setValue applies a value decoded from a TOML file, where durations are strings.
+func (c *Config) setValue(key string, v any) error {
+	const op = "Config.setValue"
+
+	f, err := lookup(key)
+	if err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	ok := false
+	switch f.kind {
+	case kindString:
+		_, ok = v.(string)
+	case kindInt:
+		_, ok = v.(int64)
+	case kindDuration:
+		if s, isString := v.(string); isString {
+			v, err = time.ParseDuration(s)
+			ok = err == nil
+		}
+	case kindList:
+		_, ok = v.([]string)
+	}
+	if !ok {
+		return &kernel.Error{Code: kernel.EInvalid, Message: fmt.Sprintf(MValueInvalid, key, kindNames[f.kind]), Operation: op}
+	}
+
+	f.set(c, v)
+	return nil
+}
+
+// Get returns a setting in its text form, the one Set accepts.
+func (c Config) Get(key string) (string, error) {
+	const op = "Config.Get"
+
+	f, err := lookup(key)
+	if err != nil {
+		return "", &kernel.Error{Operation: op, Cause: err}
+	}
+
+	switch v := f.get(&c).(type) {
+	case []string:
+		return strings.Join(v, ","), nil
+	default:
+		return fmt.Sprint(v), nil
+	}
+}
+
+// EnvName returns the environment variable overriding a key,
+// e.g. FLA_SITE_BASE_URL for site.base_url.
+func EnvName(key string) string {
+	return EnvPrefix + strings.ToUpper(strings.ReplaceAll(key, ".", "_"))
+}
+
+// EnvPrefix starts every environment variable fla reads.
+const EnvPrefix = "FLA_"
@@ -0,0 +1,59 @@
+package config_test
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+)
+
+var update = flag.Bool("update", false, "update snapshot files in testdata")
+
+func assertNoError(t *testing.T, err error) {
+	t.Helper()
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+}
+
+func assertErrorCode(t *testing.T, err error, want string) {
+	t.Helper()
+	got := kernel.ErrorCode(err)
+	if got != want {
+		t.Errorf("error code: got %q, want %q (%v)", got, want, err)
+	}
+}
+
+// assertSnapshot compares got with testdata/<name>.golden, rewriting it with -update.
+func assertSnapshot(t *testing.T, name, got string) {
+	t.Helper()
+
+	path := filepath.Join("testdata", name+".golden")
+	if *update {
+		if err := os.WriteFile(path, []byte(got), 0o644); err != nil {
+			t.Fatalf("failed to update snapshot: %v", err)
+		}
+		return
+	}
+
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("failed to read snapshot (run with -update to create): %v", err)
+	}
+	if got != string(want) {
+		t.Errorf("snapshot %s mismatch:\ngot:\n%s\nwant:\n%s", name, got, want)
+	}
+}
+
+// writeConfig writes a config file in a temporary directory and returns its path.
+func writeConfig(t *testing.T, content string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "fla.toml")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
@@ -0,0 +1,179 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+)
+
+const (
+	MFileUnreadable  string = "Config file %s could not be read."
+	MFileUnwritable  string = "Config file %s could not be written."
+	MFileSyntax      string = "Config file %s, line %d: %s."
+	MFileValue       string = "Config file %s, line %d: %s"
+	MEnvInvalid      string = "Environment variable %s: %s"
+	MOverrideSyntax  string = "Override %q must be written key=value."
+	MOverrideInvalid string = "Override %s: %s"
+)
+
+// Overrides are key=value settings from the command line, the last layer applied.
+// It implements flag.Value so a repeatable flag can fill it: -set site.name=fla.
+type Overrides map[string]string
+
+func (o Overrides) String() string {
+	pairs := make([]string, 0, len(o))
+	for key, value := range o {
+		pairs = append(pairs, key+"="+value)
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, " ")
+}
+
+// Set records one key=value override; unknown keys are rejected right away.
+func (o Overrides) Set(s string) error {
+	const op = "Overrides.Set"
+
+	key, value, ok := strings.Cut(s, "=")
+	if !ok {
+		return &kernel.Error{Code: kernel.EInvalid, Message: fmt.Sprintf(MOverrideSyntax, s), Operation: op}
+	}
+	if _, err := lookup(strings.TrimSpace(key)); err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	o[strings.TrimSpace(key)] = value
+	return nil
+}
+
+// Load layers the configuration: defaults, then the TOML file at path (none when
+// path is empty), then FLA_* variables from environ (as os.Environ returns it),
+// then overrides. The result is validated; any mistake names its source.
+func Load(path string, environ []string, overrides Overrides) (Config, error) {
+	const op = "config.Load"
+
+	c := Default()
+
+	if path != "" {
+		if err := c.loadFile(path); err != nil {
+			return Config{}, &kernel.Error{Operation: op, Cause: err}
+		}
+	}
+
+	env := make(map[string]string)
+	for _, kv := range environ {
+		if name, value, ok := strings.Cut(kv, "="); ok && strings.HasPrefix(name, EnvPrefix) {
+			env[name] = value
+		}
+	}
+	for _, key := range Keys() {
+		value, ok := env[EnvName(key)]
+		if !ok {
+			continue
+		}
+		if err := c.Set(key, value); err != nil {
+			return Config{}, &kernel.Error{
+				Code:      kernel.EInvalid,
+				Message:   fmt.Sprintf(MEnvInvalid, EnvName(key), kernel.ErrorMessage(err)),
+				Operation: op,
+				Cause:     err,
+			}
+		}
+	}
+
+	for _, key := range Keys() {
+		value, ok := overrides[key]
+		if !ok {
+			continue
+		}
+		if err := c.Set(key, value); err != nil {
+			return Config{}, &kernel.Error{
+				Code:      kernel.EInvalid,
+				Message:   fmt.Sprintf(MOverrideInvalid, key, kernel.ErrorMessage(err)),
+				Operation: op,
+				Cause:     err,
+			}
+		}
+	}
+
+	if err := c.Validate(); err != nil {
+		return Config{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return c, nil
+}
+
+func (c *Config) loadFile(path string) error {
+	const op = "Config.loadFile"
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		code := kernel.EInternal
+		if errors.Is(err, os.ErrNotExist) {
+			code = kernel.ENotFound
+		}
+		return &kernel.Error{Code: code, Message: fmt.Sprintf(MFileUnreadable, path), Operation: op, Cause: err}
+	}
+
+	entries, err := decodeTOML(data)
+	if err != nil {
+		var syntax *syntaxError
+		errors.As(err, &syntax)
+		return &kernel.Error{Code: kernel.EInvalid, Message: fmt.Sprintf(MFileSyntax, path, syntax.line, syntax.reason), Operation: op}
+	}
+
+	for _, e := range entries {
+		if err := c.setValue(e.key, e.value); err != nil {
+			return &kernel.Error{
+				Code:      kernel.EInvalid,
+				Message:   fmt.Sprintf(MFileValue, path, e.line, kernel.ErrorMessage(err)),
+				Operation: op,
+				Cause:     err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// Save writes every setting to a TOML file that Load reads back, replacing
+// path only once fully written. The file may hold credentials, so only its
+// owner can read it.
+func (c Config) Save(path string) error {
+	const op = "Config.Save"
+
+	unwritable := func(err error) error {
+		return &kernel.Error{Code: kernel.EInternal, Message: fmt.Sprintf(MFileUnwritable, path), Operation: op, Cause: err}
+	}
+
+	if err := c.Validate(); err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	file, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return unwritable(err)
+	}
+	defer os.Remove(file.Name())
+
+	if _, err := file.Write(encodeTOML(c)); err != nil {
+		file.Close()
+		return unwritable(err)
+	}
+	if err := file.Chmod(0o600); err != nil {
+		file.Close()
+		return unwritable(err)
+	}
+	if err := file.Close(); err != nil {
+		return unwritable(err)
+	}
+
+	if err := os.Rename(file.Name(), path); err != nil {
+		return unwritable(err)
+	}
+	return nil
+}
@@ -0,0 +1,275 @@
+package config
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// Syntax errors of config files, reported with their line.
+const (
+	MSyntaxKeyValue   string = "expected key = value"
+	MSyntaxTable      string = "expected [table]"
+	MSyntaxKey        string = "keys are written as bare words: letters, digits, _ and -"
+	MSyntaxValue      string = "expected a quoted string, an integer, or a list of strings"
+	MSyntaxString     string = "unterminated string"
+	MSyntaxEscape     string = "invalid escape sequence"
+	MSyntaxList       string = "lists hold quoted strings separated by commas, on one line"
+	MSyntaxTrailing   string = "unexpected text after the value"
+	MSyntaxDuplicate  string = "key %s is set twice"
+	MSyntaxNotUTF8    string = "file is not UTF-8"
+	MSyntaxOutOfTable string = "key %s must sit under a [table]"
+)
+
+// entry is one key = value line of a config file.
+type entry struct {
+	key   string // Dotted, with its table: "site.name"
+	value any    // string, int64, or []string
+	line  int
+}
+
+// syntaxError is a malformed line; Load reports it with the file name.
+type syntaxError struct {
+	line   int
+	reason string
+}
+
+func (e *syntaxError) Error() string { return fmt.Sprintf("line %d: %s", e.line, e.reason) }
+
+// decodeTOML reads the subset of TOML settings need: [table] headers and
+// key = value lines whose value is a basic or literal string, an integer,
+// or a one-line list of strings. Comments start with #.
+func decodeTOML(data []byte) ([]entry, error) {
+	if !utf8.Valid(data) {
+		return nil, &syntaxError{line: 1, reason: MSyntaxNotUTF8}
+	}
+
+	var entries []entry
+	seen := make(map[string]bool)
+	table := ""
+
+	for i, raw := range strings.Split(string(data), "\n") {
+		line := i + 1
+		text := strings.TrimSpace(strings.TrimSuffix(raw, "\r"))
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+
+		if strings.HasPrefix(text, "[") {
+			name, rest, ok := strings.Cut(text[1:], "]")
+			if !ok || !isBareKey(strings.TrimSpace(name)) || !isComment(rest) {
+				return nil, &syntaxError{line: line, reason: MSyntaxTable}
+			}
+			table = strings.TrimSpace(name)
+			continue
+		}
+
+		name, rest, ok := strings.Cut(text, "=")
+		if !ok {
+			return nil, &syntaxError{line: line, reason: MSyntaxKeyValue}
+		}
+		name = strings.TrimSpace(name)
+		if !isBareKey(name) {
+			return nil, &syntaxError{line: line, reason: MSyntaxKey}
+		}
+		if table == "" {
+			return nil, &syntaxError{line: line, reason: fmt.Sprintf(MSyntaxOutOfTable, name)}
+		}
+
+		value, rest, err := parseValue(strings.TrimSpace(rest))
+		if err != "" {
+			return nil, &syntaxError{line: line, reason: err}
+		}
+		if !isComment(rest) {
+			return nil, &syntaxError{line: line, reason: MSyntaxTrailing}
+		}
+
+		key := table + "." + name
+		if seen[key] {
+			return nil, &syntaxError{line: line, reason: fmt.Sprintf(MSyntaxDuplicate, key)}
+		}
+		seen[key] = true
+		entries = append(entries, entry{key: key, value: value, line: line})
+	}
+
+	return entries, nil
+}
+
+// parseValue reads one value from the start of s and returns the text after it,
+// or the reason s does not start with a value.
+func parseValue(s string) (value any, rest string, reason string) {
+	switch {
+	case strings.HasPrefix(s, `"`), strings.HasPrefix(s, "'"):
+		return parseString(s)
+	case strings.HasPrefix(s, "["):
+		return parseList(s)
+	}
+
+	end := strings.IndexAny(s, " \t#")
+	if end < 0 {
+		end = len(s)
+	}
+	n, err := strconv.ParseInt(strings.ReplaceAll(s[:end], "_", ""), 10, 64)
+	if err != nil {
+		return nil, "", MSyntaxValue
+	}
+	return n, s[end:], ""
+}
+
+// parseString reads a basic "..." string with escapes or a literal '...' string.
+func parseString(s string) (any, string, string) {
+	if s[0] == '\'' {
+		end := strings.IndexByte(s[1:], '\'')
+		if end < 0 {
+			return nil, "", MSyntaxString
+		}
+		return s[1 : end+1], s[end+2:], ""
+	}
+
+	var out strings.Builder
+	for i := 1; i < len(s); i++ {
+		switch c := s[i]; c {
+		case '"':
+			return out.String(), s[i+1:], ""
+		case '\\':
+			if i+1 >= len(s) {
+				return nil, "", MSyntaxString
+			}
+			i++
+			switch s[i] {
+			case '"', '\\':
+				out.WriteByte(s[i])
+			case 'n':
+				out.WriteByte('\n')
+			case 't':
+				out.WriteByte('\t')
+			case 'r':
+				out.WriteByte('\r')
+			case 'u':
+				if i+4 >= len(s) {
+					return nil, "", MSyntaxEscape
+				}
+				r, err := strconv.ParseUint(s[i+1:i+5], 16, 32)
+				if err != nil {
+					return nil, "", MSyntaxEscape
+				}
+				out.WriteRune(rune(r))
+				i += 4
+			default:
+				return nil, "", MSyntaxEscape
+			}
+		default:
+			out.WriteByte(c)
+		}
+	}
+	return nil, "", MSyntaxString
+}
+
+// parseList reads a one-line list of strings; a trailing comma is allowed.
+func parseList(s string) (any, string, string) {
+	items := []string{}
+	s = strings.TrimSpace(s[1:])
+	for {
+		if strings.HasPrefix(s, "]") {
+			return items, s[1:], ""
+		}
+
+		if !strings.HasPrefix(s, `"`) && !strings.HasPrefix(s, "'") {
+			return nil, "", MSyntaxList
+		}
+		item, rest, reason := parseString(s)
+		if reason != "" {
+			return nil, "", reason
+		}
+		items = append(items, item.(string))
+
+		s = strings.TrimSpace(rest)
+		if strings.HasPrefix(s, ",") {
+			s = strings.TrimSpace(s[1:])
+		} else if !strings.HasPrefix(s, "]") {
+			return nil, "", MSyntaxList
+		}
+	}
+}
+
+func isBareKey(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '_' || r == '-') {
+			return false
+		}
+	}
+	return true
+}
+
+// isComment reports whether the rest of a line holds nothing but an optional comment.
+func isComment(rest string) bool {
+	rest = strings.TrimSpace(rest)
+	return rest == "" || strings.HasPrefix(rest, "#")
+}
+
+// encodeTOML writes every setting under its table, in field order.
+func encodeTOML(c Config) []byte {
+	var buf bytes.Buffer
+	table := ""
+
+	for _, f := range fields {
+		name, key, _ := strings.Cut(f.key, ".")
+		if name != table {
+			if table != "" {
+				buf.WriteByte('\n')
+			}
+			fmt.Fprintf(&buf, "[%s]\n", name)
+			table = name
+		}
+
+		var value string
+		switch v := f.get(&c).(type) {
+		case string:
+			value = quote(v)
+		case int64:
+			value = strconv.FormatInt(v, 10)
+		case time.Duration:
+			value = quote(v.String())
+		case []string:
+			quoted := make([]string, len(v))
+			for i, item := range v {
+				quoted[i] = quote(item)
+			}
+			value = "[" + strings.Join(quoted, ", ") + "]"
+		}
+		fmt.Fprintf(&buf, "%s = %s\n", key, value)
+	}
+
+	return buf.Bytes()
+}
+
+// quote writes a TOML basic string.
+func quote(s string) string {
+	var out strings.Builder
+	out.WriteByte('"')
+	for _, r := range s {
+		switch {
+		case r == '"' || r == '\\':
+			out.WriteByte('\\')
+			out.WriteRune(r)
+		case r == '\n':
+			out.WriteString(`\n`)
+		case r == '\t':
+			out.WriteString(`\t`)
+		case r == '\r':
+			out.WriteString(`\r`)
+		case r < 0x20 || r == 0x7f:
+			fmt.Fprintf(&out, `\u%04X`, r)
+		default:
+			out.WriteRune(r)
+		}
+	}
+	out.WriteByte('"')
+	return out.String()
+}
@@ -0,0 +1,57 @@
+package config_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/alnah/fla/internal/config"
+	"github.com/alnah/fla/internal/domain/kernel"
+)
+
+func TestLoad_Syntax(t *testing.T) {
+	tests := []struct {
+		name    string
+		file    string
+		message string // Empty when the file is valid
+	}{
+		{"comments and blank lines", "# top\n\n[site] # table\nname = \"fla\" # trailing\n", ""},
+		{"escapes", "[site]\nname = \"Tab\\there \\\"quoted\\\" \\u00e9\"\n", ""},
+		{"literal strings keep backslashes", "[storage]\ndata_file = 'C:\\blog\\fla.zip'\n", ""},
+		{"integers with separators", "[pagination]\nmax_limit = 1_00\n", ""},
+		{"windows line endings", "[site]\r\nname = \"fla\"\r\n", ""},
+		{"key outside a table", "name = \"fla\"\n", "line 1: key name must sit under a [table]"},
+		{"unclosed table", "[site\n", "line 1: expected [table]"},
+		{"quoted key", "[site]\n\"name\" = \"fla\"\n", "line 2: keys are written as bare words"},
+		{"unterminated string", "[site]\nname = \"fla\n", "line 2: unterminated string"},
+		{"unknown escape", "[site]\nname = \"\\q\"\n", "line 2: invalid escape sequence"},
+		{"bare word value", "[site]\nname = fla\n", "line 2: expected a quoted string"},
+		{"text after value", "[site]\nname = \"fla\" \"again\"\n", "line 2: unexpected text after the value"},
+		{"list of numbers", "[locales]\nsupported = [1, 2]\n", "line 2: lists hold quoted strings"},
+		{"duplicate key", "[site]\nname = \"a\"\nname = \"b\"\n", "line 3: key site.name is set twice"},
+		{"not UTF-8", "[site]\nname = \"\xff\"\n", "line 1: file is not UTF-8"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := config.Load(writeConfig(t, tt.file), nil, nil)
+
+			if tt.message == "" {
+				assertNoError(t, err)
+				return
+			}
+			assertErrorCode(t, err, kernel.EInvalid)
+			if !strings.Contains(kernel.ErrorMessage(err), tt.message) {
+				t.Errorf("message: got %q, want it to contain %q", kernel.ErrorMessage(err), tt.message)
+			}
+		})
+	}
+
+	t.Run("escapes decode", func(t *testing.T) {
+		c, err := config.Load(writeConfig(t, "[site]\nname = \"Tab\\there \\\"quoted\\\" \\u00e9\"\n"), nil, nil)
+
+		assertNoError(t, err)
+		if c.Site.Name != "Tab\there \"quoted\" é" {
+			t.Errorf("got %q", c.Site.Name)
+		}
+	})
+}
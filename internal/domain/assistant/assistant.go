@@ -0,0 +1,80 @@
+// Package assistant is the domain's port to writing assistants such as language
+// models. The domain states what it asks for and what it accepts back; adapters
+// translate to a provider's API, so prompts, models, and keys stay out of here.
+// Whatever an assistant returns is a suggestion: it is validated like author
+// input and never saved without an author or editor taking it.
+package assistant
+
+import (
+	"github.com/alnah/fla/internal/domain/post"
+	"github.com/alnah/fla/internal/domain/shared"
+)
+
+// ContentAssistant drafts content for authors. Implementations return kernel
+// errors; provider outages should be marked Retryable.
+type ContentAssistant interface {
+	// SuggestSEODescription drafts a meta description for the lesson.
+	SuggestSEODescription(request SEODescriptionRequest) (SEODescriptionSuggestion, error)
+
+	// SimplifyToLevel rewrites Markdown content for learners of a lower level,
+	// keeping its structure (headings, lists, links).
+	SimplifyToLevel(request SimplifyRequest) (Simplification, error)
+
+	// GenerateExerciseDrafts drafts exercises on the lesson's content.
+	GenerateExerciseDrafts(request ExerciseRequest) ([]ExerciseDraft, error)
+}
+
+// SEODescriptionRequest is what the assistant knows when drafting a description.
+type SEODescriptionRequest struct {
+	Title     string
+	Content   string // Markdown
+	Locale    shared.Locale
+	MaxLength int // Characters; the assistant must stay within shared.MaxDescriptionLength
+}
+
+// SEODescriptionSuggestion is a drafted meta description.
+type SEODescriptionSuggestion struct {
+	Description string
+}
+
+// SimplifyRequest asks for content rewritten for a target level.
+type SimplifyRequest struct {
+	Content string // Markdown
+	Level   shared.CEFRLevel
+	Locale  shared.Locale
+}
+
+// Simplification is rewritten content, with the readability check of the domain.
+type Simplification struct {
+	Content        post.PostContent
+	Level          shared.CEFRLevel // Level asked for
+	EstimatedLevel shared.CEFRLevel // Level the rewritten text reads at, set by the service
+}
+
+// ExerciseKind is the shape of an exercise.
+type ExerciseKind string
+
+const (
+	ExerciseMultipleChoice ExerciseKind = "multiple_choice"
+	ExerciseFillInBlank    ExerciseKind = "fill_in_blank"
+	ExerciseOpenQuestion   ExerciseKind = "open_question"
+)
+
+func (k ExerciseKind) String() string { return string(k) }
+
+// ExerciseRequest asks for exercises on a lesson.
+type ExerciseRequest struct {
+	Title   string
+	Content string // Markdown
+	Locale  shared.Locale
+	Kinds   []ExerciseKind // Kinds wanted; empty lets the assistant choose
+	Count   int
+}
+
+// ExerciseDraft is one drafted exercise.
+type ExerciseDraft struct {
+	Kind    ExerciseKind
+	Prompt  string
+	Choices []string // Multiple choice only
+	Answer  string
+}
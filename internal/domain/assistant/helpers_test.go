@@ -0,0 +1,22 @@
+package assistant_test
+
+import (
+	"testing"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+)
+
+func assertNoError(t *testing.T, err error) {
+	t.Helper()
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+}
+
+func assertErrorCode(t *testing.T, err error, want string) {
+	t.Helper()
+	got := kernel.ErrorCode(err)
+	if got != want {
+		t.Errorf("error code: got %q, want %q", got, want)
+	}
+}
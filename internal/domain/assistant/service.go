@@ -0,0 +1,192 @@
+package assistant
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/policy"
+	"github.com/alnah/fla/internal/domain/post"
+	"github.com/alnah/fla/internal/domain/shared"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+const (
+	DefaultExerciseCount int = 5
+	MaxExerciseCount     int = 20
+
+	MAssistantForbidden        string = "Only users who can edit the post can ask the assistant."
+	MAssistantLevelNotLower    string = "Content can only be simplified to a lower level than %s."
+	MAssistantExerciseCount    string = "Ask for between 1 and %d exercises."
+	MAssistantExerciseKind     string = "Invalid exercise kind: %s."
+	MAssistantAnswerInvalid    string = "Assistant returned an unusable answer."
+	MAssistantTooManyExercises string = "Assistant returned more exercises than asked."
+)
+
+// AssistantService asks a ContentAssistant on behalf of authors and checks what
+// comes back before anyone sees it.
+type AssistantService struct {
+	assistant ContentAssistant
+	locale    shared.Locale // Language lessons are written in
+}
+
+// NewAssistantService creates assistant service over a provider adapter.
+func NewAssistantService(assistant ContentAssistant, locale shared.Locale) *AssistantService {
+	return &AssistantService{assistant: assistant, locale: locale}
+}
+
+// SuggestSEODescription drafts a meta description for the post.
+func (s *AssistantService) SuggestSEODescription(p post.Post, actor user.PostPermissionChecker) (shared.Description, error) {
+	const op = "AssistantService.SuggestSEODescription"
+
+	if err := authorize(p, actor); err != nil {
+		return "", &kernel.Error{Operation: op, Cause: err}
+	}
+
+	suggestion, err := s.assistant.SuggestSEODescription(SEODescriptionRequest{
+		Title:     p.Title.String(),
+		Content:   p.Content.String(),
+		Locale:    s.locale,
+		MaxLength: shared.MaxDescriptionLength,
+	})
+	if err != nil {
+		return "", &kernel.Error{Operation: op, Cause: err}
+	}
+
+	description, err := shared.NewDescription(suggestion.Description)
+	if err != nil {
+		return "", unusable(op, err)
+	}
+
+	return description, nil
+}
+
+// SimplifyToLevel rewrites the post's content for a lower level than current,
+// the level of the post's category. The result carries the level it reads at,
+// which may still be above the one asked for.
+func (s *AssistantService) SimplifyToLevel(p post.Post, current, level shared.CEFRLevel, actor user.PostPermissionChecker) (Simplification, error) {
+	const op = "AssistantService.SimplifyToLevel"
+
+	if err := authorize(p, actor); err != nil {
+		return Simplification{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if err := level.Validate(); err != nil {
+		return Simplification{}, &kernel.Error{Operation: op, Cause: err}
+	}
+	if current.Rank() != 0 && level.Rank() >= current.Rank() {
+		return Simplification{}, &kernel.Error{Code: kernel.EInvalid, Message: fmt.Sprintf(MAssistantLevelNotLower, current), Operation: op}
+	}
+
+	simplified, err := s.assistant.SimplifyToLevel(SimplifyRequest{Content: p.Content.String(), Level: level, Locale: s.locale})
+	if err != nil {
+		return Simplification{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	content, err := post.NewPostContent(simplified.Content.String())
+	if err != nil {
+		return Simplification{}, unusable(op, err)
+	}
+
+	return Simplification{
+		Content:        content,
+		Level:          level,
+		EstimatedLevel: post.AnalyzeText(kernel.StripMarkdown(content.String())).LevelFor(s.locale),
+	}, nil
+}
+
+// GenerateExerciseDrafts drafts count exercises of the given kinds on the post;
+// a count of zero asks for DefaultExerciseCount.
+func (s *AssistantService) GenerateExerciseDrafts(p post.Post, kinds []ExerciseKind, count int, actor user.PostPermissionChecker) ([]ExerciseDraft, error) {
+	const op = "AssistantService.GenerateExerciseDrafts"
+
+	if err := authorize(p, actor); err != nil {
+		return nil, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if count == 0 {
+		count = DefaultExerciseCount
+	}
+	if count < 0 || count > MaxExerciseCount {
+		return nil, &kernel.Error{Code: kernel.EInvalid, Message: fmt.Sprintf(MAssistantExerciseCount, MaxExerciseCount), Operation: op}
+	}
+	for _, kind := range kinds {
+		if err := kind.Validate(); err != nil {
+			return nil, &kernel.Error{Operation: op, Cause: err}
+		}
+	}
+
+	drafts, err := s.assistant.GenerateExerciseDrafts(ExerciseRequest{
+		Title:   p.Title.String(),
+		Content: p.Content.String(),
+		Locale:  s.locale,
+		Kinds:   kinds,
+		Count:   count,
+	})
+	if err != nil {
+		return nil, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if len(drafts) > count {
+		return nil, &kernel.Error{Code: kernel.EInternal, Message: MAssistantTooManyExercises, Operation: op}
+	}
+	for _, draft := range drafts {
+		if err := draft.Validate(); err != nil {
+			return nil, unusable(op, err)
+		}
+		if len(kinds) > 0 && !slices.Contains(kinds, draft.Kind) {
+			return nil, unusable(op, &kernel.Error{Code: kernel.EInvalid, Message: fmt.Sprintf(MAssistantExerciseKind, draft.Kind)})
+		}
+	}
+
+	return drafts, nil
+}
+
+// Validate ensures the exercise kind is known.
+func (k ExerciseKind) Validate() error {
+	const op = "ExerciseKind.Validate"
+
+	switch k {
+	case ExerciseMultipleChoice, ExerciseFillInBlank, ExerciseOpenQuestion:
+		return nil
+	default:
+		return &kernel.Error{Code: kernel.EInvalid, Message: fmt.Sprintf(MAssistantExerciseKind, k), Operation: op}
+	}
+}
+
+// Validate ensures the draft has a prompt, an answer, and, for multiple choice,
+// at least two choices among which the answer is.
+func (d ExerciseDraft) Validate() error {
+	const op = "ExerciseDraft.Validate"
+
+	if err := d.Kind.Validate(); err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+	if err := kernel.ValidatePresence("prompt", strings.TrimSpace(d.Prompt), op); err != nil {
+		return err
+	}
+	if err := kernel.ValidatePresence("answer", strings.TrimSpace(d.Answer), op); err != nil {
+		return err
+	}
+	if d.Kind == ExerciseMultipleChoice && (len(d.Choices) < 2 || !slices.Contains(d.Choices, d.Answer)) {
+		return &kernel.Error{Code: kernel.EInvalid, Message: MAssistantAnswerInvalid, Operation: op}
+	}
+
+	return nil
+}
+
+func authorize(p post.Post, actor user.PostPermissionChecker) error {
+	const op = "assistant.authorize"
+
+	if !user.Authorize(actor, policy.PostEdit, user.PostResource(p)).Allowed {
+		return &kernel.Error{Code: kernel.EForbidden, Message: MAssistantForbidden, Operation: op}
+	}
+	return nil
+}
+
+// unusable reports a suggestion that fails domain validation. It is the
+// provider's fault, not the caller's, so it is internal rather than invalid.
+func unusable(op string, err error) error {
+	return &kernel.Error{Code: kernel.EInternal, Message: MAssistantAnswerInvalid, Operation: op, Cause: err}
+}
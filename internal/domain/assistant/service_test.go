@@ -0,0 +1,159 @@
+package assistant_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/alnah/fla/internal/domain/assistant"
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/post"
+	"github.com/alnah/fla/internal/domain/shared"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+// stubAssistant answers with canned suggestions and records what it was asked.
+type stubAssistant struct {
+	description string
+	simplified  string
+	exercises   []assistant.ExerciseDraft
+	err         error
+
+	exerciseRequest assistant.ExerciseRequest
+}
+
+func (s *stubAssistant) SuggestSEODescription(assistant.SEODescriptionRequest) (assistant.SEODescriptionSuggestion, error) {
+	return assistant.SEODescriptionSuggestion{Description: s.description}, s.err
+}
+
+func (s *stubAssistant) SimplifyToLevel(r assistant.SimplifyRequest) (assistant.Simplification, error) {
+	return assistant.Simplification{Content: post.PostContent(s.simplified), Level: r.Level}, s.err
+}
+
+func (s *stubAssistant) GenerateExerciseDrafts(r assistant.ExerciseRequest) ([]assistant.ExerciseDraft, error) {
+	s.exerciseRequest = r
+	return s.exercises, s.err
+}
+
+var (
+	author = user.User{ID: "author", Roles: []user.Role{user.RoleAuthor}, Status: user.AccountStatusActive}
+	other  = user.User{ID: "other", Roles: []user.Role{user.RoleAuthor}, Status: user.AccountStatusActive}
+
+	lesson = post.Post{
+		PostID:  "lesson",
+		Owner:   "author",
+		Status:  post.StatusDraft,
+		Title:   "Au marché",
+		Content: post.PostContent(strings.Repeat("Le samedi matin, nous allons au marché pour acheter des légumes frais. ", 5)),
+	}
+)
+
+func TestAssistantService_SuggestSEODescription(t *testing.T) {
+	t.Run("returns a valid description", func(t *testing.T) {
+		stub := &stubAssistant{description: "Apprenez le vocabulaire du marché avec une leçon courte pour débutants."}
+		service := assistant.NewAssistantService(stub, shared.LocaleFrenchFR)
+
+		got, err := service.SuggestSEODescription(lesson, author)
+
+		assertNoError(t, err)
+		if got.String() != stub.description {
+			t.Errorf("got %q, want %q", got, stub.description)
+		}
+	})
+
+	t.Run("rejects descriptions the domain would refuse", func(t *testing.T) {
+		stub := &stubAssistant{description: strings.Repeat("trop long ", 100)}
+		service := assistant.NewAssistantService(stub, shared.LocaleFrenchFR)
+
+		_, err := service.SuggestSEODescription(lesson, author)
+
+		assertErrorCode(t, err, kernel.EInternal)
+	})
+
+	t.Run("only users who can edit the post may ask", func(t *testing.T) {
+		service := assistant.NewAssistantService(&stubAssistant{}, shared.LocaleFrenchFR)
+
+		_, err := service.SuggestSEODescription(lesson, other)
+
+		assertErrorCode(t, err, kernel.EForbidden)
+	})
+
+	t.Run("keeps provider errors retryable", func(t *testing.T) {
+		stub := &stubAssistant{err: &kernel.Error{Code: kernel.EInternal, Message: "rate limited", Retryable: true}}
+		service := assistant.NewAssistantService(stub, shared.LocaleFrenchFR)
+
+		_, err := service.SuggestSEODescription(lesson, author)
+
+		if !kernel.IsRetryable(err) {
+			t.Error("expected a retryable error")
+		}
+	})
+}
+
+func TestAssistantService_SimplifyToLevel(t *testing.T) {
+	t.Run("estimates the level of the rewritten text", func(t *testing.T) {
+		stub := &stubAssistant{simplified: strings.Repeat("Je vais au marché. J'achète des fruits. ", 10)}
+		service := assistant.NewAssistantService(stub, shared.LocaleFrenchFR)
+
+		got, err := service.SimplifyToLevel(lesson, shared.LevelB1, shared.LevelA1, author)
+
+		assertNoError(t, err)
+		if got.Level != shared.LevelA1 || got.EstimatedLevel != shared.LevelA1 {
+			t.Errorf("got level %s estimated %s, want A1", got.Level, got.EstimatedLevel)
+		}
+	})
+
+	t.Run("only to a lower level", func(t *testing.T) {
+		service := assistant.NewAssistantService(&stubAssistant{}, shared.LocaleFrenchFR)
+
+		_, err := service.SimplifyToLevel(lesson, shared.LevelA2, shared.LevelB1, author)
+
+		assertErrorCode(t, err, kernel.EInvalid)
+	})
+}
+
+func TestAssistantService_GenerateExerciseDrafts(t *testing.T) {
+	choice := assistant.ExerciseDraft{
+		Kind:    assistant.ExerciseMultipleChoice,
+		Prompt:  "Où allons-nous le samedi ?",
+		Choices: []string{"Au marché", "À la plage"},
+		Answer:  "Au marché",
+	}
+
+	t.Run("asks for the default count", func(t *testing.T) {
+		stub := &stubAssistant{exercises: []assistant.ExerciseDraft{choice}}
+		service := assistant.NewAssistantService(stub, shared.LocaleFrenchFR)
+
+		got, err := service.GenerateExerciseDrafts(lesson, nil, 0, author)
+
+		assertNoError(t, err)
+		if len(got) != 1 || stub.exerciseRequest.Count != assistant.DefaultExerciseCount {
+			t.Errorf("got %d drafts for a request of %d", len(got), stub.exerciseRequest.Count)
+		}
+	})
+
+	wrongAnswer := choice
+	wrongAnswer.Answer = "À la gare"
+	tests := []struct {
+		name      string
+		kinds     []assistant.ExerciseKind
+		count     int
+		exercises []assistant.ExerciseDraft
+		want      string
+	}{
+		{name: "too many asked", count: assistant.MaxExerciseCount + 1, want: kernel.EInvalid},
+		{name: "unknown kind asked", kinds: []assistant.ExerciseKind{"crossword"}, want: kernel.EInvalid},
+		{name: "answer outside choices", exercises: []assistant.ExerciseDraft{wrongAnswer}, want: kernel.EInternal},
+		{name: "kind not asked", kinds: []assistant.ExerciseKind{assistant.ExerciseOpenQuestion}, exercises: []assistant.ExerciseDraft{choice}, want: kernel.EInternal},
+		{name: "more than asked", count: 1, exercises: []assistant.ExerciseDraft{choice, choice}, want: kernel.EInternal},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := assistant.NewAssistantService(&stubAssistant{exercises: tt.exercises}, shared.LocaleFrenchFR)
+
+			_, err := service.GenerateExerciseDrafts(lesson, tt.kinds, tt.count, author)
+
+			assertErrorCode(t, err, tt.want)
+		})
+	}
+}
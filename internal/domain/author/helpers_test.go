@@ -0,0 +1,73 @@
+package author_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/post"
+	"github.com/alnah/fla/internal/domain/shared"
+	"github.com/alnah/fla/internal/domain/tag"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+type stubUsers map[kernel.ID[user.User]]user.User
+
+func (s stubUsers) GetUserByID(id kernel.ID[user.User]) (*user.User, error) {
+	if u, ok := s[id]; ok {
+		return &u, nil
+	}
+	return nil, &kernel.Error{Code: kernel.ENotFound, Message: "user not found"}
+}
+
+// stubPosts answers queries in memory using Query.Matches and Query.Compare.
+type stubPosts struct {
+	posts   []post.Post
+	queries int
+}
+
+func (s *stubPosts) Find(q post.Query) (post.PostsList, error) {
+	s.queries++
+	var matched []post.Post
+	for _, p := range s.posts {
+		if q.Matches(p, nil) {
+			matched = append(matched, p)
+		}
+	}
+	slices.SortFunc(matched, q.Compare)
+
+	pagination, _ := shared.NewPagination(q.Pagination.Page, q.Pagination.Limit, len(matched))
+	start := min(pagination.Offset(), len(matched))
+	end := min(start+pagination.Limit, len(matched))
+	return post.NewPostsList(matched[start:end], pagination), nil
+}
+
+type stubTags map[kernel.ID[tag.Tag]]tag.Tag
+
+func (s stubTags) GetByID(id kernel.ID[tag.Tag]) (*tag.Tag, error) {
+	if t, ok := s[id]; ok {
+		return &t, nil
+	}
+	return nil, &kernel.Error{Code: kernel.ENotFound, Message: "tag not found"}
+}
+
+func (s stubTags) GetBySlug(slug shared.Slug) (*tag.Tag, error) {
+	return nil, &kernel.Error{Code: kernel.ENotFound, Message: "tag not found"}
+}
+
+func (s stubTags) GetAll() ([]tag.Tag, error) { return nil, nil }
+
+func assertNoError(t *testing.T, err error) {
+	t.Helper()
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+}
+
+func assertErrorCode(t *testing.T, err error, want string) {
+	t.Helper()
+	got := kernel.ErrorCode(err)
+	if got != want {
+		t.Errorf("error code: got %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,63 @@
+// Package author assembles public author profiles from accounts and published posts.
+package author
+
+import (
+	"time"
+
+	"github.com/alnah/fla/internal/domain/category"
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/post"
+	"github.com/alnah/fla/internal/domain/shared"
+	"github.com/alnah/fla/internal/domain/tag"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+const (
+	MaxTopCategories = 5
+	MaxTopTags       = 10
+	RecentPostsLimit = 5
+)
+
+// AuthorProfile is everything an author page shows, computed once for every renderer.
+type AuthorProfile struct {
+	AuthorID       kernel.ID[user.User]
+	Username       shared.Username
+	DisplayName    string // Full name when known, else the display name
+	Bio            shared.Description
+	PictureURL     kernel.URL[user.ProfilePicture]
+	SocialProfiles []user.SocialProfile    // In display order
+	Messengers     []user.MessengerContact // Shared for learners to reach out; the phone number stays private
+	MemberSince    time.Time
+
+	PublishedPosts int
+	TotalWords     int
+
+	TopCategories []CategoryUsage // Most used first, at most MaxTopCategories
+	TopTags       []TagUsage      // Most used first, at most MaxTopTags
+	RecentPosts   []RecentPost    // Newest first, at most RecentPostsLimit
+}
+
+// CategoryUsage counts an author's published posts filed in one category.
+type CategoryUsage struct {
+	CategoryID kernel.ID[category.Category]
+	Name       category.CategoryName
+	Posts      int
+}
+
+// TagUsage counts an author's published posts carrying one tag.
+type TagUsage struct {
+	TagID kernel.ID[tag.Tag]
+	Name  tag.TagName
+	Slug  shared.Slug
+	Posts int
+}
+
+// RecentPost is a post teaser for the author page.
+type RecentPost struct {
+	PostID             kernel.ID[post.Post]
+	Title              shared.Title
+	Slug               shared.Slug
+	Excerpt            string
+	PublishedAt        time.Time
+	ReadingTimeMinutes int
+}
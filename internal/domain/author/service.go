@@ -0,0 +1,182 @@
+package author
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/alnah/fla/internal/domain/category"
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/post"
+	"github.com/alnah/fla/internal/domain/shared"
+	"github.com/alnah/fla/internal/domain/tag"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+const MAuthorNotFound string = "Author not found."
+
+// UserReader loads the account behind an author page.
+type UserReader interface {
+	// GetUserByID retrieves a user; returns ENotFound for deleted accounts.
+	GetUserByID(userID kernel.ID[user.User]) (*user.User, error)
+}
+
+// ProfileService builds public author profiles.
+type ProfileService struct {
+	users UserReader
+	posts post.PostFinder
+	tags  tag.TagReader
+}
+
+// NewProfileService creates profile service with account, post, and tag sources.
+func NewProfileService(users UserReader, posts post.PostFinder, tags tag.TagReader) *ProfileService {
+	return &ProfileService{
+		users: users,
+		posts: posts,
+		tags:  tags,
+	}
+}
+
+// Profile assembles an author's public profile from their published posts.
+// Returns ENotFound for accounts that are not active authors, editors, or admins,
+// so subscriber accounts cannot be looked up through author pages.
+func (s *ProfileService) Profile(authorID kernel.ID[user.User]) (AuthorProfile, error) {
+	const op = "ProfileService.Profile"
+
+	u, err := s.users.GetUserByID(authorID)
+	if err != nil {
+		return AuthorProfile{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if !u.IsActive() || !u.HasAnyRole(user.RoleAdmin, user.RoleEditor, user.RoleAuthor) {
+		return AuthorProfile{}, &kernel.Error{Code: kernel.ENotFound, Message: MAuthorNotFound, Operation: op}
+	}
+
+	posts, err := s.publishedPosts(authorID)
+	if err != nil {
+		return AuthorProfile{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	topTags, err := s.topTags(posts)
+	if err != nil {
+		return AuthorProfile{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	profile := AuthorProfile{
+		AuthorID:       u.ID,
+		Username:       u.Username,
+		DisplayName:    displayName(*u),
+		Bio:            u.Description,
+		PictureURL:     u.PictureURL,
+		SocialProfiles: user.SortSocialProfiles(u.SocialProfiles),
+		Messengers:     slices.Clone(u.Messengers),
+		MemberSince:    u.CreatedAt,
+		PublishedPosts: len(posts),
+		TopCategories:  topCategories(posts),
+		TopTags:        topTags,
+		RecentPosts:    recentPosts(posts),
+	}
+	for _, p := range posts {
+		profile.TotalWords += p.WordCount()
+	}
+
+	return profile, nil
+}
+
+// publishedPosts pages through every published post of the author, newest first.
+func (s *ProfileService) publishedPosts(authorID kernel.ID[user.User]) ([]post.Post, error) {
+	const op = "ProfileService.publishedPosts"
+
+	var posts []post.Post
+	for page := 1; ; page++ {
+		list, err := s.posts.Find(post.PublishedQuery().OwnedBy(authorID).Page(page, shared.MaxPageLimit))
+		if err != nil {
+			return nil, &kernel.Error{Operation: op, Cause: err}
+		}
+		posts = append(posts, list.Posts...)
+		if list.IsEmpty() || !list.Pagination.HasNextPage() {
+			return posts, nil
+		}
+	}
+}
+
+// topTags counts tag usage and resolves names; tags deleted since are skipped.
+func (s *ProfileService) topTags(posts []post.Post) ([]TagUsage, error) {
+	const op = "ProfileService.topTags"
+
+	counts := make(map[kernel.ID[tag.Tag]]int)
+	for _, p := range posts {
+		for _, id := range p.Tags {
+			counts[id]++
+		}
+	}
+
+	usages := make([]TagUsage, 0, len(counts))
+	for id, n := range counts {
+		t, err := s.tags.GetByID(id)
+		if kernel.ErrorCode(err) == kernel.ENotFound {
+			continue
+		}
+		if err != nil {
+			return nil, &kernel.Error{Operation: op, Cause: err}
+		}
+		usages = append(usages, TagUsage{TagID: id, Name: t.Name, Slug: t.Slug, Posts: n})
+	}
+
+	slices.SortFunc(usages, func(a, b TagUsage) int {
+		return cmp.Or(cmp.Compare(b.Posts, a.Posts), cmp.Compare(a.Name, b.Name))
+	})
+
+	return usages[:min(len(usages), MaxTopTags)], nil
+}
+
+func topCategories(posts []post.Post) []CategoryUsage {
+	byID := make(map[kernel.ID[category.Category]]*CategoryUsage)
+	for _, p := range posts {
+		c := p.Category
+		if byID[c.CategoryID] == nil {
+			byID[c.CategoryID] = &CategoryUsage{CategoryID: c.CategoryID, Name: c.Name}
+		}
+		byID[c.CategoryID].Posts++
+	}
+
+	usages := make([]CategoryUsage, 0, len(byID))
+	for _, u := range byID {
+		usages = append(usages, *u)
+	}
+
+	slices.SortFunc(usages, func(a, b CategoryUsage) int {
+		return cmp.Or(cmp.Compare(b.Posts, a.Posts), cmp.Compare(a.Name, b.Name))
+	})
+
+	return usages[:min(len(usages), MaxTopCategories)]
+}
+
+func recentPosts(posts []post.Post) []RecentPost {
+	sorted := slices.Clone(posts)
+	slices.SortFunc(sorted, post.NewQuery().SortBy(post.SortNewest...).Compare)
+
+	recent := make([]RecentPost, 0, min(len(sorted), RecentPostsLimit))
+	for _, p := range sorted[:min(len(sorted), RecentPostsLimit)] {
+		r := RecentPost{
+			PostID:             p.PostID,
+			Title:              p.Title,
+			Slug:               p.Slug,
+			Excerpt:            p.GetEffectiveExcerpt(),
+			ReadingTimeMinutes: p.EstimatedReadingTime(),
+		}
+		if p.PublishedAt != nil {
+			r.PublishedAt = *p.PublishedAt
+		}
+		recent = append(recent, r)
+	}
+
+	return recent
+}
+
+// displayName prefers the full name on a public profile.
+func displayName(u user.User) string {
+	if name := u.GetFullName(); name != "" {
+		return name
+	}
+	return u.GetDisplayName()
+}
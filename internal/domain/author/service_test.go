@@ -0,0 +1,126 @@
+package author_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/author"
+	"github.com/alnah/fla/internal/domain/category"
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/post"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+func TestProfileService_Profile(t *testing.T) {
+	users := stubUsers{
+		"marie":   {ID: "marie", Username: "marie", FirstName: "Marie", LastName: "Dupont", Description: "Professeure de FLE à Lyon.", Roles: []user.Role{user.RoleAuthor}},
+		"learner": {ID: "learner", Username: "learner", Roles: []user.Role{user.RoleSubscriber}},
+		"gone":    {ID: "gone", Username: "gone", Roles: []user.Role{user.RoleAuthor}, Status: user.AccountStatusDeactivated},
+	}
+	tags := stubTags{
+		"grammar":    {TagID: "grammar", Name: "grammaire", Slug: "grammaire"},
+		"vocabulary": {TagID: "vocabulary", Name: "vocabulaire", Slug: "vocabulaire"},
+	}
+	sports := category.Category{CategoryID: "sports", Name: "Sports"}
+	food := category.Category{CategoryID: "food", Name: "Cuisine"}
+
+	var posts []post.Post
+	for i := 1; i <= 7; i++ {
+		published := time.Date(2024, 3, i, 9, 0, 0, 0, time.UTC)
+		p := post.Post{
+			PostID:      kernel.ID[post.Post](fmt.Sprintf("p%d", i)),
+			Owner:       "marie",
+			Title:       "Une leçon de français",
+			Content:     post.PostContent(strings.Repeat("mot ", 10)),
+			Status:      post.StatusPublished,
+			PublishedAt: &published,
+			Category:    sports,
+			Tags:        post.PostTags{"grammar"},
+		}
+		if i%3 == 0 {
+			p.Category = food
+			p.Tags = post.PostTags{"vocabulary", "deleted-tag"}
+		}
+		posts = append(posts, p)
+	}
+	posts = append(posts,
+		post.Post{PostID: "draft", Owner: "marie", Status: post.StatusDraft, Content: "brouillon", Category: food},
+		post.Post{PostID: "other", Owner: "paul", Status: post.StatusPublished, Content: "autre", Category: food},
+	)
+
+	service := author.NewProfileService(users, &stubPosts{posts: posts}, tags)
+
+	t.Run("aggregates published posts", func(t *testing.T) {
+		got, err := service.Profile("marie")
+
+		assertNoError(t, err)
+		if got.DisplayName != "Marie Dupont" || got.Bio != "Professeure de FLE à Lyon." {
+			t.Errorf("identity: got %q, %q", got.DisplayName, got.Bio)
+		}
+		if got.PublishedPosts != 7 || got.TotalWords != 70 {
+			t.Errorf("totals: got %d posts, %d words", got.PublishedPosts, got.TotalWords)
+		}
+		if len(got.TopCategories) != 2 || got.TopCategories[0].CategoryID != "sports" || got.TopCategories[0].Posts != 5 {
+			t.Errorf("categories: got %+v", got.TopCategories)
+		}
+		if len(got.TopTags) != 2 || got.TopTags[0].Name != "grammaire" || got.TopTags[1].Posts != 2 {
+			t.Errorf("tags: got %+v", got.TopTags)
+		}
+	})
+
+	t.Run("lists recent posts newest first", func(t *testing.T) {
+		got, err := service.Profile("marie")
+
+		assertNoError(t, err)
+		if len(got.RecentPosts) != author.RecentPostsLimit || got.RecentPosts[0].PostID != "p7" {
+			t.Errorf("got %+v", got.RecentPosts)
+		}
+		if got.RecentPosts[0].Excerpt == "" || got.RecentPosts[0].ReadingTimeMinutes != 1 {
+			t.Errorf("teaser: got %+v", got.RecentPosts[0])
+		}
+	})
+
+	tests := []struct {
+		name     string
+		authorID kernel.ID[user.User]
+	}{
+		{"subscriber has no author page", "learner"},
+		{"deactivated author", "gone"},
+		{"unknown user", "ghost"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := service.Profile(tt.authorID)
+
+			assertErrorCode(t, err, kernel.ENotFound)
+		})
+	}
+}
+
+func TestProfileService_Profile_Pages(t *testing.T) {
+	users := stubUsers{"marie": {ID: "marie", Username: "marie", Roles: []user.Role{user.RoleAuthor}}}
+	published := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+
+	var posts []post.Post
+	for i := range 150 {
+		posts = append(posts, post.Post{
+			PostID:      kernel.ID[post.Post](fmt.Sprintf("p%03d", i)),
+			Owner:       "marie",
+			Status:      post.StatusPublished,
+			PublishedAt: &published,
+			Content:     "un mot",
+		})
+	}
+	source := &stubPosts{posts: posts}
+	service := author.NewProfileService(users, source, stubTags{})
+
+	got, err := service.Profile("marie")
+
+	assertNoError(t, err)
+	if got.PublishedPosts != 150 || source.queries != 2 {
+		t.Errorf("got %d posts in %d queries", got.PublishedPosts, source.queries)
+	}
+}
@@ -0,0 +1,231 @@
+// Package automation sends email sequences on a schedule, such as a welcome
+// series for new subscribers: a first email right away, the next three days
+// later, and so on.
+package automation
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/subscription"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+const (
+	MaxSequenceSteps   int           = 20
+	MaxStepDelay       time.Duration = 90 * 24 * time.Hour
+	MaxStepSubject     int           = 150
+	MinSequenceNameLen int           = 3
+	MaxSequenceNameLen int           = 100
+)
+
+const (
+	MTriggerInvalid          string = "Unknown sequence trigger %q."
+	MSequenceStepsMissed     string = "A sequence needs at least one email."
+	MSequenceTooLong         string = "A sequence can have at most %d emails."
+	MStepDelayInvalid        string = "Email %d must wait between 0 and %d days."
+	MEnrollmentStatusInvalid string = "Invalid enrollment status: %q."
+	MEnrollmentPosition      string = "Enrollment position is outside its sequence."
+)
+
+// Trigger is the event that enrolls subscribers in a sequence.
+type Trigger string
+
+const (
+	TriggerSubscribed Trigger = "subscribed" // A new subscription, e.g. a welcome series
+)
+
+// String returns the trigger name.
+func (t Trigger) String() string { return string(t) }
+
+// Validate ensures the trigger is known.
+func (t Trigger) Validate() error {
+	const op = "Trigger.Validate"
+
+	switch t {
+	case TriggerSubscribed:
+		return nil
+	default:
+		return &kernel.Error{Code: kernel.EInvalid, Message: fmt.Sprintf(MTriggerInvalid, t), Operation: op}
+	}
+}
+
+// Step is one email of a sequence.
+type Step struct {
+	Delay   time.Duration // Wait after the previous email, or after enrollment for the first
+	Subject string
+	Body    string // Markdown; the mailer renders it for the subscriber
+}
+
+// Sequence is an ordered series of emails sent to subscribers enrolled by its
+// trigger. Only active sequences enroll subscribers and send emails.
+type Sequence struct {
+	SequenceID kernel.ID[Sequence]
+	Name       string // For admins, e.g. "Welcome series"
+	Trigger    Trigger
+	Steps      []Step
+	Active     bool
+	CreatedBy  kernel.ID[user.User]
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// NewSequenceParams holds the data for a new sequence.
+type NewSequenceParams struct {
+	SequenceID kernel.ID[Sequence]
+	Name       string
+	Trigger    Trigger
+	Steps      []Step
+	CreatedBy  kernel.ID[user.User]
+	Clock      kernel.Clock
+}
+
+// NewSequence creates a validated, inactive sequence, so admins can review it
+// before subscribers are enrolled.
+func NewSequence(params NewSequenceParams) (Sequence, error) {
+	const op = "NewSequence"
+
+	now := params.Clock.Now()
+	s := Sequence{
+		SequenceID: params.SequenceID,
+		Name:       params.Name,
+		Trigger:    params.Trigger,
+		Steps:      append([]Step(nil), params.Steps...),
+		CreatedBy:  params.CreatedBy,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+
+	if err := s.Validate(); err != nil {
+		return Sequence{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return s, nil
+}
+
+// Validate ensures the sequence has a trigger and a sendable series of emails.
+func (s Sequence) Validate() error {
+	const op = "Sequence.Validate"
+
+	if err := s.SequenceID.Validate(); err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if err := kernel.ValidateLength("sequence name", s.Name, MinSequenceNameLen, MaxSequenceNameLen, op); err != nil {
+		return err
+	}
+
+	if err := s.Trigger.Validate(); err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if len(s.Steps) == 0 {
+		return &kernel.Error{Code: kernel.EInvalid, Message: MSequenceStepsMissed, Operation: op}
+	}
+	if len(s.Steps) > MaxSequenceSteps {
+		return &kernel.Error{Code: kernel.EInvalid, Message: fmt.Sprintf(MSequenceTooLong, MaxSequenceSteps), Operation: op}
+	}
+
+	for i, step := range s.Steps {
+		if step.Delay < 0 || step.Delay > MaxStepDelay {
+			return &kernel.Error{
+				Code:      kernel.EInvalid,
+				Message:   fmt.Sprintf(MStepDelayInvalid, i+1, int(MaxStepDelay.Hours()/24)),
+				Operation: op,
+			}
+		}
+		if err := kernel.ValidateLength("email subject", step.Subject, 1, MaxStepSubject, op); err != nil {
+			return err
+		}
+		if err := kernel.ValidatePresence("email body", step.Body, op); err != nil {
+			return err
+		}
+	}
+
+	if err := s.CreatedBy.Validate(); err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return nil
+}
+
+// String returns a string representation of the sequence.
+func (s Sequence) String() string {
+	return fmt.Sprintf("Sequence{SequenceID: %s, Name: %q, Steps: %d, Active: %t}", s.SequenceID, s.Name, len(s.Steps), s.Active)
+}
+
+// EnrollmentStatus tracks a subscriber through a sequence.
+type EnrollmentStatus string
+
+const (
+	EnrollmentActive    EnrollmentStatus = "active"    // Waiting for the next email
+	EnrollmentPaused    EnrollmentStatus = "paused"    // Unsubscribed or bounced; resumes on resubscribe
+	EnrollmentCompleted EnrollmentStatus = "completed" // Every email was sent
+)
+
+// String returns the status name.
+func (s EnrollmentStatus) String() string { return string(s) }
+
+// Validate ensures the status is known.
+func (s EnrollmentStatus) Validate() error {
+	const op = "EnrollmentStatus.Validate"
+
+	switch s {
+	case EnrollmentActive, EnrollmentPaused, EnrollmentCompleted:
+		return nil
+	default:
+		return &kernel.Error{Code: kernel.EInvalid, Message: fmt.Sprintf(MEnrollmentStatusInvalid, s), Operation: op}
+	}
+}
+
+// Enrollment is a subscriber's position in a sequence. A subscriber is
+// enrolled at most once per sequence, so resubscribing never restarts it.
+type Enrollment struct {
+	SequenceID     kernel.ID[Sequence]
+	SubscriptionID kernel.ID[subscription.Subscription]
+	Position       int // Index of the next email to send
+	Status         EnrollmentStatus
+	NextSendAt     time.Time // When the next email is due; meaningful while active
+	EnrolledAt     time.Time
+	UpdatedAt      time.Time
+}
+
+// Validate ensures the enrollment points into a sequence.
+func (e Enrollment) Validate() error {
+	const op = "Enrollment.Validate"
+
+	if err := e.SequenceID.Validate(); err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if err := e.SubscriptionID.Validate(); err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if err := e.Status.Validate(); err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if e.Position < 0 || e.Position > MaxSequenceSteps {
+		return &kernel.Error{Code: kernel.EInvalid, Message: MEnrollmentPosition, Operation: op}
+	}
+
+	return nil
+}
+
+// IsDue reports whether the next email should be sent at now.
+func (e Enrollment) IsDue(now time.Time) bool {
+	return e.Status == EnrollmentActive && !now.Before(e.NextSendAt)
+}
+
+// String returns a string representation of the enrollment.
+func (e Enrollment) String() string {
+	return fmt.Sprintf("Enrollment{SequenceID: %s, SubscriptionID: %s, Position: %d, Status: %s}", e.SequenceID, e.SubscriptionID, e.Position, e.Status)
+}
+
+// Mailer sends one email of a sequence to a subscriber.
+// Implemented by email adapters rendering the step in the subscriber's locale.
+type Mailer interface {
+	SendStep(sub subscription.Subscription, sequence Sequence, position int) error
+}
@@ -0,0 +1,77 @@
+package automation_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/automation"
+	"github.com/alnah/fla/internal/domain/kernel"
+)
+
+func welcomeSteps() []automation.Step {
+	return []automation.Step{
+		{Subject: "Bienvenue !", Body: "Merci de votre inscription."},
+		{Delay: 3 * 24 * time.Hour, Subject: "Par où commencer ?", Body: "Nos leçons A1 pour débuter."},
+		{Delay: 4 * 24 * time.Hour, Subject: "Votre première semaine", Body: "Les leçons les plus lues."},
+	}
+}
+
+func TestNewSequence(t *testing.T) {
+	clock := &stubClock{t: time.Date(2024, 9, 2, 8, 0, 0, 0, time.UTC)}
+	valid := func() automation.NewSequenceParams {
+		return automation.NewSequenceParams{
+			SequenceID: "welcome",
+			Name:       "Welcome series",
+			Trigger:    automation.TriggerSubscribed,
+			Steps:      welcomeSteps(),
+			CreatedBy:  "admin-1",
+			Clock:      clock,
+		}
+	}
+
+	got, err := automation.NewSequence(valid())
+	assertNoError(t, err)
+	if got.Active || !got.CreatedAt.Equal(clock.t) || len(got.Steps) != 3 {
+		t.Errorf("got %s created at %s", got, got.CreatedAt)
+	}
+
+	tests := []struct {
+		name   string
+		modify func(p *automation.NewSequenceParams)
+	}{
+		{"unknown trigger", func(p *automation.NewSequenceParams) { p.Trigger = "purchased" }},
+		{"short name", func(p *automation.NewSequenceParams) { p.Name = "W" }},
+		{"no steps", func(p *automation.NewSequenceParams) { p.Steps = nil }},
+		{"too many steps", func(p *automation.NewSequenceParams) {
+			p.Steps = make([]automation.Step, automation.MaxSequenceSteps+1)
+			for i := range p.Steps {
+				p.Steps[i] = automation.Step{Subject: "Leçon", Body: "Texte"}
+			}
+		}},
+		{"negative delay", func(p *automation.NewSequenceParams) { p.Steps[1].Delay = -time.Hour }},
+		{"delay too long", func(p *automation.NewSequenceParams) { p.Steps[1].Delay = automation.MaxStepDelay + time.Hour }},
+		{"missing subject", func(p *automation.NewSequenceParams) { p.Steps[0].Subject = "" }},
+		{"missing body", func(p *automation.NewSequenceParams) { p.Steps[2].Body = " " }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params := valid()
+			tt.modify(&params)
+			_, err := automation.NewSequence(params)
+			assertErrorCode(t, err, kernel.EInvalid)
+		})
+	}
+}
+
+func TestEnrollment_IsDue(t *testing.T) {
+	now := time.Date(2024, 9, 2, 8, 0, 0, 0, time.UTC)
+	e := automation.Enrollment{Status: automation.EnrollmentActive, NextSendAt: now}
+
+	if !e.IsDue(now) || e.IsDue(now.Add(-time.Second)) {
+		t.Error("expected due from NextSendAt on")
+	}
+	e.Status = automation.EnrollmentPaused
+	if e.IsDue(now) {
+		t.Error("paused enrollments are never due")
+	}
+}
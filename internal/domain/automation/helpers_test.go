@@ -0,0 +1,173 @@
+package automation_test
+
+import (
+	"cmp"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/automation"
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/shared"
+	"github.com/alnah/fla/internal/domain/subscription"
+)
+
+type stubClock struct {
+	t time.Time
+}
+
+func (s *stubClock) Now() time.Time { return s.t }
+
+func assertNoError(t *testing.T, err error) {
+	t.Helper()
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+}
+
+func assertErrorCode(t *testing.T, err error, want string) {
+	t.Helper()
+	got := kernel.ErrorCode(err)
+	if got != want {
+		t.Errorf("error code: got %q, want %q", got, want)
+	}
+}
+
+// stubStore keeps sequences, enrollments, and subscriptions in maps.
+type stubStore struct {
+	sequences     map[kernel.ID[automation.Sequence]]automation.Sequence
+	enrollments   map[string]automation.Enrollment
+	subscriptions map[kernel.ID[subscription.Subscription]]subscription.Subscription
+}
+
+func newStubStore() *stubStore {
+	return &stubStore{
+		sequences:     map[kernel.ID[automation.Sequence]]automation.Sequence{},
+		enrollments:   map[string]automation.Enrollment{},
+		subscriptions: map[kernel.ID[subscription.Subscription]]subscription.Subscription{},
+	}
+}
+
+func (s *stubStore) GetSequence(id kernel.ID[automation.Sequence]) (*automation.Sequence, error) {
+	seq, ok := s.sequences[id]
+	if !ok {
+		return nil, &kernel.Error{Code: kernel.ENotFound, Message: "no sequence"}
+	}
+	return &seq, nil
+}
+
+func (s *stubStore) GetSequencesByTrigger(trigger automation.Trigger) ([]automation.Sequence, error) {
+	var out []automation.Sequence
+	for _, seq := range s.sequences {
+		if seq.Trigger == trigger {
+			out = append(out, seq)
+		}
+	}
+	return out, nil
+}
+
+func (s *stubStore) SaveSequence(seq automation.Sequence) error {
+	s.sequences[seq.SequenceID] = seq
+	return nil
+}
+
+func enrollmentKey(seq kernel.ID[automation.Sequence], sub kernel.ID[subscription.Subscription]) string {
+	return seq.String() + "/" + sub.String()
+}
+
+func (s *stubStore) GetEnrollment(seq kernel.ID[automation.Sequence], sub kernel.ID[subscription.Subscription]) (*automation.Enrollment, error) {
+	e, ok := s.enrollments[enrollmentKey(seq, sub)]
+	if !ok {
+		return nil, &kernel.Error{Code: kernel.ENotFound, Message: "no enrollment"}
+	}
+	return &e, nil
+}
+
+func (s *stubStore) GetEnrollments(sub kernel.ID[subscription.Subscription]) ([]automation.Enrollment, error) {
+	var out []automation.Enrollment
+	for _, e := range s.enrollments {
+		if e.SubscriptionID == sub {
+			out = append(out, e)
+		}
+	}
+	return out, nil
+}
+
+func (s *stubStore) GetDueEnrollments(now time.Time) ([]automation.Enrollment, error) {
+	var out []automation.Enrollment
+	for _, e := range s.enrollments {
+		if e.IsDue(now) {
+			out = append(out, e)
+		}
+	}
+	slices.SortFunc(out, func(a, b automation.Enrollment) int {
+		return cmp.Or(a.NextSendAt.Compare(b.NextSendAt), cmp.Compare(a.SubscriptionID, b.SubscriptionID))
+	})
+	return out, nil
+}
+
+func (s *stubStore) SaveEnrollment(e automation.Enrollment) error {
+	s.enrollments[enrollmentKey(e.SequenceID, e.SubscriptionID)] = e
+	return nil
+}
+
+func (s *stubStore) GetByID(id kernel.ID[subscription.Subscription]) (*subscription.Subscription, error) {
+	sub, ok := s.subscriptions[id]
+	if !ok {
+		return nil, &kernel.Error{Code: kernel.ENotFound, Message: subscription.MSubscriptionNotFound}
+	}
+	return &sub, nil
+}
+
+func (s *stubStore) GetByEmail(email shared.Email) (*subscription.Subscription, error) {
+	for _, sub := range s.subscriptions {
+		if sub.Email == email {
+			return &sub, nil
+		}
+	}
+	return nil, &kernel.Error{Code: kernel.ENotFound, Message: subscription.MSubscriptionNotFound}
+}
+
+// stubMailer records sent steps; sends to failTo fail.
+type stubMailer struct {
+	sent   []string // "subscription:position"
+	failTo kernel.ID[subscription.Subscription]
+}
+
+func (m *stubMailer) SendStep(sub subscription.Subscription, seq automation.Sequence, position int) error {
+	if sub.SubscriptionID == m.failTo {
+		return errors.New("mailbox unavailable")
+	}
+	m.sent = append(m.sent, sub.SubscriptionID.String()+":"+seq.Steps[position].Subject)
+	return nil
+}
+
+type stubIdempotencyStore struct {
+	records map[string]kernel.IdempotencyRecord
+}
+
+func newStubIdempotencyStore() *stubIdempotencyStore {
+	return &stubIdempotencyStore{records: map[string]kernel.IdempotencyRecord{}}
+}
+
+func (s *stubIdempotencyStore) GetRecord(scope string, key kernel.IdempotencyKey) (*kernel.IdempotencyRecord, error) {
+	r, ok := s.records[scope+"/"+key.String()]
+	if !ok {
+		return nil, &kernel.Error{Code: kernel.ENotFound, Message: "no record"}
+	}
+	return &r, nil
+}
+
+func (s *stubIdempotencyStore) ReserveRecord(r kernel.IdempotencyRecord) (*kernel.IdempotencyRecord, error) {
+	if existing, ok := s.records[r.Scope+"/"+r.Key.String()]; ok && !existing.IsExpired(r.CreatedAt) {
+		return &existing, nil
+	}
+	s.records[r.Scope+"/"+r.Key.String()] = r
+	return nil, nil
+}
+
+func (s *stubIdempotencyStore) SaveRecord(r kernel.IdempotencyRecord) error {
+	s.records[r.Scope+"/"+r.Key.String()] = r
+	return nil
+}
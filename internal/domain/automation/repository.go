@@ -0,0 +1,55 @@
+package automation
+
+import (
+	"time"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/subscription"
+)
+
+// SequenceReader retrieves sequences.
+type SequenceReader interface {
+	// GetSequence returns a sequence. Returns ENotFound when missing.
+	GetSequence(sequenceID kernel.ID[Sequence]) (*Sequence, error)
+
+	// GetSequencesByTrigger lists the sequences a trigger enrolls in, active or not.
+	GetSequencesByTrigger(trigger Trigger) ([]Sequence, error)
+}
+
+// SequenceWriter persists sequences.
+type SequenceWriter interface {
+	// SaveSequence stores a sequence, replacing any previous one with its ID.
+	SaveSequence(sequence Sequence) error
+}
+
+// SequenceRepository combines sequence persistence and retrieval.
+// Most concrete implementations (like PostgresSequenceRepository) will implement this.
+type SequenceRepository interface {
+	SequenceReader
+	SequenceWriter
+}
+
+// EnrollmentReader retrieves enrollments.
+type EnrollmentReader interface {
+	// GetEnrollment returns a subscriber's enrollment in a sequence. Returns ENotFound when missing.
+	GetEnrollment(sequenceID kernel.ID[Sequence], subscriptionID kernel.ID[subscription.Subscription]) (*Enrollment, error)
+
+	// GetEnrollments lists a subscriber's enrollments in every sequence.
+	GetEnrollments(subscriptionID kernel.ID[subscription.Subscription]) ([]Enrollment, error)
+
+	// GetDueEnrollments lists active enrollments whose next email is due at or before now, oldest first.
+	GetDueEnrollments(now time.Time) ([]Enrollment, error)
+}
+
+// EnrollmentWriter persists enrollments.
+type EnrollmentWriter interface {
+	// SaveEnrollment stores an enrollment, replacing any previous one for the sequence and subscriber.
+	SaveEnrollment(enrollment Enrollment) error
+}
+
+// EnrollmentRepository combines enrollment persistence and retrieval.
+// Most concrete implementations (like PostgresEnrollmentRepository) will implement this.
+type EnrollmentRepository interface {
+	EnrollmentReader
+	EnrollmentWriter
+}
@@ -0,0 +1,352 @@
+package automation
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/subscription"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+// ScopeStep is the idempotency scope of sequence emails, one key per
+// subscriber, sequence, and step.
+const ScopeStep string = "automation.step"
+
+const MSequenceForbidden string = "Only admins can manage email sequences."
+
+// StepFailure records an email the mailer could not send; it is retried on
+// the next run.
+type StepFailure struct {
+	SequenceID     kernel.ID[Sequence]
+	SubscriptionID kernel.ID[subscription.Subscription]
+	Position       int
+	Err            error
+}
+
+// Run reports one pass over due enrollments.
+type Run struct {
+	Sent      int
+	Paused    int // Subscribers who stopped receiving emails since their last step
+	Completed int
+	Waiting   int // Due in an inactive sequence; sent once it is reactivated
+	Failed    []StepFailure
+}
+
+// AutomationService lets admins define sequences and runs them: it enrolls
+// subscribers when a trigger fires, sends each email when due, and pauses
+// subscribers who can no longer receive emails.
+type AutomationService struct {
+	sequences     SequenceRepository
+	enrollments   EnrollmentRepository
+	subscriptions subscription.SubscriptionReader
+	mailer        Mailer
+	sent          kernel.IdempotencyStore
+	clock         kernel.Clock
+}
+
+// NewAutomationService creates automation service with sequence and enrollment
+// storage, subscriber lookups, and delivery. The idempotency store keeps a
+// retried run from sending a step twice.
+func NewAutomationService(
+	sequences SequenceRepository,
+	enrollments EnrollmentRepository,
+	subscriptions subscription.SubscriptionReader,
+	mailer Mailer,
+	sent kernel.IdempotencyStore,
+	clock kernel.Clock,
+) *AutomationService {
+	return &AutomationService{
+		sequences:     sequences,
+		enrollments:   enrollments,
+		subscriptions: subscriptions,
+		mailer:        mailer,
+		sent:          sent,
+		clock:         clock,
+	}
+}
+
+// Create stores a new, inactive sequence authored by the actor.
+func (s *AutomationService) Create(params NewSequenceParams, actor user.PostPermissionChecker) (Sequence, error) {
+	const op = "AutomationService.Create"
+
+	if !user.IsActiveAdmin(actor) {
+		return Sequence{}, &kernel.Error{Code: kernel.EForbidden, Message: MSequenceForbidden, Operation: op}
+	}
+
+	params.CreatedBy = actor.GetID()
+	params.Clock = s.clock
+	sequence, err := NewSequence(params)
+	if err != nil {
+		return Sequence{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if err := s.sequences.SaveSequence(sequence); err != nil {
+		return Sequence{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return sequence, nil
+}
+
+// SetActive starts or stops a sequence. Stopping it keeps every subscriber's
+// position; their due emails wait until it is active again.
+func (s *AutomationService) SetActive(sequenceID kernel.ID[Sequence], active bool, actor user.PostPermissionChecker) (Sequence, error) {
+	const op = "AutomationService.SetActive"
+
+	sequence, err := s.update(sequenceID, actor, func(sequence *Sequence) {
+		sequence.Active = active
+	})
+	if err != nil {
+		return Sequence{}, &kernel.Error{Operation: op, Cause: err}
+	}
+	return sequence, nil
+}
+
+// EditSteps replaces the emails of a sequence. Enrolled subscribers keep
+// their position: one who received two emails gets the new third one next,
+// and one past the new last email completes on the next run.
+func (s *AutomationService) EditSteps(sequenceID kernel.ID[Sequence], steps []Step, actor user.PostPermissionChecker) (Sequence, error) {
+	const op = "AutomationService.EditSteps"
+
+	sequence, err := s.update(sequenceID, actor, func(sequence *Sequence) {
+		sequence.Steps = slices.Clone(steps)
+	})
+	if err != nil {
+		return Sequence{}, &kernel.Error{Operation: op, Cause: err}
+	}
+	return sequence, nil
+}
+
+// HandleSubscribed enrolls a new subscriber in every active sequence
+// triggered by subscriptions. Sequences they were enrolled in before, such as
+// a welcome series before an unsubscribe, are not restarted.
+func (s *AutomationService) HandleSubscribed(sub subscription.Subscription) ([]Enrollment, error) {
+	const op = "AutomationService.HandleSubscribed"
+
+	if !sub.CanReceiveEmails() {
+		return nil, nil
+	}
+
+	sequences, err := s.sequences.GetSequencesByTrigger(TriggerSubscribed)
+	if err != nil {
+		return nil, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	now := s.clock.Now()
+	var enrolled []Enrollment
+	for _, sequence := range sequences {
+		if !sequence.Active {
+			continue
+		}
+
+		_, err := s.enrollments.GetEnrollment(sequence.SequenceID, sub.SubscriptionID)
+		if err == nil {
+			continue
+		}
+		if kernel.ErrorCode(err) != kernel.ENotFound {
+			return enrolled, &kernel.Error{Operation: op, Cause: err}
+		}
+
+		enrollment := Enrollment{
+			SequenceID:     sequence.SequenceID,
+			SubscriptionID: sub.SubscriptionID,
+			Status:         EnrollmentActive,
+			NextSendAt:     now.Add(sequence.Steps[0].Delay),
+			EnrolledAt:     now,
+			UpdatedAt:      now,
+		}
+		if err := s.enrollments.SaveEnrollment(enrollment); err != nil {
+			return enrolled, &kernel.Error{Operation: op, Cause: err}
+		}
+		enrolled = append(enrolled, enrollment)
+	}
+
+	return enrolled, nil
+}
+
+// RunDue sends every email whose time has come and schedules the next one,
+// counted from now. Subscribers who unsubscribed or bounced since are paused
+// instead. A failed send is recorded and retried on the next run; only
+// repository failures abort the run. Meant to run periodically, like the
+// scheduler.
+func (s *AutomationService) RunDue() (Run, error) {
+	const op = "AutomationService.RunDue"
+
+	due, err := s.enrollments.GetDueEnrollments(s.clock.Now())
+	if err != nil {
+		return Run{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	var run Run
+	sequences := make(map[kernel.ID[Sequence]]*Sequence)
+	for _, enrollment := range due {
+		sequence, ok := sequences[enrollment.SequenceID]
+		if !ok {
+			if sequence, err = s.sequences.GetSequence(enrollment.SequenceID); err != nil {
+				return run, &kernel.Error{Operation: op, Cause: err}
+			}
+			sequences[enrollment.SequenceID] = sequence
+		}
+
+		if err := s.step(&run, *sequence, enrollment); err != nil {
+			return run, &kernel.Error{Operation: op, Cause: err}
+		}
+	}
+
+	return run, nil
+}
+
+// PauseSubscriber pauses a subscriber's active enrollments, e.g. right after
+// an unsubscribe or a bounce, and returns how many were paused. RunDue pauses
+// them anyway when their next email is due.
+func (s *AutomationService) PauseSubscriber(subscriptionID kernel.ID[subscription.Subscription]) (int, error) {
+	const op = "AutomationService.PauseSubscriber"
+
+	n, err := s.setStatus(subscriptionID, EnrollmentActive, EnrollmentPaused)
+	if err != nil {
+		return n, &kernel.Error{Operation: op, Cause: err}
+	}
+	return n, nil
+}
+
+// ResumeSubscriber resumes a resubscribed subscriber's paused enrollments
+// where they stopped, and returns how many were resumed. An email that came
+// due during the pause is sent on the next run.
+func (s *AutomationService) ResumeSubscriber(subscriptionID kernel.ID[subscription.Subscription]) (int, error) {
+	const op = "AutomationService.ResumeSubscriber"
+
+	sub, err := s.subscriptions.GetByID(subscriptionID)
+	if err != nil {
+		return 0, &kernel.Error{Operation: op, Cause: err}
+	}
+	if !sub.CanReceiveEmails() {
+		return 0, nil
+	}
+
+	n, err := s.setStatus(subscriptionID, EnrollmentPaused, EnrollmentActive)
+	if err != nil {
+		return n, &kernel.Error{Operation: op, Cause: err}
+	}
+	return n, nil
+}
+
+// step sends an enrollment's due email, or pauses or completes it.
+func (s *AutomationService) step(run *Run, sequence Sequence, enrollment Enrollment) error {
+	const op = "AutomationService.step"
+
+	if !sequence.Active {
+		run.Waiting++
+		return nil
+	}
+
+	now := s.clock.Now()
+	if enrollment.Position >= len(sequence.Steps) {
+		run.Completed++
+		return s.save(enrollment, EnrollmentCompleted, now)
+	}
+
+	sub, err := s.subscriptions.GetByID(enrollment.SubscriptionID)
+	if kernel.ErrorCode(err) == kernel.ENotFound {
+		run.Completed++ // Subscriber deleted; nothing left to send
+		return s.save(enrollment, EnrollmentCompleted, now)
+	}
+	if err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+	if !sub.CanReceiveEmails() {
+		run.Paused++
+		return s.save(enrollment, EnrollmentPaused, now)
+	}
+
+	// Scoped per subscriber and sequence, so the step alone is a unique key
+	scope := ScopeStep + "/" + sequence.SequenceID.String() + "/" + sub.SubscriptionID.String()
+	key := kernel.IdempotencyKey(fmt.Sprintf("step-%03d", enrollment.Position))
+	_, _, err = kernel.Idempotent(s.sent, s.clock, scope, key, sub.SubscriptionID.String(), func() (string, error) {
+		return sub.SubscriptionID.String(), s.mailer.SendStep(*sub, sequence, enrollment.Position)
+	})
+	if err != nil {
+		run.Failed = append(run.Failed, StepFailure{
+			SequenceID:     sequence.SequenceID,
+			SubscriptionID: sub.SubscriptionID,
+			Position:       enrollment.Position,
+			Err:            err,
+		})
+		return nil
+	}
+	run.Sent++
+
+	enrollment.Position++
+	if enrollment.Position == len(sequence.Steps) {
+		run.Completed++
+		return s.save(enrollment, EnrollmentCompleted, now)
+	}
+	enrollment.NextSendAt = now.Add(sequence.Steps[enrollment.Position].Delay)
+	return s.save(enrollment, EnrollmentActive, now)
+}
+
+// update applies an admin's change to a sequence and stores it.
+func (s *AutomationService) update(
+	sequenceID kernel.ID[Sequence],
+	actor user.PostPermissionChecker,
+	change func(sequence *Sequence),
+) (Sequence, error) {
+	const op = "AutomationService.update"
+
+	if !user.IsActiveAdmin(actor) {
+		return Sequence{}, &kernel.Error{Code: kernel.EForbidden, Message: MSequenceForbidden, Operation: op}
+	}
+
+	sequence, err := s.sequences.GetSequence(sequenceID)
+	if err != nil {
+		return Sequence{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	updated := *sequence
+	change(&updated)
+	updated.UpdatedAt = s.clock.Now()
+	if err := updated.Validate(); err != nil {
+		return Sequence{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if err := s.sequences.SaveSequence(updated); err != nil {
+		return Sequence{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return updated, nil
+}
+
+// setStatus moves a subscriber's enrollments from one status to another.
+func (s *AutomationService) setStatus(subscriptionID kernel.ID[subscription.Subscription], from, to EnrollmentStatus) (int, error) {
+	enrollments, err := s.enrollments.GetEnrollments(subscriptionID)
+	if err != nil {
+		return 0, err
+	}
+
+	n := 0
+	for _, enrollment := range enrollments {
+		if enrollment.Status != from {
+			continue
+		}
+		if err := s.save(enrollment, to, s.clock.Now()); err != nil {
+			return n, err
+		}
+		n++
+	}
+	return n, nil
+}
+
+func (s *AutomationService) save(enrollment Enrollment, status EnrollmentStatus, now time.Time) error {
+	const op = "AutomationService.save"
+
+	enrollment.Status = status
+	enrollment.UpdatedAt = now
+	if err := enrollment.Validate(); err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if err := s.enrollments.SaveEnrollment(enrollment); err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+	return nil
+}
@@ -0,0 +1,188 @@
+package automation_test
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/automation"
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/subscription"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+func TestAutomationService(t *testing.T) {
+	start := time.Date(2024, 9, 2, 8, 0, 0, 0, time.UTC)
+	clock := &stubClock{t: start}
+	admin := user.User{ID: "admin-1", Roles: []user.Role{user.RoleAdmin}}
+	editor := user.User{ID: "editor-1", Roles: []user.Role{user.RoleEditor}}
+
+	store := newStubStore()
+	for _, id := range []kernel.ID[subscription.Subscription]{"ana", "tom"} {
+		store.subscriptions[id] = subscription.Subscription{SubscriptionID: id, Status: subscription.StatusActive, IsActive: true}
+	}
+	setStatus := func(id kernel.ID[subscription.Subscription], status subscription.Status) {
+		sub := store.subscriptions[id]
+		sub.Status, sub.IsActive = status, status == subscription.StatusActive
+		store.subscriptions[id] = sub
+	}
+
+	mailer := &stubMailer{}
+	service := automation.NewAutomationService(store, store, store, mailer, newStubIdempotencyStore(), clock)
+	params := automation.NewSequenceParams{SequenceID: "welcome", Name: "Welcome series", Trigger: automation.TriggerSubscribed, Steps: welcomeSteps()}
+
+	t.Run("only admins manage sequences", func(t *testing.T) {
+		_, err := service.Create(params, editor)
+		assertErrorCode(t, err, kernel.EForbidden)
+	})
+
+	_, err := service.Create(params, admin)
+	assertNoError(t, err)
+
+	t.Run("inactive sequences enroll nobody", func(t *testing.T) {
+		enrolled, err := service.HandleSubscribed(store.subscriptions["ana"])
+		assertNoError(t, err)
+		if len(enrolled) != 0 {
+			t.Errorf("got %v", enrolled)
+		}
+	})
+
+	_, err = service.SetActive("welcome", true, admin)
+	assertNoError(t, err)
+	for _, id := range []kernel.ID[subscription.Subscription]{"ana", "tom"} {
+		enrolled, err := service.HandleSubscribed(store.subscriptions[id])
+		assertNoError(t, err)
+		if len(enrolled) != 1 || !enrolled[0].NextSendAt.Equal(start) {
+			t.Fatalf("enrolled: got %v", enrolled)
+		}
+	}
+
+	t.Run("sends the first email right away", func(t *testing.T) {
+		run, err := service.RunDue()
+		assertNoError(t, err)
+		if run.Sent != 2 || !slices.Equal(mailer.sent, []string{"ana:Bienvenue !", "tom:Bienvenue !"}) {
+			t.Fatalf("run: got %+v, sent %v", run, mailer.sent)
+		}
+
+		got, _ := store.GetEnrollment("welcome", "ana")
+		if got.Position != 1 || !got.NextSendAt.Equal(start.Add(3*24*time.Hour)) {
+			t.Errorf("got %s next at %s", got, got.NextSendAt)
+		}
+
+		run, err = service.RunDue()
+		assertNoError(t, err)
+		if run.Sent != 0 {
+			t.Errorf("nothing is due yet: got %+v", run)
+		}
+	})
+
+	t.Run("pauses subscribers who unsubscribed", func(t *testing.T) {
+		setStatus("tom", subscription.StatusUnsubscribed)
+		clock.t = start.Add(3 * 24 * time.Hour)
+
+		run, err := service.RunDue()
+		assertNoError(t, err)
+		if run.Sent != 1 || run.Paused != 1 {
+			t.Errorf("run: got %+v", run)
+		}
+		if got, _ := store.GetEnrollment("welcome", "tom"); got.Status != automation.EnrollmentPaused || got.Position != 1 {
+			t.Errorf("got %s", got)
+		}
+	})
+
+	t.Run("resubscribing resumes where it stopped", func(t *testing.T) {
+		setStatus("tom", subscription.StatusActive)
+
+		enrolled, err := service.HandleSubscribed(store.subscriptions["tom"])
+		assertNoError(t, err)
+		if len(enrolled) != 0 {
+			t.Errorf("expected no restart, got %v", enrolled)
+		}
+
+		resumed, err := service.ResumeSubscriber("tom")
+		assertNoError(t, err)
+		if resumed != 1 {
+			t.Errorf("resumed: got %d", resumed)
+		}
+
+		clock.t = clock.t.Add(time.Hour)
+		run, err := service.RunDue()
+		assertNoError(t, err)
+		if run.Sent != 1 || mailer.sent[len(mailer.sent)-1] != "tom:Par où commencer ?" {
+			t.Errorf("run: got %+v, sent %v", run, mailer.sent)
+		}
+	})
+
+	t.Run("pauses right away on request", func(t *testing.T) {
+		paused, err := service.PauseSubscriber("tom")
+		assertNoError(t, err)
+		if paused != 1 {
+			t.Errorf("paused: got %d", paused)
+		}
+		_, err = service.ResumeSubscriber("tom")
+		assertNoError(t, err)
+	})
+
+	t.Run("retries failed sends and completes", func(t *testing.T) {
+		mailer.failTo = "ana"
+		clock.t = start.Add(8 * 24 * time.Hour)
+
+		run, err := service.RunDue()
+		assertNoError(t, err)
+		if run.Sent != 1 || run.Completed != 1 || len(run.Failed) != 1 || run.Failed[0].SubscriptionID != "ana" {
+			t.Fatalf("run: got %+v", run)
+		}
+
+		mailer.failTo = ""
+		run, err = service.RunDue()
+		assertNoError(t, err)
+		if run.Sent != 1 || run.Completed != 1 {
+			t.Errorf("rerun: got %+v", run)
+		}
+		if got, _ := store.GetEnrollment("welcome", "ana"); got.Status != automation.EnrollmentCompleted || got.Position != 3 {
+			t.Errorf("got %s", got)
+		}
+	})
+
+	t.Run("inactive sequences hold due emails", func(t *testing.T) {
+		_, err := service.Create(automation.NewSequenceParams{SequenceID: "tips", Name: "Study tips", Trigger: automation.TriggerSubscribed, Steps: welcomeSteps()}, admin)
+		assertNoError(t, err)
+		_, err = service.SetActive("tips", true, admin)
+		assertNoError(t, err)
+		store.subscriptions["lea"] = subscription.Subscription{SubscriptionID: "lea", Status: subscription.StatusActive, IsActive: true}
+		_, err = service.HandleSubscribed(store.subscriptions["lea"])
+		assertNoError(t, err)
+
+		_, err = service.SetActive("tips", false, admin)
+		assertNoError(t, err)
+		run, err := service.RunDue()
+		assertNoError(t, err)
+		if run.Sent != 1 || run.Waiting != 1 {
+			t.Errorf("run: got %+v", run)
+		}
+		if got, _ := store.GetEnrollment("tips", "lea"); got.Position != 0 || got.Status != automation.EnrollmentActive {
+			t.Errorf("got %s", got)
+		}
+	})
+
+	t.Run("editing steps keeps positions", func(t *testing.T) {
+		_, err := service.EditSteps("welcome", welcomeSteps()[:1], admin)
+		assertNoError(t, err)
+
+		clock.t = clock.t.Add(3 * 24 * time.Hour)
+		run, err := service.RunDue()
+		assertNoError(t, err)
+		if run.Sent != 0 || run.Completed != 1 {
+			t.Errorf("run: got %+v", run)
+		}
+		if got, _ := store.GetEnrollment("welcome", "lea"); got.Status != automation.EnrollmentCompleted || got.Position != 1 {
+			t.Errorf("got %s", got)
+		}
+
+		_, err = service.EditSteps("welcome", nil, admin)
+		assertErrorCode(t, err, kernel.EInvalid)
+
+		_, err = service.EditSteps("welcome", welcomeSteps(), editor)
+		assertErrorCode(t, err, kernel.EForbidden)
+	})
+}
@@ -0,0 +1,154 @@
+package backup_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/category"
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/post"
+	"github.com/alnah/fla/internal/domain/shared"
+	"github.com/alnah/fla/internal/domain/subscription"
+	"github.com/alnah/fla/internal/domain/tag"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+type stubClock struct {
+	t time.Time
+}
+
+func (s *stubClock) Now() time.Time { return s.t }
+
+func admin() user.User {
+	return user.User{ID: "admin-1", Roles: []user.Role{user.RoleAdmin}}
+}
+
+func editor() user.User {
+	return user.User{ID: "editor-1", Roles: []user.Role{user.RoleEditor}}
+}
+
+type stubUsers struct {
+	users   []user.User
+	created []user.User
+}
+
+func (s *stubUsers) GetAllUsers() ([]user.User, error) { return s.users, nil }
+
+func (s *stubUsers) CreateUser(u user.User) error {
+	s.created = append(s.created, u)
+	return nil
+}
+
+type stubCategories struct {
+	categories []category.Category
+	created    []category.Category
+}
+
+func (s *stubCategories) GetByID(kernel.ID[category.Category]) (*category.Category, error) {
+	return nil, &kernel.Error{Code: kernel.ENotFound}
+}
+
+func (s *stubCategories) GetAll() ([]category.Category, error) { return s.categories, nil }
+
+func (s *stubCategories) Create(c category.Category) error {
+	s.created = append(s.created, c)
+	return nil
+}
+
+func (s *stubCategories) Update(category.Category) error            { return nil }
+func (s *stubCategories) Delete(kernel.ID[category.Category]) error { return nil }
+
+type stubTags struct {
+	tags    []tag.Tag
+	created []tag.Tag
+}
+
+func (s *stubTags) GetByID(kernel.ID[tag.Tag]) (*tag.Tag, error) {
+	return nil, &kernel.Error{Code: kernel.ENotFound}
+}
+
+func (s *stubTags) GetBySlug(shared.Slug) (*tag.Tag, error) {
+	return nil, &kernel.Error{Code: kernel.ENotFound}
+}
+
+func (s *stubTags) GetAll() ([]tag.Tag, error) { return s.tags, nil }
+
+func (s *stubTags) Create(t tag.Tag) error {
+	s.created = append(s.created, t)
+	return nil
+}
+
+func (s *stubTags) Update(tag.Tag) error            { return nil }
+func (s *stubTags) Delete(kernel.ID[tag.Tag]) error { return nil }
+
+// stubPosts serves posts one per page to exercise paging.
+type stubPosts struct {
+	posts   []post.Post
+	created []post.Post
+	err     error
+}
+
+func (s *stubPosts) Find(q post.Query) (post.PostsList, error) {
+	pagination, _ := shared.NewPagination(q.Pagination.Page, 1, len(s.posts))
+	if q.Pagination.Page > len(s.posts) {
+		return post.NewPostsList(nil, pagination), nil
+	}
+	return post.NewPostsList(s.posts[q.Pagination.Page-1:q.Pagination.Page], pagination), nil
+}
+
+func (s *stubPosts) Create(p post.Post) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.created = append(s.created, p)
+	return nil
+}
+
+func (s *stubPosts) Update(post.Post) error            { return nil }
+func (s *stubPosts) Delete(kernel.ID[post.Post]) error { return nil }
+
+type stubSubscriptions struct {
+	subscriptions []subscription.Subscription
+	created       []subscription.Subscription
+}
+
+func (s *stubSubscriptions) GetActiveSubscriptions() ([]subscription.Subscription, error) {
+	return s.subscriptions, nil
+}
+
+func (s *stubSubscriptions) GetAllSubscriptions() ([]subscription.Subscription, error) {
+	return s.subscriptions, nil
+}
+
+func (s *stubSubscriptions) Create(sub subscription.Subscription) error {
+	s.created = append(s.created, sub)
+	return nil
+}
+
+func (s *stubSubscriptions) Update(subscription.Subscription) error            { return nil }
+func (s *stubSubscriptions) Delete(kernel.ID[subscription.Subscription]) error { return nil }
+
+func assertNoError(t *testing.T, err error) {
+	t.Helper()
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+}
+
+func assertErrorCode(t *testing.T, err error, want string) {
+	t.Helper()
+	got := kernel.ErrorCode(err)
+	if got != want {
+		t.Errorf("error code: got %q, want %q", got, want)
+	}
+}
+
+// testConsent returns a signup form consent given at the clock's time.
+func testConsent(clock kernel.Clock) subscription.Consent {
+	return subscription.Consent{
+		Source:      subscription.SourceSignupForm,
+		FormURL:     "https://fla.example/newsletter",
+		TextVersion: "2024-05-01",
+		GivenAt:     clock.Now(),
+	}
+}
@@ -0,0 +1,63 @@
+package backup
+
+import (
+	"time"
+)
+
+// SchemaVersion is the archive layout this code writes and restores.
+// Records are the aggregates' JSON encoding, so bump it whenever an exported
+// field of a backed-up aggregate is renamed or changes meaning.
+const SchemaVersion = 1
+
+// ManifestFileName is written last, so an archive without it is incomplete.
+const ManifestFileName = "manifest.json"
+
+// Aggregate names one backed-up aggregate and its JSON lines file.
+type Aggregate string
+
+const (
+	AggregateUsers         Aggregate = "users"
+	AggregateCategories    Aggregate = "categories"
+	AggregateTags          Aggregate = "tags"
+	AggregatePosts         Aggregate = "posts"
+	AggregateSubscriptions Aggregate = "subscriptions"
+)
+
+// Aggregates lists every aggregate in restore order: referenced records come first.
+var Aggregates = []Aggregate{
+	AggregateUsers,
+	AggregateCategories,
+	AggregateTags,
+	AggregatePosts,
+	AggregateSubscriptions,
+}
+
+func (a Aggregate) String() string { return string(a) }
+
+// FileName returns the archive entry holding the aggregate, one JSON record per line.
+func (a Aggregate) FileName() string { return string(a) + ".jsonl" }
+
+// Manifest describes an archive so restores can detect truncation and tampering.
+type Manifest struct {
+	SchemaVersion int            `json:"schema_version"`
+	CreatedAt     time.Time      `json:"created_at"`
+	Files         []ManifestFile `json:"files"`
+}
+
+// ManifestFile describes one aggregate file of the archive.
+type ManifestFile struct {
+	Name      string    `json:"name"`
+	Aggregate Aggregate `json:"aggregate"`
+	Records   int       `json:"records"`
+	SHA256    string    `json:"sha256"` // Hex digest of the file content
+}
+
+// File returns the manifest entry of an aggregate, if present.
+func (m Manifest) File(aggregate Aggregate) (ManifestFile, bool) {
+	for _, f := range m.Files {
+		if f.Aggregate == aggregate {
+			return f, true
+		}
+	}
+	return ManifestFile{}, false
+}
@@ -0,0 +1,50 @@
+package backup
+
+import (
+	"io"
+
+	"github.com/alnah/fla/internal/domain/category"
+	"github.com/alnah/fla/internal/domain/post"
+	"github.com/alnah/fla/internal/domain/subscription"
+	"github.com/alnah/fla/internal/domain/tag"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+// UserStore lists every account for backups and recreates them on restore.
+type UserStore interface {
+	// GetAllUsers returns every account, whatever its status.
+	GetAllUsers() ([]user.User, error)
+
+	// CreateUser persists a restored account as is.
+	CreateUser(u user.User) error
+}
+
+// CategoryStore reads and recreates the category tree.
+type CategoryStore interface {
+	category.CategoryReader
+	category.CategoryWriter
+}
+
+// TagStore reads and recreates tags.
+type TagStore interface {
+	tag.TagReader
+	tag.TagWriter
+}
+
+// PostStore pages through posts in every status and recreates them.
+type PostStore interface {
+	post.PostFinder
+	post.PostWriter
+}
+
+// SubscriptionStore reads and recreates newsletter subscriptions.
+type SubscriptionStore interface {
+	subscription.SubscriptionLister
+	subscription.SubscriptionWriter
+}
+
+// ArchiveWriter creates archive entries; *zip.Writer satisfies it.
+// Reading goes through fs.FS, which *zip.Reader and os.DirFS implement.
+type ArchiveWriter interface {
+	Create(name string) (io.Writer, error)
+}
@@ -0,0 +1,313 @@
+package backup
+
+import (
+	"cmp"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"slices"
+
+	"github.com/alnah/fla/internal/domain/category"
+	"github.com/alnah/fla/internal/domain/importer"
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/post"
+	"github.com/alnah/fla/internal/domain/shared"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+const (
+	MBackupForbidden     string = "Only admins can back up or restore the blog."
+	MManifestMissing     string = "Archive has no manifest; it is incomplete or not a backup."
+	MManifestInvalid     string = "Archive manifest is unreadable."
+	MSchemaUnsupported   string = "Archive schema version %d is not supported (expected %d)."
+	MRestoreBlocked      string = "Archive failed verification; nothing was restored."
+	MFileMissing         string = "Archive has no %s file."
+	MChecksumMismatch    string = "File %s does not match its checksum."
+	MRecordCountMismatch string = "File %s holds %d records; the manifest lists %d."
+)
+
+// BackupSource names restores in validation reports.
+const BackupSource = "backup"
+
+// BackupService exports the whole domain state to an archive and restores it.
+// Archives hold one JSON lines file per aggregate plus a manifest with the
+// schema version, record counts, and checksums.
+type BackupService struct {
+	users         UserStore
+	categories    CategoryStore
+	tags          TagStore
+	posts         PostStore
+	subscriptions SubscriptionStore
+	clock         kernel.Clock
+}
+
+// NewBackupService creates backup service with one store per aggregate.
+func NewBackupService(
+	users UserStore,
+	categories CategoryStore,
+	tags TagStore,
+	posts PostStore,
+	subscriptions SubscriptionStore,
+	clock kernel.Clock,
+) *BackupService {
+	return &BackupService{
+		users:         users,
+		categories:    categories,
+		tags:          tags,
+		posts:         posts,
+		subscriptions: subscriptions,
+		clock:         clock,
+	}
+}
+
+// Backup writes every aggregate to the archive, then the manifest describing them.
+// Admin only: archives hold every account and subscriber email.
+func (s *BackupService) Backup(archive ArchiveWriter, actor user.PostPermissionChecker) (Manifest, error) {
+	const op = "BackupService.Backup"
+
+	if !user.IsActiveAdmin(actor) {
+		return Manifest{}, &kernel.Error{Code: kernel.EForbidden, Message: MBackupForbidden, Operation: op}
+	}
+
+	snapshot, err := s.snapshot()
+	if err != nil {
+		return Manifest{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	manifest := Manifest{SchemaVersion: SchemaVersion, CreatedAt: s.clock.Now().UTC()}
+	for _, aggregate := range Aggregates {
+		data, records, err := snapshot.encode(aggregate)
+		if err != nil {
+			return Manifest{}, &kernel.Error{Code: kernel.EInternal, Operation: op, Cause: err}
+		}
+
+		if err := writeEntry(archive, aggregate.FileName(), data); err != nil {
+			return Manifest{}, &kernel.Error{Operation: op, Cause: err}
+		}
+
+		manifest.Files = append(manifest.Files, ManifestFile{
+			Name:      aggregate.FileName(),
+			Aggregate: aggregate,
+			Records:   records,
+			SHA256:    checksum(data),
+		})
+	}
+
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return Manifest{}, &kernel.Error{Code: kernel.EInternal, Operation: op, Cause: err}
+	}
+
+	if err := writeEntry(archive, ManifestFileName, data); err != nil {
+		return Manifest{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return manifest, nil
+}
+
+// Verify reads an archive without writing anything: checksums, record counts,
+// record validity, and references between records. Problems become findings;
+// only an unreadable or unsupported manifest is returned as an error.
+func (s *BackupService) Verify(archive fs.FS) (Snapshot, *importer.ValidationReport, error) {
+	const op = "BackupService.Verify"
+
+	manifest, err := readManifest(archive)
+	if err != nil {
+		return Snapshot{}, nil, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	report, err := importer.NewValidationReport(BackupSource)
+	if err != nil {
+		return Snapshot{}, nil, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	v := &verifier{report: report, clock: s.clock, refs: make(map[string]importer.ItemRef)}
+	var snapshot Snapshot
+
+	for _, aggregate := range Aggregates {
+		ref := importer.ItemRef{File: aggregate.FileName()}
+
+		entry, listed := manifest.File(aggregate)
+		data, err := fs.ReadFile(archive, aggregate.FileName())
+		if !listed || err != nil {
+			v.fail(ref, RuleFile, fmt.Sprintf(MFileMissing, aggregate.FileName()))
+			continue
+		}
+
+		if checksum(data) != entry.SHA256 {
+			v.fail(ref, RuleChecksum, fmt.Sprintf(MChecksumMismatch, entry.Name))
+		}
+
+		if records := v.decode(&snapshot, aggregate, data); records != entry.Records {
+			v.fail(ref, RuleCount, fmt.Sprintf(MRecordCountMismatch, entry.Name, records, entry.Records))
+		}
+	}
+
+	v.checkReferences(snapshot)
+
+	return snapshot, report, nil
+}
+
+// Restore verifies an archive and, only if it is clean, writes it through the
+// repositories in dependency order. Meant for an empty store; writing stops at
+// the first repository error. The report is returned either way.
+func (s *BackupService) Restore(archive fs.FS, actor user.PostPermissionChecker) (*importer.ValidationReport, error) {
+	const op = "BackupService.Restore"
+
+	if !user.IsActiveAdmin(actor) {
+		return nil, &kernel.Error{Code: kernel.EForbidden, Message: MBackupForbidden, Operation: op}
+	}
+
+	snapshot, report, err := s.Verify(archive)
+	if err != nil {
+		return nil, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if report.HasErrors() {
+		return report, &kernel.Error{Code: kernel.EInvalid, Message: MRestoreBlocked, Operation: op}
+	}
+
+	if err := s.write(snapshot); err != nil {
+		return report, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return report, nil
+}
+
+// snapshot reads every aggregate; posts are paged so no status is left out.
+func (s *BackupService) snapshot() (Snapshot, error) {
+	const op = "BackupService.snapshot"
+
+	var snapshot Snapshot
+	var err error
+
+	if snapshot.Users, err = s.users.GetAllUsers(); err != nil {
+		return Snapshot{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if snapshot.Categories, err = s.categories.GetAll(); err != nil {
+		return Snapshot{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if snapshot.Tags, err = s.tags.GetAll(); err != nil {
+		return Snapshot{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	for page := 1; ; page++ {
+		list, err := s.posts.Find(post.NewQuery().Page(page, shared.MaxPageLimit))
+		if err != nil {
+			return Snapshot{}, &kernel.Error{Operation: op, Cause: err}
+		}
+		snapshot.Posts = append(snapshot.Posts, list.Posts...)
+		if !list.Pagination.HasNextPage() {
+			break
+		}
+	}
+
+	if snapshot.Subscriptions, err = s.subscriptions.GetAllSubscriptions(); err != nil {
+		return Snapshot{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return snapshot, nil
+}
+
+// write creates every record, parents before the records pointing at them.
+func (s *BackupService) write(snapshot Snapshot) error {
+	const op = "BackupService.write"
+
+	for _, u := range snapshot.Users {
+		if err := s.users.CreateUser(u); err != nil {
+			return &kernel.Error{Operation: op, Cause: err}
+		}
+	}
+
+	for _, c := range parentsFirst(snapshot.Categories) {
+		if err := s.categories.Create(c); err != nil {
+			return &kernel.Error{Operation: op, Cause: err}
+		}
+	}
+
+	for _, t := range snapshot.Tags {
+		if err := s.tags.Create(t); err != nil {
+			return &kernel.Error{Operation: op, Cause: err}
+		}
+	}
+
+	for _, p := range snapshot.Posts {
+		if err := s.posts.Create(p); err != nil {
+			return &kernel.Error{Operation: op, Cause: err}
+		}
+	}
+
+	for _, sub := range snapshot.Subscriptions {
+		if err := s.subscriptions.Create(sub); err != nil {
+			return &kernel.Error{Operation: op, Cause: err}
+		}
+	}
+
+	return nil
+}
+
+// parentsFirst orders categories by depth so every parent exists before its children.
+func parentsFirst(categories []category.Category) []category.Category {
+	parents := make(map[kernel.ID[category.Category]]*kernel.ID[category.Category], len(categories))
+	for _, c := range categories {
+		parents[c.CategoryID] = c.ParentID
+	}
+
+	sorted := slices.Clone(categories)
+	slices.SortStableFunc(sorted, func(a, b category.Category) int {
+		return cmp.Compare(categoryDepth(a.CategoryID, parents), categoryDepth(b.CategoryID, parents))
+	})
+	return sorted
+}
+
+func readManifest(archive fs.FS) (Manifest, error) {
+	const op = "readManifest"
+
+	data, err := fs.ReadFile(archive, ManifestFileName)
+	if errors.Is(err, fs.ErrNotExist) {
+		return Manifest{}, &kernel.Error{Code: kernel.EInvalid, Message: MManifestMissing, Operation: op}
+	}
+	if err != nil {
+		return Manifest{}, &kernel.Error{Code: kernel.EInternal, Operation: op, Cause: err}
+	}
+
+	var manifest Manifest
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		return Manifest{}, &kernel.Error{Code: kernel.EInvalid, Message: MManifestInvalid, Operation: op, Cause: err}
+	}
+
+	if manifest.SchemaVersion != SchemaVersion {
+		return Manifest{}, &kernel.Error{
+			Code:      kernel.EInvalid,
+			Message:   fmt.Sprintf(MSchemaUnsupported, manifest.SchemaVersion, SchemaVersion),
+			Operation: op,
+		}
+	}
+
+	return manifest, nil
+}
+
+func writeEntry(archive ArchiveWriter, name string, data []byte) error {
+	const op = "writeEntry"
+
+	w, err := archive.Create(name)
+	if err != nil {
+		return &kernel.Error{Code: kernel.EInternal, Operation: op, Cause: err}
+	}
+
+	if _, err := w.Write(data); err != nil {
+		return &kernel.Error{Code: kernel.EInternal, Operation: op, Cause: err}
+	}
+
+	return nil
+}
+
+func checksum(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,316 @@
+package backup_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/backup"
+	"github.com/alnah/fla/internal/domain/category"
+	"github.com/alnah/fla/internal/domain/importer"
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/post"
+	"github.com/alnah/fla/internal/domain/shared"
+	"github.com/alnah/fla/internal/domain/subscription"
+	"github.com/alnah/fla/internal/domain/tag"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+var backupNow = time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+
+type fixture struct {
+	service       *backup.BackupService
+	users         *stubUsers
+	categories    *stubCategories
+	tags          *stubTags
+	posts         *stubPosts
+	subscriptions *stubSubscriptions
+}
+
+// newFixture builds a small but complete blog: author, A1 > Lecture, a tag,
+// a published lesson, and a subscriber following the reading category.
+func newFixture(t *testing.T) fixture {
+	t.Helper()
+	clock := &stubClock{backupNow}
+
+	marie, err := user.NewUser(user.NewUserParams{UserID: "marie", Username: "marie", Email: "marie@example.com", Roles: []user.Role{user.RoleAuthor}, Clock: clock})
+	assertNoError(t, err)
+
+	a1, err := category.NewCategory(category.NewCategoryParams{CategoryID: "a1", Name: "A1", CreatedBy: "marie", Clock: clock})
+	assertNoError(t, err)
+
+	reading, err := category.NewCategory(category.NewCategoryParams{CategoryID: "a1-reading", Name: "Lecture", ParentID: &a1.CategoryID, CreatedBy: "marie", Clock: clock})
+	assertNoError(t, err)
+
+	grammar, err := tag.NewTag(tag.Tag{TagID: "grammar", Name: "Grammaire", CreatedBy: "marie", CreatedAt: backupNow})
+	assertNoError(t, err)
+
+	published := backupNow.AddDate(0, -1, 0)
+	lesson, err := post.NewPost(post.NewPostParams{
+		PostID:      "lesson",
+		Owner:       "marie",
+		Title:       "Au marché le samedi",
+		Content:     post.PostContent(strings.Repeat("Le samedi, je vais au marché. ", 12)),
+		Status:      post.StatusPublished,
+		Category:    reading,
+		PublishedAt: &published,
+		Tags:        post.PostTags{"grammar"},
+		Clock:       clock,
+	})
+	assertNoError(t, err)
+
+	second := lesson
+	second.PostID = "lesson-2"
+
+	sub, err := subscription.NewSubscription(subscription.NewSubscriptionParams{
+		SubscriptionID: "sub-1",
+		FirstName:      "Léa",
+		Email:          "lea@example.com",
+		Preferences:    &subscription.Preferences{CategoryIDs: []kernel.ID[category.Category]{"a1-reading"}, Locale: shared.DefaultLocale, Frequency: subscription.FrequencyInstant},
+		Consent:        testConsent(clock),
+		Clock:          clock,
+	})
+	assertNoError(t, err)
+
+	f := fixture{
+		users:         &stubUsers{users: []user.User{marie}},
+		categories:    &stubCategories{categories: []category.Category{reading, a1}},
+		tags:          &stubTags{tags: []tag.Tag{grammar}},
+		posts:         &stubPosts{posts: []post.Post{lesson, second}},
+		subscriptions: &stubSubscriptions{subscriptions: []subscription.Subscription{sub}},
+	}
+	f.service = backup.NewBackupService(f.users, f.categories, f.tags, f.posts, f.subscriptions, clock)
+	return f
+}
+
+// backupArchive writes a zip archive in memory and returns its entries.
+func backupArchive(t *testing.T, f fixture) (fstest.MapFS, backup.Manifest) {
+	t.Helper()
+
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	manifest, err := f.service.Backup(w, admin())
+	assertNoError(t, err)
+	assertNoError(t, w.Close())
+
+	r, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	assertNoError(t, err)
+
+	archive := fstest.MapFS{}
+	for _, file := range r.File {
+		rc, err := file.Open()
+		assertNoError(t, err)
+		var data bytes.Buffer
+		_, err = data.ReadFrom(rc)
+		assertNoError(t, err)
+		archive[file.Name] = &fstest.MapFile{Data: data.Bytes()}
+	}
+
+	return archive, manifest
+}
+
+func TestBackupService_Backup(t *testing.T) {
+	t.Run("writes every aggregate and a manifest", func(t *testing.T) {
+		archive, manifest := backupArchive(t, newFixture(t))
+
+		if manifest.SchemaVersion != backup.SchemaVersion || len(manifest.Files) != len(backup.Aggregates) {
+			t.Fatalf("manifest: got %+v", manifest)
+		}
+		if posts, _ := manifest.File(backup.AggregatePosts); posts.Records != 2 {
+			t.Errorf("posts: got %d records", posts.Records)
+		}
+		if _, ok := archive[backup.ManifestFileName]; !ok {
+			t.Error("expected manifest entry")
+		}
+		if !strings.Contains(string(archive["posts.jsonl"].Data), `"Clock":null`) {
+			t.Error("expected clocks left out")
+		}
+	})
+
+	t.Run("only admins can back up", func(t *testing.T) {
+		f := newFixture(t)
+
+		_, err := f.service.Backup(zip.NewWriter(&bytes.Buffer{}), editor())
+
+		assertErrorCode(t, err, kernel.EForbidden)
+	})
+}
+
+func TestBackupService_Restore(t *testing.T) {
+	t.Run("round-trips through a zip archive", func(t *testing.T) {
+		archive, _ := backupArchive(t, newFixture(t))
+		target := newFixture(t)
+
+		report, err := target.service.Restore(archive, admin())
+
+		assertNoError(t, err)
+		if report.HasErrors() {
+			t.Fatalf("unexpected findings: %+v", report.Findings)
+		}
+		if len(target.users.created) != 1 || len(target.tags.created) != 1 || len(target.posts.created) != 2 || len(target.subscriptions.created) != 1 {
+			t.Errorf("restored %d users, %d tags, %d posts, %d subscriptions",
+				len(target.users.created), len(target.tags.created), len(target.posts.created), len(target.subscriptions.created))
+		}
+		if c := target.categories.created; len(c) != 2 || c[0].CategoryID != "a1" {
+			t.Errorf("expected parent category first, got %v", c)
+		}
+		if p := target.posts.created[0]; p.Clock == nil || p.Title != "Au marché le samedi" || !p.Tags.Contains("grammar") {
+			t.Errorf("post: got %s", p)
+		}
+	})
+
+	t.Run("only admins can restore", func(t *testing.T) {
+		archive, _ := backupArchive(t, newFixture(t))
+
+		_, err := newFixture(t).service.Restore(archive, editor())
+
+		assertErrorCode(t, err, kernel.EForbidden)
+	})
+
+	t.Run("suspended admins cannot restore", func(t *testing.T) {
+		archive, _ := backupArchive(t, newFixture(t))
+		suspended := admin()
+		suspended.Status = user.AccountStatusSuspended
+
+		_, err := newFixture(t).service.Restore(archive, suspended)
+
+		assertErrorCode(t, err, kernel.EForbidden)
+	})
+
+	t.Run("surfaces repository failures", func(t *testing.T) {
+		archive, _ := backupArchive(t, newFixture(t))
+		target := newFixture(t)
+		target.posts.err = &kernel.Error{Code: kernel.EConflict, Message: "post exists"}
+
+		_, err := target.service.Restore(archive, admin())
+
+		assertErrorCode(t, err, kernel.EConflict)
+	})
+
+	tests := []struct {
+		name   string
+		tamper func(archive fstest.MapFS)
+		rule   string
+		line   int
+	}{
+		{
+			name:   "missing file",
+			tamper: func(a fstest.MapFS) { delete(a, "tags.jsonl") },
+			rule:   backup.RuleFile,
+		},
+		{
+			name: "edited file",
+			tamper: func(a fstest.MapFS) {
+				a["tags.jsonl"].Data = bytes.Replace(a["tags.jsonl"].Data, []byte("Grammaire"), []byte("Grammar"), 1)
+			},
+			rule: backup.RuleChecksum,
+		},
+		{
+			name:   "undecodable record",
+			tamper: func(a fstest.MapFS) { a["posts.jsonl"].Data = append(a["posts.jsonl"].Data, []byte("{oops\n")...) },
+			rule:   backup.RuleDecode,
+			line:   3,
+		},
+		{
+			name:   "duplicate record",
+			tamper: func(a fstest.MapFS) { a["users.jsonl"].Data = bytes.Repeat(a["users.jsonl"].Data, 2) },
+			rule:   backup.RuleDuplicate,
+			line:   2,
+		},
+		{
+			name:   "post in a missing category",
+			tamper: func(a fstest.MapFS) { a["categories.jsonl"].Data = firstLine(a["categories.jsonl"].Data) },
+			rule:   backup.RuleReference,
+			line:   1,
+		},
+		{
+			name:   "tag by a missing user",
+			tamper: func(a fstest.MapFS) { a["users.jsonl"].Data = nil },
+			rule:   backup.RuleReference,
+			line:   1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			archive, _ := backupArchive(t, newFixture(t))
+			tt.tamper(archive)
+			target := newFixture(t)
+
+			report, err := target.service.Restore(archive, admin())
+
+			assertErrorCode(t, err, kernel.EInvalid)
+			if !hasFinding(report, tt.rule, tt.line) {
+				t.Errorf("expected %q on line %d, got %+v", tt.rule, tt.line, report.Findings)
+			}
+			if len(target.users.created) != 0 {
+				t.Error("expected nothing restored")
+			}
+		})
+	}
+
+	manifestTests := []struct {
+		name   string
+		tamper func(archive fstest.MapFS)
+	}{
+		{"missing manifest", func(a fstest.MapFS) { delete(a, backup.ManifestFileName) }},
+		{"unreadable manifest", func(a fstest.MapFS) { a[backup.ManifestFileName].Data = []byte("{") }},
+		{"newer schema", func(a fstest.MapFS) {
+			var m backup.Manifest
+			_ = json.Unmarshal(a[backup.ManifestFileName].Data, &m)
+			m.SchemaVersion = backup.SchemaVersion + 1
+			a[backup.ManifestFileName].Data, _ = json.Marshal(m)
+		}},
+	}
+
+	for _, tt := range manifestTests {
+		t.Run(tt.name, func(t *testing.T) {
+			archive, _ := backupArchive(t, newFixture(t))
+			tt.tamper(archive)
+
+			_, err := newFixture(t).service.Restore(archive, admin())
+
+			assertErrorCode(t, err, kernel.EInvalid)
+		})
+	}
+}
+
+func TestBackupService_Verify(t *testing.T) {
+	t.Run("overdue scheduled posts only warn", func(t *testing.T) {
+		f := newFixture(t)
+		scheduled := f.posts.posts[0]
+		publishAt := backupNow.Add(time.Hour)
+		scheduled.Status, scheduled.PublishedAt = post.StatusScheduled, &publishAt
+		f.posts.posts = []post.Post{scheduled}
+		archive, _ := backupArchive(t, f)
+
+		later := backup.NewBackupService(f.users, f.categories, f.tags, f.posts, f.subscriptions, &stubClock{backupNow.Add(2 * time.Hour)})
+		_, report, err := later.Verify(archive)
+
+		assertNoError(t, err)
+		if report.HasErrors() || report.Count(importer.SeverityWarning) != 1 {
+			t.Errorf("got %+v", report.Findings)
+		}
+	})
+}
+
+func firstLine(data []byte) []byte {
+	line, _, _ := bytes.Cut(data, []byte("\n"))
+	return append(line, '\n')
+}
+
+func hasFinding(report *importer.ValidationReport, rule string, line int) bool {
+	if report == nil {
+		return false
+	}
+	for _, f := range report.Findings {
+		if f.Rule == rule && f.Ref.Line == line {
+			return true
+		}
+	}
+	return false
+}
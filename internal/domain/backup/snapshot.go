@@ -0,0 +1,216 @@
+package backup
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/alnah/fla/internal/domain/category"
+	"github.com/alnah/fla/internal/domain/importer"
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/post"
+	"github.com/alnah/fla/internal/domain/subscription"
+	"github.com/alnah/fla/internal/domain/tag"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+const (
+	MRecordUndecodable  string = "Record is not valid JSON: %s."
+	MRecordDuplicate    string = "Record %s appears more than once."
+	MReferenceMissing   string = "References missing %s %s."
+	MCategoryTooDeep    string = "Category hierarchy exceeds %d levels or loops."
+	MScheduledNowPassed string = "Scheduled date has passed; the scheduler will publish the post after restore."
+)
+
+// Verification finding rules.
+const (
+	RuleFile      = "backup.file"
+	RuleChecksum  = "backup.checksum"
+	RuleCount     = "backup.count"
+	RuleDecode    = "backup.decode"
+	RuleDuplicate = "backup.duplicate"
+	RuleRecord    = "backup.record"
+	RuleReference = "backup.reference"
+)
+
+// Snapshot is the whole domain state held by one archive.
+type Snapshot struct {
+	Users         []user.User
+	Categories    []category.Category
+	Tags          []tag.Tag
+	Posts         []post.Post
+	Subscriptions []subscription.Subscription
+}
+
+// encode returns an aggregate's records as JSON lines, with the record count.
+// Clocks are dependencies, not state, so they are left out.
+func (s Snapshot) encode(aggregate Aggregate) ([]byte, int, error) {
+	switch aggregate {
+	case AggregateUsers:
+		return encodeLines(s.Users, func(u user.User) user.User { u.Clock = nil; return u })
+	case AggregateCategories:
+		return encodeLines(s.Categories, func(c category.Category) category.Category { c.Clock = nil; return c })
+	case AggregateTags:
+		return encodeLines(s.Tags, func(t tag.Tag) tag.Tag { return t })
+	case AggregatePosts:
+		return encodeLines(s.Posts, func(p post.Post) post.Post { p.Clock, p.Category.Clock = nil, nil; return p })
+	default:
+		return encodeLines(s.Subscriptions, func(sub subscription.Subscription) subscription.Subscription { sub.Clock = nil; return sub })
+	}
+}
+
+func encodeLines[T any](records []T, strip func(T) T) ([]byte, int, error) {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+
+	for _, r := range records {
+		if err := encoder.Encode(strip(r)); err != nil {
+			return nil, 0, err
+		}
+	}
+
+	return buf.Bytes(), len(records), nil
+}
+
+// verifier checks archive content and records where each record was read,
+// so integrity findings point at file and line.
+type verifier struct {
+	report *importer.ValidationReport
+	clock  kernel.Clock
+	refs   map[string]importer.ItemRef // By "kind:id"
+}
+
+func (v *verifier) fail(ref importer.ItemRef, rule, message string) {
+	_ = v.report.AddError(ref, rule, message, "")
+}
+
+// decode reads one aggregate file into the snapshot.
+func (v *verifier) decode(snapshot *Snapshot, aggregate Aggregate, data []byte) int {
+	switch aggregate {
+	case AggregateUsers:
+		snapshot.Users = decodeLines(v, aggregate, data, "user",
+			func(u user.User) string { return u.ID.String() },
+			func(u user.User) user.User { u.Clock = v.clock; return u })
+		return len(snapshot.Users)
+	case AggregateCategories:
+		snapshot.Categories = decodeLines(v, aggregate, data, "category",
+			func(c category.Category) string { return c.CategoryID.String() },
+			func(c category.Category) category.Category { c.Clock = v.clock; return c })
+		return len(snapshot.Categories)
+	case AggregateTags:
+		snapshot.Tags = decodeLines(v, aggregate, data, "tag",
+			func(t tag.Tag) string { return t.TagID.String() },
+			func(t tag.Tag) tag.Tag { return t })
+		return len(snapshot.Tags)
+	case AggregatePosts:
+		snapshot.Posts = decodeLines(v, aggregate, data, "post",
+			func(p post.Post) string { return p.PostID.String() },
+			func(p post.Post) post.Post { p.Clock, p.Category.Clock = v.clock, v.clock; return p })
+		return len(snapshot.Posts)
+	default:
+		snapshot.Subscriptions = decodeLines(v, aggregate, data, "subscription",
+			func(s subscription.Subscription) string { return s.SubscriptionID.String() },
+			func(s subscription.Subscription) subscription.Subscription { s.Clock = v.clock; return s })
+		return len(snapshot.Subscriptions)
+	}
+}
+
+// decodeLines decodes and validates JSON lines; rejected lines are reported and skipped.
+func decodeLines[T interface{ Validate() error }](v *verifier, aggregate Aggregate, data []byte, kind string, key func(T) string, restore func(T) T) []T {
+	var records []T
+
+	for i, line := range bytes.Split(data, []byte("\n")) {
+		if len(bytes.TrimSpace(line)) == 0 {
+			continue
+		}
+
+		ref := importer.ItemRef{File: aggregate.FileName(), Line: i + 1}
+
+		var record T
+		if err := json.Unmarshal(line, &record); err != nil {
+			v.fail(ref, RuleDecode, fmt.Sprintf(MRecordUndecodable, err))
+			continue
+		}
+		record = restore(record)
+
+		ref.Item = kind + ":" + key(record)
+		if _, duplicate := v.refs[ref.Item]; duplicate {
+			v.fail(ref, RuleDuplicate, fmt.Sprintf(MRecordDuplicate, ref.Item))
+			continue
+		}
+		v.refs[ref.Item] = ref
+
+		if err := record.Validate(); err != nil {
+			if kernel.ErrorMessage(err) == post.MPostScheduledDatePast {
+				_ = v.report.AddWarning(ref, RuleRecord, MScheduledNowPassed, "")
+			} else {
+				_ = v.report.AddDomainError(ref, RuleRecord, err)
+			}
+		}
+
+		records = append(records, record)
+	}
+
+	return records
+}
+
+// checkReferences reports records pointing at records the archive does not hold.
+func (v *verifier) checkReferences(s Snapshot) {
+	require := func(from importer.ItemRef, kind, id string) {
+		if _, ok := v.refs[kind+":"+id]; !ok {
+			v.fail(from, RuleReference, fmt.Sprintf(MReferenceMissing, kind, id))
+		}
+	}
+
+	parents := make(map[kernel.ID[category.Category]]*kernel.ID[category.Category], len(s.Categories))
+	for _, c := range s.Categories {
+		parents[c.CategoryID] = c.ParentID
+	}
+
+	for _, c := range s.Categories {
+		ref := v.refs["category:"+c.CategoryID.String()]
+		require(ref, "user", c.CreatedBy.String())
+		if c.ParentID != nil {
+			require(ref, "category", c.ParentID.String())
+		}
+		if categoryDepth(c.CategoryID, parents) > category.MaxCategoryDepth {
+			v.fail(ref, RuleReference, fmt.Sprintf(MCategoryTooDeep, category.MaxCategoryDepth))
+		}
+	}
+
+	for _, t := range s.Tags {
+		require(v.refs["tag:"+t.TagID.String()], "user", t.CreatedBy.String())
+	}
+
+	for _, p := range s.Posts {
+		ref := v.refs["post:"+p.PostID.String()]
+		require(ref, "user", p.Owner.String())
+		require(ref, "category", p.Category.CategoryID.String())
+		for _, tagID := range p.Tags {
+			require(ref, "tag", tagID.String())
+		}
+		if p.ApprovedBy != nil {
+			require(ref, "user", p.ApprovedBy.String())
+		}
+	}
+
+	for _, sub := range s.Subscriptions {
+		ref := v.refs["subscription:"+sub.SubscriptionID.String()]
+		for _, categoryID := range sub.Preferences.CategoryIDs {
+			require(ref, "category", categoryID.String())
+		}
+	}
+}
+
+// categoryDepth counts levels up to the root; loops count as too deep.
+func categoryDepth(id kernel.ID[category.Category], parents map[kernel.ID[category.Category]]*kernel.ID[category.Category]) int {
+	depth := 1
+	for parent := parents[id]; parent != nil; parent = parents[*parent] {
+		depth++
+		if depth > category.MaxCategoryDepth {
+			break
+		}
+	}
+	return depth
+}
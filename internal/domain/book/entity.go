@@ -0,0 +1,108 @@
+// Package book compiles published lessons into e-books: a category with its
+// subcategories, or a series of posts in reading order.
+package book
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/post"
+	"github.com/alnah/fla/internal/domain/shared"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+const (
+	MaxSeriesPosts int = 200
+
+	MBookEmpty         string = "The book has no published lesson."
+	MSeriesPostsMissed string = "A series needs at least one post."
+	MSeriesTooLong     string = "A series can have at most %d posts."
+	MSeriesPostRepeat  string = "Post %s appears twice in the series."
+)
+
+// Book is the structure of an e-book, independent of its file format:
+// metadata, an optional cover, and chapters in spine (reading) order.
+type Book struct {
+	Identifier  string // Stable across compilations, e.g. "urn:fla:category:a1"
+	Title       string
+	Description string
+	Language    shared.Locale
+	Publisher   string                 // The site name
+	Authors     []kernel.ID[user.User] // Post owners in order of first chapter; adapters resolve names
+	Cover       *Cover
+	Chapters    []Chapter
+	Modified    time.Time // Latest update among the chapters
+}
+
+// Cover is the book's cover image.
+type Cover struct {
+	URL string
+	Alt string
+}
+
+// Chapter is one post of the book. Content is the post's Markdown; the output
+// adapter converts it to XHTML.
+type Chapter struct {
+	ID          string // Spine item ID, e.g. "chapter-001"
+	PostID      kernel.ID[post.Post]
+	Title       string
+	Description string
+	Section     string // Category path names, e.g. "A1 › Lecture", for the table of contents
+	Content     string
+	SourceURL   string // The lesson online
+}
+
+// String returns a string representation of the book.
+func (b Book) String() string {
+	return fmt.Sprintf("Book{Identifier: %q, Title: %q, Chapters: %d}", b.Identifier, b.Title, len(b.Chapters))
+}
+
+// Series is an ordered selection of posts compiled as one book; each post's
+// position is its chapter number.
+type Series struct {
+	Slug        shared.Slug // Names the book identifier
+	Title       shared.Title
+	Description shared.Description
+	PostIDs     []kernel.ID[post.Post]
+}
+
+// Validate ensures the series names a book and lists each post once.
+func (s Series) Validate() error {
+	const op = "Series.Validate"
+
+	if err := s.Slug.Validate(); err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if err := s.Title.Validate(); err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if err := s.Description.Validate(); err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if len(s.PostIDs) == 0 {
+		return &kernel.Error{Code: kernel.EInvalid, Message: MSeriesPostsMissed, Operation: op}
+	}
+	if len(s.PostIDs) > MaxSeriesPosts {
+		return &kernel.Error{Code: kernel.EInvalid, Message: fmt.Sprintf(MSeriesTooLong, MaxSeriesPosts), Operation: op}
+	}
+
+	seen := make(map[kernel.ID[post.Post]]bool, len(s.PostIDs))
+	for _, id := range s.PostIDs {
+		if seen[id] {
+			return &kernel.Error{Code: kernel.EInvalid, Message: fmt.Sprintf(MSeriesPostRepeat, id), Operation: op}
+		}
+		seen[id] = true
+	}
+
+	return nil
+}
+
+// Writer turns a book into a file, e.g. an EPUB container.
+// Implemented by output adapters.
+type Writer interface {
+	WriteBook(book Book) error
+}
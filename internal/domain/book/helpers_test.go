@@ -0,0 +1,145 @@
+package book_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/book"
+	"github.com/alnah/fla/internal/domain/category"
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/post"
+	"github.com/alnah/fla/internal/domain/shared"
+)
+
+type stubClock struct {
+	t time.Time
+}
+
+func (s *stubClock) Now() time.Time { return s.t }
+
+// stubPosts returns its posts in the order given, filtered by status and
+// category subtree as a repository would.
+type stubPosts struct {
+	posts      []post.Post
+	categories []category.Category
+	query      post.Query
+}
+
+func (s *stubPosts) GetByID(id kernel.ID[post.Post]) (*post.Post, error) {
+	for _, p := range s.posts {
+		if p.PostID == id {
+			return &p, nil
+		}
+	}
+	return nil, &kernel.Error{Code: kernel.ENotFound, Message: "post not found"}
+}
+
+func (s *stubPosts) GetBySlug(shared.Slug) (*post.Post, error) {
+	return nil, &kernel.Error{Code: kernel.ENotFound, Message: "post not found"}
+}
+
+func (s *stubPosts) Find(q post.Query) (post.PostsList, error) {
+	s.query = q
+	tree, err := category.NewCategoryTree(s.categories)
+	if err != nil {
+		return post.PostsList{}, err
+	}
+
+	var out []post.Post
+	for _, p := range s.posts {
+		if p.IsPublished() && (q.CategorySubtree == nil || tree.IsWithin(p.Category.CategoryID, *q.CategorySubtree)) {
+			out = append(out, p)
+		}
+	}
+	return post.NewPostsList(out, shared.Pagination{Page: 1, Limit: q.Pagination.Limit, TotalItems: len(out), TotalPages: 1}), nil
+}
+
+type stubCategories struct {
+	all []category.Category
+}
+
+func (s *stubCategories) GetByID(id kernel.ID[category.Category]) (*category.Category, error) {
+	for _, c := range s.all {
+		if c.CategoryID == id {
+			return &c, nil
+		}
+	}
+	return nil, &kernel.Error{Code: kernel.ENotFound, Message: "category not found"}
+}
+
+func (s *stubCategories) GetAll() ([]category.Category, error) { return s.all, nil }
+
+type stubWriter struct {
+	books []book.Book
+}
+
+func (s *stubWriter) WriteBook(b book.Book) error {
+	s.books = append(s.books, b)
+	return nil
+}
+
+var fixtureNow = time.Date(2024, 9, 2, 8, 0, 0, 0, time.UTC)
+
+// fixtureCategories is A1 with Lecture (first) and Grammaire (second) below it.
+func fixtureCategories() []category.Category {
+	a1ID := kernel.ID[category.Category]("a1")
+	return []category.Category{
+		{CategoryID: a1ID, Name: "A1", Slug: "a1", Description: "Premiers pas en français.", CreatedBy: "author-1"},
+		{CategoryID: "grammar", Name: "Grammaire", Slug: "grammaire", ParentID: &a1ID, SortOrder: 2, CreatedBy: "author-1"},
+		{CategoryID: "reading", Name: "Lecture", Slug: "lecture", ParentID: &a1ID, SortOrder: 1, CreatedBy: "author-1"},
+		{CategoryID: "b1", Name: "B1", Slug: "b1", CreatedBy: "author-1"},
+	}
+}
+
+func testPost(t *testing.T, id, title string, categoryID kernel.ID[category.Category], status post.Status) post.Post {
+	t.Helper()
+
+	var cat category.Category
+	for _, c := range fixtureCategories() {
+		if c.CategoryID == categoryID {
+			cat = c
+		}
+	}
+
+	content, _ := post.NewPostContent(strings.Repeat("Le menu du jour propose une soupe. ", 10))
+	p, err := post.NewPost(post.NewPostParams{
+		PostID:   kernel.ID[post.Post](id),
+		Owner:    "author-1",
+		Title:    shared.Title(title),
+		Content:  content,
+		Status:   post.StatusDraft,
+		Category: cat,
+		Clock:    &stubClock{t: fixtureNow},
+	})
+	assertNoError(t, err)
+	p.Status = status
+	return p
+}
+
+func newService(t *testing.T, posts *stubPosts, writer *stubWriter) *book.BookService {
+	t.Helper()
+
+	site, err := shared.NewSite("Français Facile", "https://fla.example", shared.DefaultLocale)
+	assertNoError(t, err)
+
+	posts.categories = fixtureCategories()
+	s, err := book.NewBookService(posts, &stubCategories{all: fixtureCategories()}, writer, site)
+	assertNoError(t, err)
+	return s
+}
+
+func assertNoError(t *testing.T, err error) {
+	t.Helper()
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+}
+
+func assertErrorCode(t *testing.T, err error, want string) {
+	t.Helper()
+	got := kernel.ErrorCode(err)
+	if got != want {
+		t.Errorf("error code: got %q, want %q", got, want)
+	}
+}
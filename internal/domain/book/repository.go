@@ -0,0 +1,9 @@
+package book
+
+import "github.com/alnah/fla/internal/domain/post"
+
+// PostStore reads posts one by one for series and by category for subtrees.
+type PostStore interface {
+	post.PostReader
+	post.PostFinder
+}
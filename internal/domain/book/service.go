@@ -0,0 +1,220 @@
+package book
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/alnah/fla/internal/domain/category"
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/post"
+	"github.com/alnah/fla/internal/domain/seo"
+	"github.com/alnah/fla/internal/domain/shared"
+)
+
+// sectionSeparator joins category names in chapter sections.
+const sectionSeparator = " › "
+
+const (
+	MBookCategoryNotFound string = "Category not found."
+	MBookPostNotLive      string = "Post %s is not published."
+)
+
+// BookService compiles published lessons into books and hands them to an
+// output adapter. Drafts and archived posts are never compiled.
+type BookService struct {
+	posts      PostStore
+	categories category.CategoryReader
+	writer     Writer
+	site       shared.Site
+}
+
+// NewBookService creates book service with post and category lookups, the
+// output adapter, and the site that publishes the books.
+func NewBookService(posts PostStore, categories category.CategoryReader, writer Writer, site shared.Site) (*BookService, error) {
+	const op = "NewBookService"
+
+	if err := site.Validate(); err != nil {
+		return nil, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return &BookService{posts: posts, categories: categories, writer: writer, site: site}, nil
+}
+
+// CompileCategory compiles a category and its subcategories. Chapters follow
+// the navigation menu: the category's own lessons first, then each
+// subcategory's, depth first in display order; lessons of a category come in
+// publication order, oldest first. Titles and descriptions come from the
+// category; sections name the subcategory of each chapter.
+func (s *BookService) CompileCategory(categoryID kernel.ID[category.Category]) (Book, error) {
+	const op = "BookService.CompileCategory"
+
+	tree, err := category.LoadCategoryTree(s.categories)
+	if err != nil {
+		return Book{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	root, ok := tree.Get(categoryID)
+	if !ok {
+		return Book{}, &kernel.Error{Code: kernel.ENotFound, Message: MBookCategoryNotFound, Operation: op}
+	}
+
+	posts, err := s.publishedPosts(categoryID)
+	if err != nil {
+		return Book{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	byCategory := make(map[kernel.ID[category.Category]][]post.Post)
+	for _, p := range posts {
+		byCategory[p.Category.CategoryID] = append(byCategory[p.Category.CategoryID], p)
+	}
+
+	rootDepth := len(tree.Ancestors(categoryID))
+	var spine []post.Post
+	var sections []string
+	for _, c := range tree.Subtree(categoryID) {
+		path, _ := tree.Path(c.CategoryID)
+		section := sectionOf(path[rootDepth+1:])
+		for _, p := range byCategory[c.CategoryID] {
+			spine = append(spine, p)
+			sections = append(sections, section)
+		}
+	}
+
+	b := Book{
+		Identifier:  "urn:fla:category:" + categoryID.String(),
+		Title:       root.Name.String(),
+		Description: root.Description.String(),
+	}
+	if err := s.fill(&b, tree, spine, sections); err != nil {
+		return Book{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return b, nil
+}
+
+// CompileSeries compiles a series, one chapter per post in series order.
+// Sections name each post's full category path.
+func (s *BookService) CompileSeries(series Series) (Book, error) {
+	const op = "BookService.CompileSeries"
+
+	if err := series.Validate(); err != nil {
+		return Book{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	tree, err := category.LoadCategoryTree(s.categories)
+	if err != nil {
+		return Book{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	spine := make([]post.Post, 0, len(series.PostIDs))
+	sections := make([]string, 0, len(series.PostIDs))
+	for _, id := range series.PostIDs {
+		p, err := s.posts.GetByID(id)
+		if err != nil {
+			return Book{}, &kernel.Error{Operation: op, Cause: err}
+		}
+		if !p.IsPublished() {
+			return Book{}, &kernel.Error{Code: kernel.EInvalid, Message: fmt.Sprintf(MBookPostNotLive, id), Operation: op}
+		}
+
+		path, _ := tree.Path(p.Category.CategoryID)
+		spine = append(spine, *p)
+		sections = append(sections, sectionOf(path))
+	}
+
+	b := Book{
+		Identifier:  "urn:fla:series:" + series.Slug.String(),
+		Title:       series.Title.String(),
+		Description: series.Description.String(),
+	}
+	if err := s.fill(&b, tree, spine, sections); err != nil {
+		return Book{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return b, nil
+}
+
+// Export hands a compiled book to the output adapter.
+func (s *BookService) Export(b Book) error {
+	const op = "BookService.Export"
+
+	if len(b.Chapters) == 0 {
+		return &kernel.Error{Code: kernel.EInvalid, Message: MBookEmpty, Operation: op}
+	}
+
+	if err := s.writer.WriteBook(b); err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+	return nil
+}
+
+// fill sets the site metadata, chapters, authors, cover, and modification
+// date of a book from its posts in spine order. The cover is the first
+// featured image, so a series opens on its first illustrated lesson.
+func (s *BookService) fill(b *Book, tree category.CategoryTree, spine []post.Post, sections []string) error {
+	const op = "BookService.fill"
+
+	if len(spine) == 0 {
+		return &kernel.Error{Code: kernel.ENotFound, Message: MBookEmpty, Operation: op}
+	}
+
+	b.Language = s.site.Locale
+	b.Publisher = s.site.Name
+	if b.Description == "" {
+		b.Description = seo.EffectiveDescription(spine[0])
+	}
+
+	for i, p := range spine {
+		path, _ := tree.Path(p.Category.CategoryID)
+		b.Chapters = append(b.Chapters, Chapter{
+			ID:          fmt.Sprintf("chapter-%03d", i+1),
+			PostID:      p.PostID,
+			Title:       seo.EffectiveTitle(p),
+			Description: seo.EffectiveDescription(p),
+			Section:     sections[i],
+			Content:     p.Content.String(),
+			SourceURL:   s.site.URL(p.URLPath(path)),
+		})
+
+		if !slices.Contains(b.Authors, p.Owner) {
+			b.Authors = append(b.Authors, p.Owner)
+		}
+		if b.Cover == nil && p.FeaturedImage != "" {
+			b.Cover = &Cover{URL: p.FeaturedImage.String(), Alt: b.Title}
+		}
+		if p.UpdatedAt.After(b.Modified) {
+			b.Modified = p.UpdatedAt
+		}
+	}
+
+	return nil
+}
+
+// publishedPosts pages through the published posts of a category subtree,
+// oldest first.
+func (s *BookService) publishedPosts(categoryID kernel.ID[category.Category]) ([]post.Post, error) {
+	const op = "BookService.publishedPosts"
+
+	query := post.PublishedQuery().InCategory(categoryID).SortBy(post.SortOldest...)
+	var posts []post.Post
+	for page := 1; ; page++ {
+		list, err := s.posts.Find(query.Page(page, shared.MaxPageLimit))
+		if err != nil {
+			return nil, &kernel.Error{Operation: op, Cause: err}
+		}
+		posts = append(posts, list.Posts...)
+		if list.IsEmpty() || !list.Pagination.HasNextPage() {
+			return posts, nil
+		}
+	}
+}
+
+// sectionOf joins category names, e.g. "A1 › Lecture".
+func sectionOf(path category.CategoryPath) string {
+	names := make([]string, len(path))
+	for i, c := range path {
+		names[i] = c.Name.String()
+	}
+	return strings.Join(names, sectionSeparator)
+}
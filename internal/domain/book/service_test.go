@@ -0,0 +1,145 @@
+package book_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/book"
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/post"
+	"github.com/alnah/fla/internal/domain/shared"
+)
+
+func TestCompileCategory(t *testing.T) {
+	t.Run("orders chapters by menu then publication", func(t *testing.T) {
+		grammar := testPost(t, "p1", "Le présent de l’indicatif", "grammar", post.StatusPublished)
+		reading := testPost(t, "p2", "Lire un menu", "reading", post.StatusPublished)
+		reading.FeaturedImage = "https://fla.example/img/menu.jpg"
+		intro := testPost(t, "p3", "Bienvenue au niveau A1", "a1", post.StatusPublished)
+		draft := testPost(t, "p4", "Brouillon en cours", "reading", post.StatusDraft)
+		other := testPost(t, "p5", "Au travail en équipe", "b1", post.StatusPublished)
+		posts := &stubPosts{posts: []post.Post{grammar, reading, intro, draft, other}}
+
+		b, err := newService(t, posts, &stubWriter{}).CompileCategory("a1")
+		assertNoError(t, err)
+
+		if posts.query.CategorySubtree == nil || *posts.query.CategorySubtree != "a1" {
+			t.Errorf("query subtree: got %v, want a1", posts.query.CategorySubtree)
+		}
+
+		want := []struct {
+			id      kernel.ID[post.Post]
+			section string
+		}{{"p3", ""}, {"p2", "Lecture"}, {"p1", "Grammaire"}}
+		if len(b.Chapters) != len(want) {
+			t.Fatalf("chapters: got %d, want %d", len(b.Chapters), len(want))
+		}
+		for i, w := range want {
+			if b.Chapters[i].PostID != w.id || b.Chapters[i].Section != w.section {
+				t.Errorf("chapter %d: got %s in %q, want %s in %q", i, b.Chapters[i].PostID, b.Chapters[i].Section, w.id, w.section)
+			}
+		}
+
+		if b.Identifier != "urn:fla:category:a1" || b.Title != "A1" || b.Description != "Premiers pas en français." {
+			t.Errorf("metadata: got %+v", b)
+		}
+		if b.Publisher != "Français Facile" || b.Language != shared.DefaultLocale {
+			t.Errorf("publisher and language: got %q, %q", b.Publisher, b.Language)
+		}
+		if b.Cover == nil || b.Cover.URL != "https://fla.example/img/menu.jpg" {
+			t.Errorf("cover: got %+v", b.Cover)
+		}
+		if got := b.Chapters[1]; got.ID != "chapter-002" || got.SourceURL != "https://fla.example/a1/lecture/"+reading.Slug.String() {
+			t.Errorf("chapter: got %s at %s", got.ID, got.SourceURL)
+		}
+	})
+
+	t.Run("uses SEO fields for chapter metadata", func(t *testing.T) {
+		p := testPost(t, "p1", "Lire un menu", "reading", post.StatusPublished)
+		p.SEOTitle = "Comprendre un menu de restaurant"
+		p.SEODescription = "Lisez un menu et commandez."
+		posts := &stubPosts{posts: []post.Post{p}}
+
+		b, err := newService(t, posts, &stubWriter{}).CompileCategory("reading")
+		assertNoError(t, err)
+
+		got := b.Chapters[0]
+		if got.Title != "Comprendre un menu de restaurant" || got.Description != "Lisez un menu et commandez." {
+			t.Errorf("chapter metadata: got %q, %q", got.Title, got.Description)
+		}
+		if b.Description != "Lisez un menu et commandez." {
+			t.Errorf("book description falls back to the first chapter: got %q", b.Description)
+		}
+	})
+
+	t.Run("rejects unknown and empty categories", func(t *testing.T) {
+		s := newService(t, &stubPosts{}, &stubWriter{})
+
+		_, err := s.CompileCategory("c2")
+		assertErrorCode(t, err, kernel.ENotFound)
+
+		_, err = s.CompileCategory("b1")
+		assertErrorCode(t, err, kernel.ENotFound)
+	})
+}
+
+func TestCompileSeries(t *testing.T) {
+	a := testPost(t, "p1", "Le présent de l’indicatif", "grammar", post.StatusPublished)
+	b := testPost(t, "p2", "Lire un menu", "reading", post.StatusPublished)
+	b.UpdatedAt = fixtureNow.Add(24 * time.Hour)
+	draft := testPost(t, "p3", "Brouillon en cours", "reading", post.StatusDraft)
+	series := book.Series{Slug: "au-restaurant", Title: "Au restaurant", PostIDs: []kernel.ID[post.Post]{"p2", "p1"}}
+
+	t.Run("follows series order", func(t *testing.T) {
+		s := newService(t, &stubPosts{posts: []post.Post{a, b, draft}}, &stubWriter{})
+
+		got, err := s.CompileSeries(series)
+		assertNoError(t, err)
+
+		if got.Identifier != "urn:fla:series:au-restaurant" || got.Title != "Au restaurant" {
+			t.Errorf("metadata: got %q, %q", got.Identifier, got.Title)
+		}
+		if len(got.Chapters) != 2 || got.Chapters[0].PostID != "p2" || got.Chapters[1].PostID != "p1" {
+			t.Fatalf("spine: got %v", got.Chapters)
+		}
+		if got.Chapters[0].Section != "A1 › Lecture" {
+			t.Errorf("section: got %q", got.Chapters[0].Section)
+		}
+		if !got.Modified.Equal(b.UpdatedAt) {
+			t.Errorf("modified: got %v, want %v", got.Modified, b.UpdatedAt)
+		}
+		if len(got.Authors) != 1 || got.Authors[0] != "author-1" {
+			t.Errorf("authors: got %v", got.Authors)
+		}
+	})
+
+	t.Run("rejects unpublished posts", func(t *testing.T) {
+		s := newService(t, &stubPosts{posts: []post.Post{a, b, draft}}, &stubWriter{})
+
+		withDraft := series
+		withDraft.PostIDs = []kernel.ID[post.Post]{"p1", "p3"}
+		_, err := s.CompileSeries(withDraft)
+		assertErrorCode(t, err, kernel.EInvalid)
+	})
+
+	t.Run("rejects repeated posts", func(t *testing.T) {
+		repeated := series
+		repeated.PostIDs = []kernel.ID[post.Post]{"p1", "p1"}
+		assertErrorCode(t, repeated.Validate(), kernel.EInvalid)
+	})
+}
+
+func TestExport(t *testing.T) {
+	writer := &stubWriter{}
+	p := testPost(t, "p1", "Lire un menu", "reading", post.StatusPublished)
+	s := newService(t, &stubPosts{posts: []post.Post{p}}, writer)
+
+	b, err := s.CompileCategory("reading")
+	assertNoError(t, err)
+	assertNoError(t, s.Export(b))
+	if len(writer.books) != 1 || writer.books[0].Identifier != b.Identifier {
+		t.Errorf("written: got %v", writer.books)
+	}
+
+	assertErrorCode(t, s.Export(book.Book{}), kernel.EInvalid)
+}
@@ -0,0 +1,105 @@
+package category
+
+import (
+	"sync"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+)
+
+// PathCache keeps resolved category paths in memory in front of a repository.
+// Paths change rarely but are read on every page, so caching them spares a walk
+// up the hierarchy per request. Writes through the cache clear it whole, since
+// renaming or moving one category changes the paths of all its descendants.
+//
+// PathCache implements Repository, so it can be passed wherever one is expected.
+// Writes made to the repository behind its back call for an explicit Invalidate.
+type PathCache struct {
+	Repository
+
+	mu    sync.RWMutex
+	paths map[kernel.ID[Category]]CategoryPath
+}
+
+// NewPathCache creates an empty path cache in front of repository.
+func NewPathCache(repository Repository) *PathCache {
+	return &PathCache{Repository: repository, paths: make(map[kernel.ID[Category]]CategoryPath)}
+}
+
+// BuildPath returns the cached path or resolves and caches it.
+func (c *PathCache) BuildPath(categoryID kernel.ID[Category]) (CategoryPath, error) {
+	c.mu.RLock()
+	path, ok := c.paths[categoryID]
+	c.mu.RUnlock()
+	if ok {
+		return path, nil
+	}
+
+	path, err := c.Repository.BuildPath(categoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	c.paths[categoryID] = path
+	c.mu.Unlock()
+	return path, nil
+}
+
+// BuildPaths serves cached paths and resolves the missing ones in a single repository call.
+func (c *PathCache) BuildPaths(categoryIDs []kernel.ID[Category]) (map[kernel.ID[Category]]CategoryPath, error) {
+	paths := make(map[kernel.ID[Category]]CategoryPath, len(categoryIDs))
+	var missing []kernel.ID[Category]
+
+	c.mu.RLock()
+	for _, id := range categoryIDs {
+		if path, ok := c.paths[id]; ok {
+			paths[id] = path
+		} else {
+			missing = append(missing, id)
+		}
+	}
+	c.mu.RUnlock()
+
+	if len(missing) == 0 {
+		return paths, nil
+	}
+
+	resolved, err := c.Repository.BuildPaths(missing)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	for id, path := range resolved {
+		c.paths[id] = path
+		paths[id] = path
+	}
+	c.mu.Unlock()
+	return paths, nil
+}
+
+// Create adds a category and clears the cache.
+func (c *PathCache) Create(category Category) error {
+	defer c.Invalidate()
+	return c.Repository.Create(category)
+}
+
+// Update saves a category and clears the cache.
+func (c *PathCache) Update(category Category) error {
+	defer c.Invalidate()
+	return c.Repository.Update(category)
+}
+
+// Delete removes a category and clears the cache.
+func (c *PathCache) Delete(categoryID kernel.ID[Category]) error {
+	defer c.Invalidate()
+	return c.Repository.Delete(categoryID)
+}
+
+// Invalidate forgets every cached path.
+func (c *PathCache) Invalidate() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	clear(c.paths)
+}
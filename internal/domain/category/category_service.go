@@ -0,0 +1,123 @@
+package category
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+)
+
+const (
+	MCategoryManageForbidden  string = "Only editors and admins can manage categories."
+	MCategoryReorderNotChild  string = "Category %q is not a child of this parent."
+	MCategoryReorderDuplicate string = "Category %q is listed more than once."
+	MCategoryReorderMissing   string = "Reorder must list every sibling category; %d missing."
+)
+
+// Curator represents a user allowed to restructure the category tree.
+// Implemented by user.User.
+type Curator interface {
+	CanManageCategories() bool
+}
+
+// CategoryService manages the structure of the category tree.
+// Keeps curriculum sequencing (A1 before A2, Reading before Writing) under editorial control.
+type CategoryService struct {
+	repository CategoryOrganizer
+	posts      CategoryContent
+}
+
+// NewCategoryService creates category service with category and post content dependencies.
+func NewCategoryService(repository CategoryOrganizer, posts CategoryContent) *CategoryService {
+	return &CategoryService{
+		repository: repository,
+		posts:      posts,
+	}
+}
+
+// Reorder sets the display order of a parent's children to the given sequence.
+// A nil parent reorders root categories. Every sibling must be listed exactly once.
+func (s *CategoryService) Reorder(
+	parentID *kernel.ID[Category],
+	orderedIDs []kernel.ID[Category],
+	actor Curator,
+) ([]Category, error) {
+	const op = "CategoryService.Reorder"
+
+	if !actor.CanManageCategories() {
+		return nil, &kernel.Error{Code: kernel.EForbidden, Message: MCategoryManageForbidden, Operation: op}
+	}
+
+	siblings, err := s.siblings(parentID)
+	if err != nil {
+		return nil, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	byID := make(map[kernel.ID[Category]]Category, len(siblings))
+	for _, c := range siblings {
+		byID[c.CategoryID] = c
+	}
+
+	reordered := make([]Category, 0, len(orderedIDs))
+	seen := make(map[kernel.ID[Category]]bool, len(orderedIDs))
+	for i, id := range orderedIDs {
+		c, ok := byID[id]
+		if !ok {
+			return nil, &kernel.Error{
+				Code:      kernel.EInvalid,
+				Message:   fmt.Sprintf(MCategoryReorderNotChild, id),
+				Operation: op,
+			}
+		}
+		if seen[id] {
+			return nil, &kernel.Error{
+				Code:      kernel.EInvalid,
+				Message:   fmt.Sprintf(MCategoryReorderDuplicate, id),
+				Operation: op,
+			}
+		}
+		seen[id] = true
+
+		c.SortOrder = i
+		reordered = append(reordered, c)
+	}
+
+	if missing := len(siblings) - len(reordered); missing > 0 {
+		return nil, &kernel.Error{
+			Code:      kernel.EInvalid,
+			Message:   fmt.Sprintf(MCategoryReorderMissing, missing),
+			Operation: op,
+		}
+	}
+
+	if err := s.repository.UpdateSortOrders(reordered); err != nil {
+		return nil, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return reordered, nil
+}
+
+// siblings returns the children of the parent, or the roots when parent is nil.
+func (s *CategoryService) siblings(parentID *kernel.ID[Category]) ([]Category, error) {
+	if parentID == nil {
+		return s.repository.GetRootCategories()
+	}
+
+	if _, err := s.repository.GetByID(*parentID); err != nil {
+		return nil, err
+	}
+
+	return s.repository.GetChildren(*parentID)
+}
+
+// SortCategories orders siblings for display: by SortOrder, then by name.
+// Repositories use it so in-memory and database ordering agree.
+func SortCategories(categories []Category) {
+	slices.SortStableFunc(categories, func(a, b Category) int {
+		return cmp.Or(
+			cmp.Compare(a.SortOrder, b.SortOrder),
+			cmp.Compare(a.Name, b.Name),
+		)
+	})
+}
@@ -0,0 +1,161 @@
+package category_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/alnah/fla/internal/domain/category"
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+type stubCurator bool
+
+func (s stubCurator) CanManageCategories() bool { return bool(s) }
+
+type stubCategoryContent struct {
+	counts map[kernel.ID[category.Category]]int
+}
+
+func (s *stubCategoryContent) CountPostsInCategory(id kernel.ID[category.Category]) (int, error) {
+	return s.counts[id], nil
+}
+
+func (s *stubCategoryContent) ReassignPosts(from, to kernel.ID[category.Category]) (int, error) {
+	moved := s.counts[from]
+	s.counts[to] += moved
+	delete(s.counts, from)
+	return moved, nil
+}
+
+func setupCategoryService() (*category.CategoryService, *mockRepository) {
+	service, repo, _ := setupCategoryServiceWithPosts(nil)
+	return service, repo
+}
+
+func setupCategoryServiceWithPosts(
+	counts map[kernel.ID[category.Category]]int,
+) (*category.CategoryService, *mockRepository, *stubCategoryContent) {
+	a1ID := "a1"
+	a1 := createTestCategory("a1", "A1", nil)
+	a2 := createTestCategory("a2", "A2", nil)
+
+	readingID := "reading"
+	reading := createTestCategory("reading", "Compréhension écrite", &a1ID)
+
+	repo := &mockRepository{
+		categories: map[string]category.Category{"a1": a1, "a2": a2, "reading": reading},
+		children: map[string][]category.Category{
+			"": {a1, a2},
+			"a1": {
+				createTestCategory("writing", "Production écrite", &a1ID),
+				reading,
+				createTestCategory("listening", "Compréhension orale", &a1ID),
+			},
+			"reading": {
+				createTestCategory("sports", "Sports", &readingID),
+			},
+		},
+	}
+	if counts == nil {
+		counts = make(map[kernel.ID[category.Category]]int)
+	}
+	posts := &stubCategoryContent{counts: counts}
+
+	return category.NewCategoryService(repo, posts), repo, posts
+}
+
+func TestCategoryService_Reorder(t *testing.T) {
+	a1 := kernel.ID[category.Category]("a1")
+
+	t.Run("assigns sort order in the given sequence", func(t *testing.T) {
+		service, repo := setupCategoryService()
+		order := []kernel.ID[category.Category]{"reading", "writing", "listening"}
+
+		got, err := service.Reorder(&a1, order, stubCurator(true))
+
+		assertNoError(t, err)
+		for i, c := range got {
+			if c.CategoryID != order[i] || c.SortOrder != i {
+				t.Errorf("position %d: got %s with order %d", i, c.CategoryID, c.SortOrder)
+			}
+		}
+		if len(repo.saved) != len(order) {
+			t.Errorf("saved %d categories, want %d", len(repo.saved), len(order))
+		}
+	})
+
+	t.Run("reorders root categories when parent is nil", func(t *testing.T) {
+		service, _ := setupCategoryService()
+
+		got, err := service.Reorder(nil, []kernel.ID[category.Category]{"a2", "a1"}, stubCurator(true))
+
+		assertNoError(t, err)
+		if got[0].CategoryID != "a2" || got[1].SortOrder != 1 {
+			t.Errorf("got %v", got)
+		}
+	})
+
+	t.Run("rejects invalid sequences", func(t *testing.T) {
+		tests := []struct {
+			name  string
+			order []kernel.ID[category.Category]
+		}{
+			{"category from another parent", []kernel.ID[category.Category]{"reading", "writing", "a2"}},
+			{"duplicate category", []kernel.ID[category.Category]{"reading", "reading", "writing"}},
+			{"missing sibling", []kernel.ID[category.Category]{"reading", "writing"}},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				service, repo := setupCategoryService()
+
+				_, err := service.Reorder(&a1, tt.order, stubCurator(true))
+
+				assertError(t, err)
+				assertErrorCode(t, err, kernel.EInvalid)
+				if repo.saved != nil {
+					t.Error("expected nothing to be saved")
+				}
+			})
+		}
+	})
+
+	t.Run("rejects unknown parent", func(t *testing.T) {
+		service, _ := setupCategoryService()
+		missing := kernel.ID[category.Category]("c2")
+
+		_, err := service.Reorder(&missing, nil, stubCurator(true))
+
+		assertErrorCode(t, err, kernel.ENotFound)
+	})
+
+	t.Run("requires category management rights", func(t *testing.T) {
+		service, _ := setupCategoryService()
+		author := user.User{ID: "author-1", Roles: []user.Role{user.RoleAuthor}}
+
+		_, err := service.Reorder(nil, []kernel.ID[category.Category]{"a2", "a1"}, author)
+
+		assertErrorCode(t, err, kernel.EForbidden)
+	})
+}
+
+func TestSortCategories(t *testing.T) {
+	categories := []category.Category{
+		{CategoryID: "b1", Name: "B1", SortOrder: 2},
+		{CategoryID: "a2", Name: "A2", SortOrder: 1},
+		{CategoryID: "a1-bis", Name: "A1 bis", SortOrder: 0},
+		{CategoryID: "a1", Name: "A1", SortOrder: 0},
+	}
+
+	category.SortCategories(categories)
+
+	var got []kernel.ID[category.Category]
+	for _, c := range categories {
+		got = append(got, c.CategoryID)
+	}
+	want := []kernel.ID[category.Category]{"a1", "a1-bis", "a2", "b1"}
+	if !slices.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,192 @@
+package category
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/shared"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+const (
+	MaxCopyContentLength int = 2000
+
+	MCopyPositionInvalid string = "Invalid copy block position."
+	MCopyVersionInvalid  string = "Copy block version must be positive."
+)
+
+// CopyPosition places a curated block on the category landing page.
+type CopyPosition string
+
+const (
+	CopyIntro CopyPosition = "intro" // Shown above the post list
+	CopyOutro CopyPosition = "outro" // Shown below the post list
+)
+
+func (p CopyPosition) String() string { return string(p) }
+
+// Validate ensures the position is supported by landing page layouts.
+func (p CopyPosition) Validate() error {
+	const op = "CopyPosition.Validate"
+
+	switch p {
+	case CopyIntro, CopyOutro:
+		return nil
+	default:
+		return &kernel.Error{Code: kernel.EInvalid, Message: MCopyPositionInvalid, Operation: op}
+	}
+}
+
+// CopyContent is the markdown body of a landing page copy block.
+type CopyContent string
+
+// NewCopyContent creates validated markdown copy.
+func NewCopyContent(content string) (CopyContent, error) {
+	const op = "NewCopyContent"
+
+	c := CopyContent(strings.TrimSpace(content))
+	if err := c.Validate(); err != nil {
+		return "", &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return c, nil
+}
+
+func (c CopyContent) String() string { return string(c) }
+
+// Validate ensures copy is present and short enough to introduce, not replace, the posts.
+func (c CopyContent) Validate() error {
+	const op = "CopyContent.Validate"
+
+	if err := kernel.ValidatePresence("copy content", c.String(), op); err != nil {
+		return err
+	}
+
+	return kernel.ValidateMaxLength("copy content", c.String(), MaxCopyContentLength, op)
+}
+
+// PlainText returns the copy without markdown, for meta descriptions and feeds.
+func (c CopyContent) PlainText() string {
+	return strings.TrimSpace(kernel.StripMarkdown(c.String()))
+}
+
+// CopyBlock is one version of a curated pedagogical introduction or conclusion.
+// Every edit creates a new version so editors can review and restore history.
+type CopyBlock struct {
+	// Identity
+	CategoryID kernel.ID[Category]
+	Locale     shared.Locale
+	Position   CopyPosition
+	Version    int // Starts at 1, incremented on every revision
+
+	// Data
+	Content CopyContent
+
+	// Meta
+	UpdatedBy kernel.ID[user.User]
+	UpdatedAt time.Time
+}
+
+// NewCopyBlockParams holds the information needed to write the first version of a block.
+type NewCopyBlockParams struct {
+	CategoryID kernel.ID[Category]
+	Locale     shared.Locale
+	Position   CopyPosition
+	Content    CopyContent
+	UpdatedBy  kernel.ID[user.User]
+
+	// DI
+	Clock kernel.Clock
+}
+
+// NewCopyBlock creates the first version of a landing page copy block.
+func NewCopyBlock(p NewCopyBlockParams) (CopyBlock, error) {
+	const op = "NewCopyBlock"
+
+	block := CopyBlock{
+		CategoryID: p.CategoryID,
+		Locale:     p.Locale,
+		Position:   p.Position,
+		Version:    1,
+		Content:    p.Content,
+		UpdatedBy:  p.UpdatedBy,
+		UpdatedAt:  p.Clock.Now(),
+	}
+
+	if err := block.Validate(); err != nil {
+		return CopyBlock{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return block, nil
+}
+
+// Validate enforces copy block consistency.
+func (b CopyBlock) Validate() error {
+	const op = "CopyBlock.Validate"
+
+	if err := b.CategoryID.Validate(); err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if err := b.Locale.Validate(); err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if err := b.Position.Validate(); err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if b.Version < 1 {
+		return &kernel.Error{Code: kernel.EInvalid, Message: MCopyVersionInvalid, Operation: op}
+	}
+
+	if err := b.Content.Validate(); err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if err := b.UpdatedBy.Validate(); err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return nil
+}
+
+// Revise returns the next version of the block with new content.
+func (b CopyBlock) Revise(content CopyContent, by kernel.ID[user.User], clock kernel.Clock) (CopyBlock, error) {
+	const op = "CopyBlock.Revise"
+
+	revised := b
+	revised.Content = content
+	revised.Version = b.Version + 1
+	revised.UpdatedBy = by
+	revised.UpdatedAt = clock.Now()
+
+	if err := revised.Validate(); err != nil {
+		return b, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return revised, nil
+}
+
+// String returns a string representation of the copy block.
+func (b CopyBlock) String() string {
+	return fmt.Sprintf(
+		"CopyBlock{CategoryID: %q, Locale: %q, Position: %q, Version: %d}",
+		b.CategoryID, b.Locale, b.Position, b.Version,
+	)
+}
+
+// LandingCopy is the curated copy rendered around a category's post list.
+// Consumed by the static-site export and API layers; nil blocks are simply omitted.
+type LandingCopy struct {
+	CategoryID kernel.ID[Category]
+	Intro      *CopyBlock
+	Outro      *CopyBlock
+}
+
+// IsEmpty reports whether the landing page has no curated copy.
+func (l LandingCopy) IsEmpty() bool {
+	return l.Intro == nil && l.Outro == nil
+}
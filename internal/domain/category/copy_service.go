@@ -0,0 +1,149 @@
+package category
+
+import (
+	"fmt"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/shared"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+const (
+	MCopyEditForbidden   string = "Only editors and admins can edit category copy."
+	MCopyVersionNotFound string = "Copy block version %d not found."
+)
+
+// CopyService manages curated intro and outro copy for category landing pages.
+// Editors write one block per locale and position; readers fall back to the default locale.
+type CopyService struct {
+	categories CategoryReader
+	blocks     CopyBlockRepository
+	clock      kernel.Clock
+}
+
+// NewCopyService creates copy service with category and copy block repositories.
+func NewCopyService(categories CategoryReader, blocks CopyBlockRepository, clock kernel.Clock) *CopyService {
+	return &CopyService{
+		categories: categories,
+		blocks:     blocks,
+		clock:      clock,
+	}
+}
+
+// Edit writes new copy for a category, creating the block or its next version.
+func (s *CopyService) Edit(
+	categoryID kernel.ID[Category],
+	locale shared.Locale,
+	position CopyPosition,
+	content CopyContent,
+	editor user.PostPermissionChecker,
+) (CopyBlock, error) {
+	const op = "CopyService.Edit"
+
+	if !editor.HasAnyRole(user.RoleAdmin, user.RoleEditor) {
+		return CopyBlock{}, &kernel.Error{Code: kernel.EForbidden, Message: MCopyEditForbidden, Operation: op}
+	}
+
+	if _, err := s.categories.GetByID(categoryID); err != nil {
+		return CopyBlock{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	current, err := s.blocks.GetCurrent(categoryID, locale, position)
+	if err != nil && kernel.ErrorCode(err) != kernel.ENotFound {
+		return CopyBlock{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	var block CopyBlock
+	if current == nil {
+		block, err = NewCopyBlock(NewCopyBlockParams{
+			CategoryID: categoryID,
+			Locale:     locale,
+			Position:   position,
+			Content:    content,
+			UpdatedBy:  editor.GetID(),
+			Clock:      s.clock,
+		})
+	} else {
+		block, err = current.Revise(content, editor.GetID(), s.clock)
+	}
+	if err != nil {
+		return CopyBlock{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if err := s.blocks.Save(block); err != nil {
+		return CopyBlock{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return block, nil
+}
+
+// Restore republishes the content of an earlier version as the newest version.
+// History is never rewritten, so restoring is itself auditable.
+func (s *CopyService) Restore(
+	categoryID kernel.ID[Category],
+	locale shared.Locale,
+	position CopyPosition,
+	version int,
+	editor user.PostPermissionChecker,
+) (CopyBlock, error) {
+	const op = "CopyService.Restore"
+
+	history, err := s.blocks.GetHistory(categoryID, locale, position)
+	if err != nil {
+		return CopyBlock{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	for _, b := range history {
+		if b.Version == version {
+			return s.Edit(categoryID, locale, position, b.Content, editor)
+		}
+	}
+
+	return CopyBlock{}, &kernel.Error{
+		Code:      kernel.ENotFound,
+		Message:   fmt.Sprintf(MCopyVersionNotFound, version),
+		Operation: op,
+	}
+}
+
+// Landing returns the current intro and outro for a category in the requested locale.
+// Each block falls back to the default locale independently when not translated yet.
+func (s *CopyService) Landing(categoryID kernel.ID[Category], locale shared.Locale) (LandingCopy, error) {
+	const op = "CopyService.Landing"
+
+	landing := LandingCopy{CategoryID: categoryID}
+
+	intro, err := s.current(categoryID, locale, CopyIntro)
+	if err != nil {
+		return LandingCopy{}, &kernel.Error{Operation: op, Cause: err}
+	}
+	landing.Intro = intro
+
+	outro, err := s.current(categoryID, locale, CopyOutro)
+	if err != nil {
+		return LandingCopy{}, &kernel.Error{Operation: op, Cause: err}
+	}
+	landing.Outro = outro
+
+	return landing, nil
+}
+
+// current loads a block with default-locale fallback; nil when none exists.
+func (s *CopyService) current(categoryID kernel.ID[Category], locale shared.Locale, position CopyPosition) (*CopyBlock, error) {
+	locales := []shared.Locale{locale.GetEffectiveLocale()}
+	if locales[0] != shared.DefaultLocale {
+		locales = append(locales, shared.DefaultLocale)
+	}
+
+	for _, l := range locales {
+		block, err := s.blocks.GetCurrent(categoryID, l, position)
+		if err == nil {
+			return block, nil
+		}
+		if kernel.ErrorCode(err) != kernel.ENotFound {
+			return nil, err
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,168 @@
+package category_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/category"
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/shared"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+type copyKey struct {
+	categoryID kernel.ID[category.Category]
+	locale     shared.Locale
+	position   category.CopyPosition
+}
+
+type stubCopyBlocks struct {
+	history map[copyKey][]category.CopyBlock
+}
+
+func (s *stubCopyBlocks) GetCurrent(id kernel.ID[category.Category], locale shared.Locale, position category.CopyPosition) (*category.CopyBlock, error) {
+	versions := s.history[copyKey{id, locale, position}]
+	if len(versions) == 0 {
+		return nil, &kernel.Error{Code: kernel.ENotFound, Message: "copy not found"}
+	}
+	latest := versions[len(versions)-1]
+	return &latest, nil
+}
+
+func (s *stubCopyBlocks) GetHistory(id kernel.ID[category.Category], locale shared.Locale, position category.CopyPosition) ([]category.CopyBlock, error) {
+	return s.history[copyKey{id, locale, position}], nil
+}
+
+func (s *stubCopyBlocks) Save(block category.CopyBlock) error {
+	if s.history == nil {
+		s.history = make(map[copyKey][]category.CopyBlock)
+	}
+	key := copyKey{block.CategoryID, block.Locale, block.Position}
+	if len(s.history[key]) >= block.Version {
+		return &kernel.Error{Code: kernel.EConflict, Message: "version exists"}
+	}
+	s.history[key] = append(s.history[key], block)
+	return nil
+}
+
+func setupCopyService(t *testing.T) (*category.CopyService, *stubCopyBlocks) {
+	t.Helper()
+
+	clock := &stubClock{t: time.Date(2024, 2, 1, 10, 0, 0, 0, time.UTC)}
+	repo := &mockRepository{categories: map[string]category.Category{
+		"cat-a1": {CategoryID: "cat-a1", Name: "A1"},
+	}}
+	blocks := &stubCopyBlocks{}
+
+	return category.NewCopyService(repo, blocks, clock), blocks
+}
+
+func TestCopyService_Edit(t *testing.T) {
+	editor := user.User{ID: "user-123", Roles: []user.Role{user.RoleEditor}}
+
+	t.Run("creates then revises block", func(t *testing.T) {
+		service, blocks := setupCopyService(t)
+
+		first, err := service.Edit("cat-a1", shared.LocaleFrenchFR, category.CopyIntro, "Bienvenue.", editor)
+		assertNoError(t, err)
+		second, err := service.Edit("cat-a1", shared.LocaleFrenchFR, category.CopyIntro, "Bienvenue au niveau A1.", editor)
+		assertNoError(t, err)
+
+		if first.Version != 1 || second.Version != 2 {
+			t.Errorf("versions: got %d, %d", first.Version, second.Version)
+		}
+		history, _ := blocks.GetHistory("cat-a1", shared.LocaleFrenchFR, category.CopyIntro)
+		if len(history) != 2 {
+			t.Errorf("history: got %d versions, want 2", len(history))
+		}
+	})
+
+	t.Run("forbids authors", func(t *testing.T) {
+		service, _ := setupCopyService(t)
+		author := user.User{ID: "user-456", Roles: []user.Role{user.RoleAuthor}}
+
+		_, err := service.Edit("cat-a1", shared.LocaleFrenchFR, category.CopyIntro, "Bienvenue.", author)
+
+		assertError(t, err)
+		assertErrorCode(t, err, kernel.EForbidden)
+	})
+
+	t.Run("rejects unknown category", func(t *testing.T) {
+		service, _ := setupCopyService(t)
+
+		_, err := service.Edit("cat-missing", shared.LocaleFrenchFR, category.CopyIntro, "Bienvenue.", editor)
+
+		assertError(t, err)
+		assertErrorCode(t, err, kernel.ENotFound)
+	})
+
+	t.Run("rejects invalid content", func(t *testing.T) {
+		service, _ := setupCopyService(t)
+
+		_, err := service.Edit("cat-a1", shared.LocaleFrenchFR, category.CopyIntro, "", editor)
+
+		assertError(t, err)
+		assertErrorCode(t, err, kernel.EInvalid)
+	})
+}
+
+func TestCopyService_Restore(t *testing.T) {
+	editor := user.User{ID: "user-123", Roles: []user.Role{user.RoleAdmin}}
+
+	t.Run("restores earlier content as new version", func(t *testing.T) {
+		service, _ := setupCopyService(t)
+		_, err := service.Edit("cat-a1", shared.LocaleFrenchFR, category.CopyOutro, "Version un.", editor)
+		assertNoError(t, err)
+		_, err = service.Edit("cat-a1", shared.LocaleFrenchFR, category.CopyOutro, "Version deux.", editor)
+		assertNoError(t, err)
+
+		got, err := service.Restore("cat-a1", shared.LocaleFrenchFR, category.CopyOutro, 1, editor)
+
+		assertNoError(t, err)
+		if got.Version != 3 || got.Content != "Version un." {
+			t.Errorf("got version %d with %q", got.Version, got.Content)
+		}
+	})
+
+	t.Run("returns not found for unknown version", func(t *testing.T) {
+		service, _ := setupCopyService(t)
+
+		_, err := service.Restore("cat-a1", shared.LocaleFrenchFR, category.CopyOutro, 4, editor)
+
+		assertError(t, err)
+		assertErrorCode(t, err, kernel.ENotFound)
+	})
+}
+
+func TestCopyService_Landing(t *testing.T) {
+	editor := user.User{ID: "user-123", Roles: []user.Role{user.RoleEditor}}
+
+	t.Run("falls back to default locale per block", func(t *testing.T) {
+		service, _ := setupCopyService(t)
+		_, err := service.Edit("cat-a1", shared.LocalePortugueseBR, category.CopyIntro, "Bem-vindo.", editor)
+		assertNoError(t, err)
+		_, err = service.Edit("cat-a1", shared.DefaultLocale, category.CopyOutro, "See you soon.", editor)
+		assertNoError(t, err)
+
+		got, err := service.Landing("cat-a1", shared.LocalePortugueseBR)
+
+		assertNoError(t, err)
+		if got.Intro == nil || got.Intro.Content != "Bem-vindo." {
+			t.Errorf("Intro: got %v", got.Intro)
+		}
+		if got.Outro == nil || got.Outro.Locale != shared.DefaultLocale {
+			t.Errorf("Outro: got %v", got.Outro)
+		}
+	})
+
+	t.Run("returns empty landing without copy", func(t *testing.T) {
+		service, _ := setupCopyService(t)
+
+		got, err := service.Landing("cat-a1", shared.LocaleFrenchFR)
+
+		assertNoError(t, err)
+		if !got.IsEmpty() {
+			t.Errorf("expected empty landing, got %+v", got)
+		}
+	})
+}
@@ -0,0 +1,127 @@
+package category_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/category"
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/shared"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+func TestCopyPosition_Validate(t *testing.T) {
+	assertNoError(t, category.CopyIntro.Validate())
+	assertNoError(t, category.CopyOutro.Validate())
+
+	err := category.CopyPosition("sidebar").Validate()
+	assertError(t, err)
+	assertErrorCode(t, err, kernel.EInvalid)
+}
+
+func TestNewCopyContent(t *testing.T) {
+	t.Run("trims and keeps markdown", func(t *testing.T) {
+		got, err := category.NewCopyContent("  ## Bienvenue\n\nCe niveau couvre **les bases**.  ")
+
+		assertNoError(t, err)
+		if got.String() != "## Bienvenue\n\nCe niveau couvre **les bases**." {
+			t.Errorf("got %q", got)
+		}
+		if got.PlainText() == got.String() {
+			t.Errorf("PlainText should strip markdown, got %q", got.PlainText())
+		}
+	})
+
+	t.Run("rejects empty or too long content", func(t *testing.T) {
+		for _, input := range []string{"", "   ", strings.Repeat("a", category.MaxCopyContentLength+1)} {
+			_, err := category.NewCopyContent(input)
+
+			assertError(t, err)
+			assertErrorCode(t, err, kernel.EInvalid)
+		}
+	})
+}
+
+func TestNewCopyBlock(t *testing.T) {
+	clock := &stubClock{t: time.Date(2024, 2, 1, 10, 0, 0, 0, time.UTC)}
+	valid := category.NewCopyBlockParams{
+		CategoryID: "cat-a1",
+		Locale:     shared.LocaleFrenchFR,
+		Position:   category.CopyIntro,
+		Content:    "Bienvenue au niveau A1.",
+		UpdatedBy:  "user-123",
+		Clock:      clock,
+	}
+
+	t.Run("creates first version", func(t *testing.T) {
+		got, err := category.NewCopyBlock(valid)
+
+		assertNoError(t, err)
+		if got.Version != 1 {
+			t.Errorf("Version: got %d, want 1", got.Version)
+		}
+		if !got.UpdatedAt.Equal(clock.t) {
+			t.Errorf("UpdatedAt: got %v", got.UpdatedAt)
+		}
+	})
+
+	t.Run("rejects invalid params", func(t *testing.T) {
+		tests := []struct {
+			name   string
+			modify func(p *category.NewCopyBlockParams)
+		}{
+			{name: "missing category", modify: func(p *category.NewCopyBlockParams) { p.CategoryID = "" }},
+			{name: "unsupported locale", modify: func(p *category.NewCopyBlockParams) { p.Locale = "de-DE" }},
+			{name: "invalid position", modify: func(p *category.NewCopyBlockParams) { p.Position = "sidebar" }},
+			{name: "empty content", modify: func(p *category.NewCopyBlockParams) { p.Content = "" }},
+			{name: "missing editor", modify: func(p *category.NewCopyBlockParams) { p.UpdatedBy = "" }},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				params := valid
+				tt.modify(&params)
+
+				_, err := category.NewCopyBlock(params)
+
+				assertError(t, err)
+				assertErrorCode(t, err, kernel.EInvalid)
+			})
+		}
+	})
+}
+
+func TestCopyBlock_Revise(t *testing.T) {
+	clock := &stubClock{t: time.Date(2024, 2, 1, 10, 0, 0, 0, time.UTC)}
+	block, err := category.NewCopyBlock(category.NewCopyBlockParams{
+		CategoryID: "cat-a1",
+		Locale:     shared.LocaleFrenchFR,
+		Position:   category.CopyOutro,
+		Content:    "À bientôt.",
+		UpdatedBy:  "user-123",
+		Clock:      clock,
+	})
+	assertNoError(t, err)
+
+	t.Run("increments version", func(t *testing.T) {
+		later := &stubClock{t: clock.t.Add(time.Hour)}
+
+		got, err := block.Revise("Bravo, vous avez terminé le niveau A1 !", kernel.ID[user.User]("user-456"), later)
+
+		assertNoError(t, err)
+		if got.Version != 2 || got.UpdatedBy != "user-456" || !got.UpdatedAt.Equal(later.t) {
+			t.Errorf("got %+v", got)
+		}
+		if block.Version != 1 {
+			t.Error("original block must be unchanged")
+		}
+	})
+
+	t.Run("rejects empty content", func(t *testing.T) {
+		_, err := block.Revise("", "user-456", clock)
+
+		assertError(t, err)
+		assertErrorCode(t, err, kernel.EInvalid)
+	})
+}
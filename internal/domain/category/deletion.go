@@ -0,0 +1,269 @@
+package category
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+)
+
+const (
+	MCategoryDeleteBlocked         string = "Category cannot be deleted while it has posts or subcategories."
+	MCategoryDeleteStrategyInvalid string = "Unknown category deletion strategy %q."
+	MCategoryReassignTargetMissing string = "A target category is required to reassign posts."
+	MCategoryReassignTargetInvalid string = "Posts cannot be reassigned to the deleted category or its subcategories."
+)
+
+// DeleteStrategy selects how CategoryService.Delete handles dependent content.
+type DeleteStrategy string
+
+const (
+	// DeleteRefuse deletes only categories without posts or subcategories.
+	DeleteRefuse DeleteStrategy = "refuse"
+	// DeleteCascade deletes the category and its subcategories when none of them has posts.
+	DeleteCascade DeleteStrategy = "cascade"
+	// DeleteReassign moves the category's posts to another category before deleting it.
+	DeleteReassign DeleteStrategy = "reassign"
+)
+
+// Validate ensures the strategy is one of the supported values.
+func (s DeleteStrategy) Validate() error {
+	const op = "DeleteStrategy.Validate"
+
+	switch s {
+	case DeleteRefuse, DeleteCascade, DeleteReassign:
+		return nil
+	}
+
+	return &kernel.Error{
+		Code:      kernel.EInvalid,
+		Message:   fmt.Sprintf(MCategoryDeleteStrategyInvalid, s),
+		Operation: op,
+	}
+}
+
+// DeleteOptions configures a category deletion.
+type DeleteOptions struct {
+	Strategy   DeleteStrategy       // Defaults to DeleteRefuse when empty
+	ReassignTo *kernel.ID[Category] // Required with DeleteReassign
+}
+
+// BlockerReason explains why a category prevents deletion.
+type BlockerReason string
+
+const (
+	BlockerPosts    BlockerReason = "posts"
+	BlockerChildren BlockerReason = "children"
+)
+
+// DeletionBlocker describes one category that stops a deletion.
+type DeletionBlocker struct {
+	CategoryID kernel.ID[Category]
+	Reason     BlockerReason
+	Count      int // Number of posts or subcategories
+}
+
+// DeletionBlockers lists everything preventing a deletion.
+// Returned as the cause of an EConflict error; retrieve it with BlockersFrom.
+type DeletionBlockers []DeletionBlocker
+
+// Error implements the error interface.
+func (b DeletionBlockers) Error() string {
+	parts := make([]string, len(b))
+	for i, blocker := range b {
+		parts[i] = fmt.Sprintf("%s has %d %s", blocker.CategoryID, blocker.Count, blocker.Reason)
+	}
+	return "category deletion blocked: " + strings.Join(parts, ", ")
+}
+
+// BlockersFrom extracts deletion blockers from an error chain.
+// Lets admin screens list exactly which categories still hold content.
+func BlockersFrom(err error) (DeletionBlockers, bool) {
+	for err != nil {
+		switch e := err.(type) {
+		case DeletionBlockers:
+			return e, true
+		case *kernel.Error:
+			err = e.Cause
+		default:
+			return nil, false
+		}
+	}
+	return nil, false
+}
+
+// DeletionResult summarizes a completed deletion.
+type DeletionResult struct {
+	Deleted         []kernel.ID[Category] // Deleted categories, subcategories first
+	ReassignedPosts int
+}
+
+// Delete removes a category according to the chosen strategy.
+// Returns EConflict with DeletionBlockers when posts or subcategories stand in the way.
+func (s *CategoryService) Delete(categoryID kernel.ID[Category], actor Curator, opts DeleteOptions) (DeletionResult, error) {
+	const op = "CategoryService.Delete"
+
+	if !actor.CanManageCategories() {
+		return DeletionResult{}, &kernel.Error{Code: kernel.EForbidden, Message: MCategoryManageForbidden, Operation: op}
+	}
+
+	if opts.Strategy == "" {
+		opts.Strategy = DeleteRefuse
+	}
+	if err := opts.Strategy.Validate(); err != nil {
+		return DeletionResult{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if _, err := s.repository.GetByID(categoryID); err != nil {
+		return DeletionResult{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	subtree, err := s.subtree(categoryID)
+	if err != nil {
+		return DeletionResult{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	var result DeletionResult
+	switch opts.Strategy {
+	case DeleteRefuse:
+		result, err = s.deleteRefusing(categoryID, subtree)
+	case DeleteCascade:
+		result, err = s.deleteCascading(subtree)
+	case DeleteReassign:
+		result, err = s.deleteReassigning(categoryID, subtree, opts.ReassignTo)
+	}
+	if err != nil {
+		return DeletionResult{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return result, nil
+}
+
+func (s *CategoryService) deleteRefusing(categoryID kernel.ID[Category], subtree []kernel.ID[Category]) (DeletionResult, error) {
+	const op = "CategoryService.deleteRefusing"
+
+	var blockers DeletionBlockers
+	if children := len(subtree) - 1; children > 0 {
+		blockers = append(blockers, DeletionBlocker{CategoryID: categoryID, Reason: BlockerChildren, Count: children})
+	}
+
+	postBlockers, err := s.postBlockers([]kernel.ID[Category]{categoryID})
+	if err != nil {
+		return DeletionResult{}, &kernel.Error{Operation: op, Cause: err}
+	}
+	blockers = append(blockers, postBlockers...)
+
+	if len(blockers) > 0 {
+		return DeletionResult{}, blocked(op, blockers)
+	}
+
+	return s.deleteAll([]kernel.ID[Category]{categoryID})
+}
+
+func (s *CategoryService) deleteCascading(subtree []kernel.ID[Category]) (DeletionResult, error) {
+	const op = "CategoryService.deleteCascading"
+
+	blockers, err := s.postBlockers(subtree)
+	if err != nil {
+		return DeletionResult{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if len(blockers) > 0 {
+		return DeletionResult{}, blocked(op, blockers)
+	}
+
+	return s.deleteAll(subtree)
+}
+
+func (s *CategoryService) deleteReassigning(
+	categoryID kernel.ID[Category],
+	subtree []kernel.ID[Category],
+	target *kernel.ID[Category],
+) (DeletionResult, error) {
+	const op = "CategoryService.deleteReassigning"
+
+	if target == nil {
+		return DeletionResult{}, &kernel.Error{Code: kernel.EInvalid, Message: MCategoryReassignTargetMissing, Operation: op}
+	}
+
+	for _, id := range subtree {
+		if id == *target {
+			return DeletionResult{}, &kernel.Error{Code: kernel.EInvalid, Message: MCategoryReassignTargetInvalid, Operation: op}
+		}
+	}
+
+	if _, err := s.repository.GetByID(*target); err != nil {
+		return DeletionResult{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if children := len(subtree) - 1; children > 0 {
+		return DeletionResult{}, blocked(op, DeletionBlockers{
+			{CategoryID: categoryID, Reason: BlockerChildren, Count: children},
+		})
+	}
+
+	moved, err := s.posts.ReassignPosts(categoryID, *target)
+	if err != nil {
+		return DeletionResult{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	result, err := s.deleteAll([]kernel.ID[Category]{categoryID})
+	result.ReassignedPosts = moved
+
+	return result, err
+}
+
+// subtree returns the category and all its descendants, descendants first.
+func (s *CategoryService) subtree(categoryID kernel.ID[Category]) ([]kernel.ID[Category], error) {
+	children, err := s.repository.GetChildren(categoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []kernel.ID[Category]
+	for _, child := range children {
+		descendants, err := s.subtree(child.CategoryID)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, descendants...)
+	}
+
+	return append(ids, categoryID), nil
+}
+
+// postBlockers reports every category in the list that still holds posts.
+func (s *CategoryService) postBlockers(categoryIDs []kernel.ID[Category]) (DeletionBlockers, error) {
+	var blockers DeletionBlockers
+	for _, id := range categoryIDs {
+		count, err := s.posts.CountPostsInCategory(id)
+		if err != nil {
+			return nil, err
+		}
+		if count > 0 {
+			blockers = append(blockers, DeletionBlocker{CategoryID: id, Reason: BlockerPosts, Count: count})
+		}
+	}
+	return blockers, nil
+}
+
+// deleteAll removes categories in order, so subcategories go before their parent.
+func (s *CategoryService) deleteAll(categoryIDs []kernel.ID[Category]) (DeletionResult, error) {
+	var result DeletionResult
+	for _, id := range categoryIDs {
+		if err := s.repository.Delete(id); err != nil {
+			return result, err
+		}
+		result.Deleted = append(result.Deleted, id)
+	}
+	return result, nil
+}
+
+func blocked(op string, blockers DeletionBlockers) error {
+	return &kernel.Error{
+		Code:      kernel.EConflict,
+		Message:   MCategoryDeleteBlocked,
+		Operation: op,
+		Cause:     blockers,
+	}
+}
@@ -0,0 +1,174 @@
+package category_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/alnah/fla/internal/domain/category"
+	"github.com/alnah/fla/internal/domain/kernel"
+)
+
+type postCounts = map[kernel.ID[category.Category]]int
+
+func TestCategoryService_Delete(t *testing.T) {
+	a1 := kernel.ID[category.Category]("a1")
+
+	t.Run("refuse deletes an empty leaf", func(t *testing.T) {
+		service, repo, _ := setupCategoryServiceWithPosts(nil)
+
+		got, err := service.Delete("a2", stubCurator(true), category.DeleteOptions{})
+
+		assertNoError(t, err)
+		if !slices.Equal(got.Deleted, []kernel.ID[category.Category]{"a2"}) {
+			t.Errorf("got deleted %v", got.Deleted)
+		}
+		if !slices.Equal(repo.deleted, got.Deleted) {
+			t.Errorf("repository deleted %v", repo.deleted)
+		}
+	})
+
+	t.Run("refuse reports posts and children as blockers", func(t *testing.T) {
+		service, repo, _ := setupCategoryServiceWithPosts(postCounts{"reading": 4})
+
+		_, err := service.Delete("reading", stubCurator(true), category.DeleteOptions{Strategy: category.DeleteRefuse})
+
+		assertErrorCode(t, err, kernel.EConflict)
+		blockers, ok := category.BlockersFrom(err)
+		if !ok {
+			t.Fatalf("expected blockers in %v", err)
+		}
+		want := category.DeletionBlockers{
+			{CategoryID: "reading", Reason: category.BlockerChildren, Count: 1},
+			{CategoryID: "reading", Reason: category.BlockerPosts, Count: 4},
+		}
+		if !slices.Equal(blockers, want) {
+			t.Errorf("got blockers %v, want %v", blockers, want)
+		}
+		if len(repo.deleted) != 0 {
+			t.Error("expected nothing to be deleted")
+		}
+	})
+
+	t.Run("cascade deletes subcategories first", func(t *testing.T) {
+		service, repo, _ := setupCategoryServiceWithPosts(nil)
+
+		got, err := service.Delete("reading", stubCurator(true), category.DeleteOptions{Strategy: category.DeleteCascade})
+
+		assertNoError(t, err)
+		want := []kernel.ID[category.Category]{"sports", "reading"}
+		if !slices.Equal(repo.deleted, want) || !slices.Equal(got.Deleted, want) {
+			t.Errorf("got deleted %v, want %v", repo.deleted, want)
+		}
+	})
+
+	t.Run("cascade refuses when a subcategory has posts", func(t *testing.T) {
+		service, repo, _ := setupCategoryServiceWithPosts(postCounts{"sports": 2})
+
+		_, err := service.Delete("reading", stubCurator(true), category.DeleteOptions{Strategy: category.DeleteCascade})
+
+		assertErrorCode(t, err, kernel.EConflict)
+		blockers, _ := category.BlockersFrom(err)
+		want := category.DeletionBlockers{{CategoryID: "sports", Reason: category.BlockerPosts, Count: 2}}
+		if !slices.Equal(blockers, want) {
+			t.Errorf("got blockers %v, want %v", blockers, want)
+		}
+		if len(repo.deleted) != 0 {
+			t.Error("expected nothing to be deleted")
+		}
+	})
+
+	t.Run("reassign moves posts before deleting", func(t *testing.T) {
+		service, repo, posts := setupCategoryServiceWithPosts(postCounts{"a2": 3})
+
+		got, err := service.Delete("a2", stubCurator(true), category.DeleteOptions{
+			Strategy:   category.DeleteReassign,
+			ReassignTo: &a1,
+		})
+
+		assertNoError(t, err)
+		if got.ReassignedPosts != 3 || posts.counts["a1"] != 3 {
+			t.Errorf("got %d reassigned, a1 now has %d", got.ReassignedPosts, posts.counts["a1"])
+		}
+		if !slices.Equal(repo.deleted, []kernel.ID[category.Category]{"a2"}) {
+			t.Errorf("got deleted %v", repo.deleted)
+		}
+	})
+
+	t.Run("reassign still refuses categories with children", func(t *testing.T) {
+		service, _, posts := setupCategoryServiceWithPosts(postCounts{"reading": 1})
+		a2 := kernel.ID[category.Category]("a2")
+
+		_, err := service.Delete("reading", stubCurator(true), category.DeleteOptions{
+			Strategy:   category.DeleteReassign,
+			ReassignTo: &a2,
+		})
+
+		assertErrorCode(t, err, kernel.EConflict)
+		if posts.counts["reading"] != 1 {
+			t.Error("expected posts to stay in place")
+		}
+	})
+
+	t.Run("rejects invalid options", func(t *testing.T) {
+		sports := kernel.ID[category.Category]("sports")
+		tests := []struct {
+			name string
+			id   kernel.ID[category.Category]
+			opts category.DeleteOptions
+		}{
+			{"unknown strategy", "a2", category.DeleteOptions{Strategy: "archive"}},
+			{"reassign without target", "a2", category.DeleteOptions{Strategy: category.DeleteReassign}},
+			{"reassign to itself", "a2", category.DeleteOptions{Strategy: category.DeleteReassign, ReassignTo: ptr[category.Category]("a2")}},
+			{"reassign to subcategory", "reading", category.DeleteOptions{Strategy: category.DeleteReassign, ReassignTo: &sports}},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				service, repo, _ := setupCategoryServiceWithPosts(nil)
+
+				_, err := service.Delete(tt.id, stubCurator(true), tt.opts)
+
+				assertErrorCode(t, err, kernel.EInvalid)
+				if len(repo.deleted) != 0 {
+					t.Error("expected nothing to be deleted")
+				}
+			})
+		}
+	})
+
+	t.Run("rejects unknown category", func(t *testing.T) {
+		service, _, _ := setupCategoryServiceWithPosts(nil)
+
+		_, err := service.Delete("c2", stubCurator(true), category.DeleteOptions{})
+
+		assertErrorCode(t, err, kernel.ENotFound)
+	})
+
+	t.Run("requires category management rights", func(t *testing.T) {
+		service, _, _ := setupCategoryServiceWithPosts(nil)
+
+		_, err := service.Delete("a2", stubCurator(false), category.DeleteOptions{})
+
+		assertErrorCode(t, err, kernel.EForbidden)
+	})
+}
+
+func TestBlockersFrom(t *testing.T) {
+	t.Run("returns false for unrelated errors", func(t *testing.T) {
+		err := &kernel.Error{Code: kernel.ENotFound, Message: "missing"}
+
+		if _, ok := category.BlockersFrom(err); ok {
+			t.Error("expected no blockers")
+		}
+	})
+
+	t.Run("describes blockers in error text", func(t *testing.T) {
+		blockers := category.DeletionBlockers{{CategoryID: "a1", Reason: category.BlockerPosts, Count: 2}}
+
+		if got := blockers.Error(); got != "category deletion blocked: a1 has 2 posts" {
+			t.Errorf("got %q", got)
+		}
+	})
+}
+
+func ptr[T any](id kernel.ID[T]) *kernel.ID[T] { return &id }
@@ -2,6 +2,7 @@ package category
 
 import (
 	"fmt"
+	"maps"
 	"strings"
 	"time"
 
@@ -15,6 +16,7 @@ const (
 	MCategoryMaxDepthExceeded  string = "Category hierarchy cannot exceed 3 levels deep."
 	MCategoryNameNotUnique     string = "Category name must be unique within parent."
 	MCategorySlugNotUnique     string = "Category slug must be unique within parent."
+	MCategorySortOrderNegative string = "Category sort order cannot be negative."
 )
 
 const MaxCategoryDepth = 3
@@ -77,8 +79,14 @@ type Category struct {
 	Slug        shared.Slug
 	Description shared.Description // Optional explanation of the category
 
+	// Localization
+	Names        map[shared.Locale]CategoryName       // Per locale; nil for untranslated categories
+	Descriptions map[shared.Locale]shared.Description // Per locale; nil when the description is not translated
+	Slugs        map[shared.Locale]shared.Slug        // Generated from Names with each locale's transliteration
+
 	// Hierarchy
-	ParentID *kernel.ID[Category] // nil for root categories
+	ParentID  *kernel.ID[Category] // nil for root categories
+	SortOrder int                  // Position among siblings, lowest first
 
 	// Meta
 	CreatedBy kernel.ID[user.User]
@@ -93,12 +101,15 @@ type Category struct {
 type NewCategoryParams struct {
 	// Required
 	CategoryID kernel.ID[Category]
-	Name       CategoryName
+	Name       CategoryName // May be left empty when Names has the default locale's
 	CreatedBy  kernel.ID[user.User]
 
 	// Optional
-	Description shared.Description
-	ParentID    *kernel.ID[Category] // nil for root categories
+	Description  shared.Description
+	Names        map[shared.Locale]CategoryName       // Translations, including the default locale's
+	Descriptions map[shared.Locale]shared.Description // Translations, including the default locale's
+	ParentID     *kernel.ID[Category]                 // nil for root categories
+	SortOrder    int                                  // Defaults to 0; siblings with equal order sort by name
 
 	// DI
 	Clock kernel.Clock
@@ -111,20 +122,40 @@ func NewCategory(params NewCategoryParams) (Category, error) {
 
 	now := params.Clock.Now()
 
-	slug, err := shared.NewSlug(params.Name.String())
+	name, description := params.Name, params.Description
+	if name == "" {
+		name = params.Names[shared.DefaultLocale]
+	}
+	if description == "" {
+		description = params.Descriptions[shared.DefaultLocale]
+	}
+	if len(params.Names) > 0 {
+		if err := requireDefault("names", params.Names, name, op); err != nil {
+			return Category{}, err
+		}
+	}
+
+	slug, err := shared.NewSlug(name.String())
 	if err != nil {
 		return Category{}, &kernel.Error{Operation: op, Cause: err}
 	}
 
 	category := Category{
-		CategoryID:  params.CategoryID,
-		Name:        params.Name,
-		Slug:        slug,
-		Description: params.Description,
-		ParentID:    params.ParentID,
-		CreatedBy:   params.CreatedBy,
-		CreatedAt:   now,
-		Clock:       params.Clock,
+		CategoryID:   params.CategoryID,
+		Name:         name,
+		Slug:         slug,
+		Description:  description,
+		Names:        maps.Clone(params.Names),
+		Descriptions: maps.Clone(params.Descriptions),
+		ParentID:     params.ParentID,
+		SortOrder:    params.SortOrder,
+		CreatedBy:    params.CreatedBy,
+		CreatedAt:    now,
+		Clock:        params.Clock,
+	}
+
+	if category.Slugs, err = category.localizedSlugs(); err != nil {
+		return Category{}, &kernel.Error{Operation: op, Cause: err}
 	}
 
 	if err := category.Validate(); err != nil {
@@ -155,10 +186,22 @@ func (c Category) Validate() error {
 		return &kernel.Error{Operation: op, Cause: err}
 	}
 
+	if err := c.validateLocalization(); err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
 	if err := c.CreatedBy.Validate(); err != nil {
 		return &kernel.Error{Operation: op, Cause: err}
 	}
 
+	if c.SortOrder < 0 {
+		return &kernel.Error{
+			Code:      kernel.EInvalid,
+			Message:   MCategorySortOrderNegative,
+			Operation: op,
+		}
+	}
+
 	if err := c.validateBasicHierarchy(); err != nil {
 		return &kernel.Error{Operation: op, Cause: err}
 	}
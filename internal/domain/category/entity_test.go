@@ -353,6 +353,12 @@ func TestCategory_Validate(t *testing.T) {
 					c.Name = category.CategoryName("")
 				},
 			},
+			{
+				name: "negative sort order",
+				modifier: func(c *category.Category) {
+					c.SortOrder = -1
+				},
+			},
 			{
 				name: "invalid name",
 				modifier: func(c *category.Category) {
@@ -0,0 +1,180 @@
+package category
+
+import (
+	"fmt"
+	"maps"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/shared"
+)
+
+const (
+	MCategoryDefaultLocaleMissing  string = "Localized category %s need an entry in the default locale (%s)."
+	MCategoryDefaultLocaleMismatch string = "The default locale's entry in category %s must match the category."
+)
+
+// GetName returns the name in the requested locale, falling back to the default locale.
+func (c Category) GetName(locale shared.Locale) CategoryName {
+	if name, ok := c.Names[locale.GetEffectiveLocale()]; ok {
+		return name
+	}
+	return c.Name
+}
+
+// GetDescription returns the description in the requested locale, falling back to the default locale.
+func (c Category) GetDescription(locale shared.Locale) shared.Description {
+	if description, ok := c.Descriptions[locale.GetEffectiveLocale()]; ok {
+		return description
+	}
+	return c.Description
+}
+
+// GetSlug returns the slug in the requested locale, falling back to the default locale.
+func (c Category) GetSlug(locale shared.Locale) shared.Slug {
+	if slug, ok := c.Slugs[locale.GetEffectiveLocale()]; ok {
+		return slug
+	}
+	return c.Slug
+}
+
+// Localize returns a copy of the category translated into a locale, with the
+// slug regenerated from the name. Localizing the default locale renames the
+// category itself. An empty description falls back to the default locale's.
+func (c Category) Localize(locale shared.Locale, name CategoryName, description shared.Description) (Category, error) {
+	const op = "Category.Localize"
+
+	if err := locale.Validate(); err != nil {
+		return c, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	updated := c
+	updated.Names = maps.Clone(c.Names)
+	if updated.Names == nil {
+		updated.Names = map[shared.Locale]CategoryName{shared.DefaultLocale: c.Name}
+	}
+	updated.Descriptions = maps.Clone(c.Descriptions)
+	if updated.Descriptions == nil {
+		updated.Descriptions = map[shared.Locale]shared.Description{shared.DefaultLocale: c.Description}
+	}
+
+	updated.Names[locale] = name
+	if description == "" && !locale.IsDefault() {
+		delete(updated.Descriptions, locale)
+	} else {
+		updated.Descriptions[locale] = description
+	}
+
+	if locale.IsDefault() {
+		slug, err := shared.NewSlug(name.String())
+		if err != nil {
+			return c, &kernel.Error{Operation: op, Cause: err}
+		}
+		updated.Name, updated.Slug, updated.Description = name, slug, description
+	}
+
+	slugs, err := updated.localizedSlugs()
+	if err != nil {
+		return c, &kernel.Error{Operation: op, Cause: err}
+	}
+	updated.Slugs = slugs
+
+	if err := updated.Validate(); err != nil {
+		return c, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return updated, nil
+}
+
+// localizedSlugs generates a slug per localized name, transliterated the way
+// the locale's readers would spell it. The default locale keeps Slug.
+func (c Category) localizedSlugs() (map[shared.Locale]shared.Slug, error) {
+	const op = "Category.localizedSlugs"
+
+	if len(c.Names) == 0 {
+		return nil, nil
+	}
+
+	slugs := make(map[shared.Locale]shared.Slug, len(c.Names))
+	for locale, name := range c.Names {
+		if locale.IsDefault() {
+			slugs[locale] = c.Slug
+			continue
+		}
+
+		slug, err := shared.SlugPolicy{Locale: locale}.NewSlug(name.String())
+		if err != nil {
+			return nil, &kernel.Error{Operation: op, Cause: err}
+		}
+		slugs[locale] = slug
+	}
+
+	return slugs, nil
+}
+
+// validateLocalization ensures every translation is valid and that localized
+// names and descriptions have the default locale entry fallbacks rely on.
+func (c Category) validateLocalization() error {
+	const op = "Category.validateLocalization"
+
+	if len(c.Names) > 0 {
+		if err := requireDefault("names", c.Names, c.Name, op); err != nil {
+			return err
+		}
+	}
+	for locale, name := range c.Names {
+		if err := locale.Validate(); err != nil {
+			return &kernel.Error{Operation: op, Cause: err}
+		}
+		if err := name.Validate(); err != nil {
+			return &kernel.Error{Operation: op, Cause: err}
+		}
+	}
+
+	if len(c.Descriptions) > 0 {
+		if err := requireDefault("descriptions", c.Descriptions, c.Description, op); err != nil {
+			return err
+		}
+	}
+	for locale, description := range c.Descriptions {
+		if err := locale.Validate(); err != nil {
+			return &kernel.Error{Operation: op, Cause: err}
+		}
+		if err := description.Validate(); err != nil {
+			return &kernel.Error{Operation: op, Cause: err}
+		}
+	}
+
+	for locale, slug := range c.Slugs {
+		if err := locale.Validate(); err != nil {
+			return &kernel.Error{Operation: op, Cause: err}
+		}
+		if err := slug.Validate(); err != nil {
+			return &kernel.Error{Operation: op, Cause: err}
+		}
+	}
+
+	return nil
+}
+
+// requireDefault checks the default locale entry exists and matches the
+// category's own value.
+func requireDefault[V comparable](field string, entries map[shared.Locale]V, value V, op string) error {
+	entry, ok := entries[shared.DefaultLocale]
+	if !ok {
+		return &kernel.Error{
+			Code:      kernel.EInvalid,
+			Message:   fmt.Sprintf(MCategoryDefaultLocaleMissing, field, shared.DefaultLocale),
+			Operation: op,
+		}
+	}
+
+	if entry != value {
+		return &kernel.Error{
+			Code:      kernel.EInvalid,
+			Message:   fmt.Sprintf(MCategoryDefaultLocaleMismatch, field),
+			Operation: op,
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,148 @@
+package category_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/category"
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/shared"
+)
+
+func localizedParams() category.NewCategoryParams {
+	return category.NewCategoryParams{
+		CategoryID: "reading",
+		Names: map[shared.Locale]category.CategoryName{
+			shared.LocaleEnglishUS:    "Reading Comprehension",
+			shared.LocaleFrenchFR:     "Compréhension écrite",
+			shared.LocalePortugueseBR: "Compreensão & leitura",
+		},
+		Descriptions: map[shared.Locale]shared.Description{
+			shared.LocaleEnglishUS: "Texts with questions.",
+			shared.LocaleFrenchFR:  "Des textes et des questions.",
+		},
+		CreatedBy: "user-123",
+		Clock:     &stubClock{t: time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)},
+	}
+}
+
+func TestNewCategory_Localized(t *testing.T) {
+	t.Run("takes the default locale's name and generates slugs per locale", func(t *testing.T) {
+		got, err := category.NewCategory(localizedParams())
+
+		assertNoError(t, err)
+		if got.Name != "Reading Comprehension" || got.Slug != "reading-comprehension" || got.Description != "Texts with questions." {
+			t.Errorf("default locale: got %s, %q", got, got.Description)
+		}
+		want := map[shared.Locale]shared.Slug{
+			shared.LocaleEnglishUS:    "reading-comprehension",
+			shared.LocaleFrenchFR:     "comprehension-ecrite",
+			shared.LocalePortugueseBR: "compreensao-e-leitura",
+		}
+		for locale, slug := range want {
+			if got.GetSlug(locale) != slug {
+				t.Errorf("slug %s: got %q, want %q", locale, got.GetSlug(locale), slug)
+			}
+		}
+	})
+
+	tests := []struct {
+		name   string
+		modify func(p *category.NewCategoryParams)
+	}{
+		{"names without the default locale", func(p *category.NewCategoryParams) {
+			delete(p.Names, shared.LocaleEnglishUS)
+		}},
+		{"name differs from the default locale's", func(p *category.NewCategoryParams) {
+			p.Name = "Reading"
+		}},
+		{"descriptions without the default locale", func(p *category.NewCategoryParams) {
+			delete(p.Descriptions, shared.LocaleEnglishUS)
+		}},
+		{"unsupported locale", func(p *category.NewCategoryParams) {
+			p.Names["de-DE"] = "Leseverstehen"
+		}},
+		{"empty localized name", func(p *category.NewCategoryParams) {
+			p.Names[shared.LocaleFrenchFR] = ""
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params := localizedParams()
+			tt.modify(&params)
+
+			_, err := category.NewCategory(params)
+
+			assertErrorCode(t, err, kernel.EInvalid)
+		})
+	}
+}
+
+func TestCategory_Localized(t *testing.T) {
+	c, err := category.NewCategory(localizedParams())
+	assertNoError(t, err)
+
+	t.Run("falls back to the default locale", func(t *testing.T) {
+		if got := c.GetName(shared.LocaleFrenchFR); got != "Compréhension écrite" {
+			t.Errorf("fr-FR name: got %q", got)
+		}
+		if got := c.GetDescription(shared.LocalePortugueseBR); got != "Texts with questions." {
+			t.Errorf("pt-BR description: got %q", got)
+		}
+		if got := c.GetName("de-DE"); got != "Reading Comprehension" {
+			t.Errorf("unsupported locale: got %q", got)
+		}
+	})
+
+	t.Run("localizes an untranslated category", func(t *testing.T) {
+		legacy, err := category.NewCategory(category.NewCategoryParams{
+			CategoryID: "a1", Name: "A1", CreatedBy: "user-123", Clock: &stubClock{t: time.Now()},
+		})
+		assertNoError(t, err)
+
+		got, err := legacy.Localize(shared.LocaleFrenchFR, "A1 débutant", "")
+
+		assertNoError(t, err)
+		if got.GetName(shared.LocaleEnglishUS) != "A1" || got.GetSlug(shared.LocaleFrenchFR) != "a1-debutant" {
+			t.Errorf("got %v, %v", got.Names, got.Slugs)
+		}
+		if legacy.Names != nil {
+			t.Error("expected the original left untouched")
+		}
+	})
+
+	t.Run("localizing the default locale renames the category", func(t *testing.T) {
+		got, err := c.Localize(shared.LocaleEnglishUS, "Reading", "Short texts.")
+
+		assertNoError(t, err)
+		if got.Name != "Reading" || got.Slug != "reading" || got.Description != "Short texts." {
+			t.Errorf("got %s, %q", got, got.Description)
+		}
+		if got.GetSlug(shared.LocaleFrenchFR) != "comprehension-ecrite" {
+			t.Errorf("fr-FR slug: got %q", got.GetSlug(shared.LocaleFrenchFR))
+		}
+	})
+
+	t.Run("builds localized paths", func(t *testing.T) {
+		root, err := category.NewCategory(category.NewCategoryParams{
+			CategoryID: "a1", Name: "A1", CreatedBy: "user-123", Clock: &stubClock{t: time.Now()},
+		})
+		assertNoError(t, err)
+		path := category.CategoryPath{root, c}
+
+		if got := path.LocalizedString(shared.LocaleFrenchFR); got != "a1/comprehension-ecrite" {
+			t.Errorf("got %q", got)
+		}
+		if path.LocalizedString(shared.LocaleEnglishUS) != path.String() {
+			t.Errorf("default locale: got %q", path.LocalizedString(shared.LocaleEnglishUS))
+		}
+	})
+
+	t.Run("rejects invalid translations", func(t *testing.T) {
+		_, err := c.Localize("de-DE", "Leseverstehen", "")
+		assertErrorCode(t, err, kernel.EInvalid)
+
+		_, err = c.Localize(shared.LocaleFrenchFR, "", "")
+		assertErrorCode(t, err, kernel.EInvalid)
+	})
+}
@@ -0,0 +1,161 @@
+package category
+
+import (
+	"time"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/policy"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+const (
+	MCategoryAssignForbidden string = "Only admins can assign category editors."
+	MCategoryOwnerNotEditor  string = "Only editors can be assigned to categories."
+)
+
+// Ownership makes an editor responsible for a category and its subcategories,
+// e.g. an editor in charge of B2 content only. Editors with ownerships approve,
+// publish, and organize content within those subtrees alone; editors without
+// any keep site-wide permissions. Either way, editors get their permissions only
+// once OwnershipService.WithScopes resolved their scopes.
+type Ownership struct {
+	CategoryID kernel.ID[Category]
+	EditorID   kernel.ID[user.User]
+	AssignedBy kernel.ID[user.User]
+	AssignedAt time.Time
+}
+
+// OwnershipRepository stores category ownerships.
+type OwnershipRepository interface {
+	// SaveOwnership records an ownership, replacing any for the same category and editor.
+	SaveOwnership(ownership Ownership) error
+
+	// DeleteOwnership removes an ownership; removing a missing one is not an error.
+	DeleteOwnership(categoryID kernel.ID[Category], editorID kernel.ID[user.User]) error
+
+	// GetOwnershipsByEditor lists the categories an editor is responsible for.
+	GetOwnershipsByEditor(editorID kernel.ID[user.User]) ([]Ownership, error)
+}
+
+// Assigner decides who may assign category editors; implemented by user.User.
+type Assigner interface {
+	GetID() kernel.ID[user.User]
+	Authorize(action policy.Action, resource policy.Resource) policy.Decision
+}
+
+// OwnershipService assigns editors to category subtrees and resolves the scopes
+// permission checks limit them to.
+type OwnershipService struct {
+	ownerships OwnershipRepository
+	categories CategoryHierarchy
+	clock      kernel.Clock
+}
+
+// NewOwnershipService creates ownership service with ownership storage and the category tree.
+func NewOwnershipService(ownerships OwnershipRepository, categories CategoryHierarchy, clock kernel.Clock) *OwnershipService {
+	return &OwnershipService{ownerships: ownerships, categories: categories, clock: clock}
+}
+
+// Assign makes editor responsible for the category subtree on behalf of actor.
+func (s *OwnershipService) Assign(categoryID kernel.ID[Category], editor user.User, actor Assigner) (Ownership, error) {
+	const op = "OwnershipService.Assign"
+
+	if err := s.authorize(categoryID, actor, op); err != nil {
+		return Ownership{}, err
+	}
+
+	if !editor.HasRole(user.RoleEditor) {
+		return Ownership{}, &kernel.Error{Code: kernel.EInvalid, Message: MCategoryOwnerNotEditor, Operation: op}
+	}
+
+	ownership := Ownership{
+		CategoryID: categoryID,
+		EditorID:   editor.ID,
+		AssignedBy: actor.GetID(),
+		AssignedAt: s.clock.Now(),
+	}
+	if err := s.ownerships.SaveOwnership(ownership); err != nil {
+		return Ownership{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return ownership, nil
+}
+
+// Unassign removes editor's responsibility for the category subtree on behalf of actor.
+// An editor left without ownerships regains site-wide permissions.
+func (s *OwnershipService) Unassign(categoryID kernel.ID[Category], editorID kernel.ID[user.User], actor Assigner) error {
+	const op = "OwnershipService.Unassign"
+
+	if err := s.authorize(categoryID, actor, op); err != nil {
+		return err
+	}
+
+	if err := s.ownerships.DeleteOwnership(categoryID, editorID); err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return nil
+}
+
+// Scopes returns the IDs of every category the editor is responsible for, owned
+// categories and their descendants alike, for user.User.Scopes. Empty when the
+// editor owns no category and so is not limited.
+func (s *OwnershipService) Scopes(editorID kernel.ID[user.User]) ([]string, error) {
+	const op = "OwnershipService.Scopes"
+
+	ownerships, err := s.ownerships.GetOwnershipsByEditor(editorID)
+	if err != nil {
+		return nil, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	seen := make(map[kernel.ID[Category]]bool)
+	var scopes []string
+	queue := make([]kernel.ID[Category], 0, len(ownerships))
+	for _, o := range ownerships {
+		queue = append(queue, o.CategoryID)
+	}
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		scopes = append(scopes, id.String())
+
+		children, err := s.categories.GetChildren(id)
+		if err != nil {
+			return nil, &kernel.Error{Operation: op, Cause: err}
+		}
+		for _, child := range children {
+			queue = append(queue, child.CategoryID)
+		}
+	}
+
+	return scopes, nil
+}
+
+// WithScopes returns the user limited to the categories they are responsible for,
+// or to every category when they own none.
+func (s *OwnershipService) WithScopes(u user.User) (user.User, error) {
+	const op = "OwnershipService.WithScopes"
+
+	scopes, err := s.Scopes(u.ID)
+	if err != nil {
+		return u, &kernel.Error{Operation: op, Cause: err}
+	}
+	if len(scopes) == 0 {
+		scopes = []string{policy.AllScopes}
+	}
+
+	u.Scopes = scopes
+	return u, nil
+}
+
+func (s *OwnershipService) authorize(categoryID kernel.ID[Category], actor Assigner, op string) error {
+	resource := policy.Resource{Kind: policy.KindCategory, ID: categoryID.String(), Scope: categoryID.String()}
+	if !actor.Authorize(policy.CategoryAssign, resource).Allowed {
+		return &kernel.Error{Code: kernel.EForbidden, Message: MCategoryAssignForbidden, Operation: op}
+	}
+	return nil
+}
@@ -0,0 +1,142 @@
+package category_test
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/category"
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/policy"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+type stubOwnerships struct {
+	byEditor map[kernel.ID[user.User]][]category.Ownership
+}
+
+func (s *stubOwnerships) SaveOwnership(o category.Ownership) error {
+	s.byEditor[o.EditorID] = append(s.byEditor[o.EditorID], o)
+	return nil
+}
+
+func (s *stubOwnerships) DeleteOwnership(categoryID kernel.ID[category.Category], editorID kernel.ID[user.User]) error {
+	s.byEditor[editorID] = slices.DeleteFunc(s.byEditor[editorID], func(o category.Ownership) bool {
+		return o.CategoryID == categoryID
+	})
+	return nil
+}
+
+func (s *stubOwnerships) GetOwnershipsByEditor(editorID kernel.ID[user.User]) ([]category.Ownership, error) {
+	return s.byEditor[editorID], nil
+}
+
+func newOwnershipService(t *testing.T) (*category.OwnershipService, *stubOwnerships) {
+	t.Helper()
+
+	b2, reading, sports := "b2", "reading", "sports"
+	tree := &mockRepository{children: map[string][]category.Category{
+		"":      {createTestCategory(b2, "B2", nil), createTestCategory("b1", "B1", nil)},
+		b2:      {createTestCategory(reading, "Reading", &b2)},
+		reading: {createTestCategory(sports, "Sports", &reading)},
+	}}
+	ownerships := &stubOwnerships{byEditor: make(map[kernel.ID[user.User]][]category.Ownership)}
+	clock := &stubClock{t: time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)}
+
+	return category.NewOwnershipService(ownerships, tree, clock), ownerships
+}
+
+func activeUser(id string, roles ...user.Role) user.User {
+	return user.User{ID: kernel.ID[user.User](id), Roles: roles, Status: user.AccountStatusActive}
+}
+
+func TestOwnershipService_Assign(t *testing.T) {
+	admin := activeUser("admin", user.RoleAdmin)
+	editor := activeUser("editor", user.RoleEditor)
+
+	t.Run("records who assigned the editor and when", func(t *testing.T) {
+		service, _ := newOwnershipService(t)
+
+		got, err := service.Assign("b2", editor, admin)
+
+		assertNoError(t, err)
+		if got.EditorID != editor.ID || got.AssignedBy != admin.ID || got.AssignedAt.IsZero() {
+			t.Errorf("got %+v", got)
+		}
+	})
+
+	t.Run("only admins assign", func(t *testing.T) {
+		service, _ := newOwnershipService(t)
+
+		_, err := service.Assign("b2", editor, activeUser("other", user.RoleEditor))
+
+		assertErrorCode(t, err, kernel.EForbidden)
+	})
+
+	t.Run("only editors are assigned", func(t *testing.T) {
+		service, _ := newOwnershipService(t)
+
+		_, err := service.Assign("b2", activeUser("author", user.RoleAuthor), admin)
+
+		assertErrorCode(t, err, kernel.EInvalid)
+	})
+}
+
+func TestOwnershipService_Scopes(t *testing.T) {
+	admin := activeUser("admin", user.RoleAdmin)
+	editor := activeUser("editor", user.RoleEditor)
+
+	t.Run("covers the owned subtree", func(t *testing.T) {
+		service, _ := newOwnershipService(t)
+		_, err := service.Assign("b2", editor, admin)
+		assertNoError(t, err)
+
+		got, err := service.WithScopes(editor)
+
+		assertNoError(t, err)
+		if want := []string{"b2", "reading", "sports"}; !slices.Equal(got.Scopes, want) {
+			t.Errorf("got %v, want %v", got.Scopes, want)
+		}
+	})
+
+	t.Run("is empty without ownership", func(t *testing.T) {
+		service, _ := newOwnershipService(t)
+
+		got, err := service.Scopes(editor.ID)
+
+		assertNoError(t, err)
+		if len(got) != 0 {
+			t.Errorf("got %v, want none", got)
+		}
+	})
+
+	t.Run("unassigning restores site-wide scope", func(t *testing.T) {
+		service, _ := newOwnershipService(t)
+		_, err := service.Assign("b2", editor, admin)
+		assertNoError(t, err)
+
+		assertNoError(t, service.Unassign("b2", editor.ID, admin))
+
+		got, err := service.WithScopes(editor)
+		assertNoError(t, err)
+		if want := []string{policy.AllScopes}; !slices.Equal(got.Scopes, want) {
+			t.Errorf("got %v, want %v", got.Scopes, want)
+		}
+	})
+
+	t.Run("scoped editors organize only their subtree", func(t *testing.T) {
+		service, _ := newOwnershipService(t)
+		_, err := service.Assign("b2", editor, admin)
+		assertNoError(t, err)
+
+		got, err := service.WithScopes(editor)
+		assertNoError(t, err)
+
+		if !got.Authorize(policy.CategoryManage, policy.Resource{Kind: policy.KindCategory, Scope: "reading"}).Allowed {
+			t.Error("expected the editor to manage a category in their subtree")
+		}
+		if got.CanManageCategories() || got.CanManageTags() {
+			t.Error("expected a scoped editor to lose site-wide category and tag management")
+		}
+	})
+}
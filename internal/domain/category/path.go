@@ -1,6 +1,10 @@
 package category
 
-import "strings"
+import (
+	"strings"
+
+	"github.com/alnah/fla/internal/domain/shared"
+)
 
 // CategoryPath represents the complete hierarchy trail from root to target category.
 // Enables URL generation and breadcrumb navigation for educational content structure.
@@ -21,6 +25,17 @@ func (cp CategoryPath) String() string {
 	return strings.Join(segments, "/")
 }
 
+// LocalizedString generates the path from each category's slug in the locale,
+// e.g. "a1/reading-comprehension/sports" for en-US.
+func (cp CategoryPath) LocalizedString(locale shared.Locale) string {
+	segments := make([]string, len(cp))
+	for i, category := range cp {
+		segments[i] = category.GetSlug(locale).String()
+	}
+
+	return strings.Join(segments, "/")
+}
+
 // Depth calculates hierarchy level for validation and display purposes.
 // Enables depth-based restrictions and navigation level awareness.
 func (cp CategoryPath) Depth() int {
@@ -42,6 +57,31 @@ func (cp CategoryPath) Leaf() *Category {
 	return &cp[len(cp)-1]
 }
 
+// Level returns the CEFR level named by the root category.
+// Returns false when the root is not a level (e.g. a "Culture" section).
+func (cp CategoryPath) Level() (shared.CEFRLevel, bool) {
+	if len(cp) == 0 {
+		return "", false
+	}
+
+	level, err := shared.NewCEFRLevel(cp[0].Name.String())
+	if err != nil {
+		return "", false
+	}
+
+	return level, true
+}
+
+// CommonDepth returns how many leading categories two paths share.
+// Used to measure topical closeness: 1 means same level, 2 same skill, and so on.
+func (cp CategoryPath) CommonDepth(other CategoryPath) int {
+	n := 0
+	for n < len(cp) && n < len(other) && cp[n].CategoryID == other[n].CategoryID {
+		n++
+	}
+	return n
+}
+
 // CategoryBreadcrumb represents navigation trail elements for hierarchical browsing.
 // Enables users to understand their location and navigate back through category levels.
 type CategoryBreadcrumb struct {
@@ -49,3 +89,16 @@ type CategoryBreadcrumb struct {
 	IsLast   bool // True if this is the last item in breadcrumb
 	Level    int  // 0-based level in hierarchy (0=A1, 1=Compréhension écrite, 2=Sports)
 }
+
+// Breadcrumbs turns the path into a navigation trail, root first.
+func (cp CategoryPath) Breadcrumbs() []CategoryBreadcrumb {
+	breadcrumbs := make([]CategoryBreadcrumb, len(cp))
+	for i, category := range cp {
+		breadcrumbs[i] = CategoryBreadcrumb{
+			Category: category,
+			IsLast:   i == len(cp)-1,
+			Level:    i,
+		}
+	}
+	return breadcrumbs
+}
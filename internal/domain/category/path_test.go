@@ -6,6 +6,7 @@ import (
 
 	"github.com/alnah/fla/internal/domain/category"
 	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/shared"
 	"github.com/alnah/fla/internal/domain/user"
 )
 
@@ -204,6 +205,62 @@ func TestCategoryPath_Leaf(t *testing.T) {
 	})
 }
 
+func TestCategoryPath_Level(t *testing.T) {
+	a1ID := "a1"
+	a1 := createTestCategory("a1", "A1", nil)
+	reading := createTestCategory("reading", "Compréhension écrite", &a1ID)
+	culture := createTestCategory("culture", "Culture", nil)
+
+	tests := []struct {
+		name   string
+		path   category.CategoryPath
+		want   shared.CEFRLevel
+		wantOK bool
+	}{
+		{name: "level root", path: category.CategoryPath{a1, reading}, want: shared.LevelA1, wantOK: true},
+		{name: "non-level root", path: category.CategoryPath{culture}},
+		{name: "empty path"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := tt.path.Level()
+
+			if got != tt.want || ok != tt.wantOK {
+				t.Errorf("got (%q, %t), want (%q, %t)", got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestCategoryPath_CommonDepth(t *testing.T) {
+	a1ID, readingID := "a1", "reading"
+	a1 := createTestCategory("a1", "A1", nil)
+	a2 := createTestCategory("a2", "A2", nil)
+	reading := createTestCategory("reading", "Compréhension écrite", &a1ID)
+	sports := createTestCategory("sports", "Sports", &readingID)
+	food := createTestCategory("food", "Cuisine", &readingID)
+
+	tests := []struct {
+		name string
+		a, b category.CategoryPath
+		want int
+	}{
+		{name: "same topic", a: category.CategoryPath{a1, reading, sports}, b: category.CategoryPath{a1, reading, sports}, want: 3},
+		{name: "sibling topics", a: category.CategoryPath{a1, reading, sports}, b: category.CategoryPath{a1, reading, food}, want: 2},
+		{name: "ancestor", a: category.CategoryPath{a1}, b: category.CategoryPath{a1, reading}, want: 1},
+		{name: "different levels", a: category.CategoryPath{a1}, b: category.CategoryPath{a2}, want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.a.CommonDepth(tt.b); got != tt.want {
+				t.Errorf("got %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestCategoryBreadcrumb(t *testing.T) {
 	t.Run("breadcrumb properties", func(t *testing.T) {
 		cat := createTestCategory("a1", "A1", nil)
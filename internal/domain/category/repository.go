@@ -38,10 +38,12 @@ type CategoryWriter interface {
 type CategoryHierarchy interface {
 	// GetChildren finds subcategories for hierarchical content browsing.
 	// Used by navigation menus to show topic breakdowns (A1 → Reading, Writing).
+	// Results are ordered by SortOrder, then name (see SortCategories).
 	GetChildren(categoryID kernel.ID[Category]) ([]Category, error)
 
 	// GetRootCategories returns top-level learning categories for main navigation.
 	// Used by homepage menus and primary content organization (A1, A2, B1 levels).
+	// Results are ordered by SortOrder, then name (see SortCategories).
 	GetRootCategories() ([]Category, error)
 }
 
@@ -57,6 +59,14 @@ type CategoryPathBuilder interface {
 	FindByPath(pathSegments []string) (*Category, error)
 }
 
+// CategoryPathBatcher resolves many category paths at once.
+// Used by listings that show breadcrumbs or links for a page of posts.
+type CategoryPathBatcher interface {
+	// BuildPaths returns the path of every given category in one round trip.
+	// Returns ENotFound when any of them does not exist.
+	BuildPaths(categoryIDs []kernel.ID[Category]) (map[kernel.ID[Category]]CategoryPath, error)
+}
+
 // CategoryValidator provides data integrity checks for category creation.
 // Used by forms and APIs to prevent duplicate or invalid category structures.
 type CategoryValidator interface {
@@ -65,6 +75,14 @@ type CategoryValidator interface {
 	IsSlugUniqueInParent(slug shared.Slug, parentID *kernel.ID[Category]) (bool, error)
 }
 
+// CategoryOrderWriter persists display order changes for sibling categories.
+// Used by curriculum sequencing tools that reorder levels and skills.
+type CategoryOrderWriter interface {
+	// UpdateSortOrders saves the SortOrder of every given category in one transaction.
+	// Used by reorder operations so siblings never end up with a half-applied order.
+	UpdateSortOrders(categories []Category) error
+}
+
 // Composed interfaces for common use cases
 
 // CategoryBrowser combines reading and hierarchy for public navigation.
@@ -97,6 +115,7 @@ type CategoryOrganizer interface {
 	CategoryWriter
 	CategoryHierarchy
 	CategoryValidator
+	CategoryOrderWriter
 }
 
 // Full repository interface for implementations that provide everything.
@@ -106,5 +125,47 @@ type Repository interface {
 	CategoryWriter
 	CategoryHierarchy
 	CategoryPathBuilder
+	CategoryPathBatcher
 	CategoryValidator
+	CategoryOrderWriter
+}
+
+// Landing page copy
+
+// CopyBlockReader retrieves versioned landing page copy.
+// Used by category pages, static-site export, and the admin history view.
+type CopyBlockReader interface {
+	// GetCurrent returns the latest version of a block.
+	// Returns ENotFound when no copy was written for that locale and position.
+	GetCurrent(categoryID kernel.ID[Category], locale shared.Locale, position CopyPosition) (*CopyBlock, error)
+
+	// GetHistory returns every version of a block ordered from oldest to newest.
+	GetHistory(categoryID kernel.ID[Category], locale shared.Locale, position CopyPosition) ([]CopyBlock, error)
+}
+
+// CopyBlockWriter appends new versions of landing page copy.
+type CopyBlockWriter interface {
+	// Save stores a new version; returns EConflict if that version already exists.
+	// Lets concurrent editors detect that someone revised the block first.
+	Save(block CopyBlock) error
+}
+
+// CopyBlockRepository combines copy block history persistence and retrieval.
+type CopyBlockRepository interface {
+	CopyBlockReader
+	CopyBlockWriter
+}
+
+// Deletion support
+
+// CategoryContent reports and moves the posts filed under a category.
+// Implemented by the post repository; declared here to avoid an import cycle.
+type CategoryContent interface {
+	// CountPostsInCategory returns how many posts, in any status, use the category.
+	// Used by deletion checks so no post is left pointing at a removed category.
+	CountPostsInCategory(categoryID kernel.ID[Category]) (int, error)
+
+	// ReassignPosts moves every post from one category to another in one transaction.
+	// Returns the number of posts moved.
+	ReassignPosts(from, to kernel.ID[Category]) (int, error)
 }
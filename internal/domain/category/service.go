@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"net/url"
+	"slices"
 	"strings"
 
 	"github.com/alnah/fla/internal/domain/kernel"
@@ -63,14 +64,48 @@ func (s *PathService) GetBreadcrumbs(categoryID kernel.ID[Category]) ([]Category
 		return nil, err
 	}
 
-	breadcrumbs := make([]CategoryBreadcrumb, len(path))
-	for i, category := range path {
-		breadcrumbs[i] = CategoryBreadcrumb{
-			Category: category,
-			IsLast:   i == len(path)-1,
-			Level:    i,
-		}
+	return path.Breadcrumbs(), nil
+}
+
+// BuildURLs generates the URL paths of many categories with one repository call.
+// Used by listings where every post links to its category.
+func (s *PathService) BuildURLs(categoryIDs []kernel.ID[Category]) (map[kernel.ID[Category]]string, error) {
+	const op = "PathService.BuildURLs"
+
+	paths, err := s.buildPaths(categoryIDs)
+	if err != nil {
+		return nil, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	urls := make(map[kernel.ID[Category]]string, len(paths))
+	for id, path := range paths {
+		urls[id] = path.String()
+	}
+	return urls, nil
+}
+
+// GetBreadcrumbsFor creates the navigation trails of many categories with one repository call.
+// Pass the category IDs of a page of posts (see post.PostsList.CategoryIDs) to avoid one lookup per post.
+func (s *PathService) GetBreadcrumbsFor(categoryIDs []kernel.ID[Category]) (map[kernel.ID[Category]][]CategoryBreadcrumb, error) {
+	const op = "PathService.GetBreadcrumbsFor"
+
+	paths, err := s.buildPaths(categoryIDs)
+	if err != nil {
+		return nil, &kernel.Error{Operation: op, Cause: err}
 	}
 
+	breadcrumbs := make(map[kernel.ID[Category]][]CategoryBreadcrumb, len(paths))
+	for id, path := range paths {
+		breadcrumbs[id] = path.Breadcrumbs()
+	}
 	return breadcrumbs, nil
 }
+
+// buildPaths asks the repository once for each distinct category.
+func (s *PathService) buildPaths(categoryIDs []kernel.ID[Category]) (map[kernel.ID[Category]]CategoryPath, error) {
+	unique := slices.Compact(slices.Sorted(slices.Values(categoryIDs)))
+	if len(unique) == 0 {
+		return map[kernel.ID[Category]]CategoryPath{}, nil
+	}
+	return s.repository.BuildPaths(unique)
+}
@@ -12,7 +12,12 @@ import (
 // Mock repository for testing
 type mockRepository struct {
 	categories     map[string]category.Category
+	all            []category.Category
+	children       map[string][]category.Category
+	saved          []category.Category
+	deleted        []kernel.ID[category.Category]
 	paths          map[string]category.CategoryPath
+	pathCalls      int
 	buildPathFunc  func(kernel.ID[category.Category]) (category.CategoryPath, error)
 	findByPathFunc func([]string) (*category.Category, error)
 }
@@ -29,7 +34,7 @@ func (m *mockRepository) GetByID(catID kernel.ID[category.Category]) (*category.
 }
 
 func (m *mockRepository) GetAll() ([]category.Category, error) {
-	return nil, nil
+	return m.all, nil
 }
 
 func (m *mockRepository) Update(cat category.Category) error {
@@ -37,15 +42,16 @@ func (m *mockRepository) Update(cat category.Category) error {
 }
 
 func (m *mockRepository) Delete(catID kernel.ID[category.Category]) error {
+	m.deleted = append(m.deleted, catID)
 	return nil
 }
 
 func (m *mockRepository) GetChildren(catID kernel.ID[category.Category]) ([]category.Category, error) {
-	return nil, nil
+	return m.children[catID.String()], nil
 }
 
 func (m *mockRepository) GetRootCategories() ([]category.Category, error) {
-	return nil, nil
+	return m.children[""], nil
 }
 
 func (m *mockRepository) BuildPath(catID kernel.ID[category.Category]) (category.CategoryPath, error) {
@@ -58,6 +64,19 @@ func (m *mockRepository) BuildPath(catID kernel.ID[category.Category]) (category
 	return nil, &kernel.Error{Code: kernel.ENotFound, Message: "category not found"}
 }
 
+func (m *mockRepository) BuildPaths(catIDs []kernel.ID[category.Category]) (map[kernel.ID[category.Category]]category.CategoryPath, error) {
+	m.pathCalls++
+	paths := make(map[kernel.ID[category.Category]]category.CategoryPath, len(catIDs))
+	for _, id := range catIDs {
+		path, err := m.BuildPath(id)
+		if err != nil {
+			return nil, err
+		}
+		paths[id] = path
+	}
+	return paths, nil
+}
+
 func (m *mockRepository) FindByPath(pathSegments []string) (*category.Category, error) {
 	if m.findByPathFunc != nil {
 		return m.findByPathFunc(pathSegments)
@@ -69,6 +88,11 @@ func (m *mockRepository) IsSlugUniqueInParent(slug shared.Slug, parentID *kernel
 	return true, nil
 }
 
+func (m *mockRepository) UpdateSortOrders(categories []category.Category) error {
+	m.saved = categories
+	return nil
+}
+
 func TestPathService_BuildURL(t *testing.T) {
 	t.Run("builds URL for single category", func(t *testing.T) {
 		cat := createTestCategory("a1", "A1", nil)
@@ -379,3 +403,100 @@ func TestPathService_GetBreadcrumbs(t *testing.T) {
 		assertErrorCode(t, err, kernel.ENotFound)
 	})
 }
+
+func TestPathService_Batch(t *testing.T) {
+	a1ID, readingID := "a1", "reading"
+	a1 := createTestCategory("a1", "A1", nil)
+	reading := createTestCategory("reading", "Compréhension écrite", &a1ID)
+	sports := createTestCategory("sports", "Sports", &readingID)
+
+	newRepo := func() *mockRepository {
+		return &mockRepository{paths: map[string]category.CategoryPath{
+			"a1":     {a1},
+			"sports": {a1, reading, sports},
+		}}
+	}
+
+	t.Run("builds URLs in one call per distinct category", func(t *testing.T) {
+		repo := newRepo()
+
+		got, err := category.NewPathService(repo).BuildURLs([]kernel.ID[category.Category]{"sports", "a1", "sports"})
+
+		assertNoError(t, err)
+		if len(got) != 2 || got["sports"] != "a1/comprehension-ecrite/sports" || got["a1"] != "a1" {
+			t.Errorf("got %v", got)
+		}
+		if repo.pathCalls != 1 {
+			t.Errorf("got %d repository calls, want 1", repo.pathCalls)
+		}
+	})
+
+	t.Run("builds breadcrumbs for many categories", func(t *testing.T) {
+		got, err := category.NewPathService(newRepo()).GetBreadcrumbsFor([]kernel.ID[category.Category]{"sports", "a1"})
+
+		assertNoError(t, err)
+		trail := got["sports"]
+		if len(trail) != 3 || trail[1].Category.CategoryID != "reading" || trail[1].Level != 1 || !trail[2].IsLast {
+			t.Errorf("sports: got %+v", trail)
+		}
+		if len(got["a1"]) != 1 || !got["a1"][0].IsLast {
+			t.Errorf("a1: got %+v", got["a1"])
+		}
+	})
+
+	t.Run("fails when a category is missing", func(t *testing.T) {
+		_, err := category.NewPathService(newRepo()).BuildURLs([]kernel.ID[category.Category]{"a1", "b2"})
+
+		assertErrorCode(t, err, kernel.ENotFound)
+	})
+
+	t.Run("nothing to resolve", func(t *testing.T) {
+		repo := newRepo()
+
+		got, err := category.NewPathService(repo).BuildURLs(nil)
+
+		assertNoError(t, err)
+		if len(got) != 0 || repo.pathCalls != 0 {
+			t.Errorf("got %v after %d calls", got, repo.pathCalls)
+		}
+	})
+}
+
+func TestPathCache(t *testing.T) {
+	a1 := createTestCategory("a1", "A1", nil)
+	a2 := createTestCategory("a2", "A2", nil)
+	repo := &mockRepository{paths: map[string]category.CategoryPath{"a1": {a1}, "a2": {a2}}}
+	cache := category.NewPathCache(repo)
+
+	_, err := cache.BuildPaths([]kernel.ID[category.Category]{"a1"})
+	assertNoError(t, err)
+	paths, err := cache.BuildPaths([]kernel.ID[category.Category]{"a1", "a2"})
+	assertNoError(t, err)
+	_, err = cache.BuildPath("a2")
+	assertNoError(t, err)
+
+	if len(paths) != 2 || repo.pathCalls != 2 {
+		t.Errorf("got %d paths after %d repository calls, want 2 after 2", len(paths), repo.pathCalls)
+	}
+
+	t.Run("category changes clear the cache", func(t *testing.T) {
+		renamed := createTestCategory("a1", "A1 débutant", nil)
+		repo.paths["a1"] = category.CategoryPath{renamed}
+		assertNoError(t, cache.Update(renamed))
+
+		got, err := cache.BuildPath("a1")
+		assertNoError(t, err)
+		if got.String() != "a1-debutant" {
+			t.Errorf("got %s", got)
+		}
+	})
+
+	t.Run("errors are not cached", func(t *testing.T) {
+		_, err := cache.BuildPath("b1")
+		assertErrorCode(t, err, kernel.ENotFound)
+
+		repo.paths["b1"] = category.CategoryPath{createTestCategory("b1", "B1", nil)}
+		_, err = cache.BuildPath("b1")
+		assertNoError(t, err)
+	})
+}
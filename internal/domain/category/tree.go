@@ -0,0 +1,194 @@
+package category
+
+import (
+	"fmt"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+)
+
+const (
+	MCategoryTreeDuplicate     string = "Category %s appears more than once."
+	MCategoryTreeUnknownParent string = "Category %s has unknown parent %s."
+	MCategoryTreeCycle         string = "Category %s is its own ancestor."
+)
+
+// CategoryTree is a snapshot of the whole hierarchy, built once from
+// CategoryReader.GetAll and then traversed in memory. Sitemaps, navigation menus,
+// and validations that ask many hierarchy questions use it instead of calling the
+// repository for each. Siblings are in display order (see SortCategories).
+// The snapshot does not follow later changes; build a new one after writes.
+type CategoryTree struct {
+	categories map[kernel.ID[Category]]Category
+	children   map[kernel.ID[Category]][]kernel.ID[Category]
+	roots      []kernel.ID[Category]
+}
+
+// NewCategoryTree builds a tree from every category of the blog.
+// Fails with EInvalid on duplicates, parents missing from the list, or parent cycles.
+func NewCategoryTree(categories []Category) (CategoryTree, error) {
+	const op = "NewCategoryTree"
+
+	t := CategoryTree{
+		categories: make(map[kernel.ID[Category]]Category, len(categories)),
+		children:   make(map[kernel.ID[Category]][]kernel.ID[Category]),
+	}
+	for _, c := range categories {
+		if _, ok := t.categories[c.CategoryID]; ok {
+			return CategoryTree{}, invalidTree(op, MCategoryTreeDuplicate, c.CategoryID)
+		}
+		t.categories[c.CategoryID] = c
+	}
+
+	sorted := append([]Category(nil), categories...)
+	SortCategories(sorted)
+	for _, c := range sorted {
+		if c.ParentID == nil {
+			t.roots = append(t.roots, c.CategoryID)
+			continue
+		}
+		if _, ok := t.categories[*c.ParentID]; !ok {
+			return CategoryTree{}, invalidTree(op, MCategoryTreeUnknownParent, c.CategoryID, *c.ParentID)
+		}
+		t.children[*c.ParentID] = append(t.children[*c.ParentID], c.CategoryID)
+	}
+
+	// Every category reachable from a root is sound; the others sit on a cycle
+	if reachable := len(t.Flatten()); reachable != len(t.categories) {
+		for _, c := range sorted {
+			if _, ok := t.DepthOf(c.CategoryID); !ok {
+				return CategoryTree{}, invalidTree(op, MCategoryTreeCycle, c.CategoryID)
+			}
+		}
+	}
+
+	return t, nil
+}
+
+// LoadCategoryTree reads every category from the repository and builds a tree.
+func LoadCategoryTree(repository CategoryReader) (CategoryTree, error) {
+	const op = "LoadCategoryTree"
+
+	categories, err := repository.GetAll()
+	if err != nil {
+		return CategoryTree{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	tree, err := NewCategoryTree(categories)
+	if err != nil {
+		return CategoryTree{}, &kernel.Error{Operation: op, Cause: err}
+	}
+	return tree, nil
+}
+
+func invalidTree(op, message string, args ...any) error {
+	return &kernel.Error{Code: kernel.EInvalid, Message: fmt.Sprintf(message, args...), Operation: op}
+}
+
+// Len returns how many categories the tree holds.
+func (t CategoryTree) Len() int {
+	return len(t.categories)
+}
+
+// Get returns a category of the tree.
+func (t CategoryTree) Get(categoryID kernel.ID[Category]) (Category, bool) {
+	c, ok := t.categories[categoryID]
+	return c, ok
+}
+
+// Roots returns the top-level categories in display order.
+func (t CategoryTree) Roots() []Category {
+	return t.lookup(t.roots)
+}
+
+// Children returns the direct subcategories in display order; nil for leaves and unknown IDs.
+func (t CategoryTree) Children(categoryID kernel.ID[Category]) []Category {
+	return t.lookup(t.children[categoryID])
+}
+
+// Ancestors returns the categories above one, root first; nil for roots and unknown IDs.
+func (t CategoryTree) Ancestors(categoryID kernel.ID[Category]) []Category {
+	path, ok := t.Path(categoryID)
+	if !ok {
+		return nil
+	}
+	return path[:len(path)-1]
+}
+
+// Path returns the trail from the root down to the category, as CategoryPathBuilder.BuildPath does.
+func (t CategoryTree) Path(categoryID kernel.ID[Category]) (CategoryPath, bool) {
+	c, ok := t.categories[categoryID]
+	if !ok {
+		return nil, false
+	}
+
+	path := CategoryPath{c}
+	for c.ParentID != nil {
+		if len(path) > len(t.categories) { // Only reachable while NewCategoryTree looks for cycles
+			return nil, false
+		}
+		c = t.categories[*c.ParentID]
+		path = append(CategoryPath{c}, path...)
+	}
+	return path, true
+}
+
+// DepthOf returns how many ancestors a category has: 0 for roots.
+func (t CategoryTree) DepthOf(categoryID kernel.ID[Category]) (int, bool) {
+	path, ok := t.Path(categoryID)
+	if !ok {
+		return 0, false
+	}
+	return path.Depth(), true
+}
+
+// Subtree returns the category followed by all its descendants, depth first
+// in display order; nil for unknown IDs.
+func (t CategoryTree) Subtree(categoryID kernel.ID[Category]) []Category {
+	if _, ok := t.categories[categoryID]; !ok {
+		return nil
+	}
+	return t.walk(nil, categoryID)
+}
+
+// Flatten returns every category depth first in display order, the order of a
+// fully expanded navigation menu or a sitemap.
+func (t CategoryTree) Flatten() []Category {
+	var out []Category
+	for _, root := range t.roots {
+		out = t.walk(out, root)
+	}
+	return out
+}
+
+// IsWithin reports whether a category is the given ancestor or sits below it.
+func (t CategoryTree) IsWithin(categoryID, ancestorID kernel.ID[Category]) bool {
+	path, ok := t.Path(categoryID)
+	if !ok {
+		return false
+	}
+	for _, c := range path {
+		if c.CategoryID == ancestorID {
+			return true
+		}
+	}
+	return false
+}
+
+func (t CategoryTree) walk(out []Category, categoryID kernel.ID[Category]) []Category {
+	out = append(out, t.categories[categoryID])
+	for _, child := range t.children[categoryID] {
+		out = t.walk(out, child)
+	}
+	return out
+}
+
+func (t CategoryTree) lookup(ids []kernel.ID[Category]) []Category {
+	if len(ids) == 0 {
+		return nil
+	}
+	out := make([]Category, len(ids))
+	for i, id := range ids {
+		out[i] = t.categories[id]
+	}
+	return out
+}
@@ -0,0 +1,108 @@
+package category_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/alnah/fla/internal/domain/category"
+	"github.com/alnah/fla/internal/domain/kernel"
+)
+
+// newTestTree builds A1 > {Compréhension orale, Compréhension écrite > Sports} and A2.
+func newTestTree(t *testing.T) category.CategoryTree {
+	t.Helper()
+
+	a1, reading := "a1", "reading"
+	tree, err := category.NewCategoryTree([]category.Category{
+		createTestCategory("sports", "Sports", &reading),
+		createTestCategory("a2", "A2", nil),
+		createTestCategory("listening", "Compréhension orale", &a1),
+		createTestCategory("reading", "Compréhension écrite", &a1),
+		createTestCategory("a1", "A1", nil),
+	})
+	assertNoError(t, err)
+	return tree
+}
+
+func ids(categories []category.Category) string {
+	out := make([]string, len(categories))
+	for i, c := range categories {
+		out[i] = c.CategoryID.String()
+	}
+	return strings.Join(out, ",")
+}
+
+func TestCategoryTree_Traversal(t *testing.T) {
+	tree := newTestTree(t)
+
+	tests := []struct {
+		name string
+		got  []category.Category
+		want string
+	}{
+		{"roots", tree.Roots(), "a1,a2"},
+		{"children in display order", tree.Children("a1"), "listening,reading"},
+		{"leaf has no children", tree.Children("sports"), ""},
+		{"ancestors root first", tree.Ancestors("sports"), "a1,reading"},
+		{"root has no ancestors", tree.Ancestors("a1"), ""},
+		{"subtree depth first", tree.Subtree("a1"), "a1,listening,reading,sports"},
+		{"unknown subtree", tree.Subtree("c1"), ""},
+		{"flatten", tree.Flatten(), "a1,listening,reading,sports,a2"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ids(tt.got); got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	if depth, ok := tree.DepthOf("sports"); !ok || depth != 2 {
+		t.Errorf("depth of sports: got %d, %v", depth, ok)
+	}
+	if _, ok := tree.DepthOf("c1"); ok {
+		t.Error("expected no depth for an unknown category")
+	}
+	if path, _ := tree.Path("sports"); path.String() != "a1/comprehension-ecrite/sports" {
+		t.Errorf("path: got %s", path)
+	}
+	if !tree.IsWithin("sports", "a1") || !tree.IsWithin("a1", "a1") || tree.IsWithin("sports", "a2") {
+		t.Error("IsWithin: wrong answer")
+	}
+	if tree.Len() != 5 {
+		t.Errorf("len: got %d", tree.Len())
+	}
+}
+
+func TestNewCategoryTree_Invalid(t *testing.T) {
+	a1, b1, b2 := "a1", "b1", "b2"
+
+	tests := map[string][]category.Category{
+		"duplicate":      {createTestCategory("a1", "A1", nil), createTestCategory("a1", "A1 bis", nil)},
+		"unknown parent": {createTestCategory("reading", "Lecture", &a1)},
+		"cycle": {
+			createTestCategory("a2", "A2", nil),
+			createTestCategory("b1", "B1", &b2),
+			createTestCategory("b2", "B2", &b1),
+		},
+	}
+
+	for name, categories := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := category.NewCategoryTree(categories)
+			assertErrorCode(t, err, kernel.EInvalid)
+		})
+	}
+}
+
+func TestLoadCategoryTree(t *testing.T) {
+	repo := &mockRepository{all: []category.Category{createTestCategory("a1", "A1", nil)}}
+
+	tree, err := category.LoadCategoryTree(repo)
+
+	assertNoError(t, err)
+	if ids(tree.Roots()) != "a1" {
+		t.Errorf("got %s", ids(tree.Roots()))
+	}
+}
@@ -0,0 +1,163 @@
+// Package certificate issues and verifies certificates of completion for learners.
+package certificate
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/curriculum"
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/shared"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+const (
+	MaxLearnerNameLength int = 100
+	MaxTitleLength       int = 150
+	MaxRevocationLength  int = 500
+
+	MCertificateKindInvalid     string = "Invalid certificate kind."
+	MCertificateLevelMissing    string = "Level certificates need a CEFR level."
+	MCertificatePathMissing     string = "Learning path certificates need a learning path."
+	MCertificateRevokeForbidden string = "Only admins can revoke certificates."
+	MCertificateAlreadyRevoked  string = "Certificate is already revoked."
+)
+
+// Kind says what the learner completed.
+type Kind string
+
+const (
+	KindLevel      Kind = "level"      // Every lesson of a CEFR level
+	KindCurriculum Kind = "curriculum" // A learning path
+)
+
+func (k Kind) String() string { return string(k) }
+
+// Validate ensures the kind is known.
+func (k Kind) Validate() error {
+	const op = "Kind.Validate"
+
+	switch k {
+	case KindLevel, KindCurriculum:
+		return nil
+	default:
+		return &kernel.Error{Code: kernel.EInvalid, Message: MCertificateKindInvalid, Operation: op}
+	}
+}
+
+// Certificate attests that a learner completed a level or learning path.
+// Anyone holding the ID and token can verify it; admins can revoke it.
+type Certificate struct {
+	// Identity
+	CertificateID kernel.ID[Certificate] // Printed on the certificate
+
+	// Data
+	UserID       kernel.ID[user.User]
+	LearnerName  string // Name as printed, frozen at issue time
+	Kind         Kind
+	Level        shared.CEFRLevel                  // Required for level certificates
+	CurriculumID *kernel.ID[curriculum.Curriculum] // Required for learning path certificates
+	Title        string                            // e.g. "Niveau A1" or the learning path title
+	Token        Token
+
+	// Lifecycle
+	IssuedAt         time.Time
+	RevokedAt        *time.Time
+	RevokedBy        *kernel.ID[user.User]
+	RevocationReason string
+
+	// DI
+	Clock kernel.Clock
+}
+
+// Validate performs validation on the certificate.
+func (c Certificate) Validate() error {
+	const op = "Certificate.Validate"
+
+	if err := c.CertificateID.Validate(); err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if err := c.UserID.Validate(); err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if err := kernel.ValidateLength("learner name", c.LearnerName, 1, MaxLearnerNameLength, op); err != nil {
+		return err
+	}
+
+	if err := c.Kind.Validate(); err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	switch {
+	case c.Kind == KindLevel && c.Level == "":
+		return &kernel.Error{Code: kernel.EInvalid, Message: MCertificateLevelMissing, Operation: op}
+	case c.Kind == KindCurriculum && c.CurriculumID == nil:
+		return &kernel.Error{Code: kernel.EInvalid, Message: MCertificatePathMissing, Operation: op}
+	}
+
+	if c.Level != "" {
+		if err := c.Level.Validate(); err != nil {
+			return &kernel.Error{Operation: op, Cause: err}
+		}
+	}
+
+	if err := kernel.ValidateLength("certificate title", c.Title, 1, MaxTitleLength, op); err != nil {
+		return err
+	}
+
+	if err := c.Token.Validate(); err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return nil
+}
+
+// AchievementKey identifies what was completed, so a learner gets one certificate per achievement.
+func (c Certificate) AchievementKey() string {
+	return achievementKey(c.Kind, c.Level, c.CurriculumID)
+}
+
+// IsRevoked reports whether the certificate was withdrawn.
+func (c Certificate) IsRevoked() bool {
+	return c.RevokedAt != nil
+}
+
+// Revoke withdraws a certificate issued in error or obtained by cheating; admins only.
+func (c Certificate) Revoke(actor user.PostPermissionChecker, reason string) (Certificate, error) {
+	const op = "Certificate.Revoke"
+
+	if !user.IsActiveAdmin(actor) {
+		return c, &kernel.Error{Code: kernel.EForbidden, Message: MCertificateRevokeForbidden, Operation: op}
+	}
+
+	if c.IsRevoked() {
+		return c, &kernel.Error{Code: kernel.EConflict, Message: MCertificateAlreadyRevoked, Operation: op}
+	}
+
+	if err := kernel.ValidateLength("revocation reason", reason, 1, MaxRevocationLength, op); err != nil {
+		return c, err
+	}
+
+	now, by := c.Clock.Now(), actor.GetID()
+
+	updated := c
+	updated.RevokedAt = &now
+	updated.RevokedBy = &by
+	updated.RevocationReason = reason
+
+	return updated, nil
+}
+
+// String returns a string representation of the certificate.
+func (c Certificate) String() string {
+	return fmt.Sprintf("Certificate{ID: %s, UserID: %s, Achievement: %s}", c.CertificateID, c.UserID, c.AchievementKey())
+}
+
+func achievementKey(kind Kind, level shared.CEFRLevel, curriculumID *kernel.ID[curriculum.Curriculum]) string {
+	if kind == KindCurriculum && curriculumID != nil {
+		return "curriculum:" + curriculumID.String()
+	}
+	return "level:" + level.String()
+}
@@ -0,0 +1,94 @@
+package certificate_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/certificate"
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+type stubClock struct {
+	t time.Time
+}
+
+func (s *stubClock) Now() time.Time { return s.t }
+
+func admin() user.User {
+	return user.User{ID: "admin-1", Roles: []user.Role{user.RoleAdmin}}
+}
+
+func editor() user.User {
+	return user.User{ID: "editor-1", Roles: []user.Role{user.RoleEditor}}
+}
+
+type stubRepository struct {
+	byID    map[kernel.ID[certificate.Certificate]]certificate.Certificate
+	creates int
+}
+
+func newStubRepository() *stubRepository {
+	return &stubRepository{byID: make(map[kernel.ID[certificate.Certificate]]certificate.Certificate)}
+}
+
+func (r *stubRepository) GetByID(id kernel.ID[certificate.Certificate]) (*certificate.Certificate, error) {
+	if c, ok := r.byID[id]; ok {
+		return &c, nil
+	}
+	return nil, &kernel.Error{Code: kernel.ENotFound, Message: "certificate not found"}
+}
+
+func (r *stubRepository) GetByAchievement(userID kernel.ID[user.User], key string) (*certificate.Certificate, error) {
+	for _, c := range r.byID {
+		if c.UserID == userID && c.AchievementKey() == key {
+			return &c, nil
+		}
+	}
+	return nil, &kernel.Error{Code: kernel.ENotFound, Message: "certificate not found"}
+}
+
+func (r *stubRepository) GetByUser(userID kernel.ID[user.User]) ([]certificate.Certificate, error) {
+	var certs []certificate.Certificate
+	for _, c := range r.byID {
+		if c.UserID == userID {
+			certs = append(certs, c)
+		}
+	}
+	return certs, nil
+}
+
+func (r *stubRepository) Create(c certificate.Certificate) error {
+	r.creates++
+	r.byID[c.CertificateID] = c
+	return nil
+}
+
+func (r *stubRepository) Update(c certificate.Certificate) error {
+	r.byID[c.CertificateID] = c
+	return nil
+}
+
+type stubUsers map[kernel.ID[user.User]]user.User
+
+func (s stubUsers) GetUserByID(id kernel.ID[user.User]) (*user.User, error) {
+	if u, ok := s[id]; ok {
+		return &u, nil
+	}
+	return nil, &kernel.Error{Code: kernel.ENotFound, Message: "user not found"}
+}
+
+func assertNoError(t *testing.T, err error) {
+	t.Helper()
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+}
+
+func assertErrorCode(t *testing.T, err error, want string) {
+	t.Helper()
+	got := kernel.ErrorCode(err)
+	if got != want {
+		t.Errorf("error code: got %q, want %q", got, want)
+	}
+}
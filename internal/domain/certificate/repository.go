@@ -0,0 +1,41 @@
+package certificate
+
+import (
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+// CertificateReader retrieves issued certificates.
+type CertificateReader interface {
+	// GetByID returns a certificate. Returns ENotFound when missing.
+	GetByID(certificateID kernel.ID[Certificate]) (*Certificate, error)
+
+	// GetByAchievement returns the learner's certificate for an achievement key.
+	// Returns ENotFound when none was issued.
+	GetByAchievement(userID kernel.ID[user.User], achievementKey string) (*Certificate, error)
+
+	// GetByUser lists a learner's certificates, newest first, for their profile.
+	GetByUser(userID kernel.ID[user.User]) ([]Certificate, error)
+}
+
+// CertificateWriter persists certificates.
+type CertificateWriter interface {
+	// Create stores a newly issued certificate.
+	Create(certificate Certificate) error
+
+	// Update saves revocations.
+	Update(certificate Certificate) error
+}
+
+// Repository combines all certificate operations.
+// Most concrete implementations (like PostgresCertificateRepository) will implement this.
+type Repository interface {
+	CertificateReader
+	CertificateWriter
+}
+
+// UserReader loads the learner whose name goes on the certificate.
+type UserReader interface {
+	// GetUserByID retrieves a user; returns ENotFound for deleted accounts.
+	GetUserByID(userID kernel.ID[user.User]) (*user.User, error)
+}
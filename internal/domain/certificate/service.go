@@ -0,0 +1,155 @@
+package certificate
+
+import (
+	"time"
+
+	"github.com/alnah/fla/internal/domain/curriculum"
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/shared"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+const MCertificateNotFound string = "No certificate matches this link."
+
+// Completion is what the progress subsystem reports when a learner finishes a level or path.
+type Completion struct {
+	UserID       kernel.ID[user.User]
+	Kind         Kind
+	Level        shared.CEFRLevel                  // Set for level completions
+	CurriculumID *kernel.ID[curriculum.Curriculum] // Set for learning path completions
+	Title        string                            // What the learner completed, as printed
+	CompletedAt  time.Time                         // Dates the certificate; zero dates it when handled
+}
+
+// CompletionHandler is the hook the progress subsystem calls on each completion.
+// Implemented by CertificateService.
+type CompletionHandler interface {
+	HandleCompletion(completion Completion) (Certificate, error)
+}
+
+// Verification is the public answer to "is this certificate genuine?".
+type Verification struct {
+	Certificate Certificate
+	Valid       bool // False when revoked
+}
+
+// CertificateService issues certificates on completion and verifies them publicly.
+type CertificateService struct {
+	repository Repository
+	users      UserReader
+	clock      kernel.Clock
+}
+
+// NewCertificateService creates certificate service with persistence and learner lookup.
+func NewCertificateService(repository Repository, users UserReader, clock kernel.Clock) *CertificateService {
+	return &CertificateService{
+		repository: repository,
+		users:      users,
+		clock:      clock,
+	}
+}
+
+// HandleCompletion issues a certificate for a completion, once per learner and achievement.
+// Repeated completions return the certificate already issued.
+func (s *CertificateService) HandleCompletion(completion Completion) (Certificate, error) {
+	const op = "CertificateService.HandleCompletion"
+
+	key := achievementKey(completion.Kind, completion.Level, completion.CurriculumID)
+	existing, err := s.repository.GetByAchievement(completion.UserID, key)
+	if err == nil {
+		return *existing, nil
+	}
+	if kernel.ErrorCode(err) != kernel.ENotFound {
+		return Certificate{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	learner, err := s.users.GetUserByID(completion.UserID)
+	if err != nil {
+		return Certificate{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	id, err := NewCertificateID()
+	if err != nil {
+		return Certificate{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	token, err := NewToken()
+	if err != nil {
+		return Certificate{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	issuedAt := completion.CompletedAt
+	if issuedAt.IsZero() {
+		issuedAt = s.clock.Now()
+	}
+
+	cert := Certificate{
+		CertificateID: id,
+		UserID:        completion.UserID,
+		LearnerName:   learnerName(*learner),
+		Kind:          completion.Kind,
+		Level:         completion.Level,
+		CurriculumID:  completion.CurriculumID,
+		Title:         completion.Title,
+		Token:         token,
+		IssuedAt:      issuedAt,
+		Clock:         s.clock,
+	}
+
+	if err := cert.Validate(); err != nil {
+		return Certificate{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if err := s.repository.Create(cert); err != nil {
+		return Certificate{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return cert, nil
+}
+
+// Verify checks a certificate link. Unknown IDs and wrong tokens both return ENotFound,
+// so the endpoint cannot be used to probe for valid IDs.
+func (s *CertificateService) Verify(certificateID kernel.ID[Certificate], token Token) (Verification, error) {
+	const op = "CertificateService.Verify"
+
+	cert, err := s.repository.GetByID(certificateID)
+	if err != nil && kernel.ErrorCode(err) != kernel.ENotFound {
+		return Verification{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if err != nil || !cert.Token.Matches(token) {
+		return Verification{}, &kernel.Error{Code: kernel.ENotFound, Message: MCertificateNotFound, Operation: op}
+	}
+
+	return Verification{Certificate: *cert, Valid: !cert.IsRevoked()}, nil
+}
+
+// Revoke withdraws a certificate on behalf of an admin.
+func (s *CertificateService) Revoke(certificateID kernel.ID[Certificate], actor user.PostPermissionChecker, reason string) (Certificate, error) {
+	const op = "CertificateService.Revoke"
+
+	cert, err := s.repository.GetByID(certificateID)
+	if err != nil {
+		return Certificate{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	cert.Clock = s.clock
+	revoked, err := cert.Revoke(actor, reason)
+	if err != nil {
+		return Certificate{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if err := s.repository.Update(revoked); err != nil {
+		return Certificate{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return revoked, nil
+}
+
+// learnerName prefers the full name, as a certificate is a formal document.
+func learnerName(u user.User) string {
+	if name := u.GetFullName(); name != "" {
+		return name
+	}
+	return u.GetDisplayName()
+}
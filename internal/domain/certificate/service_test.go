@@ -0,0 +1,165 @@
+package certificate_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/certificate"
+	"github.com/alnah/fla/internal/domain/curriculum"
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/shared"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+var issuedAt = time.Date(2024, 6, 1, 9, 0, 0, 0, time.UTC)
+
+func newTestService() (*certificate.CertificateService, *stubRepository) {
+	repo := newStubRepository()
+	users := stubUsers{
+		"marie": {ID: "marie", FirstName: "Marie", LastName: "Curie", Username: "marie"},
+		"paul":  {ID: "paul", Username: "paul"},
+	}
+	return certificate.NewCertificateService(repo, users, &stubClock{t: issuedAt}), repo
+}
+
+func levelCompletion(userID kernel.ID[user.User]) certificate.Completion {
+	return certificate.Completion{UserID: userID, Kind: certificate.KindLevel, Level: shared.LevelA1, Title: "Niveau A1"}
+}
+
+func TestCertificateService_HandleCompletion(t *testing.T) {
+	t.Run("issues a certificate with the learner's full name", func(t *testing.T) {
+		service, _ := newTestService()
+
+		got, err := service.HandleCompletion(levelCompletion("marie"))
+
+		assertNoError(t, err)
+		if got.LearnerName != "Marie Curie" || !got.IssuedAt.Equal(issuedAt) || got.Token == "" {
+			t.Errorf("got %+v", got)
+		}
+	})
+
+	t.Run("dates the certificate at completion", func(t *testing.T) {
+		service, _ := newTestService()
+		completion := levelCompletion("marie")
+		completion.CompletedAt = issuedAt.Add(-48 * time.Hour)
+
+		got, err := service.HandleCompletion(completion)
+
+		assertNoError(t, err)
+		if !got.IssuedAt.Equal(completion.CompletedAt) {
+			t.Errorf("got issued at %s, want %s", got.IssuedAt, completion.CompletedAt)
+		}
+	})
+
+	t.Run("falls back to the display name", func(t *testing.T) {
+		service, _ := newTestService()
+
+		got, err := service.HandleCompletion(levelCompletion("paul"))
+
+		assertNoError(t, err)
+		if got.LearnerName != "paul" {
+			t.Errorf("got %q", got.LearnerName)
+		}
+	})
+
+	t.Run("issues once per achievement", func(t *testing.T) {
+		service, repo := newTestService()
+		first, _ := service.HandleCompletion(levelCompletion("marie"))
+
+		second, err := service.HandleCompletion(levelCompletion("marie"))
+
+		assertNoError(t, err)
+		if second.CertificateID != first.CertificateID || repo.creates != 1 {
+			t.Errorf("expected the first certificate back, got %d creates", repo.creates)
+		}
+	})
+
+	t.Run("learning paths are distinct achievements", func(t *testing.T) {
+		service, repo := newTestService()
+		path := kernel.ID[curriculum.Curriculum]("a1-course")
+		_, _ = service.HandleCompletion(levelCompletion("marie"))
+
+		_, err := service.HandleCompletion(certificate.Completion{
+			UserID: "marie", Kind: certificate.KindCurriculum, CurriculumID: &path, Title: "Parcours A1 complet",
+		})
+
+		assertNoError(t, err)
+		if repo.creates != 2 {
+			t.Errorf("got %d creates, want 2", repo.creates)
+		}
+	})
+
+	tests := []struct {
+		name       string
+		completion certificate.Completion
+		code       string
+	}{
+		{"unknown learner", levelCompletion("ghost"), kernel.ENotFound},
+		{"level missing", certificate.Completion{UserID: "marie", Kind: certificate.KindLevel, Title: "Niveau"}, kernel.EInvalid},
+		{"path missing", certificate.Completion{UserID: "marie", Kind: certificate.KindCurriculum, Title: "Parcours"}, kernel.EInvalid},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, _ := newTestService()
+
+			_, err := service.HandleCompletion(tt.completion)
+
+			assertErrorCode(t, err, tt.code)
+		})
+	}
+}
+
+func TestCertificateService_Verify(t *testing.T) {
+	service, _ := newTestService()
+	cert, _ := service.HandleCompletion(levelCompletion("marie"))
+
+	t.Run("valid certificate", func(t *testing.T) {
+		got, err := service.Verify(cert.CertificateID, cert.Token)
+
+		assertNoError(t, err)
+		if !got.Valid || got.Certificate.LearnerName != "Marie Curie" {
+			t.Errorf("got %+v", got)
+		}
+	})
+
+	t.Run("wrong token looks like an unknown certificate", func(t *testing.T) {
+		_, err := service.Verify(cert.CertificateID, "forged")
+
+		assertErrorCode(t, err, kernel.ENotFound)
+	})
+
+	t.Run("revoked certificate is not valid", func(t *testing.T) {
+		_, err := service.Revoke(cert.CertificateID, admin(), "Issued to the wrong account.")
+		assertNoError(t, err)
+
+		got, err := service.Verify(cert.CertificateID, cert.Token)
+
+		assertNoError(t, err)
+		if got.Valid {
+			t.Error("expected revoked certificate to be invalid")
+		}
+	})
+}
+
+func TestCertificateService_Revoke(t *testing.T) {
+	service, _ := newTestService()
+	cert, _ := service.HandleCompletion(levelCompletion("marie"))
+
+	_, err := service.Revoke(cert.CertificateID, editor(), "Cheating.")
+	assertErrorCode(t, err, kernel.EForbidden)
+
+	suspended := admin()
+	suspended.Status = user.AccountStatusSuspended
+	_, err = service.Revoke(cert.CertificateID, suspended, "Cheating.")
+	assertErrorCode(t, err, kernel.EForbidden)
+
+	_, err = service.Revoke(cert.CertificateID, admin(), "")
+	assertErrorCode(t, err, kernel.EInvalid)
+
+	_, err = service.Revoke(cert.CertificateID, admin(), "Cheating.")
+	assertNoError(t, err)
+
+	_, err = service.Revoke(cert.CertificateID, admin(), "Cheating.")
+	assertErrorCode(t, err, kernel.EConflict)
+}
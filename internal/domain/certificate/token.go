@@ -0,0 +1,73 @@
+package certificate
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/base64"
+	"strings"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+)
+
+const (
+	// TokenBytes is the entropy of a verification token before encoding.
+	TokenBytes = 16
+
+	// idBytes yields 16 base32 characters, printed as four groups of four.
+	idBytes = 10
+)
+
+const (
+	MTokenMissing      string = "Missing verification token."
+	MTokenGenerateFail string = "Certificate identifiers could not be generated."
+)
+
+// NewCertificateID generates a short, unambiguous ID printed on the certificate, e.g. "K7QM-2XPA-9DFW-H3TN".
+func NewCertificateID() (kernel.ID[Certificate], error) {
+	const op = "NewCertificateID"
+
+	b := make([]byte, idBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", &kernel.Error{Code: kernel.EInternal, Message: MTokenGenerateFail, Operation: op, Cause: err}
+	}
+
+	raw := base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(b)
+	groups := []string{raw[0:4], raw[4:8], raw[8:12], raw[12:16]}
+
+	return kernel.ID[Certificate](strings.Join(groups, "-")), nil
+}
+
+// Token proves a certificate link was issued by the site; it is part of the verification URL.
+// Not a credential: it only stops guessing valid certificate IDs from revealing learner names.
+type Token string
+
+// NewToken generates a random URL-safe token.
+func NewToken() (Token, error) {
+	const op = "NewToken"
+
+	b := make([]byte, TokenBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", &kernel.Error{Code: kernel.EInternal, Message: MTokenGenerateFail, Operation: op, Cause: err}
+	}
+
+	return Token(base64.RawURLEncoding.EncodeToString(b)), nil
+}
+
+func (t Token) String() string { return string(t) }
+
+// Validate ensures a token is present.
+func (t Token) Validate() error {
+	const op = "Token.Validate"
+
+	if t == "" {
+		return &kernel.Error{Code: kernel.EInvalid, Message: MTokenMissing, Operation: op}
+	}
+
+	return nil
+}
+
+// Matches compares tokens in constant time.
+func (t Token) Matches(other Token) bool {
+	return t != "" && subtle.ConstantTimeCompare([]byte(t), []byte(other)) == 1
+}
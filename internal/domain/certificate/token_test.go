@@ -0,0 +1,34 @@
+package certificate_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/alnah/fla/internal/domain/certificate"
+)
+
+func TestNewCertificateID(t *testing.T) {
+	id, err := certificate.NewCertificateID()
+
+	assertNoError(t, err)
+	if !regexp.MustCompile(`^[A-Z2-7]{4}(-[A-Z2-7]{4}){3}$`).MatchString(id.String()) {
+		t.Errorf("unexpected format %q", id)
+	}
+
+	other, _ := certificate.NewCertificateID()
+	if other == id {
+		t.Error("expected distinct IDs")
+	}
+}
+
+func TestToken_Matches(t *testing.T) {
+	token, err := certificate.NewToken()
+	assertNoError(t, err)
+
+	if !token.Matches(token) {
+		t.Error("expected token to match itself")
+	}
+	if token.Matches("forged") || certificate.Token("").Matches("") {
+		t.Error("expected mismatch")
+	}
+}
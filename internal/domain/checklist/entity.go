@@ -0,0 +1,156 @@
+// Package checklist holds the editorial checklists lessons go through before approval.
+// Each category may define a template of items (vocabulary list, audio, exercises,
+// SEO description...); subcategories inherit the nearest one. Some items are checked
+// automatically against the post, the others need an editor's sign-off.
+package checklist
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/category"
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/post"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+const (
+	MChecklistEmpty         string = "Checklist must have at least one item."
+	MChecklistTooManyItems  string = "Checklist cannot have more than %d items."
+	MChecklistItemKey       string = "Checklist item key %q must be lowercase letters, digits, and underscores."
+	MChecklistItemDuplicate string = "Checklist item %q is listed more than once."
+	MChecklistItemLabel     string = "Checklist item %q needs a label."
+	MChecklistRuleInvalid   string = "Invalid checklist rule: %s."
+)
+
+// MaxItems bounds a template; longer lists stop being read.
+const MaxItems = 20
+
+// Rule tells how an item is checked.
+type Rule string
+
+const (
+	RuleManual         Rule = "manual"          // An editor signs the item off
+	RuleSEODescription Rule = "seo_description" // The post has an SEO description
+	RuleFeaturedImage  Rule = "featured_image"  // The post has a featured image
+	RuleVocabularyList Rule = "vocabulary_list" // The content has a vocabulary heading
+	RuleAudio          Rule = "audio"           // The content links an audio file
+	RuleExercises      Rule = "exercises"       // The content has an exercises heading
+)
+
+func (r Rule) String() string { return string(r) }
+
+// Validate ensures the rule is one checklists know.
+func (r Rule) Validate() error {
+	const op = "Rule.Validate"
+
+	switch r {
+	case RuleManual, RuleSEODescription, RuleFeaturedImage, RuleVocabularyList, RuleAudio, RuleExercises:
+		return nil
+	default:
+		return &kernel.Error{Code: kernel.EInvalid, Message: fmt.Sprintf(MChecklistRuleInvalid, r), Operation: op}
+	}
+}
+
+// IsManual reports whether the item needs a sign-off rather than an automatic check.
+func (r Rule) IsManual() bool {
+	return r == RuleManual
+}
+
+var (
+	itemKeyRe = regexp.MustCompile(`^[a-z0-9_]+$`)
+
+	// Headings in the languages lessons are written in.
+	vocabularyHeadingRe = regexp.MustCompile(`(?mi)^#{1,6}\s*(vocabulaire|vocabulary|vocabulario|vocabulário|wortschatz)\b`)
+	exercisesHeadingRe  = regexp.MustCompile(`(?mi)^#{1,6}\s*(exercices?|exercises?|ejercicios?|exercícios?|übungen)\b`)
+	audioLinkRe         = regexp.MustCompile(`(?i)\]\([^)\s]+\.(mp3|ogg|oga|m4a|wav)\)`)
+)
+
+// Passes runs an automatic rule against the post. Manual rules never pass on their own.
+func (r Rule) Passes(p post.Post) bool {
+	content := p.Content.String()
+
+	switch r {
+	case RuleSEODescription:
+		return p.SEODescription != ""
+	case RuleFeaturedImage:
+		return p.HasFeaturedImage()
+	case RuleVocabularyList:
+		return vocabularyHeadingRe.MatchString(content)
+	case RuleAudio:
+		return audioLinkRe.MatchString(content)
+	case RuleExercises:
+		return exercisesHeadingRe.MatchString(content)
+	default:
+		return false
+	}
+}
+
+// Item is one line of a checklist.
+type Item struct {
+	Key   string // Stable identifier sign-offs refer to, e.g. "native_review"
+	Label string // Shown to editors, e.g. "Proofread by a native speaker"
+	Rule  Rule
+}
+
+// Template is the checklist of a category and, unless they define their own,
+// of its subcategories.
+type Template struct {
+	CategoryID kernel.ID[category.Category]
+	Items      []Item
+	UpdatedBy  kernel.ID[user.User]
+	UpdatedAt  time.Time
+}
+
+// Validate ensures items are present, bounded, well-keyed, and unique.
+func (t Template) Validate() error {
+	const op = "Template.Validate"
+
+	if err := t.CategoryID.Validate(); err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	switch {
+	case len(t.Items) == 0:
+		return &kernel.Error{Code: kernel.EInvalid, Message: MChecklistEmpty, Operation: op}
+	case len(t.Items) > MaxItems:
+		return &kernel.Error{Code: kernel.EInvalid, Message: fmt.Sprintf(MChecklistTooManyItems, MaxItems), Operation: op}
+	}
+
+	seen := make(map[string]bool, len(t.Items))
+	for _, item := range t.Items {
+		if !itemKeyRe.MatchString(item.Key) {
+			return &kernel.Error{Code: kernel.EInvalid, Message: fmt.Sprintf(MChecklistItemKey, item.Key), Operation: op}
+		}
+		if seen[item.Key] {
+			return &kernel.Error{Code: kernel.EInvalid, Message: fmt.Sprintf(MChecklistItemDuplicate, item.Key), Operation: op}
+		}
+		seen[item.Key] = true
+
+		if strings.TrimSpace(item.Label) == "" {
+			return &kernel.Error{Code: kernel.EInvalid, Message: fmt.Sprintf(MChecklistItemLabel, item.Key), Operation: op}
+		}
+		if err := item.Rule.Validate(); err != nil {
+			return &kernel.Error{Operation: op, Cause: err}
+		}
+	}
+
+	return nil
+}
+
+// SignOff records an editor vouching for a manual item of a post. It holds for
+// the content it was given on: editing the post reopens the item.
+type SignOff struct {
+	PostID      kernel.ID[post.Post]
+	ItemKey     string
+	By          kernel.ID[user.User]
+	At          time.Time
+	ContentHash string // post.HashContent of the content signed off
+}
+
+// IsCurrentFor reports whether the sign-off still holds for the post's content.
+func (s SignOff) IsCurrentFor(p post.Post) bool {
+	return s.ContentHash == post.HashContent(p.Content)
+}
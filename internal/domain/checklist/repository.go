@@ -0,0 +1,30 @@
+package checklist
+
+import (
+	"github.com/alnah/fla/internal/domain/category"
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/post"
+)
+
+// TemplateRepository stores one checklist template per category.
+type TemplateRepository interface {
+	// GetTemplate returns the category's own template.
+	// Returns ENotFound when the category defines none.
+	GetTemplate(categoryID kernel.ID[category.Category]) (*Template, error)
+
+	// SaveTemplate stores a template, replacing the category's previous one.
+	SaveTemplate(template Template) error
+
+	// DeleteTemplate removes the category's template, so it inherits its parent's again.
+	// Removing a missing template is not an error.
+	DeleteTemplate(categoryID kernel.ID[category.Category]) error
+}
+
+// SignOffRepository stores sign-offs of manual items.
+type SignOffRepository interface {
+	// GetSignOffs returns every sign-off of the post, current or not.
+	GetSignOffs(postID kernel.ID[post.Post]) ([]SignOff, error)
+
+	// SaveSignOff stores a sign-off, replacing a previous one for the same post and item.
+	SaveSignOff(signOff SignOff) error
+}
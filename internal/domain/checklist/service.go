@@ -0,0 +1,241 @@
+package checklist
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/alnah/fla/internal/domain/category"
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/policy"
+	"github.com/alnah/fla/internal/domain/post"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+const (
+	MChecklistIncomplete       string = "Checklist is incomplete: %s."
+	MChecklistItemUnknown      string = "Checklist has no item %q."
+	MChecklistItemAutomatic    string = "Checklist item %q is checked automatically."
+	MChecklistSignOffForbidden string = "User cannot sign off this post's checklist."
+)
+
+// Status is where an item of a post's checklist stands.
+type Status string
+
+const (
+	StatusPassed          Status = "passed"            // Automatic check succeeded
+	StatusFailed          Status = "failed"            // Automatic check failed; fix the post
+	StatusSignedOff       Status = "signed_off"        // An editor vouched for the current content
+	StatusAwaitingSignOff Status = "awaiting_sign_off" // Needs an editor's sign-off
+)
+
+// Result is one evaluated item.
+type Result struct {
+	Item    Item
+	Status  Status
+	SignOff *SignOff // Set when signed off
+}
+
+// Done reports whether the item no longer stands in the way of approval.
+func (r Result) Done() bool {
+	return r.Status == StatusPassed || r.Status == StatusSignedOff
+}
+
+// Evaluation is a post's checklist, item by item, in template order.
+// A post whose category has no template has an empty, complete evaluation.
+type Evaluation struct {
+	PostID  kernel.ID[post.Post]
+	Results []Result
+}
+
+// Complete reports whether every item is done.
+func (e Evaluation) Complete() bool {
+	return len(e.Open()) == 0
+}
+
+// Open returns the items not done yet.
+func (e Evaluation) Open() []Result {
+	var open []Result
+	for _, r := range e.Results {
+		if !r.Done() {
+			open = append(open, r)
+		}
+	}
+	return open
+}
+
+// ChecklistService manages category templates, evaluates posts against them,
+// and keeps posts with open items from being approved.
+type ChecklistService struct {
+	templates  TemplateRepository
+	signOffs   SignOffRepository
+	categories category.CategoryPathBuilder
+	clock      kernel.Clock
+}
+
+// NewChecklistService creates checklist service with template and sign-off storage,
+// and the category tree templates are inherited through.
+func NewChecklistService(templates TemplateRepository, signOffs SignOffRepository, categories category.CategoryPathBuilder, clock kernel.Clock) *ChecklistService {
+	return &ChecklistService{templates: templates, signOffs: signOffs, categories: categories, clock: clock}
+}
+
+// SaveTemplate sets the checklist of a category on behalf of actor.
+func (s *ChecklistService) SaveTemplate(template Template, actor user.User) (Template, error) {
+	const op = "ChecklistService.SaveTemplate"
+
+	if !actor.CanManageCategories() {
+		return Template{}, &kernel.Error{Code: kernel.EForbidden, Message: category.MCategoryManageForbidden, Operation: op}
+	}
+
+	template.UpdatedBy = actor.ID
+	template.UpdatedAt = s.clock.Now()
+	if err := template.Validate(); err != nil {
+		return Template{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if err := s.templates.SaveTemplate(template); err != nil {
+		return Template{}, &kernel.Error{Operation: op, Cause: err}
+	}
+	return template, nil
+}
+
+// TemplateFor returns the template that applies to a category: its own, else the
+// nearest ancestor's. Reports false when none applies.
+func (s *ChecklistService) TemplateFor(categoryID kernel.ID[category.Category]) (Template, bool, error) {
+	const op = "ChecklistService.TemplateFor"
+
+	path, err := s.categories.BuildPath(categoryID)
+	if err != nil {
+		return Template{}, false, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	for i := len(path) - 1; i >= 0; i-- {
+		template, err := s.templates.GetTemplate(path[i].CategoryID)
+		if kernel.ErrorCode(err) == kernel.ENotFound {
+			continue
+		} else if err != nil {
+			return Template{}, false, &kernel.Error{Operation: op, Cause: err}
+		}
+		return *template, true, nil
+	}
+
+	return Template{}, false, nil
+}
+
+// CheckPost evaluates the post against its category's checklist: automatic items
+// are checked on the spot, manual ones are done once signed off for the current content.
+func (s *ChecklistService) CheckPost(p post.Post) (Evaluation, error) {
+	const op = "ChecklistService.CheckPost"
+
+	template, found, err := s.TemplateFor(p.Category.CategoryID)
+	if err != nil {
+		return Evaluation{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	evaluation := Evaluation{PostID: p.PostID}
+	if !found {
+		return evaluation, nil
+	}
+
+	signOffs, err := s.signOffs.GetSignOffs(p.PostID)
+	if err != nil {
+		return Evaluation{}, &kernel.Error{Operation: op, Cause: err}
+	}
+	current := make(map[string]SignOff, len(signOffs))
+	for _, so := range signOffs {
+		if so.IsCurrentFor(p) {
+			current[so.ItemKey] = so
+		}
+	}
+
+	for _, item := range template.Items {
+		result := Result{Item: item}
+		switch {
+		case !item.Rule.IsManual() && item.Rule.Passes(p):
+			result.Status = StatusPassed
+		case !item.Rule.IsManual():
+			result.Status = StatusFailed
+		default:
+			if so, ok := current[item.Key]; ok {
+				result.Status, result.SignOff = StatusSignedOff, &so
+			} else {
+				result.Status = StatusAwaitingSignOff
+			}
+		}
+		evaluation.Results = append(evaluation.Results, result)
+	}
+
+	return evaluation, nil
+}
+
+// SignOff vouches for a manual item of the post, on behalf of an editor who may review it.
+func (s *ChecklistService) SignOff(p post.Post, itemKey string, actor user.PostPermissionChecker) (SignOff, error) {
+	const op = "ChecklistService.SignOff"
+
+	if !user.Authorize(actor, policy.PostReview, user.PostResource(p)).Allowed {
+		return SignOff{}, &kernel.Error{Code: kernel.EForbidden, Message: MChecklistSignOffForbidden, Operation: op}
+	}
+
+	template, _, err := s.TemplateFor(p.Category.CategoryID)
+	if err != nil {
+		return SignOff{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	item, ok := template.item(itemKey)
+	if !ok {
+		return SignOff{}, &kernel.Error{Code: kernel.ENotFound, Message: fmt.Sprintf(MChecklistItemUnknown, itemKey), Operation: op}
+	}
+	if !item.Rule.IsManual() {
+		return SignOff{}, &kernel.Error{Code: kernel.EInvalid, Message: fmt.Sprintf(MChecklistItemAutomatic, itemKey), Operation: op}
+	}
+
+	signOff := SignOff{
+		PostID:      p.PostID,
+		ItemKey:     itemKey,
+		By:          actor.GetID(),
+		At:          s.clock.Now(),
+		ContentHash: post.HashContent(p.Content),
+	}
+	if err := s.signOffs.SaveSignOff(signOff); err != nil {
+		return SignOff{}, &kernel.Error{Operation: op, Cause: err}
+	}
+	return signOff, nil
+}
+
+// Approve approves the post once its checklist is complete; open items are listed
+// in the error so editors know what is left.
+func (s *ChecklistService) Approve(p post.Post, approver user.PostPermissionChecker) (post.Post, error) {
+	const op = "ChecklistService.Approve"
+
+	evaluation, err := s.CheckPost(p)
+	if err != nil {
+		return p, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if open := evaluation.Open(); len(open) > 0 {
+		labels := make([]string, len(open))
+		for i, r := range open {
+			labels[i] = r.Item.Label
+		}
+		return p, &kernel.Error{
+			Code:      kernel.EInvalid,
+			Message:   fmt.Sprintf(MChecklistIncomplete, strings.Join(labels, ", ")),
+			Operation: op,
+		}
+	}
+
+	p.Clock = s.clock
+	approved, err := p.Approve(approver)
+	if err != nil {
+		return p, &kernel.Error{Operation: op, Cause: err}
+	}
+	return approved, nil
+}
+
+func (t Template) item(key string) (Item, bool) {
+	for _, item := range t.Items {
+		if item.Key == key {
+			return item, true
+		}
+	}
+	return Item{}, false
+}
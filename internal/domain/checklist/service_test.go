@@ -0,0 +1,264 @@
+package checklist_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/category"
+	"github.com/alnah/fla/internal/domain/checklist"
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/policy"
+	"github.com/alnah/fla/internal/domain/post"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+type stubTemplates struct {
+	templates map[kernel.ID[category.Category]]checklist.Template
+}
+
+func (s *stubTemplates) GetTemplate(categoryID kernel.ID[category.Category]) (*checklist.Template, error) {
+	t, ok := s.templates[categoryID]
+	if !ok {
+		return nil, &kernel.Error{Code: kernel.ENotFound, Message: "no template"}
+	}
+	return &t, nil
+}
+
+func (s *stubTemplates) SaveTemplate(t checklist.Template) error {
+	s.templates[t.CategoryID] = t
+	return nil
+}
+
+func (s *stubTemplates) DeleteTemplate(categoryID kernel.ID[category.Category]) error {
+	delete(s.templates, categoryID)
+	return nil
+}
+
+type stubSignOffs struct {
+	signOffs map[kernel.ID[post.Post]][]checklist.SignOff
+}
+
+func (s *stubSignOffs) GetSignOffs(postID kernel.ID[post.Post]) ([]checklist.SignOff, error) {
+	return s.signOffs[postID], nil
+}
+
+func (s *stubSignOffs) SaveSignOff(so checklist.SignOff) error {
+	s.signOffs[so.PostID] = append(s.signOffs[so.PostID], so)
+	return nil
+}
+
+// stubPaths knows the path of every category, from the root down.
+type stubPaths map[kernel.ID[category.Category]]category.CategoryPath
+
+func (s stubPaths) BuildPath(categoryID kernel.ID[category.Category]) (category.CategoryPath, error) {
+	path, ok := s[categoryID]
+	if !ok {
+		return nil, &kernel.Error{Code: kernel.ENotFound, Message: "no category"}
+	}
+	return path, nil
+}
+
+func (s stubPaths) FindByPath(pathSegments []string) (*category.Category, error) {
+	return nil, &kernel.Error{Code: kernel.ENotFound, Message: "not used"}
+}
+
+var (
+	a1      = category.Category{CategoryID: "a1", Name: "A1"}
+	reading = category.Category{CategoryID: "reading", Name: "Reading"}
+
+	admin  = user.User{ID: "admin", Roles: []user.Role{user.RoleAdmin}, Status: user.AccountStatusActive}
+	editor = user.User{ID: "editor", Roles: []user.Role{user.RoleEditor}, Status: user.AccountStatusActive, Scopes: []string{policy.AllScopes}}
+	author = user.User{ID: "author", Roles: []user.Role{user.RoleAuthor}, Status: user.AccountStatusActive}
+
+	lessonTemplate = checklist.Template{
+		CategoryID: "a1",
+		Items: []checklist.Item{
+			{Key: "vocabulary", Label: "Vocabulary list", Rule: checklist.RuleVocabularyList},
+			{Key: "seo", Label: "SEO description", Rule: checklist.RuleSEODescription},
+			{Key: "native_review", Label: "Proofread by a native speaker", Rule: checklist.RuleManual},
+		},
+	}
+)
+
+func newService(t *testing.T) (*checklist.ChecklistService, *stubTemplates) {
+	t.Helper()
+
+	templates := &stubTemplates{templates: map[kernel.ID[category.Category]]checklist.Template{"a1": lessonTemplate}}
+	signOffs := &stubSignOffs{signOffs: make(map[kernel.ID[post.Post]][]checklist.SignOff)}
+	paths := stubPaths{
+		"a1":      {a1},
+		"reading": {a1, reading},
+		"b1":      {{CategoryID: "b1", Name: "B1"}},
+	}
+	clock := &stubClock{t: time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)}
+
+	return checklist.NewChecklistService(templates, signOffs, paths, clock), templates
+}
+
+func lesson(categoryID kernel.ID[category.Category], content string) post.Post {
+	return post.Post{
+		PostID:   "lesson",
+		Owner:    author.ID,
+		Status:   post.StatusDraft,
+		Category: category.Category{CategoryID: categoryID},
+		Content:  post.PostContent(content),
+		Clock:    &stubClock{},
+	}
+}
+
+const lessonContent = "## Vocabulaire\n\n- le marché\n- les légumes\n\nAu marché, j'achète des légumes."
+
+func TestChecklistService_CheckPost(t *testing.T) {
+	t.Run("checks automatic items and awaits manual ones", func(t *testing.T) {
+		service, _ := newService(t)
+
+		got, err := service.CheckPost(lesson("a1", lessonContent))
+
+		assertNoError(t, err)
+		want := []checklist.Status{checklist.StatusPassed, checklist.StatusFailed, checklist.StatusAwaitingSignOff}
+		for i, r := range got.Results {
+			if r.Status != want[i] {
+				t.Errorf("item %s: got %s, want %s", r.Item.Key, r.Status, want[i])
+			}
+		}
+		if got.Complete() || len(got.Open()) != 2 {
+			t.Errorf("got %d open items, want 2", len(got.Open()))
+		}
+	})
+
+	t.Run("subcategories inherit the nearest template", func(t *testing.T) {
+		service, _ := newService(t)
+
+		got, err := service.CheckPost(lesson("reading", lessonContent))
+
+		assertNoError(t, err)
+		if len(got.Results) != len(lessonTemplate.Items) {
+			t.Errorf("got %d items, want the A1 template", len(got.Results))
+		}
+	})
+
+	t.Run("a post without template is complete", func(t *testing.T) {
+		service, _ := newService(t)
+
+		got, err := service.CheckPost(lesson("b1", lessonContent))
+
+		assertNoError(t, err)
+		if !got.Complete() || len(got.Results) != 0 {
+			t.Errorf("got %+v, want an empty complete evaluation", got)
+		}
+	})
+
+	t.Run("editing the content reopens signed-off items", func(t *testing.T) {
+		service, _ := newService(t)
+		p := lesson("a1", lessonContent)
+		p.SEODescription = "Vocabulaire du marché pour débutants."
+
+		_, err := service.SignOff(p, "native_review", editor)
+		assertNoError(t, err)
+		got, err := service.CheckPost(p)
+		assertNoError(t, err)
+		if !got.Complete() {
+			t.Fatalf("got open items %+v after sign-off", got.Open())
+		}
+
+		p.Content += "\n\nLe samedi, le marché est plein."
+		got, err = service.CheckPost(p)
+		assertNoError(t, err)
+		if open := got.Open(); len(open) != 1 || open[0].Item.Key != "native_review" {
+			t.Errorf("got open items %+v, want native_review", open)
+		}
+	})
+}
+
+func TestChecklistService_SignOff(t *testing.T) {
+	tests := []struct {
+		name  string
+		key   string
+		actor user.User
+		want  string
+	}{
+		{name: "authors cannot sign off", key: "native_review", actor: author, want: kernel.EForbidden},
+		{name: "automatic items cannot be signed off", key: "seo", actor: editor, want: kernel.EInvalid},
+		{name: "unknown items", key: "pictures", actor: editor, want: kernel.ENotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, _ := newService(t)
+
+			_, err := service.SignOff(lesson("a1", lessonContent), tt.key, tt.actor)
+
+			assertErrorCode(t, err, tt.want)
+		})
+	}
+}
+
+func TestChecklistService_Approve(t *testing.T) {
+	t.Run("refuses posts with open items and lists them", func(t *testing.T) {
+		service, _ := newService(t)
+
+		_, err := service.Approve(lesson("a1", lessonContent), editor)
+
+		assertErrorCode(t, err, kernel.EInvalid)
+		msg := kernel.ErrorMessage(err)
+		if !strings.Contains(msg, "SEO description") || !strings.Contains(msg, "native speaker") {
+			t.Errorf("message %q does not list the open items", msg)
+		}
+	})
+
+	t.Run("approves once every item is done", func(t *testing.T) {
+		service, _ := newService(t)
+		p := lesson("a1", lessonContent)
+		p.SEODescription = "Vocabulaire du marché pour débutants."
+		_, err := service.SignOff(p, "native_review", editor)
+		assertNoError(t, err)
+
+		got, err := service.Approve(p, editor)
+
+		assertNoError(t, err)
+		if got.ApprovedBy == nil || *got.ApprovedBy != editor.ID {
+			t.Errorf("got approved by %v, want %s", got.ApprovedBy, editor.ID)
+		}
+	})
+}
+
+func TestChecklistService_SaveTemplate(t *testing.T) {
+	t.Run("admins set a category's template", func(t *testing.T) {
+		service, templates := newService(t)
+		template := checklist.Template{
+			CategoryID: "b1",
+			Items:      []checklist.Item{{Key: "audio", Label: "Audio recording", Rule: checklist.RuleAudio}},
+		}
+
+		got, err := service.SaveTemplate(template, admin)
+
+		assertNoError(t, err)
+		if got.UpdatedBy != admin.ID || templates.templates["b1"].UpdatedAt.IsZero() {
+			t.Errorf("got %+v, want it stamped and stored", got)
+		}
+	})
+
+	t.Run("rejects invalid templates", func(t *testing.T) {
+		service, _ := newService(t)
+		template := checklist.Template{
+			CategoryID: "b1",
+			Items: []checklist.Item{
+				{Key: "audio", Label: "Audio", Rule: checklist.RuleAudio},
+				{Key: "audio", Label: "Audio again", Rule: checklist.RuleAudio},
+			},
+		}
+
+		_, err := service.SaveTemplate(template, admin)
+
+		assertErrorCode(t, err, kernel.EInvalid)
+	})
+
+	t.Run("authors cannot set templates", func(t *testing.T) {
+		service, _ := newService(t)
+
+		_, err := service.SaveTemplate(lessonTemplate, author)
+
+		assertErrorCode(t, err, kernel.EForbidden)
+	})
+}
@@ -0,0 +1,179 @@
+package credential
+
+import (
+	"time"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+const (
+	MaxFailedAttempts int           = 5                // Failures allowed before the account locks
+	LockoutDuration   time.Duration = 15 * time.Minute // How long a lock lasts
+)
+
+const (
+	MResetNotRequested string = "No password reset was requested."
+	MResetExpired      string = "Password reset link has expired."
+	MResetTokenInvalid string = "Password reset link is invalid."
+)
+
+// Credentials hold the secret a user logs in with and the state that throttles guessing.
+// Kept apart from User so profile reads never load password material.
+type Credentials struct {
+	// Identity
+	UserID kernel.ID[user.User]
+
+	// Secret
+	Hash              PasswordHash
+	PasswordChangedAt time.Time
+
+	// Throttling
+	FailedAttempts int
+	LockedUntil    *time.Time // Set once FailedAttempts reaches MaxFailedAttempts
+
+	// Recovery
+	Reset *PendingReset // Set while a reset link is outstanding
+
+	// Meta
+	CreatedAt time.Time
+	UpdatedAt time.Time
+
+	// DI
+	Clock kernel.Clock
+}
+
+// NewCredentials creates credentials for a user from an already computed hash.
+func NewCredentials(userID kernel.ID[user.User], hash PasswordHash, clock kernel.Clock) (Credentials, error) {
+	const op = "NewCredentials"
+
+	now := clock.Now()
+
+	c := Credentials{
+		UserID:            userID,
+		Hash:              hash,
+		PasswordChangedAt: now,
+		CreatedAt:         now,
+		UpdatedAt:         now,
+		Clock:             clock,
+	}
+
+	if err := c.Validate(); err != nil {
+		return Credentials{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return c, nil
+}
+
+// Validate performs validation on the credentials.
+func (c Credentials) Validate() error {
+	const op = "Credentials.Validate"
+
+	if err := c.UserID.Validate(); err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if err := c.Hash.Validate(); err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return nil
+}
+
+// IsLocked returns true while a lockout is in effect.
+func (c Credentials) IsLocked() bool {
+	return c.LockedUntil != nil && c.Clock.Now().Before(*c.LockedUntil)
+}
+
+// RecordFailure counts a wrong password and locks the account once the limit is reached.
+// A failure after an expired lock starts a new count.
+func (c Credentials) RecordFailure() Credentials {
+	now := c.Clock.Now()
+
+	updated := c
+	if c.LockedUntil != nil && !c.IsLocked() {
+		updated.FailedAttempts = 0
+		updated.LockedUntil = nil
+	}
+
+	updated.FailedAttempts++
+	if updated.FailedAttempts >= MaxFailedAttempts {
+		until := now.Add(LockoutDuration)
+		updated.LockedUntil = &until
+	}
+	updated.UpdatedAt = now
+
+	return updated
+}
+
+// RecordSuccess clears the failure count after a correct password.
+func (c Credentials) RecordSuccess() Credentials {
+	updated := c
+	updated.FailedAttempts = 0
+	updated.LockedUntil = nil
+	updated.UpdatedAt = c.Clock.Now()
+
+	return updated
+}
+
+// ChangePassword replaces the hash, unlocks the account, and cancels any pending reset.
+func (c Credentials) ChangePassword(hash PasswordHash) (Credentials, error) {
+	const op = "Credentials.ChangePassword"
+
+	if err := hash.Validate(); err != nil {
+		return c, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	now := c.Clock.Now()
+
+	updated := c
+	updated.Hash = hash
+	updated.PasswordChangedAt = now
+	updated.FailedAttempts = 0
+	updated.LockedUntil = nil
+	updated.Reset = nil
+	updated.UpdatedAt = now
+
+	return updated, nil
+}
+
+// RequestReset stores the digest of a new reset token; an earlier link stops working.
+func (c Credentials) RequestReset(token ResetToken) (Credentials, error) {
+	const op = "Credentials.RequestReset"
+
+	if err := token.Validate(); err != nil {
+		return c, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	now := c.Clock.Now()
+
+	updated := c
+	updated.Reset = &PendingReset{Digest: token.Digest(), ExpiresAt: now.Add(ResetTokenTTL)}
+	updated.UpdatedAt = now
+
+	return updated, nil
+}
+
+// CompleteReset sets a new password hash if the token matches an unexpired reset.
+func (c Credentials) CompleteReset(token ResetToken, hash PasswordHash) (Credentials, error) {
+	const op = "Credentials.CompleteReset"
+
+	if c.Reset == nil {
+		return c, &kernel.Error{Code: kernel.EConflict, Message: MResetNotRequested, Operation: op}
+	}
+
+	if !c.Reset.Matches(token) {
+		return c, &kernel.Error{Code: kernel.EForbidden, Message: MResetTokenInvalid, Operation: op}
+	}
+
+	if !c.Clock.Now().Before(c.Reset.ExpiresAt) {
+		return c, &kernel.Error{Code: kernel.EConflict, Message: MResetExpired, Operation: op}
+	}
+
+	updated, err := c.ChangePassword(hash)
+	if err != nil {
+		return c, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return updated, nil
+}
@@ -0,0 +1,34 @@
+package credential
+
+import (
+	"github.com/alnah/fla/internal/domain/kernel"
+)
+
+const MPasswordHashMissing string = "Missing password hash."
+
+// PasswordHash is the encoded output of a Hasher, including algorithm and salt.
+type PasswordHash string
+
+func (h PasswordHash) String() string { return string(h) }
+
+// Validate ensures a hash is present.
+func (h PasswordHash) Validate() error {
+	const op = "PasswordHash.Validate"
+
+	if h == "" {
+		return &kernel.Error{Code: kernel.EInvalid, Message: MPasswordHashMissing, Operation: op}
+	}
+
+	return nil
+}
+
+// Hasher turns passwords into slow, salted hashes.
+// Implemented by adapters (bcrypt, argon2id) so the domain stays algorithm-agnostic.
+type Hasher interface {
+	// Hash derives a new hash with a fresh salt.
+	Hash(password Password) (PasswordHash, error)
+
+	// Verify reports whether the password produced the hash.
+	// Returns false without error on mismatch; errors are reserved for malformed hashes.
+	Verify(hash PasswordHash, password Password) (bool, error)
+}
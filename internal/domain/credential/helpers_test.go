@@ -0,0 +1,98 @@
+package credential_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/credential"
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+type stubClock struct {
+	t time.Time
+}
+
+func (s *stubClock) Now() time.Time { return s.t }
+
+// stubHasher prefixes passwords instead of hashing them.
+type stubHasher struct {
+	err error
+}
+
+func (h stubHasher) Hash(p credential.Password) (credential.PasswordHash, error) {
+	if h.err != nil {
+		return "", h.err
+	}
+	return credential.PasswordHash("hashed:" + p.Reveal()), nil
+}
+
+func (h stubHasher) Verify(hash credential.PasswordHash, p credential.Password) (bool, error) {
+	if h.err != nil {
+		return false, h.err
+	}
+	return strings.TrimPrefix(hash.String(), "hashed:") == p.Reveal(), nil
+}
+
+type stubRepository struct {
+	credentials map[kernel.ID[user.User]]credential.Credentials
+	updates     int
+}
+
+func newStubRepository(existing ...credential.Credentials) *stubRepository {
+	r := &stubRepository{credentials: make(map[kernel.ID[user.User]]credential.Credentials)}
+	for _, c := range existing {
+		r.credentials[c.UserID] = c
+	}
+	return r
+}
+
+func (r *stubRepository) GetByUserID(userID kernel.ID[user.User]) (*credential.Credentials, error) {
+	c, ok := r.credentials[userID]
+	if !ok {
+		return nil, &kernel.Error{Code: kernel.ENotFound, Message: "not found"}
+	}
+	return &c, nil
+}
+
+func (r *stubRepository) Create(c credential.Credentials) error {
+	r.credentials[c.UserID] = c
+	return nil
+}
+
+func (r *stubRepository) Update(c credential.Credentials) error {
+	r.credentials[c.UserID] = c
+	r.updates++
+	return nil
+}
+
+func assertNoError(t *testing.T, err error) {
+	t.Helper()
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+}
+
+func assertError(t *testing.T, err error) {
+	t.Helper()
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+}
+
+func assertErrorCode(t *testing.T, err error, want string) {
+	t.Helper()
+	got := kernel.ErrorCode(err)
+	if got != want {
+		t.Errorf("error code: got %q, want %q", got, want)
+	}
+}
+
+func assertErrorMessage(t *testing.T, err error, want string) {
+	t.Helper()
+	got := kernel.ErrorMessage(err)
+	if got != want {
+		t.Errorf("error message: got %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,108 @@
+// Package credential models how people prove who they are: passwords, lockouts, and resets.
+package credential
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+)
+
+const (
+	MinPasswordLength int = 12
+	MaxPasswordLength int = 128 // Bounds hashing cost; long passphrases still fit
+)
+
+const (
+	MPasswordTooShort     string = "Password must be at least 12 characters."
+	MPasswordTooLong      string = "Password must be at most 128 characters."
+	MPasswordTooSimple    string = "Password must mix letters with digits, symbols, or spaces."
+	MPasswordRepetitive   string = "Password must not repeat a single character."
+	MPasswordTooCommon    string = "Password is too common."
+	MPasswordContainsName string = "Password must not contain your username or email."
+)
+
+// commonPasswords lists well-known passwords that pass the length and mix rules.
+var commonPasswords = []string{
+	"password1234", "password123!", "qwerty123456", "123456789abc",
+	"azerty123456", "iloveyou1234", "welcome12345", "motdepasse123",
+}
+
+// Password is a plaintext secret chosen by a user; it is never stored, only hashed.
+// String redacts the value so it cannot leak through logs.
+type Password string
+
+// NewPassword creates a validated password.
+// Identity holds values the password must not contain, such as username and email.
+func NewPassword(raw string, identity ...string) (Password, error) {
+	const op = "NewPassword"
+
+	p := Password(raw)
+	if err := p.Validate(); err != nil {
+		return "", &kernel.Error{Operation: op, Cause: err}
+	}
+
+	lower := strings.ToLower(raw)
+	for _, value := range identity {
+		value = strings.ToLower(strings.TrimSpace(value))
+		if local, _, ok := strings.Cut(value, "@"); ok {
+			value = local
+		}
+		if len(value) >= 3 && strings.Contains(lower, value) {
+			return "", &kernel.Error{Code: kernel.EInvalid, Message: MPasswordContainsName, Operation: op}
+		}
+	}
+
+	return p, nil
+}
+
+// String redacts the password.
+func (p Password) String() string { return "[REDACTED]" }
+
+// GoString redacts the password in %#v output.
+func (p Password) GoString() string { return p.String() }
+
+// Reveal returns the plaintext for hashing; only Hasher implementations should call it.
+func (p Password) Reveal() string { return string(p) }
+
+// Validate checks length and strength rules.
+func (p Password) Validate() error {
+	const op = "Password.Validate"
+
+	raw := string(p)
+	n := utf8.RuneCountInString(raw)
+
+	if n < MinPasswordLength {
+		return &kernel.Error{Code: kernel.EInvalid, Message: MPasswordTooShort, Operation: op}
+	}
+
+	if n > MaxPasswordLength {
+		return &kernel.Error{Code: kernel.EInvalid, Message: MPasswordTooLong, Operation: op}
+	}
+
+	first, _ := utf8.DecodeRuneInString(raw)
+	if strings.Count(raw, string(first)) == n {
+		return &kernel.Error{Code: kernel.EInvalid, Message: MPasswordRepetitive, Operation: op}
+	}
+
+	hasLetter, hasOther := false, false
+	for _, r := range raw {
+		if unicode.IsLetter(r) {
+			hasLetter = true
+		} else {
+			hasOther = true
+		}
+	}
+	if !hasLetter || !hasOther {
+		return &kernel.Error{Code: kernel.EInvalid, Message: MPasswordTooSimple, Operation: op}
+	}
+
+	for _, common := range commonPasswords {
+		if strings.EqualFold(raw, common) {
+			return &kernel.Error{Code: kernel.EInvalid, Message: MPasswordTooCommon, Operation: op}
+		}
+	}
+
+	return nil
+}
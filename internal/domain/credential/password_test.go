@@ -0,0 +1,56 @@
+package credential_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/alnah/fla/internal/domain/credential"
+	"github.com/alnah/fla/internal/domain/kernel"
+)
+
+func TestNewPassword(t *testing.T) {
+	tests := []struct {
+		name     string
+		raw      string
+		identity []string
+		message  string
+	}{
+		{"accepts passphrase", "correct horse battery", nil, ""},
+		{"accepts letters and digits", "Lecture2024Menu", nil, ""},
+		{"rejects short", "abc123!", nil, credential.MPasswordTooShort},
+		{"rejects long", strings.Repeat("a1", 65), nil, credential.MPasswordTooLong},
+		{"rejects letters only", "onlylettershere", nil, credential.MPasswordTooSimple},
+		{"rejects digits only", "123456789012", nil, credential.MPasswordTooSimple},
+		{"rejects repetition", "aaaaaaaaaaaaaa", nil, credential.MPasswordRepetitive},
+		{"rejects common", "Password1234", nil, credential.MPasswordTooCommon},
+		{"rejects username", "marie-secret-42", []string{"marie"}, credential.MPasswordContainsName},
+		{"rejects email local part", "xx-jdupont-2024", []string{"jdupont@example.com"}, credential.MPasswordContainsName},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := credential.NewPassword(tt.raw, tt.identity...)
+
+			if tt.message == "" {
+				assertNoError(t, err)
+				return
+			}
+			assertErrorCode(t, err, kernel.EInvalid)
+			assertErrorMessage(t, err, tt.message)
+		})
+	}
+}
+
+func TestPassword_Redacts(t *testing.T) {
+	p, _ := credential.NewPassword("correct horse battery")
+
+	for _, format := range []string{"%s", "%v", "%#v"} {
+		if got := fmt.Sprintf(format, p); strings.Contains(got, "horse") {
+			t.Errorf("%s leaked password: %q", format, got)
+		}
+	}
+	if p.Reveal() != "correct horse battery" {
+		t.Error("Reveal should return the plaintext")
+	}
+}
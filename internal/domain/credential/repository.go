@@ -0,0 +1,29 @@
+package credential
+
+import (
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+// CredentialsReader defines read operations for login and recovery.
+type CredentialsReader interface {
+	// GetByUserID retrieves the credentials of a user.
+	// Returns ENotFound when the user never set a password (machine accounts, pending invites).
+	GetByUserID(userID kernel.ID[user.User]) (*Credentials, error)
+}
+
+// CredentialsWriter defines credentials persistence operations.
+type CredentialsWriter interface {
+	// Create stores credentials for a user that has none.
+	Create(credentials Credentials) error
+
+	// Update saves hash, throttling, and reset changes.
+	Update(credentials Credentials) error
+}
+
+// Full repository interface for implementations that provide everything.
+// Most concrete implementations (like PostgresCredentialsRepository) will implement this.
+type Repository interface {
+	CredentialsReader
+	CredentialsWriter
+}
@@ -0,0 +1,69 @@
+package credential
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+)
+
+// ResetTokenBytes is the entropy of a reset token before encoding.
+const ResetTokenBytes = 32
+
+// ResetTokenTTL is how long a reset link stays valid.
+const ResetTokenTTL = time.Hour
+
+const (
+	MResetTokenMissing      string = "Missing password reset token."
+	MResetTokenGenerateFail string = "Password reset token could not be generated."
+)
+
+// ResetToken is the secret emailed to a user who forgot their password.
+// Only its digest is stored, so a database leak cannot be used to take over accounts.
+type ResetToken string
+
+// NewResetToken generates a random URL-safe token.
+func NewResetToken() (ResetToken, error) {
+	const op = "NewResetToken"
+
+	b := make([]byte, ResetTokenBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", &kernel.Error{Code: kernel.EInternal, Message: MResetTokenGenerateFail, Operation: op, Cause: err}
+	}
+
+	return ResetToken(base64.RawURLEncoding.EncodeToString(b)), nil
+}
+
+func (t ResetToken) String() string { return string(t) }
+
+// Validate ensures a token is present.
+func (t ResetToken) Validate() error {
+	const op = "ResetToken.Validate"
+
+	if t == "" {
+		return &kernel.Error{Code: kernel.EInvalid, Message: MResetTokenMissing, Operation: op}
+	}
+
+	return nil
+}
+
+// Digest returns the SHA-256 of the token, hex encoded, for storage.
+func (t ResetToken) Digest() string {
+	sum := sha256.Sum256([]byte(t))
+	return hex.EncodeToString(sum[:])
+}
+
+// PendingReset is the stored side of a reset request.
+type PendingReset struct {
+	Digest    string
+	ExpiresAt time.Time
+}
+
+// Matches compares the token digest in constant time.
+func (r PendingReset) Matches(token ResetToken) bool {
+	return token != "" && subtle.ConstantTimeCompare([]byte(r.Digest), []byte(token.Digest())) == 1
+}
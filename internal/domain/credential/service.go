@@ -0,0 +1,167 @@
+package credential
+
+import (
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+const (
+	MLoginInvalid string = "Invalid credentials."
+	MLoginLocked  string = "Too many failed attempts. Try again later."
+)
+
+// AuthService verifies logins and manages password changes and resets.
+type AuthService struct {
+	repository Repository
+	hasher     Hasher
+	clock      kernel.Clock
+}
+
+// NewAuthService creates auth service with credentials persistence and password hashing.
+func NewAuthService(repository Repository, hasher Hasher, clock kernel.Clock) *AuthService {
+	return &AuthService{
+		repository: repository,
+		hasher:     hasher,
+		clock:      clock,
+	}
+}
+
+// SetPassword hashes the password and stores it, creating credentials on first use.
+func (s *AuthService) SetPassword(userID kernel.ID[user.User], password Password) error {
+	const op = "AuthService.SetPassword"
+
+	if err := password.Validate(); err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	hash, err := s.hasher.Hash(password)
+	if err != nil {
+		return &kernel.Error{Code: kernel.EInternal, Message: kernel.MInternal, Operation: op, Cause: err}
+	}
+
+	existing, err := s.repository.GetByUserID(userID)
+	if kernel.ErrorCode(err) == kernel.ENotFound {
+		created, err := NewCredentials(userID, hash, s.clock)
+		if err != nil {
+			return &kernel.Error{Operation: op, Cause: err}
+		}
+		if err := s.repository.Create(created); err != nil {
+			return &kernel.Error{Operation: op, Cause: err}
+		}
+		return nil
+	}
+	if err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	updated, err := existing.ChangePassword(hash)
+	if err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if err := s.repository.Update(updated); err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return nil
+}
+
+// VerifyLogin checks a password against the stored hash.
+// Unknown users and wrong passwords fail alike so accounts cannot be enumerated.
+// Every failure counts toward a lockout; while locked, even the right password is refused.
+func (s *AuthService) VerifyLogin(userID kernel.ID[user.User], password Password) error {
+	const op = "AuthService.VerifyLogin"
+
+	creds, err := s.repository.GetByUserID(userID)
+	if kernel.ErrorCode(err) == kernel.ENotFound {
+		return &kernel.Error{Code: kernel.EForbidden, Message: MLoginInvalid, Operation: op}
+	}
+	if err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if creds.IsLocked() {
+		return &kernel.Error{Code: kernel.EForbidden, Message: MLoginLocked, Operation: op}
+	}
+
+	ok, err := s.hasher.Verify(creds.Hash, password)
+	if err != nil {
+		return &kernel.Error{Code: kernel.EInternal, Message: kernel.MInternal, Operation: op, Cause: err}
+	}
+
+	if !ok {
+		failed := creds.RecordFailure()
+		if err := s.repository.Update(failed); err != nil {
+			return &kernel.Error{Operation: op, Cause: err}
+		}
+		if failed.IsLocked() {
+			return &kernel.Error{Code: kernel.EForbidden, Message: MLoginLocked, Operation: op}
+		}
+		return &kernel.Error{Code: kernel.EForbidden, Message: MLoginInvalid, Operation: op}
+	}
+
+	if creds.FailedAttempts > 0 || creds.LockedUntil != nil {
+		if err := s.repository.Update(creds.RecordSuccess()); err != nil {
+			return &kernel.Error{Operation: op, Cause: err}
+		}
+	}
+
+	return nil
+}
+
+// RequestPasswordReset issues a reset token to email to the user.
+// The returned token is the only copy; credentials keep its digest.
+func (s *AuthService) RequestPasswordReset(userID kernel.ID[user.User]) (ResetToken, error) {
+	const op = "AuthService.RequestPasswordReset"
+
+	creds, err := s.repository.GetByUserID(userID)
+	if err != nil {
+		return "", &kernel.Error{Operation: op, Cause: err}
+	}
+
+	token, err := NewResetToken()
+	if err != nil {
+		return "", &kernel.Error{Operation: op, Cause: err}
+	}
+
+	updated, err := creds.RequestReset(token)
+	if err != nil {
+		return "", &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if err := s.repository.Update(updated); err != nil {
+		return "", &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return token, nil
+}
+
+// ResetPassword sets a new password when the reset token is valid.
+func (s *AuthService) ResetPassword(userID kernel.ID[user.User], token ResetToken, password Password) error {
+	const op = "AuthService.ResetPassword"
+
+	if err := password.Validate(); err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	creds, err := s.repository.GetByUserID(userID)
+	if err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	hash, err := s.hasher.Hash(password)
+	if err != nil {
+		return &kernel.Error{Code: kernel.EInternal, Message: kernel.MInternal, Operation: op, Cause: err}
+	}
+
+	updated, err := creds.CompleteReset(token, hash)
+	if err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if err := s.repository.Update(updated); err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return nil
+}
@@ -0,0 +1,196 @@
+package credential_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/credential"
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+const userID kernel.ID[user.User] = "user-1"
+
+func setup(t *testing.T) (*credential.AuthService, *stubRepository, *stubClock) {
+	t.Helper()
+	clock := &stubClock{t: time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)}
+	repo := newStubRepository()
+	service := credential.NewAuthService(repo, stubHasher{}, clock)
+
+	password, _ := credential.NewPassword("correct horse battery")
+	assertNoError(t, service.SetPassword(userID, password))
+
+	return service, repo, clock
+}
+
+func TestAuthService_SetPassword(t *testing.T) {
+	service, repo, clock := setup(t)
+
+	t.Run("creates credentials on first use", func(t *testing.T) {
+		c := repo.credentials[userID]
+		if c.Hash != "hashed:correct horse battery" || !c.PasswordChangedAt.Equal(clock.t) {
+			t.Errorf("got %+v", c)
+		}
+	})
+
+	t.Run("replaces hash on change", func(t *testing.T) {
+		password, _ := credential.NewPassword("new battery staple")
+
+		assertNoError(t, service.SetPassword(userID, password))
+
+		if repo.credentials[userID].Hash != "hashed:new battery staple" {
+			t.Errorf("got hash %q", repo.credentials[userID].Hash)
+		}
+	})
+
+	t.Run("rejects weak password", func(t *testing.T) {
+		err := service.SetPassword(userID, credential.Password("short"))
+
+		assertErrorCode(t, err, kernel.EInvalid)
+	})
+
+	t.Run("hides hasher failures", func(t *testing.T) {
+		failing := credential.NewAuthService(repo, stubHasher{err: errors.New("boom")}, clock)
+		password, _ := credential.NewPassword("correct horse battery")
+
+		err := failing.SetPassword(userID, password)
+
+		assertErrorCode(t, err, kernel.EInternal)
+	})
+}
+
+func TestAuthService_VerifyLogin(t *testing.T) {
+	right, _ := credential.NewPassword("correct horse battery")
+	wrong, _ := credential.NewPassword("wrong horse battery")
+
+	t.Run("accepts right password", func(t *testing.T) {
+		service, _, _ := setup(t)
+
+		assertNoError(t, service.VerifyLogin(userID, right))
+	})
+
+	t.Run("unknown user fails like wrong password", func(t *testing.T) {
+		service, _, _ := setup(t)
+
+		err := service.VerifyLogin("nobody", right)
+
+		assertErrorCode(t, err, kernel.EForbidden)
+		assertErrorMessage(t, err, credential.MLoginInvalid)
+	})
+
+	t.Run("locks after repeated failures", func(t *testing.T) {
+		service, repo, _ := setup(t)
+
+		for i := 1; i < credential.MaxFailedAttempts; i++ {
+			err := service.VerifyLogin(userID, wrong)
+			assertErrorMessage(t, err, credential.MLoginInvalid)
+		}
+		err := service.VerifyLogin(userID, wrong)
+		assertErrorMessage(t, err, credential.MLoginLocked)
+
+		err = service.VerifyLogin(userID, right)
+
+		assertErrorCode(t, err, kernel.EForbidden)
+		assertErrorMessage(t, err, credential.MLoginLocked)
+		if repo.credentials[userID].FailedAttempts != credential.MaxFailedAttempts {
+			t.Errorf("got %d failed attempts", repo.credentials[userID].FailedAttempts)
+		}
+	})
+
+	t.Run("unlocks after lockout expires and resets counter on success", func(t *testing.T) {
+		service, repo, clock := setup(t)
+		for range credential.MaxFailedAttempts {
+			_ = service.VerifyLogin(userID, wrong)
+		}
+
+		clock.t = clock.t.Add(credential.LockoutDuration)
+
+		assertNoError(t, service.VerifyLogin(userID, right))
+		c := repo.credentials[userID]
+		if c.FailedAttempts != 0 || c.LockedUntil != nil {
+			t.Errorf("got %d attempts, locked until %v", c.FailedAttempts, c.LockedUntil)
+		}
+	})
+
+	t.Run("does not write on clean success", func(t *testing.T) {
+		service, repo, _ := setup(t)
+
+		assertNoError(t, service.VerifyLogin(userID, right))
+
+		if repo.updates != 0 {
+			t.Errorf("got %d updates", repo.updates)
+		}
+	})
+}
+
+func TestAuthService_PasswordReset(t *testing.T) {
+	newPassword, _ := credential.NewPassword("brand new staple 9")
+
+	t.Run("resets with valid token and unlocks account", func(t *testing.T) {
+		service, repo, _ := setup(t)
+		wrong, _ := credential.NewPassword("wrong horse battery")
+		for range credential.MaxFailedAttempts {
+			_ = service.VerifyLogin(userID, wrong)
+		}
+
+		token, err := service.RequestPasswordReset(userID)
+		assertNoError(t, err)
+		if repo.credentials[userID].Reset.Digest == token.String() {
+			t.Error("token should be stored as a digest")
+		}
+
+		assertNoError(t, service.ResetPassword(userID, token, newPassword))
+
+		assertNoError(t, service.VerifyLogin(userID, newPassword))
+		if repo.credentials[userID].Reset != nil {
+			t.Error("reset should be consumed")
+		}
+	})
+
+	t.Run("rejects wrong token", func(t *testing.T) {
+		service, _, _ := setup(t)
+		_, _ = service.RequestPasswordReset(userID)
+
+		err := service.ResetPassword(userID, "forged", newPassword)
+
+		assertErrorCode(t, err, kernel.EForbidden)
+	})
+
+	t.Run("rejects superseded token", func(t *testing.T) {
+		service, _, _ := setup(t)
+		first, _ := service.RequestPasswordReset(userID)
+		_, _ = service.RequestPasswordReset(userID)
+
+		err := service.ResetPassword(userID, first, newPassword)
+
+		assertErrorCode(t, err, kernel.EForbidden)
+	})
+
+	t.Run("rejects expired token", func(t *testing.T) {
+		service, _, clock := setup(t)
+		token, _ := service.RequestPasswordReset(userID)
+		clock.t = clock.t.Add(credential.ResetTokenTTL)
+
+		err := service.ResetPassword(userID, token, newPassword)
+
+		assertErrorCode(t, err, kernel.EConflict)
+		assertErrorMessage(t, err, credential.MResetExpired)
+	})
+
+	t.Run("rejects reset without request", func(t *testing.T) {
+		service, _, _ := setup(t)
+
+		err := service.ResetPassword(userID, "anything", newPassword)
+
+		assertErrorCode(t, err, kernel.EConflict)
+	})
+
+	t.Run("unknown user", func(t *testing.T) {
+		service, _, _ := setup(t)
+
+		_, err := service.RequestPasswordReset("nobody")
+
+		assertError(t, err)
+	})
+}
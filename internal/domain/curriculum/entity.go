@@ -0,0 +1,216 @@
+// Package curriculum arranges categories into ordered learning paths.
+package curriculum
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/category"
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/shared"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+const (
+	MaxSteps          int = 50
+	DefaultMinPercent int = 100
+
+	MCurriculumForbidden      string = "Only editors and admins can manage learning paths."
+	MCurriculumStepsMissing   string = "A learning path needs at least one step."
+	MCurriculumTooManySteps   string = "A learning path can have at most %d steps."
+	MCurriculumStepDuplicate  string = "Category %s appears in more than one step."
+	MCurriculumPrereqUnknown  string = "Step %s requires %s, which is not an earlier step."
+	MCurriculumPercentInvalid string = "Completion threshold must be between 1 and 100 percent."
+)
+
+// Step is one category of the path, with the steps that must be completed first.
+type Step struct {
+	CategoryID    kernel.ID[category.Category]
+	Prerequisites []kernel.ID[category.Category] // Categories of earlier steps
+	MinPercent    int                            // Share of the category's lessons to complete
+}
+
+// Validate ensures the completion threshold is a usable percentage.
+func (s Step) Validate() error {
+	const op = "Step.Validate"
+
+	if err := s.CategoryID.Validate(); err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if s.MinPercent < 1 || s.MinPercent > 100 {
+		return &kernel.Error{Code: kernel.EInvalid, Message: MCurriculumPercentInvalid, Operation: op}
+	}
+
+	return nil
+}
+
+// Curriculum is a structured course: categories learners take in order,
+// e.g. "A1 Basics" then "A1 Reading" and "A1 Listening".
+type Curriculum struct {
+	// Identity
+	CurriculumID kernel.ID[Curriculum]
+
+	// Data
+	Title       shared.Title
+	Slug        shared.Slug
+	Description shared.Description
+	Steps       []Step // In presentation order
+
+	// Meta
+	CreatedBy kernel.ID[user.User]
+	CreatedAt time.Time
+	UpdatedAt time.Time
+
+	// DI
+	Clock kernel.Clock
+}
+
+// NewCurriculumParams holds the parameters needed to create a learning path.
+type NewCurriculumParams struct {
+	// Required
+	CurriculumID kernel.ID[Curriculum]
+	Title        shared.Title
+	Slug         shared.Slug
+	Steps        []Step // Zero MinPercent defaults to DefaultMinPercent
+	Creator      user.PostPermissionChecker
+
+	// Optional
+	Description shared.Description
+
+	// DI
+	Clock kernel.Clock
+}
+
+// NewCurriculum creates a learning path; only editors and admins may.
+// Category existence is checked separately against the tree (see CurriculumService).
+func NewCurriculum(p NewCurriculumParams) (Curriculum, error) {
+	const op = "NewCurriculum"
+
+	if !p.Creator.HasAnyRole(user.RoleAdmin, user.RoleEditor) {
+		return Curriculum{}, &kernel.Error{Code: kernel.EForbidden, Message: MCurriculumForbidden, Operation: op}
+	}
+
+	now := p.Clock.Now()
+
+	c := Curriculum{
+		CurriculumID: p.CurriculumID,
+		Title:        p.Title,
+		Slug:         p.Slug,
+		Description:  p.Description,
+		Steps:        withDefaults(p.Steps),
+		CreatedBy:    p.Creator.GetID(),
+		CreatedAt:    now,
+		UpdatedAt:    now,
+		Clock:        p.Clock,
+	}
+
+	if err := c.Validate(); err != nil {
+		return Curriculum{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return c, nil
+}
+
+// Validate performs validation on the learning path.
+// Prerequisites must point to earlier steps, which also rules out cycles.
+func (c Curriculum) Validate() error {
+	const op = "Curriculum.Validate"
+
+	if err := c.CurriculumID.Validate(); err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if err := c.Title.Validate(); err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if err := c.Slug.Validate(); err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if c.Description != "" {
+		if err := c.Description.Validate(); err != nil {
+			return &kernel.Error{Operation: op, Cause: err}
+		}
+	}
+
+	if len(c.Steps) == 0 {
+		return &kernel.Error{Code: kernel.EInvalid, Message: MCurriculumStepsMissing, Operation: op}
+	}
+
+	if len(c.Steps) > MaxSteps {
+		return &kernel.Error{Code: kernel.EInvalid, Message: fmt.Sprintf(MCurriculumTooManySteps, MaxSteps), Operation: op}
+	}
+
+	earlier := make([]kernel.ID[category.Category], 0, len(c.Steps))
+	for _, step := range c.Steps {
+		if err := step.Validate(); err != nil {
+			return &kernel.Error{Operation: op, Cause: err}
+		}
+
+		if slices.Contains(earlier, step.CategoryID) {
+			return &kernel.Error{
+				Code:      kernel.EInvalid,
+				Message:   fmt.Sprintf(MCurriculumStepDuplicate, step.CategoryID),
+				Operation: op,
+			}
+		}
+
+		for _, prereq := range step.Prerequisites {
+			if !slices.Contains(earlier, prereq) {
+				return &kernel.Error{
+					Code:      kernel.EInvalid,
+					Message:   fmt.Sprintf(MCurriculumPrereqUnknown, step.CategoryID, prereq),
+					Operation: op,
+				}
+			}
+		}
+
+		earlier = append(earlier, step.CategoryID)
+	}
+
+	return c.CreatedBy.Validate()
+}
+
+// ReplaceSteps rewrites the path; only editors and admins may.
+func (c Curriculum) ReplaceSteps(steps []Step, editor user.PostPermissionChecker) (Curriculum, error) {
+	const op = "Curriculum.ReplaceSteps"
+
+	if !editor.HasAnyRole(user.RoleAdmin, user.RoleEditor) {
+		return c, &kernel.Error{Code: kernel.EForbidden, Message: MCurriculumForbidden, Operation: op}
+	}
+
+	updated := c
+	updated.Steps = withDefaults(steps)
+	updated.UpdatedAt = c.Clock.Now()
+
+	if err := updated.Validate(); err != nil {
+		return c, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return updated, nil
+}
+
+// CategoryIDs lists step categories in path order.
+func (c Curriculum) CategoryIDs() []kernel.ID[category.Category] {
+	ids := make([]kernel.ID[category.Category], len(c.Steps))
+	for i, s := range c.Steps {
+		ids[i] = s.CategoryID
+	}
+	return ids
+}
+
+// withDefaults copies steps, applying DefaultMinPercent where unset.
+func withDefaults(steps []Step) []Step {
+	copied := make([]Step, len(steps))
+	for i, s := range steps {
+		s.Prerequisites = slices.Clone(s.Prerequisites)
+		if s.MinPercent == 0 {
+			s.MinPercent = DefaultMinPercent
+		}
+		copied[i] = s
+	}
+	return copied
+}
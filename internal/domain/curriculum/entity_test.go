@@ -0,0 +1,108 @@
+package curriculum_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/category"
+	"github.com/alnah/fla/internal/domain/curriculum"
+	"github.com/alnah/fla/internal/domain/kernel"
+)
+
+type categoryIDs = []kernel.ID[category.Category]
+
+func validParams() curriculum.NewCurriculumParams {
+	return curriculum.NewCurriculumParams{
+		CurriculumID: "a1-course",
+		Title:        "Parcours A1 complet",
+		Slug:         "parcours-a1",
+		Steps: []curriculum.Step{
+			{CategoryID: "a1-basics"},
+			{CategoryID: "a1-reading", Prerequisites: categoryIDs{"a1-basics"}, MinPercent: 80},
+			{CategoryID: "a1-listening", Prerequisites: categoryIDs{"a1-basics"}},
+		},
+		Creator: editor(),
+		Clock:   &stubClock{t: time.Date(2024, 3, 5, 9, 0, 0, 0, time.UTC)},
+	}
+}
+
+func TestNewCurriculum(t *testing.T) {
+	t.Run("defaults completion threshold", func(t *testing.T) {
+		got, err := curriculum.NewCurriculum(validParams())
+
+		assertNoError(t, err)
+		if got.Steps[0].MinPercent != curriculum.DefaultMinPercent || got.Steps[1].MinPercent != 80 {
+			t.Errorf("got %+v", got.Steps)
+		}
+		if got.CreatedBy != "editor-1" {
+			t.Errorf("created by: got %s", got.CreatedBy)
+		}
+	})
+
+	tests := []struct {
+		name   string
+		modify func(*curriculum.NewCurriculumParams)
+		code   string
+	}{
+		{"author cannot create", func(p *curriculum.NewCurriculumParams) { p.Creator = author() }, kernel.EForbidden},
+		{"no steps", func(p *curriculum.NewCurriculumParams) { p.Steps = nil }, kernel.EInvalid},
+		{"duplicate step", func(p *curriculum.NewCurriculumParams) {
+			p.Steps = append(p.Steps, curriculum.Step{CategoryID: "a1-basics"})
+		}, kernel.EInvalid},
+		{"prerequisite comes later", func(p *curriculum.NewCurriculumParams) {
+			p.Steps[0].Prerequisites = categoryIDs{"a1-reading"}
+		}, kernel.EInvalid},
+		{"prerequisite outside path", func(p *curriculum.NewCurriculumParams) {
+			p.Steps[1].Prerequisites = categoryIDs{"b1-basics"}
+		}, kernel.EInvalid},
+		{"threshold above 100", func(p *curriculum.NewCurriculumParams) { p.Steps[0].MinPercent = 120 }, kernel.EInvalid},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params := validParams()
+			tt.modify(&params)
+
+			_, err := curriculum.NewCurriculum(params)
+
+			assertErrorCode(t, err, tt.code)
+		})
+	}
+}
+
+func TestCurriculum_ReplaceSteps(t *testing.T) {
+	c, _ := curriculum.NewCurriculum(validParams())
+	steps := []curriculum.Step{{CategoryID: "a1-reading"}}
+
+	t.Run("editor replaces steps", func(t *testing.T) {
+		got, err := c.ReplaceSteps(steps, editor())
+
+		assertNoError(t, err)
+		if len(got.Steps) != 1 || len(c.Steps) != 3 {
+			t.Errorf("got %d steps, original has %d", len(got.Steps), len(c.Steps))
+		}
+	})
+
+	t.Run("author cannot", func(t *testing.T) {
+		_, err := c.ReplaceSteps(steps, author())
+
+		assertErrorCode(t, err, kernel.EForbidden)
+	})
+}
+
+func TestProgress_Percent(t *testing.T) {
+	tests := []struct {
+		progress curriculum.Progress
+		want     int
+	}{
+		{curriculum.Progress{Completed: 3, Total: 4}, 75},
+		{curriculum.Progress{Completed: 2, Total: 3}, 66},
+		{curriculum.Progress{}, 100},
+	}
+
+	for _, tt := range tests {
+		if got := tt.progress.Percent(); got != tt.want {
+			t.Errorf("%+v: got %d, want %d", tt.progress, got, tt.want)
+		}
+	}
+}
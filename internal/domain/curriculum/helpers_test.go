@@ -0,0 +1,111 @@
+package curriculum_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/category"
+	"github.com/alnah/fla/internal/domain/curriculum"
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/shared"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+type stubClock struct {
+	t time.Time
+}
+
+func (s *stubClock) Now() time.Time { return s.t }
+
+func editor() user.User {
+	return user.User{ID: "editor-1", Roles: []user.Role{user.RoleEditor}}
+}
+
+func author() user.User {
+	return user.User{ID: "author-1", Roles: []user.Role{user.RoleAuthor}}
+}
+
+type stubRepository struct {
+	byID map[kernel.ID[curriculum.Curriculum]]curriculum.Curriculum
+}
+
+func newStubRepository(items ...curriculum.Curriculum) *stubRepository {
+	r := &stubRepository{byID: make(map[kernel.ID[curriculum.Curriculum]]curriculum.Curriculum)}
+	for _, c := range items {
+		r.byID[c.CurriculumID] = c
+	}
+	return r
+}
+
+func (r *stubRepository) GetByID(id kernel.ID[curriculum.Curriculum]) (*curriculum.Curriculum, error) {
+	if c, ok := r.byID[id]; ok {
+		return &c, nil
+	}
+	return nil, &kernel.Error{Code: kernel.ENotFound, Message: "curriculum not found"}
+}
+
+func (r *stubRepository) GetBySlug(slug shared.Slug) (*curriculum.Curriculum, error) {
+	for _, c := range r.byID {
+		if c.Slug == slug {
+			return &c, nil
+		}
+	}
+	return nil, &kernel.Error{Code: kernel.ENotFound, Message: "curriculum not found"}
+}
+
+func (r *stubRepository) GetAll() ([]curriculum.Curriculum, error) {
+	var all []curriculum.Curriculum
+	for _, c := range r.byID {
+		all = append(all, c)
+	}
+	return all, nil
+}
+
+func (r *stubRepository) Create(c curriculum.Curriculum) error {
+	r.byID[c.CurriculumID] = c
+	return nil
+}
+
+func (r *stubRepository) Update(c curriculum.Curriculum) error {
+	r.byID[c.CurriculumID] = c
+	return nil
+}
+
+func (r *stubRepository) Delete(id kernel.ID[curriculum.Curriculum]) error {
+	delete(r.byID, id)
+	return nil
+}
+
+type stubCategories []category.Category
+
+func (s stubCategories) GetByID(id kernel.ID[category.Category]) (*category.Category, error) {
+	for _, c := range s {
+		if c.CategoryID == id {
+			return &c, nil
+		}
+	}
+	return nil, &kernel.Error{Code: kernel.ENotFound, Message: "category not found"}
+}
+
+func (s stubCategories) GetAll() ([]category.Category, error) { return s, nil }
+
+type stubProgress map[kernel.ID[category.Category]]curriculum.Progress
+
+func (s stubProgress) CategoryProgress(_ kernel.ID[user.User], id kernel.ID[category.Category]) (curriculum.Progress, error) {
+	return s[id], nil
+}
+
+func assertNoError(t *testing.T, err error) {
+	t.Helper()
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+}
+
+func assertErrorCode(t *testing.T, err error, want string) {
+	t.Helper()
+	got := kernel.ErrorCode(err)
+	if got != want {
+		t.Errorf("error code: got %q, want %q", got, want)
+	}
+}
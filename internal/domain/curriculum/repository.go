@@ -0,0 +1,60 @@
+package curriculum
+
+import (
+	"github.com/alnah/fla/internal/domain/category"
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/shared"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+// CurriculumReader retrieves learning paths for course pages.
+type CurriculumReader interface {
+	// GetByID returns a learning path. Returns ENotFound when missing.
+	GetByID(curriculumID kernel.ID[Curriculum]) (*Curriculum, error)
+
+	// GetBySlug finds the learning path served at a course URL.
+	GetBySlug(slug shared.Slug) (*Curriculum, error)
+
+	// GetAll lists every learning path for the course catalog.
+	GetAll() ([]Curriculum, error)
+}
+
+// CurriculumWriter persists learning paths.
+type CurriculumWriter interface {
+	// Create stores a new learning path.
+	Create(curriculum Curriculum) error
+
+	// Update saves step changes.
+	Update(curriculum Curriculum) error
+
+	// Delete removes a learning path; the categories themselves are untouched.
+	Delete(curriculumID kernel.ID[Curriculum]) error
+}
+
+// Repository combines all learning path operations.
+// Most concrete implementations (like PostgresCurriculumRepository) will implement this.
+type Repository interface {
+	CurriculumReader
+	CurriculumWriter
+}
+
+// Progress counts a learner's completed lessons within a category subtree.
+type Progress struct {
+	Completed int
+	Total     int // Published lessons in the subtree
+}
+
+// Percent returns completion rounded down; an empty category counts as complete.
+func (p Progress) Percent() int {
+	if p.Total == 0 {
+		return 100
+	}
+	return min(100, p.Completed*100/p.Total)
+}
+
+// ProgressReader reports lesson completion per category.
+// Implemented by the progress-tracking subsystem.
+type ProgressReader interface {
+	// CategoryProgress counts completions in a category and its descendants.
+	CategoryProgress(userID kernel.ID[user.User], categoryID kernel.ID[category.Category]) (Progress, error)
+}
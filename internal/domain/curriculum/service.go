@@ -0,0 +1,169 @@
+package curriculum
+
+import (
+	"fmt"
+
+	"github.com/alnah/fla/internal/domain/category"
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+const (
+	MCurriculumCategoryUnknown  string = "Step category %s does not exist."
+	MCurriculumStepsOverlapping string = "Step %s is already covered by step %s."
+)
+
+// StepProgress is a learner's standing on one step.
+type StepProgress struct {
+	Step     Step
+	Progress Progress
+	Unlocked bool // Every prerequisite is complete
+	Complete bool // Progress reaches the step's threshold
+}
+
+// PathProgress is a learner's standing on a whole learning path.
+type PathProgress struct {
+	CurriculumID kernel.ID[Curriculum]
+	Steps        []StepProgress
+}
+
+// IsComplete reports whether every step is complete.
+func (p PathProgress) IsComplete() bool {
+	for _, s := range p.Steps {
+		if !s.Complete {
+			return false
+		}
+	}
+	return true
+}
+
+// Next returns the first unlocked step not yet complete.
+func (p PathProgress) Next() (StepProgress, bool) {
+	for _, s := range p.Steps {
+		if s.Unlocked && !s.Complete {
+			return s, true
+		}
+	}
+	return StepProgress{}, false
+}
+
+// CurriculumService stores learning paths and tracks learners along them.
+type CurriculumService struct {
+	repository Repository
+	categories category.CategoryReader
+	progress   ProgressReader
+}
+
+// NewCurriculumService creates curriculum service with category tree and progress sources.
+func NewCurriculumService(repository Repository, categories category.CategoryReader, progress ProgressReader) *CurriculumService {
+	return &CurriculumService{
+		repository: repository,
+		categories: categories,
+		progress:   progress,
+	}
+}
+
+// Create checks the path against the category tree and stores it.
+func (s *CurriculumService) Create(c Curriculum) error {
+	const op = "CurriculumService.Create"
+
+	if err := s.ValidateTree(c); err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if err := s.repository.Create(c); err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return nil
+}
+
+// Update checks the path against the category tree and saves it.
+func (s *CurriculumService) Update(c Curriculum) error {
+	const op = "CurriculumService.Update"
+
+	if err := s.ValidateTree(c); err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if err := s.repository.Update(c); err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return nil
+}
+
+// ValidateTree ensures every step category exists and no step sits inside another's subtree,
+// which would count the same lessons twice.
+func (s *CurriculumService) ValidateTree(c Curriculum) error {
+	const op = "CurriculumService.ValidateTree"
+
+	categories, err := s.categories.GetAll()
+	if err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	parents := make(map[kernel.ID[category.Category]]*kernel.ID[category.Category], len(categories))
+	for _, cat := range categories {
+		parents[cat.CategoryID] = cat.ParentID
+	}
+
+	steps := make(map[kernel.ID[category.Category]]bool, len(c.Steps))
+	for _, id := range c.CategoryIDs() {
+		if _, ok := parents[id]; !ok {
+			return &kernel.Error{
+				Code:      kernel.ENotFound,
+				Message:   fmt.Sprintf(MCurriculumCategoryUnknown, id),
+				Operation: op,
+			}
+		}
+		steps[id] = true
+	}
+
+	for _, id := range c.CategoryIDs() {
+		// Depth is bounded by MaxCategoryDepth; the guard protects against corrupt cycles.
+		for ancestor, hops := parents[id], 0; ancestor != nil && hops <= category.MaxCategoryDepth; ancestor, hops = parents[*ancestor], hops+1 {
+			if steps[*ancestor] {
+				return &kernel.Error{
+					Code:      kernel.EInvalid,
+					Message:   fmt.Sprintf(MCurriculumStepsOverlapping, id, *ancestor),
+					Operation: op,
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// Progress reports where a learner stands on a learning path.
+func (s *CurriculumService) Progress(curriculumID kernel.ID[Curriculum], userID kernel.ID[user.User]) (PathProgress, error) {
+	const op = "CurriculumService.Progress"
+
+	c, err := s.repository.GetByID(curriculumID)
+	if err != nil {
+		return PathProgress{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	complete := make(map[kernel.ID[category.Category]]bool, len(c.Steps))
+	path := PathProgress{CurriculumID: c.CurriculumID, Steps: make([]StepProgress, len(c.Steps))}
+
+	// Prerequisites always precede their step, so one pass in order suffices.
+	for i, step := range c.Steps {
+		progress, err := s.progress.CategoryProgress(userID, step.CategoryID)
+		if err != nil {
+			return PathProgress{}, &kernel.Error{Operation: op, Cause: err}
+		}
+
+		unlocked := true
+		for _, prereq := range step.Prerequisites {
+			unlocked = unlocked && complete[prereq]
+		}
+
+		done := progress.Percent() >= step.MinPercent
+		complete[step.CategoryID] = done
+		path.Steps[i] = StepProgress{Step: step, Progress: progress, Unlocked: unlocked, Complete: done}
+	}
+
+	return path, nil
+}
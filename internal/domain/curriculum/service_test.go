@@ -0,0 +1,109 @@
+package curriculum_test
+
+import (
+	"testing"
+
+	"github.com/alnah/fla/internal/domain/category"
+	"github.com/alnah/fla/internal/domain/curriculum"
+	"github.com/alnah/fla/internal/domain/kernel"
+)
+
+func testTree() stubCategories {
+	a1 := kernel.ID[category.Category]("a1")
+	return stubCategories{
+		{CategoryID: a1},
+		{CategoryID: "a1-basics", ParentID: &a1},
+		{CategoryID: "a1-reading", ParentID: &a1},
+		{CategoryID: "a1-listening", ParentID: &a1},
+	}
+}
+
+func TestCurriculumService_Create(t *testing.T) {
+	t.Run("stores a path over existing categories", func(t *testing.T) {
+		repo := newStubRepository()
+		service := curriculum.NewCurriculumService(repo, testTree(), stubProgress{})
+		c, _ := curriculum.NewCurriculum(validParams())
+
+		assertNoError(t, service.Create(c))
+
+		if _, ok := repo.byID[c.CurriculumID]; !ok {
+			t.Error("expected path to be stored")
+		}
+	})
+
+	tests := []struct {
+		name  string
+		steps []curriculum.Step
+		code  string
+	}{
+		{"unknown category", []curriculum.Step{{CategoryID: "b2-writing"}}, kernel.ENotFound},
+		{"step inside another step", []curriculum.Step{{CategoryID: "a1"}, {CategoryID: "a1-reading"}}, kernel.EInvalid},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := curriculum.NewCurriculumService(newStubRepository(), testTree(), stubProgress{})
+			params := validParams()
+			params.Steps = tt.steps
+			c, err := curriculum.NewCurriculum(params)
+			assertNoError(t, err)
+
+			err = service.Create(c)
+
+			assertErrorCode(t, err, tt.code)
+		})
+	}
+}
+
+func TestCurriculumService_Progress(t *testing.T) {
+	c, _ := curriculum.NewCurriculum(validParams())
+	repo := newStubRepository(c)
+
+	t.Run("unlocks steps once prerequisites are complete", func(t *testing.T) {
+		progress := stubProgress{
+			"a1-basics":  {Completed: 10, Total: 10},
+			"a1-reading": {Completed: 7, Total: 10},
+		}
+		service := curriculum.NewCurriculumService(repo, testTree(), progress)
+
+		got, err := service.Progress(c.CurriculumID, "learner-1")
+
+		assertNoError(t, err)
+		if !got.Steps[0].Complete || !got.Steps[1].Unlocked || got.Steps[1].Complete {
+			t.Errorf("got %+v", got.Steps)
+		}
+		if next, ok := got.Next(); !ok || next.Step.CategoryID != "a1-reading" {
+			t.Errorf("next: got %+v", next)
+		}
+		if got.IsComplete() {
+			t.Error("expected path incomplete")
+		}
+	})
+
+	t.Run("keeps later steps locked", func(t *testing.T) {
+		service := curriculum.NewCurriculumService(repo, testTree(), stubProgress{"a1-basics": {Completed: 1, Total: 10}})
+
+		got, err := service.Progress(c.CurriculumID, "learner-1")
+
+		assertNoError(t, err)
+		if got.Steps[1].Unlocked || got.Steps[2].Unlocked {
+			t.Errorf("got %+v", got.Steps)
+		}
+	})
+
+	t.Run("completes when every threshold is met", func(t *testing.T) {
+		progress := stubProgress{
+			"a1-basics":    {Completed: 10, Total: 10},
+			"a1-reading":   {Completed: 8, Total: 10},
+			"a1-listening": {Completed: 5, Total: 5},
+		}
+		service := curriculum.NewCurriculumService(repo, testTree(), progress)
+
+		got, err := service.Progress(c.CurriculumID, "learner-1")
+
+		assertNoError(t, err)
+		if !got.IsComplete() {
+			t.Errorf("got %+v", got.Steps)
+		}
+	})
+}
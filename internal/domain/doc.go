@@ -8,14 +8,48 @@
 // The domain follows Domain-Driven Design principles with a modular structure:
 //
 //	domain/
-//	├── kernel/        # Core types and utilities (Clock, Error, ID[T], URL[T], validators)
-//	├── shared/        # Shared value objects (Email, Title, Pagination, Locale, etc.)
-//	├── post/          # Post aggregate (Post, Status, SEO types)
-//	├── user/          # User aggregate (User, Role, permissions)
-//	├── category/      # Category aggregate (Category, path services)
-//	├── subscription/  # Subscription aggregate (email management)
-//	├── tag/           # Tag aggregate (content tagging)
-//	└── domain.go      # Facade for backward compatibility
+//	├── kernel/          # Core types and utilities (Clock, Error, ID[T], URL[T], RelativeURL[T], HostPolicy, IdempotencyKey, message catalog, validators)
+//	├── shared/          # Shared value objects (Email, Title, Pagination, Sort, Locale, Site, CEFRLevel, Pronunciation, CampaignLink, etc.)
+//	├── post/            # Post aggregate (Post, Status, SEO types, tags, JSON-LD, preflight, accessibility audit, featured posts, duplicate detection)
+//	├── user/            # User aggregate (User, Role, permissions, account status, role changes)
+//	├── category/        # Category aggregate (Category, path services, tree snapshots, landing copy, ordering, editor ownership, localization)
+//	├── subscription/    # Subscription aggregate (email management, consent, suppression list, list import and export)
+//	├── tag/             # Tag aggregate (content tagging, merge, rename)
+//	├── metrics/         # Daily snapshots, trend reports, editorial dashboard stats, post views, email deliverability, subscriber engagement
+//	├── importer/        # WordPress/Ghost import, Markdown round-trip, validation reports (JSON, SARIF)
+//	├── widget/          # Embeddable lesson cards (oEmbed)
+//	├── notification/    # User notification preferences, dispatch, in-app inbox
+//	├── media/           # Media library (assets, alt text, usage tracking, responsive variants)
+//	├── recommendation/  # Related posts scoring
+//	├── seo/             # Head meta tags (Open Graph, Twitter Cards, hreflang), robots rules, search engine pings
+//	├── invitation/      # Team invitations (roles, expiring tokens)
+//	├── credential/      # Passwords, login lockout, password resets
+//	├── session/         # Access and refresh tokens, revocation
+//	├── reaction/        # Likes and bookmarks on published posts
+//	├── feedback/        # Learner error reports and suggestions, triage
+//	├── glossary/        # Recurring terms, per-locale definitions, content annotation, Anki decks
+//	├── curriculum/      # Learning paths over categories, prerequisites, learner progress
+//	├── certificate/     # Certificates of completion, public verification
+//	├── author/          # Public author profiles (bio, output, top categories and tags)
+//	├── backup/          # Versioned backup archives, verified restore
+//	├── search/          # Full-text index of published posts, accent-insensitive, with a query log
+//	├── ratelimit/       # Token buckets throttling anonymous subscribe, feedback, and search
+//	├── navigation/      # Header and sidebar menus from the category tree (labels, counts, active path)
+//	├── policy/          # Permission rules, Authorize(actor, action, resource) with explained decisions
+//	├── checklist/       # Editorial checklists per category: automatic checks and sign-offs before approval
+//	├── poll/            # Polls on posts and newsletters: voting window, one vote per voter, tallies
+//	├── assistant/       # Port to writing assistants: SEO descriptions, simplification by level, exercise drafts
+//	├── translation/     # Machine-translated post variants, human review before publication, outdated variants
+//	├── speech/          # Text-to-speech generations for listening exercises, audio attachments
+//	├── social/          # Share drafts of published posts for X, LinkedIn, and Instagram, publishing queue
+//	├── shortlink/       # Short codes for lessons and pages, click counts, expiry
+//	├── book/            # E-book models of a category subtree or a post series, output adapter
+//	├── privacy/         # Right-to-erasure requests across aggregates, erasure reports
+//	├── automation/      # Email sequences (welcome series), per-subscriber position, scheduled sends
+//	├── moderation/      # Content policy: per-locale wordlists, reject or flag for review, decision audit
+//	├── linkaudit/       # External links of published posts, rate-limited checks, dead links by post
+//	├── embed/           # Video and audio players in content, allowed providers, privacy-friendly facades
+//	└── domain.go        # Facade for backward compatibility
 //
 // # Core Features
 //
@@ -37,6 +71,7 @@
 //   - Anonymous subscriptions with first name and email
 //   - Subscription lifecycle management (subscribe, unsubscribe, resubscribe)
 //   - Email bounce and complaint handling
+//   - Category, locale, and frequency preferences (instant or weekly digest)
 //
 // # Usage Examples
 //
@@ -91,19 +126,22 @@
 //
 // Managing email subscriptions:
 //
-//	// User subscribes to newsletter
+//	// User subscribes to newsletter, ticking the consent checkbox
+//	consent, err := domain.NewSubscriptionConsent(subscription.SourceSignupForm,
+//	    "https://fla.example/newsletter", "2024-05-01", ipHash, clock)
 //	sub, err := domain.NewSubscription(domain.NewSubscriptionParams{
 //	    SubscriptionID: domain.NewSubscriptionID("sub-123"),
 //	    FirstName:      domain.NewFirstName("Marie"),
 //	    Email:          domain.NewEmail("marie@example.com"),
+//	    Consent:        consent,
 //	    Clock:          clock,
 //	})
 //
 //	// User unsubscribes
 //	unsubscribed, err := sub.Unsubscribe()
 //
-//	// User resubscribes later
-//	resubscribed, err := unsubscribed.Resubscribe()
+//	// User resubscribes later with fresh consent; the first one moves to PreviousConsent
+//	resubscribed, err := unsubscribed.Resubscribe(newConsent)
 //
 // Managing user locale preferences:
 //
@@ -171,10 +209,12 @@
 //
 // User Permissions:
 //   - Admin: Full system access
-//   - Editor: Can manage content and approve posts
+//   - Editor: Can manage content and approve posts, site-wide or only in the category
+//     subtrees they are assigned to
 //   - Author: Can create and edit own posts
 //   - Visitor: Can view published content
-//   - Permissions are checked through methods like CanEditPost, CanPublish, etc.
+//   - Permissions are rules in the policy package, checked through methods like
+//     CanEditPost or User.Authorize, which also tells which rule decided
 //
 // Locale Support:
 //   - Supported languages: French (France), English (US), Portuguese (Brazil)
@@ -200,6 +240,11 @@
 //	    // Handle based on error type
 //	}
 //
+// Messages are written in English; kernel.ErrorMessageLocalized(err, "fr-FR")
+// translates them through the kernel.Messages catalog, falling back to English.
+// kernel.IsRetryable(err) tells transient failures, worth retrying with backoff,
+// from permanent ones such as validation errors.
+//
 // # SEO and Social Media
 //
 // Posts support comprehensive SEO optimization:
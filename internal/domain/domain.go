@@ -83,9 +83,19 @@ type (
 	// Provides offset calculations and navigation state for repository queries.
 	Pagination = shared.Pagination
 
+	// Sort orders listings by whitelisted fields, shared by repositories and HTTP handlers.
+	// Each aggregate declares the fields it can be sorted by.
+	Sort = shared.Sort
+
 	// Locale represents a language/region combination for interface localization.
 	// Enables multilingual user interfaces while ensuring only supported languages are used.
 	Locale = shared.Locale
+
+	// TimeZone is an IANA time zone name converting instants to and from local clocks.
+	TimeZone = shared.TimeZone
+
+	// WallClock is a local date and time without a zone, resolved through a TimeZone.
+	WallClock = shared.WallClock
 )
 
 // Re-export shared constructors
@@ -129,9 +139,18 @@ var (
 	// NewPagination creates a new pagination with validation
 	NewPagination = shared.NewPagination
 
+	// ParseSort reads a query-string sort such as "-published_at,title" against a field whitelist.
+	ParseSort = shared.ParseSort
+
 	// NewLocale creates a validated locale with support checking.
 	// Ensures only supported languages are used in the application.
 	NewLocale = shared.NewLocale
+
+	// NegotiateLocale picks the supported locale that best fits an Accept-Language header.
+	NegotiateLocale = shared.NegotiateLocale
+
+	// NewTimeZone creates a time zone validated against the IANA database.
+	NewTimeZone = shared.NewTimeZone
 )
 
 // Re-export locale constants for convenience
@@ -140,6 +159,7 @@ const (
 	LocaleEnglishUS    = shared.LocaleEnglishUS    // English (United States) interface language
 	LocalePortugueseBR = shared.LocalePortugueseBR // Portuguese (Brazil) interface language
 	DefaultLocale      = shared.DefaultLocale      // Default interface language fallback
+	DefaultTimeZone    = shared.DefaultTimeZone    // Default time zone fallback
 )
 
 // Re-export post types
@@ -212,6 +232,14 @@ type (
 	// Used by website pages that show multiple posts to visitors.
 	PostLister = post.PostLister
 
+	// PostFinder lists posts matching a Query, so new filter combinations need no new method.
+	// Used by admin listings and public pages alike.
+	PostFinder = post.PostFinder
+
+	// PostArchiver buckets published posts by date for archive navigation.
+	// Used by year/month archive pages and sidebar widgets.
+	PostArchiver = post.PostArchiver
+
 	// PostSearcher handles content discovery through queries.
 	// Used by search functionality and content recommendation systems.
 	PostSearcher = post.PostSearcher
@@ -322,6 +350,10 @@ type (
 	// CategoryPathService handles URL generation and parsing for hierarchical navigation.
 	// Enables clean URLs and breadcrumb navigation for educational content structure.
 	CategoryPathService = category.PathService
+
+	// CategoryService manages the structure of the category tree.
+	// Keeps curriculum sequencing (A1 before A2, Reading before Writing) under editorial control.
+	CategoryService = category.CategoryService
 )
 
 // CategoryID provides unique identification for category entities in the system.
@@ -347,6 +379,9 @@ var (
 	// NewPathService creates path service with repository dependency.
 	// Provides URL management capabilities for category-based content organization.
 	NewCategoryPathService = category.NewPathService
+
+	// NewCategoryService creates category service with category and post content dependencies.
+	NewCategoryService = category.NewCategoryService
 )
 
 // Re-export CATEGORY REPOSITORY INTERFACES (NEW)
@@ -427,8 +462,18 @@ var (
 	// NewTagName creates validated tag label with appropriate length constraints.
 	// Ensures tags are meaningful while fitting within UI and database limits.
 	NewTagName = tag.NewTagName
+
+	// NewTagService creates tag service with repository dependency.
+	NewTagService = tag.NewTagService
 )
 
+// TagService handles tag maintenance: merging duplicates, renaming, and cleanup.
+type TagService = tag.TagService
+
+// TagRepository defines tag persistence, usage, and merge operations.
+// Provides clean interface between domain logic and data persistence layer.
+type TagRepository = tag.Repository
+
 // Re-export subscription types
 type (
 	// Subscription manages email newsletter enrollment for blog content notifications.
@@ -440,6 +485,14 @@ type (
 
 	// NewSubscriptionParams holds the parameters needed to create a new subscription
 	NewSubscriptionParams = subscription.NewSubscriptionParams
+
+	// SubscriptionPreferences captures which content a subscriber wants emails about.
+	// Selecting a category follows its whole subtree (A1 includes A1 → Listening).
+	SubscriptionPreferences = subscription.Preferences
+
+	// SubscriptionConsent records where, to which wording, and when a subscriber agreed
+	// to receive emails. Required to create or reactivate a subscription.
+	SubscriptionConsent = subscription.Consent
 )
 
 // SubscriptionID provides unique identification for email subscription records.
@@ -456,6 +509,9 @@ var NewSubscriptionID = func(id string) (SubscriptionID, error) {
 // Validates email format and subscriber information for reliable delivery.
 var NewSubscription = subscription.NewSubscription
 
+// NewSubscriptionConsent captures a subscriber's consent stamped with the current time.
+var NewSubscriptionConsent = subscription.NewConsent
+
 const (
 	SubscriptionStatusActive       = subscription.StatusActive       // Subscription is active
 	SubscriptionStatusUnsubscribed = subscription.StatusUnsubscribed // User has unsubscribed
@@ -484,6 +540,10 @@ type (
 	// CampaignTargeter identifies subscribers for content distribution.
 	// Used by email marketing automation and newsletter delivery systems.
 	CampaignTargeter = subscription.CampaignTargeter
+
+	// SegmentTargeter narrows campaign audiences using subscriber preferences.
+	// Used by notification systems to email only subscribers following a category tree.
+	SegmentTargeter = subscription.SegmentTargeter
 )
 
 // Subscription composed interfaces
@@ -527,4 +587,10 @@ const (
 	SocialMediaTikTok    = user.SocialMediaTikTok    // SocialMediaTikTok represents TikTok platform.
 	SocialMediaYouTube   = user.SocialMediaYouTube   // SocialMediaYouTube represents YouTube platform.
 	SocialMediaGitHub    = user.SocialMediaGitHub    // SocialMediaGitHub represents GitHub platform.
+	SocialMediaFacebook  = user.SocialMediaFacebook  // SocialMediaFacebook represents Facebook platform.
+	SocialMediaMastodon  = user.SocialMediaMastodon  // SocialMediaMastodon represents Mastodon instances.
+	SocialMediaBluesky   = user.SocialMediaBluesky   // SocialMediaBluesky represents Bluesky platform.
+	SocialMediaThreads   = user.SocialMediaThreads   // SocialMediaThreads represents Threads platform.
+
+	SocialMediaPersonalWebsite = user.SocialMediaPersonalWebsite // SocialMediaPersonalWebsite represents the user's own site.
 )
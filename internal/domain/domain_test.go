@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/alnah/fla/internal/domain"
+	"github.com/alnah/fla/internal/domain/subscription"
 )
 
 // TestDomainTypeAliases verifies that all type aliases are correctly exported
@@ -372,11 +373,14 @@ func TestDomainEntityCreation(t *testing.T) {
 		subID, _ := domain.NewSubscriptionID("sub-123")
 		firstName, _ := domain.NewFirstName("John")
 		email, _ := domain.NewEmail("john@example.com")
+		consent, err := domain.NewSubscriptionConsent(subscription.SourceSignupForm, "https://fla.example/newsletter", "2024-05-01", "", clock)
+		assertNoError(t, err)
 
 		sub, err := domain.NewSubscription(domain.NewSubscriptionParams{
 			SubscriptionID: subID,
 			FirstName:      firstName,
 			Email:          email,
+			Consent:        consent,
 			Clock:          clock,
 		})
 
@@ -470,6 +474,10 @@ func (m *mockCategoryRepository) BuildPath(categoryID domain.CategoryID) (domain
 	return nil, nil
 }
 
+func (m *mockCategoryRepository) BuildPaths(categoryIDs []domain.CategoryID) (map[domain.CategoryID]domain.CategoryPath, error) {
+	return nil, nil
+}
+
 func (m *mockCategoryRepository) FindByPath(pathSegments []string) (*domain.Category, error) {
 	return nil, nil
 }
@@ -478,6 +486,10 @@ func (m *mockCategoryRepository) IsSlugUniqueInParent(slug domain.Slug, parentID
 	return true, nil
 }
 
+func (m *mockCategoryRepository) UpdateSortOrders(categories []domain.Category) error {
+	return nil
+}
+
 func assertNoError(t *testing.T, err error) {
 	t.Helper()
 	if err != nil {
@@ -0,0 +1,210 @@
+// Package embed turns video and audio URLs in post content into typed player
+// descriptors. Authors paste a YouTube, Vimeo, SoundCloud, or Spotify URL on
+// its own line; providers outside the site's allowlist stay plain links, and
+// raw iframes are refused at preflight. The rendering layer shows each embed
+// as a facade that loads the provider's player only when the reader asks, so
+// pages set no third-party cookies until then.
+//
+// Lesson cards the site offers to partners are the widget package's concern.
+package embed
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+)
+
+const MProviderUnknown string = "Unknown embed provider: %s."
+
+// Provider is a third-party player the site knows how to embed.
+type Provider string
+
+const (
+	ProviderYouTube    Provider = "youtube"
+	ProviderVimeo      Provider = "vimeo"
+	ProviderSoundCloud Provider = "soundcloud"
+	ProviderSpotify    Provider = "spotify"
+)
+
+// Providers lists every provider the package can parse.
+var Providers = []Provider{ProviderYouTube, ProviderVimeo, ProviderSoundCloud, ProviderSpotify}
+
+// String returns the provider as a string.
+func (p Provider) String() string { return string(p) }
+
+// Validate ensures the provider is one the package can parse.
+func (p Provider) Validate() error {
+	const op = "Provider.Validate"
+
+	switch p {
+	case ProviderYouTube, ProviderVimeo, ProviderSoundCloud, ProviderSpotify:
+		return nil
+	default:
+		return &kernel.Error{Code: kernel.EInvalid, Message: fmt.Sprintf(MProviderUnknown, p), Operation: op}
+	}
+}
+
+// Name returns the provider's brand name, shown on facades.
+func (p Provider) Name() string {
+	switch p {
+	case ProviderYouTube:
+		return "YouTube"
+	case ProviderVimeo:
+		return "Vimeo"
+	case ProviderSoundCloud:
+		return "SoundCloud"
+	case ProviderSpotify:
+		return "Spotify"
+	default:
+		return p.String()
+	}
+}
+
+// Kind is what a player plays, which sets the shape of its facade.
+type Kind string
+
+const (
+	KindVideo Kind = "video" // 16:9 frame
+	KindAudio Kind = "audio" // Short full-width bar
+)
+
+// Kind returns what the provider's player plays.
+func (p Provider) Kind() Kind {
+	if p == ProviderSoundCloud || p == ProviderSpotify {
+		return KindAudio
+	}
+	return KindVideo
+}
+
+// Embed describes a third-party player in post content.
+type Embed struct {
+	Provider Provider
+	ID       string // Provider's identifier: a video ID, or a path such as "episode/abc" for audio
+	Title    string // Link text or iframe title; empty when the author gave none
+	Line     int    // 1-based line of the content the player replaces
+}
+
+// String returns a short description for logs.
+func (e Embed) String() string {
+	return fmt.Sprintf("Embed{Provider: %q, ID: %q, Line: %d}", e.Provider, e.ID, e.Line)
+}
+
+// Kind returns what the embed plays.
+func (e Embed) Kind() Kind { return e.Provider.Kind() }
+
+// URL returns the content's page on the provider's site, linked when the player is not loaded.
+func (e Embed) URL() string {
+	switch e.Provider {
+	case ProviderYouTube:
+		return "https://www.youtube.com/watch?v=" + e.ID
+	case ProviderVimeo:
+		return "https://vimeo.com/" + e.ID
+	case ProviderSoundCloud:
+		return "https://soundcloud.com/" + e.ID
+	case ProviderSpotify:
+		return "https://open.spotify.com/" + e.ID
+	default:
+		return ""
+	}
+}
+
+// PlayerURL returns the provider's player, in its no-tracking mode where one exists.
+// Players start on load, since readers load them by pressing play on the facade.
+func (e Embed) PlayerURL() string {
+	switch e.Provider {
+	case ProviderYouTube:
+		return "https://www.youtube-nocookie.com/embed/" + e.ID + "?autoplay=1"
+	case ProviderVimeo:
+		return "https://player.vimeo.com/video/" + e.ID + "?dnt=1&autoplay=1"
+	case ProviderSoundCloud:
+		return "https://w.soundcloud.com/player/?url=" + url.QueryEscape(e.URL()) + "&auto_play=true"
+	case ProviderSpotify:
+		return "https://open.spotify.com/embed/" + e.ID
+	default:
+		return ""
+	}
+}
+
+var (
+	youTubeIDPattern    = regexp.MustCompile(`^[A-Za-z0-9_-]{11}$`)
+	vimeoIDPattern      = regexp.MustCompile(`^[0-9]+$`)
+	soundCloudIDPattern = regexp.MustCompile(`^[a-z0-9_-]+/(?:sets/)?[a-z0-9_-]+$`)
+	spotifyIDPattern    = regexp.MustCompile(`^(?:track|album|playlist|episode|show)/[A-Za-z0-9]{22}$`)
+)
+
+// Parse recognizes the page or player URL of a provider, such as
+// https://youtu.be/dQw4w9WgXcQ or https://open.spotify.com/embed/episode/...
+// It reports false for other URLs, including provider pages that are not a
+// single video or track.
+func Parse(rawURL string) (Embed, bool) {
+	u, err := url.Parse(strings.TrimSpace(rawURL))
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+		return Embed{}, false
+	}
+	host := strings.TrimPrefix(strings.ToLower(u.Hostname()), "www.")
+	path := strings.Trim(u.Path, "/")
+
+	var provider Provider
+	var id string
+	switch host {
+	case "youtube.com", "m.youtube.com", "youtube-nocookie.com":
+		provider = ProviderYouTube
+		if path == "watch" {
+			id = u.Query().Get("v")
+		} else if rest, ok := cutAnyPrefix(path, "embed/", "shorts/", "live/"); ok {
+			id = rest
+		}
+	case "youtu.be":
+		provider, id = ProviderYouTube, path
+	case "vimeo.com":
+		provider, id = ProviderVimeo, path
+	case "player.vimeo.com":
+		provider = ProviderVimeo
+		id, _ = strings.CutPrefix(path, "video/")
+	case "soundcloud.com", "m.soundcloud.com":
+		provider, id = ProviderSoundCloud, strings.ToLower(path)
+	case "w.soundcloud.com":
+		// The player carries the track's page URL
+		if path == "player" {
+			if track, ok := Parse(u.Query().Get("url")); ok && track.Provider == ProviderSoundCloud {
+				return track, true
+			}
+		}
+		return Embed{}, false
+	case "open.spotify.com":
+		provider = ProviderSpotify
+		id, _ = strings.CutPrefix(path, "embed/")
+	default:
+		return Embed{}, false
+	}
+
+	if !idPattern(provider).MatchString(id) {
+		return Embed{}, false
+	}
+	return Embed{Provider: provider, ID: id}, true
+}
+
+func idPattern(p Provider) *regexp.Regexp {
+	switch p {
+	case ProviderYouTube:
+		return youTubeIDPattern
+	case ProviderVimeo:
+		return vimeoIDPattern
+	case ProviderSoundCloud:
+		return soundCloudIDPattern
+	default:
+		return spotifyIDPattern
+	}
+}
+
+func cutAnyPrefix(s string, prefixes ...string) (string, bool) {
+	for _, prefix := range prefixes {
+		if rest, ok := strings.CutPrefix(s, prefix); ok {
+			return rest, true
+		}
+	}
+	return s, false
+}
@@ -0,0 +1,91 @@
+package embed_test
+
+import (
+	"testing"
+
+	"github.com/alnah/fla/internal/domain/embed"
+)
+
+func TestParse(t *testing.T) {
+	const spotifyID = "4rOoJ6Egrf8K2IrywzwOMk"
+
+	tests := []struct {
+		url  string
+		want embed.Embed
+		ok   bool
+	}{
+		{"https://www.youtube.com/watch?v=dQw4w9WgXcQ&t=42", embed.Embed{Provider: embed.ProviderYouTube, ID: "dQw4w9WgXcQ"}, true},
+		{"https://youtu.be/dQw4w9WgXcQ", embed.Embed{Provider: embed.ProviderYouTube, ID: "dQw4w9WgXcQ"}, true},
+		{"https://m.youtube.com/shorts/dQw4w9WgXcQ", embed.Embed{Provider: embed.ProviderYouTube, ID: "dQw4w9WgXcQ"}, true},
+		{"https://www.youtube-nocookie.com/embed/dQw4w9WgXcQ", embed.Embed{Provider: embed.ProviderYouTube, ID: "dQw4w9WgXcQ"}, true},
+		{"https://vimeo.com/76979871", embed.Embed{Provider: embed.ProviderVimeo, ID: "76979871"}, true},
+		{"https://player.vimeo.com/video/76979871?h=abc", embed.Embed{Provider: embed.ProviderVimeo, ID: "76979871"}, true},
+		{"https://soundcloud.com/rfi/journal-en-francais-facile", embed.Embed{Provider: embed.ProviderSoundCloud, ID: "rfi/journal-en-francais-facile"}, true},
+		{"https://w.soundcloud.com/player/?url=https%3A//soundcloud.com/rfi/journal-en-francais-facile", embed.Embed{Provider: embed.ProviderSoundCloud, ID: "rfi/journal-en-francais-facile"}, true},
+		{"https://open.spotify.com/episode/" + spotifyID, embed.Embed{Provider: embed.ProviderSpotify, ID: "episode/" + spotifyID}, true},
+		{"https://open.spotify.com/embed/show/" + spotifyID, embed.Embed{Provider: embed.ProviderSpotify, ID: "show/" + spotifyID}, true},
+		{"https://www.youtube.com/@rfi", embed.Embed{}, false},
+		{"https://vimeo.com/channels/staffpicks", embed.Embed{}, false},
+		{"https://soundcloud.com/rfi", embed.Embed{}, false},
+		{"https://open.spotify.com/user/" + spotifyID, embed.Embed{}, false},
+		{"https://www.dailymotion.com/video/x8abc", embed.Embed{}, false},
+		{"javascript:alert(1)", embed.Embed{}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.url, func(t *testing.T) {
+			got, ok := embed.Parse(tt.url)
+			if ok != tt.ok || got != tt.want {
+				t.Errorf("got %v, %v; want %v, %v", got, ok, tt.want, tt.ok)
+			}
+		})
+	}
+}
+
+func TestEmbed_URLs(t *testing.T) {
+	tests := []struct {
+		embed      embed.Embed
+		wantURL    string
+		wantPlayer string
+		wantKind   embed.Kind
+	}{
+		{
+			embed.Embed{Provider: embed.ProviderYouTube, ID: "dQw4w9WgXcQ"},
+			"https://www.youtube.com/watch?v=dQw4w9WgXcQ",
+			"https://www.youtube-nocookie.com/embed/dQw4w9WgXcQ?autoplay=1",
+			embed.KindVideo,
+		},
+		{
+			embed.Embed{Provider: embed.ProviderVimeo, ID: "76979871"},
+			"https://vimeo.com/76979871",
+			"https://player.vimeo.com/video/76979871?dnt=1&autoplay=1",
+			embed.KindVideo,
+		},
+		{
+			embed.Embed{Provider: embed.ProviderSoundCloud, ID: "rfi/journal"},
+			"https://soundcloud.com/rfi/journal",
+			"https://w.soundcloud.com/player/?url=https%3A%2F%2Fsoundcloud.com%2Frfi%2Fjournal&auto_play=true",
+			embed.KindAudio,
+		},
+		{
+			embed.Embed{Provider: embed.ProviderSpotify, ID: "episode/4rOoJ6Egrf8K2IrywzwOMk"},
+			"https://open.spotify.com/episode/4rOoJ6Egrf8K2IrywzwOMk",
+			"https://open.spotify.com/embed/episode/4rOoJ6Egrf8K2IrywzwOMk",
+			embed.KindAudio,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.embed.Provider.String(), func(t *testing.T) {
+			if got := tt.embed.URL(); got != tt.wantURL {
+				t.Errorf("URL: got %q, want %q", got, tt.wantURL)
+			}
+			if got := tt.embed.PlayerURL(); got != tt.wantPlayer {
+				t.Errorf("PlayerURL: got %q, want %q", got, tt.wantPlayer)
+			}
+			if got := tt.embed.Kind(); got != tt.wantKind {
+				t.Errorf("Kind: got %q, want %q", got, tt.wantKind)
+			}
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package embed
+
+import (
+	"fmt"
+	"html"
+)
+
+// Facade holds what templates need to show an embed without loading the
+// player: a placeholder with the title and a play button that swaps in the
+// iframe, and a link to the provider for readers without JavaScript.
+type Facade struct {
+	Provider  Provider
+	Name      string // Provider's brand name
+	Kind      Kind
+	Title     string // Embed title, or the provider's name when the author gave none
+	URL       string // Page on the provider's site
+	PlayerURL string // Loaded into an iframe when the reader presses play
+}
+
+// Facade returns the embed's privacy-friendly placeholder.
+func (e Embed) Facade() Facade {
+	title := e.Title
+	if title == "" {
+		title = e.Provider.Name()
+	}
+	return Facade{
+		Provider:  e.Provider,
+		Name:      e.Provider.Name(),
+		Kind:      e.Kind(),
+		Title:     title,
+		URL:       e.URL(),
+		PlayerURL: e.PlayerURL(),
+	}
+}
+
+// HTML renders the facade with every value escaped. The site script replaces
+// the figure's content with an iframe of data-player-url, titled with
+// data-title, on click; until then no request reaches the provider.
+func (f Facade) HTML() string {
+	return fmt.Sprintf(
+		`<figure class="fla-embed fla-embed--%s" data-provider="%s" data-player-url="%s" data-title="%s">`+
+			`<button type="button" class="fla-embed__play" aria-label="%s">%s</button>`+
+			`<figcaption><a href="%s" rel="noopener">%s</a></figcaption></figure>`,
+		f.Kind,
+		f.Provider,
+		html.EscapeString(f.PlayerURL),
+		html.EscapeString(f.Title),
+		html.EscapeString(f.Title),
+		html.EscapeString(f.Name),
+		html.EscapeString(f.URL),
+		html.EscapeString(f.Title),
+	)
+}
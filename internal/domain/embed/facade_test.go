@@ -0,0 +1,46 @@
+package embed_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/alnah/fla/internal/domain/embed"
+)
+
+func TestEmbed_Facade(t *testing.T) {
+	t.Run("falls back to the provider name", func(t *testing.T) {
+		got := embed.Embed{Provider: embed.ProviderVimeo, ID: "76979871"}.Facade()
+
+		want := embed.Facade{
+			Provider:  embed.ProviderVimeo,
+			Name:      "Vimeo",
+			Kind:      embed.KindVideo,
+			Title:     "Vimeo",
+			URL:       "https://vimeo.com/76979871",
+			PlayerURL: "https://player.vimeo.com/video/76979871?dnt=1&autoplay=1",
+		}
+		if got != want {
+			t.Errorf("got %+v, want %+v", got, want)
+		}
+	})
+
+	t.Run("renders escaped markup without loading the player", func(t *testing.T) {
+		e := embed.Embed{Provider: embed.ProviderYouTube, ID: "dQw4w9WgXcQ", Title: `Le "passé" <composé>`}
+
+		got := e.Facade().HTML()
+
+		for _, want := range []string{
+			`class="fla-embed fla-embed--video"`,
+			`data-player-url="https://www.youtube-nocookie.com/embed/dQw4w9WgXcQ?autoplay=1"`,
+			`aria-label="Le &#34;passé&#34; &lt;composé&gt;"`,
+			`<a href="https://www.youtube.com/watch?v=dQw4w9WgXcQ" rel="noopener">`,
+		} {
+			if !strings.Contains(got, want) {
+				t.Errorf("expected %s in %s", want, got)
+			}
+		}
+		if strings.Contains(got, "<iframe") || strings.Contains(got, "<composé>") {
+			t.Errorf("unexpected markup in %s", got)
+		}
+	})
+}
@@ -0,0 +1,184 @@
+package embed
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/post"
+)
+
+const (
+	MPreflightIframeRaw          string = "Iframe from %q: paste the video or audio URL on its own line instead."
+	MPreflightIframeNotAllowed   string = "Iframe from %q is not from an allowed player and will be removed."
+	MPreflightProviderNotAllowed string = "%s is not an allowed player; %q will show as a plain link."
+	MPolicyProvidersMissing      string = "Embed policy must allow at least one provider."
+	MPolicyProviderDuplicate     string = "Embed provider %s is listed twice."
+)
+
+const (
+	FindingIframeRaw        post.FindingCode = "iframe_raw"
+	FindingEmbedNotAllowed  post.FindingCode = "embed_not_allowed"
+	FindingIframeNotAllowed post.FindingCode = "iframe_not_allowed"
+)
+
+// Policy lists the providers whose players may be embedded.
+type Policy struct {
+	Allowed []Provider
+}
+
+// DefaultPolicy allows every provider the package can parse.
+func DefaultPolicy() Policy {
+	return Policy{Allowed: slices.Clone(Providers)}
+}
+
+// Validate ensures the policy lists known providers, each once.
+func (p Policy) Validate() error {
+	const op = "Policy.Validate"
+
+	if len(p.Allowed) == 0 {
+		return &kernel.Error{Code: kernel.EInvalid, Message: MPolicyProvidersMissing, Operation: op}
+	}
+	for i, provider := range p.Allowed {
+		if err := provider.Validate(); err != nil {
+			return &kernel.Error{Operation: op, Cause: err}
+		}
+		if slices.Contains(p.Allowed[:i], provider) {
+			return &kernel.Error{Code: kernel.EInvalid, Message: fmt.Sprintf(MPolicyProviderDuplicate, provider), Operation: op}
+		}
+	}
+	return nil
+}
+
+// Allows reports whether the provider's players may be embedded.
+func (p Policy) Allows(provider Provider) bool { return slices.Contains(p.Allowed, provider) }
+
+// Iframe is a raw <iframe> found in post content.
+type Iframe struct {
+	Src   string
+	Line  int    // 1-based line of the content
+	Embed *Embed // The allowed player it loads, nil when the source is not one
+}
+
+// Scan lists what the rendering layer replaces in post content.
+type Scan struct {
+	Embeds  []Embed  // Allowed players, in content order; iframes of allowed players included
+	Iframes []Iframe // Every raw iframe; those without an Embed are dropped when rendering
+	Refused []Embed  // Players on their own line from providers outside the policy; left as links
+}
+
+// EmbedService detects third-party players in post content and checks them
+// against the site's allowed providers. Not to be confused with widget's,
+// which embeds the site's lessons elsewhere.
+type EmbedService struct {
+	policy Policy
+}
+
+// NewEmbedService creates embed service allowing the providers of the policy.
+func NewEmbedService(policy Policy) (*EmbedService, error) {
+	const op = "NewEmbedService"
+
+	if err := policy.Validate(); err != nil {
+		return nil, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return &EmbedService{policy: policy}, nil
+}
+
+var (
+	// A player is a URL alone on its line: bare, in angle brackets, or as the only link.
+	bareURLPattern        = regexp.MustCompile(`^<?(https?://\S+?)>?$`)
+	standaloneLinkPattern = regexp.MustCompile(`^\[([^\]]*)\]\((https?://[^)\s]+)[^)]*\)$`)
+	iframePattern         = regexp.MustCompile(`(?i)<iframe\s[^>]*>`)
+	iframeSrcPattern      = regexp.MustCompile(`(?i)\ssrc\s*=\s*["']([^"']*)["']`)
+	iframeTitlePattern    = regexp.MustCompile(`(?i)\stitle\s*=\s*["']([^"']*)["']`)
+)
+
+// Scan finds the players of the content. A provider URL becomes a player only
+// on its own line, so URLs within sentences stay links. Fenced code blocks are
+// skipped.
+func (s *EmbedService) Scan(content string) Scan {
+	var scan Scan
+
+	inFence := false
+	for i, line := range strings.Split(content, "\n") {
+		n := i + 1
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~") {
+			inFence = !inFence
+			continue
+		}
+		if inFence {
+			continue
+		}
+
+		for _, tag := range iframePattern.FindAllString(line, -1) {
+			iframe := Iframe{Line: n}
+			if m := iframeSrcPattern.FindStringSubmatch(tag); m != nil {
+				iframe.Src = m[1]
+			}
+			if e, ok := Parse(iframe.Src); ok && s.policy.Allows(e.Provider) {
+				if m := iframeTitlePattern.FindStringSubmatch(tag); m != nil {
+					e.Title = strings.TrimSpace(m[1])
+				}
+				e.Line = n
+				iframe.Embed = &e
+				scan.Embeds = append(scan.Embeds, e)
+			}
+			scan.Iframes = append(scan.Iframes, iframe)
+		}
+
+		e, ok := standalone(trimmed)
+		if !ok {
+			continue
+		}
+		e.Line = n
+		if s.policy.Allows(e.Provider) {
+			scan.Embeds = append(scan.Embeds, e)
+		} else {
+			scan.Refused = append(scan.Refused, e)
+		}
+	}
+
+	return scan
+}
+
+// Check reports raw iframes and refused players as preflight findings, to show
+// alongside the PreflightService report. Iframes block publication: they load
+// third-party players, and their cookies, before readers choose to play.
+func (s *EmbedService) Check(p post.Post) post.PreflightReport {
+	scan := s.Scan(p.Content.String())
+
+	var report post.PreflightReport
+	add := func(code post.FindingCode, severity post.Severity, message string) {
+		report.Findings = append(report.Findings, post.Finding{Code: code, Severity: severity, Message: message})
+	}
+
+	for _, iframe := range scan.Iframes {
+		if iframe.Embed != nil {
+			add(FindingIframeRaw, post.SeverityError, fmt.Sprintf(MPreflightIframeRaw, iframe.Src))
+		} else {
+			add(FindingIframeNotAllowed, post.SeverityError, fmt.Sprintf(MPreflightIframeNotAllowed, iframe.Src))
+		}
+	}
+	for _, e := range scan.Refused {
+		add(FindingEmbedNotAllowed, post.SeverityWarning, fmt.Sprintf(MPreflightProviderNotAllowed, e.Provider.Name(), e.URL()))
+	}
+	return report
+}
+
+// standalone parses a line holding only a provider URL, bare or as a link
+// whose text becomes the title.
+func standalone(line string) (Embed, bool) {
+	if m := standaloneLinkPattern.FindStringSubmatch(line); m != nil {
+		e, ok := Parse(m[2])
+		e.Title = strings.TrimSpace(m[1])
+		return e, ok
+	}
+	if m := bareURLPattern.FindStringSubmatch(line); m != nil {
+		return Parse(m[1])
+	}
+	return Embed{}, false
+}
@@ -0,0 +1,105 @@
+package embed_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/alnah/fla/internal/domain/embed"
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/post"
+)
+
+const content = `## Écouter
+
+Regardez la vidéo puis répondez aux questions.
+
+https://youtu.be/dQw4w9WgXcQ
+[Le journal en français facile](https://soundcloud.com/rfi/journal-en-francais-facile)
+La même vidéo dans une phrase : https://youtu.be/dQw4w9WgXcQ.
+<iframe src="https://player.vimeo.com/video/76979871" title="Au marché"></iframe>
+<iframe src="https://forms.example/quiz"></iframe>
+<https://www.dailymotion.com/video/x8abc>
+` + "```" + `
+https://vimeo.com/76979871
+` + "```"
+
+func TestEmbedService_Scan(t *testing.T) {
+	policy := embed.Policy{Allowed: []embed.Provider{embed.ProviderYouTube, embed.ProviderVimeo}}
+	service, err := embed.NewEmbedService(policy)
+	assertNoError(t, err)
+
+	got := service.Scan(content)
+
+	wantEmbeds := []embed.Embed{
+		{Provider: embed.ProviderYouTube, ID: "dQw4w9WgXcQ", Line: 5},
+		{Provider: embed.ProviderVimeo, ID: "76979871", Title: "Au marché", Line: 8},
+	}
+	if !slices.Equal(got.Embeds, wantEmbeds) {
+		t.Errorf("embeds: got %v, want %v", got.Embeds, wantEmbeds)
+	}
+
+	wantRefused := []embed.Embed{
+		{Provider: embed.ProviderSoundCloud, ID: "rfi/journal-en-francais-facile", Title: "Le journal en français facile", Line: 6},
+	}
+	if !slices.Equal(got.Refused, wantRefused) {
+		t.Errorf("refused: got %v, want %v", got.Refused, wantRefused)
+	}
+
+	if len(got.Iframes) != 2 {
+		t.Fatalf("got %d iframes, want 2", len(got.Iframes))
+	}
+	if got.Iframes[0].Embed == nil || got.Iframes[0].Embed.ID != "76979871" {
+		t.Errorf("expected the Vimeo iframe to load its player, got %+v", got.Iframes[0])
+	}
+	if got.Iframes[1].Embed != nil || got.Iframes[1].Src != "https://forms.example/quiz" || got.Iframes[1].Line != 9 {
+		t.Errorf("expected the form iframe to be dropped, got %+v", got.Iframes[1])
+	}
+}
+
+func TestEmbedService_Check(t *testing.T) {
+	service, err := embed.NewEmbedService(embed.Policy{Allowed: []embed.Provider{embed.ProviderYouTube, embed.ProviderVimeo}})
+	assertNoError(t, err)
+
+	report := service.Check(post.Post{Content: post.PostContent(content)})
+
+	wantErrors := []post.FindingCode{embed.FindingIframeRaw, embed.FindingIframeNotAllowed}
+	wantWarnings := []post.FindingCode{embed.FindingEmbedNotAllowed}
+	if got := findingCodes(report.Errors()); !slices.Equal(got, wantErrors) {
+		t.Errorf("errors: got %v, want %v", got, wantErrors)
+	}
+	if got := findingCodes(report.Warnings()); !slices.Equal(got, wantWarnings) {
+		t.Errorf("warnings: got %v, want %v", got, wantWarnings)
+	}
+
+	if clean := service.Check(post.Post{Content: "https://youtu.be/dQw4w9WgXcQ"}); len(clean.Findings) != 0 {
+		t.Errorf("expected no findings, got %v", clean.Findings)
+	}
+}
+
+func TestPolicy_Validate(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy embed.Policy
+		code   string
+	}{
+		{"default", embed.DefaultPolicy(), ""},
+		{"empty", embed.Policy{}, kernel.EInvalid},
+		{"unknown provider", embed.Policy{Allowed: []embed.Provider{"dailymotion"}}, kernel.EInvalid},
+		{"duplicate provider", embed.Policy{Allowed: []embed.Provider{embed.ProviderVimeo, embed.ProviderVimeo}}, kernel.EInvalid},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := embed.NewEmbedService(tt.policy)
+			assertErrorCode(t, err, tt.code)
+		})
+	}
+}
+
+func findingCodes(findings []post.Finding) []post.FindingCode {
+	codes := make([]post.FindingCode, len(findings))
+	for i, f := range findings {
+		codes[i] = f.Code
+	}
+	return codes
+}
@@ -0,0 +1,185 @@
+// Package feedback collects learner reports about lessons: typos, errors, and suggestions.
+package feedback
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/post"
+	"github.com/alnah/fla/internal/domain/shared"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+const (
+	MinMessageLength int = 10
+	MaxMessageLength int = 5000
+
+	MFeedbackForbidden         string = "Only editors and admins can triage feedback."
+	MFeedbackTransitionInvalid string = "Feedback cannot move from %s to %s."
+)
+
+// Feedback is a learner's report about the site or a specific lesson.
+// Reporters stay anonymous unless they leave an email for follow-up.
+type Feedback struct {
+	// Identity
+	FeedbackID kernel.ID[Feedback]
+
+	// Data
+	PostID   *kernel.ID[post.Post] // Lesson concerned (nil for site-wide feedback)
+	Category Category
+	Message  string
+	Email    *shared.Email // Optional, for replying to the reporter
+	Flagged  bool          // Content policy matched a term to review
+
+	// Lifecycle
+	Status     Status
+	TriagedBy  *kernel.ID[user.User]
+	TriagedAt  *time.Time
+	ResolvedBy *kernel.ID[user.User]
+	ResolvedAt *time.Time
+
+	// Meta
+	CreatedAt time.Time
+	UpdatedAt time.Time
+
+	// DI
+	Clock kernel.Clock
+}
+
+// NewFeedbackParams holds the parameters needed to create feedback.
+type NewFeedbackParams struct {
+	// Required
+	FeedbackID kernel.ID[Feedback]
+	Category   Category
+	Message    string
+
+	// Optional
+	PostID *kernel.ID[post.Post]
+	Email  *shared.Email
+
+	// DI
+	Clock kernel.Clock
+}
+
+// NewFeedback creates new feedback awaiting triage.
+func NewFeedback(p NewFeedbackParams) (Feedback, error) {
+	const op = "NewFeedback"
+
+	now := p.Clock.Now()
+
+	feedback := Feedback{
+		FeedbackID: p.FeedbackID,
+		PostID:     p.PostID,
+		Category:   p.Category,
+		Message:    p.Message,
+		Email:      p.Email,
+		Status:     StatusNew,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+		Clock:      p.Clock,
+	}
+
+	if err := feedback.Validate(); err != nil {
+		return Feedback{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return feedback, nil
+}
+
+// Validate performs validation on the feedback.
+func (f Feedback) Validate() error {
+	const op = "Feedback.Validate"
+
+	if err := f.FeedbackID.Validate(); err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if f.PostID != nil {
+		if err := f.PostID.Validate(); err != nil {
+			return &kernel.Error{Operation: op, Cause: err}
+		}
+	}
+
+	if err := f.Category.Validate(); err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if err := kernel.ValidateLength("message", f.Message, MinMessageLength, MaxMessageLength, op); err != nil {
+		return err
+	}
+
+	if f.Email != nil {
+		if err := f.Email.Validate(); err != nil {
+			return &kernel.Error{Operation: op, Cause: err}
+		}
+	}
+
+	if err := f.Status.Validate(); err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return nil
+}
+
+// String returns a string representation of the feedback.
+func (f Feedback) String() string {
+	return fmt.Sprintf("Feedback{ID: %s, Category: %s, Status: %s}", f.FeedbackID, f.Category, f.Status)
+}
+
+// Triage acknowledges the feedback, or reopens resolved feedback; editors and admins only.
+func (f Feedback) Triage(actor user.PostPermissionChecker) (Feedback, error) {
+	const op = "Feedback.Triage"
+
+	updated, err := f.transition(actor, StatusTriaged)
+	if err != nil {
+		return f, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	id, now := actor.GetID(), updated.UpdatedAt
+	updated.TriagedBy = &id
+	updated.TriagedAt = &now
+	updated.ResolvedBy = nil // Reopened feedback is no longer resolved
+	updated.ResolvedAt = nil
+
+	return updated, nil
+}
+
+// Resolve closes the feedback once fixed or dismissed; editors and admins only.
+func (f Feedback) Resolve(actor user.PostPermissionChecker) (Feedback, error) {
+	const op = "Feedback.Resolve"
+
+	updated, err := f.transition(actor, StatusResolved)
+	if err != nil {
+		return f, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	id, now := actor.GetID(), updated.UpdatedAt
+	updated.ResolvedBy = &id
+	updated.ResolvedAt = &now
+
+	return updated, nil
+}
+
+// transition moves the feedback to a new status after permission and workflow checks.
+func (f Feedback) transition(actor user.PostPermissionChecker, target Status) (Feedback, error) {
+	const op = "Feedback.transition"
+
+	if !actor.HasAnyRole(user.RoleAdmin, user.RoleEditor) {
+		return f, &kernel.Error{Code: kernel.EForbidden, Message: MFeedbackForbidden, Operation: op}
+	}
+
+	if !f.Status.CanTransitionTo(target) {
+		return f, &kernel.Error{
+			Code:      kernel.EConflict,
+			Message:   fmt.Sprintf(MFeedbackTransitionInvalid, f.Status, target),
+			Operation: op,
+		}
+	}
+
+	updated := f
+	updated.Status = target
+	updated.UpdatedAt = f.Clock.Now()
+
+	return updated, nil
+}
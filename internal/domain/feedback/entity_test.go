@@ -0,0 +1,133 @@
+package feedback_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/feedback"
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/post"
+	"github.com/alnah/fla/internal/domain/shared"
+)
+
+func validParams() feedback.NewFeedbackParams {
+	return feedback.NewFeedbackParams{
+		FeedbackID: "fb-1",
+		Category:   feedback.CategoryTypo,
+		Message:    "Il manque un accent sur « élève » dans le deuxième paragraphe.",
+		Clock:      &stubClock{t: time.Date(2024, 3, 5, 9, 0, 0, 0, time.UTC)},
+	}
+}
+
+func TestNewFeedback(t *testing.T) {
+	t.Run("starts as new", func(t *testing.T) {
+		got, err := feedback.NewFeedback(validParams())
+
+		assertNoError(t, err)
+		if got.Status != feedback.StatusNew || got.CreatedAt.IsZero() {
+			t.Errorf("got %v", got)
+		}
+	})
+
+	postID := kernel.ID[post.Post]("lesson")
+	email := shared.Email("learner@example.com")
+	badEmail := shared.Email("not-an-email")
+
+	tests := []struct {
+		name   string
+		modify func(*feedback.NewFeedbackParams)
+		code   string
+	}{
+		{"with post and email", func(p *feedback.NewFeedbackParams) { p.PostID, p.Email = &postID, &email }, ""},
+		{"unknown category", func(p *feedback.NewFeedbackParams) { p.Category = "praise" }, kernel.EInvalid},
+		{"message too short", func(p *feedback.NewFeedbackParams) { p.Message = "typo" }, kernel.EInvalid},
+		{"message too long", func(p *feedback.NewFeedbackParams) { p.Message = strings.Repeat("a", feedback.MaxMessageLength+1) }, kernel.EInvalid},
+		{"invalid email", func(p *feedback.NewFeedbackParams) { p.Email = &badEmail }, kernel.EInvalid},
+		{"missing ID", func(p *feedback.NewFeedbackParams) { p.FeedbackID = "" }, kernel.EInvalid},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params := validParams()
+			tt.modify(&params)
+
+			_, err := feedback.NewFeedback(params)
+
+			if tt.code == "" {
+				assertNoError(t, err)
+				return
+			}
+			assertErrorCode(t, err, tt.code)
+		})
+	}
+}
+
+func TestFeedback_Triage(t *testing.T) {
+	t.Run("editor triages new feedback", func(t *testing.T) {
+		f, _ := feedback.NewFeedback(validParams())
+
+		got, err := f.Triage(editor())
+
+		assertNoError(t, err)
+		if got.Status != feedback.StatusTriaged || got.TriagedBy == nil || *got.TriagedBy != "editor-1" {
+			t.Errorf("got %v", got)
+		}
+	})
+
+	t.Run("reopens resolved feedback", func(t *testing.T) {
+		f, _ := feedback.NewFeedback(validParams())
+		resolved, _ := f.Resolve(editor())
+
+		got, err := resolved.Triage(editor())
+
+		assertNoError(t, err)
+		if got.Status != feedback.StatusTriaged || got.ResolvedAt != nil {
+			t.Errorf("got %v", got)
+		}
+	})
+
+	t.Run("authors cannot triage", func(t *testing.T) {
+		f, _ := feedback.NewFeedback(validParams())
+
+		_, err := f.Triage(author())
+
+		assertErrorCode(t, err, kernel.EForbidden)
+	})
+
+	t.Run("already triaged", func(t *testing.T) {
+		f, _ := feedback.NewFeedback(validParams())
+		triaged, _ := f.Triage(editor())
+
+		_, err := triaged.Triage(editor())
+
+		assertErrorCode(t, err, kernel.EConflict)
+	})
+
+	t.Run("rescues spam", func(t *testing.T) {
+		f, _ := feedback.NewFeedback(validParams())
+		f.Status = feedback.StatusSpam
+
+		got, err := f.Triage(editor())
+
+		assertNoError(t, err)
+		if got.Status != feedback.StatusTriaged {
+			t.Errorf("got status %s", got.Status)
+		}
+	})
+}
+
+func TestFeedback_Resolve(t *testing.T) {
+	f, _ := feedback.NewFeedback(validParams())
+
+	got, err := f.Resolve(editor())
+
+	assertNoError(t, err)
+	if got.Status != feedback.StatusResolved || got.ResolvedBy == nil || got.ResolvedAt == nil {
+		t.Errorf("got %v", got)
+	}
+
+	_, err = got.Resolve(editor())
+
+	assertErrorCode(t, err, kernel.EConflict)
+}
@@ -0,0 +1,109 @@
+package feedback
+
+import (
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+)
+
+const (
+	// DefaultMaxLinks is how many links a genuine report needs at most: the
+	// page it is about, and perhaps a reference.
+	DefaultMaxLinks int = 2
+
+	// DefaultMaxPerWindow is how many reports one IP address sends per
+	// DefaultRateWindow before the next ones are flagged.
+	DefaultMaxPerWindow int = 3
+)
+
+// DefaultRateWindow is the period DefaultMaxPerWindow applies to.
+const DefaultRateWindow time.Duration = 10 * time.Minute
+
+// linkPattern matches whole URLs, so "https://www." counts once.
+var linkPattern = regexp.MustCompile(`(?i)\b(?:https?://|www\.)\S+`)
+
+// HeuristicGuard flags feedback that looks like link spam, or that comes too
+// fast from one IP address, without calling any external service.
+type HeuristicGuard struct {
+	MaxLinks      int           // Links allowed in a message
+	BannedPhrases []string      // Matched case-insensitively anywhere in the message
+	MaxPerWindow  int           // Reports allowed per IP address within Window; zero disables the check
+	Window        time.Duration // Period MaxPerWindow applies to
+
+	// DI
+	Clock kernel.Clock
+
+	mu     sync.Mutex
+	recent map[string][]time.Time // Report times per IP address within Window, oldest first
+}
+
+// NewHeuristicGuard creates a guard allowing DefaultMaxLinks links and
+// DefaultMaxPerWindow reports per IP address, and rejecting the given
+// phrases, e.g. "casino" or "buy followers".
+func NewHeuristicGuard(clock kernel.Clock, bannedPhrases ...string) *HeuristicGuard {
+	return &HeuristicGuard{
+		MaxLinks:      DefaultMaxLinks,
+		BannedPhrases: bannedPhrases,
+		MaxPerWindow:  DefaultMaxPerWindow,
+		Window:        DefaultRateWindow,
+		Clock:         clock,
+	}
+}
+
+// IsSpam flags messages with too many links or a banned phrase, and reports
+// sent by an IP address over its rate. Flagged reports still count towards the rate.
+func (g *HeuristicGuard) IsSpam(feedback Feedback, submission Submission) (bool, error) {
+	tooFast := g.countSubmission(submission.IP)
+
+	if len(linkPattern.FindAllStringIndex(feedback.Message, -1)) > g.MaxLinks {
+		return true, nil
+	}
+
+	message := strings.ToLower(feedback.Message)
+	for _, phrase := range g.BannedPhrases {
+		if phrase = strings.ToLower(strings.TrimSpace(phrase)); phrase != "" && strings.Contains(message, phrase) {
+			return true, nil
+		}
+	}
+
+	return tooFast, nil
+}
+
+// countSubmission records a report from the IP address and reports whether it
+// goes over the rate. Times older than the window are dropped for every
+// address, so idle addresses do not accumulate.
+func (g *HeuristicGuard) countSubmission(ip string) bool {
+	ip = strings.TrimSpace(ip)
+	if g.MaxPerWindow <= 0 || ip == "" {
+		return false
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	now := g.Clock.Now()
+	cutoff := now.Add(-g.Window)
+	for addr, times := range g.recent {
+		kept := times[:0]
+		for _, t := range times {
+			if t.After(cutoff) {
+				kept = append(kept, t)
+			}
+		}
+		if len(kept) == 0 {
+			delete(g.recent, addr)
+		} else {
+			g.recent[addr] = kept
+		}
+	}
+
+	if g.recent == nil {
+		g.recent = make(map[string][]time.Time)
+	}
+	g.recent[ip] = append(g.recent[ip], now)
+
+	return len(g.recent[ip]) > g.MaxPerWindow
+}
@@ -0,0 +1,69 @@
+package feedback_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/feedback"
+)
+
+func TestHeuristicGuard_IsSpam(t *testing.T) {
+	clock := &stubClock{t: time.Date(2024, 3, 5, 9, 0, 0, 0, time.UTC)}
+	guard := feedback.NewHeuristicGuard(clock, "Casino", "buy followers")
+	browser := feedback.Submission{UserAgent: "Mozilla/5.0"}
+
+	tests := []struct {
+		name       string
+		message    string
+		submission feedback.Submission
+		want       bool
+	}{
+		{"plain report", "Il manque un accent sur « à » dans la deuxième phrase.", browser, false},
+		{"links within limit", "Voir https://fla.example/a1 et www.larousse.fr pour l'accord.", browser, false},
+		{"links with www count once", "Voir https://www.larousse.fr/accord et https://www.lalanguefrancaise.com/accord.", browser, false},
+		{"too many links", "http://a.example http://b.example https://c.example", browser, true},
+		{"banned phrase", "Great lesson! Best CASINO bonus here.", browser, true},
+		{"no user agent", "Il manque un accent sur « à ».", feedback.Submission{IP: "203.0.113.7"}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := guard.IsSpam(feedback.Feedback{Message: tt.message}, tt.submission)
+
+			assertNoError(t, err)
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHeuristicGuard_Rate(t *testing.T) {
+	clock := &stubClock{t: time.Date(2024, 3, 5, 9, 0, 0, 0, time.UTC)}
+	guard := feedback.NewHeuristicGuard(clock)
+	report := feedback.Feedback{Message: "Il manque un accent sur « à »."}
+	from := func(ip string) bool {
+		t.Helper()
+		spam, err := guard.IsSpam(report, feedback.Submission{IP: ip})
+		assertNoError(t, err)
+		return spam
+	}
+
+	for i := range feedback.DefaultMaxPerWindow {
+		if from("203.0.113.7") {
+			t.Fatalf("report %d flagged within the rate", i+1)
+		}
+	}
+
+	if !from("203.0.113.7") {
+		t.Error("expected report over the rate to be flagged")
+	}
+	if from("198.51.100.4") {
+		t.Error("expected other addresses to keep their own rate")
+	}
+
+	clock.t = clock.t.Add(feedback.DefaultRateWindow)
+	if from("203.0.113.7") {
+		t.Error("expected the rate to reset after the window")
+	}
+}
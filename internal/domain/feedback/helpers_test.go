@@ -0,0 +1,122 @@
+package feedback_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/feedback"
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/moderation"
+	"github.com/alnah/fla/internal/domain/post"
+	"github.com/alnah/fla/internal/domain/shared"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+type stubClock struct {
+	t time.Time
+}
+
+func (s *stubClock) Now() time.Time { return s.t }
+
+func editor() user.User {
+	return user.User{ID: "editor-1", Roles: []user.Role{user.RoleEditor}}
+}
+
+func author() user.User {
+	return user.User{ID: "author-1", Roles: []user.Role{user.RoleAuthor}}
+}
+
+type stubRepository struct {
+	byID map[kernel.ID[feedback.Feedback]]feedback.Feedback
+}
+
+func newStubRepository() *stubRepository {
+	return &stubRepository{byID: make(map[kernel.ID[feedback.Feedback]]feedback.Feedback)}
+}
+
+func (r *stubRepository) GetByID(id kernel.ID[feedback.Feedback]) (*feedback.Feedback, error) {
+	if f, ok := r.byID[id]; ok {
+		return &f, nil
+	}
+	return nil, &kernel.Error{Code: kernel.ENotFound, Message: "feedback not found"}
+}
+
+func (r *stubRepository) Create(f feedback.Feedback) error {
+	r.byID[f.FeedbackID] = f
+	return nil
+}
+
+func (r *stubRepository) Update(f feedback.Feedback) error {
+	r.byID[f.FeedbackID] = f
+	return nil
+}
+
+func (r *stubRepository) GetByStatus(status feedback.Status, pagination shared.Pagination) (feedback.FeedbackList, error) {
+	var items []feedback.Feedback
+	for _, f := range r.byID {
+		if f.Status == status {
+			items = append(items, f)
+		}
+	}
+	return feedback.FeedbackList{Items: items, Pagination: pagination}, nil
+}
+
+func (r *stubRepository) GetByPost(postID kernel.ID[post.Post]) ([]feedback.Feedback, error) {
+	var items []feedback.Feedback
+	for _, f := range r.byID {
+		if f.PostID != nil && *f.PostID == postID {
+			items = append(items, f)
+		}
+	}
+	return items, nil
+}
+
+type stubPosts map[kernel.ID[post.Post]]post.Post
+
+func (s stubPosts) GetByID(postID kernel.ID[post.Post]) (*post.Post, error) {
+	if p, ok := s[postID]; ok {
+		return &p, nil
+	}
+	return nil, &kernel.Error{Code: kernel.ENotFound, Message: "post not found"}
+}
+
+func (s stubPosts) GetBySlug(slug shared.Slug) (*post.Post, error) {
+	return nil, &kernel.Error{Code: kernel.ENotFound, Message: "post not found"}
+}
+
+type stubGuard struct {
+	spam bool
+	err  error
+}
+
+func (g stubGuard) IsSpam(feedback.Feedback, feedback.Submission) (bool, error) { return g.spam, g.err }
+
+// stubRecords keeps the content policy audit trail.
+type stubRecords []moderation.DecisionRecord
+
+func (r *stubRecords) SaveDecisionRecord(record moderation.DecisionRecord) error {
+	*r = append(*r, record)
+	return nil
+}
+
+func assertNoError(t *testing.T, err error) {
+	t.Helper()
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+}
+
+func assertError(t *testing.T, err error) {
+	t.Helper()
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+}
+
+func assertErrorCode(t *testing.T, err error, want string) {
+	t.Helper()
+	got := kernel.ErrorCode(err)
+	if got != want {
+		t.Errorf("error code: got %q, want %q", got, want)
+	}
+}
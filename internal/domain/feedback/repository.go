@@ -0,0 +1,46 @@
+package feedback
+
+import (
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/post"
+	"github.com/alnah/fla/internal/domain/shared"
+)
+
+// FeedbackList is one page of feedback for the triage queue.
+type FeedbackList struct {
+	Items      []Feedback
+	Pagination shared.Pagination
+}
+
+// FeedbackReader retrieves individual reports.
+type FeedbackReader interface {
+	// GetByID returns a report for triage. Returns ENotFound when missing.
+	GetByID(feedbackID kernel.ID[Feedback]) (*Feedback, error)
+}
+
+// FeedbackWriter persists reports.
+type FeedbackWriter interface {
+	// Create stores a newly submitted report.
+	Create(feedback Feedback) error
+
+	// Update saves triage changes.
+	Update(feedback Feedback) error
+}
+
+// FeedbackLister browses reports for editors.
+// Used by the triage queue and the feedback panel of the post editor.
+type FeedbackLister interface {
+	// GetByStatus lists reports in a status, oldest first so nothing waits forever.
+	GetByStatus(status Status, pagination shared.Pagination) (FeedbackList, error)
+
+	// GetByPost lists every report about a lesson, newest first.
+	GetByPost(postID kernel.ID[post.Post]) ([]Feedback, error)
+}
+
+// Repository combines all feedback operations.
+// Most concrete implementations (like PostgresFeedbackRepository) will implement this.
+type Repository interface {
+	FeedbackReader
+	FeedbackWriter
+	FeedbackLister
+}
@@ -0,0 +1,176 @@
+package feedback
+
+import (
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/moderation"
+	"github.com/alnah/fla/internal/domain/post"
+	"github.com/alnah/fla/internal/domain/shared"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+const (
+	MFeedbackSpam          string = "Feedback was rejected."
+	MFeedbackPostNotPublic string = "Feedback can only be left on published lessons."
+)
+
+// Submission carries request details spam guards may inspect alongside the feedback.
+// The form layer fills it; none of it is stored with the feedback.
+type Submission struct {
+	IP        string
+	UserAgent string
+	Honeypot  string        // Hidden form field; humans leave it empty
+	Locale    shared.Locale // Language of the form; selects the content policy wordlist
+}
+
+// SpamGuard screens submissions before they are stored.
+// Implementations include rate limits, CAPTCHA verification, or a spam-scoring API.
+type SpamGuard interface {
+	// IsSpam reports whether the submission should be stored as spam.
+	IsSpam(feedback Feedback, submission Submission) (bool, error)
+}
+
+// ContentScreener checks text against the content policy.
+// Implemented by moderation.FilterService.
+type ContentScreener interface {
+	// Screen returns the verdict on the text; rejected text fails with EInvalid.
+	Screen(field moderation.Field, subjectID string, text string, locale shared.Locale) (moderation.Verdict, error)
+}
+
+// FeedbackService accepts learner feedback and moves it through triage.
+type FeedbackService struct {
+	repository Repository
+	posts      post.PostReader
+	content    ContentScreener
+	guards     []SpamGuard
+	clock      kernel.Clock
+}
+
+// NewFeedbackService creates feedback service; guards run in order on every submission.
+// A honeypot check always runs first, before any guard; the content policy runs last.
+func NewFeedbackService(
+	repository Repository,
+	posts post.PostReader,
+	content ContentScreener,
+	clock kernel.Clock,
+	guards ...SpamGuard,
+) *FeedbackService {
+	return &FeedbackService{
+		repository: repository,
+		posts:      posts,
+		content:    content,
+		guards:     guards,
+		clock:      clock,
+	}
+}
+
+// Submit validates, screens, and stores new feedback.
+// A filled honeypot is rejected with EForbidden and a deliberately vague
+// message; feedback a guard flags is stored as spam so editors can rescue it.
+// Text the content policy rejects fails with EInvalid, and text it flags is
+// stored flagged for review.
+func (s *FeedbackService) Submit(p NewFeedbackParams, submission Submission) (Feedback, error) {
+	const op = "FeedbackService.Submit"
+
+	p.Clock = s.clock
+	feedback, err := NewFeedback(p)
+	if err != nil {
+		return Feedback{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if feedback.PostID != nil {
+		lesson, err := s.posts.GetByID(*feedback.PostID)
+		if err != nil {
+			return Feedback{}, &kernel.Error{Operation: op, Cause: err}
+		}
+		if !lesson.IsPublished() {
+			return Feedback{}, &kernel.Error{Code: kernel.EInvalid, Message: MFeedbackPostNotPublic, Operation: op}
+		}
+	}
+
+	spam, err := s.screen(feedback, submission)
+	if err != nil {
+		return Feedback{}, &kernel.Error{Operation: op, Cause: err}
+	}
+	if spam {
+		feedback.Status = StatusSpam
+	}
+
+	verdict, err := s.content.Screen(moderation.FieldFeedback, feedback.FeedbackID.String(), feedback.Message, submission.Locale)
+	if err != nil {
+		return Feedback{}, &kernel.Error{Operation: op, Cause: err}
+	}
+	feedback.Flagged = verdict.Decision == moderation.DecisionFlag
+
+	if err := s.repository.Create(feedback); err != nil {
+		return Feedback{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return feedback, nil
+}
+
+// Triage acknowledges a report on behalf of an editor.
+func (s *FeedbackService) Triage(feedbackID kernel.ID[Feedback], actor user.PostPermissionChecker) (Feedback, error) {
+	const op = "FeedbackService.Triage"
+
+	updated, err := s.update(feedbackID, func(f Feedback) (Feedback, error) { return f.Triage(actor) })
+	if err != nil {
+		return Feedback{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return updated, nil
+}
+
+// Resolve closes a report on behalf of an editor.
+func (s *FeedbackService) Resolve(feedbackID kernel.ID[Feedback], actor user.PostPermissionChecker) (Feedback, error) {
+	const op = "FeedbackService.Resolve"
+
+	updated, err := s.update(feedbackID, func(f Feedback) (Feedback, error) { return f.Resolve(actor) })
+	if err != nil {
+		return Feedback{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return updated, nil
+}
+
+// update loads a report, applies a change, and saves it.
+func (s *FeedbackService) update(feedbackID kernel.ID[Feedback], change func(Feedback) (Feedback, error)) (Feedback, error) {
+	const op = "FeedbackService.update"
+
+	current, err := s.repository.GetByID(feedbackID)
+	if err != nil {
+		return Feedback{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	current.Clock = s.clock
+	updated, err := change(*current)
+	if err != nil {
+		return Feedback{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if err := s.repository.Update(updated); err != nil {
+		return Feedback{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return updated, nil
+}
+
+// screen rejects filled honeypots, then asks each guard in turn until one flags spam.
+func (s *FeedbackService) screen(feedback Feedback, submission Submission) (bool, error) {
+	const op = "FeedbackService.screen"
+
+	if submission.Honeypot != "" {
+		return false, &kernel.Error{Code: kernel.EForbidden, Message: MFeedbackSpam, Operation: op}
+	}
+
+	for _, guard := range s.guards {
+		spam, err := guard.IsSpam(feedback, submission)
+		if err != nil {
+			return false, &kernel.Error{Operation: op, Cause: err}
+		}
+		if spam {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
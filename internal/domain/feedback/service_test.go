@@ -0,0 +1,143 @@
+package feedback_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/feedback"
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/moderation"
+	"github.com/alnah/fla/internal/domain/post"
+	"github.com/alnah/fla/internal/domain/shared"
+)
+
+func newTestService(guards ...feedback.SpamGuard) (*feedback.FeedbackService, *stubRepository) {
+	service, repo, _ := newModeratedService(guards...)
+	return service, repo
+}
+
+func newModeratedService(guards ...feedback.SpamGuard) (*feedback.FeedbackService, *stubRepository, *stubRecords) {
+	repo := newStubRepository()
+	posts := stubPosts{
+		"lesson": {PostID: "lesson", Status: post.StatusPublished},
+		"draft":  {PostID: "draft", Status: post.StatusDraft},
+	}
+	clock := &stubClock{t: time.Date(2024, 3, 5, 9, 0, 0, 0, time.UTC)}
+	filter, _ := moderation.NewContentFilter(moderation.Wordlist{
+		Locale: shared.LocaleFrenchFR,
+		Terms: []moderation.Term{
+			{Phrase: "connard", Severity: moderation.SeverityReject},
+			{Phrase: "nul", Severity: moderation.SeverityFlag},
+		},
+	})
+	records := &stubRecords{}
+	content := moderation.NewFilterService(filter, records, clock)
+	return feedback.NewFeedbackService(repo, posts, content, clock, guards...), repo, records
+}
+
+func TestFeedbackService_Submit(t *testing.T) {
+	t.Run("stores feedback on a published lesson", func(t *testing.T) {
+		service, repo := newTestService(stubGuard{})
+		params := validParams()
+		lesson := kernel.ID[post.Post]("lesson")
+		params.PostID = &lesson
+
+		got, err := service.Submit(params, feedback.Submission{IP: "203.0.113.7"})
+
+		assertNoError(t, err)
+		if _, ok := repo.byID[got.FeedbackID]; !ok {
+			t.Error("expected feedback to be stored")
+		}
+	})
+
+	tests := []struct {
+		name       string
+		guard      stubGuard
+		postID     kernel.ID[post.Post]
+		submission feedback.Submission
+		code       string
+	}{
+		{"honeypot filled", stubGuard{}, "", feedback.Submission{Honeypot: "http://spam.example"}, kernel.EForbidden},
+		{"unpublished lesson", stubGuard{}, "draft", feedback.Submission{}, kernel.EInvalid},
+		{"unknown lesson", stubGuard{}, "ghost", feedback.Submission{}, kernel.ENotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, repo := newTestService(tt.guard)
+			params := validParams()
+			if tt.postID != "" {
+				params.PostID = &tt.postID
+			}
+
+			_, err := service.Submit(params, tt.submission)
+
+			assertErrorCode(t, err, tt.code)
+			if len(repo.byID) != 0 {
+				t.Error("expected nothing stored")
+			}
+		})
+	}
+
+	t.Run("stores spam out of the triage queue", func(t *testing.T) {
+		service, repo := newTestService(stubGuard{spam: true})
+
+		got, err := service.Submit(validParams(), feedback.Submission{})
+
+		assertNoError(t, err)
+		if got.Status != feedback.StatusSpam || repo.byID[got.FeedbackID].Status != feedback.StatusSpam {
+			t.Errorf("got status %s", got.Status)
+		}
+	})
+
+	t.Run("applies the content policy", func(t *testing.T) {
+		service, repo, records := newModeratedService()
+		french := feedback.Submission{Locale: shared.LocaleFrenchFR}
+
+		params := validParams()
+		params.Message = "Cet exercice est NUL, la réponse est fausse."
+		flagged, err := service.Submit(params, french)
+		assertNoError(t, err)
+		if !flagged.Flagged || !repo.byID[flagged.FeedbackID].Flagged {
+			t.Error("expected feedback stored flagged")
+		}
+
+		params = validParams()
+		params.FeedbackID = "fb-2"
+		params.Message = "L'auteur est un connard."
+		_, err = service.Submit(params, french)
+		assertErrorCode(t, err, kernel.EInvalid)
+		if _, ok := repo.byID["fb-2"]; ok {
+			t.Error("expected rejected feedback not stored")
+		}
+
+		if len(*records) != 2 || (*records)[1].Decision != moderation.DecisionReject || (*records)[1].SubjectID != "fb-2" {
+			t.Errorf("audit: got %v", *records)
+		}
+	})
+
+	t.Run("propagates guard failures", func(t *testing.T) {
+		service, _ := newTestService(stubGuard{err: errors.New("scoring API down")})
+
+		_, err := service.Submit(validParams(), feedback.Submission{})
+
+		assertError(t, err)
+	})
+}
+
+func TestFeedbackService_Triage(t *testing.T) {
+	service, repo := newTestService()
+	submitted, _ := service.Submit(validParams(), feedback.Submission{})
+
+	_, err := service.Triage(submitted.FeedbackID, editor())
+
+	assertNoError(t, err)
+	if repo.byID[submitted.FeedbackID].Status != feedback.StatusTriaged {
+		t.Errorf("got status %s", repo.byID[submitted.FeedbackID].Status)
+	}
+
+	_, err = service.Resolve(submitted.FeedbackID, author())
+
+	assertErrorCode(t, err, kernel.EForbidden)
+}
@@ -0,0 +1,73 @@
+package feedback
+
+import (
+	"slices"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+)
+
+const (
+	MStatusInvalid   string = "Invalid feedback status."
+	MCategoryInvalid string = "Invalid feedback category."
+)
+
+// Status tracks feedback through editorial triage.
+type Status string
+
+const (
+	StatusNew      Status = "new"      // Submitted, not yet looked at
+	StatusTriaged  Status = "triaged"  // Acknowledged and queued for a fix
+	StatusResolved Status = "resolved" // Fixed or dismissed
+	StatusSpam     Status = "spam"     // Flagged by a spam guard, kept out of the triage queue
+)
+
+// allowedTransitions lets editors reopen resolved feedback for another look,
+// and rescue spam that a guard flagged by mistake.
+var allowedTransitions = map[Status][]Status{
+	StatusNew:      {StatusTriaged, StatusResolved},
+	StatusTriaged:  {StatusResolved},
+	StatusResolved: {StatusTriaged},
+	StatusSpam:     {StatusTriaged, StatusResolved},
+}
+
+func (s Status) String() string { return string(s) }
+
+// Validate ensures status uses defined triage states.
+func (s Status) Validate() error {
+	const op = "Status.Validate"
+
+	switch s {
+	case StatusNew, StatusTriaged, StatusResolved, StatusSpam:
+		return nil
+	default:
+		return &kernel.Error{Code: kernel.EInvalid, Message: MStatusInvalid, Operation: op}
+	}
+}
+
+// CanTransitionTo checks if this status can move to the target status.
+func (s Status) CanTransitionTo(target Status) bool {
+	return slices.Contains(allowedTransitions[s], target)
+}
+
+// Category tells editors what kind of fix the learner is asking for.
+type Category string
+
+const (
+	CategoryTypo         Category = "typo"          // Spelling, accents, punctuation
+	CategoryContentError Category = "content_error" // Wrong explanation, translation, or answer
+	CategorySuggestion   Category = "suggestion"    // Ideas for new or better lessons
+)
+
+func (c Category) String() string { return string(c) }
+
+// Validate ensures the category is known.
+func (c Category) Validate() error {
+	const op = "Category.Validate"
+
+	switch c {
+	case CategoryTypo, CategoryContentError, CategorySuggestion:
+		return nil
+	default:
+		return &kernel.Error{Code: kernel.EInvalid, Message: MCategoryInvalid, Operation: op}
+	}
+}
@@ -0,0 +1,92 @@
+package glossary
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"strings"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/shared"
+)
+
+// ankiGUIDAlphabet is the base91 alphabet Anki encodes note GUIDs with.
+const ankiGUIDAlphabet = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789!#$%&()*+,-./:;<=>?@[]^_`{|}~"
+
+// AnkiDeckSeparator nests decks, e.g. "Français Facile::A1::Lecture".
+const AnkiDeckSeparator = "::"
+
+// Fields every note starts with; one definition field per locale follows.
+const (
+	FieldTerm          = "Term"
+	FieldPronunciation = "Pronunciation"
+	FieldExamples      = "Examples"
+)
+
+// Deck is an Anki-compatible deck, independent of the package format: notes
+// share the deck's field list, and field values are HTML, as Anki stores them.
+type Deck struct {
+	ID          int64  // Stable across exports, derived from what the deck covers
+	Name        string // Nested with AnkiDeckSeparator
+	Description string
+	Fields      []string // FieldTerm, FieldPronunciation, FieldExamples, then "Definition (en-US)"...
+	Notes       []Note
+}
+
+// Note is one term as an Anki note. Its GUID depends on the term alone, so
+// importing a new export updates the learner's cards, keeping their review
+// history, instead of adding duplicates.
+type Note struct {
+	GUID   string
+	TermID kernel.ID[Term]
+	Fields []string // Values in Deck.Fields order
+	Tags   []string // Sorted; Anki tags hold no spaces and nest with "::"
+}
+
+// String returns a string representation of the deck.
+func (d Deck) String() string {
+	return fmt.Sprintf("Deck{ID: %d, Name: %q, Notes: %d}", d.ID, d.Name, len(d.Notes))
+}
+
+// DeckWriter turns a deck into a file, e.g. an .apkg package.
+// Implemented by output adapters.
+type DeckWriter interface {
+	WriteDeck(deck Deck) error
+}
+
+// NoteGUID derives a term's note GUID: the first 64 bits of a SHA-256 of its
+// ID, in Anki's base91.
+func NoteGUID(termID kernel.ID[Term]) string {
+	sum := sha256.Sum256([]byte("fla:glossary:" + termID.String()))
+	n := binary.BigEndian.Uint64(sum[:8])
+
+	var b []byte
+	for n > 0 {
+		b = append(b, ankiGUIDAlphabet[n%uint64(len(ankiGUIDAlphabet))])
+		n /= uint64(len(ankiGUIDAlphabet))
+	}
+	for i, j := 0, len(b)-1; i < j; i, j = i+1, j-1 {
+		b[i], b[j] = b[j], b[i]
+	}
+	return string(b)
+}
+
+// DeckID derives a positive deck ID from a stable key, e.g. a category ID.
+func DeckID(key string) int64 {
+	sum := sha256.Sum256([]byte("fla:deck:" + key))
+	return int64(binary.BigEndian.Uint64(sum[:8]) >> 1)
+}
+
+// DeckFields lists the field names of a deck with definitions in locales.
+func DeckFields(locales []shared.Locale) []string {
+	fields := []string{FieldTerm, FieldPronunciation, FieldExamples}
+	for _, locale := range locales {
+		fields = append(fields, "Definition ("+locale.String()+")")
+	}
+	return fields
+}
+
+// AnkiTag turns a slug path into an Anki tag, e.g. "a1", "lecture" into "a1::lecture".
+func AnkiTag(parts ...string) string {
+	return strings.Join(parts, AnkiDeckSeparator)
+}
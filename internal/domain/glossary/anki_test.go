@@ -0,0 +1,136 @@
+package glossary_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/category"
+	"github.com/alnah/fla/internal/domain/glossary"
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/post"
+	"github.com/alnah/fla/internal/domain/shared"
+	"github.com/alnah/fla/internal/domain/tag"
+)
+
+func TestNoteGUID(t *testing.T) {
+	a := glossary.NoteGUID("t1")
+	if a == "" || a != glossary.NoteGUID("t1") {
+		t.Errorf("GUID must be stable: got %q", a)
+	}
+	if a == glossary.NoteGUID("t2") {
+		t.Errorf("GUIDs of different terms must differ: both %q", a)
+	}
+	if glossary.DeckID("category:a1") <= 0 {
+		t.Errorf("deck ID must be positive: got %d", glossary.DeckID("category:a1"))
+	}
+}
+
+func TestDeckServiceCompile(t *testing.T) {
+	now := time.Date(2024, 9, 2, 8, 0, 0, 0, time.UTC)
+	a1ID := kernel.ID[category.Category]("a1")
+	categories := &stubCategories{all: []category.Category{
+		{CategoryID: a1ID, Name: "A1", Slug: "a1", Description: "Premiers pas.", CreatedBy: "author-1"},
+		{CategoryID: "reading", Name: "Lecture", Slug: "lecture", ParentID: &a1ID, CreatedBy: "author-1"},
+	}}
+	tags := stubTags{"food": {TagID: "food", Name: "nourriture", Slug: "nourriture"}}
+
+	lesson := func(id, content string, categoryID kernel.ID[category.Category], tagIDs ...kernel.ID[tag.Tag]) post.Post {
+		c, _ := categories.GetByID(categoryID)
+		return post.Post{
+			PostID:    kernel.ID[post.Post](id),
+			Content:   post.PostContent(content),
+			Status:    post.StatusPublished,
+			Category:  *c,
+			Tags:      tagIDs,
+			CreatedAt: now,
+		}
+	}
+
+	pomme := term("t1", "pomme", "pommes")
+	pomme.Definitions[shared.LocaleFrenchFR] = "Fruit du pommier."
+	pomme.Examples = []string{"Je mange une pomme.", "Les <pommes> sont rouges."}
+	ipa, err := shared.NewPronunciation("pomme", "pɔm", "", shared.LocaleFrenchFR)
+	assertNoError(t, err)
+	pomme.Pronunciation = &ipa
+	eleve := term("t2", "élève")
+	unused := term("t3", "voiture")
+
+	posts := &stubPosts{posts: []post.Post{
+		lesson("p1", "L'élève lit.", "a1"),
+		lesson("p2", "Deux pommes et un élève.", "reading", "food"),
+		{PostID: "p3", Content: "Une voiture.", Status: post.StatusDraft, Category: categories.all[0]},
+	}}
+
+	site, err := shared.NewSite("Français Facile", "https://fla.example", shared.DefaultLocale)
+	assertNoError(t, err)
+	s, err := glossary.NewDeckService(stubTerms{terms: []glossary.Term{pomme, eleve, unused}}, posts, tags, categories, &stubDeckWriter{}, site)
+	assertNoError(t, err)
+
+	t.Run("collects terms in order of first appearance", func(t *testing.T) {
+		deck, err := s.Compile("a1", []shared.Locale{shared.LocaleEnglishUS, shared.LocaleFrenchFR})
+		assertNoError(t, err)
+
+		if deck.Name != "Français Facile::A1" || deck.Description != "Premiers pas." {
+			t.Errorf("deck: got %q, %q", deck.Name, deck.Description)
+		}
+		if deck.ID != glossary.DeckID("category:a1") {
+			t.Errorf("deck ID: got %d", deck.ID)
+		}
+		wantFields := "Term|Pronunciation|Examples|Definition (en-US)|Definition (fr-FR)"
+		if got := strings.Join(deck.Fields, "|"); got != wantFields {
+			t.Errorf("fields: got %q, want %q", got, wantFields)
+		}
+
+		if len(deck.Notes) != 2 || deck.Notes[0].TermID != "t2" || deck.Notes[1].TermID != "t1" {
+			t.Fatalf("notes: got %v", deck.Notes)
+		}
+
+		eleveNote := deck.Notes[0]
+		if got := strings.Join(eleveNote.Tags, " "); got != "a1 a1::lecture nourriture" {
+			t.Errorf("tags: got %q", got)
+		}
+		if eleveNote.Fields[4] != "" {
+			t.Errorf("missing definition must stay empty: got %q", eleveNote.Fields[4])
+		}
+
+		pommeNote := deck.Notes[1]
+		if pommeNote.GUID != glossary.NoteGUID("t1") {
+			t.Errorf("GUID: got %q", pommeNote.GUID)
+		}
+		if got := pommeNote.Fields[2]; got != "Je mange une pomme.<br>Les &lt;pommes&gt; sont rouges." {
+			t.Errorf("examples: got %q", got)
+		}
+		if pommeNote.Fields[1] != "pomme /pɔm/" || pommeNote.Fields[4] != "Fruit du pommier." {
+			t.Errorf("fields: got %q", pommeNote.Fields)
+		}
+	})
+
+	t.Run("re-exports keep the same GUIDs", func(t *testing.T) {
+		first, err := s.Compile("a1", nil)
+		assertNoError(t, err)
+		second, err := s.Compile("a1", nil)
+		assertNoError(t, err)
+
+		for i := range first.Notes {
+			if first.Notes[i].GUID != second.Notes[i].GUID {
+				t.Errorf("note %d: GUID changed from %q to %q", i, first.Notes[i].GUID, second.Notes[i].GUID)
+			}
+		}
+		if len(first.Fields) != 3+len(shared.SupportedLocales) {
+			t.Errorf("default locales: got fields %v", first.Fields)
+		}
+	})
+
+	t.Run("rejects unknown categories and duplicate locales", func(t *testing.T) {
+		_, err := s.Compile("c2", nil)
+		assertErrorCode(t, err, kernel.ENotFound)
+
+		_, err = s.Compile("a1", []shared.Locale{shared.LocaleFrenchFR, shared.LocaleFrenchFR})
+		assertErrorCode(t, err, kernel.EInvalid)
+	})
+
+	t.Run("export needs notes", func(t *testing.T) {
+		assertErrorCode(t, s.Export(glossary.Deck{}), kernel.EInvalid)
+	})
+}
@@ -0,0 +1,103 @@
+package glossary
+
+import (
+	"cmp"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+)
+
+// skippedMarkdown matches code and links, where a tooltip would break rendering.
+var skippedMarkdown = regexp.MustCompile("(?s)```.*?```|`[^`\n]+`|!?\\[[^\\]]*\\]\\([^)]*\\)")
+
+// Annotation marks where a glossary term appears in post content.
+// Start and End are byte offsets into the Markdown source, End exclusive.
+type Annotation struct {
+	TermID kernel.ID[Term]
+	Start  int
+	End    int
+	Text   string // Content as written, e.g. "Élèves"
+}
+
+// candidate is one form to look for, tied to its term.
+type candidate struct {
+	form   string
+	termID kernel.ID[Term]
+}
+
+// Annotate finds every whole-word occurrence of the terms in Markdown content.
+// Matching ignores case; the longest form wins, so "pomme de terre" beats "pomme".
+// Code spans, code blocks, links, and images are skipped. Annotations never overlap.
+func Annotate(content string, terms []Term) []Annotation {
+	var candidates []candidate
+	for _, t := range terms {
+		for _, form := range t.AllForms() {
+			if form != "" {
+				candidates = append(candidates, candidate{form: form, termID: t.TermID})
+			}
+		}
+	}
+	slices.SortStableFunc(candidates, func(a, b candidate) int { return cmp.Compare(len(b.form), len(a.form)) })
+
+	skipped := skippedMarkdown.FindAllStringIndex(content, -1)
+
+	var annotations []Annotation
+	for i := 0; i < len(content); {
+		if len(skipped) > 0 && i >= skipped[0][0] {
+			i = max(i, skipped[0][1])
+			skipped = skipped[1:]
+			continue
+		}
+
+		if startsWord(content, i) {
+			if c, end, ok := matchAt(content, i, candidates); ok && (len(skipped) == 0 || end <= skipped[0][0]) {
+				annotations = append(annotations, Annotation{TermID: c.termID, Start: i, End: end, Text: content[i:end]})
+				i = end
+				continue
+			}
+		}
+
+		_, size := utf8.DecodeRuneInString(content[i:])
+		i += size
+	}
+
+	return annotations
+}
+
+// matchAt returns the longest candidate found at i that ends on a word boundary.
+func matchAt(content string, i int, candidates []candidate) (candidate, int, bool) {
+	for _, c := range candidates {
+		end := i + len(c.form)
+		if end > len(content) || !strings.EqualFold(content[i:end], c.form) {
+			continue
+		}
+		if next, _ := utf8.DecodeRuneInString(content[end:]); end < len(content) && isWordRune(next) {
+			continue
+		}
+		return c, end, true
+	}
+	return candidate{}, 0, false
+}
+
+// startsWord reports whether position i begins a word.
+func startsWord(content string, i int) bool {
+	r, _ := utf8.DecodeRuneInString(content[i:])
+	if !isWordRune(r) {
+		return false
+	}
+	if i == 0 {
+		return true
+	}
+	prev, _ := utf8.DecodeLastRuneInString(content[:i])
+	return !isWordRune(prev)
+}
+
+// isWordRune treats letters, digits, and hyphens as part of a word,
+// so "porte" does not match inside "porte-monnaie". Apostrophes split words: "l'élève".
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '-'
+}
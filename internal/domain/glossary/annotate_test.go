@@ -0,0 +1,83 @@
+package glossary_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/alnah/fla/internal/domain/glossary"
+	"github.com/alnah/fla/internal/domain/post"
+)
+
+func TestAnnotate(t *testing.T) {
+	terms := []glossary.Term{
+		term("eleve", "élève", "élèves"),
+		term("pomme", "pomme"),
+		term("pomme-de-terre", "pomme de terre"),
+		term("porte", "porte"),
+	}
+
+	tests := []struct {
+		name    string
+		content string
+		want    []string // Annotated text, in order
+	}{
+		{"ignores case", "Les Élèves arrivent.", []string{"Élèves"}},
+		{"after apostrophe", "L'élève mange une pomme.", []string{"élève", "pomme"}},
+		{"longest form wins", "Une pomme de terre et une pomme.", []string{"pomme de terre", "pomme"}},
+		{"whole words only", "Les pommes et le porte-monnaie.", nil},
+		{"skips inline code", "Écrivez `élève` puis élève.", []string{"élève"}},
+		{"skips code blocks", "```\npomme\n```\npomme", []string{"pomme"}},
+		{"skips links", "[une pomme](/fruits/pomme) et une pomme", []string{"pomme"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := glossary.Annotate(tt.content, terms)
+
+			var texts []string
+			for _, a := range got {
+				if tt.content[a.Start:a.End] != a.Text {
+					t.Errorf("range %d:%d does not match text %q", a.Start, a.End, a.Text)
+				}
+				texts = append(texts, a.Text)
+			}
+			if len(texts) != len(tt.want) {
+				t.Fatalf("got %q, want %q", texts, tt.want)
+			}
+			for i := range texts {
+				if texts[i] != tt.want[i] {
+					t.Errorf("got %q, want %q", texts, tt.want)
+				}
+			}
+		})
+	}
+
+	t.Run("maps forms to their term", func(t *testing.T) {
+		got := glossary.Annotate("des élèves", terms)
+
+		if len(got) != 1 || got[0].TermID != "eleve" {
+			t.Errorf("got %+v", got)
+		}
+	})
+}
+
+func TestAnnotationService_Annotate(t *testing.T) {
+	t.Run("annotates post content", func(t *testing.T) {
+		service := glossary.NewAnnotationService(stubTerms{terms: []glossary.Term{term("pomme", "pomme")}})
+
+		got, err := service.Annotate(post.PostContent("Je mange une pomme."))
+
+		assertNoError(t, err)
+		if len(got) != 1 {
+			t.Errorf("got %d annotations, want 1", len(got))
+		}
+	})
+
+	t.Run("propagates repository errors", func(t *testing.T) {
+		service := glossary.NewAnnotationService(stubTerms{err: errors.New("db down")})
+
+		_, err := service.Annotate("Je mange une pomme.")
+
+		assertError(t, err)
+	})
+}
@@ -0,0 +1,218 @@
+package glossary
+
+import (
+	"html"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/alnah/fla/internal/domain/category"
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/post"
+	"github.com/alnah/fla/internal/domain/shared"
+	"github.com/alnah/fla/internal/domain/tag"
+)
+
+const (
+	MDeckCategoryNotFound string = "Category not found."
+	MDeckEmpty            string = "No glossary term appears in these lessons."
+	MDeckLocaleDuplicate  string = "Locale listed more than once."
+)
+
+// DeckService exports the glossary terms used by published lessons as Anki decks.
+type DeckService struct {
+	terms      TermReader
+	posts      post.PostFinder
+	tags       tag.TagReader
+	categories category.CategoryReader
+	writer     DeckWriter
+	site       shared.Site
+}
+
+// NewDeckService creates deck service with glossary, post, tag, and category
+// lookups, the output adapter, and the site that names the decks.
+func NewDeckService(
+	terms TermReader,
+	posts post.PostFinder,
+	tags tag.TagReader,
+	categories category.CategoryReader,
+	writer DeckWriter,
+	site shared.Site,
+) (*DeckService, error) {
+	const op = "NewDeckService"
+
+	if err := site.Validate(); err != nil {
+		return nil, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return &DeckService{terms: terms, posts: posts, tags: tags, categories: categories, writer: writer, site: site}, nil
+}
+
+// Compile builds the deck of a category and its subcategories: a note per
+// glossary term found in their published lessons, in order of first
+// appearance, oldest lesson first. Each note carries a definition field per
+// locale, empty where the term has no definition in it, and is tagged with
+// the category path and the tags of every lesson using the term. No locales
+// means all supported ones.
+func (s *DeckService) Compile(categoryID kernel.ID[category.Category], locales []shared.Locale) (Deck, error) {
+	const op = "DeckService.Compile"
+
+	if len(locales) == 0 {
+		locales = shared.SupportedLocales
+	}
+	for i, locale := range locales {
+		if err := locale.Validate(); err != nil {
+			return Deck{}, &kernel.Error{Operation: op, Cause: err}
+		}
+		if slices.Contains(locales[:i], locale) {
+			return Deck{}, &kernel.Error{Code: kernel.EInvalid, Message: MDeckLocaleDuplicate, Operation: op}
+		}
+	}
+
+	tree, err := category.LoadCategoryTree(s.categories)
+	if err != nil {
+		return Deck{}, &kernel.Error{Operation: op, Cause: err}
+	}
+	root, ok := tree.Path(categoryID)
+	if !ok {
+		return Deck{}, &kernel.Error{Code: kernel.ENotFound, Message: MDeckCategoryNotFound, Operation: op}
+	}
+
+	terms, err := s.terms.GetAll()
+	if err != nil {
+		return Deck{}, &kernel.Error{Operation: op, Cause: err}
+	}
+	byID := make(map[kernel.ID[Term]]Term, len(terms))
+	for _, t := range terms {
+		byID[t.TermID] = t
+	}
+
+	posts, err := s.publishedPosts(categoryID)
+	if err != nil {
+		return Deck{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	var order []kernel.ID[Term]
+	tags := make(map[kernel.ID[Term]]map[string]bool)
+	for _, p := range posts {
+		postTags, err := s.postTags(p, tree)
+		if err != nil {
+			return Deck{}, &kernel.Error{Operation: op, Cause: err}
+		}
+
+		for _, a := range Annotate(p.Content.String(), terms) {
+			if tags[a.TermID] == nil {
+				tags[a.TermID] = make(map[string]bool)
+				order = append(order, a.TermID)
+			}
+			for _, t := range postTags {
+				tags[a.TermID][t] = true
+			}
+		}
+	}
+
+	if len(order) == 0 {
+		return Deck{}, &kernel.Error{Code: kernel.ENotFound, Message: MDeckEmpty, Operation: op}
+	}
+
+	names := []string{s.site.Name}
+	for _, c := range root {
+		names = append(names, c.Name.String())
+	}
+	deck := Deck{
+		ID:          DeckID("category:" + categoryID.String()),
+		Name:        strings.Join(names, AnkiDeckSeparator),
+		Description: html.EscapeString(root[len(root)-1].Description.String()),
+		Fields:      DeckFields(locales),
+	}
+	for _, id := range order {
+		deck.Notes = append(deck.Notes, Note{
+			GUID:   NoteGUID(id),
+			TermID: id,
+			Fields: noteFields(byID[id], locales),
+			Tags:   slices.Sorted(maps.Keys(tags[id])),
+		})
+	}
+
+	return deck, nil
+}
+
+// Export hands a compiled deck to the output adapter.
+func (s *DeckService) Export(deck Deck) error {
+	const op = "DeckService.Export"
+
+	if len(deck.Notes) == 0 {
+		return &kernel.Error{Code: kernel.EInvalid, Message: MDeckEmpty, Operation: op}
+	}
+
+	if err := s.writer.WriteDeck(deck); err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+	return nil
+}
+
+// publishedPosts pages through the published posts of a category subtree,
+// oldest first.
+func (s *DeckService) publishedPosts(categoryID kernel.ID[category.Category]) ([]post.Post, error) {
+	const op = "DeckService.publishedPosts"
+
+	query := post.PublishedQuery().InCategory(categoryID).SortBy(post.SortOldest...)
+	var posts []post.Post
+	for page := 1; ; page++ {
+		list, err := s.posts.Find(query.Page(page, shared.MaxPageLimit))
+		if err != nil {
+			return nil, &kernel.Error{Operation: op, Cause: err}
+		}
+		posts = append(posts, list.Posts...)
+		if list.IsEmpty() || !list.Pagination.HasNextPage() {
+			return posts, nil
+		}
+	}
+}
+
+// postTags returns the Anki tags of a lesson: its category path, e.g.
+// "a1::lecture", and its tag slugs. Tags deleted since are skipped.
+func (s *DeckService) postTags(p post.Post, tree category.CategoryTree) ([]string, error) {
+	const op = "DeckService.postTags"
+
+	var out []string
+	if path, ok := tree.Path(p.Category.CategoryID); ok {
+		slugs := make([]string, len(path))
+		for i, c := range path {
+			slugs[i] = c.Slug.String()
+		}
+		out = append(out, AnkiTag(slugs...))
+	}
+
+	for _, id := range p.Tags {
+		t, err := s.tags.GetByID(id)
+		if kernel.ErrorCode(err) == kernel.ENotFound {
+			continue
+		}
+		if err != nil {
+			return nil, &kernel.Error{Operation: op, Cause: err}
+		}
+		out = append(out, t.Slug.String())
+	}
+
+	return out, nil
+}
+
+// noteFields renders a term's values in DeckFields order, escaped for Anki's HTML fields.
+func noteFields(t Term, locales []shared.Locale) []string {
+	pronunciation := ""
+	if t.Pronunciation != nil {
+		pronunciation = html.EscapeString(t.Pronunciation.String())
+	}
+
+	examples := make([]string, len(t.Examples))
+	for i, e := range t.Examples {
+		examples[i] = html.EscapeString(e)
+	}
+
+	fields := []string{html.EscapeString(t.Headword), pronunciation, strings.Join(examples, "<br>")}
+	for _, locale := range locales {
+		fields = append(fields, html.EscapeString(t.Definitions[locale]))
+	}
+	return fields
+}
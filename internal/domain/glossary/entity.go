@@ -0,0 +1,226 @@
+// Package glossary defines recurring French terms with learner-facing definitions.
+package glossary
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/shared"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+const (
+	MaxTermLength       int = 100
+	MaxDefinitionLength int = 1000
+	MaxExampleLength    int = 300
+	MaxExamples         int = 10
+
+	MTermDefinitionMissing string = "A term needs at least one definition."
+	MTermTooManyExamples   string = "A term can have at most %d examples."
+	MTermFormDuplicate     string = "Form listed more than once: %s."
+	MTermPronunciationTerm string = "Pronunciation is for %q, which is not a form of the term."
+)
+
+// Term is a glossary entry: a French word or expression explained in the learner's language.
+// Forms list inflections (plural, feminine, conjugated) matched in lessons alongside the headword.
+type Term struct {
+	// Identity
+	TermID kernel.ID[Term]
+
+	// Data
+	Headword    string                   // Form shown in the glossary, e.g. "élève"
+	Forms       []string                 // Other forms to link, e.g. "élèves"
+	Definitions map[shared.Locale]string // Keyed by the reader's interface locale
+	Examples    []string                 // French sentences using the term
+
+	Pronunciation *shared.Pronunciation // Optional: how the headword or one of its forms sounds
+
+	// Meta
+	CreatedBy kernel.ID[user.User]
+	CreatedAt time.Time
+	UpdatedAt time.Time
+
+	// DI
+	Clock kernel.Clock
+}
+
+// NewTermParams holds the parameters needed to create a term.
+type NewTermParams struct {
+	// Required
+	TermID      kernel.ID[Term]
+	Headword    string
+	Definitions map[shared.Locale]string
+	CreatedBy   kernel.ID[user.User]
+
+	// Optional
+	Forms         []string
+	Examples      []string
+	Pronunciation *shared.Pronunciation
+
+	// DI
+	Clock kernel.Clock
+}
+
+// NewTerm creates a validated glossary term.
+func NewTerm(p NewTermParams) (Term, error) {
+	const op = "NewTerm"
+
+	now := p.Clock.Now()
+
+	term := Term{
+		TermID:        p.TermID,
+		Headword:      strings.TrimSpace(p.Headword),
+		Forms:         trimAll(p.Forms),
+		Definitions:   maps.Clone(p.Definitions),
+		Examples:      trimAll(p.Examples),
+		Pronunciation: p.Pronunciation,
+		CreatedBy:     p.CreatedBy,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+		Clock:         p.Clock,
+	}
+
+	if err := term.Validate(); err != nil {
+		return Term{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return term, nil
+}
+
+// Validate performs validation on the term.
+func (t Term) Validate() error {
+	const op = "Term.Validate"
+
+	if err := t.TermID.Validate(); err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if err := kernel.ValidateLength("term", t.Headword, 1, MaxTermLength, op); err != nil {
+		return err
+	}
+
+	seen := map[string]bool{strings.ToLower(t.Headword): true}
+	for _, form := range t.Forms {
+		if err := kernel.ValidateLength("term form", form, 1, MaxTermLength, op); err != nil {
+			return err
+		}
+		if seen[strings.ToLower(form)] {
+			return &kernel.Error{Code: kernel.EInvalid, Message: fmt.Sprintf(MTermFormDuplicate, form), Operation: op}
+		}
+		seen[strings.ToLower(form)] = true
+	}
+
+	if len(t.Definitions) == 0 {
+		return &kernel.Error{Code: kernel.EInvalid, Message: MTermDefinitionMissing, Operation: op}
+	}
+	for locale, definition := range t.Definitions {
+		if err := locale.Validate(); err != nil {
+			return &kernel.Error{Operation: op, Cause: err}
+		}
+		if err := kernel.ValidateLength("definition", definition, 1, MaxDefinitionLength, op); err != nil {
+			return err
+		}
+	}
+
+	if len(t.Examples) > MaxExamples {
+		return &kernel.Error{Code: kernel.EInvalid, Message: fmt.Sprintf(MTermTooManyExamples, MaxExamples), Operation: op}
+	}
+	for _, example := range t.Examples {
+		if err := kernel.ValidateLength("example", example, 1, MaxExampleLength, op); err != nil {
+			return err
+		}
+	}
+
+	if t.Pronunciation != nil {
+		if err := t.Pronunciation.Validate(); err != nil {
+			return &kernel.Error{Operation: op, Cause: err}
+		}
+		if !seen[strings.ToLower(t.Pronunciation.Term)] {
+			return &kernel.Error{Code: kernel.EInvalid, Message: fmt.Sprintf(MTermPronunciationTerm, t.Pronunciation.Term), Operation: op}
+		}
+	}
+
+	if err := t.CreatedBy.Validate(); err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return nil
+}
+
+// AllForms returns the headword followed by its other forms.
+func (t Term) AllForms() []string {
+	return append([]string{t.Headword}, t.Forms...)
+}
+
+// Definition returns the definition for a locale, falling back to the default locale.
+func (t Term) Definition(locale shared.Locale) (string, bool) {
+	if d, ok := t.Definitions[locale]; ok {
+		return d, true
+	}
+	d, ok := t.Definitions[shared.DefaultLocale]
+	return d, ok
+}
+
+// SetDefinition adds or replaces the definition for one locale.
+func (t Term) SetDefinition(locale shared.Locale, definition string) (Term, error) {
+	const op = "Term.SetDefinition"
+
+	updated := t
+	updated.Definitions = maps.Clone(t.Definitions)
+	if updated.Definitions == nil {
+		updated.Definitions = make(map[shared.Locale]string)
+	}
+	updated.Definitions[locale] = strings.TrimSpace(definition)
+	updated.UpdatedAt = t.Clock.Now()
+
+	if err := updated.Validate(); err != nil {
+		return t, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return updated, nil
+}
+
+// AddExample appends an example sentence.
+func (t Term) AddExample(sentence string) (Term, error) {
+	const op = "Term.AddExample"
+
+	updated := t
+	updated.Examples = append(slices.Clone(t.Examples), strings.TrimSpace(sentence))
+	updated.UpdatedAt = t.Clock.Now()
+
+	if err := updated.Validate(); err != nil {
+		return t, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return updated, nil
+}
+
+// SetPronunciation adds or replaces the term's pronunciation; nil removes it.
+func (t Term) SetPronunciation(pronunciation *shared.Pronunciation) (Term, error) {
+	const op = "Term.SetPronunciation"
+
+	updated := t
+	updated.Pronunciation = pronunciation
+	updated.UpdatedAt = t.Clock.Now()
+
+	if err := updated.Validate(); err != nil {
+		return t, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return updated, nil
+}
+
+func trimAll(values []string) []string {
+	if values == nil {
+		return nil
+	}
+	trimmed := make([]string, len(values))
+	for i, v := range values {
+		trimmed[i] = strings.TrimSpace(v)
+	}
+	return trimmed
+}
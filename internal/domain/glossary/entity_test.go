@@ -0,0 +1,125 @@
+package glossary_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/glossary"
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/shared"
+)
+
+func validTermParams() glossary.NewTermParams {
+	return glossary.NewTermParams{
+		TermID:      "eleve",
+		Headword:    " élève ",
+		Forms:       []string{"élèves"},
+		Definitions: map[shared.Locale]string{shared.LocaleEnglishUS: "student, pupil"},
+		Examples:    []string{"L'élève lit un livre."},
+		CreatedBy:   "editor-1",
+		Clock:       &stubClock{t: time.Date(2024, 3, 5, 9, 0, 0, 0, time.UTC)},
+	}
+}
+
+func TestNewTerm(t *testing.T) {
+	t.Run("trims headword", func(t *testing.T) {
+		got, err := glossary.NewTerm(validTermParams())
+
+		assertNoError(t, err)
+		if got.Headword != "élève" {
+			t.Errorf("got %q", got.Headword)
+		}
+	})
+
+	tests := []struct {
+		name   string
+		modify func(*glossary.NewTermParams)
+	}{
+		{"missing headword", func(p *glossary.NewTermParams) { p.Headword = " " }},
+		{"no definition", func(p *glossary.NewTermParams) { p.Definitions = nil }},
+		{"unsupported locale", func(p *glossary.NewTermParams) { p.Definitions = map[shared.Locale]string{"xx-XX": "?"} }},
+		{"form repeats headword", func(p *glossary.NewTermParams) { p.Forms = []string{"Élève"} }},
+		{"example too long", func(p *glossary.NewTermParams) {
+			p.Examples = []string{strings.Repeat("a", glossary.MaxExampleLength+1)}
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params := validTermParams()
+			tt.modify(&params)
+
+			_, err := glossary.NewTerm(params)
+
+			assertErrorCode(t, err, kernel.EInvalid)
+		})
+	}
+}
+
+func TestTerm_Definition(t *testing.T) {
+	term, _ := glossary.NewTerm(validTermParams())
+	term, err := term.SetDefinition(shared.LocalePortugueseBR, "aluno")
+	assertNoError(t, err)
+
+	tests := []struct {
+		locale shared.Locale
+		want   string
+	}{
+		{shared.LocalePortugueseBR, "aluno"},
+		{shared.LocaleFrenchFR, "student, pupil"}, // Falls back to the default locale
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.locale.String(), func(t *testing.T) {
+			got, ok := term.Definition(tt.locale)
+
+			if !ok || got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTerm_AddExample(t *testing.T) {
+	term, _ := glossary.NewTerm(validTermParams())
+
+	updated, err := term.AddExample("Les élèves sont en classe.")
+
+	assertNoError(t, err)
+	if len(updated.Examples) != 2 || len(term.Examples) != 1 {
+		t.Errorf("got %d examples, original has %d", len(updated.Examples), len(term.Examples))
+	}
+
+	for range glossary.MaxExamples {
+		updated, _ = updated.AddExample("Encore un exemple.")
+	}
+	_, err = updated.AddExample("Un de trop.")
+
+	assertErrorCode(t, err, kernel.EInvalid)
+}
+
+func TestTerm_SetPronunciation(t *testing.T) {
+	term, _ := glossary.NewTerm(validTermParams())
+
+	t.Run("accepts a pronunciation of one of the forms", func(t *testing.T) {
+		pronunciation, err := shared.NewPronunciation("élèves", "/e.lɛv/", "", shared.LocaleFrenchFR)
+		assertNoError(t, err)
+
+		updated, err := term.SetPronunciation(&pronunciation)
+
+		assertNoError(t, err)
+		if updated.Pronunciation == nil || updated.Pronunciation.IPA != "e.lɛv" {
+			t.Errorf("got %v", updated.Pronunciation)
+		}
+	})
+
+	t.Run("rejects a pronunciation of another word", func(t *testing.T) {
+		pronunciation, err := shared.NewPronunciation("école", "e.kɔl", "", shared.LocaleFrenchFR)
+		assertNoError(t, err)
+
+		_, err = term.SetPronunciation(&pronunciation)
+
+		assertErrorCode(t, err, kernel.EInvalid)
+	})
+}
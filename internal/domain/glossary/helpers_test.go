@@ -0,0 +1,120 @@
+package glossary_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/category"
+	"github.com/alnah/fla/internal/domain/glossary"
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/post"
+	"github.com/alnah/fla/internal/domain/shared"
+	"github.com/alnah/fla/internal/domain/tag"
+)
+
+type stubClock struct {
+	t time.Time
+}
+
+func (s *stubClock) Now() time.Time { return s.t }
+
+type stubTerms struct {
+	terms []glossary.Term
+	err   error
+}
+
+func (s stubTerms) GetByID(id kernel.ID[glossary.Term]) (*glossary.Term, error) {
+	for _, t := range s.terms {
+		if t.TermID == id {
+			return &t, nil
+		}
+	}
+	return nil, &kernel.Error{Code: kernel.ENotFound, Message: "term not found"}
+}
+
+func (s stubTerms) GetAll() ([]glossary.Term, error) { return s.terms, s.err }
+
+// stubPosts returns its posts in the order given, published ones only.
+type stubPosts struct {
+	posts []post.Post
+}
+
+func (s *stubPosts) Find(q post.Query) (post.PostsList, error) {
+	var out []post.Post
+	for _, p := range s.posts {
+		if p.IsPublished() {
+			out = append(out, p)
+		}
+	}
+	return post.NewPostsList(out, shared.Pagination{Page: 1, Limit: q.Pagination.Limit, TotalItems: len(out), TotalPages: 1}), nil
+}
+
+type stubTags map[kernel.ID[tag.Tag]]tag.Tag
+
+func (s stubTags) GetByID(id kernel.ID[tag.Tag]) (*tag.Tag, error) {
+	if t, ok := s[id]; ok {
+		return &t, nil
+	}
+	return nil, &kernel.Error{Code: kernel.ENotFound, Message: "tag not found"}
+}
+
+func (s stubTags) GetBySlug(shared.Slug) (*tag.Tag, error) {
+	return nil, &kernel.Error{Code: kernel.ENotFound, Message: "tag not found"}
+}
+
+func (s stubTags) GetAll() ([]tag.Tag, error) { return nil, nil }
+
+type stubCategories struct {
+	all []category.Category
+}
+
+func (s *stubCategories) GetByID(id kernel.ID[category.Category]) (*category.Category, error) {
+	for _, c := range s.all {
+		if c.CategoryID == id {
+			return &c, nil
+		}
+	}
+	return nil, &kernel.Error{Code: kernel.ENotFound, Message: "category not found"}
+}
+
+func (s *stubCategories) GetAll() ([]category.Category, error) { return s.all, nil }
+
+type stubDeckWriter struct {
+	decks []glossary.Deck
+}
+
+func (s *stubDeckWriter) WriteDeck(d glossary.Deck) error {
+	s.decks = append(s.decks, d)
+	return nil
+}
+
+func term(id, headword string, forms ...string) glossary.Term {
+	return glossary.Term{
+		TermID:      kernel.ID[glossary.Term](id),
+		Headword:    headword,
+		Forms:       forms,
+		Definitions: map[shared.Locale]string{shared.LocaleEnglishUS: "definition of " + headword},
+	}
+}
+
+func assertNoError(t *testing.T, err error) {
+	t.Helper()
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+}
+
+func assertError(t *testing.T, err error) {
+	t.Helper()
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+}
+
+func assertErrorCode(t *testing.T, err error, want string) {
+	t.Helper()
+	got := kernel.ErrorCode(err)
+	if got != want {
+		t.Errorf("error code: got %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,34 @@
+package glossary
+
+import (
+	"github.com/alnah/fla/internal/domain/kernel"
+)
+
+// TermReader retrieves glossary entries.
+// Used by the glossary page, tooltips, and content annotation.
+type TermReader interface {
+	// GetByID returns a term. Returns ENotFound when missing.
+	GetByID(termID kernel.ID[Term]) (*Term, error)
+
+	// GetAll returns every term, for annotation and the alphabetical glossary page.
+	GetAll() ([]Term, error)
+}
+
+// TermWriter persists glossary entries.
+type TermWriter interface {
+	// Create stores a new term.
+	Create(term Term) error
+
+	// Update saves definition and example changes.
+	Update(term Term) error
+
+	// Delete removes a term; existing annotations disappear on next render.
+	Delete(termID kernel.ID[Term]) error
+}
+
+// Repository combines all glossary operations.
+// Most concrete implementations (like PostgresGlossaryRepository) will implement this.
+type Repository interface {
+	TermReader
+	TermWriter
+}
@@ -0,0 +1,29 @@
+package glossary
+
+import (
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/post"
+)
+
+// AnnotationService links glossary terms found in lessons.
+type AnnotationService struct {
+	terms TermReader
+}
+
+// NewAnnotationService creates annotation service reading terms from the glossary.
+func NewAnnotationService(terms TermReader) *AnnotationService {
+	return &AnnotationService{terms: terms}
+}
+
+// Annotate returns where glossary terms appear in post content, in reading order.
+// The rendering layer wraps each range with a tooltip showing the definition.
+func (s *AnnotationService) Annotate(content post.PostContent) ([]Annotation, error) {
+	const op = "AnnotationService.Annotate"
+
+	terms, err := s.terms.GetAll()
+	if err != nil {
+		return nil, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return Annotate(content.String(), terms), nil
+}
@@ -0,0 +1,76 @@
+package importer
+
+import (
+	"time"
+)
+
+const (
+	MExportParseFailed  string = "Export file could not be read: %s."
+	MExportFormatNotSet string = "Export file is not a %s export."
+)
+
+// Format identifies the blogging platform an export file comes from.
+type Format string
+
+const (
+	FormatWordPress Format = "wordpress" // WXR (WordPress eXtended RSS) file
+	FormatGhost     Format = "ghost"     // Ghost JSON export
+)
+
+func (f Format) String() string { return string(f) }
+
+// Export is a platform-neutral view of an export file, before any domain validation.
+// Refs are the source platform's own identifiers (slugs, logins, or IDs),
+// so records can point at each other without knowing domain IDs yet.
+type Export struct {
+	Format     Format
+	File       string // Export file name, used in report locations
+	Authors    []ExportAuthor
+	Categories []ExportCategory // In file order; parents may come after children
+	Tags       []ExportTag
+	Posts      []ExportPost
+}
+
+// ExportAuthor is a post author; authors are matched to existing users by email.
+type ExportAuthor struct {
+	Ref   string
+	Email string
+	Name  string
+}
+
+// ExportCategory is one category of the source taxonomy.
+type ExportCategory struct {
+	Ref       string
+	ParentRef string // Empty for root categories
+	Name      string
+	Line      int // 0 when the format has no line information
+}
+
+// ExportTag is one tag of the source taxonomy.
+type ExportTag struct {
+	Ref  string
+	Name string
+	Line int
+}
+
+// ExportPost is one post as found in the export, with source vocabulary untouched.
+type ExportPost struct {
+	Ref            string
+	Line           int
+	Title          string
+	Content        string
+	Excerpt        string
+	Status         string // Source status, e.g. "publish" or "future"
+	AuthorRef      string
+	PublishedAt    *time.Time
+	CategoryRefs   []string // The first one becomes the post's category
+	TagRefs        []string
+	FeaturedImage  string
+	SEOTitle       string
+	SEODescription string
+}
+
+// itemRef locates a record of the export for report findings.
+func (e Export) itemRef(kind, ref string, line int) ItemRef {
+	return ItemRef{File: e.File, Line: line, Item: kind + ":" + ref}
+}
@@ -0,0 +1,284 @@
+package importer
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const (
+	MFrontmatterMissing     string = "File must start with a frontmatter block (---)."
+	MFrontmatterUnclosed    string = "Frontmatter block is never closed with ---."
+	MFrontmatterLineInvalid string = "Expected \"key: value\" or a list item."
+	MFrontmatterItemOrphan  string = "List item does not belong to a key."
+	MFrontmatterDuplicate   string = "Key %s is set more than once."
+	MFrontmatterQuoteBroken string = "Quoted value is not closed or has an invalid escape."
+)
+
+// frontmatterDelimiter opens and closes the YAML block.
+const frontmatterDelimiter = "---"
+
+var frontmatterKeyPattern = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+
+// frontmatterField is one top-level key of the block, with where it was found.
+// Only the YAML subset post files need is supported: scalars and lists of scalars.
+type frontmatterField struct {
+	Key       string
+	Value     string
+	List      []string
+	IsList    bool
+	Line      int
+	ItemLines []int
+}
+
+// frontmatterDocument is a Markdown file split into its header and body.
+type frontmatterDocument struct {
+	Fields   []frontmatterField // In file order
+	Body     string
+	BodyLine int // Line of the first body line
+}
+
+// field returns the named key, if present.
+func (d frontmatterDocument) field(key string) (frontmatterField, bool) {
+	for _, f := range d.Fields {
+		if f.Key == key {
+			return f, true
+		}
+	}
+	return frontmatterField{}, false
+}
+
+// parseFrontmatter splits a Markdown file and decodes its frontmatter.
+// Syntax problems are reported with their line; ok is false when any was found.
+func parseFrontmatter(data []byte, ref ItemRef, report *ValidationReport) (frontmatterDocument, bool) {
+	lines := strings.Split(strings.ReplaceAll(string(data), "\r\n", "\n"), "\n")
+	syntaxError := func(line int, message string) {
+		ref.Line = line
+		_ = report.AddError(ref, RuleFrontmatterSyntax, message, "")
+	}
+
+	if strings.TrimSpace(lines[0]) != frontmatterDelimiter {
+		syntaxError(1, MFrontmatterMissing)
+		return frontmatterDocument{}, false
+	}
+
+	end := -1
+	for i := 1; i < len(lines); i++ {
+		if strings.TrimSpace(lines[i]) == frontmatterDelimiter {
+			end = i
+			break
+		}
+	}
+	if end < 0 {
+		syntaxError(1, MFrontmatterUnclosed)
+		return frontmatterDocument{}, false
+	}
+
+	var doc frontmatterDocument
+	ok := true
+	seen := make(map[string]bool)
+	var list *frontmatterField // Key with an empty value, awaiting "- item" lines
+
+	for i := 1; i < end; i++ {
+		line := i + 1
+		raw := lines[i]
+		trimmed := strings.TrimSpace(raw)
+
+		if trimmed == "" || strings.HasPrefix(trimmed, "#") {
+			continue
+		}
+
+		if item, isItem := strings.CutPrefix(trimmed, "-"); isItem && (item == "" || item[0] == ' ') {
+			if list == nil {
+				syntaxError(line, MFrontmatterItemOrphan)
+				ok = false
+				continue
+			}
+			value, valid := parseFrontmatterScalar(strings.TrimSpace(item))
+			if !valid {
+				syntaxError(line, MFrontmatterQuoteBroken)
+				ok = false
+				continue
+			}
+			list.List = append(list.List, value)
+			list.ItemLines = append(list.ItemLines, line)
+			continue
+		}
+
+		key, value, found := strings.Cut(raw, ":")
+		if !found || !frontmatterKeyPattern.MatchString(key) {
+			syntaxError(line, MFrontmatterLineInvalid)
+			ok = false
+			list = nil
+			continue
+		}
+
+		if seen[key] {
+			syntaxError(line, fmt.Sprintf(MFrontmatterDuplicate, key))
+			ok = false
+			list = nil
+			continue
+		}
+		seen[key] = true
+
+		field := frontmatterField{Key: key, Line: line}
+		value = strings.TrimSpace(value)
+
+		switch {
+		case value == "":
+			field.IsList = true // Block list, or an empty value
+		case strings.HasPrefix(value, "["):
+			items, valid := parseFrontmatterFlowList(value)
+			if !valid {
+				syntaxError(line, MFrontmatterLineInvalid)
+				ok = false
+				list = nil
+				continue
+			}
+			field.IsList = true
+			field.List = items
+			for range items {
+				field.ItemLines = append(field.ItemLines, line)
+			}
+		default:
+			scalar, valid := parseFrontmatterScalar(value)
+			if !valid {
+				syntaxError(line, MFrontmatterQuoteBroken)
+				ok = false
+				list = nil
+				continue
+			}
+			field.Value = scalar
+		}
+
+		doc.Fields = append(doc.Fields, field)
+		list = nil
+		if field.IsList && value == "" {
+			list = &doc.Fields[len(doc.Fields)-1]
+		}
+	}
+
+	body := lines[end+1:]
+	doc.BodyLine = end + 2
+	for len(body) > 0 && strings.TrimSpace(body[0]) == "" {
+		body = body[1:]
+		doc.BodyLine++
+	}
+	doc.Body = strings.TrimRight(strings.Join(body, "\n"), "\n")
+
+	return doc, ok
+}
+
+// parseFrontmatterScalar decodes a plain, single-quoted, or double-quoted value.
+func parseFrontmatterScalar(s string) (string, bool) {
+	switch {
+	case strings.HasPrefix(s, `"`):
+		value, err := strconv.Unquote(s)
+		return value, err == nil
+	case strings.HasPrefix(s, "'"):
+		if len(s) < 2 || !strings.HasSuffix(s, "'") {
+			return "", false
+		}
+		return strings.ReplaceAll(s[1:len(s)-1], "''", "'"), true
+	default:
+		if i := strings.Index(s, " #"); i >= 0 {
+			s = s[:i]
+		}
+		return strings.TrimSpace(s), true
+	}
+}
+
+// parseFrontmatterFlowList decodes "[a, b]"; items may not contain commas unless quoted.
+func parseFrontmatterFlowList(s string) ([]string, bool) {
+	inner, ok := strings.CutSuffix(strings.TrimPrefix(s, "["), "]")
+	if !ok {
+		return nil, false
+	}
+
+	items := []string{}
+	if strings.TrimSpace(inner) == "" {
+		return items, true
+	}
+
+	for _, part := range strings.Split(inner, ",") {
+		item, valid := parseFrontmatterScalar(strings.TrimSpace(part))
+		if !valid {
+			return nil, false
+		}
+		items = append(items, item)
+	}
+
+	return items, true
+}
+
+// frontmatterWriter builds a frontmatter block key by key.
+type frontmatterWriter struct {
+	b strings.Builder
+}
+
+func newFrontmatterWriter() *frontmatterWriter {
+	w := &frontmatterWriter{}
+	w.b.WriteString(frontmatterDelimiter + "\n")
+	return w
+}
+
+// scalar writes a string value; empty values are omitted.
+func (w *frontmatterWriter) scalar(key, value string) {
+	if value == "" {
+		return
+	}
+	fmt.Fprintf(&w.b, "%s: %s\n", key, quoteFrontmatterScalar(value))
+}
+
+// raw writes a value that is already valid YAML, such as an RFC 3339 timestamp.
+func (w *frontmatterWriter) raw(key, value string) {
+	fmt.Fprintf(&w.b, "%s: %s\n", key, value)
+}
+
+// list writes a block list; empty lists are written as [] so the key stays visible.
+func (w *frontmatterWriter) list(key string, items []string) {
+	if len(items) == 0 {
+		fmt.Fprintf(&w.b, "%s: []\n", key)
+		return
+	}
+	fmt.Fprintf(&w.b, "%s:\n", key)
+	for _, item := range items {
+		fmt.Fprintf(&w.b, "  - %s\n", quoteFrontmatterScalar(item))
+	}
+}
+
+// document closes the block and appends the body.
+func (w *frontmatterWriter) document(body string) []byte {
+	w.b.WriteString(frontmatterDelimiter + "\n\n")
+	w.b.WriteString(strings.TrimRight(body, "\n"))
+	w.b.WriteString("\n")
+	return []byte(w.b.String())
+}
+
+// yamlReserved are plain scalars other YAML readers would not take as strings.
+var yamlReserved = []string{"true", "false", "yes", "no", "on", "off", "null", "~"}
+
+// quoteFrontmatterScalar leaves simple text plain and double-quotes anything
+// YAML could misread: indicators, comments, numbers, booleans, edge spaces.
+func quoteFrontmatterScalar(s string) string {
+	plain := s == strings.TrimSpace(s) &&
+		!strings.ContainsAny(s, ":#\"\\\n\t[]{},&*!|>%@`") &&
+		!strings.ContainsAny(s[:1], "-?'")
+
+	if plain {
+		if _, err := strconv.ParseFloat(s, 64); err == nil {
+			plain = false
+		}
+		for _, reserved := range yamlReserved {
+			if strings.EqualFold(s, reserved) {
+				plain = false
+			}
+		}
+	}
+
+	if plain {
+		return s
+	}
+	return strconv.Quote(s)
+}
@@ -0,0 +1,171 @@
+package importer
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+)
+
+// Ghost JSON export subset. Exports from Ghost Admin wrap the data in a "db"
+// array; older or scripted exports put "data" at the top level.
+type (
+	ghostFile struct {
+		DB   []ghostDB  `json:"db"`
+		Data *ghostData `json:"data"`
+	}
+
+	ghostDB struct {
+		Data ghostData `json:"data"`
+	}
+
+	ghostData struct {
+		Posts        []ghostPost     `json:"posts"`
+		Tags         []ghostTag      `json:"tags"`
+		PostsTags    []ghostPostLink `json:"posts_tags"`
+		Users        []ghostUser     `json:"users"`
+		PostsAuthors []ghostPostLink `json:"posts_authors"`
+	}
+
+	ghostPost struct {
+		ID              string     `json:"id"`
+		Title           string     `json:"title"`
+		HTML            string     `json:"html"`
+		CustomExcerpt   string     `json:"custom_excerpt"`
+		Status          string     `json:"status"`
+		Type            string     `json:"type"` // "post" or "page"; empty in old exports
+		PublishedAt     *time.Time `json:"published_at"`
+		FeatureImage    string     `json:"feature_image"`
+		MetaTitle       string     `json:"meta_title"`
+		MetaDescription string     `json:"meta_description"`
+		AuthorID        string     `json:"author_id"` // Before multi-author support
+	}
+
+	ghostTag struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+		Slug string `json:"slug"`
+	}
+
+	ghostUser struct {
+		ID    string `json:"id"`
+		Name  string `json:"name"`
+		Email string `json:"email"`
+	}
+
+	// ghostPostLink is a row of posts_tags or posts_authors.
+	ghostPostLink struct {
+		PostID    string `json:"post_id"`
+		TagID     string `json:"tag_id"`
+		AuthorID  string `json:"author_id"`
+		SortOrder int    `json:"sort_order"`
+	}
+)
+
+// ParseGhost reads a Ghost JSON export. Ghost has flat tags and no categories,
+// so each post's primary tag becomes its (root) category and the other public
+// tags stay tags. Internal tags ("#..."), pages, and extra co-authors are dropped.
+// JSON carries no line numbers; findings locate records by ID instead.
+func ParseGhost(file string, r io.Reader) (Export, error) {
+	const op = "ParseGhost"
+
+	var ghost ghostFile
+	if err := json.NewDecoder(r).Decode(&ghost); err != nil {
+		return Export{}, &kernel.Error{Code: kernel.EInvalid, Message: fmt.Sprintf(MExportParseFailed, file), Operation: op, Cause: err}
+	}
+
+	var data ghostData
+	switch {
+	case len(ghost.DB) > 0:
+		data = ghost.DB[0].Data
+	case ghost.Data != nil:
+		data = *ghost.Data
+	default:
+		return Export{}, &kernel.Error{Code: kernel.EInvalid, Message: fmt.Sprintf(MExportFormatNotSet, "Ghost"), Operation: op}
+	}
+
+	export := Export{Format: FormatGhost, File: file}
+
+	for _, u := range data.Users {
+		export.Authors = append(export.Authors, ExportAuthor{Ref: u.ID, Email: u.Email, Name: u.Name})
+	}
+
+	tags := make(map[string]ghostTag, len(data.Tags))
+	for _, t := range data.Tags {
+		if !strings.HasPrefix(t.Name, "#") {
+			tags[t.ID] = t
+		}
+	}
+
+	postTags := ghostLinks(data.PostsTags, func(l ghostPostLink) string { return l.TagID })
+	postAuthors := ghostLinks(data.PostsAuthors, func(l ghostPostLink) string { return l.AuthorID })
+
+	categories := make(map[string]bool)
+	exported := make(map[string]bool)
+
+	for _, gp := range data.Posts {
+		if gp.Type != "" && gp.Type != "post" {
+			continue
+		}
+
+		p := ExportPost{
+			Ref:            gp.ID,
+			Title:          gp.Title,
+			Content:        gp.HTML,
+			Excerpt:        gp.CustomExcerpt,
+			Status:         gp.Status,
+			AuthorRef:      gp.AuthorID,
+			PublishedAt:    gp.PublishedAt,
+			FeaturedImage:  gp.FeatureImage,
+			SEOTitle:       gp.MetaTitle,
+			SEODescription: gp.MetaDescription,
+		}
+
+		if authors := postAuthors[gp.ID]; len(authors) > 0 {
+			p.AuthorRef = authors[0]
+		}
+
+		for _, tagID := range postTags[gp.ID] {
+			t, ok := tags[tagID]
+			if !ok {
+				continue
+			}
+
+			if len(p.CategoryRefs) == 0 {
+				p.CategoryRefs = []string{t.Slug}
+				if !categories[t.Slug] {
+					categories[t.Slug] = true
+					export.Categories = append(export.Categories, ExportCategory{Ref: t.Slug, Name: t.Name})
+				}
+				continue
+			}
+
+			p.TagRefs = append(p.TagRefs, t.Slug)
+			if !exported[t.Slug] {
+				exported[t.Slug] = true
+				export.Tags = append(export.Tags, ExportTag{Ref: t.Slug, Name: t.Name})
+			}
+		}
+
+		export.Posts = append(export.Posts, p)
+	}
+
+	return export, nil
+}
+
+// ghostLinks groups link rows by post, ordered by sort_order.
+func ghostLinks(links []ghostPostLink, target func(ghostPostLink) string) map[string][]string {
+	sorted := slices.Clone(links)
+	slices.SortStableFunc(sorted, func(a, b ghostPostLink) int { return cmp.Compare(a.SortOrder, b.SortOrder) })
+
+	grouped := make(map[string][]string)
+	for _, l := range sorted {
+		grouped[l.PostID] = append(grouped[l.PostID], target(l))
+	}
+	return grouped
+}
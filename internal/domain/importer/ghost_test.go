@@ -0,0 +1,89 @@
+package importer_test
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/alnah/fla/internal/domain/importer"
+	"github.com/alnah/fla/internal/domain/kernel"
+)
+
+const ghostExport = `{"db": [{"meta": {"version": "5.0.0"}, "data": {
+	"posts": [
+		{"id": "p1", "title": "Au marché", "html": "<p>Le samedi…</p>", "custom_excerpt": null, "status": "published",
+		 "type": "post", "published_at": "2024-03-05T09:00:00.000Z", "feature_image": "https://example.com/marche.jpg",
+		 "meta_title": null, "meta_description": "Lire en français."},
+		{"id": "p2", "title": "Contact", "html": "<p>…</p>", "status": "published", "type": "page"}
+	],
+	"tags": [
+		{"id": "t1", "name": "Lecture", "slug": "lecture"},
+		{"id": "t2", "name": "Grammaire", "slug": "grammaire"},
+		{"id": "t3", "name": "#newsletter", "slug": "hash-newsletter"}
+	],
+	"posts_tags": [
+		{"post_id": "p1", "tag_id": "t2", "sort_order": 1},
+		{"post_id": "p1", "tag_id": "t3", "sort_order": 2},
+		{"post_id": "p1", "tag_id": "t1", "sort_order": 0}
+	],
+	"users": [{"id": "u1", "name": "Marie", "email": "marie@example.com"}],
+	"posts_authors": [{"post_id": "p1", "author_id": "u1", "sort_order": 0}]
+}}]}`
+
+func TestParseGhost(t *testing.T) {
+	got, err := importer.ParseGhost("ghost.json", strings.NewReader(ghostExport))
+
+	assertNoError(t, err)
+	if len(got.Posts) != 1 {
+		t.Fatalf("got %d posts", len(got.Posts))
+	}
+
+	p := got.Posts[0]
+
+	t.Run("primary tag becomes the category", func(t *testing.T) {
+		if !slices.Equal(p.CategoryRefs, []string{"lecture"}) || len(got.Categories) != 1 {
+			t.Errorf("categories: got %v, %+v", p.CategoryRefs, got.Categories)
+		}
+	})
+
+	t.Run("internal tags are dropped", func(t *testing.T) {
+		if !slices.Equal(p.TagRefs, []string{"grammaire"}) || len(got.Tags) != 1 {
+			t.Errorf("tags: got %v, %+v", p.TagRefs, got.Tags)
+		}
+	})
+
+	t.Run("maps post fields", func(t *testing.T) {
+		if p.AuthorRef != "u1" || p.Status != "published" || p.PublishedAt == nil {
+			t.Errorf("got %+v", p)
+		}
+		if p.SEODescription != "Lire en français." || p.FeaturedImage == "" {
+			t.Errorf("meta: got %q, %q", p.SEODescription, p.FeaturedImage)
+		}
+	})
+
+	t.Run("accepts top-level data", func(t *testing.T) {
+		got, err := importer.ParseGhost("ghost.json", strings.NewReader(`{"data": {"posts": [{"id": "p1", "status": "draft"}]}}`))
+
+		assertNoError(t, err)
+		if len(got.Posts) != 1 {
+			t.Errorf("got %d posts", len(got.Posts))
+		}
+	})
+
+	errorTests := []struct {
+		name  string
+		input string
+	}{
+		{"malformed JSON", `{"db": [`},
+		{"not a Ghost export", `{"posts": []}`},
+	}
+
+	for _, tt := range errorTests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := importer.ParseGhost("ghost.json", strings.NewReader(tt.input))
+
+			assertError(t, err)
+			assertErrorCode(t, err, kernel.EInvalid)
+		})
+	}
+}
@@ -0,0 +1,148 @@
+package importer_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/category"
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/post"
+	"github.com/alnah/fla/internal/domain/shared"
+	"github.com/alnah/fla/internal/domain/tag"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+type stubClock struct {
+	t time.Time
+}
+
+func (s *stubClock) Now() time.Time { return s.t }
+
+func admin() user.User {
+	return user.User{ID: "admin-1", Roles: []user.Role{user.RoleAdmin}}
+}
+
+func editor() user.User {
+	return user.User{ID: "editor-1", Roles: []user.Role{user.RoleEditor}}
+}
+
+var notFound = &kernel.Error{Code: kernel.ENotFound, Message: "not found"}
+
+type stubUsers map[shared.Email]user.User
+
+func (s stubUsers) GetUserByEmail(email shared.Email) (*user.User, error) {
+	if u, ok := s[email]; ok {
+		return &u, nil
+	}
+	return nil, notFound
+}
+
+// stubCategories finds existing categories by slug path ("a1/lecture").
+type stubCategories struct {
+	existing map[string]category.Category
+	created  []category.Category
+}
+
+func (s *stubCategories) BuildPath(categoryID kernel.ID[category.Category]) (category.CategoryPath, error) {
+	for key, c := range s.existing {
+		if c.CategoryID != categoryID {
+			continue
+		}
+		var path category.CategoryPath
+		for i := range strings.Split(key, "/") {
+			path = append(path, s.existing[strings.Join(strings.Split(key, "/")[:i+1], "/")])
+		}
+		return path, nil
+	}
+	return nil, notFound
+}
+
+func (s *stubCategories) FindByPath(segments []string) (*category.Category, error) {
+	if c, ok := s.existing[strings.Join(segments, "/")]; ok {
+		return &c, nil
+	}
+	return nil, notFound
+}
+
+func (s *stubCategories) Create(c category.Category) error {
+	s.created = append(s.created, c)
+	return nil
+}
+
+func (s *stubCategories) Update(category.Category) error            { return nil }
+func (s *stubCategories) Delete(kernel.ID[category.Category]) error { return nil }
+
+type stubTags struct {
+	existing map[shared.Slug]tag.Tag
+	created  []tag.Tag
+}
+
+func (s *stubTags) GetByID(tagID kernel.ID[tag.Tag]) (*tag.Tag, error) {
+	for _, t := range s.existing {
+		if t.TagID == tagID {
+			return &t, nil
+		}
+	}
+	return nil, notFound
+}
+
+func (s *stubTags) GetBySlug(slug shared.Slug) (*tag.Tag, error) {
+	if t, ok := s.existing[slug]; ok {
+		return &t, nil
+	}
+	return nil, notFound
+}
+
+func (s *stubTags) GetAll() ([]tag.Tag, error) { return nil, nil }
+
+func (s *stubTags) Create(t tag.Tag) error {
+	s.created = append(s.created, t)
+	return nil
+}
+
+func (s *stubTags) Update(tag.Tag) error            { return nil }
+func (s *stubTags) Delete(kernel.ID[tag.Tag]) error { return nil }
+
+type stubPosts struct {
+	taken   map[shared.Slug]bool
+	created []post.Post
+	err     error
+}
+
+func (s *stubPosts) Create(p post.Post) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.created = append(s.created, p)
+	return nil
+}
+
+func (s *stubPosts) Update(post.Post) error            { return nil }
+func (s *stubPosts) Delete(kernel.ID[post.Post]) error { return nil }
+
+func (s *stubPosts) IsSlugUnique(slug shared.Slug, _ *kernel.ID[post.Post]) (bool, error) {
+	return !s.taken[slug], nil
+}
+
+func assertNoError(t *testing.T, err error) {
+	t.Helper()
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+}
+
+func assertError(t *testing.T, err error) {
+	t.Helper()
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+}
+
+func assertErrorCode(t *testing.T, err error, want string) {
+	t.Helper()
+	got := kernel.ErrorCode(err)
+	if got != want {
+		t.Errorf("error code: got %q, want %q", got, want)
+	}
+}
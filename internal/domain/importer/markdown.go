@@ -0,0 +1,366 @@
+package importer
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/category"
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/post"
+	"github.com/alnah/fla/internal/domain/shared"
+	"github.com/alnah/fla/internal/domain/tag"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+const (
+	MMarkdownKeyMissing      string = "Missing frontmatter key: %s."
+	MMarkdownKeyUnknown      string = "Unknown frontmatter key: %s."
+	MMarkdownKeyNotList      string = "Key %s must be a list."
+	MMarkdownKeyNotScalar    string = "Key %s must be a single value."
+	MMarkdownDateInvalid     string = "Invalid date %q; use RFC 3339, e.g. 2024-03-05T09:00:00Z."
+	MMarkdownCategoryUnknown string = "Unknown category path: %s."
+	MMarkdownTagUnknown      string = "Unknown tag: %s."
+	MMarkdownSlugMismatch    string = "Slug %s differs from %s, the slug of the title; the title wins."
+)
+
+// MarkdownSource names Markdown imports in validation reports.
+const MarkdownSource = "markdown"
+
+// Frontmatter keys, in the order MarshalMarkdown writes them.
+const (
+	FrontmatterID                   = "id"
+	FrontmatterTitle                = "title"
+	FrontmatterSlug                 = "slug"
+	FrontmatterAuthor               = "author"
+	FrontmatterStatus               = "status"
+	FrontmatterCategory             = "category" // Slug path, e.g. "a1/comprehension-ecrite/sports"
+	FrontmatterTags                 = "tags"     // Tag slugs
+	FrontmatterExcerpt              = "excerpt"
+	FrontmatterFeaturedImage        = "featured_image"
+	FrontmatterSEOTitle             = "seo_title"
+	FrontmatterSEODescription       = "seo_description"
+	FrontmatterOpenGraphTitle       = "og_title"
+	FrontmatterOpenGraphDescription = "og_description"
+	FrontmatterOpenGraphImage       = "og_image"
+	FrontmatterCanonicalURL         = "canonical_url"
+	FrontmatterSchemaType           = "schema_type"
+	FrontmatterPublishedAt          = "published_at"
+	FrontmatterCreatedAt            = "created_at" // Informational: NewPost sets it
+	FrontmatterUpdatedAt            = "updated_at" // Informational: NewPost sets it
+)
+
+// Markdown finding rules; field findings use "frontmatter.<key>".
+const (
+	RuleFrontmatterSyntax  = "frontmatter.syntax"
+	RuleFrontmatterUnknown = "frontmatter.unknown_key"
+	RuleMarkdownBody       = "markdown.body"
+)
+
+var frontmatterKeys = []string{
+	FrontmatterID, FrontmatterTitle, FrontmatterSlug, FrontmatterAuthor, FrontmatterStatus,
+	FrontmatterCategory, FrontmatterTags, FrontmatterExcerpt, FrontmatterFeaturedImage,
+	FrontmatterSEOTitle, FrontmatterSEODescription, FrontmatterOpenGraphTitle,
+	FrontmatterOpenGraphDescription, FrontmatterOpenGraphImage, FrontmatterCanonicalURL,
+	FrontmatterSchemaType, FrontmatterPublishedAt, FrontmatterCreatedAt, FrontmatterUpdatedAt,
+}
+
+var requiredFrontmatterKeys = []string{
+	FrontmatterID, FrontmatterTitle, FrontmatterAuthor, FrontmatterStatus, FrontmatterCategory,
+}
+
+// MarshalMarkdown writes a post as Markdown with a YAML frontmatter header.
+// The category path and tags are passed resolved so the file is readable on its own.
+// Optional fields left empty are omitted; the output parses back with MarkdownService.Import.
+func MarshalMarkdown(p post.Post, path category.CategoryPath, tags []tag.Tag) []byte {
+	slugs := make([]string, len(tags))
+	for i, t := range tags {
+		slugs[i] = t.Slug.String()
+	}
+
+	w := newFrontmatterWriter()
+	w.scalar(FrontmatterID, p.PostID.String())
+	w.scalar(FrontmatterTitle, p.Title.String())
+	w.scalar(FrontmatterSlug, p.Slug.String())
+	w.scalar(FrontmatterAuthor, p.Owner.String())
+	w.scalar(FrontmatterStatus, p.Status.String())
+	w.scalar(FrontmatterCategory, path.String())
+	w.list(FrontmatterTags, slugs)
+	w.scalar(FrontmatterExcerpt, p.Excerpt.String())
+	w.scalar(FrontmatterFeaturedImage, p.FeaturedImage.String())
+	w.scalar(FrontmatterSEOTitle, p.SEOTitle.String())
+	w.scalar(FrontmatterSEODescription, p.SEODescription.String())
+	w.scalar(FrontmatterOpenGraphTitle, p.OpenGraphTitle.String())
+	w.scalar(FrontmatterOpenGraphDescription, p.OpenGraphDescription.String())
+	w.scalar(FrontmatterOpenGraphImage, p.OpenGraphImage.String())
+	w.scalar(FrontmatterCanonicalURL, p.CanonicalURL.String())
+	w.scalar(FrontmatterSchemaType, string(p.SchemaType))
+	if p.PublishedAt != nil {
+		w.raw(FrontmatterPublishedAt, p.PublishedAt.UTC().Format(time.RFC3339))
+	}
+	w.raw(FrontmatterCreatedAt, p.CreatedAt.UTC().Format(time.RFC3339))
+	w.raw(FrontmatterUpdatedAt, p.UpdatedAt.UTC().Format(time.RFC3339))
+
+	return w.document(p.Content.String())
+}
+
+// MarkdownPath returns where a post's file lives in a content repository,
+// mirroring the site URL: "a1/comprehension-ecrite/sports/jouer-au-football.md".
+func MarkdownPath(p post.Post, path category.CategoryPath) string {
+	if len(path) == 0 {
+		return p.Slug.String() + ".md"
+	}
+	return path.String() + "/" + p.Slug.String() + ".md"
+}
+
+// MarkdownService converts posts to and from Markdown files for git-based workflows.
+type MarkdownService struct {
+	categories category.CategoryPathBuilder
+	tags       tag.TagReader
+	clock      kernel.Clock
+}
+
+// NewMarkdownService creates markdown service with category and tag lookups.
+func NewMarkdownService(categories category.CategoryPathBuilder, tags tag.TagReader, clock kernel.Clock) *MarkdownService {
+	return &MarkdownService{categories: categories, tags: tags, clock: clock}
+}
+
+// Export renders a post as a Markdown file; tags deleted since tagging are left out.
+func (s *MarkdownService) Export(p post.Post) ([]byte, error) {
+	const op = "MarkdownService.Export"
+
+	path, err := s.categories.BuildPath(p.Category.CategoryID)
+	if err != nil {
+		return nil, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	var tags []tag.Tag
+	for _, tagID := range p.Tags {
+		t, err := s.tags.GetByID(tagID)
+		if kernel.ErrorCode(err) == kernel.ENotFound {
+			continue
+		}
+		if err != nil {
+			return nil, &kernel.Error{Operation: op, Cause: err}
+		}
+		tags = append(tags, *t)
+	}
+
+	return MarshalMarkdown(p, path, tags), nil
+}
+
+// Import parses a Markdown file back into post parameters.
+// Every problem is reported with the line it sits on, so editors can fix files in
+// one pass; params are only returned when the report has no errors.
+// Repository failures are returned as errors.
+func (s *MarkdownService) Import(file string, data []byte) (post.NewPostParams, *ValidationReport, error) {
+	const op = "MarkdownService.Import"
+
+	report, err := NewValidationReport(MarkdownSource)
+	if err != nil {
+		return post.NewPostParams{}, nil, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	doc, ok := parseFrontmatter(data, ItemRef{File: file}, report)
+	if !ok {
+		return post.NewPostParams{}, report, nil
+	}
+
+	r := &markdownReader{doc: doc, file: file, report: report}
+	r.checkKeys()
+
+	params := post.NewPostParams{
+		PostID:               parseField(r, FrontmatterID, kernel.NewID[post.Post]),
+		Owner:                parseField(r, FrontmatterAuthor, kernel.NewID[user.User]),
+		Title:                parseField(r, FrontmatterTitle, shared.NewTitle),
+		Status:               parseField(r, FrontmatterStatus, parseStatus),
+		Excerpt:              parseField(r, FrontmatterExcerpt, post.NewExcerpt),
+		FeaturedImage:        parseField(r, FrontmatterFeaturedImage, kernel.NewURL[post.FeaturedImage]),
+		SEOTitle:             parseField(r, FrontmatterSEOTitle, shared.NewTitle),
+		SEODescription:       parseField(r, FrontmatterSEODescription, shared.NewDescription),
+		OpenGraphTitle:       parseField(r, FrontmatterOpenGraphTitle, shared.NewTitle),
+		OpenGraphDescription: parseField(r, FrontmatterOpenGraphDescription, shared.NewDescription),
+		OpenGraphImage:       parseField(r, FrontmatterOpenGraphImage, kernel.NewURL[post.OpenGraphImage]),
+		CanonicalURL:         parseField(r, FrontmatterCanonicalURL, kernel.NewURL[post.Canonical]),
+		SchemaType:           parseField(r, FrontmatterSchemaType, parseSchemaType),
+		PublishedAt:          parseField(r, FrontmatterPublishedAt, parseDate),
+		Clock:                s.clock,
+	}
+	parseField(r, FrontmatterCreatedAt, parseDate)
+	parseField(r, FrontmatterUpdatedAt, parseDate)
+
+	content, err := post.NewPostContent(doc.Body)
+	if err != nil {
+		_ = report.AddDomainError(ItemRef{File: file, Line: doc.BodyLine}, RuleMarkdownBody, err)
+	}
+	params.Content = content
+
+	if params.Category, err = s.category(r); err != nil {
+		return post.NewPostParams{}, nil, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if params.Tags, err = s.tagIDs(r); err != nil {
+		return post.NewPostParams{}, nil, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	r.checkSlug(params.Title)
+
+	if report.HasErrors() {
+		return post.NewPostParams{}, report, nil
+	}
+
+	// Cross-field rules, such as scheduled dates lying in the future.
+	if _, err := post.NewPost(params); err != nil {
+		_ = report.AddDomainError(ItemRef{File: file, Line: 1}, RulePost, err)
+		return post.NewPostParams{}, report, nil
+	}
+
+	return params, report, nil
+}
+
+// category resolves the category path key; unknown paths are reported.
+func (s *MarkdownService) category(r *markdownReader) (category.Category, error) {
+	f, ok := r.scalar(FrontmatterCategory)
+	if !ok {
+		return category.Category{}, nil
+	}
+
+	found, err := s.categories.FindByPath(strings.Split(strings.Trim(f.Value, "/"), "/"))
+	if kernel.ErrorCode(err) == kernel.ENotFound {
+		r.fail(f.Line, FrontmatterCategory, fmt.Sprintf(MMarkdownCategoryUnknown, f.Value))
+		return category.Category{}, nil
+	}
+	if err != nil {
+		return category.Category{}, err
+	}
+
+	return *found, nil
+}
+
+// tagIDs resolves the tag slugs; each unknown tag is reported on its own line.
+func (s *MarkdownService) tagIDs(r *markdownReader) (post.PostTags, error) {
+	f, ok := r.doc.field(FrontmatterTags)
+	if !ok || (!f.IsList && f.Value == "") {
+		return nil, nil
+	}
+	if !f.IsList {
+		r.fail(f.Line, FrontmatterTags, fmt.Sprintf(MMarkdownKeyNotList, FrontmatterTags))
+		return nil, nil
+	}
+
+	var tags post.PostTags
+	for i, slug := range f.List {
+		t, err := s.tags.GetBySlug(shared.Slug(slug))
+		if kernel.ErrorCode(err) == kernel.ENotFound {
+			r.fail(f.ItemLines[i], FrontmatterTags, fmt.Sprintf(MMarkdownTagUnknown, slug))
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if !tags.Contains(t.TagID) {
+			tags = append(tags, t.TagID)
+		}
+	}
+
+	return tags, nil
+}
+
+// markdownReader reads typed values out of a parsed document, reporting as it goes.
+type markdownReader struct {
+	doc    frontmatterDocument
+	file   string
+	report *ValidationReport
+}
+
+func (r *markdownReader) ref(line int) ItemRef {
+	return ItemRef{File: r.file, Line: line}
+}
+
+func (r *markdownReader) fail(line int, key, message string) {
+	_ = r.report.AddError(r.ref(line), "frontmatter."+key, message, "")
+}
+
+// checkKeys reports missing required keys and unknown ones.
+func (r *markdownReader) checkKeys() {
+	for _, key := range requiredFrontmatterKeys {
+		if _, ok := r.doc.field(key); !ok {
+			r.fail(1, key, fmt.Sprintf(MMarkdownKeyMissing, key))
+		}
+	}
+
+	for _, f := range r.doc.Fields {
+		if !slices.Contains(frontmatterKeys, f.Key) {
+			_ = r.report.AddWarning(r.ref(f.Line), RuleFrontmatterUnknown, fmt.Sprintf(MMarkdownKeyUnknown, f.Key), "")
+		}
+	}
+}
+
+// scalar returns a key holding a single value; lists are reported.
+func (r *markdownReader) scalar(key string) (frontmatterField, bool) {
+	f, ok := r.doc.field(key)
+	if !ok {
+		return frontmatterField{}, false
+	}
+	if f.IsList && len(f.List) > 0 {
+		r.fail(f.Line, key, fmt.Sprintf(MMarkdownKeyNotScalar, key))
+		return frontmatterField{}, false
+	}
+	return f, true
+}
+
+// checkSlug warns when the stored slug no longer matches the title, since
+// NewPost always derives the slug from the title.
+func (r *markdownReader) checkSlug(title shared.Title) {
+	f, ok := r.scalar(FrontmatterSlug)
+	if !ok || f.Value == "" || title == "" {
+		return
+	}
+
+	slug, err := shared.NewSlug(title.String())
+	if err == nil && slug.String() != f.Value {
+		_ = r.report.AddWarning(r.ref(f.Line), "frontmatter."+FrontmatterSlug,
+			fmt.Sprintf(MMarkdownSlugMismatch, f.Value, slug), "Update the slug or the title so they agree.")
+	}
+}
+
+// parseField decodes a scalar key with a domain constructor, reporting failures on its line.
+func parseField[T any](r *markdownReader, key string, parse func(string) (T, error)) T {
+	var zero T
+
+	f, ok := r.scalar(key)
+	if !ok || (f.Value == "" && !slices.Contains(requiredFrontmatterKeys, key)) {
+		return zero
+	}
+
+	value, err := parse(f.Value)
+	if err != nil {
+		_ = r.report.AddDomainError(r.ref(f.Line), "frontmatter."+key, err)
+		return zero
+	}
+
+	return value
+}
+
+func parseStatus(s string) (post.Status, error) {
+	status := post.Status(s)
+	return status, status.Validate()
+}
+
+func parseSchemaType(s string) (post.SchemaType, error) {
+	schema := post.SchemaType(s)
+	return schema, schema.Validate()
+}
+
+func parseDate(s string) (*time.Time, error) {
+	const op = "parseDate"
+
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return nil, &kernel.Error{Code: kernel.EInvalid, Message: fmt.Sprintf(MMarkdownDateInvalid, s), Operation: op}
+	}
+
+	t = t.UTC()
+	return &t, nil
+}
@@ -0,0 +1,189 @@
+package importer_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/category"
+	"github.com/alnah/fla/internal/domain/importer"
+	"github.com/alnah/fla/internal/domain/post"
+	"github.com/alnah/fla/internal/domain/shared"
+	"github.com/alnah/fla/internal/domain/tag"
+)
+
+func newMarkdownService() *importer.MarkdownService {
+	a1 := category.Category{CategoryID: "a1-level", Name: "A1", Slug: "a1", CreatedBy: "admin-1"}
+	parent := a1.CategoryID
+	categories := &stubCategories{existing: map[string]category.Category{
+		"a1":         a1,
+		"a1/lecture": {CategoryID: "a1-reading", Name: "Lecture", Slug: "lecture", ParentID: &parent, CreatedBy: "admin-1"},
+	}}
+	tags := &stubTags{existing: map[shared.Slug]tag.Tag{
+		"grammaire": {TagID: "grammar", Name: "Grammaire", Slug: "grammaire"},
+	}}
+	return importer.NewMarkdownService(categories, tags, &stubClock{importNow})
+}
+
+func markdownPost(t *testing.T) post.Post {
+	t.Helper()
+	p, err := post.NewPost(post.NewPostParams{
+		PostID:         "post-42",
+		Owner:          "marie",
+		Title:          "Au marché : le samedi",
+		Content:        post.PostContent(lessonBody + "\n\n## Vocabulaire\n\n- le marché"),
+		Status:         post.StatusPublished,
+		Category:       category.Category{CategoryID: "a1-reading", Name: "Lecture", Slug: "lecture", CreatedBy: "admin-1"},
+		PublishedAt:    &publishedAt,
+		Tags:           post.PostTags{"grammar"},
+		SEODescription: "Lire en français, niveau A1.",
+		SchemaType:     post.SchemaTypeLearningResource,
+		Clock:          &stubClock{importNow},
+	})
+	assertNoError(t, err)
+	return p
+}
+
+func TestMarkdownService_RoundTrip(t *testing.T) {
+	service := newMarkdownService()
+	original := markdownPost(t)
+
+	data, err := service.Export(original)
+	assertNoError(t, err)
+
+	params, report, err := service.Import("au-marche.md", data)
+
+	assertNoError(t, err)
+	if len(report.Findings) != 0 {
+		t.Fatalf("unexpected findings: %+v\n%s", report.Findings, data)
+	}
+	if params.PostID != original.PostID || params.Title != original.Title || params.Owner != original.Owner {
+		t.Errorf("identity: got %+v", params)
+	}
+	if params.Content != original.Content {
+		t.Errorf("content: got %q", params.Content)
+	}
+	if params.Category.CategoryID != "a1-reading" || !params.Tags.Contains("grammar") {
+		t.Errorf("taxonomy: got %v, %v", params.Category.CategoryID, params.Tags)
+	}
+	if params.PublishedAt == nil || !params.PublishedAt.Equal(publishedAt) {
+		t.Errorf("published: got %v", params.PublishedAt)
+	}
+	if params.SEODescription != original.SEODescription || params.SchemaType != original.SchemaType {
+		t.Errorf("SEO: got %q, %q", params.SEODescription, params.SchemaType)
+	}
+}
+
+func TestMarshalMarkdown(t *testing.T) {
+	got := string(importer.MarshalMarkdown(markdownPost(t), nil, nil))
+
+	for _, want := range []string{
+		"---\nid: post-42\n",
+		`title: "Au marché : le samedi"`,
+		"tags: []\n",
+		"published_at: 2024-03-05T09:00:00Z\n",
+		"---\n\nLe samedi",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("missing %q in:\n%s", want, got)
+		}
+	}
+	if strings.Contains(got, "og_title") {
+		t.Error("expected empty optional fields to be omitted")
+	}
+}
+
+func TestMarkdownPath(t *testing.T) {
+	path := category.CategoryPath{{Slug: "a1"}, {Slug: "lecture"}}
+
+	if got := importer.MarkdownPath(markdownPost(t), path); got != "a1/lecture/au-marche-le-samedi.md" {
+		t.Errorf("got %q", got)
+	}
+}
+
+func TestMarkdownService_Import(t *testing.T) {
+	body := "\n" + lessonBody + "\n"
+	header := func(lines ...string) string {
+		return "---\n" + strings.Join(lines, "\n") + "\n---\n" + body
+	}
+	valid := []string{
+		"id: post-42",
+		"title: Au marché le samedi",
+		"author: marie",
+		"status: draft",
+		"category: a1/lecture",
+	}
+
+	tests := []struct {
+		name     string
+		file     string
+		rule     string
+		line     int
+		severity importer.Severity
+	}{
+		{"missing frontmatter", lessonBody, importer.RuleFrontmatterSyntax, 1, importer.SeverityError},
+		{"unclosed frontmatter", "---\ntitle: x\n", importer.RuleFrontmatterSyntax, 1, importer.SeverityError},
+		{"invalid line", header("id: post-42", "just text"), importer.RuleFrontmatterSyntax, 3, importer.SeverityError},
+		{"duplicate key", header("id: a", "id: b"), importer.RuleFrontmatterSyntax, 3, importer.SeverityError},
+		{"broken quote", header(`title: "Au marché`), importer.RuleFrontmatterSyntax, 2, importer.SeverityError},
+		{"missing key", header(valid[1:]...), "frontmatter.id", 1, importer.SeverityError},
+		{"short title", header(append([]string{"title: Court"}, valid[2:]...)...), "frontmatter.title", 2, importer.SeverityError},
+		{"unknown status", header(slicesWith(valid, 3, "status: live")...), "frontmatter.status", 5, importer.SeverityError},
+		{"unknown category", header(slicesWith(valid, 4, "category: b2/oral")...), "frontmatter.category", 6, importer.SeverityError},
+		{"unknown tag", header(append(valid, "tags:", "  - grammaire", "  - subjonctif")...), "frontmatter.tags", 9, importer.SeverityError},
+		{"invalid date", header(append(valid, "published_at: 05/03/2024")...), "frontmatter.published_at", 7, importer.SeverityError},
+		{"short body", "---\n" + strings.Join(valid, "\n") + "\n---\n\nTrop court.\n", importer.RuleMarkdownBody, 9, importer.SeverityError},
+		{"unknown key", header(append(valid, "layout: lesson")...), importer.RuleFrontmatterUnknown, 7, importer.SeverityWarning},
+		{"stale slug", header(append(valid, "slug: ancien-titre")...), "frontmatter.slug", 7, importer.SeverityWarning},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params, report, err := newMarkdownService().Import("lesson.md", []byte(tt.file))
+
+			assertNoError(t, err)
+			found := false
+			for _, f := range report.Findings {
+				if f.Rule == tt.rule && f.Ref.Line == tt.line && f.Severity == tt.severity {
+					found = true
+				}
+			}
+			if !found {
+				t.Errorf("expected %s %q on line %d, got %+v", tt.severity, tt.rule, tt.line, report.Findings)
+			}
+			if tt.severity == importer.SeverityError && params.PostID != "" {
+				t.Error("expected no params when the report has errors")
+			}
+		})
+	}
+
+	t.Run("accepts flow lists and comments", func(t *testing.T) {
+		file := header(append(valid, "# taxonomy", "tags: [grammaire]")...)
+
+		params, report, err := newMarkdownService().Import("lesson.md", []byte(file))
+
+		assertNoError(t, err)
+		if report.HasErrors() || !params.Tags.Contains("grammar") {
+			t.Errorf("got %v, %+v", params.Tags, report.Findings)
+		}
+	})
+
+	t.Run("checks cross-field rules", func(t *testing.T) {
+		past := importNow.Add(-time.Hour).Format(time.RFC3339)
+		file := header(append(slicesWith(valid, 3, "status: scheduled"), "published_at: "+past)...)
+
+		_, report, err := newMarkdownService().Import("lesson.md", []byte(file))
+
+		assertNoError(t, err)
+		if !hasFinding(report, importer.RulePost, importer.SeverityError) {
+			t.Errorf("expected scheduled date error, got %+v", report.Findings)
+		}
+	})
+}
+
+// slicesWith returns a copy of lines with one line replaced.
+func slicesWith(lines []string, i int, line string) []string {
+	out := append([]string(nil), lines...)
+	out[i] = line
+	return out
+}
@@ -0,0 +1,181 @@
+package importer
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+)
+
+const (
+	MFindingRuleMissing      string = "Missing finding rule."
+	MFindingMessageMissing   string = "Missing finding message."
+	MFindingSeverityInvalid  string = "Invalid finding severity."
+	MReportSourceMissing     string = "Missing report source."
+	MReportSerializationFail string = "Validation report could not be serialized."
+)
+
+// Severity classifies how strongly a finding should block an import.
+// Errors fail CI pipelines; warnings and notices are informational.
+type Severity string
+
+const (
+	SeverityError   Severity = "error"   // Item cannot be imported as-is
+	SeverityWarning Severity = "warning" // Item imports but needs attention
+	SeverityInfo    Severity = "info"    // Informational note about a transformation
+)
+
+func (s Severity) String() string { return string(s) }
+
+// Validate ensures severity uses a defined level.
+func (s Severity) Validate() error {
+	const op = "Severity.Validate"
+
+	switch s {
+	case SeverityError, SeverityWarning, SeverityInfo:
+		return nil
+	default:
+		return &kernel.Error{Code: kernel.EInvalid, Message: MFindingSeverityInvalid, Operation: op}
+	}
+}
+
+// sarifLevel maps severity to the SARIF result level vocabulary.
+func (s Severity) sarifLevel() string {
+	switch s {
+	case SeverityError:
+		return "error"
+	case SeverityWarning:
+		return "warning"
+	default:
+		return "note"
+	}
+}
+
+// ItemRef locates the imported item a finding refers to.
+// File and Line point into the export file; Item names the logical record.
+type ItemRef struct {
+	File string `json:"file,omitempty"` // Export file path or URI
+	Line int    `json:"line,omitempty"` // 1-based line in the export file (0 = unknown)
+	Item string `json:"item,omitempty"` // Logical reference such as "post:42" or "subscriber:7"
+}
+
+// String returns a compact human-readable reference.
+func (r ItemRef) String() string {
+	switch {
+	case r.File != "" && r.Line > 0:
+		return fmt.Sprintf("%s:%d", r.File, r.Line)
+	case r.File != "":
+		return r.File
+	default:
+		return r.Item
+	}
+}
+
+// Finding describes one validation problem discovered while importing.
+// Shared by every importer so CI tooling consumes a single schema.
+type Finding struct {
+	Ref          ItemRef  `json:"ref"`
+	Severity     Severity `json:"severity"`
+	Rule         string   `json:"rule"`                    // Stable rule identifier, e.g. "post.title.length"
+	Message      string   `json:"message"`                 // Human-readable explanation
+	SuggestedFix string   `json:"suggested_fix,omitempty"` // Optional remediation hint
+}
+
+// Validate ensures the finding carries enough information to be actionable.
+func (f Finding) Validate() error {
+	const op = "Finding.Validate"
+
+	if err := f.Severity.Validate(); err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if f.Rule == "" {
+		return &kernel.Error{Code: kernel.EInvalid, Message: MFindingRuleMissing, Operation: op}
+	}
+
+	if f.Message == "" {
+		return &kernel.Error{Code: kernel.EInvalid, Message: MFindingMessageMissing, Operation: op}
+	}
+
+	return nil
+}
+
+// ValidationReport collects findings emitted by a single import run.
+// Serializes to plain JSON or SARIF so pipelines can fail builds and annotate diffs.
+type ValidationReport struct {
+	Source   string    `json:"source"` // Importer name, e.g. "wordpress" or "csv-subscribers"
+	Findings []Finding `json:"findings"`
+}
+
+// NewValidationReport creates an empty report for the named importer.
+func NewValidationReport(source string) (*ValidationReport, error) {
+	const op = "NewValidationReport"
+
+	if err := kernel.ValidatePresence("report source", source, op); err != nil {
+		return nil, err
+	}
+
+	return &ValidationReport{Source: source, Findings: []Finding{}}, nil
+}
+
+// Add appends a validated finding to the report.
+func (r *ValidationReport) Add(f Finding) error {
+	const op = "ValidationReport.Add"
+
+	if err := f.Validate(); err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	r.Findings = append(r.Findings, f)
+	return nil
+}
+
+// AddError records a blocking finding for the referenced item.
+func (r *ValidationReport) AddError(ref ItemRef, rule, message, fix string) error {
+	return r.Add(Finding{Ref: ref, Severity: SeverityError, Rule: rule, Message: message, SuggestedFix: fix})
+}
+
+// AddWarning records a non-blocking finding for the referenced item.
+func (r *ValidationReport) AddWarning(ref ItemRef, rule, message, fix string) error {
+	return r.Add(Finding{Ref: ref, Severity: SeverityWarning, Rule: rule, Message: message, SuggestedFix: fix})
+}
+
+// AddDomainError converts a domain validation error into a blocking finding.
+// Uses the kernel error message so importers report the same text users see.
+func (r *ValidationReport) AddDomainError(ref ItemRef, rule string, err error) error {
+	return r.AddError(ref, rule, kernel.ErrorMessage(err), "")
+}
+
+// Count returns the number of findings with the given severity.
+func (r *ValidationReport) Count(severity Severity) int {
+	count := 0
+	for _, f := range r.Findings {
+		if f.Severity == severity {
+			count++
+		}
+	}
+	return count
+}
+
+// HasErrors reports whether any finding should fail the import.
+func (r *ValidationReport) HasErrors() bool {
+	return r.Count(SeverityError) > 0
+}
+
+// String returns a summary of the report.
+func (r *ValidationReport) String() string {
+	return fmt.Sprintf("ValidationReport{Source: %q, Errors: %d, Warnings: %d, Info: %d}",
+		r.Source, r.Count(SeverityError), r.Count(SeverityWarning), r.Count(SeverityInfo))
+}
+
+// ToJSON serializes the report using the common findings schema.
+func (r *ValidationReport) ToJSON() ([]byte, error) {
+	const op = "ValidationReport.ToJSON"
+
+	data, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return nil, &kernel.Error{Code: kernel.EInternal, Message: MReportSerializationFail, Operation: op, Cause: err}
+	}
+
+	return data, nil
+}
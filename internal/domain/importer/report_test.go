@@ -0,0 +1,115 @@
+package importer_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/alnah/fla/internal/domain/importer"
+	"github.com/alnah/fla/internal/domain/kernel"
+)
+
+func TestNewValidationReport(t *testing.T) {
+	t.Run("creates empty report", func(t *testing.T) {
+		got, err := importer.NewValidationReport("wordpress")
+
+		assertNoError(t, err)
+		if got.HasErrors() {
+			t.Error("expected empty report to have no errors")
+		}
+	})
+
+	t.Run("rejects missing source", func(t *testing.T) {
+		_, err := importer.NewValidationReport(" ")
+
+		assertError(t, err)
+		assertErrorCode(t, err, kernel.EInvalid)
+	})
+}
+
+func TestValidationReport_Add(t *testing.T) {
+	ref := importer.ItemRef{File: "export.xml", Line: 12, Item: "post:42"}
+
+	t.Run("counts findings by severity", func(t *testing.T) {
+		report, _ := importer.NewValidationReport("wordpress")
+
+		assertNoError(t, report.AddError(ref, "post.title.length", "Title too short.", "Expand the title."))
+		assertNoError(t, report.AddWarning(ref, "post.seo.description", "Missing SEO description.", ""))
+		assertNoError(t, report.Add(importer.Finding{Ref: ref, Severity: importer.SeverityInfo, Rule: "post.slug", Message: "Slug regenerated."}))
+
+		if report.Count(importer.SeverityError) != 1 || report.Count(importer.SeverityWarning) != 1 {
+			t.Errorf("unexpected counts: %s", report)
+		}
+		if !report.HasErrors() {
+			t.Error("expected report to have errors")
+		}
+	})
+
+	t.Run("converts domain errors", func(t *testing.T) {
+		report, _ := importer.NewValidationReport("ghost")
+		domainErr := &kernel.Error{Operation: "NewTitle", Cause: &kernel.Error{Code: kernel.EInvalid, Message: "Title is too short."}}
+
+		assertNoError(t, report.AddDomainError(ref, "post.title", domainErr))
+
+		if report.Findings[0].Message != "Title is too short." {
+			t.Errorf("Message: got %q", report.Findings[0].Message)
+		}
+	})
+
+	t.Run("rejects incomplete findings", func(t *testing.T) {
+		tests := []struct {
+			name    string
+			finding importer.Finding
+		}{
+			{name: "invalid severity", finding: importer.Finding{Severity: "fatal", Rule: "r", Message: "m"}},
+			{name: "missing rule", finding: importer.Finding{Severity: importer.SeverityError, Message: "m"}},
+			{name: "missing message", finding: importer.Finding{Severity: importer.SeverityError, Rule: "r"}},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				report, _ := importer.NewValidationReport("csv")
+
+				err := report.Add(tt.finding)
+
+				assertError(t, err)
+				assertErrorCode(t, err, kernel.EInvalid)
+			})
+		}
+	})
+}
+
+func TestValidationReport_ToJSON(t *testing.T) {
+	report, _ := importer.NewValidationReport("csv-subscribers")
+	_ = report.AddError(importer.ItemRef{File: "subs.csv", Line: 3}, "subscriber.email.format", "Invalid email format.", "Fix the address.")
+
+	data, err := report.ToJSON()
+
+	assertNoError(t, err)
+	var decoded importer.ValidationReport
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("invalid JSON: %v", err)
+	}
+	if decoded.Source != "csv-subscribers" || len(decoded.Findings) != 1 {
+		t.Errorf("unexpected decoded report: %+v", decoded)
+	}
+	if decoded.Findings[0].SuggestedFix != "Fix the address." {
+		t.Errorf("SuggestedFix: got %q", decoded.Findings[0].SuggestedFix)
+	}
+}
+
+func TestItemRef_String(t *testing.T) {
+	tests := []struct {
+		ref  importer.ItemRef
+		want string
+	}{
+		{importer.ItemRef{File: "a.xml", Line: 4, Item: "post:1"}, "a.xml:4"},
+		{importer.ItemRef{File: "a.xml", Item: "post:1"}, "a.xml"},
+		{importer.ItemRef{Item: "post:1"}, "post:1"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.ref.String(); got != tt.want {
+			t.Errorf("got %q, want %q", got, tt.want)
+		}
+	}
+}
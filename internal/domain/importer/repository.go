@@ -0,0 +1,34 @@
+package importer
+
+import (
+	"github.com/alnah/fla/internal/domain/category"
+	"github.com/alnah/fla/internal/domain/post"
+	"github.com/alnah/fla/internal/domain/shared"
+	"github.com/alnah/fla/internal/domain/tag"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+// UserFinder matches export authors to existing accounts.
+type UserFinder interface {
+	// GetUserByEmail retrieves the account using an email address.
+	// Returns ENotFound when no account uses it.
+	GetUserByEmail(email shared.Email) (*user.User, error)
+}
+
+// CategoryStore finds categories already in place and creates missing ones.
+type CategoryStore interface {
+	category.CategoryPathBuilder
+	category.CategoryWriter
+}
+
+// TagStore finds tags already in place and creates missing ones.
+type TagStore interface {
+	tag.TagReader
+	tag.TagWriter
+}
+
+// PostStore creates imported posts after checking their slugs are free.
+type PostStore interface {
+	post.PostWriter
+	post.PostValidator
+}
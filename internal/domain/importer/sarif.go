@@ -0,0 +1,149 @@
+package importer
+
+import (
+	"encoding/json"
+	"slices"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+)
+
+const (
+	SARIFVersion = "2.1.0"
+	SARIFSchema  = "https://json.schemastore.org/sarif-2.1.0.json"
+)
+
+// SARIF 2.1.0 subset sufficient for CI annotation of import findings.
+type (
+	sarifLog struct {
+		Version string     `json:"version"`
+		Schema  string     `json:"$schema"`
+		Runs    []sarifRun `json:"runs"`
+	}
+
+	sarifRun struct {
+		Tool    sarifTool     `json:"tool"`
+		Results []sarifResult `json:"results"`
+	}
+
+	sarifTool struct {
+		Driver sarifDriver `json:"driver"`
+	}
+
+	sarifDriver struct {
+		Name  string      `json:"name"`
+		Rules []sarifRule `json:"rules"`
+	}
+
+	sarifRule struct {
+		ID string `json:"id"`
+	}
+
+	sarifResult struct {
+		RuleID    string          `json:"ruleId"`
+		Level     string          `json:"level"`
+		Message   sarifText       `json:"message"`
+		Locations []sarifLocation `json:"locations,omitempty"`
+		// SARIF fixes require artifact changes, so the suggestion travels as a property
+		Properties *sarifProperties `json:"properties,omitempty"`
+	}
+
+	sarifProperties struct {
+		SuggestedFix string `json:"suggestedFix,omitempty"`
+	}
+
+	sarifText struct {
+		Text string `json:"text"`
+	}
+
+	sarifLocation struct {
+		PhysicalLocation *sarifPhysicalLocation `json:"physicalLocation,omitempty"`
+		LogicalLocations []sarifLogicalLocation `json:"logicalLocations,omitempty"`
+	}
+
+	sarifPhysicalLocation struct {
+		ArtifactLocation sarifArtifact `json:"artifactLocation"`
+		Region           *sarifRegion  `json:"region,omitempty"`
+	}
+
+	sarifArtifact struct {
+		URI string `json:"uri"`
+	}
+
+	sarifRegion struct {
+		StartLine int `json:"startLine"`
+	}
+
+	sarifLogicalLocation struct {
+		FullyQualifiedName string `json:"fullyQualifiedName"`
+	}
+)
+
+// ToSARIF serializes the report as a SARIF 2.1.0 log with a single run.
+// Lets code-scanning tools annotate export files line by line.
+func (r *ValidationReport) ToSARIF() ([]byte, error) {
+	const op = "ValidationReport.ToSARIF"
+
+	run := sarifRun{
+		Tool:    sarifTool{Driver: sarifDriver{Name: "fla-import-" + r.Source, Rules: r.sarifRules()}},
+		Results: make([]sarifResult, 0, len(r.Findings)),
+	}
+
+	for _, f := range r.Findings {
+		run.Results = append(run.Results, f.toSARIF())
+	}
+
+	log := sarifLog{Version: SARIFVersion, Schema: SARIFSchema, Runs: []sarifRun{run}}
+
+	data, err := json.MarshalIndent(log, "", "  ")
+	if err != nil {
+		return nil, &kernel.Error{Code: kernel.EInternal, Message: MReportSerializationFail, Operation: op, Cause: err}
+	}
+
+	return data, nil
+}
+
+// sarifRules lists distinct rule IDs in a stable order.
+func (r *ValidationReport) sarifRules() []sarifRule {
+	ids := make([]string, 0, len(r.Findings))
+	for _, f := range r.Findings {
+		if !slices.Contains(ids, f.Rule) {
+			ids = append(ids, f.Rule)
+		}
+	}
+	slices.Sort(ids)
+
+	rules := make([]sarifRule, len(ids))
+	for i, id := range ids {
+		rules[i] = sarifRule{ID: id}
+	}
+	return rules
+}
+
+// toSARIF converts a finding into a SARIF result.
+func (f Finding) toSARIF() sarifResult {
+	result := sarifResult{
+		RuleID:  f.Rule,
+		Level:   f.Severity.sarifLevel(),
+		Message: sarifText{Text: f.Message},
+	}
+
+	var loc sarifLocation
+	if f.Ref.File != "" {
+		loc.PhysicalLocation = &sarifPhysicalLocation{ArtifactLocation: sarifArtifact{URI: f.Ref.File}}
+		if f.Ref.Line > 0 {
+			loc.PhysicalLocation.Region = &sarifRegion{StartLine: f.Ref.Line}
+		}
+	}
+	if f.Ref.Item != "" {
+		loc.LogicalLocations = []sarifLogicalLocation{{FullyQualifiedName: f.Ref.Item}}
+	}
+	if loc.PhysicalLocation != nil || loc.LogicalLocations != nil {
+		result.Locations = []sarifLocation{loc}
+	}
+
+	if f.SuggestedFix != "" {
+		result.Properties = &sarifProperties{SuggestedFix: f.SuggestedFix}
+	}
+
+	return result
+}
@@ -0,0 +1,84 @@
+package importer_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/alnah/fla/internal/domain/importer"
+)
+
+func TestValidationReport_ToSARIF(t *testing.T) {
+	report, _ := importer.NewValidationReport("wordpress")
+	_ = report.AddError(importer.ItemRef{File: "export.xml", Line: 7, Item: "post:9"}, "post.title.length", "Title too short.", "Expand the title.")
+	_ = report.AddWarning(importer.ItemRef{Item: "tag:3"}, "tag.unused", "Tag has no posts.", "")
+	_ = report.AddError(importer.ItemRef{File: "export.xml", Line: 20}, "post.title.length", "Title too long.", "")
+
+	data, err := report.ToSARIF()
+	assertNoError(t, err)
+
+	var log struct {
+		Version string `json:"version"`
+		Runs    []struct {
+			Tool struct {
+				Driver struct {
+					Name  string `json:"name"`
+					Rules []struct {
+						ID string `json:"id"`
+					} `json:"rules"`
+				} `json:"driver"`
+			} `json:"tool"`
+			Results []struct {
+				RuleID    string `json:"ruleId"`
+				Level     string `json:"level"`
+				Locations []struct {
+					PhysicalLocation *struct {
+						Region *struct {
+							StartLine int `json:"startLine"`
+						} `json:"region"`
+					} `json:"physicalLocation"`
+				} `json:"locations"`
+				Fixes      []json.RawMessage `json:"fixes"`
+				Properties *struct {
+					SuggestedFix string `json:"suggestedFix"`
+				} `json:"properties"`
+			} `json:"results"`
+		} `json:"runs"`
+	}
+	if err := json.Unmarshal(data, &log); err != nil {
+		t.Fatalf("invalid SARIF JSON: %v", err)
+	}
+
+	if log.Version != importer.SARIFVersion {
+		t.Errorf("Version: got %q, want %q", log.Version, importer.SARIFVersion)
+	}
+	run := log.Runs[0]
+	if run.Tool.Driver.Name != "fla-import-wordpress" {
+		t.Errorf("driver name: got %q", run.Tool.Driver.Name)
+	}
+	if len(run.Tool.Driver.Rules) != 2 {
+		t.Errorf("expected 2 distinct rules, got %d", len(run.Tool.Driver.Rules))
+	}
+	if len(run.Results) != 3 {
+		t.Fatalf("expected 3 results, got %d", len(run.Results))
+	}
+	if run.Results[0].Level != "error" || run.Results[1].Level != "warning" {
+		t.Errorf("unexpected levels: %q, %q", run.Results[0].Level, run.Results[1].Level)
+	}
+	if got := run.Results[0].Locations[0].PhysicalLocation.Region.StartLine; got != 7 {
+		t.Errorf("StartLine: got %d, want 7", got)
+	}
+	if run.Results[1].Locations[0].PhysicalLocation != nil {
+		t.Error("expected logical-only location for item without file")
+	}
+	for i, r := range run.Results {
+		if r.Fixes != nil {
+			t.Errorf("result %d: expected no SARIF fixes, which require artifact changes", i)
+		}
+	}
+	if p := run.Results[0].Properties; p == nil || p.SuggestedFix != "Expand the title." {
+		t.Errorf("expected the suggestion in properties, got %+v", p)
+	}
+	if run.Results[2].Properties != nil {
+		t.Error("expected properties only for findings with suggestions")
+	}
+}
@@ -0,0 +1,556 @@
+package importer
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/alnah/fla/internal/domain/category"
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/post"
+	"github.com/alnah/fla/internal/domain/shared"
+	"github.com/alnah/fla/internal/domain/tag"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+const (
+	MImportForbidden        string = "Only admins can import content."
+	MImportHasErrors        string = "Import has blocking findings; fix them and run the dry run again."
+	MImportAuthorUnknown    string = "No user has the email %s."
+	MImportCategoryCycle    string = "Category %s is its own ancestor."
+	MImportCategoryParent   string = "Parent category %s could not be imported."
+	MImportCategoryDepth    string = "Category %s is deeper than %d levels."
+	MImportStatusSkipped    string = "Status %q has no equivalent; the post was skipped."
+	MImportStatusDowngraded string = "Status %q was imported as draft."
+	MImportPublishedMissing string = "Published post has no publication date; the import time was used."
+	MImportSchedulePassed   string = "Scheduled date has passed; the post was imported as published."
+	MImportAuthorMissing    string = "Author %q was not matched to a user."
+	MImportCategoryMissing  string = "Post has no category."
+	MImportCategoryRejected string = "Category %s could not be imported."
+	MImportCategoryExtra    string = "Post has %d categories; only %s was kept."
+	MImportFieldDropped     string = "%s dropped: %s"
+	MImportSlugTaken        string = "Another post already uses the slug %s."
+)
+
+// Finding rules, stable so CI pipelines can filter on them.
+const (
+	RuleAuthorEmail    = "author.email"
+	RuleAuthorUnknown  = "author.unknown"
+	RuleCategoryName   = "category.name"
+	RuleCategoryParent = "category.parent"
+	RuleCategoryDepth  = "category.depth"
+	RuleTagName        = "tag.name"
+	RulePost           = "post"
+	RulePostStatus     = "post.status"
+	RulePostAuthor     = "post.author"
+	RulePostCategory   = "post.category"
+	RulePostTitle      = "post.title"
+	RulePostContent    = "post.content"
+	RulePostOptional   = "post.optional_field"
+	RulePostSlug       = "post.slug"
+)
+
+// sourceStatuses maps each platform's post statuses onto the workflow.
+// Statuses missing here (trash, auto-draft, ...) are skipped.
+var sourceStatuses = map[Format]map[string]post.Status{
+	FormatWordPress: {
+		"publish": post.StatusPublished,
+		"future":  post.StatusScheduled,
+		"draft":   post.StatusDraft,
+		"pending": post.StatusDraft,
+		"private": post.StatusDraft,
+	},
+	FormatGhost: {
+		"published": post.StatusPublished,
+		"scheduled": post.StatusScheduled,
+		"draft":     post.StatusDraft,
+	},
+}
+
+// downgradedStatuses have no workflow equivalent and become drafts, with a warning.
+var downgradedStatuses = []string{"pending", "private"}
+
+// Plan is the outcome of a dry run: what Commit would write, and why the rest was left out.
+// Existing categories and tags are reused and do not appear in the plan.
+type Plan struct {
+	Report     *ValidationReport
+	Categories []category.Category // Parents before children
+	Tags       []tag.Tag
+	Posts      []post.Post
+}
+
+// Ready reports whether the plan can be committed.
+func (p Plan) Ready() bool {
+	return p.Report != nil && !p.Report.HasErrors()
+}
+
+// String returns a summary of the plan.
+func (p Plan) String() string {
+	return fmt.Sprintf("Plan{Categories: %d, Tags: %d, Posts: %d, Report: %s}",
+		len(p.Categories), len(p.Tags), len(p.Posts), p.Report)
+}
+
+// ImportService maps parsed export files onto the domain.
+// Imports run in two steps: DryRun validates everything and reports problems
+// without writing; Commit then writes a clean plan through the repositories.
+type ImportService struct {
+	users      UserFinder
+	categories CategoryStore
+	tags       TagStore
+	posts      PostStore
+	clock      kernel.Clock
+}
+
+// NewImportService creates import service with repositories and clock.
+func NewImportService(users UserFinder, categories CategoryStore, tags TagStore, posts PostStore, clock kernel.Clock) *ImportService {
+	return &ImportService{users: users, categories: categories, tags: tags, posts: posts, clock: clock}
+}
+
+// DryRun builds domain objects from an export without writing anything.
+// Validation problems become report findings; only repository failures are returned as errors.
+// Domain IDs derive from source refs (e.g. "wordpress-post-42") so reruns stay stable.
+func (s *ImportService) DryRun(export Export, actor user.PostPermissionChecker) (Plan, error) {
+	const op = "ImportService.DryRun"
+
+	if !user.IsActiveAdmin(actor) {
+		return Plan{}, &kernel.Error{Code: kernel.EForbidden, Message: MImportForbidden, Operation: op}
+	}
+
+	report, err := NewValidationReport(export.Format.String())
+	if err != nil {
+		return Plan{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	p := &planner{
+		service:          s,
+		export:           export,
+		actor:            actor.GetID(),
+		plan:             Plan{Report: report},
+		authors:          make(map[string]kernel.ID[user.User]),
+		sourceCategories: make(map[string]ExportCategory),
+		categories:       make(map[string]plannedCategory),
+		rejected:         make(map[string]bool),
+		tags:             make(map[string]kernel.ID[tag.Tag]),
+		tagSlugs:         make(map[shared.Slug]kernel.ID[tag.Tag]),
+		postSlugs:        make(map[shared.Slug]bool),
+	}
+
+	steps := []func() error{p.planAuthors, p.planCategories, p.planTags, p.planPosts}
+	for _, step := range steps {
+		if err := step(); err != nil {
+			return Plan{}, &kernel.Error{Operation: op, Cause: err}
+		}
+	}
+
+	return p.plan, nil
+}
+
+// Commit writes a dry-run plan: categories first, then tags, then posts.
+// It is not transactional; after a failure, run the dry run again, which
+// reuses what was written and reports already imported posts as duplicates.
+func (s *ImportService) Commit(plan Plan, actor user.PostPermissionChecker) error {
+	const op = "ImportService.Commit"
+
+	if !user.IsActiveAdmin(actor) {
+		return &kernel.Error{Code: kernel.EForbidden, Message: MImportForbidden, Operation: op}
+	}
+
+	if !plan.Ready() {
+		return &kernel.Error{Code: kernel.EInvalid, Message: MImportHasErrors, Operation: op}
+	}
+
+	for _, c := range plan.Categories {
+		if err := s.categories.Create(c); err != nil {
+			return &kernel.Error{Operation: op, Cause: err}
+		}
+	}
+
+	for _, t := range plan.Tags {
+		if err := s.tags.Create(t); err != nil {
+			return &kernel.Error{Operation: op, Cause: err}
+		}
+	}
+
+	for _, p := range plan.Posts {
+		if err := s.posts.Create(p); err != nil {
+			return &kernel.Error{Operation: op, Cause: err}
+		}
+	}
+
+	return nil
+}
+
+// plannedCategory is a category the plan reuses or creates, with its slug path.
+type plannedCategory struct {
+	category category.Category
+	path     []string // Slugs from the root, as FindByPath expects
+}
+
+// planner holds the state of one dry run.
+type planner struct {
+	service *ImportService
+	export  Export
+	actor   kernel.ID[user.User]
+	plan    Plan
+
+	authors          map[string]kernel.ID[user.User] // By author ref; unmatched authors are absent
+	sourceCategories map[string]ExportCategory
+	categories       map[string]plannedCategory // By category ref
+	rejected         map[string]bool            // Category refs already reported as invalid
+	tags             map[string]kernel.ID[tag.Tag]
+	tagSlugs         map[shared.Slug]kernel.ID[tag.Tag]
+	postSlugs        map[shared.Slug]bool
+}
+
+// Findings built here always carry a severity, rule, and message, so adding them cannot fail.
+
+func (p *planner) reportError(ref ItemRef, rule, message, fix string) {
+	_ = p.plan.Report.AddError(ref, rule, message, fix)
+}
+
+func (p *planner) reportWarning(ref ItemRef, rule, message, fix string) {
+	_ = p.plan.Report.AddWarning(ref, rule, message, fix)
+}
+
+func (p *planner) reportInfo(ref ItemRef, rule, message string) {
+	_ = p.plan.Report.Add(Finding{Ref: ref, Severity: SeverityInfo, Rule: rule, Message: message})
+}
+
+func (p *planner) reportDomainError(ref ItemRef, rule string, err error) {
+	_ = p.plan.Report.AddDomainError(ref, rule, err)
+}
+
+// id derives a stable domain ID from a source ref, e.g. "ghost-tag-grammaire".
+func id[T any](format Format, kind, ref string) kernel.ID[T] {
+	return kernel.ID[T](format.String() + "-" + kind + "-" + ref)
+}
+
+// planAuthors matches authors to users by email. Unmatched authors are only a
+// warning here: the posts they wrote get the blocking finding.
+func (p *planner) planAuthors() error {
+	const op = "planner.planAuthors"
+
+	for _, a := range p.export.Authors {
+		ref := p.export.itemRef("author", a.Ref, 0)
+
+		email, err := shared.NewEmail(a.Email)
+		if err != nil {
+			p.reportDomainError(ref, RuleAuthorEmail, err)
+			continue
+		}
+
+		u, err := p.service.users.GetUserByEmail(email)
+		if kernel.ErrorCode(err) == kernel.ENotFound {
+			p.reportWarning(ref, RuleAuthorUnknown, fmt.Sprintf(MImportAuthorUnknown, email), "Invite the author before importing their posts.")
+			continue
+		}
+		if err != nil {
+			return &kernel.Error{Operation: op, Cause: err}
+		}
+
+		p.authors[a.Ref] = u.ID
+	}
+
+	return nil
+}
+
+// planCategories reuses categories already at the same path and plans the rest.
+func (p *planner) planCategories() error {
+	for _, c := range p.export.Categories {
+		p.sourceCategories[c.Ref] = c
+	}
+
+	for _, c := range p.export.Categories {
+		if _, _, err := p.planCategory(c.Ref, make(map[string]bool)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// planCategory resolves a category after its ancestors. Each invalid category
+// is reported once; its descendants are reported as having a rejected parent.
+func (p *planner) planCategory(ref string, visiting map[string]bool) (plannedCategory, bool, error) {
+	const op = "planner.planCategory"
+
+	if c, ok := p.categories[ref]; ok {
+		return c, true, nil
+	}
+
+	source, ok := p.sourceCategories[ref]
+	if !ok || p.rejected[ref] {
+		return plannedCategory{}, false, nil
+	}
+
+	item := p.export.itemRef("category", ref, source.Line)
+	reject := func(rule, message string) (plannedCategory, bool, error) {
+		p.rejected[ref] = true
+		p.reportError(item, rule, message, "")
+		return plannedCategory{}, false, nil
+	}
+
+	if visiting[ref] {
+		return reject(RuleCategoryParent, fmt.Sprintf(MImportCategoryCycle, ref))
+	}
+	visiting[ref] = true
+
+	var parent *plannedCategory
+	if source.ParentRef != "" {
+		planned, ok, err := p.planCategory(source.ParentRef, visiting)
+		if err != nil {
+			return plannedCategory{}, false, err
+		}
+		if !ok {
+			if p.rejected[ref] { // Reported while walking a cycle
+				return plannedCategory{}, false, nil
+			}
+			return reject(RuleCategoryParent, fmt.Sprintf(MImportCategoryParent, source.ParentRef))
+		}
+		parent = &planned
+	}
+
+	name, err := category.NewCategoryName(source.Name)
+	if err != nil {
+		p.rejected[ref] = true
+		p.reportDomainError(item, RuleCategoryName, err)
+		return plannedCategory{}, false, nil
+	}
+
+	slug, err := shared.NewSlug(name.String())
+	if err != nil {
+		p.rejected[ref] = true
+		p.reportDomainError(item, RuleCategoryName, err)
+		return plannedCategory{}, false, nil
+	}
+
+	planned := plannedCategory{path: []string{slug.String()}}
+	var parentID *kernel.ID[category.Category]
+	if parent != nil {
+		planned.path = append(slices.Clone(parent.path), slug.String())
+		parentID = &parent.category.CategoryID
+	}
+
+	if len(planned.path) > category.MaxCategoryDepth {
+		return reject(RuleCategoryDepth, fmt.Sprintf(MImportCategoryDepth, ref, category.MaxCategoryDepth))
+	}
+
+	existing, err := p.service.categories.FindByPath(planned.path)
+	switch {
+	case err == nil:
+		planned.category = *existing
+	case kernel.ErrorCode(err) == kernel.ENotFound:
+		created, err := category.NewCategory(category.NewCategoryParams{
+			CategoryID: id[category.Category](p.export.Format, "category", ref),
+			Name:       name,
+			CreatedBy:  p.actor,
+			ParentID:   parentID,
+			Clock:      p.service.clock,
+		})
+		if err != nil {
+			p.rejected[ref] = true
+			p.reportDomainError(item, RuleCategoryName, err)
+			return plannedCategory{}, false, nil
+		}
+		planned.category = created
+		p.plan.Categories = append(p.plan.Categories, created)
+	default:
+		return plannedCategory{}, false, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	p.categories[ref] = planned
+	return planned, true, nil
+}
+
+// planTags reuses tags with the same slug and plans the rest.
+// Source tags whose names slug the same are merged into one.
+func (p *planner) planTags() error {
+	const op = "planner.planTags"
+
+	for _, t := range p.export.Tags {
+		item := p.export.itemRef("tag", t.Ref, t.Line)
+
+		name, err := tag.NewTagName(t.Name)
+		if err != nil {
+			p.reportDomainError(item, RuleTagName, err)
+			continue
+		}
+
+		slug, err := shared.NewSlug(name.String())
+		if err != nil {
+			p.reportDomainError(item, RuleTagName, err)
+			continue
+		}
+
+		if tagID, ok := p.tagSlugs[slug]; ok {
+			p.tags[t.Ref] = tagID
+			continue
+		}
+
+		existing, err := p.service.tags.GetBySlug(slug)
+		switch {
+		case err == nil:
+			p.tags[t.Ref] = existing.TagID
+		case kernel.ErrorCode(err) == kernel.ENotFound:
+			created, err := tag.NewTag(tag.Tag{
+				TagID:     id[tag.Tag](p.export.Format, "tag", t.Ref),
+				Name:      name,
+				Slug:      slug,
+				CreatedBy: p.actor,
+				CreatedAt: p.service.clock.Now(),
+			})
+			if err != nil {
+				p.reportDomainError(item, RuleTagName, err)
+				continue
+			}
+			p.tags[t.Ref] = created.TagID
+			p.plan.Tags = append(p.plan.Tags, created)
+		default:
+			return &kernel.Error{Operation: op, Cause: err}
+		}
+
+		p.tagSlugs[slug] = p.tags[t.Ref]
+	}
+
+	return nil
+}
+
+// planPosts validates every post, collecting all of its problems before moving on.
+func (p *planner) planPosts() error {
+	for _, source := range p.export.Posts {
+		if err := p.planPost(source); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *planner) planPost(source ExportPost) error {
+	const op = "planner.planPost"
+
+	item := p.export.itemRef("post", source.Ref, source.Line)
+
+	status, ok := sourceStatuses[p.export.Format][source.Status]
+	if !ok {
+		p.reportInfo(item, RulePostStatus, fmt.Sprintf(MImportStatusSkipped, source.Status))
+		return nil
+	}
+
+	if slices.Contains(downgradedStatuses, source.Status) {
+		p.reportWarning(item, RulePostStatus, fmt.Sprintf(MImportStatusDowngraded, source.Status), "Review the draft before publishing it.")
+	}
+
+	now := p.service.clock.Now()
+	publishedAt := source.PublishedAt
+	switch {
+	case status == post.StatusDraft:
+		publishedAt = nil
+	case status == post.StatusPublished && publishedAt == nil:
+		publishedAt = &now
+		p.reportWarning(item, RulePostStatus, MImportPublishedMissing, "")
+	case status == post.StatusScheduled && publishedAt != nil && !publishedAt.After(now):
+		status = post.StatusPublished
+		p.reportInfo(item, RulePostStatus, MImportSchedulePassed)
+	}
+
+	valid := true
+
+	owner, ok := p.authors[source.AuthorRef]
+	if !ok {
+		valid = false
+		p.reportError(item, RulePostAuthor, fmt.Sprintf(MImportAuthorMissing, source.AuthorRef), "Create an account with the author's email, then run the import again.")
+	}
+
+	var postCategory category.Category
+	switch {
+	case len(source.CategoryRefs) == 0:
+		valid = false
+		p.reportError(item, RulePostCategory, MImportCategoryMissing, "Assign a category in the source blog before exporting.")
+	default:
+		planned, ok := p.categories[source.CategoryRefs[0]]
+		if !ok {
+			valid = false
+			p.reportError(item, RulePostCategory, fmt.Sprintf(MImportCategoryRejected, source.CategoryRefs[0]), "")
+			break
+		}
+		postCategory = planned.category
+		if len(source.CategoryRefs) > 1 {
+			p.reportWarning(item, RulePostCategory, fmt.Sprintf(MImportCategoryExtra, len(source.CategoryRefs), source.CategoryRefs[0]), "Move the other categories into tags.")
+		}
+	}
+
+	var tags post.PostTags
+	for _, ref := range source.TagRefs {
+		if tagID, ok := p.tags[ref]; ok && !tags.Contains(tagID) {
+			tags = append(tags, tagID)
+		}
+	}
+
+	title, err := shared.NewTitle(source.Title)
+	if err != nil {
+		valid = false
+		p.reportDomainError(item, RulePostTitle, err)
+	}
+
+	content, err := post.NewPostContent(strings.TrimSpace(source.Content))
+	if err != nil {
+		valid = false
+		p.reportDomainError(item, RulePostContent, err)
+	}
+
+	if !valid {
+		return nil
+	}
+
+	params := post.NewPostParams{
+		PostID:         id[post.Post](p.export.Format, "post", source.Ref),
+		Owner:          owner,
+		Title:          title,
+		Content:        content,
+		FeaturedImage:  optional(p, item, "Featured image", source.FeaturedImage, kernel.NewURL[post.FeaturedImage]),
+		Status:         status,
+		Category:       postCategory,
+		PublishedAt:    publishedAt,
+		Excerpt:        optional(p, item, "Excerpt", strings.TrimSpace(source.Excerpt), post.NewExcerpt),
+		Tags:           tags,
+		SEOTitle:       optional(p, item, "SEO title", source.SEOTitle, shared.NewTitle),
+		SEODescription: optional(p, item, "SEO description", source.SEODescription, shared.NewDescription),
+		Clock:          p.service.clock,
+	}
+
+	imported, err := post.NewPost(params)
+	if err != nil {
+		p.reportDomainError(item, RulePost, err)
+		return nil
+	}
+
+	unique, err := p.service.posts.IsSlugUnique(imported.Slug, nil)
+	if err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+	if !unique || p.postSlugs[imported.Slug] {
+		p.reportError(item, RulePostSlug, fmt.Sprintf(MImportSlugTaken, imported.Slug), "Rename one of the posts.")
+		return nil
+	}
+	p.postSlugs[imported.Slug] = true
+
+	p.plan.Posts = append(p.plan.Posts, imported)
+	return nil
+}
+
+// optional validates a field the post can do without: invalid values are
+// dropped with a warning instead of blocking the post.
+func optional[T any](p *planner, item ItemRef, field, value string, parse func(string) (T, error)) T {
+	var zero T
+	if value == "" {
+		return zero
+	}
+
+	parsed, err := parse(value)
+	if err != nil {
+		p.reportWarning(item, RulePostOptional, fmt.Sprintf(MImportFieldDropped, field, kernel.ErrorMessage(err)), "")
+		return zero
+	}
+
+	return parsed
+}
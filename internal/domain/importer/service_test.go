@@ -0,0 +1,287 @@
+package importer_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/category"
+	"github.com/alnah/fla/internal/domain/importer"
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/post"
+	"github.com/alnah/fla/internal/domain/shared"
+	"github.com/alnah/fla/internal/domain/tag"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+var (
+	importNow   = time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	publishedAt = time.Date(2024, 3, 5, 9, 0, 0, 0, time.UTC)
+	lessonBody  = strings.Repeat("Le samedi, je vais au marché avec ma sœur. ", 10)
+)
+
+type importFixture struct {
+	service    *importer.ImportService
+	categories *stubCategories
+	tags       *stubTags
+	posts      *stubPosts
+}
+
+func newImportFixture() importFixture {
+	f := importFixture{
+		categories: &stubCategories{existing: map[string]category.Category{
+			"a1": {CategoryID: "a1-level", Name: "A1", Slug: "a1"},
+		}},
+		tags: &stubTags{existing: map[shared.Slug]tag.Tag{
+			"grammaire": {TagID: "grammar", Name: "Grammaire", Slug: "grammaire"},
+		}},
+		posts: &stubPosts{taken: map[shared.Slug]bool{"deja-publie-sur-le-site": true}},
+	}
+	users := stubUsers{"marie@example.com": {ID: "marie", Email: "marie@example.com", Roles: []user.Role{user.RoleAuthor}}}
+	f.service = importer.NewImportService(users, f.categories, f.tags, f.posts, &stubClock{importNow})
+	return f
+}
+
+func validExport() importer.Export {
+	return importer.Export{
+		Format:  importer.FormatWordPress,
+		File:    "export.xml",
+		Authors: []importer.ExportAuthor{{Ref: "marie", Email: "marie@example.com"}},
+		Categories: []importer.ExportCategory{
+			{Ref: "lecture", ParentRef: "a1", Name: "Lecture", Line: 11},
+			{Ref: "a1", Name: "A1", Line: 10},
+		},
+		Tags: []importer.ExportTag{
+			{Ref: "grammaire", Name: "Grammaire"},
+			{Ref: "vocabulaire", Name: "Vocabulaire"},
+		},
+		Posts: []importer.ExportPost{{
+			Ref:          "42",
+			Line:         13,
+			Title:        "Au marché le samedi",
+			Content:      lessonBody,
+			Status:       "publish",
+			AuthorRef:    "marie",
+			PublishedAt:  &publishedAt,
+			CategoryRefs: []string{"lecture"},
+			TagRefs:      []string{"grammaire", "vocabulaire"},
+		}},
+	}
+}
+
+func TestImportService_DryRun(t *testing.T) {
+	t.Run("plans new records and reuses existing ones", func(t *testing.T) {
+		f := newImportFixture()
+
+		plan, err := f.service.DryRun(validExport(), admin())
+
+		assertNoError(t, err)
+		if !plan.Ready() {
+			t.Fatalf("expected ready plan, got findings %+v", plan.Report.Findings)
+		}
+		if len(plan.Categories) != 1 || plan.Categories[0].CategoryID != "wordpress-category-lecture" {
+			t.Fatalf("categories: got %+v", plan.Categories)
+		}
+		if parent := plan.Categories[0].ParentID; parent == nil || *parent != "a1-level" {
+			t.Errorf("parent: got %v", parent)
+		}
+		if len(plan.Tags) != 1 || plan.Tags[0].TagID != "wordpress-tag-vocabulaire" {
+			t.Errorf("tags: got %+v", plan.Tags)
+		}
+
+		p := plan.Posts[0]
+		if p.PostID != "wordpress-post-42" || p.Owner != "marie" || p.Status != post.StatusPublished {
+			t.Errorf("post: got %s", p)
+		}
+		if p.Category.CategoryID != "wordpress-category-lecture" || !p.Tags.Contains("grammar") {
+			t.Errorf("post taxonomy: got %v, %v", p.Category.CategoryID, p.Tags)
+		}
+		if len(f.categories.created)+len(f.tags.created)+len(f.posts.created) != 0 {
+			t.Error("dry run must not write")
+		}
+	})
+
+	t.Run("only admins can import", func(t *testing.T) {
+		_, err := newImportFixture().service.DryRun(validExport(), editor())
+
+		assertErrorCode(t, err, kernel.EForbidden)
+	})
+
+	tests := []struct {
+		name     string
+		change   func(e *importer.Export)
+		rule     string
+		severity importer.Severity
+		posts    int
+	}{
+		{
+			name:     "unknown author blocks their posts",
+			change:   func(e *importer.Export) { e.Authors[0].Email = "paul@example.com" },
+			rule:     importer.RulePostAuthor,
+			severity: importer.SeverityError,
+		},
+		{
+			name:     "post without category",
+			change:   func(e *importer.Export) { e.Posts[0].CategoryRefs = nil },
+			rule:     importer.RulePostCategory,
+			severity: importer.SeverityError,
+		},
+		{
+			name: "category deeper than three levels",
+			change: func(e *importer.Export) {
+				e.Categories = append(e.Categories,
+					importer.ExportCategory{Ref: "sports", ParentRef: "lecture", Name: "Sports"},
+					importer.ExportCategory{Ref: "football", ParentRef: "sports", Name: "Football"},
+				)
+				e.Posts[0].CategoryRefs = []string{"football"}
+			},
+			rule:     importer.RuleCategoryDepth,
+			severity: importer.SeverityError,
+		},
+		{
+			name: "category cycle",
+			change: func(e *importer.Export) {
+				e.Categories = []importer.ExportCategory{
+					{Ref: "lecture", ParentRef: "oral", Name: "Lecture"},
+					{Ref: "oral", ParentRef: "lecture", Name: "Oral"},
+				}
+			},
+			rule:     importer.RuleCategoryParent,
+			severity: importer.SeverityError,
+		},
+		{
+			name:     "title too short",
+			change:   func(e *importer.Export) { e.Posts[0].Title = "Marché" },
+			rule:     importer.RulePostTitle,
+			severity: importer.SeverityError,
+		},
+		{
+			name:     "slug already used on the site",
+			change:   func(e *importer.Export) { e.Posts[0].Title = "Déjà publié sur le site" },
+			rule:     importer.RulePostSlug,
+			severity: importer.SeverityError,
+		},
+		{
+			name: "same slug twice in the export",
+			change: func(e *importer.Export) {
+				twin := e.Posts[0]
+				twin.Ref = "43"
+				e.Posts = append(e.Posts, twin)
+			},
+			rule:     importer.RulePostSlug,
+			severity: importer.SeverityError,
+			posts:    1,
+		},
+		{
+			name:     "trashed post is skipped",
+			change:   func(e *importer.Export) { e.Posts[0].Status = "trash" },
+			rule:     importer.RulePostStatus,
+			severity: importer.SeverityInfo,
+		},
+		{
+			name:     "pending review becomes draft",
+			change:   func(e *importer.Export) { e.Posts[0].Status = "pending" },
+			rule:     importer.RulePostStatus,
+			severity: importer.SeverityWarning,
+			posts:    1,
+		},
+		{
+			name:     "past schedule is published",
+			change:   func(e *importer.Export) { e.Posts[0].Status = "future" },
+			rule:     importer.RulePostStatus,
+			severity: importer.SeverityInfo,
+			posts:    1,
+		},
+		{
+			name:     "invalid optional field is dropped",
+			change:   func(e *importer.Export) { e.Posts[0].FeaturedImage = "javascript:alert(1)" },
+			rule:     importer.RulePostOptional,
+			severity: importer.SeverityWarning,
+			posts:    1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			export := validExport()
+			tt.change(&export)
+
+			plan, err := newImportFixture().service.DryRun(export, admin())
+
+			assertNoError(t, err)
+			if !hasFinding(plan.Report, tt.rule, tt.severity) {
+				t.Errorf("expected %s finding %q, got %+v", tt.severity, tt.rule, plan.Report.Findings)
+			}
+			if len(plan.Posts) != tt.posts {
+				t.Errorf("posts: got %d, want %d", len(plan.Posts), tt.posts)
+			}
+		})
+	}
+
+	t.Run("pending post keeps no publication date", func(t *testing.T) {
+		export := validExport()
+		export.Posts[0].Status = "pending"
+
+		plan, _ := newImportFixture().service.DryRun(export, admin())
+
+		if p := plan.Posts[0]; p.Status != post.StatusDraft || p.PublishedAt != nil {
+			t.Errorf("got %s, %v", p.Status, p.PublishedAt)
+		}
+	})
+}
+
+func TestImportService_Commit(t *testing.T) {
+	t.Run("writes the plan", func(t *testing.T) {
+		f := newImportFixture()
+		plan, _ := f.service.DryRun(validExport(), admin())
+
+		err := f.service.Commit(plan, admin())
+
+		assertNoError(t, err)
+		if len(f.categories.created) != 1 || len(f.tags.created) != 1 || len(f.posts.created) != 1 {
+			t.Errorf("got %d categories, %d tags, %d posts", len(f.categories.created), len(f.tags.created), len(f.posts.created))
+		}
+	})
+
+	t.Run("refuses plans with errors", func(t *testing.T) {
+		f := newImportFixture()
+		export := validExport()
+		export.Posts[0].Title = "Court"
+		plan, _ := f.service.DryRun(export, admin())
+
+		err := f.service.Commit(plan, admin())
+
+		assertErrorCode(t, err, kernel.EInvalid)
+		if len(f.categories.created) != 0 {
+			t.Error("expected nothing written")
+		}
+	})
+
+	t.Run("surfaces repository failures", func(t *testing.T) {
+		f := newImportFixture()
+		f.posts.err = &kernel.Error{Code: kernel.EInternal, Message: "database down"}
+		plan, _ := f.service.DryRun(validExport(), admin())
+
+		err := f.service.Commit(plan, admin())
+
+		assertErrorCode(t, err, kernel.EInternal)
+	})
+
+	t.Run("only admins can import", func(t *testing.T) {
+		f := newImportFixture()
+		plan, _ := f.service.DryRun(validExport(), admin())
+
+		err := f.service.Commit(plan, editor())
+
+		assertErrorCode(t, err, kernel.EForbidden)
+	})
+}
+
+func hasFinding(report *importer.ValidationReport, rule string, severity importer.Severity) bool {
+	for _, f := range report.Findings {
+		if f.Rule == rule && f.Severity == severity {
+			return true
+		}
+	}
+	return false
+}
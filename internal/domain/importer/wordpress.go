@@ -0,0 +1,241 @@
+package importer
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+)
+
+// wxrDateLayout is the format of wp:post_date_gmt; drafts carry "0000-00-00 00:00:00".
+const wxrDateLayout = "2006-01-02 15:04:05"
+
+// WXR subset: only what maps onto the domain. Elements are matched by local
+// name so every WXR version (1.0 to 1.2) decodes the same way.
+type (
+	wxrFile struct {
+		Channel *wxrChannel `xml:"channel"`
+	}
+
+	wxrChannel struct {
+		Authors    []wxrAuthor   `xml:"author"`
+		Categories []wxrCategory `xml:"category"`
+		Tags       []wxrTag      `xml:"tag"`
+		Items      []wxrItem     `xml:"item"`
+	}
+
+	wxrAuthor struct {
+		Login       string `xml:"author_login"`
+		Email       string `xml:"author_email"`
+		DisplayName string `xml:"author_display_name"`
+	}
+
+	wxrCategory struct {
+		Nicename string `xml:"category_nicename"`
+		Parent   string `xml:"category_parent"`
+		Name     string `xml:"cat_name"`
+	}
+
+	wxrTag struct {
+		Slug string `xml:"tag_slug"`
+		Name string `xml:"tag_name"`
+	}
+
+	wxrItem struct {
+		Title         string       `xml:"title"`
+		Creator       string       `xml:"creator"`
+		Encoded       []wxrEncoded `xml:"encoded"` // content:encoded and excerpt:encoded
+		PostID        string       `xml:"post_id"`
+		PostDateGMT   string       `xml:"post_date_gmt"`
+		Status        string       `xml:"status"`
+		PostType      string       `xml:"post_type"`
+		AttachmentURL string       `xml:"attachment_url"`
+		Terms         []wxrTerm    `xml:"category"`
+		Meta          []wxrMeta    `xml:"postmeta"`
+	}
+
+	wxrEncoded struct {
+		XMLName xml.Name
+		Value   string `xml:",chardata"`
+	}
+
+	wxrTerm struct {
+		Domain   string `xml:"domain,attr"` // "category" or "post_tag"
+		Nicename string `xml:"nicename,attr"`
+		Name     string `xml:",chardata"`
+	}
+
+	wxrMeta struct {
+		Key   string `xml:"meta_key"`
+		Value string `xml:"meta_value"`
+	}
+)
+
+// Post meta keys carrying data the domain models.
+const (
+	wxrMetaThumbnail      = "_thumbnail_id"
+	wxrMetaSEOTitle       = "_yoast_wpseo_title"
+	wxrMetaSEODescription = "_yoast_wpseo_metadesc"
+)
+
+// ParseWordPress reads a WXR export. Only posts are kept: pages, attachments,
+// and menu items are dropped, though attachments still resolve featured images.
+// Terms used by posts but missing from the channel taxonomy are added as roots.
+func ParseWordPress(file string, r io.Reader) (Export, error) {
+	const op = "ParseWordPress"
+
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return Export{}, &kernel.Error{Code: kernel.EInvalid, Message: fmt.Sprintf(MExportParseFailed, file), Operation: op, Cause: err}
+	}
+
+	var wxr wxrFile
+	if err := xml.Unmarshal(data, &wxr); err != nil {
+		return Export{}, &kernel.Error{Code: kernel.EInvalid, Message: fmt.Sprintf(MExportParseFailed, file), Operation: op, Cause: err}
+	}
+
+	if wxr.Channel == nil {
+		return Export{}, &kernel.Error{Code: kernel.EInvalid, Message: fmt.Sprintf(MExportFormatNotSet, "WordPress"), Operation: op}
+	}
+
+	lines, err := wxrLines(data)
+	if err != nil {
+		return Export{}, &kernel.Error{Code: kernel.EInvalid, Message: fmt.Sprintf(MExportParseFailed, file), Operation: op, Cause: err}
+	}
+
+	export := Export{Format: FormatWordPress, File: file}
+	ch := wxr.Channel
+
+	for _, a := range ch.Authors {
+		export.Authors = append(export.Authors, ExportAuthor{Ref: a.Login, Email: a.Email, Name: a.DisplayName})
+	}
+
+	categories := make(map[string]bool)
+	for i, c := range ch.Categories {
+		if c.Nicename == "" {
+			continue // Plain RSS <category>, not a wp:category
+		}
+		categories[c.Nicename] = true
+		export.Categories = append(export.Categories, ExportCategory{
+			Ref:       c.Nicename,
+			ParentRef: c.Parent,
+			Name:      c.Name,
+			Line:      lines.at("category", i),
+		})
+	}
+
+	tags := make(map[string]bool)
+	for i, t := range ch.Tags {
+		tags[t.Slug] = true
+		export.Tags = append(export.Tags, ExportTag{Ref: t.Slug, Name: t.Name, Line: lines.at("tag", i)})
+	}
+
+	attachments := make(map[string]string)
+	for _, item := range ch.Items {
+		if item.PostType == "attachment" {
+			attachments[item.PostID] = item.AttachmentURL
+		}
+	}
+
+	for i, item := range ch.Items {
+		if item.PostType != "post" {
+			continue
+		}
+
+		p := ExportPost{
+			Ref:       item.PostID,
+			Line:      lines.at("item", i),
+			Title:     item.Title,
+			Status:    item.Status,
+			AuthorRef: item.Creator,
+		}
+
+		for _, e := range item.Encoded {
+			switch {
+			case strings.Contains(e.XMLName.Space, "/content/"):
+				p.Content = e.Value
+			case strings.Contains(e.XMLName.Space, "/excerpt/"):
+				p.Excerpt = e.Value
+			}
+		}
+
+		if published, err := time.ParseInLocation(wxrDateLayout, item.PostDateGMT, time.UTC); err == nil {
+			p.PublishedAt = &published
+		}
+
+		for _, term := range item.Terms {
+			switch term.Domain {
+			case "category":
+				p.CategoryRefs = append(p.CategoryRefs, term.Nicename)
+				if !categories[term.Nicename] {
+					categories[term.Nicename] = true
+					export.Categories = append(export.Categories, ExportCategory{Ref: term.Nicename, Name: term.Name, Line: p.Line})
+				}
+			case "post_tag":
+				p.TagRefs = append(p.TagRefs, term.Nicename)
+				if !tags[term.Nicename] {
+					tags[term.Nicename] = true
+					export.Tags = append(export.Tags, ExportTag{Ref: term.Nicename, Name: term.Name, Line: p.Line})
+				}
+			}
+		}
+
+		for _, m := range item.Meta {
+			switch m.Key {
+			case wxrMetaThumbnail:
+				p.FeaturedImage = attachments[m.Value]
+			case wxrMetaSEOTitle:
+				p.SEOTitle = m.Value
+			case wxrMetaSEODescription:
+				p.SEODescription = m.Value
+			}
+		}
+
+		export.Posts = append(export.Posts, p)
+	}
+
+	return export, nil
+}
+
+// wxrLineIndex holds the line of every channel-level element, by local name, in file order.
+type wxrLineIndex map[string][]int
+
+func (l wxrLineIndex) at(name string, i int) int {
+	if i < len(l[name]) {
+		return l[name][i]
+	}
+	return 0
+}
+
+// wxrLines records where the channel's direct children start, so findings can
+// point into the file. Offsets come from a second, token-level pass.
+func wxrLines(data []byte) (wxrLineIndex, error) {
+	lines := make(wxrLineIndex)
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+
+	depth := 0
+	for {
+		offset := decoder.InputOffset()
+		token, err := decoder.Token()
+		if err == io.EOF {
+			return lines, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			depth++
+			if depth == 3 { // rss > channel > element
+				lines[t.Name.Local] = append(lines[t.Name.Local], bytes.Count(data[:offset], []byte("\n"))+1)
+			}
+		case xml.EndElement:
+			depth--
+		}
+	}
+}
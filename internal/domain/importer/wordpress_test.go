@@ -0,0 +1,125 @@
+package importer_test
+
+import (
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/importer"
+	"github.com/alnah/fla/internal/domain/kernel"
+)
+
+const wxrExport = `<?xml version="1.0" encoding="UTF-8"?>
+<rss version="2.0"
+	xmlns:excerpt="http://wordpress.org/export/1.2/excerpt/"
+	xmlns:content="http://purl.org/rss/1.0/modules/content/"
+	xmlns:dc="http://purl.org/dc/elements/1.1/"
+	xmlns:wp="http://wordpress.org/export/1.2/">
+<channel>
+	<title>Le blog de Marie</title>
+	<wp:author><wp:author_login>marie</wp:author_login><wp:author_email>marie@example.com</wp:author_email><wp:author_display_name>Marie</wp:author_display_name></wp:author>
+	<wp:category><wp:term_id>1</wp:term_id><wp:category_nicename>a1</wp:category_nicename><wp:category_parent></wp:category_parent><wp:cat_name><![CDATA[A1]]></wp:cat_name></wp:category>
+	<wp:category><wp:term_id>2</wp:term_id><wp:category_nicename>lecture</wp:category_nicename><wp:category_parent>a1</wp:category_parent><wp:cat_name><![CDATA[Lecture]]></wp:cat_name></wp:category>
+	<wp:tag><wp:term_id>3</wp:term_id><wp:tag_slug>grammaire</wp:tag_slug><wp:tag_name><![CDATA[Grammaire]]></wp:tag_name></wp:tag>
+	<item>
+		<title>Au marché</title>
+		<dc:creator><![CDATA[marie]]></dc:creator>
+		<content:encoded><![CDATA[<p>Le samedi, je vais au marché.</p>]]></content:encoded>
+		<excerpt:encoded><![CDATA[Une sortie au marché.]]></excerpt:encoded>
+		<wp:post_id>42</wp:post_id>
+		<wp:post_date_gmt>2024-03-05 09:00:00</wp:post_date_gmt>
+		<wp:status>publish</wp:status>
+		<wp:post_type>post</wp:post_type>
+		<category domain="category" nicename="lecture"><![CDATA[Lecture]]></category>
+		<category domain="post_tag" nicename="grammaire"><![CDATA[Grammaire]]></category>
+		<category domain="post_tag" nicename="vocabulaire"><![CDATA[Vocabulaire]]></category>
+		<wp:postmeta><wp:meta_key>_thumbnail_id</wp:meta_key><wp:meta_value>43</wp:meta_value></wp:postmeta>
+		<wp:postmeta><wp:meta_key>_yoast_wpseo_metadesc</wp:meta_key><wp:meta_value>Lire en français : au marché.</wp:meta_value></wp:postmeta>
+	</item>
+	<item>
+		<title>marche.jpg</title>
+		<wp:post_id>43</wp:post_id>
+		<wp:post_type>attachment</wp:post_type>
+		<wp:attachment_url>https://example.com/marche.jpg</wp:attachment_url>
+	</item>
+	<item>
+		<title>Brouillon</title>
+		<wp:post_id>44</wp:post_id>
+		<wp:post_date_gmt>0000-00-00 00:00:00</wp:post_date_gmt>
+		<wp:status>draft</wp:status>
+		<wp:post_type>post</wp:post_type>
+	</item>
+	<item>
+		<title>À propos</title>
+		<wp:post_id>2</wp:post_id>
+		<wp:post_type>page</wp:post_type>
+	</item>
+</channel>
+</rss>`
+
+func TestParseWordPress(t *testing.T) {
+	got, err := importer.ParseWordPress("export.xml", strings.NewReader(wxrExport))
+
+	assertNoError(t, err)
+
+	t.Run("reads taxonomy and authors", func(t *testing.T) {
+		if len(got.Authors) != 1 || got.Authors[0].Email != "marie@example.com" {
+			t.Errorf("authors: got %+v", got.Authors)
+		}
+		want := importer.ExportCategory{Ref: "lecture", ParentRef: "a1", Name: "Lecture", Line: 11}
+		if len(got.Categories) != 2 || got.Categories[1] != want {
+			t.Errorf("categories: got %+v", got.Categories)
+		}
+	})
+
+	t.Run("adds undeclared item terms", func(t *testing.T) {
+		if len(got.Tags) != 2 || got.Tags[1].Ref != "vocabulaire" || got.Tags[1].Line != 13 {
+			t.Errorf("tags: got %+v", got.Tags)
+		}
+	})
+
+	t.Run("keeps posts only", func(t *testing.T) {
+		if len(got.Posts) != 2 {
+			t.Fatalf("got %d posts", len(got.Posts))
+		}
+
+		p := got.Posts[0]
+		published := time.Date(2024, 3, 5, 9, 0, 0, 0, time.UTC)
+		if p.Ref != "42" || p.Line != 13 || p.AuthorRef != "marie" || p.Status != "publish" {
+			t.Errorf("post: got %+v", p)
+		}
+		if p.Content != "<p>Le samedi, je vais au marché.</p>" || p.Excerpt != "Une sortie au marché." {
+			t.Errorf("content: got %q, %q", p.Content, p.Excerpt)
+		}
+		if p.PublishedAt == nil || !p.PublishedAt.Equal(published) {
+			t.Errorf("published: got %v", p.PublishedAt)
+		}
+		if !slices.Equal(p.CategoryRefs, []string{"lecture"}) || !slices.Equal(p.TagRefs, []string{"grammaire", "vocabulaire"}) {
+			t.Errorf("terms: got %v, %v", p.CategoryRefs, p.TagRefs)
+		}
+		if p.FeaturedImage != "https://example.com/marche.jpg" || p.SEODescription == "" {
+			t.Errorf("meta: got %q, %q", p.FeaturedImage, p.SEODescription)
+		}
+		if got.Posts[1].PublishedAt != nil {
+			t.Errorf("draft date: got %v", got.Posts[1].PublishedAt)
+		}
+	})
+
+	errorTests := []struct {
+		name  string
+		input string
+	}{
+		{"malformed XML", "<rss><channel>"},
+		{"not a WXR file", `<feed xmlns="http://www.w3.org/2005/Atom"></feed>`},
+	}
+
+	for _, tt := range errorTests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := importer.ParseWordPress("export.xml", strings.NewReader(tt.input))
+
+			assertError(t, err)
+			assertErrorCode(t, err, kernel.EInvalid)
+		})
+	}
+}
@@ -0,0 +1,278 @@
+// Package invitation models how admins bring new authors, editors, and admins on board.
+package invitation
+
+import (
+	"crypto/subtle"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/shared"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+// DefaultTTL is how long an invitation stays valid after being sent.
+const DefaultTTL = 7 * 24 * time.Hour
+
+const (
+	MInvitationForbidden    string = "Only active admins can manage invitations."
+	MInvitationRoleInvalid  string = "Invitations can only grant the admin, editor, or author role, not %q."
+	MInvitationNotPending   string = "Invitation is no longer pending."
+	MInvitationExpired      string = "Invitation has expired."
+	MInvitationTokenInvalid string = "Invitation token is invalid."
+	MInvitationTTLInvalid   string = "Invitation lifetime must be positive."
+)
+
+// InvitableRoles lists roles that can be granted by invitation.
+// Subscribers sign up on their own; visitors and machines never get accounts this way.
+var InvitableRoles = []user.Role{user.RoleAdmin, user.RoleEditor, user.RoleAuthor}
+
+// Invitation lets an admin grant a role to someone who has no account yet.
+// The invitee proves access to the email by presenting the token before it expires.
+type Invitation struct {
+	// Identity
+	InvitationID kernel.ID[Invitation]
+
+	// Data
+	Email       shared.Email
+	Role        user.Role
+	TokenDigest string // Digest of the current token; the token itself is only emailed
+	InvitedBy   kernel.ID[user.User]
+
+	// Lifecycle
+	Status     Status
+	ExpiresAt  time.Time
+	SendCount  int                   // Number of times the invitation email was sent
+	AcceptedBy *kernel.ID[user.User] // Account created on acceptance (nil until accepted)
+	AcceptedAt *time.Time
+	RevokedAt  *time.Time
+
+	// Meta
+	CreatedAt time.Time
+	UpdatedAt time.Time
+
+	// DI
+	Clock kernel.Clock
+}
+
+// NewInvitationParams holds the parameters needed to create an invitation.
+type NewInvitationParams struct {
+	// Required
+	InvitationID kernel.ID[Invitation]
+	Email        shared.Email
+	Role         user.Role
+	Token        Token // From NewToken
+	Inviter      user.PostPermissionChecker
+
+	// Optional
+	TTL time.Duration // Defaults to DefaultTTL
+
+	// DI
+	Clock kernel.Clock
+}
+
+// NewInvitation creates a pending invitation; only active admins may invite.
+func NewInvitation(p NewInvitationParams) (Invitation, error) {
+	const op = "NewInvitation"
+
+	if !user.IsActiveAdmin(p.Inviter) {
+		return Invitation{}, &kernel.Error{Code: kernel.EForbidden, Message: MInvitationForbidden, Operation: op}
+	}
+
+	if err := p.Token.Validate(); err != nil {
+		return Invitation{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	ttl := p.TTL
+	if ttl == 0 {
+		ttl = DefaultTTL
+	}
+	if ttl < 0 {
+		return Invitation{}, &kernel.Error{Code: kernel.EInvalid, Message: MInvitationTTLInvalid, Operation: op}
+	}
+
+	now := p.Clock.Now()
+
+	invitation := Invitation{
+		InvitationID: p.InvitationID,
+		Email:        p.Email,
+		Role:         p.Role,
+		TokenDigest:  p.Token.Digest(),
+		InvitedBy:    p.Inviter.GetID(),
+		Status:       StatusPending,
+		ExpiresAt:    now.Add(ttl),
+		SendCount:    1,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+		Clock:        p.Clock,
+	}
+
+	if err := invitation.Validate(); err != nil {
+		return Invitation{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return invitation, nil
+}
+
+// Validate performs validation on the invitation.
+func (i Invitation) Validate() error {
+	const op = "Invitation.Validate"
+
+	if err := i.InvitationID.Validate(); err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if err := i.Email.Validate(); err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if !slices.Contains(InvitableRoles, i.Role) {
+		return &kernel.Error{
+			Code:      kernel.EInvalid,
+			Message:   fmt.Sprintf(MInvitationRoleInvalid, i.Role),
+			Operation: op,
+		}
+	}
+
+	if i.TokenDigest == "" {
+		return &kernel.Error{Code: kernel.EInvalid, Message: MTokenMissing, Operation: op}
+	}
+
+	if err := i.InvitedBy.Validate(); err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if err := i.Status.Validate(); err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return nil
+}
+
+// IsExpired returns true once the expiry time has passed.
+func (i Invitation) IsExpired() bool {
+	return !i.Clock.Now().Before(i.ExpiresAt)
+}
+
+// IsUsable returns true if the invitation can still be accepted.
+func (i Invitation) IsUsable() bool {
+	return i.Status == StatusPending && !i.IsExpired()
+}
+
+// Revoke cancels a pending invitation so its token stops working.
+func (i Invitation) Revoke(actor user.PostPermissionChecker) (Invitation, error) {
+	const op = "Invitation.Revoke"
+
+	if !user.IsActiveAdmin(actor) {
+		return i, &kernel.Error{Code: kernel.EForbidden, Message: MInvitationForbidden, Operation: op}
+	}
+
+	if i.Status != StatusPending {
+		return i, &kernel.Error{Code: kernel.EConflict, Message: MInvitationNotPending, Operation: op}
+	}
+
+	now := i.Clock.Now()
+
+	updated := i
+	updated.Status = StatusRevoked
+	updated.RevokedAt = &now
+	updated.UpdatedAt = now
+
+	return updated, nil
+}
+
+// Resend issues a fresh token and expiry for a pending invitation, expired or not.
+// The previous token stops working so only the latest email can be used.
+func (i Invitation) Resend(actor user.PostPermissionChecker, token Token, ttl time.Duration) (Invitation, error) {
+	const op = "Invitation.Resend"
+
+	if !user.IsActiveAdmin(actor) {
+		return i, &kernel.Error{Code: kernel.EForbidden, Message: MInvitationForbidden, Operation: op}
+	}
+
+	if i.Status != StatusPending {
+		return i, &kernel.Error{Code: kernel.EConflict, Message: MInvitationNotPending, Operation: op}
+	}
+
+	if err := token.Validate(); err != nil {
+		return i, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if ttl == 0 {
+		ttl = DefaultTTL
+	}
+	if ttl < 0 {
+		return i, &kernel.Error{Code: kernel.EInvalid, Message: MInvitationTTLInvalid, Operation: op}
+	}
+
+	now := i.Clock.Now()
+
+	updated := i
+	updated.TokenDigest = token.Digest()
+	updated.ExpiresAt = now.Add(ttl)
+	updated.SendCount++
+	updated.UpdatedAt = now
+
+	return updated, nil
+}
+
+// Matches compares the token digest in constant time.
+func (i Invitation) Matches(token Token) bool {
+	return token != "" && subtle.ConstantTimeCompare([]byte(i.TokenDigest), []byte(token.Digest())) == 1
+}
+
+// AcceptParams holds the account details the invitee chooses on acceptance.
+type AcceptParams struct {
+	// Required
+	Token    Token
+	UserID   kernel.ID[user.User]
+	Username shared.Username
+
+	// Optional
+	FirstName        shared.FirstName
+	LastName         shared.LastName
+	LocalePreference shared.Locale
+}
+
+// Accept creates the invitee's account with the invited email and role.
+// Fails when the token does not match, the invitation expired, or it was revoked or used.
+func (i Invitation) Accept(p AcceptParams) (Invitation, user.User, error) {
+	const op = "Invitation.Accept"
+
+	if !i.Matches(p.Token) {
+		return i, user.User{}, &kernel.Error{Code: kernel.EForbidden, Message: MInvitationTokenInvalid, Operation: op}
+	}
+
+	if i.Status != StatusPending {
+		return i, user.User{}, &kernel.Error{Code: kernel.EConflict, Message: MInvitationNotPending, Operation: op}
+	}
+
+	if i.IsExpired() {
+		return i, user.User{}, &kernel.Error{Code: kernel.EConflict, Message: MInvitationExpired, Operation: op}
+	}
+
+	account, err := user.NewUser(user.NewUserParams{
+		UserID:           p.UserID,
+		Username:         p.Username,
+		Email:            i.Email,
+		Roles:            []user.Role{i.Role},
+		FirstName:        p.FirstName,
+		LastName:         p.LastName,
+		LocalePreference: p.LocalePreference,
+		Clock:            i.Clock,
+	})
+	if err != nil {
+		return i, user.User{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	now := i.Clock.Now()
+
+	updated := i
+	updated.Status = StatusAccepted
+	updated.AcceptedBy = &account.ID
+	updated.AcceptedAt = &now
+	updated.UpdatedAt = now
+
+	return updated, account, nil
+}
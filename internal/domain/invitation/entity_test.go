@@ -0,0 +1,263 @@
+package invitation_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/invitation"
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/shared"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+func newInvitation(t *testing.T, clock kernel.Clock, role user.Role) invitation.Invitation {
+	t.Helper()
+	inv, err := invitation.NewInvitation(invitation.NewInvitationParams{
+		InvitationID: "inv-1",
+		Email:        "claire@example.com",
+		Role:         role,
+		Token:        "secret-token",
+		Inviter:      admin(),
+		Clock:        clock,
+	})
+	assertNoError(t, err)
+	return inv
+}
+
+func TestNewInvitation(t *testing.T) {
+	now := time.Date(2024, 5, 1, 9, 0, 0, 0, time.UTC)
+	clock := &stubClock{t: now}
+
+	t.Run("creates pending invitation with default expiry", func(t *testing.T) {
+		got := newInvitation(t, clock, user.RoleEditor)
+
+		if got.Status != invitation.StatusPending || got.InvitedBy != "admin-1" || got.SendCount != 1 {
+			t.Errorf("got %+v", got)
+		}
+		if !got.ExpiresAt.Equal(now.Add(invitation.DefaultTTL)) {
+			t.Errorf("got expiry %v", got.ExpiresAt)
+		}
+	})
+
+	t.Run("stores only the token digest", func(t *testing.T) {
+		got := newInvitation(t, clock, user.RoleEditor)
+
+		if got.TokenDigest != invitation.Token("secret-token").Digest() {
+			t.Errorf("got digest %q", got.TokenDigest)
+		}
+		if !got.Matches("secret-token") || got.Matches("guess") {
+			t.Error("expected only the issued token to match")
+		}
+	})
+
+	t.Run("only admins can invite", func(t *testing.T) {
+		_, err := invitation.NewInvitation(invitation.NewInvitationParams{
+			InvitationID: "inv-1",
+			Email:        "claire@example.com",
+			Role:         user.RoleAuthor,
+			Token:        "secret-token",
+			Inviter:      editor(),
+			Clock:        clock,
+		})
+
+		assertErrorCode(t, err, kernel.EForbidden)
+	})
+
+	t.Run("suspended admins cannot invite", func(t *testing.T) {
+		_, err := invitation.NewInvitation(invitation.NewInvitationParams{
+			InvitationID: "inv-1",
+			Email:        "claire@example.com",
+			Role:         user.RoleAuthor,
+			Token:        "secret-token",
+			Inviter:      suspendedAdmin(),
+			Clock:        clock,
+		})
+
+		assertErrorCode(t, err, kernel.EForbidden)
+	})
+
+	t.Run("rejects invalid input", func(t *testing.T) {
+		tests := []struct {
+			name   string
+			modify func(*invitation.NewInvitationParams)
+		}{
+			{"subscriber role", func(p *invitation.NewInvitationParams) { p.Role = user.RoleSubscriber }},
+			{"machine role", func(p *invitation.NewInvitationParams) { p.Role = user.RoleMachine }},
+			{"missing email", func(p *invitation.NewInvitationParams) { p.Email = "" }},
+			{"missing token", func(p *invitation.NewInvitationParams) { p.Token = "" }},
+			{"negative lifetime", func(p *invitation.NewInvitationParams) { p.TTL = -time.Hour }},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				params := invitation.NewInvitationParams{
+					InvitationID: "inv-1",
+					Email:        "claire@example.com",
+					Role:         user.RoleAuthor,
+					Token:        "secret-token",
+					Inviter:      admin(),
+					Clock:        clock,
+				}
+				tt.modify(&params)
+
+				_, err := invitation.NewInvitation(params)
+
+				assertError(t, err)
+				assertErrorCode(t, err, kernel.EInvalid)
+			})
+		}
+	})
+}
+
+func TestInvitation_Accept(t *testing.T) {
+	now := time.Date(2024, 5, 1, 9, 0, 0, 0, time.UTC)
+	accept := invitation.AcceptParams{
+		Token:    "secret-token",
+		UserID:   "user-42",
+		Username: "claire",
+	}
+
+	t.Run("creates user with invited email and role", func(t *testing.T) {
+		clock := &stubClock{t: now}
+		inv := newInvitation(t, clock, user.RoleEditor)
+		clock.t = now.Add(24 * time.Hour)
+
+		got, account, err := inv.Accept(accept)
+
+		assertNoError(t, err)
+		if account.Email != "claire@example.com" || !account.HasRole(user.RoleEditor) || len(account.Roles) != 1 {
+			t.Errorf("got account %+v", account)
+		}
+		if account.LocalePreference != shared.DefaultLocale {
+			t.Errorf("got locale %q", account.LocalePreference)
+		}
+		if got.Status != invitation.StatusAccepted || *got.AcceptedBy != "user-42" || !got.AcceptedAt.Equal(clock.t) {
+			t.Errorf("got invitation %+v", got)
+		}
+	})
+
+	t.Run("rejects wrong token", func(t *testing.T) {
+		inv := newInvitation(t, &stubClock{t: now}, user.RoleAuthor)
+		wrong := accept
+		wrong.Token = "guess"
+
+		_, _, err := inv.Accept(wrong)
+
+		assertErrorCode(t, err, kernel.EForbidden)
+	})
+
+	t.Run("rejects expired invitation", func(t *testing.T) {
+		clock := &stubClock{t: now}
+		inv := newInvitation(t, clock, user.RoleAuthor)
+		clock.t = inv.ExpiresAt
+
+		_, _, err := inv.Accept(accept)
+
+		assertErrorCode(t, err, kernel.EConflict)
+	})
+
+	t.Run("rejects reuse", func(t *testing.T) {
+		inv := newInvitation(t, &stubClock{t: now}, user.RoleAuthor)
+		accepted, _, err := inv.Accept(accept)
+		assertNoError(t, err)
+
+		_, _, err = accepted.Accept(accept)
+
+		assertErrorCode(t, err, kernel.EConflict)
+	})
+
+	t.Run("rejects invalid account details", func(t *testing.T) {
+		inv := newInvitation(t, &stubClock{t: now}, user.RoleAuthor)
+		invalid := accept
+		invalid.Username = ""
+
+		_, _, err := inv.Accept(invalid)
+
+		assertErrorCode(t, err, kernel.EInvalid)
+	})
+}
+
+func TestInvitation_Revoke(t *testing.T) {
+	clock := &stubClock{t: time.Date(2024, 5, 1, 9, 0, 0, 0, time.UTC)}
+
+	t.Run("revokes pending invitation", func(t *testing.T) {
+		inv := newInvitation(t, clock, user.RoleAuthor)
+
+		got, err := inv.Revoke(admin())
+
+		assertNoError(t, err)
+		if got.Status != invitation.StatusRevoked || got.RevokedAt == nil || got.IsUsable() {
+			t.Errorf("got %+v", got)
+		}
+		_, _, err = got.Accept(invitation.AcceptParams{Token: "secret-token", UserID: "u", Username: "claire"})
+		assertErrorCode(t, err, kernel.EConflict)
+	})
+
+	t.Run("only admins can revoke", func(t *testing.T) {
+		_, err := newInvitation(t, clock, user.RoleAuthor).Revoke(editor())
+
+		assertErrorCode(t, err, kernel.EForbidden)
+	})
+
+	t.Run("suspended admins cannot revoke", func(t *testing.T) {
+		_, err := newInvitation(t, clock, user.RoleAuthor).Revoke(suspendedAdmin())
+
+		assertErrorCode(t, err, kernel.EForbidden)
+	})
+
+	t.Run("cannot revoke twice", func(t *testing.T) {
+		revoked, _ := newInvitation(t, clock, user.RoleAuthor).Revoke(admin())
+
+		_, err := revoked.Revoke(admin())
+
+		assertErrorCode(t, err, kernel.EConflict)
+	})
+}
+
+func TestInvitation_Resend(t *testing.T) {
+	now := time.Date(2024, 5, 1, 9, 0, 0, 0, time.UTC)
+
+	t.Run("renews token and expiry of an expired invitation", func(t *testing.T) {
+		clock := &stubClock{t: now}
+		inv := newInvitation(t, clock, user.RoleAuthor)
+		clock.t = now.Add(30 * 24 * time.Hour)
+
+		got, err := inv.Resend(admin(), "fresh-token", 48*time.Hour)
+
+		assertNoError(t, err)
+		if !got.Matches("fresh-token") || got.SendCount != 2 || !got.ExpiresAt.Equal(clock.t.Add(48*time.Hour)) {
+			t.Errorf("got %+v", got)
+		}
+		if !got.IsUsable() {
+			t.Error("expected resent invitation to be usable")
+		}
+		_, _, err = got.Accept(invitation.AcceptParams{Token: "secret-token", UserID: "u", Username: "claire"})
+		assertErrorCode(t, err, kernel.EForbidden)
+	})
+
+	t.Run("only admins can resend", func(t *testing.T) {
+		_, err := newInvitation(t, &stubClock{t: now}, user.RoleAuthor).Resend(editor(), "fresh-token", 0)
+
+		assertErrorCode(t, err, kernel.EForbidden)
+	})
+
+	t.Run("suspended admins cannot resend", func(t *testing.T) {
+		_, err := newInvitation(t, &stubClock{t: now}, user.RoleAuthor).Resend(suspendedAdmin(), "fresh-token", 0)
+
+		assertErrorCode(t, err, kernel.EForbidden)
+	})
+
+	t.Run("cannot resend revoked invitation", func(t *testing.T) {
+		revoked, _ := newInvitation(t, &stubClock{t: now}, user.RoleAuthor).Revoke(admin())
+
+		_, err := revoked.Resend(admin(), "fresh-token", 0)
+
+		assertErrorCode(t, err, kernel.EConflict)
+	})
+
+	t.Run("requires a token", func(t *testing.T) {
+		_, err := newInvitation(t, &stubClock{t: now}, user.RoleAuthor).Resend(admin(), "", 0)
+
+		assertErrorCode(t, err, kernel.EInvalid)
+	})
+}
@@ -0,0 +1,44 @@
+package invitation
+
+import (
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/shared"
+)
+
+// InvitationReader defines read operations for invitation lookup.
+// Used by the acceptance page and the admin team screen.
+type InvitationReader interface {
+	// GetByID retrieves an invitation for revoke and resend actions.
+	GetByID(invitationID kernel.ID[Invitation]) (*Invitation, error)
+
+	// GetByTokenDigest finds the invitation behind an acceptance link by the token's digest.
+	// Returns ENotFound when no invitation carries the digest.
+	GetByTokenDigest(digest string) (*Invitation, error)
+
+	// GetPendingByEmail finds the open invitation for an email, if any.
+	// Used to prevent inviting the same person twice. Returns ENotFound when none is pending.
+	GetPendingByEmail(email shared.Email) (*Invitation, error)
+}
+
+// InvitationWriter defines invitation persistence operations.
+type InvitationWriter interface {
+	// Create stores a new invitation.
+	Create(invitation Invitation) error
+
+	// Update saves status, token digest, and expiry changes.
+	Update(invitation Invitation) error
+}
+
+// InvitationLister provides listings for the admin team screen.
+type InvitationLister interface {
+	// GetPending returns invitations not yet accepted or revoked, expired ones included.
+	GetPending() ([]Invitation, error)
+}
+
+// Full repository interface for implementations that provide everything.
+// Most concrete implementations (like PostgresInvitationRepository) will implement this.
+type Repository interface {
+	InvitationReader
+	InvitationWriter
+	InvitationLister
+}
@@ -0,0 +1,33 @@
+package invitation
+
+import (
+	"github.com/alnah/fla/internal/domain/kernel"
+)
+
+const MStatusInvalid string = "Invalid invitation status."
+
+// Status represents where an invitation stands. Expiry is computed from ExpiresAt, not stored.
+type Status string
+
+const (
+	StatusPending  Status = "pending"
+	StatusAccepted Status = "accepted"
+	StatusRevoked  Status = "revoked"
+)
+
+func (s Status) String() string { return string(s) }
+
+func (s Status) Validate() error {
+	const op = "Status.Validate"
+
+	switch s {
+	case StatusPending, StatusAccepted, StatusRevoked:
+		return nil
+	default:
+		return &kernel.Error{
+			Code:      kernel.EInvalid,
+			Message:   MStatusInvalid,
+			Operation: op,
+		}
+	}
+}
@@ -0,0 +1,58 @@
+package invitation
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+)
+
+// TokenBytes is the entropy of an invitation token before encoding.
+const TokenBytes = 32
+
+const (
+	MTokenMissing      string = "Missing invitation token."
+	MTokenGenerateFail string = "Invitation token could not be generated."
+)
+
+// Token is the secret sent to the invitee; possession proves access to the invited email.
+type Token string
+
+// NewToken generates a random URL-safe token.
+func NewToken() (Token, error) {
+	const op = "NewToken"
+
+	b := make([]byte, TokenBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", &kernel.Error{Code: kernel.EInternal, Message: MTokenGenerateFail, Operation: op, Cause: err}
+	}
+
+	return Token(base64.RawURLEncoding.EncodeToString(b)), nil
+}
+
+func (t Token) String() string { return string(t) }
+
+// Validate ensures a token is present.
+func (t Token) Validate() error {
+	const op = "Token.Validate"
+
+	if t == "" {
+		return &kernel.Error{Code: kernel.EInvalid, Message: MTokenMissing, Operation: op}
+	}
+
+	return nil
+}
+
+// Digest returns the SHA-256 of the token, hex encoded, for storage and lookup.
+func (t Token) Digest() string {
+	sum := sha256.Sum256([]byte(t))
+	return hex.EncodeToString(sum[:])
+}
+
+// Matches compares tokens in constant time to avoid leaking them through timing.
+func (t Token) Matches(other Token) bool {
+	return t != "" && subtle.ConstantTimeCompare([]byte(t), []byte(other)) == 1
+}
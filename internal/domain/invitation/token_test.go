@@ -0,0 +1,58 @@
+package invitation_test
+
+import (
+	"testing"
+
+	"github.com/alnah/fla/internal/domain/invitation"
+	"github.com/alnah/fla/internal/domain/kernel"
+)
+
+func TestNewToken(t *testing.T) {
+	a, err := invitation.NewToken()
+	assertNoError(t, err)
+	b, err := invitation.NewToken()
+	assertNoError(t, err)
+
+	if a == b {
+		t.Error("expected distinct tokens")
+	}
+	if len(a) != 43 {
+		t.Errorf("got length %d, want 43", len(a))
+	}
+}
+
+func TestToken_Matches(t *testing.T) {
+	tests := []struct {
+		token, other invitation.Token
+		want         bool
+	}{
+		{"abc", "abc", true},
+		{"abc", "abd", false},
+		{"abc", "", false},
+		{"", "", false},
+	}
+
+	for _, tt := range tests {
+		if got := tt.token.Matches(tt.other); got != tt.want {
+			t.Errorf("%q.Matches(%q): got %t, want %t", tt.token, tt.other, got, tt.want)
+		}
+	}
+}
+
+func TestToken_Validate(t *testing.T) {
+	err := invitation.Token("").Validate()
+
+	assertError(t, err)
+	assertErrorCode(t, err, kernel.EInvalid)
+}
+
+func TestToken_Digest(t *testing.T) {
+	token := invitation.Token("secret-token")
+
+	if token.Digest() == token.String() || len(token.Digest()) != 64 {
+		t.Errorf("got digest %q", token.Digest())
+	}
+	if token.Digest() != invitation.Token("secret-token").Digest() {
+		t.Error("expected stable digest")
+	}
+}
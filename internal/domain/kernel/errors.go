@@ -2,6 +2,8 @@ package kernel
 
 import (
 	"bytes"
+	"context"
+	"errors"
 	"fmt"
 )
 
@@ -31,6 +33,45 @@ type Error struct {
 
 	// Underlying error cause for error chain traversal
 	Cause error
+
+	// Invalid field, for validation errors, e.g. "title"
+	Field string
+
+	// Rejected value of the field, for diagnosis; not meant to be echoed to clients
+	Value any
+
+	// Rule the field broke, for validation errors
+	Constraint Constraint
+
+	// Transient failure, such as a repository timeout, that may succeed if tried again
+	Retryable bool
+}
+
+// Constraints reported by validation errors.
+const (
+	ConstraintRequired  string = "required"   // The field is missing or blank
+	ConstraintLength    string = "length"     // Params "min" and "max"
+	ConstraintMinLength string = "min_length" // Param "min"
+	ConstraintMaxLength string = "max_length" // Param "max"
+)
+
+// Constraint is a validation rule with its parameters, such as the bounds of a length
+// check, so clients can explain or enforce it without parsing messages.
+type Constraint struct {
+	Name   string
+	Params map[string]int
+}
+
+// FieldError returns the first error in the chain that names an invalid field,
+// or nil when the error is not about a field.
+func FieldError(err error) *Error {
+	e, ok := err.(*Error)
+	if !ok {
+		return nil
+	} else if e.Field != "" {
+		return e
+	}
+	return FieldError(e.Cause)
 }
 
 // ErrorCode extracts the machine-readable error classification for handling logic.
@@ -61,6 +102,34 @@ func ErrorMessage(err error) string {
 	return MInternal
 }
 
+// IsRetryable reports whether the error chain holds a Retryable error, a deadline, or a timeout.
+// Joined errors are retryable only when all of them are.
+func IsRetryable(err error) bool {
+	if err == nil {
+		return false
+	} else if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+
+	switch e := err.(type) {
+	case *Error:
+		return e.Retryable || IsRetryable(e.Cause)
+	case interface{ Timeout() bool }:
+		return e.Timeout()
+	case interface{ Unwrap() []error }:
+		errs := e.Unwrap()
+		for _, err := range errs {
+			if !IsRetryable(err) {
+				return false
+			}
+		}
+		return len(errs) > 0
+	case interface{ Unwrap() error }:
+		return IsRetryable(e.Unwrap())
+	}
+	return false
+}
+
 // Error returns the complete error representation including operation context.
 // Provides detailed error information for logging and debugging purposes.
 func (e *Error) Error() string {
@@ -1,7 +1,10 @@
 package kernel_test
 
 import (
+	"context"
 	"errors"
+	"fmt"
+	"maps"
 	"testing"
 
 	"github.com/alnah/fla/internal/domain/kernel"
@@ -182,6 +185,87 @@ func TestErrorMessage(t *testing.T) {
 	})
 }
 
+func TestFieldError(t *testing.T) {
+	t.Run("finds the field error in the chain", func(t *testing.T) {
+		err := &kernel.Error{Operation: "NewTitle", Cause: kernel.ValidateLength("title", "short", 10, 100, "Title.Validate")}
+
+		got := kernel.FieldError(err)
+
+		if got == nil {
+			t.Fatal("got nil, want field error")
+		}
+		if got.Field != "title" || got.Value != "short" {
+			t.Errorf("got field %q value %v, want title short", got.Field, got.Value)
+		}
+		if got.Constraint.Name != kernel.ConstraintLength || got.Constraint.Params["min"] != 10 || got.Constraint.Params["max"] != 100 {
+			t.Errorf("got constraint %+v", got.Constraint)
+		}
+	})
+
+	t.Run("reports the violated bound", func(t *testing.T) {
+		tests := []struct {
+			err        error
+			constraint string
+			params     map[string]int
+		}{
+			{kernel.ValidatePresence("slug", " ", "op"), kernel.ConstraintRequired, nil},
+			{kernel.ValidateMinLength("content", "hi", 5, "op"), kernel.ConstraintMinLength, map[string]int{"min": 5}},
+			{kernel.ValidateMaxLength("excerpt", "too long", 3, "op"), kernel.ConstraintMaxLength, map[string]int{"max": 3}},
+		}
+
+		for _, tt := range tests {
+			got := kernel.FieldError(tt.err)
+			if got.Constraint.Name != tt.constraint || !maps.Equal(got.Constraint.Params, tt.params) {
+				t.Errorf("got %+v, want %s %v", got.Constraint, tt.constraint, tt.params)
+			}
+		}
+	})
+
+	t.Run("returns nil without field", func(t *testing.T) {
+		for _, err := range []error{nil, errors.New("boom"), &kernel.Error{Code: kernel.EConflict, Message: "taken"}} {
+			if got := kernel.FieldError(err); got != nil {
+				t.Errorf("FieldError(%v): got %+v, want nil", err, got)
+			}
+		}
+	})
+}
+
+func TestIsRetryable(t *testing.T) {
+	transient := &kernel.Error{Code: kernel.EInternal, Message: "repository timed out", Retryable: true}
+	invalid := &kernel.Error{Code: kernel.EInvalid, Message: "Missing title."}
+
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"nil error", nil, false},
+		{"retryable error", transient, true},
+		{"retryable cause", &kernel.Error{Operation: "SendDigest", Cause: transient}, true},
+		{"wrapped by fmt", fmt.Errorf("sending: %w", transient), true},
+		{"validation error", invalid, false},
+		{"plain error", errors.New("boom"), false},
+		{"context deadline", &kernel.Error{Operation: "GetPost", Cause: context.DeadlineExceeded}, true},
+		{"context canceled", context.Canceled, false},
+		{"timeout error", timeoutError{}, true},
+		{"all joined errors retryable", errors.Join(transient, timeoutError{}), true},
+		{"some joined error permanent", errors.Join(transient, invalid), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := kernel.IsRetryable(tt.err); got != tt.want {
+				t.Errorf("got %t, want %t", got, tt.want)
+			}
+		})
+	}
+}
+
+type timeoutError struct{}
+
+func (timeoutError) Error() string { return "i/o timeout" }
+func (timeoutError) Timeout() bool { return true }
+
 func TestErrorConstants(t *testing.T) {
 	tests := []struct {
 		name     string
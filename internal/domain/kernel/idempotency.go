@@ -0,0 +1,188 @@
+package kernel
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	MinIdempotencyKeyLength int           = 8               // Short enough for client UUIDs without dashes
+	MaxIdempotencyKeyLength int           = 255             // Fits an HTTP header and a database index
+	IdempotencyTTL          time.Duration = 24 * time.Hour  // How long a key keeps replaying its first result
+	IdempotencyLease        time.Duration = 5 * time.Minute // How long a reservation holds a key if its request never finishes
+)
+
+const (
+	MIdempotencyKeyInvalid  string = "Idempotency key may only contain letters, digits, '-', '_', ':', and '.'."
+	MIdempotencyKeyReused   string = "Idempotency key %s was already used for a different request."
+	MIdempotencyInProgress  string = "A request with idempotency key %s is still in progress."
+	MIdempotencyScopeAbsent string = "Missing idempotency scope."
+)
+
+// IdempotencyKey is a client-chosen token that marks retries of the same request,
+// typically from an Idempotency-Key header or a webhook delivery ID. A retried request
+// carrying the same key gets the first result instead of repeating the side effect.
+type IdempotencyKey string
+
+// NewIdempotencyKey creates a validated idempotency key. An empty key is allowed
+// and means the caller did not ask for idempotency.
+func NewIdempotencyKey(key string) (IdempotencyKey, error) {
+	const op = "NewIdempotencyKey"
+
+	k := IdempotencyKey(strings.TrimSpace(key))
+	if k == "" {
+		return "", nil // Optional field
+	}
+	if err := k.Validate(); err != nil {
+		return "", &Error{Operation: op, Cause: err}
+	}
+	return k, nil
+}
+
+// String returns the key as a string.
+func (k IdempotencyKey) String() string { return string(k) }
+
+// Validate ensures the key has a usable length and only header-safe characters.
+func (k IdempotencyKey) Validate() error {
+	const op = "IdempotencyKey.Validate"
+
+	if err := ValidateLength("Idempotency key", string(k), MinIdempotencyKeyLength, MaxIdempotencyKeyLength, op); err != nil {
+		return err
+	}
+
+	for _, r := range k {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+		case r == '-', r == '_', r == ':', r == '.':
+		default:
+			return &Error{Code: EInvalid, Message: MIdempotencyKeyInvalid, Operation: op}
+		}
+	}
+	return nil
+}
+
+// IdempotencyRecord remembers the outcome of the first request made with a key.
+// Keys are only unique within a scope, the operation they guard (e.g. subscription.create),
+// so a client may reuse one key across different operations.
+type IdempotencyRecord struct {
+	Scope       string
+	Key         IdempotencyKey
+	Fingerprint string // Summary of the request, to tell a retry from a different request reusing the key
+	ResultID    string // ID of what the first request created or changed
+	Pending     bool   // Reserved by a request still running; ResultID is not known yet
+	CreatedAt   time.Time
+	ExpiresAt   time.Time // Zero for records saved before expiries were stored, which last IdempotencyTTL
+}
+
+// IsExpired reports whether the record is too old to replay.
+func (r IdempotencyRecord) IsExpired(now time.Time) bool {
+	expiresAt := r.ExpiresAt
+	if expiresAt.IsZero() {
+		expiresAt = r.CreatedAt.Add(IdempotencyTTL)
+	}
+	return !now.Before(expiresAt)
+}
+
+// IdempotencyStore keeps the records of idempotent requests.
+type IdempotencyStore interface {
+	// GetRecord returns ENotFound when no request with the key was made in the scope.
+	GetRecord(scope string, key IdempotencyKey) (*IdempotencyRecord, error)
+
+	// ReserveRecord stores the record unless one with the same scope and key has
+	// not expired at the record's CreatedAt; then it returns that one and stores
+	// nothing. Checking and storing must be atomic, so that concurrent requests
+	// with one key cannot both reserve it.
+	ReserveRecord(record IdempotencyRecord) (*IdempotencyRecord, error)
+
+	// SaveRecord stores a record, replacing any earlier one with the same scope and key.
+	SaveRecord(record IdempotencyRecord) error
+}
+
+// Idempotent runs do once per scope and key and returns the ID of its result.
+// A retry with the same key and fingerprint skips do and returns the first result ID
+// with replayed set; a different fingerprint, or a retry while the first request
+// still runs, is an EConflict. An empty key runs do every time. Failures are not
+// recorded, so a failed request can be retried. The record replays for IdempotencyTTL.
+func Idempotent(
+	store IdempotencyStore,
+	clock Clock,
+	scope string,
+	key IdempotencyKey,
+	fingerprint string,
+	do func() (string, error),
+) (resultID string, replayed bool, err error) {
+	return IdempotentUntil(store, clock, scope, key, fingerprint, clock.Now().Add(IdempotencyTTL), do)
+}
+
+// IdempotentUntil is Idempotent with a record that replays until expiresAt, for keys
+// tied to a period longer than IdempotencyTTL such as a week.
+// The key is reserved before do runs and the reservation lasts IdempotencyLease.
+func IdempotentUntil(
+	store IdempotencyStore,
+	clock Clock,
+	scope string,
+	key IdempotencyKey,
+	fingerprint string,
+	expiresAt time.Time,
+	do func() (string, error),
+) (resultID string, replayed bool, err error) {
+	const op = "IdempotentUntil"
+
+	if key == "" {
+		resultID, err := do()
+		return resultID, false, err
+	}
+	if scope == "" {
+		return "", false, &Error{Code: EInvalid, Message: MIdempotencyScopeAbsent, Operation: op}
+	}
+	if err := key.Validate(); err != nil {
+		return "", false, &Error{Operation: op, Cause: err}
+	}
+
+	now := clock.Now()
+	reservation := IdempotencyRecord{
+		Scope:       scope,
+		Key:         key,
+		Fingerprint: fingerprint,
+		Pending:     true,
+		CreatedAt:   now,
+		ExpiresAt:   now.Add(IdempotencyLease),
+	}
+
+	existing, err := store.ReserveRecord(reservation)
+	switch {
+	case err != nil:
+		return "", false, &Error{Operation: op, Cause: err}
+	case existing == nil:
+	case existing.Fingerprint != fingerprint:
+		return "", false, &Error{Code: EConflict, Message: fmt.Sprintf(MIdempotencyKeyReused, key), Operation: op}
+	case existing.Pending:
+		return "", false, &Error{Code: EConflict, Message: fmt.Sprintf(MIdempotencyInProgress, key), Operation: op}
+	default:
+		return existing.ResultID, true, nil
+	}
+
+	resultID, err = do()
+	if err != nil {
+		reservation.ExpiresAt = reservation.CreatedAt // Released, so a retry runs again
+		if saveErr := store.SaveRecord(reservation); saveErr != nil {
+			return "", false, errors.Join(err, &Error{Operation: op, Cause: saveErr})
+		}
+		return "", false, err
+	}
+
+	if err := store.SaveRecord(IdempotencyRecord{
+		Scope:       scope,
+		Key:         key,
+		Fingerprint: fingerprint,
+		ResultID:    resultID,
+		CreatedAt:   clock.Now(),
+		ExpiresAt:   expiresAt,
+	}); err != nil {
+		return "", false, &Error{Operation: op, Cause: err}
+	}
+
+	return resultID, false, nil
+}
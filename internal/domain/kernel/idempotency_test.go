@@ -0,0 +1,172 @@
+package kernel_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+)
+
+type stubIdempotencyStore struct {
+	records map[string]kernel.IdempotencyRecord
+}
+
+func (s *stubIdempotencyStore) GetRecord(scope string, key kernel.IdempotencyKey) (*kernel.IdempotencyRecord, error) {
+	r, ok := s.records[scope+"/"+key.String()]
+	if !ok {
+		return nil, &kernel.Error{Code: kernel.ENotFound, Message: "no record"}
+	}
+	return &r, nil
+}
+
+func (s *stubIdempotencyStore) ReserveRecord(r kernel.IdempotencyRecord) (*kernel.IdempotencyRecord, error) {
+	if existing, ok := s.records[r.Scope+"/"+r.Key.String()]; ok && !existing.IsExpired(r.CreatedAt) {
+		return &existing, nil
+	}
+	s.records[r.Scope+"/"+r.Key.String()] = r
+	return nil, nil
+}
+
+func (s *stubIdempotencyStore) SaveRecord(r kernel.IdempotencyRecord) error {
+	s.records[r.Scope+"/"+r.Key.String()] = r
+	return nil
+}
+
+func TestNewIdempotencyKey(t *testing.T) {
+	tests := []struct {
+		name string
+		key  string
+		code string
+	}{
+		{"uuid", " 7f3c2a90-1b6e-4c1d-9d55-0e2f4a8b6c11 ", ""},
+		{"webhook delivery", "evt_1Nq:delivery.42", ""},
+		{"empty means none", "", ""},
+		{"too short", "abc", kernel.EInvalid},
+		{"spaces", "retry me please", kernel.EInvalid},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := kernel.NewIdempotencyKey(tt.key)
+			if tt.code == "" {
+				assertNoError(t, err)
+				return
+			}
+			assertErrorCode(t, err, tt.code)
+		})
+	}
+}
+
+func TestIdempotent(t *testing.T) {
+	now := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+	clock := NewStubClock(now)
+	store := &stubIdempotencyStore{records: map[string]kernel.IdempotencyRecord{}}
+
+	calls := 0
+	create := func() (string, error) {
+		calls++
+		return "sub-1", nil
+	}
+
+	id, replayed, err := kernel.Idempotent(store, clock, "subscription.create", "key-0001", "marie@example.com", create)
+	assertNoError(t, err)
+	if id != "sub-1" || replayed {
+		t.Errorf("first call: got %q, replayed %v", id, replayed)
+	}
+
+	t.Run("retry replays the first result", func(t *testing.T) {
+		id, replayed, err := kernel.Idempotent(store, clock, "subscription.create", "key-0001", "marie@example.com", create)
+		assertNoError(t, err)
+		if id != "sub-1" || !replayed || calls != 1 {
+			t.Errorf("got %q, replayed %v, %d calls", id, replayed, calls)
+		}
+	})
+
+	t.Run("different request with the same key", func(t *testing.T) {
+		_, _, err := kernel.Idempotent(store, clock, "subscription.create", "key-0001", "tom@example.com", create)
+		assertErrorCode(t, err, kernel.EConflict)
+	})
+
+	t.Run("same key in another scope", func(t *testing.T) {
+		_, replayed, err := kernel.Idempotent(store, clock, "post.publish", "key-0001", "post-1", create)
+		assertNoError(t, err)
+		if replayed || calls != 2 {
+			t.Errorf("replayed %v, %d calls", replayed, calls)
+		}
+	})
+
+	t.Run("expired record runs again", func(t *testing.T) {
+		later := NewStubClock(now.Add(kernel.IdempotencyTTL))
+		_, replayed, err := kernel.Idempotent(store, later, "subscription.create", "key-0001", "tom@example.com", create)
+		assertNoError(t, err)
+		if replayed || calls != 3 {
+			t.Errorf("replayed %v, %d calls", replayed, calls)
+		}
+	})
+
+	t.Run("record replays until its own expiry", func(t *testing.T) {
+		expiresAt := now.Add(7 * 24 * time.Hour)
+		_, _, err := kernel.IdempotentUntil(store, clock, "email.digest", "2026-W10", "sub-1", expiresAt, create)
+		assertNoError(t, err)
+
+		later := NewStubClock(now.Add(3 * 24 * time.Hour))
+		_, replayed, err := kernel.IdempotentUntil(store, later, "email.digest", "2026-W10", "sub-1", expiresAt, create)
+		assertNoError(t, err)
+		if !replayed || calls != 4 {
+			t.Errorf("replayed %v, %d calls", replayed, calls)
+		}
+
+		after := NewStubClock(expiresAt)
+		_, replayed, err = kernel.IdempotentUntil(store, after, "email.digest", "2026-W10", "sub-1", expiresAt, create)
+		assertNoError(t, err)
+		if replayed || calls != 5 {
+			t.Errorf("replayed %v, %d calls", replayed, calls)
+		}
+	})
+
+	t.Run("failures are not recorded", func(t *testing.T) {
+		failing := func() (string, error) { return "", errors.New("smtp down") }
+		_, _, err := kernel.Idempotent(store, clock, "email.send", "key-0002", "", failing)
+		if err == nil {
+			t.Fatal("expected error")
+		}
+
+		_, replayed, err := kernel.Idempotent(store, clock, "email.send", "key-0002", "", create)
+		assertNoError(t, err)
+		if replayed || calls != 6 {
+			t.Errorf("retry after failure: replayed %v, %d calls", replayed, calls)
+		}
+	})
+
+	t.Run("retry while the first request runs", func(t *testing.T) {
+		var retryErr error
+		slow := func() (string, error) {
+			_, _, retryErr = kernel.Idempotent(store, clock, "email.send", "key-0003", "", create)
+			return "msg-1", nil
+		}
+
+		id, _, err := kernel.Idempotent(store, clock, "email.send", "key-0003", "", slow)
+		assertNoError(t, err)
+		assertErrorCode(t, retryErr, kernel.EConflict)
+		if id != "msg-1" || calls != 6 {
+			t.Errorf("got %q, %d calls", id, calls)
+		}
+	})
+
+	t.Run("abandoned reservation expires after the lease", func(t *testing.T) {
+		assertNoError(t, store.SaveRecord(kernel.IdempotencyRecord{
+			Scope: "email.send", Key: "key-0004", Pending: true, CreatedAt: now, ExpiresAt: now.Add(kernel.IdempotencyLease),
+		}))
+
+		_, _, err := kernel.Idempotent(store, clock, "email.send", "key-0004", "", create)
+		assertErrorCode(t, err, kernel.EConflict)
+
+		later := NewStubClock(now.Add(kernel.IdempotencyLease))
+		_, replayed, err := kernel.Idempotent(store, later, "email.send", "key-0004", "", create)
+		assertNoError(t, err)
+		if replayed || calls != 7 {
+			t.Errorf("replayed %v, %d calls", replayed, calls)
+		}
+	})
+}
@@ -0,0 +1,168 @@
+package kernel
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// SourceLocale is the locale messages are written in throughout the code.
+const SourceLocale string = "en-US"
+
+// MessageID identifies a message independently of its wording, e.g. "url.scheme".
+type MessageID string
+
+// Translation is one message in every locale it is available in, keyed by locale.
+// Text[SourceLocale] must be the message exactly as the code writes it, fmt verbs
+// included; other texts may reorder arguments with explicit indexes (%[2]s).
+type Translation struct {
+	ID   MessageID
+	Text map[string]string
+}
+
+// Catalog translates messages, looked up by ID or by their source text. Errors only
+// carry their message in the source locale, so localizing one means recognizing the
+// source text, including formatted arguments, then formatting the translation with
+// the same arguments. Arguments that are themselves known messages, such as field
+// names, are translated too. Safe for concurrent use.
+type Catalog struct {
+	mu       sync.RWMutex
+	byID     map[MessageID]Translation
+	bySource map[string]MessageID // Texts without verbs
+	patterns []messagePattern     // Texts with verbs, longest literal text first
+}
+
+// messagePattern matches the formatted source text of one message.
+type messagePattern struct {
+	id      MessageID
+	re      *regexp.Regexp
+	literal int // Length of the text outside verbs; longer patterns are more specific
+}
+
+// NewCatalog creates a catalog holding the translations.
+func NewCatalog(translations ...Translation) *Catalog {
+	c := &Catalog{byID: make(map[MessageID]Translation), bySource: make(map[string]MessageID)}
+	c.Add(translations...)
+	return c
+}
+
+// Add registers translations, replacing those with the same ID.
+// Translations without source text are ignored, since errors could never match them.
+func (c *Catalog) Add(translations ...Translation) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, t := range translations {
+		source := t.Text[SourceLocale]
+		if source == "" {
+			continue
+		}
+		c.byID[t.ID] = t
+
+		if !verbRe.MatchString(source) {
+			c.bySource[source] = t.ID
+			continue
+		}
+
+		literals := verbRe.Split(source, -1)
+		quoted := make([]string, len(literals))
+		literal := 0
+		for i, l := range literals {
+			quoted[i] = regexp.QuoteMeta(strings.ReplaceAll(l, "%%", "%"))
+			literal += len(l)
+		}
+		pattern := messagePattern{
+			id:      t.ID,
+			re:      regexp.MustCompile("^" + strings.Join(quoted, "(.*?)") + "$"),
+			literal: literal,
+		}
+
+		i := 0
+		for i < len(c.patterns) && c.patterns[i].literal >= literal {
+			i++
+		}
+		c.patterns = append(c.patterns[:i], append([]messagePattern{pattern}, c.patterns[i:]...)...)
+	}
+}
+
+// Text returns the message in the locale, formatted with args, falling back to a
+// locale of the same language, then to the source text. Unknown IDs return "".
+func (c *Catalog) Text(id MessageID, locale string, args ...any) string {
+	c.mu.RLock()
+	t, ok := c.byID[id]
+	c.mu.RUnlock()
+	if !ok {
+		return ""
+	}
+
+	text := t.text(locale)
+	if len(args) == 0 {
+		return text
+	}
+	return fmt.Sprintf(text, args...)
+}
+
+// Localize translates a message written in the source locale. Messages the catalog
+// does not know are returned unchanged.
+func (c *Catalog) Localize(message, locale string) string {
+	if message == "" || locale == SourceLocale {
+		return message
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if id, ok := c.bySource[message]; ok {
+		return c.byID[id].text(locale)
+	}
+
+	for _, p := range c.patterns {
+		match := p.re.FindStringSubmatch(message)
+		if match == nil {
+			continue
+		}
+
+		args := make([]any, len(match)-1)
+		for i, arg := range match[1:] {
+			if id, ok := c.bySource[arg]; ok {
+				arg = c.byID[id].text(locale)
+			}
+			args[i] = arg
+		}
+		// Arguments were captured as text, already formatted by the source verbs.
+		text := verbRe.ReplaceAllString(c.byID[p.id].text(locale), "%${1}s")
+		return fmt.Sprintf(text, args...)
+	}
+
+	return message
+}
+
+// text returns the text for the locale or a locale of the same language,
+// else the source text.
+func (t Translation) text(locale string) string {
+	if text, ok := t.Text[locale]; ok {
+		return text
+	}
+
+	language, _, _ := strings.Cut(locale, "-")
+	for l, text := range t.Text {
+		if other, _, _ := strings.Cut(l, "-"); strings.EqualFold(other, language) {
+			return text
+		}
+	}
+	return t.Text[SourceLocale]
+}
+
+// verbRe matches fmt verbs, with an optional argument index and flag; %% is not a verb.
+var verbRe = regexp.MustCompile(`%(\[\d+\])?[+#]?[a-zA-Z]`)
+
+// Messages is the catalog ErrorMessageLocalized reads. It starts with the kernel's
+// own messages; other packages add theirs when loaded.
+var Messages = NewCatalog(Translations...)
+
+// ErrorMessageLocalized returns the error's message in the locale (a BCP 47 tag such
+// as "fr-FR"), or in the source locale when Messages has no translation for it.
+func ErrorMessageLocalized(err error, locale string) string {
+	return Messages.Localize(ErrorMessage(err), locale)
+}
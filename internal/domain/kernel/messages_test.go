@@ -0,0 +1,94 @@
+package kernel_test
+
+import (
+	"testing"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+)
+
+func TestCatalogLocalize(t *testing.T) {
+	catalog := kernel.NewCatalog(
+		kernel.Translation{ID: "field.title", Text: map[string]string{"en-US": "title", "fr-FR": "titre"}},
+		kernel.Translation{ID: "field.length", Text: map[string]string{
+			"en-US": kernel.MFieldLength,
+			"fr-FR": "Le champ %s doit contenir entre %d et %d caractères.",
+			"pt-BR": "O campo %s deve ter entre %d e %d caracteres.",
+		}},
+		kernel.Translation{ID: "reorder", Text: map[string]string{
+			"en-US": "%s before %s.",
+			"fr-FR": "%[2]s après %[1]s.",
+		}},
+		kernel.Translation{ID: "internal", Text: map[string]string{
+			"en-US": kernel.MInternal,
+			"fr-FR": "Erreur interne.",
+		}},
+	)
+
+	tests := []struct {
+		name    string
+		message string
+		locale  string
+		want    string
+	}{
+		{"exact source text", kernel.MInternal, "fr-FR", "Erreur interne."},
+		{"formatted arguments", kernel.ErrLen("slug", 1, 100), "pt-BR", "O campo slug deve ter entre 1 e 100 caracteres."},
+		{"known argument translated", kernel.ErrLen("title", 3, 100), "fr-FR", "Le champ titre doit contenir entre 3 et 100 caractères."},
+		{"reordered arguments", "a before b.", "fr-FR", "b après a."},
+		{"same language fallback", kernel.MInternal, "fr-CA", "Erreur interne."},
+		{"missing locale falls back to source", kernel.MInternal, "de-DE", kernel.MInternal},
+		{"source locale unchanged", kernel.MInternal, kernel.SourceLocale, kernel.MInternal},
+		{"unknown message unchanged", "Something else.", "fr-FR", "Something else."},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := catalog.Localize(tt.message, tt.locale)
+
+			if got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCatalogText(t *testing.T) {
+	catalog := kernel.NewCatalog(kernel.Translation{ID: "field.missing", Text: map[string]string{
+		"en-US": kernel.MFieldMissing,
+		"fr-FR": "Le champ %s est obligatoire.",
+	}})
+
+	t.Run("formats the translation", func(t *testing.T) {
+		got := catalog.Text("field.missing", "fr-FR", "slug")
+
+		if got != "Le champ slug est obligatoire." {
+			t.Errorf("got %q", got)
+		}
+	})
+
+	t.Run("returns empty text for unknown IDs", func(t *testing.T) {
+		if got := catalog.Text("nope", "fr-FR"); got != "" {
+			t.Errorf("got %q, want empty", got)
+		}
+	})
+}
+
+func TestErrorMessageLocalized(t *testing.T) {
+	t.Run("localizes the message of the cause", func(t *testing.T) {
+		cause := &kernel.Error{Code: kernel.EInvalid, Message: kernel.ErrMissing("slug")}
+		err := &kernel.Error{Operation: "SavePost", Cause: cause}
+
+		got := kernel.ErrorMessageLocalized(err, "pt-BR")
+
+		if got != "O campo “slug” é obrigatório." {
+			t.Errorf("got %q", got)
+		}
+	})
+
+	t.Run("keeps the source message without translation", func(t *testing.T) {
+		err := &kernel.Error{Code: kernel.EInvalid, Message: "Unheard of."}
+
+		if got := kernel.ErrorMessageLocalized(err, "fr-FR"); got != "Unheard of." {
+			t.Errorf("got %q", got)
+		}
+	})
+}
@@ -0,0 +1,70 @@
+package kernel
+
+// Translations are the kernel's messages in every supported locale.
+var Translations = []Translation{
+	{ID: "internal", Text: map[string]string{
+		"en-US": MInternal,
+		"fr-FR": "Une erreur interne est survenue. Veuillez contacter le support technique.",
+		"pt-BR": "Ocorreu um erro interno. Entre em contato com o suporte técnico.",
+	}},
+	{ID: "field.length", Text: map[string]string{
+		"en-US": MFieldLength,
+		"fr-FR": "Le champ «\u00a0%s\u00a0» doit contenir entre %d et %d caractères.",
+		"pt-BR": "O campo “%s” deve ter entre %d e %d caracteres.",
+	}},
+	{ID: "field.too_short", Text: map[string]string{
+		"en-US": MFieldTooShort,
+		"fr-FR": "Le champ «\u00a0%s\u00a0» doit contenir plus de %d caractères.",
+		"pt-BR": "O campo “%s” deve ter mais de %d caracteres.",
+	}},
+	{ID: "field.too_long", Text: map[string]string{
+		"en-US": MFieldTooLong,
+		"fr-FR": "Le champ «\u00a0%s\u00a0» doit contenir moins de %d caractères.",
+		"pt-BR": "O campo “%s” deve ter menos de %d caracteres.",
+	}},
+	{ID: "field.missing", Text: map[string]string{
+		"en-US": MFieldMissing,
+		"fr-FR": "Le champ «\u00a0%s\u00a0» est obligatoire.",
+		"pt-BR": "O campo “%s” é obrigatório.",
+	}},
+	{ID: "url.invalid", Text: map[string]string{
+		"en-US": MInvalidURL,
+		"fr-FR": "URL invalide.",
+		"pt-BR": "URL inválida.",
+	}},
+	{ID: "url.format", Text: map[string]string{
+		"en-US": MInvalidURLFormat,
+		"fr-FR": "Format d’URL invalide.",
+		"pt-BR": "Formato de URL inválido.",
+	}},
+	{ID: "url.scheme", Text: map[string]string{
+		"en-US": MInvalidURLScheme,
+		"fr-FR": "L’URL doit utiliser le protocole http ou https.",
+		"pt-BR": "A URL deve usar o protocolo http ou https.",
+	}},
+	{ID: "url.relative", Text: map[string]string{
+		"en-US": MInvalidRelativeURL,
+		"fr-FR": "Une URL relative doit être un chemin commençant par une seule barre oblique.",
+		"pt-BR": "Uma URL relativa deve ser um caminho que começa com uma única barra.",
+	}},
+	{ID: "url.host_not_allowed", Text: map[string]string{
+		"en-US": MURLHostNotAllowed,
+		"fr-FR": "L’hôte %s n’est pas autorisé\u202f; utilisez %s.",
+		"pt-BR": "O host %s não é permitido; use %s.",
+	}},
+	{ID: "idempotency.key_invalid", Text: map[string]string{
+		"en-US": MIdempotencyKeyInvalid,
+		"fr-FR": "La clé d’idempotence ne peut contenir que des lettres, des chiffres, «\u00a0-\u00a0», «\u00a0_\u00a0», «\u00a0:\u00a0» et «\u00a0.\u00a0».",
+		"pt-BR": "A chave de idempotência só pode conter letras, dígitos, '-', '_', ':' e '.'.",
+	}},
+	{ID: "idempotency.key_reused", Text: map[string]string{
+		"en-US": MIdempotencyKeyReused,
+		"fr-FR": "La clé d’idempotence %s a déjà servi pour une autre requête.",
+		"pt-BR": "A chave de idempotência %s já foi usada em outra requisição.",
+	}},
+	{ID: "idempotency.scope_missing", Text: map[string]string{
+		"en-US": MIdempotencyScopeAbsent,
+		"fr-FR": "Portée d’idempotence manquante.",
+		"pt-BR": "Escopo de idempotência ausente.",
+	}},
+}
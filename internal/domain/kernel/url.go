@@ -6,9 +6,10 @@ import (
 )
 
 const (
-	MInvalidURL       string = "Invalid URL."
-	MInvalidURLFormat string = "Invalid URL format."
-	MInvalidURLScheme string = "URL must use http or https scheme."
+	MInvalidURL         string = "Invalid URL."
+	MInvalidURLFormat   string = "Invalid URL format."
+	MInvalidURLScheme   string = "URL must use http or https scheme."
+	MInvalidRelativeURL string = "Relative URL must be a path starting with a single slash."
 )
 
 // URL represents validated URLs for resources with security validation.
@@ -84,3 +85,159 @@ func (u URL[T]) validateScheme() error {
 
 	return nil
 }
+
+// Host returns the URL's host without port, lowercased, or "" when it has none.
+func (u URL[T]) Host() string {
+	parsed, err := url.Parse(u.String())
+	if err != nil {
+		return ""
+	}
+	return strings.ToLower(parsed.Hostname())
+}
+
+// Join returns the URL with path segments appended. Each segment is escaped, so
+// slashes or question marks in it cannot change the URL's structure.
+func (u URL[T]) Join(segments ...string) (URL[T], error) {
+	const op = "URL.Join"
+
+	joined, err := joinURL(u.String(), segments)
+	if err != nil {
+		return "", &Error{Operation: op, Cause: err}
+	}
+	return URL[T](joined), nil
+}
+
+// WithQuery returns the URL with the parameters set, replacing values the URL
+// already has for the same keys and keeping the others.
+func (u URL[T]) WithQuery(params url.Values) (URL[T], error) {
+	const op = "URL.WithQuery"
+
+	merged, err := withQuery(u.String(), params)
+	if err != nil {
+		return "", &Error{Operation: op, Cause: err}
+	}
+	return URL[T](merged), nil
+}
+
+// RelativeURL is a site-relative reference such as "/a1/lecture" or
+// "/media/menu.jpg?w=640", for canonical paths and assets served by the site
+// itself, whatever host it is exported to. Protocol-relative URLs ("//cdn...")
+// name a host and are not relative.
+type RelativeURL[T any] string
+
+// NewRelativeURL creates validated site-relative URL; empty input is allowed.
+func NewRelativeURL[T any](urlStr string) (RelativeURL[T], error) {
+	const op = "NewRelativeURL"
+
+	if urlStr == "" {
+		return "", nil // Optional field
+	}
+
+	r := RelativeURL[T](strings.TrimSpace(urlStr))
+	if err := r.Validate(); err != nil {
+		return "", &Error{Operation: op, Cause: err}
+	}
+
+	return r, nil
+}
+
+func (r RelativeURL[T]) String() string { return string(r) }
+
+// Validate ensures the URL is a path on this site, with no scheme or host.
+func (r RelativeURL[T]) Validate() error {
+	const op = "RelativeURL.Validate"
+
+	if r.String() == "" {
+		return nil // Optional field
+	}
+
+	u, err := url.Parse(r.String())
+	if err != nil {
+		return &Error{Code: EInvalid, Message: MInvalidURLFormat, Operation: op, Cause: err}
+	}
+
+	if u.Scheme != "" || u.Host != "" || !strings.HasPrefix(u.Path, "/") || strings.HasPrefix(r.String(), "//") {
+		return &Error{Code: EInvalid, Message: MInvalidRelativeURL, Operation: op}
+	}
+
+	return nil
+}
+
+// Join returns the path with segments appended, each escaped.
+func (r RelativeURL[T]) Join(segments ...string) (RelativeURL[T], error) {
+	const op = "RelativeURL.Join"
+
+	joined, err := joinURL(r.String(), segments)
+	if err != nil {
+		return "", &Error{Operation: op, Cause: err}
+	}
+	return RelativeURL[T](joined), nil
+}
+
+// WithQuery returns the path with the parameters set, keeping other parameters.
+func (r RelativeURL[T]) WithQuery(params url.Values) (RelativeURL[T], error) {
+	const op = "RelativeURL.WithQuery"
+
+	merged, err := withQuery(r.String(), params)
+	if err != nil {
+		return "", &Error{Operation: op, Cause: err}
+	}
+	return RelativeURL[T](merged), nil
+}
+
+// Resolve returns the absolute URL of the path on a site, given its base URL
+// (e.g. "https://fla.example"). A base path, if any, is kept.
+func (r RelativeURL[T]) Resolve(baseURL string) (URL[T], error) {
+	const op = "RelativeURL.Resolve"
+
+	base := URL[T](strings.TrimRight(baseURL, "/"))
+	if err := base.Validate(); err != nil {
+		return "", &Error{Operation: op, Cause: err}
+	}
+	if err := r.Validate(); err != nil {
+		return "", &Error{Operation: op, Cause: err}
+	}
+
+	return URL[T](base.String() + r.String()), nil
+}
+
+func joinURL(raw string, segments []string) (string, error) {
+	const op = "joinURL"
+
+	u, err := url.Parse(raw)
+	if err != nil {
+		return "", &Error{Code: EInvalid, Message: MInvalidURLFormat, Operation: op, Cause: err}
+	}
+
+	var plain, escaped []string
+	for _, s := range segments {
+		if s = strings.Trim(s, "/"); s != "" {
+			plain = append(plain, s)
+			escaped = append(escaped, url.PathEscape(s))
+		}
+	}
+	if len(plain) == 0 {
+		return raw, nil
+	}
+
+	// RawPath keeps slashes inside a segment escaped; Path holds them decoded.
+	u.RawPath = strings.TrimRight(u.EscapedPath(), "/") + "/" + strings.Join(escaped, "/")
+	u.Path = strings.TrimRight(u.Path, "/") + "/" + strings.Join(plain, "/")
+	return u.String(), nil
+}
+
+func withQuery(raw string, params url.Values) (string, error) {
+	const op = "withQuery"
+
+	u, err := url.Parse(raw)
+	if err != nil {
+		return "", &Error{Code: EInvalid, Message: MInvalidURLFormat, Operation: op, Cause: err}
+	}
+
+	query := u.Query()
+	for key, values := range params {
+		query[key] = values
+	}
+	u.RawQuery = query.Encode()
+	return u.String(), nil
+}
@@ -0,0 +1,66 @@
+package kernel
+
+import (
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+)
+
+const MURLHostNotAllowed string = "Host %s is not allowed; use %s."
+
+// HostPolicy restricts the hosts a kind of resource may be loaded from, such as
+// images limited to the site's CDN. Entries are host names; "*.example.com"
+// matches every subdomain of example.com but not example.com itself. An empty
+// policy allows every host.
+type HostPolicy struct {
+	Allowed []string
+}
+
+// NewHostPolicy creates a host policy from entries as written in configuration,
+// lowercased and without surrounding spaces.
+func NewHostPolicy(hosts ...string) HostPolicy {
+	var allowed []string
+	for _, h := range hosts {
+		if h = strings.ToLower(strings.TrimSpace(h)); h != "" {
+			allowed = append(allowed, h)
+		}
+	}
+	return HostPolicy{Allowed: allowed}
+}
+
+// Allows reports whether resources may be loaded from the host.
+func (p HostPolicy) Allows(host string) bool {
+	if len(p.Allowed) == 0 {
+		return true
+	}
+
+	host = strings.ToLower(host)
+	return slices.ContainsFunc(p.Allowed, func(allowed string) bool {
+		if suffix, ok := strings.CutPrefix(allowed, "*."); ok {
+			return strings.HasSuffix(host, "."+suffix)
+		}
+		return host == allowed
+	})
+}
+
+// Check ensures a URL is served from an allowed host. Relative URLs point at the
+// site itself and are always allowed.
+func (p HostPolicy) Check(rawURL string) error {
+	const op = "HostPolicy.Check"
+
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return &Error{Code: EInvalid, Message: MInvalidURLFormat, Operation: op, Cause: err}
+	}
+
+	if u.Host == "" || p.Allows(u.Hostname()) {
+		return nil
+	}
+
+	return &Error{
+		Code:      EInvalid,
+		Message:   fmt.Sprintf(MURLHostNotAllowed, u.Hostname(), strings.Join(p.Allowed, ", ")),
+		Operation: op,
+	}
+}
@@ -1,6 +1,7 @@
 package kernel_test
 
 import (
+	"net/url"
 	"testing"
 
 	"github.com/alnah/fla/internal/domain/kernel"
@@ -179,3 +180,108 @@ func TestURL_TypeSafety(t *testing.T) {
 		}
 	})
 }
+
+func TestURL_Join(t *testing.T) {
+	tests := []struct {
+		base     kernel.URL[TestResource]
+		segments []string
+		want     string
+	}{
+		{"https://fla.example", []string{"a1", "lecture"}, "https://fla.example/a1/lecture"},
+		{"https://fla.example/blog/", []string{"/a1/"}, "https://fla.example/blog/a1"},
+		{"https://fla.example/search?q=x", []string{"page 2"}, "https://fla.example/search/page%202?q=x"},
+		{"https://fla.example", []string{"a/b?c"}, "https://fla.example/a%2Fb%3Fc"},
+		{"https://fla.example/a1", nil, "https://fla.example/a1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			got, err := tt.base.Join(tt.segments...)
+			assertNoError(t, err)
+			if got.String() != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestURL_WithQuery(t *testing.T) {
+	u := kernel.URL[TestResource]("https://fla.example/search?q=menu&page=1#results")
+
+	got, err := u.WithQuery(url.Values{"page": {"2"}, "level": {"a1 & a2"}})
+
+	assertNoError(t, err)
+	if got != "https://fla.example/search?level=a1+%26+a2&page=2&q=menu#results" {
+		t.Errorf("got %q", got)
+	}
+}
+
+func TestNewRelativeURL(t *testing.T) {
+	t.Run("accepts site paths", func(t *testing.T) {
+		for _, input := range []string{"", "/", "/a1/lecture", "/media/menu.jpg?w=640", "/a1#exercices"} {
+			t.Run(input, func(t *testing.T) {
+				got, err := kernel.NewRelativeURL[TestResource](input)
+				assertNoError(t, err)
+				if got.String() != input {
+					t.Errorf("got %q", got)
+				}
+			})
+		}
+	})
+
+	t.Run("rejects absolute and path-relative references", func(t *testing.T) {
+		for _, input := range []string{"https://fla.example/a1", "//cdn.example/menu.jpg", "a1/lecture", "mailto:marie@fla.example"} {
+			t.Run(input, func(t *testing.T) {
+				_, err := kernel.NewRelativeURL[TestResource](input)
+				assertErrorCode(t, err, kernel.EInvalid)
+			})
+		}
+	})
+
+	t.Run("builds and resolves", func(t *testing.T) {
+		r, err := kernel.RelativeURL[TestResource]("/a1").Join("compréhension écrite")
+		assertNoError(t, err)
+		r, err = r.WithQuery(url.Values{"page": {"2"}})
+		assertNoError(t, err)
+
+		got, err := r.Resolve("https://fla.example/")
+		assertNoError(t, err)
+		if got != "https://fla.example/a1/compr%C3%A9hension%20%C3%A9crite?page=2" {
+			t.Errorf("got %q", got)
+		}
+
+		_, err = r.Resolve("fla.example")
+		assertErrorCode(t, err, kernel.EInvalid)
+	})
+}
+
+func TestHostPolicy(t *testing.T) {
+	policy := kernel.NewHostPolicy("cdn.fla.example", " *.cloudfront.net ", "")
+
+	tests := []struct {
+		url     string
+		allowed bool
+	}{
+		{"https://cdn.fla.example/menu.jpg", true},
+		{"https://CDN.fla.example:8443/menu.jpg", true},
+		{"https://d111.cloudfront.net/menu.jpg", true},
+		{"https://cloudfront.net/menu.jpg", false},
+		{"https://images.example.com/menu.jpg", false},
+		{"/media/menu.jpg", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.url, func(t *testing.T) {
+			err := policy.Check(tt.url)
+			if tt.allowed {
+				assertNoError(t, err)
+				return
+			}
+			assertErrorCode(t, err, kernel.EInvalid)
+		})
+	}
+
+	t.Run("empty policy allows every host", func(t *testing.T) {
+		assertNoError(t, kernel.HostPolicy{}.Check("https://images.example.com/menu.jpg"))
+	})
+}
@@ -6,28 +6,36 @@ import (
 	"unicode/utf8"
 )
 
+// Validation message templates, formatted with the field name first.
+const (
+	MFieldLength   string = "%s must be between %d and %d characters."
+	MFieldTooShort string = "%s must be greater than %d characters."
+	MFieldTooLong  string = "%s must be less than %d characters."
+	MFieldMissing  string = "Missing %s."
+)
+
 // ErrLen generates consistent length validation error messages.
 // Reduces repetitive error message formatting across value objects.
 func ErrLen(field string, minLen, maxLen int) string {
-	return fmt.Sprintf("%s must be between %d and %d characters.", field, minLen, maxLen)
+	return fmt.Sprintf(MFieldLength, field, minLen, maxLen)
 }
 
 // ErrGt generates greater-than length validation error messages.
 // Provides consistent minimum length error formatting.
 func ErrGt(field string, minLen int) string {
-	return fmt.Sprintf("%s must be greater than %d characters.", field, minLen)
+	return fmt.Sprintf(MFieldTooShort, field, minLen)
 }
 
 // ErrLt generates less-than length validation error messages.
 // Provides consistent maximum length error formatting.
 func ErrLt(field string, maxLen int) string {
-	return fmt.Sprintf("%s must be less than %d characters.", field, maxLen)
+	return fmt.Sprintf(MFieldTooLong, field, maxLen)
 }
 
 // ErrMissing generates missing field error messages.
 // Standardizes presence validation errors.
 func ErrMissing(field string) string {
-	return fmt.Sprintf("Missing %s.", field)
+	return fmt.Sprintf(MFieldMissing, field)
 }
 
 // ValidatePresence ensures a field is not empty.
@@ -35,9 +43,12 @@ func ErrMissing(field string) string {
 func ValidatePresence(field, value, operation string) error {
 	if strings.TrimSpace(value) == "" {
 		return &Error{
-			Code:      EInvalid,
-			Message:   ErrMissing(field),
-			Operation: operation,
+			Code:       EInvalid,
+			Message:    ErrMissing(field),
+			Operation:  operation,
+			Field:      field,
+			Value:      value,
+			Constraint: Constraint{Name: ConstraintRequired},
 		}
 	}
 	return nil
@@ -49,9 +60,12 @@ func ValidateLength(field, value string, minLen, maxLen int, operation string) e
 	length := utf8.RuneCountInString(value)
 	if length < minLen || length > maxLen {
 		return &Error{
-			Code:      EInvalid,
-			Message:   ErrLen(field, minLen, maxLen),
-			Operation: operation,
+			Code:       EInvalid,
+			Message:    ErrLen(field, minLen, maxLen),
+			Operation:  operation,
+			Field:      field,
+			Value:      value,
+			Constraint: Constraint{Name: ConstraintLength, Params: map[string]int{"min": minLen, "max": maxLen}},
 		}
 	}
 	return nil
@@ -62,9 +76,12 @@ func ValidateLength(field, value string, minLen, maxLen int, operation string) e
 func ValidateMinLength(field, value string, minLen int, operation string) error {
 	if utf8.RuneCountInString(value) < minLen {
 		return &Error{
-			Code:      EInvalid,
-			Message:   ErrGt(field, minLen),
-			Operation: operation,
+			Code:       EInvalid,
+			Message:    ErrGt(field, minLen),
+			Operation:  operation,
+			Field:      field,
+			Value:      value,
+			Constraint: Constraint{Name: ConstraintMinLength, Params: map[string]int{"min": minLen}},
 		}
 	}
 	return nil
@@ -75,9 +92,12 @@ func ValidateMinLength(field, value string, minLen int, operation string) error
 func ValidateMaxLength(field, value string, maxLen int, operation string) error {
 	if utf8.RuneCountInString(value) > maxLen {
 		return &Error{
-			Code:      EInvalid,
-			Message:   ErrLt(field, maxLen),
-			Operation: operation,
+			Code:       EInvalid,
+			Message:    ErrLt(field, maxLen),
+			Operation:  operation,
+			Field:      field,
+			Value:      value,
+			Constraint: Constraint{Name: ConstraintMaxLength, Params: map[string]int{"max": maxLen}},
 		}
 	}
 	return nil
@@ -0,0 +1,121 @@
+// Package linkaudit finds external links in published posts that no longer
+// work. Links are extracted from post content, checked through an HTTPChecker
+// with a per-host rate limit, and their last status is stored so editors get a
+// report of dead links grouped by post.
+package linkaudit
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+)
+
+const (
+	MLinkURLMissing      string = "Missing link URL."
+	MDeadAfterInvalid    string = "Links must fail at least once to be dead."
+	MRecheckAfterInvalid string = "Recheck interval must be positive."
+)
+
+// LinkStatus is the outcome of the last check of a link.
+type LinkStatus string
+
+const (
+	StatusUnchecked  LinkStatus = "unchecked"  // Found in content, not checked yet
+	StatusOK         LinkStatus = "ok"         // Answered 2xx
+	StatusRedirected LinkStatus = "redirected" // Answered 3xx; Location tells where the link moved
+	StatusFailing    LinkStatus = "failing"    // Server error or unreachable, but not long enough to be dead
+	StatusDead       LinkStatus = "dead"       // Answered 4xx, or failed DeadAfter checks in a row
+)
+
+// String returns the status as a string.
+func (s LinkStatus) String() string { return string(s) }
+
+// CheckResult is what an HTTPChecker got back from a URL. Redirects are not
+// followed, so editors can update links that moved.
+type CheckResult struct {
+	StatusCode int
+	Location   string // Redirect target for 3xx answers
+}
+
+// Link is an external URL found in published content, with the result of its
+// last check. URL is the identity; the same URL in several posts is checked once.
+type Link struct {
+	URL        kernel.URL[Link]
+	Status     LinkStatus
+	StatusCode int    // Last HTTP status, 0 when unreachable or unchecked
+	Location   string // Redirect target when redirected
+	LastError  string // Why the last check could not reach the server
+	Failures   int    // Failed checks in a row
+	CheckedAt  *time.Time
+}
+
+// NewLink returns an unchecked link.
+func NewLink(url kernel.URL[Link]) (Link, error) {
+	const op = "NewLink"
+
+	if url == "" {
+		return Link{}, &kernel.Error{Code: kernel.EInvalid, Message: MLinkURLMissing, Operation: op}
+	}
+
+	return Link{URL: url, Status: StatusUnchecked}, nil
+}
+
+// String returns a short description for logs.
+func (l Link) String() string {
+	return fmt.Sprintf("Link{URL: %q, Status: %q, StatusCode: %d, Failures: %d}", l.URL, l.Status, l.StatusCode, l.Failures)
+}
+
+// Host returns the host the link points to, which rate limits are kept per.
+func (l Link) Host() string { return l.URL.Host() }
+
+// IsDead reports whether editors should fix or remove the link.
+func (l Link) IsDead() bool { return l.Status == StatusDead }
+
+// IsFresh reports whether the link was checked less than recheckAfter ago.
+func (l Link) IsFresh(now time.Time, recheckAfter time.Duration) bool {
+	return l.CheckedAt != nil && now.Sub(*l.CheckedAt) < recheckAfter
+}
+
+// Record returns the link updated with a check made at now. A 4xx answer
+// (other than 429) means the page is gone; server errors, 429, and
+// unreachable hosts are often temporary, so the link is only dead after
+// deadAfter of them in a row.
+func (l Link) Record(result CheckResult, checkErr error, now time.Time, deadAfter int) Link {
+	updated := l
+	updated.CheckedAt = &now
+	updated.StatusCode = result.StatusCode
+	updated.Location = ""
+	updated.LastError = ""
+
+	switch code := result.StatusCode; {
+	case checkErr != nil:
+		updated.StatusCode = 0
+		updated.LastError = checkErr.Error()
+		updated = updated.failed(deadAfter)
+	case code >= 500 || code == http.StatusTooManyRequests:
+		updated = updated.failed(deadAfter)
+	case code >= 400:
+		updated.Status = StatusDead
+		updated.Failures = l.Failures + 1
+	case code >= 300:
+		updated.Status = StatusRedirected
+		updated.Location = result.Location
+		updated.Failures = 0
+	default:
+		updated.Status = StatusOK
+		updated.Failures = 0
+	}
+
+	return updated
+}
+
+func (l Link) failed(deadAfter int) Link {
+	l.Failures++
+	l.Status = StatusFailing
+	if l.Failures >= deadAfter {
+		l.Status = StatusDead
+	}
+	return l
+}
@@ -0,0 +1,67 @@
+package linkaudit_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/linkaudit"
+)
+
+func TestLink_Record(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	link, err := linkaudit.NewLink("https://www.lemonde.fr/culture")
+	assertNoError(t, err)
+
+	t.Run("classifies answers", func(t *testing.T) {
+		tests := []struct {
+			name   string
+			result linkaudit.CheckResult
+			want   linkaudit.LinkStatus
+		}{
+			{"ok", linkaudit.CheckResult{StatusCode: 200}, linkaudit.StatusOK},
+			{"moved", linkaudit.CheckResult{StatusCode: 301, Location: "https://lemonde.fr/culture"}, linkaudit.StatusRedirected},
+			{"gone", linkaudit.CheckResult{StatusCode: 404}, linkaudit.StatusDead},
+			{"throttled", linkaudit.CheckResult{StatusCode: 429}, linkaudit.StatusFailing},
+			{"server error", linkaudit.CheckResult{StatusCode: 503}, linkaudit.StatusFailing},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				got := link.Record(tt.result, nil, now, 3)
+
+				if got.Status != tt.want || got.StatusCode != tt.result.StatusCode || got.Location != tt.result.Location {
+					t.Errorf("got %s", got)
+				}
+				if got.CheckedAt == nil || !got.CheckedAt.Equal(now) {
+					t.Errorf("CheckedAt: got %v", got.CheckedAt)
+				}
+			})
+		}
+	})
+
+	t.Run("unreachable links die after repeated failures", func(t *testing.T) {
+		got := link
+		for range 2 {
+			got = got.Record(linkaudit.CheckResult{}, errors.New("timeout"), now, 3)
+		}
+		if got.Status != linkaudit.StatusFailing || got.LastError != "timeout" {
+			t.Fatalf("after 2 failures: got %s", got)
+		}
+
+		got = got.Record(linkaudit.CheckResult{}, errors.New("timeout"), now, 3)
+		if !got.IsDead() || got.Failures != 3 {
+			t.Errorf("after 3 failures: got %s", got)
+		}
+
+		got = got.Record(linkaudit.CheckResult{StatusCode: 200}, nil, now, 3)
+		if got.Status != linkaudit.StatusOK || got.Failures != 0 || got.LastError != "" {
+			t.Errorf("after recovering: got %s", got)
+		}
+	})
+
+	t.Run("requires a URL", func(t *testing.T) {
+		_, err := linkaudit.NewLink("")
+		assertErrorCode(t, err, kernel.EInvalid)
+	})
+}
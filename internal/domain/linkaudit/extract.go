@@ -0,0 +1,67 @@
+package linkaudit
+
+import (
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/shared"
+)
+
+// urlRe finds http(s) URLs in Markdown: inline links, autolinks, and bare URLs.
+// Brackets, quotes, and angle brackets end a URL; parentheses are balanced afterwards.
+var urlRe = regexp.MustCompile(`https?://[^\s<>"'\[\]]+`)
+
+// ExtractExternalLinks returns the distinct http(s) URLs of the content that
+// point outside the site, in order of appearance. Links inside fenced code
+// blocks are examples, not references, and are skipped.
+func ExtractExternalLinks(content string, site shared.Site) []kernel.URL[Link] {
+	siteHost := site.BaseURL.Host()
+
+	var links []kernel.URL[Link]
+	inFence := false
+	for _, line := range strings.Split(content, "\n") {
+		if trimmed := strings.TrimSpace(line); strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~") {
+			inFence = !inFence
+			continue
+		}
+		if inFence {
+			continue
+		}
+
+		for _, raw := range urlRe.FindAllString(line, -1) {
+			link := kernel.URL[Link](trimURL(raw))
+			if host := link.Host(); host == "" || host == siteHost || !isWebURL(link.String()) {
+				continue
+			}
+			if !slices.Contains(links, link) {
+				links = append(links, link)
+			}
+		}
+	}
+
+	return links
+}
+
+// trimURL drops what surrounds a URL in prose: trailing punctuation, and the
+// closing parenthesis of a Markdown link or aside, kept when the URL opened it
+// as in https://fr.wikipedia.org/wiki/Passé_(temps).
+func trimURL(raw string) string {
+	for {
+		trimmed := strings.TrimRight(raw, ".,;:!?*_")
+		if strings.HasSuffix(trimmed, ")") && strings.Count(trimmed, "(") < strings.Count(trimmed, ")") {
+			trimmed = strings.TrimSuffix(trimmed, ")")
+		}
+		if trimmed == raw {
+			return raw
+		}
+		raw = trimmed
+	}
+}
+
+func isWebURL(raw string) bool {
+	parsed, err := url.Parse(raw)
+	return err == nil && (parsed.Scheme == "http" || parsed.Scheme == "https") && parsed.Host != ""
+}
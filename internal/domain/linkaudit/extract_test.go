@@ -0,0 +1,35 @@
+package linkaudit_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/linkaudit"
+	"github.com/alnah/fla/internal/domain/shared"
+)
+
+func TestExtractExternalLinks(t *testing.T) {
+	site := shared.Site{Name: "fla", BaseURL: "https://fla.example", Locale: shared.LocaleFrenchFR}
+
+	content := `Lisez [cet article](https://www.lemonde.fr/culture/article.html "Le Monde") et <https://tv5monde.com/apprendre>.
+Voir aussi https://fr.wikipedia.org/wiki/Passé_(temps), puis (https://www.larousse.fr/conjugaison).
+Notre leçon : [le passé composé](https://fla.example/a2/passe-compose). Encore [Le Monde](https://www.lemonde.fr/culture/article.html).
+
+` + "```" + `
+curl https://api.example.com/v1/lessons
+` + "```" + `
+Pas un lien : ftp://files.example.com et mailto:marie@example.com.`
+
+	got := linkaudit.ExtractExternalLinks(content, site)
+
+	want := []kernel.URL[linkaudit.Link]{
+		"https://www.lemonde.fr/culture/article.html",
+		"https://tv5monde.com/apprendre",
+		"https://fr.wikipedia.org/wiki/Passé_(temps)",
+		"https://www.larousse.fr/conjugaison",
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("got %q\nwant %q", got, want)
+	}
+}
@@ -0,0 +1,78 @@
+package linkaudit_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/linkaudit"
+	"github.com/alnah/fla/internal/domain/post"
+	"github.com/alnah/fla/internal/domain/shared"
+)
+
+type stubClock struct {
+	t time.Time
+}
+
+func (s *stubClock) Now() time.Time { return s.t }
+
+func assertNoError(t *testing.T, err error) {
+	t.Helper()
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+}
+
+func assertErrorCode(t *testing.T, err error, want string) {
+	t.Helper()
+	got := kernel.ErrorCode(err)
+	if got != want {
+		t.Errorf("error code: got %q, want %q", got, want)
+	}
+}
+
+// stubPosts returns every post on a single page.
+type stubPosts struct {
+	posts []post.Post
+}
+
+func (s *stubPosts) Find(query post.Query) (post.PostsList, error) {
+	return post.PostsList{Posts: s.posts, Pagination: shared.Pagination{Page: 1, Limit: query.Pagination.Limit, TotalPages: 1}}, nil
+}
+
+type stubLinks struct {
+	links map[kernel.URL[linkaudit.Link]]linkaudit.Link
+}
+
+func newStubLinks() *stubLinks {
+	return &stubLinks{links: map[kernel.URL[linkaudit.Link]]linkaudit.Link{}}
+}
+
+func (s *stubLinks) GetLink(url kernel.URL[linkaudit.Link]) (*linkaudit.Link, error) {
+	link, ok := s.links[url]
+	if !ok {
+		return nil, &kernel.Error{Code: kernel.ENotFound, Message: "no link"}
+	}
+	return &link, nil
+}
+
+func (s *stubLinks) SaveLink(link linkaudit.Link) error {
+	s.links[link.URL] = link
+	return nil
+}
+
+// stubChecker answers with a status per URL; unknown URLs are unreachable.
+type stubChecker struct {
+	codes   map[kernel.URL[linkaudit.Link]]int
+	checked []kernel.URL[linkaudit.Link]
+}
+
+func (c *stubChecker) Check(url kernel.URL[linkaudit.Link]) (linkaudit.CheckResult, error) {
+	c.checked = append(c.checked, url)
+	code, ok := c.codes[url]
+	if !ok {
+		return linkaudit.CheckResult{}, errors.New("no such host")
+	}
+	return linkaudit.CheckResult{StatusCode: code}, nil
+}
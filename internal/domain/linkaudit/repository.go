@@ -0,0 +1,29 @@
+package linkaudit
+
+import "github.com/alnah/fla/internal/domain/kernel"
+
+// LinkReader retrieves link statuses.
+type LinkReader interface {
+	// GetLink returns the stored status of a URL. Returns ENotFound when never recorded.
+	GetLink(url kernel.URL[Link]) (*Link, error)
+}
+
+// LinkWriter persists link statuses.
+type LinkWriter interface {
+	// SaveLink stores a link, replacing any previous one with its URL.
+	SaveLink(link Link) error
+}
+
+// LinkRepository combines link persistence and retrieval.
+// Most concrete implementations (like PostgresLinkRepository) will implement this.
+type LinkRepository interface {
+	LinkReader
+	LinkWriter
+}
+
+// HTTPChecker requests a URL without following redirects. Adapters choose the
+// method (HEAD, then GET for servers refusing it), timeout, and user agent. An
+// error means no answer was received: DNS failure, refused connection, timeout.
+type HTTPChecker interface {
+	Check(url kernel.URL[Link]) (CheckResult, error)
+}
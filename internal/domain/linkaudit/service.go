@@ -0,0 +1,252 @@
+package linkaudit
+
+import (
+	"slices"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/post"
+	"github.com/alnah/fla/internal/domain/ratelimit"
+	"github.com/alnah/fla/internal/domain/shared"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+const MReportForbidden string = "Only editors and admins can see the link report."
+
+// Policy tunes how politely and how often links are checked.
+type Policy struct {
+	PerHost      ratelimit.Policy // Checks a host gets in a burst, and how fast that budget refills
+	RecheckAfter time.Duration    // Links checked more recently keep their status
+	DeadAfter    int              // Failed checks in a row before a failing link is dead
+}
+
+// DefaultPolicy checks each link weekly, a few requests at a time per host,
+// and gives flaky servers three weeks before reporting their links.
+func DefaultPolicy() Policy {
+	return Policy{
+		PerHost:      ratelimit.Policy{Capacity: 5, RefillEvery: 2 * time.Second},
+		RecheckAfter: 7 * 24 * time.Hour,
+		DeadAfter:    3,
+	}
+}
+
+// Validate ensures the per-host limit, recheck interval, and dead threshold are usable.
+func (p Policy) Validate() error {
+	const op = "Policy.Validate"
+
+	if err := p.PerHost.Validate(); err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+	if p.RecheckAfter <= 0 {
+		return &kernel.Error{Code: kernel.EInvalid, Message: MRecheckAfterInvalid, Operation: op}
+	}
+	if p.DeadAfter < 1 {
+		return &kernel.Error{Code: kernel.EInvalid, Message: MDeadAfterInvalid, Operation: op}
+	}
+	return nil
+}
+
+// AuditRun reports one pass over the links of published posts.
+type AuditRun struct {
+	Posts    int // Published posts scanned
+	Links    int // Distinct external links found
+	Checked  int
+	Fresh    int // Checked recently enough to keep their status
+	Deferred int // Over their host's rate limit; checked on a later run
+	Dead     int // Dead after this run, checked now or before
+}
+
+// PostDeadLinks lists the dead links of one post.
+type PostDeadLinks struct {
+	PostID kernel.ID[post.Post]
+	Title  shared.Title
+	Links  []Link
+}
+
+// DeadLinkReport lists, post by post, the links editors should fix.
+type DeadLinkReport struct {
+	Posts []PostDeadLinks // Only posts with dead links, in published order
+}
+
+// Count returns the number of dead links across posts; a link in two posts counts twice.
+func (r DeadLinkReport) Count() int {
+	n := 0
+	for _, p := range r.Posts {
+		n += len(p.Links)
+	}
+	return n
+}
+
+// AuditService checks the external links of published posts and reports the dead ones.
+type AuditService struct {
+	posts   post.PostFinder
+	links   LinkRepository
+	checker HTTPChecker
+	site    shared.Site
+	policy  Policy
+	clock   kernel.Clock
+}
+
+// NewAuditService creates link audit service with post lookups, link storage,
+// and an HTTP checker. Links to the site itself are not audited.
+func NewAuditService(
+	posts post.PostFinder,
+	links LinkRepository,
+	checker HTTPChecker,
+	site shared.Site,
+	policy Policy,
+	clock kernel.Clock,
+) (*AuditService, error) {
+	const op = "NewAuditService"
+
+	if err := policy.Validate(); err != nil {
+		return nil, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return &AuditService{posts: posts, links: links, checker: checker, site: site, policy: policy, clock: clock}, nil
+}
+
+// Run checks the external links of every published post, each URL once,
+// skipping those checked within RecheckAfter. A host that used up its rate
+// limit has its remaining links deferred to the next run. Checker errors are
+// recorded on the link; only failures to read posts or store links abort.
+func (s *AuditService) Run() (AuditRun, error) {
+	const op = "AuditService.Run"
+
+	posts, err := s.publishedLinks()
+	if err != nil {
+		return AuditRun{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	var urls []kernel.URL[Link]
+	for _, p := range posts {
+		for _, url := range p.links {
+			if !slices.Contains(urls, url) {
+				urls = append(urls, url)
+			}
+		}
+	}
+	slices.Sort(urls)
+
+	run := AuditRun{Posts: len(posts), Links: len(urls)}
+	buckets := make(map[string]ratelimit.Bucket)
+	for _, url := range urls {
+		link, err := s.load(url)
+		if err != nil {
+			return AuditRun{}, &kernel.Error{Operation: op, Cause: err}
+		}
+
+		now := s.clock.Now()
+		switch {
+		case link.IsFresh(now, s.policy.RecheckAfter):
+			run.Fresh++
+		case !s.take(buckets, link.Host(), now):
+			run.Deferred++
+		default:
+			result, checkErr := s.checker.Check(url)
+			link = link.Record(result, checkErr, s.clock.Now(), s.policy.DeadAfter)
+			if err := s.links.SaveLink(link); err != nil {
+				return AuditRun{}, &kernel.Error{Operation: op, Cause: err}
+			}
+			run.Checked++
+		}
+
+		if link.IsDead() {
+			run.Dead++
+		}
+	}
+
+	return run, nil
+}
+
+// Report lists the dead links of each published post, from the statuses the
+// last runs recorded. Links not checked yet are left out.
+func (s *AuditService) Report(actor user.PostPermissionChecker) (DeadLinkReport, error) {
+	const op = "AuditService.Report"
+
+	if !actor.HasAnyRole(user.RoleAdmin, user.RoleEditor) {
+		return DeadLinkReport{}, &kernel.Error{Code: kernel.EForbidden, Message: MReportForbidden, Operation: op}
+	}
+
+	posts, err := s.publishedLinks()
+	if err != nil {
+		return DeadLinkReport{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	var report DeadLinkReport
+	for _, p := range posts {
+		entry := PostDeadLinks{PostID: p.post.PostID, Title: p.post.Title}
+		for _, url := range p.links {
+			link, err := s.load(url)
+			if err != nil {
+				return DeadLinkReport{}, &kernel.Error{Operation: op, Cause: err}
+			}
+			if link.IsDead() {
+				entry.Links = append(entry.Links, link)
+			}
+		}
+		if len(entry.Links) > 0 {
+			report.Posts = append(report.Posts, entry)
+		}
+	}
+
+	return report, nil
+}
+
+// postLinks is a published post with the external links of its content.
+type postLinks struct {
+	post  post.Post
+	links []kernel.URL[Link]
+}
+
+func (s *AuditService) publishedLinks() ([]postLinks, error) {
+	query := post.PublishedQuery()
+
+	var posts []postLinks
+	for page := 1; ; page++ {
+		list, err := s.posts.Find(query.Page(page, shared.MaxPageLimit))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, p := range list.Posts {
+			posts = append(posts, postLinks{post: p, links: ExtractExternalLinks(p.Content.String(), s.site)})
+		}
+
+		if !list.Pagination.HasNextPage() {
+			break
+		}
+	}
+
+	return posts, nil
+}
+
+// load returns the stored link; a missing one is unchecked.
+func (s *AuditService) load(url kernel.URL[Link]) (Link, error) {
+	link, err := s.links.GetLink(url)
+	if kernel.ErrorCode(err) == kernel.ENotFound {
+		return NewLink(url)
+	}
+	if err != nil {
+		return Link{}, err
+	}
+	return *link, nil
+}
+
+// take spends one check from the host's bucket, reporting false when it is empty.
+func (s *AuditService) take(buckets map[string]ratelimit.Bucket, host string, now time.Time) bool {
+	bucket, ok := buckets[host]
+	if !ok {
+		bucket = ratelimit.NewBucket(s.policy.PerHost, now)
+	}
+	bucket = bucket.Refill(s.policy.PerHost, now)
+
+	if bucket.Tokens == 0 {
+		buckets[host] = bucket
+		return false
+	}
+
+	bucket.Tokens--
+	buckets[host] = bucket
+	return true
+}
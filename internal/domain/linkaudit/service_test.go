@@ -0,0 +1,129 @@
+package linkaudit_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/linkaudit"
+	"github.com/alnah/fla/internal/domain/post"
+	"github.com/alnah/fla/internal/domain/ratelimit"
+	"github.com/alnah/fla/internal/domain/shared"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+func TestAuditService(t *testing.T) {
+	clock := &stubClock{t: time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)}
+	site := shared.Site{Name: "fla", BaseURL: "https://fla.example", Locale: shared.LocaleFrenchFR}
+	editor := user.User{ID: "editor-1", Roles: []user.Role{user.RoleEditor}}
+
+	newPost := func(id, title, content string) post.Post {
+		return post.Post{PostID: kernel.ID[post.Post](id), Title: shared.Title(title), Content: post.PostContent(content)}
+	}
+	posts := &stubPosts{posts: []post.Post{
+		newPost("market", "Au marché", "Voir https://ok.example/marche et https://gone.example/fromages."),
+		newPost("cinema", "Au cinéma", "Voir https://gone.example/fromages, https://down.example/films et [nous](https://fla.example/a1)."),
+		newPost("numbers", "Les nombres", "Voir https://ok.example/nombres."),
+	}}
+	checker := &stubChecker{codes: map[kernel.URL[linkaudit.Link]]int{
+		"https://ok.example/marche":     200,
+		"https://ok.example/nombres":    200,
+		"https://gone.example/fromages": 404,
+	}}
+
+	newService := func(t *testing.T, links *stubLinks, policy linkaudit.Policy) *linkaudit.AuditService {
+		t.Helper()
+		s, err := linkaudit.NewAuditService(posts, links, checker, site, policy, clock)
+		assertNoError(t, err)
+		return s
+	}
+
+	t.Run("checks each link once and reports dead links by post", func(t *testing.T) {
+		checker.checked = nil
+		links := newStubLinks()
+		service := newService(t, links, linkaudit.DefaultPolicy())
+
+		run, err := service.Run()
+
+		assertNoError(t, err)
+		want := linkaudit.AuditRun{Posts: 3, Links: 4, Checked: 4, Dead: 1}
+		if run != want {
+			t.Errorf("got %+v, want %+v", run, want)
+		}
+		if links.links["https://down.example/films"].Status != linkaudit.StatusFailing {
+			t.Errorf("unreachable link: got %s", links.links["https://down.example/films"])
+		}
+
+		report, err := service.Report(&editor)
+
+		assertNoError(t, err)
+		if len(report.Posts) != 2 || report.Count() != 2 {
+			t.Fatalf("got %+v", report)
+		}
+		if report.Posts[0].PostID != "market" || report.Posts[1].PostID != "cinema" {
+			t.Errorf("posts: got %s, %s", report.Posts[0].PostID, report.Posts[1].PostID)
+		}
+		if report.Posts[1].Links[0].URL != "https://gone.example/fromages" {
+			t.Errorf("links: got %v", report.Posts[1].Links)
+		}
+	})
+
+	t.Run("keeps recent statuses and rechecks stale ones", func(t *testing.T) {
+		checker.checked = nil
+		links := newStubLinks()
+		service := newService(t, links, linkaudit.DefaultPolicy())
+		_, err := service.Run()
+		assertNoError(t, err)
+
+		checker.checked = nil
+		run, err := service.Run()
+		assertNoError(t, err)
+		if run.Fresh != 4 || run.Checked != 0 || len(checker.checked) != 0 {
+			t.Errorf("same day: got %+v", run)
+		}
+
+		clock.t = clock.t.AddDate(0, 0, 8)
+		defer func() { clock.t = clock.t.AddDate(0, 0, -8) }()
+		run, err = service.Run()
+		assertNoError(t, err)
+		if run.Checked != 4 {
+			t.Errorf("a week later: got %+v", run)
+		}
+	})
+
+	t.Run("defers links over the host's rate limit", func(t *testing.T) {
+		checker.checked = nil
+		links := newStubLinks()
+		policy := linkaudit.DefaultPolicy()
+		policy.PerHost = ratelimit.Policy{Capacity: 1, RefillEvery: time.Minute}
+		service := newService(t, links, policy)
+
+		run, err := service.Run()
+
+		assertNoError(t, err)
+		if run.Checked != 3 || run.Deferred != 1 {
+			t.Errorf("got %+v", run)
+		}
+		if _, err := links.GetLink("https://ok.example/nombres"); kernel.ErrorCode(err) != kernel.ENotFound {
+			t.Errorf("expected the second ok.example link deferred, got %v", err)
+		}
+	})
+
+	t.Run("only editors and admins see the report", func(t *testing.T) {
+		service := newService(t, newStubLinks(), linkaudit.DefaultPolicy())
+		author := user.User{ID: "author-1", Roles: []user.Role{user.RoleAuthor}}
+
+		_, err := service.Report(&author)
+
+		assertErrorCode(t, err, kernel.EForbidden)
+	})
+
+	t.Run("rejects invalid policies", func(t *testing.T) {
+		policy := linkaudit.DefaultPolicy()
+		policy.DeadAfter = 0
+
+		_, err := linkaudit.NewAuditService(posts, newStubLinks(), checker, site, policy, clock)
+
+		assertErrorCode(t, err, kernel.EInvalid)
+	})
+}
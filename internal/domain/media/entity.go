@@ -0,0 +1,287 @@
+package media
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/post"
+	"github.com/alnah/fla/internal/domain/shared"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+const (
+	MaxAltTextLength  int   = 250
+	MaxMediaByteSize  int64 = 10 << 20 // 10 MiB
+	MaxMediaDimension int   = 10000
+
+	MMediaURLMissing       string = "Missing media URL."
+	MMediaDimensionInvalid string = "Media dimensions must be between 1 and %d pixels."
+	MMediaSizeInvalid      string = "Media size must be between 1 byte and %d bytes."
+	MUsageFieldInvalid     string = "Invalid media usage field."
+)
+
+// AltText describes an image for screen readers and search engines.
+type AltText string
+
+// NewAltText creates validated alternative text.
+func NewAltText(text string) (AltText, error) {
+	const op = "NewAltText"
+
+	a := AltText(strings.TrimSpace(text))
+	if err := a.Validate(); err != nil {
+		return "", &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return a, nil
+}
+
+func (a AltText) String() string { return string(a) }
+
+// Validate ensures alt text is present and concise.
+func (a AltText) Validate() error {
+	const op = "AltText.Validate"
+
+	if err := kernel.ValidatePresence("alt text", a.String(), op); err != nil {
+		return err
+	}
+
+	return kernel.ValidateMaxLength("alt text", a.String(), MaxAltTextLength, op)
+}
+
+// UsageField names where a post references a media asset.
+type UsageField string
+
+const (
+	UsageFeaturedImage  UsageField = "featured_image"
+	UsageOpenGraphImage UsageField = "open_graph_image"
+)
+
+func (f UsageField) String() string { return string(f) }
+
+// Validate ensures the usage field is known.
+func (f UsageField) Validate() error {
+	const op = "UsageField.Validate"
+
+	switch f {
+	case UsageFeaturedImage, UsageOpenGraphImage:
+		return nil
+	default:
+		return &kernel.Error{Code: kernel.EInvalid, Message: MUsageFieldInvalid, Operation: op}
+	}
+}
+
+// Usage records one post referencing the asset.
+type Usage struct {
+	PostID kernel.ID[post.Post]
+	Field  UsageField
+}
+
+// Media represents an uploaded asset stored on the CDN.
+// Usage tracking lets editors see where an image appears before replacing or deleting it.
+type Media struct {
+	// Identity
+	MediaID kernel.ID[Media]
+	URL     kernel.URL[Media]
+
+	// Data
+	AltText  map[shared.Locale]AltText // Alt text per content locale
+	Width    int
+	Height   int
+	ByteSize int64
+	Variants []Variant // Responsive copies generated by an ImageProcessor
+
+	// Tracking
+	Usages []Usage
+
+	// Meta
+	Owner     kernel.ID[user.User]
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// NewMediaParams holds the information captured when an asset is uploaded.
+type NewMediaParams struct {
+	// Required
+	MediaID  kernel.ID[Media]
+	URL      kernel.URL[Media]
+	Width    int
+	Height   int
+	ByteSize int64
+	Owner    kernel.ID[user.User]
+
+	// Optional
+	AltText map[shared.Locale]AltText
+
+	// DI
+	Clock kernel.Clock
+}
+
+// NewMedia creates a validated media asset.
+func NewMedia(p NewMediaParams) (Media, error) {
+	const op = "NewMedia"
+
+	now := p.Clock.Now()
+
+	altText := make(map[shared.Locale]AltText, len(p.AltText))
+	for locale, text := range p.AltText {
+		altText[locale] = text
+	}
+
+	m := Media{
+		MediaID:   p.MediaID,
+		URL:       p.URL,
+		AltText:   altText,
+		Width:     p.Width,
+		Height:    p.Height,
+		ByteSize:  p.ByteSize,
+		Owner:     p.Owner,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	if err := m.Validate(); err != nil {
+		return Media{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return m, nil
+}
+
+// Validate enforces asset metadata rules.
+func (m Media) Validate() error {
+	const op = "Media.Validate"
+
+	if err := m.MediaID.Validate(); err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if m.URL == "" {
+		return &kernel.Error{Code: kernel.EInvalid, Message: MMediaURLMissing, Operation: op}
+	}
+
+	if err := m.URL.Validate(); err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if m.Width < 1 || m.Height < 1 || m.Width > MaxMediaDimension || m.Height > MaxMediaDimension {
+		return &kernel.Error{
+			Code:      kernel.EInvalid,
+			Message:   fmt.Sprintf(MMediaDimensionInvalid, MaxMediaDimension),
+			Operation: op,
+		}
+	}
+
+	if m.ByteSize < 1 || m.ByteSize > MaxMediaByteSize {
+		return &kernel.Error{
+			Code:      kernel.EInvalid,
+			Message:   fmt.Sprintf(MMediaSizeInvalid, MaxMediaByteSize),
+			Operation: op,
+		}
+	}
+
+	for locale, text := range m.AltText {
+		if err := locale.Validate(); err != nil {
+			return &kernel.Error{Operation: op, Cause: err}
+		}
+		if err := text.Validate(); err != nil {
+			return &kernel.Error{Operation: op, Cause: err}
+		}
+	}
+
+	if err := m.validateVariants(); err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	for _, u := range m.Usages {
+		if err := u.PostID.Validate(); err != nil {
+			return &kernel.Error{Operation: op, Cause: err}
+		}
+		if err := u.Field.Validate(); err != nil {
+			return &kernel.Error{Operation: op, Cause: err}
+		}
+	}
+
+	if err := m.Owner.Validate(); err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return nil
+}
+
+// GetAltText returns alt text in the requested locale, falling back to the default locale.
+func (m Media) GetAltText(locale shared.Locale) AltText {
+	if text, ok := m.AltText[locale.GetEffectiveLocale()]; ok {
+		return text
+	}
+	return m.AltText[shared.DefaultLocale]
+}
+
+// SetAltText returns a copy of the media with alt text set for a locale.
+func (m Media) SetAltText(locale shared.Locale, text AltText, clock kernel.Clock) (Media, error) {
+	const op = "Media.SetAltText"
+
+	updated := m
+	updated.AltText = make(map[shared.Locale]AltText, len(m.AltText)+1)
+	for l, t := range m.AltText {
+		updated.AltText[l] = t
+	}
+	updated.AltText[locale] = text
+	updated.UpdatedAt = clock.Now()
+
+	if err := updated.Validate(); err != nil {
+		return m, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return updated, nil
+}
+
+// AspectRatio returns width divided by height.
+func (m Media) AspectRatio() float64 {
+	if m.Height == 0 {
+		return 0
+	}
+	return float64(m.Width) / float64(m.Height)
+}
+
+// TrackUsage returns a copy recording that a post references the asset.
+// Recording the same usage twice is a no-op.
+func (m Media) TrackUsage(usage Usage) (Media, error) {
+	const op = "Media.TrackUsage"
+
+	if slices.Contains(m.Usages, usage) {
+		return m, nil
+	}
+
+	updated := m
+	updated.Usages = append(slices.Clone(m.Usages), usage)
+
+	if err := updated.Validate(); err != nil {
+		return m, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return updated, nil
+}
+
+// ReleaseUsages returns a copy without any usage by the given post.
+func (m Media) ReleaseUsages(postID kernel.ID[post.Post]) Media {
+	updated := m
+	updated.Usages = slices.DeleteFunc(slices.Clone(m.Usages), func(u Usage) bool {
+		return u.PostID == postID
+	})
+	return updated
+}
+
+// IsInUse reports whether any post references the asset.
+func (m Media) IsInUse() bool {
+	return len(m.Usages) > 0
+}
+
+// String returns a string representation of the media.
+func (m Media) String() string {
+	return fmt.Sprintf(
+		"Media{MediaID: %q, URL: %q, Width: %d, Height: %d, ByteSize: %d, Variants: %d, Usages: %d}",
+		m.MediaID, m.URL, m.Width, m.Height, m.ByteSize, len(m.Variants), len(m.Usages),
+	)
+}
@@ -0,0 +1,128 @@
+package media_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/media"
+	"github.com/alnah/fla/internal/domain/shared"
+)
+
+func TestNewAltText(t *testing.T) {
+	t.Run("trims text", func(t *testing.T) {
+		got, err := media.NewAltText("  Un ballon de football  ")
+
+		assertNoError(t, err)
+		if got != "Un ballon de football" {
+			t.Errorf("got %q", got)
+		}
+	})
+
+	t.Run("rejects empty or too long text", func(t *testing.T) {
+		for _, input := range []string{"", strings.Repeat("a", media.MaxAltTextLength+1)} {
+			_, err := media.NewAltText(input)
+
+			assertError(t, err)
+			assertErrorCode(t, err, kernel.EInvalid)
+		}
+	})
+}
+
+func TestNewMedia(t *testing.T) {
+	clock := &stubClock{t: time.Date(2024, 4, 1, 12, 0, 0, 0, time.UTC)}
+	valid := media.NewMediaParams{
+		MediaID:  "media-1",
+		URL:      "https://cdn.fla.example/football.jpg",
+		Width:    1200,
+		Height:   630,
+		ByteSize: 120_000,
+		Owner:    "user-123",
+		Clock:    clock,
+	}
+
+	t.Run("creates media", func(t *testing.T) {
+		got, err := media.NewMedia(valid)
+
+		assertNoError(t, err)
+		if !got.CreatedAt.Equal(clock.t) || got.IsInUse() {
+			t.Errorf("got %v", got)
+		}
+	})
+
+	t.Run("rejects invalid params", func(t *testing.T) {
+		tests := []struct {
+			name   string
+			modify func(p *media.NewMediaParams)
+		}{
+			{name: "missing ID", modify: func(p *media.NewMediaParams) { p.MediaID = "" }},
+			{name: "missing URL", modify: func(p *media.NewMediaParams) { p.URL = "" }},
+			{name: "insecure URL", modify: func(p *media.NewMediaParams) { p.URL = "ftp://cdn.fla.example/a.jpg" }},
+			{name: "zero width", modify: func(p *media.NewMediaParams) { p.Width = 0 }},
+			{name: "huge height", modify: func(p *media.NewMediaParams) { p.Height = media.MaxMediaDimension + 1 }},
+			{name: "empty file", modify: func(p *media.NewMediaParams) { p.ByteSize = 0 }},
+			{name: "oversized file", modify: func(p *media.NewMediaParams) { p.ByteSize = media.MaxMediaByteSize + 1 }},
+			{name: "missing owner", modify: func(p *media.NewMediaParams) { p.Owner = "" }},
+			{name: "unsupported alt locale", modify: func(p *media.NewMediaParams) {
+				p.AltText = map[shared.Locale]media.AltText{"de-DE": "Fußball"}
+			}},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				params := valid
+				tt.modify(&params)
+
+				_, err := media.NewMedia(params)
+
+				assertError(t, err)
+				assertErrorCode(t, err, kernel.EInvalid)
+			})
+		}
+	})
+}
+
+func TestMedia_AltText(t *testing.T) {
+	clock := &stubClock{t: time.Date(2024, 4, 2, 12, 0, 0, 0, time.UTC)}
+	m := newMedia(t, "media-1", "https://cdn.fla.example/football.jpg")
+
+	t.Run("falls back to default locale", func(t *testing.T) {
+		if got := m.GetAltText(shared.LocaleFrenchFR); got != "Children playing football" {
+			t.Errorf("got %q", got)
+		}
+	})
+
+	t.Run("sets localized alt text", func(t *testing.T) {
+		got, err := m.SetAltText(shared.LocaleFrenchFR, "Des enfants jouent au football", clock)
+
+		assertNoError(t, err)
+		if got.GetAltText(shared.LocaleFrenchFR) != "Des enfants jouent au football" {
+			t.Errorf("got %q", got.GetAltText(shared.LocaleFrenchFR))
+		}
+		if _, ok := m.AltText[shared.LocaleFrenchFR]; ok {
+			t.Error("original media must be unchanged")
+		}
+	})
+}
+
+func TestMedia_Usage(t *testing.T) {
+	m := newMedia(t, "media-1", "https://cdn.fla.example/football.jpg")
+	usage := media.Usage{PostID: "post-1", Field: media.UsageFeaturedImage}
+
+	tracked, err := m.TrackUsage(usage)
+	assertNoError(t, err)
+	tracked, err = tracked.TrackUsage(usage)
+	assertNoError(t, err)
+
+	if len(tracked.Usages) != 1 || !tracked.IsInUse() {
+		t.Errorf("Usages: got %v", tracked.Usages)
+	}
+	if released := tracked.ReleaseUsages("post-1"); released.IsInUse() {
+		t.Errorf("expected no usage after release, got %v", released.Usages)
+	}
+
+	_, err = m.TrackUsage(media.Usage{PostID: "post-1", Field: "gallery"})
+	assertError(t, err)
+	assertErrorCode(t, err, kernel.EInvalid)
+}
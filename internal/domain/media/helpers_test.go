@@ -0,0 +1,125 @@
+package media_test
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/media"
+	"github.com/alnah/fla/internal/domain/post"
+	"github.com/alnah/fla/internal/domain/shared"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+type stubClock struct {
+	t time.Time
+}
+
+func (s *stubClock) Now() time.Time { return s.t }
+
+type stubRepository struct {
+	byID map[kernel.ID[media.Media]]media.Media
+	err  error
+}
+
+func newStubRepository(items ...media.Media) *stubRepository {
+	r := &stubRepository{byID: make(map[kernel.ID[media.Media]]media.Media)}
+	for _, m := range items {
+		r.byID[m.MediaID] = m
+	}
+	return r
+}
+
+func (r *stubRepository) GetByID(id kernel.ID[media.Media]) (*media.Media, error) {
+	if m, ok := r.byID[id]; ok {
+		return &m, nil
+	}
+	return nil, &kernel.Error{Code: kernel.ENotFound, Message: "media not found"}
+}
+
+func (r *stubRepository) GetByURL(url string) (*media.Media, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+	for _, m := range r.byID {
+		if m.URL.String() == url {
+			return &m, nil
+		}
+	}
+	return nil, &kernel.Error{Code: kernel.ENotFound, Message: "media not found"}
+}
+
+func (r *stubRepository) Create(m media.Media) error {
+	r.byID[m.MediaID] = m
+	return nil
+}
+
+func (r *stubRepository) Update(m media.Media) error {
+	r.byID[m.MediaID] = m
+	return nil
+}
+
+func (r *stubRepository) Delete(id kernel.ID[media.Media]) error {
+	delete(r.byID, id)
+	return nil
+}
+
+func (r *stubRepository) GetByOwner(ownerID kernel.ID[user.User]) ([]media.Media, error) {
+	return nil, nil
+}
+
+func (r *stubRepository) GetByPost(postID kernel.ID[post.Post]) ([]media.Media, error) {
+	var used []media.Media
+	for _, m := range r.byID {
+		if slices.ContainsFunc(m.Usages, func(u media.Usage) bool { return u.PostID == postID }) {
+			used = append(used, m)
+		}
+	}
+	return used, nil
+}
+
+func (r *stubRepository) GetUnused() ([]media.Media, error) {
+	return nil, nil
+}
+
+func newMedia(t *testing.T, id, url string) media.Media {
+	t.Helper()
+
+	m, err := media.NewMedia(media.NewMediaParams{
+		MediaID:  kernel.ID[media.Media](id),
+		URL:      kernel.URL[media.Media](url),
+		Width:    1200,
+		Height:   630,
+		ByteSize: 120_000,
+		Owner:    "user-123",
+		AltText: map[shared.Locale]media.AltText{
+			shared.DefaultLocale: "Children playing football",
+		},
+		Clock: &stubClock{t: time.Date(2024, 4, 1, 12, 0, 0, 0, time.UTC)},
+	})
+	assertNoError(t, err)
+	return m
+}
+
+func assertNoError(t *testing.T, err error) {
+	t.Helper()
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+}
+
+func assertError(t *testing.T, err error) {
+	t.Helper()
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+}
+
+func assertErrorCode(t *testing.T, err error, want string) {
+	t.Helper()
+	got := kernel.ErrorCode(err)
+	if got != want {
+		t.Errorf("error code: got %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,51 @@
+package media
+
+import (
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/post"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+// MediaReader provides read access to the media library.
+// Used by image pickers, post validation, and broken link detection.
+type MediaReader interface {
+	// GetByID retrieves an asset for editing its metadata.
+	GetByID(mediaID kernel.ID[Media]) (*Media, error)
+
+	// GetByURL finds the asset served at a CDN URL.
+	// Returns ENotFound when no uploaded asset matches, i.e. a broken or external link.
+	GetByURL(url string) (*Media, error)
+}
+
+// MediaWriter handles media library persistence.
+type MediaWriter interface {
+	// Create registers a freshly uploaded asset.
+	Create(media Media) error
+
+	// Update saves metadata and usage changes.
+	Update(media Media) error
+
+	// Delete removes an asset; callers check IsInUse first.
+	Delete(mediaID kernel.ID[Media]) error
+}
+
+// MediaLister browses the library.
+type MediaLister interface {
+	// GetByOwner lists assets uploaded by a user, newest first.
+	GetByOwner(ownerID kernel.ID[user.User]) ([]Media, error)
+
+	// GetByPost lists assets with a usage by the post.
+	// Used to release images a post stopped referencing.
+	GetByPost(postID kernel.ID[post.Post]) ([]Media, error)
+
+	// GetUnused lists assets no post references, for cleanup.
+	GetUnused() ([]Media, error)
+}
+
+// Repository combines all media library operations.
+// Most concrete implementations (like PostgresMediaRepository) will implement this.
+type Repository interface {
+	MediaReader
+	MediaWriter
+	MediaLister
+}
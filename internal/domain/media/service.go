@@ -0,0 +1,261 @@
+package media
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/post"
+	"github.com/alnah/fla/internal/domain/shared"
+)
+
+const (
+	MBrokenImages string = "Post references images missing from the media library: %s."
+	MMediaInUse   string = "Media is still used by %d post(s)."
+)
+
+// BrokenReference is a post image URL that does not resolve to a library asset.
+type BrokenReference struct {
+	Field UsageField
+	URL   string
+}
+
+// LibraryService keeps post image references consistent with the media library.
+type LibraryService struct {
+	repository Repository
+}
+
+// NewLibraryService creates library service with repository dependency.
+func NewLibraryService(repository Repository) *LibraryService {
+	return &LibraryService{
+		repository: repository,
+	}
+}
+
+// FindBrokenReferences returns featured and Open Graph images not found in the library.
+// Empty image fields are optional and never reported.
+func (s *LibraryService) FindBrokenReferences(p post.Post) ([]BrokenReference, error) {
+	const op = "LibraryService.FindBrokenReferences"
+
+	var broken []BrokenReference
+	for _, ref := range postImages(p) {
+		_, err := s.repository.GetByURL(ref.URL)
+		if err == nil {
+			continue
+		}
+		if kernel.ErrorCode(err) != kernel.ENotFound {
+			return nil, &kernel.Error{Operation: op, Cause: err}
+		}
+		broken = append(broken, ref)
+	}
+
+	return broken, nil
+}
+
+// ValidatePostImages fails with EInvalid when a post references missing images.
+// Meant to run before publish so broken images never reach readers.
+func (s *LibraryService) ValidatePostImages(p post.Post) error {
+	const op = "LibraryService.ValidatePostImages"
+
+	broken, err := s.FindBrokenReferences(p)
+	if err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if len(broken) > 0 {
+		urls := make([]string, len(broken))
+		for i, ref := range broken {
+			urls[i] = ref.URL
+		}
+		return &kernel.Error{
+			Code:      kernel.EInvalid,
+			Message:   fmt.Sprintf(MBrokenImages, strings.Join(urls, ", ")),
+			Operation: op,
+		}
+	}
+
+	return nil
+}
+
+// TrackPost records which assets a post uses after it is saved, and releases
+// the usages of images the post no longer references.
+func (s *LibraryService) TrackPost(p post.Post) error {
+	const op = "LibraryService.TrackPost"
+
+	refs := postImages(p)
+
+	used, err := s.repository.GetByPost(p.PostID)
+	if err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+	for _, m := range used {
+		updated := m.ReleaseUsages(p.PostID)
+		for _, ref := range refs {
+			if ref.URL != m.URL.String() {
+				continue
+			}
+			if updated, err = updated.TrackUsage(Usage{PostID: p.PostID, Field: ref.Field}); err != nil {
+				return &kernel.Error{Operation: op, Cause: err}
+			}
+		}
+
+		if slices.Equal(updated.Usages, m.Usages) {
+			continue
+		}
+		if err := s.repository.Update(updated); err != nil {
+			return &kernel.Error{Operation: op, Cause: err}
+		}
+	}
+
+	for _, ref := range refs {
+		m, err := s.repository.GetByURL(ref.URL)
+		if err != nil {
+			return &kernel.Error{Operation: op, Cause: err}
+		}
+
+		updated, err := m.TrackUsage(Usage{PostID: p.PostID, Field: ref.Field})
+		if err != nil {
+			return &kernel.Error{Operation: op, Cause: err}
+		}
+
+		if err := s.repository.Update(updated); err != nil {
+			return &kernel.Error{Operation: op, Cause: err}
+		}
+	}
+
+	return nil
+}
+
+// Delete removes an asset from the library unless a post still uses it.
+func (s *LibraryService) Delete(mediaID kernel.ID[Media]) error {
+	const op = "LibraryService.Delete"
+
+	m, err := s.repository.GetByID(mediaID)
+	if err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if m.IsInUse() {
+		return &kernel.Error{
+			Code:      kernel.EConflict,
+			Message:   fmt.Sprintf(MMediaInUse, len(m.Usages)),
+			Operation: op,
+		}
+	}
+
+	if err := s.repository.Delete(mediaID); err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return nil
+}
+
+// Inspect returns the stored size of the asset served at url, so post
+// preflight can flag oversized images. Returns ENotFound outside the library.
+func (s *LibraryService) Inspect(url string) (post.ImageInfo, error) {
+	const op = "LibraryService.Inspect"
+
+	m, err := s.repository.GetByURL(url)
+	if err != nil {
+		return post.ImageInfo{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return post.ImageInfo{Width: m.Width, Height: m.Height, ByteSize: m.ByteSize}, nil
+}
+
+// FeaturedImage returns srcset-ready data for the post's featured image, with
+// alt text in the locale, or nil when the post has none or it is external.
+func (s *LibraryService) FeaturedImage(p post.Post, locale shared.Locale) (*ResponsiveImage, error) {
+	const op = "LibraryService.FeaturedImage"
+
+	if !p.HasFeaturedImage() {
+		return nil, nil
+	}
+
+	m, err := s.repository.GetByURL(p.FeaturedImage.String())
+	if kernel.ErrorCode(err) == kernel.ENotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	image := m.Responsive(locale)
+	return &image, nil
+}
+
+// VariantService generates the responsive copies of library images.
+type VariantService struct {
+	repository Repository
+	processor  ImageProcessor
+	widths     []int
+	formats    []ImageFormat
+	clock      kernel.Clock
+}
+
+// NewVariantService creates variant service with repository and image
+// processing dependencies, generating DefaultVariantWidths in DefaultVariantFormats.
+func NewVariantService(repository Repository, processor ImageProcessor, clock kernel.Clock) *VariantService {
+	return &VariantService{
+		repository: repository,
+		processor:  processor,
+		widths:     DefaultVariantWidths,
+		formats:    DefaultVariantFormats,
+		clock:      clock,
+	}
+}
+
+// Generate processes the variants the asset is missing and stores them.
+// Variants processed before a failure are kept, so a retry resumes where
+// it stopped.
+func (s *VariantService) Generate(mediaID kernel.ID[Media]) (Media, error) {
+	const op = "VariantService.Generate"
+
+	m, err := s.repository.GetByID(mediaID)
+	if err != nil {
+		return Media{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	var variants []Variant
+	var processErr error
+	for _, spec := range m.MissingVariants(s.widths, s.formats) {
+		variant, err := s.processor.Process(*m, spec)
+		if err != nil {
+			processErr = err
+			break
+		}
+		variants = append(variants, variant)
+	}
+
+	if len(variants) == 0 && processErr == nil {
+		return *m, nil
+	}
+
+	updated := *m
+	if len(variants) > 0 {
+		if updated, err = m.AddVariants(variants, s.clock); err != nil {
+			return Media{}, &kernel.Error{Operation: op, Cause: err}
+		}
+		if err := s.repository.Update(updated); err != nil {
+			return Media{}, &kernel.Error{Operation: op, Cause: err}
+		}
+	}
+
+	if processErr != nil {
+		return updated, &kernel.Error{Operation: op, Cause: processErr}
+	}
+
+	return updated, nil
+}
+
+func postImages(p post.Post) []BrokenReference {
+	var refs []BrokenReference
+	if p.FeaturedImage != "" {
+		refs = append(refs, BrokenReference{Field: UsageFeaturedImage, URL: p.FeaturedImage.String()})
+	}
+	if p.OpenGraphImage != "" {
+		refs = append(refs, BrokenReference{Field: UsageOpenGraphImage, URL: p.OpenGraphImage.String()})
+	}
+	return refs
+}
@@ -0,0 +1,130 @@
+package media_test
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/media"
+	"github.com/alnah/fla/internal/domain/post"
+)
+
+func TestLibraryService_ValidatePostImages(t *testing.T) {
+	featured := newMedia(t, "media-1", "https://cdn.fla.example/football.jpg")
+
+	t.Run("accepts posts referencing library images", func(t *testing.T) {
+		service := media.NewLibraryService(newStubRepository(featured))
+		p := post.Post{PostID: "post-1", FeaturedImage: "https://cdn.fla.example/football.jpg"}
+
+		assertNoError(t, service.ValidatePostImages(p))
+	})
+
+	t.Run("accepts posts without images", func(t *testing.T) {
+		service := media.NewLibraryService(newStubRepository())
+
+		assertNoError(t, service.ValidatePostImages(post.Post{PostID: "post-1"}))
+	})
+
+	t.Run("reports broken references", func(t *testing.T) {
+		service := media.NewLibraryService(newStubRepository(featured))
+		p := post.Post{
+			PostID:         "post-1",
+			FeaturedImage:  "https://cdn.fla.example/football.jpg",
+			OpenGraphImage: "https://cdn.fla.example/deleted.jpg",
+		}
+
+		broken, err := service.FindBrokenReferences(p)
+		assertNoError(t, err)
+		if len(broken) != 1 || broken[0].Field != media.UsageOpenGraphImage {
+			t.Errorf("broken: got %v", broken)
+		}
+
+		err = service.ValidatePostImages(p)
+		assertError(t, err)
+		assertErrorCode(t, err, kernel.EInvalid)
+		if !strings.Contains(err.Error(), "deleted.jpg") {
+			t.Errorf("error should name the broken URL: %v", err)
+		}
+	})
+
+	t.Run("propagates repository errors", func(t *testing.T) {
+		repo := newStubRepository()
+		repo.err = &kernel.Error{Code: kernel.EInternal, Message: "database down"}
+		service := media.NewLibraryService(repo)
+
+		err := service.ValidatePostImages(post.Post{PostID: "post-1", FeaturedImage: "https://cdn.fla.example/a.jpg"})
+
+		assertError(t, err)
+		assertErrorCode(t, err, kernel.EInternal)
+	})
+}
+
+func TestLibraryService_TrackPostAndDelete(t *testing.T) {
+	repo := newStubRepository(newMedia(t, "media-1", "https://cdn.fla.example/football.jpg"))
+	service := media.NewLibraryService(repo)
+	p := post.Post{PostID: "post-1", FeaturedImage: "https://cdn.fla.example/football.jpg"}
+
+	assertNoError(t, service.TrackPost(p))
+
+	err := service.Delete("media-1")
+	assertError(t, err)
+	assertErrorCode(t, err, kernel.EConflict)
+
+	repo.byID["media-1"] = repo.byID["media-1"].ReleaseUsages(p.PostID)
+	assertNoError(t, service.Delete("media-1"))
+
+	err = service.Delete("media-1")
+	assertError(t, err)
+	assertErrorCode(t, err, kernel.ENotFound)
+}
+
+func TestLibraryService_TrackPost(t *testing.T) {
+	football := "https://cdn.fla.example/football.jpg"
+	market := "https://cdn.fla.example/market.jpg"
+	repo := newStubRepository(newMedia(t, "media-1", football), newMedia(t, "media-2", market))
+	service := media.NewLibraryService(repo)
+	p := post.Post{PostID: "post-1", FeaturedImage: kernel.URL[post.FeaturedImage](football), OpenGraphImage: kernel.URL[post.OpenGraphImage](football)}
+
+	assertNoError(t, service.TrackPost(p))
+	if got := repo.byID["media-1"].Usages; len(got) != 2 {
+		t.Fatalf("got usages %v", got)
+	}
+
+	t.Run("releases dropped fields", func(t *testing.T) {
+		p.OpenGraphImage = ""
+
+		assertNoError(t, service.TrackPost(p))
+
+		want := []media.Usage{{PostID: "post-1", Field: media.UsageFeaturedImage}}
+		if got := repo.byID["media-1"].Usages; !slices.Equal(got, want) {
+			t.Errorf("got usages %v, want %v", got, want)
+		}
+	})
+
+	t.Run("releases replaced images", func(t *testing.T) {
+		p.FeaturedImage = kernel.URL[post.FeaturedImage](market)
+
+		assertNoError(t, service.TrackPost(p))
+
+		if repo.byID["media-1"].IsInUse() {
+			t.Errorf("got usages %v", repo.byID["media-1"].Usages)
+		}
+		if got := repo.byID["media-2"].Usages; len(got) != 1 || got[0].Field != media.UsageFeaturedImage {
+			t.Errorf("got usages %v", got)
+		}
+	})
+
+	t.Run("keeps other posts' usages", func(t *testing.T) {
+		other := post.Post{PostID: "post-2", FeaturedImage: kernel.URL[post.FeaturedImage](market)}
+		assertNoError(t, service.TrackPost(other))
+		p.FeaturedImage = ""
+
+		assertNoError(t, service.TrackPost(p))
+
+		want := []media.Usage{{PostID: "post-2", Field: media.UsageFeaturedImage}}
+		if got := repo.byID["media-2"].Usages; !slices.Equal(got, want) {
+			t.Errorf("got usages %v, want %v", got, want)
+		}
+	})
+}
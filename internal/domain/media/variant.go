@@ -0,0 +1,204 @@
+package media
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/shared"
+)
+
+const (
+	MImageFormatInvalid  string = "Invalid image format: %s."
+	MVariantURLMissing   string = "Missing image variant URL."
+	MVariantWidthInvalid string = "Image variant width must be between 1 and the original width (%d pixels)."
+	MVariantDuplicate    string = "Image variant %dw %s is listed twice."
+)
+
+// ImageFormat is the encoding of an image file.
+type ImageFormat string
+
+const (
+	FormatJPEG ImageFormat = "jpeg"
+	FormatPNG  ImageFormat = "png"
+	FormatWebP ImageFormat = "webp"
+	FormatAVIF ImageFormat = "avif"
+)
+
+func (f ImageFormat) String() string { return string(f) }
+
+// Validate ensures the format is one browsers display.
+func (f ImageFormat) Validate() error {
+	const op = "ImageFormat.Validate"
+
+	switch f {
+	case FormatJPEG, FormatPNG, FormatWebP, FormatAVIF:
+		return nil
+	default:
+		return &kernel.Error{Code: kernel.EInvalid, Message: fmt.Sprintf(MImageFormatInvalid, f), Operation: op}
+	}
+}
+
+// MIMEType returns the type of the format for <source type="...">.
+func (f ImageFormat) MIMEType() string { return "image/" + f.String() }
+
+// DefaultVariantWidths are the widths generated for responsive images, from
+// phones to large screens. Widths above the original are skipped.
+var DefaultVariantWidths = []int{320, 640, 960, 1280, 1920}
+
+// DefaultVariantFormats are the formats generated for responsive images, most
+// compact first. Browsers lacking both fall back to the original.
+var DefaultVariantFormats = []ImageFormat{FormatAVIF, FormatWebP}
+
+// VariantSpec asks an ImageProcessor for one resized, re-encoded copy of an asset.
+type VariantSpec struct {
+	Width  int
+	Format ImageFormat
+}
+
+// Variant is a generated copy of an image asset, scaled to Width with the
+// original aspect ratio.
+type Variant struct {
+	Width    int
+	Height   int
+	Format   ImageFormat
+	URL      kernel.URL[Media]
+	ByteSize int64
+}
+
+// Spec returns the spec the variant was generated from.
+func (v Variant) Spec() VariantSpec { return VariantSpec{Width: v.Width, Format: v.Format} }
+
+// ImageProcessor resizes and re-encodes images, then stores the result on the
+// CDN. Implemented by adapters around libvips or an image CDN.
+type ImageProcessor interface {
+	Process(original Media, spec VariantSpec) (Variant, error)
+}
+
+// PlanVariants returns the variants the asset should have: each width up to
+// the original's, in each format. Originals narrower than every width get a
+// single re-encoded copy at their own width.
+func (m Media) PlanVariants(widths []int, formats []ImageFormat) []VariantSpec {
+	var kept []int
+	for _, w := range widths {
+		if w <= m.Width && !slices.Contains(kept, w) {
+			kept = append(kept, w)
+		}
+	}
+	if len(kept) == 0 {
+		kept = []int{m.Width}
+	}
+	slices.Sort(kept)
+
+	specs := make([]VariantSpec, 0, len(kept)*len(formats))
+	for _, format := range formats {
+		for _, w := range kept {
+			specs = append(specs, VariantSpec{Width: w, Format: format})
+		}
+	}
+	return specs
+}
+
+// MissingVariants returns the planned specs the asset has no variant for yet.
+func (m Media) MissingVariants(widths []int, formats []ImageFormat) []VariantSpec {
+	return slices.DeleteFunc(m.PlanVariants(widths, formats), func(spec VariantSpec) bool {
+		return m.variantIndex(spec) >= 0
+	})
+}
+
+// AddVariants returns a copy with the variants stored, replacing any with the
+// same width and format. Variants are kept sorted by format, then width.
+func (m Media) AddVariants(variants []Variant, clock kernel.Clock) (Media, error) {
+	const op = "Media.AddVariants"
+
+	updated := m
+	updated.Variants = slices.Clone(m.Variants)
+	for _, v := range variants {
+		if i := updated.variantIndex(v.Spec()); i >= 0 {
+			updated.Variants[i] = v
+		} else {
+			updated.Variants = append(updated.Variants, v)
+		}
+	}
+	slices.SortFunc(updated.Variants, func(a, b Variant) int {
+		return cmp.Or(cmp.Compare(a.Format, b.Format), cmp.Compare(a.Width, b.Width))
+	})
+	updated.UpdatedAt = clock.Now()
+
+	if err := updated.Validate(); err != nil {
+		return m, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return updated, nil
+}
+
+// SrcSet returns the srcset attribute for a format, e.g.
+// "https://cdn.example/a-640.webp 640w, https://cdn.example/a-1280.webp 1280w",
+// or "" when the asset has no variant in that format.
+func (m Media) SrcSet(format ImageFormat) string {
+	var candidates []string
+	for _, v := range m.Variants {
+		if v.Format == format {
+			candidates = append(candidates, fmt.Sprintf("%s %dw", v.URL, v.Width))
+		}
+	}
+	return strings.Join(candidates, ", ")
+}
+
+func (m Media) variantIndex(spec VariantSpec) int {
+	return slices.IndexFunc(m.Variants, func(v Variant) bool { return v.Spec() == spec })
+}
+
+func (m Media) validateVariants() error {
+	const op = "Media.validateVariants"
+
+	seen := make(map[VariantSpec]bool, len(m.Variants))
+	for _, v := range m.Variants {
+		if err := v.Format.Validate(); err != nil {
+			return &kernel.Error{Operation: op, Cause: err}
+		}
+		if v.URL == "" {
+			return &kernel.Error{Code: kernel.EInvalid, Message: MVariantURLMissing, Operation: op}
+		}
+		if v.Width < 1 || v.Width > m.Width {
+			return &kernel.Error{Code: kernel.EInvalid, Message: fmt.Sprintf(MVariantWidthInvalid, m.Width), Operation: op}
+		}
+		if seen[v.Spec()] {
+			return &kernel.Error{Code: kernel.EInvalid, Message: fmt.Sprintf(MVariantDuplicate, v.Width, v.Format), Operation: op}
+		}
+		seen[v.Spec()] = true
+	}
+
+	return nil
+}
+
+// ImageSource is one <source> of a <picture> element.
+type ImageSource struct {
+	Type   string // MIME type, e.g. "image/avif"
+	SrcSet string
+}
+
+// ResponsiveImage holds what templates need to render an asset as a
+// <picture>: sources by format, most compact first, and the original as the
+// <img> fallback, with its size to reserve layout space.
+type ResponsiveImage struct {
+	Src     string
+	Width   int
+	Height  int
+	Alt     string
+	Sources []ImageSource
+}
+
+// Responsive returns the asset's srcset-ready data, with alt text in the locale.
+// Formats follow DefaultVariantFormats; formats without variants are left out.
+func (m Media) Responsive(locale shared.Locale) ResponsiveImage {
+	image := ResponsiveImage{Src: m.URL.String(), Width: m.Width, Height: m.Height, Alt: m.GetAltText(locale).String()}
+	for _, format := range DefaultVariantFormats {
+		if srcset := m.SrcSet(format); srcset != "" {
+			image.Sources = append(image.Sources, ImageSource{Type: format.MIMEType(), SrcSet: srcset})
+		}
+	}
+	return image
+}
@@ -0,0 +1,178 @@
+package media_test
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/media"
+	"github.com/alnah/fla/internal/domain/post"
+	"github.com/alnah/fla/internal/domain/shared"
+)
+
+// stubProcessor names variants after their spec and fails on failFormat.
+type stubProcessor struct {
+	processed  []media.VariantSpec
+	failFormat media.ImageFormat
+}
+
+func (p *stubProcessor) Process(original media.Media, spec media.VariantSpec) (media.Variant, error) {
+	if spec.Format == p.failFormat {
+		return media.Variant{}, errors.New("encoder crashed")
+	}
+	p.processed = append(p.processed, spec)
+	return media.Variant{
+		Width:    spec.Width,
+		Height:   spec.Width * original.Height / original.Width,
+		Format:   spec.Format,
+		URL:      kernel.URL[media.Media](fmt.Sprintf("%s-%d.%s", original.MediaID, spec.Width, spec.Format)),
+		ByteSize: int64(spec.Width) * 40,
+	}, nil
+}
+
+func TestMedia_PlanVariants(t *testing.T) {
+	m := newMedia(t, "media-1", "https://cdn.fla.example/football.jpg") // 1200 pixels wide
+
+	t.Run("skips widths above the original", func(t *testing.T) {
+		got := m.PlanVariants(media.DefaultVariantWidths, []media.ImageFormat{media.FormatWebP})
+
+		want := []media.VariantSpec{{Width: 320, Format: media.FormatWebP}, {Width: 640, Format: media.FormatWebP}, {Width: 960, Format: media.FormatWebP}}
+		if !slices.Equal(got, want) {
+			t.Errorf("got %v, want %v", got, want)
+		}
+	})
+
+	t.Run("re-encodes small originals at their own width", func(t *testing.T) {
+		small := m
+		small.Width, small.Height = 200, 100
+
+		got := small.PlanVariants(media.DefaultVariantWidths, media.DefaultVariantFormats)
+
+		want := []media.VariantSpec{{Width: 200, Format: media.FormatAVIF}, {Width: 200, Format: media.FormatWebP}}
+		if !slices.Equal(got, want) {
+			t.Errorf("got %v, want %v", got, want)
+		}
+	})
+}
+
+func TestMedia_AddVariants(t *testing.T) {
+	m := newMedia(t, "media-1", "https://cdn.fla.example/football.jpg")
+	clock := &stubClock{t: time.Date(2024, 4, 2, 12, 0, 0, 0, time.UTC)}
+
+	t.Run("builds srcset and picture sources", func(t *testing.T) {
+		got, err := m.AddVariants([]media.Variant{
+			{Width: 640, Height: 336, Format: media.FormatWebP, URL: "https://cdn.fla.example/football-640.webp"},
+			{Width: 320, Height: 168, Format: media.FormatWebP, URL: "https://cdn.fla.example/football-320.webp"},
+		}, clock)
+
+		assertNoError(t, err)
+		if srcset := got.SrcSet(media.FormatWebP); srcset != "https://cdn.fla.example/football-320.webp 320w, https://cdn.fla.example/football-640.webp 640w" {
+			t.Errorf("srcset: got %q", srcset)
+		}
+
+		image := got.Responsive(shared.LocaleFrenchFR)
+		want := media.ResponsiveImage{
+			Src: "https://cdn.fla.example/football.jpg", Width: 1200, Height: 630, Alt: "Children playing football",
+			Sources: []media.ImageSource{{Type: "image/webp", SrcSet: got.SrcSet(media.FormatWebP)}},
+		}
+		if fmt.Sprint(image) != fmt.Sprint(want) {
+			t.Errorf("got %+v, want %+v", image, want)
+		}
+		if len(m.Variants) != 0 {
+			t.Error("expected the original left untouched")
+		}
+	})
+
+	tests := []struct {
+		name    string
+		variant media.Variant
+	}{
+		{"wider than the original", media.Variant{Width: 1920, Format: media.FormatWebP, URL: "https://cdn.fla.example/a.webp"}},
+		{"unknown format", media.Variant{Width: 640, Format: "gif", URL: "https://cdn.fla.example/a.gif"}},
+		{"no URL", media.Variant{Width: 640, Format: media.FormatAVIF}},
+	}
+	for _, tt := range tests {
+		t.Run("rejects "+tt.name, func(t *testing.T) {
+			_, err := m.AddVariants([]media.Variant{tt.variant}, clock)
+			assertErrorCode(t, err, kernel.EInvalid)
+		})
+	}
+}
+
+func TestVariantService_Generate(t *testing.T) {
+	clock := &stubClock{t: time.Date(2024, 4, 2, 12, 0, 0, 0, time.UTC)}
+
+	t.Run("generates missing variants once", func(t *testing.T) {
+		repo := newStubRepository(newMedia(t, "media-1", "https://cdn.fla.example/football.jpg"))
+		processor := &stubProcessor{}
+		service := media.NewVariantService(repo, processor, clock)
+
+		got, err := service.Generate("media-1")
+
+		assertNoError(t, err)
+		if len(got.Variants) != 6 || len(repo.byID["media-1"].Variants) != 6 {
+			t.Fatalf("got %d variants, stored %d", len(got.Variants), len(repo.byID["media-1"].Variants))
+		}
+
+		processor.processed = nil
+		_, err = service.Generate("media-1")
+		assertNoError(t, err)
+		if len(processor.processed) != 0 {
+			t.Errorf("regenerated %v", processor.processed)
+		}
+	})
+
+	t.Run("keeps variants processed before a failure", func(t *testing.T) {
+		repo := newStubRepository(newMedia(t, "media-1", "https://cdn.fla.example/football.jpg"))
+		service := media.NewVariantService(repo, &stubProcessor{failFormat: media.FormatWebP}, clock)
+
+		_, err := service.Generate("media-1")
+
+		if err == nil {
+			t.Fatal("expected the processing error")
+		}
+		if got := repo.byID["media-1"].SrcSet(media.FormatAVIF); got == "" {
+			t.Error("expected AVIF variants stored")
+		}
+	})
+}
+
+func TestLibraryService_Images(t *testing.T) {
+	featured := newMedia(t, "media-1", "https://cdn.fla.example/football.jpg")
+	service := media.NewLibraryService(newStubRepository(featured))
+
+	t.Run("inspects library images", func(t *testing.T) {
+		got, err := service.Inspect(featured.URL.String())
+
+		assertNoError(t, err)
+		if got != (post.ImageInfo{Width: 1200, Height: 630, ByteSize: 120_000}) {
+			t.Errorf("got %+v", got)
+		}
+
+		_, err = service.Inspect("https://images.example/a.jpg")
+		assertErrorCode(t, err, kernel.ENotFound)
+	})
+
+	t.Run("returns the featured image of posts", func(t *testing.T) {
+		got, err := service.FeaturedImage(post.Post{FeaturedImage: "https://cdn.fla.example/football.jpg"}, shared.LocaleFrenchFR)
+
+		assertNoError(t, err)
+		if got == nil || got.Src != featured.URL.String() || got.Alt != "Children playing football" {
+			t.Errorf("got %+v", got)
+		}
+	})
+
+	t.Run("has nothing for external or missing images", func(t *testing.T) {
+		for _, p := range []post.Post{{}, {FeaturedImage: "https://images.example/a.jpg"}} {
+			got, err := service.FeaturedImage(p, shared.LocaleFrenchFR)
+
+			assertNoError(t, err)
+			if got != nil {
+				t.Errorf("got %+v", got)
+			}
+		}
+	})
+}
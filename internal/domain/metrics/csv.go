@@ -0,0 +1,200 @@
+package metrics
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+)
+
+const MCSVWriteFailed string = "Statistics could not be exported as CSV."
+
+// Table is a report section that can be exported as CSV.
+type Table interface {
+	Header() []string
+	Records() [][]string
+}
+
+// WriteCSV writes a header row followed by one row per record.
+func WriteCSV(w io.Writer, table Table) error {
+	const op = "WriteCSV"
+
+	out := csv.NewWriter(w)
+	if err := out.Write(table.Header()); err != nil {
+		return &kernel.Error{Code: kernel.EInternal, Message: MCSVWriteFailed, Operation: op, Cause: err}
+	}
+	if err := out.WriteAll(table.Records()); err != nil {
+		return &kernel.Error{Code: kernel.EInternal, Message: MCSVWriteFailed, Operation: op, Cause: err}
+	}
+
+	return nil
+}
+
+// StatusCounts lists post counts in workflow order.
+type StatusCounts []StatusCount
+
+func (c StatusCounts) Header() []string { return []string{"status", "posts"} }
+
+func (c StatusCounts) Records() [][]string {
+	records := make([][]string, len(c))
+	for i, r := range c {
+		records[i] = []string{r.Status.String(), strconv.Itoa(r.Posts)}
+	}
+	return records
+}
+
+// CategoryCounts lists categories in tree order.
+type CategoryCounts []CategoryCount
+
+func (c CategoryCounts) Header() []string {
+	return []string{"category_id", "name", "parent_id", "direct_posts", "subtree_posts"}
+}
+
+func (c CategoryCounts) Records() [][]string {
+	records := make([][]string, len(c))
+	for i, r := range c {
+		parent := ""
+		if r.ParentID != nil {
+			parent = r.ParentID.String()
+		}
+		records[i] = []string{r.CategoryID.String(), r.Name, parent, strconv.Itoa(r.Direct), strconv.Itoa(r.Subtree)}
+	}
+	return records
+}
+
+// AuthorCounts lists authors, most published first.
+type AuthorCounts []AuthorCount
+
+func (c AuthorCounts) Header() []string {
+	return []string{"author_id", "posts", "published", "words_published"}
+}
+
+func (c AuthorCounts) Records() [][]string {
+	records := make([][]string, len(c))
+	for i, r := range c {
+		records[i] = []string{r.AuthorID.String(), strconv.Itoa(r.Posts), strconv.Itoa(r.Published), strconv.Itoa(r.WordsPublished)}
+	}
+	return records
+}
+
+// WeeklyWordsSeries lists published output week by week.
+type WeeklyWordsSeries []WeeklyWords
+
+func (s WeeklyWordsSeries) Header() []string { return []string{"week_start", "posts", "words"} }
+
+func (s WeeklyWordsSeries) Records() [][]string {
+	records := make([][]string, len(s))
+	for i, r := range s {
+		records[i] = []string{r.WeekStart.Format(time.DateOnly), strconv.Itoa(r.Posts), strconv.Itoa(r.Words)}
+	}
+	return records
+}
+
+// WeeklySubscribersSeries lists subscriber movement week by week.
+type WeeklySubscribersSeries []WeeklySubscribers
+
+func (s WeeklySubscribersSeries) Header() []string {
+	return []string{"week_start", "subscribed", "unsubscribed", "net", "total"}
+}
+
+func (s WeeklySubscribersSeries) Records() [][]string {
+	records := make([][]string, len(s))
+	for i, r := range s {
+		records[i] = []string{
+			r.WeekStart.Format(time.DateOnly),
+			strconv.Itoa(r.Subscribed),
+			strconv.Itoa(r.Unsubscribed),
+			strconv.Itoa(r.Net),
+			strconv.Itoa(r.Total),
+		}
+	}
+	return records
+}
+
+// deliverabilityHeader names the columns of DeliverabilityStats; rates are
+// fractions, e.g. 0.25 for a quarter.
+var deliverabilityHeader = []string{
+	"sent", "delivered", "bounced", "complained", "opened", "clicked",
+	"delivery_rate", "bounce_rate", "complaint_rate", "open_rate", "click_rate",
+}
+
+func deliverabilityRecord(s DeliverabilityStats) []string {
+	rate := func(r float64) string { return strconv.FormatFloat(r, 'f', 4, 64) }
+	return []string{
+		strconv.Itoa(s.Sent),
+		strconv.Itoa(s.Delivered),
+		strconv.Itoa(s.Bounced),
+		strconv.Itoa(s.Complained),
+		strconv.Itoa(s.Opened),
+		strconv.Itoa(s.Clicked),
+		rate(s.DeliveryRate()),
+		rate(s.BounceRate()),
+		rate(s.ComplaintRate()),
+		rate(s.OpenRate()),
+		rate(s.ClickRate()),
+	}
+}
+
+// CampaignDeliverabilities lists campaigns by name.
+type CampaignDeliverabilities []CampaignDeliverability
+
+func (c CampaignDeliverabilities) Header() []string {
+	return append([]string{"campaign"}, deliverabilityHeader...)
+}
+
+func (c CampaignDeliverabilities) Records() [][]string {
+	records := make([][]string, len(c))
+	for i, r := range c {
+		records[i] = append([]string{r.Campaign}, deliverabilityRecord(r.DeliverabilityStats)...)
+	}
+	return records
+}
+
+// DomainDeliverabilities lists recipient domains, most emailed first.
+type DomainDeliverabilities []DomainDeliverability
+
+func (d DomainDeliverabilities) Header() []string {
+	return append([]string{"domain"}, deliverabilityHeader...)
+}
+
+func (d DomainDeliverabilities) Records() [][]string {
+	records := make([][]string, len(d))
+	for i, r := range d {
+		records[i] = append([]string{r.Domain}, deliverabilityRecord(r.DeliverabilityStats)...)
+	}
+	return records
+}
+
+// EngagementScores lists subscriber scores, least engaged first.
+type EngagementScores []EngagementScore
+
+func (e EngagementScores) Header() []string {
+	return []string{
+		"subscription_id", "email", "tier", "score", "recency", "frequency",
+		"received", "engaged", "last_engaged_at",
+	}
+}
+
+func (e EngagementScores) Records() [][]string {
+	records := make([][]string, len(e))
+	for i, r := range e {
+		lastEngagedAt := ""
+		if r.LastEngagedAt != nil {
+			lastEngagedAt = r.LastEngagedAt.Format(time.RFC3339)
+		}
+		records[i] = []string{
+			r.SubscriptionID.String(),
+			r.Email.String(),
+			r.Tier.String(),
+			strconv.Itoa(r.Score()),
+			strconv.Itoa(r.Recency),
+			strconv.Itoa(r.Frequency),
+			strconv.Itoa(r.Received),
+			strconv.Itoa(r.Engaged),
+			lastEngagedAt,
+		}
+	}
+	return records
+}
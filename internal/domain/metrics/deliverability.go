@@ -0,0 +1,249 @@
+package metrics
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/subscription"
+)
+
+// DeliveryEventLister lists mailer events for deliverability reports.
+// Typically implemented by the adapter storing webhook events of the email provider.
+type DeliveryEventLister interface {
+	// ListDeliveryEventsSince returns every event recorded at or after since,
+	// in any order.
+	ListDeliveryEventsSince(since time.Time) ([]subscription.DeliveryEvent, error)
+}
+
+// DeliverabilityStats counts emails by what became of them. Each email counts
+// once per outcome, however many events it has: opening a digest three times
+// is one open. Opens and clicks prove delivery, so they count as delivered
+// when the provider's delivery event is missing; clicks likewise count as
+// opens, since clients blocking images never report opens.
+type DeliverabilityStats struct {
+	Sent       int
+	Delivered  int
+	Bounced    int
+	Complained int
+	Opened     int
+	Clicked    int
+}
+
+// DeliveryRate is the share of sent emails that were delivered.
+func (s DeliverabilityStats) DeliveryRate() float64 { return ratio(s.Delivered, s.Sent) }
+
+// BounceRate is the share of sent emails that bounced.
+func (s DeliverabilityStats) BounceRate() float64 { return ratio(s.Bounced, s.Sent) }
+
+// ComplaintRate is the share of delivered emails marked as spam. Providers
+// start filtering above about 0.001.
+func (s DeliverabilityStats) ComplaintRate() float64 { return ratio(s.Complained, s.Delivered) }
+
+// OpenRate is the share of delivered emails opened.
+func (s DeliverabilityStats) OpenRate() float64 { return ratio(s.Opened, s.Delivered) }
+
+// ClickRate is the share of delivered emails with a clicked link.
+func (s DeliverabilityStats) ClickRate() float64 { return ratio(s.Clicked, s.Delivered) }
+
+// Compare returns the change from previous to these stats.
+func (s DeliverabilityStats) Compare(previous DeliverabilityStats) DeliverabilityChange {
+	return DeliverabilityChange{
+		Sent:          s.Sent - previous.Sent,
+		DeliveryRate:  s.DeliveryRate() - previous.DeliveryRate(),
+		BounceRate:    s.BounceRate() - previous.BounceRate(),
+		ComplaintRate: s.ComplaintRate() - previous.ComplaintRate(),
+		OpenRate:      s.OpenRate() - previous.OpenRate(),
+		ClickRate:     s.ClickRate() - previous.ClickRate(),
+	}
+}
+
+// DeliverabilityChange compares two periods: current minus previous, so
+// growth is positive. Rate changes are in the rates' unit, e.g. 0.02 for
+// two percentage points.
+type DeliverabilityChange struct {
+	Sent          int
+	DeliveryRate  float64
+	BounceRate    float64
+	ComplaintRate float64
+	OpenRate      float64
+	ClickRate     float64
+}
+
+// CampaignDeliverability is the deliverability of one campaign.
+type CampaignDeliverability struct {
+	Campaign string // UTM campaign, e.g. "weekly-digest-2024-w36"; empty for emails outside campaigns
+	DeliverabilityStats
+}
+
+// DomainDeliverability is the deliverability of one recipient domain.
+// A provider filtering the newsletter shows as one domain lagging the others.
+type DomainDeliverability struct {
+	Domain string // Lowercased, e.g. "orange.fr"; empty when the subscriber was deleted
+	DeliverabilityStats
+}
+
+// DeliverabilityReport aggregates deliverability over a period and compares it
+// with the period of the same length just before.
+type DeliverabilityReport struct {
+	From        time.Time
+	To          time.Time
+	GeneratedAt time.Time
+
+	Current  DeliverabilityStats
+	Previous DeliverabilityStats
+	Change   DeliverabilityChange
+
+	ByCampaign CampaignDeliverabilities
+	ByDomain   DomainDeliverabilities
+}
+
+// DeliverabilityService computes deliverability reports from mailer events.
+type DeliverabilityService struct {
+	events      DeliveryEventLister
+	subscribers subscription.SubscriptionLister
+	clock       kernel.Clock
+}
+
+// NewDeliverabilityService creates deliverability service with event and subscriber sources.
+// Subscribers give each email its recipient domain.
+func NewDeliverabilityService(
+	events DeliveryEventLister,
+	subscribers subscription.SubscriptionLister,
+	clock kernel.Clock,
+) *DeliverabilityService {
+	return &DeliverabilityService{events: events, subscribers: subscribers, clock: clock}
+}
+
+// Report aggregates the emails sent within [from, to). An email belongs to
+// the period of its sent event, so opens and bounces recorded after to still
+// count for it; events of emails sent earlier are left out.
+func (s *DeliverabilityService) Report(from, to time.Time) (DeliverabilityReport, error) {
+	const op = "DeliverabilityService.Report"
+
+	if !to.After(from) {
+		return DeliverabilityReport{}, &kernel.Error{Code: kernel.EInvalid, Message: MStatsPeriodInvalid, Operation: op}
+	}
+	previousFrom := from.Add(-to.Sub(from))
+
+	events, err := s.events.ListDeliveryEventsSince(previousFrom)
+	if err != nil {
+		return DeliverabilityReport{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	subscriptions, err := s.subscribers.GetAllSubscriptions()
+	if err != nil {
+		return DeliverabilityReport{}, &kernel.Error{Operation: op, Cause: err}
+	}
+	domains := make(map[kernel.ID[subscription.Subscription]]string, len(subscriptions))
+	for _, sub := range subscriptions {
+		domains[sub.SubscriptionID] = strings.ToLower(sub.Email.Domain())
+	}
+
+	report := DeliverabilityReport{From: from, To: to, GeneratedAt: s.clock.Now()}
+	campaigns := make(map[string]*CampaignDeliverability)
+	byDomain := make(map[string]*DomainDeliverability)
+	for _, m := range groupMessages(events) {
+		switch {
+		case !m.sentAt.Before(previousFrom) && m.sentAt.Before(from):
+			m.addTo(&report.Previous)
+		case !m.sentAt.Before(from) && m.sentAt.Before(to):
+			m.addTo(&report.Current)
+
+			c, ok := campaigns[m.campaign]
+			if !ok {
+				c = &CampaignDeliverability{Campaign: m.campaign}
+				campaigns[m.campaign] = c
+			}
+			m.addTo(&c.DeliverabilityStats)
+
+			domain := domains[m.subscriptionID]
+			d, ok := byDomain[domain]
+			if !ok {
+				d = &DomainDeliverability{Domain: domain}
+				byDomain[domain] = d
+			}
+			m.addTo(&d.DeliverabilityStats)
+		}
+	}
+	report.Change = report.Current.Compare(report.Previous)
+
+	for _, c := range campaigns {
+		report.ByCampaign = append(report.ByCampaign, *c)
+	}
+	slices.SortFunc(report.ByCampaign, func(a, b CampaignDeliverability) int {
+		return cmp.Compare(a.Campaign, b.Campaign)
+	})
+
+	for _, d := range byDomain {
+		report.ByDomain = append(report.ByDomain, *d)
+	}
+	slices.SortFunc(report.ByDomain, func(a, b DomainDeliverability) int {
+		return cmp.Or(cmp.Compare(b.Sent, a.Sent), cmp.Compare(a.Domain, b.Domain))
+	})
+
+	return report, nil
+}
+
+// message gathers the events of one email.
+type message struct {
+	subscriptionID kernel.ID[subscription.Subscription]
+	campaign       string
+	sentAt         time.Time // Zero when the sent event precedes the listed events
+	kinds          map[subscription.DeliveryEventKind]bool
+}
+
+func (m message) addTo(stats *DeliverabilityStats) {
+	clicked := m.kinds[subscription.DeliveryClicked]
+	opened := clicked || m.kinds[subscription.DeliveryOpened]
+
+	stats.Sent++
+	if opened || m.kinds[subscription.DeliveryDelivered] {
+		stats.Delivered++
+	}
+	if m.kinds[subscription.DeliveryBounced] {
+		stats.Bounced++
+	}
+	if m.kinds[subscription.DeliveryComplained] {
+		stats.Complained++
+	}
+	if opened {
+		stats.Opened++
+	}
+	if clicked {
+		stats.Clicked++
+	}
+}
+
+// groupMessages gathers events by message ID.
+func groupMessages(events []subscription.DeliveryEvent) map[string]*message {
+	messages := make(map[string]*message)
+	for _, e := range events {
+		m, ok := messages[e.MessageID]
+		if !ok {
+			m = &message{
+				subscriptionID: e.SubscriptionID,
+				campaign:       e.Campaign,
+				kinds:          make(map[subscription.DeliveryEventKind]bool),
+			}
+			messages[e.MessageID] = m
+		}
+		if e.Kind == subscription.DeliverySent {
+			m.sentAt = e.OccurredAt
+		}
+		if m.campaign == "" {
+			m.campaign = e.Campaign
+		}
+		m.kinds[e.Kind] = true
+	}
+	return messages
+}
+
+func ratio(part, whole int) float64 {
+	if whole == 0 {
+		return 0
+	}
+	return float64(part) / float64(whole)
+}
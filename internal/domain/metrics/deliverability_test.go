@@ -0,0 +1,102 @@
+package metrics_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/metrics"
+	"github.com/alnah/fla/internal/domain/subscription"
+)
+
+type stubEvents []subscription.DeliveryEvent
+
+func (s stubEvents) ListDeliveryEventsSince(since time.Time) ([]subscription.DeliveryEvent, error) {
+	var out []subscription.DeliveryEvent
+	for _, e := range s {
+		if !e.OccurredAt.Before(since) {
+			out = append(out, e)
+		}
+	}
+	return out, nil
+}
+
+func TestDeliverabilityService_Report(t *testing.T) {
+	from := time.Date(2024, 9, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, 7)
+	clock := &stubClock{t: to.Add(48 * time.Hour)}
+
+	subscribers := stubSubscriptions{
+		{SubscriptionID: "ana", Email: "ana@gmail.com"},
+		{SubscriptionID: "lea", Email: "lea@Orange.fr"},
+		{SubscriptionID: "tom", Email: "tom@orange.fr"},
+	}
+
+	var events stubEvents
+	send := func(id, to, campaign string, at time.Time, kinds ...subscription.DeliveryEventKind) {
+		events = append(events, subscription.DeliveryEvent{
+			SubscriptionID: kernel.ID[subscription.Subscription](to), Kind: subscription.DeliverySent,
+			MessageID: id, Campaign: campaign, OccurredAt: at,
+		})
+		for i, kind := range kinds {
+			events = append(events, subscription.DeliveryEvent{
+				SubscriptionID: kernel.ID[subscription.Subscription](to), Kind: kind,
+				MessageID: id, OccurredAt: at.Add(time.Duration(i+1) * time.Hour),
+			})
+		}
+	}
+	day := 24 * time.Hour
+	// This week
+	send("m1", "ana", "weekly-digest-2024-w36", from.Add(day), subscription.DeliveryDelivered, subscription.DeliveryOpened, subscription.DeliveryOpened)
+	send("m2", "lea", "weekly-digest-2024-w36", from.Add(day), subscription.DeliveryBounced)
+	send("m3", "tom", "weekly-digest-2024-w36", from.Add(day), subscription.DeliveryClicked) // Delivery event lost
+	send("m4", "tom", "", to.Add(-time.Hour), subscription.DeliveryDelivered, subscription.DeliveryComplained)
+	// The week before
+	send("m0", "ana", "weekly-digest-2024-w35", from.Add(-day), subscription.DeliveryDelivered)
+	// Before the compared weeks, opened this week
+	events = append(events, subscription.DeliveryEvent{SubscriptionID: "ana", Kind: subscription.DeliveryOpened, MessageID: "old", OccurredAt: from.Add(2 * day)})
+
+	service := metrics.NewDeliverabilityService(events, subscribers, clock)
+	report, err := service.Report(from, to)
+	assertNoError(t, err)
+
+	want := metrics.DeliverabilityStats{Sent: 4, Delivered: 3, Bounced: 1, Complained: 1, Opened: 2, Clicked: 1}
+	if report.Current != want {
+		t.Errorf("current: got %+v, want %+v", report.Current, want)
+	}
+	if report.Previous != (metrics.DeliverabilityStats{Sent: 1, Delivered: 1}) {
+		t.Errorf("previous: got %+v", report.Previous)
+	}
+	if report.Current.BounceRate() != 0.25 || report.Change.Sent != 3 || report.Change.DeliveryRate != -0.25 {
+		t.Errorf("change: got %+v", report.Change)
+	}
+
+	if len(report.ByCampaign) != 2 || report.ByCampaign[0].Campaign != "" || report.ByCampaign[1].Sent != 3 {
+		t.Errorf("campaigns: got %+v", report.ByCampaign)
+	}
+
+	if len(report.ByDomain) != 2 {
+		t.Fatalf("domains: got %+v", report.ByDomain)
+	}
+	orange := report.ByDomain[0]
+	if orange.Domain != "orange.fr" || orange.Sent != 3 || orange.Bounced != 1 || orange.Complained != 1 {
+		t.Errorf("orange.fr: got %+v", orange)
+	}
+	if gmail := report.ByDomain[1]; gmail.Domain != "gmail.com" || gmail.OpenRate() != 1 {
+		t.Errorf("gmail.com: got %+v", gmail)
+	}
+
+	var buf bytes.Buffer
+	assertNoError(t, metrics.WriteCSV(&buf, report.ByDomain))
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 3 || !strings.HasPrefix(lines[0], "domain,sent,") || lines[2] != "gmail.com,1,1,0,0,1,0,1.0000,0.0000,0.0000,1.0000,0.0000" {
+		t.Errorf("csv: got\n%s", buf.String())
+	}
+
+	t.Run("rejects empty periods", func(t *testing.T) {
+		_, err := service.Report(to, from)
+		assertErrorCode(t, err, kernel.EInvalid)
+	})
+}
@@ -0,0 +1,209 @@
+package metrics
+
+import (
+	"cmp"
+	"math"
+	"slices"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/shared"
+	"github.com/alnah/fla/internal/domain/subscription"
+)
+
+// Engagement thresholds. Subscribers who have not opened or clicked an email
+// for LapsingAfter are lapsing, and dormant after DormantAfter.
+const (
+	LapsingAfter     time.Duration = 30 * 24 * time.Hour
+	DormantAfter     time.Duration = 90 * 24 * time.Hour
+	EngagementWindow time.Duration = 180 * 24 * time.Hour // History scored; older events are ignored
+)
+
+// MaxEngagementScore is the score of a subscriber who opened every email of
+// the window, the last one this week.
+const MaxEngagementScore int = 10
+
+// EngagementTier classifies subscribers by how recently they engaged.
+type EngagementTier string
+
+const (
+	EngagementActive  EngagementTier = "active"
+	EngagementLapsing EngagementTier = "lapsing" // Candidates for a re-engagement campaign
+	EngagementDormant EngagementTier = "dormant" // Candidates for removal from the list
+)
+
+func (t EngagementTier) String() string { return string(t) }
+
+// EngagementScore rates one subscriber's engagement on recency and frequency.
+// Opens and clicks count as engagement; clicks prove an open, as in
+// deliverability reports.
+type EngagementScore struct {
+	SubscriptionID kernel.ID[subscription.Subscription]
+	Email          shared.Email
+	Tier           EngagementTier
+	Recency        int        // 0 to 5, from the time since the last open or click
+	Frequency      int        // 0 to 5, from the share of emails opened or clicked
+	Received       int        // Emails sent within the window
+	Engaged        int        // Emails within the window opened or clicked
+	LastEngagedAt  *time.Time // Nil without any open or click within the window
+}
+
+// Score sums recency and frequency, from 0 to MaxEngagementScore.
+func (s EngagementScore) Score() int { return s.Recency + s.Frequency }
+
+// EngagementReport scores every subscriber who can receive emails.
+type EngagementReport struct {
+	GeneratedAt time.Time
+	Scores      EngagementScores // Least engaged first
+}
+
+// Count returns how many subscribers are in a tier.
+func (r EngagementReport) Count(tier EngagementTier) int {
+	n := 0
+	for _, s := range r.Scores {
+		if s.Tier == tier {
+			n++
+		}
+	}
+	return n
+}
+
+// Segment lists the subscribers of a tier, least engaged first, e.g. the
+// lapsing ones as the audience of a re-engagement campaign, or the dormant
+// ones for list hygiene.
+func (r EngagementReport) Segment(tier EngagementTier) []kernel.ID[subscription.Subscription] {
+	var ids []kernel.ID[subscription.Subscription]
+	for _, s := range r.Scores {
+		if s.Tier == tier {
+			ids = append(ids, s.SubscriptionID)
+		}
+	}
+	return ids
+}
+
+// EngagementService scores subscribers from their delivery and click history.
+type EngagementService struct {
+	events      DeliveryEventLister
+	subscribers subscription.SubscriptionLister
+	clock       kernel.Clock
+}
+
+// NewEngagementService creates engagement service with event and subscriber sources.
+func NewEngagementService(
+	events DeliveryEventLister,
+	subscribers subscription.SubscriptionLister,
+	clock kernel.Clock,
+) *EngagementService {
+	return &EngagementService{events: events, subscribers: subscribers, clock: clock}
+}
+
+// Score rates every subscriber who can receive emails over the last
+// EngagementWindow. A subscription counts as engagement too, so new
+// subscribers are active until they had time to open an email.
+func (s *EngagementService) Score() (EngagementReport, error) {
+	const op = "EngagementService.Score"
+
+	now := s.clock.Now()
+	since := now.Add(-EngagementWindow)
+	events, err := s.events.ListDeliveryEventsSince(since)
+	if err != nil {
+		return EngagementReport{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	subscriptions, err := s.subscribers.GetAllSubscriptions()
+	if err != nil {
+		return EngagementReport{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	type history struct {
+		received, engaged int
+		lastEngagedAt     *time.Time
+	}
+	histories := make(map[kernel.ID[subscription.Subscription]]*history)
+	at := func(id kernel.ID[subscription.Subscription]) *history {
+		h, ok := histories[id]
+		if !ok {
+			h = &history{}
+			histories[id] = h
+		}
+		return h
+	}
+
+	for _, e := range events {
+		if e.Kind != subscription.DeliveryOpened && e.Kind != subscription.DeliveryClicked {
+			continue
+		}
+		h := at(e.SubscriptionID)
+		if h.lastEngagedAt == nil || e.OccurredAt.After(*h.lastEngagedAt) {
+			occurredAt := e.OccurredAt
+			h.lastEngagedAt = &occurredAt
+		}
+	}
+	for _, m := range groupMessages(events) {
+		if m.sentAt.IsZero() {
+			continue // Sent before the window
+		}
+		h := at(m.subscriptionID)
+		h.received++
+		if m.kinds[subscription.DeliveryOpened] || m.kinds[subscription.DeliveryClicked] {
+			h.engaged++
+		}
+	}
+
+	report := EngagementReport{GeneratedAt: now}
+	for _, sub := range subscriptions {
+		if !sub.CanReceiveEmails() {
+			continue
+		}
+
+		h := at(sub.SubscriptionID)
+		last := sub.SubscribedAt
+		if h.lastEngagedAt != nil && h.lastEngagedAt.After(last) {
+			last = *h.lastEngagedAt
+		}
+		idle := now.Sub(last)
+
+		report.Scores = append(report.Scores, EngagementScore{
+			SubscriptionID: sub.SubscriptionID,
+			Email:          sub.Email,
+			Tier:           engagementTier(idle),
+			Recency:        recencyScore(idle),
+			Frequency:      frequencyScore(h.engaged, h.received),
+			Received:       h.received,
+			Engaged:        h.engaged,
+			LastEngagedAt:  h.lastEngagedAt,
+		})
+	}
+	slices.SortFunc(report.Scores, func(a, b EngagementScore) int {
+		return cmp.Or(cmp.Compare(a.Score(), b.Score()), cmp.Compare(a.SubscriptionID, b.SubscriptionID))
+	})
+
+	return report, nil
+}
+
+func engagementTier(idle time.Duration) EngagementTier {
+	switch {
+	case idle < LapsingAfter:
+		return EngagementActive
+	case idle < DormantAfter:
+		return EngagementLapsing
+	default:
+		return EngagementDormant
+	}
+}
+
+// recencyScore gives 5 points within a week, then one less per threshold.
+func recencyScore(idle time.Duration) int {
+	day := 24 * time.Hour
+	for score, within := range []time.Duration{EngagementWindow, DormantAfter, 60 * day, LapsingAfter, 7 * day} {
+		if idle >= within {
+			return score
+		}
+	}
+	return 5
+}
+
+// frequencyScore scales the share of engaged emails to 0 to 5 points.
+func frequencyScore(engaged, received int) int {
+	return int(math.Round(5 * ratio(engaged, received)))
+}
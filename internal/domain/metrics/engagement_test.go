@@ -0,0 +1,110 @@
+package metrics_test
+
+import (
+	"bytes"
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/metrics"
+	"github.com/alnah/fla/internal/domain/subscription"
+)
+
+func TestEngagementService_Score(t *testing.T) {
+	now := time.Date(2024, 9, 30, 12, 0, 0, 0, time.UTC)
+	day := 24 * time.Hour
+	longAgo := time.Date(2024, 1, 8, 0, 0, 0, 0, time.UTC)
+
+	subscriber := func(id string, subscribedAt time.Time, status subscription.Status) subscription.Subscription {
+		return subscription.Subscription{
+			SubscriptionID: kernel.ID[subscription.Subscription](id),
+			Email:          "lea@example.com",
+			Status:         status,
+			IsActive:       status == subscription.StatusActive,
+			SubscribedAt:   subscribedAt,
+		}
+	}
+	subscribers := stubSubscriptions{
+		subscriber("ana", longAgo, subscription.StatusActive),
+		subscriber("lea", longAgo, subscription.StatusActive),
+		subscriber("tom", longAgo, subscription.StatusActive),
+		subscriber("noa", now.Add(-5*day), subscription.StatusActive),
+		subscriber("max", longAgo, subscription.StatusUnsubscribed),
+	}
+
+	var events stubEvents
+	n := 0
+	send := func(to string, at time.Time, kinds ...subscription.DeliveryEventKind) {
+		n++
+		id := fmt.Sprintf("m%d", n)
+		events = append(events, subscription.DeliveryEvent{
+			SubscriptionID: kernel.ID[subscription.Subscription](to), Kind: subscription.DeliverySent, MessageID: id, OccurredAt: at,
+		})
+		for _, kind := range kinds {
+			events = append(events, subscription.DeliveryEvent{
+				SubscriptionID: kernel.ID[subscription.Subscription](to), Kind: kind, MessageID: id, OccurredAt: at.Add(time.Hour),
+			})
+		}
+	}
+	for _, weeksAgo := range []int{12, 8, 4, 1} {
+		at := now.Add(-time.Duration(weeksAgo) * 7 * day)
+		send("tom", at, subscription.DeliveryDelivered)
+		send("max", at, subscription.DeliveryOpened)
+		if weeksAgo == 8 {
+			send("lea", at, subscription.DeliveryClicked)
+		} else {
+			send("lea", at, subscription.DeliveryDelivered)
+		}
+		if weeksAgo == 12 {
+			send("ana", at, subscription.DeliveryDelivered)
+		} else {
+			send("ana", at, subscription.DeliveryOpened, subscription.DeliveryOpened)
+		}
+	}
+	send("noa", now.Add(-2*day), subscription.DeliveryDelivered)
+
+	service := metrics.NewEngagementService(events, subscribers, &stubClock{t: now})
+	report, err := service.Score()
+	assertNoError(t, err)
+
+	var got []string
+	for _, s := range report.Scores {
+		got = append(got, fmt.Sprintf("%s:%s:%d+%d:%d/%d", s.SubscriptionID, s.Tier, s.Recency, s.Frequency, s.Engaged, s.Received))
+	}
+	want := []string{
+		"tom:dormant:0+0:0/4",
+		"lea:lapsing:3+1:1/4",
+		"noa:active:5+0:0/1",
+		"ana:active:5+4:3/4",
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("scores:\ngot  %v\nwant %v", got, want)
+	}
+
+	if lea := report.Scores[1]; lea.LastEngagedAt == nil || !lea.LastEngagedAt.Equal(now.Add(-56*day+time.Hour)) {
+		t.Errorf("lea last engaged at: got %v", lea.LastEngagedAt)
+	}
+	if report.Scores[0].LastEngagedAt != nil {
+		t.Errorf("tom never engaged: got %v", report.Scores[0].LastEngagedAt)
+	}
+
+	if got := report.Segment(metrics.EngagementLapsing); !slices.Equal(got, []kernel.ID[subscription.Subscription]{"lea"}) {
+		t.Errorf("lapsing: got %v", got)
+	}
+	if got := report.Segment(metrics.EngagementDormant); !slices.Equal(got, []kernel.ID[subscription.Subscription]{"tom"}) {
+		t.Errorf("dormant: got %v", got)
+	}
+	if report.Count(metrics.EngagementActive) != 2 {
+		t.Errorf("active: got %d", report.Count(metrics.EngagementActive))
+	}
+
+	var buf bytes.Buffer
+	assertNoError(t, metrics.WriteCSV(&buf, report.Scores))
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 5 || lines[1] != "tom,lea@example.com,dormant,0,0,0,4,0," {
+		t.Errorf("csv: got %q", lines)
+	}
+}
@@ -0,0 +1,86 @@
+package metrics
+
+import (
+	"time"
+
+	"github.com/alnah/fla/internal/domain/category"
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/post"
+)
+
+// SnapshotWriter persists daily snapshots into a time-series-friendly store.
+// Used by the snapshot job that runs once per day.
+type SnapshotWriter interface {
+	// Save stores the snapshot, replacing any existing snapshot for the same day.
+	// Used so re-running the job on the same day stays idempotent.
+	Save(snapshot DailySnapshot) error
+}
+
+// SnapshotReader retrieves historical snapshots for trend reporting.
+// Used by admin dashboards and month-over-month reports.
+type SnapshotReader interface {
+	// GetByDate returns the snapshot recorded for a given UTC day.
+	// Returns ENotFound when no snapshot exists for that day.
+	GetByDate(date time.Time) (*DailySnapshot, error)
+
+	// GetRange returns snapshots between two days inclusive, ordered by date.
+	// Used to build trend charts and period comparisons.
+	GetRange(from, to time.Time) ([]DailySnapshot, error)
+}
+
+// Repository combines snapshot persistence and retrieval.
+// Most concrete implementations (like PostgresSnapshotRepository) will implement this.
+type Repository interface {
+	SnapshotWriter
+	SnapshotReader
+}
+
+// Sources of live aggregates
+
+// PublishedPostCounter counts live content per learning level.
+// Typically implemented by the post repository adapter.
+type PublishedPostCounter interface {
+	// CountPublishedByLevel returns published post counts keyed by root category.
+	CountPublishedByLevel() (map[kernel.ID[category.Category]]int, error)
+}
+
+// ActiveSubscriberCounter counts subscribers currently receiving emails.
+// Typically implemented by the subscription repository adapter.
+type ActiveSubscriberCounter interface {
+	// CountActiveSubscribers returns the number of active subscriptions.
+	CountActiveSubscribers() (int, error)
+}
+
+// CompletionCounter counts lesson completions recorded by learners.
+// Optional source until a progress-tracking subsystem provides it.
+type CompletionCounter interface {
+	// CountCompletions returns the total number of completions to date.
+	CountCompletions() (int, error)
+}
+
+// Post views
+
+// ViewWriter stores view counts; built for high write volume.
+// Used by the view service, which batches increments instead of writing per request.
+type ViewWriter interface {
+	// IncrementViews adds each bucket's Views to the stored count for that post and day.
+	// Implementations should apply the whole batch in one round trip (e.g. an upsert).
+	IncrementViews(batch []DailyViews) error
+}
+
+// ViewReader aggregates stored view counts.
+// Used by post pages and popularity widgets.
+type ViewReader interface {
+	// SumViews returns all recorded views of a post; zero when it has none.
+	SumViews(postID kernel.ID[post.Post]) (int, error)
+
+	// TopPosts returns the most viewed posts between two UTC days inclusive, most viewed first.
+	TopPosts(from, to time.Time, limit int) ([]PostViews, error)
+}
+
+// ViewRepository combines view persistence and aggregation.
+// Most concrete implementations (like PostgresViewRepository) will implement this.
+type ViewRepository interface {
+	ViewWriter
+	ViewReader
+}
@@ -0,0 +1,165 @@
+package metrics
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/category"
+	"github.com/alnah/fla/internal/domain/kernel"
+)
+
+const MSnapshotPeriodEmpty string = "No snapshot recorded for %s."
+
+// SnapshotService records daily aggregates and builds trend reports from them.
+// Decouples reporting from raw event history by reading stored snapshots only.
+type SnapshotService struct {
+	repository  Repository
+	posts       PublishedPostCounter
+	subscribers ActiveSubscriberCounter
+	completions CompletionCounter // Optional: nil records zero completions
+	clock       kernel.Clock
+}
+
+// NewSnapshotService creates snapshot service with its aggregate sources.
+// The completion counter may be nil until progress tracking is available.
+func NewSnapshotService(
+	repository Repository,
+	posts PublishedPostCounter,
+	subscribers ActiveSubscriberCounter,
+	completions CompletionCounter,
+	clock kernel.Clock,
+) *SnapshotService {
+	return &SnapshotService{
+		repository:  repository,
+		posts:       posts,
+		subscribers: subscribers,
+		completions: completions,
+		clock:       clock,
+	}
+}
+
+// Record captures today's aggregates and persists them as a daily snapshot.
+// Safe to run several times a day: the repository replaces the day's entry.
+func (s *SnapshotService) Record() (DailySnapshot, error) {
+	const op = "SnapshotService.Record"
+
+	byLevel, err := s.posts.CountPublishedByLevel()
+	if err != nil {
+		return DailySnapshot{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	active, err := s.subscribers.CountActiveSubscribers()
+	if err != nil {
+		return DailySnapshot{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	completions := 0
+	if s.completions != nil {
+		if completions, err = s.completions.CountCompletions(); err != nil {
+			return DailySnapshot{}, &kernel.Error{Operation: op, Cause: err}
+		}
+	}
+
+	now := s.clock.Now()
+	snapshot, err := NewDailySnapshot(now, byLevel, active, completions, now)
+	if err != nil {
+		return DailySnapshot{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if err := s.repository.Save(snapshot); err != nil {
+		return DailySnapshot{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return snapshot, nil
+}
+
+// TrendReport compares the closing snapshots of two consecutive months.
+// Deltas are current minus previous, so growth is positive.
+type TrendReport struct {
+	Year  int
+	Month time.Month
+
+	Previous DailySnapshot // Last snapshot of the previous month
+	Current  DailySnapshot // Last snapshot of the requested month
+
+	PublishedPostsDelta    int
+	ActiveSubscribersDelta int
+	CompletionsDelta       int
+	LevelDeltas            map[kernel.ID[category.Category]]int
+}
+
+// MonthOverMonth builds a trend report for the given month against the month before.
+// Returns ENotFound when either month has no recorded snapshot.
+func (s *SnapshotService) MonthOverMonth(year int, month time.Month) (TrendReport, error) {
+	const op = "SnapshotService.MonthOverMonth"
+
+	currentStart := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+	previousStart := currentStart.AddDate(0, -1, 0)
+
+	current, err := s.closingSnapshot(currentStart)
+	if err != nil {
+		return TrendReport{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	previous, err := s.closingSnapshot(previousStart)
+	if err != nil {
+		return TrendReport{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return TrendReport{
+		Year:                   year,
+		Month:                  month,
+		Previous:               previous,
+		Current:                current,
+		PublishedPostsDelta:    current.PublishedPosts() - previous.PublishedPosts(),
+		ActiveSubscribersDelta: current.ActiveSubscribers - previous.ActiveSubscribers,
+		CompletionsDelta:       current.Completions - previous.Completions,
+		LevelDeltas:            levelDeltas(previous, current),
+	}, nil
+}
+
+// closingSnapshot returns the latest snapshot within the month starting at monthStart.
+func (s *SnapshotService) closingSnapshot(monthStart time.Time) (DailySnapshot, error) {
+	const op = "SnapshotService.closingSnapshot"
+
+	monthEnd := monthStart.AddDate(0, 1, -1)
+
+	snapshots, err := s.repository.GetRange(monthStart, monthEnd)
+	if err != nil {
+		return DailySnapshot{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if len(snapshots) == 0 {
+		return DailySnapshot{}, &kernel.Error{
+			Code:      kernel.ENotFound,
+			Message:   fmt.Sprintf(MSnapshotPeriodEmpty, monthStart.Format("2006-01")),
+			Operation: op,
+		}
+	}
+
+	latest := snapshots[0]
+	for _, snapshot := range snapshots[1:] {
+		if snapshot.Date.After(latest.Date) {
+			latest = snapshot
+		}
+	}
+
+	return latest, nil
+}
+
+// levelDeltas computes per-level differences including levels present in only one snapshot.
+func levelDeltas(previous, current DailySnapshot) map[kernel.ID[category.Category]]int {
+	deltas := make(map[kernel.ID[category.Category]]int)
+
+	for id, count := range current.PublishedPostsByLevel {
+		deltas[id] = count - previous.PublishedPostsByLevel[id]
+	}
+
+	for id, count := range previous.PublishedPostsByLevel {
+		if _, ok := current.PublishedPostsByLevel[id]; !ok {
+			deltas[id] = -count
+		}
+	}
+
+	return deltas
+}
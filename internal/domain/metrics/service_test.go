@@ -0,0 +1,191 @@
+package metrics_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/category"
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/metrics"
+)
+
+type mockRepository struct {
+	snapshots map[time.Time]metrics.DailySnapshot
+	saveErr   error
+}
+
+func newMockRepository() *mockRepository {
+	return &mockRepository{snapshots: make(map[time.Time]metrics.DailySnapshot)}
+}
+
+func (m *mockRepository) Save(s metrics.DailySnapshot) error {
+	if m.saveErr != nil {
+		return m.saveErr
+	}
+	m.snapshots[s.Date] = s
+	return nil
+}
+
+func (m *mockRepository) GetByDate(date time.Time) (*metrics.DailySnapshot, error) {
+	if s, ok := m.snapshots[metrics.TruncateToDay(date)]; ok {
+		return &s, nil
+	}
+	return nil, &kernel.Error{Code: kernel.ENotFound, Message: "snapshot not found"}
+}
+
+func (m *mockRepository) GetRange(from, to time.Time) ([]metrics.DailySnapshot, error) {
+	var result []metrics.DailySnapshot
+	for date, s := range m.snapshots {
+		if !date.Before(from) && !date.After(to) {
+			result = append(result, s)
+		}
+	}
+	return result, nil
+}
+
+type stubCounters struct {
+	byLevel     map[kernel.ID[category.Category]]int
+	active      int
+	completions int
+	err         error
+}
+
+func (s stubCounters) CountPublishedByLevel() (map[kernel.ID[category.Category]]int, error) {
+	return s.byLevel, s.err
+}
+
+func (s stubCounters) CountActiveSubscribers() (int, error) { return s.active, s.err }
+
+func (s stubCounters) CountCompletions() (int, error) { return s.completions, s.err }
+
+func TestSnapshotService_Record(t *testing.T) {
+	now := time.Date(2024, 5, 10, 6, 0, 0, 0, time.UTC)
+	a1 := kernel.ID[category.Category]("a1")
+
+	t.Run("records aggregates for today", func(t *testing.T) {
+		repo := newMockRepository()
+		counters := stubCounters{byLevel: map[kernel.ID[category.Category]]int{a1: 4}, active: 120, completions: 7}
+		service := metrics.NewSnapshotService(repo, counters, counters, counters, &stubClock{t: now})
+
+		got, err := service.Record()
+
+		assertNoError(t, err)
+		if got.ActiveSubscribers != 120 || got.Completions != 7 || got.PublishedPostsByLevel[a1] != 4 {
+			t.Errorf("unexpected snapshot: %v", got)
+		}
+		if _, ok := repo.snapshots[metrics.TruncateToDay(now)]; !ok {
+			t.Error("expected snapshot to be saved")
+		}
+	})
+
+	t.Run("records zero completions without counter", func(t *testing.T) {
+		repo := newMockRepository()
+		counters := stubCounters{active: 1}
+		service := metrics.NewSnapshotService(repo, counters, counters, nil, &stubClock{t: now})
+
+		got, err := service.Record()
+
+		assertNoError(t, err)
+		if got.Completions != 0 {
+			t.Errorf("Completions: got %d, want 0", got.Completions)
+		}
+	})
+
+	t.Run("replaces snapshot on same day", func(t *testing.T) {
+		repo := newMockRepository()
+		clock := &stubClock{t: now}
+		service := metrics.NewSnapshotService(repo, stubCounters{active: 1}, stubCounters{active: 1}, nil, clock)
+		_, _ = service.Record()
+
+		clock.t = now.Add(5 * time.Hour)
+		service = metrics.NewSnapshotService(repo, stubCounters{active: 2}, stubCounters{active: 2}, nil, clock)
+		_, err := service.Record()
+
+		assertNoError(t, err)
+		if len(repo.snapshots) != 1 {
+			t.Fatalf("expected one snapshot, got %d", len(repo.snapshots))
+		}
+		if repo.snapshots[metrics.TruncateToDay(now)].ActiveSubscribers != 2 {
+			t.Error("expected latest run to replace the day's snapshot")
+		}
+	})
+
+	t.Run("propagates source errors", func(t *testing.T) {
+		counters := stubCounters{err: &kernel.Error{Code: kernel.EInternal, Message: "database error"}}
+		service := metrics.NewSnapshotService(newMockRepository(), counters, counters, nil, &stubClock{t: now})
+
+		_, err := service.Record()
+
+		assertError(t, err)
+		assertErrorCode(t, err, kernel.EInternal)
+	})
+
+	t.Run("propagates repository errors", func(t *testing.T) {
+		repo := newMockRepository()
+		repo.saveErr = &kernel.Error{Code: kernel.EInternal, Message: "database error"}
+		service := metrics.NewSnapshotService(repo, stubCounters{}, stubCounters{}, nil, &stubClock{t: now})
+
+		_, err := service.Record()
+
+		assertError(t, err)
+		assertErrorCode(t, err, kernel.EInternal)
+	})
+}
+
+func TestSnapshotService_MonthOverMonth(t *testing.T) {
+	a1 := kernel.ID[category.Category]("a1")
+	a2 := kernel.ID[category.Category]("a2")
+
+	seed := func(t *testing.T, repo *mockRepository, date time.Time, levels map[kernel.ID[category.Category]]int, active int) {
+		t.Helper()
+		s, err := metrics.NewDailySnapshot(date, levels, active, 0, date)
+		assertNoError(t, err)
+		repo.snapshots[s.Date] = s
+	}
+
+	t.Run("compares closing snapshots", func(t *testing.T) {
+		repo := newMockRepository()
+		seed(t, repo, time.Date(2024, 4, 15, 0, 0, 0, 0, time.UTC), map[kernel.ID[category.Category]]int{a1: 1}, 50)
+		seed(t, repo, time.Date(2024, 4, 30, 0, 0, 0, 0, time.UTC), map[kernel.ID[category.Category]]int{a1: 2, a2: 3}, 80)
+		seed(t, repo, time.Date(2024, 5, 31, 0, 0, 0, 0, time.UTC), map[kernel.ID[category.Category]]int{a1: 6}, 100)
+		service := metrics.NewSnapshotService(repo, nil, nil, nil, &stubClock{})
+
+		got, err := service.MonthOverMonth(2024, time.May)
+
+		assertNoError(t, err)
+		if got.ActiveSubscribersDelta != 20 {
+			t.Errorf("ActiveSubscribersDelta: got %d, want 20", got.ActiveSubscribersDelta)
+		}
+		if got.PublishedPostsDelta != 1 {
+			t.Errorf("PublishedPostsDelta: got %d, want 1", got.PublishedPostsDelta)
+		}
+		if got.LevelDeltas[a1] != 4 || got.LevelDeltas[a2] != -3 {
+			t.Errorf("LevelDeltas: got %v", got.LevelDeltas)
+		}
+	})
+
+	t.Run("handles year boundary", func(t *testing.T) {
+		repo := newMockRepository()
+		seed(t, repo, time.Date(2023, 12, 31, 0, 0, 0, 0, time.UTC), nil, 10)
+		seed(t, repo, time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC), nil, 15)
+		service := metrics.NewSnapshotService(repo, nil, nil, nil, &stubClock{})
+
+		got, err := service.MonthOverMonth(2024, time.January)
+
+		assertNoError(t, err)
+		if got.ActiveSubscribersDelta != 5 {
+			t.Errorf("ActiveSubscribersDelta: got %d, want 5", got.ActiveSubscribersDelta)
+		}
+	})
+
+	t.Run("returns not found for missing month", func(t *testing.T) {
+		repo := newMockRepository()
+		seed(t, repo, time.Date(2024, 5, 31, 0, 0, 0, 0, time.UTC), nil, 10)
+		service := metrics.NewSnapshotService(repo, nil, nil, nil, &stubClock{})
+
+		_, err := service.MonthOverMonth(2024, time.May)
+
+		assertError(t, err)
+		assertErrorCode(t, err, kernel.ENotFound)
+	})
+}
@@ -0,0 +1,118 @@
+package metrics
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/category"
+	"github.com/alnah/fla/internal/domain/kernel"
+)
+
+const (
+	MSnapshotDateMissing  string = "Missing snapshot date."
+	MSnapshotNegativeStat string = "Snapshot %s cannot be negative."
+)
+
+// DailySnapshot records the aggregated platform state for a single UTC day.
+// Enables trend reports without recomputing history from raw events.
+type DailySnapshot struct {
+	// Identity
+	Date time.Time // Truncated to midnight UTC, one snapshot per day
+
+	// Aggregates
+	PublishedPostsByLevel map[kernel.ID[category.Category]]int // Keyed by root (level) category
+	ActiveSubscribers     int
+	Completions           int
+
+	// Meta
+	RecordedAt time.Time
+}
+
+// NewDailySnapshot creates a validated snapshot normalized to its UTC day.
+// Copies the level map so later mutations by the caller cannot alter history.
+func NewDailySnapshot(
+	date time.Time,
+	publishedByLevel map[kernel.ID[category.Category]]int,
+	activeSubscribers, completions int,
+	recordedAt time.Time,
+) (DailySnapshot, error) {
+	const op = "NewDailySnapshot"
+
+	levels := make(map[kernel.ID[category.Category]]int, len(publishedByLevel))
+	for id, count := range publishedByLevel {
+		levels[id] = count
+	}
+
+	s := DailySnapshot{
+		Date:                  TruncateToDay(date),
+		PublishedPostsByLevel: levels,
+		ActiveSubscribers:     activeSubscribers,
+		Completions:           completions,
+		RecordedAt:            recordedAt,
+	}
+
+	if err := s.Validate(); err != nil {
+		return DailySnapshot{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return s, nil
+}
+
+// Validate ensures snapshot values are usable for trend computation.
+// Negative counts would indicate a broken aggregation source.
+func (s DailySnapshot) Validate() error {
+	const op = "DailySnapshot.Validate"
+
+	if s.Date.IsZero() {
+		return &kernel.Error{Code: kernel.EInvalid, Message: MSnapshotDateMissing, Operation: op}
+	}
+
+	for id, count := range s.PublishedPostsByLevel {
+		if err := id.Validate(); err != nil {
+			return &kernel.Error{Operation: op, Cause: err}
+		}
+		if count < 0 {
+			return negativeStatError("published post count", op)
+		}
+	}
+
+	if s.ActiveSubscribers < 0 {
+		return negativeStatError("active subscriber count", op)
+	}
+
+	if s.Completions < 0 {
+		return negativeStatError("completion count", op)
+	}
+
+	return nil
+}
+
+// PublishedPosts returns the total number of published posts across all levels.
+func (s DailySnapshot) PublishedPosts() int {
+	total := 0
+	for _, count := range s.PublishedPostsByLevel {
+		total += count
+	}
+	return total
+}
+
+// String returns a string representation of the snapshot.
+func (s DailySnapshot) String() string {
+	return fmt.Sprintf("DailySnapshot{Date: %s, PublishedPosts: %d, ActiveSubscribers: %d, Completions: %d}",
+		s.Date.Format(time.DateOnly), s.PublishedPosts(), s.ActiveSubscribers, s.Completions)
+}
+
+// TruncateToDay normalizes a timestamp to midnight UTC of the same day.
+// Snapshots are keyed by day so repeated runs overwrite rather than duplicate.
+func TruncateToDay(t time.Time) time.Time {
+	u := t.UTC()
+	return time.Date(u.Year(), u.Month(), u.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+func negativeStatError(field, op string) error {
+	return &kernel.Error{
+		Code:      kernel.EInvalid,
+		Message:   fmt.Sprintf(MSnapshotNegativeStat, field),
+		Operation: op,
+	}
+}
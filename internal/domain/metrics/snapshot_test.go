@@ -0,0 +1,78 @@
+package metrics_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/category"
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/metrics"
+)
+
+func TestNewDailySnapshot(t *testing.T) {
+	a1 := kernel.ID[category.Category]("a1")
+	a2 := kernel.ID[category.Category]("a2")
+	recordedAt := time.Date(2024, 5, 10, 23, 45, 0, 0, time.UTC)
+
+	t.Run("truncates date to UTC day", func(t *testing.T) {
+		paris := time.FixedZone("CEST", 2*60*60)
+		date := time.Date(2024, 5, 11, 1, 30, 0, 0, paris)
+
+		got, err := metrics.NewDailySnapshot(date, nil, 10, 2, recordedAt)
+
+		assertNoError(t, err)
+		want := time.Date(2024, 5, 10, 0, 0, 0, 0, time.UTC)
+		if !got.Date.Equal(want) {
+			t.Errorf("Date: got %v, want %v", got.Date, want)
+		}
+	})
+
+	t.Run("copies level counts", func(t *testing.T) {
+		levels := map[kernel.ID[category.Category]]int{a1: 3, a2: 5}
+
+		got, err := metrics.NewDailySnapshot(recordedAt, levels, 0, 0, recordedAt)
+		levels[a1] = 100
+
+		assertNoError(t, err)
+		if got.PublishedPostsByLevel[a1] != 3 {
+			t.Errorf("expected snapshot to be isolated from caller map, got %d", got.PublishedPostsByLevel[a1])
+		}
+		if got.PublishedPosts() != 8 {
+			t.Errorf("PublishedPosts: got %d, want 8", got.PublishedPosts())
+		}
+	})
+
+	t.Run("rejects invalid values", func(t *testing.T) {
+		tests := []struct {
+			name        string
+			date        time.Time
+			levels      map[kernel.ID[category.Category]]int
+			subscribers int
+			completions int
+		}{
+			{name: "zero date", date: time.Time{}},
+			{name: "negative level count", date: recordedAt, levels: map[kernel.ID[category.Category]]int{a1: -1}},
+			{name: "empty level ID", date: recordedAt, levels: map[kernel.ID[category.Category]]int{"": 1}},
+			{name: "negative subscribers", date: recordedAt, subscribers: -1},
+			{name: "negative completions", date: recordedAt, completions: -1},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				_, err := metrics.NewDailySnapshot(tt.date, tt.levels, tt.subscribers, tt.completions, recordedAt)
+
+				assertError(t, err)
+				assertErrorCode(t, err, kernel.EInvalid)
+			})
+		}
+	})
+}
+
+func TestTruncateToDay(t *testing.T) {
+	got := metrics.TruncateToDay(time.Date(2024, 2, 29, 18, 5, 3, 9, time.UTC))
+	want := time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)
+
+	if !got.Equal(want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,341 @@
+package metrics
+
+import (
+	"cmp"
+	"slices"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/category"
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/post"
+	"github.com/alnah/fla/internal/domain/subscription"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+const MStatsPeriodInvalid string = "Statistics period must end after it starts."
+
+// PostFact is the slice of a post that editorial statistics need.
+// Avoids loading full content when aggregating the whole catalog.
+type PostFact struct {
+	PostID      kernel.ID[post.Post]
+	Owner       kernel.ID[user.User]
+	CategoryID  kernel.ID[category.Category]
+	Status      post.Status
+	WordCount   int
+	CreatedAt   time.Time
+	PublishedAt *time.Time // Nil until first publication
+}
+
+// PostFactLister lists post facts for dashboard aggregates.
+// Typically implemented by the post repository adapter with a projection query.
+type PostFactLister interface {
+	// ListPostFacts returns one fact per post, whatever its status.
+	ListPostFacts() ([]PostFact, error)
+}
+
+// StatusCount is the number of posts in one workflow status.
+type StatusCount struct {
+	Status post.Status
+	Posts  int
+}
+
+// CategoryCount is the number of posts filed in a category and below it.
+type CategoryCount struct {
+	CategoryID kernel.ID[category.Category]
+	Name       string
+	ParentID   *kernel.ID[category.Category]
+	Direct     int // Posts filed in this category
+	Subtree    int // Posts filed in this category or any descendant
+}
+
+// AuthorCount summarizes one author's output.
+type AuthorCount struct {
+	AuthorID       kernel.ID[user.User]
+	Posts          int // All statuses
+	Published      int
+	WordsPublished int
+}
+
+// WeeklyWords is the published output of one week.
+type WeeklyWords struct {
+	WeekStart time.Time // Monday 00:00 UTC
+	Posts     int
+	Words     int
+}
+
+// WeeklySubscribers is the subscriber movement of one week.
+// Bounces and complaints are not counted as departures; they have no dated event.
+type WeeklySubscribers struct {
+	WeekStart    time.Time // Monday 00:00 UTC
+	Subscribed   int
+	Unsubscribed int
+	Net          int
+	Total        int // Subscribed and not unsubscribed at the end of the week
+}
+
+// EditorialReport gathers the admin dashboard aggregates.
+// Counts cover the whole catalog; weekly series cover the requested period.
+type EditorialReport struct {
+	From        time.Time
+	To          time.Time
+	GeneratedAt time.Time
+
+	ByStatus   StatusCounts
+	ByCategory CategoryCounts
+	ByAuthor   AuthorCounts
+
+	// AverageTimeToPublish is the mean delay from creation to first publication
+	// for posts first published within the period; zero when none were.
+	AverageTimeToPublish time.Duration
+
+	WordsPerWeek     WeeklyWordsSeries
+	SubscriberGrowth WeeklySubscribersSeries
+}
+
+// StatsService computes editorial statistics from live repositories.
+type StatsService struct {
+	posts       PostFactLister
+	categories  category.CategoryReader
+	subscribers subscription.SubscriptionLister
+	clock       kernel.Clock
+}
+
+// NewStatsService creates stats service with post, category, and subscriber sources.
+func NewStatsService(
+	posts PostFactLister,
+	categories category.CategoryReader,
+	subscribers subscription.SubscriptionLister,
+	clock kernel.Clock,
+) *StatsService {
+	return &StatsService{
+		posts:       posts,
+		categories:  categories,
+		subscribers: subscribers,
+		clock:       clock,
+	}
+}
+
+// Editorial builds the dashboard report; weekly series span the weeks touching [from, to).
+func (s *StatsService) Editorial(from, to time.Time) (EditorialReport, error) {
+	const op = "StatsService.Editorial"
+
+	if !to.After(from) {
+		return EditorialReport{}, &kernel.Error{Code: kernel.EInvalid, Message: MStatsPeriodInvalid, Operation: op}
+	}
+
+	facts, err := s.posts.ListPostFacts()
+	if err != nil {
+		return EditorialReport{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	categories, err := s.categories.GetAll()
+	if err != nil {
+		return EditorialReport{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	subscriptions, err := s.subscribers.GetAllSubscriptions()
+	if err != nil {
+		return EditorialReport{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	weeks := weekStarts(from, to)
+
+	return EditorialReport{
+		From:                 from,
+		To:                   to,
+		GeneratedAt:          s.clock.Now(),
+		ByStatus:             countByStatus(facts),
+		ByCategory:           countByCategory(facts, categories),
+		ByAuthor:             countByAuthor(facts),
+		AverageTimeToPublish: averageTimeToPublish(facts, from, to),
+		WordsPerWeek:         wordsPerWeek(facts, weeks),
+		SubscriberGrowth:     subscriberGrowth(subscriptions, weeks),
+	}, nil
+}
+
+// WeekStart returns Monday 00:00 UTC of the week containing t.
+func WeekStart(t time.Time) time.Time {
+	day := TruncateToDay(t)
+	offset := (int(day.Weekday()) + 6) % 7 // Monday = 0
+	return day.AddDate(0, 0, -offset)
+}
+
+func weekStarts(from, to time.Time) []time.Time {
+	var weeks []time.Time
+	for week := WeekStart(from); week.Before(to); week = week.AddDate(0, 0, 7) {
+		weeks = append(weeks, week)
+	}
+	return weeks
+}
+
+func countByStatus(facts []PostFact) StatusCounts {
+	statuses := []post.Status{post.StatusDraft, post.StatusScheduled, post.StatusPublished, post.StatusArchived}
+	counts := make(StatusCounts, len(statuses))
+	for i, status := range statuses {
+		counts[i].Status = status
+		for _, f := range facts {
+			if f.Status == status {
+				counts[i].Posts++
+			}
+		}
+	}
+	return counts
+}
+
+// countByCategory adds each post to its category and every ancestor.
+// Categories are listed in tree order, parents before children.
+func countByCategory(facts []PostFact, categories []category.Category) CategoryCounts {
+	parents := make(map[kernel.ID[category.Category]]*kernel.ID[category.Category], len(categories))
+	for _, c := range categories {
+		parents[c.CategoryID] = c.ParentID
+	}
+
+	direct := make(map[kernel.ID[category.Category]]int)
+	subtree := make(map[kernel.ID[category.Category]]int)
+	for _, f := range facts {
+		direct[f.CategoryID]++
+		// Depth is bounded by MaxCategoryDepth; the guard protects against corrupt cycles.
+		for id, hops := &f.CategoryID, 0; id != nil && hops <= category.MaxCategoryDepth; id, hops = parents[*id], hops+1 {
+			subtree[*id]++
+		}
+	}
+
+	sorted := slices.Clone(categories)
+	category.SortCategories(sorted)
+
+	counts := make(CategoryCounts, 0, len(sorted))
+	for _, c := range sorted {
+		counts = append(counts, CategoryCount{
+			CategoryID: c.CategoryID,
+			Name:       c.Name.String(),
+			ParentID:   c.ParentID,
+			Direct:     direct[c.CategoryID],
+			Subtree:    subtree[c.CategoryID],
+		})
+	}
+
+	return treeOrderCounts(counts)
+}
+
+// treeOrderCounts lists each root followed depth-first by its descendants, keeping sibling order.
+func treeOrderCounts(counts CategoryCounts) CategoryCounts {
+	children := make(map[kernel.ID[category.Category]][]CategoryCount)
+	var roots []CategoryCount
+	for _, c := range counts {
+		if c.ParentID == nil {
+			roots = append(roots, c)
+		} else {
+			children[*c.ParentID] = append(children[*c.ParentID], c)
+		}
+	}
+
+	ordered := make(CategoryCounts, 0, len(counts))
+	var walk func(nodes []CategoryCount)
+	walk = func(nodes []CategoryCount) {
+		for _, n := range nodes {
+			ordered = append(ordered, n)
+			walk(children[n.CategoryID])
+		}
+	}
+	walk(roots)
+
+	return ordered
+}
+
+func countByAuthor(facts []PostFact) AuthorCounts {
+	byAuthor := make(map[kernel.ID[user.User]]*AuthorCount)
+	for _, f := range facts {
+		a, ok := byAuthor[f.Owner]
+		if !ok {
+			a = &AuthorCount{AuthorID: f.Owner}
+			byAuthor[f.Owner] = a
+		}
+		a.Posts++
+		if f.Status == post.StatusPublished {
+			a.Published++
+			a.WordsPublished += f.WordCount
+		}
+	}
+
+	counts := make(AuthorCounts, 0, len(byAuthor))
+	for _, a := range byAuthor {
+		counts = append(counts, *a)
+	}
+
+	// Most productive first; ties broken by ID for stable exports.
+	slices.SortFunc(counts, func(a, b AuthorCount) int {
+		if c := cmp.Compare(b.Published, a.Published); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.AuthorID, b.AuthorID)
+	})
+
+	return counts
+}
+
+func averageTimeToPublish(facts []PostFact, from, to time.Time) time.Duration {
+	var total time.Duration
+	n := 0
+	for _, f := range facts {
+		if f.PublishedAt == nil || f.PublishedAt.Before(from) || !f.PublishedAt.Before(to) {
+			continue
+		}
+		total += f.PublishedAt.Sub(f.CreatedAt)
+		n++
+	}
+	if n == 0 {
+		return 0
+	}
+	return total / time.Duration(n)
+}
+
+// wordsPerWeek counts posts currently published, by week of first publication.
+func wordsPerWeek(facts []PostFact, weeks []time.Time) WeeklyWordsSeries {
+	series := make(WeeklyWordsSeries, len(weeks))
+	index := make(map[time.Time]int, len(weeks))
+	for i, week := range weeks {
+		series[i].WeekStart = week
+		index[week] = i
+	}
+
+	for _, f := range facts {
+		if f.Status != post.StatusPublished || f.PublishedAt == nil {
+			continue
+		}
+		if i, ok := index[WeekStart(*f.PublishedAt)]; ok {
+			series[i].Posts++
+			series[i].Words += f.WordCount
+		}
+	}
+
+	return series
+}
+
+func subscriberGrowth(subscriptions []subscription.Subscription, weeks []time.Time) WeeklySubscribersSeries {
+	series := make(WeeklySubscribersSeries, len(weeks))
+	for i, week := range weeks {
+		end := week.AddDate(0, 0, 7)
+		g := WeeklySubscribers{WeekStart: week}
+
+		for _, sub := range subscriptions {
+			if within(sub.SubscribedAt, week, end) {
+				g.Subscribed++
+			}
+			if sub.UnsubscribedAt != nil && within(*sub.UnsubscribedAt, week, end) {
+				g.Unsubscribed++
+			}
+			if sub.SubscribedAt.Before(end) && (sub.UnsubscribedAt == nil || !sub.UnsubscribedAt.Before(end)) {
+				g.Total++
+			}
+		}
+
+		g.Net = g.Subscribed - g.Unsubscribed
+		series[i] = g
+	}
+
+	return series
+}
+
+func within(t, start, end time.Time) bool {
+	return !t.Before(start) && t.Before(end)
+}
@@ -0,0 +1,181 @@
+package metrics_test
+
+import (
+	"bytes"
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/category"
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/metrics"
+	"github.com/alnah/fla/internal/domain/post"
+	"github.com/alnah/fla/internal/domain/subscription"
+)
+
+type stubFacts struct {
+	facts []metrics.PostFact
+	err   error
+}
+
+func (s stubFacts) ListPostFacts() ([]metrics.PostFact, error) { return s.facts, s.err }
+
+type stubCategories []category.Category
+
+func (s stubCategories) GetByID(id kernel.ID[category.Category]) (*category.Category, error) {
+	return nil, &kernel.Error{Code: kernel.ENotFound}
+}
+
+func (s stubCategories) GetAll() ([]category.Category, error) { return s, nil }
+
+type stubSubscriptions []subscription.Subscription
+
+func (s stubSubscriptions) GetActiveSubscriptions() ([]subscription.Subscription, error) {
+	return s, nil
+}
+
+func (s stubSubscriptions) GetAllSubscriptions() ([]subscription.Subscription, error) { return s, nil }
+
+func TestStatsService_Editorial(t *testing.T) {
+	// Monday 2024-03-04 through Sunday 2024-03-17: two full weeks.
+	from := time.Date(2024, 3, 4, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 3, 18, 0, 0, 0, 0, time.UTC)
+	clock := &stubClock{t: to}
+	at := func(day, hour int) *time.Time {
+		t := time.Date(2024, 3, day, hour, 0, 0, 0, time.UTC)
+		return &t
+	}
+
+	a1 := kernel.ID[category.Category]("a1")
+	reading := kernel.ID[category.Category]("reading")
+	categories := stubCategories{
+		{CategoryID: "b1", Name: "B1", SortOrder: 1},
+		{CategoryID: "sports", Name: "Sports", ParentID: &reading},
+		{CategoryID: reading, Name: "Reading", ParentID: &a1},
+		{CategoryID: a1, Name: "A1"},
+	}
+
+	facts := stubFacts{facts: []metrics.PostFact{
+		{PostID: "p1", Owner: "marie", CategoryID: "sports", Status: post.StatusPublished, WordCount: 300, CreatedAt: *at(1, 9), PublishedAt: at(5, 9)},
+		{PostID: "p2", Owner: "marie", CategoryID: "reading", Status: post.StatusPublished, WordCount: 500, CreatedAt: *at(10, 9), PublishedAt: at(12, 9)},
+		{PostID: "p3", Owner: "paul", CategoryID: "b1", Status: post.StatusPublished, WordCount: 200, CreatedAt: *at(11, 9), PublishedAt: at(12, 21)},
+		{PostID: "p4", Owner: "paul", CategoryID: "sports", Status: post.StatusDraft, WordCount: 100, CreatedAt: *at(13, 9)},
+		{PostID: "p5", Owner: "marie", CategoryID: "a1", Status: post.StatusArchived, WordCount: 900, CreatedAt: *at(1, 9), PublishedAt: at(6, 9)},
+	}}
+
+	subscriptions := stubSubscriptions{
+		{SubscribedAt: *at(1, 9)},
+		{SubscribedAt: *at(5, 9)},
+		{SubscribedAt: *at(6, 9), UnsubscribedAt: at(12, 9)},
+		{SubscribedAt: *at(14, 9)},
+	}
+
+	service := metrics.NewStatsService(facts, categories, subscriptions, clock)
+
+	report, err := service.Editorial(from, to)
+	assertNoError(t, err)
+
+	t.Run("counts posts per status in workflow order", func(t *testing.T) {
+		want := "draft:1 scheduled:0 published:3 archived:1"
+		var got []string
+		for _, c := range report.ByStatus {
+			got = append(got, c.Status.String()+":"+strconv.Itoa(c.Posts))
+		}
+		if strings.Join(got, " ") != want {
+			t.Errorf("got %v, want %s", got, want)
+		}
+	})
+
+	t.Run("counts category subtrees in tree order", func(t *testing.T) {
+		want := []struct {
+			id              string
+			direct, subtree int
+		}{
+			{"a1", 1, 4},
+			{"reading", 1, 3},
+			{"sports", 2, 2},
+			{"b1", 1, 1},
+		}
+		if len(report.ByCategory) != len(want) {
+			t.Fatalf("got %d categories", len(report.ByCategory))
+		}
+		for i, w := range want {
+			got := report.ByCategory[i]
+			if got.CategoryID.String() != w.id || got.Direct != w.direct || got.Subtree != w.subtree {
+				t.Errorf("row %d: got %+v, want %+v", i, got, w)
+			}
+		}
+	})
+
+	t.Run("ranks authors by published posts", func(t *testing.T) {
+		marie := report.ByAuthor[0]
+		if marie.AuthorID != "marie" || marie.Posts != 3 || marie.Published != 2 || marie.WordsPublished != 800 {
+			t.Errorf("got %+v", marie)
+		}
+	})
+
+	t.Run("averages draft to publish delay within period", func(t *testing.T) {
+		// p1: 4 days, p2: 2 days, p3: 1.5 days, p5: 5 days
+		want := (96*time.Hour + 48*time.Hour + 36*time.Hour + 120*time.Hour) / 4
+		if report.AverageTimeToPublish != want {
+			t.Errorf("got %v, want %v", report.AverageTimeToPublish, want)
+		}
+	})
+
+	t.Run("sums published words per week", func(t *testing.T) {
+		if len(report.WordsPerWeek) != 2 {
+			t.Fatalf("got %d weeks", len(report.WordsPerWeek))
+		}
+		first, second := report.WordsPerWeek[0], report.WordsPerWeek[1]
+		if first.Posts != 1 || first.Words != 300 || second.Posts != 2 || second.Words != 700 {
+			t.Errorf("got %+v, %+v", first, second)
+		}
+	})
+
+	t.Run("tracks subscriber growth", func(t *testing.T) {
+		first, second := report.SubscriberGrowth[0], report.SubscriberGrowth[1]
+		if first.Subscribed != 2 || first.Net != 2 || first.Total != 3 {
+			t.Errorf("first week: got %+v", first)
+		}
+		if second.Subscribed != 1 || second.Unsubscribed != 1 || second.Net != 0 || second.Total != 3 {
+			t.Errorf("second week: got %+v", second)
+		}
+	})
+
+	t.Run("exports sections as CSV", func(t *testing.T) {
+		var buf bytes.Buffer
+
+		assertNoError(t, metrics.WriteCSV(&buf, report.WordsPerWeek))
+
+		want := "week_start,posts,words\n2024-03-04,1,300\n2024-03-11,2,700\n"
+		if buf.String() != want {
+			t.Errorf("got %q, want %q", buf.String(), want)
+		}
+	})
+
+	t.Run("rejects empty period", func(t *testing.T) {
+		_, err := service.Editorial(to, from)
+
+		assertErrorCode(t, err, kernel.EInvalid)
+	})
+
+	t.Run("propagates source errors", func(t *testing.T) {
+		failing := metrics.NewStatsService(stubFacts{err: errors.New("db down")}, categories, subscriptions, clock)
+
+		_, err := failing.Editorial(from, to)
+
+		assertError(t, err)
+	})
+}
+
+func TestWeekStart(t *testing.T) {
+	sunday := time.Date(2024, 3, 10, 23, 0, 0, 0, time.UTC)
+
+	got := metrics.WeekStart(sunday)
+
+	if want := time.Date(2024, 3, 4, 0, 0, 0, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
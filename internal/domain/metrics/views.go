@@ -0,0 +1,188 @@
+package metrics
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/post"
+)
+
+const (
+	MViewPostMissing     string = "Missing post ID."
+	MViewWindowInvalid   string = "Trending window must be positive."
+	MViewLimitOutOfRange string = "Trending limit must be between 1 and %d."
+)
+
+const (
+	// DefaultViewFlushThreshold is how many distinct post-day buckets are buffered before writing.
+	DefaultViewFlushThreshold = 100
+	MaxTrendingLimit          = 50
+)
+
+// botMarkers are user agent fragments of crawlers, link previewers, and scripts.
+// Deliberately simple: the aim is to keep popularity honest, not to stop abuse.
+var botMarkers = []string{
+	"bot", "crawl", "spider", "slurp", "preview", "fetch", "monitor",
+	"headless", "curl", "wget", "python-requests", "go-http-client", "java/",
+}
+
+// IsBot reports whether a user agent looks automated; empty user agents count as bots.
+func IsBot(userAgent string) bool {
+	ua := strings.ToLower(strings.TrimSpace(userAgent))
+	if ua == "" {
+		return true
+	}
+	for _, marker := range botMarkers {
+		if strings.Contains(ua, marker) {
+			return true
+		}
+	}
+	return false
+}
+
+// DailyViews counts the views of one post on one UTC day.
+type DailyViews struct {
+	PostID kernel.ID[post.Post]
+	Day    time.Time // 00:00 UTC
+	Views  int
+}
+
+// PostViews is a post's view total over some period.
+type PostViews struct {
+	PostID kernel.ID[post.Post]
+	Views  int
+}
+
+// ViewService records post views and ranks posts by popularity.
+// Views are buffered per post and day, then written as batched increments.
+// Safe for concurrent use by request handlers.
+type ViewService struct {
+	repository ViewRepository
+	clock      kernel.Clock
+	threshold  int
+
+	mu      sync.Mutex
+	pending map[DailyViews]int // Keyed by post and day with Views zeroed
+}
+
+// NewViewService creates view service flushing every DefaultViewFlushThreshold buckets.
+func NewViewService(repository ViewRepository, clock kernel.Clock) *ViewService {
+	return &ViewService{
+		repository: repository,
+		clock:      clock,
+		threshold:  DefaultViewFlushThreshold,
+		pending:    make(map[DailyViews]int),
+	}
+}
+
+// Record counts one view unless the user agent looks automated.
+// Reports whether the view was counted; errors come from an automatic flush only.
+func (s *ViewService) Record(postID kernel.ID[post.Post], userAgent string) (bool, error) {
+	const op = "ViewService.Record"
+
+	if postID == "" {
+		return false, &kernel.Error{Code: kernel.EInvalid, Message: MViewPostMissing, Operation: op}
+	}
+
+	if IsBot(userAgent) {
+		return false, nil
+	}
+
+	s.mu.Lock()
+	s.pending[DailyViews{PostID: postID, Day: TruncateToDay(s.clock.Now())}]++
+	full := len(s.pending) >= s.threshold
+	s.mu.Unlock()
+
+	if full {
+		if err := s.Flush(); err != nil {
+			return true, &kernel.Error{Operation: op, Cause: err}
+		}
+	}
+
+	return true, nil
+}
+
+// Flush writes buffered views as one batch; call it periodically and on shutdown.
+// On failure the batch is put back so no view is lost.
+func (s *ViewService) Flush() error {
+	const op = "ViewService.Flush"
+
+	s.mu.Lock()
+	batch := make([]DailyViews, 0, len(s.pending))
+	for key, views := range s.pending {
+		key.Views = views
+		batch = append(batch, key)
+	}
+	s.pending = make(map[DailyViews]int)
+	s.mu.Unlock()
+
+	if len(batch) == 0 {
+		return nil
+	}
+
+	if err := s.repository.IncrementViews(batch); err != nil {
+		s.mu.Lock()
+		for _, b := range batch {
+			views := b.Views
+			b.Views = 0
+			s.pending[b] += views
+		}
+		s.mu.Unlock()
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return nil
+}
+
+// TotalViews returns all views of a post, including views not yet flushed.
+func (s *ViewService) TotalViews(postID kernel.ID[post.Post]) (int, error) {
+	const op = "ViewService.TotalViews"
+
+	if postID == "" {
+		return 0, &kernel.Error{Code: kernel.EInvalid, Message: MViewPostMissing, Operation: op}
+	}
+
+	total, err := s.repository.SumViews(postID)
+	if err != nil {
+		return 0, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	s.mu.Lock()
+	for key, views := range s.pending {
+		if key.PostID == postID {
+			total += views
+		}
+	}
+	s.mu.Unlock()
+
+	return total, nil
+}
+
+// TrendingPosts ranks posts by views over the last window, most viewed first.
+// The window is widened to whole UTC days; unflushed views are not included.
+func (s *ViewService) TrendingPosts(window time.Duration, limit int) ([]PostViews, error) {
+	const op = "ViewService.TrendingPosts"
+
+	if window <= 0 {
+		return nil, &kernel.Error{Code: kernel.EInvalid, Message: MViewWindowInvalid, Operation: op}
+	}
+
+	if limit < 1 || limit > MaxTrendingLimit {
+		return nil, &kernel.Error{
+			Code:      kernel.EInvalid,
+			Message:   fmt.Sprintf(MViewLimitOutOfRange, MaxTrendingLimit),
+			Operation: op,
+		}
+	}
+
+	now := s.clock.Now()
+	trending, err := s.repository.TopPosts(TruncateToDay(now.Add(-window)), TruncateToDay(now), limit)
+	if err != nil {
+		return nil, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return trending, nil
+}
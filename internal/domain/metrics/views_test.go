@@ -0,0 +1,199 @@
+package metrics_test
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/metrics"
+	"github.com/alnah/fla/internal/domain/post"
+)
+
+const browser = "Mozilla/5.0 (Macintosh; Intel Mac OS X 14_4) AppleWebKit/605.1.15 Safari/605.1.15"
+
+type stubViews struct {
+	stored  map[kernel.ID[post.Post]]int
+	batches [][]metrics.DailyViews
+	top     struct{ from, to time.Time }
+	err     error
+}
+
+func newStubViews() *stubViews {
+	return &stubViews{stored: make(map[kernel.ID[post.Post]]int)}
+}
+
+func (s *stubViews) IncrementViews(batch []metrics.DailyViews) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.batches = append(s.batches, batch)
+	for _, b := range batch {
+		s.stored[b.PostID] += b.Views
+	}
+	return nil
+}
+
+func (s *stubViews) SumViews(postID kernel.ID[post.Post]) (int, error) {
+	return s.stored[postID], s.err
+}
+
+func (s *stubViews) TopPosts(from, to time.Time, limit int) ([]metrics.PostViews, error) {
+	s.top.from, s.top.to = from, to
+	return nil, s.err
+}
+
+func TestIsBot(t *testing.T) {
+	tests := []struct {
+		userAgent string
+		want      bool
+	}{
+		{browser, false},
+		{"Mozilla/5.0 (compatible; Googlebot/2.1; +http://www.google.com/bot.html)", true},
+		{"facebookexternalhit/1.1 (+http://www.facebook.com/externalhit_uatext.php) Preview", true},
+		{"curl/8.4.0", true},
+		{"", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.userAgent, func(t *testing.T) {
+			if got := metrics.IsBot(tt.userAgent); got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestViewService_Record(t *testing.T) {
+	now := time.Date(2024, 3, 5, 14, 30, 0, 0, time.UTC)
+
+	t.Run("buffers views per post and day until flushed", func(t *testing.T) {
+		repo := newStubViews()
+		service := metrics.NewViewService(repo, &stubClock{t: now})
+
+		for range 3 {
+			counted, err := service.Record("p1", browser)
+			assertNoError(t, err)
+			if !counted {
+				t.Fatal("expected view to be counted")
+			}
+		}
+		if len(repo.batches) != 0 {
+			t.Fatal("expected no write before flush")
+		}
+
+		assertNoError(t, service.Flush())
+
+		if len(repo.batches) != 1 || len(repo.batches[0]) != 1 {
+			t.Fatalf("got batches %v", repo.batches)
+		}
+		got := repo.batches[0][0]
+		if got.PostID != "p1" || got.Views != 3 || !got.Day.Equal(metrics.TruncateToDay(now)) {
+			t.Errorf("got %+v", got)
+		}
+	})
+
+	t.Run("ignores bots", func(t *testing.T) {
+		service := metrics.NewViewService(newStubViews(), &stubClock{t: now})
+
+		counted, err := service.Record("p1", "Googlebot/2.1")
+
+		assertNoError(t, err)
+		if counted {
+			t.Error("expected bot view to be ignored")
+		}
+	})
+
+	t.Run("flushes automatically when the buffer fills", func(t *testing.T) {
+		repo := newStubViews()
+		service := metrics.NewViewService(repo, &stubClock{t: now})
+
+		for i := range metrics.DefaultViewFlushThreshold {
+			_, err := service.Record(kernel.ID[post.Post]("p"+strconv.Itoa(i)), browser)
+			assertNoError(t, err)
+		}
+
+		if len(repo.batches) != 1 || len(repo.batches[0]) != metrics.DefaultViewFlushThreshold {
+			t.Errorf("expected one full batch, got %d batches", len(repo.batches))
+		}
+	})
+
+	t.Run("rejects missing post", func(t *testing.T) {
+		service := metrics.NewViewService(newStubViews(), &stubClock{t: now})
+
+		_, err := service.Record("", browser)
+
+		assertErrorCode(t, err, kernel.EInvalid)
+	})
+}
+
+func TestViewService_Flush(t *testing.T) {
+	now := time.Date(2024, 3, 5, 14, 30, 0, 0, time.UTC)
+
+	t.Run("keeps views when the write fails", func(t *testing.T) {
+		repo := newStubViews()
+		repo.err = errors.New("db down")
+		service := metrics.NewViewService(repo, &stubClock{t: now})
+		_, _ = service.Record("p1", browser)
+
+		assertError(t, service.Flush())
+
+		repo.err = nil
+		assertNoError(t, service.Flush())
+		if repo.stored["p1"] != 1 {
+			t.Errorf("got %d stored views, want 1", repo.stored["p1"])
+		}
+	})
+}
+
+func TestViewService_TotalViews(t *testing.T) {
+	repo := newStubViews()
+	repo.stored["p1"] = 40
+	service := metrics.NewViewService(repo, &stubClock{t: time.Date(2024, 3, 5, 0, 0, 0, 0, time.UTC)})
+	_, _ = service.Record("p1", browser)
+	_, _ = service.Record("p2", browser)
+
+	got, err := service.TotalViews("p1")
+
+	assertNoError(t, err)
+	if got != 41 {
+		t.Errorf("got %d, want 41", got)
+	}
+}
+
+func TestViewService_TrendingPosts(t *testing.T) {
+	now := time.Date(2024, 3, 8, 14, 30, 0, 0, time.UTC)
+
+	t.Run("widens window to whole days", func(t *testing.T) {
+		repo := newStubViews()
+		service := metrics.NewViewService(repo, &stubClock{t: now})
+
+		_, err := service.TrendingPosts(7*24*time.Hour, 10)
+
+		assertNoError(t, err)
+		if !repo.top.from.Equal(time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)) || !repo.top.to.Equal(time.Date(2024, 3, 8, 0, 0, 0, 0, time.UTC)) {
+			t.Errorf("got %v to %v", repo.top.from, repo.top.to)
+		}
+	})
+
+	tests := []struct {
+		name   string
+		window time.Duration
+		limit  int
+	}{
+		{"empty window", 0, 10},
+		{"zero limit", time.Hour, 0},
+		{"limit too high", time.Hour, metrics.MaxTrendingLimit + 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := metrics.NewViewService(newStubViews(), &stubClock{t: now})
+
+			_, err := service.TrendingPosts(tt.window, tt.limit)
+
+			assertErrorCode(t, err, kernel.EInvalid)
+		})
+	}
+}
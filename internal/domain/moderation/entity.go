@@ -0,0 +1,158 @@
+// Package moderation screens user-generated text against a configurable
+// content policy: per-locale wordlists whose terms either reject the text or
+// flag it for an editor's review. Every decision that matched a term is kept
+// for audit.
+package moderation
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/shared"
+)
+
+const (
+	MSeverityInvalid string = "Invalid content policy severity."
+	MFieldInvalid    string = "Invalid screened field."
+	MTermMissing     string = "Content policy terms cannot be empty."
+	MTermDuplicate   string = "Term %q is listed twice for %s."
+	MContentRejected string = "This text contains words that are not allowed here."
+)
+
+// Severity is what a matched term does to the text.
+type Severity string
+
+const (
+	SeverityFlag   Severity = "flag"   // Accepted, then held for an editor's review
+	SeverityReject Severity = "reject" // Turned away with MContentRejected
+)
+
+func (s Severity) String() string { return string(s) }
+
+// Validate ensures the severity is known.
+func (s Severity) Validate() error {
+	const op = "Severity.Validate"
+
+	switch s {
+	case SeverityFlag, SeverityReject:
+		return nil
+	default:
+		return &kernel.Error{Code: kernel.EInvalid, Message: MSeverityInvalid, Operation: op}
+	}
+}
+
+// Field is the kind of text screened.
+type Field string
+
+const (
+	FieldFeedback Field = "feedback" // Feedback messages, matched word by word
+	FieldUsername Field = "username" // Usernames, matched anywhere since they have no spaces
+)
+
+func (f Field) String() string { return string(f) }
+
+// Validate ensures the field is known.
+func (f Field) Validate() error {
+	const op = "Field.Validate"
+
+	switch f {
+	case FieldFeedback, FieldUsername:
+		return nil
+	default:
+		return &kernel.Error{Code: kernel.EInvalid, Message: MFieldInvalid, Operation: op}
+	}
+}
+
+// Term is a word or phrase of a wordlist. Matching ignores case, accents, and
+// punctuation, so "Connard" and "connard!" both match "connard".
+type Term struct {
+	Phrase   string
+	Severity Severity
+}
+
+// Validate ensures the term has words and a known severity.
+func (t Term) Validate() error {
+	const op = "Term.Validate"
+
+	if strings.TrimSpace(t.Phrase) == "" {
+		return &kernel.Error{Code: kernel.EInvalid, Message: MTermMissing, Operation: op}
+	}
+
+	if err := t.Severity.Validate(); err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return nil
+}
+
+// Wordlist holds the terms of one locale.
+type Wordlist struct {
+	Locale shared.Locale
+	Terms  []Term
+}
+
+// Validate ensures the locale is supported and each term is valid and listed once.
+func (w Wordlist) Validate() error {
+	const op = "Wordlist.Validate"
+
+	if err := w.Locale.Validate(); err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	seen := make(map[string]bool, len(w.Terms))
+	for _, term := range w.Terms {
+		if err := term.Validate(); err != nil {
+			return &kernel.Error{Operation: op, Cause: err}
+		}
+
+		key := strings.Join(normalize(term.Phrase), " ")
+		if seen[key] {
+			return &kernel.Error{
+				Code:      kernel.EInvalid,
+				Message:   fmt.Sprintf(MTermDuplicate, term.Phrase, w.Locale),
+				Operation: op,
+			}
+		}
+		seen[key] = true
+	}
+
+	return nil
+}
+
+// Decision is the outcome of screening a text.
+type Decision string
+
+const (
+	DecisionAllow  Decision = "allow"
+	DecisionFlag   Decision = "flag"
+	DecisionReject Decision = "reject"
+)
+
+func (d Decision) String() string { return string(d) }
+
+// Verdict is the decision on a text and the terms that led to it.
+type Verdict struct {
+	Decision Decision
+	Matches  []Term // Most severe first; empty when allowed
+}
+
+// Allowed reports whether the text may be stored, flagged or not.
+func (v Verdict) Allowed() bool { return v.Decision != DecisionReject }
+
+// DecisionRecord is the audit entry of a screening that matched a term.
+// It names the matched terms but never keeps the screened text.
+type DecisionRecord struct {
+	Field     Field
+	SubjectID string        // ID of the feedback or account the text belongs to
+	Locale    shared.Locale // Empty when every wordlist applied
+	Decision  Decision
+	Terms     []string // Phrases matched
+	DecidedAt time.Time
+}
+
+// String returns a string representation of the record.
+func (r DecisionRecord) String() string {
+	return fmt.Sprintf("DecisionRecord{Field: %s, Subject: %s, Decision: %s}", r.Field, r.SubjectID, r.Decision)
+}
@@ -0,0 +1,118 @@
+package moderation
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/search"
+	"github.com/alnah/fla/internal/domain/shared"
+)
+
+// compiledTerm is a term normalized once for matching.
+type compiledTerm struct {
+	Term
+	words  []string // For text, matched as consecutive words
+	joined string   // For usernames, matched as a substring
+}
+
+// ContentFilter matches text against the configured wordlists.
+type ContentFilter struct {
+	terms map[shared.Locale][]compiledTerm
+}
+
+// NewContentFilter creates a filter from wordlists, at most one per locale.
+// Locales without a wordlist allow everything.
+func NewContentFilter(wordlists ...Wordlist) (*ContentFilter, error) {
+	const op = "NewContentFilter"
+
+	f := &ContentFilter{terms: make(map[shared.Locale][]compiledTerm, len(wordlists))}
+	for _, list := range wordlists {
+		if err := list.Validate(); err != nil {
+			return nil, &kernel.Error{Operation: op, Cause: err}
+		}
+
+		for _, term := range list.Terms {
+			words := normalize(term.Phrase)
+			if len(words) == 0 {
+				continue // Only punctuation or single letters; it would match everything
+			}
+			f.terms[list.Locale] = append(f.terms[list.Locale], compiledTerm{
+				Term:   term,
+				words:  words,
+				joined: strings.Join(words, ""),
+			})
+		}
+	}
+
+	return f, nil
+}
+
+// Check screens text with the wordlist of the locale, or with every wordlist
+// when the locale is empty, as for usernames which have no language.
+func (f *ContentFilter) Check(field Field, text string, locale shared.Locale) Verdict {
+	var terms []compiledTerm
+	if locale == "" {
+		for _, l := range shared.SupportedLocales {
+			terms = append(terms, f.terms[l]...)
+		}
+	} else {
+		terms = f.terms[locale]
+	}
+
+	words := normalize(text)
+	joined := strings.Join(words, "")
+
+	verdict := Verdict{Decision: DecisionAllow}
+	for _, term := range terms {
+		matched := false
+		if field == FieldUsername {
+			matched = strings.Contains(joined, term.joined)
+		} else {
+			matched = containsWords(words, term.words)
+		}
+		if !matched || slices.ContainsFunc(verdict.Matches, func(t Term) bool { return t.Phrase == term.Phrase }) {
+			continue
+		}
+
+		verdict.Matches = append(verdict.Matches, term.Term)
+		switch term.Severity {
+		case SeverityReject:
+			verdict.Decision = DecisionReject
+		case SeverityFlag:
+			if verdict.Decision == DecisionAllow {
+				verdict.Decision = DecisionFlag
+			}
+		}
+	}
+
+	slices.SortStableFunc(verdict.Matches, func(a, b Term) int {
+		return cmp.Compare(rank(b.Severity), rank(a.Severity))
+	})
+
+	return verdict
+}
+
+// normalize splits text into lowercase words without accents, the way the
+// search index does.
+func normalize(text string) []string {
+	return search.Tokenize(text)
+}
+
+// containsWords reports whether phrase appears as consecutive words of text.
+func containsWords(text, phrase []string) bool {
+	for i := 0; i+len(phrase) <= len(text); i++ {
+		if slices.Equal(text[i:i+len(phrase)], phrase) {
+			return true
+		}
+	}
+	return false
+}
+
+func rank(s Severity) int {
+	if s == SeverityReject {
+		return 1
+	}
+	return 0
+}
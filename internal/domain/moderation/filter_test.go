@@ -0,0 +1,73 @@
+package moderation_test
+
+import (
+	"testing"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/moderation"
+	"github.com/alnah/fla/internal/domain/shared"
+)
+
+func TestNewContentFilter(t *testing.T) {
+	tests := []struct {
+		name string
+		list moderation.Wordlist
+	}{
+		{"unsupported locale", moderation.Wordlist{Locale: "de-DE", Terms: []moderation.Term{{Phrase: "blöd", Severity: moderation.SeverityFlag}}}},
+		{"empty term", moderation.Wordlist{Locale: shared.LocaleFrenchFR, Terms: []moderation.Term{{Phrase: " ", Severity: moderation.SeverityFlag}}}},
+		{"unknown severity", moderation.Wordlist{Locale: shared.LocaleFrenchFR, Terms: []moderation.Term{{Phrase: "nul", Severity: "ban"}}}},
+		{"duplicate term", moderation.Wordlist{Locale: shared.LocaleFrenchFR, Terms: []moderation.Term{
+			{Phrase: "Nul", Severity: moderation.SeverityFlag},
+			{Phrase: "nul !", Severity: moderation.SeverityReject},
+		}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := moderation.NewContentFilter(tt.list)
+			assertErrorCode(t, err, kernel.EInvalid)
+		})
+	}
+}
+
+func TestContentFilter_Check(t *testing.T) {
+	filter, err := moderation.NewContentFilter(testWordlists()...)
+	assertNoError(t, err)
+
+	tests := []struct {
+		name    string
+		field   moderation.Field
+		text    string
+		locale  shared.Locale
+		want    moderation.Decision
+		matches int
+	}{
+		{"clean text", moderation.FieldFeedback, "Merci pour cette leçon très claire.", shared.LocaleFrenchFR, moderation.DecisionAllow, 0},
+		{"flagged word", moderation.FieldFeedback, "Cet exercice est NUL.", shared.LocaleFrenchFR, moderation.DecisionFlag, 1},
+		{"whole words only", moderation.FieldFeedback, "Une nullité, une annulation.", shared.LocaleFrenchFR, moderation.DecisionAllow, 0},
+		{"phrase across punctuation", moderation.FieldFeedback, "Ta... gueule !", shared.LocaleFrenchFR, moderation.DecisionReject, 1},
+		{"reject wins over flag", moderation.FieldFeedback, "Nul, ce connard.", shared.LocaleFrenchFR, moderation.DecisionReject, 2},
+		{"other locale's list not applied", moderation.FieldFeedback, "Quel idiot.", shared.LocaleFrenchFR, moderation.DecisionAllow, 0},
+		{"locale without list", moderation.FieldFeedback, "connard", shared.LocalePortugueseBR, moderation.DecisionAllow, 0},
+		{"username inside a word", moderation.FieldUsername, "Le_Connard42", "", moderation.DecisionReject, 1},
+		{"username checked against every list", moderation.FieldUsername, "village-idiot", "", moderation.DecisionFlag, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := filter.Check(tt.field, tt.text, tt.locale)
+
+			if got.Decision != tt.want || len(got.Matches) != tt.matches {
+				t.Errorf("got %+v, want %s with %d matches", got, tt.want, tt.matches)
+			}
+		})
+	}
+
+	t.Run("most severe match first", func(t *testing.T) {
+		got := filter.Check(moderation.FieldFeedback, "Nul, ce connard.", shared.LocaleFrenchFR)
+
+		if got.Matches[0].Phrase != "connard" {
+			t.Errorf("got %v", got.Matches)
+		}
+	})
+}
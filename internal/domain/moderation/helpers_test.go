@@ -0,0 +1,52 @@
+package moderation_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/moderation"
+	"github.com/alnah/fla/internal/domain/shared"
+)
+
+type stubClock struct {
+	t time.Time
+}
+
+func (s *stubClock) Now() time.Time { return s.t }
+
+func assertNoError(t *testing.T, err error) {
+	t.Helper()
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+}
+
+func assertErrorCode(t *testing.T, err error, want string) {
+	t.Helper()
+	got := kernel.ErrorCode(err)
+	if got != want {
+		t.Errorf("error code: got %q, want %q", got, want)
+	}
+}
+
+// stubRecords keeps the audit trail in order.
+type stubRecords []moderation.DecisionRecord
+
+func (r *stubRecords) SaveDecisionRecord(record moderation.DecisionRecord) error {
+	*r = append(*r, record)
+	return nil
+}
+
+func testWordlists() []moderation.Wordlist {
+	return []moderation.Wordlist{
+		{Locale: shared.LocaleFrenchFR, Terms: []moderation.Term{
+			{Phrase: "connard", Severity: moderation.SeverityReject},
+			{Phrase: "ta gueule", Severity: moderation.SeverityReject},
+			{Phrase: "nul", Severity: moderation.SeverityFlag},
+		}},
+		{Locale: shared.LocaleEnglishUS, Terms: []moderation.Term{
+			{Phrase: "idiot", Severity: moderation.SeverityFlag},
+		}},
+	}
+}
@@ -0,0 +1,22 @@
+package moderation
+
+import "time"
+
+// DecisionRecordWriter appends screening decisions to the audit trail.
+type DecisionRecordWriter interface {
+	// SaveDecisionRecord appends a record; records are never updated.
+	SaveDecisionRecord(record DecisionRecord) error
+}
+
+// DecisionRecordReader retrieves the audit trail for editors reviewing the filter.
+type DecisionRecordReader interface {
+	// GetDecisionRecords returns the records decided within [from, to) ordered oldest first.
+	GetDecisionRecords(from, to time.Time) ([]DecisionRecord, error)
+}
+
+// DecisionRecordRepository combines audit persistence and retrieval.
+// Most concrete implementations (like PostgresDecisionRecordRepository) will implement this.
+type DecisionRecordRepository interface {
+	DecisionRecordWriter
+	DecisionRecordReader
+}
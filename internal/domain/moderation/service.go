@@ -0,0 +1,68 @@
+package moderation
+
+import (
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/shared"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+// FilterService screens text and keeps an audit trail of what it caught.
+type FilterService struct {
+	filter  *ContentFilter
+	records DecisionRecordWriter
+	clock   kernel.Clock
+}
+
+// NewFilterService creates filter service with the configured filter and audit trail.
+func NewFilterService(filter *ContentFilter, records DecisionRecordWriter, clock kernel.Clock) *FilterService {
+	return &FilterService{filter: filter, records: records, clock: clock}
+}
+
+// Screen checks the text of a subject, such as a feedback message or a
+// username, and records the decision when a term matched; allowed text
+// leaves no trace. Rejected text fails with EInvalid and MContentRejected,
+// alongside the verdict.
+func (s *FilterService) Screen(field Field, subjectID string, text string, locale shared.Locale) (Verdict, error) {
+	const op = "FilterService.Screen"
+
+	if err := field.Validate(); err != nil {
+		return Verdict{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	verdict := s.filter.Check(field, text, locale)
+	if verdict.Decision == DecisionAllow {
+		return verdict, nil
+	}
+
+	record := DecisionRecord{
+		Field:     field,
+		SubjectID: subjectID,
+		Locale:    locale,
+		Decision:  verdict.Decision,
+		DecidedAt: s.clock.Now(),
+	}
+	for _, term := range verdict.Matches {
+		record.Terms = append(record.Terms, term.Phrase)
+	}
+	if err := s.records.SaveDecisionRecord(record); err != nil {
+		return Verdict{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if !verdict.Allowed() {
+		return verdict, &kernel.Error{Code: kernel.EInvalid, Message: MContentRejected, Operation: op}
+	}
+
+	return verdict, nil
+}
+
+// ScreenUsername checks a username chosen for an account, against every
+// wordlist.
+func (s *FilterService) ScreenUsername(userID kernel.ID[user.User], username shared.Username) (Verdict, error) {
+	const op = "FilterService.ScreenUsername"
+
+	verdict, err := s.Screen(FieldUsername, userID.String(), username.String(), "")
+	if err != nil {
+		return verdict, &kernel.Error{Operation: op, Cause: err}
+	}
+	return verdict, nil
+}
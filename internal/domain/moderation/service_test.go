@@ -0,0 +1,67 @@
+package moderation_test
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/moderation"
+	"github.com/alnah/fla/internal/domain/shared"
+)
+
+func TestFilterService_Screen(t *testing.T) {
+	clock := &stubClock{t: time.Date(2024, 3, 5, 9, 0, 0, 0, time.UTC)}
+	filter, err := moderation.NewContentFilter(testWordlists()...)
+	assertNoError(t, err)
+	records := &stubRecords{}
+	service := moderation.NewFilterService(filter, records, clock)
+
+	t.Run("allowed text leaves no trace", func(t *testing.T) {
+		verdict, err := service.Screen(moderation.FieldFeedback, "fb-1", "Merci !", shared.LocaleFrenchFR)
+
+		assertNoError(t, err)
+		if verdict.Decision != moderation.DecisionAllow || len(*records) != 0 {
+			t.Errorf("got %+v, %d records", verdict, len(*records))
+		}
+	})
+
+	t.Run("flagged text is accepted and recorded", func(t *testing.T) {
+		verdict, err := service.Screen(moderation.FieldFeedback, "fb-2", "Exercice nul.", shared.LocaleFrenchFR)
+
+		assertNoError(t, err)
+		if !verdict.Allowed() || len(*records) != 1 {
+			t.Fatalf("got %+v, %d records", verdict, len(*records))
+		}
+		want := moderation.DecisionRecord{
+			Field:     moderation.FieldFeedback,
+			SubjectID: "fb-2",
+			Locale:    shared.LocaleFrenchFR,
+			Decision:  moderation.DecisionFlag,
+			Terms:     []string{"nul"},
+			DecidedAt: clock.t,
+		}
+		got := (*records)[0]
+		if got.String() != want.String() || !slices.Equal(got.Terms, want.Terms) || !got.DecidedAt.Equal(want.DecidedAt) {
+			t.Errorf("got %+v, want %+v", got, want)
+		}
+	})
+
+	t.Run("rejected usernames fail and are recorded", func(t *testing.T) {
+		verdict, err := service.ScreenUsername("user-9", shared.Username("connard_du_75"))
+
+		assertErrorCode(t, err, kernel.EInvalid)
+		if kernel.ErrorMessage(err) != moderation.MContentRejected {
+			t.Errorf("message: got %q", kernel.ErrorMessage(err))
+		}
+		if verdict.Allowed() || len(*records) != 2 || (*records)[1].Field != moderation.FieldUsername {
+			t.Errorf("got %+v, records %v", verdict, *records)
+		}
+	})
+
+	t.Run("unknown field", func(t *testing.T) {
+		_, err := service.Screen("bio", "user-9", "Bonjour", shared.LocaleFrenchFR)
+
+		assertErrorCode(t, err, kernel.EInvalid)
+	})
+}
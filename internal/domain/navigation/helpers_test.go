@@ -0,0 +1,72 @@
+package navigation_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/category"
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/shared"
+)
+
+type stubClock struct {
+	t time.Time
+}
+
+func (s *stubClock) Now() time.Time { return s.t }
+
+type stubCategories struct {
+	all []category.Category
+}
+
+func (s *stubCategories) GetByID(id kernel.ID[category.Category]) (*category.Category, error) {
+	for _, c := range s.all {
+		if c.CategoryID == id {
+			return &c, nil
+		}
+	}
+	return nil, &kernel.Error{Code: kernel.ENotFound, Message: "category not found"}
+}
+
+func (s *stubCategories) GetAll() ([]category.Category, error) { return s.all, nil }
+
+type stubCounter map[kernel.ID[category.Category]]int
+
+func (s stubCounter) CountPublishedByCategory() (map[kernel.ID[category.Category]]int, error) {
+	return s, nil
+}
+
+type stubLabels map[shared.Locale]map[kernel.ID[category.Category]]string
+
+func (s stubLabels) GetCategoryLabels(locale shared.Locale) (map[kernel.ID[category.Category]]string, error) {
+	return s[locale], nil
+}
+
+func newCategory(t *testing.T, id, name string, parentID *kernel.ID[category.Category]) category.Category {
+	t.Helper()
+
+	c, err := category.NewCategory(category.NewCategoryParams{
+		CategoryID: kernel.ID[category.Category](id),
+		Name:       category.CategoryName(name),
+		ParentID:   parentID,
+		CreatedBy:  "marie",
+		Clock:      &stubClock{time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)},
+	})
+	assertNoError(t, err)
+	return c
+}
+
+func assertNoError(t *testing.T, err error) {
+	t.Helper()
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+}
+
+func assertErrorCode(t *testing.T, err error, want string) {
+	t.Helper()
+	got := kernel.ErrorCode(err)
+	if got != want {
+		t.Errorf("error code: got %q, want %q", got, want)
+	}
+}
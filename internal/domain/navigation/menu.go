@@ -0,0 +1,103 @@
+// Package navigation builds the site menus from the category hierarchy: labels
+// in the reader's language, published post counts, and the trail leading to the
+// page being viewed, the structure the header and sidebar render.
+package navigation
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/alnah/fla/internal/domain/category"
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/shared"
+)
+
+const (
+	MMenuDepthInvalid  string = "Menu depth must be between 1 and %d."
+	MCurrentURLInvalid string = "Invalid current URL."
+)
+
+// MenuItem is one category in a menu. PostCount includes the posts of every
+// subcategory, also those below the menu's depth limit.
+type MenuItem struct {
+	CategoryID kernel.ID[category.Category]
+	Label      string
+	URL        string // Site-relative path, e.g. /a1/comprehension-ecrite
+	Depth      int    // 0 for levels
+	PostCount  int
+	Active     bool // The current page is this category or lies below it
+	Current    bool // The current page is this category's landing page
+	Children   []MenuItem
+}
+
+// Menu is a category tree ready to render, levels first.
+type Menu struct {
+	Locale shared.Locale
+	Items  []MenuItem
+}
+
+// ActiveTrail returns the active items from the level down to the deepest one shown,
+// the breadcrumb of the current page as far as the menu goes.
+func (m Menu) ActiveTrail() []MenuItem {
+	var trail []MenuItem
+	for items := m.Items; ; {
+		i := activeIndex(items)
+		if i < 0 {
+			return trail
+		}
+		trail = append(trail, items[i])
+		items = items[i].Children
+	}
+}
+
+func activeIndex(items []MenuItem) int {
+	for i, item := range items {
+		if item.Active {
+			return i
+		}
+	}
+	return -1
+}
+
+// MenuOptions tunes a menu for one page.
+type MenuOptions struct {
+	Locale     shared.Locale // Labels language; falls back to the default locale
+	CurrentURL string        // Page being viewed, absolute or site-relative; empty for none
+	MaxDepth   int           // Levels of categories shown; 0 shows them all
+	HideEmpty  bool          // Leave out categories without published posts
+}
+
+// Validate ensures the depth limit fits the category hierarchy.
+func (o MenuOptions) Validate() error {
+	const op = "MenuOptions.Validate"
+
+	if o.MaxDepth < 0 || o.MaxDepth > category.MaxCategoryDepth {
+		return &kernel.Error{
+			Code:      kernel.EInvalid,
+			Message:   fmt.Sprintf(MMenuDepthInvalid, category.MaxCategoryDepth),
+			Operation: op,
+		}
+	}
+	return nil
+}
+
+// currentSegments splits the path of the current URL into decoded segments.
+func currentSegments(rawURL string) ([]string, error) {
+	const op = "currentSegments"
+
+	if rawURL == "" {
+		return nil, nil
+	}
+
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, &kernel.Error{Code: kernel.EInvalid, Message: MCurrentURLInvalid, Operation: op}
+	}
+
+	trimmed := strings.Trim(u.Path, "/")
+	if trimmed == "" {
+		return nil, nil
+	}
+	return strings.Split(trimmed, "/"), nil
+}
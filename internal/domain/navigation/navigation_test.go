@@ -0,0 +1,137 @@
+package navigation_test
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/alnah/fla/internal/domain/category"
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/navigation"
+	"github.com/alnah/fla/internal/domain/shared"
+)
+
+// newTestService serves A1 > {Compréhension orale, Compréhension écrite > Sports} and A2,
+// with posts in Sports and Compréhension orale, and labels in French and English.
+func newTestService(t *testing.T) *navigation.MenuService {
+	t.Helper()
+
+	a1 := newCategory(t, "a1", "A1", nil)
+	listening := newCategory(t, "listening", "Compréhension orale", &a1.CategoryID)
+	reading := newCategory(t, "reading", "Compréhension écrite", &a1.CategoryID)
+	sports := newCategory(t, "sports", "Sports", &reading.CategoryID)
+	a2 := newCategory(t, "a2", "A2", nil)
+
+	return navigation.NewMenuService(
+		&stubCategories{all: []category.Category{a1, listening, reading, sports, a2}},
+		stubCounter{"sports": 3, "listening": 1},
+		stubLabels{
+			shared.LocaleEnglishUS: {"reading": "Reading", "listening": "Listening"},
+			shared.LocaleFrenchFR:  {"reading": "Lecture"},
+		},
+	)
+}
+
+// outline renders items as "label(count)" with children in brackets,
+// * marking active items and ! the current one.
+func outline(items []navigation.MenuItem) string {
+	out := make([]string, len(items))
+	for i, item := range items {
+		s := item.Label + "(" + strconv.Itoa(item.PostCount) + ")"
+		if item.Active {
+			s += "*"
+		}
+		if item.Current {
+			s += "!"
+		}
+		if len(item.Children) > 0 {
+			s += "[" + outline(item.Children) + "]"
+		}
+		out[i] = s
+	}
+	return strings.Join(out, " ")
+}
+
+func TestMenuService_Build(t *testing.T) {
+	service := newTestService(t)
+
+	tests := []struct {
+		name    string
+		options navigation.MenuOptions
+		want    string
+	}{
+		{
+			name:    "whole tree with rolled up counts",
+			options: navigation.MenuOptions{},
+			want:    "A1(4)[Listening(1) Reading(3)[Sports(3)]] A2(0)",
+		},
+		{
+			name:    "labels fall back to the default locale",
+			options: navigation.MenuOptions{Locale: shared.LocaleFrenchFR},
+			want:    "A1(4)[Listening(1) Lecture(3)[Sports(3)]] A2(0)",
+		},
+		{
+			name:    "depth limit",
+			options: navigation.MenuOptions{MaxDepth: 1},
+			want:    "A1(4) A2(0)",
+		},
+		{
+			name:    "hides empty categories",
+			options: navigation.MenuOptions{HideEmpty: true, MaxDepth: 2},
+			want:    "A1(4)[Listening(1) Reading(3)]",
+		},
+		{
+			name:    "highlights the active path",
+			options: navigation.MenuOptions{CurrentURL: "https://fla.example/a1/comprehension-ecrite/sports?page=2"},
+			want:    "A1(4)*[Listening(1) Reading(3)*[Sports(3)*!]] A2(0)",
+		},
+		{
+			name:    "a post page keeps its category active",
+			options: navigation.MenuOptions{CurrentURL: "/a1/comprehension-orale/au-marche", MaxDepth: 2},
+			want:    "A1(4)*[Listening(1)* Reading(3)] A2(0)",
+		},
+		{
+			name:    "matches whole segments only",
+			options: navigation.MenuOptions{CurrentURL: "/a1/comprehension", MaxDepth: 2},
+			want:    "A1(4)*[Listening(1) Reading(3)] A2(0)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			menu, err := service.Build(tt.options)
+			assertNoError(t, err)
+
+			if got := outline(menu.Items); got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("links and depths", func(t *testing.T) {
+		menu, err := service.Build(navigation.MenuOptions{CurrentURL: "/a1/comprehension-ecrite/sports"})
+		assertNoError(t, err)
+
+		trail := menu.ActiveTrail()
+		if len(trail) != 3 {
+			t.Fatalf("trail: got %d items", len(trail))
+		}
+		sports := trail[2]
+		if sports.URL != "/a1/comprehension-ecrite/sports" || sports.Depth != 2 {
+			t.Errorf("got %q at depth %d", sports.URL, sports.Depth)
+		}
+		if menu.Locale != shared.DefaultLocale {
+			t.Errorf("locale: got %q", menu.Locale)
+		}
+	})
+
+	t.Run("rejects depth beyond the hierarchy", func(t *testing.T) {
+		_, err := service.Build(navigation.MenuOptions{MaxDepth: category.MaxCategoryDepth + 1})
+		assertErrorCode(t, err, kernel.EInvalid)
+	})
+
+	t.Run("rejects a malformed current URL", func(t *testing.T) {
+		_, err := service.Build(navigation.MenuOptions{CurrentURL: "http://[::1"})
+		assertErrorCode(t, err, kernel.EInvalid)
+	})
+}
@@ -0,0 +1,22 @@
+package navigation
+
+import (
+	"github.com/alnah/fla/internal/domain/category"
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/shared"
+)
+
+// PublishedCounter counts live posts per category for menu badges.
+// Implemented by the post repository.
+type PublishedCounter interface {
+	// CountPublishedByCategory returns how many published posts are filed directly
+	// under each category. Categories without published posts may be omitted.
+	CountPublishedByCategory() (map[kernel.ID[category.Category]]int, error)
+}
+
+// LabelReader provides category names translated for the interface language.
+type LabelReader interface {
+	// GetCategoryLabels returns the translated name of each category in the locale.
+	// Categories not translated yet are omitted and keep their own name.
+	GetCategoryLabels(locale shared.Locale) (map[kernel.ID[category.Category]]string, error)
+}
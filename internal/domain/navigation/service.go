@@ -0,0 +1,128 @@
+package navigation
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/alnah/fla/internal/domain/category"
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/shared"
+)
+
+// MenuService builds navigation menus. Each build reads the hierarchy, the counts,
+// and the labels once, however many categories the menu shows.
+type MenuService struct {
+	categories category.CategoryReader
+	posts      PublishedCounter
+	labels     LabelReader
+}
+
+// NewMenuService creates menu service with category, post count, and label lookups.
+func NewMenuService(categories category.CategoryReader, posts PublishedCounter, labels LabelReader) *MenuService {
+	return &MenuService{categories: categories, posts: posts, labels: labels}
+}
+
+// Build returns the menu for a page. Labels missing in the requested locale fall back
+// to the default locale, then to the category name.
+func (s *MenuService) Build(options MenuOptions) (Menu, error) {
+	const op = "MenuService.Build"
+
+	if err := options.Validate(); err != nil {
+		return Menu{}, &kernel.Error{Operation: op, Cause: err}
+	}
+	current, err := currentSegments(options.CurrentURL)
+	if err != nil {
+		return Menu{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	tree, err := category.LoadCategoryTree(s.categories)
+	if err != nil {
+		return Menu{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	counts, err := s.posts.CountPublishedByCategory()
+	if err != nil {
+		return Menu{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	locale := options.Locale.GetEffectiveLocale()
+	labels, err := s.labelsFor(locale)
+	if err != nil {
+		return Menu{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	b := builder{tree: tree, counts: counts, labels: labels, current: current, options: options}
+	return Menu{Locale: locale, Items: b.items(tree.Roots(), nil)}, nil
+}
+
+// labelsFor merges the labels of the locale over those of the default locale.
+func (s *MenuService) labelsFor(locale shared.Locale) (map[kernel.ID[category.Category]]string, error) {
+	labels, err := s.labels.GetCategoryLabels(shared.DefaultLocale)
+	if err != nil {
+		return nil, err
+	}
+	if locale == shared.DefaultLocale {
+		return labels, nil
+	}
+
+	localized, err := s.labels.GetCategoryLabels(locale)
+	if err != nil {
+		return nil, err
+	}
+	merged := make(map[kernel.ID[category.Category]]string, len(labels)+len(localized))
+	for _, m := range []map[kernel.ID[category.Category]]string{labels, localized} {
+		for id, label := range m {
+			merged[id] = label
+		}
+	}
+	return merged, nil
+}
+
+// builder turns one tree into menu items.
+type builder struct {
+	tree    category.CategoryTree
+	counts  map[kernel.ID[category.Category]]int
+	labels  map[kernel.ID[category.Category]]string
+	current []string
+	options MenuOptions
+}
+
+func (b builder) items(categories []category.Category, parentSegments []string) []MenuItem {
+	var items []MenuItem
+	for _, c := range categories {
+		segments := append(slices.Clip(parentSegments), c.Slug.String())
+
+		count := 0
+		for _, d := range b.tree.Subtree(c.CategoryID) {
+			count += b.counts[d.CategoryID]
+		}
+		if b.options.HideEmpty && count == 0 {
+			continue
+		}
+
+		label := c.Name.String()
+		if l, ok := b.labels[c.CategoryID]; ok && l != "" {
+			label = l
+		}
+
+		depth := len(segments) - 1
+		item := MenuItem{
+			CategoryID: c.CategoryID,
+			Label:      label,
+			URL:        "/" + strings.Join(segments, "/"),
+			Depth:      depth,
+			PostCount:  count,
+			Active:     isPrefix(segments, b.current),
+			Current:    slices.Equal(segments, b.current),
+		}
+		if b.options.MaxDepth == 0 || depth+1 < b.options.MaxDepth {
+			item.Children = b.items(b.tree.Children(c.CategoryID), segments)
+		}
+		items = append(items, item)
+	}
+	return items
+}
+
+func isPrefix(prefix, segments []string) bool {
+	return len(prefix) <= len(segments) && slices.Equal(prefix, segments[:len(prefix)])
+}
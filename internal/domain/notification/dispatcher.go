@@ -0,0 +1,146 @@
+package notification
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+const (
+	MDeliveryFailed    string = "Notification delivery failed on channel %q."
+	MMessageKindMisfit string = "Notification kind %q belongs to type %q, not %q."
+)
+
+// Message is a notification addressed to one user, independent of the channel.
+type Message struct {
+	Recipient kernel.ID[user.User]
+	Type      Type // Preference type deciding the channels
+	Kind      Kind // Optional: precise event, shown in the inbox
+	Subject   string
+	Body      string
+	Link      string // Optional: admin URL the notification points to
+}
+
+// Validate ensures the message can be delivered.
+func (m Message) Validate() error {
+	const op = "Message.Validate"
+
+	if err := m.Recipient.Validate(); err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if err := m.Type.Validate(); err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if m.Kind != "" {
+		if err := m.Kind.Validate(); err != nil {
+			return &kernel.Error{Operation: op, Cause: err}
+		}
+		if m.Kind.Type() != m.Type {
+			return &kernel.Error{
+				Code:      kernel.EInvalid,
+				Message:   fmt.Sprintf(MMessageKindMisfit, m.Kind, m.Kind.Type(), m.Type),
+				Operation: op,
+			}
+		}
+	}
+
+	return kernel.ValidatePresence("subject", m.Subject, op)
+}
+
+// NewMessage creates a message for an event kind, deriving its preference type.
+func NewMessage(kind Kind, subject, body, link string) Message {
+	return Message{
+		Type:    kind.Type(),
+		Kind:    kind,
+		Subject: subject,
+		Body:    body,
+		Link:    link,
+	}
+}
+
+// Notifier is what event handlers call to notify a user.
+// Implemented by Dispatcher; handlers depend on this interface so they can be tested alone.
+type Notifier interface {
+	Dispatch(recipient user.User, message Message) ([]Channel, error)
+}
+
+// Sender delivers messages on a single channel.
+// Implemented by adapters such as the in-app inbox store (see NewNotification) or the email renderer.
+type Sender interface {
+	Send(message Message) error
+}
+
+// Dispatcher routes notifications to the channels each user opted into.
+// Users without saved preferences get their role defaults.
+type Dispatcher struct {
+	preferences PreferenceReader
+	senders     map[Channel]Sender
+}
+
+// NewDispatcher creates dispatcher with a sender per supported channel.
+// Channels without a sender are skipped silently.
+func NewDispatcher(preferences PreferenceReader, senders map[Channel]Sender) *Dispatcher {
+	return &Dispatcher{
+		preferences: preferences,
+		senders:     senders,
+	}
+}
+
+// PreferencesFor returns the effective preferences for a user.
+// Falls back to role defaults when nothing is saved.
+func (d *Dispatcher) PreferencesFor(recipient user.User) (Preferences, error) {
+	const op = "Dispatcher.PreferencesFor"
+
+	saved, err := d.preferences.GetByUserID(recipient.ID)
+	if err != nil {
+		if kernel.ErrorCode(err) == kernel.ENotFound {
+			return DefaultPreferences(recipient.ID, recipient.Roles), nil
+		}
+		return Preferences{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return *saved, nil
+}
+
+// Dispatch sends the message on every channel the recipient allows.
+// Attempts all channels even if one fails and returns the channels that delivered.
+func (d *Dispatcher) Dispatch(recipient user.User, message Message) ([]Channel, error) {
+	const op = "Dispatcher.Dispatch"
+
+	message.Recipient = recipient.ID
+	if err := message.Validate(); err != nil {
+		return nil, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	prefs, err := d.PreferencesFor(recipient)
+	if err != nil {
+		return nil, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	var delivered []Channel
+	var errs []error
+	for _, channel := range prefs.ChannelsFor(message.Type) {
+		sender, ok := d.senders[channel]
+		if !ok {
+			continue
+		}
+
+		if err := sender.Send(message); err != nil {
+			errs = append(errs, &kernel.Error{
+				Code:      kernel.EInternal,
+				Message:   fmt.Sprintf(MDeliveryFailed, channel),
+				Operation: op,
+				Cause:     err,
+			})
+			continue
+		}
+
+		delivered = append(delivered, channel)
+	}
+
+	return delivered, errors.Join(errs...)
+}
@@ -0,0 +1,109 @@
+package notification_test
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/notification"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+func setupDispatcher(repo *stubPreferenceRepository) (*notification.Dispatcher, *recordingSender, *recordingSender) {
+	inApp := &recordingSender{}
+	email := &recordingSender{}
+	dispatcher := notification.NewDispatcher(repo, map[notification.Channel]notification.Sender{
+		notification.ChannelInApp: inApp,
+		notification.ChannelEmail: email,
+	})
+	return dispatcher, inApp, email
+}
+
+func TestDispatcher_Dispatch(t *testing.T) {
+	author := user.User{ID: "user-123", Roles: []user.Role{user.RoleAuthor}}
+	approval := notification.Message{Type: notification.TypeApprovalDecision, Subject: "Post approved"}
+	comment := notification.Message{Type: notification.TypeComment, Subject: "New comment"}
+
+	t.Run("applies role defaults without saved preferences", func(t *testing.T) {
+		dispatcher, inApp, email := setupDispatcher(&stubPreferenceRepository{})
+
+		got, err := dispatcher.Dispatch(author, approval)
+		assertNoError(t, err)
+		if !slices.Equal(got, []notification.Channel{notification.ChannelInApp, notification.ChannelEmail}) {
+			t.Errorf("approval channels: got %v", got)
+		}
+
+		got, err = dispatcher.Dispatch(author, comment)
+		assertNoError(t, err)
+		if !slices.Equal(got, []notification.Channel{notification.ChannelInApp}) {
+			t.Errorf("comment channels: got %v", got)
+		}
+
+		if len(inApp.sent) != 2 || len(email.sent) != 1 {
+			t.Errorf("sent: in-app %d, email %d", len(inApp.sent), len(email.sent))
+		}
+		if inApp.sent[0].Recipient != author.ID {
+			t.Errorf("Recipient: got %q", inApp.sent[0].Recipient)
+		}
+	})
+
+	t.Run("enforces saved preferences", func(t *testing.T) {
+		clock := &stubClock{t: time.Date(2024, 5, 1, 8, 0, 0, 0, time.UTC)}
+		muted, err := notification.NewPreferences(author.ID, map[notification.Type][]notification.Channel{
+			notification.TypeApprovalDecision: {notification.ChannelEmail},
+		}, clock)
+		assertNoError(t, err)
+		repo := &stubPreferenceRepository{}
+		assertNoError(t, repo.Save(muted))
+		dispatcher, inApp, email := setupDispatcher(repo)
+
+		_, err = dispatcher.Dispatch(author, approval)
+		assertNoError(t, err)
+		got, err := dispatcher.Dispatch(author, comment)
+		assertNoError(t, err)
+
+		if len(got) != 0 {
+			t.Errorf("expected muted comments, got %v", got)
+		}
+		if len(inApp.sent) != 0 || len(email.sent) != 1 {
+			t.Errorf("sent: in-app %d, email %d", len(inApp.sent), len(email.sent))
+		}
+	})
+
+	t.Run("continues when one channel fails", func(t *testing.T) {
+		dispatcher, inApp, email := setupDispatcher(&stubPreferenceRepository{})
+		email.fail = true
+
+		got, err := dispatcher.Dispatch(author, approval)
+
+		assertError(t, err)
+		assertErrorCode(t, err, kernel.EInternal)
+		if !slices.Equal(got, []notification.Channel{notification.ChannelInApp}) {
+			t.Errorf("delivered: got %v", got)
+		}
+		if len(inApp.sent) != 1 {
+			t.Errorf("in-app sent: got %d", len(inApp.sent))
+		}
+	})
+
+	t.Run("rejects invalid message", func(t *testing.T) {
+		dispatcher, _, _ := setupDispatcher(&stubPreferenceRepository{})
+
+		_, err := dispatcher.Dispatch(author, notification.Message{Type: notification.TypeComment})
+
+		assertError(t, err)
+		assertErrorCode(t, err, kernel.EInvalid)
+	})
+
+	t.Run("propagates repository errors", func(t *testing.T) {
+		dispatcher, _, _ := setupDispatcher(&stubPreferenceRepository{
+			err: &kernel.Error{Code: kernel.EInternal, Message: "database down"},
+		})
+
+		_, err := dispatcher.Dispatch(author, approval)
+
+		assertError(t, err)
+		assertErrorCode(t, err, kernel.EInternal)
+	})
+}
@@ -0,0 +1,75 @@
+package notification_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/notification"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+type stubClock struct {
+	t time.Time
+}
+
+func (s *stubClock) Now() time.Time { return s.t }
+
+type stubPreferenceRepository struct {
+	saved map[kernel.ID[user.User]]notification.Preferences
+	err   error
+}
+
+func (s *stubPreferenceRepository) GetByUserID(userID kernel.ID[user.User]) (*notification.Preferences, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	if p, ok := s.saved[userID]; ok {
+		return &p, nil
+	}
+	return nil, &kernel.Error{Code: kernel.ENotFound, Message: "preferences not found"}
+}
+
+func (s *stubPreferenceRepository) Save(p notification.Preferences) error {
+	if s.saved == nil {
+		s.saved = make(map[kernel.ID[user.User]]notification.Preferences)
+	}
+	s.saved[p.UserID] = p
+	return nil
+}
+
+type recordingSender struct {
+	sent []notification.Message
+	fail bool
+}
+
+func (r *recordingSender) Send(m notification.Message) error {
+	if r.fail {
+		return errors.New("smtp unavailable")
+	}
+	r.sent = append(r.sent, m)
+	return nil
+}
+
+func assertNoError(t *testing.T, err error) {
+	t.Helper()
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+}
+
+func assertError(t *testing.T, err error) {
+	t.Helper()
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+}
+
+func assertErrorCode(t *testing.T, err error, want string) {
+	t.Helper()
+	got := kernel.ErrorCode(err)
+	if got != want {
+		t.Errorf("error code: got %q, want %q", got, want)
+	}
+}
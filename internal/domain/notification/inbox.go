@@ -0,0 +1,215 @@
+package notification
+
+import (
+	"slices"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+const (
+	MKindInvalid           string = "Invalid notification kind."
+	MNotificationForbidden string = "Only the recipient can change a notification."
+)
+
+// Kind identifies the event behind a notification.
+// Several kinds can share one preference Type, so users opt in per topic, not per event.
+type Kind string
+
+const (
+	KindPostApproved      Kind = "post_approved"       // Editor approved the recipient's post
+	KindPostRejected      Kind = "post_rejected"       // Editor sent the post back with feedback
+	KindNewComment        Kind = "new_comment"         // Reader commented on the recipient's post
+	KindMentionedInReview Kind = "mentioned_in_review" // Someone @mentioned the recipient in review notes
+)
+
+// kindTypes maps each kind to the preference type that controls its channels.
+var kindTypes = map[Kind]Type{
+	KindPostApproved:      TypeApprovalDecision,
+	KindPostRejected:      TypeApprovalDecision,
+	KindNewComment:        TypeComment,
+	KindMentionedInReview: TypeMention,
+}
+
+// Kinds lists every notification kind.
+var Kinds = []Kind{KindPostApproved, KindPostRejected, KindNewComment, KindMentionedInReview}
+
+func (k Kind) String() string { return string(k) }
+
+// Validate ensures the kind is known.
+func (k Kind) Validate() error {
+	const op = "Kind.Validate"
+
+	if !slices.Contains(Kinds, k) {
+		return &kernel.Error{Code: kernel.EInvalid, Message: MKindInvalid, Operation: op}
+	}
+
+	return nil
+}
+
+// Type returns the preference type deciding where this kind is delivered.
+func (k Kind) Type() Type {
+	return kindTypes[k]
+}
+
+// Notification is a message stored in a user's in-app inbox.
+type Notification struct {
+	// Identity
+	NotificationID kernel.ID[Notification]
+	Recipient      kernel.ID[user.User]
+
+	// Data
+	Kind    Kind
+	Subject string
+	Body    string
+	Link    string
+
+	// State
+	ReadAt *time.Time // Nil while unread
+
+	// Meta
+	CreatedAt time.Time
+
+	// DI
+	Clock kernel.Clock
+}
+
+// NewNotification stores a dispatched message in the inbox.
+// The in-app Sender adapter calls this with an ID it generates.
+func NewNotification(notificationID kernel.ID[Notification], message Message, clock kernel.Clock) (Notification, error) {
+	const op = "NewNotification"
+
+	if err := notificationID.Validate(); err != nil {
+		return Notification{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if err := message.Validate(); err != nil {
+		return Notification{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if err := message.Kind.Validate(); err != nil {
+		return Notification{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return Notification{
+		NotificationID: notificationID,
+		Recipient:      message.Recipient,
+		Kind:           message.Kind,
+		Subject:        message.Subject,
+		Body:           message.Body,
+		Link:           message.Link,
+		CreatedAt:      clock.Now(),
+		Clock:          clock,
+	}, nil
+}
+
+// IsRead returns true once the recipient opened or dismissed the notification.
+func (n Notification) IsRead() bool {
+	return n.ReadAt != nil
+}
+
+// MarkRead flags the notification as read; marking twice keeps the first read time.
+func (n Notification) MarkRead(readerID kernel.ID[user.User]) (Notification, error) {
+	const op = "Notification.MarkRead"
+
+	if readerID != n.Recipient {
+		return n, &kernel.Error{Code: kernel.EForbidden, Message: MNotificationForbidden, Operation: op}
+	}
+
+	if n.IsRead() {
+		return n, nil
+	}
+
+	now := n.Clock.Now()
+
+	updated := n
+	updated.ReadAt = &now
+
+	return updated, nil
+}
+
+// MarkUnread flags the notification as unread again, as a reminder.
+func (n Notification) MarkUnread(readerID kernel.ID[user.User]) (Notification, error) {
+	const op = "Notification.MarkUnread"
+
+	if readerID != n.Recipient {
+		return n, &kernel.Error{Code: kernel.EForbidden, Message: MNotificationForbidden, Operation: op}
+	}
+
+	updated := n
+	updated.ReadAt = nil
+
+	return updated, nil
+}
+
+// InboxService manages read state of in-app notifications.
+type InboxService struct {
+	repository InboxRepository
+	clock      kernel.Clock
+}
+
+// NewInboxService creates inbox service with notification persistence.
+func NewInboxService(repository InboxRepository, clock kernel.Clock) *InboxService {
+	return &InboxService{
+		repository: repository,
+		clock:      clock,
+	}
+}
+
+// MarkRead marks one notification as read for its recipient.
+func (s *InboxService) MarkRead(notificationID kernel.ID[Notification], readerID kernel.ID[user.User]) (Notification, error) {
+	const op = "InboxService.MarkRead"
+
+	n, err := s.repository.GetByID(notificationID)
+	if err != nil {
+		return Notification{}, &kernel.Error{Operation: op, Cause: err}
+	}
+	n.Clock = s.clock
+
+	read, err := n.MarkRead(readerID)
+	if err != nil {
+		return Notification{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if !n.IsRead() {
+		if err := s.repository.Update(read); err != nil {
+			return Notification{}, &kernel.Error{Operation: op, Cause: err}
+		}
+	}
+
+	return read, nil
+}
+
+// MarkUnread marks one notification as unread for its recipient.
+func (s *InboxService) MarkUnread(notificationID kernel.ID[Notification], readerID kernel.ID[user.User]) (Notification, error) {
+	const op = "InboxService.MarkUnread"
+
+	n, err := s.repository.GetByID(notificationID)
+	if err != nil {
+		return Notification{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	unread, err := n.MarkUnread(readerID)
+	if err != nil {
+		return Notification{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if err := s.repository.Update(unread); err != nil {
+		return Notification{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return unread, nil
+}
+
+// MarkAllRead clears the user's unread badge and returns how many notifications changed.
+func (s *InboxService) MarkAllRead(readerID kernel.ID[user.User]) (int, error) {
+	const op = "InboxService.MarkAllRead"
+
+	n, err := s.repository.MarkAllRead(readerID, s.clock.Now())
+	if err != nil {
+		return 0, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return n, nil
+}
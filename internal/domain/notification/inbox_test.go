@@ -0,0 +1,240 @@
+package notification_test
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/notification"
+	"github.com/alnah/fla/internal/domain/shared"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+type stubInboxRepository struct {
+	notifications map[kernel.ID[notification.Notification]]notification.Notification
+	updates       int
+}
+
+func newStubInbox(existing ...notification.Notification) *stubInboxRepository {
+	r := &stubInboxRepository{notifications: make(map[kernel.ID[notification.Notification]]notification.Notification)}
+	for _, n := range existing {
+		r.notifications[n.NotificationID] = n
+	}
+	return r
+}
+
+func (r *stubInboxRepository) GetByID(id kernel.ID[notification.Notification]) (*notification.Notification, error) {
+	n, ok := r.notifications[id]
+	if !ok {
+		return nil, &kernel.Error{Code: kernel.ENotFound, Message: "notification not found"}
+	}
+	return &n, nil
+}
+
+func (r *stubInboxRepository) GetForUser(userID kernel.ID[user.User], unreadOnly bool, p shared.Pagination) (notification.NotificationsList, error) {
+	return notification.NotificationsList{}, nil
+}
+
+func (r *stubInboxRepository) CountUnread(userID kernel.ID[user.User]) (int, error) {
+	count := 0
+	for _, n := range r.notifications {
+		if n.Recipient == userID && !n.IsRead() {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (r *stubInboxRepository) Create(n notification.Notification) error {
+	r.notifications[n.NotificationID] = n
+	return nil
+}
+
+func (r *stubInboxRepository) Update(n notification.Notification) error {
+	r.notifications[n.NotificationID] = n
+	r.updates++
+	return nil
+}
+
+func (r *stubInboxRepository) MarkAllRead(userID kernel.ID[user.User], at time.Time) (int, error) {
+	count := 0
+	for id, n := range r.notifications {
+		if n.Recipient == userID && !n.IsRead() {
+			n.ReadAt = &at
+			r.notifications[id] = n
+			count++
+		}
+	}
+	return count, nil
+}
+
+func TestKind_Type(t *testing.T) {
+	tests := []struct {
+		kind notification.Kind
+		want notification.Type
+	}{
+		{notification.KindPostApproved, notification.TypeApprovalDecision},
+		{notification.KindPostRejected, notification.TypeApprovalDecision},
+		{notification.KindNewComment, notification.TypeComment},
+		{notification.KindMentionedInReview, notification.TypeMention},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.kind.String(), func(t *testing.T) {
+			assertNoError(t, tt.kind.Validate())
+			if got := tt.kind.Type(); got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	assertErrorCode(t, notification.Kind("liked").Validate(), kernel.EInvalid)
+}
+
+func TestMessage_Kind(t *testing.T) {
+	t.Run("new message derives type from kind", func(t *testing.T) {
+		m := notification.NewMessage(notification.KindMentionedInReview, "You were mentioned", "", "/admin/posts/1")
+		m.Recipient = "user-1"
+
+		assertNoError(t, m.Validate())
+		if m.Type != notification.TypeMention {
+			t.Errorf("got type %q", m.Type)
+		}
+	})
+
+	t.Run("rejects kind under another type", func(t *testing.T) {
+		m := notification.Message{Recipient: "user-1", Type: notification.TypeComment, Kind: notification.KindPostApproved, Subject: "Approved"}
+
+		assertErrorCode(t, m.Validate(), kernel.EInvalid)
+	})
+}
+
+func TestDispatcher_Mentions(t *testing.T) {
+	editor := user.User{ID: "user-1", Roles: []user.Role{user.RoleEditor}}
+	dispatcher, inApp, email := setupDispatcher(&stubPreferenceRepository{})
+
+	got, err := dispatcher.Dispatch(editor, notification.NewMessage(notification.KindMentionedInReview, "You were mentioned", "", ""))
+
+	assertNoError(t, err)
+	if !slices.Equal(got, []notification.Channel{notification.ChannelInApp, notification.ChannelEmail}) {
+		t.Errorf("got %v", got)
+	}
+	if len(inApp.sent) != 1 || len(email.sent) != 1 || inApp.sent[0].Kind != notification.KindMentionedInReview {
+		t.Errorf("got in-app %v, email %v", inApp.sent, email.sent)
+	}
+}
+
+func TestNotification_ReadState(t *testing.T) {
+	clock := &stubClock{t: time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)}
+	message := notification.NewMessage(notification.KindPostRejected, "Post sent back", "Please add examples.", "/admin/posts/1")
+	message.Recipient = "user-1"
+
+	n, err := notification.NewNotification("n1", message, clock)
+	assertNoError(t, err)
+	if n.IsRead() || n.Kind != notification.KindPostRejected || !n.CreatedAt.Equal(clock.t) {
+		t.Fatalf("got %+v", n)
+	}
+
+	t.Run("recipient marks read and unread", func(t *testing.T) {
+		read, err := n.MarkRead("user-1")
+		assertNoError(t, err)
+		if !read.IsRead() || !read.ReadAt.Equal(clock.t) {
+			t.Errorf("got read at %v", read.ReadAt)
+		}
+
+		unread, err := read.MarkUnread("user-1")
+		assertNoError(t, err)
+		if unread.IsRead() {
+			t.Error("expected unread")
+		}
+	})
+
+	t.Run("marking read twice keeps first time", func(t *testing.T) {
+		read, _ := n.MarkRead("user-1")
+		later := &stubClock{t: clock.t.Add(time.Hour)}
+		read.Clock = later
+
+		again, err := read.MarkRead("user-1")
+
+		assertNoError(t, err)
+		if !again.ReadAt.Equal(clock.t) {
+			t.Errorf("got read at %v", again.ReadAt)
+		}
+	})
+
+	t.Run("others cannot change read state", func(t *testing.T) {
+		_, err := n.MarkRead("user-2")
+		assertErrorCode(t, err, kernel.EForbidden)
+
+		_, err = n.MarkUnread("user-2")
+		assertErrorCode(t, err, kernel.EForbidden)
+	})
+
+	t.Run("requires kind", func(t *testing.T) {
+		plain := notification.Message{Recipient: "user-1", Type: notification.TypeComment, Subject: "Comment"}
+
+		_, err := notification.NewNotification("n2", plain, clock)
+
+		assertErrorCode(t, err, kernel.EInvalid)
+	})
+}
+
+func TestInboxService(t *testing.T) {
+	clock := &stubClock{t: time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)}
+	newNotification := func(id kernel.ID[notification.Notification], recipient kernel.ID[user.User]) notification.Notification {
+		m := notification.NewMessage(notification.KindNewComment, "New comment", "", "")
+		m.Recipient = recipient
+		n, _ := notification.NewNotification(id, m, clock)
+		return n
+	}
+
+	t.Run("marks one read and skips write when already read", func(t *testing.T) {
+		repo := newStubInbox(newNotification("n1", "user-1"))
+		service := notification.NewInboxService(repo, clock)
+
+		got, err := service.MarkRead("n1", "user-1")
+		assertNoError(t, err)
+		_, err = service.MarkRead("n1", "user-1")
+		assertNoError(t, err)
+
+		if !got.IsRead() || repo.updates != 1 {
+			t.Errorf("got read %v, %d updates", got.IsRead(), repo.updates)
+		}
+	})
+
+	t.Run("marks unread", func(t *testing.T) {
+		repo := newStubInbox(newNotification("n1", "user-1"))
+		service := notification.NewInboxService(repo, clock)
+		_, _ = service.MarkRead("n1", "user-1")
+
+		got, err := service.MarkUnread("n1", "user-1")
+
+		assertNoError(t, err)
+		if got.IsRead() {
+			t.Error("expected unread")
+		}
+	})
+
+	t.Run("refuses other users", func(t *testing.T) {
+		repo := newStubInbox(newNotification("n1", "user-1"))
+		service := notification.NewInboxService(repo, clock)
+
+		_, err := service.MarkRead("n1", "user-2")
+
+		assertErrorCode(t, err, kernel.EForbidden)
+	})
+
+	t.Run("marks all read for one user", func(t *testing.T) {
+		repo := newStubInbox(newNotification("n1", "user-1"), newNotification("n2", "user-1"), newNotification("n3", "user-2"))
+		service := notification.NewInboxService(repo, clock)
+
+		n, err := service.MarkAllRead("user-1")
+
+		assertNoError(t, err)
+		unread, _ := repo.CountUnread("user-2")
+		if n != 2 || unread != 1 {
+			t.Errorf("got %d marked, %d unread for other user", n, unread)
+		}
+	})
+}
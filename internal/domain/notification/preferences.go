@@ -0,0 +1,221 @@
+package notification
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+const (
+	MTypeInvalid              string = "Invalid notification type."
+	MChannelInvalid           string = "Invalid notification channel."
+	MPreferencesDuplicateChan string = "Duplicate channel %q for notification type %q."
+)
+
+// Type identifies a category of notification a user can opt in or out of.
+type Type string
+
+const (
+	TypeApprovalDecision Type = "approval_decision" // Editor approved or rejected a post
+	TypeComment          Type = "comment"           // New comment on an owned post
+	TypeMention          Type = "mention"           // Mentioned in a review discussion
+)
+
+// Types lists every notification type in display order.
+var Types = []Type{TypeApprovalDecision, TypeComment, TypeMention}
+
+func (t Type) String() string { return string(t) }
+
+// Validate ensures the notification type is known.
+func (t Type) Validate() error {
+	const op = "Type.Validate"
+
+	if !slices.Contains(Types, t) {
+		return &kernel.Error{Code: kernel.EInvalid, Message: MTypeInvalid, Operation: op}
+	}
+
+	return nil
+}
+
+// Channel identifies how a notification reaches the user.
+type Channel string
+
+const (
+	ChannelInApp Channel = "in_app" // Notification center inside the admin interface
+	ChannelEmail Channel = "email"  // Transactional email
+)
+
+// Channels lists every delivery channel in dispatch order.
+var Channels = []Channel{ChannelInApp, ChannelEmail}
+
+func (c Channel) String() string { return string(c) }
+
+// Validate ensures the channel is supported.
+func (c Channel) Validate() error {
+	const op = "Channel.Validate"
+
+	if !slices.Contains(Channels, c) {
+		return &kernel.Error{Code: kernel.EInvalid, Message: MChannelInvalid, Operation: op}
+	}
+
+	return nil
+}
+
+// roleDefaults defines which channels each role receives by default.
+// Content roles hear about approval decisions and mentions everywhere; comments stay in-app.
+var roleDefaults = map[user.Role]map[Type][]Channel{
+	user.RoleAdmin: {
+		TypeApprovalDecision: {ChannelInApp, ChannelEmail},
+		TypeComment:          {ChannelInApp},
+		TypeMention:          {ChannelInApp, ChannelEmail},
+	},
+	user.RoleEditor: {
+		TypeApprovalDecision: {ChannelInApp, ChannelEmail},
+		TypeComment:          {ChannelInApp},
+		TypeMention:          {ChannelInApp, ChannelEmail},
+	},
+	user.RoleAuthor: {
+		TypeApprovalDecision: {ChannelInApp, ChannelEmail},
+		TypeComment:          {ChannelInApp},
+		TypeMention:          {ChannelInApp, ChannelEmail},
+	},
+	user.RoleSubscriber: {
+		TypeComment: {ChannelInApp},
+	},
+}
+
+// Preferences is the per-user matrix of notification types and enabled channels.
+// Distinct from newsletter preferences: applies to authenticated users only.
+type Preferences struct {
+	UserID    kernel.ID[user.User]
+	Channels  map[Type][]Channel // Missing or empty entry disables the type
+	UpdatedAt time.Time          // Zero for role defaults never saved
+}
+
+// DefaultPreferences returns the union of role defaults for a user.
+// Visitors and machine accounts receive no notifications by default.
+func DefaultPreferences(userID kernel.ID[user.User], roles []user.Role) Preferences {
+	channels := make(map[Type][]Channel)
+
+	for _, role := range roles {
+		for t, defaults := range roleDefaults[role] {
+			for _, c := range defaults {
+				if !slices.Contains(channels[t], c) {
+					channels[t] = append(channels[t], c)
+				}
+			}
+		}
+	}
+
+	for t := range channels {
+		channels[t] = sortChannels(channels[t])
+	}
+
+	return Preferences{UserID: userID, Channels: channels}
+}
+
+// NewPreferences creates a validated preference matrix chosen by the user.
+// Copies the matrix so callers cannot mutate it afterwards.
+func NewPreferences(userID kernel.ID[user.User], channels map[Type][]Channel, clock kernel.Clock) (Preferences, error) {
+	const op = "NewPreferences"
+
+	p := Preferences{
+		UserID:    userID,
+		Channels:  cloneMatrix(channels),
+		UpdatedAt: clock.Now(),
+	}
+
+	if err := p.Validate(); err != nil {
+		return Preferences{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return p, nil
+}
+
+// Validate ensures every type and channel in the matrix is supported.
+func (p Preferences) Validate() error {
+	const op = "Preferences.Validate"
+
+	if err := p.UserID.Validate(); err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	for t, channels := range p.Channels {
+		if err := t.Validate(); err != nil {
+			return &kernel.Error{Operation: op, Cause: err}
+		}
+
+		seen := make(map[Channel]bool, len(channels))
+		for _, c := range channels {
+			if err := c.Validate(); err != nil {
+				return &kernel.Error{Operation: op, Cause: err}
+			}
+			if seen[c] {
+				return &kernel.Error{
+					Code:      kernel.EInvalid,
+					Message:   fmt.Sprintf(MPreferencesDuplicateChan, c, t),
+					Operation: op,
+				}
+			}
+			seen[c] = true
+		}
+	}
+
+	return nil
+}
+
+// Allows reports whether the user receives a notification type on a channel.
+func (p Preferences) Allows(t Type, c Channel) bool {
+	return slices.Contains(p.Channels[t], c)
+}
+
+// ChannelsFor returns the enabled channels for a type in dispatch order.
+func (p Preferences) ChannelsFor(t Type) []Channel {
+	return sortChannels(slices.Clone(p.Channels[t]))
+}
+
+// Update replaces the channels of one notification type.
+// Passing no channels mutes the type entirely.
+func (p Preferences) Update(t Type, channels []Channel, clock kernel.Clock) (Preferences, error) {
+	const op = "Preferences.Update"
+
+	updated := p
+	updated.Channels = cloneMatrix(p.Channels)
+	updated.Channels[t] = sortChannels(slices.Clone(channels))
+	updated.UpdatedAt = clock.Now()
+
+	if err := updated.Validate(); err != nil {
+		return p, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return updated, nil
+}
+
+// IsDefault reports whether the matrix comes from role defaults rather than user choice.
+func (p Preferences) IsDefault() bool {
+	return p.UpdatedAt.IsZero()
+}
+
+// String returns a string representation of the preferences.
+func (p Preferences) String() string {
+	return fmt.Sprintf("Preferences{UserID: %q, Channels: %v}", p.UserID, p.Channels)
+}
+
+func cloneMatrix(channels map[Type][]Channel) map[Type][]Channel {
+	cloned := make(map[Type][]Channel, len(channels))
+	for t, c := range channels {
+		cloned[t] = slices.Clone(c)
+	}
+	return cloned
+}
+
+// sortChannels orders channels following Channels so dispatch order is stable.
+func sortChannels(channels []Channel) []Channel {
+	slices.SortStableFunc(channels, func(a, b Channel) int {
+		return slices.Index(Channels, a) - slices.Index(Channels, b)
+	})
+	return channels
+}
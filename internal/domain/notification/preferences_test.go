@@ -0,0 +1,155 @@
+package notification_test
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/notification"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+func TestType_Validate(t *testing.T) {
+	for _, valid := range notification.Types {
+		assertNoError(t, valid.Validate())
+	}
+
+	err := notification.Type("newsletter").Validate()
+	assertError(t, err)
+	assertErrorCode(t, err, kernel.EInvalid)
+}
+
+func TestChannel_Validate(t *testing.T) {
+	for _, valid := range notification.Channels {
+		assertNoError(t, valid.Validate())
+	}
+
+	err := notification.Channel("sms").Validate()
+	assertError(t, err)
+	assertErrorCode(t, err, kernel.EInvalid)
+}
+
+func TestDefaultPreferences(t *testing.T) {
+	tests := []struct {
+		name     string
+		roles    []user.Role
+		approval []notification.Channel
+		comment  []notification.Channel
+	}{
+		{
+			name:     "author gets approvals everywhere and comments in-app",
+			roles:    []user.Role{user.RoleAuthor},
+			approval: []notification.Channel{notification.ChannelInApp, notification.ChannelEmail},
+			comment:  []notification.Channel{notification.ChannelInApp},
+		},
+		{
+			name:    "subscriber gets comments in-app only",
+			roles:   []user.Role{user.RoleSubscriber},
+			comment: []notification.Channel{notification.ChannelInApp},
+		},
+		{
+			name:     "multiple roles are merged without duplicates",
+			roles:    []user.Role{user.RoleSubscriber, user.RoleEditor},
+			approval: []notification.Channel{notification.ChannelInApp, notification.ChannelEmail},
+			comment:  []notification.Channel{notification.ChannelInApp},
+		},
+		{
+			name:  "machine receives nothing",
+			roles: []user.Role{user.RoleMachine},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := notification.DefaultPreferences("user-123", tt.roles)
+
+			if !got.IsDefault() {
+				t.Error("expected default preferences")
+			}
+			if ch := got.ChannelsFor(notification.TypeApprovalDecision); !slices.Equal(ch, tt.approval) {
+				t.Errorf("approval channels: got %v, want %v", ch, tt.approval)
+			}
+			if ch := got.ChannelsFor(notification.TypeComment); !slices.Equal(ch, tt.comment) {
+				t.Errorf("comment channels: got %v, want %v", ch, tt.comment)
+			}
+		})
+	}
+}
+
+func TestNewPreferences(t *testing.T) {
+	clock := &stubClock{t: time.Date(2024, 5, 1, 8, 0, 0, 0, time.UTC)}
+
+	t.Run("creates user preferences", func(t *testing.T) {
+		matrix := map[notification.Type][]notification.Channel{
+			notification.TypeComment: {notification.ChannelEmail, notification.ChannelInApp},
+		}
+
+		got, err := notification.NewPreferences("user-123", matrix, clock)
+
+		assertNoError(t, err)
+		if got.IsDefault() {
+			t.Error("expected customized preferences")
+		}
+		if !got.Allows(notification.TypeComment, notification.ChannelEmail) {
+			t.Error("expected comments by email")
+		}
+		if got.Allows(notification.TypeApprovalDecision, notification.ChannelInApp) {
+			t.Error("expected approvals muted")
+		}
+
+		matrix[notification.TypeComment][0] = "sms"
+		if !got.Allows(notification.TypeComment, notification.ChannelEmail) {
+			t.Error("preferences must not share caller's slices")
+		}
+	})
+
+	t.Run("rejects invalid matrix", func(t *testing.T) {
+		tests := []struct {
+			name   string
+			userID kernel.ID[user.User]
+			matrix map[notification.Type][]notification.Channel
+		}{
+			{name: "missing user", userID: ""},
+			{name: "unknown type", userID: "user-123", matrix: map[notification.Type][]notification.Channel{"newsletter": {notification.ChannelEmail}}},
+			{name: "unknown channel", userID: "user-123", matrix: map[notification.Type][]notification.Channel{notification.TypeComment: {"sms"}}},
+			{name: "duplicate channel", userID: "user-123", matrix: map[notification.Type][]notification.Channel{notification.TypeComment: {notification.ChannelEmail, notification.ChannelEmail}}},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				_, err := notification.NewPreferences(tt.userID, tt.matrix, clock)
+
+				assertError(t, err)
+				assertErrorCode(t, err, kernel.EInvalid)
+			})
+		}
+	})
+}
+
+func TestPreferences_Update(t *testing.T) {
+	clock := &stubClock{t: time.Date(2024, 5, 1, 8, 0, 0, 0, time.UTC)}
+	defaults := notification.DefaultPreferences("user-123", []user.Role{user.RoleAuthor})
+
+	t.Run("replaces channels of one type", func(t *testing.T) {
+		got, err := defaults.Update(notification.TypeApprovalDecision, []notification.Channel{notification.ChannelInApp}, clock)
+
+		assertNoError(t, err)
+		if got.Allows(notification.TypeApprovalDecision, notification.ChannelEmail) {
+			t.Error("expected approval emails muted")
+		}
+		if !got.UpdatedAt.Equal(clock.t) {
+			t.Errorf("UpdatedAt: got %v", got.UpdatedAt)
+		}
+		if !defaults.Allows(notification.TypeApprovalDecision, notification.ChannelEmail) {
+			t.Error("original preferences must be unchanged")
+		}
+	})
+
+	t.Run("rejects unknown channel", func(t *testing.T) {
+		_, err := defaults.Update(notification.TypeComment, []notification.Channel{"sms"}, clock)
+
+		assertError(t, err)
+		assertErrorCode(t, err, kernel.EInvalid)
+	})
+}
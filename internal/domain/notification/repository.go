@@ -0,0 +1,68 @@
+package notification
+
+import (
+	"time"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/shared"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+// PreferenceReader retrieves saved notification preferences.
+// Used by the dispatcher and the account settings page.
+type PreferenceReader interface {
+	// GetByUserID returns the user's saved matrix.
+	// Returns ENotFound when the user never customized preferences.
+	GetByUserID(userID kernel.ID[user.User]) (*Preferences, error)
+}
+
+// PreferenceWriter persists notification preferences chosen by users.
+type PreferenceWriter interface {
+	// Save stores the matrix, replacing any previous one for the user.
+	Save(preferences Preferences) error
+}
+
+// PreferenceRepository combines preference persistence and retrieval.
+// Most concrete implementations (like PostgresPreferenceRepository) will implement this.
+type PreferenceRepository interface {
+	PreferenceReader
+	PreferenceWriter
+}
+
+// InboxReader retrieves in-app notifications.
+// Used by the notification center and the unread badge.
+type InboxReader interface {
+	// GetByID retrieves a notification for read-state changes.
+	GetByID(notificationID kernel.ID[Notification]) (*Notification, error)
+
+	// GetForUser lists a user's notifications, newest first.
+	GetForUser(userID kernel.ID[user.User], unreadOnly bool, pagination shared.Pagination) (NotificationsList, error)
+
+	// CountUnread returns the number shown on the unread badge.
+	CountUnread(userID kernel.ID[user.User]) (int, error)
+}
+
+// InboxWriter persists in-app notifications.
+type InboxWriter interface {
+	// Create stores a new notification.
+	Create(notification Notification) error
+
+	// Update saves read-state changes.
+	Update(notification Notification) error
+
+	// MarkAllRead sets readAt on every unread notification of a user and returns how many changed.
+	MarkAllRead(userID kernel.ID[user.User], readAt time.Time) (int, error)
+}
+
+// InboxRepository combines inbox persistence and retrieval.
+// Most concrete implementations (like PostgresInboxRepository) will implement this.
+type InboxRepository interface {
+	InboxReader
+	InboxWriter
+}
+
+// NotificationsList is a page of inbox notifications.
+type NotificationsList struct {
+	Notifications []Notification
+	Pagination    shared.Pagination
+}
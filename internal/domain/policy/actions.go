@@ -0,0 +1,26 @@
+package policy
+
+// Actions on the blog's resources. Packages adding resources declare their own.
+const (
+	PostCreate   Action = "post.create"
+	PostView     Action = "post.view"
+	PostEdit     Action = "post.edit" // Content, tags, and category
+	PostDelete   Action = "post.delete"
+	PostPublish  Action = "post.publish"
+	PostSchedule Action = "post.schedule"
+	PostArchive  Action = "post.archive"
+	PostReview   Action = "post.review" // Editorial workflow: approve, release, unpublish
+	PostFeature  Action = "post.feature"
+
+	CategoryManage Action = "category.manage"
+	CategoryAssign Action = "category.assign" // Limit an editor to category subtrees
+	TagManage      Action = "tag.manage"
+	ReactionCreate Action = "reaction.create" // Like or bookmark a published post
+)
+
+// Resource kinds.
+const (
+	KindPost     string = "post"
+	KindCategory string = "category"
+	KindTag      string = "tag"
+)
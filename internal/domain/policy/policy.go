@@ -0,0 +1,159 @@
+// Package policy decides who may do what. Permissions are declarative rules
+// (roles, ownership, resource status) declared by the packages that own the
+// roles and resources, which build a Policy from them once; Authorize evaluates
+// them and explains its decision, so every resource (posts, categories, comments,
+// media, campaigns) shares one permission model instead of hand-written checks.
+package policy
+
+import (
+	"fmt"
+	"slices"
+)
+
+// Action is something an actor does to a resource, named "<resource>.<verb>".
+type Action string
+
+func (a Action) String() string { return string(a) }
+
+// Effect is what a matching rule decides.
+type Effect string
+
+const (
+	Allow Effect = "allow"
+	Deny  Effect = "deny"
+)
+
+// Actor is whoever asks for permission. An actor without active account (suspended,
+// deactivated, or anonymous) only gets what rules open to anyone grant.
+type Actor struct {
+	ID     string
+	Roles  []string
+	Active bool
+	Scopes []string // Categories scoped rules limit the actor to; AllScopes means everywhere
+}
+
+// AllScopes in an actor's scopes lifts the limit of scoped rules.
+const AllScopes string = "*"
+
+// InScope reports whether the actor's scopes include the resource's.
+// Actors without scopes match no scoped rule.
+func (a Actor) InScope(resource Resource) bool {
+	return slices.Contains(a.Scopes, AllScopes) || (resource.Scope != "" && slices.Contains(a.Scopes, resource.Scope))
+}
+
+// HasAnyRole reports whether the actor holds one of the roles.
+func (a Actor) HasAnyRole(roles ...string) bool {
+	for _, role := range roles {
+		if slices.Contains(a.Roles, role) {
+			return true
+		}
+	}
+	return false
+}
+
+// Resource is what an action applies to. Owner and Status are empty for
+// resources nobody owns or without lifecycle, such as the category tree.
+type Resource struct {
+	Kind   string // e.g. "post", "category"
+	ID     string
+	Owner  string // Actor ID of the owner
+	Status string
+	Scope  string // Category the resource belongs to, for scoped rules
+}
+
+// Rule grants or denies actions when all its conditions hold. Empty conditions
+// match anything: a rule without roles applies to every active actor.
+type Rule struct {
+	Name     string   // Explains decisions, e.g. "authors edit their own posts"
+	Effect   Effect   // Allow when empty
+	Actions  []Action // Required
+	Roles    []string // The actor needs one of them
+	Owner    bool     // The actor must own the resource
+	Statuses []string // The resource must be in one of them
+	Anyone   bool     // Also matches inactive and anonymous actors
+	Scoped   bool     // The actor's scopes must include the resource's
+}
+
+// matches reports whether the rule applies to the request.
+func (r Rule) matches(actor Actor, action Action, resource Resource) bool {
+	switch {
+	case !slices.Contains(r.Actions, action):
+		return false
+	case !r.Anyone && !actor.Active:
+		return false
+	case len(r.Roles) > 0 && !actor.HasAnyRole(r.Roles...):
+		return false
+	case r.Owner && (actor.ID == "" || actor.ID != resource.Owner):
+		return false
+	case len(r.Statuses) > 0 && !slices.Contains(r.Statuses, resource.Status):
+		return false
+	case r.Scoped && !actor.InScope(resource):
+		return false
+	}
+	return true
+}
+
+// Decision is the outcome of Authorize and the rule behind it.
+// Rule is empty when no rule allowed the action.
+type Decision struct {
+	Action  Action
+	Allowed bool
+	Rule    string
+	Reason  string
+}
+
+// String explains the decision, e.g. for audit logs.
+func (d Decision) String() string {
+	if d.Allowed {
+		return fmt.Sprintf("%s allowed by rule %q", d.Action, d.Rule)
+	} else if d.Rule != "" {
+		return fmt.Sprintf("%s denied by rule %q", d.Action, d.Rule)
+	}
+	return fmt.Sprintf("%s denied: %s", d.Action, d.Reason)
+}
+
+// Reasons given when no rule decides.
+const (
+	ReasonNoRule   string = "no rule allows it"
+	ReasonInactive string = "the account is not active"
+)
+
+// Policy is a fixed set of rules. Deny rules win over allow rules, and actions no
+// rule allows are denied, so the order of the rules does not matter. A policy
+// cannot change once built, so it is safe to share and to use concurrently.
+type Policy struct {
+	rules []Rule
+}
+
+// New creates a policy holding a copy of the rules.
+func New(rules ...Rule) *Policy {
+	return &Policy{rules: slices.Clone(rules)}
+}
+
+// Rules returns the policy's rules, to document or review the permission model.
+func (p *Policy) Rules() []Rule {
+	return slices.Clone(p.rules)
+}
+
+// Authorize decides whether actor may perform action on resource.
+func (p *Policy) Authorize(actor Actor, action Action, resource Resource) Decision {
+	var allowedBy *Rule
+	for i, rule := range p.rules {
+		if !rule.matches(actor, action, resource) {
+			continue
+		}
+		if rule.Effect == Deny {
+			return Decision{Action: action, Rule: rule.Name}
+		}
+		if allowedBy == nil {
+			allowedBy = &p.rules[i]
+		}
+	}
+
+	if allowedBy != nil {
+		return Decision{Action: action, Allowed: true, Rule: allowedBy.Name}
+	} else if !actor.Active {
+		return Decision{Action: action, Reason: ReasonInactive}
+	}
+	return Decision{Action: action, Reason: ReasonNoRule}
+}
@@ -0,0 +1,93 @@
+package policy_test
+
+import (
+	"testing"
+
+	"github.com/alnah/fla/internal/domain/policy"
+)
+
+const comment = "comment"
+
+const (
+	commentView     policy.Action = "comment.view"
+	commentEdit     policy.Action = "comment.edit"
+	commentModerate policy.Action = "comment.moderate"
+)
+
+func TestPolicy_Authorize(t *testing.T) {
+	p := policy.New(
+		policy.Rule{Name: "anyone reads approved comments", Actions: []policy.Action{commentView}, Statuses: []string{"approved"}, Anyone: true},
+		policy.Rule{Name: "owners edit their comments", Actions: []policy.Action{commentEdit}, Owner: true},
+		policy.Rule{Name: "moderators moderate", Actions: []policy.Action{commentEdit, commentModerate}, Roles: []string{"moderator"}},
+		policy.Rule{Name: "locked comments stay locked", Effect: policy.Deny, Actions: []policy.Action{commentEdit}, Statuses: []string{"locked"}},
+	)
+
+	owner := policy.Actor{ID: "u1", Roles: []string{"subscriber"}, Active: true}
+	moderator := policy.Actor{ID: "u2", Roles: []string{"moderator"}, Active: true}
+	suspended := policy.Actor{ID: "u2", Roles: []string{"moderator"}}
+	anonymous := policy.Actor{}
+
+	tests := []struct {
+		name     string
+		actor    policy.Actor
+		action   policy.Action
+		resource policy.Resource
+		allowed  bool
+		rule     string
+		reason   string
+	}{
+		{"owner edits own comment", owner, commentEdit, policy.Resource{Kind: comment, Owner: "u1"}, true, "owners edit their comments", ""},
+		{"owner cannot edit others' comment", owner, commentEdit, policy.Resource{Kind: comment, Owner: "u3"}, false, "", policy.ReasonNoRule},
+		{"role grants action", moderator, commentModerate, policy.Resource{Kind: comment}, true, "moderators moderate", ""},
+		{"deny wins over allow", moderator, commentEdit, policy.Resource{Kind: comment, Status: "locked"}, false, "locked comments stay locked", ""},
+		{"inactive account loses roles", suspended, commentModerate, policy.Resource{Kind: comment}, false, "", policy.ReasonInactive},
+		{"rule open to anyone", anonymous, commentView, policy.Resource{Kind: comment, Status: "approved"}, true, "anyone reads approved comments", ""},
+		{"anonymous does not own ownerless resources", anonymous, commentEdit, policy.Resource{Kind: comment}, false, "", policy.ReasonInactive},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := p.Authorize(tt.actor, tt.action, tt.resource)
+
+			if got.Allowed != tt.allowed || got.Rule != tt.rule || got.Reason != tt.reason {
+				t.Errorf("got %+v, want allowed %t by %q (%s)", got, tt.allowed, tt.rule, tt.reason)
+			}
+			if got.Action != tt.action {
+				t.Errorf("got action %q, want %q", got.Action, tt.action)
+			}
+		})
+	}
+}
+
+func TestDecision_String(t *testing.T) {
+	tests := []struct {
+		decision policy.Decision
+		want     string
+	}{
+		{policy.Decision{Action: commentEdit, Allowed: true, Rule: "owners"}, `comment.edit allowed by rule "owners"`},
+		{policy.Decision{Action: commentEdit, Rule: "locked"}, `comment.edit denied by rule "locked"`},
+		{policy.Decision{Action: commentEdit, Reason: policy.ReasonNoRule}, "comment.edit denied: no rule allows it"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.decision.String(); got != tt.want {
+			t.Errorf("got %q, want %q", got, tt.want)
+		}
+	}
+}
+
+func TestPolicy_Rules(t *testing.T) {
+	rules := []policy.Rule{{Name: "moderators moderate", Actions: []policy.Action{commentModerate}, Roles: []string{"moderator"}}}
+	p := policy.New(rules...)
+	actor := policy.Actor{ID: "u1", Roles: []string{"moderator"}, Active: true}
+
+	rules[0].Roles = []string{"subscriber"}
+	p.Rules()[0].Roles = []string{"subscriber"}
+
+	if !p.Authorize(actor, commentModerate, policy.Resource{}).Allowed {
+		t.Error("policy changed after it was built")
+	}
+	if got := len(p.Rules()); got != 1 {
+		t.Errorf("got %d rules, want 1", got)
+	}
+}
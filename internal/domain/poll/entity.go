@@ -0,0 +1,327 @@
+// Package poll runs short polls and surveys for subscribers: a question, a few
+// options, an opening window, and one vote per voter. Polls are attached to a
+// post or a newsletter issue and voted on anonymously or by signed-in readers.
+package poll
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+const (
+	MinQuestionLength int = 5
+	MaxQuestionLength int = 300
+	MaxOptionLength   int = 120
+	MinOptions        int = 2
+	MaxOptions        int = 10
+
+	MPollOptionsCount    string = "Poll needs between %d and %d options."
+	MPollOptionDuplicate string = "Poll option %q is listed more than once."
+	MPollWindowInvalid   string = "Poll must close after it opens."
+	MPollTargetInvalid   string = "Invalid poll target: %s."
+	MPollModeInvalid     string = "Invalid poll voting mode: %s."
+	MPollOptionUnknown   string = "Poll has no option %q."
+	MPollNotOpen         string = "Poll is not open for votes."
+	MPollAlreadyClosed   string = "Poll is already closed."
+	MPollVoterMissing    string = "Missing voter token."
+)
+
+// TargetKind is what a poll is attached to.
+type TargetKind string
+
+const (
+	TargetPost       TargetKind = "post"       // Shown at the end of a lesson
+	TargetNewsletter TargetKind = "newsletter" // Linked from a newsletter issue
+)
+
+func (k TargetKind) String() string { return string(k) }
+
+// Validate ensures the target kind is known.
+func (k TargetKind) Validate() error {
+	const op = "TargetKind.Validate"
+
+	switch k {
+	case TargetPost, TargetNewsletter:
+		return nil
+	default:
+		return &kernel.Error{Code: kernel.EInvalid, Message: fmt.Sprintf(MPollTargetInvalid, k), Operation: op}
+	}
+}
+
+// Target is the post or newsletter issue a poll belongs to.
+type Target struct {
+	Kind TargetKind
+	ID   string // Post ID or newsletter issue ID
+}
+
+// Validate ensures the target is known and identified.
+func (t Target) Validate() error {
+	const op = "Target.Validate"
+
+	if err := t.Kind.Validate(); err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return kernel.ValidatePresence("target", t.ID, op)
+}
+
+// Mode tells who may vote.
+type Mode string
+
+const (
+	ModeAnonymous     Mode = "anonymous"     // Anyone, deduplicated by an opaque token
+	ModeAuthenticated Mode = "authenticated" // Signed-in readers only, one vote per account
+)
+
+func (m Mode) String() string { return string(m) }
+
+// Validate ensures the voting mode is known.
+func (m Mode) Validate() error {
+	const op = "Mode.Validate"
+
+	switch m {
+	case ModeAnonymous, ModeAuthenticated:
+		return nil
+	default:
+		return &kernel.Error{Code: kernel.EInvalid, Message: fmt.Sprintf(MPollModeInvalid, m), Operation: op}
+	}
+}
+
+// Option is one possible answer.
+type Option struct {
+	ID    string // Stable within the poll; votes refer to it
+	Label string
+}
+
+// Poll asks subscribers one question.
+type Poll struct {
+	// Identity
+	PollID kernel.ID[Poll]
+
+	// Data
+	Question string
+	Options  []Option
+	Target   Target
+	Mode     Mode
+
+	// Lifecycle
+	OpensAt  time.Time
+	ClosesAt *time.Time // nil while the poll runs until closed by hand
+
+	// Meta
+	CreatedBy kernel.ID[user.User]
+	CreatedAt time.Time
+	UpdatedAt time.Time
+
+	// DI
+	Clock kernel.Clock
+}
+
+// NewPollParams holds the parameters needed to create a poll.
+type NewPollParams struct {
+	// Required
+	PollID    kernel.ID[Poll]
+	Question  string
+	Options   []string // Labels, in display order
+	Target    Target
+	CreatedBy kernel.ID[user.User]
+
+	// Optional
+	Mode     Mode       // Defaults to ModeAnonymous
+	OpensAt  time.Time  // Defaults to now
+	ClosesAt *time.Time // Defaults to open-ended
+
+	// DI
+	Clock kernel.Clock
+}
+
+// NewPoll creates a poll. Options are numbered from 1 in the order given.
+func NewPoll(p NewPollParams) (Poll, error) {
+	const op = "NewPoll"
+
+	now := p.Clock.Now()
+
+	mode := p.Mode
+	if mode == "" {
+		mode = ModeAnonymous
+	}
+	opensAt := p.OpensAt
+	if opensAt.IsZero() {
+		opensAt = now
+	}
+
+	options := make([]Option, len(p.Options))
+	for i, label := range p.Options {
+		options[i] = Option{ID: strconv.Itoa(i + 1), Label: strings.TrimSpace(label)}
+	}
+
+	poll := Poll{
+		PollID:    p.PollID,
+		Question:  strings.TrimSpace(p.Question),
+		Options:   options,
+		Target:    p.Target,
+		Mode:      mode,
+		OpensAt:   opensAt,
+		ClosesAt:  p.ClosesAt,
+		CreatedBy: p.CreatedBy,
+		CreatedAt: now,
+		UpdatedAt: now,
+		Clock:     p.Clock,
+	}
+
+	if err := poll.Validate(); err != nil {
+		return Poll{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return poll, nil
+}
+
+// Validate performs validation on the poll.
+func (p Poll) Validate() error {
+	const op = "Poll.Validate"
+
+	if err := p.PollID.Validate(); err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if err := kernel.ValidateLength("question", p.Question, MinQuestionLength, MaxQuestionLength, op); err != nil {
+		return err
+	}
+
+	if len(p.Options) < MinOptions || len(p.Options) > MaxOptions {
+		return &kernel.Error{Code: kernel.EInvalid, Message: fmt.Sprintf(MPollOptionsCount, MinOptions, MaxOptions), Operation: op}
+	}
+
+	seen := make(map[string]bool, len(p.Options))
+	for _, o := range p.Options {
+		if err := kernel.ValidateLength("option", o.Label, 1, MaxOptionLength, op); err != nil {
+			return err
+		}
+		label := strings.ToLower(o.Label)
+		if seen[label] || seen[o.ID] {
+			return &kernel.Error{Code: kernel.EInvalid, Message: fmt.Sprintf(MPollOptionDuplicate, o.Label), Operation: op}
+		}
+		seen[label], seen[o.ID] = true, true
+	}
+
+	if err := p.Target.Validate(); err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if err := p.Mode.Validate(); err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if p.ClosesAt != nil && !p.ClosesAt.After(p.OpensAt) {
+		return &kernel.Error{Code: kernel.EInvalid, Message: MPollWindowInvalid, Operation: op}
+	}
+
+	return nil
+}
+
+// String returns a string representation of the poll.
+func (p Poll) String() string {
+	return fmt.Sprintf("Poll{ID: %s, Target: %s/%s, Options: %d}", p.PollID, p.Target.Kind, p.Target.ID, len(p.Options))
+}
+
+// IsOpen reports whether the poll takes votes now.
+func (p Poll) IsOpen() bool {
+	now := p.Clock.Now()
+	return !now.Before(p.OpensAt) && (p.ClosesAt == nil || now.Before(*p.ClosesAt))
+}
+
+// IsClosed reports whether the poll's window has ended. A poll not open yet is not closed.
+func (p Poll) IsClosed() bool {
+	return p.ClosesAt != nil && !p.Clock.Now().Before(*p.ClosesAt)
+}
+
+// Option returns the option with the given ID.
+func (p Poll) Option(optionID string) (Option, bool) {
+	for _, o := range p.Options {
+		if o.ID == optionID {
+			return o, true
+		}
+	}
+	return Option{}, false
+}
+
+// Close ends the poll now; results stay readable.
+func (p Poll) Close() (Poll, error) {
+	const op = "Poll.Close"
+
+	if p.IsClosed() {
+		return p, &kernel.Error{Code: kernel.EConflict, Message: MPollAlreadyClosed, Operation: op}
+	}
+
+	now := p.Clock.Now()
+	updated := p
+	updated.ClosesAt = &now
+	updated.UpdatedAt = now
+	return updated, nil
+}
+
+// Vote is one voter's answer. Voters are known by a key, never by their raw token.
+type Vote struct {
+	PollID   kernel.ID[Poll]
+	OptionID string
+	VoterKey string
+	CastAt   time.Time
+}
+
+// Ballot is who is voting: a signed-in reader, or an anonymous voter carrying an
+// opaque token (a browser cookie or a newsletter recipient token).
+type Ballot struct {
+	User  *user.User
+	Token string
+}
+
+// VoterKey identifies the voter for deduplication. Anonymous tokens are hashed so
+// they cannot be read back from stored votes.
+func (b Ballot) VoterKey() string {
+	if b.User != nil {
+		return "user:" + b.User.ID.String()
+	}
+	sum := sha256.Sum256([]byte(b.Token))
+	return "anon:" + hex.EncodeToString(sum[:])
+}
+
+// Tally is the count of one option.
+type Tally struct {
+	Option  Option
+	Votes   int
+	Percent int // Share of all votes, rounded
+}
+
+// Results are a poll's tallies, in option order.
+type Results struct {
+	PollID  kernel.ID[Poll]
+	Total   int
+	Tallies []Tally
+	Closed  bool
+}
+
+// Tallies turns vote counts by option ID into results; options without votes count zero.
+func (p Poll) Tallies(counts map[string]int) Results {
+	results := Results{PollID: p.PollID, Closed: p.IsClosed()}
+	for _, o := range p.Options {
+		results.Total += counts[o.ID]
+	}
+
+	for _, o := range p.Options {
+		tally := Tally{Option: o, Votes: counts[o.ID]}
+		if results.Total > 0 {
+			tally.Percent = int(math.Round(float64(tally.Votes) * 100 / float64(results.Total)))
+		}
+		results.Tallies = append(results.Tallies, tally)
+	}
+
+	return results
+}
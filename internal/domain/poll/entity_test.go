@@ -0,0 +1,108 @@
+package poll_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/poll"
+)
+
+func validParams(clock kernel.Clock) poll.NewPollParams {
+	return poll.NewPollParams{
+		PollID:    "poll-1",
+		Question:  "Quel thème pour la prochaine leçon ?",
+		Options:   []string{"Au marché", "À la gare", "Chez le médecin"},
+		Target:    poll.Target{Kind: poll.TargetPost, ID: "lesson-1"},
+		CreatedBy: "editor",
+		Clock:     clock,
+	}
+}
+
+func TestNewPoll(t *testing.T) {
+	now := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	clock := &stubClock{t: now}
+
+	t.Run("numbers options and opens now by default", func(t *testing.T) {
+		got, err := poll.NewPoll(validParams(clock))
+
+		assertNoError(t, err)
+		if got.Options[2].ID != "3" || got.Mode != poll.ModeAnonymous || !got.OpensAt.Equal(now) {
+			t.Errorf("got %+v", got)
+		}
+		if !got.IsOpen() {
+			t.Error("poll should be open")
+		}
+	})
+
+	earlier := now.Add(-time.Hour)
+	tests := []struct {
+		name   string
+		modify func(*poll.NewPollParams)
+	}{
+		{name: "one option", modify: func(p *poll.NewPollParams) { p.Options = p.Options[:1] }},
+		{name: "duplicate options", modify: func(p *poll.NewPollParams) { p.Options = []string{"Oui", "oui"} }},
+		{name: "blank option", modify: func(p *poll.NewPollParams) { p.Options = []string{"Oui", " "} }},
+		{name: "short question", modify: func(p *poll.NewPollParams) { p.Question = "Oui?" }},
+		{name: "unknown target", modify: func(p *poll.NewPollParams) { p.Target.Kind = "page" }},
+		{name: "missing target ID", modify: func(p *poll.NewPollParams) { p.Target.ID = "" }},
+		{name: "unknown mode", modify: func(p *poll.NewPollParams) { p.Mode = "secret" }},
+		{name: "closes before it opens", modify: func(p *poll.NewPollParams) { p.ClosesAt = &earlier }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params := validParams(clock)
+			tt.modify(&params)
+
+			_, err := poll.NewPoll(params)
+
+			assertErrorCode(t, err, kernel.EInvalid)
+		})
+	}
+}
+
+func TestPoll_Window(t *testing.T) {
+	now := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	clock := &stubClock{t: now}
+	params := validParams(clock)
+	params.OpensAt = now.Add(time.Hour)
+	closesAt := now.Add(2 * time.Hour)
+	params.ClosesAt = &closesAt
+	p, err := poll.NewPoll(params)
+	assertNoError(t, err)
+
+	for _, tc := range []struct {
+		at           time.Time
+		open, closed bool
+	}{
+		{at: now, open: false, closed: false},
+		{at: now.Add(time.Hour), open: true, closed: false},
+		{at: closesAt, open: false, closed: true},
+	} {
+		clock.t = tc.at
+		if p.IsOpen() != tc.open || p.IsClosed() != tc.closed {
+			t.Errorf("at %v: got open %t closed %t", tc.at, p.IsOpen(), p.IsClosed())
+		}
+	}
+
+	_, err = p.Close()
+	assertErrorCode(t, err, kernel.EConflict)
+}
+
+func TestPoll_Tallies(t *testing.T) {
+	p, err := poll.NewPoll(validParams(&stubClock{t: time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)}))
+	assertNoError(t, err)
+
+	got := p.Tallies(map[string]int{"1": 2, "3": 1, "stale": 4})
+
+	if got.Total != 3 {
+		t.Errorf("total: got %d, want 3", got.Total)
+	}
+	want := []int{67, 0, 33}
+	for i, tally := range got.Tallies {
+		if tally.Percent != want[i] {
+			t.Errorf("option %s: got %d%%, want %d%%", tally.Option.ID, tally.Percent, want[i])
+		}
+	}
+}
@@ -0,0 +1,44 @@
+package poll
+
+import (
+	"github.com/alnah/fla/internal/domain/kernel"
+)
+
+// PollReader retrieves polls.
+type PollReader interface {
+	// GetByID returns a poll. Returns ENotFound when missing.
+	GetByID(pollID kernel.ID[Poll]) (*Poll, error)
+
+	// GetByTarget lists the polls attached to a post or newsletter issue, oldest first.
+	GetByTarget(target Target) ([]Poll, error)
+}
+
+// PollWriter persists polls.
+type PollWriter interface {
+	// Create stores a new poll.
+	Create(poll Poll) error
+
+	// Update saves changes to a poll, such as closing it.
+	Update(poll Poll) error
+}
+
+// VoteStore records votes, at most one per voter and poll.
+type VoteStore interface {
+	// GetVote returns the voter's vote on a poll.
+	// Returns ENotFound when the voter has not voted.
+	GetVote(pollID kernel.ID[Poll], voterKey string) (*Vote, error)
+
+	// AddVote stores a vote; the service checks for duplicates first.
+	AddVote(vote Vote) error
+
+	// CountVotes returns the number of votes per option ID.
+	CountVotes(pollID kernel.ID[Poll]) (map[string]int, error)
+}
+
+// Repository combines all poll operations.
+// Most concrete implementations (like PostgresPollRepository) will implement this.
+type Repository interface {
+	PollReader
+	PollWriter
+	VoteStore
+}
@@ -0,0 +1,193 @@
+package poll
+
+import (
+	"fmt"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/policy"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+const (
+	MPollManageForbidden string = "Only editors and admins can manage polls."
+	MPollVoteForbidden   string = "Sign in to vote in this poll."
+	MPollAlreadyVoted    string = "You have already voted in this poll."
+)
+
+// Poll actions, checked against permissions.
+const (
+	ActionManage policy.Action = "poll.manage" // Create and close polls
+	ActionVote   policy.Action = "poll.vote"   // Vote as a signed-in reader
+)
+
+// KindPoll is the policy resource kind of polls.
+const KindPoll string = "poll"
+
+// rules are the poll permissions.
+// Anonymous votes need no permission: the poll's mode decides.
+var rules = []policy.Rule{
+	{
+		Name:    "editors manage polls",
+		Actions: []policy.Action{ActionManage},
+		Roles:   []string{user.RoleAdmin.String(), user.RoleEditor.String()},
+	},
+	{
+		Name:    "signed-in readers vote",
+		Actions: []policy.Action{ActionVote},
+		Roles:   []string{user.RoleAdmin.String(), user.RoleEditor.String(), user.RoleAuthor.String(), user.RoleSubscriber.String()},
+	},
+}
+
+// permissions is the poll policy, built once from rules.
+var permissions = policy.New(rules...)
+
+// PollService creates polls, takes votes, and tallies results.
+type PollService struct {
+	repository Repository
+	clock      kernel.Clock
+}
+
+// NewPollService creates poll service with poll and vote storage.
+func NewPollService(repository Repository, clock kernel.Clock) *PollService {
+	return &PollService{repository: repository, clock: clock}
+}
+
+// Create stores a new poll on behalf of an editor.
+func (s *PollService) Create(params NewPollParams, actor user.PostPermissionChecker) (Poll, error) {
+	const op = "PollService.Create"
+
+	if !allowed(actor, ActionManage) {
+		return Poll{}, &kernel.Error{Code: kernel.EForbidden, Message: MPollManageForbidden, Operation: op}
+	}
+
+	params.CreatedBy = actor.GetID()
+	params.Clock = s.clock
+	p, err := NewPoll(params)
+	if err != nil {
+		return Poll{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if err := s.repository.Create(p); err != nil {
+		return Poll{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return p, nil
+}
+
+// Close ends a poll ahead of its closing time, on behalf of an editor.
+func (s *PollService) Close(pollID kernel.ID[Poll], actor user.PostPermissionChecker) (Poll, error) {
+	const op = "PollService.Close"
+
+	if !allowed(actor, ActionManage) {
+		return Poll{}, &kernel.Error{Code: kernel.EForbidden, Message: MPollManageForbidden, Operation: op}
+	}
+
+	p, err := s.get(pollID)
+	if err != nil {
+		return Poll{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	closed, err := p.Close()
+	if err != nil {
+		return Poll{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if err := s.repository.Update(closed); err != nil {
+		return Poll{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return closed, nil
+}
+
+// Vote records the ballot's answer while the poll is open. Each voter votes once:
+// a second vote is a conflict, whatever the option.
+func (s *PollService) Vote(pollID kernel.ID[Poll], optionID string, ballot Ballot) (Vote, error) {
+	const op = "PollService.Vote"
+
+	p, err := s.get(pollID)
+	if err != nil {
+		return Vote{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	switch {
+	case ballot.User != nil && !allowed(*ballot.User, ActionVote):
+		return Vote{}, &kernel.Error{Code: kernel.EForbidden, Message: MPollVoteForbidden, Operation: op}
+	case ballot.User == nil && p.Mode == ModeAuthenticated:
+		return Vote{}, &kernel.Error{Code: kernel.EForbidden, Message: MPollVoteForbidden, Operation: op}
+	case ballot.User == nil && ballot.Token == "":
+		return Vote{}, &kernel.Error{Code: kernel.EInvalid, Message: MPollVoterMissing, Operation: op}
+	}
+
+	if !p.IsOpen() {
+		return Vote{}, &kernel.Error{Code: kernel.EInvalid, Message: MPollNotOpen, Operation: op}
+	}
+	if _, ok := p.Option(optionID); !ok {
+		return Vote{}, &kernel.Error{Code: kernel.EInvalid, Message: fmt.Sprintf(MPollOptionUnknown, optionID), Operation: op}
+	}
+
+	vote := Vote{PollID: pollID, OptionID: optionID, VoterKey: ballot.VoterKey()}
+
+	_, err = s.repository.GetVote(pollID, vote.VoterKey)
+	if err == nil {
+		return Vote{}, &kernel.Error{Code: kernel.EConflict, Message: MPollAlreadyVoted, Operation: op}
+	}
+	if kernel.ErrorCode(err) != kernel.ENotFound {
+		return Vote{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	vote.CastAt = s.clock.Now()
+	if err := s.repository.AddVote(vote); err != nil {
+		return Vote{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return vote, nil
+}
+
+// Results tallies the votes of a poll, open or closed.
+func (s *PollService) Results(pollID kernel.ID[Poll]) (Results, error) {
+	const op = "PollService.Results"
+
+	p, err := s.get(pollID)
+	if err != nil {
+		return Results{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	counts, err := s.repository.CountVotes(pollID)
+	if err != nil {
+		return Results{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return p.Tallies(counts), nil
+}
+
+// ForTarget lists the polls attached to a post or newsletter issue.
+func (s *PollService) ForTarget(target Target) ([]Poll, error) {
+	const op = "PollService.ForTarget"
+
+	if err := target.Validate(); err != nil {
+		return nil, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	polls, err := s.repository.GetByTarget(target)
+	if err != nil {
+		return nil, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	for i := range polls {
+		polls[i].Clock = s.clock
+	}
+	return polls, nil
+}
+
+func (s *PollService) get(pollID kernel.ID[Poll]) (Poll, error) {
+	p, err := s.repository.GetByID(pollID)
+	if err != nil {
+		return Poll{}, err
+	}
+	p.Clock = s.clock
+	return *p, nil
+}
+
+func allowed(actor user.PostPermissionChecker, action policy.Action) bool {
+	return permissions.Authorize(user.ActorOf(actor), action, policy.Resource{Kind: KindPoll}).Allowed
+}
@@ -0,0 +1,172 @@
+package poll_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/poll"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+type stubRepository struct {
+	polls map[kernel.ID[poll.Poll]]poll.Poll
+	votes map[string]poll.Vote
+}
+
+func newStubRepository() *stubRepository {
+	return &stubRepository{polls: make(map[kernel.ID[poll.Poll]]poll.Poll), votes: make(map[string]poll.Vote)}
+}
+
+func (s *stubRepository) GetByID(pollID kernel.ID[poll.Poll]) (*poll.Poll, error) {
+	p, ok := s.polls[pollID]
+	if !ok {
+		return nil, &kernel.Error{Code: kernel.ENotFound, Message: "no poll"}
+	}
+	return &p, nil
+}
+
+func (s *stubRepository) GetByTarget(target poll.Target) ([]poll.Poll, error) {
+	var polls []poll.Poll
+	for _, p := range s.polls {
+		if p.Target == target {
+			polls = append(polls, p)
+		}
+	}
+	return polls, nil
+}
+
+func (s *stubRepository) Create(p poll.Poll) error { s.polls[p.PollID] = p; return nil }
+func (s *stubRepository) Update(p poll.Poll) error { s.polls[p.PollID] = p; return nil }
+
+func (s *stubRepository) GetVote(pollID kernel.ID[poll.Poll], voterKey string) (*poll.Vote, error) {
+	v, ok := s.votes[pollID.String()+"/"+voterKey]
+	if !ok {
+		return nil, &kernel.Error{Code: kernel.ENotFound, Message: "no vote"}
+	}
+	return &v, nil
+}
+
+func (s *stubRepository) AddVote(v poll.Vote) error {
+	s.votes[v.PollID.String()+"/"+v.VoterKey] = v
+	return nil
+}
+
+func (s *stubRepository) CountVotes(pollID kernel.ID[poll.Poll]) (map[string]int, error) {
+	counts := make(map[string]int)
+	for _, v := range s.votes {
+		if v.PollID == pollID {
+			counts[v.OptionID]++
+		}
+	}
+	return counts, nil
+}
+
+var (
+	editor     = user.User{ID: "editor", Roles: []user.Role{user.RoleEditor}, Status: user.AccountStatusActive}
+	subscriber = user.User{ID: "reader", Roles: []user.Role{user.RoleSubscriber}, Status: user.AccountStatusActive}
+	visitor    = user.User{ID: "visitor", Roles: []user.Role{user.RoleVisitor}, Status: user.AccountStatusActive}
+)
+
+func newService(t *testing.T, mode poll.Mode) (*poll.PollService, *stubClock) {
+	t.Helper()
+
+	clock := &stubClock{t: time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)}
+	service := poll.NewPollService(newStubRepository(), clock)
+
+	params := validParams(clock)
+	params.Mode = mode
+	_, err := service.Create(params, editor)
+	assertNoError(t, err)
+
+	return service, clock
+}
+
+func TestPollService_Create(t *testing.T) {
+	service := poll.NewPollService(newStubRepository(), &stubClock{})
+
+	_, err := service.Create(validParams(&stubClock{}), subscriber)
+
+	assertErrorCode(t, err, kernel.EForbidden)
+}
+
+func TestPollService_Vote(t *testing.T) {
+	t.Run("counts one vote per anonymous token", func(t *testing.T) {
+		service, _ := newService(t, poll.ModeAnonymous)
+
+		_, err := service.Vote("poll-1", "2", poll.Ballot{Token: "cookie-a"})
+		assertNoError(t, err)
+		_, err = service.Vote("poll-1", "1", poll.Ballot{Token: "cookie-a"})
+		assertErrorCode(t, err, kernel.EConflict)
+		_, err = service.Vote("poll-1", "2", poll.Ballot{Token: "cookie-b"})
+		assertNoError(t, err)
+
+		got, err := service.Results("poll-1")
+		assertNoError(t, err)
+		if got.Total != 2 || got.Tallies[1].Votes != 2 {
+			t.Errorf("got %+v, want 2 votes for option 2", got)
+		}
+	})
+
+	t.Run("stores a hash, not the token", func(t *testing.T) {
+		service, _ := newService(t, poll.ModeAnonymous)
+
+		got, err := service.Vote("poll-1", "1", poll.Ballot{Token: "cookie-a"})
+
+		assertNoError(t, err)
+		if got.VoterKey == "anon:cookie-a" || len(got.VoterKey) != len("anon:")+64 {
+			t.Errorf("got voter key %q", got.VoterKey)
+		}
+	})
+
+	t.Run("counts one vote per account", func(t *testing.T) {
+		service, _ := newService(t, poll.ModeAuthenticated)
+
+		_, err := service.Vote("poll-1", "1", poll.Ballot{User: &subscriber, Token: "cookie-a"})
+		assertNoError(t, err)
+		_, err = service.Vote("poll-1", "1", poll.Ballot{User: &subscriber, Token: "cookie-b"})
+		assertErrorCode(t, err, kernel.EConflict)
+	})
+
+	tests := []struct {
+		name   string
+		mode   poll.Mode
+		option string
+		ballot poll.Ballot
+		later  time.Duration
+		want   string
+	}{
+		{name: "anonymous ballot in an authenticated poll", mode: poll.ModeAuthenticated, option: "1", ballot: poll.Ballot{Token: "cookie"}, want: kernel.EForbidden},
+		{name: "visitors cannot vote as users", mode: poll.ModeAnonymous, option: "1", ballot: poll.Ballot{User: &visitor}, want: kernel.EForbidden},
+		{name: "anonymous ballot without token", mode: poll.ModeAnonymous, option: "1", ballot: poll.Ballot{}, want: kernel.EInvalid},
+		{name: "unknown option", mode: poll.ModeAnonymous, option: "9", ballot: poll.Ballot{Token: "cookie"}, want: kernel.EInvalid},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, _ := newService(t, tt.mode)
+
+			_, err := service.Vote("poll-1", tt.option, tt.ballot)
+
+			assertErrorCode(t, err, tt.want)
+		})
+	}
+
+	t.Run("closed polls take no votes but keep results", func(t *testing.T) {
+		service, clock := newService(t, poll.ModeAnonymous)
+		_, err := service.Vote("poll-1", "1", poll.Ballot{Token: "cookie-a"})
+		assertNoError(t, err)
+
+		_, err = service.Close("poll-1", editor)
+		assertNoError(t, err)
+		clock.t = clock.t.Add(time.Minute)
+
+		_, err = service.Vote("poll-1", "1", poll.Ballot{Token: "cookie-b"})
+		assertErrorCode(t, err, kernel.EInvalid)
+		got, err := service.Results("poll-1")
+		assertNoError(t, err)
+		if !got.Closed || got.Total != 1 {
+			t.Errorf("got %+v, want closed with 1 vote", got)
+		}
+	})
+}
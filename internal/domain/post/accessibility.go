@@ -0,0 +1,310 @@
+package post
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+)
+
+// MinContrastRatio is the WCAG AA contrast ratio body text needs against its background.
+const MinContrastRatio float64 = 4.5
+
+const (
+	MPreflightHeadingLevelSkipped string = "Heading %q jumps from level %d to %d; screen reader users navigate by heading levels."
+	MPreflightLinkTextVague       string = "Link text %q does not say where the link goes."
+	MPreflightContrastLow         string = "Text styled %q has a contrast ratio of %.1f:1, below %.1f:1."
+	MAccessibilityRuleUnknown     string = "Unknown accessibility rule: %s."
+	MSeverityInvalid              string = "Invalid severity: %s."
+)
+
+const (
+	FindingHeadingLevelSkipped FindingCode = "heading_level_skipped"
+	FindingLinkTextVague       FindingCode = "link_text_vague"
+	FindingContrastLow         FindingCode = "contrast_low"
+)
+
+// AccessibilityRules lists the findings of the accessibility audit.
+var AccessibilityRules = []FindingCode{
+	FindingImageAltMissing,
+	FindingHeadingLevelSkipped,
+	FindingLinkTextVague,
+	FindingContrastLow,
+}
+
+// AccessibilityPolicy sets the severity of each accessibility rule. Rules left
+// out are not checked.
+type AccessibilityPolicy map[FindingCode]Severity
+
+// DefaultAccessibilityPolicy blocks publication on images without alt text,
+// which screen readers cannot describe at all, and warns about the rest.
+func DefaultAccessibilityPolicy() AccessibilityPolicy {
+	return AccessibilityPolicy{
+		FindingImageAltMissing:     SeverityError,
+		FindingHeadingLevelSkipped: SeverityWarning,
+		FindingLinkTextVague:       SeverityWarning,
+		FindingContrastLow:         SeverityWarning,
+	}
+}
+
+// Validate ensures every rule is an accessibility rule with a known severity.
+func (p AccessibilityPolicy) Validate() error {
+	const op = "AccessibilityPolicy.Validate"
+
+	for code, severity := range p {
+		if !slices.Contains(AccessibilityRules, code) {
+			return &kernel.Error{Code: kernel.EInvalid, Message: fmt.Sprintf(MAccessibilityRuleUnknown, code), Operation: op}
+		}
+		if severity != SeverityError && severity != SeverityWarning {
+			return &kernel.Error{Code: kernel.EInvalid, Message: fmt.Sprintf(MSeverityInvalid, severity), Operation: op}
+		}
+	}
+	return nil
+}
+
+// AccessibilityIssue is one accessibility problem in post content.
+type AccessibilityIssue struct {
+	Code     FindingCode
+	Severity Severity
+	Line     int // 1-based line of the content
+	Message  string
+}
+
+// AccessibilityReport lists the accessibility issues of a post, in content order.
+type AccessibilityReport struct {
+	Issues []AccessibilityIssue
+}
+
+// Passed returns true when no issue blocks publication.
+func (r AccessibilityReport) Passed() bool {
+	for _, issue := range r.Issues {
+		if issue.Severity == SeverityError {
+			return false
+		}
+	}
+	return true
+}
+
+// Findings returns the issues as preflight findings.
+func (r AccessibilityReport) Findings() []Finding {
+	findings := make([]Finding, len(r.Issues))
+	for i, issue := range r.Issues {
+		findings[i] = Finding{Code: issue.Code, Severity: issue.Severity, Message: issue.Message}
+	}
+	return findings
+}
+
+// vagueLinkTexts are link texts that only make sense next to the surrounding
+// sentence, which screen reader users listing links do not hear.
+var vagueLinkTexts = []string{
+	"click here", "here", "read more", "more", "link", "this link",
+	"cliquez ici", "cliquer ici", "ici", "en savoir plus", "lire la suite", "ce lien", "lien",
+	"clique aqui", "aqui", "saiba mais", "leia mais", "este link",
+}
+
+var (
+	headingPattern   = regexp.MustCompile(`^(#{1,6})\s+(.+?)\s*#*\s*$`)
+	htmlLinkPattern  = regexp.MustCompile(`(?i)<a\s[^>]*>(.*?)</a>`)
+	htmlImgPattern   = regexp.MustCompile(`(?i)<img\s[^>]*>`)
+	altAttrPattern   = regexp.MustCompile(`(?i)\salt\s*=`)
+	srcAttrPattern   = regexp.MustCompile(`(?i)\ssrc\s*=\s*["']([^"']*)["']`)
+	styleAttrPattern = regexp.MustCompile(`(?i)\sstyle\s*=\s*"([^"]*)"|\sstyle\s*=\s*'([^']*)'`)
+)
+
+// AccessibilityService audits post content for readers using screen readers
+// or with low vision.
+type AccessibilityService struct {
+	policy AccessibilityPolicy
+}
+
+// NewAccessibilityService creates accessibility service checking the rules of the policy.
+func NewAccessibilityService(policy AccessibilityPolicy) (*AccessibilityService, error) {
+	const op = "NewAccessibilityService"
+
+	if err := policy.Validate(); err != nil {
+		return nil, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return &AccessibilityService{policy: policy}, nil
+}
+
+// Audit scans the post's Markdown and inline HTML for images without alt
+// text, headings skipping levels, vague link texts, and inline styles with
+// low contrast. The post title is the page's level 1 heading, so content
+// headings start at level 2. Fenced code blocks are skipped.
+func (s *AccessibilityService) Audit(p Post) AccessibilityReport {
+	var report AccessibilityReport
+	add := func(code FindingCode, line int, message string) {
+		if severity, ok := s.policy[code]; ok {
+			report.Issues = append(report.Issues, AccessibilityIssue{Code: code, Severity: severity, Line: line, Message: message})
+		}
+	}
+
+	level, inFence := 1, false
+	for i, line := range strings.Split(p.Content.String(), "\n") {
+		n := i + 1
+		if trimmed := strings.TrimSpace(line); strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~") {
+			inFence = !inFence
+			continue
+		}
+		if inFence {
+			continue
+		}
+
+		if m := headingPattern.FindStringSubmatch(line); m != nil {
+			next := len(m[1])
+			if next > level+1 {
+				add(FindingHeadingLevelSkipped, n, fmt.Sprintf(MPreflightHeadingLevelSkipped, m[2], level, next))
+			}
+			level = next
+		}
+
+		for _, image := range markdownImagePattern.FindAllStringSubmatch(line, -1) {
+			if strings.TrimSpace(image[1]) == "" {
+				add(FindingImageAltMissing, n, fmt.Sprintf(MPreflightImageAltMissing, image[2]))
+			}
+		}
+		for _, tag := range htmlImgPattern.FindAllString(line, -1) {
+			// alt="" is how HTML marks decorative images, so only a missing attribute is reported
+			if !altAttrPattern.MatchString(tag) {
+				src := ""
+				if m := srcAttrPattern.FindStringSubmatch(tag); m != nil {
+					src = m[1]
+				}
+				add(FindingImageAltMissing, n, fmt.Sprintf(MPreflightImageAltMissing, src))
+			}
+		}
+
+		var texts []string
+		for _, link := range markdownLinkPattern.FindAllStringSubmatch(line, -1) {
+			if link[1] != "!" {
+				texts = append(texts, link[2])
+			}
+		}
+		for _, link := range htmlLinkPattern.FindAllStringSubmatch(line, -1) {
+			texts = append(texts, link[1])
+		}
+		for _, text := range texts {
+			if isVagueLinkText(text) {
+				add(FindingLinkTextVague, n, fmt.Sprintf(MPreflightLinkTextVague, strings.TrimSpace(text)))
+			}
+		}
+
+		for _, m := range styleAttrPattern.FindAllStringSubmatch(line, -1) {
+			style := m[1] + m[2]
+			if ratio, ok := styleContrast(style); ok && ratio < MinContrastRatio {
+				add(FindingContrastLow, n, fmt.Sprintf(MPreflightContrastLow, style, math.Floor(ratio*10)/10, MinContrastRatio))
+			}
+		}
+	}
+
+	return report
+}
+
+func isVagueLinkText(text string) bool {
+	return slices.Contains(vagueLinkTexts, strings.ToLower(strings.Trim(text, ".,;:!?…*_ ")))
+}
+
+// rgb is a color as 0-255 channels.
+type rgb [3]int
+
+var (
+	defaultTextColor       = rgb{0, 0, 0}
+	defaultBackgroundColor = rgb{255, 255, 255}
+)
+
+// styleContrast returns the contrast ratio of an inline style's text and
+// background colors, the other one taken as the site default (black text on
+// white). Styles setting neither, or colors it cannot read, report false.
+func styleContrast(style string) (float64, bool) {
+	text, background := defaultTextColor, defaultBackgroundColor
+	set := false
+
+	for _, declaration := range strings.Split(style, ";") {
+		property, value, ok := strings.Cut(declaration, ":")
+		if !ok {
+			continue
+		}
+		color, ok := parseColor(strings.TrimSpace(value))
+		switch strings.ToLower(strings.TrimSpace(property)) {
+		case "color":
+			if !ok {
+				return 0, false
+			}
+			text, set = color, true
+		case "background-color", "background":
+			if !ok {
+				return 0, false
+			}
+			background, set = color, true
+		}
+	}
+
+	if !set {
+		return 0, false
+	}
+	return contrastRatio(text, background), true
+}
+
+// parseColor reads #rgb, #rrggbb, and rgb(r, g, b) colors, and black or white.
+func parseColor(value string) (rgb, bool) {
+	value = strings.ToLower(strings.TrimSuffix(strings.TrimSpace(value), "!important"))
+	value = strings.TrimSpace(value)
+
+	switch {
+	case value == "black":
+		return rgb{0, 0, 0}, true
+	case value == "white":
+		return rgb{255, 255, 255}, true
+	case strings.HasPrefix(value, "#") && len(value) == 4:
+		value = "#" + strings.Repeat(value[1:2], 2) + strings.Repeat(value[2:3], 2) + strings.Repeat(value[3:4], 2)
+		fallthrough
+	case strings.HasPrefix(value, "#") && len(value) == 7:
+		n, err := strconv.ParseUint(value[1:], 16, 32)
+		if err != nil {
+			return rgb{}, false
+		}
+		return rgb{int(n >> 16 & 0xff), int(n >> 8 & 0xff), int(n & 0xff)}, true
+	case strings.HasPrefix(value, "rgb(") && strings.HasSuffix(value, ")"):
+		parts := strings.Split(value[len("rgb("):len(value)-1], ",")
+		if len(parts) != 3 {
+			return rgb{}, false
+		}
+		var c rgb
+		for i, part := range parts {
+			n, err := strconv.Atoi(strings.TrimSpace(part))
+			if err != nil || n < 0 || n > 255 {
+				return rgb{}, false
+			}
+			c[i] = n
+		}
+		return c, true
+	default:
+		return rgb{}, false
+	}
+}
+
+// contrastRatio is the WCAG contrast ratio of two colors, from 1 to 21.
+func contrastRatio(a, b rgb) float64 {
+	la, lb := luminance(a), luminance(b)
+	if la < lb {
+		la, lb = lb, la
+	}
+	return (la + 0.05) / (lb + 0.05)
+}
+
+// luminance is the WCAG relative luminance of a color.
+func luminance(c rgb) float64 {
+	channel := func(v int) float64 {
+		s := float64(v) / 255
+		if s <= 0.03928 {
+			return s / 12.92
+		}
+		return math.Pow((s+0.055)/1.055, 2.4)
+	}
+	return 0.2126*channel(c[0]) + 0.7152*channel(c[1]) + 0.0722*channel(c[2])
+}
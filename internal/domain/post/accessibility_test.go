@@ -0,0 +1,75 @@
+package post_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/post"
+)
+
+func TestAccessibilityService_Audit(t *testing.T) {
+	service, err := post.NewAccessibilityService(post.DefaultAccessibilityPolicy())
+	assertNoError(t, err)
+
+	type issue struct {
+		code post.FindingCode
+		line int
+	}
+	audit := func(content string) []issue {
+		report := service.Audit(post.Post{Content: post.PostContent(content)})
+		got := make([]issue, len(report.Issues))
+		for i, is := range report.Issues {
+			got[i] = issue{is.Code, is.Line}
+		}
+		return got
+	}
+
+	tests := []struct {
+		name    string
+		content string
+		want    []issue
+	}{
+		{"accessible content", "## Au marché\n\n### Les fruits\n\n![Un étal de pommes](https://cdn.example/pommes.jpg)\n\nVoir [la leçon sur les légumes](/a1/legumes).", []issue{}},
+		{"markdown image without alt", "Texte\n![](https://cdn.example/a.jpg)", []issue{{post.FindingImageAltMissing, 2}}},
+		{"html image without alt", `<img src="https://cdn.example/a.jpg">`, []issue{{post.FindingImageAltMissing, 1}}},
+		{"decorative html image", `<img src="https://cdn.example/a.jpg" alt="">`, []issue{}},
+		{"content starting below level 2", "#### Détails", []issue{{post.FindingHeadingLevelSkipped, 1}}},
+		{"skipped heading level", "## Verbes\n\n#### Exceptions\n\n## Noms", []issue{{post.FindingHeadingLevelSkipped, 3}}},
+		{"vague link texts", "[Cliquez ici](https://a.example) ou [read more…](/b) ou <a href=\"/c\">aqui</a>", []issue{
+			{post.FindingLinkTextVague, 1}, {post.FindingLinkTextVague, 1}, {post.FindingLinkTextVague, 1},
+		}},
+		{"low contrast", `<span style="color: #aaa">pâle</span> <span style="color:#fff;background-color:#000">net</span>`, []issue{{post.FindingContrastLow, 1}}},
+		{"low contrast on background", `<p style='background: rgb(40, 40, 40)'>sombre</p>`, []issue{{post.FindingContrastLow, 1}}},
+		{"code blocks", "```html\n<img src=\"a.jpg\">\n# titre\n```", []issue{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := audit(tt.content); !slices.Equal(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("applies the policy's severities", func(t *testing.T) {
+		strict, err := post.NewAccessibilityService(post.AccessibilityPolicy{post.FindingLinkTextVague: post.SeverityError})
+		assertNoError(t, err)
+
+		report := strict.Audit(post.Post{Content: "[ici](/a) ![](/b.jpg)"})
+
+		if len(report.Issues) != 1 || report.Issues[0].Severity != post.SeverityError || report.Passed() {
+			t.Errorf("got %+v", report.Issues)
+		}
+	})
+
+	t.Run("rejects invalid policies", func(t *testing.T) {
+		for _, policy := range []post.AccessibilityPolicy{
+			{post.FindingContentTooLong: post.SeverityWarning},
+			{post.FindingContrastLow: "info"},
+		} {
+			_, err := post.NewAccessibilityService(policy)
+			assertErrorCode(t, err, kernel.EInvalid)
+		}
+	})
+}
@@ -0,0 +1,207 @@
+package post
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/shared"
+)
+
+const (
+	MArchiveYearInvalid  string = "Archive year must be between %d and %d."
+	MArchiveMonthInvalid string = "Archive month must be between 1 and 12."
+	MArchivePathInvalid  string = "Invalid archive path: %s."
+)
+
+const (
+	MinArchiveYear = 1970
+	MaxArchiveYear = 9999
+)
+
+// ArchivePeriod is a calendar year, or one month of it, in UTC.
+// A zero Month means the whole year.
+type ArchivePeriod struct {
+	Year  int
+	Month time.Month
+}
+
+// NewArchivePeriod creates a validated archive period; pass month 0 for a whole year.
+func NewArchivePeriod(year int, month time.Month) (ArchivePeriod, error) {
+	const op = "NewArchivePeriod"
+
+	period := ArchivePeriod{Year: year, Month: month}
+	if err := period.Validate(); err != nil {
+		return ArchivePeriod{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return period, nil
+}
+
+// ParseArchivePath reads archive URLs such as "/2024/" or "/2024/05/".
+// The trailing slash is optional; months must be two digits.
+func ParseArchivePath(path string) (ArchivePeriod, error) {
+	const op = "ParseArchivePath"
+
+	invalid := &kernel.Error{Code: kernel.EInvalid, Message: fmt.Sprintf(MArchivePathInvalid, path), Operation: op}
+
+	parts := strings.Split(strings.Trim(path, "/"), "/")
+	if len(parts) > 2 || len(parts[0]) != 4 || (len(parts) == 2 && len(parts[1]) != 2) {
+		return ArchivePeriod{}, invalid
+	}
+
+	year, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return ArchivePeriod{}, invalid
+	}
+
+	var month int
+	if len(parts) == 2 {
+		if month, err = strconv.Atoi(parts[1]); err != nil {
+			return ArchivePeriod{}, invalid
+		}
+	}
+
+	period, err := NewArchivePeriod(year, time.Month(month))
+	if err != nil {
+		return ArchivePeriod{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return period, nil
+}
+
+// Validate ensures the year is plausible and the month, if set, exists.
+func (p ArchivePeriod) Validate() error {
+	const op = "ArchivePeriod.Validate"
+
+	if p.Year < MinArchiveYear || p.Year > MaxArchiveYear {
+		return &kernel.Error{
+			Code:      kernel.EInvalid,
+			Message:   fmt.Sprintf(MArchiveYearInvalid, MinArchiveYear, MaxArchiveYear),
+			Operation: op,
+		}
+	}
+
+	if p.Month < 0 || p.Month > time.December {
+		return &kernel.Error{Code: kernel.EInvalid, Message: MArchiveMonthInvalid, Operation: op}
+	}
+
+	return nil
+}
+
+// IsYear reports whether the period covers a whole year.
+func (p ArchivePeriod) IsYear() bool {
+	return p.Month == 0
+}
+
+// Range returns the half-open UTC interval the period covers.
+func (p ArchivePeriod) Range() DateRange {
+	if p.IsYear() {
+		from := time.Date(p.Year, time.January, 1, 0, 0, 0, 0, time.UTC)
+		return DateRange{From: from, To: from.AddDate(1, 0, 0)}
+	}
+	from := time.Date(p.Year, p.Month, 1, 0, 0, 0, 0, time.UTC)
+	return DateRange{From: from, To: from.AddDate(0, 1, 0)}
+}
+
+// Path returns the archive URL path, e.g. "/2024/" or "/2024/05/".
+func (p ArchivePeriod) Path() string {
+	if p.IsYear() {
+		return fmt.Sprintf("/%04d/", p.Year)
+	}
+	return fmt.Sprintf("/%04d/%02d/", p.Year, int(p.Month))
+}
+
+// String returns "2024" or "2024-05".
+func (p ArchivePeriod) String() string {
+	if p.IsYear() {
+		return fmt.Sprintf("%04d", p.Year)
+	}
+	return fmt.Sprintf("%04d-%02d", p.Year, int(p.Month))
+}
+
+// Compare orders periods chronologically; a year sorts before its months.
+func (p ArchivePeriod) Compare(other ArchivePeriod) int {
+	return cmp.Or(cmp.Compare(p.Year, other.Year), cmp.Compare(p.Month, other.Month))
+}
+
+// ArchiveCount is the number of published posts in one period.
+type ArchiveCount struct {
+	Period ArchivePeriod
+	Posts  int
+}
+
+// ArchiveYear groups the months of one year that have published posts.
+type ArchiveYear struct {
+	ArchiveCount                // Whole-year total
+	Months       []ArchiveCount // Newest month first
+}
+
+// ArchiveRepository provides the date-bucketed queries archive pages need.
+type ArchiveRepository interface {
+	PostArchiver
+	PostFinder
+}
+
+// ArchiveService builds year/month archive navigation and listings.
+type ArchiveService struct {
+	posts ArchiveRepository
+}
+
+// NewArchiveService creates archive service with a post repository.
+func NewArchiveService(posts ArchiveRepository) *ArchiveService {
+	return &ArchiveService{posts: posts}
+}
+
+// Years returns the archive tree, newest year first; empty periods are omitted.
+func (s *ArchiveService) Years() ([]ArchiveYear, error) {
+	const op = "ArchiveService.Years"
+
+	counts, err := s.posts.CountPublishedByMonth()
+	if err != nil {
+		return nil, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	slices.SortFunc(counts, func(a, b ArchiveCount) int { return b.Period.Compare(a.Period) })
+
+	var years []ArchiveYear
+	for _, c := range counts {
+		if c.Posts == 0 {
+			continue
+		}
+		if n := len(years); n == 0 || years[n-1].Period.Year != c.Period.Year {
+			years = append(years, ArchiveYear{ArchiveCount: ArchiveCount{Period: ArchivePeriod{Year: c.Period.Year}}})
+		}
+		y := &years[len(years)-1]
+		y.Posts += c.Posts
+		y.Months = append(y.Months, c)
+	}
+
+	return years, nil
+}
+
+// Posts lists the posts published within a period, newest first.
+func (s *ArchiveService) Posts(period ArchivePeriod, pagination shared.Pagination) (PostsList, error) {
+	const op = "ArchiveService.Posts"
+
+	if err := period.Validate(); err != nil {
+		return PostsList{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	r := period.Range()
+	query := PublishedQuery().PublishedIn(r.From, r.To).Page(pagination.Page, pagination.Limit)
+	if err := query.Validate(); err != nil {
+		return PostsList{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	list, err := s.posts.Find(query)
+	if err != nil {
+		return PostsList{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return list, nil
+}
@@ -0,0 +1,145 @@
+package post_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/post"
+	"github.com/alnah/fla/internal/domain/shared"
+)
+
+type stubArchive struct {
+	counts []post.ArchiveCount
+	found  post.Query
+	err    error
+}
+
+func (s *stubArchive) CountPublishedByMonth() ([]post.ArchiveCount, error) { return s.counts, s.err }
+
+func (s *stubArchive) Find(query post.Query) (post.PostsList, error) {
+	s.found = query
+	return post.PostsList{Pagination: query.Pagination}, s.err
+}
+
+func TestParseArchivePath(t *testing.T) {
+	tests := []struct {
+		path string
+		want post.ArchivePeriod
+	}{
+		{"/2024/", post.ArchivePeriod{Year: 2024}},
+		{"/2024/05/", post.ArchivePeriod{Year: 2024, Month: time.May}},
+		{"2024/12", post.ArchivePeriod{Year: 2024, Month: time.December}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			got, err := post.ParseArchivePath(tt.path)
+
+			assertNoError(t, err)
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	for _, path := range []string{"/", "/24/", "/2024/5/", "/2024/13/", "/2024/05/01/", "/abcd/", "/1900/"} {
+		t.Run("rejects "+path, func(t *testing.T) {
+			_, err := post.ParseArchivePath(path)
+
+			assertErrorCode(t, err, kernel.EInvalid)
+		})
+	}
+}
+
+func TestArchivePeriod(t *testing.T) {
+	month := post.ArchivePeriod{Year: 2024, Month: time.December}
+	year := post.ArchivePeriod{Year: 2024}
+
+	t.Run("path round-trips", func(t *testing.T) {
+		for _, p := range []post.ArchivePeriod{month, year} {
+			got, err := post.ParseArchivePath(p.Path())
+
+			assertNoError(t, err)
+			if got != p {
+				t.Errorf("got %v, want %v", got, p)
+			}
+		}
+	})
+
+	t.Run("month range crosses year end", func(t *testing.T) {
+		r := month.Range()
+
+		if !r.From.Equal(time.Date(2024, 12, 1, 0, 0, 0, 0, time.UTC)) || !r.To.Equal(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)) {
+			t.Errorf("got %v to %v", r.From, r.To)
+		}
+	})
+
+	t.Run("year range", func(t *testing.T) {
+		r := year.Range()
+
+		if !r.Contains(time.Date(2024, 12, 31, 23, 59, 0, 0, time.UTC)) || r.Contains(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)) {
+			t.Errorf("unexpected range %v to %v", r.From, r.To)
+		}
+	})
+}
+
+func TestArchiveService_Years(t *testing.T) {
+	repo := &stubArchive{counts: []post.ArchiveCount{
+		{Period: post.ArchivePeriod{Year: 2023, Month: time.November}, Posts: 2},
+		{Period: post.ArchivePeriod{Year: 2024, Month: time.January}, Posts: 1},
+		{Period: post.ArchivePeriod{Year: 2024, Month: time.May}, Posts: 4},
+		{Period: post.ArchivePeriod{Year: 2024, Month: time.March}, Posts: 0},
+	}}
+	service := post.NewArchiveService(repo)
+
+	years, err := service.Years()
+
+	assertNoError(t, err)
+	if len(years) != 2 {
+		t.Fatalf("got %d years, want 2", len(years))
+	}
+	if years[0].Period.Year != 2024 || years[0].Posts != 5 || len(years[0].Months) != 2 {
+		t.Errorf("2024: got %+v", years[0])
+	}
+	if years[0].Months[0].Period.Month != time.May {
+		t.Errorf("months should be newest first, got %v", years[0].Months[0].Period)
+	}
+	if years[1].Period.Year != 2023 || years[1].Posts != 2 {
+		t.Errorf("2023: got %+v", years[1])
+	}
+}
+
+func TestArchiveService_Posts(t *testing.T) {
+	repo := &stubArchive{}
+	service := post.NewArchiveService(repo)
+	period := post.ArchivePeriod{Year: 2024, Month: time.May}
+
+	t.Run("queries published posts within the period", func(t *testing.T) {
+		_, err := service.Posts(period, shared.Pagination{Page: 2, Limit: 10})
+
+		assertNoError(t, err)
+		q := repo.found
+		if len(q.Statuses) != 1 || q.Statuses[0] != post.StatusPublished {
+			t.Errorf("statuses: got %v", q.Statuses)
+		}
+		if q.PublishedBetween == nil || *q.PublishedBetween != period.Range() {
+			t.Errorf("range: got %v", q.PublishedBetween)
+		}
+		if q.Pagination.Page != 2 {
+			t.Errorf("page: got %d", q.Pagination.Page)
+		}
+	})
+
+	t.Run("rejects invalid period", func(t *testing.T) {
+		_, err := service.Posts(post.ArchivePeriod{Year: 2024, Month: 13}, shared.Pagination{Page: 1, Limit: 10})
+
+		assertErrorCode(t, err, kernel.EInvalid)
+	})
+
+	t.Run("rejects invalid pagination", func(t *testing.T) {
+		_, err := service.Posts(period, shared.Pagination{})
+
+		assertErrorCode(t, err, kernel.EInvalid)
+	})
+}
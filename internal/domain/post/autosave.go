@@ -0,0 +1,104 @@
+package post
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/shared"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+const (
+	MPostCannotEdit   string = "User cannot edit this post."
+	MPostEditConflict string = "This post was changed by someone else since you opened it."
+)
+
+// VersionInfo summarizes one version of a post for conflict resolution screens.
+type VersionInfo struct {
+	Title     shared.Title
+	WordCount int
+	UpdatedAt time.Time
+}
+
+// EditConflict describes a rejected save: the stored version moved on since the editor loaded it.
+// Returned as the cause of an EConflict error; retrieve it with ConflictFrom.
+type EditConflict struct {
+	Expected  time.Time   // UpdatedAt the editor based their changes on
+	Current   VersionInfo // Version currently stored
+	Attempted VersionInfo // Version the editor tried to save
+}
+
+// Error implements the error interface.
+func (c EditConflict) Error() string {
+	return fmt.Sprintf("edit conflict: expected version %s, current version %s",
+		c.Expected.Format(time.RFC3339Nano), c.Current.UpdatedAt.Format(time.RFC3339Nano))
+}
+
+// ConflictFrom extracts edit conflict details from an error chain.
+// Lets the editor show both versions side by side instead of a bare error.
+func ConflictFrom(err error) (EditConflict, bool) {
+	for err != nil {
+		switch e := err.(type) {
+		case EditConflict:
+			return e, true
+		case *kernel.Error:
+			err = e.Cause
+		default:
+			return EditConflict{}, false
+		}
+	}
+	return EditConflict{}, false
+}
+
+// UpdateContent saves new title and content using optimistic concurrency.
+// expectedUpdatedAt is the UpdatedAt the editor loaded; a mismatch means someone saved in between.
+func (p Post) UpdateContent(
+	title shared.Title,
+	content PostContent,
+	editor user.PostPermissionChecker,
+	expectedUpdatedAt time.Time,
+) (Post, error) {
+	const op = "Post.UpdateContent"
+
+	if !p.CanBeEditedBy(editor) {
+		return p, &kernel.Error{Code: kernel.EForbidden, Message: MPostCannotEdit, Operation: op}
+	}
+
+	if err := title.Validate(); err != nil {
+		return p, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if err := content.Validate(); err != nil {
+		return p, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	updatedPost := p
+	updatedPost.Title = title
+	updatedPost.Content = content
+
+	if !p.UpdatedAt.Equal(expectedUpdatedAt) {
+		return p, &kernel.Error{
+			Code:      kernel.EConflict,
+			Message:   MPostEditConflict,
+			Operation: op,
+			Cause: EditConflict{
+				Expected: expectedUpdatedAt,
+				Current: VersionInfo{
+					Title:     p.Title,
+					WordCount: p.WordCount(),
+					UpdatedAt: p.UpdatedAt,
+				},
+				Attempted: VersionInfo{
+					Title:     title,
+					WordCount: updatedPost.WordCount(),
+					UpdatedAt: expectedUpdatedAt,
+				},
+			},
+		}
+	}
+
+	updatedPost.UpdatedAt = p.Clock.Now()
+
+	return updatedPost, nil
+}
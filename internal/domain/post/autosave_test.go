@@ -0,0 +1,105 @@
+package post_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/post"
+	"github.com/alnah/fla/internal/domain/shared"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+func TestPost_UpdateContent(t *testing.T) {
+	loaded := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+	saved := loaded.Add(5 * time.Minute)
+	author := &mockUser{id: "user-123", roles: []user.Role{user.RoleAuthor}}
+
+	newDraft := func(t *testing.T) post.Post {
+		t.Helper()
+		clock := &mockClock{now: loaded}
+		title, _ := shared.NewTitle("Le passé composé")
+		content, _ := post.NewPostContent(strings.Repeat("J'ai mangé une pomme. ", 20))
+
+		p, err := post.NewPost(post.NewPostParams{
+			PostID:   "post-123",
+			Owner:    "user-123",
+			Title:    title,
+			Content:  content,
+			Status:   post.StatusDraft,
+			Category: createTestCategory(t, clock),
+			Clock:    clock,
+		})
+		assertNoError(t, err)
+		p.Clock = &mockClock{now: saved}
+		return p
+	}
+
+	newTitle, _ := shared.NewTitle("Le passé composé avec être")
+	newContent, _ := post.NewPostContent(strings.Repeat("Je suis allé au marché. ", 25))
+
+	t.Run("saves when the expected version matches", func(t *testing.T) {
+		p := newDraft(t)
+
+		got, err := p.UpdateContent(newTitle, newContent, author, loaded)
+
+		assertNoError(t, err)
+		if got.Title != newTitle || got.Content != newContent {
+			t.Error("expected title and content to be updated")
+		}
+		if !got.UpdatedAt.Equal(saved) {
+			t.Errorf("got UpdatedAt %v, want %v", got.UpdatedAt, saved)
+		}
+	})
+
+	t.Run("rejects stale saves with both versions", func(t *testing.T) {
+		p := newDraft(t)
+		first, err := p.UpdateContent(newTitle, newContent, author, loaded)
+		assertNoError(t, err)
+
+		staleTitle, _ := shared.NewTitle("Le passé composé (brouillon)")
+		_, err = first.UpdateContent(staleTitle, p.Content, author, loaded)
+
+		assertErrorCode(t, err, kernel.EConflict)
+		conflict, ok := post.ConflictFrom(err)
+		if !ok {
+			t.Fatalf("expected conflict details in %v", err)
+		}
+		if !conflict.Expected.Equal(loaded) || !conflict.Current.UpdatedAt.Equal(saved) {
+			t.Errorf("got expected %v, current %v", conflict.Expected, conflict.Current.UpdatedAt)
+		}
+		if conflict.Current.Title != newTitle || conflict.Attempted.Title != staleTitle {
+			t.Errorf("got current %q, attempted %q", conflict.Current.Title, conflict.Attempted.Title)
+		}
+		if conflict.Current.WordCount != first.WordCount() || conflict.Attempted.WordCount != p.WordCount() {
+			t.Errorf("got word counts %d and %d", conflict.Current.WordCount, conflict.Attempted.WordCount)
+		}
+	})
+
+	t.Run("rejects users who cannot edit", func(t *testing.T) {
+		p := newDraft(t)
+		other := &mockUser{id: "user-456", roles: []user.Role{user.RoleAuthor}}
+
+		_, err := p.UpdateContent(newTitle, newContent, other, loaded)
+
+		assertErrorCode(t, err, kernel.EForbidden)
+	})
+
+	t.Run("rejects invalid content", func(t *testing.T) {
+		p := newDraft(t)
+
+		_, err := p.UpdateContent(newTitle, post.PostContent("trop court"), author, loaded)
+
+		assertError(t, err)
+		assertErrorCode(t, err, kernel.EInvalid)
+	})
+}
+
+func TestConflictFrom(t *testing.T) {
+	err := &kernel.Error{Code: kernel.EConflict, Message: "other conflict"}
+
+	if _, ok := post.ConflictFrom(err); ok {
+		t.Error("expected no conflict details")
+	}
+}
@@ -0,0 +1,158 @@
+package post
+
+import (
+	"cmp"
+	"fmt"
+	"hash/fnv"
+	"math"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+)
+
+const (
+	ShingleSize               = 5   // Words per shingle
+	FingerprintSize           = 128 // Shingle hashes a fingerprint keeps
+	DefaultDuplicateThreshold = 0.5 // Similarity from which posts are reported as duplicates
+	MaxDuplicateMatches       = 3   // Similar posts a duplicate check reports at most
+)
+
+const MPreflightContentDuplicate string = "Content is %d%% similar to post %q; check both cover different ground."
+
+const FindingContentDuplicate FindingCode = "content_duplicate"
+
+// Fingerprint sketches post content for near-duplicate detection: the smallest
+// hashes of its overlapping word sequences (shingles), in ascending order. Two
+// fingerprints estimate how much text their posts share without comparing them
+// in full, so editing a few words keeps posts similar.
+type Fingerprint []uint64
+
+// FingerprintContent computes the fingerprint of post content, ignoring Markdown,
+// case, and punctuation.
+func FingerprintContent(content PostContent) Fingerprint {
+	return fingerprint(kernel.StripMarkdown(content.String()))
+}
+
+func fingerprint(plain string) Fingerprint {
+	words := strings.FieldsFunc(strings.ToLower(plain), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	if len(words) == 0 {
+		return nil
+	}
+
+	size := min(ShingleSize, len(words))
+	hashes := make([]uint64, 0, len(words)-size+1)
+	for i := 0; i+size <= len(words); i++ {
+		h := fnv.New64a()
+		h.Write([]byte(strings.Join(words[i:i+size], " ")))
+		hashes = append(hashes, h.Sum64())
+	}
+
+	slices.Sort(hashes)
+	hashes = slices.Compact(hashes)
+	return Fingerprint(hashes[:min(FingerprintSize, len(hashes))])
+}
+
+// Similarity estimates the share of shingles two posts have in common, from 0
+// (nothing shared) to 1 (same text).
+func (f Fingerprint) Similarity(other Fingerprint) float64 {
+	if len(f) == 0 || len(other) == 0 {
+		return 0
+	}
+
+	// The smallest hashes of the union are a random sample of it; the share of
+	// the sample found in both fingerprints estimates their Jaccard similarity.
+	var sample, shared int
+	i, j := 0, 0
+	for sample < FingerprintSize && (i < len(f) || j < len(other)) {
+		switch {
+		case j == len(other) || (i < len(f) && f[i] < other[j]):
+			i++
+		case i == len(f) || other[j] < f[i]:
+			j++
+		default:
+			shared++
+			i++
+			j++
+		}
+		sample++
+	}
+	return float64(shared) / float64(sample)
+}
+
+// PostFingerprints lists stored fingerprints to compare new content against.
+type PostFingerprints interface {
+	// GetFingerprints returns the fingerprint of every post, by post ID.
+	// Stores compute them when posts are written (see PostSummary).
+	GetFingerprints() (map[kernel.ID[Post]]Fingerprint, error)
+}
+
+// DuplicateMatch is an existing post found similar to the one checked.
+type DuplicateMatch struct {
+	PostID     kernel.ID[Post]
+	Similarity float64
+}
+
+// DuplicateService warns editors when a post largely repeats an existing one,
+// as happens with imports or when two authors cover the same grammar topic.
+type DuplicateService struct {
+	posts     PostFingerprints
+	threshold float64
+}
+
+// NewDuplicateService creates duplicate service over stored fingerprints. A threshold
+// outside (0, 1] uses DefaultDuplicateThreshold.
+func NewDuplicateService(posts PostFingerprints, threshold float64) *DuplicateService {
+	if threshold <= 0 || threshold > 1 {
+		threshold = DefaultDuplicateThreshold
+	}
+	return &DuplicateService{posts: posts, threshold: threshold}
+}
+
+// FindSimilar returns the posts at least as similar to p as the threshold, most
+// similar first and at most MaxDuplicateMatches. The post itself is skipped.
+func (s *DuplicateService) FindSimilar(p Post) ([]DuplicateMatch, error) {
+	const op = "DuplicateService.FindSimilar"
+
+	fingerprints, err := s.posts.GetFingerprints()
+	if err != nil {
+		return nil, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	own := FingerprintContent(p.Content)
+	var matches []DuplicateMatch
+	for id, fp := range fingerprints {
+		if id == p.PostID {
+			continue
+		}
+		if similarity := own.Similarity(fp); similarity >= s.threshold {
+			matches = append(matches, DuplicateMatch{PostID: id, Similarity: similarity})
+		}
+	}
+
+	slices.SortFunc(matches, func(a, b DuplicateMatch) int {
+		return cmp.Or(cmp.Compare(b.Similarity, a.Similarity), cmp.Compare(a.PostID, b.PostID))
+	})
+	return matches[:min(MaxDuplicateMatches, len(matches))], nil
+}
+
+// Check reports similar posts as preflight warnings, to show alongside the
+// PreflightService report when a post is created or approved.
+func (s *DuplicateService) Check(p Post) (PreflightReport, error) {
+	const op = "DuplicateService.Check"
+
+	matches, err := s.FindSimilar(p)
+	if err != nil {
+		return PreflightReport{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	var report PreflightReport
+	for _, m := range matches {
+		percent := int(math.Round(m.Similarity * 100))
+		report.add(FindingContentDuplicate, SeverityWarning, fmt.Sprintf(MPreflightContentDuplicate, percent, m.PostID))
+	}
+	return report, nil
+}
@@ -0,0 +1,72 @@
+package post_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/post"
+)
+
+const (
+	marketLesson = "Le samedi matin, Léa va au marché avec sa grand-mère. Elles achètent des pommes, " +
+		"des poireaux et un gros fromage de chèvre. Le marchand leur donne une botte de radis en cadeau. " +
+		"Ensuite, elles boivent un café sur la place et regardent les enfants jouer près de la fontaine. " +
+		"À midi, elles rentrent à la maison et préparent une soupe de légumes pour toute la famille."
+	passeCompose = "Le passé composé se forme avec un auxiliaire, avoir ou être, suivi du participe passé. " +
+		"On utilise être avec les verbes de mouvement comme aller, venir, partir et arriver, ainsi qu'avec " +
+		"les verbes pronominaux. Le participe passé s'accorde alors avec le sujet. Avec avoir, il ne " +
+		"s'accorde qu'avec un complément d'objet direct placé avant le verbe."
+)
+
+type stubFingerprints map[kernel.ID[post.Post]]post.Fingerprint
+
+func (s stubFingerprints) GetFingerprints() (map[kernel.ID[post.Post]]post.Fingerprint, error) {
+	return s, nil
+}
+
+func TestFingerprint_Similarity(t *testing.T) {
+	market := post.FingerprintContent(marketLesson)
+
+	tests := []struct {
+		name     string
+		content  string
+		min, max float64
+	}{
+		{"same text", marketLesson, 1, 1},
+		{"same text in other formatting", strings.ToUpper(strings.Replace(marketLesson, "samedi", "**samedi**", 1)), 1, 1},
+		{"lightly edited", strings.Replace(marketLesson, "des pommes", "des poires", 1), 0.7, 0.95},
+		{"other topic", passeCompose, 0, 0.05},
+		{"empty", "", 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := market.Similarity(post.FingerprintContent(post.PostContent(tt.content)))
+
+			if got < tt.min || got > tt.max {
+				t.Errorf("got %.2f, want between %.2f and %.2f", got, tt.min, tt.max)
+			}
+		})
+	}
+}
+
+func TestDuplicateService_Check(t *testing.T) {
+	existing := stubFingerprints{
+		"market":  post.FingerprintContent(marketLesson),
+		"grammar": post.FingerprintContent(passeCompose),
+		"draft":   post.FingerprintContent(post.PostContent(strings.Replace(marketLesson, "Léa", "Tom", 1))),
+	}
+	service := post.NewDuplicateService(existing, 0)
+	imported := post.Post{PostID: "draft", Content: post.PostContent(marketLesson)}
+
+	report, err := service.Check(imported)
+
+	assertNoError(t, err)
+	if len(report.Findings) != 1 || report.Findings[0].Code != post.FindingContentDuplicate {
+		t.Fatalf("got %+v, want one duplicate warning", report.Findings)
+	}
+	if !report.Passed() || !strings.Contains(report.Findings[0].Message, `"market"`) {
+		t.Errorf("got %+v, want a warning naming the market post", report.Findings[0])
+	}
+}
@@ -2,12 +2,12 @@ package post
 
 import (
 	"fmt"
-	"math"
-	"strings"
+	"slices"
 	"time"
 
 	"github.com/alnah/fla/internal/domain/category"
 	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/policy"
 	"github.com/alnah/fla/internal/domain/shared"
 	"github.com/alnah/fla/internal/domain/user"
 )
@@ -40,9 +40,11 @@ type Post struct {
 	// Data
 	Title         shared.Title
 	Content       PostContent
+	Excerpt       Excerpt                   // Optional: summary for feeds and listings (defaults SEODescription)
 	FeaturedImage kernel.URL[FeaturedImage] // Optional: featured image for the post
 	Status        Status
 	Slug          shared.Slug
+	Locale        shared.Locale // Optional: language the post is written in (empty = unknown)
 
 	// SEO & Social Media
 	SEOTitle             shared.Title               // Optional: SEO-optimized title (defaults Title)
@@ -60,10 +62,18 @@ type Post struct {
 	ApprovedBy  *kernel.ID[user.User] // Who approved the post for publishing (nil = not approved)
 	ApprovedAt  *time.Time            // When post was approved (nil = not approved)
 
+	// Curation
+	Featured      bool       // Pinned on the homepage and its category page while published
+	FeaturedUntil *time.Time // When featuring ends (nil = until unfeatured)
+
 	// Meta
 	CreatedAt time.Time
 	UpdatedAt time.Time
 	Category  category.Category // Post must have one Category
+	Tags      PostTags          // Optional: cross-cutting labels, at most MaxTagsPerPost
+
+	// Learning aids
+	Pronunciations []shared.Pronunciation // Optional: key terms with IPA, at most MaxPronunciationsPerPost
 
 	// DI
 	Clock kernel.Clock
@@ -82,6 +92,11 @@ type NewPostParams struct {
 
 	// Optional
 	PublishedAt *time.Time
+	Excerpt     Excerpt       // Summary for feeds and listings
+	Tags        PostTags      // Copied so the caller's slice stays independent
+	Typography  shared.Locale // Language of the post; sets title and excerpt in its typography, empty keeps them as typed
+
+	Pronunciations []shared.Pronunciation // Copied so the caller's slice stays independent
 
 	// Optional SEO & Social Media (all optional)
 	SEOTitle       shared.Title
@@ -107,6 +122,11 @@ func NewPost(p NewPostParams) (Post, error) {
 
 	now := p.Clock.Now()
 
+	if p.Typography != "" {
+		p.Title = p.Title.Normalize(p.Typography)
+		p.Excerpt = p.Excerpt.Normalize(p.Typography)
+	}
+
 	slug, err := shared.NewSlug(p.Title.String())
 	if err != nil {
 		return Post{}, &kernel.Error{Operation: op, Cause: err}
@@ -117,9 +137,11 @@ func NewPost(p NewPostParams) (Post, error) {
 		Owner:                p.Owner,
 		Title:                p.Title,
 		Content:              p.Content,
+		Excerpt:              p.Excerpt,
 		FeaturedImage:        p.FeaturedImage,
 		Status:               p.Status,
 		Slug:                 slug,
+		Locale:               p.Typography,
 		SEOTitle:             p.SEOTitle,
 		SEODescription:       p.SEODescription,
 		OpenGraphTitle:       p.OpenGraphTitle,
@@ -133,6 +155,8 @@ func NewPost(p NewPostParams) (Post, error) {
 		CreatedAt:            now,
 		UpdatedAt:            now,
 		Category:             p.Category,
+		Tags:                 slices.Clone(p.Tags),
+		Pronunciations:       slices.Clone(p.Pronunciations),
 		Clock:                p.Clock,
 	}
 
@@ -213,6 +237,7 @@ func (p Post) validateCoreFields() error {
 		p.Status.Validate,
 		p.Slug.Validate,
 		p.Category.Validate,
+		p.Tags.Validate,
 	}
 
 	for _, validate := range validators {
@@ -242,6 +267,7 @@ func (p Post) validateSEOFields() error {
 
 	// Always validate descriptions and images (they handle empty values internally)
 	validators := []func() error{
+		p.Excerpt.Validate,
 		p.SEODescription.Validate,
 		p.OpenGraphDescription.Validate,
 		p.OpenGraphImage.Validate,
@@ -261,6 +287,11 @@ func (p Post) validateMetadataFields() error {
 	validators := []func() error{
 		p.CanonicalURL.Validate,
 		p.SchemaType.Validate,
+		p.validatePronunciations,
+	}
+
+	if p.Locale != "" {
+		validators = append(validators, p.Locale.Validate)
 	}
 
 	for _, validate := range validators {
@@ -307,25 +338,15 @@ func (p Post) validateWorkflowFields() error {
 
 // WordCount calculates content length for reading time estimation and content planning.
 // Strips markdown formatting to provide accurate word counts for educational material.
+// Listings showing several figures should compute Summary once instead.
 func (p Post) WordCount() int {
-	content := p.Content.String()
-
-	// Remove markdown syntax and HTML tags for more accurate count
-	content = kernel.StripMarkdown(content)
-
-	// Split by whitespace and count non-empty strings
-	words := strings.Fields(content)
-	return len(words)
+	return countWords(kernel.StripMarkdown(p.Content.String()))
 }
 
 // EstimatedReadingTime helps learners plan study sessions by providing realistic time expectations.
 // Calculated using average adult reading speed for educational content.
 func (p Post) EstimatedReadingTime() int {
-	wordCount := p.WordCount()
-	minutes := float64(wordCount) / AverageWordsPerMinute
-
-	// Round up to at least 1 minute
-	return int(math.Max(1, math.Ceil(minutes)))
+	return readingMinutes(p.WordCount())
 }
 
 // IsPublished returns true if the post is published.
@@ -345,22 +366,7 @@ func (p Post) CanBeEditedBy(u user.PostPermissionChecker) bool {
 
 // GetExcerpt returns a truncated version of the content for previews.
 func (p Post) GetExcerpt(maxLength int) string {
-	content := p.Content.String()
-
-	// Strip markdown for cleaner excerpt.
-	content = kernel.StripMarkdown(content)
-
-	if len(content) <= maxLength {
-		return content
-	}
-
-	// Truncate and add ellipsis, but try to break at word boundary.
-	truncated := content[:maxLength]
-	if lastSpace := strings.LastIndex(truncated, " "); lastSpace > maxLength/2 {
-		truncated = truncated[:lastSpace]
-	}
-
-	return truncated + "..."
+	return truncateExcerpt(kernel.StripMarkdown(p.Content.String()), maxLength)
 }
 
 // HasFeaturedImage returns true if the post has a featured image.
@@ -368,6 +374,23 @@ func (p Post) HasFeaturedImage() bool {
 	return p.FeaturedImage.String() != ""
 }
 
+// GetEffectiveOpenGraphImage returns the social preview image, defaulting to the featured image.
+func (p Post) GetEffectiveOpenGraphImage() string {
+	if p.OpenGraphImage != "" {
+		return p.OpenGraphImage.String()
+	}
+	return p.FeaturedImage.String()
+}
+
+// URLPath builds the site-relative path of the post below its category hierarchy.
+// Produces paths like "a1/comprehension-ecrite/sports/jouer-au-football".
+func (p Post) URLPath(categoryPath category.CategoryPath) string {
+	if prefix := categoryPath.String(); prefix != "" {
+		return prefix + "/" + p.Slug.String()
+	}
+	return p.Slug.String()
+}
+
 // IsApproved returns true if the post has been approved.
 func (p Post) IsApproved() bool {
 	return p.ApprovedBy != nil && p.ApprovedAt != nil
@@ -432,7 +455,7 @@ func (p Post) validatePublishTransition(u user.PostPermissionChecker, op string)
 	}
 
 	// Only admin/editor can publish
-	if !u.HasAnyRole(user.RoleAdmin, user.RoleEditor) {
+	if !p.canReview(u) {
 		return &kernel.Error{
 			Code:      kernel.EForbidden,
 			Message:   MPostCannotPublish,
@@ -445,7 +468,7 @@ func (p Post) validatePublishTransition(u user.PostPermissionChecker, op string)
 
 // validateScheduleTransition validates permission to schedule a post.
 func (p Post) validateScheduleTransition(u user.PostPermissionChecker, op string) error {
-	if !u.HasAnyRole(user.RoleAdmin, user.RoleEditor) {
+	if !p.canReview(u) {
 		return &kernel.Error{
 			Code:      kernel.EForbidden,
 			Message:   MPostCannotSchedule,
@@ -457,7 +480,7 @@ func (p Post) validateScheduleTransition(u user.PostPermissionChecker, op string
 
 // validateArchiveTransition validates permission to archive a post.
 func (p Post) validateArchiveTransition(u user.PostPermissionChecker, op string) error {
-	if !u.HasAnyRole(user.RoleAdmin, user.RoleEditor) {
+	if !p.canReview(u) {
 		return &kernel.Error{
 			Code:      kernel.EForbidden,
 			Message:   fmt.Sprintf(MPostInvalidStatusTransition, p.Status, StatusArchived),
@@ -470,7 +493,7 @@ func (p Post) validateArchiveTransition(u user.PostPermissionChecker, op string)
 // validateDraftTransition validates permission to move post back to draft.
 func (p Post) validateDraftTransition(u user.PostPermissionChecker, op string) error {
 	// Published posts can go back to draft for major edits (admin/editor only)
-	if p.Status == StatusPublished && !u.HasAnyRole(user.RoleAdmin, user.RoleEditor) {
+	if p.Status == StatusPublished && !p.canReview(u) {
 		return &kernel.Error{
 			Code:      kernel.EForbidden,
 			Message:   fmt.Sprintf(MPostInvalidStatusTransition, p.Status, StatusDraft),
@@ -480,13 +503,19 @@ func (p Post) validateDraftTransition(u user.PostPermissionChecker, op string) e
 	return nil
 }
 
+// canReview reports whether the actor may take part in the editorial workflow:
+// approving, releasing, archiving, or unpublishing posts.
+func (p Post) canReview(actor user.PostPermissionChecker) bool {
+	return user.Authorize(actor, policy.PostReview, user.PostResource(p)).Allowed
+}
+
 // Approve validates editorial approval for content publication in collaborative environments.
 // Enforces business rules preventing self-approval and ensuring content quality control.
 func (p Post) Approve(approver user.PostPermissionChecker) (Post, error) {
 	const op = "Post.Approve"
 
 	// Only admin/editor can approve.
-	if !approver.HasAnyRole(user.RoleAdmin, user.RoleEditor) {
+	if !p.canReview(approver) {
 		return p, &kernel.Error{
 			Code:      kernel.EForbidden,
 			Message:   MPostCannotApprove,
@@ -540,6 +569,24 @@ func (p Post) Schedule(publishAt time.Time, u user.PostPermissionChecker) (Post,
 	return updatedPost, nil
 }
 
+// ScheduleLocal schedules the post for a wall-clock time in a time zone, such
+// as Monday 9:00 Paris time, whatever the daylight saving offset that day.
+func (p Post) ScheduleLocal(at shared.WallClock, tz shared.TimeZone, u user.PostPermissionChecker) (Post, error) {
+	const op = "Post.ScheduleLocal"
+
+	publishAt, err := tz.Resolve(at)
+	if err != nil {
+		return p, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	updatedPost, err := p.Schedule(publishAt, u)
+	if err != nil {
+		return p, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return updatedPost, nil
+}
+
 // Publish publishes the post immediately.
 func (p Post) Publish(u user.PostPermissionChecker) (Post, error) {
 	const op = "Post.Publish"
@@ -568,3 +615,9 @@ func (p Post) GetOwner() kernel.ID[user.User] {
 func (p Post) GetStatus() string {
 	return string(p.Status)
 }
+
+// GetCategoryID returns the post's category ID, so editors limited to some
+// categories only handle posts within them.
+func (p Post) GetCategoryID() string {
+	return p.Category.CategoryID.String()
+}
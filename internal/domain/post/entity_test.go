@@ -8,6 +8,7 @@ import (
 
 	"github.com/alnah/fla/internal/domain/category"
 	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/policy"
 	"github.com/alnah/fla/internal/domain/post"
 	"github.com/alnah/fla/internal/domain/shared"
 	"github.com/alnah/fla/internal/domain/user"
@@ -40,6 +41,14 @@ func (m *mockUser) GetID() kernel.ID[user.User] {
 	return m.id
 }
 
+func (m *mockUser) IsActive() bool {
+	return true
+}
+
+func (m *mockUser) GetScopes() []string {
+	return []string{policy.AllScopes}
+}
+
 func (m *mockUser) CanEditPost(p user.PostInterface) bool {
 	if m.HasAnyRole(user.RoleAdmin, user.RoleEditor) {
 		return true
@@ -251,6 +260,34 @@ func TestNewPost(t *testing.T) {
 		}
 	})
 
+	t.Run("normalizes typography when asked", func(t *testing.T) {
+		p, err := post.NewPost(post.NewPostParams{
+			PostID:     "post-123",
+			Owner:      "user-123",
+			Title:      "L'accord du participe passé ?",
+			Content:    post.PostContent(strings.Repeat("This is test content. ", 20)),
+			Excerpt:    `Le "piège" des auxiliaires...`,
+			Status:     post.StatusDraft,
+			Category:   createTestCategory(t, clock),
+			Typography: shared.LocaleFrenchFR,
+			Clock:      clock,
+		})
+		assertNoError(t, err)
+
+		if p.Title != "L’accord du participe passé\u202F?" {
+			t.Errorf("title: got %q", p.Title)
+		}
+		if p.Excerpt != "Le «\u00A0piège\u00A0» des auxiliaires…" {
+			t.Errorf("excerpt: got %q", p.Excerpt)
+		}
+		if p.Slug != "l-accord-du-participe-passe" {
+			t.Errorf("slug: got %q", p.Slug)
+		}
+		if p.Locale != shared.LocaleFrenchFR {
+			t.Errorf("locale: got %q", p.Locale)
+		}
+	})
+
 	t.Run("validates required fields", func(t *testing.T) {
 		// Create valid parameters first
 		postID, _ := kernel.NewID[post.Post]("post-123")
@@ -752,6 +789,78 @@ func TestPost_HasFeaturedImage(t *testing.T) {
 	})
 }
 
+func TestPost_GetEffectiveOpenGraphImage(t *testing.T) {
+	clock := &mockClock{now: time.Now()}
+	postID, _ := kernel.NewID[post.Post]("post-123")
+	ownerID, _ := kernel.NewID[user.User]("user-123")
+	title, _ := shared.NewTitle("Test Post Title Example")
+	content, _ := post.NewPostContent(strings.Repeat("Test content. ", 25))
+
+	tests := []struct {
+		name          string
+		featuredImage kernel.URL[post.FeaturedImage]
+		ogImage       kernel.URL[post.OpenGraphImage]
+		want          string
+	}{
+		{name: "prefers open graph image", featuredImage: "https://example.com/featured.jpg", ogImage: "https://example.com/og.jpg", want: "https://example.com/og.jpg"},
+		{name: "falls back to featured image", featuredImage: "https://example.com/featured.jpg", want: "https://example.com/featured.jpg"},
+		{name: "empty without images", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := post.NewPost(post.NewPostParams{
+				PostID:         postID,
+				Owner:          ownerID,
+				Title:          title,
+				Content:        content,
+				FeaturedImage:  tt.featuredImage,
+				OpenGraphImage: tt.ogImage,
+				Status:         post.StatusDraft,
+				Category:       createTestCategory(t, clock),
+				Clock:          clock,
+			})
+			assertNoError(t, err)
+
+			if got := p.GetEffectiveOpenGraphImage(); got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPost_URLPath(t *testing.T) {
+	clock := &mockClock{now: time.Now()}
+	postID, _ := kernel.NewID[post.Post]("post-123")
+	ownerID, _ := kernel.NewID[user.User]("user-123")
+	title, _ := shared.NewTitle("Jouer au football")
+	content, _ := post.NewPostContent(strings.Repeat("Test content. ", 25))
+	cat := createTestCategory(t, clock)
+
+	p, err := post.NewPost(post.NewPostParams{
+		PostID:   postID,
+		Owner:    ownerID,
+		Title:    title,
+		Content:  content,
+		Status:   post.StatusDraft,
+		Category: cat,
+		Clock:    clock,
+	})
+	assertNoError(t, err)
+
+	t.Run("prefixes category path", func(t *testing.T) {
+		if got := p.URLPath(category.CategoryPath{cat}); got != "test-category/jouer-au-football" {
+			t.Errorf("got %q", got)
+		}
+	})
+
+	t.Run("returns slug without category path", func(t *testing.T) {
+		if got := p.URLPath(nil); got != "jouer-au-football" {
+			t.Errorf("got %q", got)
+		}
+	})
+}
+
 func TestPost_GetExcerpt(t *testing.T) {
 	clock := &mockClock{now: time.Now()}
 
@@ -1300,6 +1409,20 @@ func TestPost_Approve(t *testing.T) {
 		}
 	})
 
+	t.Run("editor approves only within their categories", func(t *testing.T) {
+		ownerID, _ := kernel.NewID[user.User]("owner-123")
+		p := createPost(ownerID)
+		editor := user.User{ID: "editor-123", Roles: []user.Role{user.RoleEditor}, Status: user.AccountStatusActive}
+
+		editor.Scopes = []string{"another-category"}
+		_, err := p.Approve(editor)
+		assertErrorCode(t, err, kernel.EForbidden)
+
+		editor.Scopes = []string{p.GetCategoryID()}
+		_, err = p.Approve(editor)
+		assertNoError(t, err)
+	})
+
 	t.Run("editor cannot approve own post", func(t *testing.T) {
 		editorID, _ := kernel.NewID[user.User]("editor-123")
 
@@ -1404,6 +1527,32 @@ func TestPost_Schedule(t *testing.T) {
 		assertError(t, err)
 		assertErrorCode(t, err, kernel.EForbidden)
 	})
+
+	t.Run("schedules a local time in the given zone", func(t *testing.T) {
+		p := createPost()
+		adminID, _ := kernel.NewID[user.User]("admin-123")
+		admin := &mockUser{id: adminID, roles: []user.Role{user.RoleAdmin}}
+		at := shared.WallClock{Year: 2030, Month: time.July, Day: 1, Hour: 9}
+
+		scheduled, err := p.ScheduleLocal(at, "Europe/Paris", admin)
+
+		assertNoError(t, err)
+		want := time.Date(2030, 7, 1, 7, 0, 0, 0, time.UTC)
+		if scheduled.PublishedAt == nil || !scheduled.PublishedAt.Equal(want) {
+			t.Errorf("PublishedAt: got %v, want %s", scheduled.PublishedAt, want)
+		}
+	})
+
+	t.Run("cannot schedule a local time skipped by daylight saving", func(t *testing.T) {
+		p := createPost()
+		adminID, _ := kernel.NewID[user.User]("admin-123")
+		admin := &mockUser{id: adminID, roles: []user.Role{user.RoleAdmin}}
+		at := shared.WallClock{Year: 2030, Month: time.March, Day: 31, Hour: 2, Minute: 30}
+
+		_, err := p.ScheduleLocal(at, "Europe/Paris", admin)
+
+		assertErrorCode(t, err, kernel.EInvalid)
+	})
 }
 
 func TestPost_Publish(t *testing.T) {
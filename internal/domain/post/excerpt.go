@@ -0,0 +1,74 @@
+package post
+
+import (
+	"strings"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/shared"
+)
+
+const (
+	MinExcerptLength     int = 0 // Optional field
+	MaxExcerptLength     int = 300
+	DefaultExcerptLength int = 160 // Generated excerpt length, close to meta description limits
+)
+
+// Excerpt is an author-written summary shown in feeds, listings, and emails.
+// Optional: when empty, summaries fall back to the SEO description or generated text.
+type Excerpt string
+
+// NewExcerpt creates a validated excerpt with length checking.
+// Keeps summaries short enough for cards, feed entries, and social previews.
+func NewExcerpt(excerpt string) (Excerpt, error) {
+	const op = "NewExcerpt"
+
+	e := Excerpt(strings.TrimSpace(excerpt))
+	if err := e.Validate(); err != nil {
+		return "", &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return e, nil
+}
+
+func (e Excerpt) String() string { return string(e) }
+
+// Validate ensures the excerpt fits summary display constraints.
+func (e Excerpt) Validate() error {
+	const op = "Excerpt.Validate"
+
+	if err := kernel.ValidateLength("excerpt", e.String(), MinExcerptLength, MaxExcerptLength, op); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Normalize returns the excerpt set in the typography of the locale.
+// See shared.NormalizeTypography.
+func (e Excerpt) Normalize(locale shared.Locale) Excerpt {
+	return Excerpt(shared.NormalizeTypography(e.String(), locale))
+}
+
+// GetEffectiveExcerpt returns the summary used consistently by feeds, listings, and emails.
+// Falls back from the explicit excerpt to the SEO description, then to generated content.
+func (p Post) GetEffectiveExcerpt() string {
+	if p.Excerpt != "" {
+		return p.Excerpt.String()
+	}
+
+	if p.SEODescription != "" {
+		return p.SEODescription.String()
+	}
+
+	return p.GetExcerpt(DefaultExcerptLength)
+}
+
+// GetEffectiveOpenGraphDescription returns the description used for social sharing.
+// Prefers the dedicated Open Graph description, then the effective excerpt chain.
+func (p Post) GetEffectiveOpenGraphDescription() string {
+	if p.OpenGraphDescription != "" {
+		return p.OpenGraphDescription.String()
+	}
+
+	return p.GetEffectiveExcerpt()
+}
@@ -0,0 +1,133 @@
+package post_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/post"
+	"github.com/alnah/fla/internal/domain/shared"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+func TestNewExcerpt(t *testing.T) {
+	t.Run("creates excerpt with valid input", func(t *testing.T) {
+		inputs := []string{
+			"",
+			"Une courte introduction au passé composé.",
+			strings.Repeat("a", post.MaxExcerptLength),
+		}
+
+		for _, input := range inputs {
+			got, err := post.NewExcerpt(input)
+
+			assertNoError(t, err)
+			if got.String() != input {
+				t.Errorf("got %q, want %q", got, input)
+			}
+		}
+	})
+
+	t.Run("trims whitespace", func(t *testing.T) {
+		got, err := post.NewExcerpt("  Résumé  ")
+
+		assertNoError(t, err)
+		if got.String() != "Résumé" {
+			t.Errorf("got %q, want %q", got, "Résumé")
+		}
+	})
+
+	t.Run("rejects excerpt exceeding max length", func(t *testing.T) {
+		_, err := post.NewExcerpt(strings.Repeat("a", post.MaxExcerptLength+1))
+
+		assertError(t, err)
+		assertErrorCode(t, err, kernel.EInvalid)
+	})
+}
+
+func TestPost_GetEffectiveExcerpt(t *testing.T) {
+	clock := &mockClock{now: time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)}
+	content := "Le football est un sport populaire en France." + strings.Repeat(" Les joueurs courent beaucoup.", 12)
+
+	newPost := func(t *testing.T, excerpt post.Excerpt, seo, og shared.Description) post.Post {
+		t.Helper()
+		postID, _ := kernel.NewID[post.Post]("post-123")
+		ownerID, _ := kernel.NewID[user.User]("user-123")
+		title, _ := shared.NewTitle("Jouer au football")
+		postContent, _ := post.NewPostContent(content)
+
+		p, err := post.NewPost(post.NewPostParams{
+			PostID:               postID,
+			Owner:                ownerID,
+			Title:                title,
+			Content:              postContent,
+			Status:               post.StatusDraft,
+			Category:             createTestCategory(t, clock),
+			Excerpt:              excerpt,
+			SEODescription:       seo,
+			OpenGraphDescription: og,
+			Clock:                clock,
+		})
+		assertNoError(t, err)
+		return p
+	}
+
+	t.Run("prefers explicit excerpt", func(t *testing.T) {
+		p := newPost(t, "Explicit summary.", "SEO summary.", "")
+
+		if got := p.GetEffectiveExcerpt(); got != "Explicit summary." {
+			t.Errorf("got %q", got)
+		}
+	})
+
+	t.Run("falls back to SEO description", func(t *testing.T) {
+		p := newPost(t, "", "SEO summary.", "")
+
+		if got := p.GetEffectiveExcerpt(); got != "SEO summary." {
+			t.Errorf("got %q", got)
+		}
+	})
+
+	t.Run("falls back to generated excerpt", func(t *testing.T) {
+		p := newPost(t, "", "", "")
+
+		want := p.GetExcerpt(post.DefaultExcerptLength)
+		if got := p.GetEffectiveExcerpt(); got != want {
+			t.Errorf("got %q, want %q", got, want)
+		}
+	})
+
+	t.Run("open graph description uses excerpt chain", func(t *testing.T) {
+		withOG := newPost(t, "Explicit summary.", "", "Social summary.")
+		withoutOG := newPost(t, "Explicit summary.", "", "")
+
+		if got := withOG.GetEffectiveOpenGraphDescription(); got != "Social summary." {
+			t.Errorf("got %q, want %q", got, "Social summary.")
+		}
+		if got := withoutOG.GetEffectiveOpenGraphDescription(); got != "Explicit summary." {
+			t.Errorf("got %q, want %q", got, "Explicit summary.")
+		}
+	})
+
+	t.Run("rejects post with oversized excerpt", func(t *testing.T) {
+		postID, _ := kernel.NewID[post.Post]("post-123")
+		ownerID, _ := kernel.NewID[user.User]("user-123")
+		title, _ := shared.NewTitle("Jouer au football")
+		postContent, _ := post.NewPostContent(content)
+
+		_, err := post.NewPost(post.NewPostParams{
+			PostID:   postID,
+			Owner:    ownerID,
+			Title:    title,
+			Content:  postContent,
+			Status:   post.StatusDraft,
+			Category: createTestCategory(t, clock),
+			Excerpt:  post.Excerpt(strings.Repeat("a", post.MaxExcerptLength+1)),
+			Clock:    clock,
+		})
+
+		assertError(t, err)
+		assertErrorCode(t, err, kernel.EInvalid)
+	})
+}
@@ -0,0 +1,198 @@
+package post
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/category"
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/policy"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+const (
+	MPostCannotFeature      string = "User cannot feature this post."
+	MPostFeatureUnpublished string = "Only published posts can be featured."
+	MPostFeaturedUntilPast  string = "Featured end date must be in the future."
+	MPostFeaturedLimit      string = "At most %d posts can be featured in a category at once."
+)
+
+// DefaultMaxFeaturedPerCategory is how many posts a category features at once
+// unless the FeaturedService is configured otherwise.
+const DefaultMaxFeaturedPerCategory = 3
+
+// IsFeatured reports whether the post is featured now.
+func (p Post) IsFeatured() bool {
+	return p.IsFeaturedAt(p.Clock.Now())
+}
+
+// IsFeaturedAt reports whether the post is featured at the time: flagged,
+// published, and before its featured end date, if any.
+func (p Post) IsFeaturedAt(now time.Time) bool {
+	return p.Featured && p.IsPublished() && (p.FeaturedUntil == nil || now.Before(*p.FeaturedUntil))
+}
+
+// Feature pins a published post until the given time, or until unfeatured when
+// until is nil. Editors decide what is featured; FeaturedService also enforces
+// the per-category limit.
+func (p Post) Feature(until *time.Time, actor user.PostPermissionChecker) (Post, error) {
+	const op = "Post.Feature"
+
+	if !p.canFeature(actor) {
+		return p, &kernel.Error{Code: kernel.EForbidden, Message: MPostCannotFeature, Operation: op}
+	}
+
+	if !p.IsPublished() {
+		return p, &kernel.Error{Code: kernel.EInvalid, Message: MPostFeatureUnpublished, Operation: op}
+	}
+
+	now := p.Clock.Now()
+	if until != nil && !until.After(now) {
+		return p, &kernel.Error{Code: kernel.EInvalid, Message: MPostFeaturedUntilPast, Operation: op}
+	}
+
+	featured := p
+	featured.Featured = true
+	featured.FeaturedUntil = until
+	featured.UpdatedAt = now
+
+	return featured, nil
+}
+
+// Unfeature removes the post from featured listings.
+func (p Post) Unfeature(actor user.PostPermissionChecker) (Post, error) {
+	const op = "Post.Unfeature"
+
+	if !p.canFeature(actor) {
+		return p, &kernel.Error{Code: kernel.EForbidden, Message: MPostCannotFeature, Operation: op}
+	}
+
+	unfeatured := p
+	unfeatured.Featured = false
+	unfeatured.FeaturedUntil = nil
+	unfeatured.UpdatedAt = p.Clock.Now()
+
+	return unfeatured, nil
+}
+
+func (p Post) canFeature(actor user.PostPermissionChecker) bool {
+	return user.Authorize(actor, policy.PostFeature, user.PostResource(p)).Allowed
+}
+
+// FeaturedRepository loads and saves posts and lists the featured ones.
+type FeaturedRepository interface {
+	PostReader
+	PostWriter
+	PostCurator
+}
+
+// FeaturedService curates featured posts, keeping each category to a limited
+// number at once so the homepage stays a selection rather than a second feed.
+type FeaturedService struct {
+	posts          FeaturedRepository
+	maxPerCategory int
+	clock          kernel.Clock
+}
+
+// NewFeaturedService creates featured service with a post repository. A maxPerCategory
+// below 1 uses DefaultMaxFeaturedPerCategory.
+func NewFeaturedService(posts FeaturedRepository, maxPerCategory int, clock kernel.Clock) *FeaturedService {
+	if maxPerCategory < 1 {
+		maxPerCategory = DefaultMaxFeaturedPerCategory
+	}
+	return &FeaturedService{posts: posts, maxPerCategory: maxPerCategory, clock: clock}
+}
+
+// Feature pins a post on behalf of actor, until the given time or until unfeatured.
+// Fails with a conflict when its category already features the maximum number of posts.
+func (s *FeaturedService) Feature(postID kernel.ID[Post], until *time.Time, actor user.PostPermissionChecker) (Post, error) {
+	const op = "FeaturedService.Feature"
+
+	p, err := s.posts.GetByID(postID)
+	if err != nil {
+		return Post{}, &kernel.Error{Operation: op, Cause: err}
+	}
+	p.Clock = s.clock
+
+	featured, err := p.Feature(until, actor)
+	if err != nil {
+		return Post{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if err := s.checkLimit(featured, op); err != nil {
+		return Post{}, err
+	}
+
+	if err := s.posts.Update(featured); err != nil {
+		return Post{}, &kernel.Error{Operation: op, Cause: err}
+	}
+	return featured, nil
+}
+
+// Unfeature removes a post from featured listings on behalf of actor.
+func (s *FeaturedService) Unfeature(postID kernel.ID[Post], actor user.PostPermissionChecker) (Post, error) {
+	const op = "FeaturedService.Unfeature"
+
+	p, err := s.posts.GetByID(postID)
+	if err != nil {
+		return Post{}, &kernel.Error{Operation: op, Cause: err}
+	}
+	p.Clock = s.clock
+
+	unfeatured, err := p.Unfeature(actor)
+	if err != nil {
+		return Post{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if err := s.posts.Update(unfeatured); err != nil {
+		return Post{}, &kernel.Error{Operation: op, Cause: err}
+	}
+	return unfeatured, nil
+}
+
+// Homepage returns the posts featured now across categories, most recently published first.
+func (s *FeaturedService) Homepage() ([]Post, error) {
+	const op = "FeaturedService.Homepage"
+
+	posts, err := s.posts.GetFeaturedPosts(s.clock.Now())
+	if err != nil {
+		return nil, &kernel.Error{Operation: op, Cause: err}
+	}
+	return posts, nil
+}
+
+// InCategory returns the posts featured now in the category, most recently published first.
+func (s *FeaturedService) InCategory(categoryID kernel.ID[category.Category]) ([]Post, error) {
+	const op = "FeaturedService.InCategory"
+
+	posts, err := s.posts.GetFeaturedPostsByCategory(categoryID, s.clock.Now())
+	if err != nil {
+		return nil, &kernel.Error{Operation: op, Cause: err}
+	}
+	return posts, nil
+}
+
+// checkLimit fails when featuring p would exceed its category's limit.
+// Featuring an already featured post again, e.g. to change its end date, is fine.
+func (s *FeaturedService) checkLimit(p Post, op string) error {
+	featured, err := s.posts.GetFeaturedPostsByCategory(p.Category.CategoryID, s.clock.Now())
+	if err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	others := 0
+	for _, f := range featured {
+		if f.PostID != p.PostID {
+			others++
+		}
+	}
+
+	if others >= s.maxPerCategory {
+		return &kernel.Error{
+			Code:      kernel.EConflict,
+			Message:   fmt.Sprintf(MPostFeaturedLimit, s.maxPerCategory),
+			Operation: op,
+		}
+	}
+	return nil
+}
@@ -0,0 +1,130 @@
+package post_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/category"
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/post"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+// stubFeaturedRepository adds featured listings to the publish stub.
+type stubFeaturedRepository struct {
+	stubPublishRepository
+}
+
+func (s *stubFeaturedRepository) GetFeaturedPosts(now time.Time) ([]post.Post, error) {
+	var featured []post.Post
+	for _, p := range s.posts {
+		if p.IsFeaturedAt(now) {
+			featured = append(featured, p)
+		}
+	}
+	return featured, nil
+}
+
+func (s *stubFeaturedRepository) GetFeaturedPostsByCategory(categoryID kernel.ID[category.Category], now time.Time) ([]post.Post, error) {
+	var featured []post.Post
+	for _, p := range s.posts {
+		if p.IsFeaturedAt(now) && p.Category.CategoryID == categoryID {
+			featured = append(featured, p)
+		}
+	}
+	return featured, nil
+}
+
+func newFeaturedFixture(t *testing.T, clock *mockClock, ids ...kernel.ID[post.Post]) *stubFeaturedRepository {
+	t.Helper()
+
+	repo := &stubFeaturedRepository{stubPublishRepository{posts: make(map[kernel.ID[post.Post]]post.Post)}}
+	for _, id := range ids {
+		p, err := post.NewPost(post.NewPostParams{
+			PostID:   id,
+			Owner:    "author-1",
+			Title:    "Leçon sur le marché du samedi",
+			Content:  post.PostContent(strings.Repeat("Le samedi, je vais au marché. ", 12)),
+			Status:   post.StatusDraft,
+			Category: createTestCategory(t, clock),
+			Clock:    clock,
+		})
+		assertNoError(t, err)
+		publishedAt := clock.now.Add(-time.Hour)
+		p.Status, p.PublishedAt = post.StatusPublished, &publishedAt
+		repo.posts[id] = p
+	}
+	return repo
+}
+
+func TestFeaturedService_Feature(t *testing.T) {
+	now := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	editor := &mockUser{id: "editor-1", roles: []user.Role{user.RoleEditor}}
+
+	t.Run("features a published post until a date", func(t *testing.T) {
+		clock := &mockClock{now: now}
+		repo := newFeaturedFixture(t, clock, "lesson")
+		service := post.NewFeaturedService(repo, 2, clock)
+		until := now.Add(7 * 24 * time.Hour)
+
+		got, err := service.Feature("lesson", &until, editor)
+
+		assertNoError(t, err)
+		if !got.IsFeatured() || !got.FeaturedUntil.Equal(until) {
+			t.Errorf("got featured %t until %v", got.Featured, got.FeaturedUntil)
+		}
+
+		clock.now = until
+		if repo.posts["lesson"].IsFeatured() {
+			t.Error("post still featured after its end date")
+		}
+	})
+
+	t.Run("limits featured posts per category", func(t *testing.T) {
+		clock := &mockClock{now: now}
+		repo := newFeaturedFixture(t, clock, "one", "two", "three")
+		service := post.NewFeaturedService(repo, 2, clock)
+
+		_, err := service.Feature("one", nil, editor)
+		assertNoError(t, err)
+		_, err = service.Feature("two", nil, editor)
+		assertNoError(t, err)
+
+		_, err = service.Feature("three", nil, editor)
+		assertErrorCode(t, err, kernel.EConflict)
+
+		_, err = service.Feature("two", nil, editor)
+		assertNoError(t, err)
+
+		_, err = service.Unfeature("one", editor)
+		assertNoError(t, err)
+		_, err = service.Feature("three", nil, editor)
+		assertNoError(t, err)
+
+		homepage, err := service.Homepage()
+		assertNoError(t, err)
+		if len(homepage) != 2 {
+			t.Errorf("got %d featured posts, want 2", len(homepage))
+		}
+	})
+
+	t.Run("rejects invalid requests", func(t *testing.T) {
+		clock := &mockClock{now: now}
+		repo := newFeaturedFixture(t, clock, "lesson", "draft")
+		draft := repo.posts["draft"]
+		draft.Status, draft.PublishedAt = post.StatusDraft, nil
+		repo.posts["draft"] = draft
+		service := post.NewFeaturedService(repo, 0, clock)
+		past := now.Add(-time.Minute)
+
+		_, err := service.Feature("lesson", nil, &mockUser{id: "author-1", roles: []user.Role{user.RoleAuthor}})
+		assertErrorCode(t, err, kernel.EForbidden)
+
+		_, err = service.Feature("draft", nil, editor)
+		assertErrorCode(t, err, kernel.EInvalid)
+
+		_, err = service.Feature("lesson", &past, editor)
+		assertErrorCode(t, err, kernel.EInvalid)
+	})
+}
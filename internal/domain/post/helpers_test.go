@@ -28,3 +28,32 @@ func assertErrorCode(t *testing.T, err error, want string) {
 		t.Errorf("error code: got %q, want %q", got, want)
 	}
 }
+
+type stubIdempotencyStore struct {
+	records map[string]kernel.IdempotencyRecord
+}
+
+func newStubIdempotencyStore() *stubIdempotencyStore {
+	return &stubIdempotencyStore{records: map[string]kernel.IdempotencyRecord{}}
+}
+
+func (s *stubIdempotencyStore) GetRecord(scope string, key kernel.IdempotencyKey) (*kernel.IdempotencyRecord, error) {
+	r, ok := s.records[scope+"/"+key.String()]
+	if !ok {
+		return nil, &kernel.Error{Code: kernel.ENotFound, Message: "no record"}
+	}
+	return &r, nil
+}
+
+func (s *stubIdempotencyStore) ReserveRecord(r kernel.IdempotencyRecord) (*kernel.IdempotencyRecord, error) {
+	if existing, ok := s.records[r.Scope+"/"+r.Key.String()]; ok && !existing.IsExpired(r.CreatedAt) {
+		return &existing, nil
+	}
+	s.records[r.Scope+"/"+r.Key.String()] = r
+	return nil, nil
+}
+
+func (s *stubIdempotencyStore) SaveRecord(r kernel.IdempotencyRecord) error {
+	s.records[r.Scope+"/"+r.Key.String()] = r
+	return nil
+}
@@ -0,0 +1,199 @@
+package post
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/category"
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/shared"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+const (
+	SchemaOrgContext string = "https://schema.org"
+
+	MJSONLDSerializationFailed string = "Structured data could not be serialized."
+)
+
+// JSONLDParams holds the context a post needs to describe itself as structured data.
+type JSONLDParams struct {
+	Site        shared.Site                   // Publisher name, base URL, and content language
+	Author      user.User                     // Post owner, resolved by the caller
+	Breadcrumbs []category.CategoryBreadcrumb // From category.PathService.GetBreadcrumbs
+}
+
+// JSONLDDocument is the schema.org graph embedded in a post page.
+type JSONLDDocument struct {
+	Context string `json:"@context"`
+	Graph   []any  `json:"@graph"`
+}
+
+// JSONLDCreativeWork describes the post itself (Article, BlogPosting, Course, ...).
+type JSONLDCreativeWork struct {
+	Type             string             `json:"@type"`
+	ID               string             `json:"@id"`
+	URL              string             `json:"url"`
+	Headline         string             `json:"headline,omitempty"`
+	Name             string             `json:"name,omitempty"`
+	Description      string             `json:"description,omitempty"`
+	Image            string             `json:"image,omitempty"`
+	Author           JSONLDThing        `json:"author"`
+	Publisher        *JSONLDThing       `json:"publisher,omitempty"`
+	Provider         *JSONLDThing       `json:"provider,omitempty"`
+	DatePublished    string             `json:"datePublished,omitempty"`
+	DateModified     string             `json:"dateModified,omitempty"`
+	InLanguage       string             `json:"inLanguage"`
+	WordCount        int                `json:"wordCount"`
+	EducationalLevel string             `json:"educationalLevel,omitempty"`
+	Breadcrumb       *JSONLDIDReference `json:"breadcrumb,omitempty"`
+}
+
+// JSONLDThing is a named person or organization.
+type JSONLDThing struct {
+	Type string `json:"@type"`
+	Name string `json:"name"`
+	URL  string `json:"url,omitempty"`
+}
+
+// JSONLDIDReference links two nodes of the same graph.
+type JSONLDIDReference struct {
+	ID string `json:"@id"`
+}
+
+// JSONLDBreadcrumbList is the category trail leading to the post.
+type JSONLDBreadcrumbList struct {
+	Type            string           `json:"@type"`
+	ID              string           `json:"@id"`
+	ItemListElement []JSONLDListItem `json:"itemListElement"`
+}
+
+// JSONLDListItem is one step of a breadcrumb trail.
+type JSONLDListItem struct {
+	Type     string `json:"@type"`
+	Position int    `json:"position"`
+	Name     string `json:"name"`
+	Item     string `json:"item"`
+}
+
+// ToJSONLD produces schema.org structured data for search engine rich results.
+// The @type follows SchemaType; breadcrumbs become a BreadcrumbList in the same graph.
+func (p Post) ToJSONLD(params JSONLDParams) ([]byte, error) {
+	const op = "Post.ToJSONLD"
+
+	doc, err := p.JSONLD(params)
+	if err != nil {
+		return nil, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	data, err := json.Marshal(doc)
+	if err != nil {
+		return nil, &kernel.Error{Code: kernel.EInternal, Message: MJSONLDSerializationFailed, Operation: op, Cause: err}
+	}
+
+	return data, nil
+}
+
+// JSONLD builds the structured data graph without serializing it.
+// Useful when the caller merges the graph with site-wide nodes.
+func (p Post) JSONLD(params JSONLDParams) (JSONLDDocument, error) {
+	const op = "Post.JSONLD"
+
+	if err := p.SchemaType.Validate(); err != nil {
+		return JSONLDDocument{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if err := params.Site.Validate(); err != nil {
+		return JSONLDDocument{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	path := make(category.CategoryPath, len(params.Breadcrumbs))
+	for i, b := range params.Breadcrumbs {
+		path[i] = b.Category
+	}
+
+	url := p.CanonicalURL.String()
+	if url == "" {
+		url = params.Site.URL(p.URLPath(path))
+	}
+
+	schemaType := p.SchemaType.GetEffectiveType()
+	site := JSONLDThing{Type: "Organization", Name: params.Site.Name, URL: params.Site.URL("")}
+
+	work := JSONLDCreativeWork{
+		Type:        schemaType.SchemaOrgType(),
+		ID:          url + "#content",
+		URL:         url,
+		Description: p.GetEffectiveExcerpt(),
+		Image:       p.GetEffectiveOpenGraphImage(),
+		Author:      JSONLDThing{Type: "Person", Name: authorName(params.Author)},
+		InLanguage:  params.Site.Locale.String(),
+		WordCount:   p.WordCount(),
+	}
+
+	// Courses have a name and a provider; articles have a headline and a publisher.
+	if schemaType == SchemaTypeCourse {
+		work.Name = p.Title.String()
+		work.Provider = &site
+	} else {
+		work.Headline = p.Title.String()
+		work.Publisher = &site
+	}
+
+	if p.PublishedAt != nil {
+		work.DatePublished = p.PublishedAt.UTC().Format(time.RFC3339)
+	}
+	if !p.UpdatedAt.IsZero() {
+		work.DateModified = p.UpdatedAt.UTC().Format(time.RFC3339)
+	}
+
+	if level, ok := path.Level(); ok && schemaType.IsEducational() {
+		work.EducationalLevel = level.String()
+	}
+
+	doc := JSONLDDocument{Context: SchemaOrgContext}
+
+	if len(path) > 0 {
+		breadcrumbs := buildBreadcrumbList(params.Site, path, p.Title.String(), url)
+		work.Breadcrumb = &JSONLDIDReference{ID: breadcrumbs.ID}
+		doc.Graph = append(doc.Graph, work, breadcrumbs)
+	} else {
+		doc.Graph = append(doc.Graph, work)
+	}
+
+	return doc, nil
+}
+
+// authorName prefers the real name and never falls back to the email address.
+func authorName(u user.User) string {
+	if name := u.GetFullName(); name != "" {
+		return name
+	}
+	return u.Username.String()
+}
+
+// buildBreadcrumbList lists each category level followed by the post itself.
+func buildBreadcrumbList(site shared.Site, path category.CategoryPath, title, url string) JSONLDBreadcrumbList {
+	list := JSONLDBreadcrumbList{
+		Type: "BreadcrumbList",
+		ID:   url + "#breadcrumb",
+	}
+
+	for i, c := range path {
+		list.ItemListElement = append(list.ItemListElement, JSONLDListItem{
+			Type:     "ListItem",
+			Position: i + 1,
+			Name:     c.Name.String(),
+			Item:     site.URL(path[:i+1].String()),
+		})
+	}
+
+	list.ItemListElement = append(list.ItemListElement, JSONLDListItem{
+		Type:     "ListItem",
+		Position: len(path) + 1,
+		Name:     title,
+		Item:     url,
+	})
+
+	return list
+}
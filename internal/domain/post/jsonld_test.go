@@ -0,0 +1,153 @@
+package post_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/category"
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/post"
+	"github.com/alnah/fla/internal/domain/shared"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+func TestPost_ToJSONLD(t *testing.T) {
+	clock := &mockClock{now: time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)}
+	published := time.Date(2024, 3, 2, 8, 30, 0, 0, time.UTC)
+	site, _ := shared.NewSite("FLA", "https://fla.example", shared.LocaleFrenchFR)
+	author := user.User{Username: "marie", FirstName: "Marie", LastName: "Curie", Email: "marie@example.com"}
+
+	a1ID := kernel.ID[category.Category]("a1")
+	breadcrumbs := []category.CategoryBreadcrumb{
+		{Category: category.Category{CategoryID: a1ID, Name: "A1", Slug: "a1"}, Level: 0},
+		{Category: category.Category{CategoryID: "reading", Name: "Lecture", Slug: "lecture", ParentID: &a1ID}, Level: 1, IsLast: true},
+	}
+
+	newPost := func(t *testing.T, schema post.SchemaType) post.Post {
+		t.Helper()
+		title, _ := shared.NewTitle("Lire un menu")
+		content, _ := post.NewPostContent(strings.Repeat("Le menu du jour propose une soupe. ", 10))
+
+		p, err := post.NewPost(post.NewPostParams{
+			PostID:     "post-123",
+			Owner:      "user-123",
+			Title:      title,
+			Content:    content,
+			Status:     post.StatusDraft,
+			Category:   createTestCategory(t, clock),
+			SchemaType: schema,
+			Clock:      clock,
+		})
+		assertNoError(t, err)
+		p.PublishedAt = &published
+		return p
+	}
+
+	decode := func(t *testing.T, data []byte) []map[string]any {
+		t.Helper()
+		var doc struct {
+			Context string           `json:"@context"`
+			Graph   []map[string]any `json:"@graph"`
+		}
+		if err := json.Unmarshal(data, &doc); err != nil {
+			t.Fatalf("invalid JSON: %v", err)
+		}
+		if doc.Context != post.SchemaOrgContext {
+			t.Errorf("got context %q", doc.Context)
+		}
+		return doc.Graph
+	}
+
+	t.Run("describes article with author, dates, language, and word count", func(t *testing.T) {
+		p := newPost(t, post.SchemaTypeArticle)
+
+		data, err := p.ToJSONLD(post.JSONLDParams{Site: site, Author: author, Breadcrumbs: breadcrumbs})
+
+		assertNoError(t, err)
+		work := decode(t, data)[0]
+		want := map[string]any{
+			"@type":         "Article",
+			"url":           "https://fla.example/a1/lecture/lire-un-menu",
+			"headline":      "Lire un menu",
+			"datePublished": "2024-03-02T08:30:00Z",
+			"inLanguage":    "fr-FR",
+			"wordCount":     float64(p.WordCount()),
+		}
+		for key, value := range want {
+			if work[key] != value {
+				t.Errorf("%s: got %v, want %v", key, work[key], value)
+			}
+		}
+		if got := work["author"].(map[string]any)["name"]; got != "Marie Curie" {
+			t.Errorf("author: got %v", got)
+		}
+		if got := work["publisher"].(map[string]any)["name"]; got != "FLA" {
+			t.Errorf("publisher: got %v", got)
+		}
+		if _, ok := work["educationalLevel"]; ok {
+			t.Error("articles should not carry an educational level")
+		}
+	})
+
+	t.Run("describes course with provider and level", func(t *testing.T) {
+		p := newPost(t, post.SchemaTypeCourse)
+
+		data, err := p.ToJSONLD(post.JSONLDParams{Site: site, Author: author, Breadcrumbs: breadcrumbs})
+
+		assertNoError(t, err)
+		work := decode(t, data)[0]
+		if work["@type"] != "Course" || work["name"] != "Lire un menu" {
+			t.Errorf("got type %v, name %v", work["@type"], work["name"])
+		}
+		if _, ok := work["provider"]; !ok {
+			t.Error("expected provider")
+		}
+		if work["educationalLevel"] != "A1" {
+			t.Errorf("got level %v", work["educationalLevel"])
+		}
+	})
+
+	t.Run("includes breadcrumb list ending with the post", func(t *testing.T) {
+		p := newPost(t, post.SchemaTypeBlogPosting)
+
+		doc, err := p.JSONLD(post.JSONLDParams{Site: site, Author: author, Breadcrumbs: breadcrumbs})
+
+		assertNoError(t, err)
+		if len(doc.Graph) != 2 {
+			t.Fatalf("got %d graph nodes, want 2", len(doc.Graph))
+		}
+		list := doc.Graph[1].(post.JSONLDBreadcrumbList)
+		wantItems := []string{"https://fla.example/a1", "https://fla.example/a1/lecture", "https://fla.example/a1/lecture/lire-un-menu"}
+		for i, item := range list.ItemListElement {
+			if item.Position != i+1 || item.Item != wantItems[i] {
+				t.Errorf("item %d: got %+v", i, item)
+			}
+		}
+		work := doc.Graph[0].(post.JSONLDCreativeWork)
+		if work.Breadcrumb == nil || work.Breadcrumb.ID != list.ID {
+			t.Error("expected article to reference the breadcrumb list")
+		}
+	})
+
+	t.Run("never exposes the author email", func(t *testing.T) {
+		p := newPost(t, post.SchemaTypeArticle)
+
+		data, err := p.ToJSONLD(post.JSONLDParams{Site: site, Author: user.User{Username: "marie", Email: "marie@example.com"}})
+
+		assertNoError(t, err)
+		if strings.Contains(string(data), "marie@example.com") {
+			t.Error("email leaked into structured data")
+		}
+	})
+
+	t.Run("rejects invalid schema type", func(t *testing.T) {
+		p := newPost(t, post.SchemaTypeArticle)
+		p.SchemaType = "Recipe"
+
+		_, err := p.ToJSONLD(post.JSONLDParams{Site: site, Author: author})
+
+		assertErrorCode(t, err, kernel.EInvalid)
+	})
+}
@@ -2,7 +2,10 @@ package post
 
 import (
 	"fmt"
+	"slices"
 
+	"github.com/alnah/fla/internal/domain/category"
+	"github.com/alnah/fla/internal/domain/kernel"
 	"github.com/alnah/fla/internal/domain/shared"
 )
 
@@ -35,6 +38,18 @@ func (pl PostsList) Count() int {
 	return len(pl.Posts)
 }
 
+// CategoryIDs returns the distinct categories of the listed posts, in listing order.
+// Pass them to category.PathService.GetBreadcrumbsFor to resolve every trail in one call.
+func (pl PostsList) CategoryIDs() []kernel.ID[category.Category] {
+	var ids []kernel.ID[category.Category]
+	for _, p := range pl.Posts {
+		if !slices.Contains(ids, p.Category.CategoryID) {
+			ids = append(ids, p.Category.CategoryID)
+		}
+	}
+	return ids
+}
+
 // String returns a string representation of the posts list
 func (pl PostsList) String() string {
 	return fmt.Sprintf("PostsList{Count: %d, %s}",
@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/alnah/fla/internal/domain/category"
 	"github.com/alnah/fla/internal/domain/kernel"
 	"github.com/alnah/fla/internal/domain/post"
 	"github.com/alnah/fla/internal/domain/shared"
@@ -118,3 +119,22 @@ func TestNewPostsList(t *testing.T) {
 		}
 	})
 }
+
+func TestPostsList_CategoryIDs(t *testing.T) {
+	inCategory := func(id, categoryID string) post.Post {
+		p := post.Post{PostID: kernel.ID[post.Post](id)}
+		p.Category.CategoryID = kernel.ID[category.Category](categoryID)
+		return p
+	}
+	list := post.NewPostsList([]post.Post{
+		inCategory("p1", "sports"),
+		inCategory("p2", "a1"),
+		inCategory("p3", "sports"),
+	}, shared.Pagination{})
+
+	got := list.CategoryIDs()
+
+	if len(got) != 2 || got[0] != "sports" || got[1] != "a1" {
+		t.Errorf("got %v", got)
+	}
+}
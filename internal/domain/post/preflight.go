@@ -0,0 +1,249 @@
+package post
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/alnah/fla/internal/domain/category"
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/shared"
+)
+
+// MaxRecommendedReadingMinutes is the reading time beyond which a lesson should be split.
+const MaxRecommendedReadingMinutes = 10
+
+// Featured images above these sizes slow down pages and social previews.
+const (
+	MaxFeaturedImageBytes int64 = 1 << 20 // 1 MiB
+	MaxFeaturedImageWidth int   = 2560
+)
+
+// TopicDepth is the category depth of a topic (Level → Skill → Topic).
+const TopicDepth = category.MaxCategoryDepth - 1
+
+const (
+	MPreflightSEODescriptionMissing string = "SEO description is missing; search engines will use the excerpt."
+	MPreflightFeaturedImageMissing  string = "Featured image is missing; social shares will show no picture."
+	MPreflightCategoryTooShallow    string = "Post should be filed under a topic (Level → Skill → Topic), not %q."
+	MPreflightContentTooLong        string = "Reading time is %d minutes; consider splitting lessons over %d minutes."
+	MPreflightInternalLinkBroken    string = "Internal link %q points to a page that does not exist."
+	MPreflightImageAltMissing       string = "Image %q has no alt text."
+	MPreflightImageHostNotAllowed   string = "Image %q is not served from an allowed host."
+	MPreflightFeaturedImageTooLarge string = "Featured image is %d KiB at %dx%d pixels; keep it under %d KiB and %d pixels wide."
+)
+
+// Severity tells editors whether a finding blocks publication.
+type Severity string
+
+const (
+	SeverityError   Severity = "error"   // Must be fixed before Approve/Publish
+	SeverityWarning Severity = "warning" // Should be reviewed, does not block
+)
+
+// FindingCode identifies a preflight check for filtering and translation.
+type FindingCode string
+
+const (
+	FindingSEODescriptionMissing FindingCode = "seo_description_missing"
+	FindingFeaturedImageMissing  FindingCode = "featured_image_missing"
+	FindingCategoryTooShallow    FindingCode = "category_too_shallow"
+	FindingContentTooLong        FindingCode = "content_too_long"
+	FindingInternalLinkBroken    FindingCode = "internal_link_broken"
+	FindingImageAltMissing       FindingCode = "image_alt_missing"
+	FindingImageHostNotAllowed   FindingCode = "image_host_not_allowed"
+	FindingFeaturedImageTooLarge FindingCode = "featured_image_too_large"
+)
+
+// Finding is one preflight result.
+type Finding struct {
+	Code     FindingCode
+	Severity Severity
+	Message  string
+}
+
+// PreflightReport lists everything editors should see before approving or publishing.
+type PreflightReport struct {
+	Findings []Finding
+}
+
+// Errors returns findings that block publication.
+func (r PreflightReport) Errors() []Finding {
+	return r.filter(SeverityError)
+}
+
+// Warnings returns findings that should be reviewed.
+func (r PreflightReport) Warnings() []Finding {
+	return r.filter(SeverityWarning)
+}
+
+// Passed returns true when no finding blocks publication.
+func (r PreflightReport) Passed() bool {
+	return len(r.Errors()) == 0
+}
+
+func (r PreflightReport) filter(severity Severity) []Finding {
+	var found []Finding
+	for _, f := range r.Findings {
+		if f.Severity == severity {
+			found = append(found, f)
+		}
+	}
+	return found
+}
+
+func (r *PreflightReport) add(code FindingCode, severity Severity, message string) {
+	r.Findings = append(r.Findings, Finding{Code: code, Severity: severity, Message: message})
+}
+
+// InternalLinkChecker resolves site-relative paths to published pages.
+// Implemented by the routing layer, which knows every post, category, and static page.
+type InternalLinkChecker interface {
+	// Exists reports whether a site-relative path such as "a1/lecture/lire-un-menu" resolves.
+	Exists(path string) (bool, error)
+}
+
+// ImageInfo is the stored size of an uploaded image.
+type ImageInfo struct {
+	Width    int
+	Height   int
+	ByteSize int64
+}
+
+// ImageInspector reads the size of uploaded images.
+// Implemented by the media library, which records it at upload.
+type ImageInspector interface {
+	// Inspect returns the size of the image at a URL. Returns ENotFound for images outside the library.
+	Inspect(url string) (ImageInfo, error)
+}
+
+// PreflightService checks publication readiness beyond field validation.
+type PreflightService struct {
+	categories    category.CategoryPathBuilder
+	links         InternalLinkChecker
+	inspector     ImageInspector
+	images        kernel.HostPolicy
+	accessibility *AccessibilityService
+	site          shared.Site
+}
+
+// NewPreflightService creates preflight service with category, link, and image
+// lookups, the hosts images may be served from, and the accessibility audit.
+func NewPreflightService(
+	categories category.CategoryPathBuilder,
+	links InternalLinkChecker,
+	inspector ImageInspector,
+	images kernel.HostPolicy,
+	accessibility *AccessibilityService,
+	site shared.Site,
+) *PreflightService {
+	return &PreflightService{
+		categories:    categories,
+		links:         links,
+		inspector:     inspector,
+		images:        images,
+		accessibility: accessibility,
+		site:          site,
+	}
+}
+
+// Check runs every preflight rule and returns errors and warnings together.
+// Lookup failures are returned as errors; rule violations are findings, never errors.
+func (s *PreflightService) Check(p Post) (PreflightReport, error) {
+	const op = "PreflightService.Check"
+
+	var report PreflightReport
+
+	if p.SEODescription == "" {
+		report.add(FindingSEODescriptionMissing, SeverityWarning, MPreflightSEODescriptionMissing)
+	}
+
+	if !p.HasFeaturedImage() {
+		report.add(FindingFeaturedImageMissing, SeverityWarning, MPreflightFeaturedImageMissing)
+	} else {
+		info, err := s.inspector.Inspect(p.FeaturedImage.String())
+		switch {
+		case kernel.ErrorCode(err) == kernel.ENotFound:
+			// External images have no recorded size
+		case err != nil:
+			return PreflightReport{}, &kernel.Error{Operation: op, Cause: err}
+		case info.ByteSize > MaxFeaturedImageBytes || info.Width > MaxFeaturedImageWidth:
+			report.add(FindingFeaturedImageTooLarge, SeverityWarning, fmt.Sprintf(MPreflightFeaturedImageTooLarge,
+				info.ByteSize>>10, info.Width, info.Height, MaxFeaturedImageBytes>>10, MaxFeaturedImageWidth))
+		}
+	}
+
+	path, err := s.categories.BuildPath(p.Category.CategoryID)
+	if err != nil {
+		return PreflightReport{}, &kernel.Error{Operation: op, Cause: err}
+	}
+	if path.Depth() < TopicDepth {
+		report.add(FindingCategoryTooShallow, SeverityError, fmt.Sprintf(MPreflightCategoryTooShallow, path.String()))
+	}
+
+	if minutes := p.EstimatedReadingTime(); minutes > MaxRecommendedReadingMinutes {
+		report.add(FindingContentTooLong, SeverityWarning,
+			fmt.Sprintf(MPreflightContentTooLong, minutes, MaxRecommendedReadingMinutes))
+	}
+
+	content := p.Content.String()
+
+	for _, link := range markdownLinkPattern.FindAllStringSubmatch(content, -1) {
+		if link[1] == "!" {
+			continue // Images are checked below
+		}
+		target, ok := s.internalPath(link[3])
+		if !ok {
+			continue
+		}
+		exists, err := s.links.Exists(target)
+		if err != nil {
+			return PreflightReport{}, &kernel.Error{Operation: op, Cause: err}
+		}
+		if !exists {
+			report.add(FindingInternalLinkBroken, SeverityError, fmt.Sprintf(MPreflightInternalLinkBroken, link[3]))
+		}
+	}
+
+	report.Findings = append(report.Findings, s.accessibility.Audit(p).Findings()...)
+
+	images := []string{p.FeaturedImage.String(), p.OpenGraphImage.String()}
+	for _, image := range markdownImagePattern.FindAllStringSubmatch(content, -1) {
+		images = append(images, image[2])
+	}
+
+	for _, image := range images {
+		if image != "" && s.images.Check(image) != nil {
+			report.add(FindingImageHostNotAllowed, SeverityError, fmt.Sprintf(MPreflightImageHostNotAllowed, image))
+		}
+	}
+
+	return report, nil
+}
+
+var (
+	// Links also match images, with "!" captured first so callers can skip them.
+	markdownLinkPattern  = regexp.MustCompile(`(!?)\[([^\]]*)\]\(([^)\s]+)[^)]*\)`)
+	markdownImagePattern = regexp.MustCompile(`!\[([^\]]*)\]\(([^)\s]+)[^)]*\)`)
+)
+
+// internalPath returns the site-relative path of a link to this site.
+// Anchors, mail links, and other hosts are not internal.
+func (s *PreflightService) internalPath(href string) (string, bool) {
+	u, err := url.Parse(href)
+	if err != nil || u.Scheme == "mailto" || (u.Path == "" && u.Fragment != "") {
+		return "", false
+	}
+
+	if u.Host != "" {
+		base, err := url.Parse(s.site.BaseURL.String())
+		if err != nil || !strings.EqualFold(u.Host, base.Host) {
+			return "", false
+		}
+	} else if u.Scheme != "" {
+		return "", false
+	}
+
+	return strings.Trim(u.Path, "/"), true
+}
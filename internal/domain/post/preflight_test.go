@@ -0,0 +1,239 @@
+package post_test
+
+import (
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/category"
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/post"
+	"github.com/alnah/fla/internal/domain/shared"
+)
+
+type stubCategoryPaths struct {
+	paths map[kernel.ID[category.Category]]category.CategoryPath
+}
+
+func (s *stubCategoryPaths) BuildPath(id kernel.ID[category.Category]) (category.CategoryPath, error) {
+	path, ok := s.paths[id]
+	if !ok {
+		return nil, &kernel.Error{Code: kernel.ENotFound, Message: "category not found"}
+	}
+	return path, nil
+}
+
+func (s *stubCategoryPaths) FindByPath([]string) (*category.Category, error) {
+	return nil, &kernel.Error{Code: kernel.ENotFound, Message: "category not found"}
+}
+
+type stubLinks struct {
+	pages   []string
+	checked []string
+}
+
+func (s *stubLinks) Exists(path string) (bool, error) {
+	s.checked = append(s.checked, path)
+	return slices.Contains(s.pages, path), nil
+}
+
+// stubImages knows the size of library images; others are not found.
+type stubImages struct {
+	sizes map[string]post.ImageInfo
+}
+
+func (s *stubImages) Inspect(url string) (post.ImageInfo, error) {
+	info, ok := s.sizes[url]
+	if !ok {
+		return post.ImageInfo{}, &kernel.Error{Code: kernel.ENotFound, Message: "image not found"}
+	}
+	return info, nil
+}
+
+func findingCodes(findings []post.Finding) []post.FindingCode {
+	codes := make([]post.FindingCode, len(findings))
+	for i, f := range findings {
+		codes[i] = f.Code
+	}
+	return codes
+}
+
+func TestPreflightService_Check(t *testing.T) {
+	clock := &mockClock{now: time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)}
+	site, _ := shared.NewSite("FLA", "https://fla.example", shared.LocaleFrenchFR)
+
+	a1ID, readingID := kernel.ID[category.Category]("a1"), kernel.ID[category.Category]("reading")
+	a1 := category.Category{CategoryID: a1ID, Name: "A1", Slug: "a1", CreatedBy: "user-123"}
+	reading := category.Category{CategoryID: readingID, Name: "Lecture", Slug: "lecture", ParentID: &a1ID, CreatedBy: "user-123"}
+	menus := category.Category{CategoryID: "menus", Name: "Menus", Slug: "menus", ParentID: &readingID, CreatedBy: "user-123"}
+	paths := &stubCategoryPaths{paths: map[kernel.ID[category.Category]]category.CategoryPath{
+		"reading": {a1, reading},
+		"menus":   {a1, reading, menus},
+	}}
+
+	accessibility, err := post.NewAccessibilityService(post.DefaultAccessibilityPolicy())
+	assertNoError(t, err)
+
+	newPost := func(t *testing.T, cat category.Category, body string) post.Post {
+		t.Helper()
+		title, _ := shared.NewTitle("Lire un menu")
+		content, err := post.NewPostContent(body + strings.Repeat(" Le menu propose une soupe.", 12))
+		assertNoError(t, err)
+
+		p, err := post.NewPost(post.NewPostParams{
+			PostID:         "post-123",
+			Owner:          "user-123",
+			Title:          title,
+			Content:        content,
+			Status:         post.StatusDraft,
+			Category:       cat,
+			SEODescription: "Apprendre à lire un menu.",
+			FeaturedImage:  "https://cdn.example/menu.jpg",
+			Clock:          clock,
+		})
+		assertNoError(t, err)
+		return p
+	}
+
+	t.Run("passes a complete post", func(t *testing.T) {
+		links := &stubLinks{pages: []string{"a1/lecture/commander"}}
+		service := post.NewPreflightService(paths, links, &stubImages{}, kernel.HostPolicy{}, accessibility, site)
+		p := newPost(t, menus, "Voir [commander](/a1/lecture/commander) et ![Un menu](https://cdn.example/m.jpg).")
+
+		got, err := service.Check(p)
+
+		assertNoError(t, err)
+		if len(got.Findings) != 0 || !got.Passed() {
+			t.Errorf("got findings %v", got.Findings)
+		}
+	})
+
+	t.Run("separates errors from warnings", func(t *testing.T) {
+		links := &stubLinks{}
+		service := post.NewPreflightService(paths, links, &stubImages{}, kernel.HostPolicy{}, accessibility, site)
+		p := newPost(t, reading, "Voir [la suite](https://fla.example/a1/lecture/suite) et ![](https://cdn.example/m.jpg).")
+		p.SEODescription = ""
+		p.FeaturedImage = ""
+
+		got, err := service.Check(p)
+
+		assertNoError(t, err)
+		wantWarnings := []post.FindingCode{post.FindingSEODescriptionMissing, post.FindingFeaturedImageMissing}
+		wantErrors := []post.FindingCode{post.FindingCategoryTooShallow, post.FindingInternalLinkBroken, post.FindingImageAltMissing}
+		if !slices.Equal(findingCodes(got.Warnings()), wantWarnings) {
+			t.Errorf("got warnings %v, want %v", findingCodes(got.Warnings()), wantWarnings)
+		}
+		if !slices.Equal(findingCodes(got.Errors()), wantErrors) {
+			t.Errorf("got errors %v, want %v", findingCodes(got.Errors()), wantErrors)
+		}
+		if got.Passed() {
+			t.Error("expected report to fail")
+		}
+	})
+
+	t.Run("only checks links to this site", func(t *testing.T) {
+		links := &stubLinks{}
+		service := post.NewPreflightService(paths, links, &stubImages{}, kernel.HostPolicy{}, accessibility, site)
+		p := newPost(t, menus, "Voir [ailleurs](https://autre.example/page), [ici](#suite), [écrire](mailto:a@b.fr) et [là](a1/menus).")
+
+		_, err := service.Check(p)
+
+		assertNoError(t, err)
+		if !slices.Equal(links.checked, []string{"a1/menus"}) {
+			t.Errorf("checked %v", links.checked)
+		}
+	})
+
+	t.Run("checks adjacent links and skips images", func(t *testing.T) {
+		links := &stubLinks{pages: []string{"a1/menus"}}
+		service := post.NewPreflightService(paths, links, &stubImages{}, kernel.HostPolicy{}, accessibility, site)
+		p := newPost(t, menus, "[a](/a1/menus)[b](/a1/boissons)![Un menu](/a1/image.png)")
+
+		got, err := service.Check(p)
+
+		assertNoError(t, err)
+		if !slices.Equal(links.checked, []string{"a1/menus", "a1/boissons"}) {
+			t.Errorf("checked %v", links.checked)
+		}
+		if !slices.Equal(findingCodes(got.Errors()), []post.FindingCode{post.FindingInternalLinkBroken}) {
+			t.Errorf("got errors %v", got.Errors())
+		}
+	})
+
+	t.Run("refuses images from other hosts", func(t *testing.T) {
+		service := post.NewPreflightService(paths, &stubLinks{}, &stubImages{}, kernel.NewHostPolicy("cdn.example"), accessibility, site)
+		p := newPost(t, menus, "Voir ![Un menu](https://images.example/m.jpg) et ![Une carte](/media/carte.png).")
+
+		got, err := service.Check(p)
+
+		assertNoError(t, err)
+		if !slices.Equal(findingCodes(got.Errors()), []post.FindingCode{post.FindingImageHostNotAllowed}) {
+			t.Errorf("got errors %v", got.Errors())
+		}
+	})
+
+	t.Run("warns about long lessons", func(t *testing.T) {
+		service := post.NewPreflightService(paths, &stubLinks{}, &stubImages{}, kernel.HostPolicy{}, accessibility, site)
+		p := newPost(t, menus, strings.Repeat("mot ", post.AverageWordsPerMinute*post.MaxRecommendedReadingMinutes))
+
+		got, err := service.Check(p)
+
+		assertNoError(t, err)
+		if !slices.Equal(findingCodes(got.Warnings()), []post.FindingCode{post.FindingContentTooLong}) {
+			t.Errorf("got warnings %v", findingCodes(got.Warnings()))
+		}
+		if !got.Passed() {
+			t.Error("warnings must not block publication")
+		}
+	})
+
+	t.Run("includes the accessibility audit", func(t *testing.T) {
+		service := post.NewPreflightService(paths, &stubLinks{}, &stubImages{}, kernel.HostPolicy{}, accessibility, site)
+		p := newPost(t, menus, "## Entrées\n\n#### Soupes\n\nPour le dessert, [cliquez ici](https://autre.example/desserts).\n")
+
+		got, err := service.Check(p)
+
+		assertNoError(t, err)
+		want := []post.FindingCode{post.FindingHeadingLevelSkipped, post.FindingLinkTextVague}
+		if !slices.Equal(findingCodes(got.Warnings()), want) {
+			t.Errorf("got warnings %v, want %v", findingCodes(got.Warnings()), want)
+		}
+	})
+
+	t.Run("warns about oversized featured images", func(t *testing.T) {
+		p := newPost(t, menus, "")
+		tests := []struct {
+			name string
+			info post.ImageInfo
+			want []post.FindingCode
+		}{
+			{"reasonable", post.ImageInfo{Width: 1600, Height: 900, ByteSize: 300 << 10}, nil},
+			{"too heavy", post.ImageInfo{Width: 1600, Height: 900, ByteSize: 3 << 20}, []post.FindingCode{post.FindingFeaturedImageTooLarge}},
+			{"too wide", post.ImageInfo{Width: 6000, Height: 4000, ByteSize: 900 << 10}, []post.FindingCode{post.FindingFeaturedImageTooLarge}},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				images := &stubImages{sizes: map[string]post.ImageInfo{p.FeaturedImage.String(): tt.info}}
+				service := post.NewPreflightService(paths, &stubLinks{}, images, kernel.HostPolicy{}, accessibility, site)
+
+				got, err := service.Check(p)
+
+				assertNoError(t, err)
+				if !slices.Equal(findingCodes(got.Warnings()), tt.want) {
+					t.Errorf("got warnings %v, want %v", got.Warnings(), tt.want)
+				}
+			})
+		}
+	})
+
+	t.Run("propagates category lookup errors", func(t *testing.T) {
+		service := post.NewPreflightService(paths, &stubLinks{}, &stubImages{}, kernel.HostPolicy{}, accessibility, site)
+		p := newPost(t, menus, "")
+		p.Category.CategoryID = "missing"
+
+		_, err := service.Check(p)
+
+		assertErrorCode(t, err, kernel.ENotFound)
+	})
+}
@@ -0,0 +1,65 @@
+package post
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/shared"
+)
+
+// MaxPronunciationsPerPost keeps the pronunciation box of a lesson readable.
+const MaxPronunciationsPerPost int = 30
+
+const (
+	MPostTooManyPronunciations  string = "A post can have at most %d pronunciations."
+	MPostPronunciationDuplicate string = "Pronunciation of %q is listed more than once."
+)
+
+func (p Post) validatePronunciations() error {
+	const op = "Post.validatePronunciations"
+
+	if len(p.Pronunciations) > MaxPronunciationsPerPost {
+		return &kernel.Error{Code: kernel.EInvalid, Message: fmt.Sprintf(MPostTooManyPronunciations, MaxPronunciationsPerPost), Operation: op}
+	}
+
+	seen := make(map[string]bool, len(p.Pronunciations))
+	for _, pronunciation := range p.Pronunciations {
+		if err := pronunciation.Validate(); err != nil {
+			return &kernel.Error{Operation: op, Cause: err}
+		}
+		key := strings.ToLower(pronunciation.Term)
+		if seen[key] {
+			return &kernel.Error{Code: kernel.EInvalid, Message: fmt.Sprintf(MPostPronunciationDuplicate, pronunciation.Term), Operation: op}
+		}
+		seen[key] = true
+	}
+
+	return nil
+}
+
+// PronunciationOf returns the pronunciation given for a term, matched case-insensitively.
+func (p Post) PronunciationOf(term string) (shared.Pronunciation, bool) {
+	i := slices.IndexFunc(p.Pronunciations, func(pr shared.Pronunciation) bool {
+		return strings.EqualFold(pr.Term, strings.TrimSpace(term))
+	})
+	if i < 0 {
+		return shared.Pronunciation{}, false
+	}
+	return p.Pronunciations[i], true
+}
+
+// SetPronunciations replaces the post's pronunciations.
+func (p Post) SetPronunciations(pronunciations []shared.Pronunciation) (Post, error) {
+	const op = "Post.SetPronunciations"
+
+	updated := p
+	updated.Pronunciations = slices.Clone(pronunciations)
+	if err := updated.validatePronunciations(); err != nil {
+		return p, &kernel.Error{Operation: op, Cause: err}
+	}
+	updated.UpdatedAt = p.Clock.Now()
+
+	return updated, nil
+}
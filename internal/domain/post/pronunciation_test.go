@@ -0,0 +1,59 @@
+package post_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/post"
+	"github.com/alnah/fla/internal/domain/shared"
+)
+
+func TestPost_SetPronunciations(t *testing.T) {
+	clock := &mockClock{now: time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)}
+	p, err := post.NewPost(post.NewPostParams{
+		PostID:   "lesson",
+		Owner:    "author-1",
+		Title:    "Les salutations",
+		Content:  post.PostContent(strings.Repeat("Bonjour, je m'appelle Léa. ", 15)),
+		Status:   post.StatusDraft,
+		Category: createTestCategory(t, clock),
+		Clock:    clock,
+	})
+	assertNoError(t, err)
+
+	bonjour, err := shared.NewPronunciation("bonjour", "bɔ̃ʒuʁ", "", shared.LocaleFrenchFR)
+	assertNoError(t, err)
+
+	t.Run("finds pronunciations by term", func(t *testing.T) {
+		updated, err := p.SetPronunciations([]shared.Pronunciation{bonjour})
+
+		assertNoError(t, err)
+		got, ok := updated.PronunciationOf("Bonjour")
+		if !ok || got.IPA != "bɔ̃ʒuʁ" {
+			t.Errorf("got %v, %t", got, ok)
+		}
+		if _, ok := p.PronunciationOf("bonjour"); ok {
+			t.Error("original post changed")
+		}
+	})
+
+	t.Run("rejects the same term twice", func(t *testing.T) {
+		again := bonjour
+		again.Term = "Bonjour"
+
+		_, err := p.SetPronunciations([]shared.Pronunciation{bonjour, again})
+
+		assertErrorCode(t, err, kernel.EInvalid)
+	})
+
+	t.Run("rejects invalid transcriptions", func(t *testing.T) {
+		invalid := bonjour
+		invalid.IPA = "BONJOUR"
+
+		_, err := p.SetPronunciations([]shared.Pronunciation{invalid})
+
+		assertErrorCode(t, err, kernel.EInvalid)
+	})
+}
@@ -0,0 +1,66 @@
+package post
+
+import (
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+// ScopePublish is the idempotency scope of publishing a post.
+const ScopePublish string = "post.publish"
+
+// PublishRepository loads a post and saves it once published.
+type PublishRepository interface {
+	PostReader
+	PostWriter
+}
+
+// PublishService publishes posts on request, from the editor or an automation webhook.
+type PublishService struct {
+	posts    PublishRepository
+	requests kernel.IdempotencyStore
+	clock    kernel.Clock
+}
+
+// NewPublishService creates publish service with a post repository and idempotency records.
+func NewPublishService(posts PublishRepository, requests kernel.IdempotencyStore, clock kernel.Clock) *PublishService {
+	return &PublishService{posts: posts, requests: requests, clock: clock}
+}
+
+// Publish makes a post live on behalf of actor. A retry carrying the same idempotency
+// key returns the post as it is now instead of publishing it again, which would move
+// its publication date and notify subscribers twice.
+func (s *PublishService) Publish(key kernel.IdempotencyKey, postID kernel.ID[Post], actor user.PostPermissionChecker) (Post, error) {
+	const op = "PublishService.Publish"
+
+	if _, _, err := kernel.Idempotent(s.requests, s.clock, ScopePublish, key, postID.String(), func() (string, error) {
+		return postID.String(), s.publish(postID, actor)
+	}); err != nil {
+		return Post{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	p, err := s.posts.GetByID(postID)
+	if err != nil {
+		return Post{}, &kernel.Error{Operation: op, Cause: err}
+	}
+	return *p, nil
+}
+
+func (s *PublishService) publish(postID kernel.ID[Post], actor user.PostPermissionChecker) error {
+	const op = "PublishService.publish"
+
+	p, err := s.posts.GetByID(postID)
+	if err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	p.Clock = s.clock
+	published, err := p.Publish(actor)
+	if err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if err := s.posts.Update(published); err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+	return nil
+}
@@ -0,0 +1,94 @@
+package post_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/post"
+	"github.com/alnah/fla/internal/domain/shared"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+type stubPublishRepository struct {
+	posts   map[kernel.ID[post.Post]]post.Post
+	updates int
+}
+
+func (s *stubPublishRepository) GetByID(id kernel.ID[post.Post]) (*post.Post, error) {
+	if p, ok := s.posts[id]; ok {
+		return &p, nil
+	}
+	return nil, &kernel.Error{Code: kernel.ENotFound, Message: "post not found"}
+}
+
+func (s *stubPublishRepository) GetBySlug(shared.Slug) (*post.Post, error) {
+	return nil, &kernel.Error{Code: kernel.ENotFound, Message: "post not found"}
+}
+
+func (s *stubPublishRepository) Create(p post.Post) error {
+	s.posts[p.PostID] = p
+	return nil
+}
+
+func (s *stubPublishRepository) Update(p post.Post) error {
+	s.posts[p.PostID] = p
+	s.updates++
+	return nil
+}
+
+func (s *stubPublishRepository) Delete(id kernel.ID[post.Post]) error {
+	delete(s.posts, id)
+	return nil
+}
+
+func TestPublishService_Publish(t *testing.T) {
+	now := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	clock := &mockClock{now: now}
+	editor := &mockUser{id: "editor-1", roles: []user.Role{user.RoleEditor}}
+
+	draft, err := post.NewPost(post.NewPostParams{
+		PostID:   "lesson",
+		Owner:    "author-1",
+		Title:    "Leçon sur le marché du samedi",
+		Content:  post.PostContent(strings.Repeat("Le samedi, je vais au marché. ", 12)),
+		Status:   post.StatusDraft,
+		Category: createTestCategory(t, clock),
+		Clock:    clock,
+	})
+	assertNoError(t, err)
+	approver := kernel.ID[user.User]("editor-1")
+	draft.ApprovedBy, draft.ApprovedAt = &approver, &now
+
+	repo := &stubPublishRepository{posts: map[kernel.ID[post.Post]]post.Post{"lesson": draft}}
+	service := post.NewPublishService(repo, newStubIdempotencyStore(), clock)
+
+	published, err := service.Publish("publish-0001", "lesson", editor)
+	assertNoError(t, err)
+	if published.Status != post.StatusPublished || !published.PublishedAt.Equal(now) {
+		t.Fatalf("got %s at %v", published.Status, published.PublishedAt)
+	}
+
+	t.Run("retry succeeds without publishing again", func(t *testing.T) {
+		clock.now = now.Add(time.Minute)
+		got, err := service.Publish("publish-0001", "lesson", editor)
+		assertNoError(t, err)
+		if repo.updates != 1 || !got.PublishedAt.Equal(now) {
+			t.Errorf("%d updates, published at %v", repo.updates, got.PublishedAt)
+		}
+	})
+
+	t.Run("new key is a new request", func(t *testing.T) {
+		_, err := service.Publish("publish-0002", "lesson", editor)
+		assertNoError(t, err)
+		if repo.updates != 2 {
+			t.Errorf("got %d updates, want 2", repo.updates)
+		}
+	})
+
+	t.Run("key reused for another post", func(t *testing.T) {
+		_, err := service.Publish("publish-0001", "other", editor)
+		assertErrorCode(t, err, kernel.EConflict)
+	})
+}
@@ -0,0 +1,282 @@
+package post
+
+import (
+	"cmp"
+	"slices"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/category"
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/shared"
+	"github.com/alnah/fla/internal/domain/tag"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+// MaxQueryTags bounds tag filters so a query stays index-friendly.
+const MaxQueryTags = 20
+
+const (
+	MQueryRangeInvalid  string = "Publication range must end after it starts."
+	MQueryTooManyTags   string = "A query can filter on at most 20 tags."
+	MQueryStatusInvalid string = "Invalid status filter."
+)
+
+// Fields posts can be sorted by.
+const (
+	SortFieldPublishedAt = "published_at"
+	SortFieldTitle       = "title"
+	SortFieldReadingTime = "reading_time"
+	SortFieldWordCount   = "word_count"
+)
+
+// SortFields whitelists the fields a post listing can be sorted by.
+var SortFields = shared.SortFields{SortFieldPublishedAt, SortFieldTitle, SortFieldReadingTime, SortFieldWordCount}
+
+// Common listing orders.
+var (
+	SortNewest = shared.Sort{shared.Desc(SortFieldPublishedAt)} // Most recently published first (default)
+	SortOldest = shared.Sort{shared.Asc(SortFieldPublishedAt)}  // Earliest published first
+	SortTitle  = shared.Sort{shared.Asc(SortFieldTitle)}        // Alphabetical by title
+)
+
+// DateRange is a half-open period [From, To); a zero bound leaves that side open.
+type DateRange struct {
+	From time.Time
+	To   time.Time
+}
+
+// Contains reports whether t falls within the range.
+func (r DateRange) Contains(t time.Time) bool {
+	return (r.From.IsZero() || !t.Before(r.From)) && (r.To.IsZero() || t.Before(r.To))
+}
+
+// Query describes which posts a listing wants; empty fields do not filter.
+// Filters combine with AND; TagIDs match posts carrying any of the tags.
+type Query struct {
+	Statuses         []Status
+	CategorySubtree  *kernel.ID[category.Category] // Category and all its descendants
+	TagIDs           []kernel.ID[tag.Tag]
+	Locale           *shared.Locale // Posts of unknown language never match
+	PublishedBetween *DateRange
+	OwnerID          *kernel.ID[user.User]
+	Sort             shared.Sort // Empty sorts newest first
+	Pagination       shared.Pagination
+}
+
+// NewQuery starts a query on the first page with the default limit, newest first.
+func NewQuery() Query {
+	return Query{
+		Sort:       slices.Clone(SortNewest),
+		Pagination: shared.Pagination{Page: 1, Limit: shared.DefaultPageLimit},
+	}
+}
+
+// PublishedQuery starts a query for public listings.
+func PublishedQuery() Query {
+	return NewQuery().WithStatuses(StatusPublished)
+}
+
+// WithStatuses restricts results to the given statuses.
+func (q Query) WithStatuses(statuses ...Status) Query {
+	q.Statuses = slices.Clone(statuses)
+	return q
+}
+
+// InCategory restricts results to a category and its descendants.
+func (q Query) InCategory(categoryID kernel.ID[category.Category]) Query {
+	q.CategorySubtree = &categoryID
+	return q
+}
+
+// WithAnyTag restricts results to posts carrying at least one of the tags.
+func (q Query) WithAnyTag(tagIDs ...kernel.ID[tag.Tag]) Query {
+	q.TagIDs = slices.Clone(tagIDs)
+	return q
+}
+
+// InLocale restricts results to posts written in a locale.
+func (q Query) InLocale(locale shared.Locale) Query {
+	q.Locale = &locale
+	return q
+}
+
+// PublishedIn restricts results to posts published within [from, to).
+func (q Query) PublishedIn(from, to time.Time) Query {
+	q.PublishedBetween = &DateRange{From: from, To: to}
+	return q
+}
+
+// OwnedBy restricts results to one author.
+func (q Query) OwnedBy(ownerID kernel.ID[user.User]) Query {
+	q.OwnerID = &ownerID
+	return q
+}
+
+// SortBy sets the result order; later keys break ties of earlier ones.
+func (q Query) SortBy(keys ...shared.SortKey) Query {
+	q.Sort = slices.Clone(shared.Sort(keys))
+	return q
+}
+
+// Page selects a page and page size.
+func (q Query) Page(page, limit int) Query {
+	q.Pagination = shared.Pagination{Page: page, Limit: limit}
+	return q
+}
+
+// Validate ensures every filter is well-formed before it reaches the repository.
+func (q Query) Validate() error {
+	const op = "Query.Validate"
+
+	for _, s := range q.Statuses {
+		if err := s.Validate(); err != nil {
+			return &kernel.Error{Code: kernel.EInvalid, Message: MQueryStatusInvalid, Operation: op, Cause: err}
+		}
+	}
+
+	if len(q.TagIDs) > MaxQueryTags {
+		return &kernel.Error{Code: kernel.EInvalid, Message: MQueryTooManyTags, Operation: op}
+	}
+
+	if q.Locale != nil {
+		if err := q.Locale.Validate(); err != nil {
+			return &kernel.Error{Operation: op, Cause: err}
+		}
+	}
+
+	if r := q.PublishedBetween; r != nil && !r.From.IsZero() && !r.To.IsZero() && !r.To.After(r.From) {
+		return &kernel.Error{Code: kernel.EInvalid, Message: MQueryRangeInvalid, Operation: op}
+	}
+
+	if err := q.Sort.Validate(SortFields); err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if err := q.Pagination.Validate(); err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return nil
+}
+
+// Matches reports whether a post satisfies the filters, for in-memory implementations and tests.
+// Path is the post's category path (root first).
+func (q Query) Matches(p Post, path category.CategoryPath) bool {
+	if len(q.Statuses) > 0 && !slices.Contains(q.Statuses, p.Status) {
+		return false
+	}
+
+	if q.CategorySubtree != nil && !slices.ContainsFunc(path, func(c category.Category) bool {
+		return c.CategoryID == *q.CategorySubtree
+	}) {
+		return false
+	}
+
+	if len(q.TagIDs) > 0 && !slices.ContainsFunc(q.TagIDs, p.Tags.Contains) {
+		return false
+	}
+
+	if q.PublishedBetween != nil && (p.PublishedAt == nil || !q.PublishedBetween.Contains(*p.PublishedAt)) {
+		return false
+	}
+
+	if q.OwnerID != nil && p.Owner != *q.OwnerID {
+		return false
+	}
+
+	if q.Locale != nil && p.Locale != *q.Locale {
+		return false
+	}
+
+	return true
+}
+
+// Compare orders two posts following the query sort, for in-memory implementations.
+// Unpublished posts sort after published ones in either direction; ties fall back to the post ID.
+// Sorting on reading time or word count this way summarizes posts on every comparison;
+// SortPosts summarizes each post once.
+func (q Query) Compare(a, b Post) int {
+	return q.compare(&ranked{post: a}, &ranked{post: b})
+}
+
+// SortPosts orders posts following the query sort, like Compare. Summaries, keyed by
+// post ID, are used when current; the others are computed at most once per post.
+func (q Query) SortPosts(posts []Post, summaries map[kernel.ID[Post]]PostSummary) {
+	rs := make([]*ranked, len(posts))
+	for i, p := range posts {
+		rs[i] = &ranked{post: p}
+		if s, ok := summaries[p.PostID]; ok && s.IsCurrentFor(p.Content) {
+			rs[i].summary = &s
+		}
+	}
+
+	slices.SortFunc(rs, q.compare)
+	for i, r := range rs {
+		posts[i] = r.post
+	}
+}
+
+func (q Query) compare(a, b *ranked) int {
+	sort := q.Sort
+	if len(sort) == 0 {
+		sort = SortNewest
+	}
+
+	for _, k := range sort {
+		if c := compareField(a, b, k); c != 0 {
+			return c
+		}
+	}
+	return cmp.Compare(a.post.PostID, b.post.PostID)
+}
+
+// ranked is a post being sorted, summarized at most once and only if a sort key needs it.
+type ranked struct {
+	post    Post
+	summary *PostSummary
+}
+
+func (r *ranked) Summary() PostSummary {
+	if r.summary == nil {
+		s := r.post.Summary()
+		r.summary = &s
+	}
+	return *r.summary
+}
+
+// compareField orders two posts on one sort key.
+func compareField(a, b *ranked, k shared.SortKey) int {
+	if k.Field == SortFieldPublishedAt {
+		return comparePublished(a.post, b.post, k.IsDescending())
+	}
+
+	var c int
+	switch k.Field {
+	case SortFieldTitle:
+		c = cmp.Compare(a.post.Title, b.post.Title)
+	case SortFieldReadingTime:
+		c = cmp.Compare(a.Summary().ReadingMinutes, b.Summary().ReadingMinutes)
+	case SortFieldWordCount:
+		c = cmp.Compare(a.Summary().WordCount, b.Summary().WordCount)
+	}
+	if k.IsDescending() {
+		return -c
+	}
+	return c
+}
+
+// comparePublished orders by publication time with unpublished posts last either way.
+func comparePublished(a, b Post, newestFirst bool) int {
+	switch {
+	case a.PublishedAt == nil && b.PublishedAt == nil:
+		return 0
+	case a.PublishedAt == nil:
+		return 1
+	case b.PublishedAt == nil:
+		return -1
+	case newestFirst:
+		return b.PublishedAt.Compare(*a.PublishedAt)
+	default:
+		return a.PublishedAt.Compare(*b.PublishedAt)
+	}
+}
@@ -0,0 +1,154 @@
+package post_test
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/category"
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/post"
+	"github.com/alnah/fla/internal/domain/shared"
+	"github.com/alnah/fla/internal/domain/tag"
+)
+
+func TestQuery_Validate(t *testing.T) {
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 1, 0)
+	tooManyTags := make([]kernel.ID[tag.Tag], post.MaxQueryTags+1)
+	for i := range tooManyTags {
+		tooManyTags[i] = kernel.ID[tag.Tag](string(rune('a' + i)))
+	}
+
+	tests := []struct {
+		name  string
+		query post.Query
+		code  string
+	}{
+		{"default query", post.NewQuery(), ""},
+		{"full query", post.PublishedQuery().InCategory("a1").WithAnyTag("grammar").InLocale(shared.LocaleFrenchFR).PublishedIn(from, to).OwnedBy("marie").SortBy(post.SortTitle...).Page(2, 20), ""},
+		{"open-ended range", post.NewQuery().PublishedIn(from, time.Time{}), ""},
+		{"invalid status", post.NewQuery().WithStatuses("deleted"), kernel.EInvalid},
+		{"inverted range", post.NewQuery().PublishedIn(to, from), kernel.EInvalid},
+		{"too many tags", post.NewQuery().WithAnyTag(tooManyTags...), kernel.EInvalid},
+		{"invalid locale", post.NewQuery().InLocale("xx-XX"), kernel.EInvalid},
+		{"multi-key sort", post.NewQuery().SortBy(shared.Desc(post.SortFieldWordCount), shared.Asc(post.SortFieldTitle)), ""},
+		{"unsupported sort field", post.NewQuery().SortBy(shared.Asc("owner_id")), kernel.EInvalid},
+		{"invalid page size", post.NewQuery().Page(1, shared.MaxPageLimit+1), kernel.EInvalid},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.query.Validate()
+
+			if tt.code == "" {
+				assertNoError(t, err)
+				return
+			}
+			assertErrorCode(t, err, tt.code)
+		})
+	}
+}
+
+func TestQuery_Matches(t *testing.T) {
+	a1 := category.Category{CategoryID: "a1"}
+	reading := category.Category{CategoryID: "reading"}
+	published := time.Date(2024, 3, 5, 0, 0, 0, 0, time.UTC)
+
+	lesson := post.Post{
+		PostID:      "p1",
+		Owner:       "marie",
+		Status:      post.StatusPublished,
+		PublishedAt: &published,
+		Tags:        post.PostTags{"grammar"},
+		Locale:      shared.LocaleFrenchFR,
+	}
+	path := category.CategoryPath{a1, reading}
+
+	tests := []struct {
+		name  string
+		query post.Query
+		path  category.CategoryPath
+		want  bool
+	}{
+		{"empty query matches", post.NewQuery(), path, true},
+		{"status", post.NewQuery().WithStatuses(post.StatusDraft), path, false},
+		{"category subtree includes descendants", post.NewQuery().InCategory("a1"), path, true},
+		{"category subtree excludes other branches", post.NewQuery().InCategory("b1"), path, false},
+		{"any tag", post.NewQuery().WithAnyTag("vocabulary", "grammar"), path, true},
+		{"missing tag", post.NewQuery().WithAnyTag("vocabulary"), path, false},
+		{"inside range", post.NewQuery().PublishedIn(published, published.AddDate(0, 0, 1)), path, true},
+		{"range end is exclusive", post.NewQuery().PublishedIn(published.AddDate(0, 0, -1), published), path, false},
+		{"owner", post.NewQuery().OwnedBy("paul"), path, false},
+		{"locale", post.NewQuery().InLocale(shared.LocaleFrenchFR), path, true},
+		{"other locale", post.NewQuery().InLocale(shared.LocalePortugueseBR), path, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.query.Matches(lesson, tt.path); got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("posts of unknown language never match a locale", func(t *testing.T) {
+		untyped := lesson
+		untyped.Locale = ""
+
+		if post.NewQuery().InLocale(shared.LocaleFrenchFR).Matches(untyped, path) {
+			t.Error("expected no match")
+		}
+	})
+
+	t.Run("unpublished posts never match a date range", func(t *testing.T) {
+		draft := lesson
+		draft.PublishedAt = nil
+
+		if post.NewQuery().PublishedIn(published, time.Time{}).Matches(draft, path) {
+			t.Error("expected no match")
+		}
+	})
+}
+
+func TestQuery_Compare(t *testing.T) {
+	day := func(d int) *time.Time {
+		t := time.Date(2024, 3, d, 0, 0, 0, 0, time.UTC)
+		return &t
+	}
+	posts := []post.Post{
+		{PostID: "p1", Title: "Banane", Content: "un deux trois", PublishedAt: day(2)},
+		{PostID: "p2", Title: "Abricot", Content: "un deux"},
+		{PostID: "p3", Title: "Cerise", Content: "un deux", PublishedAt: day(5)},
+	}
+	ids := func(sort shared.Sort) []string {
+		sorted := slices.Clone(posts)
+		q := post.NewQuery().SortBy(sort...)
+		slices.SortFunc(sorted, q.Compare)
+		var got []string
+		for _, p := range sorted {
+			got = append(got, p.PostID.String())
+		}
+		return got
+	}
+
+	tests := []struct {
+		name string
+		sort shared.Sort
+		want []string
+	}{
+		{"default", nil, []string{"p3", "p1", "p2"}},
+		{"newest", post.SortNewest, []string{"p3", "p1", "p2"}},
+		{"oldest keeps unpublished last", post.SortOldest, []string{"p1", "p3", "p2"}},
+		{"title", post.SortTitle, []string{"p2", "p1", "p3"}},
+		{"word count then title", shared.Sort{shared.Desc(post.SortFieldWordCount), shared.Asc(post.SortFieldTitle)}, []string{"p1", "p2", "p3"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ids(tt.sort); !slices.Equal(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
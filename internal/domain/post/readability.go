@@ -0,0 +1,143 @@
+package post
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/alnah/fla/internal/domain/category"
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/shared"
+)
+
+// LongWordLength is the letter count from which a word counts as long in LIX.
+const LongWordLength = 7
+
+// ReadabilityReport summarizes how hard a text is for language learners.
+// Metrics are computed on plain text, with Markdown stripped.
+type ReadabilityReport struct {
+	Sentences             int
+	Words                 int
+	UniqueWords           int
+	LongWords             int     // Words with at least LongWordLength letters
+	AverageSentenceLength float64 // Words per sentence
+	AverageWordLength     float64 // Letters per word
+	TypeTokenRatio        float64 // Unique words / words; higher means richer vocabulary
+	LIX                   float64 // Läsbarhetsindex: sentence length + percentage of long words
+
+	// EstimatedLevels maps each supported locale to the CEFR level the text suggests.
+	// Thresholds differ per language because word length differs (French words run longer than English).
+	EstimatedLevels map[shared.Locale]shared.CEFRLevel
+}
+
+// LevelFor returns the estimated level for a locale, falling back to the default locale.
+func (r ReadabilityReport) LevelFor(locale shared.Locale) shared.CEFRLevel {
+	if level, ok := r.EstimatedLevels[locale]; ok {
+		return level
+	}
+	return r.EstimatedLevels[shared.DefaultLocale]
+}
+
+// LevelGap compares the estimate with a declared level for one locale.
+// Positive means the text reads harder than declared; negative means easier.
+func (r ReadabilityReport) LevelGap(declared shared.CEFRLevel, locale shared.Locale) int {
+	estimated := r.LevelFor(locale)
+	if estimated.Rank() == 0 || declared.Rank() == 0 {
+		return 0
+	}
+	return estimated.Rank() - declared.Rank()
+}
+
+// lixThresholds holds the upper LIX bound of A1 through C1; anything above is C2.
+type lixThresholds [5]float64
+
+// readabilityProfiles calibrates LIX per supported locale.
+var readabilityProfiles = map[shared.Locale]lixThresholds{
+	shared.LocaleEnglishUS:    {20, 28, 36, 44, 52},
+	shared.LocaleFrenchFR:     {24, 32, 40, 48, 56},
+	shared.LocalePortugueseBR: {24, 32, 40, 48, 56},
+}
+
+// Analyze computes readability metrics to help authors match content to the level category.
+func (p Post) Analyze() ReadabilityReport {
+	return AnalyzeText(kernel.StripMarkdown(p.Content.String()))
+}
+
+// DeclaredLevelGap compares the estimate for a locale with the level of the post's category path.
+// Returns false when the path has no level root.
+func (p Post) DeclaredLevelGap(path category.CategoryPath, locale shared.Locale) (int, bool) {
+	declared, ok := path.Level()
+	if !ok {
+		return 0, false
+	}
+	return p.Analyze().LevelGap(declared, locale), true
+}
+
+// AnalyzeText computes readability metrics for plain text.
+func AnalyzeText(text string) ReadabilityReport {
+	report := ReadabilityReport{EstimatedLevels: make(map[shared.Locale]shared.CEFRLevel, len(readabilityProfiles))}
+
+	unique := make(map[string]struct{})
+	letters := 0
+
+	for _, sentence := range splitSentences(text) {
+		words := splitWords(sentence)
+		if len(words) == 0 {
+			continue
+		}
+
+		report.Sentences++
+		for _, word := range words {
+			n := utf8.RuneCountInString(word)
+			letters += n
+			if n >= LongWordLength {
+				report.LongWords++
+			}
+			unique[strings.ToLower(word)] = struct{}{}
+		}
+		report.Words += len(words)
+	}
+
+	report.UniqueWords = len(unique)
+
+	if report.Words > 0 {
+		report.AverageSentenceLength = float64(report.Words) / float64(report.Sentences)
+		report.AverageWordLength = float64(letters) / float64(report.Words)
+		report.TypeTokenRatio = float64(report.UniqueWords) / float64(report.Words)
+		report.LIX = report.AverageSentenceLength + 100*float64(report.LongWords)/float64(report.Words)
+	}
+
+	for locale, thresholds := range readabilityProfiles {
+		report.EstimatedLevels[locale] = estimateLevel(report.LIX, thresholds)
+	}
+
+	return report
+}
+
+// estimateLevel maps a LIX score to the first level whose bound it does not exceed.
+func estimateLevel(lix float64, thresholds lixThresholds) shared.CEFRLevel {
+	for i, bound := range thresholds {
+		if lix <= bound {
+			return shared.CEFRLevels[i]
+		}
+	}
+	return shared.LevelC2
+}
+
+// splitSentences cuts on terminal punctuation and line breaks, so headings and list items count.
+func splitSentences(text string) []string {
+	return strings.FieldsFunc(text, func(r rune) bool {
+		switch r {
+		case '.', '!', '?', '…', '\n':
+			return true
+		}
+		return false
+	})
+}
+
+// splitWords keeps letter and digit runs; apostrophes and hyphens separate words ("l'école" → "l", "école").
+func splitWords(sentence string) []string {
+	return strings.FieldsFunc(sentence, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
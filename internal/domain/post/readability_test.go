@@ -0,0 +1,154 @@
+package post_test
+
+import (
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/category"
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/post"
+	"github.com/alnah/fla/internal/domain/shared"
+)
+
+func TestAnalyzeText(t *testing.T) {
+	t.Run("computes sentence and vocabulary metrics", func(t *testing.T) {
+		got := post.AnalyzeText("Je mange une pomme. Tu manges une pomme!\nIl mange.")
+
+		if got.Sentences != 3 || got.Words != 10 {
+			t.Fatalf("got %d sentences, %d words", got.Sentences, got.Words)
+		}
+		if got.UniqueWords != 7 {
+			t.Errorf("got %d unique words, want 7", got.UniqueWords)
+		}
+		if math.Abs(got.TypeTokenRatio-0.7) > 1e-9 {
+			t.Errorf("got type-token ratio %v, want 0.7", got.TypeTokenRatio)
+		}
+		if math.Abs(got.AverageSentenceLength-10.0/3) > 1e-9 {
+			t.Errorf("got average sentence length %v", got.AverageSentenceLength)
+		}
+	})
+
+	t.Run("treats case-insensitive repeats as one word", func(t *testing.T) {
+		got := post.AnalyzeText("Bonjour. bonjour. BONJOUR.")
+
+		if got.UniqueWords != 1 {
+			t.Errorf("got %d unique words, want 1", got.UniqueWords)
+		}
+	})
+
+	t.Run("splits elisions", func(t *testing.T) {
+		got := post.AnalyzeText("L'école est là.")
+
+		if got.Words != 4 {
+			t.Errorf("got %d words, want 4", got.Words)
+		}
+	})
+
+	t.Run("rates short simple text easier than long complex text", func(t *testing.T) {
+		simple := post.AnalyzeText(strings.Repeat("Je suis là. Il fait beau. ", 10))
+		complex := post.AnalyzeText(strings.Repeat(
+			"Nonobstant les considérations administratives particulièrement contraignantes, "+
+				"la commission extraordinaire recommande formellement l'établissement immédiat "+
+				"d'indicateurs environnementaux supplémentaires. ", 5))
+
+		if simple.LevelFor(shared.LocaleFrenchFR) != shared.LevelA1 {
+			t.Errorf("simple text: got %s (LIX %.1f)", simple.LevelFor(shared.LocaleFrenchFR), simple.LIX)
+		}
+		if complex.LevelFor(shared.LocaleFrenchFR) != shared.LevelC2 {
+			t.Errorf("complex text: got %s (LIX %.1f)", complex.LevelFor(shared.LocaleFrenchFR), complex.LIX)
+		}
+	})
+
+	t.Run("estimates a level for every supported locale", func(t *testing.T) {
+		got := post.AnalyzeText("Un texte court.")
+
+		for _, locale := range shared.SupportedLocales {
+			if _, ok := got.EstimatedLevels[locale]; !ok {
+				t.Errorf("missing estimate for %s", locale)
+			}
+		}
+	})
+
+	t.Run("handles empty text", func(t *testing.T) {
+		got := post.AnalyzeText("")
+
+		if got.Words != 0 || got.LIX != 0 || got.LevelFor(shared.LocaleEnglishUS) != shared.LevelA1 {
+			t.Errorf("got %+v", got)
+		}
+	})
+}
+
+func TestReadabilityReport_LevelGap(t *testing.T) {
+	report := post.ReadabilityReport{EstimatedLevels: map[shared.Locale]shared.CEFRLevel{
+		shared.LocaleEnglishUS: shared.LevelB1,
+		shared.LocaleFrenchFR:  shared.LevelA2,
+	}}
+
+	tests := []struct {
+		declared shared.CEFRLevel
+		locale   shared.Locale
+		want     int
+	}{
+		{shared.LevelA1, shared.LocaleFrenchFR, 1},
+		{shared.LevelA2, shared.LocaleFrenchFR, 0},
+		{shared.LevelC1, shared.LocaleEnglishUS, -2},
+		{shared.LevelA1, "de-DE", 2}, // falls back to default locale
+		{"Z9", shared.LocaleFrenchFR, 0},
+	}
+
+	for _, tt := range tests {
+		if got := report.LevelGap(tt.declared, tt.locale); got != tt.want {
+			t.Errorf("LevelGap(%s, %s): got %d, want %d", tt.declared, tt.locale, got, tt.want)
+		}
+	}
+}
+
+func TestPost_Analyze(t *testing.T) {
+	clock := &mockClock{now: time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)}
+	title, _ := shared.NewTitle("Se présenter")
+	content, _ := post.NewPostContent("## Bonjour\n\n" + strings.Repeat("Je m'appelle **Léa**. J'ai dix ans. ", 10))
+	p, err := post.NewPost(post.NewPostParams{
+		PostID:   "post-123",
+		Owner:    "user-123",
+		Title:    title,
+		Content:  content,
+		Status:   post.StatusDraft,
+		Category: createTestCategory(t, clock),
+		Clock:    clock,
+	})
+	assertNoError(t, err)
+
+	t.Run("analyzes body text like word count", func(t *testing.T) {
+		got := p.Analyze()
+
+		if got.Sentences != 20 {
+			t.Errorf("got %d sentences, want 20", got.Sentences)
+		}
+		if got.UniqueWords != 8 {
+			t.Errorf("got %d unique words, want 8", got.UniqueWords)
+		}
+		if got.Words != p.WordCount()+20 { // "m'appelle" and "J'ai" split in each of 10 repeats
+			t.Errorf("got %d words, word count is %d", got.Words, p.WordCount())
+		}
+	})
+
+	t.Run("compares with declared level", func(t *testing.T) {
+		b2 := category.CategoryPath{{CategoryID: kernel.ID[category.Category]("b2"), Name: "B2"}}
+
+		gap, ok := p.DeclaredLevelGap(b2, shared.LocaleFrenchFR)
+
+		if !ok || gap >= 0 {
+			t.Errorf("got gap %d, ok %t; expected text easier than B2", gap, ok)
+		}
+	})
+
+	t.Run("reports missing level", func(t *testing.T) {
+		topic := category.CategoryPath{{CategoryID: "grammar", Name: "Grammaire"}}
+
+		if _, ok := p.DeclaredLevelGap(topic, shared.LocaleFrenchFR); ok {
+			t.Error("expected no declared level")
+		}
+	})
+}
@@ -1,6 +1,8 @@
 package post
 
 import (
+	"time"
+
 	"github.com/alnah/fla/internal/domain/category"
 	"github.com/alnah/fla/internal/domain/kernel"
 	"github.com/alnah/fla/internal/domain/shared"
@@ -51,11 +53,50 @@ type PostLister interface {
 	// Used by tag pages and "related posts" features to connect similar learning materials.
 	GetPostsByTag(tagID kernel.ID[tag.Tag], pagination shared.Pagination) (PostsList, error)
 
+	// GetPostsByTags returns published posts carrying any of the tags, newest first.
+	// Used by tag filter pages combining several tags (e.g. "grammaire" or "conjugaison").
+	GetPostsByTags(tagIDs []kernel.ID[tag.Tag], pagination shared.Pagination) (PostsList, error)
+
 	// GetPostsByAuthor returns content from specific writers for author profile pages.
 	// Used by author bio pages and contributor portfolios in multi-author blogs.
 	GetPostsByAuthor(authorID kernel.ID[user.User], pagination shared.Pagination) (PostsList, error)
 }
 
+// PostFinder lists posts matching a Query, so new filter combinations need no new method.
+// Used by admin listings and public pages alike.
+type PostFinder interface {
+	// Find returns the page of posts matching the query, with totals filled in Pagination.
+	// Callers validate the query first; implementations may assume it is valid.
+	Find(query Query) (PostsList, error)
+}
+
+// PostSummarizer serves content summaries computed when posts were written.
+// Used by listings and cards that show word counts, reading times, and excerpts.
+type PostSummarizer interface {
+	// GetSummary returns the stored summary of a post, current for its content.
+	GetSummary(postID kernel.ID[Post]) (PostSummary, error)
+}
+
+// PostArchiver buckets published posts by date for archive navigation.
+// Used by year/month archive pages and sidebar widgets.
+type PostArchiver interface {
+	// CountPublishedByMonth returns one count per UTC month holding published posts.
+	// Months without posts may be omitted; order is unspecified.
+	CountPublishedByMonth() ([]ArchiveCount, error)
+}
+
+// PostCurator lists featured posts for homepage and category page curation.
+// Used by homepage hero sections and category landing pages.
+type PostCurator interface {
+	// GetFeaturedPosts returns every post featured at now (see Post.IsFeaturedAt),
+	// most recently published first.
+	GetFeaturedPosts(now time.Time) ([]Post, error)
+
+	// GetFeaturedPostsByCategory returns the posts featured at now in the category,
+	// most recently published first.
+	GetFeaturedPostsByCategory(categoryID kernel.ID[category.Category], now time.Time) ([]Post, error)
+}
+
 // PostSearcher handles content discovery through queries.
 // Used by search functionality and content recommendation systems.
 type PostSearcher interface {
@@ -99,6 +140,7 @@ type PostManager interface {
 type PostBrowser interface {
 	PostReader
 	PostLister
+	PostFinder
 	PostSearcher
 }
 
@@ -117,7 +159,11 @@ type Repository interface {
 	PostReader
 	PostWriter
 	PostLister
+	PostFinder
+	PostArchiver
+	PostCurator
 	PostSearcher
 	PostScheduler
 	PostValidator
+	category.CategoryContent
 }
@@ -0,0 +1,87 @@
+package post
+
+import (
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/policy"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+// ScheduleRepository lists scheduled posts and saves them once published.
+type ScheduleRepository interface {
+	PostScheduler
+	PostWriter
+}
+
+// PublishFailure is a due post the scheduler could not publish, e.g. one never approved.
+type PublishFailure struct {
+	PostID kernel.ID[Post]
+	Err    error
+}
+
+// PublishRun reports one pass of the scheduler.
+type PublishRun struct {
+	Published []Post           // Posts now live, earliest scheduled first
+	Failed    []PublishFailure // Due posts left scheduled
+	Pending   int              // Posts scheduled for later
+}
+
+// SchedulerService publishes scheduled posts once their time has come.
+// Meant to run periodically from a background job or the command line.
+type SchedulerService struct {
+	posts ScheduleRepository
+	clock kernel.Clock
+}
+
+// NewSchedulerService creates scheduler service with a post repository and clock.
+func NewSchedulerService(posts ScheduleRepository, clock kernel.Clock) *SchedulerService {
+	return &SchedulerService{posts: posts, clock: clock}
+}
+
+// PublishDue publishes every post scheduled at or before now on behalf of actor.
+// A post that fails stays scheduled and is reported, without stopping the others,
+// so the next run retries it; only listing the scheduled posts aborts the run.
+func (s *SchedulerService) PublishDue(actor user.PostPermissionChecker) (PublishRun, error) {
+	const op = "SchedulerService.PublishDue"
+
+	if !user.Authorize(actor, policy.PostReview, policy.Resource{Kind: policy.KindPost}).Allowed {
+		return PublishRun{}, &kernel.Error{Code: kernel.EForbidden, Message: MPostCannotPublish, Operation: op}
+	}
+
+	scheduled, err := s.posts.GetScheduledPosts()
+	if err != nil {
+		return PublishRun{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	now := s.clock.Now()
+	var run PublishRun
+	for _, p := range scheduled {
+		if p.PublishedAt == nil || p.PublishedAt.After(now) {
+			run.Pending++
+			continue
+		}
+
+		published, err := s.publish(p, actor)
+		if err != nil {
+			run.Failed = append(run.Failed, PublishFailure{PostID: p.PostID, Err: err})
+			continue
+		}
+		run.Published = append(run.Published, published)
+	}
+
+	return run, nil
+}
+
+func (s *SchedulerService) publish(p Post, actor user.PostPermissionChecker) (Post, error) {
+	const op = "SchedulerService.publish"
+
+	published, err := p.Publish(actor)
+	if err != nil {
+		return Post{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if err := s.posts.Update(published); err != nil {
+		return Post{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return published, nil
+}
@@ -0,0 +1,119 @@
+package post_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/post"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+type stubSchedule struct {
+	scheduled []post.Post
+	updated   []post.Post
+	listErr   error
+	updateErr map[kernel.ID[post.Post]]error
+}
+
+func (s *stubSchedule) GetScheduledPosts() ([]post.Post, error) { return s.scheduled, s.listErr }
+
+func (s *stubSchedule) Create(post.Post) error { return nil }
+
+func (s *stubSchedule) Update(p post.Post) error {
+	if err := s.updateErr[p.PostID]; err != nil {
+		return err
+	}
+	s.updated = append(s.updated, p)
+	return nil
+}
+
+func (s *stubSchedule) Delete(kernel.ID[post.Post]) error { return nil }
+
+func TestSchedulerService_PublishDue(t *testing.T) {
+	now := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	clock := &mockClock{now: now}
+	editor := &mockUser{id: "editor-1", roles: []user.Role{user.RoleEditor}}
+
+	// Posts are scheduled the day before, while their date was still in the future
+	yesterday := &mockClock{now: now.AddDate(0, 0, -1)}
+	scheduledAt := func(id string, at time.Time, approved bool) post.Post {
+		p, err := post.NewPost(post.NewPostParams{
+			PostID:      kernel.ID[post.Post](id),
+			Owner:       "author-1",
+			Title:       "Leçon programmée pour la semaine",
+			Content:     post.PostContent(strings.Repeat("Le samedi, je vais au marché. ", 12)),
+			Status:      post.StatusScheduled,
+			Category:    createTestCategory(t, clock),
+			PublishedAt: &at,
+			Clock:       yesterday,
+		})
+		assertNoError(t, err)
+		p.Clock = clock
+		if approved {
+			approver := kernel.ID[user.User]("editor-1")
+			p.ApprovedBy, p.ApprovedAt = &approver, &at
+		}
+		return p
+	}
+
+	t.Run("publishes due posts and leaves later ones scheduled", func(t *testing.T) {
+		repo := &stubSchedule{scheduled: []post.Post{
+			scheduledAt("due", now.Add(-time.Hour), true),
+			scheduledAt("now", now, true),
+			scheduledAt("later", now.Add(time.Hour), true),
+		}}
+
+		run, err := post.NewSchedulerService(repo, clock).PublishDue(editor)
+
+		assertNoError(t, err)
+		if len(run.Published) != 2 || run.Pending != 1 || len(run.Failed) != 0 {
+			t.Fatalf("run: got %d published, %d pending, %d failed", len(run.Published), run.Pending, len(run.Failed))
+		}
+		for _, p := range repo.updated {
+			if p.Status != post.StatusPublished {
+				t.Errorf("%s: got status %s", p.PostID, p.Status)
+			}
+		}
+	})
+
+	t.Run("reports posts that fail without stopping the others", func(t *testing.T) {
+		repo := &stubSchedule{
+			scheduled: []post.Post{
+				scheduledAt("unapproved", now.Add(-time.Hour), false),
+				scheduledAt("unsaved", now.Add(-time.Hour), true),
+				scheduledAt("fine", now.Add(-time.Hour), true),
+			},
+			updateErr: map[kernel.ID[post.Post]]error{"unsaved": errors.New("disk full")},
+		}
+
+		run, err := post.NewSchedulerService(repo, clock).PublishDue(editor)
+
+		assertNoError(t, err)
+		if len(run.Published) != 1 || run.Published[0].PostID != "fine" {
+			t.Errorf("published: got %v", run.Published)
+		}
+		if len(run.Failed) != 2 || run.Failed[0].PostID != "unapproved" || run.Failed[1].PostID != "unsaved" {
+			t.Fatalf("failed: got %v", run.Failed)
+		}
+		assertErrorCode(t, run.Failed[0].Err, kernel.EInvalid)
+	})
+
+	t.Run("only editors and admins can publish", func(t *testing.T) {
+		author := &mockUser{id: "author-1", roles: []user.Role{user.RoleAuthor}}
+
+		_, err := post.NewSchedulerService(&stubSchedule{}, clock).PublishDue(author)
+
+		assertErrorCode(t, err, kernel.EForbidden)
+	})
+
+	t.Run("aborts when scheduled posts cannot be listed", func(t *testing.T) {
+		repo := &stubSchedule{listErr: &kernel.Error{Code: kernel.EInternal, Message: "unavailable"}}
+
+		_, err := post.NewSchedulerService(repo, clock).PublishDue(editor)
+
+		assertErrorCode(t, err, kernel.EInternal)
+	})
+}
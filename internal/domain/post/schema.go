@@ -11,6 +11,7 @@ const (
 	SchemaTypeEducationalContent SchemaType = "EducationalContent"
 	SchemaTypeLearningResource   SchemaType = "LearningResource"
 	SchemaTypeHowTo              SchemaType = "HowTo"
+	SchemaTypeCourse             SchemaType = "Course"
 	SchemaTypeDefault            SchemaType = SchemaTypeEducationalContent
 )
 
@@ -26,7 +27,7 @@ func (s SchemaType) Validate() error {
 
 	switch s {
 	case SchemaTypeArticle, SchemaTypeBlogPosting, SchemaTypeEducationalContent,
-		SchemaTypeLearningResource, SchemaTypeHowTo:
+		SchemaTypeLearningResource, SchemaTypeHowTo, SchemaTypeCourse:
 		return nil
 	default:
 		return &kernel.Error{
@@ -47,5 +48,15 @@ func (s SchemaType) GetEffectiveType() SchemaType {
 
 // IsEducational returns true if this is an educational content type
 func (s SchemaType) IsEducational() bool {
-	return s == SchemaTypeEducationalContent || s == SchemaTypeLearningResource
+	return s == SchemaTypeEducationalContent || s == SchemaTypeLearningResource || s == SchemaTypeCourse
+}
+
+// SchemaOrgType returns the schema.org vocabulary type emitted in JSON-LD.
+// EducationalContent is an internal grouping with no schema.org equivalent, so it maps to LearningResource.
+func (s SchemaType) SchemaOrgType() string {
+	effective := s.GetEffectiveType()
+	if effective == SchemaTypeEducationalContent {
+		return SchemaTypeLearningResource.String()
+	}
+	return effective.String()
 }
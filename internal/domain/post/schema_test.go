@@ -39,6 +39,7 @@ func TestSchemaType_Validate(t *testing.T) {
 			post.SchemaTypeEducationalContent,
 			post.SchemaTypeLearningResource,
 			post.SchemaTypeHowTo,
+			post.SchemaTypeCourse,
 			"", // empty is allowed (will use default)
 		}
 
@@ -122,6 +123,7 @@ func TestSchemaType_IsEducational(t *testing.T) {
 		{post.SchemaTypeEducationalContent, true},
 		{post.SchemaTypeLearningResource, true},
 		{post.SchemaTypeHowTo, false},
+		{post.SchemaTypeCourse, true},
 		{"", false}, // empty
 	}
 
@@ -136,6 +138,27 @@ func TestSchemaType_IsEducational(t *testing.T) {
 	}
 }
 
+func TestSchemaType_SchemaOrgType(t *testing.T) {
+	tests := []struct {
+		schema post.SchemaType
+		want   string
+	}{
+		{post.SchemaTypeArticle, "Article"},
+		{post.SchemaTypeBlogPosting, "BlogPosting"},
+		{post.SchemaTypeCourse, "Course"},
+		{post.SchemaTypeEducationalContent, "LearningResource"},
+		{"", "LearningResource"},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.schema), func(t *testing.T) {
+			if got := tt.schema.SchemaOrgType(); got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestSchemaTypeConstants(t *testing.T) {
 	// Ensure constants have expected values
 	tests := []struct {
@@ -0,0 +1,77 @@
+package post
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"math"
+	"strings"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+)
+
+// PostSummary holds the figures listings derive from a post's content. Deriving
+// them strips Markdown, which is costly on long posts shown many to a page, so
+// stores compute a summary when a post is written and keep it alongside the post.
+type PostSummary struct {
+	WordCount      int
+	ReadingMinutes int
+	Excerpt        string      // Generated from the content, DefaultExcerptLength long
+	ContentHash    string      // Identifies the content the summary was computed from
+	Fingerprint    Fingerprint // Sketch of the content, for duplicate detection
+}
+
+// SummarizeContent computes the summary of post content, stripping Markdown once.
+func SummarizeContent(content PostContent) PostSummary {
+	plain := kernel.StripMarkdown(content.String())
+	words := countWords(plain)
+
+	return PostSummary{
+		WordCount:      words,
+		ReadingMinutes: readingMinutes(words),
+		Excerpt:        truncateExcerpt(plain, DefaultExcerptLength),
+		ContentHash:    HashContent(content),
+		Fingerprint:    fingerprint(plain),
+	}
+}
+
+// Summary computes the post's content summary.
+func (p Post) Summary() PostSummary {
+	return SummarizeContent(p.Content)
+}
+
+// IsCurrentFor reports whether the summary was computed from this content.
+// Stores use it to tell a summary left stale by an edit from one they can serve.
+func (s PostSummary) IsCurrentFor(content PostContent) bool {
+	return s.ContentHash != "" && s.ContentHash == HashContent(content)
+}
+
+// HashContent fingerprints post content; hashing is much cheaper than summarizing.
+func HashContent(content PostContent) string {
+	sum := sha256.Sum256([]byte(content))
+	return hex.EncodeToString(sum[:])
+}
+
+func countWords(plain string) int {
+	return len(strings.Fields(plain))
+}
+
+// readingMinutes rounds up at AverageWordsPerMinute, with at least one minute.
+func readingMinutes(words int) int {
+	minutes := float64(words) / AverageWordsPerMinute
+	return int(math.Max(1, math.Ceil(minutes)))
+}
+
+// truncateExcerpt cuts plain text to maxLength bytes, at a word boundary when one
+// falls in the second half, and marks the cut with an ellipsis.
+func truncateExcerpt(plain string, maxLength int) string {
+	if len(plain) <= maxLength {
+		return plain
+	}
+
+	truncated := plain[:maxLength]
+	if lastSpace := strings.LastIndex(truncated, " "); lastSpace > maxLength/2 {
+		truncated = truncated[:lastSpace]
+	}
+
+	return truncated + "..."
+}
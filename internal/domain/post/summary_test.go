@@ -0,0 +1,62 @@
+package post_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/post"
+	"github.com/alnah/fla/internal/domain/shared"
+)
+
+func TestSummarizeContent(t *testing.T) {
+	p := post.Post{Content: post.PostContent("## Au marché\n\n" + strings.Repeat("Le **samedi**, je vais au [marché](/a1/marche). ", 60))}
+
+	got := p.Summary()
+
+	if got.WordCount != p.WordCount() || got.WordCount != 360 {
+		t.Errorf("word count: got %d, post says %d", got.WordCount, p.WordCount())
+	}
+	if got.ReadingMinutes != p.EstimatedReadingTime() || got.ReadingMinutes != 2 {
+		t.Errorf("reading minutes: got %d, post says %d", got.ReadingMinutes, p.EstimatedReadingTime())
+	}
+	if got.Excerpt != p.GetExcerpt(post.DefaultExcerptLength) || strings.Contains(got.Excerpt, "**") {
+		t.Errorf("excerpt: got %q", got.Excerpt)
+	}
+	if !got.IsCurrentFor(p.Content) || got.IsCurrentFor(p.Content+" Fin.") {
+		t.Error("expected the summary to be current for its content only")
+	}
+	if (post.PostSummary{}).IsCurrentFor("") {
+		t.Error("expected an empty summary to be current for nothing")
+	}
+}
+
+func TestQuery_SortPosts(t *testing.T) {
+	posts := []post.Post{
+		{PostID: "short", Content: "un deux"},
+		{PostID: "long", Content: "un deux trois quatre"},
+	}
+	q := post.NewQuery().SortBy(shared.Desc(post.SortFieldWordCount))
+
+	ids := func(summaries map[kernel.ID[post.Post]]post.PostSummary) string {
+		sorted := []post.Post{posts[0], posts[1]}
+		q.SortPosts(sorted, summaries)
+		return sorted[0].PostID.String() + "," + sorted[1].PostID.String()
+	}
+
+	if got := ids(nil); got != "long,short" {
+		t.Errorf("computed summaries: got %s", got)
+	}
+
+	// A current stored summary is trusted as is; a stale one is recomputed
+	inflated := post.SummarizeContent(posts[0].Content)
+	inflated.WordCount = 100
+	if got := ids(map[kernel.ID[post.Post]]post.PostSummary{"short": inflated}); got != "short,long" {
+		t.Errorf("stored summaries: got %s", got)
+	}
+	stale := post.SummarizeContent("un")
+	stale.WordCount = 100
+	if got := ids(map[kernel.ID[post.Post]]post.PostSummary{"short": stale}); got != "long,short" {
+		t.Errorf("stale summaries: got %s", got)
+	}
+}
@@ -0,0 +1,129 @@
+package post
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/tag"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+// MaxTagsPerPost keeps tagging focused; beyond this, tags stop helping discovery.
+const MaxTagsPerPost int = 10
+
+const (
+	MPostTagsTooMany    string = "A post cannot have more than %d tags."
+	MPostTagDuplicate   string = "Tag %q is already on the post."
+	MPostTagNotFound    string = "Tag %q is not on the post."
+	MPostCannotEditTags string = "You are not allowed to change tags on this post."
+)
+
+// PostTags is the ordered set of tags attached to a post.
+type PostTags []kernel.ID[tag.Tag]
+
+// NewPostTags creates a validated tag collection, copying the input.
+func NewPostTags(tagIDs ...kernel.ID[tag.Tag]) (PostTags, error) {
+	const op = "NewPostTags"
+
+	t := PostTags(slices.Clone(tagIDs))
+	if err := t.Validate(); err != nil {
+		return nil, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return t, nil
+}
+
+// Validate enforces the tag limit, valid IDs, and no duplicates.
+func (t PostTags) Validate() error {
+	const op = "PostTags.Validate"
+
+	if len(t) > MaxTagsPerPost {
+		return &kernel.Error{
+			Code:      kernel.EInvalid,
+			Message:   fmt.Sprintf(MPostTagsTooMany, MaxTagsPerPost),
+			Operation: op,
+		}
+	}
+
+	seen := make(map[kernel.ID[tag.Tag]]bool, len(t))
+	for _, id := range t {
+		if err := id.Validate(); err != nil {
+			return &kernel.Error{Operation: op, Cause: err}
+		}
+		if seen[id] {
+			return &kernel.Error{
+				Code:      kernel.EInvalid,
+				Message:   fmt.Sprintf(MPostTagDuplicate, id),
+				Operation: op,
+			}
+		}
+		seen[id] = true
+	}
+
+	return nil
+}
+
+// Contains reports whether the tag is attached.
+func (t PostTags) Contains(tagID kernel.ID[tag.Tag]) bool {
+	return slices.Contains(t, tagID)
+}
+
+// TagEditor represents a user that may change tags on a post.
+// Implemented by user.User.
+type TagEditor interface {
+	CanAddTagToPost(post user.PostInterface) bool
+}
+
+// AddTag attaches a tag to the post.
+func (p Post) AddTag(tagID kernel.ID[tag.Tag], u TagEditor) (Post, error) {
+	const op = "Post.AddTag"
+
+	if !u.CanAddTagToPost(p) {
+		return p, &kernel.Error{Code: kernel.EForbidden, Message: MPostCannotEditTags, Operation: op}
+	}
+
+	if p.Tags.Contains(tagID) {
+		return p, &kernel.Error{
+			Code:      kernel.EConflict,
+			Message:   fmt.Sprintf(MPostTagDuplicate, tagID),
+			Operation: op,
+		}
+	}
+
+	tags := append(slices.Clone(p.Tags), tagID)
+	if err := tags.Validate(); err != nil {
+		return p, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	updatedPost := p
+	updatedPost.Tags = tags
+	updatedPost.UpdatedAt = p.Clock.Now()
+
+	return updatedPost, nil
+}
+
+// RemoveTag detaches a tag from the post.
+func (p Post) RemoveTag(tagID kernel.ID[tag.Tag], u TagEditor) (Post, error) {
+	const op = "Post.RemoveTag"
+
+	if !u.CanAddTagToPost(p) {
+		return p, &kernel.Error{Code: kernel.EForbidden, Message: MPostCannotEditTags, Operation: op}
+	}
+
+	if !p.Tags.Contains(tagID) {
+		return p, &kernel.Error{
+			Code:      kernel.ENotFound,
+			Message:   fmt.Sprintf(MPostTagNotFound, tagID),
+			Operation: op,
+		}
+	}
+
+	updatedPost := p
+	updatedPost.Tags = slices.DeleteFunc(slices.Clone(p.Tags), func(id kernel.ID[tag.Tag]) bool {
+		return id == tagID
+	})
+	updatedPost.UpdatedAt = p.Clock.Now()
+
+	return updatedPost, nil
+}
@@ -0,0 +1,206 @@
+package post_test
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/post"
+	"github.com/alnah/fla/internal/domain/shared"
+	"github.com/alnah/fla/internal/domain/tag"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+// Stub tag editor with a fixed permission answer
+type stubTagEditor struct {
+	allowed bool
+}
+
+func (s stubTagEditor) CanAddTagToPost(user.PostInterface) bool {
+	return s.allowed
+}
+
+func tagIDs(n int) []kernel.ID[tag.Tag] {
+	ids := make([]kernel.ID[tag.Tag], n)
+	for i := range ids {
+		ids[i] = kernel.ID[tag.Tag](fmt.Sprintf("tag-%d", i))
+	}
+	return ids
+}
+
+func TestNewPostTags(t *testing.T) {
+	t.Run("accepts up to the limit", func(t *testing.T) {
+		got, err := post.NewPostTags(tagIDs(post.MaxTagsPerPost)...)
+
+		assertNoError(t, err)
+		if len(got) != post.MaxTagsPerPost {
+			t.Errorf("got %d tags, want %d", len(got), post.MaxTagsPerPost)
+		}
+	})
+
+	t.Run("rejects more than the limit", func(t *testing.T) {
+		_, err := post.NewPostTags(tagIDs(post.MaxTagsPerPost + 1)...)
+
+		assertError(t, err)
+		assertErrorCode(t, err, kernel.EInvalid)
+	})
+
+	t.Run("rejects duplicates", func(t *testing.T) {
+		_, err := post.NewPostTags("grammaire", "vocabulaire", "grammaire")
+
+		assertError(t, err)
+		assertErrorCode(t, err, kernel.EInvalid)
+	})
+
+	t.Run("rejects invalid IDs", func(t *testing.T) {
+		_, err := post.NewPostTags("")
+
+		assertError(t, err)
+		assertErrorCode(t, err, kernel.EInvalid)
+	})
+
+	t.Run("copies the input", func(t *testing.T) {
+		ids := []kernel.ID[tag.Tag]{"grammaire"}
+		got, _ := post.NewPostTags(ids...)
+		ids[0] = "changed"
+
+		if !got.Contains("grammaire") {
+			t.Error("expected tags to be independent of input slice")
+		}
+	})
+}
+
+func TestPost_AddTag(t *testing.T) {
+	clock := &mockClock{now: time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)}
+	later := &mockClock{now: clock.now.Add(time.Hour)}
+
+	newPost := func(t *testing.T, tags post.PostTags) post.Post {
+		t.Helper()
+		title, _ := shared.NewTitle("Les articles définis")
+		content, _ := post.NewPostContent(strings.Repeat("Le, la, les sont des articles définis. ", 10))
+
+		p, err := post.NewPost(post.NewPostParams{
+			PostID:   "post-123",
+			Owner:    "user-123",
+			Title:    title,
+			Content:  content,
+			Status:   post.StatusDraft,
+			Category: createTestCategory(t, clock),
+			Tags:     tags,
+			Clock:    clock,
+		})
+		assertNoError(t, err)
+		p.Clock = later
+		return p
+	}
+
+	t.Run("adds tag and updates timestamp", func(t *testing.T) {
+		p := newPost(t, post.PostTags{"grammaire"})
+
+		got, err := p.AddTag("articles", stubTagEditor{allowed: true})
+
+		assertNoError(t, err)
+		if !slices.Equal(got.Tags, post.PostTags{"grammaire", "articles"}) {
+			t.Errorf("got tags %v", got.Tags)
+		}
+		if !got.UpdatedAt.Equal(later.now) {
+			t.Errorf("got UpdatedAt %v, want %v", got.UpdatedAt, later.now)
+		}
+		if len(p.Tags) != 1 {
+			t.Error("expected original post to be unchanged")
+		}
+	})
+
+	t.Run("rejects users without edit rights", func(t *testing.T) {
+		p := newPost(t, nil)
+
+		_, err := p.AddTag("articles", stubTagEditor{allowed: false})
+
+		assertError(t, err)
+		assertErrorCode(t, err, kernel.EForbidden)
+	})
+
+	t.Run("rejects tag already on post", func(t *testing.T) {
+		p := newPost(t, post.PostTags{"grammaire"})
+
+		_, err := p.AddTag("grammaire", stubTagEditor{allowed: true})
+
+		assertError(t, err)
+		assertErrorCode(t, err, kernel.EConflict)
+	})
+
+	t.Run("rejects tag beyond the limit", func(t *testing.T) {
+		p := newPost(t, tagIDs(post.MaxTagsPerPost))
+
+		_, err := p.AddTag("one-too-many", stubTagEditor{allowed: true})
+
+		assertError(t, err)
+		assertErrorCode(t, err, kernel.EInvalid)
+	})
+
+	t.Run("follows post edit permissions", func(t *testing.T) {
+		p := newPost(t, nil)
+		owner, _ := user.NewUser(user.NewUserParams{
+			UserID:   "user-123",
+			Username: "auteur",
+			Email:    "auteur@example.com",
+			Roles:    []user.Role{user.RoleAuthor},
+			Clock:    clock,
+		})
+		other := owner
+		other.ID = "user-456"
+
+		if _, err := p.AddTag("articles", owner); err != nil {
+			t.Errorf("owner: expected no error, got %v", err)
+		}
+		_, err := p.AddTag("articles", other)
+		assertErrorCode(t, err, kernel.EForbidden)
+	})
+}
+
+func TestPost_RemoveTag(t *testing.T) {
+	clock := &mockClock{now: time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)}
+	title, _ := shared.NewTitle("Les articles définis")
+	content, _ := post.NewPostContent(strings.Repeat("Le, la, les sont des articles définis. ", 10))
+
+	p, err := post.NewPost(post.NewPostParams{
+		PostID:   "post-123",
+		Owner:    "user-123",
+		Title:    title,
+		Content:  content,
+		Status:   post.StatusDraft,
+		Category: createTestCategory(t, clock),
+		Tags:     post.PostTags{"grammaire", "articles"},
+		Clock:    clock,
+	})
+	assertNoError(t, err)
+
+	t.Run("removes tag", func(t *testing.T) {
+		got, err := p.RemoveTag("grammaire", stubTagEditor{allowed: true})
+
+		assertNoError(t, err)
+		if !slices.Equal(got.Tags, post.PostTags{"articles"}) {
+			t.Errorf("got tags %v", got.Tags)
+		}
+		if len(p.Tags) != 2 {
+			t.Error("expected original post to be unchanged")
+		}
+	})
+
+	t.Run("rejects users without edit rights", func(t *testing.T) {
+		_, err := p.RemoveTag("grammaire", stubTagEditor{allowed: false})
+
+		assertError(t, err)
+		assertErrorCode(t, err, kernel.EForbidden)
+	})
+
+	t.Run("rejects tag not on post", func(t *testing.T) {
+		_, err := p.RemoveTag("vocabulaire", stubTagEditor{allowed: true})
+
+		assertError(t, err)
+		assertErrorCode(t, err, kernel.ENotFound)
+	})
+}
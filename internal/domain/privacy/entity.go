@@ -0,0 +1,133 @@
+// Package privacy carries out data protection requests that span aggregates,
+// such as erasing everything the blog stores about a person.
+package privacy
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/shared"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+const (
+	MSubjectMissing string = "An erasure request names an email address, an account, or both."
+)
+
+// Subject identifies whose data to erase: a subscriber by address, an account
+// by ID, or a person who is both.
+type Subject struct {
+	Email  shared.Email          // Optional: subscriptions and feedback left with this address
+	UserID *kernel.ID[user.User] // Optional: the account and what it owns
+}
+
+// Validate ensures the subject names someone.
+func (s Subject) Validate() error {
+	const op = "Subject.Validate"
+
+	if s.Email == "" && s.UserID == nil {
+		return &kernel.Error{Code: kernel.EInvalid, Message: MSubjectMissing, Operation: op}
+	}
+
+	if s.Email != "" {
+		if err := s.Email.Validate(); err != nil {
+			return &kernel.Error{Operation: op, Cause: err}
+		}
+	}
+
+	if s.UserID != nil {
+		if err := s.UserID.Validate(); err != nil {
+			return &kernel.Error{Operation: op, Cause: err}
+		}
+	}
+
+	return nil
+}
+
+// Action tells what erasure did to a kind of data.
+type Action string
+
+const (
+	ActionDeleted       Action = "deleted"       // Removed entirely
+	ActionAnonymized    Action = "anonymized"    // Kept with personal fields cleared
+	ActionPseudonymized Action = "pseudonymized" // Kept, linked only to an anonymized account
+	ActionRetained      Action = "retained"      // Kept as is for a legal reason
+)
+
+func (a Action) String() string { return string(a) }
+
+// Data kinds named in erasure reports.
+const (
+	DataSubscription  = "subscription"
+	DataSubscriberLog = "subscriber_history" // Status changes and delivery events
+	DataConsent       = "consent_records"
+	DataFeedback      = "feedback"
+	DataAccount       = "account"
+	DataCredentials   = "credentials"
+	DataSessions      = "sessions"
+	DataReactions     = "reactions"
+	DataCertificates  = "certificates"
+	DataRoleAudit     = "role_audit"
+	DataAuthoredPosts = "authored_posts"
+)
+
+// ErasureEntry records what happened to one kind of data.
+type ErasureEntry struct {
+	Data   string
+	Action Action
+	Count  int
+	Reason string // Why retained or pseudonymized data was kept
+}
+
+// ErasureReport is the record of an erasure request, returned to the person
+// and kept as proof the request was honored. It holds no personal data.
+type ErasureReport struct {
+	RequestedBy kernel.ID[user.User]
+	CompletedAt time.Time
+	Entries     []ErasureEntry
+}
+
+// Removed returns entries whose data no longer identifies the person.
+func (r ErasureReport) Removed() []ErasureEntry {
+	var out []ErasureEntry
+	for _, e := range r.Entries {
+		if e.Action != ActionRetained {
+			out = append(out, e)
+		}
+	}
+	return out
+}
+
+// Retained returns entries kept for legal reasons.
+func (r ErasureReport) Retained() []ErasureEntry {
+	var out []ErasureEntry
+	for _, e := range r.Entries {
+		if e.Action == ActionRetained {
+			out = append(out, e)
+		}
+	}
+	return out
+}
+
+// Entry returns the entry of a kind of data, if the request touched it.
+func (r ErasureReport) Entry(data string) (ErasureEntry, bool) {
+	for _, e := range r.Entries {
+		if e.Data == data {
+			return e, true
+		}
+	}
+	return ErasureEntry{}, false
+}
+
+// String returns a string representation of the report.
+func (r ErasureReport) String() string {
+	return fmt.Sprintf("ErasureReport{Removed: %d, Retained: %d, CompletedAt: %s}",
+		len(r.Removed()), len(r.Retained()), r.CompletedAt.Format(time.RFC3339))
+}
+
+func (r *ErasureReport) add(data string, action Action, count int, reason string) {
+	if count > 0 {
+		r.Entries = append(r.Entries, ErasureEntry{Data: data, Action: action, Count: count, Reason: reason})
+	}
+}
@@ -0,0 +1,131 @@
+package privacy_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/post"
+	"github.com/alnah/fla/internal/domain/privacy"
+	"github.com/alnah/fla/internal/domain/shared"
+	"github.com/alnah/fla/internal/domain/subscription"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+type stubClock struct {
+	t time.Time
+}
+
+func (s *stubClock) Now() time.Time { return s.t }
+
+// stubStore keeps one person's data across aggregates.
+type stubStore struct {
+	subscriptions map[shared.Email]subscription.Subscription
+	history       int
+	consents      []subscription.ConsentRecord
+	feedback      map[shared.Email]int
+	users         map[kernel.ID[user.User]]user.User
+	owned         map[kernel.ID[user.User]]int // Records per account: credentials, sessions, reactions, certificates
+	roleHistory   []user.RoleChange
+	posts         int
+}
+
+func (s *stubStore) GetByEmail(email shared.Email) (*subscription.Subscription, error) {
+	if sub, ok := s.subscriptions[email]; ok {
+		return &sub, nil
+	}
+	return nil, &kernel.Error{Code: kernel.ENotFound, Message: "subscription not found"}
+}
+
+func (s *stubStore) Delete(id kernel.ID[subscription.Subscription]) error {
+	for email, sub := range s.subscriptions {
+		if sub.SubscriptionID == id {
+			delete(s.subscriptions, email)
+		}
+	}
+	return nil
+}
+
+func (s *stubStore) DeleteSubscriberHistory(kernel.ID[subscription.Subscription]) (int, error) {
+	n := s.history
+	s.history = 0
+	return n, nil
+}
+
+func (s *stubStore) GetConsentHistory(kernel.ID[subscription.Subscription]) ([]subscription.ConsentRecord, error) {
+	return s.consents, nil
+}
+
+func (s *stubStore) AnonymizeReporter(email shared.Email) (int, error) {
+	n := s.feedback[email]
+	delete(s.feedback, email)
+	return n, nil
+}
+
+func (s *stubStore) GetUserByID(id kernel.ID[user.User]) (*user.User, error) {
+	if u, ok := s.users[id]; ok {
+		return &u, nil
+	}
+	return nil, &kernel.Error{Code: kernel.ENotFound, Message: "user not found"}
+}
+
+func (s *stubStore) UpdateUser(u user.User) error {
+	s.users[u.ID] = u
+	return nil
+}
+
+func (s *stubStore) erase(id kernel.ID[user.User]) (int, error) {
+	n := s.owned[id]
+	return n, nil
+}
+
+func (s *stubStore) DeleteCredentials(id kernel.ID[user.User]) (int, error)  { return s.erase(id) }
+func (s *stubStore) RevokeAllForUser(id kernel.ID[user.User]) (int, error)   { return s.erase(id) }
+func (s *stubStore) RemoveAllReactions(id kernel.ID[user.User]) (int, error) { return s.erase(id) }
+func (s *stubStore) DeleteCertificates(id kernel.ID[user.User]) (int, error) { return s.erase(id) }
+
+func (s *stubStore) GetRoleHistory(kernel.ID[user.User]) ([]user.RoleChange, error) {
+	return s.roleHistory, nil
+}
+
+func (s *stubStore) Find(q post.Query) (post.PostsList, error) {
+	return post.NewPostsList(nil, shared.Pagination{Page: 1, Limit: q.Pagination.Limit, TotalItems: s.posts, TotalPages: s.posts}), nil
+}
+
+func (s *stubStore) stores() privacy.Stores {
+	return privacy.Stores{
+		Subscribers: s,
+		History:     s,
+		Consents:    s,
+		Feedback:    s,
+		Accounts:    s,
+		AccountData: s,
+		RoleHistory: s,
+		Posts:       s,
+	}
+}
+
+func assertNoError(t *testing.T, err error) {
+	t.Helper()
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+}
+
+func assertErrorCode(t *testing.T, err error, want string) {
+	t.Helper()
+	got := kernel.ErrorCode(err)
+	if got != want {
+		t.Errorf("error code: got %q, want %q", got, want)
+	}
+}
+
+// testConsent returns a signup form consent given at the clock's time.
+func testConsent(clock kernel.Clock) subscription.Consent {
+	return subscription.Consent{
+		Source:      subscription.SourceSignupForm,
+		FormURL:     "https://fla.example/newsletter",
+		TextVersion: "2024-05-01",
+		GivenAt:     clock.Now(),
+	}
+}
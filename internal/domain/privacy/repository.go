@@ -0,0 +1,62 @@
+package privacy
+
+import (
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/post"
+	"github.com/alnah/fla/internal/domain/shared"
+	"github.com/alnah/fla/internal/domain/subscription"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+// SubscriberStore finds and deletes subscriptions.
+type SubscriberStore interface {
+	// GetByEmail returns the subscription of an address. Returns ENotFound when missing.
+	GetByEmail(email shared.Email) (*subscription.Subscription, error)
+
+	// Delete removes a subscription.
+	Delete(subscriptionID kernel.ID[subscription.Subscription]) error
+}
+
+// SubscriberHistoryEraser removes what was logged about a subscriber.
+type SubscriberHistoryEraser interface {
+	// DeleteSubscriberHistory removes status changes and delivery events and returns how many.
+	DeleteSubscriberHistory(subscriptionID kernel.ID[subscription.Subscription]) (int, error)
+}
+
+// ConsentHistory reads consent records, which erasure keeps as proof.
+type ConsentHistory interface {
+	GetConsentHistory(subscriptionID kernel.ID[subscription.Subscription]) ([]subscription.ConsentRecord, error)
+}
+
+// FeedbackAnonymizer clears reporter addresses from feedback.
+type FeedbackAnonymizer interface {
+	// AnonymizeReporter removes the address from every report left with it and returns how many.
+	AnonymizeReporter(email shared.Email) (int, error)
+}
+
+// AccountStore reads and saves accounts.
+type AccountStore interface {
+	GetUserByID(userID kernel.ID[user.User]) (*user.User, error)
+	UpdateUser(u user.User) error
+}
+
+// AccountDataEraser removes what an account owns beside its profile.
+// Each method returns how many records it removed; none found is not an error.
+type AccountDataEraser interface {
+	DeleteCredentials(userID kernel.ID[user.User]) (int, error)
+	RevokeAllForUser(userID kernel.ID[user.User]) (int, error) // Sessions
+	RemoveAllReactions(userID kernel.ID[user.User]) (int, error)
+	DeleteCertificates(userID kernel.ID[user.User]) (int, error)
+}
+
+// Stores gathers the repositories an erasure touches.
+type Stores struct {
+	Subscribers SubscriberStore
+	History     SubscriberHistoryEraser
+	Consents    ConsentHistory
+	Feedback    FeedbackAnonymizer
+	Accounts    AccountStore
+	AccountData AccountDataEraser
+	RoleHistory user.RoleHistoryReader
+	Posts       post.PostFinder
+}
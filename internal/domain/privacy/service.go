@@ -0,0 +1,165 @@
+package privacy
+
+import (
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/post"
+	"github.com/alnah/fla/internal/domain/shared"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+const MErasureForbidden string = "Only admins can erase someone else's data."
+
+// Reasons recorded for data kept after erasure.
+const (
+	ReasonConsentProof  string = "Proof of consent to emails (GDPR art. 7(1)); linked only to the deleted subscription's ID."
+	ReasonRoleAudit     string = "Security audit trail of role changes; the account it names is anonymized."
+	ReasonAuthoredPosts string = "Published lessons stay online under the anonymized account."
+)
+
+// ErasureService honors right-to-erasure requests. Every step is idempotent,
+// so a request interrupted by a failure is completed by running it again.
+type ErasureService struct {
+	stores Stores
+	clock  kernel.Clock
+}
+
+// NewErasureService creates erasure service with the repositories an erasure touches.
+func NewErasureService(stores Stores, clock kernel.Clock) *ErasureService {
+	return &ErasureService{stores: stores, clock: clock}
+}
+
+// Erase removes or anonymizes everything stored about a subject and reports
+// what was kept and why. Admins handle any request; users may erase their own
+// account. An account's email is erased as a subscriber address too.
+//   - Subscriber: subscription and its history deleted, feedback anonymized,
+//     consent records retained
+//   - Account: profile anonymized, credentials, sessions, reactions, and
+//     certificates deleted; role audit and authored lessons pseudonymized
+func (s *ErasureService) Erase(subject Subject, actor user.PostPermissionChecker) (ErasureReport, error) {
+	const op = "ErasureService.Erase"
+
+	if err := subject.Validate(); err != nil {
+		return ErasureReport{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if !user.IsActiveAdmin(actor) && (subject.UserID == nil || *subject.UserID != actor.GetID()) {
+		return ErasureReport{}, &kernel.Error{Code: kernel.EForbidden, Message: MErasureForbidden, Operation: op}
+	}
+
+	report := ErasureReport{RequestedBy: actor.GetID()}
+
+	var account *user.User
+	if subject.UserID != nil {
+		var err error
+		account, err = s.stores.Accounts.GetUserByID(*subject.UserID)
+		if err != nil {
+			return ErasureReport{}, &kernel.Error{Operation: op, Cause: err}
+		}
+	}
+
+	emails := []shared.Email{}
+	if subject.Email != "" {
+		emails = append(emails, subject.Email.Normalize())
+	}
+	if account != nil && !account.IsErased() && account.Email.Canonical() != subject.Email.Canonical() {
+		emails = append(emails, account.Email.Normalize())
+	}
+	for _, email := range emails {
+		if err := s.eraseSubscriber(&report, email); err != nil {
+			return ErasureReport{}, &kernel.Error{Operation: op, Cause: err}
+		}
+	}
+
+	if account != nil {
+		if err := s.eraseAccount(&report, *account, actor); err != nil {
+			return ErasureReport{}, &kernel.Error{Operation: op, Cause: err}
+		}
+	}
+
+	report.CompletedAt = s.clock.Now()
+	return report, nil
+}
+
+// eraseSubscriber deletes a subscription with its history and anonymizes feedback.
+func (s *ErasureService) eraseSubscriber(report *ErasureReport, email shared.Email) error {
+	const op = "ErasureService.eraseSubscriber"
+
+	feedback, err := s.stores.Feedback.AnonymizeReporter(email)
+	if err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+	report.add(DataFeedback, ActionAnonymized, feedback, "")
+
+	sub, err := s.stores.Subscribers.GetByEmail(email)
+	if kernel.ErrorCode(err) == kernel.ENotFound {
+		return nil
+	}
+	if err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	consents, err := s.stores.Consents.GetConsentHistory(sub.SubscriptionID)
+	if err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+	report.add(DataConsent, ActionRetained, len(consents), ReasonConsentProof)
+
+	history, err := s.stores.History.DeleteSubscriberHistory(sub.SubscriptionID)
+	if err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+	report.add(DataSubscriberLog, ActionDeleted, history, "")
+
+	if err := s.stores.Subscribers.Delete(sub.SubscriptionID); err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+	report.add(DataSubscription, ActionDeleted, 1, "")
+
+	return nil
+}
+
+// eraseAccount deletes what the account owns, then anonymizes its profile last,
+// so a failed request can still find the account by its data when run again.
+func (s *ErasureService) eraseAccount(report *ErasureReport, account user.User, actor user.PostPermissionChecker) error {
+	const op = "ErasureService.eraseAccount"
+
+	steps := []struct {
+		data  string
+		erase func(kernel.ID[user.User]) (int, error)
+	}{
+		{DataSessions, s.stores.AccountData.RevokeAllForUser},
+		{DataCredentials, s.stores.AccountData.DeleteCredentials},
+		{DataReactions, s.stores.AccountData.RemoveAllReactions},
+		{DataCertificates, s.stores.AccountData.DeleteCertificates},
+	}
+	for _, step := range steps {
+		n, err := step.erase(account.ID)
+		if err != nil {
+			return &kernel.Error{Operation: op, Cause: err}
+		}
+		report.add(step.data, ActionDeleted, n, "")
+	}
+
+	audit, err := s.stores.RoleHistory.GetRoleHistory(account.ID)
+	if err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+	report.add(DataRoleAudit, ActionPseudonymized, len(audit), ReasonRoleAudit)
+
+	authored, err := s.stores.Posts.Find(post.NewQuery().OwnedBy(account.ID).Page(1, 1))
+	if err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+	report.add(DataAuthoredPosts, ActionPseudonymized, authored.Pagination.TotalItems, ReasonAuthoredPosts)
+
+	anonymized, err := account.Anonymize(actor)
+	if err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+	if err := s.stores.Accounts.UpdateUser(anonymized); err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+	report.add(DataAccount, ActionAnonymized, 1, "")
+
+	return nil
+}
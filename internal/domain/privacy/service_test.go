@@ -0,0 +1,134 @@
+package privacy_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/privacy"
+	"github.com/alnah/fla/internal/domain/shared"
+	"github.com/alnah/fla/internal/domain/subscription"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+func TestErasureService_Erase(t *testing.T) {
+	clock := &stubClock{t: time.Date(2024, 9, 2, 8, 0, 0, 0, time.UTC)}
+	admin := user.User{ID: "admin-1", Roles: []user.Role{user.RoleAdmin}}
+
+	newStore := func(t *testing.T) *stubStore {
+		t.Helper()
+		sub, err := subscription.NewSubscription(subscription.NewSubscriptionParams{
+			SubscriptionID: "s1", FirstName: "Marie", Email: "marie@example.com", Consent: testConsent(clock), Clock: clock,
+		})
+		assertNoError(t, err)
+		author, err := user.NewUser(user.NewUserParams{
+			UserID: "author-1", Username: "marie", Email: "marie@example.com", Roles: []user.Role{user.RoleAuthor}, Clock: clock,
+		})
+		assertNoError(t, err)
+		author.FirstName = "Marie"
+
+		return &stubStore{
+			subscriptions: map[shared.Email]subscription.Subscription{"marie@example.com": sub},
+			history:       4,
+			consents:      []subscription.ConsentRecord{{SubscriptionID: "s1", PolicyVersion: "2024-05-01", Source: subscription.SourceSignupForm, AcceptedAt: clock.t}},
+			feedback:      map[shared.Email]int{"marie@example.com": 2},
+			users:         map[kernel.ID[user.User]]user.User{"author-1": author},
+			owned:         map[kernel.ID[user.User]]int{"author-1": 1},
+			roleHistory:   []user.RoleChange{{UserID: "author-1", Role: user.RoleAuthor, Action: user.RoleChangeGranted, ChangedBy: "admin-1"}},
+			posts:         3,
+		}
+	}
+
+	t.Run("erases a subscriber by address", func(t *testing.T) {
+		store := newStore(t)
+		service := privacy.NewErasureService(store.stores(), clock)
+
+		report, err := service.Erase(privacy.Subject{Email: "marie@EXAMPLE.com"}, admin)
+		assertNoError(t, err)
+
+		if len(store.subscriptions) != 0 || store.history != 0 || len(store.feedback) != 0 {
+			t.Errorf("subscriber data left: %+v", store)
+		}
+		want := map[string]privacy.Action{
+			privacy.DataSubscription:  privacy.ActionDeleted,
+			privacy.DataSubscriberLog: privacy.ActionDeleted,
+			privacy.DataFeedback:      privacy.ActionAnonymized,
+			privacy.DataConsent:       privacy.ActionRetained,
+		}
+		for data, action := range want {
+			if e, ok := report.Entry(data); !ok || e.Action != action {
+				t.Errorf("%s: got %+v, want %s", data, e, action)
+			}
+		}
+		if retained := report.Retained(); len(retained) != 1 || retained[0].Reason == "" {
+			t.Errorf("retained data needs a reason: got %+v", retained)
+		}
+		if _, ok := report.Entry(privacy.DataAccount); ok {
+			t.Error("an address alone must not touch accounts")
+		}
+		if !report.CompletedAt.Equal(clock.t) || report.RequestedBy != admin.ID {
+			t.Errorf("report: got %s", report)
+		}
+	})
+
+	t.Run("users erase their own account and subscription", func(t *testing.T) {
+		store := newStore(t)
+		service := privacy.NewErasureService(store.stores(), clock)
+		author := store.users["author-1"]
+		id := author.ID
+
+		report, err := service.Erase(privacy.Subject{UserID: &id}, author)
+		assertNoError(t, err)
+
+		if got := store.users["author-1"]; !got.IsErased() || got.FirstName != "" {
+			t.Errorf("account not anonymized: %s", got)
+		}
+		if len(store.subscriptions) != 0 {
+			t.Error("the account's subscription should be deleted")
+		}
+		for data, action := range map[string]privacy.Action{
+			privacy.DataAccount:       privacy.ActionAnonymized,
+			privacy.DataCredentials:   privacy.ActionDeleted,
+			privacy.DataSessions:      privacy.ActionDeleted,
+			privacy.DataRoleAudit:     privacy.ActionPseudonymized,
+			privacy.DataAuthoredPosts: privacy.ActionPseudonymized,
+		} {
+			if e, ok := report.Entry(data); !ok || e.Action != action {
+				t.Errorf("%s: got %+v, want %s", data, e, action)
+			}
+		}
+		if e, _ := report.Entry(privacy.DataAuthoredPosts); e.Count != 3 {
+			t.Errorf("authored posts: got %d, want 3", e.Count)
+		}
+
+		again, err := service.Erase(privacy.Subject{UserID: &id}, author)
+		assertNoError(t, err)
+		if _, ok := again.Entry(privacy.DataSubscription); ok {
+			t.Error("a rerun has no subscription left to delete")
+		}
+	})
+
+	t.Run("others need to be admins", func(t *testing.T) {
+		store := newStore(t)
+		service := privacy.NewErasureService(store.stores(), clock)
+		editor := user.User{ID: "editor-1", Roles: []user.Role{user.RoleEditor}}
+		id := kernel.ID[user.User]("author-1")
+
+		_, err := service.Erase(privacy.Subject{UserID: &id}, editor)
+		assertErrorCode(t, err, kernel.EForbidden)
+
+		_, err = service.Erase(privacy.Subject{Email: "marie@example.com"}, editor)
+		assertErrorCode(t, err, kernel.EForbidden)
+
+		suspended := user.User{ID: "admin-2", Roles: []user.Role{user.RoleAdmin}, Status: user.AccountStatusSuspended}
+		_, err = service.Erase(privacy.Subject{UserID: &id}, suspended)
+		assertErrorCode(t, err, kernel.EForbidden)
+	})
+
+	t.Run("rejects empty subjects", func(t *testing.T) {
+		service := privacy.NewErasureService(newStore(t).stores(), clock)
+
+		_, err := service.Erase(privacy.Subject{}, admin)
+		assertErrorCode(t, err, kernel.EInvalid)
+	})
+}
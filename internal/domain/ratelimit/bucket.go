@@ -0,0 +1,65 @@
+package ratelimit
+
+import "time"
+
+// Key identifies one bucket: an action taken by one subject.
+type Key struct {
+	Action  Action
+	Subject Subject
+}
+
+// String returns the key as action/kind:value, e.g. search/ip:203.0.113.7.
+func (k Key) String() string { return k.Action.String() + "/" + k.Subject.String() }
+
+// Bucket is the stored state of one token bucket. Tokens are whole attempts;
+// LastRefill is when the last token was earned back, or when the bucket was last full.
+type Bucket struct {
+	Tokens     int
+	LastRefill time.Time
+}
+
+// NewBucket returns a full bucket for the policy.
+func NewBucket(policy Policy, now time.Time) Bucket {
+	return Bucket{Tokens: policy.Capacity, LastRefill: now}
+}
+
+// Refill adds the tokens earned since LastRefill, capped at capacity. Time spent
+// towards the next token is kept, so frequent calls do not slow the refill.
+func (b Bucket) Refill(policy Policy, now time.Time) Bucket {
+	if b.Tokens >= policy.Capacity {
+		return Bucket{Tokens: policy.Capacity, LastRefill: now}
+	}
+
+	elapsed := now.Sub(b.LastRefill)
+	if elapsed < policy.RefillEvery {
+		return b
+	}
+
+	earned := int(elapsed / policy.RefillEvery)
+	if b.Tokens+earned >= policy.Capacity {
+		return Bucket{Tokens: policy.Capacity, LastRefill: now}
+	}
+	return Bucket{
+		Tokens:     b.Tokens + earned,
+		LastRefill: b.LastRefill.Add(time.Duration(earned) * policy.RefillEvery),
+	}
+}
+
+// RetryAfter is how long until the bucket holds a token again; zero when it has one.
+// The bucket is expected to be refilled at now.
+func (b Bucket) RetryAfter(policy Policy, now time.Time) time.Duration {
+	if b.Tokens > 0 {
+		return 0
+	}
+	return max(policy.RefillEvery-now.Sub(b.LastRefill), 0)
+}
+
+// ResetIn is how long until the bucket is full again; zero when it is.
+// The bucket is expected to be refilled at now.
+func (b Bucket) ResetIn(policy Policy, now time.Time) time.Duration {
+	missing := policy.Capacity - b.Tokens
+	if missing <= 0 {
+		return 0
+	}
+	return max(time.Duration(missing)*policy.RefillEvery-now.Sub(b.LastRefill), 0)
+}
@@ -0,0 +1,60 @@
+package ratelimit_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/ratelimit"
+)
+
+var fixtureNow = time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+
+type stubClock struct {
+	t time.Time
+}
+
+func (s *stubClock) Now() time.Time { return s.t }
+
+type stubStore struct {
+	buckets map[ratelimit.Key]ratelimit.Bucket
+	err     error
+}
+
+func newStubStore() *stubStore {
+	return &stubStore{buckets: make(map[ratelimit.Key]ratelimit.Bucket)}
+}
+
+func (s *stubStore) GetBucket(key ratelimit.Key) (*ratelimit.Bucket, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	b, ok := s.buckets[key]
+	if !ok {
+		return nil, &kernel.Error{Code: kernel.ENotFound, Message: "no bucket"}
+	}
+	return &b, nil
+}
+
+func (s *stubStore) SaveBucket(key ratelimit.Key, bucket ratelimit.Bucket) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.buckets[key] = bucket
+	return nil
+}
+
+func assertNoError(t *testing.T, err error) {
+	t.Helper()
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+}
+
+func assertErrorCode(t *testing.T, err error, want string) {
+	t.Helper()
+	got := kernel.ErrorCode(err)
+	if got != want {
+		t.Errorf("error code: got %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,176 @@
+// Package ratelimit throttles anonymous public actions: subscribe attempts,
+// feedback submissions, and search queries. Each action and subject (an email
+// address or an IP address) owns a token bucket that refills at a steady rate
+// read from the Clock, so short bursts pass but floods are turned away.
+package ratelimit
+
+import (
+	"fmt"
+	"net/netip"
+	"strings"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/shared"
+)
+
+const (
+	MActionInvalid      string = "Invalid rate-limited action: %s."
+	MSubjectKindInvalid string = "Invalid rate limit subject: %s."
+	MSubjectMissing     string = "A rate-limited action needs at least one subject."
+	MIPInvalid          string = "Invalid IP address: %s."
+	MCapacityInvalid    string = "Rate limit capacity must be at least 1."
+	MRefillInvalid      string = "Rate limit refill interval must be positive."
+	MPolicyMissing      string = "No rate limit is defined for %s by %s."
+)
+
+// IPv6PrefixBits is the prefix IPv6 addresses are limited by. A single host
+// usually controls a whole /64, so its addresses share one bucket.
+const IPv6PrefixBits int = 64
+
+// Action is a public action that anonymous visitors can repeat.
+type Action string
+
+const (
+	ActionSubscribe Action = "subscribe" // Newsletter sign-up attempts
+	ActionFeedback  Action = "feedback"  // Error reports and suggestions on posts
+	ActionSearch    Action = "search"    // Full-text search queries
+)
+
+// String returns the action as a string.
+func (a Action) String() string { return string(a) }
+
+// Validate ensures the action is one the limiter knows.
+func (a Action) Validate() error {
+	const op = "Action.Validate"
+
+	switch a {
+	case ActionSubscribe, ActionFeedback, ActionSearch:
+		return nil
+	}
+	return &kernel.Error{Code: kernel.EInvalid, Message: fmt.Sprintf(MActionInvalid, a), Operation: op}
+}
+
+// SubjectKind tells what identifies the visitor being limited.
+type SubjectKind string
+
+const (
+	SubjectEmail SubjectKind = "email"
+	SubjectIP    SubjectKind = "ip"
+)
+
+// String returns the subject kind as a string.
+func (k SubjectKind) String() string { return string(k) }
+
+// Subject is who a bucket belongs to. Values are normalized by the constructors
+// so that trivially different spellings of the same visitor share a bucket.
+type Subject struct {
+	Kind  SubjectKind
+	Value string
+}
+
+// EmailSubject identifies a visitor by email address, compared case-insensitively.
+func EmailSubject(email shared.Email) (Subject, error) {
+	const op = "EmailSubject"
+
+	if err := email.Validate(); err != nil {
+		return Subject{}, &kernel.Error{Operation: op, Cause: err}
+	}
+	return Subject{Kind: SubjectEmail, Value: strings.ToLower(email.String())}, nil
+}
+
+// IPSubject identifies a visitor by IP address. IPv4-mapped IPv6 addresses count
+// as IPv4, and IPv6 addresses are reduced to their /64 network.
+func IPSubject(ip string) (Subject, error) {
+	const op = "IPSubject"
+
+	addr, err := netip.ParseAddr(strings.TrimSpace(ip))
+	if err != nil {
+		return Subject{}, &kernel.Error{Code: kernel.EInvalid, Message: fmt.Sprintf(MIPInvalid, ip), Operation: op}
+	}
+
+	addr = addr.Unmap().WithZone("")
+	if addr.Is6() {
+		prefix, _ := addr.Prefix(IPv6PrefixBits)
+		return Subject{Kind: SubjectIP, Value: prefix.String()}, nil
+	}
+	return Subject{Kind: SubjectIP, Value: addr.String()}, nil
+}
+
+// String returns the subject as kind:value, e.g. ip:203.0.113.7.
+func (s Subject) String() string { return s.Kind.String() + ":" + s.Value }
+
+// Validate ensures the subject has a known kind and a value.
+func (s Subject) Validate() error {
+	const op = "Subject.Validate"
+
+	if s.Kind != SubjectEmail && s.Kind != SubjectIP {
+		return &kernel.Error{Code: kernel.EInvalid, Message: fmt.Sprintf(MSubjectKindInvalid, s.Kind), Operation: op}
+	}
+	if s.Value == "" {
+		return &kernel.Error{Code: kernel.EInvalid, Message: MSubjectMissing, Operation: op}
+	}
+	return nil
+}
+
+// Rule names the bucket family a policy applies to: one action limited by one kind of subject.
+type Rule struct {
+	Action Action
+	Kind   SubjectKind
+}
+
+// String returns the rule as action/kind, e.g. subscribe/email.
+func (r Rule) String() string { return r.Action.String() + "/" + r.Kind.String() }
+
+// Policy sizes a token bucket: Capacity attempts in a burst, and one attempt
+// earned back every RefillEvery.
+type Policy struct {
+	Capacity    int
+	RefillEvery time.Duration
+}
+
+// Validate ensures the bucket holds at least one token and refills.
+func (p Policy) Validate() error {
+	const op = "Policy.Validate"
+
+	if p.Capacity < 1 {
+		return &kernel.Error{Code: kernel.EInvalid, Message: MCapacityInvalid, Operation: op}
+	}
+	if p.RefillEvery <= 0 {
+		return &kernel.Error{Code: kernel.EInvalid, Message: MRefillInvalid, Operation: op}
+	}
+	return nil
+}
+
+// Policies maps each rule to its bucket size.
+type Policies map[Rule]Policy
+
+// DefaultPolicies returns the limits the public site runs with. Per-IP limits are
+// looser than per-email ones because schools and offices share addresses.
+func DefaultPolicies() Policies {
+	return Policies{
+		{ActionSubscribe, SubjectEmail}: {Capacity: 3, RefillEvery: 20 * time.Minute},
+		{ActionSubscribe, SubjectIP}:    {Capacity: 20, RefillEvery: 3 * time.Minute},
+		{ActionFeedback, SubjectEmail}:  {Capacity: 5, RefillEvery: 12 * time.Minute},
+		{ActionFeedback, SubjectIP}:     {Capacity: 10, RefillEvery: 6 * time.Minute},
+		{ActionSearch, SubjectIP}:       {Capacity: 60, RefillEvery: time.Second},
+	}
+}
+
+// Validate ensures every rule names a known action and subject kind and has a valid policy.
+func (ps Policies) Validate() error {
+	const op = "Policies.Validate"
+
+	for rule, policy := range ps {
+		if err := rule.Action.Validate(); err != nil {
+			return &kernel.Error{Operation: op, Cause: err}
+		}
+		if err := (Subject{Kind: rule.Kind, Value: "-"}).Validate(); err != nil {
+			return &kernel.Error{Operation: op, Cause: err}
+		}
+		if err := policy.Validate(); err != nil {
+			return &kernel.Error{Operation: op, Cause: err}
+		}
+	}
+	return nil
+}
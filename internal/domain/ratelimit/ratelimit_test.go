@@ -0,0 +1,169 @@
+package ratelimit_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/ratelimit"
+	"github.com/alnah/fla/internal/domain/shared"
+)
+
+func TestIPSubject(t *testing.T) {
+	tests := []struct {
+		name string
+		ip   string
+		want string
+	}{
+		{"ipv4", " 203.0.113.7 ", "ip:203.0.113.7"},
+		{"ipv4 mapped", "::ffff:203.0.113.7", "ip:203.0.113.7"},
+		{"ipv6 shares its /64", "2001:db8:1:2:aaaa::1", "ip:2001:db8:1:2::/64"},
+		{"ipv6 zone dropped", "fe80::1%eth0", "ip:fe80::/64"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ratelimit.IPSubject(tt.ip)
+			assertNoError(t, err)
+			if got.String() != tt.want {
+				t.Errorf("got %s, want %s", got, tt.want)
+			}
+		})
+	}
+
+	_, err := ratelimit.IPSubject("203.0.113")
+	assertErrorCode(t, err, kernel.EInvalid)
+}
+
+func TestEmailSubject(t *testing.T) {
+	got, err := ratelimit.EmailSubject(shared.Email("Marie@Example.com"))
+	assertNoError(t, err)
+	if got.String() != "email:marie@example.com" {
+		t.Errorf("got %s", got)
+	}
+
+	_, err = ratelimit.EmailSubject(shared.Email("marie"))
+	assertErrorCode(t, err, kernel.EInvalid)
+}
+
+func TestBucket_Refill(t *testing.T) {
+	policy := ratelimit.Policy{Capacity: 3, RefillEvery: time.Minute}
+	empty := ratelimit.Bucket{Tokens: 0, LastRefill: fixtureNow}
+
+	tests := []struct {
+		name  string
+		after time.Duration
+		want  ratelimit.Bucket
+	}{
+		{"nothing earned yet", 59 * time.Second, empty},
+		{"keeps partial progress", 90 * time.Second, ratelimit.Bucket{Tokens: 1, LastRefill: fixtureNow.Add(time.Minute)}},
+		{"capped at capacity", time.Hour, ratelimit.Bucket{Tokens: 3, LastRefill: fixtureNow.Add(time.Hour)}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := empty.Refill(policy, fixtureNow.Add(tt.after))
+			if got != tt.want {
+				t.Errorf("got %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewLimiterService_InvalidPolicies(t *testing.T) {
+	tests := map[string]ratelimit.Policies{
+		"no capacity":  {{ratelimit.ActionSearch, ratelimit.SubjectIP}: {Capacity: 0, RefillEvery: time.Second}},
+		"no refill":    {{ratelimit.ActionSearch, ratelimit.SubjectIP}: {Capacity: 1}},
+		"bad action":   {{"comment", ratelimit.SubjectIP}: {Capacity: 1, RefillEvery: time.Second}},
+		"bad subjects": {{ratelimit.ActionSearch, "cookie"}: {Capacity: 1, RefillEvery: time.Second}},
+	}
+
+	for name, policies := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := ratelimit.NewLimiterService(newStubStore(), policies, &stubClock{fixtureNow})
+			assertErrorCode(t, err, kernel.EInvalid)
+		})
+	}
+
+	_, err := ratelimit.NewLimiterService(newStubStore(), ratelimit.DefaultPolicies(), &stubClock{fixtureNow})
+	assertNoError(t, err)
+}
+
+func TestLimiterService_Allow(t *testing.T) {
+	policies := ratelimit.Policies{
+		{ratelimit.ActionSubscribe, ratelimit.SubjectEmail}: {Capacity: 2, RefillEvery: 10 * time.Minute},
+		{ratelimit.ActionSubscribe, ratelimit.SubjectIP}:    {Capacity: 3, RefillEvery: time.Minute},
+	}
+	email, _ := ratelimit.EmailSubject("marie@example.com")
+	ip, _ := ratelimit.IPSubject("203.0.113.7")
+
+	newService := func(t *testing.T) (*ratelimit.LimiterService, *stubClock, *stubStore) {
+		t.Helper()
+		clock := &stubClock{fixtureNow}
+		store := newStubStore()
+		s, err := ratelimit.NewLimiterService(store, policies, clock)
+		assertNoError(t, err)
+		return s, clock, store
+	}
+
+	t.Run("spends from every bucket and reports the tightest", func(t *testing.T) {
+		s, _, store := newService(t)
+
+		got, err := s.Allow(ratelimit.ActionSubscribe, email, ip)
+		assertNoError(t, err)
+		want := ratelimit.Decision{Remaining: 1, ResetIn: 10 * time.Minute}
+		if got != want {
+			t.Errorf("got %+v, want %+v", got, want)
+		}
+		if b := store.buckets[ratelimit.Key{Action: ratelimit.ActionSubscribe, Subject: ip}]; b.Tokens != 2 {
+			t.Errorf("ip bucket: got %d tokens, want 2", b.Tokens)
+		}
+	})
+
+	t.Run("limited with retry hint, nothing spent", func(t *testing.T) {
+		s, clock, store := newService(t)
+		for range 2 {
+			_, err := s.Allow(ratelimit.ActionSubscribe, email, ip)
+			assertNoError(t, err)
+		}
+
+		clock.t = fixtureNow.Add(4 * time.Minute)
+		_, err := s.Allow(ratelimit.ActionSubscribe, email, ip)
+		assertErrorCode(t, err, kernel.EConflict)
+		if got := kernel.ErrorMessage(err); got != "Too many attempts. Try again in 6m0s." {
+			t.Errorf("message: got %q", got)
+		}
+		retry, ok := ratelimit.RetryAfter(err)
+		if !ok || retry != 6*time.Minute {
+			t.Errorf("retry after: got %s, %v", retry, ok)
+		}
+		if b := store.buckets[ratelimit.Key{Action: ratelimit.ActionSubscribe, Subject: ip}]; b.Tokens != 1 {
+			t.Errorf("ip bucket: got %d tokens, want 1", b.Tokens)
+		}
+
+		clock.t = fixtureNow.Add(10 * time.Minute)
+		_, err = s.Allow(ratelimit.ActionSubscribe, email, ip)
+		assertNoError(t, err)
+	})
+
+	t.Run("no subject or no policy for the action", func(t *testing.T) {
+		s, _, _ := newService(t)
+
+		_, err := s.Allow(ratelimit.ActionSearch, ip)
+		assertErrorCode(t, err, kernel.EInvalid)
+
+		_, err = s.Allow(ratelimit.ActionSubscribe)
+		assertErrorCode(t, err, kernel.EInvalid)
+	})
+
+	t.Run("store failure", func(t *testing.T) {
+		s, _, store := newService(t)
+		store.err = &kernel.Error{Code: kernel.EInternal, Message: "disk full"}
+
+		_, err := s.Allow(ratelimit.ActionSubscribe, ip)
+		assertErrorCode(t, err, kernel.EInternal)
+		if _, ok := ratelimit.RetryAfter(err); ok {
+			t.Error("store failure must not carry a retry hint")
+		}
+	})
+}
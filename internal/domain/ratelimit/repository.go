@@ -0,0 +1,19 @@
+package ratelimit
+
+// BucketReader loads bucket state.
+type BucketReader interface {
+	// GetBucket returns ENotFound when the subject has no bucket for the action yet.
+	GetBucket(key Key) (*Bucket, error)
+}
+
+// BucketWriter saves bucket state. Implementations may drop buckets that are
+// full, since a missing bucket is treated as a full one.
+type BucketWriter interface {
+	SaveBucket(key Key, bucket Bucket) error
+}
+
+// BucketStore combines bucket retrieval and persistence.
+type BucketStore interface {
+	BucketReader
+	BucketWriter
+}
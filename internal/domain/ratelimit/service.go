@@ -0,0 +1,163 @@
+package ratelimit
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+)
+
+const MRateLimited string = "Too many attempts. Try again in %s."
+
+// LimitError records which rule turned an attempt away and when a retry can succeed.
+// It is the cause of the EConflict error Allow returns; read it with RetryAfter.
+type LimitError struct {
+	Rule       Rule
+	RetryAfter time.Duration
+}
+
+func (e *LimitError) Error() string {
+	return fmt.Sprintf("rate limit %s exceeded, retry after %s", e.Rule, e.RetryAfter)
+}
+
+// RetryAfter returns how long a rate-limited caller should wait, for example
+// to fill a Retry-After header. Reports false when err is not a rate limit error.
+func RetryAfter(err error) (time.Duration, bool) {
+	for err != nil {
+		switch e := err.(type) {
+		case *LimitError:
+			return e.RetryAfter, true
+		case *kernel.Error:
+			err = e.Cause
+		default:
+			return 0, false
+		}
+	}
+	return 0, false
+}
+
+// Decision describes the tightest bucket after an allowed attempt.
+type Decision struct {
+	Remaining int           // Attempts left before the next refill
+	ResetIn   time.Duration // Time until the bucket is full again
+}
+
+// LimiterService decides whether anonymous visitors may repeat a public action.
+type LimiterService struct {
+	mu       sync.Mutex // Serializes read-modify-write cycles on buckets within one process
+	store    BucketStore
+	policies Policies
+	clock    kernel.Clock
+}
+
+// NewLimiterService creates limiter service with bucket storage and per-rule policies.
+func NewLimiterService(store BucketStore, policies Policies, clock kernel.Clock) (*LimiterService, error) {
+	const op = "NewLimiterService"
+
+	if err := policies.Validate(); err != nil {
+		return nil, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return &LimiterService{store: store, policies: policies, clock: clock}, nil
+}
+
+// Allow spends one token of the action from every subject's bucket. Subjects
+// whose kind has no policy for the action are ignored. When any bucket is empty,
+// nothing is spent and the error is EConflict, caused by a LimitError carrying
+// the longest wait among the empty buckets.
+func (s *LimiterService) Allow(action Action, subjects ...Subject) (Decision, error) {
+	const op = "LimiterService.Allow"
+
+	if err := action.Validate(); err != nil {
+		return Decision{}, &kernel.Error{Operation: op, Cause: err}
+	}
+	if len(subjects) == 0 {
+		return Decision{}, &kernel.Error{Code: kernel.EInvalid, Message: MSubjectMissing, Operation: op}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.clock.Now()
+
+	type entry struct {
+		key    Key
+		policy Policy
+		bucket Bucket
+	}
+	var entries []entry
+	var limited *LimitError
+
+	for _, subject := range subjects {
+		if err := subject.Validate(); err != nil {
+			return Decision{}, &kernel.Error{Operation: op, Cause: err}
+		}
+
+		rule := Rule{Action: action, Kind: subject.Kind}
+		policy, ok := s.policies[rule]
+		if !ok {
+			continue
+		}
+
+		key := Key{Action: action, Subject: subject}
+		bucket, err := s.load(key, policy, now)
+		if err != nil {
+			return Decision{}, &kernel.Error{Operation: op, Cause: err}
+		}
+
+		if wait := bucket.RetryAfter(policy, now); bucket.Tokens == 0 && (limited == nil || wait > limited.RetryAfter) {
+			limited = &LimitError{Rule: rule, RetryAfter: wait}
+		}
+		entries = append(entries, entry{key: key, policy: policy, bucket: bucket})
+	}
+
+	if len(entries) == 0 {
+		return Decision{}, &kernel.Error{
+			Code:      kernel.EInvalid,
+			Message:   fmt.Sprintf(MPolicyMissing, action, subjects[0].Kind),
+			Operation: op,
+		}
+	}
+
+	if limited != nil {
+		return Decision{}, &kernel.Error{
+			Code:      kernel.EConflict,
+			Message:   fmt.Sprintf(MRateLimited, roundUp(limited.RetryAfter)),
+			Operation: op,
+			Cause:     limited,
+		}
+	}
+
+	var decision Decision
+	for i, e := range entries {
+		e.bucket.Tokens--
+		if err := s.store.SaveBucket(e.key, e.bucket); err != nil {
+			return Decision{}, &kernel.Error{Operation: op, Cause: err}
+		}
+
+		remaining, resetIn := e.bucket.Tokens, e.bucket.ResetIn(e.policy, now)
+		if i == 0 || remaining < decision.Remaining {
+			decision.Remaining = remaining
+		}
+		decision.ResetIn = max(decision.ResetIn, resetIn)
+	}
+
+	return decision, nil
+}
+
+// load returns the subject's bucket refilled to now; a missing bucket is a full one.
+func (s *LimiterService) load(key Key, policy Policy, now time.Time) (Bucket, error) {
+	stored, err := s.store.GetBucket(key)
+	if kernel.ErrorCode(err) == kernel.ENotFound {
+		return NewBucket(policy, now), nil
+	} else if err != nil {
+		return Bucket{}, err
+	}
+	return stored.Refill(policy, now), nil
+}
+
+// roundUp rounds a wait up to whole seconds, so users are never told to retry too early.
+func roundUp(d time.Duration) time.Duration {
+	return (d + time.Second - 1).Truncate(time.Second)
+}
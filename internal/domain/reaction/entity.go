@@ -0,0 +1,77 @@
+package reaction
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/post"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+const (
+	MReactionKindInvalid string = "Invalid reaction kind."
+	MReactionPostMissing string = "Missing post ID."
+	MReactionUserMissing string = "Missing user ID."
+)
+
+// Kind distinguishes public appreciation from private reading lists.
+type Kind string
+
+const (
+	KindLike     Kind = "like"     // Counted publicly on the post
+	KindBookmark Kind = "bookmark" // Saved to the user's reading list
+)
+
+func (k Kind) String() string { return string(k) }
+
+// Validate ensures the reaction kind is known.
+func (k Kind) Validate() error {
+	const op = "Kind.Validate"
+
+	switch k {
+	case KindLike, KindBookmark:
+		return nil
+	default:
+		return &kernel.Error{Code: kernel.EInvalid, Message: MReactionKindInvalid, Operation: op}
+	}
+}
+
+// Reaction records one user liking or bookmarking one post.
+// A user holds at most one reaction of each kind per post.
+type Reaction struct {
+	PostID    kernel.ID[post.Post]
+	UserID    kernel.ID[user.User]
+	Kind      Kind
+	CreatedAt time.Time
+}
+
+// Validate ensures the reaction references a post and a user.
+func (r Reaction) Validate() error {
+	const op = "Reaction.Validate"
+
+	if r.PostID == "" {
+		return &kernel.Error{Code: kernel.EInvalid, Message: MReactionPostMissing, Operation: op}
+	}
+
+	if r.UserID == "" {
+		return &kernel.Error{Code: kernel.EInvalid, Message: MReactionUserMissing, Operation: op}
+	}
+
+	if err := r.Kind.Validate(); err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return nil
+}
+
+// String returns a string representation of the reaction.
+func (r Reaction) String() string {
+	return fmt.Sprintf("Reaction{PostID: %s, UserID: %s, Kind: %s}", r.PostID, r.UserID, r.Kind)
+}
+
+// Counts summarizes reactions on one post.
+type Counts struct {
+	Likes     int
+	Bookmarks int
+}
@@ -0,0 +1,113 @@
+package reaction_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/post"
+	"github.com/alnah/fla/internal/domain/reaction"
+	"github.com/alnah/fla/internal/domain/shared"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+type stubClock struct {
+	t time.Time
+}
+
+func (s *stubClock) Now() time.Time { return s.t }
+
+type stubReactor struct {
+	id       kernel.ID[user.User]
+	canReact bool
+}
+
+func (r stubReactor) GetID() kernel.ID[user.User] { return r.id }
+func (r stubReactor) CanReact() bool              { return r.canReact }
+
+type reactionKey struct {
+	postID kernel.ID[post.Post]
+	userID kernel.ID[user.User]
+	kind   reaction.Kind
+}
+
+type stubRepository struct {
+	reactions map[reactionKey]reaction.Reaction
+	adds      int
+}
+
+func newStubRepository() *stubRepository {
+	return &stubRepository{reactions: make(map[reactionKey]reaction.Reaction)}
+}
+
+func (r *stubRepository) Get(postID kernel.ID[post.Post], userID kernel.ID[user.User], kind reaction.Kind) (*reaction.Reaction, error) {
+	if found, ok := r.reactions[reactionKey{postID, userID, kind}]; ok {
+		return &found, nil
+	}
+	return nil, &kernel.Error{Code: kernel.ENotFound, Message: "reaction not found"}
+}
+
+func (r *stubRepository) Add(found reaction.Reaction) error {
+	r.adds++
+	r.reactions[reactionKey{found.PostID, found.UserID, found.Kind}] = found
+	return nil
+}
+
+func (r *stubRepository) Remove(postID kernel.ID[post.Post], userID kernel.ID[user.User], kind reaction.Kind) error {
+	delete(r.reactions, reactionKey{postID, userID, kind})
+	return nil
+}
+
+func (r *stubRepository) CountByPost(postID kernel.ID[post.Post]) (reaction.Counts, error) {
+	var counts reaction.Counts
+	for key := range r.reactions {
+		if key.postID != postID {
+			continue
+		}
+		switch key.kind {
+		case reaction.KindLike:
+			counts.Likes++
+		case reaction.KindBookmark:
+			counts.Bookmarks++
+		}
+	}
+	return counts, nil
+}
+
+func (r *stubRepository) GetBookmarkedPosts(userID kernel.ID[user.User], pagination shared.Pagination) (post.PostsList, error) {
+	var posts []post.Post
+	for key := range r.reactions {
+		if key.userID == userID && key.kind == reaction.KindBookmark {
+			posts = append(posts, post.Post{PostID: key.postID})
+		}
+	}
+	return post.NewPostsList(posts, pagination), nil
+}
+
+type stubPosts map[kernel.ID[post.Post]]post.Post
+
+func (s stubPosts) GetByID(postID kernel.ID[post.Post]) (*post.Post, error) {
+	if p, ok := s[postID]; ok {
+		return &p, nil
+	}
+	return nil, &kernel.Error{Code: kernel.ENotFound, Message: "post not found"}
+}
+
+func (s stubPosts) GetBySlug(slug shared.Slug) (*post.Post, error) {
+	return nil, &kernel.Error{Code: kernel.ENotFound, Message: "post not found"}
+}
+
+func assertNoError(t *testing.T, err error) {
+	t.Helper()
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+}
+
+func assertErrorCode(t *testing.T, err error, want string) {
+	t.Helper()
+	got := kernel.ErrorCode(err)
+	if got != want {
+		t.Errorf("error code: got %q, want %q", got, want)
+	}
+}
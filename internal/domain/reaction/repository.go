@@ -0,0 +1,49 @@
+package reaction
+
+import (
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/post"
+	"github.com/alnah/fla/internal/domain/shared"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+// ReactionReader looks up individual reactions.
+// Used to render like and bookmark buttons in their current state.
+type ReactionReader interface {
+	// Get returns the user's reaction of a kind on a post.
+	// Returns ENotFound when the user has not reacted that way.
+	Get(postID kernel.ID[post.Post], userID kernel.ID[user.User], kind Kind) (*Reaction, error)
+}
+
+// ReactionWriter persists reactions.
+type ReactionWriter interface {
+	// Add stores a reaction; the service checks for duplicates first.
+	Add(reaction Reaction) error
+
+	// Remove deletes a reaction; removing a missing reaction is not an error.
+	Remove(postID kernel.ID[post.Post], userID kernel.ID[user.User], kind Kind) error
+}
+
+// ReactionCounter aggregates reactions for display.
+// Used by post pages and listings showing like counts.
+type ReactionCounter interface {
+	// CountByPost returns like and bookmark totals for a post.
+	CountByPost(postID kernel.ID[post.Post]) (Counts, error)
+}
+
+// BookmarkLister lists reading lists.
+// Used by the "saved lessons" page of signed-in users.
+type BookmarkLister interface {
+	// GetBookmarkedPosts returns posts the user bookmarked, most recently saved first.
+	// Posts no longer published are left out.
+	GetBookmarkedPosts(userID kernel.ID[user.User], pagination shared.Pagination) (post.PostsList, error)
+}
+
+// Repository combines all reaction operations.
+// Most concrete implementations (like PostgresReactionRepository) will implement this.
+type Repository interface {
+	ReactionReader
+	ReactionWriter
+	ReactionCounter
+	BookmarkLister
+}
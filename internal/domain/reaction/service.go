@@ -0,0 +1,130 @@
+package reaction
+
+import (
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/post"
+	"github.com/alnah/fla/internal/domain/shared"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+const (
+	MReactionForbidden       string = "Sign in to like or bookmark posts."
+	MReactionPostUnpublished string = "Only published posts can be liked or bookmarked."
+)
+
+// Reactor is whoever reacts to a post; implemented by user.User.
+type Reactor interface {
+	GetID() kernel.ID[user.User]
+	CanReact() bool
+}
+
+// ReactionService adds and removes likes and bookmarks.
+// Add and Remove are idempotent so double clicks and retries are harmless.
+type ReactionService struct {
+	repository Repository
+	posts      post.PostReader
+	clock      kernel.Clock
+}
+
+// NewReactionService creates reaction service with reaction storage and post lookup.
+func NewReactionService(repository Repository, posts post.PostReader, clock kernel.Clock) *ReactionService {
+	return &ReactionService{
+		repository: repository,
+		posts:      posts,
+		clock:      clock,
+	}
+}
+
+// Add records a reaction on a published post, returning the existing one if already there.
+func (s *ReactionService) Add(reactor Reactor, postID kernel.ID[post.Post], kind Kind) (Reaction, error) {
+	const op = "ReactionService.Add"
+
+	if !reactor.CanReact() {
+		return Reaction{}, &kernel.Error{Code: kernel.EForbidden, Message: MReactionForbidden, Operation: op}
+	}
+
+	r := Reaction{PostID: postID, UserID: reactor.GetID(), Kind: kind}
+	if err := r.Validate(); err != nil {
+		return Reaction{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	existing, err := s.repository.Get(postID, r.UserID, kind)
+	if err == nil {
+		return *existing, nil
+	}
+	if kernel.ErrorCode(err) != kernel.ENotFound {
+		return Reaction{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	p, err := s.posts.GetByID(postID)
+	if err != nil {
+		return Reaction{}, &kernel.Error{Operation: op, Cause: err}
+	}
+	if !p.IsPublished() {
+		return Reaction{}, &kernel.Error{Code: kernel.EInvalid, Message: MReactionPostUnpublished, Operation: op}
+	}
+
+	r.CreatedAt = s.clock.Now()
+	if err := s.repository.Add(r); err != nil {
+		return Reaction{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return r, nil
+}
+
+// Remove withdraws a reaction; removing one that does not exist succeeds.
+// Works on unpublished posts too, so readers can clean up their lists.
+func (s *ReactionService) Remove(reactor Reactor, postID kernel.ID[post.Post], kind Kind) error {
+	const op = "ReactionService.Remove"
+
+	if !reactor.CanReact() {
+		return &kernel.Error{Code: kernel.EForbidden, Message: MReactionForbidden, Operation: op}
+	}
+
+	r := Reaction{PostID: postID, UserID: reactor.GetID(), Kind: kind}
+	if err := r.Validate(); err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if err := s.repository.Remove(postID, r.UserID, kind); err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return nil
+}
+
+// Counts returns like and bookmark totals for a post.
+func (s *ReactionService) Counts(postID kernel.ID[post.Post]) (Counts, error) {
+	const op = "ReactionService.Counts"
+
+	if postID == "" {
+		return Counts{}, &kernel.Error{Code: kernel.EInvalid, Message: MReactionPostMissing, Operation: op}
+	}
+
+	counts, err := s.repository.CountByPost(postID)
+	if err != nil {
+		return Counts{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return counts, nil
+}
+
+// Bookmarks lists the reactor's reading list, most recently saved first.
+func (s *ReactionService) Bookmarks(reactor Reactor, pagination shared.Pagination) (post.PostsList, error) {
+	const op = "ReactionService.Bookmarks"
+
+	if !reactor.CanReact() {
+		return post.PostsList{}, &kernel.Error{Code: kernel.EForbidden, Message: MReactionForbidden, Operation: op}
+	}
+
+	if err := pagination.Validate(); err != nil {
+		return post.PostsList{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	list, err := s.repository.GetBookmarkedPosts(reactor.GetID(), pagination)
+	if err != nil {
+		return post.PostsList{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return list, nil
+}
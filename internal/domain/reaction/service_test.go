@@ -0,0 +1,145 @@
+package reaction_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/post"
+	"github.com/alnah/fla/internal/domain/reaction"
+	"github.com/alnah/fla/internal/domain/shared"
+)
+
+func newTestService() (*reaction.ReactionService, *stubRepository) {
+	repo := newStubRepository()
+	posts := stubPosts{
+		"lesson": {PostID: "lesson", Status: post.StatusPublished},
+		"draft":  {PostID: "draft", Status: post.StatusDraft},
+	}
+	clock := &stubClock{t: time.Date(2024, 3, 5, 9, 0, 0, 0, time.UTC)}
+	return reaction.NewReactionService(repo, posts, clock), repo
+}
+
+var (
+	marie   = stubReactor{id: "marie", canReact: true}
+	visitor = stubReactor{id: "visitor"}
+)
+
+func TestReactionService_Add(t *testing.T) {
+	t.Run("likes a published post", func(t *testing.T) {
+		service, repo := newTestService()
+
+		got, err := service.Add(marie, "lesson", reaction.KindLike)
+
+		assertNoError(t, err)
+		if got.UserID != "marie" || got.CreatedAt.IsZero() || repo.adds != 1 {
+			t.Errorf("got %v after %d adds", got, repo.adds)
+		}
+	})
+
+	t.Run("is idempotent", func(t *testing.T) {
+		service, repo := newTestService()
+		first, _ := service.Add(marie, "lesson", reaction.KindBookmark)
+
+		second, err := service.Add(marie, "lesson", reaction.KindBookmark)
+
+		assertNoError(t, err)
+		if repo.adds != 1 || !second.CreatedAt.Equal(first.CreatedAt) {
+			t.Errorf("expected existing reaction, got %d adds", repo.adds)
+		}
+	})
+
+	tests := []struct {
+		name    string
+		reactor stubReactor
+		postID  kernel.ID[post.Post]
+		kind    reaction.Kind
+		code    string
+	}{
+		{"visitor cannot react", visitor, "lesson", reaction.KindLike, kernel.EForbidden},
+		{"unknown kind", marie, "lesson", "love", kernel.EInvalid},
+		{"unpublished post", marie, "draft", reaction.KindLike, kernel.EInvalid},
+		{"missing post", marie, "ghost", reaction.KindLike, kernel.ENotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, _ := newTestService()
+
+			_, err := service.Add(tt.reactor, tt.postID, tt.kind)
+
+			assertErrorCode(t, err, tt.code)
+		})
+	}
+}
+
+func TestReactionService_Remove(t *testing.T) {
+	t.Run("removes and tolerates repeats", func(t *testing.T) {
+		service, _ := newTestService()
+		_, _ = service.Add(marie, "lesson", reaction.KindLike)
+
+		assertNoError(t, service.Remove(marie, "lesson", reaction.KindLike))
+		assertNoError(t, service.Remove(marie, "lesson", reaction.KindLike))
+
+		counts, err := service.Counts("lesson")
+		assertNoError(t, err)
+		if counts.Likes != 0 {
+			t.Errorf("got %d likes, want 0", counts.Likes)
+		}
+	})
+
+	t.Run("visitor cannot remove", func(t *testing.T) {
+		service, _ := newTestService()
+
+		err := service.Remove(visitor, "lesson", reaction.KindLike)
+
+		assertErrorCode(t, err, kernel.EForbidden)
+	})
+}
+
+func TestReactionService_Counts(t *testing.T) {
+	service, _ := newTestService()
+	paul := stubReactor{id: "paul", canReact: true}
+	_, _ = service.Add(marie, "lesson", reaction.KindLike)
+	_, _ = service.Add(paul, "lesson", reaction.KindLike)
+	_, _ = service.Add(paul, "lesson", reaction.KindBookmark)
+
+	got, err := service.Counts("lesson")
+
+	assertNoError(t, err)
+	if got != (reaction.Counts{Likes: 2, Bookmarks: 1}) {
+		t.Errorf("got %+v", got)
+	}
+}
+
+func TestReactionService_Bookmarks(t *testing.T) {
+	page := shared.Pagination{Page: 1, Limit: 10}
+
+	t.Run("lists the reactor's bookmarks", func(t *testing.T) {
+		service, _ := newTestService()
+		_, _ = service.Add(marie, "lesson", reaction.KindBookmark)
+
+		got, err := service.Bookmarks(marie, page)
+
+		assertNoError(t, err)
+		if got.Count() != 1 || got.Posts[0].PostID != "lesson" {
+			t.Errorf("got %v", got)
+		}
+	})
+
+	t.Run("visitor has no bookmarks", func(t *testing.T) {
+		service, _ := newTestService()
+
+		_, err := service.Bookmarks(visitor, page)
+
+		assertErrorCode(t, err, kernel.EForbidden)
+	})
+
+	t.Run("rejects invalid pagination", func(t *testing.T) {
+		service, _ := newTestService()
+
+		_, err := service.Bookmarks(marie, shared.Pagination{})
+
+		assertErrorCode(t, err, kernel.EInvalid)
+	})
+}
@@ -0,0 +1,127 @@
+package recommendation_test
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/category"
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/post"
+	"github.com/alnah/fla/internal/domain/shared"
+	"github.com/alnah/fla/internal/domain/tag"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+type stubPosts struct {
+	posts []post.Post
+}
+
+func (s *stubPosts) GetByID(id kernel.ID[post.Post]) (*post.Post, error) {
+	for _, p := range s.posts {
+		if p.PostID == id {
+			return &p, nil
+		}
+	}
+	return nil, &kernel.Error{Code: kernel.ENotFound, Message: "post not found"}
+}
+
+func (s *stubPosts) GetBySlug(slug shared.Slug) (*post.Post, error) {
+	return nil, &kernel.Error{Code: kernel.ENotFound, Message: "post not found"}
+}
+
+func (s *stubPosts) GetPublishedPosts(pagination shared.Pagination) (post.PostsList, error) {
+	return post.NewPostsList(s.posts, pagination), nil
+}
+
+func (s *stubPosts) GetPostsByCategory(id kernel.ID[category.Category], pagination shared.Pagination) (post.PostsList, error) {
+	var found []post.Post
+	for _, p := range s.posts {
+		if p.Category.CategoryID == id {
+			found = append(found, p)
+		}
+	}
+	return post.NewPostsList(found, pagination), nil
+}
+
+func (s *stubPosts) GetPostsByTag(id kernel.ID[tag.Tag], pagination shared.Pagination) (post.PostsList, error) {
+	return s.GetPostsByTags([]kernel.ID[tag.Tag]{id}, pagination)
+}
+
+func (s *stubPosts) GetPostsByTags(ids []kernel.ID[tag.Tag], pagination shared.Pagination) (post.PostsList, error) {
+	var found []post.Post
+	for _, p := range s.posts {
+		if slices.ContainsFunc(ids, p.Tags.Contains) {
+			found = append(found, p)
+		}
+	}
+	return post.NewPostsList(found, pagination), nil
+}
+
+func (s *stubPosts) GetPostsByAuthor(id kernel.ID[user.User], pagination shared.Pagination) (post.PostsList, error) {
+	return post.PostsList{}, nil
+}
+
+type stubPaths struct {
+	paths map[kernel.ID[category.Category]]category.CategoryPath
+}
+
+func (s *stubPaths) BuildPath(id kernel.ID[category.Category]) (category.CategoryPath, error) {
+	if path, ok := s.paths[id]; ok {
+		return path, nil
+	}
+	return nil, &kernel.Error{Code: kernel.ENotFound, Message: "category not found"}
+}
+
+func (s *stubPaths) FindByPath(segments []string) (*category.Category, error) {
+	return nil, &kernel.Error{Code: kernel.ENotFound, Message: "category not found"}
+}
+
+func (s *stubPaths) GetChildren(id kernel.ID[category.Category]) ([]category.Category, error) {
+	var children []category.Category
+	for _, path := range s.paths {
+		if len(path) > 1 && path[len(path)-2].CategoryID == id {
+			children = append(children, path[len(path)-1])
+		}
+	}
+	return children, nil
+}
+
+func (s *stubPaths) GetRootCategories() ([]category.Category, error) {
+	return nil, nil
+}
+
+func cat(id, name string) category.Category {
+	return category.Category{CategoryID: kernel.ID[category.Category](id), Name: category.CategoryName(name)}
+}
+
+func publishedPost(id string, c category.Category, publishedAt time.Time) post.Post {
+	return post.Post{
+		PostID:      kernel.ID[post.Post](id),
+		Status:      post.StatusPublished,
+		Category:    c,
+		PublishedAt: &publishedAt,
+	}
+}
+
+func assertNoError(t *testing.T, err error) {
+	t.Helper()
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+}
+
+func assertError(t *testing.T, err error) {
+	t.Helper()
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+}
+
+func assertErrorCode(t *testing.T, err error, want string) {
+	t.Helper()
+	got := kernel.ErrorCode(err)
+	if got != want {
+		t.Errorf("error code: got %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,20 @@
+package recommendation
+
+import (
+	"github.com/alnah/fla/internal/domain/category"
+	"github.com/alnah/fla/internal/domain/post"
+)
+
+// PostRepository provides the post lookups needed to gather candidates.
+// Typically implemented by the post repository adapter.
+type PostRepository interface {
+	post.PostReader
+	post.PostLister
+}
+
+// CategoryTree provides the hierarchy lookups used to find nearby topics.
+// Typically implemented by the category repository adapter.
+type CategoryTree interface {
+	category.CategoryPathBuilder
+	category.CategoryHierarchy
+}
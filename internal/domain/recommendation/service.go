@@ -0,0 +1,201 @@
+package recommendation
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/alnah/fla/internal/domain/category"
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/post"
+	"github.com/alnah/fla/internal/domain/shared"
+	"github.com/alnah/fla/internal/domain/tag"
+)
+
+// Scoring weights for relatedness signals.
+const (
+	WeightSharedTag     = 3 // Per tag both posts carry
+	WeightCategoryDepth = 2 // Per shared category below the level root (skill, topic)
+	WeightSameLevel     = 2 // Same CEFR level
+	WeightAdjacentLevel = 1 // One CEFR level apart
+
+	DefaultRelatedLimit = 4
+	MaxRelatedLimit     = 20
+	CandidatePoolSize   = 50 // Posts fetched per tag or category when gathering candidates
+)
+
+// RelatedPost is a recommended post with its relatedness score.
+type RelatedPost struct {
+	Post  post.Post
+	Score int
+}
+
+// RelatedService recommends posts for "keep learning" sections.
+// Scores candidates by shared tags, category subtree closeness, and CEFR level proximity.
+type RelatedService struct {
+	posts      PostRepository
+	categories CategoryTree
+}
+
+// NewRelatedService creates recommendation service with post and category lookups.
+func NewRelatedService(posts PostRepository, categories CategoryTree) *RelatedService {
+	return &RelatedService{
+		posts:      posts,
+		categories: categories,
+	}
+}
+
+// RelatedPosts returns up to limit published posts most related to the given post.
+// Ties break on most recent publication so fresh content surfaces first.
+func (s *RelatedService) RelatedPosts(postID kernel.ID[post.Post], limit int) ([]RelatedPost, error) {
+	const op = "RelatedService.RelatedPosts"
+
+	if limit <= 0 {
+		limit = DefaultRelatedLimit
+	}
+	limit = min(limit, MaxRelatedLimit)
+
+	source, err := s.posts.GetByID(postID)
+	if err != nil {
+		return nil, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	sourcePath, err := s.categories.BuildPath(source.Category.CategoryID)
+	if err != nil {
+		return nil, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	candidates, err := s.candidates(source, sourcePath)
+	if err != nil {
+		return nil, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	paths := map[kernel.ID[category.Category]]category.CategoryPath{source.Category.CategoryID: sourcePath}
+	related := make([]RelatedPost, 0, len(candidates))
+	for _, candidate := range candidates {
+		candidatePath, ok := paths[candidate.Category.CategoryID]
+		if !ok {
+			if candidatePath, err = s.categories.BuildPath(candidate.Category.CategoryID); err != nil {
+				return nil, &kernel.Error{Operation: op, Cause: err}
+			}
+			paths[candidate.Category.CategoryID] = candidatePath
+		}
+
+		score := Score(source.Tags, sourcePath, candidate.Tags, candidatePath)
+		if score > 0 {
+			related = append(related, RelatedPost{Post: candidate, Score: score})
+		}
+	}
+
+	slices.SortStableFunc(related, func(a, b RelatedPost) int {
+		if c := cmp.Compare(b.Score, a.Score); c != 0 {
+			return c
+		}
+		return comparePublishedAt(b.Post, a.Post)
+	})
+
+	if len(related) > limit {
+		related = related[:limit]
+	}
+
+	return related, nil
+}
+
+// Score computes the relatedness of two posts from their tags and category paths.
+func Score(
+	tagsA []kernel.ID[tag.Tag], pathA category.CategoryPath,
+	tagsB []kernel.ID[tag.Tag], pathB category.CategoryPath,
+) int {
+	score := 0
+
+	for _, t := range tagsA {
+		if slices.Contains(tagsB, t) {
+			score += WeightSharedTag
+		}
+	}
+
+	// The root is the level, scored separately by proximity
+	if depth := pathA.CommonDepth(pathB); depth > 1 {
+		score += (depth - 1) * WeightCategoryDepth
+	}
+
+	levelA, okA := pathA.Level()
+	levelB, okB := pathB.Level()
+	if okA && okB {
+		switch levelA.Distance(levelB) {
+		case 0:
+			score += WeightSameLevel
+		case 1:
+			score += WeightAdjacentLevel
+		}
+	}
+
+	return score
+}
+
+// candidates gathers published posts sharing a tag or the source's category branch.
+func (s *RelatedService) candidates(
+	source *post.Post,
+	sourcePath category.CategoryPath,
+) ([]post.Post, error) {
+	page := shared.Pagination{Page: 1, Limit: CandidatePoolSize}
+
+	var lists []post.PostsList
+	if len(source.Tags) > 0 {
+		list, err := s.posts.GetPostsByTags(source.Tags, page)
+		if err != nil {
+			return nil, err
+		}
+		lists = append(lists, list)
+	}
+
+	// Leaf category, its parent, and sibling topics of the same skill
+	categoryIDs := []kernel.ID[category.Category]{source.Category.CategoryID}
+	if len(sourcePath) > 1 {
+		parentID := sourcePath[len(sourcePath)-2].CategoryID
+		categoryIDs = append(categoryIDs, parentID)
+
+		siblings, err := s.categories.GetChildren(parentID)
+		if err != nil {
+			return nil, err
+		}
+		for _, sibling := range siblings {
+			if sibling.CategoryID != source.Category.CategoryID {
+				categoryIDs = append(categoryIDs, sibling.CategoryID)
+			}
+		}
+	}
+	for _, categoryID := range categoryIDs {
+		list, err := s.posts.GetPostsByCategory(categoryID, page)
+		if err != nil {
+			return nil, err
+		}
+		lists = append(lists, list)
+	}
+
+	seen := map[kernel.ID[post.Post]]bool{source.PostID: true}
+	var candidates []post.Post
+	for _, list := range lists {
+		for _, p := range list.Posts {
+			if seen[p.PostID] || !p.IsPublished() {
+				continue
+			}
+			seen[p.PostID] = true
+			candidates = append(candidates, p)
+		}
+	}
+
+	return candidates, nil
+}
+
+func comparePublishedAt(a, b post.Post) int {
+	switch {
+	case a.PublishedAt == nil && b.PublishedAt == nil:
+		return 0
+	case a.PublishedAt == nil:
+		return -1
+	case b.PublishedAt == nil:
+		return 1
+	default:
+		return a.PublishedAt.Compare(*b.PublishedAt)
+	}
+}
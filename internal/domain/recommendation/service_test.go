@@ -0,0 +1,137 @@
+package recommendation_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/category"
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/post"
+	"github.com/alnah/fla/internal/domain/recommendation"
+)
+
+func setupRelatedService() *recommendation.RelatedService {
+	a1, a2, b2 := cat("a1", "A1"), cat("a2", "A2"), cat("b2", "B2")
+	a1Reading, a2Reading := cat("a1-reading", "Lecture"), cat("a2-reading", "Lecture")
+	a1Sports, a1Food := cat("a1-sports", "Sports"), cat("a1-food", "Cuisine")
+	day := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	draft := publishedPost("draft-sports", a1Sports, day)
+	draft.Status = post.StatusDraft
+
+	source := publishedPost("source", a1Sports, day)
+	source.Tags = post.PostTags{"football", "vocabulaire"}
+	taggedA2 := publishedPost("tagged-a2", a2Reading, day.AddDate(0, 0, 3))
+	taggedA2.Tags = post.PostTags{"football"}
+	taggedB2 := publishedPost("tagged-b2", b2, day.AddDate(0, 0, 4))
+	taggedB2.Tags = post.PostTags{"football", "vocabulaire"}
+	draft.Tags = post.PostTags{"football"}
+
+	repo := &stubPosts{
+		posts: []post.Post{
+			source,
+			publishedPost("same-topic", a1Sports, day.AddDate(0, 0, 1)),
+			publishedPost("sibling-topic", a1Food, day.AddDate(0, 0, 2)),
+			taggedA2,
+			taggedB2,
+			draft,
+		},
+	}
+
+	paths := &stubPaths{paths: map[kernel.ID[category.Category]]category.CategoryPath{
+		"a1-sports":  {a1, a1Reading, a1Sports},
+		"a1-food":    {a1, a1Reading, a1Food},
+		"a2-reading": {a2, a2Reading},
+		"b2":         {b2},
+	}}
+
+	return recommendation.NewRelatedService(repo, paths)
+}
+
+func TestRelatedService_RelatedPosts(t *testing.T) {
+	t.Run("ranks by score then most recent", func(t *testing.T) {
+		service := setupRelatedService()
+
+		got, err := service.RelatedPosts("source", 10)
+
+		assertNoError(t, err)
+		want := []struct {
+			id    kernel.ID[post.Post]
+			score int
+		}{
+			{"tagged-b2", 2 * recommendation.WeightSharedTag},
+			{"same-topic", 2*recommendation.WeightCategoryDepth + recommendation.WeightSameLevel},
+			{"tagged-a2", recommendation.WeightSharedTag + recommendation.WeightAdjacentLevel},
+			{"sibling-topic", recommendation.WeightCategoryDepth + recommendation.WeightSameLevel},
+		}
+		if len(got) != len(want) {
+			t.Fatalf("got %d related posts, want %d: %v", len(got), len(want), got)
+		}
+		for i, w := range want {
+			if got[i].Post.PostID != w.id || got[i].Score != w.score {
+				t.Errorf("[%d]: got %s (%d), want %s (%d)", i, got[i].Post.PostID, got[i].Score, w.id, w.score)
+			}
+		}
+	})
+
+	t.Run("excludes source and unpublished posts", func(t *testing.T) {
+		service := setupRelatedService()
+
+		got, err := service.RelatedPosts("source", 10)
+
+		assertNoError(t, err)
+		for _, r := range got {
+			if r.Post.PostID == "source" || r.Post.PostID == "draft-sports" {
+				t.Errorf("unexpected post %s", r.Post.PostID)
+			}
+		}
+	})
+
+	t.Run("applies limit and default", func(t *testing.T) {
+		service := setupRelatedService()
+
+		got, err := service.RelatedPosts("source", 2)
+		assertNoError(t, err)
+		if len(got) != 2 {
+			t.Errorf("limit 2: got %d", len(got))
+		}
+
+		got, err = service.RelatedPosts("source", 0)
+		assertNoError(t, err)
+		if len(got) != recommendation.DefaultRelatedLimit {
+			t.Errorf("default limit: got %d", len(got))
+		}
+	})
+
+	t.Run("returns not found for unknown post", func(t *testing.T) {
+		service := setupRelatedService()
+
+		_, err := service.RelatedPosts("missing", 4)
+
+		assertError(t, err)
+		assertErrorCode(t, err, kernel.ENotFound)
+	})
+}
+
+func TestScore(t *testing.T) {
+	a1, c1 := cat("a1", "A1"), cat("c1", "C1")
+	culture := cat("culture", "Culture")
+
+	tests := []struct {
+		name         string
+		pathA, pathB category.CategoryPath
+		want         int
+	}{
+		{name: "distant levels", pathA: category.CategoryPath{a1}, pathB: category.CategoryPath{c1}, want: 0},
+		{name: "same level root only", pathA: category.CategoryPath{a1}, pathB: category.CategoryPath{a1}, want: recommendation.WeightSameLevel},
+		{name: "non-level roots", pathA: category.CategoryPath{culture}, pathB: category.CategoryPath{culture}, want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := recommendation.Score(nil, tt.pathA, nil, tt.pathB); got != tt.want {
+				t.Errorf("got %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
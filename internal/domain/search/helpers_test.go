@@ -0,0 +1,90 @@
+package search_test
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/category"
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/post"
+	"github.com/alnah/fla/internal/domain/search"
+	"github.com/alnah/fla/internal/domain/shared"
+)
+
+var fixtureNow = time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+
+type stubClock struct {
+	t time.Time
+}
+
+func (s *stubClock) Now() time.Time { return s.t }
+
+type stubPosts struct {
+	posts []post.Post
+	err   error
+}
+
+func (s *stubPosts) Find(q post.Query) (post.PostsList, error) {
+	if s.err != nil {
+		return post.PostsList{}, s.err
+	}
+
+	var matched []post.Post
+	for _, p := range s.posts {
+		if q.Matches(p, nil) {
+			matched = append(matched, p)
+		}
+	}
+	slices.SortFunc(matched, q.Compare)
+
+	pagination, _ := shared.NewPagination(q.Pagination.Page, q.Pagination.Limit, len(matched))
+	start := min(pagination.Offset(), len(matched))
+	end := min(start+pagination.Limit, len(matched))
+	return post.NewPostsList(matched[start:end], pagination), nil
+}
+
+type stubCategories struct {
+	category category.Category
+}
+
+func (s stubCategories) BuildPath(kernel.ID[category.Category]) (category.CategoryPath, error) {
+	return category.CategoryPath{s.category}, nil
+}
+
+func (s stubCategories) FindByPath([]string) (*category.Category, error) { return &s.category, nil }
+
+type stubStore struct {
+	index *search.Index
+	err   error
+}
+
+func (s *stubStore) SaveIndex(index search.Index) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.index = &index
+	return nil
+}
+
+func (s *stubStore) LoadIndex() (search.Index, error) {
+	if s.index == nil {
+		return search.Index{}, &kernel.Error{Code: kernel.ENotFound, Message: "no index"}
+	}
+	return *s.index, nil
+}
+
+func assertNoError(t *testing.T, err error) {
+	t.Helper()
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+}
+
+func assertErrorCode(t *testing.T, err error, want string) {
+	t.Helper()
+	got := kernel.ErrorCode(err)
+	if got != want {
+		t.Errorf("error code: got %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,155 @@
+// Package search indexes published posts for site search.
+// The index is rebuilt from the posts, never edited in place, so it can
+// always be thrown away and rebuilt after a bug fix or a bulk import.
+package search
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+	"time"
+	"unicode"
+
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/post"
+	"github.com/alnah/fla/internal/domain/shared"
+)
+
+const (
+	MinTermLength int = 2 // Shorter words match too much to be worth indexing
+	TitleWeight   int = 3 // A term in the title counts as much as three in the body
+)
+
+// Document is one indexed post, with what result lists display.
+type Document struct {
+	PostID      kernel.ID[post.Post]
+	Title       shared.Title
+	Path        string // URL path, e.g. "a1/comprehension-ecrite/sports/jouer-au-football"
+	Excerpt     string
+	PublishedAt time.Time
+}
+
+// Posting records that a document holds a term, with its weighted frequency.
+type Posting struct {
+	Document int // Position in Index.Documents
+	Weight   int
+}
+
+// Index is an inverted index from normalized terms to the documents holding them.
+type Index struct {
+	BuiltAt   time.Time
+	Documents []Document
+	Postings  map[string][]Posting
+}
+
+// Result is a document matching a search, with its relevance.
+type Result struct {
+	Document
+	Score int
+}
+
+// NewIndex creates an empty index built at the given time.
+func NewIndex(builtAt time.Time) Index {
+	return Index{BuiltAt: builtAt, Postings: make(map[string][]Posting)}
+}
+
+// Add indexes a post under its URL path. Title terms weigh TitleWeight times body terms.
+func (idx *Index) Add(p post.Post, path string) {
+	doc := len(idx.Documents)
+	idx.Documents = append(idx.Documents, Document{
+		PostID:      p.PostID,
+		Title:       p.Title,
+		Path:        path,
+		Excerpt:     p.GetEffectiveExcerpt(),
+		PublishedAt: publishedAt(p),
+	})
+
+	weights := make(map[string]int)
+	for _, term := range Tokenize(p.Title.String()) {
+		weights[term] += TitleWeight
+	}
+	for _, term := range Tokenize(kernel.StripMarkdown(p.Content.String())) {
+		weights[term]++
+	}
+
+	for term, weight := range weights {
+		idx.Postings[term] = append(idx.Postings[term], Posting{Document: doc, Weight: weight})
+	}
+}
+
+// Search returns the documents holding every term of the query, best first;
+// equal scores list the newest post first. An empty query matches nothing.
+func (idx Index) Search(query string, limit int) []Result {
+	terms := slices.Compact(slices.Sorted(slices.Values(Tokenize(query))))
+	if len(terms) == 0 || limit <= 0 {
+		return nil
+	}
+
+	scores := make(map[int]int)
+	for i, term := range terms {
+		matched := make(map[int]int)
+		for _, posting := range idx.Postings[term] {
+			if _, ok := scores[posting.Document]; i == 0 || ok {
+				matched[posting.Document] = scores[posting.Document] + posting.Weight
+			}
+		}
+		scores = matched
+	}
+
+	results := make([]Result, 0, len(scores))
+	for doc, score := range scores {
+		results = append(results, Result{Document: idx.Documents[doc], Score: score})
+	}
+	slices.SortFunc(results, func(a, b Result) int {
+		return cmp.Or(
+			cmp.Compare(b.Score, a.Score),
+			b.PublishedAt.Compare(a.PublishedAt),
+			cmp.Compare(a.PostID, b.PostID),
+		)
+	})
+
+	return results[:min(limit, len(results))]
+}
+
+// Terms returns how many distinct terms the index holds.
+func (idx Index) Terms() int {
+	return len(idx.Postings)
+}
+
+var (
+	ligatures    = strings.NewReplacer("œ", "oe", "æ", "ae", "ß", "ss")
+	accentFolder = transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC)
+)
+
+// Tokenize splits text into normalized terms: lowercase, without accents or
+// ligatures, cut at anything but letters and digits. Learners often type
+// "eleve" for "élève", so both must meet on the same term.
+func Tokenize(text string) []string {
+	folded, _, err := transform.String(accentFolder, ligatures.Replace(strings.ToLower(text)))
+	if err != nil {
+		folded = strings.ToLower(text)
+	}
+
+	words := strings.FieldsFunc(folded, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	terms := words[:0]
+	for _, w := range words {
+		if len([]rune(w)) >= MinTermLength {
+			terms = append(terms, w)
+		}
+	}
+	return terms
+}
+
+func publishedAt(p post.Post) time.Time {
+	if p.PublishedAt == nil {
+		return time.Time{}
+	}
+	return *p.PublishedAt
+}
@@ -0,0 +1,157 @@
+package search_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/category"
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/post"
+	"github.com/alnah/fla/internal/domain/search"
+	"github.com/alnah/fla/internal/domain/shared"
+)
+
+func newPost(t *testing.T, id, title, content string, status post.Status, publishedAt time.Time) post.Post {
+	t.Helper()
+
+	sports, err := category.NewCategory(category.NewCategoryParams{CategoryID: "sports", Name: "Sports", CreatedBy: "marie", Clock: &stubClock{fixtureNow}})
+	assertNoError(t, err)
+
+	p, err := post.NewPost(post.NewPostParams{
+		PostID:      kernel.ID[post.Post](id),
+		Owner:       "marie",
+		Title:       shared.Title(title),
+		Content:     post.PostContent(content + strings.Repeat(" Nous parlons en français.", 12)),
+		Status:      status,
+		Category:    sports,
+		PublishedAt: &publishedAt,
+		Clock:       &stubClock{publishedAt.Add(-time.Hour)},
+	})
+	assertNoError(t, err)
+	return p
+}
+
+func TestTokenize(t *testing.T) {
+	got := search.Tokenize("L'élève œuvre au **Café** — à 9h, c'est ça !")
+	want := []string{"eleve", "oeuvre", "au", "cafe", "9h", "est", "ca"}
+
+	if strings.Join(got, " ") != strings.Join(want, " ") {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestIndex_Search(t *testing.T) {
+	early := fixtureNow.AddDate(0, 0, -2)
+	late := fixtureNow.AddDate(0, 0, -1)
+
+	index := search.NewIndex(fixtureNow)
+	index.Add(newPost(t, "football", "Jouer au football", "Le football se joue en équipe.", post.StatusPublished, early), "sports/jouer-au-football")
+	index.Add(newPost(t, "tennis", "Regarder le tennis", "Le tennis et le football à la télévision.", post.StatusPublished, late), "sports/regarder-le-tennis")
+	index.Add(newPost(t, "equipe", "Les sports d'équipe", "Le rugby se joue en équipe.", post.StatusPublished, late), "sports/les-sports-d-equipe")
+
+	ids := func(results []search.Result) string {
+		var out []string
+		for _, r := range results {
+			out = append(out, r.PostID.String())
+		}
+		return strings.Join(out, ",")
+	}
+
+	tests := []struct {
+		name  string
+		query string
+		limit int
+		want  string
+	}{
+		{"title matches rank first", "football", 10, "football,tennis"},
+		{"every term must match", "football télévision", 10, "tennis"},
+		{"accents are ignored", "EQUIPE", 10, "equipe,football"},
+		{"limit cuts the tail", "football", 1, "football"},
+		{"unknown terms match nothing", "natation", 10, ""},
+		{"short words are not indexed", "a", 10, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ids(index.Search(tt.query, tt.limit)); got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("results carry what listings display", func(t *testing.T) {
+		results := index.Search("tennis", 1)
+
+		if results[0].Path != "sports/regarder-le-tennis" || results[0].Title != "Regarder le tennis" || results[0].Excerpt == "" {
+			t.Errorf("got %+v", results[0].Document)
+		}
+	})
+
+	t.Run("explicit excerpt replaces the generated one", func(t *testing.T) {
+		p := newPost(t, "natation", "Nager à la piscine", "La natation se pratique à la piscine.", post.StatusPublished, late)
+		p.Excerpt = "Le vocabulaire de la piscine."
+		index.Add(p, "sports/nager-a-la-piscine")
+
+		results := index.Search("natation", 1)
+
+		if len(results) != 1 || results[0].Excerpt != "Le vocabulaire de la piscine." {
+			t.Errorf("got %+v", results)
+		}
+	})
+}
+
+func TestIndexService_Rebuild(t *testing.T) {
+	published := fixtureNow.AddDate(0, 0, -1)
+	scheduled := fixtureNow.AddDate(0, 0, 1)
+	sports, err := category.NewCategory(category.NewCategoryParams{CategoryID: "sports", Name: "Sports", CreatedBy: "marie", Clock: &stubClock{fixtureNow}})
+	assertNoError(t, err)
+
+	posts := &stubPosts{posts: []post.Post{
+		newPost(t, "football", "Jouer au football", "Le football se joue en équipe.", post.StatusPublished, published),
+		newPost(t, "tennis", "Regarder le tennis", "Le tennis à la télévision.", post.StatusScheduled, scheduled),
+	}}
+
+	t.Run("indexes published posts only and saves the index", func(t *testing.T) {
+		store := &stubStore{}
+		service := search.NewIndexService(posts, stubCategories{sports}, store, &stubClock{fixtureNow})
+
+		index, err := service.Rebuild()
+
+		assertNoError(t, err)
+		if len(index.Documents) != 1 || index.Documents[0].Path != "sports/jouer-au-football" {
+			t.Fatalf("documents: got %+v", index.Documents)
+		}
+		if store.index == nil || !store.index.BuiltAt.Equal(fixtureNow) {
+			t.Error("expected the index saved")
+		}
+
+		results, err := service.Search("equipe", 10)
+		assertNoError(t, err)
+		if len(results) != 1 {
+			t.Errorf("search: got %v", results)
+		}
+	})
+
+	t.Run("keeps the previous index when posts cannot be read", func(t *testing.T) {
+		store := &stubStore{}
+		failing := &stubPosts{err: &kernel.Error{Code: kernel.EInternal, Message: "unavailable"}}
+
+		_, err := search.NewIndexService(failing, stubCategories{sports}, store, &stubClock{fixtureNow}).Rebuild()
+
+		assertErrorCode(t, err, kernel.EInternal)
+		if store.index != nil {
+			t.Error("expected nothing saved")
+		}
+	})
+}
+
+func TestIndexService_Search(t *testing.T) {
+	service := search.NewIndexService(&stubPosts{}, stubCategories{}, &stubStore{}, &stubClock{fixtureNow})
+
+	_, err := service.Search("football", shared.MaxPageLimit+1)
+	assertErrorCode(t, err, kernel.EInvalid)
+
+	_, err = service.Search("football", 10)
+	assertErrorCode(t, err, kernel.ENotFound)
+}
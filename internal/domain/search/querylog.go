@@ -0,0 +1,162 @@
+package search
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/shared"
+)
+
+const (
+	MaxQueryReportLimit int = 100
+
+	MQueryPeriodInvalid string = "Query report period must end after it starts."
+	MQueryLimitInvalid  string = "Query report limit must be between 1 and %d."
+)
+
+// QueryRecord is one search as learners typed it, normalized, with how many
+// posts it found. Records hold no user or session, only the query.
+type QueryRecord struct {
+	Query      string // NormalizeQuery of the typed text
+	Locale     shared.Locale
+	Results    int
+	SearchedAt time.Time
+}
+
+// QueryStat aggregates the searches of one normalized query in one locale.
+type QueryStat struct {
+	Query        string
+	Locale       shared.Locale
+	Searches     int
+	Results      int // Result count of the latest search
+	LastSearched time.Time
+}
+
+// QueryReport tells content planners what learners searched for over a period,
+// and what they searched for without finding anything.
+type QueryReport struct {
+	From, To    time.Time
+	Locale      shared.Locale // Empty for every locale
+	TopQueries  []QueryStat   // Most searched first
+	ZeroResults []QueryStat   // Queries whose latest search found nothing, most searched first
+}
+
+// NormalizeQuery reduces a query to the terms searches match on, sorted and
+// without repeats, so "Verbes ÊTRE" and "etre verbes" count as one query.
+// Returns "" when no term is long enough to search.
+func NormalizeQuery(query string) string {
+	return strings.Join(slices.Compact(slices.Sorted(slices.Values(Tokenize(query)))), " ")
+}
+
+// QueryLogService records searches and reports on them.
+type QueryLogService struct {
+	log   QueryLog
+	clock kernel.Clock
+}
+
+// NewQueryLogService creates query log service with query storage.
+func NewQueryLogService(log QueryLog, clock kernel.Clock) *QueryLogService {
+	return &QueryLogService{log: log, clock: clock}
+}
+
+// Record logs a search and its result count. Queries without searchable terms
+// are not logged; reports false for them.
+func (s *QueryLogService) Record(query string, locale shared.Locale, results int) (bool, error) {
+	const op = "QueryLogService.Record"
+
+	normalized := NormalizeQuery(query)
+	if normalized == "" {
+		return false, nil
+	}
+
+	if err := locale.Validate(); err != nil {
+		return false, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	record := QueryRecord{Query: normalized, Locale: locale, Results: max(results, 0), SearchedAt: s.clock.Now()}
+	if err := s.log.RecordQuery(record); err != nil {
+		return false, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return true, nil
+}
+
+// Report lists the limit most searched queries of the period, and the limit
+// most searched of those that found nothing. An empty locale covers every locale.
+func (s *QueryLogService) Report(from, to time.Time, locale shared.Locale, limit int) (QueryReport, error) {
+	const op = "QueryLogService.Report"
+
+	if !to.After(from) {
+		return QueryReport{}, &kernel.Error{Code: kernel.EInvalid, Message: MQueryPeriodInvalid, Operation: op}
+	}
+	if limit < 1 || limit > MaxQueryReportLimit {
+		return QueryReport{}, &kernel.Error{
+			Code:      kernel.EInvalid,
+			Message:   fmt.Sprintf(MQueryLimitInvalid, MaxQueryReportLimit),
+			Operation: op,
+		}
+	}
+	if locale != "" {
+		if err := locale.Validate(); err != nil {
+			return QueryReport{}, &kernel.Error{Operation: op, Cause: err}
+		}
+	}
+
+	top, err := s.log.TopQueries(from, to, locale, limit)
+	if err != nil {
+		return QueryReport{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	zero, err := s.log.ZeroResultQueries(from, to, locale, limit)
+	if err != nil {
+		return QueryReport{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return QueryReport{From: from, To: to, Locale: locale, TopQueries: top, ZeroResults: zero}, nil
+}
+
+// AggregateQueries turns records into stats ranked by searches, then by latest
+// search, for stores without aggregation of their own. With zeroOnly, only
+// queries whose latest search found nothing are kept.
+func AggregateQueries(records []QueryRecord, zeroOnly bool, limit int) []QueryStat {
+	type key struct {
+		query  string
+		locale shared.Locale
+	}
+
+	byQuery := make(map[key]*QueryStat)
+	for _, r := range records {
+		k := key{r.Query, r.Locale}
+		stat, ok := byQuery[k]
+		if !ok {
+			stat = &QueryStat{Query: r.Query, Locale: r.Locale}
+			byQuery[k] = stat
+		}
+		stat.Searches++
+		if !r.SearchedAt.Before(stat.LastSearched) {
+			stat.LastSearched = r.SearchedAt
+			stat.Results = r.Results
+		}
+	}
+
+	stats := make([]QueryStat, 0, len(byQuery))
+	for _, stat := range byQuery {
+		if !zeroOnly || stat.Results == 0 {
+			stats = append(stats, *stat)
+		}
+	}
+	slices.SortFunc(stats, func(a, b QueryStat) int {
+		if a.Searches != b.Searches {
+			return b.Searches - a.Searches
+		}
+		if c := b.LastSearched.Compare(a.LastSearched); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Query+string(a.Locale), b.Query+string(b.Locale))
+	})
+
+	return stats[:min(limit, len(stats))]
+}
@@ -0,0 +1,115 @@
+package search_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/search"
+	"github.com/alnah/fla/internal/domain/shared"
+)
+
+// stubQueryLog keeps records in memory and ranks them with AggregateQueries.
+type stubQueryLog struct {
+	records []search.QueryRecord
+}
+
+func (s *stubQueryLog) RecordQuery(r search.QueryRecord) error {
+	s.records = append(s.records, r)
+	return nil
+}
+
+func (s *stubQueryLog) in(from, to time.Time, locale shared.Locale) []search.QueryRecord {
+	var records []search.QueryRecord
+	for _, r := range s.records {
+		if !r.SearchedAt.Before(from) && r.SearchedAt.Before(to) && (locale == "" || r.Locale == locale) {
+			records = append(records, r)
+		}
+	}
+	return records
+}
+
+func (s *stubQueryLog) TopQueries(from, to time.Time, locale shared.Locale, limit int) ([]search.QueryStat, error) {
+	return search.AggregateQueries(s.in(from, to, locale), false, limit), nil
+}
+
+func (s *stubQueryLog) ZeroResultQueries(from, to time.Time, locale shared.Locale, limit int) ([]search.QueryStat, error) {
+	return search.AggregateQueries(s.in(from, to, locale), true, limit), nil
+}
+
+func TestNormalizeQuery(t *testing.T) {
+	tests := []struct {
+		query, want string
+	}{
+		{query: "Verbes ÊTRE", want: "etre verbes"},
+		{query: "etre  verbes verbes", want: "etre verbes"},
+		{query: "  à ", want: ""},
+	}
+
+	for _, tt := range tests {
+		if got := search.NormalizeQuery(tt.query); got != tt.want {
+			t.Errorf("NormalizeQuery(%q): got %q, want %q", tt.query, got, tt.want)
+		}
+	}
+}
+
+func TestQueryLogService(t *testing.T) {
+	start := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	clock := &stubClock{t: start}
+	log := &stubQueryLog{}
+	service := search.NewQueryLogService(log, clock)
+
+	searches := []struct {
+		query   string
+		locale  shared.Locale
+		results int
+	}{
+		{"subjonctif", shared.LocaleFrenchFR, 0},
+		{"Subjonctif", shared.LocaleFrenchFR, 0},
+		{"passé composé", shared.LocaleFrenchFR, 4},
+		{"subjonctif", shared.LocaleEnglishUS, 0},
+		{"passe compose", shared.LocaleFrenchFR, 4},
+		{"passe compose", shared.LocaleFrenchFR, 5},
+		{"imparfait", shared.LocaleFrenchFR, 0},
+		{"imparfait", shared.LocaleFrenchFR, 2}, // Found once a lesson was published
+		{"?", shared.LocaleFrenchFR, 0},
+	}
+	for _, s := range searches {
+		clock.t = clock.t.Add(time.Minute)
+		_, err := service.Record(s.query, s.locale, s.results)
+		assertNoError(t, err)
+	}
+
+	if len(log.records) != len(searches)-1 {
+		t.Fatalf("got %d records, want queries without terms skipped", len(log.records))
+	}
+
+	t.Run("ranks queries and zero-result queries per locale", func(t *testing.T) {
+		got, err := service.Report(start, start.Add(time.Hour), shared.LocaleFrenchFR, 10)
+
+		assertNoError(t, err)
+		if len(got.TopQueries) != 3 || got.TopQueries[0].Query != "compose passe" || got.TopQueries[0].Searches != 3 {
+			t.Errorf("got top queries %+v", got.TopQueries)
+		}
+		if len(got.ZeroResults) != 1 || got.ZeroResults[0].Query != "subjonctif" || got.ZeroResults[0].Searches != 2 {
+			t.Errorf("got zero-result queries %+v", got.ZeroResults)
+		}
+	})
+
+	t.Run("covers every locale", func(t *testing.T) {
+		got, err := service.Report(start, start.Add(time.Hour), "", 1)
+
+		assertNoError(t, err)
+		if len(got.ZeroResults) != 1 || got.ZeroResults[0].Locale != shared.LocaleFrenchFR {
+			t.Errorf("got %+v, want the French subjonctif first", got.ZeroResults)
+		}
+	})
+
+	t.Run("validates the period and limit", func(t *testing.T) {
+		_, err := service.Report(start, start, "", 10)
+		assertErrorCode(t, err, kernel.EInvalid)
+
+		_, err = service.Report(start, start.Add(time.Hour), "", search.MaxQueryReportLimit+1)
+		assertErrorCode(t, err, kernel.EInvalid)
+	})
+}
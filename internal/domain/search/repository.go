@@ -0,0 +1,39 @@
+package search
+
+import (
+	"time"
+
+	"github.com/alnah/fla/internal/domain/shared"
+)
+
+// IndexWriter stores a freshly built index, replacing the previous one whole.
+// Used by rebuilds so searches never see a half-built index.
+type IndexWriter interface {
+	SaveIndex(index Index) error
+}
+
+// IndexReader loads the current index for searches.
+type IndexReader interface {
+	// LoadIndex returns ENotFound when no index was built yet.
+	LoadIndex() (Index, error)
+}
+
+// IndexStore combines index persistence and retrieval.
+type IndexStore interface {
+	IndexReader
+	IndexWriter
+}
+
+// QueryLog stores searches and ranks them for content planning.
+// Periods include From and exclude To; an empty locale means every locale.
+type QueryLog interface {
+	// RecordQuery appends a search to the log.
+	RecordQuery(record QueryRecord) error
+
+	// TopQueries returns the most searched queries of the period.
+	TopQueries(from, to time.Time, locale shared.Locale, limit int) ([]QueryStat, error)
+
+	// ZeroResultQueries returns the most searched queries of the period whose
+	// latest search found nothing.
+	ZeroResultQueries(from, to time.Time, locale shared.Locale, limit int) ([]QueryStat, error)
+}
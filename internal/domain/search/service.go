@@ -0,0 +1,77 @@
+package search
+
+import (
+	"fmt"
+
+	"github.com/alnah/fla/internal/domain/category"
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/post"
+	"github.com/alnah/fla/internal/domain/shared"
+)
+
+const MSearchLimitInvalid string = "Search limit must be between %d and %d."
+
+// IndexService rebuilds the search index from published posts and answers searches.
+type IndexService struct {
+	posts      post.PostFinder
+	categories category.CategoryPathBuilder
+	store      IndexStore
+	clock      kernel.Clock
+}
+
+// NewIndexService creates index service with post and category lookups and index storage.
+func NewIndexService(posts post.PostFinder, categories category.CategoryPathBuilder, store IndexStore, clock kernel.Clock) *IndexService {
+	return &IndexService{posts: posts, categories: categories, store: store, clock: clock}
+}
+
+// Rebuild indexes every published post and replaces the stored index.
+// The previous index stays in place if anything fails.
+func (s *IndexService) Rebuild() (Index, error) {
+	const op = "IndexService.Rebuild"
+
+	index := NewIndex(s.clock.Now())
+	for page := 1; ; page++ {
+		list, err := s.posts.Find(post.PublishedQuery().Page(page, shared.MaxPageLimit))
+		if err != nil {
+			return Index{}, &kernel.Error{Operation: op, Cause: err}
+		}
+
+		for _, p := range list.Posts {
+			path, err := s.categories.BuildPath(p.Category.CategoryID)
+			if err != nil {
+				return Index{}, &kernel.Error{Operation: op, Cause: err}
+			}
+			index.Add(p, p.URLPath(path))
+		}
+
+		if !list.Pagination.HasNextPage() {
+			break
+		}
+	}
+
+	if err := s.store.SaveIndex(index); err != nil {
+		return Index{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return index, nil
+}
+
+// Search returns up to limit posts matching every term of the query, best first.
+func (s *IndexService) Search(query string, limit int) ([]Result, error) {
+	const op = "IndexService.Search"
+
+	if limit < shared.MinPageLimit || limit > shared.MaxPageLimit {
+		return nil, &kernel.Error{
+			Code:      kernel.EInvalid,
+			Message:   fmt.Sprintf(MSearchLimitInvalid, shared.MinPageLimit, shared.MaxPageLimit),
+			Operation: op,
+		}
+	}
+
+	index, err := s.store.LoadIndex()
+	if err != nil {
+		return nil, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return index.Search(query, limit), nil
+}
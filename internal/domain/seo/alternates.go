@@ -0,0 +1,172 @@
+package seo
+
+import (
+	"fmt"
+	"html"
+	"slices"
+	"strings"
+
+	"github.com/alnah/fla/internal/domain/category"
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/post"
+	"github.com/alnah/fla/internal/domain/shared"
+)
+
+// HrefLangDefault marks the alternate shown to visitors matching no listed locale.
+const HrefLangDefault = "x-default"
+
+const (
+	MTranslationLocaleDuplicate string = "Locale %s has more than one translation."
+	MTranslationNotFound        string = "No translation found for locale %s."
+)
+
+// Translation is one locale variant of a post.
+type Translation struct {
+	Locale shared.Locale
+	Post   post.Post
+}
+
+// TranslationReader finds every locale variant of a post.
+// Implemented by the translation store; declared here to keep seo free of storage concerns.
+type TranslationReader interface {
+	// GetTranslations returns all variants in the post's translation group, the post included.
+	// Returns only the post itself when it has not been translated.
+	GetTranslations(postID kernel.ID[post.Post]) ([]Translation, error)
+}
+
+// Alternate is one hreflang link.
+type Alternate struct {
+	HrefLang string // BCP 47 tag such as "fr-FR", or HrefLangDefault
+	URL      string
+}
+
+// LocaleLinks holds the canonical and alternate links for one locale variant.
+type LocaleLinks struct {
+	Canonical  string
+	Alternates []Alternate
+}
+
+// HTML renders the links as a head fragment with every value escaped.
+func (l LocaleLinks) HTML() string {
+	var b strings.Builder
+
+	if l.Canonical != "" {
+		fmt.Fprintf(&b, "<link rel=\"canonical\" href=\"%s\">\n", html.EscapeString(l.Canonical))
+	}
+	for _, a := range l.Alternates {
+		fmt.Fprintf(&b, "<link rel=\"alternate\" hreflang=\"%s\" href=\"%s\">\n",
+			html.EscapeString(a.HrefLang), html.EscapeString(a.URL))
+	}
+
+	return b.String()
+}
+
+// AlternateService generates canonical and hreflang links across locale variants.
+// Keeps multilingual SEO consistent instead of rebuilding URLs in each template.
+type AlternateService struct {
+	categories   category.CategoryPathBuilder
+	translations TranslationReader
+	site         shared.Site
+}
+
+// NewAlternateService creates alternate link service with category and translation lookups.
+func NewAlternateService(
+	categories category.CategoryPathBuilder,
+	translations TranslationReader,
+	site shared.Site,
+) *AlternateService {
+	return &AlternateService{
+		categories:   categories,
+		translations: translations,
+		site:         site,
+	}
+}
+
+// LinksFor returns the canonical link of the variant and hreflang alternates for every published variant.
+// The site's primary locale, or the first variant without it, is also listed as x-default.
+func (s *AlternateService) LinksFor(postID kernel.ID[post.Post], locale shared.Locale) (LocaleLinks, error) {
+	const op = "AlternateService.LinksFor"
+
+	translations, err := s.translations.GetTranslations(postID)
+	if err != nil {
+		return LocaleLinks{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	var links LocaleLinks
+	var fallback string
+	seen := make(map[shared.Locale]bool, len(translations))
+
+	for _, t := range translations {
+		if seen[t.Locale] {
+			return LocaleLinks{}, &kernel.Error{
+				Code:      kernel.EConflict,
+				Message:   fmt.Sprintf(MTranslationLocaleDuplicate, t.Locale),
+				Operation: op,
+			}
+		}
+		seen[t.Locale] = true
+
+		url, err := s.LocalizedURL(t.Post, t.Locale)
+		if err != nil {
+			return LocaleLinks{}, &kernel.Error{Operation: op, Cause: err}
+		}
+
+		if t.Locale == locale {
+			links.Canonical = url
+		}
+
+		if !t.Post.IsPublished() {
+			continue
+		}
+
+		links.Alternates = append(links.Alternates, Alternate{HrefLang: t.Locale.String(), URL: url})
+		if fallback == "" || t.Locale == s.site.Locale {
+			fallback = url
+		}
+	}
+
+	if links.Canonical == "" {
+		return LocaleLinks{}, &kernel.Error{
+			Code:      kernel.ENotFound,
+			Message:   fmt.Sprintf(MTranslationNotFound, locale),
+			Operation: op,
+		}
+	}
+
+	// A single variant has nothing to alternate with.
+	if len(links.Alternates) < 2 {
+		links.Alternates = nil
+		return links, nil
+	}
+
+	slices.SortFunc(links.Alternates, func(a, b Alternate) int {
+		return strings.Compare(a.HrefLang, b.HrefLang)
+	})
+	links.Alternates = append(links.Alternates, Alternate{HrefLang: HrefLangDefault, URL: fallback})
+
+	return links, nil
+}
+
+// LocalizedURL builds the absolute URL of a post variant.
+// The primary locale lives at the root; other locales get a lowercase prefix such as "/pt-br/".
+// An explicit canonical URL on the post always wins.
+func (s *AlternateService) LocalizedURL(p post.Post, locale shared.Locale) (string, error) {
+	if p.CanonicalURL != "" {
+		return p.CanonicalURL.String(), nil
+	}
+
+	path, err := s.categories.BuildPath(p.Category.CategoryID)
+	if err != nil {
+		return "", err
+	}
+
+	return s.site.URL(LocalePrefix(s.site, locale) + p.URLPath(path)), nil
+}
+
+// LocalePrefix returns the path prefix of a locale, empty for the site's primary locale.
+func LocalePrefix(site shared.Site, locale shared.Locale) string {
+	if locale == "" || locale == site.Locale {
+		return ""
+	}
+	return strings.ToLower(locale.String()) + "/"
+}
@@ -0,0 +1,132 @@
+package seo_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/post"
+	"github.com/alnah/fla/internal/domain/seo"
+	"github.com/alnah/fla/internal/domain/shared"
+)
+
+type stubTranslations struct {
+	groups map[kernel.ID[post.Post]][]seo.Translation
+}
+
+func (s *stubTranslations) GetTranslations(id kernel.ID[post.Post]) ([]seo.Translation, error) {
+	group, ok := s.groups[id]
+	if !ok {
+		return nil, &kernel.Error{Code: kernel.ENotFound, Message: "post not found"}
+	}
+	return group, nil
+}
+
+func variant(t *testing.T, id kernel.ID[post.Post], slug shared.Slug, status post.Status) post.Post {
+	t.Helper()
+	p := testPost(t)
+	p.PostID = id
+	p.Slug = slug
+	p.Status = status
+	return p
+}
+
+func TestAlternateService_LinksFor(t *testing.T) {
+	fr := variant(t, "post-fr", "lire-un-menu", post.StatusPublished)
+	en := variant(t, "post-en", "reading-a-menu", post.StatusPublished)
+	pt := variant(t, "post-pt", "ler-um-cardapio", post.StatusDraft)
+	solo := variant(t, "post-solo", "solo", post.StatusPublished)
+
+	group := []seo.Translation{
+		{Locale: shared.LocaleEnglishUS, Post: en},
+		{Locale: shared.LocaleFrenchFR, Post: fr},
+		{Locale: shared.LocalePortugueseBR, Post: pt},
+	}
+	translations := &stubTranslations{groups: map[kernel.ID[post.Post]][]seo.Translation{
+		"post-fr":   group,
+		"post-en":   group,
+		"post-pt":   group,
+		"post-solo": {{Locale: shared.LocaleFrenchFR, Post: solo}},
+		"post-dup":  {{Locale: shared.LocaleFrenchFR, Post: fr}, {Locale: shared.LocaleFrenchFR, Post: solo}},
+	}}
+	service := seo.NewAlternateService(testPaths(), translations, testSite(t))
+
+	t.Run("lists published variants with x-default on the primary locale", func(t *testing.T) {
+		got, err := service.LinksFor("post-en", shared.LocaleEnglishUS)
+
+		assertNoError(t, err)
+		if got.Canonical != "https://fla.example/en-us/a1/lecture/reading-a-menu" {
+			t.Errorf("got canonical %q", got.Canonical)
+		}
+		want := []seo.Alternate{
+			{HrefLang: "en-US", URL: "https://fla.example/en-us/a1/lecture/reading-a-menu"},
+			{HrefLang: "fr-FR", URL: "https://fla.example/a1/lecture/lire-un-menu"},
+			{HrefLang: seo.HrefLangDefault, URL: "https://fla.example/a1/lecture/lire-un-menu"},
+		}
+		if len(got.Alternates) != len(want) {
+			t.Fatalf("got %v, want %v", got.Alternates, want)
+		}
+		for i := range want {
+			if got.Alternates[i] != want[i] {
+				t.Errorf("alternate %d: got %v, want %v", i, got.Alternates[i], want[i])
+			}
+		}
+	})
+
+	t.Run("gives unpublished variants a canonical but no alternate", func(t *testing.T) {
+		got, err := service.LinksFor("post-pt", shared.LocalePortugueseBR)
+
+		assertNoError(t, err)
+		if got.Canonical != "https://fla.example/pt-br/a1/lecture/ler-um-cardapio" {
+			t.Errorf("got canonical %q", got.Canonical)
+		}
+		for _, a := range got.Alternates {
+			if a.HrefLang == "pt-BR" {
+				t.Error("draft variant must not be advertised")
+			}
+		}
+	})
+
+	t.Run("omits alternates for untranslated posts", func(t *testing.T) {
+		got, err := service.LinksFor("post-solo", shared.LocaleFrenchFR)
+
+		assertNoError(t, err)
+		if got.Alternates != nil {
+			t.Errorf("got alternates %v", got.Alternates)
+		}
+	})
+
+	t.Run("rejects locale without variant", func(t *testing.T) {
+		_, err := service.LinksFor("post-solo", shared.LocaleEnglishUS)
+
+		assertErrorCode(t, err, kernel.ENotFound)
+	})
+
+	t.Run("rejects duplicate locales", func(t *testing.T) {
+		_, err := service.LinksFor("post-dup", shared.LocaleFrenchFR)
+
+		assertErrorCode(t, err, kernel.EConflict)
+	})
+}
+
+func TestLocaleLinks_HTML(t *testing.T) {
+	links := seo.LocaleLinks{
+		Canonical: "https://fla.example/a1/menu",
+		Alternates: []seo.Alternate{
+			{HrefLang: "fr-FR", URL: "https://fla.example/a1/menu"},
+			{HrefLang: seo.HrefLangDefault, URL: "https://fla.example/a1/menu?a=1&b=2"},
+		},
+	}
+
+	got := links.HTML()
+
+	for _, want := range []string{
+		`<link rel="canonical" href="https://fla.example/a1/menu">`,
+		`<link rel="alternate" hreflang="fr-FR" href="https://fla.example/a1/menu">`,
+		`<link rel="alternate" hreflang="x-default" href="https://fla.example/a1/menu?a=1&amp;b=2">`,
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("missing %q in:\n%s", want, got)
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package seo_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/category"
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/post"
+	"github.com/alnah/fla/internal/domain/shared"
+)
+
+type stubClock struct {
+	t time.Time
+}
+
+func (s *stubClock) Now() time.Time { return s.t }
+
+type stubPaths struct {
+	paths map[kernel.ID[category.Category]]category.CategoryPath
+}
+
+func (s *stubPaths) BuildPath(id kernel.ID[category.Category]) (category.CategoryPath, error) {
+	path, ok := s.paths[id]
+	if !ok {
+		return nil, &kernel.Error{Code: kernel.ENotFound, Message: "category not found"}
+	}
+	return path, nil
+}
+
+func (s *stubPaths) FindByPath([]string) (*category.Category, error) {
+	return nil, &kernel.Error{Code: kernel.ENotFound, Message: "category not found"}
+}
+
+func testPaths() *stubPaths {
+	a1ID := kernel.ID[category.Category]("a1")
+	a1 := category.Category{CategoryID: a1ID, Name: "A1", Slug: "a1"}
+	reading := category.Category{CategoryID: "reading", Name: "Lecture", Slug: "lecture", ParentID: &a1ID}
+
+	return &stubPaths{paths: map[kernel.ID[category.Category]]category.CategoryPath{
+		"a1":      {a1},
+		"reading": {a1, reading},
+	}}
+}
+
+func testSite(t *testing.T) shared.Site {
+	t.Helper()
+	site, err := shared.NewSite("FLA", "https://fla.example", shared.LocaleFrenchFR)
+	assertNoError(t, err)
+	return site
+}
+
+func testPost(t *testing.T) post.Post {
+	t.Helper()
+	clock := &stubClock{t: time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)}
+	title, _ := shared.NewTitle("Lire un menu")
+	content, _ := post.NewPostContent(strings.Repeat("Le menu du jour propose une soupe. ", 10))
+
+	p, err := post.NewPost(post.NewPostParams{
+		PostID:   "post-123",
+		Owner:    "user-123",
+		Title:    title,
+		Content:  content,
+		Status:   post.StatusDraft,
+		Category: category.Category{CategoryID: "reading", Name: "Lecture", Slug: "lecture", CreatedBy: "user-123"},
+		Clock:    clock,
+	})
+	assertNoError(t, err)
+	return p
+}
+
+func assertNoError(t *testing.T, err error) {
+	t.Helper()
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+}
+
+func assertError(t *testing.T, err error) {
+	t.Helper()
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+}
+
+func assertErrorCode(t *testing.T, err error, want string) {
+	t.Helper()
+	got := kernel.ErrorCode(err)
+	if got != want {
+		t.Errorf("error code: got %q, want %q", got, want)
+	}
+}
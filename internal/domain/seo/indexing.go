@@ -0,0 +1,261 @@
+package seo
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/category"
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/post"
+	"github.com/alnah/fla/internal/domain/shared"
+)
+
+const (
+	// MaxPingAttempts bounds how often a ping is tried before it is given up.
+	MaxPingAttempts int = 4
+
+	// PingRetryDelay is the wait before the first retry; it doubles after each failure.
+	PingRetryDelay time.Duration = 5 * time.Minute
+
+	// SitemapPath is where the site serves its sitemap index.
+	SitemapPath string = "/sitemap.xml"
+)
+
+const (
+	MPingPostUnpublished string = "Only published posts are submitted to search engines."
+	MPingStatusInvalid   string = "Invalid ping status: %q."
+	MPingEngineUnknown   string = "No notifier for search engine %q."
+)
+
+// Engine names a search engine endpoint, e.g. "bing" or "indexnow".
+type Engine string
+
+// String returns the engine name.
+func (e Engine) String() string { return string(e) }
+
+// Ping tells a search engine that the site changed. Sitemap ping endpoints
+// read SitemapURL; IndexNow submits URLs.
+type Ping struct {
+	SitemapURL string
+	URLs       []string
+}
+
+// Notifier submits pings to one search engine.
+// Implemented by adapters calling the engine's HTTP endpoint; failures worth
+// retrying should be reported as retryable (see kernel.IsRetryable).
+type Notifier interface {
+	Notify(ping Ping) error
+}
+
+// PingStatus tracks a ping through its attempts.
+type PingStatus string
+
+const (
+	PingPending   PingStatus = "pending"   // Waiting for its next attempt
+	PingDelivered PingStatus = "delivered" // Accepted by the engine
+	PingFailed    PingStatus = "failed"    // Given up: permanent error or too many attempts
+)
+
+// String returns the status name.
+func (s PingStatus) String() string { return string(s) }
+
+// Validate ensures the status is known.
+func (s PingStatus) Validate() error {
+	const op = "PingStatus.Validate"
+
+	switch s {
+	case PingPending, PingDelivered, PingFailed:
+		return nil
+	default:
+		return &kernel.Error{
+			Code:      kernel.EInvalid,
+			Message:   fmt.Sprintf(MPingStatusInvalid, s),
+			Operation: op,
+		}
+	}
+}
+
+// PingRecord logs the notification of one engine about one post.
+// There is a single record per post and engine; publishing again replaces it.
+type PingRecord struct {
+	PostID        kernel.ID[post.Post]
+	Engine        Engine
+	Ping          Ping
+	Status        PingStatus
+	Attempts      int
+	LastError     string    // Cause of the latest failure, for the admin log
+	NextAttemptAt time.Time // Set while pending
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// IsDue reports whether a pending ping should be tried again at now.
+func (r PingRecord) IsDue(now time.Time) bool {
+	return r.Status == PingPending && !now.Before(r.NextAttemptAt)
+}
+
+// String returns a string representation of the ping record.
+func (r PingRecord) String() string {
+	return fmt.Sprintf("PingRecord{PostID: %s, Engine: %s, Status: %s, Attempts: %d}", r.PostID, r.Engine, r.Status, r.Attempts)
+}
+
+// PingLog persists ping records.
+// Most concrete implementations (like PostgresPingLog) will implement this.
+type PingLog interface {
+	// SavePing stores the record, replacing any previous one for the post and engine.
+	SavePing(record PingRecord) error
+
+	// GetPings lists the records of a post, for the admin log.
+	GetPings(postID kernel.ID[post.Post]) ([]PingRecord, error)
+
+	// GetDuePings lists pending records whose next attempt is at or before now.
+	GetDuePings(now time.Time) ([]PingRecord, error)
+}
+
+// PublicationHandler is the hook the publishing workflow calls once a post is live.
+// Implemented by IndexingService.
+type PublicationHandler interface {
+	HandlePublished(p post.Post) ([]PingRecord, error)
+}
+
+// IndexingService notifies search engines of published posts. Engine failures
+// are logged on the ping record, not returned, so a slow engine never fails a
+// publication; transient ones are retried with backoff by RetryDue.
+type IndexingService struct {
+	log        PingLog
+	notifiers  map[Engine]Notifier
+	categories category.CategoryPathBuilder
+	site       shared.Site
+	clock      kernel.Clock
+}
+
+// NewIndexingService creates indexing service with a notifier per engine,
+// the ping log, and what it needs to build public URLs.
+func NewIndexingService(log PingLog, notifiers map[Engine]Notifier, categories category.CategoryPathBuilder, site shared.Site, clock kernel.Clock) (*IndexingService, error) {
+	const op = "NewIndexingService"
+
+	if err := site.Validate(); err != nil {
+		return nil, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return &IndexingService{
+		log:        log,
+		notifiers:  notifiers,
+		categories: categories,
+		site:       site,
+		clock:      clock,
+	}, nil
+}
+
+// HandlePublished pings every engine about a newly published post and returns
+// the resulting records, in engine order.
+func (s *IndexingService) HandlePublished(p post.Post) ([]PingRecord, error) {
+	const op = "IndexingService.HandlePublished"
+
+	if !p.IsPublished() {
+		return nil, &kernel.Error{Code: kernel.EInvalid, Message: MPingPostUnpublished, Operation: op}
+	}
+
+	path, err := s.categories.BuildPath(p.Category.CategoryID)
+	if err != nil {
+		return nil, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	ping := Ping{
+		SitemapURL: s.site.URL(SitemapPath),
+		URLs:       []string{s.site.URL(p.URLPath(path))},
+	}
+
+	now := s.clock.Now()
+	records := make([]PingRecord, 0, len(s.notifiers))
+	for _, engine := range slices.Sorted(maps.Keys(s.notifiers)) {
+		record, err := s.attempt(PingRecord{
+			PostID:    p.PostID,
+			Engine:    engine,
+			Ping:      ping,
+			Status:    PingPending,
+			CreatedAt: now,
+		})
+		if err != nil {
+			return nil, &kernel.Error{Operation: op, Cause: err}
+		}
+		records = append(records, record)
+	}
+
+	return records, nil
+}
+
+// RetryDue tries again every pending ping whose backoff has elapsed and returns
+// how many were delivered. Meant to run periodically, like the scheduler.
+func (s *IndexingService) RetryDue() (int, error) {
+	const op = "IndexingService.RetryDue"
+
+	due, err := s.log.GetDuePings(s.clock.Now())
+	if err != nil {
+		return 0, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	delivered := 0
+	for _, record := range due {
+		record, err := s.attempt(record)
+		if err != nil {
+			return delivered, &kernel.Error{Operation: op, Cause: err}
+		}
+		if record.Status == PingDelivered {
+			delivered++
+		}
+	}
+
+	return delivered, nil
+}
+
+// Pings returns the ping log of a post.
+func (s *IndexingService) Pings(postID kernel.ID[post.Post]) ([]PingRecord, error) {
+	const op = "IndexingService.Pings"
+
+	records, err := s.log.GetPings(postID)
+	if err != nil {
+		return nil, &kernel.Error{Operation: op, Cause: err}
+	}
+	return records, nil
+}
+
+// attempt notifies the record's engine once and saves the outcome. Engines
+// without notifier, e.g. removed from configuration, fail the ping.
+func (s *IndexingService) attempt(record PingRecord) (PingRecord, error) {
+	const op = "IndexingService.attempt"
+
+	now := s.clock.Now()
+	record.Attempts++
+	record.UpdatedAt = now
+
+	notifier, ok := s.notifiers[record.Engine]
+	var err error
+	if ok {
+		err = notifier.Notify(record.Ping)
+	} else {
+		err = &kernel.Error{Code: kernel.ENotFound, Message: fmt.Sprintf(MPingEngineUnknown, record.Engine), Operation: op}
+	}
+
+	switch {
+	case err == nil:
+		record.Status = PingDelivered
+		record.LastError = ""
+		record.NextAttemptAt = time.Time{}
+	case kernel.IsRetryable(err) && record.Attempts < MaxPingAttempts:
+		record.Status = PingPending
+		record.LastError = err.Error()
+		record.NextAttemptAt = now.Add(PingRetryDelay << (record.Attempts - 1))
+	default:
+		record.Status = PingFailed
+		record.LastError = err.Error()
+		record.NextAttemptAt = time.Time{}
+	}
+
+	if err := s.log.SavePing(record); err != nil {
+		return PingRecord{}, &kernel.Error{Operation: op, Cause: err}
+	}
+	return record, nil
+}
@@ -0,0 +1,179 @@
+package seo_test
+
+import (
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/post"
+	"github.com/alnah/fla/internal/domain/seo"
+)
+
+type stubPingLog struct {
+	records []seo.PingRecord
+}
+
+func (s *stubPingLog) SavePing(r seo.PingRecord) error {
+	for i, existing := range s.records {
+		if existing.PostID == r.PostID && existing.Engine == r.Engine {
+			s.records[i] = r
+			return nil
+		}
+	}
+	s.records = append(s.records, r)
+	return nil
+}
+
+func (s *stubPingLog) GetPings(postID kernel.ID[post.Post]) ([]seo.PingRecord, error) {
+	var out []seo.PingRecord
+	for _, r := range s.records {
+		if r.PostID == postID {
+			out = append(out, r)
+		}
+	}
+	return out, nil
+}
+
+func (s *stubPingLog) GetDuePings(now time.Time) ([]seo.PingRecord, error) {
+	var out []seo.PingRecord
+	for _, r := range s.records {
+		if r.IsDue(now) {
+			out = append(out, r)
+		}
+	}
+	return out, nil
+}
+
+// recordingNotifier fails with the queued errors, then accepts pings.
+type recordingNotifier struct {
+	pings []seo.Ping
+	errs  []error
+}
+
+func (r *recordingNotifier) Notify(ping seo.Ping) error {
+	if len(r.errs) > 0 {
+		err := r.errs[0]
+		r.errs = r.errs[1:]
+		return err
+	}
+	r.pings = append(r.pings, ping)
+	return nil
+}
+
+var errEngineBusy = &kernel.Error{Code: kernel.EInternal, Message: "503 from engine", Retryable: true}
+
+func TestIndexingService_HandlePublished(t *testing.T) {
+	start := time.Date(2024, 3, 2, 9, 0, 0, 0, time.UTC)
+
+	setup := func(t *testing.T) (*seo.IndexingService, *stubPingLog, *stubClock, *recordingNotifier, *recordingNotifier) {
+		t.Helper()
+		clock := &stubClock{t: start}
+		log := &stubPingLog{}
+		bing := &recordingNotifier{}
+		indexNow := &recordingNotifier{}
+		service, err := seo.NewIndexingService(log, map[seo.Engine]seo.Notifier{
+			"indexnow": indexNow,
+			"bing":     bing,
+		}, testPaths(), testSite(t), clock)
+		assertNoError(t, err)
+		return service, log, clock, bing, indexNow
+	}
+
+	published := func(t *testing.T) post.Post {
+		p := testPost(t)
+		p.Status = post.StatusPublished
+		return p
+	}
+
+	t.Run("pings every engine with the sitemap and post URL", func(t *testing.T) {
+		service, _, _, bing, indexNow := setup(t)
+
+		got, err := service.HandlePublished(published(t))
+
+		assertNoError(t, err)
+		if len(got) != 2 || got[0].Engine != "bing" || got[1].Engine != "indexnow" {
+			t.Fatalf("got records %v", got)
+		}
+		for _, r := range got {
+			if r.Status != seo.PingDelivered || r.Attempts != 1 {
+				t.Errorf("got %v, want delivered at first attempt", r)
+			}
+		}
+		want := seo.Ping{
+			SitemapURL: "https://fla.example/sitemap.xml",
+			URLs:       []string{"https://fla.example/a1/lecture/lire-un-menu"},
+		}
+		if len(bing.pings) != 1 || bing.pings[0].SitemapURL != want.SitemapURL || !slices.Equal(indexNow.pings[0].URLs, want.URLs) {
+			t.Errorf("got pings %+v and %+v, want %+v", bing.pings, indexNow.pings, want)
+		}
+	})
+
+	t.Run("retries transient failures with backoff", func(t *testing.T) {
+		service, log, clock, bing, _ := setup(t)
+		bing.errs = []error{errEngineBusy, errEngineBusy}
+
+		got, err := service.HandlePublished(published(t))
+
+		assertNoError(t, err)
+		if got[0].Status != seo.PingPending || !got[0].NextAttemptAt.Equal(start.Add(seo.PingRetryDelay)) {
+			t.Fatalf("got %+v, want pending retry", got[0])
+		}
+
+		clock.t = start.Add(seo.PingRetryDelay)
+		delivered, err := service.RetryDue()
+		assertNoError(t, err)
+		if delivered != 0 || !log.records[0].NextAttemptAt.Equal(clock.t.Add(2*seo.PingRetryDelay)) {
+			t.Fatalf("got %d delivered, record %+v, want a doubled delay", delivered, log.records[0])
+		}
+
+		delivered, err = service.RetryDue()
+		assertNoError(t, err)
+		if delivered != 0 {
+			t.Errorf("got %d delivered before the backoff elapsed", delivered)
+		}
+
+		clock.t = clock.t.Add(2 * seo.PingRetryDelay)
+		delivered, err = service.RetryDue()
+		assertNoError(t, err)
+		if delivered != 1 || log.records[0].Status != seo.PingDelivered || log.records[0].Attempts != 3 {
+			t.Errorf("got %d delivered, record %+v", delivered, log.records[0])
+		}
+	})
+
+	t.Run("gives up on permanent failures and after max attempts", func(t *testing.T) {
+		service, log, clock, bing, indexNow := setup(t)
+		bing.errs = []error{errors.New("403 invalid key")}
+		for range seo.MaxPingAttempts {
+			indexNow.errs = append(indexNow.errs, errEngineBusy)
+		}
+
+		_, err := service.HandlePublished(published(t))
+		assertNoError(t, err)
+		for range seo.MaxPingAttempts {
+			clock.t = clock.t.Add(24 * time.Hour)
+			_, err := service.RetryDue()
+			assertNoError(t, err)
+		}
+
+		got, err := service.Pings("post-123")
+		assertNoError(t, err)
+		for _, r := range got {
+			if r.Status != seo.PingFailed || r.LastError == "" {
+				t.Errorf("got %+v, want failed with its cause", r)
+			}
+		}
+		if log.records[1].Attempts != seo.MaxPingAttempts {
+			t.Errorf("got %d attempts, want %d", log.records[1].Attempts, seo.MaxPingAttempts)
+		}
+	})
+
+	t.Run("rejects unpublished posts", func(t *testing.T) {
+		service, _, _, _, _ := setup(t)
+
+		_, err := service.HandlePublished(testPost(t))
+
+		assertErrorCode(t, err, kernel.EInvalid)
+	})
+}
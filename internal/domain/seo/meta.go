@@ -0,0 +1,86 @@
+// Package seo resolves search engine and social sharing metadata for public pages.
+// Fallback chains live here so templates only print already resolved values.
+package seo
+
+import (
+	"fmt"
+	"html"
+	"strings"
+)
+
+// Card and object types understood by social platforms.
+const (
+	TwitterCardSummary    = "summary"
+	TwitterCardLargeImage = "summary_large_image"
+
+	OpenGraphTypeArticle = "article"
+	OpenGraphTypeWebsite = "website"
+)
+
+// MetaTags is the fully resolved head metadata for one page.
+// Every field is final: empty means the tag is omitted.
+type MetaTags struct {
+	// Search engines
+	Title        string
+	Description  string
+	CanonicalURL string
+
+	// Open Graph (https://ogp.me)
+	OpenGraphType        string
+	OpenGraphTitle       string
+	OpenGraphDescription string
+	OpenGraphImage       string
+	OpenGraphURL         string
+	OpenGraphSiteName    string
+	OpenGraphLocale      string // Underscore form required by Open Graph, e.g. "fr_FR"
+
+	// Twitter Cards
+	TwitterCard        string
+	TwitterSite        string
+	TwitterTitle       string
+	TwitterDescription string
+	TwitterImage       string
+
+	// Article
+	PublishedTime string // RFC 3339
+	ModifiedTime  string // RFC 3339
+}
+
+// HTML renders the tags as a head fragment with every value escaped.
+func (m MetaTags) HTML() string {
+	var b strings.Builder
+
+	if m.Title != "" {
+		fmt.Fprintf(&b, "<title>%s</title>\n", html.EscapeString(m.Title))
+	}
+	writeMeta(&b, "name", "description", m.Description)
+	if m.CanonicalURL != "" {
+		fmt.Fprintf(&b, "<link rel=\"canonical\" href=\"%s\">\n", html.EscapeString(m.CanonicalURL))
+	}
+
+	writeMeta(&b, "property", "og:type", m.OpenGraphType)
+	writeMeta(&b, "property", "og:title", m.OpenGraphTitle)
+	writeMeta(&b, "property", "og:description", m.OpenGraphDescription)
+	writeMeta(&b, "property", "og:image", m.OpenGraphImage)
+	writeMeta(&b, "property", "og:url", m.OpenGraphURL)
+	writeMeta(&b, "property", "og:site_name", m.OpenGraphSiteName)
+	writeMeta(&b, "property", "og:locale", m.OpenGraphLocale)
+	writeMeta(&b, "property", "article:published_time", m.PublishedTime)
+	writeMeta(&b, "property", "article:modified_time", m.ModifiedTime)
+
+	writeMeta(&b, "name", "twitter:card", m.TwitterCard)
+	writeMeta(&b, "name", "twitter:site", m.TwitterSite)
+	writeMeta(&b, "name", "twitter:title", m.TwitterTitle)
+	writeMeta(&b, "name", "twitter:description", m.TwitterDescription)
+	writeMeta(&b, "name", "twitter:image", m.TwitterImage)
+
+	return b.String()
+}
+
+// writeMeta writes one meta element, skipping empty values.
+func writeMeta(b *strings.Builder, attribute, key, value string) {
+	if value == "" {
+		return
+	}
+	fmt.Fprintf(b, "<meta %s=\"%s\" content=\"%s\">\n", attribute, key, html.EscapeString(value))
+}
@@ -0,0 +1,117 @@
+package seo
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/alnah/fla/internal/domain/category"
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/post"
+	"github.com/alnah/fla/internal/domain/shared"
+)
+
+const (
+	MRobotsPathInvalid  string = "Robots path %q must start with /."
+	MRobotsAgentInvalid string = "Invalid crawler user agent %q."
+)
+
+// MetaRobots is the robots meta tag of a page.
+type MetaRobots struct {
+	NoIndex  bool // Keep the page out of search results
+	NoFollow bool // Do not follow or credit its links
+}
+
+// String renders the tag content, e.g. "noindex, follow".
+func (m MetaRobots) String() string {
+	index, follow := "index", "follow"
+	if m.NoIndex {
+		index = "noindex"
+	}
+	if m.NoFollow {
+		follow = "nofollow"
+	}
+	return index + ", " + follow
+}
+
+// RobotsPolicy decides what crawlers may visit and index. Built from the
+// configuration and shared by the HTTP layer and the static export, so both
+// serve the same robots.txt and meta tags.
+type RobotsPolicy struct {
+	Site               shared.Site
+	DisallowAll        bool     // Closes the whole site, e.g. on staging
+	Disallow           []string // Paths closed to every crawler, e.g. "/admin"
+	BlockedAgents      []string // Crawlers refused the whole site, e.g. "GPTBot"
+	NoFollowCategories []string // Category paths whose posts get nofollow, e.g. "a1/lecture"
+}
+
+// Validate ensures the policy renders a well-formed robots.txt.
+func (r RobotsPolicy) Validate() error {
+	const op = "RobotsPolicy.Validate"
+
+	if err := r.Site.Validate(); err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	for _, path := range r.Disallow {
+		if !strings.HasPrefix(path, "/") || strings.ContainsAny(path, " \t\n") {
+			return &kernel.Error{Code: kernel.EInvalid, Message: fmt.Sprintf(MRobotsPathInvalid, path), Operation: op}
+		}
+	}
+
+	for _, agent := range r.BlockedAgents {
+		if agent == "" || agent == "*" || strings.ContainsAny(agent, " \t\n:") {
+			return &kernel.Error{Code: kernel.EInvalid, Message: fmt.Sprintf(MRobotsAgentInvalid, agent), Operation: op}
+		}
+	}
+
+	return nil
+}
+
+// RobotsTxt renders robots.txt: blocked crawlers first, then the rules for
+// every other crawler, then the sitemap location.
+func (r RobotsPolicy) RobotsTxt() string {
+	var b strings.Builder
+
+	for _, agent := range r.BlockedAgents {
+		fmt.Fprintf(&b, "User-agent: %s\nDisallow: /\n\n", agent)
+	}
+
+	b.WriteString("User-agent: *\n")
+	switch {
+	case r.DisallowAll:
+		b.WriteString("Disallow: /\n")
+	case len(r.Disallow) == 0:
+		b.WriteString("Disallow:\n") // An empty rule allows everything
+	default:
+		for _, path := range r.Disallow {
+			fmt.Fprintf(&b, "Disallow: %s\n", path)
+		}
+	}
+
+	if !r.DisallowAll {
+		fmt.Fprintf(&b, "\nSitemap: %s\n", r.Site.URL(SitemapPath))
+	}
+
+	return b.String()
+}
+
+// ForPost returns the robots meta tag of a post page. Drafts, scheduled posts,
+// and archived posts, only reachable as previews, are never indexed; posts under
+// a nofollow category keep their links uncredited.
+func (r RobotsPolicy) ForPost(p post.Post, path category.CategoryPath) MetaRobots {
+	return MetaRobots{
+		NoIndex:  r.DisallowAll || !p.IsPublished(),
+		NoFollow: r.isNoFollow(path.String()),
+	}
+}
+
+// isNoFollow reports whether a category path is, or is under, a nofollow category.
+func (r RobotsPolicy) isNoFollow(path string) bool {
+	for _, prefix := range r.NoFollowCategories {
+		prefix = strings.Trim(prefix, "/")
+		if prefix != "" && (path == prefix || strings.HasPrefix(path, prefix+"/")) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,109 @@
+package seo_test
+
+import (
+	"testing"
+
+	"github.com/alnah/fla/internal/domain/category"
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/post"
+	"github.com/alnah/fla/internal/domain/seo"
+)
+
+func TestRobotsPolicy_RobotsTxt(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy seo.RobotsPolicy
+		want   string
+	}{
+		{
+			name: "blocks agents and paths",
+			policy: seo.RobotsPolicy{
+				Disallow:      []string{"/admin", "/api"},
+				BlockedAgents: []string{"GPTBot"},
+			},
+			want: "User-agent: GPTBot\nDisallow: /\n\n" +
+				"User-agent: *\nDisallow: /admin\nDisallow: /api\n\n" +
+				"Sitemap: https://fla.example/sitemap.xml\n",
+		},
+		{
+			name:   "allows everything without rules",
+			policy: seo.RobotsPolicy{},
+			want:   "User-agent: *\nDisallow:\n\nSitemap: https://fla.example/sitemap.xml\n",
+		},
+		{
+			name:   "closes the whole site",
+			policy: seo.RobotsPolicy{DisallowAll: true, Disallow: []string{"/admin"}},
+			want:   "User-agent: *\nDisallow: /\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.policy.Site = testSite(t)
+			assertNoError(t, tt.policy.Validate())
+
+			if got := tt.policy.RobotsTxt(); got != tt.want {
+				t.Errorf("got\n%s\nwant\n%s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRobotsPolicy_Validate(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy seo.RobotsPolicy
+	}{
+		{"relative path", seo.RobotsPolicy{Disallow: []string{"admin"}}},
+		{"wildcard agent", seo.RobotsPolicy{BlockedAgents: []string{"*"}}},
+		{"agent with spaces", seo.RobotsPolicy{BlockedAgents: []string{"Bad Bot"}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.policy.Site = testSite(t)
+
+			assertErrorCode(t, tt.policy.Validate(), kernel.EInvalid)
+		})
+	}
+}
+
+func TestRobotsPolicy_ForPost(t *testing.T) {
+	paths := testPaths()
+	reading := paths.paths["reading"]
+	a1 := paths.paths["a1"]
+	policy := seo.RobotsPolicy{Site: testSite(t), NoFollowCategories: []string{"/a1/lecture/"}}
+
+	published := testPost(t)
+	published.Status = post.StatusPublished
+	archived := testPost(t)
+	archived.Status = post.StatusArchived
+
+	tests := []struct {
+		name string
+		p    post.Post
+		path category.CategoryPath
+		want string
+	}{
+		{"indexes published posts", published, a1, "index, follow"},
+		{"keeps drafts out of the index", testPost(t), a1, "noindex, follow"},
+		{"keeps archived previews out of the index", archived, a1, "noindex, follow"},
+		{"applies nofollow by category path", published, reading, "index, nofollow"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := policy.ForPost(tt.p, tt.path).String(); got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("closed site indexes nothing", func(t *testing.T) {
+		closed := seo.RobotsPolicy{Site: testSite(t), DisallowAll: true}
+
+		if got := closed.ForPost(published, a1); !got.NoIndex {
+			t.Errorf("got %q", got)
+		}
+	})
+}
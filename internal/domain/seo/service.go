@@ -0,0 +1,154 @@
+package seo
+
+import (
+	"strings"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/category"
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/post"
+	"github.com/alnah/fla/internal/domain/shared"
+)
+
+const MTwitterHandleInvalid string = "Twitter handle must start with @."
+
+// SiteDefaults holds the site-wide values used when a post leaves a field empty.
+type SiteDefaults struct {
+	Site          shared.Site
+	DefaultImage  string // Shared image for posts without featured or Open Graph image
+	TwitterHandle string // Site account, e.g. "@fla"; optional
+}
+
+// Validate ensures the defaults can produce absolute URLs and valid handles.
+func (d SiteDefaults) Validate() error {
+	const op = "SiteDefaults.Validate"
+
+	if err := d.Site.Validate(); err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if d.TwitterHandle != "" && !strings.HasPrefix(d.TwitterHandle, "@") {
+		return &kernel.Error{Code: kernel.EInvalid, Message: MTwitterHandleInvalid, Operation: op}
+	}
+
+	return nil
+}
+
+// MetaService resolves head metadata for posts.
+// Centralizes fallback chains so every template renders the same tags.
+type MetaService struct {
+	categories category.CategoryPathBuilder
+	defaults   SiteDefaults
+}
+
+// NewMetaService creates meta service with category path lookup and site defaults.
+func NewMetaService(categories category.CategoryPathBuilder, defaults SiteDefaults) (*MetaService, error) {
+	const op = "NewMetaService"
+
+	if err := defaults.Validate(); err != nil {
+		return nil, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return &MetaService{
+		categories: categories,
+		defaults:   defaults,
+	}, nil
+}
+
+// ForPost resolves the complete meta set for a post page.
+// Titles fall back OpenGraphTitle → SEOTitle → Title; descriptions and images follow the post fallbacks.
+func (s *MetaService) ForPost(p post.Post) (MetaTags, error) {
+	const op = "MetaService.ForPost"
+
+	canonical, err := s.canonicalURL(p)
+	if err != nil {
+		return MetaTags{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	title := EffectiveTitle(p)
+	ogTitle := EffectiveOpenGraphTitle(p)
+	description := EffectiveDescription(p)
+	ogDescription := p.GetEffectiveOpenGraphDescription()
+
+	image := p.GetEffectiveOpenGraphImage()
+	if image == "" {
+		image = s.defaults.DefaultImage
+	}
+
+	card := TwitterCardSummary
+	if image != "" {
+		card = TwitterCardLargeImage
+	}
+
+	tags := MetaTags{
+		Title:        title,
+		Description:  description,
+		CanonicalURL: canonical,
+
+		OpenGraphType:        OpenGraphTypeArticle,
+		OpenGraphTitle:       ogTitle,
+		OpenGraphDescription: ogDescription,
+		OpenGraphImage:       image,
+		OpenGraphURL:         canonical,
+		OpenGraphSiteName:    s.defaults.Site.Name,
+		OpenGraphLocale:      OpenGraphLocale(s.defaults.Site.Locale),
+
+		TwitterCard:        card,
+		TwitterSite:        s.defaults.TwitterHandle,
+		TwitterTitle:       ogTitle,
+		TwitterDescription: ogDescription,
+		TwitterImage:       image,
+	}
+
+	if p.PublishedAt != nil {
+		tags.PublishedTime = p.PublishedAt.UTC().Format(time.RFC3339)
+	}
+	if !p.UpdatedAt.IsZero() {
+		tags.ModifiedTime = p.UpdatedAt.UTC().Format(time.RFC3339)
+	}
+
+	return tags, nil
+}
+
+// canonicalURL prefers the explicit canonical URL, else the post's public URL.
+func (s *MetaService) canonicalURL(p post.Post) (string, error) {
+	if p.CanonicalURL != "" {
+		return p.CanonicalURL.String(), nil
+	}
+
+	path, err := s.categories.BuildPath(p.Category.CategoryID)
+	if err != nil {
+		return "", err
+	}
+
+	return s.defaults.Site.URL(p.URLPath(path)), nil
+}
+
+// EffectiveTitle returns the title shown in search results: SEOTitle, else Title.
+func EffectiveTitle(p post.Post) string {
+	if p.SEOTitle != "" {
+		return p.SEOTitle.String()
+	}
+	return p.Title.String()
+}
+
+// EffectiveOpenGraphTitle returns the title shown when shared: OpenGraphTitle, else EffectiveTitle.
+func EffectiveOpenGraphTitle(p post.Post) string {
+	if p.OpenGraphTitle != "" {
+		return p.OpenGraphTitle.String()
+	}
+	return EffectiveTitle(p)
+}
+
+// EffectiveDescription returns the search result snippet: SEODescription, else the excerpt.
+func EffectiveDescription(p post.Post) string {
+	if p.SEODescription != "" {
+		return p.SEODescription.String()
+	}
+	return p.GetEffectiveExcerpt()
+}
+
+// OpenGraphLocale converts a BCP 47 locale ("fr-FR") to the Open Graph form ("fr_FR").
+func OpenGraphLocale(locale shared.Locale) string {
+	return strings.ReplaceAll(locale.String(), "-", "_")
+}
@@ -0,0 +1,133 @@
+package seo_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/seo"
+)
+
+func TestNewMetaService(t *testing.T) {
+	t.Run("rejects handle without @", func(t *testing.T) {
+		_, err := seo.NewMetaService(testPaths(), seo.SiteDefaults{Site: testSite(t), TwitterHandle: "fla"})
+
+		assertError(t, err)
+		assertErrorCode(t, err, kernel.EInvalid)
+	})
+
+	t.Run("rejects missing site", func(t *testing.T) {
+		_, err := seo.NewMetaService(testPaths(), seo.SiteDefaults{})
+
+		assertErrorCode(t, err, kernel.EInvalid)
+	})
+}
+
+func TestMetaService_ForPost(t *testing.T) {
+	service, err := seo.NewMetaService(testPaths(), seo.SiteDefaults{
+		Site:          testSite(t),
+		DefaultImage:  "https://fla.example/default.png",
+		TwitterHandle: "@fla",
+	})
+	assertNoError(t, err)
+
+	t.Run("falls back to title, excerpt, and default image", func(t *testing.T) {
+		p := testPost(t)
+
+		got, err := service.ForPost(p)
+
+		assertNoError(t, err)
+		want := seo.MetaTags{
+			Title:                "Lire un menu",
+			Description:          p.GetEffectiveExcerpt(),
+			CanonicalURL:         "https://fla.example/a1/lecture/lire-un-menu",
+			OpenGraphType:        seo.OpenGraphTypeArticle,
+			OpenGraphTitle:       "Lire un menu",
+			OpenGraphDescription: p.GetEffectiveExcerpt(),
+			OpenGraphImage:       "https://fla.example/default.png",
+			OpenGraphURL:         "https://fla.example/a1/lecture/lire-un-menu",
+			OpenGraphSiteName:    "FLA",
+			OpenGraphLocale:      "fr_FR",
+			TwitterCard:          seo.TwitterCardLargeImage,
+			TwitterSite:          "@fla",
+			TwitterTitle:         "Lire un menu",
+			TwitterDescription:   p.GetEffectiveExcerpt(),
+			TwitterImage:         "https://fla.example/default.png",
+			ModifiedTime:         "2024-03-01T09:00:00Z",
+		}
+		if got != want {
+			t.Errorf("got %+v\nwant %+v", got, want)
+		}
+	})
+
+	t.Run("prefers the most specific fields", func(t *testing.T) {
+		p := testPost(t)
+		p.SEOTitle = "Menu au restaurant"
+		p.OpenGraphTitle = "Commander au restaurant"
+		p.SEODescription = "Apprendre à lire un menu."
+		p.OpenGraphDescription = "Partagez cette leçon."
+		p.FeaturedImage = "https://cdn.example/menu.jpg"
+		p.CanonicalURL = "https://partner.example/menu"
+
+		got, err := service.ForPost(p)
+
+		assertNoError(t, err)
+		if got.Title != "Menu au restaurant" || got.OpenGraphTitle != "Commander au restaurant" {
+			t.Errorf("got titles %q, %q", got.Title, got.OpenGraphTitle)
+		}
+		if got.Description != "Apprendre à lire un menu." || got.TwitterDescription != "Partagez cette leçon." {
+			t.Errorf("got descriptions %q, %q", got.Description, got.TwitterDescription)
+		}
+		if got.OpenGraphImage != "https://cdn.example/menu.jpg" {
+			t.Errorf("got image %q", got.OpenGraphImage)
+		}
+		if got.CanonicalURL != "https://partner.example/menu" {
+			t.Errorf("got canonical %q", got.CanonicalURL)
+		}
+	})
+
+	t.Run("uses summary card without any image", func(t *testing.T) {
+		noImage, _ := seo.NewMetaService(testPaths(), seo.SiteDefaults{Site: testSite(t)})
+
+		got, err := noImage.ForPost(testPost(t))
+
+		assertNoError(t, err)
+		if got.TwitterCard != seo.TwitterCardSummary || got.OpenGraphImage != "" {
+			t.Errorf("got card %q, image %q", got.TwitterCard, got.OpenGraphImage)
+		}
+	})
+
+	t.Run("propagates unknown category", func(t *testing.T) {
+		p := testPost(t)
+		p.Category.CategoryID = "missing"
+
+		_, err := service.ForPost(p)
+
+		assertErrorCode(t, err, kernel.ENotFound)
+	})
+}
+
+func TestMetaTags_HTML(t *testing.T) {
+	tags := seo.MetaTags{
+		Title:          `Le "bon" menu <A1>`,
+		CanonicalURL:   "https://fla.example/a1/menu",
+		OpenGraphTitle: "Menu & boissons",
+		TwitterCard:    seo.TwitterCardSummary,
+	}
+
+	got := tags.HTML()
+
+	for _, want := range []string{
+		"<title>Le &#34;bon&#34; menu &lt;A1&gt;</title>",
+		`<link rel="canonical" href="https://fla.example/a1/menu">`,
+		`<meta property="og:title" content="Menu &amp; boissons">`,
+		`<meta name="twitter:card" content="summary">`,
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("missing %q in:\n%s", want, got)
+		}
+	}
+	if strings.Contains(got, "og:image") {
+		t.Error("expected empty tags to be omitted")
+	}
+}
@@ -0,0 +1,167 @@
+package session
+
+import (
+	"slices"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+// Token lifetimes. Access tokens are short so revocation and role changes apply quickly.
+const (
+	AccessTokenTTL        time.Duration = 15 * time.Minute
+	MachineAccessTokenTTL time.Duration = time.Hour
+	RefreshTokenTTL       time.Duration = 30 * 24 * time.Hour
+)
+
+const (
+	MClientKindInvalid  string = "Invalid client kind."
+	MSessionRevoked     string = "Session has been revoked."
+	MSessionExpired     string = "Session has expired."
+	MSessionNoRefresh   string = "Machine sessions cannot be refreshed."
+	MSessionUserMissing string = "Session has no user."
+)
+
+// ClientKind distinguishes people using the site from integrations calling the API.
+type ClientKind string
+
+const (
+	ClientUser    ClientKind = "user"    // Browser or app session, refreshable
+	ClientMachine ClientKind = "machine" // Integration with RoleMachine, access token only
+)
+
+func (k ClientKind) String() string { return string(k) }
+
+// Validate ensures the client kind is defined.
+func (k ClientKind) Validate() error {
+	const op = "ClientKind.Validate"
+
+	switch k {
+	case ClientUser, ClientMachine:
+		return nil
+	default:
+		return &kernel.Error{Code: kernel.EInvalid, Message: MClientKindInvalid, Operation: op}
+	}
+}
+
+// Session is one sign-in of a user or machine client.
+// Every access token carries the session ID so revoking the session invalidates them all.
+type Session struct {
+	// Identity
+	SessionID kernel.ID[Session]
+	UserID    kernel.ID[user.User]
+
+	// Data
+	Kind          ClientKind
+	Roles         []user.Role // Snapshot embedded in access tokens
+	RefreshDigest string      // Digest of the current refresh token; empty for machines
+
+	// Lifecycle
+	ExpiresAt       time.Time // End of the refresh window (or of the access token for machines)
+	LastRefreshedAt *time.Time
+	RevokedAt       *time.Time
+
+	// Meta
+	CreatedAt time.Time
+	UpdatedAt time.Time
+
+	// DI
+	Clock kernel.Clock
+}
+
+// Validate performs validation on the session.
+func (s Session) Validate() error {
+	const op = "Session.Validate"
+
+	if err := s.SessionID.Validate(); err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if err := s.UserID.Validate(); err != nil {
+		return &kernel.Error{Code: kernel.EInvalid, Message: MSessionUserMissing, Operation: op, Cause: err}
+	}
+
+	if err := s.Kind.Validate(); err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return nil
+}
+
+// IsRevoked returns true once the session was revoked.
+func (s Session) IsRevoked() bool {
+	return s.RevokedAt != nil
+}
+
+// IsExpired returns true once the refresh window has passed.
+func (s Session) IsExpired() bool {
+	return !s.Clock.Now().Before(s.ExpiresAt)
+}
+
+// Claims builds the access token claims for this session at the current time.
+func (s Session) Claims() Claims {
+	now := s.Clock.Now()
+
+	ttl := AccessTokenTTL
+	if s.Kind == ClientMachine {
+		ttl = MachineAccessTokenTTL
+	}
+
+	return Claims{
+		Subject:   s.UserID,
+		SessionID: s.SessionID,
+		Kind:      s.Kind,
+		Roles:     slices.Clone(s.Roles),
+		IssuedAt:  now,
+		ExpiresAt: now.Add(ttl),
+	}
+}
+
+// Rotate replaces the refresh token and extends the refresh window.
+// Roles are refreshed so role changes reach the next access token.
+func (s Session) Rotate(token RefreshToken, roles []user.Role) (Session, error) {
+	const op = "Session.Rotate"
+
+	if s.Kind == ClientMachine {
+		return s, &kernel.Error{Code: kernel.EConflict, Message: MSessionNoRefresh, Operation: op}
+	}
+
+	if s.IsRevoked() {
+		return s, &kernel.Error{Code: kernel.EForbidden, Message: MSessionRevoked, Operation: op}
+	}
+
+	if s.IsExpired() {
+		return s, &kernel.Error{Code: kernel.EForbidden, Message: MSessionExpired, Operation: op}
+	}
+
+	if err := token.Validate(); err != nil {
+		return s, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	now := s.Clock.Now()
+
+	updated := s
+	updated.Roles = slices.Clone(roles)
+	updated.RefreshDigest = token.Digest()
+	updated.ExpiresAt = now.Add(RefreshTokenTTL)
+	updated.LastRefreshedAt = &now
+	updated.UpdatedAt = now
+
+	return updated, nil
+}
+
+// Revoke ends the session; revoking twice is a no-op.
+func (s Session) Revoke() Session {
+	if s.IsRevoked() {
+		return s
+	}
+
+	now := s.Clock.Now()
+
+	updated := s
+	updated.RevokedAt = &now
+	updated.UpdatedAt = now
+
+	return updated
+}
@@ -0,0 +1,123 @@
+package session_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/session"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+type stubClock struct {
+	t time.Time
+}
+
+func (s *stubClock) Now() time.Time { return s.t }
+
+// stubSigner keeps claims in memory and hands out sequential tokens.
+type stubSigner struct {
+	issued map[session.AccessToken]session.Claims
+}
+
+func (s *stubSigner) Sign(c session.Claims) (session.AccessToken, error) {
+	if s.issued == nil {
+		s.issued = make(map[session.AccessToken]session.Claims)
+	}
+	token := session.AccessToken(fmt.Sprintf("access-%d", len(s.issued)+1))
+	s.issued[token] = c
+	return token, nil
+}
+
+func (s *stubSigner) Parse(token session.AccessToken) (session.Claims, error) {
+	c, ok := s.issued[token]
+	if !ok {
+		return session.Claims{}, errors.New("bad signature")
+	}
+	return c, nil
+}
+
+type stubRepository struct {
+	sessions map[kernel.ID[session.Session]]session.Session
+}
+
+func newStubRepository() *stubRepository {
+	return &stubRepository{sessions: make(map[kernel.ID[session.Session]]session.Session)}
+}
+
+func (r *stubRepository) GetByID(id kernel.ID[session.Session]) (*session.Session, error) {
+	s, ok := r.sessions[id]
+	if !ok {
+		return nil, &kernel.Error{Code: kernel.ENotFound, Message: "not found"}
+	}
+	return &s, nil
+}
+
+func (r *stubRepository) GetByRefreshDigest(digest string) (*session.Session, error) {
+	for _, s := range r.sessions {
+		if s.RefreshDigest != "" && s.RefreshDigest == digest {
+			return &s, nil
+		}
+	}
+	return nil, &kernel.Error{Code: kernel.ENotFound, Message: "not found"}
+}
+
+func (r *stubRepository) Create(s session.Session) error {
+	r.sessions[s.SessionID] = s
+	return nil
+}
+
+func (r *stubRepository) Update(s session.Session) error {
+	r.sessions[s.SessionID] = s
+	return nil
+}
+
+func (r *stubRepository) IsRevoked(id kernel.ID[session.Session]) (bool, error) {
+	return r.sessions[id].IsRevoked(), nil
+}
+
+func (r *stubRepository) RevokeAllForUser(userID kernel.ID[user.User]) (int, error) {
+	n := 0
+	for id, s := range r.sessions {
+		if s.UserID == userID && !s.IsRevoked() {
+			r.sessions[id] = s.Revoke()
+			n++
+		}
+	}
+	return n, nil
+}
+
+type stubUsers map[kernel.ID[user.User]]user.User
+
+func (s stubUsers) GetUserByID(id kernel.ID[user.User]) (*user.User, error) {
+	u, ok := s[id]
+	if !ok {
+		return nil, &kernel.Error{Code: kernel.ENotFound, Message: "not found"}
+	}
+	return &u, nil
+}
+
+func assertNoError(t *testing.T, err error) {
+	t.Helper()
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+}
+
+func assertErrorCode(t *testing.T, err error, want string) {
+	t.Helper()
+	got := kernel.ErrorCode(err)
+	if got != want {
+		t.Errorf("error code: got %q, want %q", got, want)
+	}
+}
+
+func assertErrorMessage(t *testing.T, err error, want string) {
+	t.Helper()
+	got := kernel.ErrorMessage(err)
+	if got != want {
+		t.Errorf("error message: got %q, want %q", got, want)
+	}
+}
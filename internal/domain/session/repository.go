@@ -0,0 +1,50 @@
+package session
+
+import (
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+// SessionReader defines read operations for session lookup.
+type SessionReader interface {
+	// GetByID retrieves a session for revocation.
+	GetByID(sessionID kernel.ID[Session]) (*Session, error)
+
+	// GetByRefreshDigest finds the session whose current refresh token has the digest.
+	// Returns ENotFound for unknown or rotated tokens.
+	GetByRefreshDigest(digest string) (*Session, error)
+}
+
+// SessionWriter defines session persistence operations.
+type SessionWriter interface {
+	// Create stores a new session.
+	Create(session Session) error
+
+	// Update saves rotation and revocation changes.
+	Update(session Session) error
+}
+
+// RevocationList answers whether access tokens of a session must be refused.
+// Checked on every request, so implementations usually cache it (Redis, in-memory set).
+type RevocationList interface {
+	// IsRevoked reports whether the session was revoked.
+	IsRevoked(sessionID kernel.ID[Session]) (bool, error)
+
+	// RevokeAllForUser revokes every open session of a user and returns how many were open.
+	// Used on password change, suspension, and "sign out everywhere".
+	RevokeAllForUser(userID kernel.ID[user.User]) (int, error)
+}
+
+// Full repository interface for implementations that provide everything.
+// Most concrete implementations (like PostgresSessionRepository) will implement this.
+type Repository interface {
+	SessionReader
+	SessionWriter
+	RevocationList
+}
+
+// UserReader loads the account behind a session so refreshes see current roles and status.
+type UserReader interface {
+	// GetUserByID retrieves a user; returns ENotFound for deleted accounts.
+	GetUserByID(userID kernel.ID[user.User]) (*user.User, error)
+}
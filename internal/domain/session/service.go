@@ -0,0 +1,227 @@
+package session
+
+import (
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+const (
+	MSessionAccountInactive string = "Account is not active."
+	MSessionRevokeForbidden string = "Only the session owner or an admin can revoke sessions."
+	MAccessTokenInvalid     string = "Access token is invalid."
+	MAccessTokenExpired     string = "Access token has expired."
+	MRefreshTokenInvalid    string = "Refresh token is invalid."
+)
+
+// Actor is whoever asks to revoke sessions: a signed-in user or their token claims.
+type Actor interface {
+	HasRole(role user.Role) bool
+	GetID() kernel.ID[user.User]
+}
+
+// SessionService issues, validates, refreshes, and revokes sessions.
+type SessionService struct {
+	repository Repository
+	users      UserReader
+	signer     Signer
+	clock      kernel.Clock
+}
+
+// NewSessionService creates session service with persistence, account lookup, and token signing.
+func NewSessionService(repository Repository, users UserReader, signer Signer, clock kernel.Clock) *SessionService {
+	return &SessionService{
+		repository: repository,
+		users:      users,
+		signer:     signer,
+		clock:      clock,
+	}
+}
+
+// Issue starts a session for an authenticated account.
+// Accounts with RoleMachine get a machine session without refresh token.
+func (s *SessionService) Issue(sessionID kernel.ID[Session], u user.User) (TokenPair, error) {
+	const op = "SessionService.Issue"
+
+	if !u.IsActive() {
+		return TokenPair{}, &kernel.Error{Code: kernel.EForbidden, Message: MSessionAccountInactive, Operation: op}
+	}
+
+	now := s.clock.Now()
+
+	sess := Session{
+		SessionID: sessionID,
+		UserID:    u.ID,
+		Kind:      ClientUser,
+		Roles:     u.Roles,
+		ExpiresAt: now.Add(RefreshTokenTTL),
+		CreatedAt: now,
+		UpdatedAt: now,
+		Clock:     s.clock,
+	}
+
+	var refresh RefreshToken
+	if u.HasRole(user.RoleMachine) {
+		sess.Kind = ClientMachine
+		sess.ExpiresAt = now.Add(MachineAccessTokenTTL)
+	} else {
+		token, err := NewRefreshToken()
+		if err != nil {
+			return TokenPair{}, &kernel.Error{Operation: op, Cause: err}
+		}
+		refresh = token
+		sess.RefreshDigest = token.Digest()
+	}
+
+	if err := sess.Validate(); err != nil {
+		return TokenPair{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	pair, err := s.sign(sess, refresh)
+	if err != nil {
+		return TokenPair{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if err := s.repository.Create(sess); err != nil {
+		return TokenPair{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return pair, nil
+}
+
+// Validate returns the claims of a valid access token.
+// Refuses tampered, expired, and revoked tokens with EForbidden.
+func (s *SessionService) Validate(token AccessToken) (Claims, error) {
+	const op = "SessionService.Validate"
+
+	if err := token.Validate(); err != nil {
+		return Claims{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	claims, err := s.signer.Parse(token)
+	if err != nil {
+		return Claims{}, &kernel.Error{Code: kernel.EForbidden, Message: MAccessTokenInvalid, Operation: op, Cause: err}
+	}
+
+	if !s.clock.Now().Before(claims.ExpiresAt) {
+		return Claims{}, &kernel.Error{Code: kernel.EForbidden, Message: MAccessTokenExpired, Operation: op}
+	}
+
+	revoked, err := s.repository.IsRevoked(claims.SessionID)
+	if err != nil {
+		return Claims{}, &kernel.Error{Operation: op, Cause: err}
+	}
+	if revoked {
+		return Claims{}, &kernel.Error{Code: kernel.EForbidden, Message: MSessionRevoked, Operation: op}
+	}
+
+	return claims, nil
+}
+
+// Refresh exchanges a refresh token for a new pair; the old refresh token stops working.
+// Roles are reloaded from the account, and sessions of inactive accounts are revoked.
+func (s *SessionService) Refresh(token RefreshToken) (TokenPair, error) {
+	const op = "SessionService.Refresh"
+
+	if err := token.Validate(); err != nil {
+		return TokenPair{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	sess, err := s.repository.GetByRefreshDigest(token.Digest())
+	if kernel.ErrorCode(err) == kernel.ENotFound {
+		return TokenPair{}, &kernel.Error{Code: kernel.EForbidden, Message: MRefreshTokenInvalid, Operation: op}
+	}
+	if err != nil {
+		return TokenPair{}, &kernel.Error{Operation: op, Cause: err}
+	}
+	sess.Clock = s.clock
+
+	account, err := s.users.GetUserByID(sess.UserID)
+	if err != nil {
+		return TokenPair{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if !account.IsActive() {
+		if err := s.repository.Update(sess.Revoke()); err != nil {
+			return TokenPair{}, &kernel.Error{Operation: op, Cause: err}
+		}
+		return TokenPair{}, &kernel.Error{Code: kernel.EForbidden, Message: MSessionAccountInactive, Operation: op}
+	}
+
+	next, err := NewRefreshToken()
+	if err != nil {
+		return TokenPair{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	rotated, err := sess.Rotate(next, account.Roles)
+	if err != nil {
+		return TokenPair{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	pair, err := s.sign(rotated, next)
+	if err != nil {
+		return TokenPair{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if err := s.repository.Update(rotated); err != nil {
+		return TokenPair{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return pair, nil
+}
+
+// Revoke ends one session; the owner or an admin may do this.
+func (s *SessionService) Revoke(sessionID kernel.ID[Session], actor Actor) error {
+	const op = "SessionService.Revoke"
+
+	sess, err := s.repository.GetByID(sessionID)
+	if err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+	sess.Clock = s.clock
+
+	if actor.GetID() != sess.UserID && !actor.HasRole(user.RoleAdmin) {
+		return &kernel.Error{Code: kernel.EForbidden, Message: MSessionRevokeForbidden, Operation: op}
+	}
+
+	if err := s.repository.Update(sess.Revoke()); err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return nil
+}
+
+// RevokeAllForUser signs a user out everywhere and returns how many sessions were open.
+func (s *SessionService) RevokeAllForUser(userID kernel.ID[user.User], actor Actor) (int, error) {
+	const op = "SessionService.RevokeAllForUser"
+
+	if actor.GetID() != userID && !actor.HasRole(user.RoleAdmin) {
+		return 0, &kernel.Error{Code: kernel.EForbidden, Message: MSessionRevokeForbidden, Operation: op}
+	}
+
+	n, err := s.repository.RevokeAllForUser(userID)
+	if err != nil {
+		return 0, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return n, nil
+}
+
+// sign builds the token pair for a session.
+func (s *SessionService) sign(sess Session, refresh RefreshToken) (TokenPair, error) {
+	const op = "SessionService.sign"
+
+	claims := sess.Claims()
+
+	access, err := s.signer.Sign(claims)
+	if err != nil {
+		return TokenPair{}, &kernel.Error{Code: kernel.EInternal, Message: kernel.MInternal, Operation: op, Cause: err}
+	}
+
+	pair := TokenPair{AccessToken: access, AccessExpiresAt: claims.ExpiresAt}
+	if refresh != "" {
+		pair.RefreshToken = refresh
+		pair.RefreshExpiresAt = sess.ExpiresAt
+	}
+
+	return pair, nil
+}
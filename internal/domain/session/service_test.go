@@ -0,0 +1,206 @@
+package session_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/session"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+type fixture struct {
+	service *session.SessionService
+	repo    *stubRepository
+	users   stubUsers
+	clock   *stubClock
+}
+
+func setup() fixture {
+	clock := &stubClock{t: time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)}
+	repo := newStubRepository()
+	users := stubUsers{
+		"author-1": {ID: "author-1", Roles: []user.Role{user.RoleAuthor}, Status: user.AccountStatusActive, Clock: clock},
+		"bot-1":    {ID: "bot-1", Roles: []user.Role{user.RoleMachine}, Status: user.AccountStatusActive, Clock: clock},
+		"admin-1":  {ID: "admin-1", Roles: []user.Role{user.RoleAdmin}, Status: user.AccountStatusActive, Clock: clock},
+	}
+	return fixture{
+		service: session.NewSessionService(repo, users, &stubSigner{}, clock),
+		repo:    repo,
+		users:   users,
+		clock:   clock,
+	}
+}
+
+func TestSessionService_Issue(t *testing.T) {
+	t.Run("user gets access and refresh tokens with role claims", func(t *testing.T) {
+		f := setup()
+
+		pair, err := f.service.Issue("s1", f.users["author-1"])
+
+		assertNoError(t, err)
+		if pair.RefreshToken == "" || !pair.AccessExpiresAt.Equal(f.clock.t.Add(session.AccessTokenTTL)) {
+			t.Errorf("got %+v", pair)
+		}
+		claims, err := f.service.Validate(pair.AccessToken)
+		assertNoError(t, err)
+		if claims.Subject != "author-1" || !claims.HasRole(user.RoleAuthor) || claims.Kind != session.ClientUser {
+			t.Errorf("got claims %+v", claims)
+		}
+		if f.repo.sessions["s1"].RefreshDigest == pair.RefreshToken.String() {
+			t.Error("refresh token should be stored as a digest")
+		}
+	})
+
+	t.Run("machine gets longer access token and no refresh token", func(t *testing.T) {
+		f := setup()
+
+		pair, err := f.service.Issue("s1", f.users["bot-1"])
+
+		assertNoError(t, err)
+		if pair.RefreshToken != "" || !pair.AccessExpiresAt.Equal(f.clock.t.Add(session.MachineAccessTokenTTL)) {
+			t.Errorf("got %+v", pair)
+		}
+	})
+
+	t.Run("refuses inactive account", func(t *testing.T) {
+		f := setup()
+		suspended := f.users["author-1"]
+		suspended.Status = user.AccountStatusSuspended
+
+		_, err := f.service.Issue("s1", suspended)
+
+		assertErrorCode(t, err, kernel.EForbidden)
+	})
+}
+
+func TestSessionService_Validate(t *testing.T) {
+	t.Run("rejects expired token", func(t *testing.T) {
+		f := setup()
+		pair, _ := f.service.Issue("s1", f.users["author-1"])
+		f.clock.t = f.clock.t.Add(session.AccessTokenTTL)
+
+		_, err := f.service.Validate(pair.AccessToken)
+
+		assertErrorMessage(t, err, session.MAccessTokenExpired)
+	})
+
+	t.Run("rejects tampered token", func(t *testing.T) {
+		f := setup()
+
+		_, err := f.service.Validate("forged")
+
+		assertErrorCode(t, err, kernel.EForbidden)
+		assertErrorMessage(t, err, session.MAccessTokenInvalid)
+	})
+
+	t.Run("rejects token of revoked session", func(t *testing.T) {
+		f := setup()
+		pair, _ := f.service.Issue("s1", f.users["author-1"])
+		assertNoError(t, f.service.Revoke("s1", f.users["author-1"]))
+
+		_, err := f.service.Validate(pair.AccessToken)
+
+		assertErrorMessage(t, err, session.MSessionRevoked)
+	})
+
+	t.Run("rejects empty token", func(t *testing.T) {
+		f := setup()
+
+		_, err := f.service.Validate("")
+
+		assertErrorCode(t, err, kernel.EInvalid)
+	})
+}
+
+func TestSessionService_Refresh(t *testing.T) {
+	t.Run("rotates refresh token and reloads roles", func(t *testing.T) {
+		f := setup()
+		first, _ := f.service.Issue("s1", f.users["author-1"])
+		promoted := f.users["author-1"]
+		promoted.Roles = []user.Role{user.RoleAuthor, user.RoleEditor}
+		f.users["author-1"] = promoted
+
+		second, err := f.service.Refresh(first.RefreshToken)
+
+		assertNoError(t, err)
+		if second.RefreshToken == first.RefreshToken {
+			t.Error("refresh token should rotate")
+		}
+		claims, _ := f.service.Validate(second.AccessToken)
+		if !claims.HasRole(user.RoleEditor) {
+			t.Errorf("got roles %v", claims.Roles)
+		}
+
+		_, err = f.service.Refresh(first.RefreshToken)
+		assertErrorMessage(t, err, session.MRefreshTokenInvalid)
+	})
+
+	t.Run("revokes session of suspended account", func(t *testing.T) {
+		f := setup()
+		pair, _ := f.service.Issue("s1", f.users["author-1"])
+		suspended := f.users["author-1"]
+		suspended.Status = user.AccountStatusSuspended
+		f.users["author-1"] = suspended
+
+		_, err := f.service.Refresh(pair.RefreshToken)
+
+		assertErrorMessage(t, err, session.MSessionAccountInactive)
+		if !f.repo.sessions["s1"].IsRevoked() {
+			t.Error("session should be revoked")
+		}
+	})
+
+	t.Run("rejects expired refresh window", func(t *testing.T) {
+		f := setup()
+		pair, _ := f.service.Issue("s1", f.users["author-1"])
+		f.clock.t = f.clock.t.Add(session.RefreshTokenTTL)
+
+		_, err := f.service.Refresh(pair.RefreshToken)
+
+		assertErrorCode(t, err, kernel.EForbidden)
+	})
+
+	t.Run("rejects revoked session", func(t *testing.T) {
+		f := setup()
+		pair, _ := f.service.Issue("s1", f.users["author-1"])
+		_ = f.service.Revoke("s1", f.users["admin-1"])
+
+		_, err := f.service.Refresh(pair.RefreshToken)
+
+		assertErrorMessage(t, err, session.MSessionRevoked)
+	})
+}
+
+func TestSessionService_Revoke(t *testing.T) {
+	t.Run("other users cannot revoke", func(t *testing.T) {
+		f := setup()
+		_, _ = f.service.Issue("s1", f.users["author-1"])
+
+		err := f.service.Revoke("s1", f.users["bot-1"])
+
+		assertErrorCode(t, err, kernel.EForbidden)
+	})
+
+	t.Run("signs out everywhere", func(t *testing.T) {
+		f := setup()
+		_, _ = f.service.Issue("s1", f.users["author-1"])
+		_, _ = f.service.Issue("s2", f.users["author-1"])
+		_, _ = f.service.Issue("s3", f.users["admin-1"])
+
+		n, err := f.service.RevokeAllForUser("author-1", f.users["admin-1"])
+
+		assertNoError(t, err)
+		if n != 2 || f.repo.sessions["s3"].IsRevoked() {
+			t.Errorf("got %d revoked", n)
+		}
+	})
+
+	t.Run("sign out everywhere requires owner or admin", func(t *testing.T) {
+		f := setup()
+
+		_, err := f.service.RevokeAllForUser("author-1", f.users["bot-1"])
+
+		assertErrorCode(t, err, kernel.EForbidden)
+	})
+}
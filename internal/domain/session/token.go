@@ -0,0 +1,120 @@
+// Package session issues and validates the tokens that keep users and machine clients signed in.
+package session
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"slices"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+// RefreshTokenBytes is the entropy of a refresh token before encoding.
+const RefreshTokenBytes = 32
+
+const (
+	MRefreshTokenMissing      string = "Missing refresh token."
+	MRefreshTokenGenerateFail string = "Refresh token could not be generated."
+	MAccessTokenMissing       string = "Missing access token."
+)
+
+// Claims are what an access token asserts about its bearer.
+// Roles are copied at issue time, so a role change takes effect at the next refresh.
+type Claims struct {
+	Subject   kernel.ID[user.User]
+	SessionID kernel.ID[Session]
+	Kind      ClientKind
+	Roles     []user.Role
+	IssuedAt  time.Time
+	ExpiresAt time.Time
+}
+
+// HasRole checks if the token grants a specific role.
+func (c Claims) HasRole(role user.Role) bool {
+	return slices.Contains(c.Roles, role)
+}
+
+// HasAnyRole checks if the token grants any of the specified roles.
+func (c Claims) HasAnyRole(roles ...user.Role) bool {
+	return slices.ContainsFunc(roles, c.HasRole)
+}
+
+// GetID returns the subject for permission checks.
+func (c Claims) GetID() kernel.ID[user.User] {
+	return c.Subject
+}
+
+// AccessToken is a signed, self-contained bearer token.
+type AccessToken string
+
+func (t AccessToken) String() string { return string(t) }
+
+// Validate ensures a token is present.
+func (t AccessToken) Validate() error {
+	const op = "AccessToken.Validate"
+
+	if t == "" {
+		return &kernel.Error{Code: kernel.EInvalid, Message: MAccessTokenMissing, Operation: op}
+	}
+
+	return nil
+}
+
+// Signer encodes claims into access tokens and decodes them back.
+// Implemented by adapters (JWT with a rotating key set) so the domain stays format-agnostic.
+type Signer interface {
+	// Sign produces a tamper-proof token carrying the claims.
+	Sign(claims Claims) (AccessToken, error)
+
+	// Parse verifies the signature and returns the claims.
+	// Expiry is checked by the domain with its Clock, not by the signer.
+	Parse(token AccessToken) (Claims, error)
+}
+
+// RefreshToken is an opaque secret exchanged for a new token pair.
+// Only its digest is stored, so a database leak cannot be used to resume sessions.
+type RefreshToken string
+
+// NewRefreshToken generates a random URL-safe token.
+func NewRefreshToken() (RefreshToken, error) {
+	const op = "NewRefreshToken"
+
+	b := make([]byte, RefreshTokenBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", &kernel.Error{Code: kernel.EInternal, Message: MRefreshTokenGenerateFail, Operation: op, Cause: err}
+	}
+
+	return RefreshToken(base64.RawURLEncoding.EncodeToString(b)), nil
+}
+
+func (t RefreshToken) String() string { return string(t) }
+
+// Validate ensures a token is present.
+func (t RefreshToken) Validate() error {
+	const op = "RefreshToken.Validate"
+
+	if t == "" {
+		return &kernel.Error{Code: kernel.EInvalid, Message: MRefreshTokenMissing, Operation: op}
+	}
+
+	return nil
+}
+
+// Digest returns the SHA-256 of the token, hex encoded, for storage and lookup.
+func (t RefreshToken) Digest() string {
+	sum := sha256.Sum256([]byte(t))
+	return hex.EncodeToString(sum[:])
+}
+
+// TokenPair is what a client receives after signing in or refreshing.
+// Machine clients get no refresh token; they sign in again with their own credentials.
+type TokenPair struct {
+	AccessToken      AccessToken
+	AccessExpiresAt  time.Time
+	RefreshToken     RefreshToken // Empty for machine clients
+	RefreshExpiresAt time.Time    // Zero for machine clients
+}
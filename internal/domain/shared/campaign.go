@@ -0,0 +1,144 @@
+package shared
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+)
+
+const (
+	MaxCampaignValueLength int = 100
+
+	MCampaignValueInvalid string = "UTM %s must be lowercase words joined by hyphens."
+	MCampaignURLInvalid   string = "Campaign links need an absolute http or https URL."
+)
+
+// UTM mediums, one per channel, so reports group visits the same way whichever
+// module built the link.
+const (
+	MediumEmail  string = "email"
+	MediumSocial string = "social"
+)
+
+// SourceNewsletter is the UTM source of every email the blog sends.
+const SourceNewsletter string = "newsletter"
+
+// CampaignLink holds the UTM parameters appended to links the blog shares.
+// Values are canonical: lowercase ASCII words joined by hyphens, as slugs, so
+// "Weekly Digest" and "weekly-digest" land in the same analytics row.
+type CampaignLink struct {
+	Source   string // Who sends the visit, e.g. "newsletter" or "linkedin"
+	Medium   string // Channel, e.g. MediumEmail
+	Campaign string // What is promoted, e.g. a post slug or a digest week
+	Term     string // Optional: paid keyword
+	Content  string // Optional: which link of a message, e.g. "header"
+}
+
+// NewCampaignLink creates a campaign link, canonicalizing each value.
+func NewCampaignLink(source, medium, campaign string) (CampaignLink, error) {
+	const op = "NewCampaignLink"
+
+	c := CampaignLink{
+		Source:   CanonicalCampaignValue(source),
+		Medium:   CanonicalCampaignValue(medium),
+		Campaign: CanonicalCampaignValue(campaign),
+	}
+	if err := c.Validate(); err != nil {
+		return CampaignLink{}, &kernel.Error{Operation: op, Cause: err}
+	}
+	return c, nil
+}
+
+// NewsletterCampaign creates the campaign link of an email, e.g. a weekly digest.
+func NewsletterCampaign(campaign string) (CampaignLink, error) {
+	return NewCampaignLink(SourceNewsletter, MediumEmail, campaign)
+}
+
+// SocialCampaign creates the campaign link of a post shared on a platform.
+func SocialCampaign(platform, campaign string) (CampaignLink, error) {
+	return NewCampaignLink(platform, MediumSocial, campaign)
+}
+
+// WithContent returns a copy telling apart links of the same message.
+func (c CampaignLink) WithContent(content string) CampaignLink {
+	c.Content = CanonicalCampaignValue(content)
+	return c
+}
+
+// CanonicalCampaignValue lowercases a value to hyphenated ASCII words, as slugs
+// are; empty when it has no letters or digits.
+func CanonicalCampaignValue(value string) string {
+	s, err := slugify(value, transliterate)
+	if err != nil {
+		return ""
+	}
+	return s
+}
+
+// Validate ensures source, medium, and campaign are set and every value is canonical.
+func (c CampaignLink) Validate() error {
+	const op = "CampaignLink.Validate"
+
+	values := []struct {
+		name, value string
+		required    bool
+	}{
+		{"source", c.Source, true},
+		{"medium", c.Medium, true},
+		{"campaign", c.Campaign, true},
+		{"term", c.Term, false},
+		{"content", c.Content, false},
+	}
+	for _, v := range values {
+		if v.value == "" && !v.required {
+			continue
+		}
+		if err := kernel.ValidatePresence("utm "+v.name, v.value, op); err != nil {
+			return err
+		}
+		if len(v.value) > MaxCampaignValueLength || !slugFormatRe.MatchString(v.value) {
+			return &kernel.Error{Code: kernel.EInvalid, Message: fmt.Sprintf(MCampaignValueInvalid, v.name), Operation: op}
+		}
+	}
+
+	return nil
+}
+
+// Apply returns rawURL with the campaign's UTM parameters, replacing any it
+// already had. Other query parameters are kept; all come out sorted by name,
+// so the same link is always written the same way.
+func (c CampaignLink) Apply(rawURL string) (string, error) {
+	const op = "CampaignLink.Apply"
+
+	if err := c.Validate(); err != nil {
+		return "", &kernel.Error{Operation: op, Cause: err}
+	}
+
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return "", &kernel.Error{Code: kernel.EInvalid, Message: MCampaignURLInvalid, Operation: op, Cause: err}
+	}
+
+	query := u.Query()
+	for key := range query {
+		if strings.HasPrefix(strings.ToLower(key), "utm_") {
+			query.Del(key)
+		}
+	}
+	for key, value := range map[string]string{
+		"utm_source":   c.Source,
+		"utm_medium":   c.Medium,
+		"utm_campaign": c.Campaign,
+		"utm_term":     c.Term,
+		"utm_content":  c.Content,
+	} {
+		if value != "" {
+			query.Set(key, value)
+		}
+	}
+
+	u.RawQuery = query.Encode()
+	return u.String(), nil
+}
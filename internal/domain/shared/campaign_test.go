@@ -0,0 +1,83 @@
+package shared_test
+
+import (
+	"testing"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/shared"
+)
+
+func TestNewCampaignLink(t *testing.T) {
+	t.Run("canonicalizes values", func(t *testing.T) {
+		got, err := shared.NewsletterCampaign("Weekly Digest 2024-W10")
+
+		assertNoError(t, err)
+		want := shared.CampaignLink{Source: "newsletter", Medium: "email", Campaign: "weekly-digest-2024-w10"}
+		if got != want {
+			t.Errorf("got %+v, want %+v", got, want)
+		}
+	})
+
+	t.Run("rejects values without letters or digits", func(t *testing.T) {
+		_, err := shared.SocialCampaign("linkedin", " -- ")
+
+		assertErrorCode(t, err, kernel.EInvalid)
+	})
+}
+
+func TestCampaignLink_Validate(t *testing.T) {
+	tests := []struct {
+		name string
+		link shared.CampaignLink
+	}{
+		{"uppercase", shared.CampaignLink{Source: "LinkedIn", Medium: "social", Campaign: "a1"}},
+		{"missing medium", shared.CampaignLink{Source: "linkedin", Campaign: "a1"}},
+		{"spaces in content", shared.CampaignLink{Source: "linkedin", Medium: "social", Campaign: "a1", Content: "top link"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertErrorCode(t, tt.link.Validate(), kernel.EInvalid)
+		})
+	}
+}
+
+func TestCampaignLink_Apply(t *testing.T) {
+	link, err := shared.SocialCampaign("twitter", "le-passe-compose")
+	assertNoError(t, err)
+
+	tests := []struct {
+		name, url, want string
+		link            shared.CampaignLink
+	}{
+		{
+			name: "appends parameters sorted",
+			url:  "https://fla.example/a1/le-passe-compose",
+			link: link,
+			want: "https://fla.example/a1/le-passe-compose?utm_campaign=le-passe-compose&utm_medium=social&utm_source=twitter",
+		},
+		{
+			name: "replaces earlier UTM parameters and keeps others",
+			url:  "https://fla.example/a1/le-passe-compose?UTM_SOURCE=x&utm_term=old&page=2",
+			link: link.WithContent("Header Button"),
+			want: "https://fla.example/a1/le-passe-compose?page=2&utm_campaign=le-passe-compose&utm_content=header-button&utm_medium=social&utm_source=twitter",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.link.Apply(tt.url)
+
+			assertNoError(t, err)
+			if got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("rejects relative URLs", func(t *testing.T) {
+		_, err := link.Apply("/a1/le-passe-compose")
+
+		assertErrorCode(t, err, kernel.EInvalid)
+	})
+}
@@ -0,0 +1,69 @@
+package shared
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+)
+
+const MCEFRLevelInvalid string = "Invalid CEFR level: %q."
+
+// CEFRLevel is a Common European Framework of Reference proficiency level.
+// Root categories are named after levels, so a post's level is its root category.
+type CEFRLevel string
+
+const (
+	LevelA1 CEFRLevel = "A1" // Breakthrough
+	LevelA2 CEFRLevel = "A2" // Waystage
+	LevelB1 CEFRLevel = "B1" // Threshold
+	LevelB2 CEFRLevel = "B2" // Vantage
+	LevelC1 CEFRLevel = "C1" // Effective operational proficiency
+	LevelC2 CEFRLevel = "C2" // Mastery
+)
+
+// CEFRLevels lists every level from beginner to mastery.
+var CEFRLevels = []CEFRLevel{LevelA1, LevelA2, LevelB1, LevelB2, LevelC1, LevelC2}
+
+// NewCEFRLevel parses a level case-insensitively ("b1" → B1).
+func NewCEFRLevel(level string) (CEFRLevel, error) {
+	const op = "NewCEFRLevel"
+
+	l := CEFRLevel(strings.ToUpper(strings.TrimSpace(level)))
+	if err := l.Validate(); err != nil {
+		return "", &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return l, nil
+}
+
+func (l CEFRLevel) String() string { return string(l) }
+
+// Validate ensures the level is one of the six CEFR levels.
+func (l CEFRLevel) Validate() error {
+	const op = "CEFRLevel.Validate"
+
+	if !slices.Contains(CEFRLevels, l) {
+		return &kernel.Error{Code: kernel.EInvalid, Message: fmt.Sprintf(MCEFRLevelInvalid, l), Operation: op}
+	}
+
+	return nil
+}
+
+// Rank returns the level position from 1 (A1) to 6 (C2), or 0 if invalid.
+func (l CEFRLevel) Rank() int {
+	return slices.Index(CEFRLevels, l) + 1
+}
+
+// Distance returns how many levels separate two levels, or -1 if either is invalid.
+func (l CEFRLevel) Distance(other CEFRLevel) int {
+	a, b := l.Rank(), other.Rank()
+	if a == 0 || b == 0 {
+		return -1
+	}
+	if a > b {
+		return a - b
+	}
+	return b - a
+}
@@ -0,0 +1,50 @@
+package shared_test
+
+import (
+	"testing"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/shared"
+)
+
+func TestNewCEFRLevel(t *testing.T) {
+	t.Run("parses levels case-insensitively", func(t *testing.T) {
+		got, err := shared.NewCEFRLevel(" b1 ")
+
+		assertNoError(t, err)
+		if got != shared.LevelB1 {
+			t.Errorf("got %q, want %q", got, shared.LevelB1)
+		}
+	})
+
+	t.Run("rejects unknown levels", func(t *testing.T) {
+		for _, input := range []string{"", "A3", "D1", "beginner"} {
+			_, err := shared.NewCEFRLevel(input)
+
+			assertError(t, err)
+			assertErrorCode(t, err, kernel.EInvalid)
+		}
+	})
+}
+
+func TestCEFRLevel_Distance(t *testing.T) {
+	tests := []struct {
+		a, b shared.CEFRLevel
+		want int
+	}{
+		{shared.LevelA1, shared.LevelA1, 0},
+		{shared.LevelA1, shared.LevelA2, 1},
+		{shared.LevelC2, shared.LevelA1, 5},
+		{shared.LevelB1, "Z9", -1},
+	}
+
+	for _, tt := range tests {
+		if got := tt.a.Distance(tt.b); got != tt.want {
+			t.Errorf("%s→%s: got %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+
+	if shared.LevelA1.Rank() != 1 || shared.LevelC2.Rank() != 6 || shared.CEFRLevel("X").Rank() != 0 {
+		t.Error("unexpected ranks")
+	}
+}
@@ -0,0 +1,180 @@
+package shared
+
+import (
+	"fmt"
+	"time"
+
+	"golang.org/x/text/feature/plural"
+)
+
+// dateWords holds how a locale writes dates and durations for readers.
+type dateWords struct {
+	months      [12]string
+	date        func(day int, month string, year int) string
+	clock       string // time.Format layout
+	dateTime    string // Date, then clock
+	readingTime string
+	justNow     string
+	past        string // Duration, e.g. "3 days"
+	future      string
+	units       map[timeUnit]map[plural.Form]string // Count; Other is required
+}
+
+type timeUnit int
+
+const (
+	unitMinute timeUnit = iota
+	unitHour
+	unitDay
+	unitWeek
+	unitMonth
+	unitYear
+)
+
+var dateLocales = map[Locale]dateWords{
+	LocaleEnglishUS: {
+		months: [12]string{
+			"January", "February", "March", "April", "May", "June",
+			"July", "August", "September", "October", "November", "December",
+		},
+		date:        func(day int, month string, year int) string { return fmt.Sprintf("%s %d, %d", month, day, year) },
+		clock:       "3:04 PM",
+		dateTime:    "%s at %s",
+		readingTime: "%d min read",
+		justNow:     "just now",
+		past:        "%s ago",
+		future:      "in %s",
+		units: map[timeUnit]map[plural.Form]string{
+			unitMinute: {plural.One: "%d minute", plural.Other: "%d minutes"},
+			unitHour:   {plural.One: "%d hour", plural.Other: "%d hours"},
+			unitDay:    {plural.One: "%d day", plural.Other: "%d days"},
+			unitWeek:   {plural.One: "%d week", plural.Other: "%d weeks"},
+			unitMonth:  {plural.One: "%d month", plural.Other: "%d months"},
+			unitYear:   {plural.One: "%d year", plural.Other: "%d years"},
+		},
+	},
+	LocaleFrenchFR: {
+		months: [12]string{
+			"janvier", "février", "mars", "avril", "mai", "juin",
+			"juillet", "août", "septembre", "octobre", "novembre", "décembre",
+		},
+		date: func(day int, month string, year int) string {
+			if day == 1 {
+				return fmt.Sprintf("1er %s %d", month, year)
+			}
+			return fmt.Sprintf("%d %s %d", day, month, year)
+		},
+		clock:       "15:04",
+		dateTime:    "%s à %s",
+		readingTime: "%d min de lecture",
+		justNow:     "à l’instant",
+		past:        "il y a %s",
+		future:      "dans %s",
+		units: map[timeUnit]map[plural.Form]string{
+			unitMinute: {plural.One: "%d minute", plural.Other: "%d minutes"},
+			unitHour:   {plural.One: "%d heure", plural.Other: "%d heures"},
+			unitDay:    {plural.One: "%d jour", plural.Other: "%d jours"},
+			unitWeek:   {plural.One: "%d semaine", plural.Other: "%d semaines"},
+			unitMonth:  {plural.Other: "%d mois"},
+			unitYear:   {plural.One: "%d an", plural.Other: "%d ans"},
+		},
+	},
+	LocalePortugueseBR: {
+		months: [12]string{
+			"janeiro", "fevereiro", "março", "abril", "maio", "junho",
+			"julho", "agosto", "setembro", "outubro", "novembro", "dezembro",
+		},
+		date:        func(day int, month string, year int) string { return fmt.Sprintf("%d de %s de %d", day, month, year) },
+		clock:       "15:04",
+		dateTime:    "%s às %s",
+		readingTime: "%d min de leitura",
+		justNow:     "agora mesmo",
+		past:        "há %s",
+		future:      "em %s",
+		units: map[timeUnit]map[plural.Form]string{
+			unitMinute: {plural.One: "%d minuto", plural.Other: "%d minutos"},
+			unitHour:   {plural.One: "%d hora", plural.Other: "%d horas"},
+			unitDay:    {plural.One: "%d dia", plural.Other: "%d dias"},
+			unitWeek:   {plural.One: "%d semana", plural.Other: "%d semanas"},
+			unitMonth:  {plural.One: "%d mês", plural.Other: "%d meses"},
+			unitYear:   {plural.One: "%d ano", plural.Other: "%d anos"},
+		},
+	},
+}
+
+// FormatDate renders the day t falls on in the time zone, as readers of the
+// locale write it: "January 15, 2024", "15 janvier 2024", "15 de janeiro de 2024".
+func FormatDate(t time.Time, locale Locale, tz TimeZone) string {
+	words := wordsFor(locale)
+	local := tz.In(t)
+	return words.date(local.Day(), words.months[local.Month()-1], local.Year())
+}
+
+// FormatDateTime renders the date and clock time of t in the time zone, e.g.
+// "January 15, 2024 at 9:00 AM" or "15 janvier 2024 à 09:00".
+func FormatDateTime(t time.Time, locale Locale, tz TimeZone) string {
+	words := wordsFor(locale)
+	return fmt.Sprintf(words.dateTime, FormatDate(t, locale, tz), tz.In(t).Format(words.clock))
+}
+
+// FormatReadingTime renders an estimated reading time, at least one minute:
+// "5 min read", "5 min de lecture", "5 min de leitura".
+func FormatReadingTime(minutes int, locale Locale) string {
+	return fmt.Sprintf(wordsFor(locale).readingTime, max(minutes, 1))
+}
+
+// FormatRelativeTime renders how long before or after now t is, in the
+// largest whole unit: "3 days ago", "il y a 3 jours", "há 3 dias", or "in 2
+// hours" for future times. Less than a minute apart reads as "just now".
+// Months count 30 days and years 365, which is close enough to read.
+func FormatRelativeTime(t, now time.Time, locale Locale) string {
+	words := wordsFor(locale)
+
+	elapsed := now.Sub(t)
+	format := words.past
+	if elapsed < 0 {
+		elapsed, format = -elapsed, words.future
+	}
+
+	var unit timeUnit
+	var n int
+	switch days := int(elapsed / (24 * time.Hour)); {
+	case elapsed < time.Minute:
+		return words.justNow
+	case elapsed < time.Hour:
+		unit, n = unitMinute, int(elapsed/time.Minute)
+	case days < 1:
+		unit, n = unitHour, int(elapsed/time.Hour)
+	case days < 7:
+		unit, n = unitDay, days
+	case days < 30:
+		unit, n = unitWeek, days/7
+	case days < 365:
+		unit, n = unitMonth, days/30
+	default:
+		unit, n = unitYear, days/365
+	}
+
+	forms := words.units[unit]
+	text, ok := forms[pluralFormOf(locale, n)]
+	if !ok {
+		text = forms[plural.Other]
+	}
+
+	return fmt.Sprintf(format, fmt.Sprintf(text, n))
+}
+
+// wordsFor returns the locale's date words, or the default locale's for
+// unsupported locales.
+func wordsFor(locale Locale) dateWords {
+	return dateLocales[locale.GetEffectiveLocale()]
+}
+
+// pluralFormOf returns the CLDR plural category of a count in the locale.
+func pluralFormOf(locale Locale, n int) plural.Form {
+	tag, err := locale.GetEffectiveLocale().ToLanguageTag()
+	if err != nil {
+		return plural.Other
+	}
+	return plural.Cardinal.MatchPlural(tag, n, 0, 0, 0, 0)
+}
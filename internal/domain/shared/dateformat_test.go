@@ -0,0 +1,99 @@
+package shared_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/shared"
+)
+
+func TestFormatDate(t *testing.T) {
+	// 23:30 UTC on January 14 is already January 15 in Paris
+	at := time.Date(2024, 1, 14, 23, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		locale shared.Locale
+		tz     shared.TimeZone
+		want   string
+	}{
+		{shared.LocaleEnglishUS, "UTC", "January 14, 2024"},
+		{shared.LocaleEnglishUS, "Europe/Paris", "January 15, 2024"},
+		{shared.LocaleFrenchFR, "Europe/Paris", "15 janvier 2024"},
+		{shared.LocalePortugueseBR, "America/Sao_Paulo", "14 de janeiro de 2024"},
+		{"de-DE", "", "January 14, 2024"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.locale.String()+" "+tt.tz.String(), func(t *testing.T) {
+			if got := shared.FormatDate(at, tt.locale, tt.tz); got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("french first of the month", func(t *testing.T) {
+		got := shared.FormatDate(time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC), shared.LocaleFrenchFR, "Europe/Paris")
+		if got != "1er mai 2024" {
+			t.Errorf("got %q", got)
+		}
+	})
+}
+
+func TestFormatDateTime(t *testing.T) {
+	at := time.Date(2024, 7, 15, 7, 5, 0, 0, time.UTC)
+
+	tests := map[shared.Locale]string{
+		shared.LocaleEnglishUS:    "July 15, 2024 at 9:05 AM",
+		shared.LocaleFrenchFR:     "15 juillet 2024 à 09:05",
+		shared.LocalePortugueseBR: "15 de julho de 2024 às 09:05",
+	}
+
+	for locale, want := range tests {
+		if got := shared.FormatDateTime(at, locale, "Europe/Paris"); got != want {
+			t.Errorf("%s: got %q, want %q", locale, got, want)
+		}
+	}
+}
+
+func TestFormatReadingTime(t *testing.T) {
+	if got := shared.FormatReadingTime(5, shared.LocaleFrenchFR); got != "5 min de lecture" {
+		t.Errorf("got %q", got)
+	}
+	if got := shared.FormatReadingTime(0, shared.LocaleEnglishUS); got != "1 min read" {
+		t.Errorf("short posts: got %q", got)
+	}
+}
+
+func TestFormatRelativeTime(t *testing.T) {
+	now := time.Date(2024, 6, 15, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		t      time.Time
+		locale shared.Locale
+		want   string
+	}{
+		{"just now", now.Add(-30 * time.Second), shared.LocaleEnglishUS, "just now"},
+		{"one minute", now.Add(-time.Minute), shared.LocaleEnglishUS, "1 minute ago"},
+		{"hours", now.Add(-5 * time.Hour), shared.LocaleFrenchFR, "il y a 5 heures"},
+		{"days in english", now.AddDate(0, 0, -3), shared.LocaleEnglishUS, "3 days ago"},
+		{"days in french", now.AddDate(0, 0, -3), shared.LocaleFrenchFR, "il y a 3 jours"},
+		{"days in portuguese", now.AddDate(0, 0, -3), shared.LocalePortugueseBR, "há 3 dias"},
+		{"one day in french", now.AddDate(0, 0, -1), shared.LocaleFrenchFR, "il y a 1 jour"},
+		{"weeks", now.AddDate(0, 0, -15), shared.LocalePortugueseBR, "há 2 semanas"},
+		{"invariable french months", now.AddDate(0, -1, 0), shared.LocaleFrenchFR, "il y a 1 mois"},
+		{"months", now.AddDate(0, 0, -95), shared.LocalePortugueseBR, "há 3 meses"},
+		{"years", now.AddDate(-2, 0, 0), shared.LocaleFrenchFR, "il y a 2 ans"},
+		{"future", now.Add(2 * time.Hour), shared.LocaleEnglishUS, "in 2 hours"},
+		{"future in french", now.AddDate(0, 0, 1), shared.LocaleFrenchFR, "dans 1 jour"},
+		{"unsupported locale", now.AddDate(0, 0, -3), "de-DE", "3 days ago"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := shared.FormatRelativeTime(tt.t, now, tt.locale); got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package shared
 
 import (
 	"regexp"
+	"slices"
 	"strings"
 
 	"github.com/alnah/fla/internal/domain/kernel"
@@ -13,6 +14,11 @@ const (
 	MEmailFormatInvalid string = "Invalid email format."
 )
 
+// EmailPattern is the format every email must match. It is more comprehensive
+// while still being readable, and handles most common formats of RFC 5322.
+const EmailPattern string = `^[a-zA-Z0-9!#$%&'*+/=?^_` + "`" + `{|}~-]+(?:\.[a-zA-Z0-9!#$%&'*+/=?^_` + "`" +
+	`{|}~-]+)*@(?:[a-zA-Z0-9](?:[a-zA-Z0-9-]*[a-zA-Z0-9])?\.)+[a-zA-Z0-9](?:[a-zA-Z0-9-]*[a-zA-Z0-9])?$`
+
 // Email represents validated email addresses for user communication.
 // Ensures deliverable addresses for notifications and account management.
 type Email string
@@ -32,6 +38,53 @@ func NewEmail(email string) (Email, error) {
 
 func (e Email) String() string { return string(e) }
 
+// LocalPart returns the part before the @, or "" when there is none.
+func (e Email) LocalPart() string {
+	i := strings.LastIndexByte(e.String(), '@')
+	if i < 0 {
+		return ""
+	}
+	return e.String()[:i]
+}
+
+// Domain returns the part after the @ as written, or "" when there is none.
+func (e Email) Domain() string {
+	i := strings.LastIndexByte(e.String(), '@')
+	if i < 0 {
+		return ""
+	}
+	return e.String()[i+1:]
+}
+
+// Normalize returns the address with its domain lowercased. Domains are case
+// insensitive; local parts may not be, so theirs is kept as typed.
+func (e Email) Normalize() Email {
+	if e.Domain() == "" {
+		return e
+	}
+	return Email(e.LocalPart() + "@" + strings.ToLower(e.Domain()))
+}
+
+// gmailDomains deliver to the same inbox whatever dots or +tags the local part has.
+var gmailDomains = []string{"gmail.com", "googlemail.com"}
+
+// Canonical returns the key under which addresses reaching the same inbox compare
+// equal: lowercased, and for Gmail without dots or +tag in the local part.
+// Use it to detect duplicates, never as the address to send to.
+func (e Email) Canonical() Email {
+	local, domain := strings.ToLower(e.LocalPart()), strings.ToLower(e.Domain())
+	if domain == "" {
+		return Email(strings.ToLower(e.String()))
+	}
+
+	if slices.Contains(gmailDomains, domain) {
+		local, _, _ = strings.Cut(local, "+")
+		local = strings.ReplaceAll(local, ".", "")
+		domain = gmailDomains[0]
+	}
+	return Email(local + "@" + domain)
+}
+
 // Validate ensures email meets RFC standards for reliable delivery.
 // Prevents communication failures due to malformed addresses.
 func (e Email) Validate() error {
@@ -66,12 +119,7 @@ func (e Email) validatePresence() error {
 func (e Email) validateFormat() error {
 	const op = "Email.validateFormat"
 
-	// This pattern is more comprehensive while still being readable
-	// It handles most common email formats according to RFC 5322
-	pattern := `^[a-zA-Z0-9!#$%&'*+/=?^_` + "`" + `{|}~-]+(?:\.[a-zA-Z0-9!#$%&'*+/=?^_` + "`" +
-		`{|}~-]+)*@(?:[a-zA-Z0-9](?:[a-zA-Z0-9-]*[a-zA-Z0-9])?\.)+[a-zA-Z0-9](?:[a-zA-Z0-9-]*[a-zA-Z0-9])?$`
-
-	matched, err := regexp.MatchString(pattern, e.String())
+	matched, err := regexp.MatchString(EmailPattern, e.String())
 	if err != nil {
 		return &kernel.Error{
 			Code:      kernel.EInternal,
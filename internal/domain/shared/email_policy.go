@@ -0,0 +1,60 @@
+package shared
+
+import (
+	"slices"
+	"strings"
+)
+
+// EmailVerdict is what a DomainPolicy says about an address.
+type EmailVerdict struct {
+	Disposable bool // Throwaway inbox: mail to it goes unread, then bounces
+	Role       bool // Shared mailbox such as info@ or admin@, more prone to complaints
+}
+
+// DomainPolicy judges addresses before they join a mailing list. Implementations
+// may keep static lists or query a reputation service.
+type DomainPolicy interface {
+	// AssessEmail returns the verdict on an address. Errors mean the policy
+	// could not decide, not that the address is bad.
+	AssessEmail(email Email) (EmailVerdict, error)
+}
+
+// DefaultDisposableDomains are widely used throwaway inbox services.
+var DefaultDisposableDomains = []string{
+	"10minutemail.com", "dispostable.com", "guerrillamail.com", "mailinator.com",
+	"maildrop.cc", "sharklasers.com", "temp-mail.org", "tempmail.com",
+	"throwawaymail.com", "trashmail.com", "yopmail.com",
+}
+
+// DefaultRoleLocalParts are local parts of mailboxes shared by a team.
+var DefaultRoleLocalParts = []string{
+	"abuse", "admin", "contact", "help", "hello", "info", "marketing",
+	"noreply", "no-reply", "postmaster", "sales", "support", "webmaster",
+}
+
+// ListDomainPolicy judges addresses against fixed lists. Entries are lowercase;
+// a disposable domain also covers its subdomains.
+type ListDomainPolicy struct {
+	DisposableDomains []string
+	RoleLocalParts    []string
+}
+
+// DefaultDomainPolicy returns a list policy with the default lists.
+func DefaultDomainPolicy() ListDomainPolicy {
+	return ListDomainPolicy{
+		DisposableDomains: slices.Clone(DefaultDisposableDomains),
+		RoleLocalParts:    slices.Clone(DefaultRoleLocalParts),
+	}
+}
+
+// AssessEmail checks the domain and local part against the lists. Never fails.
+func (p ListDomainPolicy) AssessEmail(email Email) (EmailVerdict, error) {
+	canonical := email.Canonical()
+	domain, local := canonical.Domain(), canonical.LocalPart()
+	local, _, _ = strings.Cut(local, "+")
+
+	disposable := slices.ContainsFunc(p.DisposableDomains, func(d string) bool {
+		return domain == d || strings.HasSuffix(domain, "."+d)
+	})
+	return EmailVerdict{Disposable: disposable, Role: slices.Contains(p.RoleLocalParts, local)}, nil
+}
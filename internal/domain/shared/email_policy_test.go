@@ -0,0 +1,58 @@
+package shared_test
+
+import (
+	"testing"
+
+	"github.com/alnah/fla/internal/domain/shared"
+)
+
+func TestEmail_Normalization(t *testing.T) {
+	tests := []struct {
+		email     shared.Email
+		normal    shared.Email
+		canonical shared.Email
+	}{
+		{"Marie.Dupont@Example.FR", "Marie.Dupont@example.fr", "marie.dupont@example.fr"},
+		{"Marie.Dupont+fla@GMail.com", "Marie.Dupont+fla@gmail.com", "mariedupont@gmail.com"},
+		{"m.dupont@googlemail.com", "m.dupont@googlemail.com", "mdupont@gmail.com"},
+		{"tom+news@example.com", "tom+news@example.com", "tom+news@example.com"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.email.String(), func(t *testing.T) {
+			if got := tt.email.Normalize(); got != tt.normal {
+				t.Errorf("normalize: got %q, want %q", got, tt.normal)
+			}
+			if got := tt.email.Canonical(); got != tt.canonical {
+				t.Errorf("canonical: got %q, want %q", got, tt.canonical)
+			}
+		})
+	}
+}
+
+func TestListDomainPolicy_AssessEmail(t *testing.T) {
+	policy := shared.DefaultDomainPolicy()
+
+	tests := []struct {
+		email shared.Email
+		want  shared.EmailVerdict
+	}{
+		{"marie@example.fr", shared.EmailVerdict{}},
+		{"marie@YOPmail.com", shared.EmailVerdict{Disposable: true}},
+		{"marie@eu.mailinator.com", shared.EmailVerdict{Disposable: true}},
+		{"marie@notmailinator.com", shared.EmailVerdict{}},
+		{"Contact@ecole.fr", shared.EmailVerdict{Role: true}},
+		{"info+newsletter@ecole.fr", shared.EmailVerdict{Role: true}},
+		{"admin@trashmail.com", shared.EmailVerdict{Disposable: true, Role: true}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.email.String(), func(t *testing.T) {
+			got, err := policy.AssessEmail(tt.email)
+			assertNoError(t, err)
+			if got != tt.want {
+				t.Errorf("got %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -28,3 +28,11 @@ func assertErrorCode(t *testing.T, err error, want string) {
 		t.Errorf("error code: got %q, want %q", got, want)
 	}
 }
+
+func assertErrorMessage(t *testing.T, err error, want string) {
+	t.Helper()
+	got := kernel.ErrorMessage(err)
+	if got != want {
+		t.Errorf("error message: got %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,45 @@
+package shared
+
+import (
+	"golang.org/x/text/language"
+)
+
+// localeMatcher matches language priority lists against SupportedLocales,
+// with DefaultLocale first since the matcher falls back to its first tag.
+var localeMatcher, matchedLocales = newLocaleMatcher()
+
+func newLocaleMatcher() (language.Matcher, []Locale) {
+	locales := []Locale{DefaultLocale}
+	for _, l := range SupportedLocales {
+		if l != DefaultLocale {
+			locales = append(locales, l)
+		}
+	}
+
+	tags := make([]language.Tag, len(locales))
+	for i, l := range locales {
+		tags[i] = language.MustParse(string(l))
+	}
+
+	return language.NewMatcher(tags), locales
+}
+
+// NegotiateLocale returns the supported locale that best fits an
+// Accept-Language header (an RFC 4647 language priority list), for anonymous
+// visitors who have no locale preference yet. Languages are tried by
+// decreasing quality, and a supported locale of the same language stands in
+// for another region: fr-CA gets fr-FR, pt-PT gets pt-BR. Empty or malformed
+// headers, wildcards, and unsupported languages get DefaultLocale.
+func NegotiateLocale(acceptLanguage string) Locale {
+	desired, _, err := language.ParseAcceptLanguage(acceptLanguage)
+	if err != nil || len(desired) == 0 {
+		return DefaultLocale
+	}
+
+	_, index, confidence := localeMatcher.Match(desired...)
+	if confidence == language.No {
+		return DefaultLocale
+	}
+
+	return matchedLocales[index]
+}
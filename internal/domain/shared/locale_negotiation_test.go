@@ -0,0 +1,37 @@
+package shared_test
+
+import (
+	"testing"
+
+	"github.com/alnah/fla/internal/domain/shared"
+)
+
+func TestNegotiateLocale(t *testing.T) {
+	tests := []struct {
+		name   string
+		header string
+		want   shared.Locale
+	}{
+		{"exact match", "pt-BR", shared.LocalePortugueseBR},
+		{"language only", "fr", shared.LocaleFrenchFR},
+		{"region fallback", "fr-CA", shared.LocaleFrenchFR},
+		{"other region of the default language", "en-GB", shared.LocaleEnglishUS},
+		{"portuguese from portugal", "pt-PT,pt;q=0.9", shared.LocalePortugueseBR},
+		{"browser list", "fr-CA,fr;q=0.9,en-US;q=0.8,en;q=0.7", shared.LocaleFrenchFR},
+		{"quality over order", "en;q=0.1, fr-BE;q=0.9", shared.LocaleFrenchFR},
+		{"skips unsupported languages", "de-DE, de;q=0.9, pt;q=0.5", shared.LocalePortugueseBR},
+		{"refused language", "fr;q=0, pt", shared.LocalePortugueseBR},
+		{"unsupported only", "es-ES, es;q=0.9", shared.DefaultLocale},
+		{"wildcard", "*", shared.DefaultLocale},
+		{"empty header", "", shared.DefaultLocale},
+		{"malformed header", "fr;;q=x", shared.DefaultLocale},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := shared.NegotiateLocale(tt.header); got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
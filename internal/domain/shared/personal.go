@@ -16,6 +16,9 @@ const (
 	MinUserNameLength  = 3
 )
 
+// UsernamePattern is the format every username must match.
+const UsernamePattern string = `^[a-zA-Z0-9_-]+$`
+
 const (
 	MUsernameInvalidChars string = "Username can only contain letters, numbers, underscores, and hyphens."
 )
@@ -124,8 +127,7 @@ func (u Username) Validate() error {
 func (u Username) validateCharacters() error {
 	const op = "Username.validateCharacters"
 
-	pattern := `^[a-zA-Z0-9_-]+$`
-	matched, _ := regexp.MatchString(pattern, u.String())
+	matched, _ := regexp.MatchString(UsernamePattern, u.String())
 	if !matched {
 		return &kernel.Error{
 			Code:      kernel.EInvalid,
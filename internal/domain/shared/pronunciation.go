@@ -0,0 +1,168 @@
+package shared
+
+import (
+	"fmt"
+	"html"
+	"strings"
+	"unicode"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+)
+
+const (
+	MaxPronunciationTermLength int = 100
+	MaxIPALength               int = 200
+
+	MIPAInvalidCharacter string = "IPA transcription contains %q, which is not an IPA symbol."
+)
+
+// IPA is a transcription in the International Phonetic Alphabet, stored without
+// the slashes or brackets that frame it in print, e.g. "bɔ̃ʒuʁ".
+type IPA string
+
+// NewIPA creates a validated transcription; surrounding slashes or brackets are removed.
+func NewIPA(transcription string) (IPA, error) {
+	const op = "NewIPA"
+
+	t := strings.TrimSpace(transcription)
+	t = strings.TrimSpace(strings.Trim(t, "/[]"))
+
+	ipa := IPA(t)
+	if err := ipa.Validate(); err != nil {
+		return "", &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return ipa, nil
+}
+
+func (i IPA) String() string { return string(i) }
+
+// Phonemic frames the transcription in slashes, as dictionaries print it: /bɔ̃ʒuʁ/.
+func (i IPA) Phonemic() string { return "/" + i.String() + "/" }
+
+// Phonetic frames the transcription in brackets, for narrow transcriptions: [bɔ̃ʒuʁ].
+func (i IPA) Phonetic() string { return "[" + i.String() + "]" }
+
+// Validate ensures the transcription is present, bounded, and made of IPA symbols only.
+func (i IPA) Validate() error {
+	const op = "IPA.Validate"
+
+	if err := kernel.ValidateLength("IPA", i.String(), 1, MaxIPALength, op); err != nil {
+		return err
+	}
+
+	for _, r := range i.String() {
+		if !isIPARune(r) {
+			return &kernel.Error{Code: kernel.EInvalid, Message: fmt.Sprintf(MIPAInvalidCharacter, r), Operation: op}
+		}
+	}
+
+	return nil
+}
+
+// ipaSymbols are the symbols outside the IPA blocks that transcriptions use:
+// Latin letters borrowed as vowels and consonants, Greek letters, stress, length,
+// syllable and liaison marks, and prosodic bars.
+const ipaSymbols = "æçðøħŋœɶβθχ.ˈˌːˑ‿|‖↗↘ "
+
+// isIPARune accepts lowercase Latin letters, the IPA Extensions, spacing modifier
+// letters, combining diacritics, phonetic extensions, and ipaSymbols. Uppercase
+// letters, digits, and punctuation other than the marks above are not IPA.
+func isIPARune(r rune) bool {
+	switch {
+	case r >= 'a' && r <= 'z':
+		return true
+	case r >= 0x0250 && r <= 0x02FF: // IPA Extensions, Spacing Modifier Letters
+		return true
+	case unicode.Is(unicode.Mn, r) && r >= 0x0300 && r <= 0x036F: // Combining diacritics: nasal tilde, syllabic mark
+		return true
+	case r >= 0x1D00 && r <= 0x1DBF: // Phonetic Extensions
+		return true
+	default:
+		return strings.ContainsRune(ipaSymbols, r)
+	}
+}
+
+// Pronunciation tells how a term sounds: its IPA transcription and, optionally,
+// a recording. Attached to posts and glossary terms.
+type Pronunciation struct {
+	Term   string
+	IPA    IPA
+	Audio  kernel.URL[PronunciationAudio] // Optional recording
+	Locale Locale                         // Language the term is in, e.g. fr-FR
+}
+
+// PronunciationAudio marks URLs of pronunciation recordings.
+type PronunciationAudio struct{}
+
+// NewPronunciation creates a validated pronunciation. An empty audio URL means none.
+func NewPronunciation(term, transcription, audio string, locale Locale) (Pronunciation, error) {
+	const op = "NewPronunciation"
+
+	ipa, err := NewIPA(transcription)
+	if err != nil {
+		return Pronunciation{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	p := Pronunciation{
+		Term:   strings.TrimSpace(term),
+		IPA:    ipa,
+		Audio:  kernel.URL[PronunciationAudio](strings.TrimSpace(audio)),
+		Locale: locale,
+	}
+	if err := p.Validate(); err != nil {
+		return Pronunciation{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return p, nil
+}
+
+// Validate ensures the term, transcription, audio URL, and locale are valid.
+func (p Pronunciation) Validate() error {
+	const op = "Pronunciation.Validate"
+
+	if err := kernel.ValidateLength("term", p.Term, 1, MaxPronunciationTermLength, op); err != nil {
+		return err
+	}
+
+	validators := []func() error{
+		p.IPA.Validate,
+		p.Audio.Validate,
+		p.Locale.Validate,
+	}
+	for _, validate := range validators {
+		if err := validate(); err != nil {
+			return &kernel.Error{Operation: op, Cause: err}
+		}
+	}
+
+	return nil
+}
+
+// HasAudio reports whether a recording comes with the transcription.
+func (p Pronunciation) HasAudio() bool {
+	return p.Audio != ""
+}
+
+// String renders the term with its transcription, e.g. "bonjour /bɔ̃ʒuʁ/".
+func (p Pronunciation) String() string {
+	return p.Term + " " + p.IPA.Phonemic()
+}
+
+// HTML renders the pronunciation as an inline fragment with every value escaped.
+// The transcription is tagged with the locale's IPA variant so screen readers do
+// not read it as the locale's spelling; a recording adds an audio player.
+func (p Pronunciation) HTML() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, `<span class="pronunciation"><span lang="%s">%s</span> <span class="ipa" lang="%s-fonipa">%s</span>`,
+		html.EscapeString(p.Locale.String()),
+		html.EscapeString(p.Term),
+		html.EscapeString(p.Locale.ToISO639Language()),
+		html.EscapeString(p.IPA.Phonemic()),
+	)
+	if p.HasAudio() {
+		fmt.Fprintf(&b, ` <audio controls preload="none" src="%s"></audio>`, html.EscapeString(p.Audio.String()))
+	}
+	b.WriteString(`</span>`)
+	return b.String()
+}
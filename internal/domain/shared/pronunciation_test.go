@@ -0,0 +1,78 @@
+package shared_test
+
+import (
+	"testing"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/shared"
+)
+
+func TestNewIPA(t *testing.T) {
+	t.Run("accepts IPA and strips its frame", func(t *testing.T) {
+		tests := []struct {
+			input, want string
+		}{
+			{input: "/bɔ̃ʒuʁ/", want: "bɔ̃ʒuʁ"},
+			{input: "[ʃa.to]", want: "ʃa.to"},
+			{input: "lez‿ɑ̃.fɑ̃", want: "lez‿ɑ̃.fɑ̃"},
+			{input: "ˈθɪŋk", want: "ˈθɪŋk"},
+			{input: "kœʁ", want: "kœʁ"},
+			{input: "ʁəɡaʁde ʁe.ɡaʁ", want: "ʁəɡaʁde ʁe.ɡaʁ"},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.input, func(t *testing.T) {
+				got, err := shared.NewIPA(tt.input)
+
+				assertNoError(t, err)
+				if got.String() != tt.want {
+					t.Errorf("got %q, want %q", got, tt.want)
+				}
+			})
+		}
+	})
+
+	t.Run("rejects what is not IPA", func(t *testing.T) {
+		for _, input := range []string{"", "//", "Bonjour", "bon3ur", "bɔ̃ʒuʁ!", "ʃ/a"} {
+			t.Run(input, func(t *testing.T) {
+				_, err := shared.NewIPA(input)
+
+				assertErrorCode(t, err, kernel.EInvalid)
+			})
+		}
+	})
+}
+
+func TestPronunciation(t *testing.T) {
+	t.Run("renders text and HTML", func(t *testing.T) {
+		p, err := shared.NewPronunciation("bonjour", "/bɔ̃ʒuʁ/", "https://cdn.example.com/bonjour.mp3", shared.LocaleFrenchFR)
+		assertNoError(t, err)
+
+		if got := p.String(); got != "bonjour /bɔ̃ʒuʁ/" {
+			t.Errorf("got %q", got)
+		}
+		want := `<span class="pronunciation"><span lang="fr-FR">bonjour</span> <span class="ipa" lang="fr-fonipa">/bɔ̃ʒuʁ/</span>` +
+			` <audio controls preload="none" src="https://cdn.example.com/bonjour.mp3"></audio></span>`
+		if got := p.HTML(); got != want {
+			t.Errorf("got  %s\nwant %s", got, want)
+		}
+	})
+
+	t.Run("escapes the term", func(t *testing.T) {
+		p, err := shared.NewPronunciation("<b>chat</b>", "ʃa", "", shared.LocaleFrenchFR)
+		assertNoError(t, err)
+
+		want := `<span class="pronunciation"><span lang="fr-FR">&lt;b&gt;chat&lt;/b&gt;</span> <span class="ipa" lang="fr-fonipa">/ʃa/</span></span>`
+		if got := p.HTML(); got != want {
+			t.Errorf("got  %s\nwant %s", got, want)
+		}
+	})
+
+	t.Run("rejects invalid audio and locales", func(t *testing.T) {
+		_, err := shared.NewPronunciation("chat", "ʃa", "ftp://cdn.example.com/chat.mp3", shared.LocaleFrenchFR)
+		assertErrorCode(t, err, kernel.EInvalid)
+
+		_, err = shared.NewPronunciation("chat", "ʃa", "", "de-DE")
+		assertErrorCode(t, err, kernel.EInvalid)
+	})
+}
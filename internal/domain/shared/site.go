@@ -0,0 +1,78 @@
+package shared
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+)
+
+const MSiteBaseURLMissing string = "Missing site base URL."
+
+// Site describes the public website serving the content.
+// Used to build absolute URLs for feeds, embeds, structured data, and emails.
+type Site struct {
+	Name    string
+	BaseURL kernel.URL[Site] // Absolute URL without trailing slash, e.g. "https://fla.example"
+	Locale  Locale           // Primary content language
+}
+
+// NewSite creates validated site metadata with a normalized base URL.
+// Strips trailing slashes so path joining never produces double slashes.
+func NewSite(name, baseURL string, locale Locale) (Site, error) {
+	const op = "NewSite"
+
+	if locale == "" {
+		locale = DefaultLocale
+	}
+
+	s := Site{
+		Name:    strings.TrimSpace(name),
+		BaseURL: kernel.URL[Site](strings.TrimRight(strings.TrimSpace(baseURL), "/")),
+		Locale:  locale,
+	}
+
+	if err := s.Validate(); err != nil {
+		return Site{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return s, nil
+}
+
+// Validate ensures the site can produce absolute links.
+func (s Site) Validate() error {
+	const op = "Site.Validate"
+
+	if err := kernel.ValidatePresence("site name", s.Name, op); err != nil {
+		return err
+	}
+
+	if s.BaseURL == "" {
+		return &kernel.Error{Code: kernel.EInvalid, Message: MSiteBaseURLMissing, Operation: op}
+	}
+
+	if err := s.BaseURL.Validate(); err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if err := s.Locale.Validate(); err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return nil
+}
+
+// URL joins a site-relative path to the base URL.
+// Accepts paths with or without a leading slash.
+func (s Site) URL(path string) string {
+	path = strings.TrimLeft(path, "/")
+	if path == "" {
+		return s.BaseURL.String() + "/"
+	}
+	return s.BaseURL.String() + "/" + path
+}
+
+// String returns a string representation of the site.
+func (s Site) String() string {
+	return fmt.Sprintf("Site{Name: %q, BaseURL: %q, Locale: %q}", s.Name, s.BaseURL, s.Locale)
+}
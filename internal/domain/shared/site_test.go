@@ -0,0 +1,65 @@
+package shared_test
+
+import (
+	"testing"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/shared"
+)
+
+func TestNewSite(t *testing.T) {
+	t.Run("creates site and normalizes base URL", func(t *testing.T) {
+		got, err := shared.NewSite(" FLA ", "https://fla.example/ ", "")
+
+		assertNoError(t, err)
+		if got.Name != "FLA" {
+			t.Errorf("Name: got %q", got.Name)
+		}
+		if got.BaseURL != "https://fla.example" {
+			t.Errorf("BaseURL: got %q", got.BaseURL)
+		}
+		if got.Locale != shared.DefaultLocale {
+			t.Errorf("Locale: got %q", got.Locale)
+		}
+	})
+
+	t.Run("rejects invalid site", func(t *testing.T) {
+		tests := []struct {
+			name    string
+			site    string
+			baseURL string
+			locale  shared.Locale
+		}{
+			{name: "missing name", baseURL: "https://fla.example"},
+			{name: "missing base URL", site: "FLA"},
+			{name: "insecure scheme", site: "FLA", baseURL: "ftp://fla.example"},
+			{name: "unsupported locale", site: "FLA", baseURL: "https://fla.example", locale: "de-DE"},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				_, err := shared.NewSite(tt.site, tt.baseURL, tt.locale)
+
+				assertError(t, err)
+				assertErrorCode(t, err, kernel.EInvalid)
+			})
+		}
+	})
+}
+
+func TestSite_URL(t *testing.T) {
+	site, _ := shared.NewSite("FLA", "https://fla.example", shared.LocaleFrenchFR)
+
+	tests := map[string]string{
+		"":                    "https://fla.example/",
+		"/":                   "https://fla.example/",
+		"a1/sports":           "https://fla.example/a1/sports",
+		"/a1/sports/football": "https://fla.example/a1/sports/football",
+	}
+
+	for path, want := range tests {
+		if got := site.URL(path); got != want {
+			t.Errorf("URL(%q): got %q, want %q", path, got, want)
+		}
+	}
+}
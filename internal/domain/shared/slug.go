@@ -15,6 +15,9 @@ import (
 
 const MaxSlugLength int = MaxTitleLength + 10
 
+// SlugPattern is the format every slug must match: lowercase words joined by single hyphens.
+const SlugPattern string = `^[a-z0-9]+(?:-[a-z0-9]+)*$`
+
 const (
 	MSlugInvalidChars string = "Slug contains invalid characters."
 	MSlugGeneration   string = "Slug could not be generated."
@@ -161,7 +164,25 @@ func transliterate(s string) string {
 // generateSlug transforms text into URL-safe format with international support.
 // Handles accents, special characters, and length constraints automatically.
 func generateSlug(input string) (string, error) {
-	const op = "generateSlug"
+	s, err := slugify(input, transliterate)
+	if err != nil {
+		return "", err
+	}
+
+	// Enforce MaxSlugLength without breaking runes; trim trailing hyphens again
+	if utf8.RuneCountInString(s) > MaxSlugLength {
+		r := []rune(s)
+		s = string(r[:MaxSlugLength])
+		s = strings.TrimRight(s, "-")
+	}
+
+	return s, nil
+}
+
+// slugify lowercases input to hyphenated ASCII words, using the given
+// transliteration before stripping the remaining accents.
+func slugify(input string, transliterate func(string) string) (string, error) {
+	const op = "slugify"
 
 	// Trim whitespace first
 	input = strings.TrimSpace(input)
@@ -207,13 +228,6 @@ func generateSlug(input string) (string, error) {
 		}
 	}
 
-	// Enforce MaxSlugLength without breaking runes; trim trailing hyphens again
-	if utf8.RuneCountInString(s) > MaxSlugLength {
-		r := []rune(s)
-		s = string(r[:MaxSlugLength])
-		s = strings.TrimRight(s, "-")
-	}
-
 	return s, nil
 }
 
@@ -229,7 +243,7 @@ func containsAlphanumeric(s string) bool {
 
 // Precompile the accent‐removal transformer and non-alphanumeric regex.
 var (
-	slugFormatRe  = regexp.MustCompile(`^[a-z0-9]+(?:-[a-z0-9]+)*$`)
+	slugFormatRe  = regexp.MustCompile(SlugPattern)
 	accentRemover = transform.Chain(
 		norm.NFD,
 		runes.Remove(runes.In(unicode.Mn)),
@@ -0,0 +1,162 @@
+package shared
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"golang.org/x/text/language"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+)
+
+const (
+	MSlugReserved        string = "Slug %s is reserved."
+	MSlugMaxLengthBounds string = "Slug max length must be between 1 and %d."
+)
+
+// DefaultReservedSlugs are the paths the site's own routes take. A post or category
+// with one of these slugs would be shadowed by the route, or shadow it.
+var DefaultReservedSlugs = []Slug{
+	"admin", "api", "feed", "rss", "tags", "categories", "search",
+	"sitemap", "login", "logout", "static", "assets", "s",
+}
+
+// localeTransliterations override the common transliteration for one language,
+// keyed by ISO 639-1 code. Ampersands read as the language's "and".
+var localeTransliterations = map[string]map[rune]string{
+	"de": {
+		'Ä': "AE", 'ä': "ae",
+		'Ö': "OE", 'ö': "oe",
+		'Ü': "UE", 'ü': "ue",
+		'ẞ': "SS", 'ß': "ss",
+		'&': "-und-",
+	},
+	"en": {'&': "-and-"},
+	"es": {'&': "-y-"},
+	"fr": {'&': "-et-"},
+	"pt": {'Ç': "C", 'ç': "c", '&': "-e-"},
+}
+
+// SlugPolicy generates slugs for one locale and guards the site's reserved paths.
+// The zero value transliterates like NewSlug and reserves nothing.
+type SlugPolicy struct {
+	Locale    Locale // Language whose transliteration rules apply; any BCP 47 tag
+	Reserved  []Slug
+	MaxLength int // Slugs longer are cut at a word boundary; 0 means MaxSlugLength
+}
+
+// DefaultSlugPolicy returns the policy for a locale, with the default reserved slugs.
+func DefaultSlugPolicy(locale Locale) SlugPolicy {
+	return SlugPolicy{Locale: locale, Reserved: slices.Clone(DefaultReservedSlugs)}
+}
+
+// Validate ensures the length limit fits slugs and every reserved slug is well formed.
+func (p SlugPolicy) Validate() error {
+	const op = "SlugPolicy.Validate"
+
+	if p.MaxLength < 0 || p.MaxLength > MaxSlugLength {
+		return &kernel.Error{
+			Code:      kernel.EInvalid,
+			Message:   fmt.Sprintf(MSlugMaxLengthBounds, MaxSlugLength),
+			Operation: op,
+		}
+	}
+
+	for _, reserved := range p.Reserved {
+		if err := reserved.Validate(); err != nil {
+			return &kernel.Error{Operation: op, Cause: err}
+		}
+	}
+
+	return nil
+}
+
+// NewSlug generates a slug from input with the locale's transliteration, truncated
+// at a word boundary. Returns EConflict when the result is reserved.
+func (p SlugPolicy) NewSlug(input string) (Slug, error) {
+	const op = "SlugPolicy.NewSlug"
+
+	s, err := slugify(input, p.transliterate)
+	if err != nil {
+		return "", &kernel.Error{Operation: op, Cause: err}
+	}
+
+	slug := Slug(truncateSlug(s, p.maxLength()))
+	if err := p.Check(slug); err != nil {
+		return "", &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return slug, nil
+}
+
+// Check ensures a slug, generated or typed by an editor, is valid, within the
+// length limit, and not reserved.
+func (p SlugPolicy) Check(slug Slug) error {
+	const op = "SlugPolicy.Check"
+
+	if err := slug.Validate(); err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if err := kernel.ValidateMaxLength("slug", slug.String(), p.maxLength(), op); err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if p.IsReserved(slug) {
+		return &kernel.Error{
+			Code:      kernel.EConflict,
+			Message:   fmt.Sprintf(MSlugReserved, slug),
+			Operation: op,
+		}
+	}
+
+	return nil
+}
+
+// IsReserved reports whether the slug is one of the policy's reserved slugs.
+func (p SlugPolicy) IsReserved(slug Slug) bool {
+	return slices.Contains(p.Reserved, slug)
+}
+
+func (p SlugPolicy) maxLength() int {
+	if p.MaxLength == 0 {
+		return MaxSlugLength
+	}
+	return p.MaxLength
+}
+
+// transliterate applies the locale's rules, then the common ones.
+func (p SlugPolicy) transliterate(s string) string {
+	base, _ := language.Make(p.Locale.String()).Base()
+	rules := localeTransliterations[base.String()]
+	if len(rules) == 0 {
+		return transliterate(s)
+	}
+
+	var result strings.Builder
+	for _, r := range s {
+		if replacement, ok := rules[r]; ok {
+			result.WriteString(replacement)
+		} else {
+			result.WriteRune(r)
+		}
+	}
+	return transliterate(result.String())
+}
+
+// truncateSlug cuts a slug to maxLength, dropping the last word rather than
+// splitting it. A first word longer than the limit is cut where the limit falls.
+func truncateSlug(s string, maxLength int) string {
+	if len(s) <= maxLength {
+		return s
+	}
+
+	if s[maxLength] == '-' {
+		return s[:maxLength]
+	}
+	if i := strings.LastIndexByte(s[:maxLength], '-'); i > 0 {
+		return s[:i]
+	}
+	return strings.TrimRight(s[:maxLength], "-")
+}
@@ -0,0 +1,116 @@
+package shared_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/shared"
+)
+
+func TestSlugPolicy_NewSlug(t *testing.T) {
+	t.Run("applies the locale's transliteration", func(t *testing.T) {
+		tests := []struct {
+			locale shared.Locale
+			input  string
+			want   string
+		}{
+			{"de-DE", "Grüße aus München", "gruesse-aus-muenchen"},
+			{"", "Grüße aus München", "grusse-aus-munchen"},
+			{shared.LocalePortugueseBR, "Ação & reação", "acao-e-reacao"},
+			{shared.LocaleFrenchFR, "Questions & réponses", "questions-et-reponses"},
+			{shared.LocaleEnglishUS, "Questions & answers", "questions-and-answers"},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.input, func(t *testing.T) {
+				got, err := shared.SlugPolicy{Locale: tt.locale}.NewSlug(tt.input)
+
+				assertNoError(t, err)
+				if got.String() != tt.want {
+					t.Errorf("got %q, want %q", got, tt.want)
+				}
+			})
+		}
+	})
+
+	t.Run("truncates at a word boundary", func(t *testing.T) {
+		tests := []struct {
+			maxLength int
+			input     string
+			want      string
+		}{
+			{20, "Les verbes pronominaux au passé composé", "les-verbes"},
+			{16, "Les verbes pronominaux", "les-verbes"},
+			{10, "Les verbes pronominaux", "les-verbes"},
+			{5, "Anticonstitutionnellement", "antic"},
+			{0, strings.Repeat("mot ", 100), strings.TrimSuffix(strings.Repeat("mot-", shared.MaxSlugLength/4), "-")},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.want, func(t *testing.T) {
+				got, err := shared.SlugPolicy{MaxLength: tt.maxLength}.NewSlug(tt.input)
+
+				assertNoError(t, err)
+				if got.String() != tt.want {
+					t.Errorf("got %q, want %q", got, tt.want)
+				}
+			})
+		}
+	})
+
+	t.Run("rejects reserved slugs", func(t *testing.T) {
+		policy := shared.DefaultSlugPolicy(shared.LocaleFrenchFR)
+
+		_, err := policy.NewSlug("Admin")
+		assertErrorCode(t, err, kernel.EConflict)
+		assertErrorMessage(t, err, "Slug admin is reserved.")
+
+		got, err := policy.NewSlug("Administration")
+		assertNoError(t, err)
+		if got != "administration" {
+			t.Errorf("got %q", got)
+		}
+	})
+}
+
+func TestSlugPolicy_Check(t *testing.T) {
+	policy := shared.SlugPolicy{Reserved: []shared.Slug{"feed"}, MaxLength: 10}
+
+	tests := []struct {
+		slug shared.Slug
+		want string
+	}{
+		{"le-marche", ""},
+		{"feed", kernel.EConflict},
+		{"le-marche-du-samedi", kernel.EInvalid},
+		{"Le Marché", kernel.EInvalid},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.slug.String(), func(t *testing.T) {
+			err := policy.Check(tt.slug)
+			if tt.want == "" {
+				assertNoError(t, err)
+				return
+			}
+			assertErrorCode(t, err, tt.want)
+		})
+	}
+}
+
+func TestSlugPolicy_Validate(t *testing.T) {
+	assertNoError(t, shared.DefaultSlugPolicy(shared.LocaleEnglishUS).Validate())
+	assertErrorCode(t, shared.SlugPolicy{MaxLength: shared.MaxSlugLength + 1}.Validate(), kernel.EInvalid)
+	assertErrorCode(t, shared.SlugPolicy{Reserved: []shared.Slug{"Admin"}}.Validate(), kernel.EInvalid)
+}
+
+func TestSlugErrorLocalized(t *testing.T) {
+	err := shared.Slug("").Validate()
+
+	got := kernel.ErrorMessageLocalized(err, "fr-FR")
+
+	if got != "Le champ «\u00a0slug\u00a0» est obligatoire." {
+		t.Errorf("got %q", got)
+	}
+}
@@ -0,0 +1,173 @@
+package shared
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+)
+
+const (
+	MSortFieldMissing     string = "Missing sort field."
+	MSortFieldUnsupported string = "Unsupported sort field: %s."
+	MSortFieldDuplicate   string = "Sort field listed more than once: %s."
+	MSortDirectionInvalid string = "Invalid sort direction: %s."
+	MSortTooManyKeys      string = "A sort can combine at most %d keys."
+)
+
+// MaxSortKeys bounds multi-key sorts so they stay index-friendly.
+const MaxSortKeys = 3
+
+// SortDirection orders one sort key.
+type SortDirection string
+
+const (
+	SortAscending  SortDirection = "asc"
+	SortDescending SortDirection = "desc"
+)
+
+func (d SortDirection) String() string { return string(d) }
+
+// Validate ensures the direction is supported.
+func (d SortDirection) Validate() error {
+	const op = "SortDirection.Validate"
+
+	if d != SortAscending && d != SortDescending {
+		return &kernel.Error{
+			Code:      kernel.EInvalid,
+			Message:   fmt.Sprintf(MSortDirectionInvalid, d),
+			Operation: op,
+		}
+	}
+
+	return nil
+}
+
+// SortFields is the whitelist of fields an aggregate can be sorted by.
+// Each aggregate declares its own so no raw column name reaches a repository.
+type SortFields []string
+
+// Allows reports whether field is whitelisted.
+func (f SortFields) Allows(field string) bool {
+	return slices.Contains(f, field)
+}
+
+// SortKey orders results by one field.
+type SortKey struct {
+	Field     string
+	Direction SortDirection
+}
+
+// Asc sorts by field, smallest first.
+func Asc(field string) SortKey { return SortKey{Field: field, Direction: SortAscending} }
+
+// Desc sorts by field, largest first.
+func Desc(field string) SortKey { return SortKey{Field: field, Direction: SortDescending} }
+
+// IsDescending reports whether the key sorts largest first.
+func (k SortKey) IsDescending() bool { return k.Direction == SortDescending }
+
+// String returns the key in query-string form, prefixed with "-" when descending.
+func (k SortKey) String() string {
+	if k.IsDescending() {
+		return "-" + k.Field
+	}
+	return k.Field
+}
+
+// Sort is an ordered list of keys; later keys break ties of earlier ones.
+// An empty sort leaves the order to the repository default.
+type Sort []SortKey
+
+// NewSort creates a validated sort over the allowed fields.
+func NewSort(allowed SortFields, keys ...SortKey) (Sort, error) {
+	const op = "NewSort"
+
+	sort := Sort(slices.Clone(keys))
+	if err := sort.Validate(allowed); err != nil {
+		return nil, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return sort, nil
+}
+
+// ParseSort reads a comma-separated sort such as "-published_at,title",
+// where a leading "-" means descending. An empty string yields an empty sort.
+func ParseSort(spec string, allowed SortFields) (Sort, error) {
+	const op = "ParseSort"
+
+	spec = strings.TrimSpace(spec)
+	if spec == "" {
+		return nil, nil
+	}
+
+	var keys []SortKey
+	for _, part := range strings.Split(spec, ",") {
+		part = strings.TrimSpace(part)
+		if field, ok := strings.CutPrefix(part, "-"); ok {
+			keys = append(keys, Desc(field))
+		} else {
+			keys = append(keys, Asc(part))
+		}
+	}
+
+	sort, err := NewSort(allowed, keys...)
+	if err != nil {
+		return nil, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return sort, nil
+}
+
+// Validate ensures every key targets a whitelisted field at most once.
+func (s Sort) Validate(allowed SortFields) error {
+	const op = "Sort.Validate"
+
+	if len(s) > MaxSortKeys {
+		return &kernel.Error{
+			Code:      kernel.EInvalid,
+			Message:   fmt.Sprintf(MSortTooManyKeys, MaxSortKeys),
+			Operation: op,
+		}
+	}
+
+	seen := make(map[string]bool, len(s))
+	for _, k := range s {
+		if k.Field == "" {
+			return &kernel.Error{Code: kernel.EInvalid, Message: MSortFieldMissing, Operation: op}
+		}
+
+		if !allowed.Allows(k.Field) {
+			return &kernel.Error{
+				Code:      kernel.EInvalid,
+				Message:   fmt.Sprintf(MSortFieldUnsupported, k.Field),
+				Operation: op,
+			}
+		}
+
+		if seen[k.Field] {
+			return &kernel.Error{
+				Code:      kernel.EInvalid,
+				Message:   fmt.Sprintf(MSortFieldDuplicate, k.Field),
+				Operation: op,
+			}
+		}
+		seen[k.Field] = true
+
+		if err := k.Direction.Validate(); err != nil {
+			return &kernel.Error{Operation: op, Cause: err}
+		}
+	}
+
+	return nil
+}
+
+// String returns the sort in the form ParseSort accepts.
+func (s Sort) String() string {
+	parts := make([]string, len(s))
+	for i, k := range s {
+		parts[i] = k.String()
+	}
+	return strings.Join(parts, ",")
+}
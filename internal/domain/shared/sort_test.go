@@ -0,0 +1,84 @@
+package shared_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/shared"
+)
+
+var testSortFields = shared.SortFields{"published_at", "title", "word_count"}
+
+func TestParseSort(t *testing.T) {
+	tests := []struct {
+		name string
+		spec string
+		want shared.Sort
+	}{
+		{"empty", "", nil},
+		{"ascending", "title", shared.Sort{shared.Asc("title")}},
+		{"descending", "-published_at", shared.Sort{shared.Desc("published_at")}},
+		{"multi-key", "-word_count, title", shared.Sort{shared.Desc("word_count"), shared.Asc("title")}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := shared.ParseSort(tt.spec, testSortFields)
+
+			assertNoError(t, err)
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("round-trips through String", func(t *testing.T) {
+		got, err := shared.ParseSort("-word_count,title", testSortFields)
+
+		assertNoError(t, err)
+		if got.String() != "-word_count,title" {
+			t.Errorf("got %q", got.String())
+		}
+	})
+
+	errorTests := []struct {
+		name    string
+		spec    string
+		message string
+	}{
+		{"unknown field", "password_hash", "Unsupported sort field: password_hash."},
+		{"duplicate field", "title,-title", "Sort field listed more than once: title."},
+		{"empty key", "title,", shared.MSortFieldMissing},
+		{"too many keys", "title,word_count,published_at,title", "A sort can combine at most 3 keys."},
+	}
+
+	for _, tt := range errorTests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := shared.ParseSort(tt.spec, testSortFields)
+
+			assertErrorCode(t, err, kernel.EInvalid)
+			assertErrorMessage(t, err, tt.message)
+		})
+	}
+}
+
+func TestNewSort(t *testing.T) {
+	t.Run("rejects invalid direction", func(t *testing.T) {
+		_, err := shared.NewSort(testSortFields, shared.SortKey{Field: "title", Direction: "up"})
+
+		assertErrorCode(t, err, kernel.EInvalid)
+	})
+
+	t.Run("copies keys", func(t *testing.T) {
+		keys := []shared.SortKey{shared.Asc("title")}
+
+		got, err := shared.NewSort(testSortFields, keys...)
+		keys[0] = shared.Desc("word_count")
+
+		assertNoError(t, err)
+		if got[0] != shared.Asc("title") {
+			t.Errorf("got %v", got[0])
+		}
+	})
+}
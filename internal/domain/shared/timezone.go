@@ -0,0 +1,178 @@
+package shared
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	_ "time/tzdata" // Validation must not depend on the host's zoneinfo files
+
+	"github.com/alnah/fla/internal/domain/kernel"
+)
+
+const (
+	MTimeZoneMissing      string = "Missing time zone."
+	MTimeZoneInvalid      string = "Unknown time zone: %s."
+	MWallClockInvalid     string = "Invalid local date or time."
+	MWallClockNonexistent string = "%s does not exist in %s: clocks skip it for daylight saving time."
+)
+
+// TimeZone is an IANA time zone name such as "Europe/Paris". Instants are
+// stored in UTC; a time zone only converts them to and from what people read
+// on their clocks, daylight saving time included.
+type TimeZone string
+
+// DefaultTimeZone is the fallback when no time zone is specified.
+const DefaultTimeZone TimeZone = "UTC"
+
+// NewTimeZone creates a validated time zone.
+func NewTimeZone(name string) (TimeZone, error) {
+	const op = "NewTimeZone"
+
+	tz := TimeZone(strings.TrimSpace(name))
+	if err := tz.Validate(); err != nil {
+		return "", &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return tz, nil
+}
+
+func (tz TimeZone) String() string { return string(tz) }
+
+// Validate ensures the name is in the IANA time zone database. "Local" is
+// refused since it means whatever zone the server runs in.
+func (tz TimeZone) Validate() error {
+	const op = "TimeZone.Validate"
+
+	if err := kernel.ValidatePresence("time zone", tz.String(), op); err != nil {
+		return err
+	}
+
+	if _, err := time.LoadLocation(tz.String()); err != nil || tz == "Local" {
+		return &kernel.Error{Code: kernel.EInvalid, Message: fmt.Sprintf(MTimeZoneInvalid, tz), Operation: op, Cause: err}
+	}
+
+	return nil
+}
+
+// GetEffectiveTimeZone returns the time zone to use, with default fallback.
+func (tz TimeZone) GetEffectiveTimeZone() TimeZone {
+	if tz.Validate() != nil {
+		return DefaultTimeZone
+	}
+	return tz
+}
+
+// Location returns the zone's rules, or UTC for invalid zones.
+func (tz TimeZone) Location() *time.Location {
+	loc, err := time.LoadLocation(tz.GetEffectiveTimeZone().String())
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
+// In returns the instant as read on the zone's clocks, for display.
+func (tz TimeZone) In(t time.Time) time.Time {
+	return t.In(tz.Location())
+}
+
+// WallClock is a local date and time as people say it, without a zone:
+// Monday, September 2, 2024 at 9:00.
+type WallClock struct {
+	Year   int
+	Month  time.Month
+	Day    int
+	Hour   int
+	Minute int
+}
+
+// String returns the wall clock as "2024-09-02 09:00".
+func (w WallClock) String() string {
+	return fmt.Sprintf("%04d-%02d-%02d %02d:%02d", w.Year, w.Month, w.Day, w.Hour, w.Minute)
+}
+
+// Validate ensures the date exists in the calendar and the time of day is in range.
+func (w WallClock) Validate() error {
+	const op = "WallClock.Validate"
+
+	date := time.Date(w.Year, w.Month, w.Day, 0, 0, 0, 0, time.UTC)
+	if date.Year() != w.Year || date.Month() != w.Month || date.Day() != w.Day ||
+		w.Hour < 0 || w.Hour > 23 || w.Minute < 0 || w.Minute > 59 {
+		return &kernel.Error{Code: kernel.EInvalid, Message: MWallClockInvalid, Operation: op}
+	}
+
+	return nil
+}
+
+// Resolve returns the instant the zone's clocks show the wall clock. When
+// clocks fall back and show it twice, the first occurrence is returned; when
+// they spring forward over it, it fails with EInvalid rather than guessing.
+func (tz TimeZone) Resolve(w WallClock) (time.Time, error) {
+	const op = "TimeZone.Resolve"
+
+	if err := tz.Validate(); err != nil {
+		return time.Time{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if err := w.Validate(); err != nil {
+		return time.Time{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	loc := tz.Location()
+	asUTC := time.Date(w.Year, w.Month, w.Day, w.Hour, w.Minute, 0, 0, time.UTC)
+
+	// Try every offset in force around that day; those giving back the same
+	// wall clock are the instants it designates.
+	var first time.Time
+	for _, around := range []time.Time{asUTC.Add(-24 * time.Hour), asUTC, asUTC.Add(24 * time.Hour)} {
+		_, offset := around.In(loc).Zone()
+		candidate := asUTC.Add(-time.Duration(offset) * time.Second)
+		if wallClockOf(candidate.In(loc)) != w {
+			continue
+		}
+		if first.IsZero() || candidate.Before(first) {
+			first = candidate
+		}
+	}
+
+	if first.IsZero() {
+		return time.Time{}, &kernel.Error{
+			Code:      kernel.EInvalid,
+			Message:   fmt.Sprintf(MWallClockNonexistent, w, tz),
+			Operation: op,
+		}
+	}
+
+	return first.UTC(), nil
+}
+
+// Next returns the first instant after from when the zone's clocks show the
+// weekday at hour:minute, e.g. "next Monday 9:00 Paris time". The time of day
+// is kept across daylight saving changes, so the UTC hour may differ from one
+// week to the next.
+func (tz TimeZone) Next(from time.Time, weekday time.Weekday, hour, minute int) (time.Time, error) {
+	const op = "TimeZone.Next"
+
+	local := tz.In(from)
+	for days := range 8 {
+		day := local.AddDate(0, 0, days)
+		if day.Weekday() != weekday {
+			continue
+		}
+
+		at, err := tz.Resolve(WallClock{Year: day.Year(), Month: day.Month(), Day: day.Day(), Hour: hour, Minute: minute})
+		if err != nil {
+			return time.Time{}, &kernel.Error{Operation: op, Cause: err}
+		}
+		if at.After(from) {
+			return at, nil
+		}
+	}
+
+	// Unreachable: the same weekday a week later is always after from
+	return time.Time{}, &kernel.Error{Code: kernel.EInternal, Message: MWallClockInvalid, Operation: op}
+}
+
+func wallClockOf(t time.Time) WallClock {
+	return WallClock{Year: t.Year(), Month: t.Month(), Day: t.Day(), Hour: t.Hour(), Minute: t.Minute()}
+}
@@ -0,0 +1,102 @@
+package shared_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/shared"
+)
+
+func TestNewTimeZone(t *testing.T) {
+	t.Run("accepts IANA names", func(t *testing.T) {
+		got, err := shared.NewTimeZone("  Europe/Paris ")
+
+		assertNoError(t, err)
+		if got != "Europe/Paris" {
+			t.Errorf("got %q", got)
+		}
+	})
+
+	for _, name := range []string{"", "Europe/Lyon", "CET+1", "Local"} {
+		t.Run("rejects "+name, func(t *testing.T) {
+			_, err := shared.NewTimeZone(name)
+			assertErrorCode(t, err, kernel.EInvalid)
+		})
+	}
+}
+
+func TestTimeZone_GetEffectiveTimeZone(t *testing.T) {
+	if got := shared.TimeZone("").GetEffectiveTimeZone(); got != shared.DefaultTimeZone {
+		t.Errorf("empty: got %q", got)
+	}
+	if got := shared.TimeZone("America/Sao_Paulo").GetEffectiveTimeZone(); got != "America/Sao_Paulo" {
+		t.Errorf("valid: got %q", got)
+	}
+}
+
+func TestTimeZone_Resolve(t *testing.T) {
+	paris := shared.TimeZone("Europe/Paris")
+
+	tests := []struct {
+		name string
+		at   shared.WallClock
+		want time.Time
+	}{
+		{"winter time", shared.WallClock{Year: 2024, Month: time.January, Day: 15, Hour: 9}, time.Date(2024, 1, 15, 8, 0, 0, 0, time.UTC)},
+		{"summer time", shared.WallClock{Year: 2024, Month: time.July, Day: 15, Hour: 9}, time.Date(2024, 7, 15, 7, 0, 0, 0, time.UTC)},
+		{"day clocks spring forward", shared.WallClock{Year: 2024, Month: time.March, Day: 31, Hour: 9}, time.Date(2024, 3, 31, 7, 0, 0, 0, time.UTC)},
+		{"repeated hour takes the first", shared.WallClock{Year: 2024, Month: time.October, Day: 27, Hour: 2, Minute: 30}, time.Date(2024, 10, 27, 0, 30, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := paris.Resolve(tt.at)
+
+			assertNoError(t, err)
+			if !got.Equal(tt.want) {
+				t.Errorf("got %s, want %s", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("rejects skipped times", func(t *testing.T) {
+		_, err := paris.Resolve(shared.WallClock{Year: 2024, Month: time.March, Day: 31, Hour: 2, Minute: 30})
+		assertErrorCode(t, err, kernel.EInvalid)
+	})
+
+	t.Run("rejects impossible dates", func(t *testing.T) {
+		_, err := paris.Resolve(shared.WallClock{Year: 2023, Month: time.February, Day: 29, Hour: 9})
+		assertErrorCode(t, err, kernel.EInvalid)
+
+		_, err = paris.Resolve(shared.WallClock{Year: 2024, Month: time.January, Day: 1, Hour: 24})
+		assertErrorCode(t, err, kernel.EInvalid)
+	})
+}
+
+func TestTimeZone_Next(t *testing.T) {
+	paris := shared.TimeZone("Europe/Paris")
+
+	tests := []struct {
+		name string
+		from time.Time
+		want time.Time
+	}{
+		{"later this week", time.Date(2024, 3, 27, 12, 0, 0, 0, time.UTC), time.Date(2024, 4, 1, 7, 0, 0, 0, time.UTC)},
+		{"later the same day", time.Date(2024, 4, 1, 6, 0, 0, 0, time.UTC), time.Date(2024, 4, 1, 7, 0, 0, 0, time.UTC)},
+		{"already past today", time.Date(2024, 4, 1, 7, 0, 0, 0, time.UTC), time.Date(2024, 4, 8, 7, 0, 0, 0, time.UTC)},
+		{"across the fall back", time.Date(2024, 10, 22, 12, 0, 0, 0, time.UTC), time.Date(2024, 10, 28, 8, 0, 0, 0, time.UTC)},
+		{"sunday night in UTC is monday in Paris", time.Date(2024, 1, 14, 23, 30, 0, 0, time.UTC), time.Date(2024, 1, 15, 8, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := paris.Next(tt.from, time.Monday, 9, 0)
+
+			assertNoError(t, err)
+			if !got.Equal(tt.want) {
+				t.Errorf("got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
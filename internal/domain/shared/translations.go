@@ -0,0 +1,174 @@
+package shared
+
+import "github.com/alnah/fla/internal/domain/kernel"
+
+// Translations are the shared value objects' messages and field names in every
+// supported locale. They are added to kernel.Messages when the package loads.
+var Translations = []kernel.Translation{
+	// Field names, as validation messages quote them
+	{ID: "field.title", Text: map[string]string{"en-US": "title", "fr-FR": "titre", "pt-BR": "título"}},
+	{ID: "field.slug", Text: map[string]string{"en-US": "slug", "fr-FR": "slug", "pt-BR": "slug"}},
+	{ID: "field.description", Text: map[string]string{"en-US": "description", "fr-FR": "description", "pt-BR": "descrição"}},
+	{ID: "field.username", Text: map[string]string{"en-US": "username", "fr-FR": "nom d’utilisateur", "pt-BR": "nome de usuário"}},
+	{ID: "field.first_name", Text: map[string]string{"en-US": "first name", "fr-FR": "prénom", "pt-BR": "nome"}},
+	{ID: "field.last_name", Text: map[string]string{"en-US": "last name", "fr-FR": "nom", "pt-BR": "sobrenome"}},
+	{ID: "field.locale", Text: map[string]string{"en-US": "locale", "fr-FR": "langue", "pt-BR": "idioma"}},
+	{ID: "field.site_name", Text: map[string]string{"en-US": "site name", "fr-FR": "nom du site", "pt-BR": "nome do site"}},
+	{ID: "field.ipa", Text: map[string]string{"en-US": "IPA", "fr-FR": "API", "pt-BR": "AFI"}},
+	{ID: "field.time_zone", Text: map[string]string{"en-US": "time zone", "fr-FR": "fuseau horaire", "pt-BR": "fuso horário"}},
+
+	{ID: "campaign.value_invalid", Text: map[string]string{
+		"en-US": MCampaignValueInvalid,
+		"fr-FR": "Le paramètre UTM %s doit être en minuscules, mots reliés par des tirets.",
+		"pt-BR": "O parâmetro UTM %s deve estar em minúsculas, palavras unidas por hifens.",
+	}},
+	{ID: "campaign.url_invalid", Text: map[string]string{
+		"en-US": MCampaignURLInvalid,
+		"fr-FR": "Les liens de campagne exigent une URL http ou https absolue.",
+		"pt-BR": "Links de campanha exigem uma URL http ou https absoluta.",
+	}},
+	{ID: "cefr.invalid", Text: map[string]string{
+		"en-US": MCEFRLevelInvalid,
+		"fr-FR": "Niveau CECRL invalide : %q.",
+		"pt-BR": "Nível QECR inválido: %q.",
+	}},
+	{ID: "datetime.missing", Text: map[string]string{
+		"en-US": MDatetimeMissing,
+		"fr-FR": "Date et heure manquantes.",
+		"pt-BR": "Data e hora ausentes.",
+	}},
+	{ID: "datetime.not_past", Text: map[string]string{
+		"en-US": MDatetimeNotPast,
+		"fr-FR": "La date ne doit pas être dans le futur.",
+		"pt-BR": "A data não deve estar no futuro.",
+	}},
+	{ID: "email.invalid", Text: map[string]string{
+		"en-US": MEmailInvalid,
+		"fr-FR": "Adresse e-mail invalide.",
+		"pt-BR": "E-mail inválido.",
+	}},
+	{ID: "email.missing", Text: map[string]string{
+		"en-US": MEmailMissing,
+		"fr-FR": "Adresse e-mail manquante.",
+		"pt-BR": "E-mail ausente.",
+	}},
+	{ID: "email.format", Text: map[string]string{
+		"en-US": MEmailFormatInvalid,
+		"fr-FR": "Format d’adresse e-mail invalide.",
+		"pt-BR": "Formato de e-mail inválido.",
+	}},
+	{ID: "ipa.invalid_character", Text: map[string]string{
+		"en-US": MIPAInvalidCharacter,
+		"fr-FR": "La transcription API contient %q, qui n’est pas un symbole de l’API.",
+		"pt-BR": "A transcrição AFI contém %q, que não é um símbolo do AFI.",
+	}},
+	{ID: "locale.invalid", Text: map[string]string{
+		"en-US": MLocaleInvalid,
+		"fr-FR": "Code de langue invalide.",
+		"pt-BR": "Código de idioma inválido.",
+	}},
+	{ID: "locale.missing", Text: map[string]string{
+		"en-US": MLocaleMissing,
+		"fr-FR": "Langue manquante.",
+		"pt-BR": "Idioma ausente.",
+	}},
+	{ID: "locale.unsupported", Text: map[string]string{
+		"en-US": MLocaleUnsupported,
+		"fr-FR": "Langue non prise en charge : %s.",
+		"pt-BR": "Idioma não suportado: %s.",
+	}},
+	{ID: "pagination.page", Text: map[string]string{
+		"en-US": MPaginationInvalidPage,
+		"fr-FR": "Le numéro de page doit être supérieur à 0.",
+		"pt-BR": "O número da página deve ser maior que 0.",
+	}},
+	{ID: "pagination.limit", Text: map[string]string{
+		"en-US": MPaginationInvalidLimit,
+		"fr-FR": "La limite doit être comprise entre %d et %d.",
+		"pt-BR": "O limite deve estar entre %d e %d.",
+	}},
+	{ID: "pagination.total", Text: map[string]string{
+		"en-US": MPaginationInvalidTotal,
+		"fr-FR": "Le nombre total d’éléments ne peut pas être négatif.",
+		"pt-BR": "O total de itens não pode ser negativo.",
+	}},
+	{ID: "username.chars", Text: map[string]string{
+		"en-US": MUsernameInvalidChars,
+		"fr-FR": "Le nom d’utilisateur ne peut contenir que des lettres, des chiffres, des tirets bas et des tirets.",
+		"pt-BR": "O nome de usuário só pode conter letras, números, sublinhados e hífens.",
+	}},
+	{ID: "site.base_url_missing", Text: map[string]string{
+		"en-US": MSiteBaseURLMissing,
+		"fr-FR": "URL de base du site manquante.",
+		"pt-BR": "URL base do site ausente.",
+	}},
+	{ID: "slug.chars", Text: map[string]string{
+		"en-US": MSlugInvalidChars,
+		"fr-FR": "Le slug contient des caractères invalides.",
+		"pt-BR": "O slug contém caracteres inválidos.",
+	}},
+	{ID: "slug.generation", Text: map[string]string{
+		"en-US": MSlugGeneration,
+		"fr-FR": "Impossible de générer le slug.",
+		"pt-BR": "Não foi possível gerar o slug.",
+	}},
+	{ID: "slug.reserved", Text: map[string]string{
+		"en-US": MSlugReserved,
+		"fr-FR": "Le slug %s est réservé.",
+		"pt-BR": "O slug %s é reservado.",
+	}},
+	{ID: "slug.max_length", Text: map[string]string{
+		"en-US": MSlugMaxLengthBounds,
+		"fr-FR": "La longueur maximale d’un slug doit être comprise entre 1 et %d.",
+		"pt-BR": "O tamanho máximo do slug deve estar entre 1 e %d.",
+	}},
+	{ID: "sort.field_missing", Text: map[string]string{
+		"en-US": MSortFieldMissing,
+		"fr-FR": "Champ de tri manquant.",
+		"pt-BR": "Campo de ordenação ausente.",
+	}},
+	{ID: "sort.field_unsupported", Text: map[string]string{
+		"en-US": MSortFieldUnsupported,
+		"fr-FR": "Champ de tri non pris en charge : %s.",
+		"pt-BR": "Campo de ordenação não suportado: %s.",
+	}},
+	{ID: "sort.field_duplicate", Text: map[string]string{
+		"en-US": MSortFieldDuplicate,
+		"fr-FR": "Champ de tri indiqué plusieurs fois : %s.",
+		"pt-BR": "Campo de ordenação repetido: %s.",
+	}},
+	{ID: "sort.direction", Text: map[string]string{
+		"en-US": MSortDirectionInvalid,
+		"fr-FR": "Sens de tri invalide : %s.",
+		"pt-BR": "Direção de ordenação inválida: %s.",
+	}},
+	{ID: "sort.too_many_keys", Text: map[string]string{
+		"en-US": MSortTooManyKeys,
+		"fr-FR": "Un tri peut combiner au plus %d clés.",
+		"pt-BR": "Uma ordenação pode combinar no máximo %d chaves.",
+	}},
+	{ID: "timezone.missing", Text: map[string]string{
+		"en-US": MTimeZoneMissing,
+		"fr-FR": "Fuseau horaire manquant.",
+		"pt-BR": "Fuso horário ausente.",
+	}},
+	{ID: "timezone.invalid", Text: map[string]string{
+		"en-US": MTimeZoneInvalid,
+		"fr-FR": "Fuseau horaire inconnu : %s.",
+		"pt-BR": "Fuso horário desconhecido: %s.",
+	}},
+	{ID: "wallclock.invalid", Text: map[string]string{
+		"en-US": MWallClockInvalid,
+		"fr-FR": "Date ou heure locale invalide.",
+		"pt-BR": "Data ou hora local inválida.",
+	}},
+	{ID: "wallclock.nonexistent", Text: map[string]string{
+		"en-US": MWallClockNonexistent,
+		"fr-FR": "%s n’existe pas dans le fuseau %s : les horloges sautent cette heure au changement d’heure.",
+		"pt-BR": "%s não existe em %s: os relógios pulam esse horário no horário de verão.",
+	}},
+}
+
+func init() {
+	kernel.Messages.Add(Translations...)
+}
@@ -0,0 +1,122 @@
+package shared
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+// Spaces that keep punctuation on the line of the word it follows.
+const (
+	NoBreakSpace       rune = '\u00A0' // Before a colon and inside guillemets
+	NarrowNoBreakSpace rune = '\u202F' // Before semicolons, exclamation and question marks
+)
+
+// quoteStyle is how a language writes double quotes, spacing included.
+type quoteStyle struct {
+	open, close string
+}
+
+var (
+	frenchQuotes  = quoteStyle{"«" + string(NoBreakSpace), string(NoBreakSpace) + "»"}
+	englishQuotes = quoteStyle{"“", "”"}
+)
+
+// highPunctuation are the marks French typography sets apart from the word before.
+const highPunctuation = ";:!?"
+
+// NormalizeTypography sets titles and short texts in the typography of a locale:
+// curly apostrophes, the locale's quotation marks, ellipses, and single spaces.
+// French gets no-break spaces before high punctuation and inside guillemets; other
+// languages lose any space there. Colons and marks inside URLs or times (10:30,
+// https://, ?page=2) are left alone. Applying it twice changes nothing.
+func NormalizeTypography(text string, locale Locale) string {
+	french := locale.ToISO639Language() == "fr"
+	quotes := englishQuotes
+	if french {
+		quotes = frenchQuotes
+	}
+
+	text = strings.TrimSpace(whitespaceRe.ReplaceAllString(text, " "))
+	text = strings.ReplaceAll(text, "...", "…")
+	runes := []rune(text)
+
+	out := make([]rune, 0, len(runes))
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		prev := runeAt(runes, i-1)
+
+		switch {
+		case r == '\'':
+			if opensQuote(prev) {
+				out = append(out, '‘')
+			} else {
+				out = append(out, '’')
+			}
+
+		case r == '"' && opensQuote(prev), r == '«', r == '“':
+			out = append(out, []rune(quotes.open)...)
+			for i+1 < len(runes) && isSpacing(runes[i+1]) {
+				i++
+			}
+
+		case r == '"', r == '»', r == '”':
+			out = append(trimSpacing(out), []rune(quotes.close)...)
+
+		case strings.ContainsRune(highPunctuation, r) && endsClause(runes, i):
+			out = trimSpacing(out)
+			if french && len(out) > 0 && !strings.ContainsRune(highPunctuation, out[len(out)-1]) {
+				if r == ':' {
+					out = append(out, NoBreakSpace)
+				} else {
+					out = append(out, NarrowNoBreakSpace)
+				}
+			}
+			out = append(out, r)
+
+		default:
+			out = append(out, r)
+		}
+	}
+
+	return string(out)
+}
+
+// Normalize returns the title set in the typography of the locale.
+// See NormalizeTypography.
+func (t Title) Normalize(locale Locale) Title {
+	return Title(NormalizeTypography(t.String(), locale))
+}
+
+// opensQuote reports whether a quote following prev opens a quotation.
+func opensQuote(prev rune) bool {
+	return prev == 0 || unicode.IsSpace(prev) || strings.ContainsRune("([{‘“«", prev)
+}
+
+// endsClause reports whether the mark at i is punctuation rather than part of a
+// URL, time, or other token: it must be followed by a space, the end of the
+// text, closing punctuation, or another mark.
+func endsClause(runes []rune, i int) bool {
+	next := runeAt(runes, i+1)
+	return next == 0 || unicode.IsSpace(next) || strings.ContainsRune(highPunctuation+`)]}"»”’.,…`, next)
+}
+
+func runeAt(runes []rune, i int) rune {
+	if i < 0 || i >= len(runes) {
+		return 0
+	}
+	return runes[i]
+}
+
+func isSpacing(r rune) bool {
+	return r == ' ' || r == NoBreakSpace || r == NarrowNoBreakSpace
+}
+
+func trimSpacing(out []rune) []rune {
+	for len(out) > 0 && isSpacing(out[len(out)-1]) {
+		out = out[:len(out)-1]
+	}
+	return out
+}
+
+var whitespaceRe = regexp.MustCompile(`[ \t\r\n]+`)
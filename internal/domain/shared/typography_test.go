@@ -0,0 +1,51 @@
+package shared_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/alnah/fla/internal/domain/shared"
+)
+
+func TestNormalizeTypography(t *testing.T) {
+	// Make the no-break spaces visible: _ is U+00A0, ^ is U+202F.
+	visible := strings.NewReplacer(string(shared.NoBreakSpace), "_", string(shared.NarrowNoBreakSpace), "^")
+
+	tests := []struct {
+		name   string
+		locale shared.Locale
+		input  string
+		want   string
+	}{
+		{"french high punctuation", shared.LocaleFrenchFR, "Tu viens ? Oui ! Enfin; presque : demain", "Tu viens^? Oui^! Enfin^; presque_: demain"},
+		{"french guillemets", shared.LocaleFrenchFR, `Elle dit "bonjour" puis « au revoir »`, "Elle dit «_bonjour_» puis «_au revoir_»"},
+		{"french apostrophes", shared.LocaleFrenchFR, "L'école d'aujourd'hui", "L’école d’aujourd’hui"},
+		{"french stacked marks", shared.LocaleFrenchFR, "Quoi ?!", "Quoi^?!"},
+		{"french times and links untouched", shared.LocaleFrenchFR, "Rendez-vous à 10:30 sur https://fla.example/?page=2", "Rendez-vous à 10:30 sur https://fla.example/?page=2"},
+		{"ellipsis and spaces", shared.LocaleFrenchFR, "  Et   puis...  rien  ", "Et puis… rien"},
+		{"english quotes", shared.LocaleEnglishUS, `The "passé composé" isn't 'hard'`, "The “passé composé” isn’t ‘hard’"},
+		{"english drops spaces before punctuation", shared.LocaleEnglishUS, "Ready ? Go !", "Ready? Go!"},
+		{"portuguese quotes from guillemets", shared.LocalePortugueseBR, "Ele disse « olá »", "Ele disse “olá”"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := shared.NormalizeTypography(tt.input, tt.locale)
+			if visible.Replace(got) != tt.want {
+				t.Errorf("got %q, want %q", visible.Replace(got), tt.want)
+			}
+
+			if again := shared.NormalizeTypography(got, tt.locale); again != got {
+				t.Errorf("not idempotent: got %q", visible.Replace(again))
+			}
+		})
+	}
+}
+
+func TestTitle_Normalize(t *testing.T) {
+	got := shared.Title("Qu'est-ce que le subjonctif ?").Normalize(shared.LocaleFrenchFR)
+
+	if got != "Qu’est-ce que le subjonctif\u202F?" {
+		t.Errorf("got %q", got)
+	}
+}
@@ -0,0 +1,167 @@
+// Package shortlink creates short codes pointing to lessons or other pages,
+// for print materials and classrooms where long category paths are impractical.
+package shortlink
+
+import (
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"regexp"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/post"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+const (
+	// CodeLength is the length of generated codes: 31^7, about 27 billion codes.
+	CodeLength int = 7
+
+	MinCodeLength int = 4
+	MaxCodeLength int = 32
+)
+
+// CodeAlphabet leaves out characters misread on paper or a whiteboard: 0, 1, i, l, o.
+const CodeAlphabet string = "23456789abcdefghjkmnpqrstuvwxyz"
+
+const (
+	MCodeInvalid           string = "Short codes hold lowercase letters, digits, and hyphens."
+	MCodeGenerateFail      string = "Short code could not be generated."
+	MShortlinkTargetMissed string = "A shortlink points to either a post or a URL."
+	MShortlinkExpiryPast   string = "Expiry must be after creation."
+)
+
+var codeRe = regexp.MustCompile(`^[a-z0-9]+(?:-[a-z0-9]+)*$`)
+
+// Code is the path segment of a short URL, e.g. "k7qm2xp" in "https://fla.example/s/k7qm2xp".
+type Code string
+
+// NewCode generates a random code of CodeLength characters from CodeAlphabet.
+func NewCode() (Code, error) {
+	const op = "NewCode"
+
+	alphabet := big.NewInt(int64(len(CodeAlphabet)))
+	b := make([]byte, CodeLength)
+	for i := range b {
+		n, err := rand.Int(rand.Reader, alphabet)
+		if err != nil {
+			return "", &kernel.Error{Code: kernel.EInternal, Message: MCodeGenerateFail, Operation: op, Cause: err}
+		}
+		b[i] = CodeAlphabet[n.Int64()]
+	}
+
+	return Code(b), nil
+}
+
+func (c Code) String() string { return string(c) }
+
+// Validate ensures the code is URL-safe and of reasonable length. Chosen codes,
+// such as "a1-menu", may use any lowercase letter, digit, or hyphen.
+func (c Code) Validate() error {
+	const op = "Code.Validate"
+
+	if err := kernel.ValidateLength("short code", c.String(), MinCodeLength, MaxCodeLength, op); err != nil {
+		return err
+	}
+
+	if !codeRe.MatchString(c.String()) {
+		return &kernel.Error{Code: kernel.EInvalid, Message: MCodeInvalid, Operation: op}
+	}
+
+	return nil
+}
+
+// Shortlink points a short code to a post or to any other URL.
+// Post targets resolve to the post's current URL, so they survive slug and category changes.
+type Shortlink struct {
+	// Identity
+	Code Code
+
+	// Target: exactly one is set
+	PostID *kernel.ID[post.Post]
+	URL    kernel.URL[Shortlink]
+
+	// Usage
+	Clicks int
+
+	// Meta
+	CreatedBy kernel.ID[user.User]
+	CreatedAt time.Time
+	ExpiresAt *time.Time // Optional: e.g. the end of a school term
+}
+
+// NewShortlinkParams holds the data for a new shortlink.
+type NewShortlinkParams struct {
+	Code      Code
+	PostID    *kernel.ID[post.Post]
+	URL       kernel.URL[Shortlink]
+	CreatedBy kernel.ID[user.User]
+	ExpiresAt *time.Time
+	Clock     kernel.Clock
+}
+
+// NewShortlink creates a validated shortlink without clicks.
+func NewShortlink(params NewShortlinkParams) (Shortlink, error) {
+	const op = "NewShortlink"
+
+	s := Shortlink{
+		Code:      params.Code,
+		PostID:    params.PostID,
+		URL:       params.URL,
+		CreatedBy: params.CreatedBy,
+		CreatedAt: params.Clock.Now(),
+		ExpiresAt: params.ExpiresAt,
+	}
+
+	if err := s.Validate(); err != nil {
+		return Shortlink{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return s, nil
+}
+
+// Validate ensures the shortlink has a valid code and a single target.
+func (s Shortlink) Validate() error {
+	const op = "Shortlink.Validate"
+
+	if err := s.Code.Validate(); err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if (s.PostID == nil) == (s.URL == "") {
+		return &kernel.Error{Code: kernel.EInvalid, Message: MShortlinkTargetMissed, Operation: op}
+	}
+
+	if s.PostID != nil {
+		if err := s.PostID.Validate(); err != nil {
+			return &kernel.Error{Operation: op, Cause: err}
+		}
+	} else if err := s.URL.Validate(); err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if err := s.CreatedBy.Validate(); err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if s.ExpiresAt != nil && !s.ExpiresAt.After(s.CreatedAt) {
+		return &kernel.Error{Code: kernel.EInvalid, Message: MShortlinkExpiryPast, Operation: op}
+	}
+
+	return nil
+}
+
+// IsExpired reports whether the shortlink stopped resolving at now.
+func (s Shortlink) IsExpired(now time.Time) bool {
+	return s.ExpiresAt != nil && !now.Before(*s.ExpiresAt)
+}
+
+// String returns a string representation of the shortlink.
+func (s Shortlink) String() string {
+	target := s.URL.String()
+	if s.PostID != nil {
+		target = "post " + s.PostID.String()
+	}
+	return fmt.Sprintf("Shortlink{Code: %s, Target: %s, Clicks: %d}", s.Code, target, s.Clicks)
+}
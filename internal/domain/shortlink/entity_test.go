@@ -0,0 +1,70 @@
+package shortlink_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/post"
+	"github.com/alnah/fla/internal/domain/shortlink"
+)
+
+func TestNewCode(t *testing.T) {
+	seen := map[shortlink.Code]bool{}
+	for range 100 {
+		code, err := shortlink.NewCode()
+		assertNoError(t, err)
+
+		if len(code) != shortlink.CodeLength || strings.Trim(code.String(), shortlink.CodeAlphabet) != "" {
+			t.Fatalf("got %q", code)
+		}
+		assertNoError(t, code.Validate())
+		if seen[code] {
+			t.Fatalf("got %q twice", code)
+		}
+		seen[code] = true
+	}
+}
+
+func TestCode_Validate(t *testing.T) {
+	tests := []struct {
+		code shortlink.Code
+		want string
+	}{
+		{"a1-menu", ""},
+		{"abc", kernel.EInvalid},
+		{"A1-Menu", kernel.EInvalid},
+		{"a1--menu", kernel.EInvalid},
+		{"menu/a1", kernel.EInvalid},
+	}
+
+	for _, tt := range tests {
+		if got := kernel.ErrorCode(tt.code.Validate()); got != tt.want {
+			t.Errorf("%q: got %q, want %q", tt.code, got, tt.want)
+		}
+	}
+}
+
+func TestNewShortlink(t *testing.T) {
+	clock := &stubClock{t: fixtureNow}
+	postID := kernel.ID[post.Post]("post-1")
+	past := fixtureNow.Add(-time.Hour)
+
+	tests := []struct {
+		name   string
+		params shortlink.NewShortlinkParams
+	}{
+		{"no target", shortlink.NewShortlinkParams{Code: "a1-menu", CreatedBy: "editor-1", Clock: clock}},
+		{"two targets", shortlink.NewShortlinkParams{Code: "a1-menu", PostID: &postID, URL: "https://example.com", CreatedBy: "editor-1", Clock: clock}},
+		{"expired at creation", shortlink.NewShortlinkParams{Code: "a1-menu", PostID: &postID, CreatedBy: "editor-1", ExpiresAt: &past, Clock: clock}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := shortlink.NewShortlink(tt.params)
+
+			assertErrorCode(t, err, kernel.EInvalid)
+		})
+	}
+}
@@ -0,0 +1,127 @@
+package shortlink_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/category"
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/post"
+	"github.com/alnah/fla/internal/domain/shared"
+	"github.com/alnah/fla/internal/domain/shortlink"
+)
+
+type stubClock struct {
+	t time.Time
+}
+
+func (s *stubClock) Now() time.Time { return s.t }
+
+type stubShortlinks struct {
+	links map[shortlink.Code]shortlink.Shortlink
+	taken int // Generated codes reported taken before one is free
+}
+
+func (s *stubShortlinks) GetByCode(code shortlink.Code) (*shortlink.Shortlink, error) {
+	if l, ok := s.links[code]; ok {
+		return &l, nil
+	}
+	return nil, &kernel.Error{Code: kernel.ENotFound, Message: "shortlink not found"}
+}
+
+func (s *stubShortlinks) GetByPost(postID kernel.ID[post.Post]) ([]shortlink.Shortlink, error) {
+	var out []shortlink.Shortlink
+	for _, l := range s.links {
+		if l.PostID != nil && *l.PostID == postID {
+			out = append(out, l)
+		}
+	}
+	return out, nil
+}
+
+func (s *stubShortlinks) Create(l shortlink.Shortlink) error {
+	if _, ok := s.links[l.Code]; ok || s.taken > 0 {
+		s.taken--
+		return &kernel.Error{Code: kernel.EConflict, Message: "code taken"}
+	}
+	s.links[l.Code] = l
+	return nil
+}
+
+func (s *stubShortlinks) RecordClick(code shortlink.Code) error {
+	l := s.links[code]
+	l.Clicks++
+	s.links[code] = l
+	return nil
+}
+
+func (s *stubShortlinks) Delete(code shortlink.Code) error {
+	delete(s.links, code)
+	return nil
+}
+
+type stubPosts struct {
+	posts map[kernel.ID[post.Post]]post.Post
+}
+
+func (s *stubPosts) GetByID(id kernel.ID[post.Post]) (*post.Post, error) {
+	if p, ok := s.posts[id]; ok {
+		return &p, nil
+	}
+	return nil, &kernel.Error{Code: kernel.ENotFound, Message: "post not found"}
+}
+
+func (s *stubPosts) GetBySlug(shared.Slug) (*post.Post, error) {
+	return nil, &kernel.Error{Code: kernel.ENotFound, Message: "post not found"}
+}
+
+type stubPaths struct{}
+
+func (stubPaths) BuildPath(kernel.ID[category.Category]) (category.CategoryPath, error) {
+	a1ID := kernel.ID[category.Category]("a1")
+	return category.CategoryPath{
+		{CategoryID: a1ID, Name: "A1", Slug: "a1"},
+		{CategoryID: "reading", Name: "Lecture", Slug: "lecture", ParentID: &a1ID},
+	}, nil
+}
+
+func (stubPaths) FindByPath([]string) (*category.Category, error) {
+	return nil, &kernel.Error{Code: kernel.ENotFound, Message: "category not found"}
+}
+
+var fixtureNow = time.Date(2024, 9, 2, 8, 0, 0, 0, time.UTC)
+
+func testPost(t *testing.T, id kernel.ID[post.Post], status post.Status) post.Post {
+	t.Helper()
+	title, _ := shared.NewTitle("Lire un menu")
+	content, _ := post.NewPostContent(strings.Repeat("Le menu du jour propose une soupe. ", 10))
+
+	p, err := post.NewPost(post.NewPostParams{
+		PostID:   id,
+		Owner:    "author-1",
+		Title:    title,
+		Content:  content,
+		Status:   post.StatusDraft,
+		Category: category.Category{CategoryID: "reading", Name: "Lecture", Slug: "lecture", CreatedBy: "author-1"},
+		Clock:    &stubClock{t: fixtureNow},
+	})
+	assertNoError(t, err)
+	p.Status = status
+	return p
+}
+
+func assertNoError(t *testing.T, err error) {
+	t.Helper()
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+}
+
+func assertErrorCode(t *testing.T, err error, want string) {
+	t.Helper()
+	got := kernel.ErrorCode(err)
+	if got != want {
+		t.Errorf("error code: got %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,34 @@
+package shortlink
+
+import (
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/post"
+)
+
+// ShortlinkReader retrieves shortlinks.
+type ShortlinkReader interface {
+	// GetByCode returns the shortlink a code resolves. Returns ENotFound when missing.
+	GetByCode(code Code) (*Shortlink, error)
+
+	// GetByPost lists the shortlinks pointing to a post, for the editor's share panel.
+	GetByPost(postID kernel.ID[post.Post]) ([]Shortlink, error)
+}
+
+// ShortlinkWriter persists shortlinks.
+type ShortlinkWriter interface {
+	// Create stores a new shortlink. Returns EConflict when the code is taken.
+	Create(shortlink Shortlink) error
+
+	// RecordClick adds one to the click count, atomically, so concurrent visits all count.
+	RecordClick(code Code) error
+
+	// Delete removes a shortlink; its code may then be reused.
+	Delete(code Code) error
+}
+
+// Repository combines shortlink persistence and retrieval.
+// Most concrete implementations (like PostgresShortlinkRepository) will implement this.
+type Repository interface {
+	ShortlinkReader
+	ShortlinkWriter
+}
@@ -0,0 +1,229 @@
+package shortlink
+
+import (
+	"time"
+
+	"github.com/alnah/fla/internal/domain/category"
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/policy"
+	"github.com/alnah/fla/internal/domain/post"
+	"github.com/alnah/fla/internal/domain/shared"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+// MaxCodeAttempts bounds retries when a generated code is already taken.
+const MaxCodeAttempts int = 5
+
+// PathPrefix is where the site serves short URLs.
+const PathPrefix string = "s/"
+
+const (
+	MShortlinkForbidden   string = "User cannot create shortlinks for this target."
+	MShortlinkNotFound    string = "No link matches this code."
+	MShortlinkCodeTaken   string = "This short code is already taken."
+	MShortlinkCodeExhaust string = "No free short code was found; try again."
+)
+
+// Shortlink actions, checked against permissions.
+const (
+	ActionManage policy.Action = "shortlink.manage" // Link to any URL, delete any shortlink
+)
+
+// KindShortlink is the policy resource kind of shortlinks.
+const KindShortlink string = "shortlink"
+
+// rules are the shortlink permissions.
+// Linking to a post needs policy.PostEdit on it instead.
+var rules = []policy.Rule{
+	{
+		Name:    "editors manage shortlinks",
+		Actions: []policy.Action{ActionManage},
+		Roles:   []string{user.RoleAdmin.String(), user.RoleEditor.String()},
+	},
+}
+
+// permissions is the shortlink policy, built once from rules.
+var permissions = policy.New(rules...)
+
+// CreateParams describes a shortlink to create. An empty code is generated.
+type CreateParams struct {
+	Code      Code
+	PostID    *kernel.ID[post.Post]
+	URL       kernel.URL[Shortlink]
+	ExpiresAt *time.Time
+}
+
+// ShortlinkService creates shortlinks and resolves them to their target.
+type ShortlinkService struct {
+	repository Repository
+	posts      post.PostReader
+	categories category.CategoryPathBuilder
+	site       shared.Site
+	clock      kernel.Clock
+}
+
+// NewShortlinkService creates shortlink service with storage, and post and
+// category lookups to resolve post targets.
+func NewShortlinkService(repository Repository, posts post.PostReader, categories category.CategoryPathBuilder, site shared.Site, clock kernel.Clock) *ShortlinkService {
+	return &ShortlinkService{repository: repository, posts: posts, categories: categories, site: site, clock: clock}
+}
+
+// Create stores a shortlink on behalf of actor. Authors may link to posts they
+// can edit; only editors link to other URLs. Generated codes that collide are
+// drawn again, up to MaxCodeAttempts times; a chosen code that is taken fails
+// with EConflict.
+func (s *ShortlinkService) Create(params CreateParams, actor user.PostPermissionChecker) (Shortlink, error) {
+	const op = "ShortlinkService.Create"
+
+	if err := s.authorize(params.PostID, actor); err != nil {
+		return Shortlink{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	generated := params.Code == ""
+	for range MaxCodeAttempts {
+		code := params.Code
+		if generated {
+			var err error
+			if code, err = NewCode(); err != nil {
+				return Shortlink{}, &kernel.Error{Operation: op, Cause: err}
+			}
+		}
+
+		link, err := NewShortlink(NewShortlinkParams{
+			Code:      code,
+			PostID:    params.PostID,
+			URL:       params.URL,
+			CreatedBy: actor.GetID(),
+			ExpiresAt: params.ExpiresAt,
+			Clock:     s.clock,
+		})
+		if err != nil {
+			return Shortlink{}, &kernel.Error{Operation: op, Cause: err}
+		}
+
+		err = s.repository.Create(link)
+		switch {
+		case err == nil:
+			return link, nil
+		case kernel.ErrorCode(err) != kernel.EConflict:
+			return Shortlink{}, &kernel.Error{Operation: op, Cause: err}
+		case !generated:
+			return Shortlink{}, &kernel.Error{Code: kernel.EConflict, Message: MShortlinkCodeTaken, Operation: op, Cause: err}
+		}
+	}
+
+	return Shortlink{}, &kernel.Error{Code: kernel.EInternal, Message: MShortlinkCodeExhaust, Operation: op, Retryable: true}
+}
+
+// Resolve returns the URL a code points to and counts the click. Expired
+// shortlinks, and posts no longer published, resolve to ENotFound.
+func (s *ShortlinkService) Resolve(code Code) (string, error) {
+	const op = "ShortlinkService.Resolve"
+
+	link, err := s.repository.GetByCode(code)
+	if err != nil {
+		if kernel.ErrorCode(err) == kernel.ENotFound {
+			return "", &kernel.Error{Code: kernel.ENotFound, Message: MShortlinkNotFound, Operation: op, Cause: err}
+		}
+		return "", &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if link.IsExpired(s.clock.Now()) {
+		return "", &kernel.Error{Code: kernel.ENotFound, Message: MShortlinkNotFound, Operation: op}
+	}
+
+	target := link.URL.String()
+	if link.PostID != nil {
+		if target, err = s.postURL(*link.PostID); err != nil {
+			return "", &kernel.Error{Operation: op, Cause: err}
+		}
+	}
+
+	if err := s.repository.RecordClick(code); err != nil {
+		return "", &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return target, nil
+}
+
+// ShortURL returns the public short URL of a code, e.g. "https://fla.example/s/k7qm2xp".
+func (s *ShortlinkService) ShortURL(code Code) string {
+	return s.site.URL(PathPrefix + code.String())
+}
+
+// ForPost lists the shortlinks pointing to a post.
+func (s *ShortlinkService) ForPost(postID kernel.ID[post.Post]) ([]Shortlink, error) {
+	const op = "ShortlinkService.ForPost"
+
+	links, err := s.repository.GetByPost(postID)
+	if err != nil {
+		return nil, &kernel.Error{Operation: op, Cause: err}
+	}
+	return links, nil
+}
+
+// Delete removes a shortlink on behalf of its creator or an editor.
+func (s *ShortlinkService) Delete(code Code, actor user.PostPermissionChecker) error {
+	const op = "ShortlinkService.Delete"
+
+	link, err := s.repository.GetByCode(code)
+	if err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if link.CreatedBy != actor.GetID() && !canManage(actor) {
+		return &kernel.Error{Code: kernel.EForbidden, Message: MShortlinkForbidden, Operation: op}
+	}
+
+	if err := s.repository.Delete(code); err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+	return nil
+}
+
+// authorize checks the actor may link to the target: a post they can edit, or
+// any URL for editors.
+func (s *ShortlinkService) authorize(postID *kernel.ID[post.Post], actor user.PostPermissionChecker) error {
+	const op = "ShortlinkService.authorize"
+
+	if postID == nil {
+		if !canManage(actor) {
+			return &kernel.Error{Code: kernel.EForbidden, Message: MShortlinkForbidden, Operation: op}
+		}
+		return nil
+	}
+
+	p, err := s.posts.GetByID(*postID)
+	if err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if !user.Authorize(actor, policy.PostEdit, user.PostResource(*p)).Allowed {
+		return &kernel.Error{Code: kernel.EForbidden, Message: MShortlinkForbidden, Operation: op}
+	}
+	return nil
+}
+
+// postURL returns the current public URL of a published post.
+func (s *ShortlinkService) postURL(postID kernel.ID[post.Post]) (string, error) {
+	const op = "ShortlinkService.postURL"
+
+	p, err := s.posts.GetByID(postID)
+	if err != nil {
+		return "", &kernel.Error{Operation: op, Cause: err}
+	}
+	if !p.IsPublished() {
+		return "", &kernel.Error{Code: kernel.ENotFound, Message: MShortlinkNotFound, Operation: op}
+	}
+
+	path, err := s.categories.BuildPath(p.Category.CategoryID)
+	if err != nil {
+		return "", &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return s.site.URL(p.URLPath(path)), nil
+}
+
+func canManage(actor user.PostPermissionChecker) bool {
+	return permissions.Authorize(user.ActorOf(actor), ActionManage, policy.Resource{Kind: KindShortlink}).Allowed
+}
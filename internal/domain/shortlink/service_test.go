@@ -0,0 +1,127 @@
+package shortlink_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/policy"
+	"github.com/alnah/fla/internal/domain/post"
+	"github.com/alnah/fla/internal/domain/shared"
+	"github.com/alnah/fla/internal/domain/shortlink"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+func TestShortlinkService(t *testing.T) {
+	editor := user.User{ID: "editor-1", Roles: []user.Role{user.RoleEditor}, Scopes: []string{policy.AllScopes}}
+	author := user.User{ID: "author-1", Roles: []user.Role{user.RoleAuthor}}
+	otherAuthor := user.User{ID: "author-2", Roles: []user.Role{user.RoleAuthor}}
+	lesson := kernel.ID[post.Post]("lesson")
+	draft := kernel.ID[post.Post]("draft")
+
+	setup := func(t *testing.T) (*shortlink.ShortlinkService, *stubShortlinks, *stubClock) {
+		t.Helper()
+		site, err := shared.NewSite("FLA", "https://fla.example", shared.LocaleFrenchFR)
+		assertNoError(t, err)
+		clock := &stubClock{t: fixtureNow}
+		links := &stubShortlinks{links: map[shortlink.Code]shortlink.Shortlink{}}
+		posts := &stubPosts{posts: map[kernel.ID[post.Post]]post.Post{
+			lesson: testPost(t, lesson, post.StatusPublished),
+			draft:  testPost(t, draft, post.StatusDraft),
+		}}
+		return shortlink.NewShortlinkService(links, posts, stubPaths{}, site, clock), links, clock
+	}
+
+	t.Run("resolves post targets to their current URL and counts clicks", func(t *testing.T) {
+		service, links, _ := setup(t)
+
+		link, err := service.Create(shortlink.CreateParams{PostID: &lesson}, author)
+		assertNoError(t, err)
+		if service.ShortURL(link.Code) != "https://fla.example/s/"+link.Code.String() {
+			t.Errorf("got %q", service.ShortURL(link.Code))
+		}
+
+		for range 2 {
+			got, err := service.Resolve(link.Code)
+			assertNoError(t, err)
+			if got != "https://fla.example/a1/lecture/lire-un-menu" {
+				t.Errorf("got %q", got)
+			}
+		}
+		if links.links[link.Code].Clicks != 2 {
+			t.Errorf("got %d clicks", links.links[link.Code].Clicks)
+		}
+	})
+
+	t.Run("draws another code on collision", func(t *testing.T) {
+		service, links, _ := setup(t)
+		links.taken = 2
+
+		_, err := service.Create(shortlink.CreateParams{URL: "https://example.com/exercices"}, editor)
+
+		assertNoError(t, err)
+		if len(links.links) != 1 {
+			t.Errorf("got %d links", len(links.links))
+		}
+	})
+
+	t.Run("refuses taken chosen codes", func(t *testing.T) {
+		service, _, _ := setup(t)
+		_, err := service.Create(shortlink.CreateParams{Code: "a1-menu", PostID: &lesson}, editor)
+		assertNoError(t, err)
+
+		_, err = service.Create(shortlink.CreateParams{Code: "a1-menu", URL: "https://example.com"}, editor)
+
+		assertErrorCode(t, err, kernel.EConflict)
+	})
+
+	t.Run("gives up after too many collisions", func(t *testing.T) {
+		service, links, _ := setup(t)
+		links.taken = shortlink.MaxCodeAttempts
+
+		_, err := service.Create(shortlink.CreateParams{PostID: &lesson}, editor)
+
+		assertErrorCode(t, err, kernel.EInternal)
+		if !kernel.IsRetryable(err) {
+			t.Error("expected a retryable error")
+		}
+	})
+
+	t.Run("limits targets to what the actor may edit", func(t *testing.T) {
+		service, _, _ := setup(t)
+
+		_, err := service.Create(shortlink.CreateParams{URL: "https://example.com"}, author)
+		assertErrorCode(t, err, kernel.EForbidden)
+
+		_, err = service.Create(shortlink.CreateParams{PostID: &lesson}, otherAuthor)
+		assertErrorCode(t, err, kernel.EForbidden)
+	})
+
+	t.Run("hides expired links and unpublished posts", func(t *testing.T) {
+		service, _, clock := setup(t)
+		endOfTerm := fixtureNow.Add(30 * 24 * time.Hour)
+		expiring, err := service.Create(shortlink.CreateParams{URL: "https://example.com", ExpiresAt: &endOfTerm}, editor)
+		assertNoError(t, err)
+		unpublished, err := service.Create(shortlink.CreateParams{PostID: &draft}, editor)
+		assertNoError(t, err)
+
+		_, err = service.Resolve(unpublished.Code)
+		assertErrorCode(t, err, kernel.ENotFound)
+
+		clock.t = endOfTerm
+		_, err = service.Resolve(expiring.Code)
+		assertErrorCode(t, err, kernel.ENotFound)
+	})
+
+	t.Run("lets creators and editors delete", func(t *testing.T) {
+		service, links, _ := setup(t)
+		link, err := service.Create(shortlink.CreateParams{PostID: &lesson}, author)
+		assertNoError(t, err)
+
+		assertErrorCode(t, service.Delete(link.Code, otherAuthor), kernel.EForbidden)
+		assertNoError(t, service.Delete(link.Code, author))
+		if len(links.links) != 0 {
+			t.Errorf("got %d links", len(links.links))
+		}
+	})
+}
@@ -0,0 +1,183 @@
+package social
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/alnah/fla/internal/domain/post"
+	"github.com/alnah/fla/internal/domain/seo"
+	"github.com/alnah/fla/internal/domain/shared"
+	"github.com/alnah/fla/internal/domain/tag"
+)
+
+// Hashtag counts per platform: a couple on X, a few on LinkedIn, as many as
+// Instagram allows.
+const (
+	MaxTwitterHashtags   int = 2
+	MaxLinkedInHashtags  int = 5
+	MaxInstagramHashtags int = 30
+)
+
+// ellipsis ends shortened text.
+const ellipsis = "…"
+
+// Share is what a draft is composed from: the published post, its public URL,
+// and its tags.
+type Share struct {
+	Post post.Post
+	URL  string
+	Tags []tag.Tag
+}
+
+// Compose writes the share text of a post for a platform. The link carries
+// UTM parameters naming the platform, so visits are attributed to it. The draft
+// is scheduled without a time; the caller sets it.
+//   - X: title, the excerpt if room remains, hashtags, link; within 280 characters
+//   - LinkedIn: title, description, link, hashtags
+//   - Instagram: title, description, hashtags; the link is kept for the bio
+func Compose(share Share, platform Platform) Draft {
+	link := TrackedURL(share.URL, platform, share.Post.Slug.String())
+	hashtags := Hashtags(share.Tags)
+	title := share.Post.Title.String()
+	description := seo.EffectiveDescription(share.Post)
+
+	switch platform {
+	case PlatformTwitter:
+		hashtags = hashtags[:min(len(hashtags), MaxTwitterHashtags)]
+	case PlatformLinkedIn:
+		hashtags = hashtags[:min(len(hashtags), MaxLinkedInHashtags)]
+	case PlatformInstagram:
+		hashtags = hashtags[:min(len(hashtags), MaxInstagramHashtags)]
+	}
+
+	text := composeText(platform, title, description, hashtags, link)
+	if excess := platform.TextLength(text, link) - platform.MaxLength(); excess > 0 {
+		// Only descriptions are long enough to overflow LinkedIn and Instagram
+		description = truncate(description, utf8.RuneCountInString(description)-excess)
+		text = composeText(platform, title, description, hashtags, link)
+	}
+
+	return Draft{
+		PostID:   share.Post.PostID,
+		Platform: platform,
+		Text:     text,
+		Link:     link,
+		Hashtags: hashtags,
+		Status:   StatusScheduled,
+	}
+}
+
+func composeText(platform Platform, title, description string, hashtags []string, link string) string {
+	switch platform {
+	case PlatformTwitter:
+		return composeTwitter(title, description, hashtags, link)
+	case PlatformLinkedIn:
+		return joinParagraphs(title, description, link, strings.Join(hashtags, " "))
+	default:
+		return joinParagraphs(title, description, strings.Join(hashtags, " "))
+	}
+}
+
+// composeTwitter fits the title and link first, then adds the excerpt and
+// hashtags while they fit.
+func composeTwitter(title, description string, hashtags []string, link string) string {
+	budget := PlatformTwitter.MaxLength() - TwitterURLLength - len("\n\n")
+	title = truncate(title, budget)
+	body := title
+
+	if room := budget - utf8.RuneCountInString(body) - len("\n\n"); room > 20 && description != "" {
+		body = joinParagraphs(body, truncate(description, room))
+	}
+
+	tags := ""
+	for _, h := range hashtags {
+		next := strings.TrimSpace(tags + " " + h)
+		if utf8.RuneCountInString(body)+len("\n\n")+utf8.RuneCountInString(next) > budget {
+			break
+		}
+		tags = next
+	}
+
+	return joinParagraphs(body, tags, link)
+}
+
+// TrackedURL adds the campaign parameters of a social share to a post URL:
+// the platform as source and the post slug as campaign. URLs that cannot carry
+// them, which the site never builds, are returned as they are.
+func TrackedURL(rawURL string, platform Platform, campaign string) string {
+	link, err := shared.SocialCampaign(platform.String(), campaign)
+	if err != nil {
+		return rawURL
+	}
+
+	tracked, err := link.Apply(rawURL)
+	if err != nil {
+		return rawURL
+	}
+	return tracked
+}
+
+// Hashtags turns tag names into hashtags, e.g. "passé composé" into
+// "#PasséComposé", dropping duplicates and names without letters or digits.
+func Hashtags(tags []tag.Tag) []string {
+	var out []string
+	seen := map[string]bool{}
+	for _, t := range tags {
+		h := Hashtag(t.Name.String())
+		if h == "" || seen[strings.ToLower(h)] {
+			continue
+		}
+		seen[strings.ToLower(h)] = true
+		out = append(out, h)
+	}
+	return out
+}
+
+// Hashtag turns a name into a hashtag, capitalizing each word; empty when the
+// name has no letters or digits.
+func Hashtag(name string) string {
+	words := strings.FieldsFunc(name, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	if len(words) == 0 {
+		return ""
+	}
+
+	var b strings.Builder
+	b.WriteString("#")
+	for _, word := range words {
+		first, size := utf8.DecodeRuneInString(word)
+		b.WriteRune(unicode.ToUpper(first))
+		b.WriteString(word[size:])
+	}
+	return b.String()
+}
+
+// truncate shortens text to at most n characters, cutting at a word boundary
+// and ending with an ellipsis.
+func truncate(text string, n int) string {
+	if utf8.RuneCountInString(text) <= n {
+		return text
+	}
+	if n < 2 {
+		return ""
+	}
+
+	runes := []rune(text)[:n-1]
+	cut := string(runes)
+	if i := strings.LastIndexByte(cut, ' '); i > 0 {
+		cut = cut[:i]
+	}
+	return strings.TrimRight(cut, " ,;:.") + ellipsis
+}
+
+func joinParagraphs(parts ...string) string {
+	var kept []string
+	for _, p := range parts {
+		if p = strings.TrimSpace(p); p != "" {
+			kept = append(kept, p)
+		}
+	}
+	return strings.Join(kept, "\n\n")
+}
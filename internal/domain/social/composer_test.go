@@ -0,0 +1,87 @@
+package social_test
+
+import (
+	"slices"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/alnah/fla/internal/domain/post"
+	"github.com/alnah/fla/internal/domain/shared"
+	"github.com/alnah/fla/internal/domain/social"
+)
+
+func TestHashtag(t *testing.T) {
+	tests := []struct {
+		name, want string
+	}{
+		{"passé composé", "#PasséComposé"},
+		{"A1", "#A1"},
+		{"l'imparfait", "#LImparfait"},
+		{" -- ", ""},
+	}
+
+	for _, tt := range tests {
+		if got := social.Hashtag(tt.name); got != tt.want {
+			t.Errorf("Hashtag(%q): got %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestCompose(t *testing.T) {
+	share := social.Share{
+		Post: testPost(t, post.StatusPublished),
+		URL:  "https://fla.example/a1/le-passe-compose",
+		Tags: testTags(),
+	}
+
+	t.Run("tracks the link per platform", func(t *testing.T) {
+		got := social.Compose(share, social.PlatformLinkedIn)
+
+		want := "https://fla.example/a1/le-passe-compose?utm_campaign=le-passe-compose&utm_medium=social&utm_source=linkedin"
+		if got.Link != want {
+			t.Errorf("got %q, want %q", got.Link, want)
+		}
+		if !strings.Contains(got.Text, want) {
+			t.Errorf("got text %q without the link", got.Text)
+		}
+	})
+
+	t.Run("fits X within 280 characters", func(t *testing.T) {
+		got := social.Compose(share, social.PlatformTwitter)
+
+		assertNoError(t, got.Validate())
+		if !strings.HasPrefix(got.Text, "Le passé composé\n\n") || !strings.HasSuffix(got.Text, got.Link) {
+			t.Errorf("got %q", got.Text)
+		}
+		if !slices.Equal(got.Hashtags, []string{"#PasséComposé", "#Verbes"}) {
+			t.Errorf("got hashtags %v", got.Hashtags)
+		}
+		if n := social.PlatformTwitter.TextLength(got.Text, got.Link); n > 280 {
+			t.Errorf("got %d characters", n)
+		}
+	})
+
+	t.Run("keeps the Instagram link out of the caption", func(t *testing.T) {
+		got := social.Compose(share, social.PlatformInstagram)
+
+		if strings.Contains(got.Text, "https://") {
+			t.Errorf("got link in caption %q", got.Text)
+		}
+		if !strings.HasSuffix(got.Text, "#PasséComposé #Verbes #Grammaire") {
+			t.Errorf("got %q, want deduplicated hashtags last", got.Text)
+		}
+	})
+
+	t.Run("shortens long descriptions", func(t *testing.T) {
+		long := share
+		long.Post.SEODescription = shared.Description(strings.Repeat("Révisez le passé composé avec des exemples. ", 80))
+
+		got := social.Compose(long, social.PlatformInstagram)
+
+		assertNoError(t, got.Validate())
+		if n := utf8.RuneCountInString(got.Text); n > 2200 || !strings.Contains(got.Text, "…") {
+			t.Errorf("got %d characters", n)
+		}
+	})
+}
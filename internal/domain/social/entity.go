@@ -0,0 +1,160 @@
+package social
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/post"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+const (
+	MPlatformUnsupported string = "Unsupported social platform %q."
+	MDraftStatusInvalid  string = "Invalid share draft status: %q."
+	MDraftTooLong        string = "%s posts are limited to %d characters."
+)
+
+// Platform is a network lessons are shared on. Names match user.SocialMediaURL.
+type Platform string
+
+const (
+	PlatformTwitter   Platform = Platform(user.SocialMediaTwitter)
+	PlatformLinkedIn  Platform = Platform(user.SocialMediaLinkedIn)
+	PlatformInstagram Platform = Platform(user.SocialMediaInstagram)
+)
+
+// platformLimits are the longest texts each platform accepts, in characters.
+var platformLimits = map[Platform]int{
+	PlatformTwitter:   280,
+	PlatformLinkedIn:  3000,
+	PlatformInstagram: 2200,
+}
+
+// platformNames are the names shown in messages.
+var platformNames = map[Platform]string{
+	PlatformTwitter:   "X",
+	PlatformLinkedIn:  "LinkedIn",
+	PlatformInstagram: "Instagram",
+}
+
+// TwitterURLLength is how many characters X counts for any link, once shortened.
+const TwitterURLLength int = 23
+
+// String returns the platform name.
+func (p Platform) String() string { return string(p) }
+
+// Validate ensures the platform is supported.
+func (p Platform) Validate() error {
+	const op = "Platform.Validate"
+
+	if _, ok := platformLimits[p]; !ok {
+		return &kernel.Error{Code: kernel.EInvalid, Message: fmt.Sprintf(MPlatformUnsupported, p), Operation: op}
+	}
+	return nil
+}
+
+// MaxLength returns the longest text the platform accepts.
+func (p Platform) MaxLength() int { return platformLimits[p] }
+
+// TextLength counts text as the platform does: X counts every link as
+// TwitterURLLength characters, the others count characters.
+func (p Platform) TextLength(text, link string) int {
+	n := utf8.RuneCountInString(text)
+	if p == PlatformTwitter && link != "" {
+		n += strings.Count(text, link) * (TwitterURLLength - utf8.RuneCountInString(link))
+	}
+	return n
+}
+
+// Status tracks a share draft through the queue.
+type Status string
+
+const (
+	StatusScheduled Status = "scheduled" // Waiting for its time; still editable
+	StatusPublished Status = "published" // Posted on the platform
+	StatusFailed    Status = "failed"    // The publisher refused it; edit to queue again
+	StatusCancelled Status = "cancelled" // Withdrawn by an editor
+)
+
+// String returns the status name.
+func (s Status) String() string { return string(s) }
+
+// Validate ensures the status is known.
+func (s Status) Validate() error {
+	const op = "Status.Validate"
+
+	switch s {
+	case StatusScheduled, StatusPublished, StatusFailed, StatusCancelled:
+		return nil
+	default:
+		return &kernel.Error{Code: kernel.EInvalid, Message: fmt.Sprintf(MDraftStatusInvalid, s), Operation: op}
+	}
+}
+
+// Draft is a platform-specific share of a post, queued until ScheduledAt.
+// A post has at most one draft per platform.
+type Draft struct {
+	PostID      kernel.ID[post.Post]
+	Platform    Platform
+	Text        string
+	Link        string   // Tracked post URL; in Text except on Instagram, where captions are not clickable
+	Hashtags    []string // From the post's tags, e.g. "#PasséComposé"
+	Status      Status
+	ScheduledAt time.Time
+	PublishedAt *time.Time
+	ExternalID  string // The platform's ID of the published post
+	LastError   string // Why the publisher refused it
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// Validate ensures the draft fits its platform.
+func (d Draft) Validate() error {
+	const op = "Draft.Validate"
+
+	if err := d.PostID.Validate(); err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if err := d.Platform.Validate(); err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if err := d.Status.Validate(); err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if err := kernel.ValidatePresence("share text", d.Text, op); err != nil {
+		return err
+	}
+
+	if d.Platform.TextLength(d.Text, d.Link) > d.Platform.MaxLength() {
+		return &kernel.Error{
+			Code:      kernel.EInvalid,
+			Message:   fmt.Sprintf(MDraftTooLong, platformNames[d.Platform], d.Platform.MaxLength()),
+			Operation: op,
+		}
+	}
+
+	return nil
+}
+
+// IsDue reports whether a scheduled draft should be published at now.
+func (d Draft) IsDue(now time.Time) bool {
+	return d.Status == StatusScheduled && !now.Before(d.ScheduledAt)
+}
+
+// String returns a string representation of the draft.
+func (d Draft) String() string {
+	return fmt.Sprintf("Draft{PostID: %s, Platform: %s, Status: %s}", d.PostID, d.Platform, d.Status)
+}
+
+// Publisher posts drafts on one platform.
+// Implemented by adapters calling the platform's API.
+type Publisher interface {
+	// Publish posts the draft and returns the platform's ID for it.
+	Publish(draft Draft) (string, error)
+}
@@ -0,0 +1,171 @@
+package social_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/category"
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/post"
+	"github.com/alnah/fla/internal/domain/shared"
+	"github.com/alnah/fla/internal/domain/social"
+	"github.com/alnah/fla/internal/domain/tag"
+)
+
+type stubClock struct {
+	t time.Time
+}
+
+func (s *stubClock) Now() time.Time { return s.t }
+
+type stubDrafts struct {
+	drafts []social.Draft
+}
+
+func (s *stubDrafts) GetDraft(postID kernel.ID[post.Post], platform social.Platform) (*social.Draft, error) {
+	for _, d := range s.drafts {
+		if d.PostID == postID && d.Platform == platform {
+			return &d, nil
+		}
+	}
+	return nil, &kernel.Error{Code: kernel.ENotFound, Message: "draft not found"}
+}
+
+func (s *stubDrafts) GetDrafts(postID kernel.ID[post.Post]) ([]social.Draft, error) {
+	var out []social.Draft
+	for _, d := range s.drafts {
+		if d.PostID == postID {
+			out = append(out, d)
+		}
+	}
+	return out, nil
+}
+
+func (s *stubDrafts) GetDueDrafts(now time.Time) ([]social.Draft, error) {
+	var out []social.Draft
+	for _, d := range s.drafts {
+		if d.IsDue(now) {
+			out = append(out, d)
+		}
+	}
+	return out, nil
+}
+
+func (s *stubDrafts) SaveDraft(draft social.Draft) error {
+	for i, d := range s.drafts {
+		if d.PostID == draft.PostID && d.Platform == draft.Platform {
+			s.drafts[i] = draft
+			return nil
+		}
+	}
+	s.drafts = append(s.drafts, draft)
+	return nil
+}
+
+type stubPosts struct {
+	posts map[kernel.ID[post.Post]]post.Post
+}
+
+func (s *stubPosts) GetByID(id kernel.ID[post.Post]) (*post.Post, error) {
+	if p, ok := s.posts[id]; ok {
+		return &p, nil
+	}
+	return nil, &kernel.Error{Code: kernel.ENotFound, Message: "post not found"}
+}
+
+func (s *stubPosts) GetBySlug(shared.Slug) (*post.Post, error) {
+	return nil, &kernel.Error{Code: kernel.ENotFound, Message: "post not found"}
+}
+
+type stubTags struct {
+	tags []tag.Tag
+}
+
+func (s *stubTags) GetByID(id kernel.ID[tag.Tag]) (*tag.Tag, error) {
+	for _, t := range s.tags {
+		if t.TagID == id {
+			return &t, nil
+		}
+	}
+	return nil, &kernel.Error{Code: kernel.ENotFound, Message: "tag not found"}
+}
+
+func (s *stubTags) GetBySlug(shared.Slug) (*tag.Tag, error) {
+	return nil, &kernel.Error{Code: kernel.ENotFound, Message: "tag not found"}
+}
+
+func (s *stubTags) GetAll() ([]tag.Tag, error) { return s.tags, nil }
+
+type stubPaths struct{}
+
+func (stubPaths) BuildPath(kernel.ID[category.Category]) (category.CategoryPath, error) {
+	return category.CategoryPath{{CategoryID: "a1", Name: "A1", Slug: "a1"}}, nil
+}
+
+func (stubPaths) FindByPath([]string) (*category.Category, error) {
+	return nil, &kernel.Error{Code: kernel.ENotFound, Message: "category not found"}
+}
+
+// recordingPublisher refuses drafts while fail is set.
+type recordingPublisher struct {
+	published []social.Draft
+	fail      bool
+}
+
+func (r *recordingPublisher) Publish(d social.Draft) (string, error) {
+	if r.fail {
+		return "", errors.New("401 token expired")
+	}
+	r.published = append(r.published, d)
+	return "ext-" + d.Platform.String(), nil
+}
+
+var fixtureNow = time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+
+func testTags() []tag.Tag {
+	return []tag.Tag{
+		{TagID: "t1", Name: "passé composé", Slug: "passe-compose"},
+		{TagID: "t2", Name: "verbes", Slug: "verbes"},
+		{TagID: "t3", Name: "Passé-Composé", Slug: "passe-compose-2"},
+		{TagID: "t4", Name: "grammaire", Slug: "grammaire"},
+	}
+}
+
+func testPost(t *testing.T, status post.Status) post.Post {
+	t.Helper()
+	title, _ := shared.NewTitle("Le passé composé")
+	content, _ := post.NewPostContent(strings.Repeat("Hier, nous avons mangé au restaurant. ", 12))
+	tags, err := post.NewPostTags("t1", "t2", "t3", "t4")
+	assertNoError(t, err)
+
+	p, err := post.NewPost(post.NewPostParams{
+		PostID:   "post-123",
+		Owner:    "user-123",
+		Title:    title,
+		Content:  content,
+		Status:   post.StatusDraft,
+		Category: category.Category{CategoryID: "a1", Name: "A1", Slug: "a1", CreatedBy: "user-123"},
+		Tags:     tags,
+		Clock:    &stubClock{t: fixtureNow},
+	})
+	assertNoError(t, err)
+	p.Status = status
+	return p
+}
+
+func assertNoError(t *testing.T, err error) {
+	t.Helper()
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+}
+
+func assertErrorCode(t *testing.T, err error, want string) {
+	t.Helper()
+	got := kernel.ErrorCode(err)
+	if got != want {
+		t.Errorf("error code: got %q, want %q", got, want)
+	}
+}
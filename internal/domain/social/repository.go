@@ -0,0 +1,33 @@
+package social
+
+import (
+	"time"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/post"
+)
+
+// DraftReader retrieves share drafts.
+type DraftReader interface {
+	// GetDraft returns a post's draft for a platform. Returns ENotFound when missing.
+	GetDraft(postID kernel.ID[post.Post], platform Platform) (*Draft, error)
+
+	// GetDrafts lists a post's drafts, for the editor's share panel.
+	GetDrafts(postID kernel.ID[post.Post]) ([]Draft, error)
+
+	// GetDueDrafts lists scheduled drafts whose time is at or before now, oldest first.
+	GetDueDrafts(now time.Time) ([]Draft, error)
+}
+
+// DraftWriter persists share drafts.
+type DraftWriter interface {
+	// SaveDraft stores a draft, replacing any previous one for the post and platform.
+	SaveDraft(draft Draft) error
+}
+
+// DraftRepository combines draft persistence and retrieval.
+// Most concrete implementations (like PostgresDraftRepository) will implement this.
+type DraftRepository interface {
+	DraftReader
+	DraftWriter
+}
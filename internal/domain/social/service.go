@@ -0,0 +1,268 @@
+package social
+
+import (
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/category"
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/policy"
+	"github.com/alnah/fla/internal/domain/post"
+	"github.com/alnah/fla/internal/domain/shared"
+	"github.com/alnah/fla/internal/domain/tag"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+// ShareDelay leaves editors time to review drafts before they go out.
+const ShareDelay time.Duration = 30 * time.Minute
+
+const (
+	MShareForbidden      string = "User cannot share this post."
+	MSharePostNotLive    string = "Only published posts can be shared."
+	MDraftAlreadyShared  string = "This draft was already published."
+	MDraftScheduleInPast string = "Share time must not be in the past."
+)
+
+// ShareService promotes published posts: it composes a draft per platform,
+// queues it, and publishes it when due. Editors may rewrite, reschedule, or
+// cancel drafts until then.
+type ShareService struct {
+	drafts     DraftRepository
+	publishers map[Platform]Publisher
+	posts      post.PostReader
+	tags       tag.TagReader
+	categories category.CategoryPathBuilder
+	site       shared.Site
+	clock      kernel.Clock
+}
+
+// NewShareService creates share service with a publisher per platform, draft
+// storage, and what it needs to compose drafts: posts, tags, and public URLs.
+func NewShareService(
+	drafts DraftRepository,
+	publishers map[Platform]Publisher,
+	posts post.PostReader,
+	tags tag.TagReader,
+	categories category.CategoryPathBuilder,
+	site shared.Site,
+	clock kernel.Clock,
+) (*ShareService, error) {
+	const op = "NewShareService"
+
+	if err := site.Validate(); err != nil {
+		return nil, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	for platform := range publishers {
+		if err := platform.Validate(); err != nil {
+			return nil, &kernel.Error{Operation: op, Cause: err}
+		}
+	}
+
+	return &ShareService{
+		drafts:     drafts,
+		publishers: publishers,
+		posts:      posts,
+		tags:       tags,
+		categories: categories,
+		site:       site,
+		clock:      clock,
+	}, nil
+}
+
+// HandlePublished queues a draft per platform, ShareDelay from now. Platforms
+// the post was already published on are skipped, so republishing never shares
+// twice; other drafts are composed again from the post as it is now.
+func (s *ShareService) HandlePublished(p post.Post) ([]Draft, error) {
+	const op = "ShareService.HandlePublished"
+
+	if !p.IsPublished() {
+		return nil, &kernel.Error{Code: kernel.EInvalid, Message: MSharePostNotLive, Operation: op}
+	}
+
+	share, err := s.share(p)
+	if err != nil {
+		return nil, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	now := s.clock.Now()
+	var queued []Draft
+	for _, platform := range slices.Sorted(maps.Keys(s.publishers)) {
+		existing, err := s.drafts.GetDraft(p.PostID, platform)
+		if err != nil && kernel.ErrorCode(err) != kernel.ENotFound {
+			return nil, &kernel.Error{Operation: op, Cause: err}
+		}
+		if existing != nil && existing.Status == StatusPublished {
+			continue
+		}
+
+		draft := Compose(share, platform)
+		draft.ScheduledAt = now.Add(ShareDelay)
+		draft.CreatedAt = now
+		draft.UpdatedAt = now
+		if err := s.save(draft); err != nil {
+			return nil, &kernel.Error{Operation: op, Cause: err}
+		}
+		queued = append(queued, draft)
+	}
+
+	return queued, nil
+}
+
+// Drafts returns a post's drafts.
+func (s *ShareService) Drafts(postID kernel.ID[post.Post]) ([]Draft, error) {
+	const op = "ShareService.Drafts"
+
+	drafts, err := s.drafts.GetDrafts(postID)
+	if err != nil {
+		return nil, &kernel.Error{Operation: op, Cause: err}
+	}
+	return drafts, nil
+}
+
+// Edit rewrites and reschedules a draft on behalf of an editor. A zero time
+// keeps the schedule. Failed and cancelled drafts are queued again.
+func (s *ShareService) Edit(postID kernel.ID[post.Post], platform Platform, text string, at time.Time, actor user.PostPermissionChecker) (Draft, error) {
+	const op = "ShareService.Edit"
+
+	draft, err := s.editable(postID, platform, actor)
+	if err != nil {
+		return Draft{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	now := s.clock.Now()
+	if !at.IsZero() {
+		if at.Before(now) {
+			return Draft{}, &kernel.Error{Code: kernel.EInvalid, Message: MDraftScheduleInPast, Operation: op}
+		}
+		draft.ScheduledAt = at
+	}
+	if draft.ScheduledAt.Before(now) {
+		draft.ScheduledAt = now
+	}
+
+	draft.Text = text
+	draft.Status = StatusScheduled
+	draft.LastError = ""
+	draft.UpdatedAt = now
+	if err := s.save(draft); err != nil {
+		return Draft{}, &kernel.Error{Operation: op, Cause: err}
+	}
+	return draft, nil
+}
+
+// Cancel withdraws a draft on behalf of an editor.
+func (s *ShareService) Cancel(postID kernel.ID[post.Post], platform Platform, actor user.PostPermissionChecker) (Draft, error) {
+	const op = "ShareService.Cancel"
+
+	draft, err := s.editable(postID, platform, actor)
+	if err != nil {
+		return Draft{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	draft.Status = StatusCancelled
+	draft.UpdatedAt = s.clock.Now()
+	if err := s.save(draft); err != nil {
+		return Draft{}, &kernel.Error{Operation: op, Cause: err}
+	}
+	return draft, nil
+}
+
+// PublishDue publishes every scheduled draft whose time has come and returns
+// how many went out. A refused draft is marked failed with the reason and the
+// others still go out. Meant to run periodically, like the scheduler.
+func (s *ShareService) PublishDue() (int, error) {
+	const op = "ShareService.PublishDue"
+
+	now := s.clock.Now()
+	due, err := s.drafts.GetDueDrafts(now)
+	if err != nil {
+		return 0, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	published := 0
+	for _, draft := range due {
+		publisher, ok := s.publishers[draft.Platform]
+		if !ok {
+			continue // Platform removed from configuration; the draft waits
+		}
+
+		externalID, err := publisher.Publish(draft)
+		if err != nil {
+			draft.Status = StatusFailed
+			draft.LastError = err.Error()
+		} else {
+			draft.Status = StatusPublished
+			draft.ExternalID = externalID
+			draft.PublishedAt = &now
+			published++
+		}
+
+		draft.UpdatedAt = now
+		if err := s.drafts.SaveDraft(draft); err != nil {
+			return published, &kernel.Error{Operation: op, Cause: err}
+		}
+	}
+
+	return published, nil
+}
+
+// share gathers what drafts are composed from.
+func (s *ShareService) share(p post.Post) (Share, error) {
+	const op = "ShareService.share"
+
+	path, err := s.categories.BuildPath(p.Category.CategoryID)
+	if err != nil {
+		return Share{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	tags := make([]tag.Tag, 0, len(p.Tags))
+	for _, tagID := range p.Tags {
+		t, err := s.tags.GetByID(tagID)
+		if err != nil {
+			return Share{}, &kernel.Error{Operation: op, Cause: err}
+		}
+		tags = append(tags, *t)
+	}
+
+	return Share{Post: p, URL: s.site.URL(p.URLPath(path)), Tags: tags}, nil
+}
+
+// editable loads a draft the actor may still change.
+func (s *ShareService) editable(postID kernel.ID[post.Post], platform Platform, actor user.PostPermissionChecker) (Draft, error) {
+	const op = "ShareService.editable"
+
+	p, err := s.posts.GetByID(postID)
+	if err != nil {
+		return Draft{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if !user.Authorize(actor, policy.PostPublish, user.PostResource(*p)).Allowed {
+		return Draft{}, &kernel.Error{Code: kernel.EForbidden, Message: MShareForbidden, Operation: op}
+	}
+
+	draft, err := s.drafts.GetDraft(postID, platform)
+	if err != nil {
+		return Draft{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if draft.Status == StatusPublished {
+		return Draft{}, &kernel.Error{Code: kernel.EConflict, Message: MDraftAlreadyShared, Operation: op}
+	}
+
+	return *draft, nil
+}
+
+func (s *ShareService) save(draft Draft) error {
+	const op = "ShareService.save"
+
+	if err := draft.Validate(); err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if err := s.drafts.SaveDraft(draft); err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+	return nil
+}
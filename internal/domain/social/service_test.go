@@ -0,0 +1,130 @@
+package social_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/policy"
+	"github.com/alnah/fla/internal/domain/post"
+	"github.com/alnah/fla/internal/domain/shared"
+	"github.com/alnah/fla/internal/domain/social"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+func TestShareService(t *testing.T) {
+	editor := user.User{ID: "editor-1", Roles: []user.Role{user.RoleEditor}, Scopes: []string{policy.AllScopes}}
+	subscriber := user.User{ID: "reader-1", Roles: []user.Role{user.RoleSubscriber}}
+
+	setup := func(t *testing.T) (*social.ShareService, *stubDrafts, *stubClock, *recordingPublisher, *recordingPublisher) {
+		t.Helper()
+		site, err := shared.NewSite("FLA", "https://fla.example", shared.LocaleFrenchFR)
+		assertNoError(t, err)
+		clock := &stubClock{t: fixtureNow}
+		drafts := &stubDrafts{}
+		x := &recordingPublisher{}
+		linkedIn := &recordingPublisher{}
+		p := testPost(t, post.StatusPublished)
+
+		service, err := social.NewShareService(drafts, map[social.Platform]social.Publisher{
+			social.PlatformTwitter:  x,
+			social.PlatformLinkedIn: linkedIn,
+		}, &stubPosts{posts: map[kernel.ID[post.Post]]post.Post{p.PostID: p}}, &stubTags{tags: testTags()}, stubPaths{}, site, clock)
+		assertNoError(t, err)
+		return service, drafts, clock, x, linkedIn
+	}
+
+	t.Run("queues and publishes a draft per platform", func(t *testing.T) {
+		service, drafts, clock, x, linkedIn := setup(t)
+
+		queued, err := service.HandlePublished(testPost(t, post.StatusPublished))
+		assertNoError(t, err)
+		if len(queued) != 2 || !queued[0].ScheduledAt.Equal(fixtureNow.Add(social.ShareDelay)) {
+			t.Fatalf("got %v", queued)
+		}
+
+		published, err := service.PublishDue()
+		assertNoError(t, err)
+		if published != 0 {
+			t.Errorf("got %d published before the delay", published)
+		}
+
+		clock.t = fixtureNow.Add(social.ShareDelay)
+		published, err = service.PublishDue()
+		assertNoError(t, err)
+		if published != 2 || len(x.published) != 1 || len(linkedIn.published) != 1 {
+			t.Fatalf("got %d published", published)
+		}
+		if drafts.drafts[0].Status != social.StatusPublished || drafts.drafts[0].ExternalID == "" {
+			t.Errorf("got %+v", drafts.drafts[0])
+		}
+
+		queued, err = service.HandlePublished(testPost(t, post.StatusPublished))
+		assertNoError(t, err)
+		if len(queued) != 0 {
+			t.Errorf("got %d drafts queued again after sharing", len(queued))
+		}
+	})
+
+	t.Run("records refusals and requeues edited drafts", func(t *testing.T) {
+		service, drafts, clock, x, _ := setup(t)
+		x.fail = true
+		_, err := service.HandlePublished(testPost(t, post.StatusPublished))
+		assertNoError(t, err)
+		clock.t = fixtureNow.Add(time.Hour)
+
+		published, err := service.PublishDue()
+		assertNoError(t, err)
+		if published != 1 || drafts.drafts[1].Status != social.StatusFailed || drafts.drafts[1].LastError == "" {
+			t.Fatalf("got %d published, drafts %+v", published, drafts.drafts)
+		}
+
+		x.fail = false
+		got, err := service.Edit("post-123", social.PlatformTwitter, "Révisez le passé composé !", time.Time{}, editor)
+		assertNoError(t, err)
+		if got.Status != social.StatusScheduled || !got.ScheduledAt.Equal(clock.t) {
+			t.Errorf("got %+v, want queued now", got)
+		}
+
+		published, err = service.PublishDue()
+		assertNoError(t, err)
+		if published != 1 || x.published[0].Text != "Révisez le passé composé !" {
+			t.Errorf("got %d published, %v", published, x.published)
+		}
+	})
+
+	t.Run("limits edits to publishers of the post", func(t *testing.T) {
+		service, _, _, _, _ := setup(t)
+		_, err := service.HandlePublished(testPost(t, post.StatusPublished))
+		assertNoError(t, err)
+
+		_, err = service.Cancel("post-123", social.PlatformTwitter, subscriber)
+		assertErrorCode(t, err, kernel.EForbidden)
+
+		got, err := service.Cancel("post-123", social.PlatformTwitter, editor)
+		assertNoError(t, err)
+		if got.Status != social.StatusCancelled {
+			t.Errorf("got %v", got)
+		}
+	})
+
+	t.Run("rejects drafts over the platform limit", func(t *testing.T) {
+		service, _, _, _, _ := setup(t)
+		_, err := service.HandlePublished(testPost(t, post.StatusPublished))
+		assertNoError(t, err)
+
+		long := make([]byte, 281)
+		for i := range long {
+			long[i] = 'a'
+		}
+		_, err = service.Edit("post-123", social.PlatformTwitter, string(long), time.Time{}, editor)
+		assertErrorCode(t, err, kernel.EInvalid)
+	})
+
+	t.Run("shares only published posts", func(t *testing.T) {
+		service, _, _, _, _ := setup(t)
+
+		_, err := service.HandlePublished(testPost(t, post.StatusDraft))
+		assertErrorCode(t, err, kernel.EInvalid)
+	})
+}
@@ -0,0 +1,165 @@
+// Package speech turns lesson text into audio for listening exercises. A
+// SpeechSynthesizer adapter does the synthesis, possibly asynchronously; the
+// domain tracks each generation until its audio is attached to the post.
+package speech
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/post"
+	"github.com/alnah/fla/internal/domain/shared"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+const (
+	MinSpeed            float64 = 0.5
+	MaxSpeed            float64 = 2.0
+	DefaultSpeed        float64 = 1.0
+	MaxSpeechTextLength int     = 20000 // Characters sent in one request
+
+	MSpeechSpeedInvalid   string = "Speech speed must be between %.1f and %.1f."
+	MSpeechStatusInvalid  string = "Invalid speech generation status: %s."
+	MSpeechTextTooLong    string = "Text to speak cannot exceed %d characters."
+	MSpeechSentenceAbsent string = "Sentence is not in the post: %q."
+)
+
+// SpeechSynthesizer generates audio from text. Implemented by text-to-speech
+// adapters; synchronous providers return ready jobs straight away.
+type SpeechSynthesizer interface {
+	// Synthesize starts generating audio for the request.
+	Synthesize(request Request) (Job, error)
+
+	// Job reports the state of a generation started earlier.
+	// Returns ENotFound when the provider does not know the reference.
+	Job(ref string) (Job, error)
+}
+
+// Request is what the synthesizer is asked to speak, and how.
+type Request struct {
+	Text     string // Plain text, one sentence per line
+	Settings Settings
+}
+
+// Job is the provider's view of a generation.
+type Job struct {
+	Ref      string // Provider reference, for Job
+	Status   Status
+	Audio    kernel.URL[Audio] // Set when ready
+	Duration time.Duration     // Set when ready
+	Error    string            // Set when failed, for editors
+}
+
+// Audio marks URLs of generated audio files.
+type Audio struct{}
+
+// Settings choose the voice.
+type Settings struct {
+	Voice  string // Provider voice name; empty lets the provider choose for the locale
+	Locale shared.Locale
+	Speed  float64 // 1.0 is natural pace; learners often want slower
+}
+
+// Validate ensures the locale is supported and the speed sensible.
+func (s Settings) Validate() error {
+	const op = "Settings.Validate"
+
+	if err := s.Locale.Validate(); err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if s.Speed < MinSpeed || s.Speed > MaxSpeed {
+		return &kernel.Error{Code: kernel.EInvalid, Message: fmt.Sprintf(MSpeechSpeedInvalid, MinSpeed, MaxSpeed), Operation: op}
+	}
+
+	return nil
+}
+
+// Status is where a generation stands.
+type Status string
+
+const (
+	StatusPending Status = "pending" // Submitted, not finished
+	StatusReady   Status = "ready"   // Audio available
+	StatusFailed  Status = "failed"  // Provider gave up
+)
+
+func (s Status) String() string { return string(s) }
+
+// Validate ensures the status is known.
+func (s Status) Validate() error {
+	const op = "Status.Validate"
+
+	switch s {
+	case StatusPending, StatusReady, StatusFailed:
+		return nil
+	default:
+		return &kernel.Error{Code: kernel.EInvalid, Message: fmt.Sprintf(MSpeechStatusInvalid, s), Operation: op}
+	}
+}
+
+// IsFinal reports whether the generation will not change anymore.
+func (s Status) IsFinal() bool {
+	return s == StatusReady || s == StatusFailed
+}
+
+// Generation tracks one request for audio of a post.
+type Generation struct {
+	GenerationID kernel.ID[Generation]
+	PostID       kernel.ID[post.Post]
+	Text         string
+	Sentences    bool // Text is a selection of sentences rather than the whole post
+	Settings     Settings
+
+	Status      Status
+	ProviderRef string
+	Error       string
+
+	RequestedBy kernel.ID[user.User]
+	RequestedAt time.Time
+	CompletedAt *time.Time
+}
+
+// String returns a string representation of the generation.
+func (g Generation) String() string {
+	return fmt.Sprintf("Generation{ID: %s, PostID: %s, Status: %s}", g.GenerationID, g.PostID, g.Status)
+}
+
+// AudioAttachment is generated audio attached to a post, with its transcript so
+// listening exercises can show it after the learner answers.
+type AudioAttachment struct {
+	PostID       kernel.ID[post.Post]
+	GenerationID kernel.ID[Generation]
+	URL          kernel.URL[Audio]
+	Duration     time.Duration
+	Transcript   string
+	Settings     Settings
+	CreatedAt    time.Time
+}
+
+// SpeechText returns what to speak for a post: its plain text, or the given
+// sentences, each of which must appear in it.
+func SpeechText(p post.Post, sentences []string) (string, error) {
+	const op = "SpeechText"
+
+	text := kernel.StripMarkdown(p.Content.String())
+	if len(sentences) > 0 {
+		selected := make([]string, 0, len(sentences))
+		for _, sentence := range sentences {
+			sentence = strings.TrimSpace(sentence)
+			if sentence == "" || !strings.Contains(text, sentence) {
+				return "", &kernel.Error{Code: kernel.EInvalid, Message: fmt.Sprintf(MSpeechSentenceAbsent, sentence), Operation: op}
+			}
+			selected = append(selected, sentence)
+		}
+		text = strings.Join(selected, "\n")
+	}
+
+	if len([]rune(text)) > MaxSpeechTextLength {
+		return "", &kernel.Error{Code: kernel.EInvalid, Message: fmt.Sprintf(MSpeechTextTooLong, MaxSpeechTextLength), Operation: op}
+	}
+
+	return text, nil
+}
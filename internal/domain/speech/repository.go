@@ -0,0 +1,33 @@
+package speech
+
+import (
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/post"
+)
+
+// GenerationRepository tracks generations until they finish.
+type GenerationRepository interface {
+	// GetGeneration returns a generation. Returns ENotFound when missing.
+	GetGeneration(generationID kernel.ID[Generation]) (*Generation, error)
+
+	// GetPendingGenerations lists unfinished generations, oldest first.
+	GetPendingGenerations() ([]Generation, error)
+
+	// SaveGeneration stores a generation, replacing its previous state.
+	SaveGeneration(generation Generation) error
+}
+
+// AttachmentRepository stores the audio attached to posts.
+type AttachmentRepository interface {
+	// GetAttachments lists a post's audio, oldest first.
+	GetAttachments(postID kernel.ID[post.Post]) ([]AudioAttachment, error)
+
+	// SaveAttachment attaches audio to a post.
+	SaveAttachment(attachment AudioAttachment) error
+}
+
+// Repository combines all speech operations.
+type Repository interface {
+	GenerationRepository
+	AttachmentRepository
+}
@@ -0,0 +1,190 @@
+package speech
+
+import (
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/policy"
+	"github.com/alnah/fla/internal/domain/post"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+const MSpeechForbidden string = "User cannot add audio to this post."
+
+// SpeechService requests audio for posts, follows generations, and attaches the
+// audio once ready.
+type SpeechService struct {
+	synthesizer SpeechSynthesizer
+	repository  Repository
+	posts       post.PostReader
+	clock       kernel.Clock
+}
+
+// NewSpeechService creates speech service with a synthesizer, generation and
+// attachment storage, and post lookup.
+func NewSpeechService(synthesizer SpeechSynthesizer, repository Repository, posts post.PostReader, clock kernel.Clock) *SpeechService {
+	return &SpeechService{synthesizer: synthesizer, repository: repository, posts: posts, clock: clock}
+}
+
+// Request asks for audio of the post, or of the given sentences of it. A zero
+// speed means DefaultSpeed. Synthesizers answering at once attach the audio
+// before Request returns.
+func (s *SpeechService) Request(generationID kernel.ID[Generation], postID kernel.ID[post.Post], sentences []string, settings Settings, actor user.PostPermissionChecker) (Generation, error) {
+	const op = "SpeechService.Request"
+
+	if err := generationID.Validate(); err != nil {
+		return Generation{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if settings.Speed == 0 {
+		settings.Speed = DefaultSpeed
+	}
+	if err := settings.Validate(); err != nil {
+		return Generation{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	p, err := s.posts.GetByID(postID)
+	if err != nil {
+		return Generation{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if !user.Authorize(actor, policy.PostEdit, user.PostResource(*p)).Allowed {
+		return Generation{}, &kernel.Error{Code: kernel.EForbidden, Message: MSpeechForbidden, Operation: op}
+	}
+
+	text, err := SpeechText(*p, sentences)
+	if err != nil {
+		return Generation{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	job, err := s.synthesizer.Synthesize(Request{Text: text, Settings: settings})
+	if err != nil {
+		return Generation{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	generation := Generation{
+		GenerationID: generationID,
+		PostID:       postID,
+		Text:         text,
+		Sentences:    len(sentences) > 0,
+		Settings:     settings,
+		Status:       StatusPending,
+		RequestedBy:  actor.GetID(),
+		RequestedAt:  s.clock.Now(),
+	}
+
+	generation, err = s.apply(generation, job)
+	if err != nil {
+		return Generation{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return generation, nil
+}
+
+// Refresh asks the synthesizer how a pending generation is going, attaching its
+// audio when ready. Finished generations are returned as they are.
+func (s *SpeechService) Refresh(generationID kernel.ID[Generation]) (Generation, error) {
+	const op = "SpeechService.Refresh"
+
+	generation, err := s.repository.GetGeneration(generationID)
+	if err != nil {
+		return Generation{}, &kernel.Error{Operation: op, Cause: err}
+	}
+	if generation.Status.IsFinal() {
+		return *generation, nil
+	}
+
+	job, err := s.synthesizer.Job(generation.ProviderRef)
+	if err != nil {
+		return Generation{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	updated, err := s.apply(*generation, job)
+	if err != nil {
+		return Generation{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return updated, nil
+}
+
+// RefreshPending refreshes every pending generation, for a background job.
+// A failing generation does not stop the others; the first error is returned
+// after all were tried.
+func (s *SpeechService) RefreshPending() ([]Generation, error) {
+	const op = "SpeechService.RefreshPending"
+
+	pending, err := s.repository.GetPendingGenerations()
+	if err != nil {
+		return nil, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	var finished []Generation
+	var firstErr error
+	for _, g := range pending {
+		updated, err := s.Refresh(g.GenerationID)
+		if err != nil {
+			if firstErr == nil {
+				firstErr = &kernel.Error{Operation: op, Cause: err}
+			}
+			continue
+		}
+		if updated.Status.IsFinal() {
+			finished = append(finished, updated)
+		}
+	}
+
+	return finished, firstErr
+}
+
+// Attachments lists the audio attached to a post.
+func (s *SpeechService) Attachments(postID kernel.ID[post.Post]) ([]AudioAttachment, error) {
+	const op = "SpeechService.Attachments"
+
+	attachments, err := s.repository.GetAttachments(postID)
+	if err != nil {
+		return nil, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return attachments, nil
+}
+
+// apply records the provider's job state and attaches the audio once ready.
+func (s *SpeechService) apply(generation Generation, job Job) (Generation, error) {
+	const op = "SpeechService.apply"
+
+	if err := job.Status.Validate(); err != nil {
+		return Generation{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	generation.Status = job.Status
+	generation.ProviderRef = job.Ref
+	generation.Error = job.Error
+
+	if job.Status == StatusReady {
+		if err := job.Audio.Validate(); err != nil {
+			return Generation{}, &kernel.Error{Operation: op, Cause: err}
+		}
+
+		now := s.clock.Now()
+		attachment := AudioAttachment{
+			PostID:       generation.PostID,
+			GenerationID: generation.GenerationID,
+			URL:          job.Audio,
+			Duration:     job.Duration,
+			Transcript:   generation.Text,
+			Settings:     generation.Settings,
+			CreatedAt:    now,
+		}
+		if err := s.repository.SaveAttachment(attachment); err != nil {
+			return Generation{}, &kernel.Error{Operation: op, Cause: err}
+		}
+		generation.CompletedAt = &now
+	} else if job.Status == StatusFailed {
+		now := s.clock.Now()
+		generation.CompletedAt = &now
+	}
+
+	if err := s.repository.SaveGeneration(generation); err != nil {
+		return Generation{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return generation, nil
+}
@@ -0,0 +1,184 @@
+package speech_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/post"
+	"github.com/alnah/fla/internal/domain/shared"
+	"github.com/alnah/fla/internal/domain/speech"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+// stubSynthesizer answers pending, then whatever jobs holds for the reference.
+type stubSynthesizer struct {
+	immediate *speech.Job
+	jobs      map[string]speech.Job
+	requests  []speech.Request
+}
+
+func (s *stubSynthesizer) Synthesize(r speech.Request) (speech.Job, error) {
+	s.requests = append(s.requests, r)
+	if s.immediate != nil {
+		return *s.immediate, nil
+	}
+	return speech.Job{Ref: "job-1", Status: speech.StatusPending}, nil
+}
+
+func (s *stubSynthesizer) Job(ref string) (speech.Job, error) {
+	job, ok := s.jobs[ref]
+	if !ok {
+		return speech.Job{}, &kernel.Error{Code: kernel.ENotFound, Message: "unknown job"}
+	}
+	return job, nil
+}
+
+type stubRepository struct {
+	generations map[kernel.ID[speech.Generation]]speech.Generation
+	attachments []speech.AudioAttachment
+}
+
+func (s *stubRepository) GetGeneration(id kernel.ID[speech.Generation]) (*speech.Generation, error) {
+	g, ok := s.generations[id]
+	if !ok {
+		return nil, &kernel.Error{Code: kernel.ENotFound, Message: "no generation"}
+	}
+	return &g, nil
+}
+
+func (s *stubRepository) GetPendingGenerations() ([]speech.Generation, error) {
+	var pending []speech.Generation
+	for _, g := range s.generations {
+		if !g.Status.IsFinal() {
+			pending = append(pending, g)
+		}
+	}
+	return pending, nil
+}
+
+func (s *stubRepository) SaveGeneration(g speech.Generation) error {
+	s.generations[g.GenerationID] = g
+	return nil
+}
+
+func (s *stubRepository) GetAttachments(postID kernel.ID[post.Post]) ([]speech.AudioAttachment, error) {
+	return s.attachments, nil
+}
+
+func (s *stubRepository) SaveAttachment(a speech.AudioAttachment) error {
+	s.attachments = append(s.attachments, a)
+	return nil
+}
+
+type stubPosts map[kernel.ID[post.Post]]post.Post
+
+func (s stubPosts) GetByID(postID kernel.ID[post.Post]) (*post.Post, error) {
+	p, ok := s[postID]
+	if !ok {
+		return nil, &kernel.Error{Code: kernel.ENotFound, Message: "no post"}
+	}
+	return &p, nil
+}
+
+func (s stubPosts) GetBySlug(slug shared.Slug) (*post.Post, error) {
+	return nil, &kernel.Error{Code: kernel.ENotFound, Message: "not used"}
+}
+
+var (
+	author = user.User{ID: "author", Roles: []user.Role{user.RoleAuthor}, Status: user.AccountStatusActive}
+	other  = user.User{ID: "other", Roles: []user.Role{user.RoleAuthor}, Status: user.AccountStatusActive}
+
+	lesson = post.Post{
+		PostID:  "lesson",
+		Owner:   "author",
+		Status:  post.StatusDraft,
+		Content: post.PostContent("## Au marché\n\nJe vais au marché le samedi. J'achète des pommes et des poires."),
+	}
+	french = speech.Settings{Locale: shared.LocaleFrenchFR, Speed: 0.8}
+)
+
+func newService(synthesizer *stubSynthesizer) (*speech.SpeechService, *stubRepository) {
+	repo := &stubRepository{generations: make(map[kernel.ID[speech.Generation]]speech.Generation)}
+	clock := &stubClock{t: time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)}
+	return speech.NewSpeechService(synthesizer, repo, stubPosts{"lesson": lesson}, clock), repo
+}
+
+func TestSpeechService_Request(t *testing.T) {
+	t.Run("tracks a pending generation until ready, then attaches the audio", func(t *testing.T) {
+		synthesizer := &stubSynthesizer{jobs: map[string]speech.Job{}}
+		service, repo := newService(synthesizer)
+
+		got, err := service.Request("gen-1", "lesson", nil, french, author)
+
+		assertNoError(t, err)
+		if got.Status != speech.StatusPending || len(repo.attachments) != 0 {
+			t.Fatalf("got %s with %d attachments, want pending", got.Status, len(repo.attachments))
+		}
+		if text := synthesizer.requests[0].Text; strings.Contains(text, "##") || !strings.Contains(text, "pommes") {
+			t.Errorf("got text %q, want the plain content", text)
+		}
+
+		synthesizer.jobs["job-1"] = speech.Job{Ref: "job-1", Status: speech.StatusReady, Audio: "https://cdn.example.com/gen-1.mp3", Duration: 12 * time.Second}
+		finished, err := service.RefreshPending()
+
+		assertNoError(t, err)
+		if len(finished) != 1 || finished[0].CompletedAt == nil {
+			t.Fatalf("got %v, want gen-1 completed", finished)
+		}
+		if len(repo.attachments) != 1 || repo.attachments[0].URL != "https://cdn.example.com/gen-1.mp3" {
+			t.Errorf("got attachments %v", repo.attachments)
+		}
+	})
+
+	t.Run("speaks selected sentences only", func(t *testing.T) {
+		synthesizer := &stubSynthesizer{immediate: &speech.Job{Ref: "job-2", Status: speech.StatusReady, Audio: "https://cdn.example.com/gen-2.mp3"}}
+		service, repo := newService(synthesizer)
+
+		got, err := service.Request("gen-2", "lesson", []string{"J'achète des pommes et des poires."}, speech.Settings{Locale: shared.LocaleFrenchFR}, author)
+
+		assertNoError(t, err)
+		if got.Status != speech.StatusReady || !got.Sentences || got.Settings.Speed != speech.DefaultSpeed {
+			t.Errorf("got %+v", got)
+		}
+		if repo.attachments[0].Transcript != "J'achète des pommes et des poires." {
+			t.Errorf("got transcript %q", repo.attachments[0].Transcript)
+		}
+	})
+
+	t.Run("records failures", func(t *testing.T) {
+		synthesizer := &stubSynthesizer{immediate: &speech.Job{Ref: "job-3", Status: speech.StatusFailed, Error: "voice unavailable"}}
+		service, repo := newService(synthesizer)
+
+		got, err := service.Request("gen-3", "lesson", nil, french, author)
+
+		assertNoError(t, err)
+		if got.Status != speech.StatusFailed || got.Error != "voice unavailable" || len(repo.attachments) != 0 {
+			t.Errorf("got %+v", got)
+		}
+	})
+
+	tests := []struct {
+		name      string
+		sentences []string
+		settings  speech.Settings
+		actor     user.User
+		want      string
+	}{
+		{name: "sentence not in the post", sentences: []string{"Il pleut."}, settings: french, actor: author, want: kernel.EInvalid},
+		{name: "speed out of range", settings: speech.Settings{Locale: shared.LocaleFrenchFR, Speed: 3}, actor: author, want: kernel.EInvalid},
+		{name: "unsupported locale", settings: speech.Settings{Locale: "de-DE"}, actor: author, want: kernel.EInvalid},
+		{name: "users who cannot edit the post", settings: french, actor: other, want: kernel.EForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, _ := newService(&stubSynthesizer{})
+
+			_, err := service.Request("gen-4", "lesson", tt.sentences, tt.settings, tt.actor)
+
+			assertErrorCode(t, err, tt.want)
+		})
+	}
+}
@@ -0,0 +1,276 @@
+package subscription
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/netip"
+	"strings"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+)
+
+const (
+	MaxPolicyVersionLength int = 32
+
+	MConsentSourceInvalid string = "Invalid consent source."
+	MConsentIPInvalid     string = "Invalid IP address for consent record."
+	MConsentIPHashInvalid string = "Consent IP hash must be a SHA-256 hex digest."
+	MConsentMissing       string = "Subscribing requires the subscriber's consent."
+	MConsentFormMissing   string = "Consent given on a form needs the form's URL."
+	MConsentTimeMissing   string = "Consent needs the time it was given."
+)
+
+// PolicyVersion identifies the terms and privacy policy text a subscriber accepted.
+// Typically a publication date such as "2024-05-01".
+type PolicyVersion string
+
+// NewPolicyVersion creates a validated policy version.
+func NewPolicyVersion(version string) (PolicyVersion, error) {
+	const op = "NewPolicyVersion"
+
+	v := PolicyVersion(strings.TrimSpace(version))
+	if err := v.Validate(); err != nil {
+		return "", &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return v, nil
+}
+
+func (v PolicyVersion) String() string { return string(v) }
+
+// Validate ensures the version is present and short.
+func (v PolicyVersion) Validate() error {
+	const op = "PolicyVersion.Validate"
+
+	if err := kernel.ValidatePresence("policy version", v.String(), op); err != nil {
+		return err
+	}
+
+	return kernel.ValidateMaxLength("policy version", v.String(), MaxPolicyVersionLength, op)
+}
+
+// ConsentSource identifies the form or flow where consent was given.
+type ConsentSource string
+
+const (
+	SourceSignupForm ConsentSource = "signup_form" // Newsletter form on the site
+	SourceLessonForm ConsentSource = "lesson_form" // Inline form at the end of a lesson
+	SourceImport     ConsentSource = "import"      // Imported from another platform with proof
+	SourceReconsent  ConsentSource = "reconsent"   // Re-consent campaign after a policy update
+)
+
+func (s ConsentSource) String() string { return string(s) }
+
+// Validate ensures the consent source is known.
+func (s ConsentSource) Validate() error {
+	const op = "ConsentSource.Validate"
+
+	switch s {
+	case SourceSignupForm, SourceLessonForm, SourceImport, SourceReconsent:
+		return nil
+	default:
+		return &kernel.Error{Code: kernel.EInvalid, Message: MConsentSourceInvalid, Operation: op}
+	}
+}
+
+// HashIP returns a salted SHA-256 digest of an IP address.
+// Stored instead of the raw address so consent stays provable without keeping personal data.
+func HashIP(ip, salt string) (string, error) {
+	const op = "HashIP"
+
+	addr, err := netip.ParseAddr(strings.TrimSpace(ip))
+	if err != nil {
+		return "", &kernel.Error{Code: kernel.EInvalid, Message: MConsentIPInvalid, Operation: op, Cause: err}
+	}
+
+	sum := sha256.Sum256([]byte(salt + addr.Unmap().String()))
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// ConsentForm marks URLs of pages holding a signup form.
+type ConsentForm struct{}
+
+// Consent is the lawful basis for emailing a subscriber: where they agreed,
+// to which wording, and when. Subscriptions cannot be created or reactivated
+// without one.
+type Consent struct {
+	Source      ConsentSource
+	FormURL     kernel.URL[ConsentForm] // Page of the form; empty for imports
+	TextVersion PolicyVersion           // Version of the checkbox text agreed to
+	IPHash      string                  // Salted SHA-256 of the client IP, see HashIP; empty when unavailable
+	GivenAt     time.Time
+}
+
+// NewConsent creates a validated consent stamped with the current time.
+func NewConsent(
+	source ConsentSource,
+	formURL kernel.URL[ConsentForm],
+	textVersion PolicyVersion,
+	ipHash string,
+	clock kernel.Clock,
+) (Consent, error) {
+	const op = "NewConsent"
+
+	c := Consent{
+		Source:      source,
+		FormURL:     formURL,
+		TextVersion: textVersion,
+		IPHash:      ipHash,
+		GivenAt:     clock.Now(),
+	}
+
+	if err := c.Validate(); err != nil {
+		return Consent{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return c, nil
+}
+
+// Validate ensures the consent can serve as proof. Imported consents were
+// given on another platform's form, so they carry no URL.
+func (c Consent) Validate() error {
+	const op = "Consent.Validate"
+
+	if err := c.Source.Validate(); err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if c.FormURL == "" && c.Source != SourceImport {
+		return &kernel.Error{Code: kernel.EInvalid, Message: MConsentFormMissing, Operation: op}
+	}
+	if c.FormURL != "" {
+		if err := c.FormURL.Validate(); err != nil {
+			return &kernel.Error{Operation: op, Cause: err}
+		}
+	}
+
+	if err := c.TextVersion.Validate(); err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if err := validateIPHash(c.IPHash, op); err != nil {
+		return err
+	}
+
+	if c.GivenAt.IsZero() {
+		return &kernel.Error{Code: kernel.EInvalid, Message: MConsentTimeMissing, Operation: op}
+	}
+
+	return nil
+}
+
+// IsZero reports whether no consent was captured, as for subscriptions
+// created before consent tracking.
+func (c Consent) IsZero() bool {
+	return c == Consent{}
+}
+
+// Record returns the consent as an entry of the subscriber's consent log.
+func (c Consent) Record(subscriptionID kernel.ID[Subscription]) ConsentRecord {
+	return ConsentRecord{
+		SubscriptionID: subscriptionID,
+		PolicyVersion:  c.TextVersion,
+		Source:         c.Source,
+		IPHash:         c.IPHash,
+		AcceptedAt:     c.GivenAt,
+	}
+}
+
+// String returns a string representation of the consent.
+func (c Consent) String() string {
+	return fmt.Sprintf("Consent{Source: %q, TextVersion: %q, GivenAt: %s}", c.Source, c.TextVersion, c.GivenAt.Format(time.RFC3339))
+}
+
+// ConsentRecord proves a subscriber accepted a policy version at a given time.
+// Records are append-only; a new acceptance never overwrites an earlier one.
+type ConsentRecord struct {
+	SubscriptionID kernel.ID[Subscription]
+	PolicyVersion  PolicyVersion
+	Source         ConsentSource
+	IPHash         string // Salted SHA-256 of the client IP, empty when unavailable (imports)
+	AcceptedAt     time.Time
+}
+
+// NewConsentRecord creates a validated consent record stamped with the current time.
+func NewConsentRecord(
+	subscriptionID kernel.ID[Subscription],
+	version PolicyVersion,
+	source ConsentSource,
+	ipHash string,
+	clock kernel.Clock,
+) (ConsentRecord, error) {
+	const op = "NewConsentRecord"
+
+	r := ConsentRecord{
+		SubscriptionID: subscriptionID,
+		PolicyVersion:  version,
+		Source:         source,
+		IPHash:         ipHash,
+		AcceptedAt:     clock.Now(),
+	}
+
+	if err := r.Validate(); err != nil {
+		return ConsentRecord{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return r, nil
+}
+
+// Validate ensures the record is complete enough to serve as proof.
+func (r ConsentRecord) Validate() error {
+	const op = "ConsentRecord.Validate"
+
+	if err := r.SubscriptionID.Validate(); err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if err := r.PolicyVersion.Validate(); err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if err := r.Source.Validate(); err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return validateIPHash(r.IPHash, op)
+}
+
+// validateIPHash accepts an empty hash or a SHA-256 hex digest.
+func validateIPHash(ipHash, op string) error {
+	if ipHash != "" {
+		if _, err := hex.DecodeString(ipHash); err != nil || len(ipHash) != sha256.Size*2 {
+			return &kernel.Error{Code: kernel.EInvalid, Message: MConsentIPHashInvalid, Operation: op}
+		}
+	}
+	return nil
+}
+
+// String returns a string representation of the consent record.
+func (r ConsentRecord) String() string {
+	return fmt.Sprintf(
+		"ConsentRecord{SubscriptionID: %q, PolicyVersion: %q, Source: %q, AcceptedAt: %s}",
+		r.SubscriptionID, r.PolicyVersion, r.Source, r.AcceptedAt.Format(time.RFC3339),
+	)
+}
+
+// ConsentProof gathers everything needed to answer "when and how did this person consent?".
+type ConsentProof struct {
+	Subscription Subscription
+	Records      []ConsentRecord // Oldest first
+}
+
+// Latest returns the most recent consent, or nil when none was recorded.
+func (p ConsentProof) Latest() *ConsentRecord {
+	if len(p.Records) == 0 {
+		return nil
+	}
+	return &p.Records[len(p.Records)-1]
+}
+
+// Covers reports whether the subscriber accepted the given policy version.
+func (p ConsentProof) Covers(version PolicyVersion) bool {
+	latest := p.Latest()
+	return latest != nil && latest.PolicyVersion == version
+}
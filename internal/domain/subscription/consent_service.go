@@ -0,0 +1,115 @@
+package subscription
+
+import (
+	"github.com/alnah/fla/internal/domain/kernel"
+)
+
+// ReconsentLauncher sends the re-consent campaign to subscribers.
+// Implemented by the email marketing adapter.
+type ReconsentLauncher interface {
+	LaunchReconsent(version PolicyVersion, recipients []Subscription) error
+}
+
+// ConsentService captures and proves subscriber consent to the current policy.
+type ConsentService struct {
+	subscriptions SubscriptionReader
+	consents      ConsentRepository
+	launcher      ReconsentLauncher
+	ipSalt        string
+	clock         kernel.Clock
+}
+
+// NewConsentService creates consent service.
+// The IP salt is a server secret so hashes cannot be reversed by brute force.
+func NewConsentService(
+	subscriptions SubscriptionReader,
+	consents ConsentRepository,
+	launcher ReconsentLauncher,
+	ipSalt string,
+	clock kernel.Clock,
+) *ConsentService {
+	return &ConsentService{
+		subscriptions: subscriptions,
+		consents:      consents,
+		launcher:      launcher,
+		ipSalt:        ipSalt,
+		clock:         clock,
+	}
+}
+
+// RecordConsent stores a subscriber's acceptance of a policy version.
+// The client IP is optional and only its salted hash is kept.
+func (s *ConsentService) RecordConsent(
+	subscriptionID kernel.ID[Subscription],
+	version PolicyVersion,
+	source ConsentSource,
+	clientIP string,
+) (ConsentRecord, error) {
+	const op = "ConsentService.RecordConsent"
+
+	if _, err := s.subscriptions.GetByID(subscriptionID); err != nil {
+		return ConsentRecord{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	ipHash := ""
+	if clientIP != "" {
+		hash, err := HashIP(clientIP, s.ipSalt)
+		if err != nil {
+			return ConsentRecord{}, &kernel.Error{Operation: op, Cause: err}
+		}
+		ipHash = hash
+	}
+
+	record, err := NewConsentRecord(subscriptionID, version, source, ipHash, s.clock)
+	if err != nil {
+		return ConsentRecord{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if err := s.consents.Record(record); err != nil {
+		return ConsentRecord{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return record, nil
+}
+
+// ProveConsent returns the subscription with its full consent history.
+func (s *ConsentService) ProveConsent(subscriptionID kernel.ID[Subscription]) (ConsentProof, error) {
+	const op = "ConsentService.ProveConsent"
+
+	sub, err := s.subscriptions.GetByID(subscriptionID)
+	if err != nil {
+		return ConsentProof{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	records, err := s.consents.GetConsentHistory(subscriptionID)
+	if err != nil {
+		return ConsentProof{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return ConsentProof{Subscription: *sub, Records: records}, nil
+}
+
+// TriggerReconsent launches the re-consent campaign for subscribers behind the current version.
+// Returns the number of subscribers targeted; zero means nothing was sent.
+func (s *ConsentService) TriggerReconsent(current PolicyVersion) (int, error) {
+	const op = "ConsentService.TriggerReconsent"
+
+	if err := current.Validate(); err != nil {
+		return 0, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	recipients, err := s.consents.GetActiveWithoutConsentTo(current)
+	if err != nil {
+		return 0, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if len(recipients) == 0 {
+		return 0, nil
+	}
+
+	if err := s.launcher.LaunchReconsent(current, recipients); err != nil {
+		return 0, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return len(recipients), nil
+}
@@ -0,0 +1,173 @@
+package subscription_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/shared"
+	"github.com/alnah/fla/internal/domain/subscription"
+)
+
+type stubConsentStore struct {
+	subscriptions map[kernel.ID[subscription.Subscription]]subscription.Subscription
+	records       []subscription.ConsentRecord
+}
+
+func (s *stubConsentStore) GetByID(id kernel.ID[subscription.Subscription]) (*subscription.Subscription, error) {
+	if sub, ok := s.subscriptions[id]; ok {
+		return &sub, nil
+	}
+	return nil, &kernel.Error{Code: kernel.ENotFound, Message: "subscription not found"}
+}
+
+func (s *stubConsentStore) GetByEmail(email shared.Email) (*subscription.Subscription, error) {
+	return nil, &kernel.Error{Code: kernel.ENotFound, Message: "subscription not found"}
+}
+
+func (s *stubConsentStore) Record(r subscription.ConsentRecord) error {
+	s.records = append(s.records, r)
+	return nil
+}
+
+func (s *stubConsentStore) GetConsentHistory(id kernel.ID[subscription.Subscription]) ([]subscription.ConsentRecord, error) {
+	var history []subscription.ConsentRecord
+	for _, r := range s.records {
+		if r.SubscriptionID == id {
+			history = append(history, r)
+		}
+	}
+	return history, nil
+}
+
+func (s *stubConsentStore) GetActiveWithoutConsentTo(version subscription.PolicyVersion) ([]subscription.Subscription, error) {
+	var found []subscription.Subscription
+	for id, sub := range s.subscriptions {
+		history, _ := s.GetConsentHistory(id)
+		if sub.IsSubscribed() && !(subscription.ConsentProof{Records: history}).Covers(version) {
+			found = append(found, sub)
+		}
+	}
+	return found, nil
+}
+
+type stubLauncher struct {
+	version    subscription.PolicyVersion
+	recipients []subscription.Subscription
+	err        error
+}
+
+func (l *stubLauncher) LaunchReconsent(v subscription.PolicyVersion, recipients []subscription.Subscription) error {
+	l.version, l.recipients = v, recipients
+	return l.err
+}
+
+func setupConsentService(t *testing.T) (*subscription.ConsentService, *stubConsentStore, *stubLauncher) {
+	t.Helper()
+
+	clock := &stubClock{t: time.Date(2024, 5, 1, 9, 0, 0, 0, time.UTC)}
+	store := &stubConsentStore{subscriptions: map[kernel.ID[subscription.Subscription]]subscription.Subscription{}}
+	for _, id := range []kernel.ID[subscription.Subscription]{"sub-1", "sub-2"} {
+		sub, err := subscription.NewSubscription(subscription.NewSubscriptionParams{
+			SubscriptionID: id,
+			FirstName:      "Marie",
+			Email:          shared.Email(id.String() + "@example.com"),
+			Consent:        testConsent(clock),
+			Clock:          clock,
+		})
+		assertNoError(t, err)
+		store.subscriptions[id] = sub
+	}
+	launcher := &stubLauncher{}
+
+	return subscription.NewConsentService(store, store, launcher, "secret", clock), store, launcher
+}
+
+func TestConsentService_RecordConsent(t *testing.T) {
+	t.Run("stores hashed IP only", func(t *testing.T) {
+		service, store, _ := setupConsentService(t)
+
+		got, err := service.RecordConsent("sub-1", "v1", subscription.SourceSignupForm, "203.0.113.7")
+
+		assertNoError(t, err)
+		want, _ := subscription.HashIP("203.0.113.7", "secret")
+		if got.IPHash != want || len(store.records) != 1 {
+			t.Errorf("got %+v", got)
+		}
+	})
+
+	t.Run("allows missing IP for imports", func(t *testing.T) {
+		service, _, _ := setupConsentService(t)
+
+		got, err := service.RecordConsent("sub-1", "v1", subscription.SourceImport, "")
+
+		assertNoError(t, err)
+		if got.IPHash != "" {
+			t.Errorf("IPHash: got %q", got.IPHash)
+		}
+	})
+
+	t.Run("rejects unknown subscription", func(t *testing.T) {
+		service, _, _ := setupConsentService(t)
+
+		_, err := service.RecordConsent("sub-9", "v1", subscription.SourceSignupForm, "")
+
+		assertError(t, err)
+		assertErrorCode(t, err, kernel.ENotFound)
+	})
+}
+
+func TestConsentService_ProveConsent(t *testing.T) {
+	service, _, _ := setupConsentService(t)
+	_, err := service.RecordConsent("sub-1", "v1", subscription.SourceSignupForm, "")
+	assertNoError(t, err)
+	_, err = service.RecordConsent("sub-1", "v2", subscription.SourceReconsent, "")
+	assertNoError(t, err)
+
+	got, err := service.ProveConsent("sub-1")
+
+	assertNoError(t, err)
+	if len(got.Records) != 2 || !got.Covers("v2") || got.Subscription.SubscriptionID != "sub-1" {
+		t.Errorf("got %+v", got)
+	}
+}
+
+func TestConsentService_TriggerReconsent(t *testing.T) {
+	t.Run("targets subscribers behind current version", func(t *testing.T) {
+		service, _, launcher := setupConsentService(t)
+		_, err := service.RecordConsent("sub-1", "v2", subscription.SourceSignupForm, "")
+		assertNoError(t, err)
+		_, err = service.RecordConsent("sub-2", "v1", subscription.SourceSignupForm, "")
+		assertNoError(t, err)
+
+		got, err := service.TriggerReconsent("v2")
+
+		assertNoError(t, err)
+		if got != 1 || launcher.version != "v2" || launcher.recipients[0].SubscriptionID != "sub-2" {
+			t.Errorf("got %d recipients: %v", got, launcher.recipients)
+		}
+	})
+
+	t.Run("skips campaign when everyone consented", func(t *testing.T) {
+		service, _, launcher := setupConsentService(t)
+		_, _ = service.RecordConsent("sub-1", "v1", subscription.SourceSignupForm, "")
+		_, _ = service.RecordConsent("sub-2", "v1", subscription.SourceSignupForm, "")
+
+		got, err := service.TriggerReconsent("v1")
+
+		assertNoError(t, err)
+		if got != 0 || launcher.recipients != nil {
+			t.Errorf("expected no campaign, got %d", got)
+		}
+	})
+
+	t.Run("propagates launcher errors", func(t *testing.T) {
+		service, _, launcher := setupConsentService(t)
+		launcher.err = errors.New("esp down")
+
+		_, err := service.TriggerReconsent("v1")
+
+		assertError(t, err)
+	})
+}
@@ -0,0 +1,154 @@
+package subscription_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/subscription"
+)
+
+func TestNewPolicyVersion(t *testing.T) {
+	got, err := subscription.NewPolicyVersion(" 2024-05-01 ")
+	assertNoError(t, err)
+	if got != "2024-05-01" {
+		t.Errorf("got %q", got)
+	}
+
+	for _, input := range []string{"", strings.Repeat("v", subscription.MaxPolicyVersionLength+1)} {
+		_, err := subscription.NewPolicyVersion(input)
+		assertError(t, err)
+		assertErrorCode(t, err, kernel.EInvalid)
+	}
+}
+
+func TestHashIP(t *testing.T) {
+	t.Run("is stable and salted", func(t *testing.T) {
+		a, err := subscription.HashIP("203.0.113.7", "salt")
+		assertNoError(t, err)
+		b, _ := subscription.HashIP("203.0.113.7", "salt")
+		c, _ := subscription.HashIP("203.0.113.7", "other")
+
+		if a != b {
+			t.Error("expected same hash for same input")
+		}
+		if a == c {
+			t.Error("expected salt to change the hash")
+		}
+		if len(a) != 64 || strings.Contains(a, "203.0.113.7") {
+			t.Errorf("unexpected hash %q", a)
+		}
+	})
+
+	t.Run("normalizes IPv4-mapped IPv6", func(t *testing.T) {
+		a, _ := subscription.HashIP("203.0.113.7", "salt")
+		b, err := subscription.HashIP("::ffff:203.0.113.7", "salt")
+
+		assertNoError(t, err)
+		if a != b {
+			t.Error("expected mapped address to hash like IPv4")
+		}
+	})
+
+	t.Run("rejects invalid IP", func(t *testing.T) {
+		_, err := subscription.HashIP("not-an-ip", "salt")
+
+		assertError(t, err)
+		assertErrorCode(t, err, kernel.EInvalid)
+	})
+}
+
+func TestNewConsentRecord(t *testing.T) {
+	clock := &stubClock{t: time.Date(2024, 5, 1, 9, 0, 0, 0, time.UTC)}
+	hash, _ := subscription.HashIP("203.0.113.7", "salt")
+
+	t.Run("creates record", func(t *testing.T) {
+		got, err := subscription.NewConsentRecord("sub-1", "2024-05-01", subscription.SourceSignupForm, hash, clock)
+
+		assertNoError(t, err)
+		if !got.AcceptedAt.Equal(clock.t) {
+			t.Errorf("AcceptedAt: got %v", got.AcceptedAt)
+		}
+	})
+
+	t.Run("rejects invalid record", func(t *testing.T) {
+		tests := []struct {
+			name    string
+			id      kernel.ID[subscription.Subscription]
+			version subscription.PolicyVersion
+			source  subscription.ConsentSource
+			ipHash  string
+		}{
+			{name: "missing subscription", version: "v1", source: subscription.SourceSignupForm},
+			{name: "missing version", id: "sub-1", source: subscription.SourceSignupForm},
+			{name: "unknown source", id: "sub-1", version: "v1", source: "popup"},
+			{name: "raw IP instead of hash", id: "sub-1", version: "v1", source: subscription.SourceSignupForm, ipHash: "203.0.113.7"},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				_, err := subscription.NewConsentRecord(tt.id, tt.version, tt.source, tt.ipHash, clock)
+
+				assertError(t, err)
+				assertErrorCode(t, err, kernel.EInvalid)
+			})
+		}
+	})
+}
+
+func TestConsentProof(t *testing.T) {
+	proof := subscription.ConsentProof{}
+	if proof.Latest() != nil || proof.Covers("v1") {
+		t.Error("empty proof must not cover any version")
+	}
+
+	proof.Records = []subscription.ConsentRecord{{PolicyVersion: "v1"}, {PolicyVersion: "v2"}}
+	if !proof.Covers("v2") || proof.Covers("v1") {
+		t.Error("proof must cover only the latest version")
+	}
+}
+
+func TestNewConsent(t *testing.T) {
+	clock := &stubClock{t: time.Date(2024, 5, 1, 9, 0, 0, 0, time.UTC)}
+	ipHash, _ := subscription.HashIP("203.0.113.7", "salt")
+
+	t.Run("stamps the clock time", func(t *testing.T) {
+		got, err := subscription.NewConsent(subscription.SourceLessonForm, "https://fla.example/a1/lecture", "2024-05-01", ipHash, clock)
+		assertNoError(t, err)
+		if !got.GivenAt.Equal(clock.t) {
+			t.Errorf("GivenAt: got %v, want %v", got.GivenAt, clock.t)
+		}
+
+		record := got.Record("sub-1")
+		assertNoError(t, record.Validate())
+		if record.PolicyVersion != "2024-05-01" || record.IPHash != ipHash {
+			t.Errorf("unexpected record %s", record)
+		}
+	})
+
+	t.Run("imports need no form URL", func(t *testing.T) {
+		_, err := subscription.NewConsent(subscription.SourceImport, "", "2024-05-01", "", clock)
+		assertNoError(t, err)
+	})
+
+	tests := []struct {
+		name        string
+		source      subscription.ConsentSource
+		formURL     kernel.URL[subscription.ConsentForm]
+		textVersion subscription.PolicyVersion
+		ipHash      string
+	}{
+		{"unknown source", "popup", "https://fla.example/newsletter", "2024-05-01", ""},
+		{"form without URL", subscription.SourceSignupForm, "", "2024-05-01", ""},
+		{"invalid URL", subscription.SourceSignupForm, "not a url", "2024-05-01", ""},
+		{"missing text version", subscription.SourceSignupForm, "https://fla.example/newsletter", "", ""},
+		{"raw IP", subscription.SourceSignupForm, "https://fla.example/newsletter", "2024-05-01", "203.0.113.7"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := subscription.NewConsent(tt.source, tt.formURL, tt.textVersion, tt.ipHash, clock)
+			assertErrorCode(t, err, kernel.EInvalid)
+		})
+	}
+}
@@ -2,8 +2,10 @@ package subscription
 
 import (
 	"fmt"
+	"slices"
 	"time"
 
+	"github.com/alnah/fla/internal/domain/category"
 	"github.com/alnah/fla/internal/domain/kernel"
 	"github.com/alnah/fla/internal/domain/shared"
 )
@@ -13,6 +15,7 @@ const (
 	MSubscriptionNotFound      string = "Subscription not found."
 	MSubscriptionAlreadyActive string = "Subscription is already active."
 	MSubscriptionNotActive     string = "Subscription is not active."
+	MSubscriptionDisposable    string = "Disposable email addresses cannot subscribe."
 )
 
 // Subscription manages email newsletter enrollment for blog content notifications.
@@ -22,14 +25,20 @@ type Subscription struct {
 	SubscriptionID kernel.ID[Subscription]
 
 	// Subscriber Info
-	FirstName shared.FirstName
-	Email     shared.Email
+	FirstName   shared.FirstName
+	Email       shared.Email
+	RoleAddress bool // Shared mailbox such as info@; delivered to, but watched for complaints
 
 	// Status
 	Status Status
 
 	// Preferences
-	IsActive bool // Quick check for active subscriptions
+	IsActive    bool        // Quick check for active subscriptions
+	Preferences Preferences // Followed categories, email locale, and frequency
+
+	// Consent
+	Consent         Consent   // Lawful basis of the current subscription; zero for subscriptions older than consent tracking
+	PreviousConsent []Consent // Consents of earlier subscriptions, oldest first, kept across resubscribes
 
 	// Meta
 	SubscribedAt   time.Time
@@ -46,24 +55,40 @@ type NewSubscriptionParams struct {
 	SubscriptionID kernel.ID[Subscription]
 	FirstName      shared.FirstName
 	Email          shared.Email
+	Consent        Consent
+
+	// Optional
+	Preferences *Preferences // Defaults to DefaultPreferences when nil
 
 	// DI
 	Clock kernel.Clock
 }
 
 // NewSubscription creates an active email subscription with immediate notification enrollment.
-// Validates email format and subscriber information for reliable delivery.
+// Validates email format and subscriber information for reliable delivery, and
+// requires the subscriber's consent.
 func NewSubscription(p NewSubscriptionParams) (Subscription, error) {
 	const op = "NewSubscription"
 
+	if p.Consent.IsZero() {
+		return Subscription{}, &kernel.Error{Code: kernel.EInvalid, Message: MConsentMissing, Operation: op}
+	}
+
 	now := p.Clock.Now()
 
+	preferences := DefaultPreferences()
+	if p.Preferences != nil {
+		preferences = *p.Preferences
+	}
+
 	subscription := Subscription{
 		SubscriptionID: p.SubscriptionID,
 		FirstName:      p.FirstName,
 		Email:          p.Email,
 		Status:         StatusActive,
 		IsActive:       true,
+		Preferences:    preferences,
+		Consent:        p.Consent,
 		SubscribedAt:   now,
 		UnsubscribedAt: nil,
 		UpdatedAt:      now,
@@ -97,6 +122,22 @@ func (s Subscription) Validate() error {
 		return &kernel.Error{Operation: op, Cause: err}
 	}
 
+	if err := s.Preferences.Validate(); err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if !s.Consent.IsZero() {
+		if err := s.Consent.Validate(); err != nil {
+			return &kernel.Error{Operation: op, Cause: err}
+		}
+	}
+
+	for _, c := range s.PreviousConsent {
+		if err := c.Validate(); err != nil {
+			return &kernel.Error{Operation: op, Cause: err}
+		}
+	}
+
 	return nil
 }
 
@@ -143,8 +184,9 @@ func (s Subscription) Unsubscribe() (Subscription, error) {
 	return updated, nil
 }
 
-// Resubscribe reactivates an unsubscribed subscription
-func (s Subscription) Resubscribe() (Subscription, error) {
+// Resubscribe reactivates an unsubscribed subscription with fresh consent.
+// The consent of the previous subscription moves to PreviousConsent.
+func (s Subscription) Resubscribe(consent Consent) (Subscription, error) {
 	const op = "Subscription.Resubscribe"
 
 	if s.Status == StatusActive {
@@ -164,6 +206,13 @@ func (s Subscription) Resubscribe() (Subscription, error) {
 		}
 	}
 
+	if consent.IsZero() {
+		return s, &kernel.Error{Code: kernel.EInvalid, Message: MConsentMissing, Operation: op}
+	}
+	if err := consent.Validate(); err != nil {
+		return s, &kernel.Error{Operation: op, Cause: err}
+	}
+
 	now := s.Clock.Now()
 
 	updated := s
@@ -171,6 +220,10 @@ func (s Subscription) Resubscribe() (Subscription, error) {
 	updated.IsActive = true
 	updated.UnsubscribedAt = nil
 	updated.UpdatedAt = now
+	updated.Consent = consent
+	if !s.Consent.IsZero() {
+		updated.PreviousConsent = append(slices.Clone(s.PreviousConsent), s.Consent)
+	}
 
 	return updated, nil
 }
@@ -199,6 +252,36 @@ func (s Subscription) MarkAsComplained() (Subscription, error) {
 	return updated, nil
 }
 
+// UpdatePreferences replaces the subscriber's content and delivery preferences.
+// Category existence must be checked beforehand with Preferences.ValidateCategories.
+func (s Subscription) UpdatePreferences(preferences Preferences) (Subscription, error) {
+	const op = "Subscription.UpdatePreferences"
+
+	if err := preferences.Validate(); err != nil {
+		return s, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	updated := s
+	updated.Preferences = preferences
+	updated.UpdatedAt = s.Clock.Now()
+
+	return updated, nil
+}
+
+// IsInterestedIn returns true if the subscription should receive content at the given path.
+// Combines the active status with category and locale preferences.
+func (s Subscription) IsInterestedIn(path category.CategoryPath, locale shared.Locale) bool {
+	if !s.CanReceiveEmails() {
+		return false
+	}
+
+	if locale != "" && s.Preferences.Locale != locale {
+		return false
+	}
+
+	return s.Preferences.IsInterestedIn(path)
+}
+
 // IsSubscribed returns true if subscription is active
 func (s Subscription) IsSubscribed() bool {
 	return s.IsActive && s.Status == StatusActive
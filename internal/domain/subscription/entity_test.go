@@ -4,6 +4,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/alnah/fla/internal/domain/category"
 	"github.com/alnah/fla/internal/domain/kernel"
 	"github.com/alnah/fla/internal/domain/shared"
 	"github.com/alnah/fla/internal/domain/subscription"
@@ -28,6 +29,7 @@ func TestNewSubscription(t *testing.T) {
 			SubscriptionID: validSubscriptionID,
 			FirstName:      validFirstName,
 			Email:          validEmail,
+			Consent:        testConsent(clock),
 			Clock:          clock,
 		}
 
@@ -68,6 +70,7 @@ func TestNewSubscription(t *testing.T) {
 			SubscriptionID: validSubscriptionID,
 			FirstName:      emptyFirstName,
 			Email:          validEmail,
+			Consent:        testConsent(clock),
 			Clock:          clock,
 		}
 
@@ -90,6 +93,7 @@ func TestNewSubscription(t *testing.T) {
 					SubscriptionID: kernel.ID[subscription.Subscription](""),
 					FirstName:      validFirstName,
 					Email:          validEmail,
+					Consent:        testConsent(clock),
 					Clock:          clock,
 				},
 			},
@@ -99,6 +103,7 @@ func TestNewSubscription(t *testing.T) {
 					SubscriptionID: validSubscriptionID,
 					FirstName:      shared.FirstName("a very long name that exceeds the maximum allowed length for first names"),
 					Email:          validEmail,
+					Consent:        testConsent(clock),
 					Clock:          clock,
 				},
 			},
@@ -108,6 +113,7 @@ func TestNewSubscription(t *testing.T) {
 					SubscriptionID: validSubscriptionID,
 					FirstName:      validFirstName,
 					Email:          shared.Email(""),
+					Consent:        testConsent(clock),
 					Clock:          clock,
 				},
 			},
@@ -117,6 +123,26 @@ func TestNewSubscription(t *testing.T) {
 					SubscriptionID: validSubscriptionID,
 					FirstName:      validFirstName,
 					Email:          shared.Email("invalid-email"),
+					Consent:        testConsent(clock),
+					Clock:          clock,
+				},
+			},
+			{
+				name: "missing consent",
+				params: subscription.NewSubscriptionParams{
+					SubscriptionID: validSubscriptionID,
+					FirstName:      validFirstName,
+					Email:          validEmail,
+					Clock:          clock,
+				},
+			},
+			{
+				name: "consent without form URL",
+				params: subscription.NewSubscriptionParams{
+					SubscriptionID: validSubscriptionID,
+					FirstName:      validFirstName,
+					Email:          validEmail,
+					Consent:        subscription.Consent{Source: subscription.SourceSignupForm, TextVersion: "2024-05-01", GivenAt: fixedTime},
 					Clock:          clock,
 				},
 			},
@@ -144,6 +170,7 @@ func TestSubscription_String(t *testing.T) {
 		SubscriptionID: subscriptionID,
 		FirstName:      firstName,
 		Email:          email,
+		Consent:        testConsent(clock),
 		Clock:          clock,
 	}
 
@@ -169,6 +196,7 @@ func TestSubscription_Validate(t *testing.T) {
 			SubscriptionID: subscriptionID,
 			FirstName:      firstName,
 			Email:          email,
+			Consent:        testConsent(clock),
 			Clock:          clock,
 		})
 
@@ -225,6 +253,7 @@ func TestSubscription_Validate(t *testing.T) {
 					SubscriptionID: subscriptionID,
 					FirstName:      firstName,
 					Email:          email,
+					Consent:        testConsent(clock),
 					Clock:          clock,
 				})
 
@@ -253,6 +282,7 @@ func TestSubscription_Unsubscribe(t *testing.T) {
 			SubscriptionID: subscriptionID,
 			FirstName:      firstName,
 			Email:          email,
+			Consent:        testConsent(clock),
 			Clock:          clock,
 		})
 
@@ -328,6 +358,7 @@ func TestSubscription_Resubscribe(t *testing.T) {
 			SubscriptionID: subscriptionID,
 			FirstName:      firstName,
 			Email:          email,
+			Consent:        testConsent(clock),
 			Clock:          clock,
 		})
 
@@ -345,7 +376,7 @@ func TestSubscription_Resubscribe(t *testing.T) {
 		resubscribeTime := fixedTime.Add(48 * time.Hour)
 		clock.t = resubscribeTime
 
-		got, err := sub.Resubscribe()
+		got, err := sub.Resubscribe(testConsent(clock))
 
 		assertNoError(t, err)
 		if got.Status != subscription.StatusActive {
@@ -360,6 +391,23 @@ func TestSubscription_Resubscribe(t *testing.T) {
 		if !got.UpdatedAt.Equal(resubscribeTime) {
 			t.Errorf("UpdatedAt: got %v, want %v", got.UpdatedAt, resubscribeTime)
 		}
+		if !got.Consent.GivenAt.Equal(resubscribeTime) {
+			t.Errorf("Consent.GivenAt: got %v, want %v", got.Consent.GivenAt, resubscribeTime)
+		}
+		if len(got.PreviousConsent) != 1 || !got.PreviousConsent[0].GivenAt.Equal(fixedTime) {
+			t.Errorf("PreviousConsent: got %v, want the first consent", got.PreviousConsent)
+		}
+		if len(sub.PreviousConsent) != 0 {
+			t.Error("expected the original subscription to keep its history")
+		}
+	})
+
+	t.Run("cannot resubscribe without consent", func(t *testing.T) {
+		sub := createUnsubscribedSubscription()
+
+		_, err := sub.Resubscribe(subscription.Consent{})
+
+		assertErrorCode(t, err, kernel.EInvalid)
 	})
 
 	t.Run("cannot resubscribe active subscription", func(t *testing.T) {
@@ -371,10 +419,11 @@ func TestSubscription_Resubscribe(t *testing.T) {
 			SubscriptionID: subscriptionID,
 			FirstName:      firstName,
 			Email:          email,
+			Consent:        testConsent(clock),
 			Clock:          clock,
 		})
 
-		_, err := sub.Resubscribe()
+		_, err := sub.Resubscribe(testConsent(clock))
 
 		assertError(t, err)
 		assertErrorCode(t, err, kernel.EConflict)
@@ -384,7 +433,7 @@ func TestSubscription_Resubscribe(t *testing.T) {
 		sub := createUnsubscribedSubscription()
 		sub.Status = subscription.StatusBounced
 
-		_, err := sub.Resubscribe()
+		_, err := sub.Resubscribe(testConsent(clock))
 
 		assertError(t, err)
 		assertErrorCode(t, err, kernel.EInvalid)
@@ -394,7 +443,7 @@ func TestSubscription_Resubscribe(t *testing.T) {
 		sub := createUnsubscribedSubscription()
 		sub.Status = subscription.StatusComplained
 
-		_, err := sub.Resubscribe()
+		_, err := sub.Resubscribe(testConsent(clock))
 
 		assertError(t, err)
 		assertErrorCode(t, err, kernel.EInvalid)
@@ -414,6 +463,7 @@ func TestSubscription_MarkAsBounced(t *testing.T) {
 			SubscriptionID: subscriptionID,
 			FirstName:      firstName,
 			Email:          email,
+			Consent:        testConsent(clock),
 			Clock:          clock,
 		})
 
@@ -476,6 +526,7 @@ func TestSubscription_MarkAsComplained(t *testing.T) {
 			SubscriptionID: subscriptionID,
 			FirstName:      firstName,
 			Email:          email,
+			Consent:        testConsent(clock),
 			Clock:          clock,
 		})
 
@@ -528,6 +579,7 @@ func TestSubscription_IsSubscribed(t *testing.T) {
 				SubscriptionID: subscriptionID,
 				FirstName:      firstName,
 				Email:          email,
+				Consent:        testConsent(clock),
 				Clock:          clock,
 			})
 
@@ -555,6 +607,7 @@ func TestSubscription_CanReceiveEmails(t *testing.T) {
 		SubscriptionID: subscriptionID,
 		FirstName:      firstName,
 		Email:          email,
+		Consent:        testConsent(clock),
 		Clock:          clock,
 	})
 
@@ -587,6 +640,7 @@ func TestSubscription_GetDisplayName(t *testing.T) {
 			SubscriptionID: subscriptionID,
 			FirstName:      firstName,
 			Email:          email,
+			Consent:        testConsent(clock),
 			Clock:          clock,
 		})
 
@@ -605,6 +659,7 @@ func TestSubscription_GetDisplayName(t *testing.T) {
 			SubscriptionID: subscriptionID,
 			FirstName:      emptyFirstName,
 			Email:          email,
+			Consent:        testConsent(clock),
 			Clock:          clock,
 		})
 
@@ -616,3 +671,86 @@ func TestSubscription_GetDisplayName(t *testing.T) {
 		}
 	})
 }
+
+func TestSubscription_Preferences(t *testing.T) {
+	fixedTime := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+	clock := &stubClock{t: fixedTime}
+	subscriptionID, _ := kernel.NewID[subscription.Subscription]("sub-123")
+	email, _ := shared.NewEmail("john@example.com")
+
+	newSub := func(t *testing.T, prefs *subscription.Preferences) subscription.Subscription {
+		t.Helper()
+		sub, err := subscription.NewSubscription(subscription.NewSubscriptionParams{
+			SubscriptionID: subscriptionID,
+			Email:          email,
+			Preferences:    prefs,
+			Consent:        testConsent(clock),
+			Clock:          clock,
+		})
+		assertNoError(t, err)
+		return sub
+	}
+
+	t.Run("applies default preferences", func(t *testing.T) {
+		sub := newSub(t, nil)
+
+		if sub.Preferences.Frequency != subscription.FrequencyInstant {
+			t.Errorf("Frequency: got %q, want %q", sub.Preferences.Frequency, subscription.FrequencyInstant)
+		}
+		if !sub.Preferences.FollowsAllCategories() {
+			t.Error("expected default preferences to follow all categories")
+		}
+	})
+
+	t.Run("rejects invalid preferences at creation", func(t *testing.T) {
+		_, err := subscription.NewSubscription(subscription.NewSubscriptionParams{
+			SubscriptionID: subscriptionID,
+			Email:          email,
+			Preferences:    &subscription.Preferences{Locale: shared.LocaleFrenchFR, Frequency: "hourly"},
+			Consent:        testConsent(clock),
+			Clock:          clock,
+		})
+
+		assertError(t, err)
+		assertErrorCode(t, err, kernel.EInvalid)
+	})
+
+	t.Run("updates preferences", func(t *testing.T) {
+		sub := newSub(t, nil)
+		later := fixedTime.Add(time.Hour)
+		clock.t = later
+		defer func() { clock.t = fixedTime }()
+		prefs, _ := subscription.NewPreferences([]kernel.ID[category.Category]{"a1"}, shared.LocalePortugueseBR, subscription.FrequencyWeeklyDigest)
+
+		got, err := sub.UpdatePreferences(prefs)
+
+		assertNoError(t, err)
+		if got.Preferences.Locale != shared.LocalePortugueseBR {
+			t.Errorf("Locale: got %q", got.Preferences.Locale)
+		}
+		if !got.UpdatedAt.Equal(later) {
+			t.Errorf("UpdatedAt: got %v, want %v", got.UpdatedAt, later)
+		}
+	})
+
+	t.Run("matches interested active subscribers", func(t *testing.T) {
+		prefs, _ := subscription.NewPreferences([]kernel.ID[category.Category]{"a1"}, shared.LocaleFrenchFR, subscription.FrequencyInstant)
+		sub := newSub(t, &prefs)
+		path := categoryPath("a1", "a1-listening")
+
+		if !sub.IsInterestedIn(path, shared.LocaleFrenchFR) {
+			t.Error("expected subscriber to be interested")
+		}
+		if !sub.IsInterestedIn(path, "") {
+			t.Error("expected empty locale to match any locale")
+		}
+		if sub.IsInterestedIn(path, shared.LocaleEnglishUS) {
+			t.Error("expected locale mismatch to exclude subscriber")
+		}
+
+		unsubscribed, _ := sub.Unsubscribe()
+		if unsubscribed.IsInterestedIn(path, shared.LocaleFrenchFR) {
+			t.Error("expected unsubscribed subscriber to be excluded")
+		}
+	})
+}
@@ -0,0 +1,310 @@
+package subscription
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/category"
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/shared"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+// PortabilityFormat names the layout of subscriber data exports; bump it
+// whenever a field is renamed or changes meaning.
+const PortabilityFormat string = "fla.subscriber-export/v1"
+
+const (
+	MExportForbidden      string = "Only admins can export subscribers."
+	MExportSerializeFail  string = "Subscriber data could not be serialized."
+	MExportCSVWriteFailed string = "Subscribers could not be exported as CSV."
+)
+
+// StatusChange records one status transition, e.g. active to unsubscribed.
+type StatusChange struct {
+	SubscriptionID kernel.ID[Subscription] `json:"-"`
+	From           Status                  `json:"from,omitempty"` // Empty for the initial status
+	To             Status                  `json:"to"`
+	ChangedAt      time.Time               `json:"changed_at"`
+}
+
+// DeliveryEventKind is what happened to an email sent to a subscriber.
+type DeliveryEventKind string
+
+const (
+	DeliverySent       DeliveryEventKind = "sent"
+	DeliveryDelivered  DeliveryEventKind = "delivered"
+	DeliveryOpened     DeliveryEventKind = "opened"
+	DeliveryClicked    DeliveryEventKind = "clicked"
+	DeliveryBounced    DeliveryEventKind = "bounced"
+	DeliveryComplained DeliveryEventKind = "complained"
+)
+
+func (k DeliveryEventKind) String() string { return string(k) }
+
+// DeliveryEvent is one mailer event about an email sent to a subscriber.
+type DeliveryEvent struct {
+	SubscriptionID kernel.ID[Subscription] `json:"-"`
+	Kind           DeliveryEventKind       `json:"kind"`
+	MessageID      string                  `json:"message_id"`         // Mailer reference of the email
+	Campaign       string                  `json:"campaign,omitempty"` // UTM campaign, e.g. "weekly-digest-2024-w36"
+	OccurredAt     time.Time               `json:"occurred_at"`
+}
+
+// PortabilityRecord is everything stored about a subscriber, in a documented
+// machine-readable layout they can take to another service.
+type PortabilityRecord struct {
+	Format        string            `json:"format"`
+	ExportedAt    time.Time         `json:"exported_at"`
+	Profile       PortableProfile   `json:"profile"`
+	StatusHistory []StatusChange    `json:"status_history"`
+	Consents      []PortableConsent `json:"consents"`
+	Deliveries    []DeliveryEvent   `json:"deliveries"`
+}
+
+// PortableProfile is the subscription itself.
+type PortableProfile struct {
+	SubscriptionID string     `json:"subscription_id"`
+	FirstName      string     `json:"first_name,omitempty"`
+	Email          string     `json:"email"`
+	RoleAddress    bool       `json:"role_address"`
+	Status         Status     `json:"status"`
+	Categories     []string   `json:"categories"` // Empty means every category
+	Locale         string     `json:"locale"`
+	Frequency      Frequency  `json:"frequency"`
+	SubscribedAt   time.Time  `json:"subscribed_at"`
+	UnsubscribedAt *time.Time `json:"unsubscribed_at,omitempty"`
+	UpdatedAt      time.Time  `json:"updated_at"`
+}
+
+// PortableConsent is one consent record, or one consent given at subscribing.
+type PortableConsent struct {
+	PolicyVersion string    `json:"policy_version"`
+	Source        string    `json:"source"`
+	FormURL       string    `json:"form_url,omitempty"`
+	IPHash        string    `json:"ip_hash,omitempty"`
+	AcceptedAt    time.Time `json:"accepted_at"`
+}
+
+// ToJSON serializes the record for download.
+func (r PortabilityRecord) ToJSON() ([]byte, error) {
+	const op = "PortabilityRecord.ToJSON"
+
+	data, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return nil, &kernel.Error{Code: kernel.EInternal, Message: MExportSerializeFail, Operation: op, Cause: err}
+	}
+	return data, nil
+}
+
+// Segment narrows a bulk export; empty fields do not filter.
+type Segment struct {
+	CategoryID *kernel.ID[category.Category] // Subscribers following the category or one of its ancestors
+	Locale     shared.Locale
+	Frequency  Frequency
+}
+
+// ExportService answers data portability requests and exports subscriber lists.
+type ExportService struct {
+	subscriptions SubscriptionAdmin
+	segments      SegmentTargeter
+	consents      ConsentReader
+	history       StatusHistoryReader
+	deliveries    DeliveryEventReader
+	categories    category.CategoryPathBuilder
+	clock         kernel.Clock
+}
+
+// NewExportService creates export service with subscriber storage and history,
+// and category paths for segments.
+func NewExportService(
+	subscriptions SubscriptionAdmin,
+	segments SegmentTargeter,
+	consents ConsentReader,
+	history StatusHistoryReader,
+	deliveries DeliveryEventReader,
+	categories category.CategoryPathBuilder,
+	clock kernel.Clock,
+) *ExportService {
+	return &ExportService{
+		subscriptions: subscriptions,
+		segments:      segments,
+		consents:      consents,
+		history:       history,
+		deliveries:    deliveries,
+		categories:    categories,
+		clock:         clock,
+	}
+}
+
+// Export gathers everything stored about a subscriber. Callers first confirm
+// the requester owns the address, e.g. through a link sent to it.
+func (s *ExportService) Export(subscriptionID kernel.ID[Subscription]) (PortabilityRecord, error) {
+	const op = "ExportService.Export"
+
+	sub, err := s.subscriptions.GetByID(subscriptionID)
+	if err != nil {
+		return PortabilityRecord{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	history, err := s.history.GetStatusHistory(subscriptionID)
+	if err != nil {
+		return PortabilityRecord{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	consents, err := s.consents.GetConsentHistory(subscriptionID)
+	if err != nil {
+		return PortabilityRecord{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	deliveries, err := s.deliveries.GetDeliveryEvents(subscriptionID)
+	if err != nil {
+		return PortabilityRecord{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	record := PortabilityRecord{
+		Format:        PortabilityFormat,
+		ExportedAt:    s.clock.Now(),
+		Profile:       portableProfile(*sub),
+		StatusHistory: slices.Clone(history),
+		Consents:      make([]PortableConsent, 0, len(consents)+len(sub.PreviousConsent)+1),
+		Deliveries:    slices.Clone(deliveries),
+	}
+	for _, c := range consents {
+		record.Consents = append(record.Consents, PortableConsent{
+			PolicyVersion: c.PolicyVersion.String(),
+			Source:        c.Source.String(),
+			IPHash:        c.IPHash,
+			AcceptedAt:    c.AcceptedAt,
+		})
+	}
+	// Consents given at subscribing, oldest first; legacy subscriptions have none
+	for _, c := range append(slices.Clone(sub.PreviousConsent), sub.Consent) {
+		if c.IsZero() {
+			continue
+		}
+		record.Consents = append(record.Consents, PortableConsent{
+			PolicyVersion: c.TextVersion.String(),
+			Source:        c.Source.String(),
+			FormURL:       c.FormURL.String(),
+			IPHash:        c.IPHash,
+			AcceptedAt:    c.GivenAt,
+		})
+	}
+	// Empty lists, not null, so readers need no special case
+	if record.StatusHistory == nil {
+		record.StatusHistory = []StatusChange{}
+	}
+	if record.Deliveries == nil {
+		record.Deliveries = []DeliveryEvent{}
+	}
+
+	return record, nil
+}
+
+// ExportActiveCSV writes the active subscribers of a segment as CSV, ordered
+// by email, and returns how many were written. Values a spreadsheet would run
+// as formulas are prefixed with an apostrophe.
+func (s *ExportService) ExportActiveCSV(w io.Writer, segment Segment, actor user.PostPermissionChecker) (int, error) {
+	const op = "ExportService.ExportActiveCSV"
+
+	if !user.IsActiveAdmin(actor) {
+		return 0, &kernel.Error{Code: kernel.EForbidden, Message: MExportForbidden, Operation: op}
+	}
+
+	subs, err := s.activeIn(segment)
+	if err != nil {
+		return 0, &kernel.Error{Operation: op, Cause: err}
+	}
+	slices.SortFunc(subs, func(a, b Subscription) int {
+		return strings.Compare(a.Email.Canonical().String(), b.Email.Canonical().String())
+	})
+
+	out := csv.NewWriter(w)
+	if err := out.Write([]string{"subscription_id", "first_name", "email", "locale", "frequency", "categories", "subscribed_at"}); err != nil {
+		return 0, &kernel.Error{Code: kernel.EInternal, Message: MExportCSVWriteFailed, Operation: op, Cause: err}
+	}
+	for _, sub := range subs {
+		profile := portableProfile(sub)
+		record := []string{
+			profile.SubscriptionID,
+			profile.FirstName,
+			profile.Email,
+			profile.Locale,
+			profile.Frequency.String(),
+			strings.Join(profile.Categories, " "),
+			profile.SubscribedAt.Format(time.RFC3339),
+		}
+		for i := range record {
+			record[i] = spreadsheetSafe(record[i])
+		}
+		if err := out.Write(record); err != nil {
+			return 0, &kernel.Error{Code: kernel.EInternal, Message: MExportCSVWriteFailed, Operation: op, Cause: err}
+		}
+	}
+	out.Flush()
+	if err := out.Error(); err != nil {
+		return 0, &kernel.Error{Code: kernel.EInternal, Message: MExportCSVWriteFailed, Operation: op, Cause: err}
+	}
+
+	return len(subs), nil
+}
+
+// activeIn returns the active subscribers of a segment.
+func (s *ExportService) activeIn(segment Segment) ([]Subscription, error) {
+	const op = "ExportService.activeIn"
+
+	var subs []Subscription
+	var err error
+	if segment.CategoryID != nil {
+		path, pathErr := s.categories.BuildPath(*segment.CategoryID)
+		if pathErr != nil {
+			return nil, &kernel.Error{Operation: op, Cause: pathErr}
+		}
+		subs, err = s.segments.GetActiveSubscribersInterestedIn(path, segment.Locale)
+	} else {
+		subs, err = s.subscriptions.GetActiveSubscriptions()
+	}
+	if err != nil {
+		return nil, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return slices.DeleteFunc(subs, func(sub Subscription) bool {
+		return !sub.IsSubscribed() ||
+			(segment.Locale != "" && sub.Preferences.Locale != segment.Locale) ||
+			(segment.Frequency != "" && sub.Preferences.Frequency != segment.Frequency)
+	}), nil
+}
+
+func portableProfile(sub Subscription) PortableProfile {
+	categories := make([]string, len(sub.Preferences.CategoryIDs))
+	for i, id := range sub.Preferences.CategoryIDs {
+		categories[i] = id.String()
+	}
+
+	return PortableProfile{
+		SubscriptionID: sub.SubscriptionID.String(),
+		FirstName:      sub.FirstName.String(),
+		Email:          sub.Email.String(),
+		RoleAddress:    sub.RoleAddress,
+		Status:         sub.Status,
+		Categories:     categories,
+		Locale:         sub.Preferences.Locale.String(),
+		Frequency:      sub.Preferences.Frequency,
+		SubscribedAt:   sub.SubscribedAt,
+		UnsubscribedAt: sub.UnsubscribedAt,
+		UpdatedAt:      sub.UpdatedAt,
+	}
+}
+
+// spreadsheetSafe defuses values a spreadsheet would evaluate, e.g. "=HYPERLINK(...)".
+func spreadsheetSafe(value string) string {
+	if value != "" && strings.ContainsRune("=+-@\t\r", rune(value[0])) {
+		return "'" + value
+	}
+	return value
+}
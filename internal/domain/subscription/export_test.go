@@ -0,0 +1,198 @@
+package subscription_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/category"
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/shared"
+	"github.com/alnah/fla/internal/domain/subscription"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+// stubExportStore serves subscribers with their history and consents.
+type stubExportStore struct {
+	stubSignupStore
+	history    []subscription.StatusChange
+	consents   []subscription.ConsentRecord
+	deliveries []subscription.DeliveryEvent
+	segmentArg category.CategoryPath
+}
+
+func (s *stubExportStore) GetActiveSubscriptions() ([]subscription.Subscription, error) {
+	var out []subscription.Subscription
+	for _, sub := range s.subscriptions {
+		if sub.IsSubscribed() {
+			out = append(out, sub)
+		}
+	}
+	return out, nil
+}
+
+func (s *stubExportStore) GetAllSubscriptions() ([]subscription.Subscription, error) {
+	return s.GetActiveSubscriptions()
+}
+
+func (s *stubExportStore) GetActiveSubscribersInterestedIn(path category.CategoryPath, locale shared.Locale) ([]subscription.Subscription, error) {
+	s.segmentArg = path
+	var out []subscription.Subscription
+	for _, sub := range s.subscriptions {
+		if sub.IsInterestedIn(path, locale) {
+			out = append(out, sub)
+		}
+	}
+	return out, nil
+}
+
+func (s *stubExportStore) GetConsentHistory(kernel.ID[subscription.Subscription]) ([]subscription.ConsentRecord, error) {
+	return s.consents, nil
+}
+
+func (s *stubExportStore) GetActiveWithoutConsentTo(subscription.PolicyVersion) ([]subscription.Subscription, error) {
+	return nil, nil
+}
+
+func (s *stubExportStore) GetStatusHistory(kernel.ID[subscription.Subscription]) ([]subscription.StatusChange, error) {
+	return s.history, nil
+}
+
+func (s *stubExportStore) GetDeliveryEvents(kernel.ID[subscription.Subscription]) ([]subscription.DeliveryEvent, error) {
+	return s.deliveries, nil
+}
+
+type stubExportPaths struct{}
+
+func (stubExportPaths) BuildPath(id kernel.ID[category.Category]) (category.CategoryPath, error) {
+	return category.CategoryPath{{CategoryID: id, Name: "A1", Slug: "a1"}}, nil
+}
+
+func (stubExportPaths) FindByPath([]string) (*category.Category, error) {
+	return nil, &kernel.Error{Code: kernel.ENotFound, Message: "category not found"}
+}
+
+func TestExportService(t *testing.T) {
+	clock := &stubClock{t: time.Date(2024, 5, 1, 9, 0, 0, 0, time.UTC)}
+	admin := user.User{ID: "admin-1", Roles: []user.Role{user.RoleAdmin}}
+
+	newStore := func(t *testing.T) *stubExportStore {
+		t.Helper()
+		store := &stubExportStore{stubSignupStore: stubSignupStore{subscriptions: map[kernel.ID[subscription.Subscription]]subscription.Subscription{}}}
+		for _, s := range []struct {
+			id, name, email string
+			prefs           subscription.Preferences
+		}{
+			{"s1", "Zoé", "zoe@example.com", subscription.Preferences{Locale: shared.LocaleFrenchFR, Frequency: subscription.FrequencyInstant}},
+			{"s2", "=cmd()", "ana@example.com", subscription.Preferences{CategoryIDs: []kernel.ID[category.Category]{"a1"}, Locale: shared.LocaleEnglishUS, Frequency: subscription.FrequencyWeeklyDigest}},
+			{"s3", "Paul", "paul@example.com", subscription.DefaultPreferences()},
+		} {
+			prefs := s.prefs
+			sub, err := subscription.NewSubscription(subscription.NewSubscriptionParams{
+				SubscriptionID: kernel.ID[subscription.Subscription](s.id),
+				FirstName:      shared.FirstName(s.name),
+				Email:          shared.Email(s.email),
+				Preferences:    &prefs,
+				Consent:        testConsent(clock),
+				Clock:          clock,
+			})
+			assertNoError(t, err)
+			store.subscriptions[sub.SubscriptionID] = sub
+		}
+		paul, err := store.subscriptions["s3"].Unsubscribe()
+		assertNoError(t, err)
+		store.subscriptions["s3"] = paul
+		return store
+	}
+
+	t.Run("exports everything stored about a subscriber", func(t *testing.T) {
+		store := newStore(t)
+		unsubscribedAt := clock.t
+		store.history = []subscription.StatusChange{
+			{SubscriptionID: "s3", To: subscription.StatusActive, ChangedAt: clock.t},
+			{SubscriptionID: "s3", From: subscription.StatusActive, To: subscription.StatusUnsubscribed, ChangedAt: unsubscribedAt},
+		}
+		store.consents = []subscription.ConsentRecord{{SubscriptionID: "s3", PolicyVersion: "2024-05-01", Source: subscription.SourceSignupForm, AcceptedAt: clock.t}}
+		service := subscription.NewExportService(store, store, store, store, store, stubExportPaths{}, clock)
+
+		record, err := service.Export("s3")
+		assertNoError(t, err)
+
+		data, err := record.ToJSON()
+		assertNoError(t, err)
+		var decoded map[string]any
+		assertNoError(t, json.Unmarshal(data, &decoded))
+
+		if decoded["format"] != subscription.PortabilityFormat {
+			t.Errorf("format: got %v", decoded["format"])
+		}
+		profile := decoded["profile"].(map[string]any)
+		if profile["email"] != "paul@example.com" || profile["status"] != "unsubscribed" || profile["unsubscribed_at"] == nil {
+			t.Errorf("profile: got %v", profile)
+		}
+		if got := decoded["status_history"].([]any); len(got) != 2 {
+			t.Errorf("status history: got %v", got)
+		}
+		got := decoded["consents"].([]any)
+		if len(got) != 2 || got[0].(map[string]any)["policy_version"] != "2024-05-01" {
+			t.Fatalf("consents: got %v", got)
+		}
+		if form := got[1].(map[string]any)["form_url"]; form != "https://fla.example/newsletter" {
+			t.Errorf("subscription consent form: got %v", form)
+		}
+		if got, ok := decoded["deliveries"].([]any); !ok || len(got) != 0 {
+			t.Errorf("deliveries should be an empty list: got %v", decoded["deliveries"])
+		}
+	})
+
+	t.Run("unknown subscribers are not found", func(t *testing.T) {
+		store := newStore(t)
+		service := subscription.NewExportService(store, store, store, store, store, stubExportPaths{}, clock)
+
+		_, err := service.Export("missing")
+		assertErrorCode(t, err, kernel.ENotFound)
+	})
+
+	t.Run("exports active subscribers as CSV", func(t *testing.T) {
+		store := newStore(t)
+		service := subscription.NewExportService(store, store, store, store, store, stubExportPaths{}, clock)
+
+		var out strings.Builder
+		n, err := service.ExportActiveCSV(&out, subscription.Segment{}, admin)
+		assertNoError(t, err)
+
+		lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+		if n != 2 || len(lines) != 3 {
+			t.Fatalf("rows: got %d\n%s", n, out.String())
+		}
+		if !strings.HasPrefix(lines[1], "s2,'=cmd(),ana@example.com,en-US,weekly_digest,a1,") {
+			t.Errorf("first row: got %q", lines[1])
+		}
+	})
+
+	t.Run("filters by segment", func(t *testing.T) {
+		store := newStore(t)
+		service := subscription.NewExportService(store, store, store, store, store, stubExportPaths{}, clock)
+		a1 := kernel.ID[category.Category]("a1")
+
+		var out strings.Builder
+		n, err := service.ExportActiveCSV(&out, subscription.Segment{CategoryID: &a1, Frequency: subscription.FrequencyInstant}, admin)
+		assertNoError(t, err)
+		if n != 1 || !strings.Contains(out.String(), "zoe@example.com") {
+			t.Errorf("segment: got %d\n%s", n, out.String())
+		}
+		if len(store.segmentArg) != 1 {
+			t.Errorf("segment path: got %v", store.segmentArg)
+		}
+	})
+
+	t.Run("bulk export requires an admin", func(t *testing.T) {
+		store := newStore(t)
+		service := subscription.NewExportService(store, store, store, store, store, stubExportPaths{}, clock)
+		editor := user.User{ID: "editor-1", Roles: []user.Role{user.RoleEditor}}
+
+		_, err := service.ExportActiveCSV(&strings.Builder{}, subscription.Segment{}, editor)
+		assertErrorCode(t, err, kernel.EForbidden)
+	})
+}
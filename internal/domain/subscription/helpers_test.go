@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/shared"
+	"github.com/alnah/fla/internal/domain/subscription"
 )
 
 // Test helpers
@@ -28,3 +30,49 @@ func assertErrorCode(t *testing.T, err error, want string) {
 		t.Errorf("error code: got %q, want %q", got, want)
 	}
 }
+
+type stubIdempotencyStore struct {
+	records map[string]kernel.IdempotencyRecord
+}
+
+func newStubIdempotencyStore() *stubIdempotencyStore {
+	return &stubIdempotencyStore{records: map[string]kernel.IdempotencyRecord{}}
+}
+
+func (s *stubIdempotencyStore) GetRecord(scope string, key kernel.IdempotencyKey) (*kernel.IdempotencyRecord, error) {
+	r, ok := s.records[scope+"/"+key.String()]
+	if !ok {
+		return nil, &kernel.Error{Code: kernel.ENotFound, Message: "no record"}
+	}
+	return &r, nil
+}
+
+func (s *stubIdempotencyStore) ReserveRecord(r kernel.IdempotencyRecord) (*kernel.IdempotencyRecord, error) {
+	if existing, ok := s.records[r.Scope+"/"+r.Key.String()]; ok && !existing.IsExpired(r.CreatedAt) {
+		return &existing, nil
+	}
+	s.records[r.Scope+"/"+r.Key.String()] = r
+	return nil, nil
+}
+
+func (s *stubIdempotencyStore) SaveRecord(r kernel.IdempotencyRecord) error {
+	s.records[r.Scope+"/"+r.Key.String()] = r
+	return nil
+}
+
+// testConsent returns a signup form consent given at the clock's time.
+func testConsent(clock kernel.Clock) subscription.Consent {
+	return subscription.Consent{
+		Source:      subscription.SourceSignupForm,
+		FormURL:     "https://fla.example/newsletter",
+		TextVersion: "2024-05-01",
+		GivenAt:     clock.Now(),
+	}
+}
+
+// stubSuppressions lists suppressed canonical addresses.
+type stubSuppressions map[shared.Email]bool
+
+func (s stubSuppressions) IsSuppressed(email shared.Email) (bool, error) {
+	return s[email.Canonical()], nil
+}
@@ -0,0 +1,358 @@
+package subscription
+
+import (
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/alnah/fla/internal/domain/importer"
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/shared"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+// MaxImportRows bounds a subscriber list so one import cannot stall the mailer.
+const MaxImportRows int = 50000
+
+// ImportSource names subscriber imports in validation reports.
+const ImportSource string = "csv-subscribers"
+
+const (
+	MImportForbidden      string = "Only admins can import subscribers."
+	MImportHasErrors      string = "Fix the import errors before committing."
+	MImportCSVInvalid     string = "Subscriber list is not valid CSV."
+	MImportColumnMissing  string = "Subscriber list needs a %q column."
+	MImportTooManyRows    string = "A subscriber list can have at most %d rows."
+	MImportStatusUnknown  string = "Unknown status %q."
+	MImportDuplicate      string = "Same inbox as line %d; only the first row is imported."
+	MImportExisting       string = "Already subscribed; left as is."
+	MImportExistingClosed string = "Previously %s; left as is."
+	MImportRoleAddress    string = "Shared mailbox; imported and watched for complaints."
+	MImportSuppressed     string = "On the suppression list; not imported."
+)
+
+// Finding rules of subscriber imports.
+const (
+	RuleImportEmail      = "subscriber.email.format"
+	RuleImportFirstName  = "subscriber.first_name"
+	RuleImportDisposable = "subscriber.email.disposable"
+	RuleImportRole       = "subscriber.email.role"
+	RuleImportStatus     = "subscriber.status"
+	RuleImportDuplicate  = "subscriber.duplicate"
+	RuleImportExisting   = "subscriber.existing"
+	RuleImportSuppressed = "subscriber.suppressed"
+)
+
+// importColumns maps accepted header names to columns; headers are compared
+// lowercased with spaces and hyphens as underscores.
+var importColumns = map[string]string{
+	"first_name":    "first_name",
+	"firstname":     "first_name",
+	"email":         "email",
+	"email_address": "email",
+	"status":        "status",
+}
+
+// importStatuses maps the statuses of common newsletter tools to ours. An
+// empty status means subscribed.
+var importStatuses = map[string]Status{
+	"":             StatusActive,
+	"active":       StatusActive,
+	"subscribed":   StatusActive,
+	"unsubscribed": StatusUnsubscribed,
+	"bounced":      StatusBounced,
+	"cleaned":      StatusBounced,
+	"complained":   StatusComplained,
+}
+
+// ImportPlan is the outcome of a dry run: the subscriptions Commit would
+// create, and why other rows were left out.
+type ImportPlan struct {
+	Report        *importer.ValidationReport
+	Subscriptions []Subscription
+	Skipped       int // Duplicate, existing, or suppressed rows
+}
+
+// Ready reports whether the plan can be committed.
+func (p ImportPlan) Ready() bool {
+	return p.Report != nil && !p.Report.HasErrors()
+}
+
+// String returns a summary of the plan.
+func (p ImportPlan) String() string {
+	return fmt.Sprintf("ImportPlan{Subscriptions: %d, Skipped: %d, Report: %s}", len(p.Subscriptions), p.Skipped, p.Report)
+}
+
+// ImportService imports subscriber lists exported from other newsletter tools.
+// Imports run in two steps, as content imports do: DryRun validates every row
+// and reports problems without writing; Commit then writes a clean plan.
+type ImportService struct {
+	subscriptions SubscriptionService
+	domains       shared.DomainPolicy
+	suppressions  SuppressionChecker
+	consents      ConsentRecorder
+	clock         kernel.Clock
+}
+
+// NewImportService creates import service with subscription storage, the
+// policy screening addresses, the suppression list, the consent log, and clock.
+func NewImportService(
+	subscriptions SubscriptionService,
+	domains shared.DomainPolicy,
+	suppressions SuppressionChecker,
+	consents ConsentRecorder,
+	clock kernel.Clock,
+) *ImportService {
+	return &ImportService{
+		subscriptions: subscriptions,
+		domains:       domains,
+		suppressions:  suppressions,
+		consents:      consents,
+		clock:         clock,
+	}
+}
+
+// DryRun reads a CSV list with a header row naming "first_name" and "email"
+// columns, and optionally "status". Every row is validated as signup forms
+// validate it. Rows reaching an inbox already listed above, already
+// subscribed, or on the suppression list are skipped; a prior unsubscribe, bounce, or complaint is
+// never undone. New subscribers keep the status the list gives them, so
+// people who left the previous tool stay unsubscribed here. IDs derive from
+// the address, so reruns plan the same subscriptions. Each new subscription
+// records an import consent to textVersion, the wording subscribers agreed to
+// on the previous tool's form. Only unreadable lists, an invalid text
+// version, and repository failures are returned as errors.
+func (s *ImportService) DryRun(
+	list io.Reader,
+	file string,
+	textVersion PolicyVersion,
+	actor user.PostPermissionChecker,
+) (ImportPlan, error) {
+	const op = "ImportService.DryRun"
+
+	if !user.IsActiveAdmin(actor) {
+		return ImportPlan{}, &kernel.Error{Code: kernel.EForbidden, Message: MImportForbidden, Operation: op}
+	}
+
+	consent, err := NewConsent(SourceImport, "", textVersion, "", s.clock)
+	if err != nil {
+		return ImportPlan{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	report, err := importer.NewValidationReport(ImportSource)
+	if err != nil {
+		return ImportPlan{}, &kernel.Error{Operation: op, Cause: err}
+	}
+	plan := ImportPlan{Report: report}
+
+	reader := csv.NewReader(list)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return ImportPlan{}, &kernel.Error{Code: kernel.EInvalid, Message: MImportCSVInvalid, Operation: op, Cause: err}
+	}
+	columns := importHeader(header)
+	for _, name := range []string{"first_name", "email"} {
+		if _, ok := columns[name]; !ok {
+			return ImportPlan{}, &kernel.Error{Code: kernel.EInvalid, Message: fmt.Sprintf(MImportColumnMissing, name), Operation: op}
+		}
+	}
+
+	seen := make(map[shared.Email]int) // Canonical address to its first line
+	for rows := 0; ; rows++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return ImportPlan{}, &kernel.Error{Code: kernel.EInvalid, Message: MImportCSVInvalid, Operation: op, Cause: err}
+		}
+		if rows == MaxImportRows {
+			return ImportPlan{}, &kernel.Error{Code: kernel.EInvalid, Message: fmt.Sprintf(MImportTooManyRows, MaxImportRows), Operation: op}
+		}
+
+		line, _ := reader.FieldPos(0)
+		ref := importer.ItemRef{File: file, Line: line}
+		if err := s.planRow(&plan, ref, importRow(record, columns), consent, seen); err != nil {
+			return ImportPlan{}, &kernel.Error{Operation: op, Cause: err}
+		}
+	}
+
+	return plan, nil
+}
+
+// Commit creates the planned subscriptions and appends their import consent to
+// the consent log. Addresses that subscribed or were suppressed since the dry
+// run are left out.
+func (s *ImportService) Commit(plan ImportPlan, actor user.PostPermissionChecker) error {
+	const op = "ImportService.Commit"
+
+	if !user.IsActiveAdmin(actor) {
+		return &kernel.Error{Code: kernel.EForbidden, Message: MImportForbidden, Operation: op}
+	}
+
+	if !plan.Ready() {
+		return &kernel.Error{Code: kernel.EInvalid, Message: MImportHasErrors, Operation: op}
+	}
+
+	for _, sub := range plan.Subscriptions {
+		exists, err := s.subscriptions.ExistsByEmail(sub.Email)
+		if err != nil {
+			return &kernel.Error{Operation: op, Cause: err}
+		}
+		if exists {
+			continue
+		}
+
+		suppressed, err := s.suppressions.IsSuppressed(sub.Email)
+		if err != nil {
+			return &kernel.Error{Operation: op, Cause: err}
+		}
+		if suppressed {
+			continue
+		}
+
+		if err := s.subscriptions.Create(sub); err != nil {
+			return &kernel.Error{Operation: op, Cause: err}
+		}
+
+		if err := s.consents.Record(sub.Consent.Record(sub.SubscriptionID)); err != nil {
+			return &kernel.Error{Operation: op, Cause: err}
+		}
+	}
+
+	return nil
+}
+
+// planRow validates one row and adds it to the plan or the report.
+func (s *ImportService) planRow(
+	plan *ImportPlan,
+	ref importer.ItemRef,
+	row map[string]string,
+	consent Consent,
+	seen map[shared.Email]int,
+) error {
+	const op = "ImportService.planRow"
+
+	email, err := shared.NewEmail(row["email"])
+	if err != nil {
+		return plan.Report.AddDomainError(ref, RuleImportEmail, err)
+	}
+	email = email.Normalize()
+
+	firstName, err := shared.NewFirstName(row["first_name"])
+	if err != nil {
+		return plan.Report.AddDomainError(ref, RuleImportFirstName, err)
+	}
+
+	status, ok := importStatuses[strings.ToLower(row["status"])]
+	if !ok {
+		return plan.Report.AddError(ref, RuleImportStatus, fmt.Sprintf(MImportStatusUnknown, row["status"]), "")
+	}
+
+	canonical := email.Canonical()
+	if first, ok := seen[canonical]; ok {
+		plan.Skipped++
+		return plan.Report.AddWarning(ref, RuleImportDuplicate, fmt.Sprintf(MImportDuplicate, first), "")
+	}
+	seen[canonical] = ref.Line
+
+	existing, err := s.subscriptions.GetByEmail(email)
+	if err != nil && kernel.ErrorCode(err) != kernel.ENotFound {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+	if existing != nil {
+		plan.Skipped++
+		if existing.Status != StatusActive {
+			return plan.Report.AddWarning(ref, RuleImportExisting, fmt.Sprintf(MImportExistingClosed, existing.Status), "")
+		}
+		return plan.Report.Add(importer.Finding{Ref: ref, Severity: importer.SeverityInfo, Rule: RuleImportExisting, Message: MImportExisting})
+	}
+
+	suppressed, err := s.suppressions.IsSuppressed(email)
+	if err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+	if suppressed {
+		plan.Skipped++
+		return plan.Report.AddWarning(ref, RuleImportSuppressed, MImportSuppressed, "")
+	}
+
+	verdict, err := s.domains.AssessEmail(email)
+	if err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+	if verdict.Disposable {
+		return plan.Report.AddError(ref, RuleImportDisposable, MSubscriptionDisposable, "")
+	}
+
+	sub, err := NewSubscription(NewSubscriptionParams{
+		SubscriptionID: importID(canonical),
+		FirstName:      firstName,
+		Email:          email,
+		Consent:        consent,
+		Clock:          s.clock,
+	})
+	if err != nil {
+		return plan.Report.AddDomainError(ref, RuleImportEmail, err)
+	}
+	sub.RoleAddress = verdict.Role
+	if verdict.Role {
+		if err := plan.Report.AddWarning(ref, RuleImportRole, MImportRoleAddress, ""); err != nil {
+			return err
+		}
+	}
+
+	switch status {
+	case StatusUnsubscribed:
+		sub, err = sub.Unsubscribe()
+	case StatusBounced:
+		sub, err = sub.MarkAsBounced()
+	case StatusComplained:
+		sub, err = sub.MarkAsComplained()
+	}
+	if err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	plan.Subscriptions = append(plan.Subscriptions, sub)
+	return nil
+}
+
+// importHeader maps column names to their index.
+func importHeader(header []string) map[string]int {
+	columns := make(map[string]int)
+	for i, name := range header {
+		name = strings.TrimPrefix(name, "\ufeff") // Spreadsheet byte order mark
+		name = strings.NewReplacer(" ", "_", "-", "_").Replace(strings.ToLower(strings.TrimSpace(name)))
+		if column, ok := importColumns[name]; ok {
+			if _, dup := columns[column]; !dup {
+				columns[column] = i
+			}
+		}
+	}
+	return columns
+}
+
+// importRow picks a record's values by column name; short rows leave them empty.
+func importRow(record []string, columns map[string]int) map[string]string {
+	row := make(map[string]string, len(columns))
+	for name, i := range columns {
+		if i < len(record) {
+			row[name] = strings.TrimSpace(record[i])
+		}
+	}
+	return row
+}
+
+// importID derives a subscription ID from the canonical address.
+func importID(canonical shared.Email) kernel.ID[Subscription] {
+	sum := sha256.Sum256([]byte(canonical.String()))
+	return kernel.ID[Subscription]("import-" + hex.EncodeToString(sum[:8]))
+}
@@ -0,0 +1,171 @@
+package subscription_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/importer"
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/shared"
+	"github.com/alnah/fla/internal/domain/subscription"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+func TestImportService(t *testing.T) {
+	clock := &stubClock{t: time.Date(2024, 5, 1, 9, 0, 0, 0, time.UTC)}
+	admin := user.User{ID: "admin-1", Roles: []user.Role{user.RoleAdmin}}
+
+	newStore := func(t *testing.T) *stubSignupStore {
+		t.Helper()
+		store := &stubSignupStore{subscriptions: map[kernel.ID[subscription.Subscription]]subscription.Subscription{}}
+		for _, existing := range []struct {
+			id, email string
+			leave     bool
+		}{{"s1", "marie@example.com", false}, {"s2", "paul@example.com", true}} {
+			sub, err := subscription.NewSubscription(subscription.NewSubscriptionParams{
+				SubscriptionID: kernel.ID[subscription.Subscription](existing.id),
+				FirstName:      "Existing",
+				Email:          shared.Email(existing.email),
+				Consent:        testConsent(clock),
+				Clock:          clock,
+			})
+			assertNoError(t, err)
+			if existing.leave {
+				sub, err = sub.Unsubscribe()
+				assertNoError(t, err)
+			}
+			store.subscriptions[sub.SubscriptionID] = sub
+		}
+		return store
+	}
+
+	findings := func(report *importer.ValidationReport) map[int]string {
+		out := make(map[int]string)
+		for _, f := range report.Findings {
+			out[f.Ref.Line] = f.Rule
+		}
+		return out
+	}
+
+	t.Run("plans new rows and reports the rest", func(t *testing.T) {
+		store := newStore(t)
+		service := subscription.NewImportService(store, shared.DefaultDomainPolicy(), stubSuppressions{"tom@example.com": true}, store, clock)
+		list := "First Name,Email Address,Status\n" +
+			"Ana,ana@Example.COM,subscribed\n" + // 2: imported, domain lowercased
+			"Ana,a.n.a@gmail.com,\n" + // 3: imported
+			"Ana bis,ana+news@gmail.com,\n" + // 4: same inbox as line 3
+			"Marie,marie@example.com,\n" + // 5: already subscribed
+			"Paul,paul@example.com,subscribed\n" + // 6: unsubscribed here; kept so
+			"Léa,lea@example.com,unsubscribed\n" + // 7: imported unsubscribed
+			"Info,info@example.com,\n" + // 8: role address, imported
+			"Tom,Tom@example.com,\n" // 9: suppressed
+
+		plan, err := service.DryRun(strings.NewReader(list), "subs.csv", "2024-05-01", admin)
+		assertNoError(t, err)
+
+		if !plan.Ready() || len(plan.Subscriptions) != 4 || plan.Skipped != 4 {
+			t.Fatalf("plan: got %s", plan)
+		}
+		if got := plan.Subscriptions[0].Email; got != "ana@example.com" {
+			t.Errorf("email: got %q", got)
+		}
+		if got := plan.Subscriptions[0].Consent; got.Source != subscription.SourceImport || got.TextVersion != "2024-05-01" {
+			t.Errorf("consent: got %s", got)
+		}
+		if got := plan.Subscriptions[2]; got.Status != subscription.StatusUnsubscribed || got.UnsubscribedAt == nil {
+			t.Errorf("unsubscribed row: got %s", got)
+		}
+		if !plan.Subscriptions[3].RoleAddress {
+			t.Error("role address should be flagged")
+		}
+
+		want := map[int]string{
+			4: subscription.RuleImportDuplicate,
+			5: subscription.RuleImportExisting,
+			6: subscription.RuleImportExisting,
+			8: subscription.RuleImportRole,
+			9: subscription.RuleImportSuppressed,
+		}
+		got := findings(plan.Report)
+		for line, rule := range want {
+			if got[line] != rule {
+				t.Errorf("line %d: got rule %q, want %q", line, got[line], rule)
+			}
+		}
+		if store.created != 0 {
+			t.Errorf("dry run wrote %d subscriptions", store.created)
+		}
+
+		assertNoError(t, service.Commit(plan, admin))
+		if store.created != 4 {
+			t.Errorf("created: got %d, want 4", store.created)
+		}
+		history, err := store.GetConsentHistory(plan.Subscriptions[0].SubscriptionID)
+		assertNoError(t, err)
+		if len(history) != 1 || history[0].Source != subscription.SourceImport || history[0].PolicyVersion != "2024-05-01" {
+			t.Errorf("consent history: got %v", history)
+		}
+		if paul, _ := store.GetByEmail("paul@example.com"); paul.Status != subscription.StatusUnsubscribed {
+			t.Errorf("prior unsubscribe must be kept: got %s", paul.Status)
+		}
+
+		again, err := service.DryRun(strings.NewReader(list), "subs.csv", "2024-05-01", admin)
+		assertNoError(t, err)
+		if len(again.Subscriptions) != 0 {
+			t.Errorf("rerun should skip imported rows: got %d", len(again.Subscriptions))
+		}
+	})
+
+	t.Run("invalid rows block the commit", func(t *testing.T) {
+		store := newStore(t)
+		service := subscription.NewImportService(store, shared.DefaultDomainPolicy(), stubSuppressions{}, store, clock)
+		list := "email,first_name,status\n" +
+			"not-an-email,Ana,\n" +
+			"tom@yopmail.com,Tom,\n" +
+			"zoe@example.com," + strings.Repeat("Zoé", 20) + ",\n" +
+			"max@example.com,Max,pending\n"
+
+		plan, err := service.DryRun(strings.NewReader(list), "subs.csv", "2024-05-01", admin)
+		assertNoError(t, err)
+
+		want := map[int]string{
+			2: subscription.RuleImportEmail,
+			3: subscription.RuleImportDisposable,
+			4: subscription.RuleImportFirstName,
+			5: subscription.RuleImportStatus,
+		}
+		got := findings(plan.Report)
+		for line, rule := range want {
+			if got[line] != rule {
+				t.Errorf("line %d: got rule %q, want %q", line, got[line], rule)
+			}
+		}
+		assertErrorCode(t, service.Commit(plan, admin), kernel.EInvalid)
+	})
+
+	t.Run("rejects lists without required columns", func(t *testing.T) {
+		store := newStore(t)
+		service := subscription.NewImportService(store, shared.DefaultDomainPolicy(), stubSuppressions{}, store, clock)
+
+		_, err := service.DryRun(strings.NewReader("name,mail\nAna,ana@example.com\n"), "subs.csv", "2024-05-01", admin)
+		assertErrorCode(t, err, kernel.EInvalid)
+	})
+
+	t.Run("requires the consent text version", func(t *testing.T) {
+		store := newStore(t)
+		service := subscription.NewImportService(store, shared.DefaultDomainPolicy(), stubSuppressions{}, store, clock)
+
+		_, err := service.DryRun(strings.NewReader("first_name,email\nAna,ana@example.com\n"), "subs.csv", "", admin)
+		assertErrorCode(t, err, kernel.EInvalid)
+	})
+
+	t.Run("requires an admin", func(t *testing.T) {
+		store := newStore(t)
+		service := subscription.NewImportService(store, shared.DefaultDomainPolicy(), stubSuppressions{}, store, clock)
+		editor := user.User{ID: "editor-1", Roles: []user.Role{user.RoleEditor}}
+
+		_, err := service.DryRun(strings.NewReader("first_name,email\n"), "subs.csv", "2024-05-01", editor)
+		assertErrorCode(t, err, kernel.EForbidden)
+	})
+}
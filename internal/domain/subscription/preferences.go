@@ -0,0 +1,170 @@
+package subscription
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/alnah/fla/internal/domain/category"
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/shared"
+)
+
+const (
+	MFrequencyInvalid           string = "Invalid email frequency."
+	MPreferencesDuplicateTopic  string = "Duplicate category in preferences: %q."
+	MPreferencesTooManyTopics   string = "Preferences cannot select more than %d categories."
+	MPreferencesUnknownCategory string = "Unknown category in preferences: %q."
+)
+
+// MaxPreferredCategories bounds how many category trees a subscriber can follow.
+const MaxPreferredCategories = 20
+
+// Frequency controls how often a subscriber receives content emails.
+type Frequency string
+
+const (
+	FrequencyInstant      Frequency = "instant"       // One email per published post
+	FrequencyWeeklyDigest Frequency = "weekly_digest" // One summary email per week
+)
+
+func (f Frequency) String() string { return string(f) }
+
+// Validate ensures frequency uses a supported delivery cadence.
+func (f Frequency) Validate() error {
+	const op = "Frequency.Validate"
+
+	switch f {
+	case FrequencyInstant, FrequencyWeeklyDigest:
+		return nil
+	default:
+		return &kernel.Error{Code: kernel.EInvalid, Message: MFrequencyInvalid, Operation: op}
+	}
+}
+
+// Preferences captures which content a subscriber wants emails about.
+// Selecting a category follows its whole subtree (A1 includes A1 → Listening).
+type Preferences struct {
+	CategoryIDs []kernel.ID[category.Category] // Empty means all categories
+	Locale      shared.Locale                  // Language of the emails
+	Frequency   Frequency
+}
+
+// DefaultPreferences returns the preferences applied to new subscriptions.
+// Subscribers receive every post instantly in the default locale.
+func DefaultPreferences() Preferences {
+	return Preferences{
+		CategoryIDs: nil,
+		Locale:      shared.DefaultLocale,
+		Frequency:   FrequencyInstant,
+	}
+}
+
+// NewPreferences creates validated subscriber preferences.
+// Copies the category selection so callers cannot mutate it afterwards.
+func NewPreferences(categoryIDs []kernel.ID[category.Category], locale shared.Locale, frequency Frequency) (Preferences, error) {
+	const op = "NewPreferences"
+
+	if locale == "" {
+		locale = shared.DefaultLocale
+	}
+
+	p := Preferences{
+		CategoryIDs: slices.Clone(categoryIDs),
+		Locale:      locale,
+		Frequency:   frequency,
+	}
+
+	if err := p.Validate(); err != nil {
+		return Preferences{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return p, nil
+}
+
+// Validate ensures preferences are internally consistent.
+// Existence of selected categories is checked by ValidateCategories.
+func (p Preferences) Validate() error {
+	const op = "Preferences.Validate"
+
+	if err := p.Locale.Validate(); err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if err := p.Frequency.Validate(); err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if len(p.CategoryIDs) > MaxPreferredCategories {
+		return &kernel.Error{
+			Code:      kernel.EInvalid,
+			Message:   fmt.Sprintf(MPreferencesTooManyTopics, MaxPreferredCategories),
+			Operation: op,
+		}
+	}
+
+	seen := make(map[kernel.ID[category.Category]]bool, len(p.CategoryIDs))
+	for _, id := range p.CategoryIDs {
+		if err := id.Validate(); err != nil {
+			return &kernel.Error{Operation: op, Cause: err}
+		}
+		if seen[id] {
+			return &kernel.Error{
+				Code:      kernel.EInvalid,
+				Message:   fmt.Sprintf(MPreferencesDuplicateTopic, id),
+				Operation: op,
+			}
+		}
+		seen[id] = true
+	}
+
+	return nil
+}
+
+// ValidateCategories checks that every selected category exists.
+// Prevents subscribers from following deleted or mistyped category trees.
+func (p Preferences) ValidateCategories(categories category.CategoryReader) error {
+	const op = "Preferences.ValidateCategories"
+
+	for _, id := range p.CategoryIDs {
+		if _, err := categories.GetByID(id); err != nil {
+			if kernel.ErrorCode(err) == kernel.ENotFound {
+				return &kernel.Error{
+					Code:      kernel.EInvalid,
+					Message:   fmt.Sprintf(MPreferencesUnknownCategory, id),
+					Operation: op,
+					Cause:     err,
+				}
+			}
+			return &kernel.Error{Operation: op, Cause: err}
+		}
+	}
+
+	return nil
+}
+
+// FollowsAllCategories returns true when no category filter is set.
+func (p Preferences) FollowsAllCategories() bool {
+	return len(p.CategoryIDs) == 0
+}
+
+// IsInterestedIn reports whether content at the given category path matches these preferences.
+// A selection matches when it is the path's leaf or any of its ancestors.
+func (p Preferences) IsInterestedIn(path category.CategoryPath) bool {
+	if p.FollowsAllCategories() {
+		return true
+	}
+
+	for _, c := range path {
+		if slices.Contains(p.CategoryIDs, c.CategoryID) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// String returns a string representation of the preferences.
+func (p Preferences) String() string {
+	return fmt.Sprintf("Preferences{Categories: %v, Locale: %q, Frequency: %q}",
+		p.CategoryIDs, p.Locale, p.Frequency)
+}
@@ -0,0 +1,144 @@
+package subscription_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/alnah/fla/internal/domain/category"
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/shared"
+	"github.com/alnah/fla/internal/domain/subscription"
+)
+
+type stubCategoryReader struct {
+	known map[kernel.ID[category.Category]]bool
+	err   error
+}
+
+func (s stubCategoryReader) GetByID(id kernel.ID[category.Category]) (*category.Category, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	if s.known[id] {
+		return &category.Category{CategoryID: id}, nil
+	}
+	return nil, &kernel.Error{Code: kernel.ENotFound, Message: "category not found"}
+}
+
+func (s stubCategoryReader) GetAll() ([]category.Category, error) { return nil, nil }
+
+func categoryPath(ids ...string) category.CategoryPath {
+	path := make(category.CategoryPath, len(ids))
+	for i, id := range ids {
+		path[i] = category.Category{CategoryID: kernel.ID[category.Category](id)}
+	}
+	return path
+}
+
+func TestNewPreferences(t *testing.T) {
+	a1 := kernel.ID[category.Category]("a1")
+
+	t.Run("creates valid preferences", func(t *testing.T) {
+		got, err := subscription.NewPreferences([]kernel.ID[category.Category]{a1}, shared.LocaleFrenchFR, subscription.FrequencyWeeklyDigest)
+
+		assertNoError(t, err)
+		if got.Locale != shared.LocaleFrenchFR || got.Frequency != subscription.FrequencyWeeklyDigest {
+			t.Errorf("unexpected preferences: %s", got)
+		}
+	})
+
+	t.Run("defaults locale", func(t *testing.T) {
+		got, err := subscription.NewPreferences(nil, "", subscription.FrequencyInstant)
+
+		assertNoError(t, err)
+		if got.Locale != shared.DefaultLocale {
+			t.Errorf("Locale: got %q, want %q", got.Locale, shared.DefaultLocale)
+		}
+	})
+
+	t.Run("rejects invalid preferences", func(t *testing.T) {
+		tooMany := make([]kernel.ID[category.Category], subscription.MaxPreferredCategories+1)
+		for i := range tooMany {
+			tooMany[i] = kernel.ID[category.Category](fmt.Sprintf("cat-%d", i))
+		}
+
+		tests := []struct {
+			name       string
+			categories []kernel.ID[category.Category]
+			locale     shared.Locale
+			frequency  subscription.Frequency
+		}{
+			{name: "unsupported locale", locale: "de-DE", frequency: subscription.FrequencyInstant},
+			{name: "invalid frequency", locale: shared.LocaleFrenchFR, frequency: "daily"},
+			{name: "duplicate category", categories: []kernel.ID[category.Category]{a1, a1}, locale: shared.LocaleFrenchFR, frequency: subscription.FrequencyInstant},
+			{name: "empty category ID", categories: []kernel.ID[category.Category]{""}, locale: shared.LocaleFrenchFR, frequency: subscription.FrequencyInstant},
+			{name: "too many categories", categories: tooMany, locale: shared.LocaleFrenchFR, frequency: subscription.FrequencyInstant},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				_, err := subscription.NewPreferences(tt.categories, tt.locale, tt.frequency)
+
+				assertError(t, err)
+				assertErrorCode(t, err, kernel.EInvalid)
+			})
+		}
+	})
+}
+
+func TestPreferences_ValidateCategories(t *testing.T) {
+	a1 := kernel.ID[category.Category]("a1")
+	b2 := kernel.ID[category.Category]("b2")
+	prefs, _ := subscription.NewPreferences([]kernel.ID[category.Category]{a1, b2}, shared.LocaleFrenchFR, subscription.FrequencyInstant)
+
+	t.Run("accepts existing categories", func(t *testing.T) {
+		reader := stubCategoryReader{known: map[kernel.ID[category.Category]]bool{a1: true, b2: true}}
+
+		assertNoError(t, prefs.ValidateCategories(reader))
+	})
+
+	t.Run("rejects unknown category", func(t *testing.T) {
+		reader := stubCategoryReader{known: map[kernel.ID[category.Category]]bool{a1: true}}
+
+		err := prefs.ValidateCategories(reader)
+
+		assertError(t, err)
+		assertErrorCode(t, err, kernel.EInvalid)
+	})
+
+	t.Run("propagates repository errors", func(t *testing.T) {
+		reader := stubCategoryReader{err: &kernel.Error{Code: kernel.EInternal, Message: "database error"}}
+
+		err := prefs.ValidateCategories(reader)
+
+		assertError(t, err)
+		assertErrorCode(t, err, kernel.EInternal)
+	})
+}
+
+func TestPreferences_IsInterestedIn(t *testing.T) {
+	listening := categoryPath("a1", "a1-listening")
+
+	tests := []struct {
+		name     string
+		selected []kernel.ID[category.Category]
+		want     bool
+	}{
+		{name: "follows everything", selected: nil, want: true},
+		{name: "follows level tree", selected: []kernel.ID[category.Category]{"a1"}, want: true},
+		{name: "follows exact category", selected: []kernel.ID[category.Category]{"a1-listening"}, want: true},
+		{name: "follows other tree", selected: []kernel.ID[category.Category]{"b2"}, want: false},
+		{name: "follows only a descendant", selected: []kernel.ID[category.Category]{"a1-listening-sports"}, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prefs, err := subscription.NewPreferences(tt.selected, shared.LocaleFrenchFR, subscription.FrequencyInstant)
+			assertNoError(t, err)
+
+			if got := prefs.IsInterestedIn(listening); got != tt.want {
+				t.Errorf("got %t, want %t", got, tt.want)
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package subscription
 
 import (
+	"github.com/alnah/fla/internal/domain/category"
 	"github.com/alnah/fla/internal/domain/kernel"
 	"github.com/alnah/fla/internal/domain/shared"
 )
@@ -61,6 +62,15 @@ type CampaignTargeter interface {
 	GetSubscribersForNewPost() ([]Subscription, error)
 }
 
+// SegmentTargeter narrows campaign audiences using subscriber preferences.
+// Used by notification systems to email only subscribers following a category tree.
+type SegmentTargeter interface {
+	// GetActiveSubscribersInterestedIn returns active subscribers following any category on the path.
+	// Used for queries like "active subscribers interested in A1 → Listening" in a given locale.
+	// An empty locale matches every email language.
+	GetActiveSubscribersInterestedIn(path category.CategoryPath, locale shared.Locale) ([]Subscription, error)
+}
+
 // Composed interfaces for common use cases
 
 // SubscriptionService combines core operations for public subscription management.
@@ -76,6 +86,7 @@ type SubscriptionService interface {
 type NewsletterManager interface {
 	SubscriptionLister
 	CampaignTargeter
+	SegmentTargeter
 }
 
 // SubscriptionAdmin provides complete subscriber database control.
@@ -92,6 +103,7 @@ type SubscriptionAdmin interface {
 type EmailMarketer interface {
 	SubscriptionLister
 	CampaignTargeter
+	SegmentTargeter
 	SubscriptionValidator
 }
 
@@ -103,4 +115,78 @@ type Repository interface {
 	SubscriptionLister
 	SubscriptionValidator
 	CampaignTargeter
+	SegmentTargeter
+}
+
+// Consent tracking
+
+// ConsentRecorder appends consent records captured by forms and campaigns.
+type ConsentRecorder interface {
+	// Record stores a new consent record; existing records are never modified.
+	Record(record ConsentRecord) error
+}
+
+// ConsentReader retrieves consent history for compliance requests.
+type ConsentReader interface {
+	// GetConsentHistory returns every record of a subscriber ordered oldest first.
+	// Used to prove consent when a subscriber or regulator asks.
+	GetConsentHistory(subscriptionID kernel.ID[Subscription]) ([]ConsentRecord, error)
+
+	// GetActiveWithoutConsentTo returns active subscribers whose latest consent is not the version.
+	// Used to target re-consent campaigns after a policy update.
+	GetActiveWithoutConsentTo(version PolicyVersion) ([]Subscription, error)
+}
+
+// ConsentRepository combines consent persistence and retrieval.
+type ConsentRepository interface {
+	ConsentRecorder
+	ConsentReader
+}
+
+// Subscriber history
+
+// StatusHistoryReader retrieves status changes for data portability requests.
+type StatusHistoryReader interface {
+	// GetStatusHistory returns every status change of a subscriber ordered oldest first.
+	// Appended by the repository whenever Update changes the status.
+	GetStatusHistory(subscriptionID kernel.ID[Subscription]) ([]StatusChange, error)
+}
+
+// DeliveryEventReader retrieves what the mailer recorded about emails sent to a subscriber.
+type DeliveryEventReader interface {
+	// GetDeliveryEvents returns every delivery event of a subscriber ordered oldest first.
+	GetDeliveryEvents(subscriptionID kernel.ID[Subscription]) ([]DeliveryEvent, error)
+}
+
+// Suppression list
+
+// SuppressionChecker tells whether an address may be contacted.
+// Consulted by campaign senders and subscription creation.
+type SuppressionChecker interface {
+	// IsSuppressed reports whether an address reaching the same inbox, as
+	// compared by shared.Email.Canonical, is on the list.
+	IsSuppressed(email shared.Email) (bool, error)
+}
+
+// SuppressionLister provides the whole list for admin review.
+type SuppressionLister interface {
+	// GetSuppressions returns every entry ordered by email.
+	GetSuppressions() ([]Suppression, error)
+}
+
+// SuppressionWriter adds and removes suppression entries.
+// Used by admins and by mailer adapters processing bounces and complaints.
+type SuppressionWriter interface {
+	// AddSuppression stores an entry; an address already listed fails with EConflict.
+	AddSuppression(suppression Suppression) error
+
+	// RemoveSuppression deletes the entry matching the inbox; none fails with ENotFound.
+	RemoveSuppression(email shared.Email) error
+}
+
+// SuppressionRepository combines suppression list persistence and retrieval.
+type SuppressionRepository interface {
+	SuppressionChecker
+	SuppressionLister
+	SuppressionWriter
 }
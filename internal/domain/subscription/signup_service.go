@@ -0,0 +1,136 @@
+package subscription
+
+import (
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/shared"
+)
+
+// ScopeSubscribe is the idempotency scope of subscription creation.
+const ScopeSubscribe string = "subscription.create"
+
+// SignupService creates subscriptions from public signup forms and partner webhooks.
+type SignupService struct {
+	subscriptions SubscriptionService
+	domains       shared.DomainPolicy
+	suppressions  SuppressionChecker
+	consents      ConsentRecorder
+	requests      kernel.IdempotencyStore
+	clock         kernel.Clock
+}
+
+// NewSignupService creates signup service with subscription storage, the policy
+// screening addresses, the suppression list, the consent log, and idempotency records.
+func NewSignupService(
+	subscriptions SubscriptionService,
+	domains shared.DomainPolicy,
+	suppressions SuppressionChecker,
+	consents ConsentRecorder,
+	requests kernel.IdempotencyStore,
+	clock kernel.Clock,
+) *SignupService {
+	return &SignupService{
+		subscriptions: subscriptions,
+		domains:       domains,
+		suppressions:  suppressions,
+		consents:      consents,
+		requests:      requests,
+		clock:         clock,
+	}
+}
+
+// Subscribe creates an active subscription; params.Clock is ignored in favor of the service clock.
+// The address is stored with its domain lowercased. Disposable addresses are refused
+// with EInvalid, suppressed ones with EForbidden; role addresses are accepted and flagged.
+// The consent given on the form is appended to the consent log.
+// A retry carrying the same idempotency key returns the subscription created the first time,
+// as it is now, instead of failing because the email is already subscribed.
+func (s *SignupService) Subscribe(key kernel.IdempotencyKey, params NewSubscriptionParams) (Subscription, error) {
+	const op = "SignupService.Subscribe"
+
+	params.Clock = s.clock
+	params.Email = params.Email.Normalize()
+	fingerprint := params.Email.Canonical().String()
+
+	id, _, err := kernel.Idempotent(s.requests, s.clock, ScopeSubscribe, key, fingerprint, func() (string, error) {
+		created, err := s.create(params)
+		return created.SubscriptionID.String(), err
+	})
+	if err != nil {
+		return Subscription{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	sub, err := s.subscriptions.GetByID(kernel.ID[Subscription](id))
+	if err != nil {
+		return Subscription{}, &kernel.Error{Operation: op, Cause: err}
+	}
+	return *sub, nil
+}
+
+func (s *SignupService) create(params NewSubscriptionParams) (Subscription, error) {
+	const op = "SignupService.create"
+
+	sub, err := NewSubscription(params)
+	if err != nil {
+		return Subscription{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	suppressed, err := s.suppressions.IsSuppressed(sub.Email)
+	if err != nil {
+		return Subscription{}, &kernel.Error{Operation: op, Cause: err}
+	}
+	if suppressed {
+		return Subscription{}, &kernel.Error{Code: kernel.EForbidden, Message: MSuppressedAddress, Operation: op}
+	}
+
+	verdict, err := s.domains.AssessEmail(sub.Email)
+	if err != nil {
+		return Subscription{}, &kernel.Error{Operation: op, Cause: err}
+	}
+	if verdict.Disposable {
+		return Subscription{}, &kernel.Error{Code: kernel.EInvalid, Message: MSubscriptionDisposable, Operation: op}
+	}
+	sub.RoleAddress = verdict.Role
+
+	exists, err := s.subscriptions.ExistsByEmail(sub.Email)
+	if err != nil {
+		return Subscription{}, &kernel.Error{Operation: op, Cause: err}
+	}
+	if exists {
+		return Subscription{}, &kernel.Error{Code: kernel.EConflict, Message: MSubscriptionEmailExists, Operation: op}
+	}
+
+	if err := s.subscriptions.Create(sub); err != nil {
+		return Subscription{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if err := s.consents.Record(sub.Consent.Record(sub.SubscriptionID)); err != nil {
+		return Subscription{}, &kernel.Error{Operation: op, Cause: err}
+	}
+	return sub, nil
+}
+
+// Resubscribe reactivates an unsubscribed subscription with fresh consent
+// and appends that consent to the consent log.
+func (s *SignupService) Resubscribe(subscriptionID kernel.ID[Subscription], consent Consent) (Subscription, error) {
+	const op = "SignupService.Resubscribe"
+
+	sub, err := s.subscriptions.GetByID(subscriptionID)
+	if err != nil {
+		return Subscription{}, &kernel.Error{Operation: op, Cause: err}
+	}
+	sub.Clock = s.clock
+
+	updated, err := sub.Resubscribe(consent)
+	if err != nil {
+		return Subscription{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if err := s.subscriptions.Update(updated); err != nil {
+		return Subscription{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if err := s.consents.Record(updated.Consent.Record(updated.SubscriptionID)); err != nil {
+		return Subscription{}, &kernel.Error{Operation: op, Cause: err}
+	}
+	return updated, nil
+}
@@ -0,0 +1,192 @@
+package subscription_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/shared"
+	"github.com/alnah/fla/internal/domain/subscription"
+)
+
+type stubSignupStore struct {
+	subscriptions map[kernel.ID[subscription.Subscription]]subscription.Subscription
+	records       []subscription.ConsentRecord
+	created       int
+}
+
+func (s *stubSignupStore) GetByID(id kernel.ID[subscription.Subscription]) (*subscription.Subscription, error) {
+	if sub, ok := s.subscriptions[id]; ok {
+		return &sub, nil
+	}
+	return nil, &kernel.Error{Code: kernel.ENotFound, Message: "subscription not found"}
+}
+
+func (s *stubSignupStore) GetByEmail(email shared.Email) (*subscription.Subscription, error) {
+	for _, sub := range s.subscriptions {
+		if strings.EqualFold(sub.Email.String(), email.String()) {
+			return &sub, nil
+		}
+	}
+	return nil, &kernel.Error{Code: kernel.ENotFound, Message: "subscription not found"}
+}
+
+func (s *stubSignupStore) ExistsByEmail(email shared.Email) (bool, error) {
+	_, err := s.GetByEmail(email)
+	return err == nil, nil
+}
+
+func (s *stubSignupStore) Create(sub subscription.Subscription) error {
+	s.subscriptions[sub.SubscriptionID] = sub
+	s.created++
+	return nil
+}
+
+func (s *stubSignupStore) Update(sub subscription.Subscription) error {
+	s.subscriptions[sub.SubscriptionID] = sub
+	return nil
+}
+
+func (s *stubSignupStore) Delete(id kernel.ID[subscription.Subscription]) error {
+	delete(s.subscriptions, id)
+	return nil
+}
+
+func (s *stubSignupStore) Record(r subscription.ConsentRecord) error {
+	s.records = append(s.records, r)
+	return nil
+}
+
+func (s *stubSignupStore) GetConsentHistory(id kernel.ID[subscription.Subscription]) ([]subscription.ConsentRecord, error) {
+	var history []subscription.ConsentRecord
+	for _, r := range s.records {
+		if r.SubscriptionID == id {
+			history = append(history, r)
+		}
+	}
+	return history, nil
+}
+
+func TestSignupService_Subscribe(t *testing.T) {
+	store := &stubSignupStore{subscriptions: map[kernel.ID[subscription.Subscription]]subscription.Subscription{}}
+	clock := &stubClock{t: time.Date(2024, 5, 1, 9, 0, 0, 0, time.UTC)}
+	service := subscription.NewSignupService(store, shared.DefaultDomainPolicy(), stubSuppressions{"blocked@example.com": true}, store, newStubIdempotencyStore(), clock)
+
+	params := func(id, email string) subscription.NewSubscriptionParams {
+		return subscription.NewSubscriptionParams{
+			SubscriptionID: kernel.ID[subscription.Subscription](id),
+			FirstName:      "Marie",
+			Email:          shared.Email(email),
+			Consent:        testConsent(clock),
+		}
+	}
+
+	first, err := service.Subscribe("signup-0001", params("sub-1", "marie@example.com"))
+	assertNoError(t, err)
+	if first.SubscriptionID != "sub-1" || !first.SubscribedAt.Equal(clock.t) {
+		t.Errorf("got %s subscribed at %s", first.SubscriptionID, first.SubscribedAt)
+	}
+
+	t.Run("records the consent", func(t *testing.T) {
+		history, err := store.GetConsentHistory("sub-1")
+		assertNoError(t, err)
+		if len(history) != 1 || history[0].Source != subscription.SourceSignupForm || !history[0].AcceptedAt.Equal(clock.t) {
+			t.Errorf("got history %v", history)
+		}
+	})
+
+	t.Run("retry returns the first subscription", func(t *testing.T) {
+		got, err := service.Subscribe("signup-0001", params("sub-2", "Marie@example.com"))
+		assertNoError(t, err)
+		if got.SubscriptionID != "sub-1" || store.created != 1 || len(store.records) != 1 {
+			t.Errorf("got %s after %d creates and %d consents", got.SubscriptionID, store.created, len(store.records))
+		}
+	})
+
+	t.Run("key reused for another email", func(t *testing.T) {
+		_, err := service.Subscribe("signup-0001", params("sub-3", "tom@example.com"))
+		assertErrorCode(t, err, kernel.EConflict)
+		if store.created != 1 {
+			t.Errorf("created %d subscriptions", store.created)
+		}
+	})
+
+	t.Run("new key for a subscribed email", func(t *testing.T) {
+		_, err := service.Subscribe("signup-0002", params("sub-4", "marie@example.com"))
+		assertErrorCode(t, err, kernel.EConflict)
+	})
+
+	t.Run("refuses disposable addresses", func(t *testing.T) {
+		_, err := service.Subscribe("signup-0003", params("sub-7", "tom@Yopmail.com"))
+		assertErrorCode(t, err, kernel.EInvalid)
+	})
+
+	t.Run("refuses suppressed addresses", func(t *testing.T) {
+		_, err := service.Subscribe("signup-0005", params("sub-9", "Blocked@Example.com"))
+		assertErrorCode(t, err, kernel.EForbidden)
+	})
+
+	t.Run("flags role addresses", func(t *testing.T) {
+		got, err := service.Subscribe("signup-0004", params("sub-8", "Info@Ecole.Example"))
+		assertNoError(t, err)
+		if !got.RoleAddress || got.Email != "Info@ecole.example" {
+			t.Errorf("got %q, role %t", got.Email, got.RoleAddress)
+		}
+	})
+
+	t.Run("without key", func(t *testing.T) {
+		_, err := service.Subscribe("", params("sub-5", "lea@example.com"))
+		assertNoError(t, err)
+		_, err = service.Subscribe("", params("sub-6", "lea@example.com"))
+		assertErrorCode(t, err, kernel.EConflict)
+	})
+}
+
+func TestSignupService_Resubscribe(t *testing.T) {
+	store := &stubSignupStore{subscriptions: map[kernel.ID[subscription.Subscription]]subscription.Subscription{}}
+	clock := &stubClock{t: time.Date(2024, 5, 1, 9, 0, 0, 0, time.UTC)}
+	service := subscription.NewSignupService(store, shared.DefaultDomainPolicy(), stubSuppressions{}, store, newStubIdempotencyStore(), clock)
+
+	sub, err := service.Subscribe("", subscription.NewSubscriptionParams{
+		SubscriptionID: "sub-1",
+		FirstName:      "Marie",
+		Email:          "marie@example.com",
+		Consent:        testConsent(clock),
+	})
+	assertNoError(t, err)
+	sub, err = sub.Unsubscribe()
+	assertNoError(t, err)
+	assertNoError(t, store.Update(sub))
+
+	t.Run("records the fresh consent", func(t *testing.T) {
+		clock.t = clock.t.Add(30 * 24 * time.Hour)
+
+		got, err := service.Resubscribe("sub-1", testConsent(clock))
+
+		assertNoError(t, err)
+		if !got.IsSubscribed() || len(got.PreviousConsent) != 1 {
+			t.Errorf("got %s", got)
+		}
+		history, err := store.GetConsentHistory("sub-1")
+		assertNoError(t, err)
+		if len(history) != 2 || !history[1].AcceptedAt.Equal(clock.t) {
+			t.Errorf("got history %v", history)
+		}
+	})
+
+	t.Run("refuses active subscriptions", func(t *testing.T) {
+		_, err := service.Resubscribe("sub-1", testConsent(clock))
+
+		assertErrorCode(t, err, kernel.EConflict)
+		if len(store.records) != 2 {
+			t.Errorf("recorded %d consents", len(store.records))
+		}
+	})
+
+	t.Run("unknown subscription", func(t *testing.T) {
+		_, err := service.Resubscribe("sub-9", testConsent(clock))
+
+		assertErrorCode(t, err, kernel.ENotFound)
+	})
+}
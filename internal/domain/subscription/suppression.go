@@ -0,0 +1,168 @@
+package subscription
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/shared"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+const (
+	MaxSuppressionNoteLength int = 500
+
+	MSuppressionForbidden     string = "Only admins can change the suppression list."
+	MSuppressionReasonInvalid string = "Invalid suppression reason."
+	MSuppressionNotFound      string = "Address is not on the suppression list."
+	MSuppressionExists        string = "Address is already on the suppression list."
+	MSuppressedAddress        string = "This address cannot be subscribed."
+)
+
+// SuppressionReason is the explanation code of a suppression entry.
+type SuppressionReason string
+
+const (
+	ReasonHardBounce SuppressionReason = "hard_bounce" // Mailbox does not exist; retrying hurts sender reputation
+	ReasonComplaint  SuppressionReason = "complaint"   // Marked as spam by the recipient
+	ReasonManual     SuppressionReason = "manual"      // Blocked by an admin, e.g. on request by letter
+)
+
+func (r SuppressionReason) String() string { return string(r) }
+
+// Validate ensures the reason is a known code.
+func (r SuppressionReason) Validate() error {
+	const op = "SuppressionReason.Validate"
+
+	switch r {
+	case ReasonHardBounce, ReasonComplaint, ReasonManual:
+		return nil
+	default:
+		return &kernel.Error{Code: kernel.EInvalid, Message: MSuppressionReasonInvalid, Operation: op}
+	}
+}
+
+// Suppression is an address that must never be contacted, whatever its
+// subscription says. The list is shared by every campaign and outlives
+// subscriptions: deleting a subscriber keeps the address suppressed.
+// Entries match the inbox, so "marie.l+news@gmail.com" is suppressed by
+// "mariel@gmail.com".
+type Suppression struct {
+	Email     shared.Email
+	Reason    SuppressionReason
+	Note      string               // Optional: context for other admins
+	AddedBy   kernel.ID[user.User] // Empty when added by the mailer
+	CreatedAt time.Time
+}
+
+// NewSuppression creates a validated suppression entry stamped with the
+// current time. The email domain is lowercased.
+func NewSuppression(
+	email shared.Email,
+	reason SuppressionReason,
+	note string,
+	addedBy kernel.ID[user.User],
+	clock kernel.Clock,
+) (Suppression, error) {
+	const op = "NewSuppression"
+
+	s := Suppression{
+		Email:     email.Normalize(),
+		Reason:    reason,
+		Note:      note,
+		AddedBy:   addedBy,
+		CreatedAt: clock.Now(),
+	}
+
+	if err := s.Validate(); err != nil {
+		return Suppression{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return s, nil
+}
+
+// Validate ensures the entry names an address and explains itself.
+func (s Suppression) Validate() error {
+	const op = "Suppression.Validate"
+
+	if err := s.Email.Validate(); err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if err := s.Reason.Validate(); err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if err := kernel.ValidateMaxLength("suppression note", s.Note, MaxSuppressionNoteLength, op); err != nil {
+		return err
+	}
+
+	if s.AddedBy != "" {
+		if err := s.AddedBy.Validate(); err != nil {
+			return &kernel.Error{Operation: op, Cause: err}
+		}
+	}
+
+	return nil
+}
+
+// String returns a string representation of the suppression entry.
+func (s Suppression) String() string {
+	return fmt.Sprintf("Suppression{Email: %s, Reason: %s}", s.Email, s.Reason)
+}
+
+// SuppressionService lets admins maintain the suppression list.
+type SuppressionService struct {
+	suppressions SuppressionRepository
+	clock        kernel.Clock
+}
+
+// NewSuppressionService creates suppression service with list storage and clock.
+func NewSuppressionService(suppressions SuppressionRepository, clock kernel.Clock) *SuppressionService {
+	return &SuppressionService{suppressions: suppressions, clock: clock}
+}
+
+// Add puts an address on the suppression list. Adding an address already
+// suppressed fails with EConflict, so the first reason is kept.
+func (s *SuppressionService) Add(email shared.Email, reason SuppressionReason, note string, actor user.PostPermissionChecker) (Suppression, error) {
+	const op = "SuppressionService.Add"
+
+	if !user.IsActiveAdmin(actor) {
+		return Suppression{}, &kernel.Error{Code: kernel.EForbidden, Message: MSuppressionForbidden, Operation: op}
+	}
+
+	entry, err := NewSuppression(email, reason, note, actor.GetID(), s.clock)
+	if err != nil {
+		return Suppression{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	suppressed, err := s.suppressions.IsSuppressed(entry.Email)
+	if err != nil {
+		return Suppression{}, &kernel.Error{Operation: op, Cause: err}
+	}
+	if suppressed {
+		return Suppression{}, &kernel.Error{Code: kernel.EConflict, Message: MSuppressionExists, Operation: op}
+	}
+
+	if err := s.suppressions.AddSuppression(entry); err != nil {
+		return Suppression{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return entry, nil
+}
+
+// Remove takes an address off the suppression list, e.g. once a bounced
+// mailbox works again. Its subscription, if any, is left as it is.
+func (s *SuppressionService) Remove(email shared.Email, actor user.PostPermissionChecker) error {
+	const op = "SuppressionService.Remove"
+
+	if !user.IsActiveAdmin(actor) {
+		return &kernel.Error{Code: kernel.EForbidden, Message: MSuppressionForbidden, Operation: op}
+	}
+
+	if err := s.suppressions.RemoveSuppression(email.Normalize()); err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return nil
+}
@@ -0,0 +1,115 @@
+package subscription_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/shared"
+	"github.com/alnah/fla/internal/domain/subscription"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+// stubSuppressionStore keeps entries by canonical address.
+type stubSuppressionStore struct {
+	entries map[shared.Email]subscription.Suppression
+}
+
+func (s *stubSuppressionStore) IsSuppressed(email shared.Email) (bool, error) {
+	_, ok := s.entries[email.Canonical()]
+	return ok, nil
+}
+
+func (s *stubSuppressionStore) GetSuppressions() ([]subscription.Suppression, error) {
+	var out []subscription.Suppression
+	for _, e := range s.entries {
+		out = append(out, e)
+	}
+	return out, nil
+}
+
+func (s *stubSuppressionStore) AddSuppression(entry subscription.Suppression) error {
+	s.entries[entry.Email.Canonical()] = entry
+	return nil
+}
+
+func (s *stubSuppressionStore) RemoveSuppression(email shared.Email) error {
+	if _, ok := s.entries[email.Canonical()]; !ok {
+		return &kernel.Error{Code: kernel.ENotFound, Message: subscription.MSuppressionNotFound}
+	}
+	delete(s.entries, email.Canonical())
+	return nil
+}
+
+func TestNewSuppression(t *testing.T) {
+	clock := &stubClock{t: time.Date(2024, 5, 1, 9, 0, 0, 0, time.UTC)}
+
+	t.Run("mailer entries need no author", func(t *testing.T) {
+		got, err := subscription.NewSuppression("Lea@Example.COM", subscription.ReasonHardBounce, "", "", clock)
+		assertNoError(t, err)
+		if got.Email != "Lea@example.com" || !got.CreatedAt.Equal(clock.t) {
+			t.Errorf("got %s at %s", got, got.CreatedAt)
+		}
+	})
+
+	tests := []struct {
+		name   string
+		email  shared.Email
+		reason subscription.SuppressionReason
+		note   string
+	}{
+		{"invalid email", "lea", subscription.ReasonManual, ""},
+		{"unknown reason", "lea@example.com", "unsubscribed", ""},
+		{"missing reason", "lea@example.com", "", ""},
+		{"note too long", "lea@example.com", subscription.ReasonManual, strings.Repeat("n", subscription.MaxSuppressionNoteLength+1)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := subscription.NewSuppression(tt.email, tt.reason, tt.note, "", clock)
+			assertErrorCode(t, err, kernel.EInvalid)
+		})
+	}
+}
+
+func TestSuppressionService(t *testing.T) {
+	clock := &stubClock{t: time.Date(2024, 5, 1, 9, 0, 0, 0, time.UTC)}
+	admin := user.User{ID: "admin-1", Roles: []user.Role{user.RoleAdmin}}
+	editor := user.User{ID: "editor-1", Roles: []user.Role{user.RoleEditor}}
+
+	store := &stubSuppressionStore{entries: map[shared.Email]subscription.Suppression{}}
+	service := subscription.NewSuppressionService(store, clock)
+
+	t.Run("admins add entries with a reason", func(t *testing.T) {
+		got, err := service.Add("a.n.a@gmail.com", subscription.ReasonManual, "Asked by letter", admin)
+		assertNoError(t, err)
+		if got.AddedBy != "admin-1" || got.Reason != subscription.ReasonManual {
+			t.Errorf("got %s by %s", got, got.AddedBy)
+		}
+
+		suppressed, _ := store.IsSuppressed("ana+news@gmail.com")
+		if !suppressed {
+			t.Error("expected every spelling of the inbox suppressed")
+		}
+	})
+
+	t.Run("keeps the first reason", func(t *testing.T) {
+		_, err := service.Add("ana@gmail.com", subscription.ReasonComplaint, "", admin)
+		assertErrorCode(t, err, kernel.EConflict)
+	})
+
+	t.Run("requires an admin", func(t *testing.T) {
+		_, err := service.Add("tom@example.com", subscription.ReasonManual, "", editor)
+		assertErrorCode(t, err, kernel.EForbidden)
+
+		err = service.Remove("ana@gmail.com", editor)
+		assertErrorCode(t, err, kernel.EForbidden)
+	})
+
+	t.Run("admins remove entries", func(t *testing.T) {
+		assertNoError(t, service.Remove("ana@gmail.com", admin))
+
+		err := service.Remove("ana@gmail.com", admin)
+		assertErrorCode(t, err, kernel.ENotFound)
+	})
+}
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/shared"
 	"github.com/alnah/fla/internal/domain/user"
 )
 
@@ -60,6 +61,7 @@ type Tag struct {
 
 	// Data
 	Name TagName
+	Slug shared.Slug // Generated from Name for tag page URLs
 
 	// Meta
 	CreatedBy kernel.ID[user.User]
@@ -68,9 +70,18 @@ type Tag struct {
 
 // NewTag creates a validated tag with proper metadata tracking.
 // Ensures tag consistency and audit trail for content organization.
+// Generates the slug from the name when not provided.
 func NewTag(t Tag) (Tag, error) {
 	const op = "NewTag"
 
+	if t.Slug == "" && t.Name != "" {
+		slug, err := shared.NewSlug(t.Name.String())
+		if err != nil {
+			return Tag{}, &kernel.Error{Operation: op, Cause: err}
+		}
+		t.Slug = slug
+	}
+
 	if err := t.Validate(); err != nil {
 		return Tag{}, &kernel.Error{Operation: op, Cause: err}
 	}
@@ -91,9 +102,36 @@ func (t Tag) Validate() error {
 		return &kernel.Error{Operation: op, Cause: err}
 	}
 
+	if t.Slug != "" {
+		if err := t.Slug.Validate(); err != nil {
+			return &kernel.Error{Operation: op, Cause: err}
+		}
+	}
+
 	if err := t.CreatedBy.Validate(); err != nil {
 		return &kernel.Error{Operation: op, Cause: err}
 	}
 
 	return nil
 }
+
+// Rename returns a copy of the tag with a new name and regenerated slug.
+// Slug uniqueness is checked by the service against the repository.
+func (t Tag) Rename(name TagName) (Tag, error) {
+	const op = "Tag.Rename"
+
+	slug, err := shared.NewSlug(name.String())
+	if err != nil {
+		return t, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	renamed := t
+	renamed.Name = name
+	renamed.Slug = slug
+
+	if err := renamed.Validate(); err != nil {
+		return t, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return renamed, nil
+}
@@ -96,6 +96,9 @@ func TestNewTag(t *testing.T) {
 		if got.CreatedBy != validUserID {
 			t.Errorf("CreatedBy: got %v, want %v", got.CreatedBy, validUserID)
 		}
+		if got.Slug != "grammar" {
+			t.Errorf("Slug: got %q, want %q", got.Slug, "grammar")
+		}
 		if !got.CreatedAt.Equal(validTime) {
 			t.Errorf("CreatedAt: got %v, want %v", got.CreatedAt, validTime)
 		}
@@ -218,6 +221,30 @@ func TestTag_Validate(t *testing.T) {
 	})
 }
 
+func TestTag_Rename(t *testing.T) {
+	original, err := tag.NewTag(tag.Tag{TagID: "tag-1", Name: "Grammaire", CreatedBy: "user-123"})
+	assertNoError(t, err)
+
+	t.Run("regenerates slug", func(t *testing.T) {
+		got, err := original.Rename("Passé composé")
+
+		assertNoError(t, err)
+		if got.Name != "Passé composé" || got.Slug != "passe-compose" {
+			t.Errorf("got %q / %q", got.Name, got.Slug)
+		}
+		if original.Slug != "grammaire" {
+			t.Error("original tag must be unchanged")
+		}
+	})
+
+	t.Run("rejects invalid name", func(t *testing.T) {
+		_, err := original.Rename("")
+
+		assertError(t, err)
+		assertErrorCode(t, err, kernel.EInvalid)
+	})
+}
+
 func TestTagName_String(t *testing.T) {
 	want := "grammar"
 	name := tag.TagName(want)
@@ -0,0 +1,58 @@
+package tag
+
+import (
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/shared"
+)
+
+// TagReader defines read operations for tag lookup.
+// Used by tag pages, post editors, and tag management screens.
+type TagReader interface {
+	// GetByID retrieves a tag for editing or display.
+	GetByID(tagID kernel.ID[Tag]) (*Tag, error)
+
+	// GetBySlug finds a tag from its URL segment for tag pages.
+	// Returns ENotFound when no tag uses the slug.
+	GetBySlug(slug shared.Slug) (*Tag, error)
+
+	// GetAll returns every tag for autocomplete and admin listings.
+	GetAll() ([]Tag, error)
+}
+
+// TagWriter defines tag persistence operations.
+type TagWriter interface {
+	// Create adds a new tag.
+	Create(tag Tag) error
+
+	// Update saves a renamed tag.
+	Update(tag Tag) error
+
+	// Delete removes a tag and its post associations.
+	Delete(tagID kernel.ID[Tag]) error
+}
+
+// TagUsage reports how tags are used by posts.
+// Used by tag clouds, admin listings, and orphan cleanup.
+type TagUsage interface {
+	// CountPosts returns how many posts carry the tag.
+	CountPosts(tagID kernel.ID[Tag]) (int, error)
+
+	// GetOrphans returns tags no post carries.
+	GetOrphans() ([]Tag, error)
+}
+
+// TagMerger re-tags content when tags are consolidated.
+type TagMerger interface {
+	// Merge moves every post association from source to target and deletes source.
+	// Must run in a single transaction so no post ends up untagged or double-tagged.
+	Merge(sourceID, targetID kernel.ID[Tag]) error
+}
+
+// Full repository interface for implementations that provide everything.
+// Most concrete implementations (like PostgresTagRepository) will implement this.
+type Repository interface {
+	TagReader
+	TagWriter
+	TagUsage
+	TagMerger
+}
@@ -0,0 +1,128 @@
+package tag
+
+import (
+	"github.com/alnah/fla/internal/domain/kernel"
+)
+
+const (
+	MTagManageForbidden string = "You are not allowed to manage tags."
+	MTagMergeSelf       string = "Cannot merge a tag into itself."
+	MTagSlugTaken       string = "Another tag already uses this name."
+)
+
+// Manager represents a user that may manage tags.
+// Implemented by user.User.
+type Manager interface {
+	CanManageTags() bool
+}
+
+// TagService handles tag maintenance: merging duplicates, renaming, and cleanup.
+type TagService struct {
+	repository Repository
+}
+
+// NewTagService creates tag service with repository dependency.
+func NewTagService(repository Repository) *TagService {
+	return &TagService{
+		repository: repository,
+	}
+}
+
+// MergeTags folds source into target: posts tagged with source end up tagged with target.
+func (s *TagService) MergeTags(sourceID, targetID kernel.ID[Tag], actor Manager) error {
+	const op = "TagService.MergeTags"
+
+	if err := authorize(actor, op); err != nil {
+		return err
+	}
+
+	if sourceID == targetID {
+		return &kernel.Error{Code: kernel.EInvalid, Message: MTagMergeSelf, Operation: op}
+	}
+
+	for _, id := range []kernel.ID[Tag]{sourceID, targetID} {
+		if _, err := s.repository.GetByID(id); err != nil {
+			return &kernel.Error{Operation: op, Cause: err}
+		}
+	}
+
+	if err := s.repository.Merge(sourceID, targetID); err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return nil
+}
+
+// Rename changes a tag's name and slug, refusing slugs used by another tag.
+func (s *TagService) Rename(tagID kernel.ID[Tag], name TagName, actor Manager) (Tag, error) {
+	const op = "TagService.Rename"
+
+	if err := authorize(actor, op); err != nil {
+		return Tag{}, err
+	}
+
+	current, err := s.repository.GetByID(tagID)
+	if err != nil {
+		return Tag{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	renamed, err := current.Rename(name)
+	if err != nil {
+		return Tag{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	existing, err := s.repository.GetBySlug(renamed.Slug)
+	if err != nil && kernel.ErrorCode(err) != kernel.ENotFound {
+		return Tag{}, &kernel.Error{Operation: op, Cause: err}
+	}
+	if existing != nil && existing.TagID != tagID {
+		return Tag{}, &kernel.Error{Code: kernel.EConflict, Message: MTagSlugTaken, Operation: op}
+	}
+
+	if err := s.repository.Update(renamed); err != nil {
+		return Tag{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return renamed, nil
+}
+
+// GetUsageCount returns how many posts carry the tag.
+func (s *TagService) GetUsageCount(tagID kernel.ID[Tag]) (int, error) {
+	const op = "TagService.GetUsageCount"
+
+	count, err := s.repository.CountPosts(tagID)
+	if err != nil {
+		return 0, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return count, nil
+}
+
+// CleanupOrphans deletes tags no post uses and returns how many were removed.
+func (s *TagService) CleanupOrphans(actor Manager) (int, error) {
+	const op = "TagService.CleanupOrphans"
+
+	if err := authorize(actor, op); err != nil {
+		return 0, err
+	}
+
+	orphans, err := s.repository.GetOrphans()
+	if err != nil {
+		return 0, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	for i, orphan := range orphans {
+		if err := s.repository.Delete(orphan.TagID); err != nil {
+			return i, &kernel.Error{Operation: op, Cause: err}
+		}
+	}
+
+	return len(orphans), nil
+}
+
+func authorize(actor Manager, op string) error {
+	if actor == nil || !actor.CanManageTags() {
+		return &kernel.Error{Code: kernel.EForbidden, Message: MTagManageForbidden, Operation: op}
+	}
+	return nil
+}
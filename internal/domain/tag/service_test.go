@@ -0,0 +1,185 @@
+package tag_test
+
+import (
+	"testing"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/policy"
+	"github.com/alnah/fla/internal/domain/shared"
+	"github.com/alnah/fla/internal/domain/tag"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+type mockTagRepository struct {
+	tags   map[kernel.ID[tag.Tag]]tag.Tag
+	usage  map[kernel.ID[tag.Tag]]int
+	merged [][2]kernel.ID[tag.Tag]
+}
+
+func newMockTagRepository(t *testing.T, names ...string) *mockTagRepository {
+	t.Helper()
+
+	r := &mockTagRepository{tags: map[kernel.ID[tag.Tag]]tag.Tag{}, usage: map[kernel.ID[tag.Tag]]int{}}
+	for _, name := range names {
+		created, err := tag.NewTag(tag.Tag{TagID: kernel.ID[tag.Tag](name), Name: tag.TagName(name), CreatedBy: "user-123"})
+		assertNoError(t, err)
+		r.tags[created.TagID] = created
+	}
+	return r
+}
+
+func (r *mockTagRepository) GetByID(id kernel.ID[tag.Tag]) (*tag.Tag, error) {
+	if found, ok := r.tags[id]; ok {
+		return &found, nil
+	}
+	return nil, &kernel.Error{Code: kernel.ENotFound, Message: "tag not found"}
+}
+
+func (r *mockTagRepository) GetBySlug(slug shared.Slug) (*tag.Tag, error) {
+	for _, found := range r.tags {
+		if found.Slug == slug {
+			return &found, nil
+		}
+	}
+	return nil, &kernel.Error{Code: kernel.ENotFound, Message: "tag not found"}
+}
+
+func (r *mockTagRepository) GetAll() ([]tag.Tag, error) { return nil, nil }
+
+func (r *mockTagRepository) Create(t tag.Tag) error { r.tags[t.TagID] = t; return nil }
+
+func (r *mockTagRepository) Update(t tag.Tag) error { r.tags[t.TagID] = t; return nil }
+
+func (r *mockTagRepository) Delete(id kernel.ID[tag.Tag]) error { delete(r.tags, id); return nil }
+
+func (r *mockTagRepository) CountPosts(id kernel.ID[tag.Tag]) (int, error) { return r.usage[id], nil }
+
+func (r *mockTagRepository) GetOrphans() ([]tag.Tag, error) {
+	var orphans []tag.Tag
+	for id, found := range r.tags {
+		if r.usage[id] == 0 {
+			orphans = append(orphans, found)
+		}
+	}
+	return orphans, nil
+}
+
+func (r *mockTagRepository) Merge(sourceID, targetID kernel.ID[tag.Tag]) error {
+	r.merged = append(r.merged, [2]kernel.ID[tag.Tag]{sourceID, targetID})
+	r.usage[targetID] += r.usage[sourceID]
+	delete(r.usage, sourceID)
+	delete(r.tags, sourceID)
+	return nil
+}
+
+var (
+	editor = user.User{ID: "user-1", Roles: []user.Role{user.RoleEditor}, Scopes: []string{policy.AllScopes}}
+	author = user.User{ID: "user-2", Roles: []user.Role{user.RoleAuthor}}
+)
+
+func TestTagService_MergeTags(t *testing.T) {
+	t.Run("merges source into target", func(t *testing.T) {
+		repo := newMockTagRepository(t, "grammaire", "grammar")
+		repo.usage["grammaire"], repo.usage["grammar"] = 3, 2
+		service := tag.NewTagService(repo)
+
+		err := service.MergeTags("grammaire", "grammar", editor)
+
+		assertNoError(t, err)
+		if count, _ := service.GetUsageCount("grammar"); count != 5 {
+			t.Errorf("usage: got %d, want 5", count)
+		}
+		if _, ok := repo.tags["grammaire"]; ok {
+			t.Error("source tag must be removed")
+		}
+	})
+
+	t.Run("rejects invalid merges", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			source   kernel.ID[tag.Tag]
+			target   kernel.ID[tag.Tag]
+			actor    tag.Manager
+			wantCode string
+		}{
+			{name: "author", source: "grammaire", target: "grammar", actor: author, wantCode: kernel.EForbidden},
+			{name: "same tag", source: "grammar", target: "grammar", actor: editor, wantCode: kernel.EInvalid},
+			{name: "unknown target", source: "grammar", target: "missing", actor: editor, wantCode: kernel.ENotFound},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				repo := newMockTagRepository(t, "grammaire", "grammar")
+				service := tag.NewTagService(repo)
+
+				err := service.MergeTags(tt.source, tt.target, tt.actor)
+
+				assertError(t, err)
+				assertErrorCode(t, err, tt.wantCode)
+				if len(repo.merged) != 0 {
+					t.Error("repository must not be called")
+				}
+			})
+		}
+	})
+}
+
+func TestTagService_Rename(t *testing.T) {
+	t.Run("renames tag", func(t *testing.T) {
+		repo := newMockTagRepository(t, "grammaire")
+		service := tag.NewTagService(repo)
+
+		got, err := service.Rename("grammaire", "Grammaire française", editor)
+
+		assertNoError(t, err)
+		if repo.tags["grammaire"].Slug != "grammaire-francaise" || got.Slug != "grammaire-francaise" {
+			t.Errorf("slug: got %q", got.Slug)
+		}
+	})
+
+	t.Run("allows case-only rename of the same tag", func(t *testing.T) {
+		service := tag.NewTagService(newMockTagRepository(t, "grammaire"))
+
+		_, err := service.Rename("grammaire", "Grammaire", editor)
+
+		assertNoError(t, err)
+	})
+
+	t.Run("detects slug conflicts", func(t *testing.T) {
+		service := tag.NewTagService(newMockTagRepository(t, "grammaire", "vocabulaire"))
+
+		_, err := service.Rename("grammaire", "Vocabulaire", editor)
+
+		assertError(t, err)
+		assertErrorCode(t, err, kernel.EConflict)
+	})
+
+	t.Run("forbids authors", func(t *testing.T) {
+		service := tag.NewTagService(newMockTagRepository(t, "grammaire"))
+
+		_, err := service.Rename("grammaire", "Grammar", author)
+
+		assertError(t, err)
+		assertErrorCode(t, err, kernel.EForbidden)
+	})
+}
+
+func TestTagService_CleanupOrphans(t *testing.T) {
+	repo := newMockTagRepository(t, "grammaire", "orpheline")
+	repo.usage["grammaire"] = 1
+	service := tag.NewTagService(repo)
+
+	_, err := service.CleanupOrphans(author)
+	assertError(t, err)
+	assertErrorCode(t, err, kernel.EForbidden)
+
+	got, err := service.CleanupOrphans(editor)
+
+	assertNoError(t, err)
+	if got != 1 || len(repo.tags) != 1 {
+		t.Errorf("removed %d, remaining %d", got, len(repo.tags))
+	}
+	if _, ok := repo.tags["grammaire"]; !ok {
+		t.Error("used tag must be kept")
+	}
+}
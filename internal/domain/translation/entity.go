@@ -0,0 +1,74 @@
+// Package translation drafts locale variants of posts with a machine translator.
+// Drafts are ordinary draft posts tied to their source; they are published only
+// once a human has reviewed the text as it stands, and are flagged when the source
+// changes after translation.
+package translation
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/glossary"
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/post"
+	"github.com/alnah/fla/internal/domain/shared"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+// Translator turns text from one locale into another. Implemented by machine
+// translation adapters; Markdown structure must survive translation.
+type Translator interface {
+	// Translate returns text in the target locale, following the glossary hints.
+	Translate(text string, from, to shared.Locale, hints []GlossaryHint) (string, error)
+}
+
+// GlossaryHint tells the translator how to render a term. An empty Target keeps
+// the source term as written.
+type GlossaryHint struct {
+	Source string
+	Target string
+}
+
+// HintsFrom keeps every glossary headword and form untranslated: they are the
+// French the lesson teaches, whatever language it is explained in.
+func HintsFrom(terms []glossary.Term) []GlossaryHint {
+	var hints []GlossaryHint
+	for _, term := range terms {
+		for _, form := range term.AllForms() {
+			hints = append(hints, GlossaryHint{Source: form})
+		}
+	}
+	return hints
+}
+
+// Variant ties a translated post to its source.
+type Variant struct {
+	PostID       kernel.ID[post.Post] // The translated post
+	SourceID     kernel.ID[post.Post]
+	Locale       shared.Locale
+	SourceLocale shared.Locale
+	SourceHash   string // post.HashContent of the source when translated
+
+	TranslatedBy kernel.ID[user.User]
+	TranslatedAt time.Time
+
+	ReviewedBy   *kernel.ID[user.User]
+	ReviewedAt   *time.Time
+	ReviewedHash string // post.HashContent of the variant when reviewed
+}
+
+// String returns a string representation of the variant.
+func (v Variant) String() string {
+	return fmt.Sprintf("Variant{PostID: %s, SourceID: %s, Locale: %s, Reviewed: %t}", v.PostID, v.SourceID, v.Locale, v.ReviewedBy != nil)
+}
+
+// IsReviewedFor reports whether a reviewer approved the variant's current text.
+// Editing the variant after review calls for another review.
+func (v Variant) IsReviewedFor(p post.Post) bool {
+	return v.ReviewedBy != nil && v.ReviewedHash == post.HashContent(p.Content)
+}
+
+// IsOutdatedBy reports whether the source changed since the variant was translated.
+func (v Variant) IsOutdatedBy(source post.Post) bool {
+	return v.SourceHash != post.HashContent(source.Content)
+}
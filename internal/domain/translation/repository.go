@@ -0,0 +1,26 @@
+package translation
+
+import (
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/post"
+)
+
+// VariantRepository stores the links between posts and their translations.
+// The same store answers seo.TranslationReader for hreflang links.
+type VariantRepository interface {
+	// GetVariant returns the variant record of a translated post.
+	// Returns ENotFound when the post is not a translation.
+	GetVariant(postID kernel.ID[post.Post]) (*Variant, error)
+
+	// GetVariants lists the translations of a source post, in any review state.
+	GetVariants(sourceID kernel.ID[post.Post]) ([]Variant, error)
+
+	// SaveVariant stores a variant, replacing the previous record of the same post.
+	SaveVariant(variant Variant) error
+}
+
+// PostStore reads and writes the posts variants are made of.
+type PostStore interface {
+	post.PostReader
+	post.PostWriter
+}
@@ -0,0 +1,260 @@
+package translation
+
+import (
+	"fmt"
+
+	"github.com/alnah/fla/internal/domain/glossary"
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/policy"
+	"github.com/alnah/fla/internal/domain/post"
+	"github.com/alnah/fla/internal/domain/shared"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+const (
+	MTranslationForbidden       string = "User cannot translate this post."
+	MTranslationReviewForbidden string = "Only editors and admins can review translations."
+	MTranslationSameLocale      string = "Post is already in %s."
+	MTranslationOfVariant       string = "Translate the source post, not one of its translations."
+	MTranslationExists          string = "Post already has a %s translation."
+	MTranslationNotReviewed     string = "Translation must be reviewed before publication."
+)
+
+// TranslationWorkflow drafts, reviews, and publishes translations of posts.
+type TranslationWorkflow struct {
+	translator   Translator
+	posts        PostStore
+	variants     VariantRepository
+	terms        glossary.TermReader
+	sourceLocale shared.Locale // Locale source posts are written in
+	clock        kernel.Clock
+}
+
+// NewTranslationWorkflow creates translation workflow with a translator, post and
+// variant storage, and the glossary terms to keep untranslated.
+func NewTranslationWorkflow(
+	translator Translator,
+	posts PostStore,
+	variants VariantRepository,
+	terms glossary.TermReader,
+	sourceLocale shared.Locale,
+	clock kernel.Clock,
+) *TranslationWorkflow {
+	return &TranslationWorkflow{
+		translator:   translator,
+		posts:        posts,
+		variants:     variants,
+		terms:        terms,
+		sourceLocale: sourceLocale,
+		clock:        clock,
+	}
+}
+
+// Draft machine-translates the source post into a new draft post with the given ID,
+// owned by actor, in the source's category and with its tags.
+func (w *TranslationWorkflow) Draft(sourceID, variantID kernel.ID[post.Post], locale shared.Locale, actor user.PostPermissionChecker) (post.Post, Variant, error) {
+	const op = "TranslationWorkflow.Draft"
+
+	if err := locale.Validate(); err != nil {
+		return post.Post{}, Variant{}, &kernel.Error{Operation: op, Cause: err}
+	}
+	if locale == w.sourceLocale {
+		return post.Post{}, Variant{}, &kernel.Error{Code: kernel.EInvalid, Message: fmt.Sprintf(MTranslationSameLocale, locale), Operation: op}
+	}
+
+	source, err := w.posts.GetByID(sourceID)
+	if err != nil {
+		return post.Post{}, Variant{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if !user.Authorize(actor, policy.PostView, user.PostResource(*source)).Allowed ||
+		!user.Authorize(actor, policy.PostCreate, policy.Resource{Kind: policy.KindPost}).Allowed {
+		return post.Post{}, Variant{}, &kernel.Error{Code: kernel.EForbidden, Message: MTranslationForbidden, Operation: op}
+	}
+
+	if err := w.ensureNew(*source, locale); err != nil {
+		return post.Post{}, Variant{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	terms, err := w.terms.GetAll()
+	if err != nil {
+		return post.Post{}, Variant{}, &kernel.Error{Operation: op, Cause: err}
+	}
+	hints := HintsFrom(terms)
+
+	translate := func(text string) (string, error) {
+		if text == "" {
+			return "", nil
+		}
+		return w.translator.Translate(text, w.sourceLocale, locale, hints)
+	}
+
+	texts := []string{source.Title.String(), source.Content.String(), source.Excerpt.String(), source.SEODescription.String()}
+	for i, text := range texts {
+		if texts[i], err = translate(text); err != nil {
+			return post.Post{}, Variant{}, &kernel.Error{Operation: op, Cause: err}
+		}
+	}
+
+	translated, err := post.NewPost(post.NewPostParams{
+		PostID:         variantID,
+		Owner:          actor.GetID(),
+		Title:          shared.Title(texts[0]),
+		Content:        post.PostContent(texts[1]),
+		Excerpt:        post.Excerpt(texts[2]),
+		SEODescription: shared.Description(texts[3]),
+		FeaturedImage:  source.FeaturedImage,
+		Status:         post.StatusDraft,
+		Category:       source.Category,
+		Tags:           source.Tags,
+		SchemaType:     source.SchemaType,
+		Typography:     locale,
+		Clock:          w.clock,
+	})
+	if err != nil {
+		return post.Post{}, Variant{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	variant := Variant{
+		PostID:       variantID,
+		SourceID:     sourceID,
+		Locale:       locale,
+		SourceLocale: w.sourceLocale,
+		SourceHash:   post.HashContent(source.Content),
+		TranslatedBy: actor.GetID(),
+		TranslatedAt: w.clock.Now(),
+	}
+
+	if err := w.posts.Create(translated); err != nil {
+		return post.Post{}, Variant{}, &kernel.Error{Operation: op, Cause: err}
+	}
+	if err := w.variants.SaveVariant(variant); err != nil {
+		return post.Post{}, Variant{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return translated, variant, nil
+}
+
+// ensureNew refuses to translate translations and to translate twice into a locale.
+func (w *TranslationWorkflow) ensureNew(source post.Post, locale shared.Locale) error {
+	const op = "TranslationWorkflow.ensureNew"
+
+	_, err := w.variants.GetVariant(source.PostID)
+	if err == nil {
+		return &kernel.Error{Code: kernel.EInvalid, Message: MTranslationOfVariant, Operation: op}
+	}
+	if kernel.ErrorCode(err) != kernel.ENotFound {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	existing, err := w.variants.GetVariants(source.PostID)
+	if err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+	for _, v := range existing {
+		if v.Locale == locale {
+			return &kernel.Error{Code: kernel.EConflict, Message: fmt.Sprintf(MTranslationExists, locale), Operation: op}
+		}
+	}
+
+	return nil
+}
+
+// Review records that an editor checked the translation as it now reads, and
+// approves the translated post. Translators cannot review their own drafts.
+func (w *TranslationWorkflow) Review(variantID kernel.ID[post.Post], reviewer user.PostPermissionChecker) (Variant, error) {
+	const op = "TranslationWorkflow.Review"
+
+	translated, variant, err := w.get(variantID)
+	if err != nil {
+		return Variant{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if !user.Authorize(reviewer, policy.PostReview, user.PostResource(translated)).Allowed {
+		return Variant{}, &kernel.Error{Code: kernel.EForbidden, Message: MTranslationReviewForbidden, Operation: op}
+	}
+
+	approved, err := translated.Approve(reviewer)
+	if err != nil {
+		return Variant{}, &kernel.Error{Operation: op, Cause: err}
+	}
+	if err := w.posts.Update(approved); err != nil {
+		return Variant{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	id, now := reviewer.GetID(), w.clock.Now()
+	variant.ReviewedBy = &id
+	variant.ReviewedAt = &now
+	variant.ReviewedHash = post.HashContent(translated.Content)
+
+	if err := w.variants.SaveVariant(variant); err != nil {
+		return Variant{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return variant, nil
+}
+
+// Publish publishes a reviewed translation. Translations edited since their
+// review go back to review first.
+func (w *TranslationWorkflow) Publish(variantID kernel.ID[post.Post], actor user.PostPermissionChecker) (post.Post, error) {
+	const op = "TranslationWorkflow.Publish"
+
+	translated, variant, err := w.get(variantID)
+	if err != nil {
+		return post.Post{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if !variant.IsReviewedFor(translated) {
+		return post.Post{}, &kernel.Error{Code: kernel.EInvalid, Message: MTranslationNotReviewed, Operation: op}
+	}
+
+	published, err := translated.Publish(actor)
+	if err != nil {
+		return post.Post{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if err := w.posts.Update(published); err != nil {
+		return post.Post{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return published, nil
+}
+
+// Outdated lists the translations of a source post translated from an earlier
+// version of its content.
+func (w *TranslationWorkflow) Outdated(sourceID kernel.ID[post.Post]) ([]Variant, error) {
+	const op = "TranslationWorkflow.Outdated"
+
+	source, err := w.posts.GetByID(sourceID)
+	if err != nil {
+		return nil, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	variants, err := w.variants.GetVariants(sourceID)
+	if err != nil {
+		return nil, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	var outdated []Variant
+	for _, v := range variants {
+		if v.IsOutdatedBy(*source) {
+			outdated = append(outdated, v)
+		}
+	}
+	return outdated, nil
+}
+
+func (w *TranslationWorkflow) get(variantID kernel.ID[post.Post]) (post.Post, Variant, error) {
+	variant, err := w.variants.GetVariant(variantID)
+	if err != nil {
+		return post.Post{}, Variant{}, err
+	}
+
+	translated, err := w.posts.GetByID(variantID)
+	if err != nil {
+		return post.Post{}, Variant{}, err
+	}
+	translated.Clock = w.clock
+
+	return *translated, *variant, nil
+}
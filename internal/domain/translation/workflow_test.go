@@ -0,0 +1,253 @@
+package translation_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/category"
+	"github.com/alnah/fla/internal/domain/glossary"
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/policy"
+	"github.com/alnah/fla/internal/domain/post"
+	"github.com/alnah/fla/internal/domain/shared"
+	"github.com/alnah/fla/internal/domain/translation"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+// stubTranslator tags text with the target locale and records the hints it got.
+type stubTranslator struct {
+	hints []translation.GlossaryHint
+	calls int
+}
+
+func (s *stubTranslator) Translate(text string, from, to shared.Locale, hints []translation.GlossaryHint) (string, error) {
+	s.hints = hints
+	s.calls++
+	return to.String() + " " + text, nil
+}
+
+type stubPosts struct {
+	posts map[kernel.ID[post.Post]]post.Post
+}
+
+func (s *stubPosts) GetByID(postID kernel.ID[post.Post]) (*post.Post, error) {
+	p, ok := s.posts[postID]
+	if !ok {
+		return nil, &kernel.Error{Code: kernel.ENotFound, Message: "no post"}
+	}
+	return &p, nil
+}
+
+func (s *stubPosts) GetBySlug(slug shared.Slug) (*post.Post, error) {
+	return nil, &kernel.Error{Code: kernel.ENotFound, Message: "not used"}
+}
+
+func (s *stubPosts) Create(p post.Post) error { s.posts[p.PostID] = p; return nil }
+func (s *stubPosts) Update(p post.Post) error { s.posts[p.PostID] = p; return nil }
+
+func (s *stubPosts) Delete(postID kernel.ID[post.Post]) error {
+	delete(s.posts, postID)
+	return nil
+}
+
+type stubVariants struct {
+	variants map[kernel.ID[post.Post]]translation.Variant
+}
+
+func (s *stubVariants) GetVariant(postID kernel.ID[post.Post]) (*translation.Variant, error) {
+	v, ok := s.variants[postID]
+	if !ok {
+		return nil, &kernel.Error{Code: kernel.ENotFound, Message: "not a translation"}
+	}
+	return &v, nil
+}
+
+func (s *stubVariants) GetVariants(sourceID kernel.ID[post.Post]) ([]translation.Variant, error) {
+	var variants []translation.Variant
+	for _, v := range s.variants {
+		if v.SourceID == sourceID {
+			variants = append(variants, v)
+		}
+	}
+	return variants, nil
+}
+
+func (s *stubVariants) SaveVariant(v translation.Variant) error {
+	s.variants[v.PostID] = v
+	return nil
+}
+
+type stubTerms []glossary.Term
+
+func (s stubTerms) GetByID(termID kernel.ID[glossary.Term]) (*glossary.Term, error) {
+	return nil, &kernel.Error{Code: kernel.ENotFound, Message: "not used"}
+}
+
+func (s stubTerms) GetAll() ([]glossary.Term, error) { return s, nil }
+
+var (
+	author  = user.User{ID: "author", Roles: []user.Role{user.RoleAuthor}, Status: user.AccountStatusActive}
+	editor  = user.User{ID: "editor", Roles: []user.Role{user.RoleEditor}, Status: user.AccountStatusActive, Scopes: []string{policy.AllScopes}}
+	visitor = user.User{ID: "visitor", Roles: []user.Role{user.RoleVisitor}, Status: user.AccountStatusActive}
+)
+
+type fixture struct {
+	workflow   *translation.TranslationWorkflow
+	translator *stubTranslator
+	posts      *stubPosts
+}
+
+func newFixture(t *testing.T) fixture {
+	t.Helper()
+
+	clock := &stubClock{t: time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)}
+	a1, err := category.NewCategory(category.NewCategoryParams{CategoryID: "a1", Name: "A1", CreatedBy: editor.ID, Clock: clock})
+	assertNoError(t, err)
+	source, err := post.NewPost(post.NewPostParams{
+		PostID:         "lesson",
+		Owner:          author.ID,
+		Title:          "At the market",
+		Content:        post.PostContent(strings.Repeat("On Saturdays we go to the marché to buy fresh vegetables. ", 8)),
+		SEODescription: "Market vocabulary for beginners, with short dialogues.",
+		Status:         post.StatusDraft,
+		Category:       a1,
+		Clock:          clock,
+	})
+	assertNoError(t, err)
+
+	f := fixture{
+		translator: &stubTranslator{},
+		posts:      &stubPosts{posts: map[kernel.ID[post.Post]]post.Post{"lesson": source}},
+	}
+	terms := stubTerms{{TermID: "marche", Headword: "marché", Forms: []string{"marchés"}}}
+	variants := &stubVariants{variants: make(map[kernel.ID[post.Post]]translation.Variant)}
+	f.workflow = translation.NewTranslationWorkflow(f.translator, f.posts, variants, terms, shared.LocaleEnglishUS, clock)
+	return f
+}
+
+func TestTranslationWorkflow_Draft(t *testing.T) {
+	t.Run("creates a draft translation keeping glossary terms", func(t *testing.T) {
+		f := newFixture(t)
+
+		got, variant, err := f.workflow.Draft("lesson", "lesson-pt", shared.LocalePortugueseBR, author)
+
+		assertNoError(t, err)
+		if got.Status != post.StatusDraft || !strings.HasPrefix(got.Content.String(), "pt-BR ") {
+			t.Errorf("got %s with content %.20q", got.Status, got.Content)
+		}
+		if variant.SourceID != "lesson" || variant.Locale != shared.LocalePortugueseBR || variant.ReviewedBy != nil {
+			t.Errorf("got variant %v", variant)
+		}
+		if f.translator.calls != 3 { // Title, content, SEO description; no excerpt
+			t.Errorf("got %d translator calls, want 3", f.translator.calls)
+		}
+		want := []translation.GlossaryHint{{Source: "marché"}, {Source: "marchés"}}
+		if len(f.translator.hints) != 2 || f.translator.hints[0] != want[0] || f.translator.hints[1] != want[1] {
+			t.Errorf("got hints %v, want %v", f.translator.hints, want)
+		}
+	})
+
+	t.Run("once per locale", func(t *testing.T) {
+		f := newFixture(t)
+		_, _, err := f.workflow.Draft("lesson", "lesson-pt", shared.LocalePortugueseBR, author)
+		assertNoError(t, err)
+
+		_, _, err = f.workflow.Draft("lesson", "lesson-pt-2", shared.LocalePortugueseBR, author)
+
+		assertErrorCode(t, err, kernel.EConflict)
+	})
+
+	t.Run("not from a translation", func(t *testing.T) {
+		f := newFixture(t)
+		_, _, err := f.workflow.Draft("lesson", "lesson-pt", shared.LocalePortugueseBR, author)
+		assertNoError(t, err)
+
+		_, _, err = f.workflow.Draft("lesson-pt", "lesson-fr", shared.LocaleFrenchFR, author)
+
+		assertErrorCode(t, err, kernel.EInvalid)
+	})
+
+	t.Run("not into the source locale", func(t *testing.T) {
+		f := newFixture(t)
+
+		_, _, err := f.workflow.Draft("lesson", "lesson-en", shared.LocaleEnglishUS, author)
+
+		assertErrorCode(t, err, kernel.EInvalid)
+	})
+
+	t.Run("only writers who can see the source", func(t *testing.T) {
+		f := newFixture(t)
+
+		_, _, err := f.workflow.Draft("lesson", "lesson-pt", shared.LocalePortugueseBR, visitor)
+
+		assertErrorCode(t, err, kernel.EForbidden)
+	})
+}
+
+func TestTranslationWorkflow_ReviewAndPublish(t *testing.T) {
+	t.Run("publishes once reviewed", func(t *testing.T) {
+		f := newFixture(t)
+		_, _, err := f.workflow.Draft("lesson", "lesson-pt", shared.LocalePortugueseBR, author)
+		assertNoError(t, err)
+
+		_, err = f.workflow.Publish("lesson-pt", editor)
+		assertErrorCode(t, err, kernel.EInvalid)
+
+		_, err = f.workflow.Review("lesson-pt", editor)
+		assertNoError(t, err)
+		got, err := f.workflow.Publish("lesson-pt", editor)
+
+		assertNoError(t, err)
+		if !got.IsPublished() {
+			t.Errorf("got status %s, want published", got.Status)
+		}
+	})
+
+	t.Run("edits after review call for another review", func(t *testing.T) {
+		f := newFixture(t)
+		_, _, err := f.workflow.Draft("lesson", "lesson-pt", shared.LocalePortugueseBR, author)
+		assertNoError(t, err)
+		_, err = f.workflow.Review("lesson-pt", editor)
+		assertNoError(t, err)
+
+		edited := f.posts.posts["lesson-pt"]
+		edited.Content += " Mais uma frase."
+		f.posts.posts["lesson-pt"] = edited
+
+		_, err = f.workflow.Publish("lesson-pt", editor)
+		assertErrorCode(t, err, kernel.EInvalid)
+	})
+
+	t.Run("translators cannot review their own drafts", func(t *testing.T) {
+		f := newFixture(t)
+		_, _, err := f.workflow.Draft("lesson", "lesson-pt", shared.LocalePortugueseBR, editor)
+		assertNoError(t, err)
+
+		_, err = f.workflow.Review("lesson-pt", editor)
+
+		assertErrorCode(t, err, kernel.EForbidden)
+	})
+}
+
+func TestTranslationWorkflow_Outdated(t *testing.T) {
+	f := newFixture(t)
+	_, _, err := f.workflow.Draft("lesson", "lesson-pt", shared.LocalePortugueseBR, author)
+	assertNoError(t, err)
+
+	got, err := f.workflow.Outdated("lesson")
+	assertNoError(t, err)
+	if len(got) != 0 {
+		t.Fatalf("got %d outdated translations of an unchanged source", len(got))
+	}
+
+	source := f.posts.posts["lesson"]
+	source.Content += " New paragraph."
+	f.posts.posts["lesson"] = source
+
+	got, err = f.workflow.Outdated("lesson")
+	assertNoError(t, err)
+	if len(got) != 1 || got[0].PostID != "lesson-pt" {
+		t.Errorf("got %v, want lesson-pt", got)
+	}
+}
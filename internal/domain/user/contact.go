@@ -0,0 +1,230 @@
+package user
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+)
+
+// MessengerPlatform defines supported instant messaging apps for teacher contacts.
+type MessengerPlatform string
+
+const (
+	MessengerWhatsApp MessengerPlatform = "whatsapp" // Reached by phone number
+	MessengerTelegram MessengerPlatform = "telegram" // Reached by @username
+	MessengerSignal   MessengerPlatform = "signal"   // Reached by phone number
+)
+
+const (
+	MPhoneNumberRequired          string = "Phone number is required."
+	MPhoneNumberInvalid           string = "Phone number must be in international format, e.g. +33 6 12 34 56 78."
+	MMessengerHandleRequired      string = "Messenger handle is required."
+	MTelegramHandleInvalid        string = "Telegram username must be 5 to 32 letters, digits, or underscores, starting with a letter."
+	MMessengerPlatformUnsupported string = "Unsupported messenger platform."
+)
+
+// PhoneNumber is a phone number in E.164 form: +, country code, and subscriber
+// number, at most 15 digits, without spaces.
+type PhoneNumber string
+
+// NewPhoneNumber creates a validated phone number from an international number as
+// people write it: spaces, dots, dashes, and parentheses are dropped, and a leading
+// 00 stands for +.
+func NewPhoneNumber(input string) (PhoneNumber, error) {
+	const op = "NewPhoneNumber"
+
+	digits := phoneSeparators.Replace(strings.TrimSpace(input))
+	if rest, ok := strings.CutPrefix(digits, "00"); ok {
+		digits = "+" + rest
+	}
+
+	p := PhoneNumber(digits)
+	if err := p.Validate(); err != nil {
+		return "", &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return p, nil
+}
+
+func (p PhoneNumber) String() string { return string(p) }
+
+// Validate ensures the number is in E.164 form.
+func (p PhoneNumber) Validate() error {
+	const op = "PhoneNumber.Validate"
+
+	if p == "" {
+		return &kernel.Error{Code: kernel.EInvalid, Message: MPhoneNumberRequired, Operation: op}
+	}
+
+	if !e164Re.MatchString(p.String()) {
+		return &kernel.Error{Code: kernel.EInvalid, Message: MPhoneNumberInvalid, Operation: op}
+	}
+
+	return nil
+}
+
+// Digits returns the number without its leading +, as wa.me links expect.
+func (p PhoneNumber) Digits() string {
+	return strings.TrimPrefix(p.String(), "+")
+}
+
+// Display formats the number for reading, grouped as its country writes it
+// (+33 6 12 34 56 78). Numbers of other countries keep their E.164 form.
+func (p PhoneNumber) Display() string {
+	digits := p.Digits()
+	for _, plan := range numberingPlans {
+		national, ok := strings.CutPrefix(digits, plan.countryCode)
+		if !ok || len(national) != plan.length() {
+			continue
+		}
+
+		groups := []string{"+" + plan.countryCode}
+		for _, size := range plan.groups {
+			groups = append(groups, national[:size])
+			national = national[size:]
+		}
+		return strings.Join(groups, " ")
+	}
+	return p.String()
+}
+
+// numberingPlan groups the national number of one country for display.
+type numberingPlan struct {
+	countryCode string
+	groups      []int
+}
+
+func (n numberingPlan) length() int {
+	total := 0
+	for _, size := range n.groups {
+		total += size
+	}
+	return total
+}
+
+// numberingPlans cover the countries most of the blog's teachers and learners call from.
+var numberingPlans = []numberingPlan{
+	{"33", []int{1, 2, 2, 2, 2}}, // France
+	{"32", []int{3, 2, 2, 2}},    // Belgium, mobiles
+	{"41", []int{2, 3, 2, 2}},    // Switzerland
+	{"1", []int{3, 3, 4}},        // United States, Canada
+	{"55", []int{2, 5, 4}},       // Brazil, mobiles
+	{"55", []int{2, 4, 4}},       // Brazil, landlines
+	{"351", []int{3, 3, 3}},      // Portugal
+	{"44", []int{4, 6}},          // United Kingdom
+}
+
+// MessengerContact is a way to reach a teacher on an instant messaging app.
+// Handle is an E.164 phone number for WhatsApp and Signal, a username for Telegram.
+type MessengerContact struct {
+	Platform MessengerPlatform
+	Handle   string
+}
+
+// NewMessengerContact creates validated messenger contact with platform-specific rules.
+// Phone numbers are accepted as people write them; Telegram usernames with or without @.
+func NewMessengerContact(platform MessengerPlatform, handle string) (MessengerContact, error) {
+	const op = "NewMessengerContact"
+
+	handle = strings.TrimSpace(handle)
+	switch platform {
+	case MessengerWhatsApp, MessengerSignal:
+		if phone, err := NewPhoneNumber(handle); err == nil {
+			handle = phone.String()
+		}
+	case MessengerTelegram:
+		handle = strings.TrimPrefix(handle, "@")
+	}
+
+	contact := MessengerContact{Platform: platform, Handle: handle}
+	if err := contact.Validate(); err != nil {
+		return MessengerContact{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return contact, nil
+}
+
+func (mc MessengerContact) String() string {
+	return fmt.Sprintf("MessengerContact{Platform: %q, Handle: %q}", mc.Platform, mc.Handle)
+}
+
+// Validate ensures the handle has the form the platform expects.
+func (mc MessengerContact) Validate() error {
+	const op = "MessengerContact.Validate"
+
+	if err := mc.validatePlatform(); err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if err := mc.validateHandle(); err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return nil
+}
+
+func (mc MessengerContact) validatePlatform() error {
+	const op = "MessengerContact.validatePlatform"
+
+	switch mc.Platform {
+	case MessengerWhatsApp, MessengerTelegram, MessengerSignal:
+		return nil
+	default:
+		return &kernel.Error{
+			Code:      kernel.EConflict,
+			Message:   MMessengerPlatformUnsupported,
+			Operation: op,
+		}
+	}
+}
+
+func (mc MessengerContact) validateHandle() error {
+	const op = "MessengerContact.validateHandle"
+
+	if mc.Handle == "" {
+		return &kernel.Error{Code: kernel.EInvalid, Message: MMessengerHandleRequired, Operation: op}
+	}
+
+	if mc.Platform == MessengerTelegram {
+		if !telegramRe.MatchString(mc.Handle) {
+			return &kernel.Error{Code: kernel.EInvalid, Message: MTelegramHandleInvalid, Operation: op}
+		}
+		return nil
+	}
+
+	if err := PhoneNumber(mc.Handle).Validate(); err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return nil
+}
+
+// Display formats the handle for reading: a grouped phone number or an @username.
+func (mc MessengerContact) Display() string {
+	if mc.Platform == MessengerTelegram {
+		return "@" + mc.Handle
+	}
+	return PhoneNumber(mc.Handle).Display()
+}
+
+// URL returns the link opening a chat with the contact in the app.
+func (mc MessengerContact) URL() string {
+	switch mc.Platform {
+	case MessengerWhatsApp:
+		return "https://wa.me/" + PhoneNumber(mc.Handle).Digits()
+	case MessengerTelegram:
+		return "https://t.me/" + mc.Handle
+	case MessengerSignal:
+		return "https://signal.me/#p/" + mc.Handle
+	default:
+		return ""
+	}
+}
+
+var (
+	e164Re          = regexp.MustCompile(`^\+[1-9][0-9]{1,14}$`)
+	telegramRe      = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]{4,31}$`)
+	phoneSeparators = strings.NewReplacer(" ", "", ".", "", "-", "", "(", "", ")", "", " ", "")
+)
@@ -0,0 +1,88 @@
+package user_test
+
+import (
+	"testing"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+func TestNewPhoneNumber(t *testing.T) {
+	t.Run("accepts numbers as people write them", func(t *testing.T) {
+		tests := []struct {
+			input   string
+			want    user.PhoneNumber
+			display string
+		}{
+			{"+33 6 12 34 56 78", "+33612345678", "+33 6 12 34 56 78"},
+			{"0033.6.12.34.56.78", "+33612345678", "+33 6 12 34 56 78"},
+			{"+1 (415) 555-0123", "+14155550123", "+1 415 555 0123"},
+			{"+55 11 91234-5678", "+5511912345678", "+55 11 91234 5678"},
+			{"+55 11 3123-4567", "+551131234567", "+55 11 3123 4567"},
+			{"+81 90 1234 5678", "+819012345678", "+819012345678"},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.input, func(t *testing.T) {
+				got, err := user.NewPhoneNumber(tt.input)
+				assertNoError(t, err)
+
+				if got != tt.want {
+					t.Errorf("got %q, want %q", got, tt.want)
+				}
+				if got.Display() != tt.display {
+					t.Errorf("display: got %q, want %q", got.Display(), tt.display)
+				}
+			})
+		}
+	})
+
+	t.Run("rejects numbers without country code or too long", func(t *testing.T) {
+		for _, input := range []string{"", "06 12 34 56 78", "+0612345678", "+33 6 12 34 56 78 90 12 34", "+33 six"} {
+			t.Run(input, func(t *testing.T) {
+				_, err := user.NewPhoneNumber(input)
+				assertErrorCode(t, err, kernel.EInvalid)
+			})
+		}
+	})
+}
+
+func TestNewMessengerContact(t *testing.T) {
+	tests := []struct {
+		platform user.MessengerPlatform
+		handle   string
+		display  string
+		url      string
+	}{
+		{user.MessengerWhatsApp, "+33 6 12 34 56 78", "+33 6 12 34 56 78", "https://wa.me/33612345678"},
+		{user.MessengerSignal, "+33612345678", "+33 6 12 34 56 78", "https://signal.me/#p/+33612345678"},
+		{user.MessengerTelegram, "@prof_marie", "@prof_marie", "https://t.me/prof_marie"},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.platform), func(t *testing.T) {
+			got, err := user.NewMessengerContact(tt.platform, tt.handle)
+			assertNoError(t, err)
+
+			if got.Display() != tt.display || got.URL() != tt.url {
+				t.Errorf("got %q and %q", got.Display(), got.URL())
+			}
+		})
+	}
+
+	t.Run("rejects handles of the wrong kind", func(t *testing.T) {
+		_, err := user.NewMessengerContact(user.MessengerWhatsApp, "prof_marie")
+		assertErrorCode(t, err, kernel.EInvalid)
+
+		_, err = user.NewMessengerContact(user.MessengerTelegram, "+33612345678")
+		assertErrorCode(t, err, kernel.EInvalid)
+
+		_, err = user.NewMessengerContact(user.MessengerTelegram, "abc")
+		assertErrorCode(t, err, kernel.EInvalid)
+	})
+
+	t.Run("rejects unsupported platforms", func(t *testing.T) {
+		_, err := user.NewMessengerContact("viber", "+33612345678")
+		assertErrorCode(t, err, kernel.EConflict)
+	})
+}
@@ -15,6 +15,8 @@ const (
 	MUserInvalidRole          string = "Invalid role: %q."
 	MUserInvalidSocialProfile string = "Invalid social profile: %+v."
 	MUserDuplicateSocialMedia string = "Duplicate social media platform: %q."
+	MUserInvalidMessenger     string = "Invalid messenger contact: %+v."
+	MUserDuplicateMessenger   string = "Duplicate messenger platform: %q."
 )
 
 // User represents an authenticated person with role-based permissions in the blogging system.
@@ -26,7 +28,8 @@ type User struct {
 	Email    shared.Email
 
 	// Permissions
-	Roles []Role
+	Roles  []Role
+	Scopes []string // Category IDs an editor is limited to, from category ownership; policy.AllScopes for site-wide editors, empty until resolved
 
 	// Profile Data
 	FirstName      shared.FirstName
@@ -34,9 +37,17 @@ type User struct {
 	Description    shared.Description
 	PictureURL     kernel.URL[ProfilePicture]
 	SocialProfiles []SocialProfile
+	Phone          PhoneNumber // Optional; empty when not shared
+	Messengers     []MessengerContact
 
 	// Preferences
-	LocalePreference shared.Locale // User's preferred interface language
+	LocalePreference   shared.Locale   // User's preferred interface language
+	TimeZonePreference shared.TimeZone // Zone for dates and schedules; empty reads as the default
+
+	// Lifecycle
+	Status        AccountStatus
+	Suspension    *Suspension // Set while suspended (nil otherwise)
+	DeactivatedAt *time.Time
 
 	// Meta
 	CreatedAt time.Time
@@ -61,9 +72,12 @@ type NewUserParams struct {
 	Description    shared.Description
 	PictureURL     kernel.URL[ProfilePicture]
 	SocialProfiles []SocialProfile
+	Phone          PhoneNumber
+	Messengers     []MessengerContact
 
 	// Optional Preferences
-	LocalePreference shared.Locale // Defaults to system default if not provided
+	LocalePreference   shared.Locale   // Defaults to system default if not provided
+	TimeZonePreference shared.TimeZone // Defaults to system default if not provided
 
 	// DI
 	Clock kernel.Clock
@@ -82,20 +96,29 @@ func NewUser(p NewUserParams) (User, error) {
 		locale = shared.DefaultLocale
 	}
 
+	timeZone := p.TimeZonePreference
+	if timeZone == "" {
+		timeZone = shared.DefaultTimeZone
+	}
+
 	user := User{
-		ID:               p.UserID,
-		Username:         p.Username,
-		Email:            p.Email,
-		FirstName:        p.FirstName,
-		LastName:         p.LastName,
-		Description:      p.Description,
-		PictureURL:       p.PictureURL,
-		SocialProfiles:   p.SocialProfiles,
-		LocalePreference: locale,
-		Roles:            p.Roles,
-		CreatedAt:        now,
-		UpdatedAt:        now,
-		Clock:            p.Clock,
+		ID:                 p.UserID,
+		Username:           p.Username,
+		Email:              p.Email,
+		FirstName:          p.FirstName,
+		LastName:           p.LastName,
+		Description:        p.Description,
+		PictureURL:         p.PictureURL,
+		SocialProfiles:     p.SocialProfiles,
+		Phone:              p.Phone,
+		Messengers:         p.Messengers,
+		LocalePreference:   locale,
+		TimeZonePreference: timeZone,
+		Roles:              p.Roles,
+		Status:             AccountStatusActive,
+		CreatedAt:          now,
+		UpdatedAt:          now,
+		Clock:              p.Clock,
 	}
 
 	if err := user.Validate(); err != nil {
@@ -121,6 +144,28 @@ func (u User) UpdateLocalePreference(newLocale shared.Locale) (User, error) {
 	return updated, nil
 }
 
+// UpdateTimeZonePreference allows users to change the time zone their dates
+// and schedules are read in.
+func (u User) UpdateTimeZonePreference(newTimeZone shared.TimeZone) (User, error) {
+	const op = "User.UpdateTimeZonePreference"
+
+	if err := newTimeZone.Validate(); err != nil {
+		return u, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	updated := u
+	updated.TimeZonePreference = newTimeZone
+	updated.UpdatedAt = u.Clock.Now()
+
+	return updated, nil
+}
+
+// GetTimeZone returns the user's time zone, or the default for accounts
+// created before time zones were stored.
+func (u User) GetTimeZone() shared.TimeZone {
+	return u.TimeZonePreference.GetEffectiveTimeZone()
+}
+
 // GetDisplayName returns the user's display name in their preferred language
 func (u User) GetDisplayName() string {
 	// Prioritize first name, then username, then email
@@ -168,8 +213,11 @@ func (u User) String() string {
 		"Description: %q, "+
 		"PictureURL: %q, "+
 		"SocialProfiles: %+v, "+
+		"Messengers: %+v, "+
 		"LocalePreference: %q, "+
+		"TimeZonePreference: %q, "+
 		"Roles: %+v, "+
+		"Status: %q, "+
 		"CreatedAt: %s, "+
 		"UpdatedAt: %s"+
 		"}",
@@ -181,8 +229,11 @@ func (u User) String() string {
 		description,
 		u.PictureURL,
 		u.SocialProfiles,
+		u.Messengers,
 		u.LocalePreference,
+		u.TimeZonePreference,
 		u.Roles,
+		u.Status,
 		u.CreatedAt.Format(time.RFC3339),
 		u.UpdatedAt.Format(time.RFC3339),
 	)
@@ -209,10 +260,18 @@ func (u User) Validate() error {
 		return &kernel.Error{Operation: op, Cause: err}
 	}
 
+	if err := u.Status.Validate(); err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
 	if err := u.validateSocialProfiles(); err != nil {
 		return &kernel.Error{Operation: op, Cause: err}
 	}
 
+	if err := u.validateContacts(); err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
 	return nil
 }
 
@@ -263,6 +322,13 @@ func (u User) validatePreferences() error {
 		return &kernel.Error{Operation: op, Cause: err}
 	}
 
+	// Accounts created before time zones were stored have none
+	if u.TimeZonePreference != "" {
+		if err := u.TimeZonePreference.Validate(); err != nil {
+			return &kernel.Error{Operation: op, Cause: err}
+		}
+	}
+
 	return nil
 }
 
@@ -335,3 +401,36 @@ func (u User) HasRole(role Role) bool {
 func (u User) HasAnyRole(roles ...Role) bool {
 	return slices.ContainsFunc(roles, u.HasRole)
 }
+
+func (u User) validateContacts() error {
+	const op = "User.validateContacts"
+
+	if u.Phone != "" {
+		if err := u.Phone.Validate(); err != nil {
+			return &kernel.Error{Operation: op, Cause: err}
+		}
+	}
+
+	platformCount := make(map[MessengerPlatform]int)
+	for _, contact := range u.Messengers {
+		if err := contact.Validate(); err != nil {
+			return &kernel.Error{
+				Code:      kernel.EInvalid,
+				Message:   fmt.Sprintf(MUserInvalidMessenger, contact),
+				Operation: op,
+				Cause:     err,
+			}
+		}
+
+		platformCount[contact.Platform]++
+		if platformCount[contact.Platform] > 1 {
+			return &kernel.Error{
+				Code:      kernel.EInvalid,
+				Message:   fmt.Sprintf(MUserDuplicateMessenger, contact.Platform),
+				Operation: op,
+			}
+		}
+	}
+
+	return nil
+}
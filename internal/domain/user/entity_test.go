@@ -209,6 +209,31 @@ func TestNewUser(t *testing.T) {
 					Clock: clock,
 				},
 			},
+			{
+				name: "phone number without country code",
+				params: user.NewUserParams{
+					UserID:   validUserID,
+					Username: validUsername,
+					Email:    validEmail,
+					Roles:    []user.Role{user.RoleAuthor},
+					Phone:    "0612345678",
+					Clock:    clock,
+				},
+			},
+			{
+				name: "duplicate messenger platforms",
+				params: user.NewUserParams{
+					UserID:   validUserID,
+					Username: validUsername,
+					Email:    validEmail,
+					Roles:    []user.Role{user.RoleAuthor},
+					Messengers: []user.MessengerContact{
+						{Platform: user.MessengerTelegram, Handle: "prof_marie"},
+						{Platform: user.MessengerTelegram, Handle: "marie_fle"},
+					},
+					Clock: clock,
+				},
+			},
 		}
 
 		for _, tt := range tests {
@@ -306,6 +331,57 @@ func TestUser_UpdateLocalePreference(t *testing.T) {
 	})
 }
 
+func TestUser_UpdateTimeZonePreference(t *testing.T) {
+	clock := &stubClock{t: time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)}
+
+	userID, _ := kernel.NewID[user.User]("user-123")
+	username, _ := shared.NewUsername("johndoe")
+	email, _ := shared.NewEmail("john@example.com")
+
+	u, err := user.NewUser(user.NewUserParams{
+		UserID:   userID,
+		Username: username,
+		Email:    email,
+		Roles:    []user.Role{user.RoleAuthor},
+		Clock:    clock,
+	})
+	assertNoError(t, err)
+
+	t.Run("defaults to the default time zone", func(t *testing.T) {
+		if u.TimeZonePreference != shared.DefaultTimeZone {
+			t.Errorf("TimeZonePreference: got %q, want %q", u.TimeZonePreference, shared.DefaultTimeZone)
+		}
+	})
+
+	t.Run("updates time zone preference successfully", func(t *testing.T) {
+		updated, err := u.UpdateTimeZonePreference("Europe/Paris")
+
+		assertNoError(t, err)
+		if updated.GetTimeZone() != "Europe/Paris" {
+			t.Errorf("GetTimeZone: got %q", updated.GetTimeZone())
+		}
+		if u.TimeZonePreference == updated.TimeZonePreference {
+			t.Error("Original user was modified")
+		}
+	})
+
+	t.Run("rejects unknown time zone", func(t *testing.T) {
+		_, err := u.UpdateTimeZonePreference("Europe/Lyon")
+
+		assertErrorCode(t, err, kernel.EInvalid)
+	})
+
+	t.Run("accounts without a time zone read the default", func(t *testing.T) {
+		legacy := u
+		legacy.TimeZonePreference = ""
+
+		assertNoError(t, legacy.Validate())
+		if legacy.GetTimeZone() != shared.DefaultTimeZone {
+			t.Errorf("GetTimeZone: got %q", legacy.GetTimeZone())
+		}
+	})
+}
+
 func TestUser_GetDisplayName(t *testing.T) {
 	clock := &stubClock{t: time.Now()}
 
@@ -0,0 +1,56 @@
+package user
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/shared"
+)
+
+// ErasedEmailDomain receives the placeholder addresses of erased accounts; the
+// .invalid top-level domain never delivers.
+const ErasedEmailDomain string = "erased.invalid"
+
+const MAccountEraseDenied string = "Only the account owner or an admin can erase an account."
+
+// Anonymize erases the personal data of an account on behalf of its owner or
+// an admin. The ID stays, so lessons and audit entries still point to an
+// account, now holding nothing about the person: username and email become
+// placeholders derived from the ID, the profile is cleared, and the account is
+// deactivated. Roles are kept for the audit trail; a deactivated account uses none.
+func (u User) Anonymize(actor PostPermissionChecker) (User, error) {
+	const op = "User.Anonymize"
+
+	if actor.GetID() != u.ID && !IsActiveAdmin(actor) {
+		return u, &kernel.Error{Code: kernel.EForbidden, Message: MAccountEraseDenied, Operation: op}
+	}
+
+	sum := sha256.Sum256([]byte(u.ID.String()))
+	pseudonym := "erased-" + hex.EncodeToString(sum[:8])
+	now := u.Clock.Now()
+
+	updated := u
+	updated.Username = shared.Username(pseudonym)
+	updated.Email = shared.Email(pseudonym + "@" + ErasedEmailDomain)
+	updated.FirstName = ""
+	updated.LastName = ""
+	updated.Description = ""
+	updated.PictureURL = ""
+	updated.SocialProfiles = nil
+	updated.Phone = ""
+	updated.Messengers = nil
+	updated.Suspension = nil
+	updated.Status = AccountStatusDeactivated
+	if updated.DeactivatedAt == nil {
+		updated.DeactivatedAt = &now
+	}
+	updated.UpdatedAt = now
+
+	return updated, nil
+}
+
+// IsErased reports whether the account was anonymized.
+func (u User) IsErased() bool {
+	return u.Email.Domain() == ErasedEmailDomain
+}
@@ -0,0 +1,47 @@
+package user_test
+
+import (
+	"testing"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+func TestUser_Anonymize(t *testing.T) {
+	t.Run("owner erases their account", func(t *testing.T) {
+		author := createTestUser("author-1", user.RoleAuthor)
+		author.FirstName = "Marie"
+		author.Description = "Professeure de FLE à Lyon."
+
+		got, err := author.Anonymize(author)
+
+		assertNoError(t, err)
+		assertNoError(t, got.Validate())
+		if got.ID != author.ID || !got.IsErased() || !got.IsDeactivated() {
+			t.Errorf("got %s, want an erased, deactivated account with the same ID", got)
+		}
+		if got.FirstName != "" || got.Description != "" || got.Username == author.Username {
+			t.Errorf("personal data kept: %s", got)
+		}
+		if again, _ := author.Anonymize(author); again.Username != got.Username {
+			t.Errorf("placeholder must be stable: got %q and %q", got.Username, again.Username)
+		}
+	})
+
+	t.Run("others cannot erase an account", func(t *testing.T) {
+		author := createTestUser("author-1", user.RoleAuthor)
+		editor := createTestUser("editor-1", user.RoleEditor)
+
+		_, err := author.Anonymize(editor)
+		assertErrorCode(t, err, kernel.EForbidden)
+	})
+
+	t.Run("suspended admins cannot erase others", func(t *testing.T) {
+		author := createTestUser("author-1", user.RoleAuthor)
+		admin := createTestUser("admin-1", user.RoleAdmin)
+		admin.Status = user.AccountStatusSuspended
+
+		_, err := author.Anonymize(admin)
+		assertErrorCode(t, err, kernel.EForbidden)
+	})
+}
@@ -2,6 +2,7 @@ package user
 
 import (
 	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/policy"
 )
 
 // PostInterface represents the minimal interface needed for permission checks.
@@ -20,67 +21,177 @@ type PostPermissionChecker interface {
 	CanEditPost(post PostInterface) bool
 }
 
+// rules are the permissions each role grants. Apart from reading published posts,
+// every permission requires an active account: suspended and deactivated users
+// keep their roles but lose what those roles grant.
+var rules = []policy.Rule{
+	{Name: "anyone reads published posts", Actions: []policy.Action{policy.PostView}, Statuses: []string{"published"}, Anyone: true},
+	{Name: "owners read their own posts", Actions: []policy.Action{policy.PostView}, Owner: true},
+	{Name: "writers create posts", Actions: []policy.Action{policy.PostCreate}, Roles: roles(RoleAdmin, RoleEditor, RoleAuthor)},
+	{
+		Name:    "editors handle posts in their categories",
+		Actions: []policy.Action{policy.PostView, policy.PostEdit, policy.PostPublish, policy.PostSchedule, policy.PostArchive, policy.PostReview, policy.PostFeature},
+		Roles:   roles(RoleAdmin, RoleEditor),
+		Scoped:  true,
+	},
+	{
+		Name:    "authors handle their own posts",
+		Actions: []policy.Action{policy.PostEdit, policy.PostPublish, policy.PostSchedule},
+		Roles:   roles(RoleAuthor),
+		Owner:   true,
+	},
+	{Name: "admins delete any post", Actions: []policy.Action{policy.PostDelete}, Roles: roles(RoleAdmin)},
+	{Name: "owners delete their own drafts", Actions: []policy.Action{policy.PostDelete}, Owner: true, Statuses: []string{"draft"}},
+	{
+		Name:    "editors organize content",
+		Actions: []policy.Action{policy.CategoryManage, policy.TagManage},
+		Roles:   roles(RoleAdmin, RoleEditor),
+		Scoped:  true,
+	},
+	{Name: "admins assign category editors", Actions: []policy.Action{policy.CategoryAssign}, Roles: roles(RoleAdmin)},
+	{
+		Name:    "signed-in readers react",
+		Actions: []policy.Action{policy.ReactionCreate},
+		Roles:   roles(RoleAdmin, RoleEditor, RoleAuthor, RoleSubscriber),
+	},
+}
+
+// permissions is the blog's policy, built once from rules.
+var permissions = policy.New(rules...)
+
+// Permissions returns the blog's permission policy, to document or review it.
+// Packages declaring their own actions build their own policy.
+func Permissions() *policy.Policy {
+	return permissions
+}
+
+func roles(rs ...Role) []string {
+	out := make([]string, len(rs))
+	for i, r := range rs {
+		out[i] = r.String()
+	}
+	return out
+}
+
+// Actor describes the user to the permission policy. Admins are never limited
+// by category scopes; other users get scoped permissions only once their scopes
+// are resolved.
+func (u User) Actor() policy.Actor {
+	actor := policy.Actor{ID: u.ID.String(), Active: u.IsActive(), Scopes: u.Scopes}
+	for _, role := range u.Roles {
+		actor.Roles = append(actor.Roles, role.String())
+	}
+	if u.HasRole(RoleAdmin) {
+		actor.Scopes = []string{policy.AllScopes}
+	}
+	return actor
+}
+
+// ActorOf describes any permission checker to the policy. Checkers other than
+// User that do not report an account status are taken as inactive, and those
+// that do not report scopes get no scoped permission unless they are admins.
+func ActorOf(c PostPermissionChecker) policy.Actor {
+	switch u := c.(type) {
+	case User:
+		return u.Actor()
+	case *User:
+		return u.Actor()
+	}
+
+	actor := policy.Actor{ID: c.GetID().String()}
+	for _, role := range []Role{RoleAdmin, RoleEditor, RoleAuthor, RoleSubscriber, RoleVisitor, RoleMachine} {
+		if c.HasRole(role) {
+			actor.Roles = append(actor.Roles, role.String())
+		}
+	}
+	if status, ok := c.(interface{ IsActive() bool }); ok {
+		actor.Active = status.IsActive()
+	}
+	if scoped, ok := c.(interface{ GetScopes() []string }); ok {
+		actor.Scopes = scoped.GetScopes()
+	}
+	if c.HasRole(RoleAdmin) {
+		actor.Scopes = []string{policy.AllScopes}
+	}
+	return actor
+}
+
+// IsActiveAdmin reports whether the actor is an admin whose account is active.
+// Admin-only operations check it, so suspended and deactivated admins lose their powers.
+func IsActiveAdmin(actor PostPermissionChecker) bool {
+	return actor.HasRole(RoleAdmin) && ActorOf(actor).Active
+}
+
+// PostResource describes a post to the permission policy. Posts reporting
+// their category are subject to category scopes.
+func PostResource(post PostInterface) policy.Resource {
+	resource := policy.Resource{Kind: policy.KindPost, Owner: post.GetOwner().String(), Status: post.GetStatus()}
+	if categorized, ok := post.(interface{ GetCategoryID() string }); ok {
+		resource.Scope = categorized.GetCategoryID()
+	}
+	return resource
+}
+
 // GetID returns the user's ID for permission checks.
 func (u User) GetID() kernel.ID[User] {
 	return u.ID
 }
 
+// Authorize decides whether the actor may perform action on resource under the
+// blog's policy, and why.
+func Authorize(actor PostPermissionChecker, action policy.Action, resource policy.Resource) policy.Decision {
+	return permissions.Authorize(ActorOf(actor), action, resource)
+}
+
+// Authorize decides whether the user may perform action on resource, and why.
+func (u User) Authorize(action policy.Action, resource policy.Resource) policy.Decision {
+	return permissions.Authorize(u.Actor(), action, resource)
+}
+
+func (u User) can(action policy.Action, resource policy.Resource) bool {
+	return u.Authorize(action, resource).Allowed
+}
+
 // CanCreatePost determines if user has permission to create new blog posts.
 // Authors, editors, and admins can create content in the system.
 func (u User) CanCreatePost() bool {
-	return u.HasAnyRole(RoleAdmin, RoleEditor, RoleAuthor)
+	return u.can(policy.PostCreate, policy.Resource{Kind: policy.KindPost})
 }
 
 // CanViewPost checks if user can access post content based on publication status.
-// Published content is public; draft content requires ownership or editorial roles.
+// Published content is public; draft content requires an active account with ownership or editorial roles.
 func (u User) CanViewPost(post PostInterface) bool {
-	if post.GetStatus() == "published" {
-		return true
-	}
-
-	return post.GetOwner() == u.ID || u.HasAnyRole(RoleAdmin, RoleEditor)
+	return u.can(policy.PostView, PostResource(post))
 }
 
 // CanEditPost determines editing permissions based on ownership and role hierarchy.
 // Admins and editors can edit any post; authors can edit their own content.
 func (u User) CanEditPost(post PostInterface) bool {
-	if u.HasAnyRole(RoleAdmin, RoleEditor) {
-		return true
-	}
-
-	return post.GetOwner() == u.ID && u.HasRole(RoleAuthor)
+	return u.can(policy.PostEdit, PostResource(post))
 }
 
 // CanDeletePost restricts deletion to appropriate users based on content status.
 // Prevents accidental loss of published content while allowing draft cleanup.
 func (u User) CanDeletePost(post PostInterface) bool {
-	if u.HasRole(RoleAdmin) {
-		return true
-	}
-
-	return post.GetOwner() == u.ID && post.GetStatus() == "draft"
+	return u.can(policy.PostDelete, PostResource(post))
 }
 
 // CanPublishPost determines publication permissions in the editorial workflow.
 // Maintains content quality through role-based publication controls.
 func (u User) CanPublishPost(post PostInterface) bool {
-	if u.HasAnyRole(RoleAdmin, RoleEditor) {
-		return true
-	}
-
-	return post.GetOwner() == u.ID && u.HasRole(RoleAuthor)
+	return u.can(policy.PostPublish, PostResource(post))
 }
 
 // CanSchedulePost checks permissions for delayed publication features.
 // Enables content planning while maintaining editorial oversight.
 func (u User) CanSchedulePost(post PostInterface) bool {
-	return u.CanPublishPost(post)
+	return u.can(policy.PostSchedule, PostResource(post))
 }
 
 // CanArchivePost determines who can remove content from active circulation.
 // Restricts archiving to editorial roles to prevent content loss.
 func (u User) CanArchivePost(post PostInterface) bool {
-	return u.HasAnyRole(RoleAdmin, RoleEditor)
+	return u.can(policy.PostArchive, PostResource(post))
 }
 
 // CanChangePostStatus validates status transition permissions for workflow control.
@@ -103,13 +214,13 @@ func (u User) CanChangePostStatus(post PostInterface, newStatus string) bool {
 // CanManageCategories determines who can create and modify the content taxonomy.
 // Restricts category management to prevent structural chaos in content organization.
 func (u User) CanManageCategories() bool {
-	return u.HasAnyRole(RoleAdmin, RoleEditor)
+	return u.can(policy.CategoryManage, policy.Resource{Kind: policy.KindCategory})
 }
 
 // CanManageTags controls who can create and modify content tags.
 // Maintains tag consistency while allowing editorial content organization.
 func (u User) CanManageTags() bool {
-	return u.HasAnyRole(RoleAdmin, RoleEditor)
+	return u.can(policy.TagManage, policy.Resource{Kind: policy.KindTag})
 }
 
 // CanAddTagToPost checks if user can associate tags with specific posts.
@@ -123,3 +234,9 @@ func (u User) CanAddTagToPost(post PostInterface) bool {
 func (u User) CanChangePostCategory(post PostInterface) bool {
 	return u.CanEditPost(post)
 }
+
+// CanReact determines who can like and bookmark published posts.
+// Any signed-in reader can react; anonymous visitors and machine accounts cannot.
+func (u User) CanReact() bool {
+	return u.can(policy.ReactionCreate, policy.Resource{Kind: policy.KindPost, Status: "published"})
+}
@@ -1,10 +1,12 @@
 package user_test
 
 import (
+	"slices"
 	"testing"
 	"time"
 
 	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/policy"
 	"github.com/alnah/fla/internal/domain/shared"
 	"github.com/alnah/fla/internal/domain/user"
 )
@@ -37,6 +39,7 @@ func createTestUser(id string, roles ...user.Role) user.User {
 		Roles:    roles,
 		Clock:    clock,
 	})
+	u.Scopes = []string{policy.AllScopes} // Resolved as site-wide
 
 	return u
 }
@@ -457,3 +460,133 @@ func TestUser_CanChangePostCategory(t *testing.T) {
 		})
 	}
 }
+
+func TestUser_CanReact(t *testing.T) {
+	tests := []struct {
+		name  string
+		roles []user.Role
+		want  bool
+	}{
+		{"subscriber can react", []user.Role{user.RoleSubscriber}, true},
+		{"author can react", []user.Role{user.RoleAuthor}, true},
+		{"visitor cannot react", []user.Role{user.RoleVisitor}, false},
+		{"machine cannot react", []user.Role{user.RoleMachine}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u := createTestUser("user-123", tt.roles...)
+
+			got := u.CanReact()
+
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUser_Authorize(t *testing.T) {
+	author := createTestUser("user-123", user.RoleAuthor)
+	draft := &mockPost{owner: author.ID, status: "draft"}
+	other := &mockPost{owner: kernel.ID[user.User]("user-456"), status: "draft"}
+
+	t.Run("explains which rule allowed", func(t *testing.T) {
+		got := author.Authorize(policy.PostEdit, user.PostResource(draft))
+
+		if !got.Allowed || got.Rule != "authors handle their own posts" {
+			t.Errorf("got %s", got)
+		}
+	})
+
+	t.Run("explains a denial", func(t *testing.T) {
+		got := author.Authorize(policy.PostEdit, user.PostResource(other))
+
+		if got.Allowed || got.Reason != policy.ReasonNoRule {
+			t.Errorf("got %s", got)
+		}
+	})
+
+	t.Run("suspended accounts lose their roles", func(t *testing.T) {
+		suspended := author
+		suspended.Status = user.AccountStatusSuspended
+
+		got := suspended.Authorize(policy.PostEdit, user.PostResource(draft))
+
+		if got.Allowed || got.Reason != policy.ReasonInactive {
+			t.Errorf("got %s", got)
+		}
+	})
+}
+
+// statuslessAdmin is a permission checker that does not report an account status.
+type statuslessAdmin struct{}
+
+func (statuslessAdmin) HasRole(role user.Role) bool { return role == user.RoleAdmin }
+func (statuslessAdmin) HasAnyRole(roles ...user.Role) bool {
+	return slices.Contains(roles, user.RoleAdmin)
+}
+func (statuslessAdmin) GetID() kernel.ID[user.User]              { return "admin-1" }
+func (statuslessAdmin) CanEditPost(post user.PostInterface) bool { return true }
+
+func TestActorOf(t *testing.T) {
+	t.Run("users report their status", func(t *testing.T) {
+		admin := createTestUser("admin-1", user.RoleAdmin)
+
+		if got := user.ActorOf(&admin); !got.Active || got.ID != "admin-1" {
+			t.Errorf("got %+v", got)
+		}
+	})
+
+	t.Run("unknown status is inactive", func(t *testing.T) {
+		got := user.Authorize(statuslessAdmin{}, policy.PostDelete, policy.Resource{Kind: policy.KindPost})
+
+		if got.Allowed || got.Reason != policy.ReasonInactive {
+			t.Errorf("got %s", got)
+		}
+	})
+}
+
+// categorizedPost is a post that reports its category, for scoped permissions.
+type categorizedPost struct {
+	mockPost
+	category string
+}
+
+func (c *categorizedPost) GetCategoryID() string { return c.category }
+
+func TestUser_CategoryScopes(t *testing.T) {
+	b2Editor := createTestUser("editor-1", user.RoleEditor)
+	b2Editor.Scopes = []string{"b2", "b2-reading"}
+	inB2 := &categorizedPost{mockPost: mockPost{owner: "author-1", status: "draft"}, category: "b2-reading"}
+	inA1 := &categorizedPost{mockPost: mockPost{owner: "author-1", status: "draft"}, category: "a1"}
+
+	tests := []struct {
+		name  string
+		actor user.User
+		post  user.PostInterface
+		want  bool
+	}{
+		{"scoped editor publishes within scope", b2Editor, inB2, true},
+		{"scoped editor cannot publish outside scope", b2Editor, inA1, false},
+		{"site-wide editor publishes anywhere", createTestUser("editor-2", user.RoleEditor), inA1, true},
+		{"editor with unresolved scopes publishes nowhere", func() user.User {
+			u := createTestUser("editor-3", user.RoleEditor)
+			u.Scopes = nil
+			return u
+		}(), inB2, false},
+		{"admins ignore scopes", func() user.User {
+			u := createTestUser("admin-1", user.RoleAdmin)
+			u.Scopes = []string{"b2"}
+			return u
+		}(), inA1, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.actor.CanPublishPost(tt.post); got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -2,7 +2,10 @@ package user
 
 import (
 	"fmt"
+	"net/netip"
 	"net/url"
+	"regexp"
+	"slices"
 	"strings"
 
 	"github.com/alnah/fla/internal/domain/kernel"
@@ -19,14 +22,54 @@ const (
 	SocialMediaTikTok    SocialMediaURL = "tiktok"    // Short-form video platform
 	SocialMediaYouTube   SocialMediaURL = "youtube"   // Video content platform
 	SocialMediaGitHub    SocialMediaURL = "github"    // Code repository platform
+	SocialMediaFacebook  SocialMediaURL = "facebook"  // Social network, pages and groups
+	SocialMediaMastodon  SocialMediaURL = "mastodon"  // Federated platform, on any instance
+	SocialMediaBluesky   SocialMediaURL = "bluesky"   // Decentralized microblogging platform
+	SocialMediaThreads   SocialMediaURL = "threads"   // Microblogging platform by Instagram
+
+	SocialMediaPersonalWebsite SocialMediaURL = "website" // The user's own site or blog
 )
 
+// SocialPlatforms lists every supported platform in display order: the user's own
+// site first, then the platforms teachers most often share lessons on.
+var SocialPlatforms = []SocialMediaURL{
+	SocialMediaPersonalWebsite,
+	SocialMediaYouTube,
+	SocialMediaInstagram,
+	SocialMediaTikTok,
+	SocialMediaFacebook,
+	SocialMediaLinkedIn,
+	SocialMediaTwitter,
+	SocialMediaMastodon,
+	SocialMediaBluesky,
+	SocialMediaThreads,
+	SocialMediaGitHub,
+}
+
+// platformHosts are the hosts each platform serves profiles from, without "www.".
+// Profiles of Facebook, Bluesky, and Threads must use them; the older platforms are
+// not checked, so profiles saved before these rules stay valid. No platform host
+// is accepted as a personal website. Mastodon instances are checked by path.
+var platformHosts = map[SocialMediaURL][]string{
+	SocialMediaFacebook:  {"facebook.com", "m.facebook.com", "fb.com"},
+	SocialMediaBluesky:   {"bsky.app"},
+	SocialMediaThreads:   {"threads.net", "threads.com"},
+	SocialMediaTwitter:   {"twitter.com", "x.com"},
+	SocialMediaLinkedIn:  {"linkedin.com"},
+	SocialMediaInstagram: {"instagram.com"},
+	SocialMediaTikTok:    {"tiktok.com"},
+	SocialMediaYouTube:   {"youtube.com", "youtu.be"},
+	SocialMediaGitHub:    {"github.com"},
+}
+
 const (
 	MSocialProfileInvalid      string = "Invalid social media profile."
 	MSocialURLRequired         string = "Social media URL is required."
 	MSocialURLInvalidFormat    string = "Invalid URL format."
 	MSocialURLInvalidScheme    string = "Social media URL must use http or https scheme."
 	MSocialPlatformUnsupported string = "Unsupported social media platform."
+	MSocialURLNotProfile       string = "URL is not a %s profile."
+	MWebsiteURLInvalid         string = "Website URL must name a public host, not a social platform."
 )
 
 // SocialProfile represents validated social media profile links.
@@ -78,6 +121,10 @@ func (sp SocialProfile) Validate() error {
 		return &kernel.Error{Operation: op, Cause: err}
 	}
 
+	if err := sp.validatePlatformURL(); err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+
 	return nil
 }
 
@@ -129,18 +176,114 @@ func (sp SocialProfile) validateURLScheme() error {
 func (sp SocialProfile) validatePlatform() error {
 	const op = "SocialProfile.validatePlatform"
 
-	switch sp.Platform {
-	case SocialMediaLinkedIn, SocialMediaInstagram, SocialMediaTwitter,
-		SocialMediaTikTok, SocialMediaYouTube, SocialMediaGitHub:
-		return nil
-	default:
+	if !slices.Contains(SocialPlatforms, sp.Platform) {
 		return &kernel.Error{
 			Code:      kernel.EConflict,
 			Message:   MSocialPlatformUnsupported,
 			Operation: op,
 		}
 	}
+
+	return nil
 }
 
+// validatePlatformURL checks the host and path of platforms whose profile URLs
+// have a known shape.
+func (sp SocialProfile) validatePlatformURL() error {
+	const op = "SocialProfile.validatePlatformURL"
+
+	u, _ := url.Parse(sp.URL)
+	host := strings.TrimPrefix(strings.ToLower(u.Hostname()), "www.")
+
+	var valid bool
+	switch sp.Platform {
+	case SocialMediaPersonalWebsite:
+		return sp.validateWebsite(u, host)
+	case SocialMediaMastodon:
+		valid = strings.Contains(host, ".") && fediverseHandleRe.MatchString(u.Path)
+	case SocialMediaBluesky:
+		valid = slices.Contains(platformHosts[sp.Platform], host) && blueskyPathRe.MatchString(u.Path)
+	case SocialMediaThreads:
+		valid = slices.Contains(platformHosts[sp.Platform], host) && fediverseHandleRe.MatchString(u.Path)
+	case SocialMediaFacebook:
+		valid = slices.Contains(platformHosts[sp.Platform], host) && strings.Trim(u.Path, "/") != ""
+	default:
+		valid = true
+	}
+
+	if !valid {
+		return &kernel.Error{
+			Code:      kernel.EInvalid,
+			Message:   fmt.Sprintf(MSocialURLNotProfile, sp.Platform),
+			Operation: op,
+		}
+	}
+
+	return nil
+}
+
+// validateWebsite accepts public hosts only: no addresses, local names, or
+// credentials, and no social platform, which has its own profile type.
+func (sp SocialProfile) validateWebsite(u *url.URL, host string) error {
+	const op = "SocialProfile.validateWebsite"
+
+	_, ipErr := netip.ParseAddr(host)
+	social := slices.ContainsFunc(SocialPlatforms, func(p SocialMediaURL) bool {
+		return slices.Contains(platformHosts[p], host)
+	})
+
+	if ipErr == nil || !strings.Contains(host, ".") || u.User != nil || social {
+		return &kernel.Error{
+			Code:      kernel.EInvalid,
+			Message:   MWebsiteURLInvalid,
+			Operation: op,
+		}
+	}
+
+	return nil
+}
+
+// Handle returns the account name shown next to the platform icon: @user for
+// Threads, @user@instance for Mastodon, the domain handle for Bluesky, and the
+// host for personal websites. Other platforms have no handle in a fixed place.
+func (sp SocialProfile) Handle() string {
+	u, err := url.Parse(sp.URL)
+	if err != nil {
+		return ""
+	}
+	host := strings.TrimPrefix(strings.ToLower(u.Hostname()), "www.")
+	path := strings.Trim(u.Path, "/")
+
+	switch sp.Platform {
+	case SocialMediaMastodon:
+		if strings.Count(path, "@") > 1 { // Remote account seen through this instance
+			return path
+		}
+		return path + "@" + host
+	case SocialMediaThreads:
+		return path
+	case SocialMediaBluesky:
+		return "@" + strings.TrimPrefix(path, "profile/")
+	case SocialMediaPersonalWebsite:
+		return host
+	default:
+		return ""
+	}
+}
+
+// SortSocialProfiles returns the profiles in display order, that of SocialPlatforms.
+func SortSocialProfiles(profiles []SocialProfile) []SocialProfile {
+	sorted := slices.Clone(profiles)
+	slices.SortStableFunc(sorted, func(a, b SocialProfile) int {
+		return slices.Index(SocialPlatforms, a.Platform) - slices.Index(SocialPlatforms, b.Platform)
+	})
+	return sorted
+}
+
+var (
+	fediverseHandleRe = regexp.MustCompile(`^/@[A-Za-z0-9_.]+(@[A-Za-z0-9.-]+\.[A-Za-z]{2,})?/?$`)
+	blueskyPathRe     = regexp.MustCompile(`^/profile/([A-Za-z0-9-]+(\.[A-Za-z0-9-]+)+|did:[a-z]+:[A-Za-z0-9._:-]+)/?$`)
+)
+
 // ProfilePicture type marker for URL generic
 type ProfilePicture struct{}
@@ -1,6 +1,7 @@
 package user_test
 
 import (
+	"slices"
 	"testing"
 
 	"github.com/alnah/fla/internal/domain/kernel"
@@ -101,7 +102,7 @@ func TestNewSocialProfile(t *testing.T) {
 
 	t.Run("rejects unsupported platforms", func(t *testing.T) {
 		unsupportedPlatforms := []user.SocialMediaURL{
-			"facebook",
+			"myspace",
 			"snapchat",
 			"reddit",
 			"",
@@ -118,6 +119,69 @@ func TestNewSocialProfile(t *testing.T) {
 	})
 }
 
+func TestSocialProfile_PlatformURLs(t *testing.T) {
+	tests := []struct {
+		platform user.SocialMediaURL
+		url      string
+		valid    bool
+		handle   string
+	}{
+		{user.SocialMediaMastodon, "https://mastodon.social/@prof_marie", true, "@prof_marie@mastodon.social"},
+		{user.SocialMediaMastodon, "https://piaille.fr/@marie@mastodon.social", true, "@marie@mastodon.social"},
+		{user.SocialMediaMastodon, "https://mastodon.social/prof_marie", false, ""},
+		{user.SocialMediaMastodon, "https://localhost/@prof_marie", false, ""},
+		{user.SocialMediaBluesky, "https://bsky.app/profile/marie.bsky.social", true, "@marie.bsky.social"},
+		{user.SocialMediaBluesky, "https://bsky.app/marie", false, ""},
+		{user.SocialMediaThreads, "https://www.threads.net/@prof_marie", true, "@prof_marie"},
+		{user.SocialMediaThreads, "https://threads.example.com/@prof_marie", false, ""},
+		{user.SocialMediaFacebook, "https://www.facebook.com/profmarie", true, ""},
+		{user.SocialMediaFacebook, "https://facebook.com/", false, ""},
+		{user.SocialMediaPersonalWebsite, "https://www.marie-fle.fr/cours", true, "marie-fle.fr"},
+		{user.SocialMediaPersonalWebsite, "https://192.168.1.10", false, ""},
+		{user.SocialMediaPersonalWebsite, "http://intranet/", false, ""},
+		{user.SocialMediaPersonalWebsite, "https://facebook.com/profmarie", false, ""},
+		{user.SocialMediaPersonalWebsite, "https://x.com/profmarie", false, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.url, func(t *testing.T) {
+			profile, err := user.NewSocialProfile(tt.platform, tt.url)
+
+			if !tt.valid {
+				assertErrorCode(t, err, kernel.EInvalid)
+				return
+			}
+			assertNoError(t, err)
+			if profile.Handle() != tt.handle {
+				t.Errorf("handle: got %q, want %q", profile.Handle(), tt.handle)
+			}
+		})
+	}
+}
+
+func TestSortSocialProfiles(t *testing.T) {
+	profiles := []user.SocialProfile{
+		{Platform: user.SocialMediaGitHub, URL: "https://github.com/marie"},
+		{Platform: user.SocialMediaMastodon, URL: "https://mastodon.social/@marie"},
+		{Platform: user.SocialMediaPersonalWebsite, URL: "https://marie-fle.fr"},
+		{Platform: user.SocialMediaYouTube, URL: "https://youtube.com/@marie"},
+	}
+
+	sorted := user.SortSocialProfiles(profiles)
+
+	var got []user.SocialMediaURL
+	for _, p := range sorted {
+		got = append(got, p.Platform)
+	}
+	want := []user.SocialMediaURL{user.SocialMediaPersonalWebsite, user.SocialMediaYouTube, user.SocialMediaMastodon, user.SocialMediaGitHub}
+	if !slices.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+	if profiles[0].Platform != user.SocialMediaGitHub {
+		t.Error("input reordered")
+	}
+}
+
 func TestSocialProfile_String(t *testing.T) {
 	profile, _ := user.NewSocialProfile(user.SocialMediaTwitter, "https://twitter.com/username")
 
@@ -179,8 +243,8 @@ func TestSocialProfile_Validate(t *testing.T) {
 
 	t.Run("unsupported platform fails", func(t *testing.T) {
 		profile := user.SocialProfile{
-			Platform: "facebook",
-			URL:      "https://facebook.com/username",
+			Platform: "myspace",
+			URL:      "https://myspace.com/username",
 		}
 
 		err := profile.Validate()
@@ -203,6 +267,11 @@ func TestSocialMediaURLConstants(t *testing.T) {
 		{"TikTok", user.SocialMediaTikTok, "tiktok"},
 		{"YouTube", user.SocialMediaYouTube, "youtube"},
 		{"GitHub", user.SocialMediaGitHub, "github"},
+		{"Facebook", user.SocialMediaFacebook, "facebook"},
+		{"Mastodon", user.SocialMediaMastodon, "mastodon"},
+		{"Bluesky", user.SocialMediaBluesky, "bluesky"},
+		{"Threads", user.SocialMediaThreads, "threads"},
+		{"PersonalWebsite", user.SocialMediaPersonalWebsite, "website"},
 	}
 
 	for _, tt := range tests {
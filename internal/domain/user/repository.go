@@ -0,0 +1,40 @@
+package user
+
+import (
+	"github.com/alnah/fla/internal/domain/kernel"
+)
+
+// RoleCounter counts accounts by role for safety rules.
+type RoleCounter interface {
+	// CountActiveWithRole returns how many active accounts hold the role.
+	// Suspended and deactivated accounts are not counted.
+	CountActiveWithRole(role Role) (int, error)
+}
+
+// RoleChangeWriter persists role changes.
+type RoleChangeWriter interface {
+	// SaveRoleChange stores the user's new roles and appends the audit entry atomically.
+	SaveRoleChange(u User, change RoleChange) error
+}
+
+// RoleHistoryReader retrieves the role audit trail.
+type RoleHistoryReader interface {
+	// GetRoleHistory returns every role change of a user ordered oldest first.
+	GetRoleHistory(userID kernel.ID[User]) ([]RoleChange, error)
+}
+
+// RoleRepository combines what RoleService needs with audit retrieval.
+// Most concrete implementations (like PostgresUserRepository) will implement this.
+type RoleRepository interface {
+	RoleCounter
+	RoleChangeWriter
+	RoleHistoryReader
+}
+
+// StatusRepository counts admins and persists account status changes for StatusService.
+type StatusRepository interface {
+	RoleCounter
+
+	// UpdateUser stores the user's new status.
+	UpdateUser(u User) error
+}
@@ -0,0 +1,165 @@
+package user
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+)
+
+const (
+	MRoleManageForbidden  string = "Only active admins can manage roles."
+	MRoleAlreadyGranted   string = "User already has the %q role."
+	MRoleNotGranted       string = "User does not have the %q role."
+	MRoleLastRole         string = "Cannot revoke the only role of a user."
+	MRoleLastAdmin        string = "Cannot revoke the admin role from the last active admin."
+	MRoleMachineExclusive string = "The machine role cannot be combined with other roles."
+)
+
+// RoleChangeAction tells whether a role was granted or revoked.
+type RoleChangeAction string
+
+const (
+	RoleChangeGranted RoleChangeAction = "granted"
+	RoleChangeRevoked RoleChangeAction = "revoked"
+)
+
+// RoleChange is the audit entry emitted by every successful grant or revoke.
+// Entries are append-only, so the role history of an account can always be replayed.
+type RoleChange struct {
+	UserID    kernel.ID[User]
+	Role      Role
+	Action    RoleChangeAction
+	ChangedBy kernel.ID[User]
+	ChangedAt time.Time
+}
+
+// GrantRole adds a role to the user; only active admins may grant roles.
+// Machine accounts are integration identities and never share a human role.
+func (u User) GrantRole(actor PostPermissionChecker, role Role) (User, RoleChange, error) {
+	const op = "User.GrantRole"
+
+	if !IsActiveAdmin(actor) {
+		return u, RoleChange{}, &kernel.Error{Code: kernel.EForbidden, Message: MRoleManageForbidden, Operation: op}
+	}
+
+	if err := role.Validate(); err != nil {
+		return u, RoleChange{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if u.HasRole(role) {
+		return u, RoleChange{}, &kernel.Error{
+			Code:      kernel.EConflict,
+			Message:   fmt.Sprintf(MRoleAlreadyGranted, role),
+			Operation: op,
+		}
+	}
+
+	if len(u.Roles) > 0 && (role == RoleMachine || u.HasRole(RoleMachine)) {
+		return u, RoleChange{}, &kernel.Error{Code: kernel.EConflict, Message: MRoleMachineExclusive, Operation: op}
+	}
+
+	updated := u
+	updated.Roles = append(slices.Clone(u.Roles), role)
+
+	updated, change := updated.recordRoleChange(actor, role, RoleChangeGranted)
+
+	return updated, change, nil
+}
+
+// RevokeRole removes a role from the user; only active admins may revoke roles.
+// A user keeps at least one role. The last-admin rule needs all accounts and is enforced by RoleService.
+func (u User) RevokeRole(actor PostPermissionChecker, role Role) (User, RoleChange, error) {
+	const op = "User.RevokeRole"
+
+	if !IsActiveAdmin(actor) {
+		return u, RoleChange{}, &kernel.Error{Code: kernel.EForbidden, Message: MRoleManageForbidden, Operation: op}
+	}
+
+	if !u.HasRole(role) {
+		return u, RoleChange{}, &kernel.Error{
+			Code:      kernel.EConflict,
+			Message:   fmt.Sprintf(MRoleNotGranted, role),
+			Operation: op,
+		}
+	}
+
+	if len(u.Roles) == 1 {
+		return u, RoleChange{}, &kernel.Error{Code: kernel.EConflict, Message: MRoleLastRole, Operation: op}
+	}
+
+	updated := u
+	updated.Roles = slices.DeleteFunc(slices.Clone(u.Roles), func(r Role) bool { return r == role })
+
+	updated, change := updated.recordRoleChange(actor, role, RoleChangeRevoked)
+
+	return updated, change, nil
+}
+
+// recordRoleChange stamps the update and builds its audit entry.
+func (u User) recordRoleChange(actor PostPermissionChecker, role Role, action RoleChangeAction) (User, RoleChange) {
+	now := u.Clock.Now()
+	u.UpdatedAt = now
+
+	return u, RoleChange{
+		UserID:    u.ID,
+		Role:      role,
+		Action:    action,
+		ChangedBy: actor.GetID(),
+		ChangedAt: now,
+	}
+}
+
+// RoleService applies role changes that depend on other accounts.
+type RoleService struct {
+	repository RoleRepository
+}
+
+// NewRoleService creates role service with account counting and audit persistence.
+func NewRoleService(repository RoleRepository) *RoleService {
+	return &RoleService{repository: repository}
+}
+
+// Grant adds a role and persists the user together with its audit entry.
+func (s *RoleService) Grant(target User, actor PostPermissionChecker, role Role) (User, error) {
+	const op = "RoleService.Grant"
+
+	updated, change, err := target.GrantRole(actor, role)
+	if err != nil {
+		return target, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if err := s.repository.SaveRoleChange(updated, change); err != nil {
+		return target, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return updated, nil
+}
+
+// Revoke removes a role and persists the user together with its audit entry.
+// Refuses to revoke admin from the last active admin so the site never locks itself out.
+func (s *RoleService) Revoke(target User, actor PostPermissionChecker, role Role) (User, error) {
+	const op = "RoleService.Revoke"
+
+	if role == RoleAdmin && target.HasRole(RoleAdmin) && target.IsActive() && IsActiveAdmin(actor) {
+		admins, err := s.repository.CountActiveWithRole(RoleAdmin)
+		if err != nil {
+			return target, &kernel.Error{Operation: op, Cause: err}
+		}
+		if admins <= 1 {
+			return target, &kernel.Error{Code: kernel.EConflict, Message: MRoleLastAdmin, Operation: op}
+		}
+	}
+
+	updated, change, err := target.RevokeRole(actor, role)
+	if err != nil {
+		return target, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if err := s.repository.SaveRoleChange(updated, change); err != nil {
+		return target, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return updated, nil
+}
@@ -0,0 +1,216 @@
+package user_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+type stubRoleRepository struct {
+	admins  int
+	err     error
+	saved   []user.User
+	changes []user.RoleChange
+}
+
+func (s *stubRoleRepository) CountActiveWithRole(role user.Role) (int, error) {
+	return s.admins, s.err
+}
+
+func (s *stubRoleRepository) SaveRoleChange(u user.User, change user.RoleChange) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.saved = append(s.saved, u)
+	s.changes = append(s.changes, change)
+	return nil
+}
+
+func (s *stubRoleRepository) GetRoleHistory(userID kernel.ID[user.User]) ([]user.RoleChange, error) {
+	return s.changes, s.err
+}
+
+func TestUser_GrantRole(t *testing.T) {
+	admin := createTestUser("admin-1", user.RoleAdmin)
+	suspendedAdmin := createTestUser("admin-2", user.RoleAdmin)
+	suspendedAdmin.Status = user.AccountStatusSuspended
+
+	t.Run("admin grants role and emits audit entry", func(t *testing.T) {
+		author := createTestUser("author-1", user.RoleAuthor)
+
+		got, change, err := author.GrantRole(admin, user.RoleEditor)
+
+		assertNoError(t, err)
+		if !got.HasRole(user.RoleEditor) || !got.HasRole(user.RoleAuthor) {
+			t.Errorf("got roles %v", got.Roles)
+		}
+		if author.HasRole(user.RoleEditor) {
+			t.Error("original user should not change")
+		}
+		want := user.RoleChange{UserID: author.ID, Role: user.RoleEditor, Action: user.RoleChangeGranted, ChangedBy: admin.ID, ChangedAt: got.UpdatedAt}
+		if change != want {
+			t.Errorf("got change %+v, want %+v", change, want)
+		}
+	})
+
+	tests := []struct {
+		name  string
+		actor user.User
+		roles []user.Role
+		role  user.Role
+		code  string
+	}{
+		{"rejects non-admin", createTestUser("editor-1", user.RoleEditor), []user.Role{user.RoleAuthor}, user.RoleEditor, kernel.EForbidden},
+		{"rejects suspended admin", suspendedAdmin, []user.Role{user.RoleAuthor}, user.RoleAdmin, kernel.EForbidden},
+		{"rejects invalid role", admin, []user.Role{user.RoleAuthor}, "owner", kernel.EInvalid},
+		{"rejects role already held", admin, []user.Role{user.RoleAuthor}, user.RoleAuthor, kernel.EConflict},
+		{"rejects machine on human account", admin, []user.Role{user.RoleAuthor}, user.RoleMachine, kernel.EConflict},
+		{"rejects human role on machine account", admin, []user.Role{user.RoleMachine}, user.RoleEditor, kernel.EConflict},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := createTestUser("target-1", tt.roles...)
+
+			_, _, err := target.GrantRole(tt.actor, tt.role)
+
+			assertErrorCode(t, err, tt.code)
+		})
+	}
+}
+
+func TestUser_RevokeRole(t *testing.T) {
+	admin := createTestUser("admin-1", user.RoleAdmin)
+
+	t.Run("admin revokes role and emits audit entry", func(t *testing.T) {
+		target := createTestUser("user-1", user.RoleAuthor, user.RoleEditor)
+
+		got, change, err := target.RevokeRole(admin, user.RoleEditor)
+
+		assertNoError(t, err)
+		if got.HasRole(user.RoleEditor) || !got.HasRole(user.RoleAuthor) {
+			t.Errorf("got roles %v", got.Roles)
+		}
+		if !target.HasRole(user.RoleEditor) {
+			t.Error("original user should not change")
+		}
+		if change.Action != user.RoleChangeRevoked || change.Role != user.RoleEditor || change.ChangedBy != admin.ID {
+			t.Errorf("got change %+v", change)
+		}
+	})
+
+	t.Run("rejects non-admin", func(t *testing.T) {
+		target := createTestUser("user-1", user.RoleAuthor, user.RoleEditor)
+
+		_, _, err := target.RevokeRole(target, user.RoleAuthor)
+
+		assertErrorCode(t, err, kernel.EForbidden)
+	})
+
+	t.Run("rejects suspended admin", func(t *testing.T) {
+		target := createTestUser("user-1", user.RoleAuthor, user.RoleEditor)
+		suspended := createTestUser("admin-2", user.RoleAdmin)
+		suspended.Status = user.AccountStatusSuspended
+
+		_, _, err := target.RevokeRole(suspended, user.RoleEditor)
+
+		assertErrorCode(t, err, kernel.EForbidden)
+	})
+
+	t.Run("rejects role not held", func(t *testing.T) {
+		target := createTestUser("user-1", user.RoleAuthor, user.RoleEditor)
+
+		_, _, err := target.RevokeRole(admin, user.RoleAdmin)
+
+		assertErrorCode(t, err, kernel.EConflict)
+	})
+
+	t.Run("rejects removing the only role", func(t *testing.T) {
+		target := createTestUser("user-1", user.RoleAuthor)
+
+		_, _, err := target.RevokeRole(admin, user.RoleAuthor)
+
+		assertErrorCode(t, err, kernel.EConflict)
+		assertErrorMessage(t, err, user.MRoleLastRole)
+	})
+}
+
+func TestRoleService_Grant(t *testing.T) {
+	admin := createTestUser("admin-1", user.RoleAdmin)
+	author := createTestUser("author-1", user.RoleAuthor)
+
+	t.Run("persists user with audit entry", func(t *testing.T) {
+		repo := &stubRoleRepository{}
+		service := user.NewRoleService(repo)
+
+		got, err := service.Grant(author, admin, user.RoleEditor)
+
+		assertNoError(t, err)
+		if !got.HasRole(user.RoleEditor) || len(repo.saved) != 1 || len(repo.changes) != 1 {
+			t.Errorf("got roles %v, saved %d, changes %d", got.Roles, len(repo.saved), len(repo.changes))
+		}
+	})
+
+	t.Run("does not persist refused change", func(t *testing.T) {
+		repo := &stubRoleRepository{}
+		service := user.NewRoleService(repo)
+
+		_, err := service.Grant(author, author, user.RoleEditor)
+
+		assertErrorCode(t, err, kernel.EForbidden)
+		if len(repo.changes) != 0 {
+			t.Error("refused change should not be recorded")
+		}
+	})
+}
+
+func TestRoleService_Revoke(t *testing.T) {
+	admin := createTestUser("admin-1", user.RoleAdmin, user.RoleEditor)
+	other := createTestUser("admin-2", user.RoleAdmin)
+
+	t.Run("revokes admin while another admin remains", func(t *testing.T) {
+		repo := &stubRoleRepository{admins: 2}
+		service := user.NewRoleService(repo)
+
+		got, err := service.Revoke(admin, other, user.RoleAdmin)
+
+		assertNoError(t, err)
+		if got.HasRole(user.RoleAdmin) || len(repo.changes) != 1 {
+			t.Errorf("got roles %v, changes %d", got.Roles, len(repo.changes))
+		}
+	})
+
+	t.Run("refuses to revoke the last active admin", func(t *testing.T) {
+		repo := &stubRoleRepository{admins: 1}
+		service := user.NewRoleService(repo)
+
+		_, err := service.Revoke(admin, admin, user.RoleAdmin)
+
+		assertErrorCode(t, err, kernel.EConflict)
+		assertErrorMessage(t, err, user.MRoleLastAdmin)
+		if len(repo.changes) != 0 {
+			t.Error("refused change should not be recorded")
+		}
+	})
+
+	t.Run("suspended admin does not count as last admin", func(t *testing.T) {
+		suspended, _ := admin.Suspend("Compromised account", other)
+		repo := &stubRoleRepository{admins: 1}
+		service := user.NewRoleService(repo)
+
+		_, err := service.Revoke(suspended, other, user.RoleAdmin)
+
+		assertNoError(t, err)
+	})
+
+	t.Run("propagates repository errors", func(t *testing.T) {
+		repo := &stubRoleRepository{err: errors.New("db down")}
+		service := user.NewRoleService(repo)
+
+		_, err := service.Revoke(admin, other, user.RoleAdmin)
+
+		assertError(t, err)
+	})
+}
@@ -0,0 +1,247 @@
+package user
+
+import (
+	"strings"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+)
+
+// MaxSuspensionReasonLength keeps suspension notes short enough for audit listings.
+const MaxSuspensionReasonLength = 500
+
+const (
+	MAccountStatusInvalid      string = "Invalid account status."
+	MAccountStatusForbidden    string = "Only active admins can suspend or reactivate accounts."
+	MAccountDeactivateDenied   string = "Only the account owner or an active admin can deactivate an account."
+	MAccountSelfSuspension     string = "Admins cannot suspend their own account."
+	MAccountSelfActivation     string = "Admins cannot reactivate their own account."
+	MAccountLastAdmin          string = "Cannot suspend or deactivate the last active admin."
+	MAccountAlreadyActive      string = "Account is already active."
+	MAccountAlreadySuspended   string = "Account is already suspended."
+	MAccountAlreadyDeactivated string = "Account is already deactivated."
+	MSuspensionReasonMissing   string = "Suspension reason is required."
+	MSuspensionReasonTooLong   string = "Suspension reason must be at most 500 characters."
+)
+
+// AccountStatus tracks whether a user may act on the platform.
+// Only active accounts keep the capabilities granted by their roles.
+type AccountStatus string
+
+const (
+	AccountStatusActive      AccountStatus = "active"      // Normal access according to roles
+	AccountStatusSuspended   AccountStatus = "suspended"   // Blocked by an admin, can be reactivated
+	AccountStatusDeactivated AccountStatus = "deactivated" // Closed by the owner or an admin
+)
+
+func (s AccountStatus) String() string { return string(s) }
+
+// Validate ensures the status is a defined lifecycle state.
+// Empty status is accepted and treated as active for accounts stored before statuses existed.
+func (s AccountStatus) Validate() error {
+	const op = "AccountStatus.Validate"
+
+	switch s {
+	case "", AccountStatusActive, AccountStatusSuspended, AccountStatusDeactivated:
+		return nil
+	default:
+		return &kernel.Error{Code: kernel.EInvalid, Message: MAccountStatusInvalid, Operation: op}
+	}
+}
+
+// GetEffectiveStatus returns the status, defaulting to active when unset.
+func (s AccountStatus) GetEffectiveStatus() AccountStatus {
+	if s == "" {
+		return AccountStatusActive
+	}
+	return s
+}
+
+// Suspension records who blocked an account, when, and why.
+type Suspension struct {
+	Reason      string
+	SuspendedBy kernel.ID[User]
+	SuspendedAt time.Time
+}
+
+// IsActive returns true if the account keeps its role capabilities.
+func (u User) IsActive() bool {
+	return u.Status.GetEffectiveStatus() == AccountStatusActive
+}
+
+// IsSuspended returns true if an admin has blocked the account.
+func (u User) IsSuspended() bool {
+	return u.Status == AccountStatusSuspended
+}
+
+// IsDeactivated returns true if the account has been closed.
+func (u User) IsDeactivated() bool {
+	return u.Status == AccountStatusDeactivated
+}
+
+// Activate restores a suspended or deactivated account; only other active admins may do this.
+func (u User) Activate(actor PostPermissionChecker) (User, error) {
+	const op = "User.Activate"
+
+	if !IsActiveAdmin(actor) {
+		return u, &kernel.Error{Code: kernel.EForbidden, Message: MAccountStatusForbidden, Operation: op}
+	}
+
+	if actor.GetID() == u.ID {
+		return u, &kernel.Error{Code: kernel.EForbidden, Message: MAccountSelfActivation, Operation: op}
+	}
+
+	if u.IsActive() {
+		return u, &kernel.Error{Code: kernel.EConflict, Message: MAccountAlreadyActive, Operation: op}
+	}
+
+	updated := u
+	updated.Status = AccountStatusActive
+	updated.Suspension = nil
+	updated.DeactivatedAt = nil
+	updated.UpdatedAt = u.Clock.Now()
+
+	return updated, nil
+}
+
+// Suspend blocks an active account until an admin reactivates it.
+// Only active admins may suspend, never themselves, and a reason is required for the audit trail.
+// The last-admin rule needs all accounts and is enforced by StatusService.
+func (u User) Suspend(reason string, by PostPermissionChecker) (User, error) {
+	const op = "User.Suspend"
+
+	if !IsActiveAdmin(by) {
+		return u, &kernel.Error{Code: kernel.EForbidden, Message: MAccountStatusForbidden, Operation: op}
+	}
+
+	if by.GetID() == u.ID {
+		return u, &kernel.Error{Code: kernel.EForbidden, Message: MAccountSelfSuspension, Operation: op}
+	}
+
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return u, &kernel.Error{Code: kernel.EInvalid, Message: MSuspensionReasonMissing, Operation: op}
+	}
+	if len([]rune(reason)) > MaxSuspensionReasonLength {
+		return u, &kernel.Error{Code: kernel.EInvalid, Message: MSuspensionReasonTooLong, Operation: op}
+	}
+
+	switch u.Status.GetEffectiveStatus() {
+	case AccountStatusSuspended:
+		return u, &kernel.Error{Code: kernel.EConflict, Message: MAccountAlreadySuspended, Operation: op}
+	case AccountStatusDeactivated:
+		return u, &kernel.Error{Code: kernel.EConflict, Message: MAccountAlreadyDeactivated, Operation: op}
+	}
+
+	now := u.Clock.Now()
+
+	updated := u
+	updated.Status = AccountStatusSuspended
+	updated.Suspension = &Suspension{Reason: reason, SuspendedBy: by.GetID(), SuspendedAt: now}
+	updated.UpdatedAt = now
+
+	return updated, nil
+}
+
+// Deactivate closes an account; the owner or an active admin may do this.
+// A suspended account can be deactivated, keeping its suspension record.
+// The last-admin rule needs all accounts and is enforced by StatusService.
+func (u User) Deactivate(actor PostPermissionChecker) (User, error) {
+	const op = "User.Deactivate"
+
+	if actor.GetID() != u.ID && !IsActiveAdmin(actor) {
+		return u, &kernel.Error{Code: kernel.EForbidden, Message: MAccountDeactivateDenied, Operation: op}
+	}
+
+	if u.IsDeactivated() {
+		return u, &kernel.Error{Code: kernel.EConflict, Message: MAccountAlreadyDeactivated, Operation: op}
+	}
+
+	now := u.Clock.Now()
+
+	updated := u
+	updated.Status = AccountStatusDeactivated
+	updated.DeactivatedAt = &now
+	updated.UpdatedAt = now
+
+	return updated, nil
+}
+
+// StatusService applies account status changes that depend on other accounts.
+type StatusService struct {
+	repository StatusRepository
+}
+
+// NewStatusService creates status service with account counting and persistence.
+func NewStatusService(repository StatusRepository) *StatusService {
+	return &StatusService{repository: repository}
+}
+
+// Activate restores the account and persists it.
+func (s *StatusService) Activate(target User, actor PostPermissionChecker) (User, error) {
+	const op = "StatusService.Activate"
+
+	updated, err := target.Activate(actor)
+	if err != nil {
+		return target, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return s.save(updated, target, op)
+}
+
+// Suspend blocks the account and persists it.
+// Refuses to suspend the last active admin so the site never locks itself out.
+func (s *StatusService) Suspend(target User, reason string, actor PostPermissionChecker) (User, error) {
+	const op = "StatusService.Suspend"
+
+	updated, err := target.Suspend(reason, actor)
+	if err != nil {
+		return target, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if err := s.keepAnAdmin(target, op); err != nil {
+		return target, err
+	}
+
+	return s.save(updated, target, op)
+}
+
+// Deactivate closes the account and persists it.
+// Refuses to deactivate the last active admin so the site never locks itself out.
+func (s *StatusService) Deactivate(target User, actor PostPermissionChecker) (User, error) {
+	const op = "StatusService.Deactivate"
+
+	updated, err := target.Deactivate(actor)
+	if err != nil {
+		return target, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if err := s.keepAnAdmin(target, op); err != nil {
+		return target, err
+	}
+
+	return s.save(updated, target, op)
+}
+
+// keepAnAdmin fails when target is the only active admin left.
+func (s *StatusService) keepAnAdmin(target User, op string) error {
+	if !target.HasRole(RoleAdmin) || !target.IsActive() {
+		return nil
+	}
+
+	admins, err := s.repository.CountActiveWithRole(RoleAdmin)
+	if err != nil {
+		return &kernel.Error{Operation: op, Cause: err}
+	}
+	if admins <= 1 {
+		return &kernel.Error{Code: kernel.EConflict, Message: MAccountLastAdmin, Operation: op}
+	}
+	return nil
+}
+
+func (s *StatusService) save(updated, target User, op string) (User, error) {
+	if err := s.repository.UpdateUser(updated); err != nil {
+		return target, &kernel.Error{Operation: op, Cause: err}
+	}
+	return updated, nil
+}
@@ -0,0 +1,305 @@
+package user_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+func TestAccountStatus_Validate(t *testing.T) {
+	for _, s := range []user.AccountStatus{"", user.AccountStatusActive, user.AccountStatusSuspended, user.AccountStatusDeactivated} {
+		assertNoError(t, s.Validate())
+	}
+
+	err := user.AccountStatus("banned").Validate()
+	assertErrorCode(t, err, kernel.EInvalid)
+}
+
+func TestNewUser_DefaultsToActive(t *testing.T) {
+	u := createTestUser("user-1", user.RoleAuthor)
+
+	if u.Status != user.AccountStatusActive || !u.IsActive() {
+		t.Errorf("got status %q, want active", u.Status)
+	}
+}
+
+func TestUser_Suspend(t *testing.T) {
+	admin := createTestUser("admin-1", user.RoleAdmin)
+
+	t.Run("admin suspends with reason", func(t *testing.T) {
+		author := createTestUser("author-1", user.RoleAuthor)
+
+		got, err := author.Suspend("  Spam links in drafts  ", admin)
+
+		assertNoError(t, err)
+		if !got.IsSuspended() || got.IsActive() {
+			t.Errorf("got status %q, want suspended", got.Status)
+		}
+		if got.Suspension == nil || got.Suspension.Reason != "Spam links in drafts" || got.Suspension.SuspendedBy != admin.ID {
+			t.Errorf("got suspension %+v", got.Suspension)
+		}
+		if author.Status != user.AccountStatusActive {
+			t.Error("original user should not change")
+		}
+	})
+
+	t.Run("rejects non-admin", func(t *testing.T) {
+		author := createTestUser("author-1", user.RoleAuthor)
+		editor := createTestUser("editor-1", user.RoleEditor)
+
+		_, err := author.Suspend("Spam", editor)
+
+		assertErrorCode(t, err, kernel.EForbidden)
+	})
+
+	t.Run("rejects self suspension", func(t *testing.T) {
+		_, err := admin.Suspend("Testing", admin)
+
+		assertErrorCode(t, err, kernel.EForbidden)
+		assertErrorMessage(t, err, user.MAccountSelfSuspension)
+	})
+
+	t.Run("requires reason", func(t *testing.T) {
+		author := createTestUser("author-1", user.RoleAuthor)
+
+		_, err := author.Suspend("   ", admin)
+
+		assertErrorCode(t, err, kernel.EInvalid)
+	})
+
+	t.Run("rejects overlong reason", func(t *testing.T) {
+		author := createTestUser("author-1", user.RoleAuthor)
+
+		_, err := author.Suspend(strings.Repeat("a", user.MaxSuspensionReasonLength+1), admin)
+
+		assertErrorCode(t, err, kernel.EInvalid)
+	})
+
+	t.Run("rejects already suspended", func(t *testing.T) {
+		author := createTestUser("author-1", user.RoleAuthor)
+		suspended, _ := author.Suspend("Spam", admin)
+
+		_, err := suspended.Suspend("Again", admin)
+
+		assertErrorCode(t, err, kernel.EConflict)
+	})
+}
+
+func TestUser_Activate(t *testing.T) {
+	admin := createTestUser("admin-1", user.RoleAdmin)
+	author := createTestUser("author-1", user.RoleAuthor)
+	suspended, _ := author.Suspend("Spam", admin)
+
+	t.Run("admin reactivates suspended account", func(t *testing.T) {
+		got, err := suspended.Activate(admin)
+
+		assertNoError(t, err)
+		if !got.IsActive() || got.Suspension != nil {
+			t.Errorf("got status %q, suspension %+v", got.Status, got.Suspension)
+		}
+	})
+
+	t.Run("admin reactivates deactivated account", func(t *testing.T) {
+		closed, _ := author.Deactivate(author)
+
+		got, err := closed.Activate(admin)
+
+		assertNoError(t, err)
+		if !got.IsActive() || got.DeactivatedAt != nil {
+			t.Errorf("got status %q, deactivated at %v", got.Status, got.DeactivatedAt)
+		}
+	})
+
+	t.Run("rejects non-admin", func(t *testing.T) {
+		_, err := suspended.Activate(suspended)
+
+		assertErrorCode(t, err, kernel.EForbidden)
+	})
+
+	t.Run("rejects suspended admin reactivating themselves", func(t *testing.T) {
+		other := createTestUser("admin-2", user.RoleAdmin)
+		suspendedAdmin, err := other.Suspend("Compromised account", admin)
+		assertNoError(t, err)
+
+		_, err = suspendedAdmin.Activate(suspendedAdmin)
+
+		assertErrorCode(t, err, kernel.EForbidden)
+	})
+
+	t.Run("rejects admin reactivating their own account", func(t *testing.T) {
+		closed, _ := admin.Deactivate(admin)
+
+		_, err := closed.Activate(admin)
+
+		assertErrorCode(t, err, kernel.EForbidden)
+	})
+
+	t.Run("rejects already active", func(t *testing.T) {
+		_, err := author.Activate(admin)
+
+		assertErrorCode(t, err, kernel.EConflict)
+	})
+}
+
+func TestUser_Deactivate(t *testing.T) {
+	admin := createTestUser("admin-1", user.RoleAdmin)
+	author := createTestUser("author-1", user.RoleAuthor)
+
+	t.Run("owner deactivates own account", func(t *testing.T) {
+		got, err := author.Deactivate(author)
+
+		assertNoError(t, err)
+		if !got.IsDeactivated() || got.DeactivatedAt == nil {
+			t.Errorf("got status %q", got.Status)
+		}
+	})
+
+	t.Run("admin deactivates suspended account", func(t *testing.T) {
+		suspended, _ := author.Suspend("Spam", admin)
+
+		got, err := suspended.Deactivate(admin)
+
+		assertNoError(t, err)
+		if !got.IsDeactivated() || got.Suspension == nil {
+			t.Errorf("got status %q, suspension %+v", got.Status, got.Suspension)
+		}
+	})
+
+	t.Run("rejects other non-admin", func(t *testing.T) {
+		editor := createTestUser("editor-1", user.RoleEditor)
+
+		_, err := author.Deactivate(editor)
+
+		assertErrorCode(t, err, kernel.EForbidden)
+	})
+
+	t.Run("rejects suspended admin", func(t *testing.T) {
+		other := createTestUser("admin-2", user.RoleAdmin)
+		suspendedAdmin, err := other.Suspend("Compromised account", admin)
+		assertNoError(t, err)
+
+		_, err = author.Deactivate(suspendedAdmin)
+
+		assertErrorCode(t, err, kernel.EForbidden)
+	})
+
+	t.Run("rejects already deactivated", func(t *testing.T) {
+		closed, _ := author.Deactivate(author)
+
+		_, err := closed.Deactivate(author)
+
+		assertErrorCode(t, err, kernel.EConflict)
+	})
+}
+
+type stubStatusRepository struct {
+	admins int
+	saved  []user.User
+}
+
+func (s *stubStatusRepository) CountActiveWithRole(role user.Role) (int, error) {
+	return s.admins, nil
+}
+
+func (s *stubStatusRepository) UpdateUser(u user.User) error {
+	s.saved = append(s.saved, u)
+	return nil
+}
+
+func TestStatusService(t *testing.T) {
+	admin := createTestUser("admin-1", user.RoleAdmin)
+	other := createTestUser("admin-2", user.RoleAdmin)
+
+	t.Run("suspends an admin while another remains", func(t *testing.T) {
+		repository := &stubStatusRepository{admins: 2}
+
+		got, err := user.NewStatusService(repository).Suspend(other, "Compromised account", admin)
+
+		assertNoError(t, err)
+		if !got.IsSuspended() || len(repository.saved) != 1 {
+			t.Errorf("got status %q, %d saved", got.Status, len(repository.saved))
+		}
+	})
+
+	t.Run("refuses to suspend the last active admin", func(t *testing.T) {
+		repository := &stubStatusRepository{admins: 1}
+
+		_, err := user.NewStatusService(repository).Suspend(other, "Compromised account", admin)
+
+		assertErrorCode(t, err, kernel.EConflict)
+		assertErrorMessage(t, err, user.MAccountLastAdmin)
+		if len(repository.saved) != 0 {
+			t.Error("refused change was persisted")
+		}
+	})
+
+	t.Run("refuses to deactivate the last active admin", func(t *testing.T) {
+		repository := &stubStatusRepository{admins: 1}
+
+		_, err := user.NewStatusService(repository).Deactivate(admin, admin)
+
+		assertErrorCode(t, err, kernel.EConflict)
+	})
+
+	t.Run("reactivates and persists", func(t *testing.T) {
+		repository := &stubStatusRepository{admins: 2}
+		suspended, err := other.Suspend("Compromised account", admin)
+		assertNoError(t, err)
+
+		got, err := user.NewStatusService(repository).Activate(suspended, admin)
+
+		assertNoError(t, err)
+		if !got.IsActive() || len(repository.saved) != 1 {
+			t.Errorf("got status %q, %d saved", got.Status, len(repository.saved))
+		}
+	})
+}
+
+func TestUser_InactiveLosesCapabilities(t *testing.T) {
+	admin := createTestUser("admin-1", user.RoleAdmin)
+	editor := createTestUser("editor-1", user.RoleEditor)
+	draft := &mockPost{owner: editor.ID, status: "draft"}
+	published := &mockPost{owner: editor.ID, status: "published"}
+
+	suspended, _ := editor.Suspend("Spam", admin)
+	deactivated, _ := editor.Deactivate(editor)
+
+	for name, u := range map[string]user.User{"suspended": suspended, "deactivated": deactivated} {
+		t.Run(name, func(t *testing.T) {
+			denied := map[string]bool{
+				"CanCreatePost":       u.CanCreatePost(),
+				"CanEditPost":         u.CanEditPost(draft),
+				"CanDeletePost":       u.CanDeletePost(draft),
+				"CanPublishPost":      u.CanPublishPost(draft),
+				"CanSchedulePost":     u.CanSchedulePost(draft),
+				"CanArchivePost":      u.CanArchivePost(published),
+				"CanChangePostStatus": u.CanChangePostStatus(draft, "published"),
+				"CanManageCategories": u.CanManageCategories(),
+				"CanManageTags":       u.CanManageTags(),
+				"CanViewPost(draft)":  u.CanViewPost(draft),
+			}
+			for check, allowed := range denied {
+				if allowed {
+					t.Errorf("%s: expected false", check)
+				}
+			}
+
+			if !u.CanViewPost(published) {
+				t.Error("published content should stay visible")
+			}
+			if !u.HasRole(user.RoleEditor) {
+				t.Error("roles should be kept")
+			}
+		})
+	}
+
+	t.Run("zero status keeps legacy users active", func(t *testing.T) {
+		legacy := user.User{ID: "legacy-1", Roles: []user.Role{user.RoleAuthor}}
+
+		if !legacy.CanCreatePost() {
+			t.Error("expected legacy user without status to create posts")
+		}
+	})
+}
@@ -0,0 +1,77 @@
+package widget
+
+import (
+	"encoding/json"
+	"fmt"
+	"html"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+)
+
+const (
+	OEmbedVersion = "1.0"
+	OEmbedType    = "rich"
+
+	DefaultCardWidth  = 480
+	DefaultCardHeight = 200
+)
+
+const MCardSerializationFailed string = "Lesson card could not be serialized."
+
+// LessonCard is the oEmbed-compatible payload describing an embeddable lesson.
+// Standard oEmbed fields come first; lesson-specific fields follow as extensions.
+type LessonCard struct {
+	// oEmbed (https://oembed.com) rich response
+	Type            string `json:"type"`
+	Version         string `json:"version"`
+	Title           string `json:"title"`
+	ProviderName    string `json:"provider_name"`
+	ProviderURL     string `json:"provider_url"`
+	ThumbnailURL    string `json:"thumbnail_url,omitempty"`
+	HTML            string `json:"html"`
+	Width           int    `json:"width"`
+	Height          int    `json:"height"`
+	AuthorName      string `json:"author_name,omitempty"`
+	CacheAgeSeconds int    `json:"cache_age,omitempty"`
+
+	// Lesson extensions
+	Excerpt            string `json:"excerpt"`
+	LevelBadge         string `json:"level_badge,omitempty"`
+	ReadingTimeMinutes int    `json:"reading_time_minutes"`
+	CanonicalURL       string `json:"canonical_url"`
+	Locale             string `json:"locale"`
+}
+
+// ToJSON serializes the card for oEmbed endpoints and partner integrations.
+func (c LessonCard) ToJSON() ([]byte, error) {
+	const op = "LessonCard.ToJSON"
+
+	data, err := json.Marshal(c)
+	if err != nil {
+		return nil, &kernel.Error{Code: kernel.EInternal, Message: MCardSerializationFailed, Operation: op, Cause: err}
+	}
+
+	return data, nil
+}
+
+// renderCardHTML produces the markup partners embed, with every value escaped.
+func renderCardHTML(c LessonCard) string {
+	thumbnail := ""
+	if c.ThumbnailURL != "" {
+		thumbnail = fmt.Sprintf(`<img src="%s" alt="">`, html.EscapeString(c.ThumbnailURL))
+	}
+
+	badge := ""
+	if c.LevelBadge != "" {
+		badge = fmt.Sprintf(`<span class="fla-lesson-card__level">%s</span>`, html.EscapeString(c.LevelBadge))
+	}
+
+	return fmt.Sprintf(
+		`<blockquote class="fla-lesson-card">%s%s<a href="%s">%s</a><p>%s</p></blockquote>`,
+		thumbnail,
+		badge,
+		html.EscapeString(c.CanonicalURL),
+		html.EscapeString(c.Title),
+		html.EscapeString(c.Excerpt),
+	)
+}
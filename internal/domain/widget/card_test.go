@@ -0,0 +1,45 @@
+package widget_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/alnah/fla/internal/domain/post"
+)
+
+func TestLessonCard_ToJSON(t *testing.T) {
+	service, _ := setupEmbedService(t, post.StatusPublished)
+	card, err := service.LessonCardForURL("https://fla.example/a1/sports/jouer-au-football")
+	assertNoError(t, err)
+
+	data, err := card.ToJSON()
+
+	assertNoError(t, err)
+	var got map[string]any
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("invalid JSON: %v", err)
+	}
+	for _, key := range []string{"type", "version", "title", "provider_name", "provider_url", "html", "width", "height", "thumbnail_url", "excerpt", "level_badge", "reading_time_minutes", "canonical_url"} {
+		if _, ok := got[key]; !ok {
+			t.Errorf("missing key %q", key)
+		}
+	}
+}
+
+func TestLessonCard_HTML(t *testing.T) {
+	t.Run("escapes user content", func(t *testing.T) {
+		service, p := setupEmbedService(t, post.StatusPublished)
+		p.Title = `Le <script>alert("x")</script> football`
+
+		card, err := service.LessonCardForPost(p)
+
+		assertNoError(t, err)
+		if strings.Contains(card.HTML, "<script>") {
+			t.Errorf("HTML not escaped: %s", card.HTML)
+		}
+		if !strings.Contains(card.HTML, "&lt;script&gt;") {
+			t.Errorf("expected escaped title in HTML: %s", card.HTML)
+		}
+	})
+}
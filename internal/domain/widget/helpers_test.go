@@ -0,0 +1,112 @@
+package widget_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/category"
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/post"
+	"github.com/alnah/fla/internal/domain/shared"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+type stubClock struct {
+	t time.Time
+}
+
+func (s *stubClock) Now() time.Time { return s.t }
+
+type stubPosts struct {
+	bySlug map[shared.Slug]*post.Post
+}
+
+func (s *stubPosts) GetByID(id kernel.ID[post.Post]) (*post.Post, error) {
+	for _, p := range s.bySlug {
+		if p.PostID == id {
+			return p, nil
+		}
+	}
+	return nil, &kernel.Error{Code: kernel.ENotFound, Message: "post not found"}
+}
+
+func (s *stubPosts) GetBySlug(slug shared.Slug) (*post.Post, error) {
+	if p, ok := s.bySlug[slug]; ok {
+		return p, nil
+	}
+	return nil, &kernel.Error{Code: kernel.ENotFound, Message: "post not found"}
+}
+
+type stubPaths struct {
+	paths map[kernel.ID[category.Category]]category.CategoryPath
+}
+
+func (s *stubPaths) BuildPath(id kernel.ID[category.Category]) (category.CategoryPath, error) {
+	if path, ok := s.paths[id]; ok {
+		return path, nil
+	}
+	return nil, &kernel.Error{Code: kernel.ENotFound, Message: "category not found"}
+}
+
+func (s *stubPaths) FindByPath(pathSegments []string) (*category.Category, error) {
+	return nil, &kernel.Error{Code: kernel.ENotFound, Message: "category not found"}
+}
+
+func newCategory(t *testing.T, clock kernel.Clock, id, name string, parent *kernel.ID[category.Category]) category.Category {
+	t.Helper()
+
+	c, err := category.NewCategory(category.NewCategoryParams{
+		CategoryID: kernel.ID[category.Category](id),
+		Name:       category.CategoryName(name),
+		CreatedBy:  kernel.ID[user.User]("user-123"),
+		ParentID:   parent,
+		Clock:      clock,
+	})
+	assertNoError(t, err)
+	return c
+}
+
+func newPost(t *testing.T, clock kernel.Clock, cat category.Category, title string, status post.Status) post.Post {
+	t.Helper()
+
+	body := "Le football est un sport populaire en France." + strings.Repeat(" Les joueurs courent beaucoup sur le terrain.", 10)
+	content, err := post.NewPostContent(body)
+	assertNoError(t, err)
+
+	p, err := post.NewPost(post.NewPostParams{
+		PostID:        kernel.ID[post.Post]("post-123"),
+		Owner:         kernel.ID[user.User]("user-123"),
+		Title:         shared.Title(title),
+		Content:       content,
+		FeaturedImage: "https://cdn.fla.example/football.jpg",
+		Status:        status,
+		Category:      cat,
+		Excerpt:       "Vocabulaire du football pour débutants.",
+		Clock:         clock,
+	})
+	assertNoError(t, err)
+	return p
+}
+
+func assertNoError(t *testing.T, err error) {
+	t.Helper()
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+}
+
+func assertError(t *testing.T, err error) {
+	t.Helper()
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+}
+
+func assertErrorCode(t *testing.T, err error, want string) {
+	t.Helper()
+	got := kernel.ErrorCode(err)
+	if got != want {
+		t.Errorf("error code: got %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,127 @@
+package widget
+
+import (
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/category"
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/post"
+	"github.com/alnah/fla/internal/domain/shared"
+)
+
+const (
+	MEmbedURLInvalid   string = "Invalid lesson URL."
+	MEmbedURLForeign   string = "URL does not belong to this site."
+	MEmbedPostNotFound string = "Lesson not found."
+)
+
+// EmbedCacheAge is how long consumers may cache a lesson card.
+const EmbedCacheAge time.Duration = time.Hour
+
+// EmbedService produces embeddable lesson cards for partner sites and social previews.
+// Only published posts are exposed so drafts never leak through embeds.
+type EmbedService struct {
+	posts      post.PostReader
+	categories category.CategoryPathBuilder
+	site       shared.Site
+}
+
+// NewEmbedService creates embed service with post and category lookups.
+func NewEmbedService(posts post.PostReader, categories category.CategoryPathBuilder, site shared.Site) *EmbedService {
+	return &EmbedService{
+		posts:      posts,
+		categories: categories,
+		site:       site,
+	}
+}
+
+// LessonCardForURL resolves a public post URL into its lesson card.
+// The last path segment is the post slug; the host must match the site.
+func (s *EmbedService) LessonCardForURL(rawURL string) (LessonCard, error) {
+	const op = "EmbedService.LessonCardForURL"
+
+	slug, err := s.slugFromURL(rawURL)
+	if err != nil {
+		return LessonCard{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	p, err := s.posts.GetBySlug(slug)
+	if err != nil {
+		return LessonCard{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if p == nil || !p.IsPublished() {
+		return LessonCard{}, &kernel.Error{Code: kernel.ENotFound, Message: MEmbedPostNotFound, Operation: op}
+	}
+
+	return s.LessonCardForPost(*p)
+}
+
+// LessonCardForPost builds the card for an already loaded post.
+// Used by the social auto-poster to render consistent previews.
+func (s *EmbedService) LessonCardForPost(p post.Post) (LessonCard, error) {
+	const op = "EmbedService.LessonCardForPost"
+
+	if !p.IsPublished() {
+		return LessonCard{}, &kernel.Error{Code: kernel.ENotFound, Message: MEmbedPostNotFound, Operation: op}
+	}
+
+	path, err := s.categories.BuildPath(p.Category.CategoryID)
+	if err != nil {
+		return LessonCard{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	canonical := p.CanonicalURL.String()
+	if canonical == "" {
+		canonical = s.site.URL(p.URLPath(path))
+	}
+
+	card := LessonCard{
+		Type:               OEmbedType,
+		Version:            OEmbedVersion,
+		Title:              p.Title.String(),
+		ProviderName:       s.site.Name,
+		ProviderURL:        s.site.BaseURL.String(),
+		ThumbnailURL:       p.GetEffectiveOpenGraphImage(),
+		Width:              DefaultCardWidth,
+		Height:             DefaultCardHeight,
+		CacheAgeSeconds:    int(EmbedCacheAge / time.Second),
+		Excerpt:            p.GetEffectiveExcerpt(),
+		ReadingTimeMinutes: p.EstimatedReadingTime(),
+		CanonicalURL:       canonical,
+		Locale:             s.site.Locale.String(),
+	}
+
+	if level, ok := path.Level(); ok {
+		card.LevelBadge = level.String()
+	}
+
+	card.HTML = renderCardHTML(card)
+
+	return card, nil
+}
+
+// slugFromURL validates the URL host and extracts the trailing slug segment.
+func (s *EmbedService) slugFromURL(rawURL string) (shared.Slug, error) {
+	const op = "EmbedService.slugFromURL"
+
+	u, err := url.Parse(strings.TrimSpace(rawURL))
+	if err != nil || u.Host == "" {
+		return "", &kernel.Error{Code: kernel.EInvalid, Message: MEmbedURLInvalid, Operation: op, Cause: err}
+	}
+
+	base, _ := url.Parse(s.site.BaseURL.String())
+	if !strings.EqualFold(u.Host, base.Host) {
+		return "", &kernel.Error{Code: kernel.EInvalid, Message: MEmbedURLForeign, Operation: op}
+	}
+
+	segments := strings.Split(strings.Trim(u.Path, "/"), "/")
+	slug := shared.Slug(segments[len(segments)-1])
+	if err := slug.Validate(); err != nil {
+		return "", &kernel.Error{Code: kernel.EInvalid, Message: MEmbedURLInvalid, Operation: op, Cause: err}
+	}
+
+	return slug, nil
+}
@@ -0,0 +1,160 @@
+package widget_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/category"
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/post"
+	"github.com/alnah/fla/internal/domain/shared"
+	"github.com/alnah/fla/internal/domain/widget"
+)
+
+func setupEmbedService(t *testing.T, status post.Status) (*widget.EmbedService, post.Post) {
+	t.Helper()
+
+	clock := &stubClock{t: time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)}
+	a1 := newCategory(t, clock, "cat-a1", "A1", nil)
+	parent := a1.CategoryID
+	sports := newCategory(t, clock, "cat-sports", "Sports", &parent)
+
+	p := newPost(t, clock, sports, "Jouer au football", status)
+
+	site, err := shared.NewSite("FLA", "https://fla.example", shared.LocaleFrenchFR)
+	assertNoError(t, err)
+
+	posts := &stubPosts{bySlug: map[shared.Slug]*post.Post{p.Slug: &p}}
+	paths := &stubPaths{paths: map[kernel.ID[category.Category]]category.CategoryPath{
+		sports.CategoryID: {a1, sports},
+	}}
+
+	return widget.NewEmbedService(posts, paths, site), p
+}
+
+func TestEmbedService_LessonCardForURL(t *testing.T) {
+	t.Run("builds card for published post", func(t *testing.T) {
+		service, _ := setupEmbedService(t, post.StatusPublished)
+
+		got, err := service.LessonCardForURL("https://fla.example/a1/sports/jouer-au-football")
+
+		assertNoError(t, err)
+		if got.Type != widget.OEmbedType || got.Version != widget.OEmbedVersion {
+			t.Errorf("oEmbed envelope: got %q %q", got.Type, got.Version)
+		}
+		if got.Title != "Jouer au football" {
+			t.Errorf("Title: got %q", got.Title)
+		}
+		if got.LevelBadge != "A1" {
+			t.Errorf("LevelBadge: got %q, want A1", got.LevelBadge)
+		}
+		if got.CanonicalURL != "https://fla.example/a1/sports/jouer-au-football" {
+			t.Errorf("CanonicalURL: got %q", got.CanonicalURL)
+		}
+		if got.ThumbnailURL != "https://cdn.fla.example/football.jpg" {
+			t.Errorf("ThumbnailURL: got %q", got.ThumbnailURL)
+		}
+		if got.Excerpt != "Vocabulaire du football pour débutants." {
+			t.Errorf("Excerpt: got %q", got.Excerpt)
+		}
+		if got.ReadingTimeMinutes < 1 {
+			t.Errorf("ReadingTimeMinutes: got %d", got.ReadingTimeMinutes)
+		}
+		if got.ProviderName != "FLA" || got.ProviderURL != "https://fla.example" {
+			t.Errorf("provider: got %q %q", got.ProviderName, got.ProviderURL)
+		}
+		if got.Locale != "fr-FR" {
+			t.Errorf("Locale: got %q", got.Locale)
+		}
+		if got.HTML == "" {
+			t.Error("expected embed HTML")
+		}
+	})
+
+	t.Run("accepts trailing slash and query string", func(t *testing.T) {
+		service, _ := setupEmbedService(t, post.StatusPublished)
+
+		_, err := service.LessonCardForURL("https://FLA.example/a1/sports/jouer-au-football/?utm_source=x")
+
+		assertNoError(t, err)
+	})
+
+	t.Run("rejects invalid or foreign URLs", func(t *testing.T) {
+		service, _ := setupEmbedService(t, post.StatusPublished)
+
+		inputs := []string{
+			"",
+			"not a url",
+			"https://other.example/a1/sports/jouer-au-football",
+			"https://fla.example/",
+		}
+
+		for _, input := range inputs {
+			_, err := service.LessonCardForURL(input)
+
+			assertError(t, err)
+			assertErrorCode(t, err, kernel.EInvalid)
+		}
+	})
+
+	t.Run("returns not found for unknown slug", func(t *testing.T) {
+		service, _ := setupEmbedService(t, post.StatusPublished)
+
+		_, err := service.LessonCardForURL("https://fla.example/a1/sports/inconnu")
+
+		assertError(t, err)
+		assertErrorCode(t, err, kernel.ENotFound)
+	})
+
+	t.Run("hides unpublished posts", func(t *testing.T) {
+		service, _ := setupEmbedService(t, post.StatusDraft)
+
+		_, err := service.LessonCardForURL("https://fla.example/a1/sports/jouer-au-football")
+
+		assertError(t, err)
+		assertErrorCode(t, err, kernel.ENotFound)
+	})
+}
+
+func TestEmbedService_LessonCardForPost(t *testing.T) {
+	t.Run("prefers explicit canonical URL", func(t *testing.T) {
+		service, p := setupEmbedService(t, post.StatusPublished)
+		p.CanonicalURL = "https://partner.example/football"
+
+		got, err := service.LessonCardForPost(p)
+
+		assertNoError(t, err)
+		if got.CanonicalURL != "https://partner.example/football" {
+			t.Errorf("CanonicalURL: got %q", got.CanonicalURL)
+		}
+	})
+
+	t.Run("shows no badge outside CEFR levels", func(t *testing.T) {
+		clock := &stubClock{t: time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)}
+		culture := newCategory(t, clock, "cat-culture", "Culture", nil)
+		p := newPost(t, clock, culture, "Le 14 juillet", post.StatusPublished)
+		site, err := shared.NewSite("FLA", "https://fla.example", shared.LocaleFrenchFR)
+		assertNoError(t, err)
+		paths := &stubPaths{paths: map[kernel.ID[category.Category]]category.CategoryPath{
+			culture.CategoryID: {culture},
+		}}
+		service := widget.NewEmbedService(&stubPosts{}, paths, site)
+
+		got, err := service.LessonCardForPost(p)
+
+		assertNoError(t, err)
+		if got.LevelBadge != "" {
+			t.Errorf("LevelBadge: got %q, want none", got.LevelBadge)
+		}
+	})
+
+	t.Run("propagates category lookup errors", func(t *testing.T) {
+		service, p := setupEmbedService(t, post.StatusPublished)
+		p.Category.CategoryID = "cat-missing"
+
+		_, err := service.LessonCardForPost(p)
+
+		assertError(t, err)
+		assertErrorCode(t, err, kernel.ENotFound)
+	})
+}
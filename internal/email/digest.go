@@ -0,0 +1,215 @@
+package email
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/category"
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/metrics"
+	"github.com/alnah/fla/internal/domain/post"
+	"github.com/alnah/fla/internal/domain/shared"
+	"github.com/alnah/fla/internal/domain/subscription"
+)
+
+// DigestDays is how far back the weekly digest looks for published posts.
+const DigestDays int = 7
+
+// ScopeDigest is the idempotency scope of weekly digest sends, one key per subscriber and ISO week.
+const ScopeDigest string = "email.digest"
+
+// DigestRecordMargin keeps digest send records past the end of their ISO week,
+// so a run started late on Sunday still sees the earlier runs of that week.
+const DigestRecordMargin time.Duration = 24 * time.Hour
+
+// Sender delivers rendered messages. Adapters wrap an email provider or, in
+// development, write messages to disk.
+type Sender interface {
+	Send(message Message) error
+}
+
+// DigestFailure records a subscriber the digest could not be sent to.
+type DigestFailure struct {
+	SubscriptionID kernel.ID[subscription.Subscription]
+	Err            error
+}
+
+// DigestRun reports one weekly digest send.
+type DigestRun struct {
+	Posts       int // Posts published during the period
+	Sent        int
+	Skipped     int // Weekly subscribers with no post in their categories
+	Suppressed  int // Weekly subscribers on the suppression list
+	AlreadySent int // Subscribers who got this week's digest from an earlier run
+	Failed      []DigestFailure
+}
+
+// DigestService sends the weekly digest to subscribers who chose it.
+type DigestService struct {
+	subscriptions subscription.SubscriptionLister
+	suppressions  subscription.SuppressionChecker
+	posts         post.PostFinder
+	categories    category.CategoryPathBuilder
+	renderer      Renderer
+	sender        Sender
+	sent          kernel.IdempotencyStore
+	site          shared.Site
+	clock         kernel.Clock
+}
+
+// NewDigestService creates digest service with subscriber and post lookups, rendering, and delivery.
+// The suppression list is checked before each send; the idempotency store remembers
+// who received each week's digest.
+func NewDigestService(
+	subscriptions subscription.SubscriptionLister,
+	suppressions subscription.SuppressionChecker,
+	posts post.PostFinder,
+	categories category.CategoryPathBuilder,
+	renderer Renderer,
+	sender Sender,
+	sent kernel.IdempotencyStore,
+	site shared.Site,
+	clock kernel.Clock,
+) *DigestService {
+	return &DigestService{
+		subscriptions: subscriptions,
+		suppressions:  suppressions,
+		posts:         posts,
+		categories:    categories,
+		renderer:      renderer,
+		sender:        sender,
+		sent:          sent,
+		site:          site,
+		clock:         clock,
+	}
+}
+
+// SendWeekly sends each weekly digest subscriber the posts of the last DigestDays
+// days in the categories they follow, unless their address is suppressed. A
+// failed delivery is recorded and the run goes on; only failures to read
+// subscribers, posts, or the suppression list abort it. Running again in
+// the same ISO week sends only to subscribers the earlier runs missed.
+func (s *DigestService) SendWeekly() (DigestRun, error) {
+	const op = "DigestService.SendWeekly"
+
+	now := s.clock.Now()
+	year, week := now.UTC().ISOWeek() // UTC, as metrics.WeekStart
+	key := kernel.IdempotencyKey(fmt.Sprintf("%04d-W%02d", year, week))
+	expiresAt := metrics.WeekStart(now).AddDate(0, 0, 7).Add(DigestRecordMargin)
+
+	campaign, err := shared.NewsletterCampaign("weekly-digest-" + key.String())
+	if err != nil {
+		return DigestRun{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	items, paths, err := s.recentItems(campaign)
+	if err != nil {
+		return DigestRun{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	subscriptions, err := s.subscriptions.GetActiveSubscriptions()
+	if err != nil {
+		return DigestRun{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	run := DigestRun{Posts: len(items)}
+	for _, sub := range subscriptions {
+		if sub.Preferences.Frequency != subscription.FrequencyWeeklyDigest || !sub.CanReceiveEmails() {
+			continue
+		}
+
+		var digest WeeklyDigest
+		for i, item := range items {
+			if sub.IsInterestedIn(paths[i], "") {
+				digest.Items = append(digest.Items, item)
+			}
+		}
+		if len(digest.Items) == 0 {
+			run.Skipped++
+			continue
+		}
+
+		suppressed, err := s.suppressions.IsSuppressed(sub.Email)
+		if err != nil {
+			return DigestRun{}, &kernel.Error{Operation: op, Cause: err}
+		}
+		if suppressed {
+			run.Suppressed++
+			continue
+		}
+
+		// Scoped per subscriber, so the week alone is a unique key
+		scope := ScopeDigest + "/" + sub.SubscriptionID.String()
+		_, replayed, err := kernel.IdempotentUntil(s.sent, s.clock, scope, key, sub.SubscriptionID.String(), expiresAt, func() (string, error) {
+			return sub.SubscriptionID.String(), s.send(digest, sub)
+		})
+		switch {
+		case err != nil:
+			run.Failed = append(run.Failed, DigestFailure{SubscriptionID: sub.SubscriptionID, Err: err})
+		case replayed:
+			run.AlreadySent++
+		default:
+			run.Sent++
+		}
+	}
+
+	return run, nil
+}
+
+// recentItems lists the posts published during the period, newest first,
+// with the category path of each for matching subscriber interests. Links
+// carry the digest's campaign parameters.
+func (s *DigestService) recentItems(campaign shared.CampaignLink) ([]DigestItem, []category.CategoryPath, error) {
+	now := s.clock.Now()
+	query := post.PublishedQuery().PublishedIn(now.AddDate(0, 0, -DigestDays), now)
+
+	var items []DigestItem
+	var paths []category.CategoryPath
+	for page := 1; ; page++ {
+		list, err := s.posts.Find(query.Page(page, shared.MaxPageLimit))
+		if err != nil {
+			return nil, nil, err
+		}
+
+		for _, p := range list.Posts {
+			path, err := s.categories.BuildPath(p.Category.CategoryID)
+			if err != nil {
+				return nil, nil, err
+			}
+			url, err := campaign.Apply(s.site.URL(p.URLPath(path)))
+			if err != nil {
+				return nil, nil, err
+			}
+			item := DigestItem{
+				Title:          p.Title.String(),
+				URL:            url,
+				Excerpt:        p.GetEffectiveExcerpt(),
+				ReadingMinutes: p.EstimatedReadingTime(),
+			}
+			if p.PublishedAt != nil {
+				item.PublishedAt = *p.PublishedAt
+			}
+			items = append(items, item)
+			paths = append(paths, path)
+		}
+
+		if !list.Pagination.HasNextPage() {
+			break
+		}
+	}
+
+	return items, paths, nil
+}
+
+func (s *DigestService) send(digest WeeklyDigest, sub subscription.Subscription) error {
+	message, err := s.renderer.Render(digest, Recipient{
+		Email:          sub.Email,
+		FirstName:      sub.FirstName,
+		Locale:         sub.Preferences.Locale,
+		UnsubscribeURL: s.site.URL("subscriptions/" + sub.SubscriptionID.String() + "/unsubscribe"),
+	})
+	if err != nil {
+		return err
+	}
+	return s.sender.Send(message)
+}
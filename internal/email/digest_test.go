@@ -0,0 +1,156 @@
+package email_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alnah/fla/internal/adapters/memory"
+	"github.com/alnah/fla/internal/domain/category"
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/post"
+	"github.com/alnah/fla/internal/domain/shared"
+	"github.com/alnah/fla/internal/domain/subscription"
+	"github.com/alnah/fla/internal/email"
+)
+
+var digestNow = time.Date(2026, 3, 4, 9, 0, 0, 0, time.UTC) // Wednesday of ISO week 10
+
+type stubClock struct {
+	t time.Time
+}
+
+func (s *stubClock) Now() time.Time { return s.t }
+
+type stubSender struct {
+	sent   []email.Message
+	failTo shared.Email
+}
+
+func (s *stubSender) Send(m email.Message) error {
+	if m.To == s.failTo {
+		return errors.New("mailbox unavailable")
+	}
+	s.sent = append(s.sent, m)
+	return nil
+}
+
+func TestDigestService_SendWeekly(t *testing.T) {
+	clock := &stubClock{digestNow}
+	categories := memory.NewCategoryStore()
+	posts := memory.NewPostStore(categories)
+	subscriptions := memory.NewSubscriptionStore()
+
+	newCategory := func(id, name string) category.Category {
+		c, err := category.NewCategory(category.NewCategoryParams{
+			CategoryID: kernel.ID[category.Category](id),
+			Name:       category.CategoryName(name),
+			CreatedBy:  "marie",
+			Clock:      clock,
+		})
+		assertNoError(t, err)
+		assertNoError(t, categories.Create(c))
+		return c
+	}
+	a1, a2 := newCategory("a1", "A1"), newCategory("a2", "A2")
+
+	newPost := func(id, title, excerpt string, c category.Category, daysAgo int) {
+		publishedAt := digestNow.AddDate(0, 0, -daysAgo)
+		p, err := post.NewPost(post.NewPostParams{
+			PostID:      kernel.ID[post.Post](id),
+			Owner:       "marie",
+			Title:       shared.Title(title),
+			Content:     post.PostContent(strings.Repeat("Le samedi, je vais au marché. ", 12)),
+			Status:      post.StatusPublished,
+			Category:    c,
+			PublishedAt: &publishedAt,
+			Excerpt:     post.Excerpt(excerpt),
+			Clock:       clock,
+		})
+		assertNoError(t, err)
+		assertNoError(t, posts.Create(p))
+	}
+	newPost("market", "Faire ses courses au marché", "Le vocabulaire des fruits et légumes.", a1, 2)
+	newPost("cinema", "Une soirée au cinéma", "", a2, 3)
+	newPost("old", "Une vieille leçon", "", a1, 10)
+
+	newSubscription := func(id, address string, frequency subscription.Frequency, follows ...kernel.ID[category.Category]) {
+		s, err := subscription.NewSubscription(subscription.NewSubscriptionParams{
+			SubscriptionID: kernel.ID[subscription.Subscription](id),
+			FirstName:      "Marie",
+			Email:          shared.Email(address),
+			Preferences:    &subscription.Preferences{CategoryIDs: follows, Locale: shared.DefaultLocale, Frequency: frequency},
+			Consent:        testConsent(clock),
+			Clock:          clock,
+		})
+		assertNoError(t, err)
+		assertNoError(t, subscriptions.Create(s))
+	}
+	newSubscription("all", "all@example.com", subscription.FrequencyWeeklyDigest)
+	newSubscription("a1", "a1@example.com", subscription.FrequencyWeeklyDigest, a1.CategoryID)
+	newSubscription("instant", "instant@example.com", subscription.FrequencyInstant)
+	newSubscription("bounce", "bounce@example.com", subscription.FrequencyWeeklyDigest)
+	newSubscription("blocked", "blocked@example.com", subscription.FrequencyWeeklyDigest)
+
+	suppressions := memory.NewSuppressionStore()
+	blocked, err := subscription.NewSuppression("Blocked@Example.com", subscription.ReasonComplaint, "", "", clock)
+	assertNoError(t, err)
+	assertNoError(t, suppressions.AddSuppression(blocked))
+
+	site := shared.Site{Name: "fla", BaseURL: "https://fla.example.com", Locale: shared.DefaultLocale}
+	sender := &stubSender{failTo: "bounce@example.com"}
+	service := email.NewDigestService(subscriptions, suppressions, posts, categories, email.NewTemplateRenderer(), sender, memory.NewIdempotencyStore(), site, clock)
+
+	run, err := service.SendWeekly()
+
+	assertNoError(t, err)
+	if run.Posts != 2 || run.Sent != 2 || run.Skipped != 0 || run.Suppressed != 1 || len(run.Failed) != 1 || run.Failed[0].SubscriptionID != "bounce" {
+		t.Fatalf("run: got %+v", run)
+	}
+
+	byRecipient := map[shared.Email]email.Message{}
+	for _, m := range sender.sent {
+		byRecipient[m.To] = m
+	}
+
+	all := byRecipient["all@example.com"]
+	if !strings.Contains(all.Text, "https://fla.example.com/a1/faire-ses-courses-au-marche") || !strings.Contains(all.Text, "Une soirée au cinéma") {
+		t.Errorf("expected both recent posts, got:\n%s", all.Text)
+	}
+	if !strings.Contains(all.Text, "utm_campaign=weekly-digest-") || !strings.Contains(all.Text, "utm_medium=email&utm_source=newsletter") {
+		t.Errorf("expected tracked post links, got:\n%s", all.Text)
+	}
+	if !strings.Contains(all.Text, "Le vocabulaire des fruits et légumes.") {
+		t.Errorf("expected the author's excerpt, got:\n%s", all.Text)
+	}
+	if strings.Contains(all.Text, "Une vieille leçon") {
+		t.Error("expected posts older than a week left out")
+	}
+	if !strings.Contains(all.Text, "https://fla.example.com/subscriptions/all/unsubscribe") {
+		t.Error("expected an unsubscribe link")
+	}
+
+	a1Digest := byRecipient["a1@example.com"]
+	if strings.Contains(a1Digest.Text, "Une soirée au cinéma") {
+		t.Error("expected posts outside followed categories left out")
+	}
+
+	// A rerun the same week only retries the failed delivery
+	sender.failTo = ""
+	rerun, err := service.SendWeekly()
+
+	assertNoError(t, err)
+	if rerun.Sent != 1 || rerun.AlreadySent != 2 || len(rerun.Failed) != 0 || len(sender.sent) != 3 {
+		t.Errorf("rerun: got %+v after %d messages", rerun, len(sender.sent))
+	}
+
+	// Days later in the same week, the records still hold
+	clock.t = digestNow.AddDate(0, 0, 3)
+	late, err := service.SendWeekly()
+
+	assertNoError(t, err)
+	if late.Sent != 0 || late.AlreadySent != 3 || len(late.Failed) != 0 || len(sender.sent) != 3 {
+		t.Errorf("late rerun: got %+v after %d messages", late, len(sender.sent))
+	}
+}
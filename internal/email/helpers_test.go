@@ -0,0 +1,66 @@
+package email_test
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/subscription"
+)
+
+var update = flag.Bool("update", false, "update snapshot files in testdata")
+
+func assertNoError(t *testing.T, err error) {
+	t.Helper()
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+}
+
+func assertError(t *testing.T, err error) {
+	t.Helper()
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+}
+
+func assertErrorCode(t *testing.T, err error, want string) {
+	t.Helper()
+	got := kernel.ErrorCode(err)
+	if got != want {
+		t.Errorf("error code: got %q, want %q", got, want)
+	}
+}
+
+// assertSnapshot compares got with testdata/<name>.golden, rewriting it with -update.
+func assertSnapshot(t *testing.T, name, got string) {
+	t.Helper()
+
+	path := filepath.Join("testdata", name+".golden")
+	if *update {
+		if err := os.WriteFile(path, []byte(got), 0o644); err != nil {
+			t.Fatalf("failed to update snapshot: %v", err)
+		}
+		return
+	}
+
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("failed to read snapshot (run with -update to create): %v", err)
+	}
+	if got != string(want) {
+		t.Errorf("snapshot %s mismatch:\ngot:\n%s\nwant:\n%s", name, got, want)
+	}
+}
+
+// testConsent returns a signup form consent given at the clock's time.
+func testConsent(clock kernel.Clock) subscription.Consent {
+	return subscription.Consent{
+		Source:      subscription.SourceSignupForm,
+		FormURL:     "https://fla.example/newsletter",
+		TextVersion: "2024-05-01",
+		GivenAt:     clock.Now(),
+	}
+}
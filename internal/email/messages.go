@@ -0,0 +1,122 @@
+package email
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/shared"
+)
+
+// Message keys for localized email copy.
+const (
+	keyGreetingNamed     = "greeting.named"
+	keyGreetingAnonymous = "greeting.anonymous"
+	keyLocaleNote        = "footer.locale"
+	keyMemberNote        = "footer.member"
+	keyUnsubscribe       = "footer.unsubscribe"
+	keyNewPostSubject    = "new_post.subject"
+	keyNewPostIntro      = "new_post.intro"
+	keyNewPostCTA        = "new_post.cta"
+	keyWelcomeSubject    = "welcome.subject"
+	keyWelcomeBody       = "welcome.body"
+	keyWelcomeCTA        = "welcome.cta"
+	keyConfirmSubject    = "confirm.subject"
+	keyConfirmBody       = "confirm.body"
+	keyConfirmCTA        = "confirm.cta"
+	keyDigestSubject     = "digest.subject"
+	keyDigestIntro       = "digest.intro"
+)
+
+// catalog holds email copy for every supported locale.
+// Format verbs are filled by localizer.T with template-specific values.
+var catalog = map[shared.Locale]map[string]string{
+	shared.LocaleFrenchFR: {
+		keyGreetingNamed:     "Bonjour %s,",
+		keyGreetingAnonymous: "Bonjour,",
+		keyLocaleNote:        "Vous recevez cet e-mail en %s.",
+		keyMemberNote:        "Merci de votre soutien en tant que membre.",
+		keyUnsubscribe:       "Se désabonner",
+		keyNewPostSubject:    "Nouvelle leçon : %s",
+		keyNewPostIntro:      "Une nouvelle leçon vient d'être publiée dans %s.",
+		keyNewPostCTA:        "Lire la leçon",
+		keyWelcomeSubject:    "Bienvenue sur %s",
+		keyWelcomeBody:       "Merci de votre inscription à %s. Vous recevrez nos prochaines leçons par e-mail.",
+		keyWelcomeCTA:        "Découvrir les leçons",
+		keyConfirmSubject:    "Confirmez votre inscription",
+		keyConfirmBody:       "Cliquez sur le lien ci-dessous pour confirmer votre inscription à %s.",
+		keyConfirmCTA:        "Confirmer mon inscription",
+		keyDigestSubject:     "Vos leçons de la semaine (%d)",
+		keyDigestIntro:       "Voici les leçons publiées cette semaine :",
+	},
+	shared.LocaleEnglishUS: {
+		keyGreetingNamed:     "Hello %s,",
+		keyGreetingAnonymous: "Hello,",
+		keyLocaleNote:        "You are receiving this email in %s.",
+		keyMemberNote:        "Thank you for supporting us as a member.",
+		keyUnsubscribe:       "Unsubscribe",
+		keyNewPostSubject:    "New lesson: %s",
+		keyNewPostIntro:      "A new lesson was just published in %s.",
+		keyNewPostCTA:        "Read the lesson",
+		keyWelcomeSubject:    "Welcome to %s",
+		keyWelcomeBody:       "Thanks for subscribing to %s. Our next lessons will arrive in your inbox.",
+		keyWelcomeCTA:        "Browse the lessons",
+		keyConfirmSubject:    "Confirm your subscription",
+		keyConfirmBody:       "Click the link below to confirm your subscription to %s.",
+		keyConfirmCTA:        "Confirm my subscription",
+		keyDigestSubject:     "Your lessons this week (%d)",
+		keyDigestIntro:       "Here are the lessons published this week:",
+	},
+	shared.LocalePortugueseBR: {
+		keyGreetingNamed:     "Olá %s,",
+		keyGreetingAnonymous: "Olá,",
+		keyLocaleNote:        "Você está recebendo este e-mail em %s.",
+		keyMemberNote:        "Obrigado pelo seu apoio como membro.",
+		keyUnsubscribe:       "Cancelar inscrição",
+		keyNewPostSubject:    "Nova lição: %s",
+		keyNewPostIntro:      "Uma nova lição acaba de ser publicada em %s.",
+		keyNewPostCTA:        "Ler a lição",
+		keyWelcomeSubject:    "Bem-vindo ao %s",
+		keyWelcomeBody:       "Obrigado por se inscrever no %s. As próximas lições chegarão ao seu e-mail.",
+		keyWelcomeCTA:        "Ver as lições",
+		keyConfirmSubject:    "Confirme sua inscrição",
+		keyConfirmBody:       "Clique no link abaixo para confirmar sua inscrição no %s.",
+		keyConfirmCTA:        "Confirmar minha inscrição",
+		keyDigestSubject:     "Suas lições da semana (%d)",
+		keyDigestIntro:       "Estas são as lições publicadas esta semana:",
+	},
+}
+
+// localizer resolves email copy for one locale, falling back to the default locale,
+// and formats dates as the recipient reads them.
+type localizer struct {
+	locale   shared.Locale
+	timeZone shared.TimeZone
+}
+
+func newLocalizer(locale shared.Locale, timeZone shared.TimeZone) localizer {
+	return localizer{locale: locale.GetEffectiveLocale(), timeZone: timeZone.GetEffectiveTimeZone()}
+}
+
+// T returns the formatted copy for key, or the key itself when missing everywhere.
+func (l localizer) T(key string, args ...any) string {
+	format, ok := catalog[l.locale][key]
+	if !ok {
+		if format, ok = catalog[shared.DefaultLocale][key]; !ok {
+			return key
+		}
+	}
+
+	if len(args) == 0 {
+		return format
+	}
+
+	return fmt.Sprintf(format, args...)
+}
+
+// Date returns the date t falls on for the recipient, or "" for zero times.
+func (l localizer) Date(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return shared.FormatDate(t, l.locale, l.timeZone)
+}
@@ -0,0 +1,124 @@
+// Package email renders transactional and newsletter emails for subscribers.
+package email
+
+import (
+	"fmt"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/shared"
+)
+
+// Message is a fully rendered email ready for a delivery adapter.
+type Message struct {
+	To      shared.Email
+	Subject string
+	HTML    string
+	Text    string
+}
+
+// Persona describes a hypothetical recipient used to preview an email.
+// Covers the variations that change rendering: language, personalization, and membership.
+type Persona struct {
+	Locale    shared.Locale
+	FirstName shared.FirstName // Empty to preview the anonymous greeting
+	Member    bool             // Member (paid) vs. free subscriber
+}
+
+// Key returns a stable identifier for the persona, suitable for snapshot file names.
+func (p Persona) Key() string {
+	name := "anonymous"
+	if p.FirstName != "" {
+		name = "named"
+	}
+
+	plan := "free"
+	if p.Member {
+		plan = "member"
+	}
+
+	return fmt.Sprintf("%s_%s_%s", p.Locale, name, plan)
+}
+
+// String returns a string representation of the persona.
+func (p Persona) String() string {
+	return fmt.Sprintf("Persona{Locale: %q, FirstName: %q, Member: %t}", p.Locale, p.FirstName, p.Member)
+}
+
+// PreviewFirstName is the sample first name used by named personas.
+const PreviewFirstName shared.FirstName = "Marie"
+
+// PersonaMatrix returns every combination of supported locale, named/anonymous, and member/free.
+// Ordered by locale, then name presence, then membership for deterministic previews.
+func PersonaMatrix() []Persona {
+	personas := make([]Persona, 0, len(shared.SupportedLocales)*4)
+
+	for _, locale := range shared.SupportedLocales {
+		for _, firstName := range []shared.FirstName{PreviewFirstName, ""} {
+			for _, member := range []bool{true, false} {
+				personas = append(personas, Persona{Locale: locale, FirstName: firstName, Member: member})
+			}
+		}
+	}
+
+	return personas
+}
+
+// Previewable is an email template or campaign that can be rendered for a persona.
+type Previewable interface {
+	Name() string
+	RenderFor(persona Persona) (Message, error)
+}
+
+// Variant is the outcome of rendering one previewable for one persona.
+type Variant struct {
+	Persona Persona
+	Message Message
+	Err     error // Rendering failure for this persona, nil on success
+}
+
+// Preview holds all rendered variants of a template for visual QA.
+type Preview struct {
+	Template string
+	Variants []Variant
+}
+
+// Failed returns the variants that could not be rendered.
+func (p Preview) Failed() []Variant {
+	var failed []Variant
+	for _, v := range p.Variants {
+		if v.Err != nil {
+			failed = append(failed, v)
+		}
+	}
+	return failed
+}
+
+// PreviewService renders templates across a persona matrix for review.
+// Rendering errors are collected per variant so one broken locale doesn't hide the others.
+type PreviewService struct{}
+
+// NewPreviewService creates a preview service.
+func NewPreviewService() *PreviewService {
+	return &PreviewService{}
+}
+
+// Preview renders the template for each persona, defaulting to the full PersonaMatrix.
+func (s *PreviewService) Preview(template Previewable, personas ...Persona) (Preview, error) {
+	const op = "PreviewService.Preview"
+
+	if template == nil {
+		return Preview{}, &kernel.Error{Code: kernel.EInvalid, Message: kernel.ErrMissing("template"), Operation: op}
+	}
+
+	if len(personas) == 0 {
+		personas = PersonaMatrix()
+	}
+
+	preview := Preview{Template: template.Name(), Variants: make([]Variant, 0, len(personas))}
+	for _, persona := range personas {
+		msg, err := template.RenderFor(persona)
+		preview.Variants = append(preview.Variants, Variant{Persona: persona, Message: msg, Err: err})
+	}
+
+	return preview, nil
+}
@@ -0,0 +1,112 @@
+package email_test
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/shared"
+	"github.com/alnah/fla/internal/email"
+)
+
+// greetingTemplate is a minimal previewable used to exercise the matrix.
+type greetingTemplate struct {
+	failFor shared.Locale
+}
+
+func (g greetingTemplate) Name() string { return "greeting" }
+
+func (g greetingTemplate) RenderFor(p email.Persona) (email.Message, error) {
+	if p.Locale == g.failFor {
+		return email.Message{}, errors.New("missing translation")
+	}
+
+	name := p.FirstName.String()
+	if name == "" {
+		name = "there"
+	}
+	body := fmt.Sprintf("Hello %s (%s)", name, p.Locale)
+	if p.Member {
+		body += " - thanks for being a member"
+	}
+
+	return email.Message{Subject: "Hello", Text: body, HTML: "<p>" + body + "</p>"}, nil
+}
+
+func TestPersonaMatrix(t *testing.T) {
+	personas := email.PersonaMatrix()
+
+	want := len(shared.SupportedLocales) * 4
+	if len(personas) != want {
+		t.Fatalf("got %d personas, want %d", len(personas), want)
+	}
+
+	seen := make(map[string]bool)
+	for _, p := range personas {
+		if seen[p.Key()] {
+			t.Errorf("duplicate persona %s", p.Key())
+		}
+		seen[p.Key()] = true
+	}
+
+	if personas[0].Key() != "fr-FR_named_member" {
+		t.Errorf("first persona: got %q", personas[0].Key())
+	}
+}
+
+func TestPreviewService_Preview(t *testing.T) {
+	service := email.NewPreviewService()
+
+	t.Run("renders full matrix by default", func(t *testing.T) {
+		preview, err := service.Preview(greetingTemplate{})
+
+		assertNoError(t, err)
+		if len(preview.Variants) != len(email.PersonaMatrix()) {
+			t.Errorf("got %d variants", len(preview.Variants))
+		}
+		if len(preview.Failed()) != 0 {
+			t.Errorf("unexpected failures: %v", preview.Failed())
+		}
+	})
+
+	t.Run("renders selected personas", func(t *testing.T) {
+		persona := email.Persona{Locale: shared.LocaleFrenchFR, FirstName: "Marie"}
+
+		preview, err := service.Preview(greetingTemplate{}, persona)
+
+		assertNoError(t, err)
+		if len(preview.Variants) != 1 || preview.Variants[0].Persona != persona {
+			t.Errorf("unexpected variants: %+v", preview.Variants)
+		}
+	})
+
+	t.Run("collects failures per variant", func(t *testing.T) {
+		preview, err := service.Preview(greetingTemplate{failFor: shared.LocalePortugueseBR})
+
+		assertNoError(t, err)
+		if len(preview.Failed()) != 4 {
+			t.Errorf("got %d failed variants, want 4", len(preview.Failed()))
+		}
+	})
+
+	t.Run("rejects missing template", func(t *testing.T) {
+		_, err := service.Preview(nil)
+
+		assertError(t, err)
+		assertErrorCode(t, err, kernel.EInvalid)
+	})
+
+	t.Run("matches snapshot", func(t *testing.T) {
+		preview, err := service.Preview(greetingTemplate{})
+		assertNoError(t, err)
+
+		var b strings.Builder
+		for _, v := range preview.Variants {
+			fmt.Fprintf(&b, "== %s\n%s\n", v.Persona.Key(), v.Message.Text)
+		}
+
+		assertSnapshot(t, "preview_greeting", b.String())
+	})
+}
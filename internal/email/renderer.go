@@ -0,0 +1,150 @@
+package email
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	htmltemplate "html/template"
+	texttemplate "text/template"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/shared"
+)
+
+const (
+	MTemplateUnknown string = "Unknown email template: %s."
+	MRenderFailed    string = "Email could not be rendered."
+)
+
+//go:embed templates/*.html templates/*.txt
+var templateFS embed.FS
+
+// Recipient identifies who an email is rendered for.
+type Recipient struct {
+	Email          shared.Email
+	FirstName      shared.FirstName
+	Locale         shared.Locale
+	TimeZone       shared.TimeZone // Optional: dates read in the default time zone when empty
+	Member         bool
+	UnsubscribeURL string // Optional: omitted for transactional emails
+}
+
+// Renderer turns a typed template into a final message for a recipient.
+// Delivery adapters (Postmark, SES) only deal with the resulting subject and bodies.
+type Renderer interface {
+	Render(template Template, to Recipient) (Message, error)
+}
+
+// TemplateRenderer renders embedded html/template and text/template files.
+// Every template shares a localized layout with greeting and footer.
+type TemplateRenderer struct {
+	html map[string]*htmltemplate.Template
+	text map[string]*texttemplate.Template
+}
+
+// NewTemplateRenderer parses all embedded templates once.
+// Panics on malformed templates since they are compiled into the binary.
+func NewTemplateRenderer() *TemplateRenderer {
+	names := []string{
+		TemplateNewPostNotification,
+		TemplateWelcome,
+		TemplateConfirmSubscription,
+		TemplateWeeklyDigest,
+	}
+
+	r := &TemplateRenderer{
+		html: make(map[string]*htmltemplate.Template, len(names)),
+		text: make(map[string]*texttemplate.Template, len(names)),
+	}
+
+	for _, name := range names {
+		r.html[name] = htmltemplate.Must(htmltemplate.ParseFS(templateFS, "templates/layout.html", "templates/"+name+".html"))
+		r.text[name] = texttemplate.Must(texttemplate.ParseFS(templateFS, "templates/layout.txt", "templates/"+name+".txt"))
+	}
+
+	return r
+}
+
+// layoutData feeds the shared layout around each template's content.
+type layoutData struct {
+	Lang             string
+	Greeting         string
+	Content          any
+	LocaleNote       string
+	MemberNote       string
+	UnsubscribeURL   string
+	UnsubscribeLabel string
+}
+
+// Render produces the localized subject, HTML body, and plain-text fallback.
+func (r *TemplateRenderer) Render(template Template, to Recipient) (Message, error) {
+	const op = "TemplateRenderer.Render"
+
+	if err := template.Validate(); err != nil {
+		return Message{}, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	html, ok := r.html[template.Name()]
+	if !ok {
+		return Message{}, &kernel.Error{
+			Code:      kernel.EInvalid,
+			Message:   fmt.Sprintf(MTemplateUnknown, template.Name()),
+			Operation: op,
+		}
+	}
+	text := r.text[template.Name()]
+
+	l := newLocalizer(to.Locale, to.TimeZone)
+	data := layoutData{
+		Lang:             l.locale.String(),
+		Greeting:         greeting(l, to.FirstName),
+		Content:          template.content(l),
+		LocaleNote:       l.T(keyLocaleNote, l.locale.GetSelfDisplayName()),
+		UnsubscribeURL:   to.UnsubscribeURL,
+		UnsubscribeLabel: l.T(keyUnsubscribe),
+	}
+	if to.Member {
+		data.MemberNote = l.T(keyMemberNote)
+	}
+
+	var htmlBody, textBody bytes.Buffer
+	if err := html.Execute(&htmlBody, data); err != nil {
+		return Message{}, &kernel.Error{Code: kernel.EInternal, Message: MRenderFailed, Operation: op, Cause: err}
+	}
+	if err := text.Execute(&textBody, data); err != nil {
+		return Message{}, &kernel.Error{Code: kernel.EInternal, Message: MRenderFailed, Operation: op, Cause: err}
+	}
+
+	return Message{
+		To:      to.Email,
+		Subject: template.subject(l),
+		HTML:    htmlBody.String(),
+		Text:    textBody.String(),
+	}, nil
+}
+
+func greeting(l localizer, firstName shared.FirstName) string {
+	if firstName == "" {
+		return l.T(keyGreetingAnonymous)
+	}
+	return l.T(keyGreetingNamed, firstName)
+}
+
+// TemplatePreview adapts a renderer and template to the preview matrix.
+type TemplatePreview struct {
+	Renderer Renderer
+	Template Template
+}
+
+func (p TemplatePreview) Name() string { return p.Template.Name() }
+
+// RenderFor renders the template for a persona using a placeholder address.
+func (p TemplatePreview) RenderFor(persona Persona) (Message, error) {
+	return p.Renderer.Render(p.Template, Recipient{
+		Email:          "preview@example.com",
+		FirstName:      persona.FirstName,
+		Locale:         persona.Locale,
+		Member:         persona.Member,
+		UnsubscribeURL: "https://example.com/unsubscribe/preview",
+	})
+}
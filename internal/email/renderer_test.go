@@ -0,0 +1,138 @@
+package email_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/shared"
+	"github.com/alnah/fla/internal/email"
+)
+
+var samplePost = email.NewPostNotification{
+	PostTitle:    "Jouer au football",
+	PostURL:      "https://fla.example/a1/comprehension-ecrite/sports",
+	Excerpt:      "Le football est un sport populaire.",
+	CategoryName: "A1 › Compréhension écrite",
+}
+
+func TestTemplateRenderer_Render(t *testing.T) {
+	renderer := email.NewTemplateRenderer()
+
+	t.Run("localizes subject and greeting", func(t *testing.T) {
+		tests := []struct {
+			locale   shared.Locale
+			subject  string
+			greeting string
+		}{
+			{shared.LocaleFrenchFR, "Nouvelle leçon : Jouer au football", "Bonjour Marie,"},
+			{shared.LocaleEnglishUS, "New lesson: Jouer au football", "Hello Marie,"},
+			{shared.LocalePortugueseBR, "Nova lição: Jouer au football", "Olá Marie,"},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.locale.String(), func(t *testing.T) {
+				msg, err := renderer.Render(samplePost, email.Recipient{Email: "marie@example.com", FirstName: "Marie", Locale: tt.locale})
+
+				assertNoError(t, err)
+				if msg.Subject != tt.subject {
+					t.Errorf("Subject: got %q, want %q", msg.Subject, tt.subject)
+				}
+				if !strings.HasPrefix(msg.Text, tt.greeting) {
+					t.Errorf("Text should start with %q, got %q", tt.greeting, msg.Text)
+				}
+				if !strings.Contains(msg.Text, tt.locale.GetSelfDisplayName()) {
+					t.Errorf("expected footer to mention %q", tt.locale.GetSelfDisplayName())
+				}
+				if msg.To != "marie@example.com" {
+					t.Errorf("To: got %q", msg.To)
+				}
+			})
+		}
+	})
+
+	t.Run("falls back to default locale", func(t *testing.T) {
+		msg, err := renderer.Render(samplePost, email.Recipient{Locale: "de-DE"})
+
+		assertNoError(t, err)
+		if !strings.HasPrefix(msg.Subject, "New lesson") {
+			t.Errorf("Subject: got %q", msg.Subject)
+		}
+		if !strings.Contains(msg.HTML, `lang="en-US"`) {
+			t.Error("expected HTML lang attribute to use default locale")
+		}
+	})
+
+	t.Run("escapes HTML but not text body", func(t *testing.T) {
+		tmpl := samplePost
+		tmpl.PostTitle = "Les <balises> & le HTML"
+
+		msg, err := renderer.Render(tmpl, email.Recipient{Locale: shared.LocaleFrenchFR})
+
+		assertNoError(t, err)
+		if !strings.Contains(msg.HTML, "Les &lt;balises&gt; &amp; le HTML") {
+			t.Errorf("expected escaped title in HTML, got %q", msg.HTML)
+		}
+		if !strings.Contains(msg.Text, "Les <balises> & le HTML") {
+			t.Errorf("expected raw title in text, got %q", msg.Text)
+		}
+	})
+
+	t.Run("includes member note and unsubscribe link when set", func(t *testing.T) {
+		msg, err := renderer.Render(samplePost, email.Recipient{
+			Locale:         shared.LocaleEnglishUS,
+			Member:         true,
+			UnsubscribeURL: "https://fla.example/unsubscribe/xyz",
+		})
+
+		assertNoError(t, err)
+		if !strings.Contains(msg.Text, "Thank you for supporting us as a member.") {
+			t.Error("expected member note")
+		}
+		if !strings.Contains(msg.HTML, `href="https://fla.example/unsubscribe/xyz"`) {
+			t.Error("expected unsubscribe link")
+		}
+	})
+
+	t.Run("rejects invalid template data", func(t *testing.T) {
+		_, err := renderer.Render(email.WeeklyDigest{}, email.Recipient{Locale: shared.LocaleFrenchFR})
+
+		assertError(t, err)
+		assertErrorCode(t, err, kernel.EInvalid)
+	})
+}
+
+func TestTemplateRenderer_Snapshots(t *testing.T) {
+	renderer := email.NewTemplateRenderer()
+	service := email.NewPreviewService()
+
+	templates := []email.Template{
+		samplePost,
+		email.WelcomeEmail{SiteName: "FLA", SiteURL: "https://fla.example"},
+		email.ConfirmSubscription{SiteName: "FLA", ConfirmURL: "https://fla.example/confirm/abc"},
+		email.WeeklyDigest{Items: []email.DigestItem{
+			{
+				Title: "Le passé composé", URL: "https://fla.example/a2/passe-compose", Excerpt: "Conjuguer au passé.",
+				PublishedAt: time.Date(2024, 5, 1, 8, 0, 0, 0, time.UTC), ReadingMinutes: 6,
+			},
+			{Title: "Les nombres", URL: "https://fla.example/a1/nombres"},
+		}},
+	}
+
+	for _, tmpl := range templates {
+		t.Run(tmpl.Name(), func(t *testing.T) {
+			preview, err := service.Preview(email.TemplatePreview{Renderer: renderer, Template: tmpl})
+			assertNoError(t, err)
+
+			var b strings.Builder
+			for _, v := range preview.Variants {
+				assertNoError(t, v.Err)
+				fmt.Fprintf(&b, "== %s\nSubject: %s\n-- text\n%s\n-- html\n%s\n", v.Persona.Key(), v.Message.Subject, v.Message.Text, v.Message.HTML)
+			}
+
+			assertSnapshot(t, "template_"+tmpl.Name(), b.String())
+		})
+	}
+}
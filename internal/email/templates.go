@@ -0,0 +1,187 @@
+package email
+
+import (
+	"strings"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/shared"
+)
+
+// Template names, matching the files under templates/.
+const (
+	TemplateNewPostNotification = "new_post_notification"
+	TemplateWelcome             = "welcome"
+	TemplateConfirmSubscription = "confirm_subscription"
+	TemplateWeeklyDigest        = "weekly_digest"
+)
+
+const MDigestEmpty string = "Weekly digest must contain at least one post."
+
+// Template is a typed email whose fields feed the localized layout.
+// Implemented only by this package so every template has files and copy.
+type Template interface {
+	Name() string
+	Validate() error
+
+	subject(l localizer) string
+	content(l localizer) any
+}
+
+// NewPostNotification announces a freshly published lesson.
+type NewPostNotification struct {
+	PostTitle    string
+	PostURL      string
+	Excerpt      string // Optional: effective excerpt of the post
+	CategoryName string // Category shown in the intro, e.g. "A1 › Compréhension écrite"
+}
+
+func (NewPostNotification) Name() string { return TemplateNewPostNotification }
+
+// Validate ensures the notification links to a titled post.
+func (n NewPostNotification) Validate() error {
+	const op = "NewPostNotification.Validate"
+
+	if err := kernel.ValidatePresence("post title", n.PostTitle, op); err != nil {
+		return err
+	}
+	return kernel.ValidatePresence("post URL", n.PostURL, op)
+}
+
+func (n NewPostNotification) subject(l localizer) string {
+	return l.T(keyNewPostSubject, n.PostTitle)
+}
+
+func (n NewPostNotification) content(l localizer) any {
+	return struct{ Intro, Title, Excerpt, URL, CTA string }{
+		Intro:   l.T(keyNewPostIntro, n.CategoryName),
+		Title:   n.PostTitle,
+		Excerpt: n.Excerpt,
+		URL:     n.PostURL,
+		CTA:     l.T(keyNewPostCTA),
+	}
+}
+
+// WelcomeEmail greets a subscriber after confirmation.
+type WelcomeEmail struct {
+	SiteName string
+	SiteURL  string
+}
+
+func (WelcomeEmail) Name() string { return TemplateWelcome }
+
+// Validate ensures the welcome email names and links the site.
+func (w WelcomeEmail) Validate() error {
+	const op = "WelcomeEmail.Validate"
+
+	if err := kernel.ValidatePresence("site name", w.SiteName, op); err != nil {
+		return err
+	}
+	return kernel.ValidatePresence("site URL", w.SiteURL, op)
+}
+
+func (w WelcomeEmail) subject(l localizer) string {
+	return l.T(keyWelcomeSubject, w.SiteName)
+}
+
+func (w WelcomeEmail) content(l localizer) any {
+	return struct{ Body, URL, CTA string }{
+		Body: l.T(keyWelcomeBody, w.SiteName),
+		URL:  w.SiteURL,
+		CTA:  l.T(keyWelcomeCTA),
+	}
+}
+
+// ConfirmSubscription asks a new subscriber to confirm their address (double opt-in).
+type ConfirmSubscription struct {
+	SiteName   string
+	ConfirmURL string
+}
+
+func (ConfirmSubscription) Name() string { return TemplateConfirmSubscription }
+
+// Validate ensures the confirmation link is present.
+func (c ConfirmSubscription) Validate() error {
+	const op = "ConfirmSubscription.Validate"
+
+	if err := kernel.ValidatePresence("site name", c.SiteName, op); err != nil {
+		return err
+	}
+	return kernel.ValidatePresence("confirmation URL", c.ConfirmURL, op)
+}
+
+func (c ConfirmSubscription) subject(l localizer) string {
+	return l.T(keyConfirmSubject)
+}
+
+func (c ConfirmSubscription) content(l localizer) any {
+	return struct{ Body, URL, CTA string }{
+		Body: l.T(keyConfirmBody, c.SiteName),
+		URL:  c.ConfirmURL,
+		CTA:  l.T(keyConfirmCTA),
+	}
+}
+
+// DigestItem is one lesson listed in the weekly digest.
+type DigestItem struct {
+	Title          string
+	URL            string
+	Excerpt        string
+	PublishedAt    time.Time // Optional: shown in the recipient's locale and time zone
+	ReadingMinutes int       // Optional: estimated reading time
+}
+
+// WeeklyDigest summarizes the lessons published during the week.
+type WeeklyDigest struct {
+	Items []DigestItem
+}
+
+func (WeeklyDigest) Name() string { return TemplateWeeklyDigest }
+
+// Validate ensures the digest lists at least one complete item.
+func (d WeeklyDigest) Validate() error {
+	const op = "WeeklyDigest.Validate"
+
+	if len(d.Items) == 0 {
+		return &kernel.Error{Code: kernel.EInvalid, Message: MDigestEmpty, Operation: op}
+	}
+
+	for _, item := range d.Items {
+		if err := kernel.ValidatePresence("post title", item.Title, op); err != nil {
+			return err
+		}
+		if err := kernel.ValidatePresence("post URL", item.URL, op); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (d WeeklyDigest) subject(l localizer) string {
+	return l.T(keyDigestSubject, len(d.Items))
+}
+
+func (d WeeklyDigest) content(l localizer) any {
+	type item struct{ Title, URL, Excerpt, Meta string }
+
+	items := make([]item, len(d.Items))
+	for i, it := range d.Items {
+		var meta []string
+		if date := l.Date(it.PublishedAt); date != "" {
+			meta = append(meta, date)
+		}
+		if it.ReadingMinutes > 0 {
+			meta = append(meta, shared.FormatReadingTime(it.ReadingMinutes, l.locale))
+		}
+		items[i] = item{Title: it.Title, URL: it.URL, Excerpt: it.Excerpt, Meta: strings.Join(meta, " · ")}
+	}
+
+	return struct {
+		Intro string
+		Items []item
+	}{
+		Intro: l.T(keyDigestIntro),
+		Items: items,
+	}
+}
@@ -0,0 +1,45 @@
+package email_test
+
+import (
+	"testing"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/email"
+)
+
+func TestTemplates_Validate(t *testing.T) {
+	t.Run("accepts complete templates", func(t *testing.T) {
+		templates := []email.Template{
+			email.NewPostNotification{PostTitle: "Jouer au football", PostURL: "https://fla.example/a1/sports/football"},
+			email.WelcomeEmail{SiteName: "FLA", SiteURL: "https://fla.example"},
+			email.ConfirmSubscription{SiteName: "FLA", ConfirmURL: "https://fla.example/confirm/abc"},
+			email.WeeklyDigest{Items: []email.DigestItem{{Title: "Le passé composé", URL: "https://fla.example/a2/passe-compose"}}},
+		}
+
+		for _, tmpl := range templates {
+			t.Run(tmpl.Name(), func(t *testing.T) {
+				assertNoError(t, tmpl.Validate())
+			})
+		}
+	})
+
+	t.Run("rejects incomplete templates", func(t *testing.T) {
+		templates := map[string]email.Template{
+			"post without URL":     email.NewPostNotification{PostTitle: "Jouer au football"},
+			"post without title":   email.NewPostNotification{PostURL: "https://fla.example/p"},
+			"welcome without site": email.WelcomeEmail{SiteURL: "https://fla.example"},
+			"confirm without link": email.ConfirmSubscription{SiteName: "FLA"},
+			"empty digest":         email.WeeklyDigest{},
+			"digest item no URL":   email.WeeklyDigest{Items: []email.DigestItem{{Title: "Le passé composé"}}},
+		}
+
+		for name, tmpl := range templates {
+			t.Run(name, func(t *testing.T) {
+				err := tmpl.Validate()
+
+				assertError(t, err)
+				assertErrorCode(t, err, kernel.EInvalid)
+			})
+		}
+	})
+}
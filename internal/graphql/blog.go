@@ -0,0 +1,564 @@
+package graphql
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/alnah/fla/internal/domain/category"
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/post"
+	"github.com/alnah/fla/internal/domain/shared"
+	"github.com/alnah/fla/internal/domain/tag"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+const (
+	MPostNotFound     string = "Post not found."
+	MCategoryNotFound string = "Category not found."
+	MTagNotFound      string = "Tag not found."
+	MUserNotFound     string = "User not found."
+	MLookupInvalid    string = "Give exactly one of %s."
+	MStatusForbidden  string = "Only editors and admins can list unpublished posts of other authors."
+	MFieldForbidden   string = "You are not allowed to see this field."
+)
+
+// Enums shared by the blog schema.
+var (
+	PostStatus = &Enum{Name: "PostStatus", Values: []string{
+		post.StatusDraft.String(),
+		post.StatusPublished.String(),
+		post.StatusScheduled.String(),
+		post.StatusArchived.String(),
+	}}
+
+	Role = &Enum{Name: "Role", Values: []string{
+		user.RoleAdmin.String(),
+		user.RoleEditor.String(),
+		user.RoleAuthor.String(),
+		user.RoleSubscriber.String(),
+		user.RoleVisitor.String(),
+		user.RoleMachine.String(),
+	}}
+)
+
+// blog resolves the blog schema against the repositories.
+type blog struct {
+	posts      PostStore
+	categories CategoryStore
+	tags       tag.TagReader
+	users      UserReader
+}
+
+// NewBlogSchema builds the read-only schema over posts, categories, tags, and users.
+//
+// Visibility follows the domain permissions: unpublished posts are only found by
+// actors who can edit them, other authors' drafts can only be listed by editors and
+// admins, and private account fields (email, roles, locale) are only resolved for
+// the account itself or an admin. Denied fields resolve to null with a FORBIDDEN error.
+func NewBlogSchema(posts PostStore, categories CategoryStore, tags tag.TagReader, users UserReader) *Schema {
+	b := &blog{posts: posts, categories: categories, tags: tags, users: users}
+
+	// Declared first so fields can refer to each other, including recursively.
+	postType := &Object{Name: "Post"}
+	categoryType := &Object{Name: "Category"}
+	breadcrumbType := &Object{Name: "Breadcrumb"}
+	tagType := &Object{Name: "Tag"}
+	userType := &Object{Name: "User"}
+	pageInfoType := &Object{Name: "PageInfo"}
+	connectionType := &Object{Name: "PostConnection"}
+
+	pageArgs := []Argument{
+		{Name: "page", Type: Int, Default: 1},
+		{Name: "limit", Type: Int, Default: shared.DefaultPageLimit},
+	}
+
+	postType.Fields = map[string]*Field{
+		"id":            {Type: &NonNull{Of: ID}, Resolve: from(func(p post.Post) any { return p.PostID })},
+		"title":         {Type: &NonNull{Of: String}, Resolve: from(func(p post.Post) any { return p.Title })},
+		"slug":          {Type: &NonNull{Of: String}, Resolve: from(func(p post.Post) any { return p.Slug })},
+		"status":        {Type: &NonNull{Of: PostStatus}, Resolve: from(func(p post.Post) any { return p.Status })},
+		"excerpt":       {Type: &NonNull{Of: String}, Resolve: from(func(p post.Post) any { return p.GetEffectiveExcerpt() })},
+		"content":       {Type: &NonNull{Of: String}, Resolve: from(func(p post.Post) any { return p.Content })},
+		"featuredImage": {Type: String, Resolve: from(func(p post.Post) any { return optional(p.FeaturedImage.String()) })},
+		"publishedAt":   {Type: DateTime, Resolve: from(func(p post.Post) any { return p.PublishedAt })},
+		"createdAt":     {Type: &NonNull{Of: DateTime}, Resolve: from(func(p post.Post) any { return p.CreatedAt })},
+		"updatedAt":     {Type: &NonNull{Of: DateTime}, Resolve: from(func(p post.Post) any { return p.UpdatedAt })},
+		"wordCount":     {Type: &NonNull{Of: Int}, Resolve: from(func(p post.Post) any { return p.WordCount() })},
+		"readingTime":   {Type: &NonNull{Of: Int}, Resolve: from(func(p post.Post) any { return p.EstimatedReadingTime() })},
+		"path":          {Type: &NonNull{Of: String}, Resolve: b.postPath},
+		"category":      {Type: &NonNull{Of: categoryType}, Resolve: from(func(p post.Post) any { return p.Category })},
+		"tags":          {Type: &NonNull{Of: &List{Of: &NonNull{Of: tagType}}}, Resolve: b.postTags},
+		"author":        {Type: userType, Resolve: b.postAuthor},
+		"approvedBy":    {Type: userType, Resolve: b.postApprover},
+		"approvedAt": {Type: DateTime, Resolve: editorial(func(p post.Post) (any, error) {
+			return p.ApprovedAt, nil
+		})},
+	}
+
+	categoryType.Fields = map[string]*Field{
+		"id":          {Type: &NonNull{Of: ID}, Resolve: from(func(c category.Category) any { return c.CategoryID })},
+		"name":        {Type: &NonNull{Of: String}, Resolve: from(func(c category.Category) any { return c.Name })},
+		"slug":        {Type: &NonNull{Of: String}, Resolve: from(func(c category.Category) any { return c.Slug })},
+		"description": {Type: String, Resolve: from(func(c category.Category) any { return optional(c.Description.String()) })},
+		"sortOrder":   {Type: &NonNull{Of: Int}, Resolve: from(func(c category.Category) any { return c.SortOrder })},
+		"path":        {Type: &NonNull{Of: String}, Resolve: b.categoryPath},
+		"parent":      {Type: categoryType, Resolve: b.categoryParent},
+		"children":    {Type: &NonNull{Of: &List{Of: &NonNull{Of: categoryType}}}, Resolve: b.categoryChildren},
+		"breadcrumbs": {Type: &NonNull{Of: &List{Of: &NonNull{Of: breadcrumbType}}}, Resolve: b.categoryBreadcrumbs},
+		"posts":       {Type: &NonNull{Of: connectionType}, Args: pageArgs, Resolve: b.categoryPosts},
+	}
+
+	breadcrumbType.Fields = map[string]*Field{
+		"category": {Type: &NonNull{Of: categoryType}, Resolve: from(func(c category.CategoryBreadcrumb) any { return c.Category })},
+		"level":    {Type: &NonNull{Of: Int}, Resolve: from(func(c category.CategoryBreadcrumb) any { return c.Level })},
+		"isLast":   {Type: &NonNull{Of: Boolean}, Resolve: from(func(c category.CategoryBreadcrumb) any { return c.IsLast })},
+	}
+
+	tagType.Fields = map[string]*Field{
+		"id":    {Type: &NonNull{Of: ID}, Resolve: from(func(t tag.Tag) any { return t.TagID })},
+		"name":  {Type: &NonNull{Of: String}, Resolve: from(func(t tag.Tag) any { return t.Name })},
+		"slug":  {Type: &NonNull{Of: String}, Resolve: from(func(t tag.Tag) any { return t.Slug })},
+		"posts": {Type: &NonNull{Of: connectionType}, Args: pageArgs, Resolve: b.tagPosts},
+	}
+
+	userType.Fields = map[string]*Field{
+		"id":          {Type: &NonNull{Of: ID}, Resolve: from(func(u user.User) any { return u.ID })},
+		"username":    {Type: &NonNull{Of: String}, Resolve: from(func(u user.User) any { return u.Username })},
+		"displayName": {Type: &NonNull{Of: String}, Resolve: from(func(u user.User) any { return u.GetDisplayName() })},
+		"bio":         {Type: String, Resolve: from(func(u user.User) any { return optional(u.Description.String()) })},
+		"pictureURL":  {Type: String, Resolve: from(func(u user.User) any { return optional(u.PictureURL.String()) })},
+		"createdAt":   {Type: &NonNull{Of: DateTime}, Resolve: from(func(u user.User) any { return u.CreatedAt })},
+		"email":       {Type: String, Resolve: private(func(u user.User) any { return u.Email })},
+		"roles":       {Type: &List{Of: &NonNull{Of: Role}}, Resolve: private(func(u user.User) any { return u.Roles })},
+		"locale":      {Type: String, Resolve: private(func(u user.User) any { return u.LocalePreference })},
+	}
+
+	pageInfoType.Fields = map[string]*Field{
+		"page":            {Type: &NonNull{Of: Int}, Resolve: from(func(p shared.Pagination) any { return p.Page })},
+		"limit":           {Type: &NonNull{Of: Int}, Resolve: from(func(p shared.Pagination) any { return p.Limit })},
+		"totalItems":      {Type: &NonNull{Of: Int}, Resolve: from(func(p shared.Pagination) any { return p.TotalItems })},
+		"totalPages":      {Type: &NonNull{Of: Int}, Resolve: from(func(p shared.Pagination) any { return p.TotalPages })},
+		"hasNextPage":     {Type: &NonNull{Of: Boolean}, Resolve: from(func(p shared.Pagination) any { return p.HasNextPage() })},
+		"hasPreviousPage": {Type: &NonNull{Of: Boolean}, Resolve: from(func(p shared.Pagination) any { return p.HasPreviousPage() })},
+	}
+
+	connectionType.Fields = map[string]*Field{
+		"nodes":    {Type: &NonNull{Of: &List{Of: &NonNull{Of: postType}}}, Resolve: from(func(l post.PostsList) any { return l.Posts })},
+		"pageInfo": {Type: &NonNull{Of: pageInfoType}, Resolve: from(func(l post.PostsList) any { return l.Pagination })},
+	}
+
+	query := &Object{Name: "Query", Fields: map[string]*Field{
+		"post": {
+			Type:    postType,
+			Args:    []Argument{{Name: "id", Type: ID}, {Name: "slug", Type: String}},
+			Resolve: b.post,
+		},
+		"posts": {
+			Type: &NonNull{Of: connectionType},
+			Args: append([]Argument{
+				{Name: "status", Type: &List{Of: &NonNull{Of: PostStatus}}},
+				{Name: "category", Type: ID},
+				{Name: "tags", Type: &List{Of: &NonNull{Of: ID}}},
+				{Name: "author", Type: ID},
+			}, pageArgs...),
+			Resolve: b.postList,
+		},
+		"category": {
+			Type:    categoryType,
+			Args:    []Argument{{Name: "id", Type: ID}, {Name: "path", Type: String}},
+			Resolve: b.category,
+		},
+		"categories": {
+			Type:    &NonNull{Of: &List{Of: &NonNull{Of: categoryType}}},
+			Resolve: func(ResolveParams) (any, error) { return b.categories.GetRootCategories() },
+		},
+		"tag": {
+			Type:    tagType,
+			Args:    []Argument{{Name: "id", Type: ID}, {Name: "slug", Type: String}},
+			Resolve: b.tag,
+		},
+		"tags": {
+			Type:    &NonNull{Of: &List{Of: &NonNull{Of: tagType}}},
+			Resolve: func(ResolveParams) (any, error) { return b.tags.GetAll() },
+		},
+		"user": {
+			Type:    userType,
+			Args:    []Argument{{Name: "id", Type: &NonNull{Of: ID}}},
+			Resolve: b.user,
+		},
+		"viewer": {
+			Type:    userType,
+			Resolve: b.viewer,
+		},
+	}}
+
+	return NewSchema(query)
+}
+
+// from resolves a field from its parent value alone.
+func from[T any](fn func(T) any) ResolveFunc {
+	return func(p ResolveParams) (any, error) {
+		return fn(p.Source.(T)), nil
+	}
+}
+
+// editorial resolves a post field only for actors who can edit the post.
+func editorial(fn func(post.Post) (any, error)) ResolveFunc {
+	return func(p ResolveParams) (any, error) {
+		const op = "editorial"
+
+		source := p.Source.(post.Post)
+		if !p.Actor.CanEditPost(source) {
+			return nil, &kernel.Error{Code: kernel.EForbidden, Message: MFieldForbidden, Operation: op}
+		}
+		return fn(source)
+	}
+}
+
+// private resolves an account field only for the account itself or an admin.
+func private(fn func(user.User) any) ResolveFunc {
+	return func(p ResolveParams) (any, error) {
+		const op = "private"
+
+		source := p.Source.(user.User)
+		if p.Actor.GetID() != source.ID && !p.Actor.HasRole(user.RoleAdmin) {
+			return nil, &kernel.Error{Code: kernel.EForbidden, Message: MFieldForbidden, Operation: op}
+		}
+		return fn(source), nil
+	}
+}
+
+// optional turns empty strings into null.
+func optional(s string) any {
+	if s == "" {
+		return nil
+	}
+	return s
+}
+
+// Query
+
+// post finds a post by ID or slug. Posts the actor may not see are reported
+// as not found, so drafts do not leak through their identifiers.
+func (b *blog) post(p ResolveParams) (any, error) {
+	const op = "blog.post"
+
+	id, slug, err := oneOf(p, "id", "slug", op)
+	if err != nil {
+		return nil, err
+	}
+
+	var found *post.Post
+	if id != "" {
+		found, err = b.posts.GetByID(kernel.ID[post.Post](id))
+	} else {
+		found, err = b.posts.GetBySlug(shared.Slug(slug))
+	}
+	if err != nil {
+		return nil, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if !found.IsPublished() && !p.Actor.CanEditPost(*found) {
+		return nil, &kernel.Error{Code: kernel.ENotFound, Message: MPostNotFound, Operation: op}
+	}
+
+	return found, nil
+}
+
+// postList lists posts; without a status filter only published posts are listed.
+// Authors asking for unpublished statuses only get their own posts.
+func (b *blog) postList(p ResolveParams) (any, error) {
+	const op = "blog.postList"
+
+	q := post.PublishedQuery().Page(p.Int("page"), p.Int("limit"))
+
+	if statuses := p.Strings("status"); len(statuses) > 0 {
+		q.Statuses = nil
+		for _, s := range statuses {
+			q.Statuses = append(q.Statuses, post.Status(s))
+		}
+	}
+	if id := p.String("category"); id != "" {
+		q = q.InCategory(kernel.ID[category.Category](id))
+	}
+	for _, id := range p.Strings("tags") {
+		q.TagIDs = append(q.TagIDs, kernel.ID[tag.Tag](id))
+	}
+	if id := p.String("author"); id != "" {
+		q = q.OwnedBy(kernel.ID[user.User](id))
+	}
+
+	q, err := restrictStatuses(q, p.Actor)
+	if err != nil {
+		return nil, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	if err := q.Validate(); err != nil {
+		return nil, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	list, err := b.posts.Find(q)
+	if err != nil {
+		return nil, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return list, nil
+}
+
+// restrictStatuses applies listing permissions to unpublished statuses.
+func restrictStatuses(q post.Query, actor user.PostPermissionChecker) (post.Query, error) {
+	const op = "restrictStatuses"
+
+	unpublished := false
+	for _, s := range q.Statuses {
+		unpublished = unpublished || s != post.StatusPublished
+	}
+
+	switch {
+	case !unpublished, actor.HasAnyRole(user.RoleAdmin, user.RoleEditor):
+		return q, nil
+	case actor.HasRole(user.RoleAuthor) && (q.OwnerID == nil || *q.OwnerID == actor.GetID()):
+		return q.OwnedBy(actor.GetID()), nil
+	default:
+		return q, &kernel.Error{Code: kernel.EForbidden, Message: MStatusForbidden, Operation: op}
+	}
+}
+
+// category finds a category by ID or by URL path such as "a1/reading".
+func (b *blog) category(p ResolveParams) (any, error) {
+	const op = "blog.category"
+
+	id, path, err := oneOf(p, "id", "path", op)
+	if err != nil {
+		return nil, err
+	}
+
+	var found *category.Category
+	if id != "" {
+		found, err = b.categories.GetByID(kernel.ID[category.Category](id))
+	} else {
+		found, err = b.categories.FindByPath(strings.Split(strings.Trim(path, "/"), "/"))
+	}
+	if err != nil {
+		return nil, &kernel.Error{Operation: op, Cause: err}
+	}
+	if found == nil {
+		return nil, &kernel.Error{Code: kernel.ENotFound, Message: MCategoryNotFound, Operation: op}
+	}
+
+	return found, nil
+}
+
+// tag finds a tag by ID or slug.
+func (b *blog) tag(p ResolveParams) (any, error) {
+	const op = "blog.tag"
+
+	id, slug, err := oneOf(p, "id", "slug", op)
+	if err != nil {
+		return nil, err
+	}
+
+	var found *tag.Tag
+	if id != "" {
+		found, err = b.tags.GetByID(kernel.ID[tag.Tag](id))
+	} else {
+		found, err = b.tags.GetBySlug(shared.Slug(slug))
+	}
+	if err != nil {
+		return nil, &kernel.Error{Operation: op, Cause: err}
+	}
+	if found == nil {
+		return nil, &kernel.Error{Code: kernel.ENotFound, Message: MTagNotFound, Operation: op}
+	}
+
+	return found, nil
+}
+
+// user finds an account. Only contributors are public: other accounts are
+// reported as not found unless the actor is the account itself or an admin.
+func (b *blog) user(p ResolveParams) (any, error) {
+	const op = "blog.user"
+
+	u, err := b.users.GetUserByID(kernel.ID[user.User](p.String("id")))
+	if err != nil {
+		return nil, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	contributor := u.IsActive() && u.HasAnyRole(user.RoleAdmin, user.RoleEditor, user.RoleAuthor)
+	if !contributor && p.Actor.GetID() != u.ID && !p.Actor.HasRole(user.RoleAdmin) {
+		return nil, &kernel.Error{Code: kernel.ENotFound, Message: MUserNotFound, Operation: op}
+	}
+
+	return u, nil
+}
+
+// viewer returns the actor's own account, or null for anonymous visitors.
+func (b *blog) viewer(p ResolveParams) (any, error) {
+	const op = "blog.viewer"
+
+	if p.Actor.GetID() == "" {
+		return nil, nil
+	}
+
+	u, err := b.users.GetUserByID(p.Actor.GetID())
+	if kernel.ErrorCode(err) == kernel.ENotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return u, nil
+}
+
+// oneOf reads two alternative lookup arguments, exactly one of which must be set.
+func oneOf(p ResolveParams, first, second, op string) (string, string, error) {
+	a, b := p.String(first), p.String(second)
+	if (a == "") == (b == "") {
+		return "", "", &kernel.Error{
+			Code:      kernel.EInvalid,
+			Message:   fmt.Sprintf(MLookupInvalid, first+", "+second),
+			Operation: op,
+		}
+	}
+	return a, b, nil
+}
+
+// Post
+
+func (b *blog) postPath(p ResolveParams) (any, error) {
+	const op = "blog.postPath"
+
+	source := p.Source.(post.Post)
+	path, err := b.categories.BuildPath(source.Category.CategoryID)
+	if err != nil {
+		return nil, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return source.URLPath(path), nil
+}
+
+func (b *blog) postTags(p ResolveParams) (any, error) {
+	const op = "blog.postTags"
+
+	source := p.Source.(post.Post)
+	tags := make([]tag.Tag, 0, len(source.Tags))
+	for _, id := range source.Tags {
+		t, err := b.tags.GetByID(id)
+		if err != nil {
+			return nil, &kernel.Error{Operation: op, Cause: err}
+		}
+		tags = append(tags, *t)
+	}
+
+	return tags, nil
+}
+
+// postAuthor returns the post owner, or null once the account is deleted.
+func (b *blog) postAuthor(p ResolveParams) (any, error) {
+	return b.account(p.Source.(post.Post).Owner, "blog.postAuthor")
+}
+
+func (b *blog) postApprover(p ResolveParams) (any, error) {
+	return editorial(func(source post.Post) (any, error) {
+		if source.ApprovedBy == nil {
+			return nil, nil
+		}
+		return b.account(*source.ApprovedBy, "blog.postApprover")
+	})(p)
+}
+
+func (b *blog) account(id kernel.ID[user.User], op string) (any, error) {
+	u, err := b.users.GetUserByID(id)
+	if kernel.ErrorCode(err) == kernel.ENotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return u, nil
+}
+
+// Category
+
+func (b *blog) categoryPath(p ResolveParams) (any, error) {
+	const op = "blog.categoryPath"
+
+	path, err := b.categories.BuildPath(p.Source.(category.Category).CategoryID)
+	if err != nil {
+		return nil, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return path.String(), nil
+}
+
+func (b *blog) categoryParent(p ResolveParams) (any, error) {
+	const op = "blog.categoryParent"
+
+	source := p.Source.(category.Category)
+	if source.ParentID == nil {
+		return nil, nil
+	}
+
+	parent, err := b.categories.GetByID(*source.ParentID)
+	if err != nil {
+		return nil, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return parent, nil
+}
+
+func (b *blog) categoryChildren(p ResolveParams) (any, error) {
+	const op = "blog.categoryChildren"
+
+	children, err := b.categories.GetChildren(p.Source.(category.Category).CategoryID)
+	if err != nil {
+		return nil, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return children, nil
+}
+
+// categoryBreadcrumbs lists the trail from the root level down to the category.
+func (b *blog) categoryBreadcrumbs(p ResolveParams) (any, error) {
+	const op = "blog.categoryBreadcrumbs"
+
+	path, err := b.categories.BuildPath(p.Source.(category.Category).CategoryID)
+	if err != nil {
+		return nil, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	breadcrumbs := make([]category.CategoryBreadcrumb, len(path))
+	for i, c := range path {
+		breadcrumbs[i] = category.CategoryBreadcrumb{Category: c, IsLast: i == len(path)-1, Level: i}
+	}
+
+	return breadcrumbs, nil
+}
+
+func (b *blog) categoryPosts(p ResolveParams) (any, error) {
+	q := post.PublishedQuery().InCategory(p.Source.(category.Category).CategoryID)
+	return b.published(q, p, "blog.categoryPosts")
+}
+
+// Tag
+
+func (b *blog) tagPosts(p ResolveParams) (any, error) {
+	q := post.PublishedQuery().WithAnyTag(p.Source.(tag.Tag).TagID)
+	return b.published(q, p, "blog.tagPosts")
+}
+
+func (b *blog) published(q post.Query, p ResolveParams, op string) (any, error) {
+	q = q.Page(p.Int("page"), p.Int("limit"))
+	if err := q.Validate(); err != nil {
+		return nil, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	list, err := b.posts.Find(q)
+	if err != nil {
+		return nil, &kernel.Error{Operation: op, Cause: err}
+	}
+
+	return list, nil
+}
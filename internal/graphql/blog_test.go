@@ -0,0 +1,239 @@
+package graphql_test
+
+import (
+	"testing"
+
+	"github.com/alnah/fla/internal/domain/user"
+	"github.com/alnah/fla/internal/graphql"
+)
+
+func TestBlogSchema_Post(t *testing.T) {
+	schema := blogFixture()
+
+	t.Run("resolves a published post with category trail, tags, and author", func(t *testing.T) {
+		response := schema.Execute(graphql.Request{Query: `{
+			post(slug: "jouer-au-football") {
+				title
+				status
+				path
+				publishedAt
+				category {
+					name
+					breadcrumbs { level isLast category { slug } }
+				}
+				tags { slug }
+				author { displayName }
+			}
+		}`}, visitor)
+
+		assertErrorCodes(t, response)
+		assertJSON(t, response, `{"data": {"post": {
+			"title": "Jouer au football",
+			"status": "PUBLISHED",
+			"path": "a1/comprehension-ecrite/sports/jouer-au-football",
+			"publishedAt": "2026-03-02T09:00:00Z",
+			"category": {
+				"name": "Sports",
+				"breadcrumbs": [
+					{"level": 0, "isLast": false, "category": {"slug": "a1"}},
+					{"level": 1, "isLast": false, "category": {"slug": "comprehension-ecrite"}},
+					{"level": 2, "isLast": true, "category": {"slug": "sports"}}
+				]
+			},
+			"tags": [{"slug": "football"}, {"slug": "grammaire"}],
+			"author": {"displayName": "author-1"}
+		}}}`)
+	})
+
+	t.Run("hides drafts from actors who cannot edit them", func(t *testing.T) {
+		for _, actor := range []user.User{visitor, subscriber, rival} {
+			response := schema.Execute(graphql.Request{Query: `{ post(id: "post-2") { title } }`}, actor)
+
+			assertErrorCodes(t, response, graphql.CodeNotFound)
+			assertJSON(t, response.Data, `{"post": null}`)
+			assertJSON(t, response.Errors[0].Path, `["post"]`)
+		}
+	})
+
+	t.Run("shows drafts to their author and to editors", func(t *testing.T) {
+		for _, actor := range []user.User{author, editor} {
+			response := schema.Execute(graphql.Request{Query: `{ post(id: "post-2") { title } }`}, actor)
+
+			assertErrorCodes(t, response)
+			assertJSON(t, response.Data, `{"post": {"title": "Brouillon sur le tennis"}}`)
+		}
+	})
+
+	t.Run("resolves editorial fields only for actors who can edit the post", func(t *testing.T) {
+		query := graphql.Request{Query: `{ post(id: "post-1") { title approvedAt approvedBy { username } } }`}
+
+		response := schema.Execute(query, visitor)
+		assertErrorCodes(t, response, graphql.CodeForbidden, graphql.CodeForbidden)
+		assertJSON(t, response.Data, `{"post": {"title": "Jouer au football", "approvedAt": null, "approvedBy": null}}`)
+
+		response = schema.Execute(query, author)
+		assertErrorCodes(t, response)
+		assertJSON(t, response.Data, `{"post": {
+			"title": "Jouer au football",
+			"approvedAt": "2026-03-01T21:00:00Z",
+			"approvedBy": {"username": "editor-1"}
+		}}`)
+	})
+
+	t.Run("requires exactly one lookup argument", func(t *testing.T) {
+		response := schema.Execute(graphql.Request{Query: `{ post(id: "post-1", slug: "jouer-au-football") { title } }`}, visitor)
+
+		assertErrorCodes(t, response, graphql.CodeBadUserInput)
+		assertJSON(t, response.Data, `{"post": null}`)
+	})
+
+	t.Run("reports unknown posts as not found", func(t *testing.T) {
+		response := schema.Execute(graphql.Request{Query: `{ post(slug: "inconnu") { title } }`}, visitor)
+
+		assertErrorCodes(t, response, graphql.CodeNotFound)
+	})
+}
+
+func TestBlogSchema_Posts(t *testing.T) {
+	schema := blogFixture()
+
+	t.Run("lists published posts by default", func(t *testing.T) {
+		response := schema.Execute(graphql.Request{Query: `{
+			posts(limit: 5) { nodes { slug } pageInfo { page limit totalItems hasNextPage } }
+		}`}, visitor)
+
+		assertErrorCodes(t, response)
+		assertJSON(t, response.Data, `{"posts": {
+			"nodes": [{"slug": "jouer-au-football"}],
+			"pageInfo": {"page": 1, "limit": 5, "totalItems": 1, "hasNextPage": false}
+		}}`)
+	})
+
+	t.Run("filters by category subtree and tag", func(t *testing.T) {
+		response := schema.Execute(graphql.Request{Query: `{
+			byCategory: posts(category: "a1") { nodes { slug } }
+			byTag: posts(tags: ["grammaire"]) { nodes { slug } }
+			none: posts(tags: "inconnu") { nodes { slug } }
+		}`}, visitor)
+
+		assertErrorCodes(t, response)
+		assertJSON(t, response.Data, `{
+			"byCategory": {"nodes": [{"slug": "jouer-au-football"}]},
+			"byTag": {"nodes": [{"slug": "jouer-au-football"}]},
+			"none": {"nodes": []}
+		}`)
+	})
+
+	t.Run("forbids listing unpublished posts to readers", func(t *testing.T) {
+		response := schema.Execute(graphql.Request{Query: `{ posts(status: [DRAFT]) { nodes { slug } } }`}, subscriber)
+
+		assertErrorCodes(t, response, graphql.CodeForbidden)
+		assertJSON(t, response, `{
+			"errors": [{
+				"message": "Only editors and admins can list unpublished posts of other authors.",
+				"locations": [{"line": 1, "column": 3}],
+				"path": ["posts"],
+				"extensions": {"code": "FORBIDDEN"}
+			}],
+			"data": null
+		}`)
+	})
+
+	t.Run("limits authors to their own unpublished posts", func(t *testing.T) {
+		response := schema.Execute(graphql.Request{Query: `{ posts(status: [DRAFT]) { nodes { slug } } }`}, rival)
+		assertErrorCodes(t, response)
+		assertJSON(t, response.Data, `{"posts": {"nodes": []}}`)
+
+		response = schema.Execute(graphql.Request{Query: `{ posts(status: [DRAFT]) { nodes { slug } } }`}, author)
+		assertJSON(t, response.Data, `{"posts": {"nodes": [{"slug": "brouillon-sur-le-tennis"}]}}`)
+
+		response = schema.Execute(graphql.Request{Query: `{ posts(status: [DRAFT], author: "author-1") { nodes { slug } } }`}, rival)
+		assertErrorCodes(t, response, graphql.CodeForbidden)
+	})
+
+	t.Run("reports invalid paging as bad input", func(t *testing.T) {
+		response := schema.Execute(graphql.Request{Query: `{ posts(limit: 500) { nodes { slug } } }`}, visitor)
+
+		assertErrorCodes(t, response, graphql.CodeBadUserInput)
+	})
+}
+
+func TestBlogSchema_Categories(t *testing.T) {
+	schema := blogFixture()
+
+	t.Run("resolves nested children from the roots", func(t *testing.T) {
+		response := schema.Execute(graphql.Request{Query: `{
+			categories { slug children { slug children { slug path parent { slug } } } }
+		}`}, visitor)
+
+		assertErrorCodes(t, response)
+		assertJSON(t, response.Data, `{"categories": [{"slug": "a1", "children": [{
+			"slug": "comprehension-ecrite",
+			"children": [{"slug": "sports", "path": "a1/comprehension-ecrite/sports", "parent": {"slug": "comprehension-ecrite"}}]
+		}]}]}`)
+	})
+
+	t.Run("finds a category by path and lists its published posts", func(t *testing.T) {
+		response := schema.Execute(graphql.Request{Query: `{
+			category(path: "/a1/comprehension-ecrite/") { name description posts { nodes { slug } } }
+		}`}, visitor)
+
+		assertErrorCodes(t, response)
+		assertJSON(t, response.Data, `{"category": {
+			"name": "Compréhension écrite",
+			"description": null,
+			"posts": {"nodes": [{"slug": "jouer-au-football"}]}
+		}}`)
+	})
+}
+
+func TestBlogSchema_Tags(t *testing.T) {
+	schema := blogFixture()
+
+	response := schema.Execute(graphql.Request{Query: `{
+		tags { name }
+		tag(slug: "football") { name posts { pageInfo { totalItems } } }
+	}`}, visitor)
+
+	assertErrorCodes(t, response)
+	assertJSON(t, response.Data, `{
+		"tags": [{"name": "Grammaire"}, {"name": "Football"}],
+		"tag": {"name": "Football", "posts": {"pageInfo": {"totalItems": 1}}}
+	}`)
+}
+
+func TestBlogSchema_Users(t *testing.T) {
+	schema := blogFixture()
+
+	t.Run("resolves private fields for the account itself and admins", func(t *testing.T) {
+		query := graphql.Request{Query: `{ user(id: "author-1") { username email roles } }`}
+
+		for _, actor := range []user.User{author, admin} {
+			response := schema.Execute(query, actor)
+
+			assertErrorCodes(t, response)
+			assertJSON(t, response.Data, `{"user": {"username": "author-1", "email": "author-1@example.com", "roles": ["AUTHOR"]}}`)
+		}
+
+		response := schema.Execute(query, rival)
+		assertErrorCodes(t, response, graphql.CodeForbidden, graphql.CodeForbidden)
+		assertJSON(t, response.Data, `{"user": {"username": "author-1", "email": null, "roles": null}}`)
+	})
+
+	t.Run("hides accounts that are not contributors", func(t *testing.T) {
+		response := schema.Execute(graphql.Request{Query: `{ user(id: "subscriber-1") { username } }`}, visitor)
+		assertErrorCodes(t, response, graphql.CodeNotFound)
+
+		response = schema.Execute(graphql.Request{Query: `{ user(id: "subscriber-1") { username } }`}, admin)
+		assertErrorCodes(t, response)
+	})
+
+	t.Run("resolves the viewer, null for anonymous visitors", func(t *testing.T) {
+		response := schema.Execute(graphql.Request{Query: `{ viewer { username locale } }`}, subscriber)
+		assertJSON(t, response.Data, `{"viewer": {"username": "subscriber-1", "locale": "en-US"}}`)
+
+		response = schema.Execute(graphql.Request{Query: `{ viewer { username } }`}, visitor)
+		assertErrorCodes(t, response)
+		assertJSON(t, response.Data, `{"viewer": null}`)
+	})
+}
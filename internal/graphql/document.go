@@ -0,0 +1,559 @@
+package graphql
+
+import (
+	"fmt"
+)
+
+const (
+	MTokenUnexpected       string = "Expected %s, found %s."
+	MOperationUnsupported  string = "Unsupported operation %q; only queries are served."
+	MFragmentDuplicate     string = "Fragment %q is defined more than once."
+	MDefinitionUnsupported string = "Unexpected %s; expected an operation or a fragment."
+	MDocumentEmpty         string = "Query has no operation."
+)
+
+// document is a parsed query: its operations and the fragments they may spread.
+type document struct {
+	operations []*operation
+	fragments  map[string]*fragment
+}
+
+type operation struct {
+	name       string
+	variables  []variableDefinition
+	selections []selection
+	location   Location
+}
+
+type variableDefinition struct {
+	name     string
+	typ      typeRef
+	value    *value // Default, if any
+	location Location
+}
+
+// typeRef is a type as written in a variable definition, such as [ID!]!.
+type typeRef struct {
+	name    string   // Set for named types
+	elem    *typeRef // Set for list types
+	nonNull bool
+}
+
+func (t typeRef) String() string {
+	s := t.name
+	if t.elem != nil {
+		s = "[" + t.elem.String() + "]"
+	}
+	if t.nonNull {
+		s += "!"
+	}
+	return s
+}
+
+type fragment struct {
+	name          string
+	typeCondition string
+	directives    []directive
+	selections    []selection
+	location      Location
+}
+
+// selection is a field, a fragment spread, or an inline fragment.
+type selection interface{ isSelection() }
+
+type field struct {
+	alias      string
+	name       string
+	arguments  []argument
+	directives []directive
+	selections []selection
+	location   Location
+}
+
+// responseKey is the alias when one is given, the field name otherwise.
+func (f *field) responseKey() string {
+	if f.alias != "" {
+		return f.alias
+	}
+	return f.name
+}
+
+type fragmentSpread struct {
+	name       string
+	directives []directive
+	location   Location
+}
+
+type inlineFragment struct {
+	typeCondition string // Empty applies to any type
+	directives    []directive
+	selections    []selection
+	location      Location
+}
+
+func (*field) isSelection()          {}
+func (*fragmentSpread) isSelection() {}
+func (*inlineFragment) isSelection() {}
+
+type argument struct {
+	name     string
+	value    value
+	location Location
+}
+
+type directive struct {
+	name      string
+	arguments []argument
+	location  Location
+}
+
+type valueKind int
+
+const (
+	valueVariable valueKind = iota
+	valueInt
+	valueFloat
+	valueString
+	valueBoolean
+	valueNull
+	valueEnum
+	valueList
+	valueObject
+)
+
+// value is a literal or variable reference written in the query.
+type value struct {
+	kind     valueKind
+	raw      string // Variable name, number, string, boolean, or enum
+	list     []value
+	fields   []argument // Object fields, in order
+	location Location
+}
+
+// parser is a recursive descent parser for executable documents.
+type parser struct {
+	lexer *lexer
+	token token
+}
+
+// parse reads a whole document; the first syntax error stops parsing.
+func parse(source string) (*document, error) {
+	p := &parser{lexer: newLexer(source)}
+	if err := p.advance(); err != nil {
+		return nil, err
+	}
+
+	doc := &document{fragments: make(map[string]*fragment)}
+	for p.token.kind != tokenEOF {
+		switch {
+		case p.peek("{"), p.token.kind == tokenName && p.token.value == "query":
+			op, err := p.operation()
+			if err != nil {
+				return nil, err
+			}
+			doc.operations = append(doc.operations, op)
+		case p.token.kind == tokenName && (p.token.value == "mutation" || p.token.value == "subscription"):
+			return nil, syntaxError(p.token.location, fmt.Sprintf(MOperationUnsupported, p.token.value))
+		case p.token.kind == tokenName && p.token.value == "fragment":
+			f, err := p.fragment()
+			if err != nil {
+				return nil, err
+			}
+			if _, exists := doc.fragments[f.name]; exists {
+				return nil, validationError(f.location, fmt.Sprintf(MFragmentDuplicate, f.name))
+			}
+			doc.fragments[f.name] = f
+		default:
+			return nil, syntaxError(p.token.location, fmt.Sprintf(MDefinitionUnsupported, p.token))
+		}
+	}
+
+	if len(doc.operations) == 0 {
+		return nil, syntaxError(p.token.location, MDocumentEmpty)
+	}
+
+	return doc, nil
+}
+
+func (p *parser) advance() error {
+	t, err := p.lexer.next()
+	if err != nil {
+		return err
+	}
+	p.token = t
+	return nil
+}
+
+// peek reports whether the current token is the given punctuator.
+func (p *parser) peek(punctuator string) bool {
+	return p.token.kind == tokenPunctuator && p.token.value == punctuator
+}
+
+// skip consumes the punctuator when present.
+func (p *parser) skip(punctuator string) (bool, error) {
+	if !p.peek(punctuator) {
+		return false, nil
+	}
+	return true, p.advance()
+}
+
+func (p *parser) expect(punctuator string) (Location, error) {
+	location := p.token.location
+	if !p.peek(punctuator) {
+		return location, syntaxError(location, fmt.Sprintf(MTokenUnexpected, fmt.Sprintf("%q", punctuator), p.token))
+	}
+	return location, p.advance()
+}
+
+func (p *parser) name() (string, Location, error) {
+	t := p.token
+	if t.kind != tokenName {
+		return "", t.location, syntaxError(t.location, fmt.Sprintf(MTokenUnexpected, "a name", t))
+	}
+	return t.value, t.location, p.advance()
+}
+
+// keyword consumes a specific name, such as "on".
+func (p *parser) keyword(word string) error {
+	name, location, err := p.name()
+	if err != nil {
+		return err
+	}
+	if name != word {
+		return syntaxError(location, fmt.Sprintf(MTokenUnexpected, fmt.Sprintf("%q", word), name))
+	}
+	return nil
+}
+
+func (p *parser) operation() (*operation, error) {
+	op := &operation{location: p.token.location}
+
+	if p.token.kind == tokenName { // "query" keyword, then optional name and variables
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+		if p.token.kind == tokenName {
+			op.name = p.token.value
+			if err := p.advance(); err != nil {
+				return nil, err
+			}
+		}
+		variables, err := p.variableDefinitions()
+		if err != nil {
+			return nil, err
+		}
+		op.variables = variables
+		if _, err := p.directives(); err != nil {
+			return nil, err
+		}
+	}
+
+	selections, err := p.selectionSet()
+	if err != nil {
+		return nil, err
+	}
+	op.selections = selections
+
+	return op, nil
+}
+
+func (p *parser) variableDefinitions() ([]variableDefinition, error) {
+	if open, err := p.skip("("); !open || err != nil {
+		return nil, err
+	}
+
+	var definitions []variableDefinition
+	for !p.peek(")") {
+		location, err := p.expect("$")
+		if err != nil {
+			return nil, err
+		}
+		name, _, err := p.name()
+		if err != nil {
+			return nil, err
+		}
+		if _, err := p.expect(":"); err != nil {
+			return nil, err
+		}
+		typ, err := p.typeRef()
+		if err != nil {
+			return nil, err
+		}
+
+		definition := variableDefinition{name: name, typ: typ, location: location}
+		if hasDefault, err := p.skip("="); err != nil {
+			return nil, err
+		} else if hasDefault {
+			v, err := p.value(true)
+			if err != nil {
+				return nil, err
+			}
+			definition.value = &v
+		}
+		definitions = append(definitions, definition)
+	}
+
+	return definitions, p.advance()
+}
+
+func (p *parser) typeRef() (typeRef, error) {
+	var t typeRef
+
+	if list, err := p.skip("["); err != nil {
+		return t, err
+	} else if list {
+		elem, err := p.typeRef()
+		if err != nil {
+			return t, err
+		}
+		if _, err := p.expect("]"); err != nil {
+			return t, err
+		}
+		t.elem = &elem
+	} else {
+		name, _, err := p.name()
+		if err != nil {
+			return t, err
+		}
+		t.name = name
+	}
+
+	nonNull, err := p.skip("!")
+	t.nonNull = nonNull
+	return t, err
+}
+
+func (p *parser) fragment() (*fragment, error) {
+	f := &fragment{location: p.token.location}
+	if err := p.advance(); err != nil { // "fragment"
+		return nil, err
+	}
+
+	name, location, err := p.name()
+	if err != nil {
+		return nil, err
+	}
+	if name == "on" {
+		return nil, syntaxError(location, fmt.Sprintf(MTokenUnexpected, "a fragment name", name))
+	}
+	f.name = name
+
+	if err := p.keyword("on"); err != nil {
+		return nil, err
+	}
+	if f.typeCondition, _, err = p.name(); err != nil {
+		return nil, err
+	}
+	if f.directives, err = p.directives(); err != nil {
+		return nil, err
+	}
+	if f.selections, err = p.selectionSet(); err != nil {
+		return nil, err
+	}
+
+	return f, nil
+}
+
+func (p *parser) selectionSet() ([]selection, error) {
+	if _, err := p.expect("{"); err != nil {
+		return nil, err
+	}
+
+	var selections []selection
+	for {
+		s, err := p.selection()
+		if err != nil {
+			return nil, err
+		}
+		selections = append(selections, s)
+
+		if closed, err := p.skip("}"); err != nil {
+			return nil, err
+		} else if closed {
+			return selections, nil
+		}
+	}
+}
+
+func (p *parser) selection() (selection, error) {
+	if !p.peek("...") {
+		return p.field()
+	}
+
+	location := p.token.location
+	if err := p.advance(); err != nil {
+		return nil, err
+	}
+
+	if p.token.kind == tokenName && p.token.value != "on" {
+		spread := &fragmentSpread{name: p.token.value, location: location}
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+		directives, err := p.directives()
+		spread.directives = directives
+		return spread, err
+	}
+
+	inline := &inlineFragment{location: location}
+	if p.token.kind == tokenName { // "on"
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+		name, _, err := p.name()
+		if err != nil {
+			return nil, err
+		}
+		inline.typeCondition = name
+	}
+
+	var err error
+	if inline.directives, err = p.directives(); err != nil {
+		return nil, err
+	}
+	if inline.selections, err = p.selectionSet(); err != nil {
+		return nil, err
+	}
+
+	return inline, nil
+}
+
+func (p *parser) field() (*field, error) {
+	name, location, err := p.name()
+	if err != nil {
+		return nil, err
+	}
+	f := &field{name: name, location: location}
+
+	if aliased, err := p.skip(":"); err != nil {
+		return nil, err
+	} else if aliased {
+		f.alias = name
+		if f.name, _, err = p.name(); err != nil {
+			return nil, err
+		}
+	}
+
+	if f.arguments, err = p.arguments(false); err != nil {
+		return nil, err
+	}
+	if f.directives, err = p.directives(); err != nil {
+		return nil, err
+	}
+	if p.peek("{") {
+		if f.selections, err = p.selectionSet(); err != nil {
+			return nil, err
+		}
+	}
+
+	return f, nil
+}
+
+func (p *parser) arguments(constant bool) ([]argument, error) {
+	if open, err := p.skip("("); !open || err != nil {
+		return nil, err
+	}
+
+	var arguments []argument
+	for !p.peek(")") {
+		name, location, err := p.name()
+		if err != nil {
+			return nil, err
+		}
+		if _, err := p.expect(":"); err != nil {
+			return nil, err
+		}
+		v, err := p.value(constant)
+		if err != nil {
+			return nil, err
+		}
+		arguments = append(arguments, argument{name: name, value: v, location: location})
+	}
+
+	return arguments, p.advance()
+}
+
+func (p *parser) directives() ([]directive, error) {
+	var directives []directive
+	for p.peek("@") {
+		location := p.token.location
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+		name, _, err := p.name()
+		if err != nil {
+			return nil, err
+		}
+		arguments, err := p.arguments(false)
+		if err != nil {
+			return nil, err
+		}
+		directives = append(directives, directive{name: name, arguments: arguments, location: location})
+	}
+	return directives, nil
+}
+
+// value parses a literal; constant values (variable defaults) may not reference variables.
+func (p *parser) value(constant bool) (value, error) {
+	t := p.token
+	v := value{raw: t.value, location: t.location}
+
+	switch {
+	case t.kind == tokenPunctuator && t.value == "$" && !constant:
+		if err := p.advance(); err != nil {
+			return v, err
+		}
+		name, _, err := p.name()
+		v.kind, v.raw = valueVariable, name
+		return v, err
+	case t.kind == tokenPunctuator && t.value == "[":
+		v.kind = valueList
+		if err := p.advance(); err != nil {
+			return v, err
+		}
+		for !p.peek("]") {
+			item, err := p.value(constant)
+			if err != nil {
+				return v, err
+			}
+			v.list = append(v.list, item)
+		}
+		return v, p.advance()
+	case t.kind == tokenPunctuator && t.value == "{":
+		v.kind = valueObject
+		if err := p.advance(); err != nil {
+			return v, err
+		}
+		for !p.peek("}") {
+			name, location, err := p.name()
+			if err != nil {
+				return v, err
+			}
+			if _, err := p.expect(":"); err != nil {
+				return v, err
+			}
+			item, err := p.value(constant)
+			if err != nil {
+				return v, err
+			}
+			v.fields = append(v.fields, argument{name: name, value: item, location: location})
+		}
+		return v, p.advance()
+	case t.kind == tokenInt:
+		v.kind = valueInt
+	case t.kind == tokenFloat:
+		v.kind = valueFloat
+	case t.kind == tokenString:
+		v.kind = valueString
+	case t.kind == tokenName && (t.value == "true" || t.value == "false"):
+		v.kind = valueBoolean
+	case t.kind == tokenName && t.value == "null":
+		v.kind = valueNull
+	case t.kind == tokenName:
+		v.kind = valueEnum
+	default:
+		return v, syntaxError(t.location, fmt.Sprintf(MTokenUnexpected, "a value", t))
+	}
+
+	return v, p.advance()
+}
@@ -0,0 +1,106 @@
+package graphql
+
+import (
+	"github.com/alnah/fla/internal/domain/kernel"
+)
+
+// Codes sent in the "code" error extension, following common GraphQL server conventions
+// so off-the-shelf clients can branch on them.
+const (
+	CodeParseFailed      = "GRAPHQL_PARSE_FAILED"
+	CodeValidationFailed = "GRAPHQL_VALIDATION_FAILED"
+	CodeBadUserInput     = "BAD_USER_INPUT"
+	CodeNotFound         = "NOT_FOUND"
+	CodeForbidden        = "FORBIDDEN"
+	CodeConflict         = "CONFLICT"
+	CodeInternal         = "INTERNAL_SERVER_ERROR"
+)
+
+// Location points at a line and column of the query, both 1-based.
+type Location struct {
+	Line   int `json:"line"`
+	Column int `json:"column"`
+}
+
+// Error is one entry of a response's "errors" list.
+// Path names the field that failed, made of response keys and list indexes.
+type Error struct {
+	Message    string         `json:"message"`
+	Locations  []Location     `json:"locations,omitempty"`
+	Path       []any          `json:"path,omitempty"`
+	Extensions map[string]any `json:"extensions,omitempty"`
+}
+
+func (e *Error) Error() string { return e.Message }
+
+// Code returns the "code" extension, if any.
+func (e *Error) Code() string {
+	code, _ := e.Extensions["code"].(string)
+	return code
+}
+
+// ErrorCode maps a kernel error code to the code extension sent to clients.
+// Anything unclassified is internal.
+func ErrorCode(err error) string {
+	switch kernel.ErrorCode(err) {
+	case kernel.EInvalid:
+		return CodeBadUserInput
+	case kernel.ENotFound:
+		return CodeNotFound
+	case kernel.EForbidden:
+		return CodeForbidden
+	case kernel.EConflict:
+		return CodeConflict
+	default:
+		return CodeInternal
+	}
+}
+
+// fieldError converts a resolver error. Internal failures get the generic
+// kernel.MInternal message so their details never reach clients. Validation
+// errors add the invalid field, the constraint, and its bounds as extensions.
+func fieldError(err error, location Location, path []any) *Error {
+	if e, ok := err.(*Error); ok {
+		e.Locations = []Location{location}
+		e.Path = path
+		return e
+	}
+
+	code := ErrorCode(err)
+	message := kernel.ErrorMessage(err)
+	if code == CodeInternal {
+		message = kernel.MInternal
+	}
+
+	extensions := map[string]any{"code": code}
+	if field := kernel.FieldError(err); field != nil && code != CodeInternal {
+		extensions["field"] = field.Field
+		extensions["constraint"] = field.Constraint.Name
+		for name, n := range field.Constraint.Params {
+			extensions[name] = n
+		}
+	}
+
+	return &Error{
+		Message:    message,
+		Locations:  []Location{location},
+		Path:       path,
+		Extensions: extensions,
+	}
+}
+
+func syntaxError(location Location, message string) *Error {
+	return &Error{
+		Message:    message,
+		Locations:  []Location{location},
+		Extensions: map[string]any{"code": CodeParseFailed},
+	}
+}
+
+func validationError(location Location, message string) *Error {
+	return &Error{
+		Message:    message,
+		Locations:  []Location{location},
+		Extensions: map[string]any{"code": CodeValidationFailed},
+	}
+}
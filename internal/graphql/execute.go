@@ -0,0 +1,587 @@
+package graphql
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+// MaxDepth bounds selection nesting so recursive fields (category children)
+// cannot be used to make one request arbitrarily expensive.
+const MaxDepth = 10
+
+const (
+	MOperationUnknown     string = "Unknown operation %q."
+	MOperationAmbiguous   string = "Operation name is required when the query has several operations."
+	MFieldUnknown         string = "Cannot query field %q on type %q."
+	MSelectionRequired    string = "Field %q of type %s must have a selection of subfields."
+	MSelectionForbidden   string = "Field %q of type %s has no subfields."
+	MArgumentUnknown      string = "Unknown argument %q on field %q."
+	MArgumentDuplicate    string = "Argument %q is given more than once."
+	MArgumentRequired     string = "Argument %q of type %s is required on field %q."
+	MArgumentInvalid      string = "Argument %q has an invalid value: %v"
+	MFragmentUnknown      string = "Unknown fragment %q."
+	MFragmentCycle        string = "Fragment %q spreads itself."
+	MFragmentTypeMismatch string = "Fragment on %s cannot be spread on type %s."
+	MDirectiveUnknown     string = "Unknown directive @%s."
+	MDepthExceeded        string = "Query is nested deeper than %d levels."
+	MVariableUnknown      string = "Variable $%s is not defined."
+	MVariableTypeInvalid  string = "Variable $%s cannot be of type %s."
+	MVariableInvalid      string = "Variable $%s has an invalid value: %v"
+	MNullNonNull          string = "Cannot return null for non-nullable field %s.%s."
+	MSerializeFailed      string = "Field %s.%s returned a value its type cannot represent."
+)
+
+// Request is a GraphQL request as sent over HTTP.
+type Request struct {
+	Query         string         `json:"query"`
+	OperationName string         `json:"operationName,omitempty"`
+	Variables     map[string]any `json:"variables,omitempty"`
+}
+
+// Response is a GraphQL result. Data is nil when the request failed before
+// execution, and also when a non-null root field failed; the "data" key is
+// only written once execution started.
+type Response struct {
+	Data     Map
+	Errors   []*Error
+	executed bool
+}
+
+// MarshalJSON writes the response in the shape clients expect.
+func (r Response) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+
+	if len(r.Errors) > 0 {
+		errors, err := json.Marshal(r.Errors)
+		if err != nil {
+			return nil, err
+		}
+		buf.WriteString(`"errors":`)
+		buf.Write(errors)
+	}
+
+	if r.executed {
+		if len(r.Errors) > 0 {
+			buf.WriteByte(',')
+		}
+		data, err := json.Marshal(r.Data)
+		if err != nil {
+			return nil, err
+		}
+		buf.WriteString(`"data":`)
+		buf.Write(data)
+	}
+
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// Map is a JSON object that keeps the field order of the query.
+type Map []Entry
+
+// Entry is one key of a Map.
+type Entry struct {
+	Key   string
+	Value any
+}
+
+// Get returns the value under key, or nil.
+func (m Map) Get(key string) any {
+	for _, e := range m {
+		if e.Key == key {
+			return e.Value
+		}
+	}
+	return nil
+}
+
+// MarshalJSON writes keys in order; a nil Map is null.
+func (m Map) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return []byte("null"), nil
+	}
+
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, e := range m {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		key, _ := json.Marshal(e.Key)
+		value, err := json.Marshal(e.Value)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// Execute parses, validates, and runs a query for an actor. Failures are
+// reported in the response, never returned: field errors leave the rest of the
+// data intact, and request errors (syntax, validation, variables) leave no data.
+func (s *Schema) Execute(request Request, actor user.PostPermissionChecker) Response {
+	doc, err := parse(request.Query)
+	if err != nil {
+		return Response{Errors: []*Error{err.(*Error)}}
+	}
+
+	op, err := doc.operation(request.OperationName)
+	if err != nil {
+		return Response{Errors: []*Error{err.(*Error)}}
+	}
+
+	e := &executor{schema: s, document: doc, actor: actor}
+	if err := e.coerceVariables(op, request.Variables); err != nil {
+		return Response{Errors: []*Error{err}}
+	}
+
+	if errs := e.validate(op); len(errs) > 0 {
+		return Response{Errors: errs}
+	}
+
+	data, _ := e.selectionSet(s.query, nil, op.selections, nil)
+	return Response{Data: data, Errors: e.errors, executed: true}
+}
+
+// operation picks the operation to run by name, or the only one.
+func (d *document) operation(name string) (*operation, error) {
+	if name == "" {
+		if len(d.operations) > 1 {
+			return nil, validationError(d.operations[1].location, MOperationAmbiguous)
+		}
+		return d.operations[0], nil
+	}
+
+	for _, op := range d.operations {
+		if op.name == name {
+			return op, nil
+		}
+	}
+	return nil, validationError(Location{Line: 1, Column: 1}, fmt.Sprintf(MOperationUnknown, name))
+}
+
+// executor runs one operation; field errors accumulate as it goes.
+type executor struct {
+	schema    *Schema
+	document  *document
+	actor     user.PostPermissionChecker
+	variables map[string]any  // Input form, defaults applied
+	defined   map[string]bool // Variables the operation declares
+	errors    []*Error
+}
+
+// coerceVariables checks provided values against their declared types and
+// keeps them in input form, so each use is coerced with the argument's type.
+func (e *executor) coerceVariables(op *operation, provided map[string]any) *Error {
+	e.variables = make(map[string]any, len(op.variables))
+	e.defined = make(map[string]bool, len(op.variables))
+
+	for _, definition := range op.variables {
+		e.defined[definition.name] = true
+		t, ok := e.schema.inputType(definition.typ)
+		if !ok {
+			return validationError(definition.location, fmt.Sprintf(MVariableTypeInvalid, definition.name, definition.typ))
+		}
+
+		v, given := provided[definition.name]
+		if !given && definition.value != nil {
+			literal, err := e.literal(*definition.value)
+			if err != nil {
+				return validationError(definition.location, fmt.Sprintf(MVariableInvalid, definition.name, err))
+			}
+			v, given = literal, true
+		}
+
+		if _, err := coerceInput(t, v); err != nil {
+			return &Error{
+				Message:    fmt.Sprintf(MVariableInvalid, definition.name, err),
+				Locations:  []Location{definition.location},
+				Extensions: map[string]any{"code": CodeBadUserInput},
+			}
+		}
+		if given {
+			e.variables[definition.name] = v
+		}
+	}
+
+	return nil
+}
+
+// literal converts a query value to input form, substituting variables.
+func (e *executor) literal(v value) (any, error) {
+	switch v.kind {
+	case valueVariable:
+		if !e.defined[v.raw] {
+			return nil, fmt.Errorf(MVariableUnknown, v.raw)
+		}
+		return e.variables[v.raw], nil
+	case valueInt, valueFloat:
+		return json.Number(v.raw), nil
+	case valueString:
+		return v.raw, nil
+	case valueBoolean:
+		return v.raw == "true", nil
+	case valueNull:
+		return nil, nil
+	case valueEnum:
+		return enumLiteral(v.raw), nil
+	case valueList:
+		items := make([]any, len(v.list))
+		for i, item := range v.list {
+			converted, err := e.literal(item)
+			if err != nil {
+				return nil, err
+			}
+			items[i] = converted
+		}
+		return items, nil
+	default:
+		return nil, fmt.Errorf(MInputInvalid, "a scalar, enum, or list", "an object")
+	}
+}
+
+// arguments coerces a field's arguments and applies defaults.
+func (e *executor) arguments(def *Field, f *field) (map[string]any, error) {
+	args := make(map[string]any, len(def.Args))
+
+	for _, a := range f.arguments {
+		i := slices.IndexFunc(def.Args, func(d Argument) bool { return d.Name == a.name })
+		if i < 0 {
+			return nil, validationError(a.location, fmt.Sprintf(MArgumentUnknown, a.name, f.name))
+		}
+		if _, duplicate := args[a.name]; duplicate {
+			return nil, validationError(a.location, fmt.Sprintf(MArgumentDuplicate, a.name))
+		}
+
+		input, err := e.literal(a.value)
+		if err == nil && a.value.kind == valueVariable && input == nil && !e.given(a.value.raw) {
+			continue // Omitted variable: the argument counts as omitted
+		}
+		var coerced any
+		if err == nil {
+			coerced, err = coerceInput(def.Args[i].Type, input)
+		}
+		if err != nil {
+			return nil, &Error{
+				Message:    fmt.Sprintf(MArgumentInvalid, a.name, err),
+				Locations:  []Location{a.location},
+				Extensions: map[string]any{"code": CodeBadUserInput},
+			}
+		}
+		args[a.name] = coerced
+	}
+
+	for _, d := range def.Args {
+		if _, set := args[d.Name]; set {
+			continue
+		}
+		if d.Default != nil {
+			args[d.Name] = d.Default
+		} else if _, required := d.Type.(*NonNull); required {
+			return nil, validationError(f.location, fmt.Sprintf(MArgumentRequired, d.Name, d.Type, f.name))
+		}
+	}
+
+	return args, nil
+}
+
+func (e *executor) given(variable string) bool {
+	_, ok := e.variables[variable]
+	return ok
+}
+
+// validate checks the operation against the schema before anything is resolved.
+func (e *executor) validate(op *operation) []*Error {
+	v := &validator{executor: e, visiting: make(map[string]bool)}
+	v.selections(e.schema.query, op.selections, 1)
+	return v.errors
+}
+
+type validator struct {
+	*executor
+	visiting map[string]bool // Fragments on the current path, to catch cycles
+	errors   []*Error
+}
+
+func (v *validator) fail(err error) {
+	if e, ok := err.(*Error); ok {
+		v.errors = append(v.errors, e)
+	}
+}
+
+func (v *validator) selections(object *Object, selections []selection, depth int) {
+	if depth > MaxDepth {
+		return
+	}
+
+	for _, s := range selections {
+		switch s := s.(type) {
+		case *field:
+			v.field(object, s, depth)
+		case *fragmentSpread:
+			v.directives(s.directives)
+			f, ok := v.document.fragments[s.name]
+			if !ok {
+				v.fail(validationError(s.location, fmt.Sprintf(MFragmentUnknown, s.name)))
+				continue
+			}
+			if v.visiting[s.name] {
+				v.fail(validationError(s.location, fmt.Sprintf(MFragmentCycle, s.name)))
+				continue
+			}
+			if f.typeCondition != object.Name {
+				v.fail(validationError(s.location, fmt.Sprintf(MFragmentTypeMismatch, f.typeCondition, object.Name)))
+				continue
+			}
+			v.directives(f.directives)
+			v.visiting[s.name] = true
+			v.selections(object, f.selections, depth)
+			delete(v.visiting, s.name)
+		case *inlineFragment:
+			v.directives(s.directives)
+			if s.typeCondition != "" && s.typeCondition != object.Name {
+				v.fail(validationError(s.location, fmt.Sprintf(MFragmentTypeMismatch, s.typeCondition, object.Name)))
+				continue
+			}
+			v.selections(object, s.selections, depth)
+		}
+	}
+}
+
+func (v *validator) field(object *Object, f *field, depth int) {
+	v.directives(f.directives)
+
+	if f.name == "__typename" {
+		if len(f.selections) > 0 {
+			v.fail(validationError(f.location, fmt.Sprintf(MSelectionForbidden, f.name, String)))
+		}
+		return
+	}
+
+	def, ok := object.Fields[f.name]
+	if !ok {
+		v.fail(validationError(f.location, fmt.Sprintf(MFieldUnknown, f.name, object.Name)))
+		return
+	}
+
+	if _, err := v.arguments(def, f); err != nil {
+		v.fail(err)
+	}
+
+	child, isObject := namedType(def.Type).(*Object)
+	switch {
+	case isObject && len(f.selections) == 0:
+		v.fail(validationError(f.location, fmt.Sprintf(MSelectionRequired, f.name, def.Type)))
+	case !isObject && len(f.selections) > 0:
+		v.fail(validationError(f.location, fmt.Sprintf(MSelectionForbidden, f.name, def.Type)))
+	case isObject && depth == MaxDepth:
+		v.fail(validationError(f.location, fmt.Sprintf(MDepthExceeded, MaxDepth)))
+	case isObject:
+		v.selections(child, f.selections, depth+1)
+	}
+}
+
+func (v *validator) directives(directives []directive) {
+	for _, d := range directives {
+		if d.name != "skip" && d.name != "include" {
+			v.fail(validationError(d.location, fmt.Sprintf(MDirectiveUnknown, d.name)))
+			continue
+		}
+		if _, err := v.arguments(conditionDirective, &field{name: "@" + d.name, arguments: d.arguments, location: d.location}); err != nil {
+			v.fail(err)
+		}
+	}
+}
+
+// conditionDirective describes the arguments of @skip and @include.
+var conditionDirective = &Field{Args: []Argument{{Name: "if", Type: &NonNull{Of: Boolean}}}}
+
+// included applies @skip and @include; both are validated already.
+func (e *executor) included(directives []directive) bool {
+	for _, d := range directives {
+		args, _ := e.arguments(conditionDirective, &field{arguments: d.arguments})
+		condition, _ := args["if"].(bool)
+		if d.name == "skip" && condition || d.name == "include" && !condition {
+			return false
+		}
+	}
+	return true
+}
+
+// collect gathers the fields of a selection set by response key, in query
+// order, expanding fragments. Fields sharing a key are merged.
+func (e *executor) collect(selections []selection, keys *[]string, fields map[string][]*field) {
+	for _, s := range selections {
+		switch s := s.(type) {
+		case *field:
+			if !e.included(s.directives) {
+				continue
+			}
+			key := s.responseKey()
+			if _, seen := fields[key]; !seen {
+				*keys = append(*keys, key)
+			}
+			fields[key] = append(fields[key], s)
+		case *fragmentSpread:
+			if e.included(s.directives) {
+				f := e.document.fragments[s.name]
+				if e.included(f.directives) {
+					e.collect(f.selections, keys, fields)
+				}
+			}
+		case *inlineFragment:
+			if e.included(s.directives) {
+				e.collect(s.selections, keys, fields)
+			}
+		}
+	}
+}
+
+// selectionSet resolves the fields of one object. ok is false when a
+// non-null field failed, so the object itself must become null.
+func (e *executor) selectionSet(object *Object, source any, selections []selection, path []any) (Map, bool) {
+	var keys []string
+	fields := make(map[string][]*field)
+	e.collect(selections, &keys, fields)
+
+	result := make(Map, 0, len(keys))
+	for _, key := range keys {
+		value, ok := e.field(object, source, fields[key], append(slices.Clip(path), key))
+		if !ok {
+			return nil, false
+		}
+		result = append(result, Entry{Key: key, Value: value})
+	}
+	return result, true
+}
+
+func (e *executor) field(object *Object, source any, fields []*field, path []any) (any, bool) {
+	f := fields[0]
+	if f.name == "__typename" {
+		return object.Name, true
+	}
+
+	def := object.Fields[f.name]
+	args, err := e.arguments(def, f)
+	if err == nil {
+		var resolved any
+		resolved, err = def.Resolve(ResolveParams{Source: source, Args: args, Actor: e.actor})
+		if err == nil {
+			return e.complete(def.Type, object, fields, resolved, path)
+		}
+	}
+
+	e.errors = append(e.errors, fieldError(err, f.location, path))
+	_, nonNull := def.Type.(*NonNull)
+	return nil, !nonNull
+}
+
+// complete turns a resolved value into its response form. Errors stop at the
+// nearest nullable position; ok is false while they must propagate further up.
+func (e *executor) complete(t Type, parent *Object, fields []*field, resolved any, path []any) (any, bool) {
+	nonNull, isNonNull := t.(*NonNull)
+	if !isNonNull {
+		value, ok := e.completeNullable(t, parent, fields, resolved, path)
+		if !ok {
+			return nil, true
+		}
+		return value, true
+	}
+
+	value, ok := e.completeNullable(nonNull.Of, parent, fields, resolved, path)
+	if !ok {
+		return nil, false
+	}
+	if value == nil {
+		e.errors = append(e.errors, &Error{
+			Message:    fmt.Sprintf(MNullNonNull, parent.Name, fields[0].name),
+			Locations:  []Location{fields[0].location},
+			Path:       path,
+			Extensions: map[string]any{"code": CodeInternal},
+		})
+		return nil, false
+	}
+	return value, true
+}
+
+func (e *executor) completeNullable(t Type, parent *Object, fields []*field, resolved any, path []any) (any, bool) {
+	if isNull(resolved) {
+		return nil, true
+	}
+
+	rv := reflect.ValueOf(resolved)
+	if rv.Kind() == reflect.Pointer {
+		resolved = rv.Elem().Interface()
+	}
+
+	switch t := t.(type) {
+	case *List:
+		items := reflect.ValueOf(resolved)
+		if items.Kind() != reflect.Slice {
+			return nil, e.serializeFailed(parent, fields, path)
+		}
+		out := make([]any, items.Len())
+		for i := range items.Len() {
+			value, ok := e.complete(t.Of, parent, fields, items.Index(i).Interface(), append(slices.Clip(path), i))
+			if !ok {
+				return nil, false
+			}
+			out[i] = value
+		}
+		return out, true
+	case *Object:
+		var selections []selection
+		for _, f := range fields {
+			selections = append(selections, f.selections...)
+		}
+		value, ok := e.selectionSet(t, resolved, selections, path)
+		if !ok {
+			return nil, false
+		}
+		return value, true
+	case *Enum:
+		value, err := t.serialize(resolved)
+		if err != nil {
+			return nil, e.serializeFailed(parent, fields, path)
+		}
+		return value, true
+	case *Scalar:
+		value, err := t.Serialize(resolved)
+		if err != nil {
+			return nil, e.serializeFailed(parent, fields, path)
+		}
+		return value, true
+	default:
+		return nil, e.serializeFailed(parent, fields, path)
+	}
+}
+
+// serializeFailed records a resolver returning the wrong kind of value, a server bug.
+func (e *executor) serializeFailed(parent *Object, fields []*field, path []any) bool {
+	e.errors = append(e.errors, &Error{
+		Message:    fmt.Sprintf(MSerializeFailed, parent.Name, fields[0].name),
+		Locations:  []Location{fields[0].location},
+		Path:       path,
+		Extensions: map[string]any{"code": CodeInternal},
+	})
+	return false
+}
+
+// isNull treats nil interfaces and nil pointers as null; nil slices are empty lists.
+func isNull(v any) bool {
+	if v == nil {
+		return true
+	}
+	rv := reflect.ValueOf(v)
+	return rv.Kind() == reflect.Pointer && rv.IsNil()
+}
@@ -0,0 +1,314 @@
+package graphql_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/graphql"
+)
+
+func TestExecute_RequestErrors(t *testing.T) {
+	schema := blogFixture()
+
+	tests := []struct {
+		name    string
+		request graphql.Request
+		code    string
+		message string
+	}{
+		{
+			name:    "syntax error",
+			request: graphql.Request{Query: "{ post(slug: \"a\" { title } }"},
+			code:    graphql.CodeParseFailed,
+			message: `Expected a name, found {.`,
+		},
+		{
+			name:    "unterminated string",
+			request: graphql.Request{Query: `{ post(slug: "a) { title } }`},
+			code:    graphql.CodeParseFailed,
+			message: graphql.MStringUnterminated,
+		},
+		{
+			name:    "mutation",
+			request: graphql.Request{Query: `mutation { deletePost }`},
+			code:    graphql.CodeParseFailed,
+			message: `Unsupported operation "mutation"; only queries are served.`,
+		},
+		{
+			name:    "unknown field",
+			request: graphql.Request{Query: `{ post(slug: "a") { password } }`},
+			code:    graphql.CodeValidationFailed,
+			message: `Cannot query field "password" on type "Post".`,
+		},
+		{
+			name:    "missing subselection",
+			request: graphql.Request{Query: `{ categories }`},
+			code:    graphql.CodeValidationFailed,
+			message: `Field "categories" of type [Category!]! must have a selection of subfields.`,
+		},
+		{
+			name:    "subselection on a scalar",
+			request: graphql.Request{Query: `{ tags { name { length } } }`},
+			code:    graphql.CodeValidationFailed,
+			message: `Field "name" of type String! has no subfields.`,
+		},
+		{
+			name:    "unknown argument",
+			request: graphql.Request{Query: `{ tags(first: 2) { name } }`},
+			code:    graphql.CodeValidationFailed,
+			message: `Unknown argument "first" on field "tags".`,
+		},
+		{
+			name:    "missing required argument",
+			request: graphql.Request{Query: `{ user { username } }`},
+			code:    graphql.CodeValidationFailed,
+			message: `Argument "id" of type ID! is required on field "user".`,
+		},
+		{
+			name:    "invalid enum literal",
+			request: graphql.Request{Query: `{ posts(status: [LIVE]) { nodes { slug } } }`},
+			code:    graphql.CodeBadUserInput,
+			message: `Argument "status" has an invalid value: Expected type PostStatus, found LIVE.`,
+		},
+		{
+			name:    "missing required variable",
+			request: graphql.Request{Query: `query Post($slug: String!) { post(slug: $slug) { title } }`},
+			code:    graphql.CodeBadUserInput,
+			message: `Variable $slug has an invalid value: Expected type String!, found null.`,
+		},
+		{
+			name:    "variable of an object type",
+			request: graphql.Request{Query: `query ($p: Post) { tags { name } }`},
+			code:    graphql.CodeValidationFailed,
+			message: `Variable $p cannot be of type Post.`,
+		},
+		{
+			name:    "unknown fragment",
+			request: graphql.Request{Query: `{ tags { ...TagFields } }`},
+			code:    graphql.CodeValidationFailed,
+			message: `Unknown fragment "TagFields".`,
+		},
+		{
+			name:    "fragment on the wrong type",
+			request: graphql.Request{Query: `{ tags { ...PostFields } } fragment PostFields on Post { title }`},
+			code:    graphql.CodeValidationFailed,
+			message: `Fragment on Post cannot be spread on type Tag.`,
+		},
+		{
+			name:    "fragment cycle",
+			request: graphql.Request{Query: `{ tags { ...A } } fragment A on Tag { name ...A }`},
+			code:    graphql.CodeValidationFailed,
+			message: `Fragment "A" spreads itself.`,
+		},
+		{
+			name:    "unknown directive",
+			request: graphql.Request{Query: `{ tags @cached { name } }`},
+			code:    graphql.CodeValidationFailed,
+			message: `Unknown directive @cached.`,
+		},
+		{
+			name:    "several operations without a name",
+			request: graphql.Request{Query: `query A { tags { name } } query B { tags { slug } }`},
+			code:    graphql.CodeValidationFailed,
+			message: graphql.MOperationAmbiguous,
+		},
+		{
+			name:    "unknown operation name",
+			request: graphql.Request{Query: `query A { tags { name } }`, OperationName: "B"},
+			code:    graphql.CodeValidationFailed,
+			message: `Unknown operation "B".`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			response := schema.Execute(tt.request, visitor)
+
+			assertErrorCodes(t, response, tt.code)
+			if got := response.Errors[0].Message; got != tt.message {
+				t.Errorf("message: got %q, want %q", got, tt.message)
+			}
+			if response.Data != nil {
+				t.Errorf("expected no data, got %v", response.Data)
+			}
+		})
+	}
+}
+
+func TestExecute_ErrorsOmitData(t *testing.T) {
+	response := blogFixture().Execute(graphql.Request{Query: "{\n  tags { nam }\n}"}, visitor)
+
+	assertJSON(t, response, `{"errors": [{
+		"message": "Cannot query field \"nam\" on type \"Tag\".",
+		"locations": [{"line": 2, "column": 10}],
+		"extensions": {"code": "GRAPHQL_VALIDATION_FAILED"}
+	}]}`)
+}
+
+func TestExecute_Selections(t *testing.T) {
+	schema := blogFixture()
+
+	t.Run("applies aliases, fragments, __typename, and directives in query order", func(t *testing.T) {
+		response := schema.Execute(graphql.Request{
+			Query: `
+				query Tags($withSlug: Boolean = false, $hideName: Boolean!) {
+					first: tag(id: "football") { ...TagName __typename }
+					second: tag(id: "grammaire") {
+						... on Tag { slug @include(if: $withSlug) }
+						... @skip(if: $hideName) { name }
+						name # Merged with the skipped selection
+					}
+				}
+
+				fragment TagName on Tag { name }
+			`,
+			OperationName: "Tags",
+			Variables:     map[string]any{"hideName": true},
+		}, visitor)
+
+		assertErrorCodes(t, response)
+		assertJSON(t, response.Data, `{
+			"first": {"name": "Football", "__typename": "Tag"},
+			"second": {"name": "Grammaire"}
+		}`)
+	})
+
+	t.Run("coerces JSON variables, including integer IDs and enum names", func(t *testing.T) {
+		response := schema.Execute(graphql.Request{
+			Query: `query ($status: [PostStatus!], $limit: Int, $page: Int) {
+				posts(status: $status, limit: $limit, page: $page) { pageInfo { page limit } }
+			}`,
+			Variables: map[string]any{"status": "PUBLISHED", "limit": float64(3)},
+		}, visitor)
+
+		assertErrorCodes(t, response)
+		assertJSON(t, response.Data, `{"posts": {"pageInfo": {"page": 1, "limit": 3}}}`)
+	})
+
+	t.Run("rejects selections nested beyond the depth limit", func(t *testing.T) {
+		query := "{ categories " + strings.Repeat("{ children ", graphql.MaxDepth) +
+			"{ slug }" + strings.Repeat(" }", graphql.MaxDepth) + " }"
+
+		response := schema.Execute(graphql.Request{Query: query}, visitor)
+
+		assertErrorCodes(t, response, graphql.CodeValidationFailed)
+	})
+}
+
+func TestExecute_NullPropagation(t *testing.T) {
+	failing := func(graphql.ResolveParams) (any, error) {
+		return nil, &kernel.Error{Code: kernel.EInternal, Message: "database password is hunter2"}
+	}
+	ok := func(graphql.ResolveParams) (any, error) { return "ok", nil }
+
+	inner := &graphql.Object{Name: "Inner", Fields: map[string]*graphql.Field{
+		"required": {Type: &graphql.NonNull{Of: graphql.String}, Resolve: failing},
+		"missing":  {Type: &graphql.NonNull{Of: graphql.String}, Resolve: func(graphql.ResolveParams) (any, error) { return nil, nil }},
+		"optional": {Type: graphql.String, Resolve: failing},
+		"fine":     {Type: graphql.String, Resolve: ok},
+		"wrong":    {Type: graphql.Int, Resolve: ok},
+	}}
+	items := func(graphql.ResolveParams) (any, error) { return []string{"a", "b"}, nil }
+	schema := graphql.NewSchema(&graphql.Object{Name: "Query", Fields: map[string]*graphql.Field{
+		"inner":    {Type: inner, Resolve: ok},
+		"list":     {Type: &graphql.List{Of: &graphql.NonNull{Of: inner}}, Resolve: items},
+		"fine":     {Type: graphql.String, Resolve: ok},
+		"required": {Type: &graphql.NonNull{Of: inner}, Resolve: ok},
+	}})
+
+	tests := []struct {
+		name  string
+		query string
+		data  string
+		codes []string
+	}{
+		{
+			name:  "nullable field error stays at the field and hides internals",
+			query: `{ inner { optional fine } }`,
+			data:  `{"inner": {"optional": null, "fine": "ok"}}`,
+			codes: []string{graphql.CodeInternal},
+		},
+		{
+			name:  "non-null field error nulls the parent",
+			query: `{ inner { fine required } fine }`,
+			data:  `{"inner": null, "fine": "ok"}`,
+			codes: []string{graphql.CodeInternal},
+		},
+		{
+			name:  "null for a non-null field is an error",
+			query: `{ inner { missing } }`,
+			data:  `{"inner": null}`,
+			codes: []string{graphql.CodeInternal},
+		},
+		{
+			name:  "non-null list item error nulls the list",
+			query: `{ list { required } fine }`,
+			data:  `{"list": null, "fine": "ok"}`,
+			codes: []string{graphql.CodeInternal},
+		},
+		{
+			name:  "error under a non-null root field nulls the data",
+			query: `{ fine required { required } }`,
+			data:  `null`,
+			codes: []string{graphql.CodeInternal},
+		},
+		{
+			name:  "value of the wrong type is an error",
+			query: `{ inner { wrong } }`,
+			data:  `{"inner": {"wrong": null}}`,
+			codes: []string{graphql.CodeInternal},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			response := schema.Execute(graphql.Request{Query: tt.query}, visitor)
+
+			assertJSON(t, response.Data, tt.data)
+			assertErrorCodes(t, response, tt.codes...)
+			for _, e := range response.Errors {
+				if strings.Contains(e.Message, "hunter2") {
+					t.Errorf("internal error details leaked: %q", e.Message)
+				}
+			}
+		})
+	}
+
+	t.Run("error path includes list indexes", func(t *testing.T) {
+		response := schema.Execute(graphql.Request{Query: `{ list { fine optional } }`}, visitor)
+
+		assertJSON(t, response.Errors[1].Path, `["list", 1, "optional"]`)
+		assertJSON(t, response, `{
+			"errors": [
+				{"message": "`+kernel.MInternal+`", "locations": [{"line": 1, "column": 15}], "path": ["list", 0, "optional"], "extensions": {"code": "INTERNAL_SERVER_ERROR"}},
+				{"message": "`+kernel.MInternal+`", "locations": [{"line": 1, "column": 15}], "path": ["list", 1, "optional"], "extensions": {"code": "INTERNAL_SERVER_ERROR"}}
+			],
+			"data": {"list": [{"fine": "ok", "optional": null}, {"fine": "ok", "optional": null}]}
+		}`)
+	})
+}
+
+func TestExecute_FieldErrorExtensions(t *testing.T) {
+	schema := graphql.NewSchema(&graphql.Object{Name: "Query", Fields: map[string]*graphql.Field{
+		"title": {Type: graphql.String, Resolve: func(graphql.ResolveParams) (any, error) {
+			return nil, &kernel.Error{Operation: "NewTitle", Cause: kernel.ValidateMinLength("title", "short", 10, "Title.Validate")}
+		}},
+	}})
+
+	response := schema.Execute(graphql.Request{Query: `{ title }`}, visitor)
+
+	assertJSON(t, response.Errors[0].Extensions, `{"code": "BAD_USER_INPUT", "constraint": "min_length", "field": "title", "min": 10}`)
+}
+
+func TestNewSchema_PanicsOnMissingResolver(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("expected panic")
+		}
+	}()
+
+	graphql.NewSchema(&graphql.Object{Name: "Query", Fields: map[string]*graphql.Field{
+		"broken": {Type: graphql.String},
+	}})
+}
@@ -0,0 +1,72 @@
+package graphql
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+const (
+	MRequestUnreadable string = "Request body must be a JSON object with a query."
+	MMethodUnsupported string = "Use GET or POST."
+)
+
+// MaxRequestBytes bounds request bodies; queries are small.
+const MaxRequestBytes = 1 << 20
+
+// ActorFunc identifies who sends a request, such as from a session cookie.
+// It must return a visitor rather than nil for anonymous requests.
+type ActorFunc func(r *http.Request) user.PostPermissionChecker
+
+// Handler serves a schema over HTTP: POST with a JSON body, or GET with
+// query, operationName, and variables parameters.
+type Handler struct {
+	schema *Schema
+	actor  ActorFunc
+}
+
+// NewHandler creates a handler executing requests on behalf of the actor.
+func NewHandler(schema *Schema, actor ActorFunc) *Handler {
+	return &Handler{schema: schema, actor: actor}
+}
+
+// ServeHTTP answers 200 with the result, even with field errors, as clients
+// read the errors list; only unreadable requests get a 4xx status.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var request Request
+
+	switch r.Method {
+	case http.MethodGet:
+		request.Query = r.URL.Query().Get("query")
+		request.OperationName = r.URL.Query().Get("operationName")
+		if variables := r.URL.Query().Get("variables"); variables != "" {
+			if err := json.Unmarshal([]byte(variables), &request.Variables); err != nil {
+				writeResponse(w, http.StatusBadRequest, requestError(MRequestUnreadable))
+				return
+			}
+		}
+	case http.MethodPost:
+		decoder := json.NewDecoder(http.MaxBytesReader(w, r.Body, MaxRequestBytes))
+		if err := decoder.Decode(&request); err != nil {
+			writeResponse(w, http.StatusBadRequest, requestError(MRequestUnreadable))
+			return
+		}
+	default:
+		w.Header().Set("Allow", "GET, POST")
+		writeResponse(w, http.StatusMethodNotAllowed, requestError(MMethodUnsupported))
+		return
+	}
+
+	writeResponse(w, http.StatusOK, h.schema.Execute(request, h.actor(r)))
+}
+
+func requestError(message string) Response {
+	return Response{Errors: []*Error{{Message: message, Extensions: map[string]any{"code": CodeBadUserInput}}}}
+}
+
+func writeResponse(w http.ResponseWriter, status int, response Response) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(response)
+}
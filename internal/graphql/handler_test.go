@@ -0,0 +1,90 @@
+package graphql_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/alnah/fla/internal/domain/user"
+	"github.com/alnah/fla/internal/graphql"
+)
+
+func TestHandler(t *testing.T) {
+	handler := graphql.NewHandler(blogFixture(), func(r *http.Request) user.PostPermissionChecker {
+		if r.Header.Get("X-User") == "author-1" {
+			return author
+		}
+		return visitor
+	})
+
+	tests := []struct {
+		name    string
+		request func() *http.Request
+		status  int
+		body    string
+	}{
+		{
+			name: "POST with variables on behalf of the actor",
+			request: func() *http.Request {
+				r := httptest.NewRequest(http.MethodPost, "/graphql",
+					strings.NewReader(`{"query": "query ($id: ID!) { post(id: $id) { title } }", "variables": {"id": "post-2"}}`))
+				r.Header.Set("X-User", "author-1")
+				return r
+			},
+			status: http.StatusOK,
+			body:   `{"data":{"post":{"title":"Brouillon sur le tennis"}}}`,
+		},
+		{
+			name: "GET with query parameters",
+			request: func() *http.Request {
+				q := url.Values{"query": {`query ($slug: String) { tag(slug: $slug) { name } }`}, "variables": {`{"slug": "football"}`}}
+				return httptest.NewRequest(http.MethodGet, "/graphql?"+q.Encode(), nil)
+			},
+			status: http.StatusOK,
+			body:   `{"data":{"tag":{"name":"Football"}}}`,
+		},
+		{
+			name: "field errors still answer 200",
+			request: func() *http.Request {
+				return httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(`{"query": "{ post(id: \"post-2\") { title } }"}`))
+			},
+			status: http.StatusOK,
+			body:   `{"errors":[{"message":"Post not found.","locations":[{"line":1,"column":3}],"path":["post"],"extensions":{"code":"NOT_FOUND"}}],"data":{"post":null}}`,
+		},
+		{
+			name: "unreadable body",
+			request: func() *http.Request {
+				return httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(`query { tags }`))
+			},
+			status: http.StatusBadRequest,
+			body:   `{"errors":[{"message":"Request body must be a JSON object with a query.","extensions":{"code":"BAD_USER_INPUT"}}]}`,
+		},
+		{
+			name: "unsupported method",
+			request: func() *http.Request {
+				return httptest.NewRequest(http.MethodDelete, "/graphql", nil)
+			},
+			status: http.StatusMethodNotAllowed,
+			body:   `{"errors":[{"message":"Use GET or POST.","extensions":{"code":"BAD_USER_INPUT"}}]}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, tt.request())
+
+			if recorder.Code != tt.status {
+				t.Errorf("status: got %d, want %d", recorder.Code, tt.status)
+			}
+			if got := strings.TrimSpace(recorder.Body.String()); got != tt.body {
+				t.Errorf("body:\ngot:  %s\nwant: %s", got, tt.body)
+			}
+			if got := recorder.Header().Get("Content-Type"); got != "application/json; charset=utf-8" {
+				t.Errorf("content type: got %q", got)
+			}
+		})
+	}
+}
@@ -0,0 +1,260 @@
+package graphql_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/category"
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/policy"
+	"github.com/alnah/fla/internal/domain/post"
+	"github.com/alnah/fla/internal/domain/shared"
+	"github.com/alnah/fla/internal/domain/tag"
+	"github.com/alnah/fla/internal/domain/user"
+	"github.com/alnah/fla/internal/graphql"
+)
+
+var fixtureTime = time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+
+type stubPosts struct {
+	posts      []post.Post
+	categories stubCategories
+}
+
+func (s *stubPosts) GetByID(id kernel.ID[post.Post]) (*post.Post, error) {
+	for _, p := range s.posts {
+		if p.PostID == id {
+			return &p, nil
+		}
+	}
+	return nil, &kernel.Error{Code: kernel.ENotFound, Message: "post not found"}
+}
+
+func (s *stubPosts) GetBySlug(slug shared.Slug) (*post.Post, error) {
+	for _, p := range s.posts {
+		if p.Slug == slug {
+			return &p, nil
+		}
+	}
+	return nil, &kernel.Error{Code: kernel.ENotFound, Message: "post not found"}
+}
+
+// Find answers queries in memory using Query.Matches and Query.Compare.
+func (s *stubPosts) Find(q post.Query) (post.PostsList, error) {
+	var matched []post.Post
+	for _, p := range s.posts {
+		path, _ := s.categories.BuildPath(p.Category.CategoryID)
+		if q.Matches(p, path) {
+			matched = append(matched, p)
+		}
+	}
+	slices.SortFunc(matched, q.Compare)
+
+	pagination, _ := shared.NewPagination(q.Pagination.Page, q.Pagination.Limit, len(matched))
+	start := min(pagination.Offset(), len(matched))
+	end := min(start+pagination.Limit, len(matched))
+	return post.NewPostsList(matched[start:end], pagination), nil
+}
+
+type stubCategories []category.Category
+
+func (s stubCategories) GetByID(id kernel.ID[category.Category]) (*category.Category, error) {
+	for _, c := range s {
+		if c.CategoryID == id {
+			return &c, nil
+		}
+	}
+	return nil, &kernel.Error{Code: kernel.ENotFound, Message: "category not found"}
+}
+
+func (s stubCategories) GetAll() ([]category.Category, error) { return s, nil }
+
+func (s stubCategories) GetChildren(id kernel.ID[category.Category]) ([]category.Category, error) {
+	var children []category.Category
+	for _, c := range s {
+		if c.ParentID != nil && *c.ParentID == id {
+			children = append(children, c)
+		}
+	}
+	return children, nil
+}
+
+func (s stubCategories) GetRootCategories() ([]category.Category, error) {
+	var roots []category.Category
+	for _, c := range s {
+		if c.IsRoot() {
+			roots = append(roots, c)
+		}
+	}
+	return roots, nil
+}
+
+func (s stubCategories) BuildPath(id kernel.ID[category.Category]) (category.CategoryPath, error) {
+	var path category.CategoryPath
+	for {
+		c, err := s.GetByID(id)
+		if err != nil {
+			return nil, err
+		}
+		path = append(category.CategoryPath{*c}, path...)
+		if c.ParentID == nil {
+			return path, nil
+		}
+		id = *c.ParentID
+	}
+}
+
+func (s stubCategories) FindByPath(segments []string) (*category.Category, error) {
+	for _, c := range s {
+		path, _ := s.BuildPath(c.CategoryID)
+		if path.String() == strings.Join(segments, "/") {
+			return &c, nil
+		}
+	}
+	return nil, &kernel.Error{Code: kernel.ENotFound, Message: "category not found"}
+}
+
+type stubTags []tag.Tag
+
+func (s stubTags) GetByID(id kernel.ID[tag.Tag]) (*tag.Tag, error) {
+	for _, t := range s {
+		if t.TagID == id {
+			return &t, nil
+		}
+	}
+	return nil, &kernel.Error{Code: kernel.ENotFound, Message: "tag not found"}
+}
+
+func (s stubTags) GetBySlug(slug shared.Slug) (*tag.Tag, error) {
+	for _, t := range s {
+		if t.Slug == slug {
+			return &t, nil
+		}
+	}
+	return nil, &kernel.Error{Code: kernel.ENotFound, Message: "tag not found"}
+}
+
+func (s stubTags) GetAll() ([]tag.Tag, error) { return s, nil }
+
+type stubUsers []user.User
+
+func (s stubUsers) GetUserByID(id kernel.ID[user.User]) (*user.User, error) {
+	for _, u := range s {
+		if u.ID == id {
+			return &u, nil
+		}
+	}
+	return nil, &kernel.Error{Code: kernel.ENotFound, Message: "user not found"}
+}
+
+func account(id string, roles ...user.Role) user.User {
+	return user.User{
+		ID:               kernel.ID[user.User](id),
+		Username:         shared.Username(id),
+		Email:            shared.Email(id + "@example.com"),
+		Roles:            roles,
+		LocalePreference: shared.DefaultLocale,
+		Status:           user.AccountStatusActive,
+		Scopes:           []string{policy.AllScopes},
+		CreatedAt:        fixtureTime,
+	}
+}
+
+var (
+	admin      = account("admin-1", user.RoleAdmin)
+	editor     = account("editor-1", user.RoleEditor)
+	author     = account("author-1", user.RoleAuthor)
+	rival      = account("author-2", user.RoleAuthor)
+	subscriber = account("subscriber-1", user.RoleSubscriber)
+	visitor    = user.User{Roles: []user.Role{user.RoleVisitor}, Status: user.AccountStatusActive}
+)
+
+// blogFixture is A1 > Compréhension écrite > Sports, one published post in
+// Sports, and one draft by the same author.
+func blogFixture() *graphql.Schema {
+	a1 := category.Category{CategoryID: "a1", Name: "A1", Slug: "a1", CreatedBy: admin.ID, CreatedAt: fixtureTime}
+	reading := category.Category{
+		CategoryID: "a1-reading", Name: "Compréhension écrite", Slug: "comprehension-ecrite",
+		ParentID: &a1.CategoryID, CreatedBy: admin.ID, CreatedAt: fixtureTime,
+	}
+	sports := category.Category{
+		CategoryID: "a1-sports", Name: "Sports", Slug: "sports", Description: "Lire le sport.",
+		ParentID: &reading.CategoryID, CreatedBy: admin.ID, CreatedAt: fixtureTime,
+	}
+	categories := stubCategories{a1, reading, sports}
+
+	tags := stubTags{
+		{TagID: "grammaire", Name: "Grammaire", Slug: "grammaire", CreatedBy: admin.ID, CreatedAt: fixtureTime},
+		{TagID: "football", Name: "Football", Slug: "football", CreatedBy: admin.ID, CreatedAt: fixtureTime},
+	}
+
+	publishedAt := fixtureTime.Add(24 * time.Hour)
+	approvedAt := fixtureTime.Add(12 * time.Hour)
+	posts := &stubPosts{categories: categories, posts: []post.Post{
+		{
+			PostID:      "post-1",
+			Owner:       author.ID,
+			Title:       "Jouer au football",
+			Content:     post.PostContent(strings.Repeat("Le football se joue à onze. ", 20)),
+			Excerpt:     "Un match commenté.",
+			Status:      post.StatusPublished,
+			Slug:        "jouer-au-football",
+			PublishedAt: &publishedAt,
+			ApprovedBy:  &editor.ID,
+			ApprovedAt:  &approvedAt,
+			CreatedAt:   fixtureTime,
+			UpdatedAt:   fixtureTime,
+			Category:    sports,
+			Tags:        post.PostTags{"football", "grammaire"},
+		},
+		{
+			PostID:    "post-2",
+			Owner:     author.ID,
+			Title:     "Brouillon sur le tennis",
+			Content:   post.PostContent(strings.Repeat("Le tennis se joue à deux. ", 20)),
+			Status:    post.StatusDraft,
+			Slug:      "brouillon-sur-le-tennis",
+			CreatedAt: fixtureTime,
+			UpdatedAt: fixtureTime,
+			Category:  sports,
+		},
+	}}
+
+	return graphql.NewBlogSchema(posts, categories, tags, stubUsers{admin, editor, author, rival, subscriber})
+}
+
+// assertJSON compares the JSON encoding of got with want, ignoring want's indentation.
+func assertJSON(t *testing.T, got any, want string) {
+	t.Helper()
+
+	data, err := json.Marshal(got)
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+
+	var compact bytes.Buffer
+	if err := json.Compact(&compact, []byte(want)); err != nil {
+		t.Fatalf("invalid expected JSON: %v", err)
+	}
+
+	if string(data) != compact.String() {
+		t.Errorf("JSON mismatch:\ngot:  %s\nwant: %s", data, compact.String())
+	}
+}
+
+// assertErrorCodes checks the code extension of every response error, in order.
+func assertErrorCodes(t *testing.T, response graphql.Response, want ...string) {
+	t.Helper()
+
+	var got []string
+	for _, e := range response.Errors {
+		got = append(got, e.Code())
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("error codes: got %v, want %v (errors: %v)", got, want, response.Errors)
+	}
+}
@@ -0,0 +1,185 @@
+package graphql
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	MCharacterUnexpected string = "Unexpected character %q."
+	MNumberInvalid       string = "Invalid number %s."
+	MStringUnterminated  string = "Unterminated string."
+	MStringEscapeInvalid string = "Invalid escape sequence in string."
+)
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenPunctuator
+	tokenName
+	tokenInt
+	tokenFloat
+	tokenString
+)
+
+// token is one lexical unit of a query, with where it starts.
+type token struct {
+	kind     tokenKind
+	value    string // Punctuator text, name, number literal, or decoded string
+	location Location
+}
+
+func (t token) String() string {
+	switch t.kind {
+	case tokenEOF:
+		return "<EOF>"
+	case tokenString:
+		return strconv.Quote(t.value)
+	default:
+		return t.value
+	}
+}
+
+// lexer splits a query into tokens. Block strings are not supported.
+type lexer struct {
+	source string
+	pos    int
+	line   int
+	column int
+}
+
+func newLexer(source string) *lexer {
+	return &lexer{source: strings.TrimPrefix(source, "\ufeff"), line: 1, column: 1}
+}
+
+func (l *lexer) advance(n int) {
+	for range n {
+		if l.source[l.pos] == '\n' {
+			l.line++
+			l.column = 1
+		} else {
+			l.column++
+		}
+		l.pos++
+	}
+}
+
+// next returns the following token; commas are insignificant like whitespace.
+func (l *lexer) next() (token, error) {
+	l.skipIgnored()
+
+	start := Location{Line: l.line, Column: l.column}
+	if l.pos >= len(l.source) {
+		return token{kind: tokenEOF, location: start}, nil
+	}
+
+	c := l.source[l.pos]
+	switch {
+	case strings.HasPrefix(l.source[l.pos:], "..."):
+		l.advance(3)
+		return token{kind: tokenPunctuator, value: "...", location: start}, nil
+	case strings.IndexByte("!$():=@[]{}|", c) >= 0:
+		l.advance(1)
+		return token{kind: tokenPunctuator, value: string(c), location: start}, nil
+	case c == '_' || isLetter(c):
+		end := l.pos
+		for end < len(l.source) && (l.source[end] == '_' || isLetter(l.source[end]) || isDigit(l.source[end])) {
+			end++
+		}
+		value := l.source[l.pos:end]
+		l.advance(end - l.pos)
+		return token{kind: tokenName, value: value, location: start}, nil
+	case c == '-' || isDigit(c):
+		return l.number(start)
+	case c == '"':
+		return l.string(start)
+	default:
+		r, _ := utf8.DecodeRuneInString(l.source[l.pos:])
+		return token{}, syntaxError(start, fmt.Sprintf(MCharacterUnexpected, r))
+	}
+}
+
+func (l *lexer) skipIgnored() {
+	for l.pos < len(l.source) {
+		switch c := l.source[l.pos]; {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == ',':
+			l.advance(1)
+		case c == '#':
+			for l.pos < len(l.source) && l.source[l.pos] != '\n' {
+				l.advance(1)
+			}
+		default:
+			return
+		}
+	}
+}
+
+func (l *lexer) number(start Location) (token, error) {
+	end := l.pos
+	if l.source[end] == '-' {
+		end++
+	}
+	digits := end
+	for end < len(l.source) && isDigit(l.source[end]) {
+		end++
+	}
+	if end == digits {
+		return token{}, syntaxError(start, fmt.Sprintf(MNumberInvalid, l.source[l.pos:end]))
+	}
+
+	kind := tokenInt
+	if end < len(l.source) && l.source[end] == '.' {
+		kind = tokenFloat
+		end++
+		for end < len(l.source) && isDigit(l.source[end]) {
+			end++
+		}
+	}
+	if end < len(l.source) && (l.source[end] == 'e' || l.source[end] == 'E') {
+		kind = tokenFloat
+		end++
+		if end < len(l.source) && (l.source[end] == '+' || l.source[end] == '-') {
+			end++
+		}
+		for end < len(l.source) && isDigit(l.source[end]) {
+			end++
+		}
+	}
+
+	value := l.source[l.pos:end]
+	if _, err := strconv.ParseFloat(value, 64); err != nil {
+		return token{}, syntaxError(start, fmt.Sprintf(MNumberInvalid, value))
+	}
+
+	l.advance(end - l.pos)
+	return token{kind: kind, value: value, location: start}, nil
+}
+
+// string decodes a double-quoted string; GraphQL escapes are a subset of Go's.
+func (l *lexer) string(start Location) (token, error) {
+	end := l.pos + 1
+	for end < len(l.source) && l.source[end] != '"' && l.source[end] != '\n' {
+		if l.source[end] == '\\' {
+			end++
+		}
+		end++
+	}
+	if end >= len(l.source) || l.source[end] != '"' {
+		return token{}, syntaxError(start, MStringUnterminated)
+	}
+
+	raw := l.source[l.pos : end+1]
+	value, err := strconv.Unquote(raw)
+	if err != nil || strings.Contains(raw, `\'`) {
+		return token{}, syntaxError(start, MStringEscapeInvalid)
+	}
+
+	l.advance(end + 1 - l.pos)
+	return token{kind: tokenString, value: value, location: start}, nil
+}
+
+func isLetter(c byte) bool { return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' }
+func isDigit(c byte) bool  { return c >= '0' && c <= '9' }
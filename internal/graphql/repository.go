@@ -0,0 +1,27 @@
+package graphql
+
+import (
+	"github.com/alnah/fla/internal/domain/category"
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/post"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+// PostStore loads single posts and post listings.
+type PostStore interface {
+	post.PostReader
+	post.PostFinder
+}
+
+// CategoryStore loads categories with their hierarchy and paths.
+type CategoryStore interface {
+	category.CategoryReader
+	category.CategoryHierarchy
+	category.CategoryPathBuilder
+}
+
+// UserReader loads the accounts behind authors and the viewer.
+type UserReader interface {
+	// GetUserByID retrieves a user; returns ENotFound for deleted accounts.
+	GetUserByID(userID kernel.ID[user.User]) (*user.User, error)
+}
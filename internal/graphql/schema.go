@@ -0,0 +1,402 @@
+// Package graphql serves the blog over GraphQL. It holds a small query executor
+// (queries only: no mutations, subscriptions, or introspection) and the read-only
+// blog schema over posts, categories, tags, and users. Kernel error codes reach
+// clients as the "code" error extension.
+package graphql
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+const MInputInvalid string = "Expected type %s, found %v."
+
+// Type is a type of the schema: Scalar, Enum, Object, List, or NonNull.
+type Type interface {
+	String() string
+}
+
+// Scalar is a leaf type. Serialize turns a resolved Go value into its JSON form;
+// Parse turns an input (literal or variable) into the Go value resolvers receive.
+type Scalar struct {
+	Name      string
+	Serialize func(v any) (any, error)
+	Parse     func(v any) (any, error)
+}
+
+func (s *Scalar) String() string { return s.Name }
+
+// Enum is a leaf type over domain string values. Clients see each value
+// upper-cased ("published" is PUBLISHED); resolvers receive and return the domain value.
+type Enum struct {
+	Name   string
+	Values []string
+}
+
+func (e *Enum) String() string { return e.Name }
+
+// Object is a type with fields, each resolved by its own function.
+type Object struct {
+	Name   string
+	Fields map[string]*Field
+}
+
+func (o *Object) String() string { return o.Name }
+
+// List wraps a type whose values are lists of it.
+type List struct{ Of Type }
+
+func (l *List) String() string { return "[" + l.Of.String() + "]" }
+
+// NonNull wraps a type whose values are never null.
+type NonNull struct{ Of Type }
+
+func (n *NonNull) String() string { return n.Of.String() + "!" }
+
+// Field describes one field of an object type.
+type Field struct {
+	Type    Type
+	Args    []Argument
+	Resolve ResolveFunc
+}
+
+// Argument describes one field argument. Default is in resolver form
+// and applies when the query leaves the argument out.
+type Argument struct {
+	Name    string
+	Type    Type
+	Default any
+}
+
+// ResolveFunc computes a field's value from its parent value.
+// Objects may be returned by value or pointer; a nil pointer is null.
+type ResolveFunc func(p ResolveParams) (any, error)
+
+// ResolveParams is what a resolver knows about the field being resolved.
+// Arguments are coerced: string for String, ID, and enums; int for Int;
+// bool for Boolean; []any for lists. Omitted arguments without default are absent.
+type ResolveParams struct {
+	Source any
+	Args   map[string]any
+	Actor  user.PostPermissionChecker
+}
+
+// String returns a string argument, or "" when absent or null.
+func (p ResolveParams) String(name string) string {
+	s, _ := p.Args[name].(string)
+	return s
+}
+
+// Int returns an integer argument, or 0 when absent or null.
+func (p ResolveParams) Int(name string) int {
+	n, _ := p.Args[name].(int)
+	return n
+}
+
+// Strings returns a list argument of strings, or nil when absent or null.
+func (p ResolveParams) Strings(name string) []string {
+	items, _ := p.Args[name].([]any)
+	var out []string
+	for _, item := range items {
+		if s, ok := item.(string); ok {
+			out = append(out, s)
+		}
+	}
+	return out
+}
+
+// Built-in scalars.
+var (
+	String = &Scalar{
+		Name:      "String",
+		Serialize: serializeString,
+		Parse: func(v any) (any, error) {
+			s, ok := v.(string)
+			if !ok {
+				return nil, inputError("String", v)
+			}
+			return s, nil
+		},
+	}
+
+	Int = &Scalar{
+		Name: "Int",
+		Serialize: func(v any) (any, error) {
+			switch n := v.(type) {
+			case int:
+				return n, nil
+			case int64:
+				return n, nil
+			default:
+				return nil, fmt.Errorf("cannot serialize %T as Int", v)
+			}
+		},
+		Parse: parseInt,
+	}
+
+	Boolean = &Scalar{
+		Name: "Boolean",
+		Serialize: func(v any) (any, error) {
+			b, ok := v.(bool)
+			if !ok {
+				return nil, fmt.Errorf("cannot serialize %T as Boolean", v)
+			}
+			return b, nil
+		},
+		Parse: func(v any) (any, error) {
+			b, ok := v.(bool)
+			if !ok {
+				return nil, inputError("Boolean", v)
+			}
+			return b, nil
+		},
+	}
+
+	// ID accepts integer input too, as the specification requires; resolvers get a string.
+	ID = &Scalar{
+		Name:      "ID",
+		Serialize: serializeString,
+		Parse: func(v any) (any, error) {
+			if s, ok := v.(string); ok {
+				return s, nil
+			}
+			n, err := parseInt(v)
+			if err != nil {
+				return nil, inputError("ID", v)
+			}
+			return strconv.Itoa(n.(int)), nil
+		},
+	}
+
+	// DateTime is an RFC 3339 timestamp in UTC.
+	DateTime = &Scalar{
+		Name: "DateTime",
+		Serialize: func(v any) (any, error) {
+			t, ok := v.(time.Time)
+			if !ok {
+				return nil, fmt.Errorf("cannot serialize %T as DateTime", v)
+			}
+			return t.UTC().Format(time.RFC3339), nil
+		},
+		Parse: func(v any) (any, error) {
+			s, ok := v.(string)
+			if !ok {
+				return nil, inputError("DateTime", v)
+			}
+			t, err := time.Parse(time.RFC3339, s)
+			if err != nil {
+				return nil, inputError("DateTime", v)
+			}
+			return t, nil
+		},
+	}
+)
+
+// serializeString accepts strings and string-based domain types (slugs, IDs, names).
+func serializeString(v any) (any, error) {
+	switch s := v.(type) {
+	case string:
+		return s, nil
+	case fmt.Stringer:
+		return s.String(), nil
+	default:
+		return nil, fmt.Errorf("cannot serialize %T as String", v)
+	}
+}
+
+func parseInt(v any) (any, error) {
+	var f float64
+	switch n := v.(type) {
+	case int:
+		return n, nil
+	case float64:
+		f = n
+	case json.Number:
+		parsed, err := n.Float64()
+		if err != nil {
+			return nil, inputError("Int", v)
+		}
+		f = parsed
+	default:
+		return nil, inputError("Int", v)
+	}
+
+	if f != math.Trunc(f) || f > math.MaxInt32 || f < math.MinInt32 {
+		return nil, inputError("Int", v)
+	}
+	return int(f), nil
+}
+
+func inputError(typeName string, v any) error {
+	switch s := v.(type) {
+	case nil:
+		v = "null"
+	case string:
+		v = strconv.Quote(s)
+	}
+	return fmt.Errorf(MInputInvalid, typeName, v)
+}
+
+// enumLiteral is an unquoted enum value written in the query.
+type enumLiteral string
+
+func (e *Enum) serialize(v any) (any, error) {
+	s, err := serializeString(v)
+	if err != nil || !slices.Contains(e.Values, s.(string)) {
+		return nil, fmt.Errorf("cannot serialize %v as %s", v, e.Name)
+	}
+	return strings.ToUpper(s.(string)), nil
+}
+
+// parse accepts enum literals and, from variables, their string names.
+func (e *Enum) parse(v any) (any, error) {
+	var name string
+	switch s := v.(type) {
+	case enumLiteral:
+		name = string(s)
+	case string:
+		name = s
+	default:
+		return nil, inputError(e.Name, v)
+	}
+
+	for _, value := range e.Values {
+		if strings.ToUpper(value) == name {
+			return value, nil
+		}
+	}
+	return nil, inputError(e.Name, v)
+}
+
+// coerceInput converts an input value to resolver form for the type. Input values
+// are JSON-shaped: nil, bool, string, numbers, []any, or enum literals.
+func coerceInput(t Type, v any) (any, error) {
+	if nonNull, ok := t.(*NonNull); ok {
+		if v == nil {
+			return nil, inputError(t.String(), nil)
+		}
+		return coerceInput(nonNull.Of, v)
+	}
+
+	if v == nil {
+		return nil, nil
+	}
+
+	switch t := t.(type) {
+	case *Scalar:
+		return t.Parse(v)
+	case *Enum:
+		return t.parse(v)
+	case *List:
+		items, ok := v.([]any)
+		if !ok { // A single value is a list of one
+			items = []any{v}
+		}
+		out := make([]any, len(items))
+		for i, item := range items {
+			coerced, err := coerceInput(t.Of, item)
+			if err != nil {
+				return nil, err
+			}
+			out[i] = coerced
+		}
+		return out, nil
+	default:
+		return nil, fmt.Errorf(MInputInvalid, t, v)
+	}
+}
+
+// namedType strips list and non-null wrappers.
+func namedType(t Type) Type {
+	for {
+		switch wrapper := t.(type) {
+		case *NonNull:
+			t = wrapper.Of
+		case *List:
+			t = wrapper.Of
+		default:
+			return t
+		}
+	}
+}
+
+// Schema is an executable schema rooted at a query type.
+type Schema struct {
+	query *Object
+	types map[string]Type // Named types, for variable definitions
+}
+
+// NewSchema indexes every named type reachable from the query type.
+// Panics on duplicate type names or fields without resolver, since schemas are
+// assembled in code and such mistakes must surface at startup.
+func NewSchema(query *Object) *Schema {
+	s := &Schema{query: query, types: map[string]Type{
+		String.Name:   String,
+		Int.Name:      Int,
+		Boolean.Name:  Boolean,
+		ID.Name:       ID,
+		DateTime.Name: DateTime,
+	}}
+	s.index(query)
+	return s
+}
+
+func (s *Schema) index(t Type) {
+	named := namedType(t)
+	name := named.String()
+
+	if existing, ok := s.types[name]; ok {
+		if existing != named {
+			panic(fmt.Sprintf("graphql: two types are named %s", name))
+		}
+		return
+	}
+	s.types[name] = named
+
+	object, ok := named.(*Object)
+	if !ok {
+		return
+	}
+	for fieldName, f := range object.Fields {
+		if f.Resolve == nil {
+			panic(fmt.Sprintf("graphql: field %s.%s has no resolver", name, fieldName))
+		}
+		s.index(f.Type)
+		for _, arg := range f.Args {
+			s.index(arg.Type)
+		}
+	}
+}
+
+// inputType resolves a variable's declared type; only scalars and enums are inputs.
+func (s *Schema) inputType(ref typeRef) (Type, bool) {
+	var t Type
+	if ref.elem != nil {
+		elem, ok := s.inputType(*ref.elem)
+		if !ok {
+			return nil, false
+		}
+		t = &List{Of: elem}
+	} else {
+		named, ok := s.types[ref.name]
+		if !ok {
+			return nil, false
+		}
+		if _, object := named.(*Object); object {
+			return nil, false
+		}
+		t = named
+	}
+
+	if ref.nonNull {
+		t = &NonNull{Of: t}
+	}
+	return t, true
+}
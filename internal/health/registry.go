@@ -0,0 +1,153 @@
+package health
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+)
+
+const (
+	MComponentNameMissing string = "Missing health component name."
+	MComponentExists      string = "Health component %s is already registered."
+	MComponentNoChecks    string = "Health component %s has no checks."
+	MCheckPanicked        string = "Check panicked: %v"
+)
+
+// Component is something that runs in the background and can be checked.
+// Liveness answers "is it running at all?"; a failing liveness check means the
+// process should be restarted. Readiness answers "should it be given work?".
+// Either check may be nil, in which case it is not reported.
+type Component struct {
+	Name      string
+	Liveness  Check
+	Readiness Check
+	Runs      *RunTracker // Optional: last-run info shown in reports
+}
+
+// ComponentReport is the state of one component at report time.
+type ComponentReport struct {
+	Name      string
+	Status    Status // Worse of the two checks
+	Liveness  *CheckResult
+	Readiness *CheckResult
+	LastRun   *RunInfo
+}
+
+// HealthReport aggregates every component into the answers probes need.
+type HealthReport struct {
+	CheckedAt  time.Time
+	Status     Status // Worst component status
+	Live       bool   // No liveness check is down
+	Ready      bool   // Live, and no readiness check is down
+	Components []ComponentReport
+}
+
+// Component returns the report of the named component.
+func (r HealthReport) Component(name string) (ComponentReport, bool) {
+	i := slices.IndexFunc(r.Components, func(c ComponentReport) bool { return c.Name == name })
+	if i < 0 {
+		return ComponentReport{}, false
+	}
+	return r.Components[i], true
+}
+
+// Registry holds the components of a process. Safe for concurrent use:
+// components register at startup while probes may already be reporting.
+type Registry struct {
+	mu         sync.RWMutex
+	components []Component
+	clock      kernel.Clock
+}
+
+// NewRegistry creates an empty registry reading time from clock.
+func NewRegistry(clock kernel.Clock) *Registry {
+	return &Registry{clock: clock}
+}
+
+// Register adds a component. Names are unique and each component needs at least one check.
+func (r *Registry) Register(c Component) error {
+	const op = "Registry.Register"
+
+	c.Name = strings.TrimSpace(c.Name)
+	if c.Name == "" {
+		return &kernel.Error{Code: kernel.EInvalid, Message: MComponentNameMissing, Operation: op}
+	}
+	if c.Liveness == nil && c.Readiness == nil {
+		return &kernel.Error{Code: kernel.EInvalid, Message: fmt.Sprintf(MComponentNoChecks, c.Name), Operation: op}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if slices.ContainsFunc(r.components, func(existing Component) bool { return existing.Name == c.Name }) {
+		return &kernel.Error{Code: kernel.EConflict, Message: fmt.Sprintf(MComponentExists, c.Name), Operation: op}
+	}
+
+	r.components = append(r.components, c)
+	return nil
+}
+
+// Report runs every check, in registration order. A panicking check reports its
+// component down instead of taking the probe with it.
+func (r *Registry) Report() HealthReport {
+	r.mu.RLock()
+	components := slices.Clone(r.components)
+	r.mu.RUnlock()
+
+	now := r.clock.Now()
+	report := HealthReport{CheckedAt: now, Status: StatusUp, Live: true, Ready: true, Components: []ComponentReport{}}
+
+	for _, c := range components {
+		cr := ComponentReport{Name: c.Name, Status: StatusUp}
+
+		if c.Liveness != nil {
+			result := run(c.Liveness, now)
+			cr.Liveness = &result
+			cr.Status = cr.Status.Worse(result.Status)
+			if result.Status == StatusDown {
+				report.Live = false
+			}
+		}
+
+		if c.Readiness != nil {
+			result := run(c.Readiness, now)
+			cr.Readiness = &result
+			cr.Status = cr.Status.Worse(result.Status)
+			if result.Status == StatusDown {
+				report.Ready = false
+			}
+		}
+
+		if c.Runs != nil {
+			info := c.Runs.Info()
+			cr.LastRun = &info
+		}
+
+		report.Status = report.Status.Worse(cr.Status)
+		report.Components = append(report.Components, cr)
+	}
+
+	report.Ready = report.Ready && report.Live
+	return report
+}
+
+func run(check Check, now time.Time) (result CheckResult) {
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			result = Down(now, MCheckPanicked, recovered)
+		}
+	}()
+
+	result = check(now)
+	if result.Status.Validate() != nil {
+		return Down(now, MStatusInvalid, result.Status)
+	}
+	if result.CheckedAt.IsZero() {
+		result.CheckedAt = now
+	}
+	return result
+}
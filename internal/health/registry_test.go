@@ -0,0 +1,114 @@
+package health_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/health"
+)
+
+func fixed(status health.Status) health.Check {
+	return func(now time.Time) health.CheckResult {
+		return health.CheckResult{Status: status, Message: status.String()}
+	}
+}
+
+func TestRegistry_Register(t *testing.T) {
+	registry := health.NewRegistry(&stubClock{fixtureNow})
+	assertNoError(t, registry.Register(health.Component{Name: "mailer", Liveness: fixed(health.StatusUp)}))
+
+	tests := []struct {
+		name      string
+		component health.Component
+		code      string
+	}{
+		{"missing name", health.Component{Name: " ", Liveness: fixed(health.StatusUp)}, kernel.EInvalid},
+		{"no checks", health.Component{Name: "queue"}, kernel.EInvalid},
+		{"taken name", health.Component{Name: "mailer", Readiness: fixed(health.StatusUp)}, kernel.EConflict},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertErrorCode(t, registry.Register(tt.component), tt.code)
+		})
+	}
+}
+
+func TestRegistry_Report(t *testing.T) {
+	tests := []struct {
+		name       string
+		components []health.Component
+		status     health.Status
+		live       bool
+		ready      bool
+	}{
+		{"no components", nil, health.StatusUp, true, true},
+		{"all up", []health.Component{
+			{Name: "a", Liveness: fixed(health.StatusUp), Readiness: fixed(health.StatusUp)},
+			{Name: "b", Readiness: fixed(health.StatusUp)},
+		}, health.StatusUp, true, true},
+		{"degraded stays live and ready", []health.Component{
+			{Name: "a", Liveness: fixed(health.StatusUp), Readiness: fixed(health.StatusDegraded)},
+		}, health.StatusDegraded, true, true},
+		{"readiness down", []health.Component{
+			{Name: "a", Liveness: fixed(health.StatusUp), Readiness: fixed(health.StatusDown)},
+			{Name: "b", Liveness: fixed(health.StatusDegraded)},
+		}, health.StatusDown, true, false},
+		{"liveness down is not ready either", []health.Component{
+			{Name: "a", Liveness: fixed(health.StatusDown), Readiness: fixed(health.StatusUp)},
+		}, health.StatusDown, false, false},
+		{"panicking check is down", []health.Component{
+			{Name: "a", Liveness: func(time.Time) health.CheckResult { panic("boom") }},
+		}, health.StatusDown, false, false},
+		{"unknown status is down", []health.Component{
+			{Name: "a", Readiness: fixed("sleepy")},
+		}, health.StatusDown, true, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			registry := health.NewRegistry(&stubClock{fixtureNow})
+			for _, c := range tt.components {
+				assertNoError(t, registry.Register(c))
+			}
+
+			report := registry.Report()
+
+			if report.Status != tt.status || report.Live != tt.live || report.Ready != tt.ready {
+				t.Errorf("got status %s, live %v, ready %v", report.Status, report.Live, report.Ready)
+			}
+			if len(report.Components) != len(tt.components) || !report.CheckedAt.Equal(fixtureNow) {
+				t.Errorf("got %+v", report)
+			}
+		})
+	}
+
+	t.Run("components keep their own results", func(t *testing.T) {
+		registry := health.NewRegistry(&stubClock{fixtureNow})
+		assertNoError(t, registry.Register(health.Component{Name: "a", Liveness: fixed(health.StatusUp), Readiness: fixed(health.StatusDegraded)}))
+		assertNoError(t, registry.Register(health.Component{Name: "b", Liveness: fixed(health.StatusUp)}))
+
+		report := registry.Report()
+
+		a, ok := report.Component("a")
+		if !ok || a.Status != health.StatusDegraded || a.Readiness.Message != "degraded" || !a.Liveness.CheckedAt.Equal(fixtureNow) {
+			t.Errorf("a: got %+v", a)
+		}
+		if b, _ := report.Component("b"); b.Readiness != nil || b.Status != health.StatusUp {
+			t.Errorf("b: got %+v", b)
+		}
+		if _, ok := report.Component("c"); ok {
+			t.Error("expected unknown components absent")
+		}
+	})
+}
+
+func TestStatus_Worse(t *testing.T) {
+	if got := health.StatusUp.Worse(health.StatusDown); got != health.StatusDown {
+		t.Errorf("got %s", got)
+	}
+	if got := health.StatusDown.Worse(health.StatusDegraded); got != health.StatusDown {
+		t.Errorf("got %s", got)
+	}
+}
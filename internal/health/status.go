@@ -0,0 +1,69 @@
+// Package health reports whether the blog's long-running components (publish
+// scheduler, digest sender, and later the webhook dispatcher) are alive and
+// ready. Each component registers liveness and readiness checks; a Registry
+// runs them all into one HealthReport for probes and dashboards.
+package health
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+)
+
+const MStatusInvalid string = "Invalid health status: %s."
+
+// Status is the outcome of a check, from best to worst.
+type Status string
+
+const (
+	StatusUp       Status = "up"       // Working as expected
+	StatusDegraded Status = "degraded" // Working, but recent runs failed or ran late
+	StatusDown     Status = "down"     // Not working; needs attention
+)
+
+var statusOrder = []Status{StatusUp, StatusDegraded, StatusDown}
+
+func (s Status) String() string { return string(s) }
+
+// Validate ensures the status is one of the known statuses.
+func (s Status) Validate() error {
+	if !slices.Contains(statusOrder, s) {
+		return &kernel.Error{Code: kernel.EInvalid, Message: fmt.Sprintf(MStatusInvalid, s), Operation: "Status.Validate"}
+	}
+	return nil
+}
+
+// Worse returns the worse of two statuses.
+func (s Status) Worse(other Status) Status {
+	if slices.Index(statusOrder, other) > slices.Index(statusOrder, s) {
+		return other
+	}
+	return s
+}
+
+// CheckResult is what one check found.
+type CheckResult struct {
+	Status    Status
+	Message   string // Why the status is not up; empty when up
+	CheckedAt time.Time
+}
+
+// Check inspects one aspect of a component. Checks must be quick and side-effect free.
+type Check func(now time.Time) CheckResult
+
+// Up reports a passing check.
+func Up(now time.Time) CheckResult {
+	return CheckResult{Status: StatusUp, CheckedAt: now}
+}
+
+// Degraded reports a component working below expectations.
+func Degraded(now time.Time, format string, args ...any) CheckResult {
+	return CheckResult{Status: StatusDegraded, Message: fmt.Sprintf(format, args...), CheckedAt: now}
+}
+
+// Down reports a failing check.
+func Down(now time.Time, format string, args ...any) CheckResult {
+	return CheckResult{Status: StatusDown, Message: fmt.Sprintf(format, args...), CheckedAt: now}
+}
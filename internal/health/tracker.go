@@ -0,0 +1,166 @@
+package health
+
+import (
+	"sync"
+	"time"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+)
+
+const (
+	MPolicyIntervalInvalid string = "Run interval must be positive."
+	MPolicyGraceInvalid    string = "Run grace period cannot be negative."
+	MPolicyFailuresInvalid string = "Tolerated failures cannot be negative."
+	MRunOverdue            string = "No run since %s; expected every %s."
+	MRunSlow               string = "Run in progress for %s, longer than the %s interval."
+	MRunFailed             string = "Last run failed: %s"
+	MRunsFailed            string = "Last %d runs failed: %s"
+)
+
+// Names of the background components, used as registry keys.
+const (
+	ComponentPublishScheduler  = "publish-scheduler"
+	ComponentDigestSender      = "digest-sender"
+	ComponentWebhookDispatcher = "webhook-dispatcher"
+)
+
+// DefaultMaxFailures is how many consecutive failures make a component not ready.
+const DefaultMaxFailures int = 3
+
+// RunInfo is the run history of a periodic job.
+type RunInfo struct {
+	Runs                int
+	Running             bool
+	LastStartedAt       *time.Time
+	LastFinishedAt      *time.Time
+	LastSuccessAt       *time.Time
+	LastError           string // Message of the last failed run, cleared by a success
+	ConsecutiveFailures int
+}
+
+// RunTracker records the runs of a periodic job for its health checks.
+// Safe for concurrent use: the job records while probes read.
+type RunTracker struct {
+	mu        sync.Mutex
+	info      RunInfo
+	createdAt time.Time
+	clock     kernel.Clock
+}
+
+// NewRunTracker creates a tracker; its creation counts as the start of the first interval.
+func NewRunTracker(clock kernel.Clock) *RunTracker {
+	return &RunTracker{createdAt: clock.Now(), clock: clock}
+}
+
+// Track runs job and records when it started, when it finished, and whether it failed.
+// The job's error is returned unchanged.
+func (t *RunTracker) Track(job func() error) error {
+	t.mu.Lock()
+	started := t.clock.Now()
+	t.info.Running = true
+	t.info.LastStartedAt = &started
+	t.mu.Unlock()
+
+	err := job()
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	finished := t.clock.Now()
+	t.info.Runs++
+	t.info.Running = false
+	t.info.LastFinishedAt = &finished
+	if err != nil {
+		t.info.LastError = kernel.ErrorMessage(err)
+		t.info.ConsecutiveFailures++
+	} else {
+		t.info.LastSuccessAt = &finished
+		t.info.LastError = ""
+		t.info.ConsecutiveFailures = 0
+	}
+
+	return err
+}
+
+// Info returns a copy of the run history.
+func (t *RunTracker) Info() RunInfo {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.info
+}
+
+// RunPolicy is how often a job should run and how much trouble is tolerated.
+type RunPolicy struct {
+	Interval    time.Duration // Expected time between run starts
+	Grace       time.Duration // Lateness tolerated before liveness fails; zero means one interval
+	MaxFailures int           // Consecutive failures before readiness fails; zero means DefaultMaxFailures
+}
+
+// Validate ensures the policy describes a periodic job.
+func (p RunPolicy) Validate() error {
+	const op = "RunPolicy.Validate"
+
+	if p.Interval <= 0 {
+		return &kernel.Error{Code: kernel.EInvalid, Message: MPolicyIntervalInvalid, Operation: op}
+	}
+	if p.Grace < 0 {
+		return &kernel.Error{Code: kernel.EInvalid, Message: MPolicyGraceInvalid, Operation: op}
+	}
+	if p.MaxFailures < 0 {
+		return &kernel.Error{Code: kernel.EInvalid, Message: MPolicyFailuresInvalid, Operation: op}
+	}
+	return nil
+}
+
+// TrackedComponent builds a component whose checks read a run tracker.
+// Liveness is down once no run has started for an interval plus grace, which
+// means the loop driving the job has stalled, and degraded while a run outlasts
+// its interval. Readiness is degraded after a failed run and down after
+// MaxFailures failures in a row.
+func TrackedComponent(name string, runs *RunTracker, policy RunPolicy) (Component, error) {
+	const op = "TrackedComponent"
+
+	if err := policy.Validate(); err != nil {
+		return Component{}, &kernel.Error{Operation: op, Cause: err}
+	}
+	if policy.Grace == 0 {
+		policy.Grace = policy.Interval
+	}
+	if policy.MaxFailures == 0 {
+		policy.MaxFailures = DefaultMaxFailures
+	}
+
+	liveness := func(now time.Time) CheckResult {
+		info := runs.Info()
+
+		since := runs.createdAt
+		if info.LastStartedAt != nil {
+			since = *info.LastStartedAt
+		}
+		elapsed := now.Sub(since)
+
+		switch {
+		case info.Running && elapsed > policy.Interval+policy.Grace:
+			return Down(now, MRunSlow, elapsed, policy.Interval)
+		case info.Running && elapsed > policy.Interval:
+			return Degraded(now, MRunSlow, elapsed, policy.Interval)
+		case !info.Running && elapsed > policy.Interval+policy.Grace:
+			return Down(now, MRunOverdue, since.Format(time.RFC3339), policy.Interval)
+		}
+		return Up(now)
+	}
+
+	readiness := func(now time.Time) CheckResult {
+		info := runs.Info()
+
+		switch {
+		case info.ConsecutiveFailures >= policy.MaxFailures:
+			return Down(now, MRunsFailed, info.ConsecutiveFailures, info.LastError)
+		case info.ConsecutiveFailures > 0:
+			return Degraded(now, MRunFailed, info.LastError)
+		}
+		return Up(now)
+	}
+
+	return Component{Name: name, Liveness: liveness, Readiness: readiness, Runs: runs}, nil
+}
@@ -0,0 +1,134 @@
+package health_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alnah/fla/internal/adapters/memory"
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/policy"
+	"github.com/alnah/fla/internal/domain/post"
+	"github.com/alnah/fla/internal/domain/user"
+	"github.com/alnah/fla/internal/health"
+)
+
+func TestRunTracker_Track(t *testing.T) {
+	clock := &stubClock{fixtureNow}
+	runs := health.NewRunTracker(clock)
+	failure := &kernel.Error{Code: kernel.EInternal, Message: "Mail server unreachable."}
+
+	err := runs.Track(func() error {
+		if !runs.Info().Running {
+			t.Error("expected the run recorded as in progress")
+		}
+		clock.advance(time.Second)
+		return failure
+	})
+
+	if err != failure {
+		t.Errorf("expected the job error returned, got %v", err)
+	}
+	info := runs.Info()
+	if info.Runs != 1 || info.Running || info.ConsecutiveFailures != 1 || info.LastError != "Mail server unreachable." || info.LastSuccessAt != nil {
+		t.Errorf("after failure: got %+v", info)
+	}
+	if !info.LastStartedAt.Equal(fixtureNow) || !info.LastFinishedAt.Equal(fixtureNow.Add(time.Second)) {
+		t.Errorf("times: got %v, %v", info.LastStartedAt, info.LastFinishedAt)
+	}
+
+	assertNoError(t, runs.Track(func() error { return nil }))
+
+	info = runs.Info()
+	if info.Runs != 2 || info.ConsecutiveFailures != 0 || info.LastError != "" || info.LastSuccessAt == nil {
+		t.Errorf("after success: got %+v", info)
+	}
+}
+
+func TestTrackedComponent(t *testing.T) {
+	runPolicy := health.RunPolicy{Interval: 5 * time.Minute, MaxFailures: 2}
+
+	t.Run("rejects invalid policies", func(t *testing.T) {
+		runs := health.NewRunTracker(&stubClock{fixtureNow})
+		for _, p := range []health.RunPolicy{{}, {Interval: time.Minute, Grace: -1}, {Interval: time.Minute, MaxFailures: -1}} {
+			_, err := health.TrackedComponent("job", runs, p)
+			assertErrorCode(t, err, kernel.EInvalid)
+		}
+	})
+
+	t.Run("liveness follows run starts", func(t *testing.T) {
+		clock := &stubClock{fixtureNow}
+		runs := health.NewRunTracker(clock)
+		component, err := health.TrackedComponent("job", runs, runPolicy)
+		assertNoError(t, err)
+
+		clock.advance(9 * time.Minute)
+		if got := component.Liveness(clock.Now()); got.Status != health.StatusUp {
+			t.Errorf("within grace: got %+v", got)
+		}
+
+		clock.advance(2 * time.Minute)
+		if got := component.Liveness(clock.Now()); got.Status != health.StatusDown || !strings.Contains(got.Message, "No run since") {
+			t.Errorf("stalled: got %+v", got)
+		}
+
+		_ = runs.Track(func() error {
+			clock.advance(6 * time.Minute)
+			if got := component.Liveness(clock.Now()); got.Status != health.StatusDegraded {
+				t.Errorf("slow run: got %+v", got)
+			}
+			clock.advance(5 * time.Minute)
+			if got := component.Liveness(clock.Now()); got.Status != health.StatusDown {
+				t.Errorf("stuck run: got %+v", got)
+			}
+			return nil
+		})
+	})
+
+	t.Run("readiness follows failures", func(t *testing.T) {
+		clock := &stubClock{fixtureNow}
+		runs := health.NewRunTracker(clock)
+		component, err := health.TrackedComponent("job", runs, runPolicy)
+		assertNoError(t, err)
+		fail := func() error { return errors.New("boom") }
+
+		_ = runs.Track(fail)
+		if got := component.Readiness(clock.Now()); got.Status != health.StatusDegraded {
+			t.Errorf("one failure: got %+v", got)
+		}
+
+		_ = runs.Track(fail)
+		if got := component.Readiness(clock.Now()); got.Status != health.StatusDown || !strings.Contains(got.Message, "Last 2 runs failed") {
+			t.Errorf("two failures: got %+v", got)
+		}
+
+		_ = runs.Track(func() error { return nil })
+		if got := component.Readiness(clock.Now()); got.Status != health.StatusUp {
+			t.Errorf("recovered: got %+v", got)
+		}
+	})
+
+	t.Run("reports the publish scheduler", func(t *testing.T) {
+		clock := &stubClock{fixtureNow}
+		scheduler := post.NewSchedulerService(memory.NewPostStore(memory.NewCategoryStore()), clock)
+		editor := user.User{ID: "editor", Roles: []user.Role{user.RoleEditor}, Status: user.AccountStatusActive, Scopes: []string{policy.AllScopes}}
+
+		runs := health.NewRunTracker(clock)
+		component, err := health.TrackedComponent(health.ComponentPublishScheduler, runs, runPolicy)
+		assertNoError(t, err)
+		registry := health.NewRegistry(clock)
+		assertNoError(t, registry.Register(component))
+
+		assertNoError(t, runs.Track(func() error {
+			_, err := scheduler.PublishDue(editor)
+			return err
+		}))
+
+		report := registry.Report()
+		c, _ := report.Component(health.ComponentPublishScheduler)
+		if !report.Ready || c.LastRun == nil || c.LastRun.Runs != 1 {
+			t.Errorf("got %+v", report)
+		}
+	})
+}
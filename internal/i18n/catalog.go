@@ -0,0 +1,113 @@
+// Package i18n holds the interface strings of the site in every supported
+// locale. Messages are looked up by key, may have plural forms chosen with the
+// CLDR rules of golang.org/x/text, and fall back to the default locale when a
+// translation is missing. A completeness report lists what translators still
+// have to do.
+package i18n
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+
+	"golang.org/x/text/feature/plural"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/shared"
+)
+
+const (
+	MKeyMissing         string = "Message keys cannot be empty."
+	MMessageEmpty       string = "Message %s in %s has no text."
+	MMessageAmbiguous   string = "Message %s in %s cannot have both text and plural forms."
+	MPluralOtherMissing string = "Plural message %s in %s needs the \"other\" form."
+	MDefaultLocaleEmpty string = "The catalog has no messages in the default locale (%s)."
+)
+
+// Key identifies an interface string independently of its wording, e.g. "nav.search".
+type Key string
+
+func (k Key) String() string { return string(k) }
+
+// Message is one interface string in one locale: either a single text, or one
+// text per plural form, chosen by the count passed as first argument. Texts are
+// fmt formats.
+type Message struct {
+	Text  string
+	Forms map[plural.Form]string // CLDR plural categories; Other is required
+}
+
+// IsPlural reports whether the message varies with a count.
+func (m Message) IsPlural() bool { return len(m.Forms) > 0 }
+
+// Messages maps each locale to its messages by key.
+type Messages map[shared.Locale]map[Key]Message
+
+// Catalog holds the interface strings of every locale. The default locale is
+// the reference: its keys are the ones every other locale should translate.
+type Catalog struct {
+	messages Messages
+}
+
+// NewCatalog creates a catalog from messages in supported locales. Each
+// message must have a text or plural forms including Other, and the default
+// locale must have messages.
+func NewCatalog(messages Messages) (*Catalog, error) {
+	const op = "NewCatalog"
+
+	if len(messages[shared.DefaultLocale]) == 0 {
+		return nil, &kernel.Error{
+			Code:      kernel.EInvalid,
+			Message:   fmt.Sprintf(MDefaultLocaleEmpty, shared.DefaultLocale),
+			Operation: op,
+		}
+	}
+
+	c := &Catalog{messages: make(Messages, len(messages))}
+	for locale, byKey := range messages {
+		if err := locale.Validate(); err != nil {
+			return nil, &kernel.Error{Operation: op, Cause: err}
+		}
+
+		c.messages[locale] = make(map[Key]Message, len(byKey))
+		for key, message := range byKey {
+			if err := validateMessage(locale, key, message); err != nil {
+				return nil, &kernel.Error{Operation: op, Cause: err}
+			}
+			message.Forms = maps.Clone(message.Forms)
+			c.messages[locale][key] = message
+		}
+	}
+
+	return c, nil
+}
+
+// Lookup returns the message for a key in exactly that locale.
+func (c *Catalog) Lookup(locale shared.Locale, key Key) (Message, bool) {
+	message, ok := c.messages[locale][key]
+	return message, ok
+}
+
+// Keys returns the keys of the default locale, sorted.
+func (c *Catalog) Keys() []Key {
+	return slices.Sorted(maps.Keys(c.messages[shared.DefaultLocale]))
+}
+
+func validateMessage(locale shared.Locale, key Key, message Message) error {
+	const op = "validateMessage"
+
+	if key == "" {
+		return &kernel.Error{Code: kernel.EInvalid, Message: MKeyMissing, Operation: op}
+	}
+
+	switch {
+	case message.Text == "" && !message.IsPlural():
+		return &kernel.Error{Code: kernel.EInvalid, Message: fmt.Sprintf(MMessageEmpty, key, locale), Operation: op}
+	case message.Text != "" && message.IsPlural():
+		return &kernel.Error{Code: kernel.EInvalid, Message: fmt.Sprintf(MMessageAmbiguous, key, locale), Operation: op}
+	case message.IsPlural() && message.Forms[plural.Other] == "":
+		return &kernel.Error{Code: kernel.EInvalid, Message: fmt.Sprintf(MPluralOtherMissing, key, locale), Operation: op}
+	}
+
+	return nil
+}
@@ -0,0 +1,54 @@
+package i18n_test
+
+import (
+	"testing"
+
+	"golang.org/x/text/feature/plural"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/shared"
+	"github.com/alnah/fla/internal/i18n"
+)
+
+func TestNewCatalog(t *testing.T) {
+	t.Run("default messages are valid and complete", func(t *testing.T) {
+		catalog, err := i18n.DefaultCatalog()
+
+		assertNoError(t, err)
+		if report := catalog.Completeness(); !report.IsComplete() {
+			t.Errorf("got %+v", report.Locales)
+		}
+	})
+
+	valid := func() i18n.Messages {
+		return i18n.Messages{shared.LocaleEnglishUS: {"nav.home": {Text: "Home"}}}
+	}
+	tests := []struct {
+		name   string
+		modify func(m i18n.Messages)
+	}{
+		{"no default locale", func(m i18n.Messages) {
+			delete(m, shared.LocaleEnglishUS)
+			m[shared.LocaleFrenchFR] = map[i18n.Key]i18n.Message{"nav.home": {Text: "Accueil"}}
+		}},
+		{"unsupported locale", func(m i18n.Messages) { m["de-DE"] = map[i18n.Key]i18n.Message{"nav.home": {Text: "Start"}} }},
+		{"empty key", func(m i18n.Messages) { m[shared.LocaleEnglishUS][""] = i18n.Message{Text: "Home"} }},
+		{"empty message", func(m i18n.Messages) { m[shared.LocaleEnglishUS]["nav.search"] = i18n.Message{} }},
+		{"text and forms", func(m i18n.Messages) {
+			m[shared.LocaleEnglishUS]["nav.search"] = i18n.Message{Text: "Search", Forms: map[plural.Form]string{plural.Other: "Searches"}}
+		}},
+		{"plural without other", func(m i18n.Messages) {
+			m[shared.LocaleEnglishUS]["lessons"] = i18n.Message{Forms: map[plural.Form]string{plural.One: "%d lesson"}}
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			messages := valid()
+			tt.modify(messages)
+
+			_, err := i18n.NewCatalog(messages)
+
+			assertErrorCode(t, err, kernel.EInvalid)
+		})
+	}
+}
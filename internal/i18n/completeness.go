@@ -0,0 +1,107 @@
+package i18n
+
+import (
+	"maps"
+	"slices"
+
+	"golang.org/x/text/feature/plural"
+
+	"github.com/alnah/fla/internal/domain/shared"
+)
+
+// LocaleCompleteness tells how much of the interface a locale translates,
+// against the keys of the default locale.
+type LocaleCompleteness struct {
+	Locale     shared.Locale
+	Total      int // Keys of the default locale
+	Translated int
+	Missing    []Key // Shown in the default locale until translated
+	Incomplete []Key // Plural messages lacking a form the locale uses; Other stands in
+	Obsolete   []Key // Keys the default locale no longer has
+}
+
+// Coverage is the share of keys translated, from 0 to 1.
+func (c LocaleCompleteness) Coverage() float64 {
+	if c.Total == 0 {
+		return 1
+	}
+	return float64(c.Translated) / float64(c.Total)
+}
+
+// IsComplete reports whether nothing is left to translate or clean up.
+func (c LocaleCompleteness) IsComplete() bool {
+	return len(c.Missing) == 0 && len(c.Incomplete) == 0 && len(c.Obsolete) == 0
+}
+
+// CompletenessReport lists the completeness of every supported locale.
+type CompletenessReport struct {
+	Locales []LocaleCompleteness // In SupportedLocales order
+}
+
+// IsComplete reports whether every locale is complete.
+func (r CompletenessReport) IsComplete() bool {
+	for _, l := range r.Locales {
+		if !l.IsComplete() {
+			return false
+		}
+	}
+	return true
+}
+
+// Completeness reports, for every supported locale, the keys missing or
+// obsolete against the default locale, and the plural messages lacking forms
+// the locale's plural rules use.
+func (c *Catalog) Completeness() CompletenessReport {
+	reference := c.messages[shared.DefaultLocale]
+
+	var report CompletenessReport
+	for _, locale := range shared.SupportedLocales {
+		messages := c.messages[locale]
+		forms := pluralForms(locale)
+
+		l := LocaleCompleteness{Locale: locale, Total: len(reference)}
+		for _, key := range slices.Sorted(maps.Keys(reference)) {
+			message, ok := messages[key]
+			if !ok {
+				l.Missing = append(l.Missing, key)
+				continue
+			}
+			l.Translated++
+
+			if message.IsPlural() && slices.ContainsFunc(forms, func(f plural.Form) bool { return message.Forms[f] == "" }) {
+				l.Incomplete = append(l.Incomplete, key)
+			}
+		}
+		for _, key := range slices.Sorted(maps.Keys(messages)) {
+			if _, ok := reference[key]; !ok {
+				l.Obsolete = append(l.Obsolete, key)
+			}
+		}
+
+		report.Locales = append(report.Locales, l)
+	}
+
+	return report
+}
+
+// pluralForms returns the plural categories integers take in the locale,
+// found by probing counts: small ones, then powers of ten, which some
+// languages treat apart.
+func pluralForms(locale shared.Locale) []plural.Form {
+	probes := make([]int, 0, 207)
+	for n := range 200 {
+		probes = append(probes, n)
+	}
+	for n := 1_000; n <= 1_000_000_000; n *= 10 {
+		probes = append(probes, n)
+	}
+
+	var forms []plural.Form
+	for _, n := range probes {
+		if form := pluralForm(locale, n); !slices.Contains(forms, form) {
+			forms = append(forms, form)
+		}
+	}
+	slices.Sort(forms)
+	return forms
+}
@@ -0,0 +1,57 @@
+package i18n_test
+
+import (
+	"slices"
+	"testing"
+
+	"golang.org/x/text/feature/plural"
+
+	"github.com/alnah/fla/internal/domain/shared"
+	"github.com/alnah/fla/internal/i18n"
+)
+
+func TestCatalog_Completeness(t *testing.T) {
+	catalog, err := i18n.NewCatalog(i18n.Messages{
+		shared.LocaleEnglishUS: {
+			"nav.home":  {Text: "Home"},
+			"nav.about": {Text: "About"},
+			"lessons":   {Forms: map[plural.Form]string{plural.One: "%d lesson", plural.Other: "%d lessons"}},
+		},
+		shared.LocaleFrenchFR: {
+			"nav.home": {Text: "Accueil"},
+			"lessons":  {Forms: map[plural.Form]string{plural.Other: "%d leçons"}},
+			"nav.shop": {Text: "Boutique"},
+		},
+	})
+	assertNoError(t, err)
+
+	report := catalog.Completeness()
+
+	if len(report.Locales) != len(shared.SupportedLocales) || report.IsComplete() {
+		t.Fatalf("got %+v", report.Locales)
+	}
+
+	english := report.Locales[slices.Index(shared.SupportedLocales, shared.LocaleEnglishUS)]
+	if !english.IsComplete() || english.Coverage() != 1 {
+		t.Errorf("en-US: got %+v", english)
+	}
+
+	french := report.Locales[slices.Index(shared.SupportedLocales, shared.LocaleFrenchFR)]
+	if french.Total != 3 || french.Translated != 2 {
+		t.Errorf("fr-FR counts: got %+v", french)
+	}
+	if !slices.Equal(french.Missing, []i18n.Key{"nav.about"}) {
+		t.Errorf("fr-FR missing: got %v", french.Missing)
+	}
+	if !slices.Equal(french.Incomplete, []i18n.Key{"lessons"}) {
+		t.Errorf("fr-FR incomplete: got %v", french.Incomplete)
+	}
+	if !slices.Equal(french.Obsolete, []i18n.Key{"nav.shop"}) {
+		t.Errorf("fr-FR obsolete: got %v", french.Obsolete)
+	}
+
+	portuguese := report.Locales[slices.Index(shared.SupportedLocales, shared.LocalePortugueseBR)]
+	if portuguese.Translated != 0 || len(portuguese.Missing) != 3 || portuguese.Coverage() != 0 {
+		t.Errorf("pt-BR: got %+v", portuguese)
+	}
+}
@@ -0,0 +1,93 @@
+package i18n
+
+import (
+	"golang.org/x/text/feature/plural"
+
+	"github.com/alnah/fla/internal/domain/shared"
+)
+
+// Interface string keys.
+const (
+	KeyNavHome           Key = "nav.home"
+	KeyNavCategories     Key = "nav.categories"
+	KeyNavSearch         Key = "nav.search"
+	KeyNavSubscribe      Key = "nav.subscribe"
+	KeySearchPlaceholder Key = "search.placeholder"
+	KeySearchResults     Key = "search.results"
+	KeyPostReadingTime   Key = "post.reading_time"
+	KeyPostPublishedOn   Key = "post.published_on"
+	KeyCategoryLessons   Key = "category.lessons"
+	KeyFooterLanguage    Key = "footer.language"
+)
+
+// DefaultMessages holds the interface strings of the site.
+var DefaultMessages = Messages{
+	shared.LocaleEnglishUS: {
+		KeyNavHome:           {Text: "Home"},
+		KeyNavCategories:     {Text: "Lessons"},
+		KeyNavSearch:         {Text: "Search"},
+		KeyNavSubscribe:      {Text: "Subscribe"},
+		KeySearchPlaceholder: {Text: "Search the lessons"},
+		KeySearchResults: {Forms: map[plural.Form]string{
+			plural.One:   "%d result for “%s”",
+			plural.Other: "%d results for “%s”",
+		}},
+		KeyPostReadingTime: {Forms: map[plural.Form]string{
+			plural.One:   "%d minute to read",
+			plural.Other: "%d minutes to read",
+		}},
+		KeyPostPublishedOn: {Text: "Published on %s"},
+		KeyCategoryLessons: {Forms: map[plural.Form]string{
+			plural.One:   "%d lesson",
+			plural.Other: "%d lessons",
+		}},
+		KeyFooterLanguage: {Text: "Language"},
+	},
+	shared.LocaleFrenchFR: {
+		KeyNavHome:           {Text: "Accueil"},
+		KeyNavCategories:     {Text: "Leçons"},
+		KeyNavSearch:         {Text: "Rechercher"},
+		KeyNavSubscribe:      {Text: "S'abonner"},
+		KeySearchPlaceholder: {Text: "Rechercher dans les leçons"},
+		KeySearchResults: {Forms: map[plural.Form]string{
+			plural.One:   "%d résultat pour « %s »",
+			plural.Other: "%d résultats pour « %s »",
+		}},
+		KeyPostReadingTime: {Forms: map[plural.Form]string{
+			plural.One:   "%d minute de lecture",
+			plural.Other: "%d minutes de lecture",
+		}},
+		KeyPostPublishedOn: {Text: "Publié le %s"},
+		KeyCategoryLessons: {Forms: map[plural.Form]string{
+			plural.One:   "%d leçon",
+			plural.Other: "%d leçons",
+		}},
+		KeyFooterLanguage: {Text: "Langue"},
+	},
+	shared.LocalePortugueseBR: {
+		KeyNavHome:           {Text: "Início"},
+		KeyNavCategories:     {Text: "Lições"},
+		KeyNavSearch:         {Text: "Buscar"},
+		KeyNavSubscribe:      {Text: "Inscrever-se"},
+		KeySearchPlaceholder: {Text: "Buscar nas lições"},
+		KeySearchResults: {Forms: map[plural.Form]string{
+			plural.One:   "%d resultado para “%s”",
+			plural.Other: "%d resultados para “%s”",
+		}},
+		KeyPostReadingTime: {Forms: map[plural.Form]string{
+			plural.One:   "%d minuto de leitura",
+			plural.Other: "%d minutos de leitura",
+		}},
+		KeyPostPublishedOn: {Text: "Publicado em %s"},
+		KeyCategoryLessons: {Forms: map[plural.Form]string{
+			plural.One:   "%d lição",
+			plural.Other: "%d lições",
+		}},
+		KeyFooterLanguage: {Text: "Idioma"},
+	},
+}
+
+// DefaultCatalog returns the catalog of DefaultMessages.
+func DefaultCatalog() (*Catalog, error) {
+	return NewCatalog(DefaultMessages)
+}
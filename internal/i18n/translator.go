@@ -0,0 +1,96 @@
+package i18n
+
+import (
+	"fmt"
+
+	"golang.org/x/text/feature/plural"
+
+	"github.com/alnah/fla/internal/domain/shared"
+)
+
+// Translator resolves interface strings for templates and handlers.
+type Translator struct {
+	catalog *Catalog
+}
+
+// NewTranslator creates a translator reading the catalog.
+func NewTranslator(catalog *Catalog) *Translator {
+	return &Translator{catalog: catalog}
+}
+
+// T returns the message for key in the locale, formatted with args.
+// Unsupported locales read the default locale; keys the locale lacks fall
+// back to the default locale, then to the key itself so the gap shows on the
+// page. Plural messages pick their form from the first argument, an integer
+// count, which every form should print or skip with an explicit index.
+func (t *Translator) T(locale shared.Locale, key Key, args ...any) string {
+	locale = locale.GetEffectiveLocale()
+
+	message, ok := t.catalog.Lookup(locale, key)
+	if !ok {
+		locale = shared.DefaultLocale
+		if message, ok = t.catalog.Lookup(locale, key); !ok {
+			return key.String()
+		}
+	}
+
+	format := message.Text
+	if message.IsPlural() {
+		format = message.Forms[plural.Other]
+		if n, ok := count(args); ok {
+			if text, ok := message.Forms[pluralForm(locale, n)]; ok {
+				format = text
+			}
+		}
+	}
+
+	if len(args) == 0 {
+		return format
+	}
+	return fmt.Sprintf(format, args...)
+}
+
+// pluralForm returns the CLDR plural category of an integer in the locale,
+// e.g. One for 1 and 0 in French, but Other for 0 in English.
+func pluralForm(locale shared.Locale, n int) plural.Form {
+	tag, err := locale.ToLanguageTag()
+	if err != nil {
+		return plural.Other
+	}
+	if n < 0 {
+		n = -n
+	}
+	return plural.Cardinal.MatchPlural(tag, n%10_000_000, 0, 0, 0, 0)
+}
+
+// count reads the first argument as an integer count.
+func count(args []any) (int, bool) {
+	if len(args) == 0 {
+		return 0, false
+	}
+
+	switch n := args[0].(type) {
+	case int:
+		return n, true
+	case int8:
+		return int(n), true
+	case int16:
+		return int(n), true
+	case int32:
+		return int(n), true
+	case int64:
+		return int(n), true
+	case uint:
+		return int(n), true
+	case uint8:
+		return int(n), true
+	case uint16:
+		return int(n), true
+	case uint32:
+		return int(n), true
+	case uint64:
+		return int(n), true
+	default:
+		return 0, false
+	}
+}
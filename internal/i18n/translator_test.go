@@ -0,0 +1,47 @@
+package i18n_test
+
+import (
+	"testing"
+
+	"github.com/alnah/fla/internal/domain/shared"
+	"github.com/alnah/fla/internal/i18n"
+)
+
+func TestTranslator_T(t *testing.T) {
+	catalog, err := i18n.NewCatalog(i18n.Messages{
+		shared.LocaleEnglishUS: i18n.DefaultMessages[shared.LocaleEnglishUS],
+		shared.LocaleFrenchFR: {
+			i18n.KeyNavHome:         {Text: "Accueil"},
+			i18n.KeyCategoryLessons: i18n.DefaultMessages[shared.LocaleFrenchFR][i18n.KeyCategoryLessons],
+		},
+	})
+	assertNoError(t, err)
+	translator := i18n.NewTranslator(catalog)
+
+	tests := []struct {
+		name   string
+		locale shared.Locale
+		key    i18n.Key
+		args   []any
+		want   string
+	}{
+		{"translated", shared.LocaleFrenchFR, i18n.KeyNavHome, nil, "Accueil"},
+		{"formatted", shared.LocaleEnglishUS, i18n.KeyPostPublishedOn, []any{"March 5, 2024"}, "Published on March 5, 2024"},
+		{"missing translation", shared.LocaleFrenchFR, i18n.KeyNavSearch, nil, "Search"},
+		{"unsupported locale", "de-DE", i18n.KeyNavHome, nil, "Home"},
+		{"unknown key", shared.LocaleFrenchFR, "nav.shop", nil, "nav.shop"},
+		{"english singular", shared.LocaleEnglishUS, i18n.KeyCategoryLessons, []any{1}, "1 lesson"},
+		{"english zero is plural", shared.LocaleEnglishUS, i18n.KeyCategoryLessons, []any{0}, "0 lessons"},
+		{"french zero is singular", shared.LocaleFrenchFR, i18n.KeyCategoryLessons, []any{0}, "0 leçon"},
+		{"french plural", shared.LocaleFrenchFR, i18n.KeyCategoryLessons, []any{int64(12)}, "12 leçons"},
+		{"plural with more arguments", shared.LocaleEnglishUS, i18n.KeySearchResults, []any{3, "passé"}, "3 results for “passé”"},
+		{"plural falls back to default", shared.LocalePortugueseBR, i18n.KeyPostReadingTime, []any{1}, "1 minute to read"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := translator.T(tt.locale, tt.key, tt.args...); got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
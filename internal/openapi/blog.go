@@ -0,0 +1,151 @@
+package openapi
+
+import (
+	"maps"
+	"net/http"
+	"strconv"
+
+	"github.com/alnah/fla/internal/domain/shared"
+)
+
+// APIVersion is the version of the blog API the document describes.
+const APIVersion string = "1.0.0"
+
+const jsonContent = "application/json"
+
+// NewBlogDocument describes the blog API: posts, categories, tags, and subscriptions.
+func NewBlogDocument() *Document {
+	schemas := valueSchemas()
+	maps.Copy(schemas, resourceSchemas())
+
+	return &Document{
+		OpenAPI: Version,
+		Info: Info{
+			Title:       "fla blog API",
+			Description: "Lessons for French learners, organized by level and skill.",
+			Version:     APIVersion,
+		},
+		Paths: map[string]*PathItem{
+			"/posts": {
+				Get: &Operation{
+					OperationID: "listPosts",
+					Summary:     "List posts, published ones unless the caller can see more.",
+					Tags:        []string{"posts"},
+					Parameters: []*Parameter{
+						parameterRef("Page"),
+						parameterRef("Limit"),
+						{Name: "status", In: "query", Schema: &Schema{Type: "array", Items: ref("PostStatus")}},
+						{Name: "category", In: "query", Description: "Category slug; includes its subcategories.", Schema: ref("Slug")},
+						{Name: "tag", In: "query", Schema: ref("Slug")},
+					},
+					Responses: responses(http.StatusOK, ref("PostPage"), http.StatusBadRequest, http.StatusForbidden),
+				},
+				Post: &Operation{
+					OperationID: "createPost",
+					Summary:     "Create a draft post.",
+					Tags:        []string{"posts"},
+					RequestBody: body("PostInput"),
+					Responses:   responses(http.StatusCreated, ref("Post"), http.StatusBadRequest, http.StatusForbidden, http.StatusConflict),
+				},
+			},
+			"/posts/{slug}": {
+				Get: &Operation{
+					OperationID: "getPost",
+					Summary:     "Get a post by slug.",
+					Tags:        []string{"posts"},
+					Parameters:  []*Parameter{parameterRef("Slug")},
+					Responses:   responses(http.StatusOK, ref("Post"), http.StatusNotFound),
+				},
+				Put: &Operation{
+					OperationID: "updatePost",
+					Summary:     "Replace the editable fields of a post.",
+					Tags:        []string{"posts"},
+					Parameters:  []*Parameter{parameterRef("Slug")},
+					RequestBody: body("PostInput"),
+					Responses:   responses(http.StatusOK, ref("Post"), http.StatusBadRequest, http.StatusForbidden, http.StatusNotFound, http.StatusConflict),
+				},
+			},
+			"/categories": {
+				Get: &Operation{
+					OperationID: "listCategories",
+					Summary:     "List all categories.",
+					Tags:        []string{"categories"},
+					Responses:   responses(http.StatusOK, &Schema{Type: "array", Items: ref("Category")}),
+				},
+			},
+			"/categories/{slug}": {
+				Get: &Operation{
+					OperationID: "getCategory",
+					Summary:     "Get a category by slug.",
+					Tags:        []string{"categories"},
+					Parameters:  []*Parameter{parameterRef("Slug")},
+					Responses:   responses(http.StatusOK, ref("Category"), http.StatusNotFound),
+				},
+			},
+			"/tags": {
+				Get: &Operation{
+					OperationID: "listTags",
+					Summary:     "List all tags.",
+					Tags:        []string{"tags"},
+					Responses:   responses(http.StatusOK, &Schema{Type: "array", Items: ref("Tag")}),
+				},
+			},
+			"/subscriptions": {
+				Post: &Operation{
+					OperationID: "subscribe",
+					Summary:     "Subscribe an email address; a confirmation email follows.",
+					Tags:        []string{"subscriptions"},
+					RequestBody: body("SubscriptionInput"),
+					Responses:   responses(http.StatusAccepted, nil, http.StatusBadRequest, http.StatusConflict),
+				},
+			},
+		},
+		Components: Components{
+			Schemas: schemas,
+			Parameters: map[string]*Parameter{
+				"Page": {
+					Name:   "page",
+					In:     "query",
+					Schema: &Schema{Type: "integer", Minimum: ptr(1), Default: 1},
+				},
+				"Limit": {
+					Name: "limit",
+					In:   "query",
+					Schema: &Schema{
+						Type:    "integer",
+						Minimum: ptr(shared.MinPageLimit),
+						Maximum: ptr(shared.MaxPageLimit),
+						Default: shared.DefaultPageLimit,
+					},
+				},
+				"Slug": {Name: "slug", In: "path", Required: true, Schema: ref("Slug")},
+			},
+			Responses: errorResponses(),
+		},
+	}
+}
+
+func parameterRef(name string) *Parameter {
+	return &Parameter{Ref: "#/components/parameters/" + name}
+}
+
+func body(schema string) *RequestBody {
+	return &RequestBody{Required: true, Content: map[string]*MediaType{jsonContent: {Schema: ref(schema)}}}
+}
+
+// responses builds the success response and references the shared error
+// responses for the given statuses; a nil schema means a success without body.
+// Any operation may fail with an internal error.
+func responses(status int, schema *Schema, errorStatuses ...int) map[string]*Response {
+	success := &Response{Description: http.StatusText(status)}
+	if schema != nil {
+		success.Content = map[string]*MediaType{jsonContent: {Schema: schema}}
+	}
+
+	out := map[string]*Response{strconv.Itoa(status): success}
+	for _, s := range errorStatuses {
+		out[strconv.Itoa(s)] = &Response{Ref: "#/components/responses/" + errorResponseName(s)}
+	}
+	out["500"] = &Response{Ref: "#/components/responses/" + errorResponseName(http.StatusInternalServerError)}
+	return out
+}
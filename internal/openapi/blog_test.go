@@ -0,0 +1,215 @@
+package openapi_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/alnah/fla/internal/domain/category"
+	"github.com/alnah/fla/internal/domain/post"
+	"github.com/alnah/fla/internal/domain/shared"
+	"github.com/alnah/fla/internal/domain/subscription"
+	"github.com/alnah/fla/internal/domain/tag"
+	"github.com/alnah/fla/internal/openapi"
+)
+
+func TestNewBlogDocument_Snapshot(t *testing.T) {
+	got, err := openapi.NewBlogDocument().JSON()
+	assertNoError(t, err)
+
+	assertSnapshot(t, "blog", string(got))
+}
+
+func TestNewBlogDocument_ReferencesResolve(t *testing.T) {
+	doc := openapi.NewBlogDocument()
+	got, err := doc.JSON()
+	assertNoError(t, err)
+
+	var tree any
+	assertNoError(t, json.Unmarshal(got, &tree))
+
+	var walk func(node any)
+	walk = func(node any) {
+		switch n := node.(type) {
+		case map[string]any:
+			if ref, ok := n["$ref"].(string); ok {
+				if !resolves(doc, ref) {
+					t.Errorf("unresolved reference %s", ref)
+				}
+			}
+			for _, child := range n {
+				walk(child)
+			}
+		case []any:
+			for _, child := range n {
+				walk(child)
+			}
+		}
+	}
+	walk(tree)
+}
+
+func resolves(doc *openapi.Document, ref string) bool {
+	name, ok := strings.CutPrefix(ref, "#/components/")
+	if !ok {
+		return false
+	}
+	kind, name, _ := strings.Cut(name, "/")
+
+	switch kind {
+	case "schemas":
+		return doc.Components.Schemas[name] != nil
+	case "parameters":
+		return doc.Components.Parameters[name] != nil
+	case "responses":
+		return doc.Components.Responses[name] != nil
+	default:
+		return false
+	}
+}
+
+// The document must accept exactly what the domain accepts, at and around every bound.
+func TestNewBlogDocument_MatchesDomainRules(t *testing.T) {
+	schemas := openapi.NewBlogDocument().Components.Schemas
+
+	tests := []struct {
+		schema   string
+		validate func(string) error
+		values   []string
+	}{
+		{
+			schema:   "Title",
+			validate: func(v string) error { return shared.Title(v).Validate() },
+			values: []string{
+				strings.Repeat("a", shared.MinTitleLength-1),
+				strings.Repeat("é", shared.MinTitleLength),
+				strings.Repeat("a", shared.MaxTitleLength),
+				strings.Repeat("a", shared.MaxTitleLength+1),
+			},
+		},
+		{
+			schema:   "Slug",
+			validate: func(v string) error { return shared.Slug(v).Validate() },
+			values: []string{
+				"", "jouer-au-football", "a1", "Jouer", "double--hyphen", "-leading", "trailing-", "accentué",
+				strings.Repeat("a", shared.MaxSlugLength),
+				strings.Repeat("a", shared.MaxSlugLength+1),
+			},
+		},
+		{
+			schema:   "Description",
+			validate: func(v string) error { return shared.Description(v).Validate() },
+			values:   []string{"", strings.Repeat("a", shared.MaxDescriptionLength), strings.Repeat("a", shared.MaxDescriptionLength+1)},
+		},
+		{
+			schema:   "PostContent",
+			validate: func(v string) error { return post.PostContent(v).Validate() },
+			values: []string{
+				strings.Repeat("a", post.MinPostContentLength-1),
+				strings.Repeat("a", post.MinPostContentLength),
+				strings.Repeat("a", post.MaxPostContentLength),
+				strings.Repeat("a", post.MaxPostContentLength+1),
+			},
+		},
+		{
+			schema:   "Excerpt",
+			validate: func(v string) error { return post.Excerpt(v).Validate() },
+			values:   []string{"", strings.Repeat("a", post.MaxExcerptLength), strings.Repeat("a", post.MaxExcerptLength+1)},
+		},
+		{
+			schema:   "TagName",
+			validate: func(v string) error { return tag.TagName(v).Validate() },
+			values:   []string{"", "Grammaire", strings.Repeat("a", tag.MaxTagNameLength), strings.Repeat("a", tag.MaxTagNameLength+1)},
+		},
+		{
+			schema:   "CategoryName",
+			validate: func(v string) error { return category.CategoryName(v).Validate() },
+			values:   []string{"", "Sports", strings.Repeat("a", category.MaxCategoryNameLength), strings.Repeat("a", category.MaxCategoryNameLength+1)},
+		},
+		{
+			schema:   "Username",
+			validate: func(v string) error { return shared.Username(v).Validate() },
+			values: []string{
+				"ab", "abc", "jean_dupont-2", "jean dupont", "jean.dupont",
+				strings.Repeat("a", shared.MaxUsernameLength),
+				strings.Repeat("a", shared.MaxUsernameLength+1),
+			},
+		},
+		{
+			schema:   "FirstName",
+			validate: func(v string) error { return shared.FirstName(v).Validate() },
+			values:   []string{"", strings.Repeat("a", shared.MaxFirstNameLength), strings.Repeat("a", shared.MaxFirstNameLength+1)},
+		},
+		{
+			schema:   "Email",
+			validate: func(v string) error { return shared.Email(v).Validate() },
+			values:   []string{"", "marie@example.com", "marie.curie+fla@example.fr", "marie@", "@example.com", "marie@example"},
+		},
+		{
+			schema:   "Locale",
+			validate: func(v string) error { return shared.Locale(v).Validate() },
+			values:   []string{"fr-FR", "en-US", "pt-BR", "fr", "de-DE"},
+		},
+		{
+			schema:   "PostStatus",
+			validate: func(v string) error { return post.Status(v).Validate() },
+			values:   []string{"draft", "published", "scheduled", "archived", "deleted"},
+		},
+		{
+			schema:   "SchemaType",
+			validate: func(v string) error { return post.SchemaType(v).Validate() },
+			values:   []string{"Article", "Course", "Recipe"},
+		},
+		{
+			schema:   "Frequency",
+			validate: func(v string) error { return subscription.Frequency(v).Validate() },
+			values:   []string{"instant", "weekly_digest", "daily"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.schema, func(t *testing.T) {
+			schema, ok := schemas[tt.schema]
+			if !ok {
+				t.Fatalf("missing schema %s", tt.schema)
+			}
+
+			for _, v := range tt.values {
+				domain := tt.validate(v) == nil
+				if got := accepts(t, schema, v); got != domain {
+					t.Errorf("%.20q: schema accepts %t, domain accepts %t", v, got, domain)
+				}
+			}
+		})
+	}
+}
+
+func TestHandler(t *testing.T) {
+	handler, err := openapi.NewHandler(openapi.NewBlogDocument())
+	assertNoError(t, err)
+
+	t.Run("serves the document", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/openapi.json", nil))
+
+		var doc openapi.Document
+		assertNoError(t, json.Unmarshal(recorder.Body.Bytes(), &doc))
+		if doc.OpenAPI != openapi.Version {
+			t.Errorf("openapi: got %q, want %q", doc.OpenAPI, openapi.Version)
+		}
+		if got := recorder.Header().Get("Content-Type"); got != "application/json; charset=utf-8" {
+			t.Errorf("content type: got %q", got)
+		}
+	})
+
+	t.Run("rejects other methods", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/openapi.json", nil))
+
+		if recorder.Code != http.StatusMethodNotAllowed {
+			t.Errorf("status: got %d, want %d", recorder.Code, http.StatusMethodNotAllowed)
+		}
+	})
+}
@@ -0,0 +1,81 @@
+package openapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+)
+
+// errorStatuses maps each kernel error code to the HTTP status it is served with.
+var errorStatuses = map[string]int{
+	kernel.EInvalid:   http.StatusBadRequest,
+	kernel.EForbidden: http.StatusForbidden,
+	kernel.ENotFound:  http.StatusNotFound,
+	kernel.EConflict:  http.StatusConflict,
+	kernel.EInternal:  http.StatusInternalServerError,
+}
+
+// ErrorBody is the JSON body of every error response, the Error schema of the document.
+// Validation errors also name the invalid field and the constraint it broke, with
+// the constraint's bounds, so clients need not parse the message.
+type ErrorBody struct {
+	Code       string `json:"code"`
+	Message    string `json:"message"`
+	Field      string `json:"field,omitempty"`
+	Constraint string `json:"constraint,omitempty"`
+	Min        *int   `json:"min,omitempty"`
+	Max        *int   `json:"max,omitempty"`
+}
+
+// HTTPStatus returns the HTTP status for an error, from its kernel code.
+// Anything unclassified is internal.
+func HTTPStatus(err error) int {
+	if status, ok := errorStatuses[kernel.ErrorCode(err)]; ok {
+		return status
+	}
+	return http.StatusInternalServerError
+}
+
+// WriteError answers with the error's status and an ErrorBody. Internal failures
+// get the generic kernel.MInternal message so their details never reach clients.
+func WriteError(w http.ResponseWriter, err error) {
+	body := ErrorBody{Code: kernel.ErrorCode(err), Message: kernel.ErrorMessage(err)}
+	if HTTPStatus(err) == http.StatusInternalServerError {
+		body = ErrorBody{Code: kernel.EInternal, Message: kernel.MInternal}
+	} else if field := kernel.FieldError(err); field != nil {
+		body.Field = field.Field
+		body.Constraint = field.Constraint.Name
+		body.Min = param(field.Constraint, "min")
+		body.Max = param(field.Constraint, "max")
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(HTTPStatus(err))
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func param(c kernel.Constraint, name string) *int {
+	if n, ok := c.Params[name]; ok {
+		return &n
+	}
+	return nil
+}
+
+// errorResponses are the shared error responses, one per kernel error code.
+func errorResponses() map[string]*Response {
+	out := make(map[string]*Response, len(errorStatuses))
+	for _, status := range errorStatuses {
+		out[errorResponseName(status)] = &Response{
+			Description: http.StatusText(status),
+			Content:     map[string]*MediaType{jsonContent: {Schema: ref("Error")}},
+		}
+	}
+	return out
+}
+
+// errorResponseName names a shared error response after its status, e.g. NotFound.
+func errorResponseName(status int) string {
+	return strings.ReplaceAll(http.StatusText(status), " ", "")
+}
@@ -0,0 +1,75 @@
+package openapi_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/openapi"
+)
+
+func TestHTTPStatus(t *testing.T) {
+	tests := []struct {
+		err  error
+		want int
+	}{
+		{&kernel.Error{Code: kernel.EInvalid}, http.StatusBadRequest},
+		{&kernel.Error{Code: kernel.EForbidden}, http.StatusForbidden},
+		{&kernel.Error{Code: kernel.ENotFound}, http.StatusNotFound},
+		{&kernel.Error{Code: kernel.EConflict}, http.StatusConflict},
+		{&kernel.Error{Code: kernel.EInternal}, http.StatusInternalServerError},
+		{&kernel.Error{Operation: "op", Cause: &kernel.Error{Code: kernel.ENotFound}}, http.StatusNotFound},
+		{errors.New("boom"), http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		if got := openapi.HTTPStatus(tt.err); got != tt.want {
+			t.Errorf("HTTPStatus(%v): got %d, want %d", tt.err, got, tt.want)
+		}
+	}
+}
+
+func TestWriteError(t *testing.T) {
+	tests := []struct {
+		name   string
+		err    error
+		status int
+		body   string
+	}{
+		{
+			name:   "client error keeps its message",
+			err:    &kernel.Error{Operation: "NewSlug", Cause: &kernel.Error{Code: kernel.EInvalid, Message: "Slug contains invalid characters."}},
+			status: http.StatusBadRequest,
+			body:   `{"code":"invalid","message":"Slug contains invalid characters."}`,
+		},
+		{
+			name:   "validation error names the field and constraint",
+			err:    &kernel.Error{Operation: "NewTitle", Cause: kernel.ValidateLength("title", "short", 10, 100, "Title.Validate")},
+			status: http.StatusBadRequest,
+			body:   `{"code":"invalid","message":"title must be between 10 and 100 characters.","field":"title","constraint":"length","min":10,"max":100}`,
+		},
+		{
+			name:   "internal error hides its details",
+			err:    &kernel.Error{Code: kernel.EInternal, Message: "database password is hunter2"},
+			status: http.StatusInternalServerError,
+			body:   `{"code":"internal","message":"` + kernel.MInternal + `"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := httptest.NewRecorder()
+			openapi.WriteError(recorder, tt.err)
+
+			if recorder.Code != tt.status {
+				t.Errorf("status: got %d, want %d", recorder.Code, tt.status)
+			}
+			if got := strings.TrimSpace(recorder.Body.String()); got != tt.body {
+				t.Errorf("body:\ngot:  %s\nwant: %s", got, tt.body)
+			}
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package openapi
+
+import (
+	"net/http"
+)
+
+// Handler serves a document as JSON, rendered once at construction.
+type Handler struct {
+	body []byte
+}
+
+// NewHandler renders the document. It fails only if the document holds
+// a value JSON cannot encode, a programming mistake to surface at startup.
+func NewHandler(doc *Document) (*Handler, error) {
+	body, err := doc.JSON()
+	if err != nil {
+		return nil, err
+	}
+	return &Handler{body: body}, nil
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	_, _ = w.Write(h.body)
+}
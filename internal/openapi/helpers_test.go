@@ -0,0 +1,65 @@
+package openapi_test
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/alnah/fla/internal/openapi"
+)
+
+var update = flag.Bool("update", false, "update snapshot files in testdata")
+
+func assertNoError(t *testing.T, err error) {
+	t.Helper()
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+}
+
+// assertSnapshot compares got with testdata/<name>.golden, rewriting it with -update.
+func assertSnapshot(t *testing.T, name, got string) {
+	t.Helper()
+
+	path := filepath.Join("testdata", name+".golden")
+	if *update {
+		if err := os.WriteFile(path, []byte(got), 0o644); err != nil {
+			t.Fatalf("failed to update snapshot: %v", err)
+		}
+		return
+	}
+
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("failed to read snapshot (run with -update to create): %v", err)
+	}
+	if got != string(want) {
+		t.Errorf("snapshot %s mismatch:\ngot:\n%s\nwant:\n%s", name, got, want)
+	}
+}
+
+// accepts checks a string against a string schema the way a generated client would.
+func accepts(t *testing.T, s *openapi.Schema, value string) bool {
+	t.Helper()
+
+	length := utf8.RuneCountInString(value)
+	if s.MinLength != nil && length < *s.MinLength {
+		return false
+	}
+	if s.MaxLength != nil && length > *s.MaxLength {
+		return false
+	}
+	if s.Enum != nil && !slices.Contains(s.Enum, value) {
+		return false
+	}
+	if s.Pattern != "" {
+		re, err := regexp.Compile(s.Pattern)
+		assertNoError(t, err)
+		return re.MatchString(value)
+	}
+	return true
+}
@@ -0,0 +1,226 @@
+package openapi
+
+import (
+	"github.com/alnah/fla/internal/domain/category"
+	"github.com/alnah/fla/internal/domain/kernel"
+	"github.com/alnah/fla/internal/domain/post"
+	"github.com/alnah/fla/internal/domain/shared"
+	"github.com/alnah/fla/internal/domain/subscription"
+	"github.com/alnah/fla/internal/domain/tag"
+	"github.com/alnah/fla/internal/domain/user"
+)
+
+// valueSchemas maps each domain value object to its schema. Every bound and
+// pattern comes from the domain package that validates the value.
+func valueSchemas() map[string]*Schema {
+	return map[string]*Schema{
+		"ID": {Type: "string", MinLength: ptr(1)},
+		"Title": text(shared.MinTitleLength, shared.MaxTitleLength,
+			"Title shown on pages and in listings."),
+		"Slug": {
+			Type:        "string",
+			Description: "URL segment: lowercase words joined by single hyphens.",
+			MinLength:   ptr(1),
+			MaxLength:   ptr(shared.MaxSlugLength),
+			Pattern:     shared.SlugPattern,
+		},
+		"Description": text(shared.MinDescriptionLength, shared.MaxDescriptionLength,
+			"Meta description for search results and social cards."),
+		"PostContent": text(post.MinPostContentLength, post.MaxPostContentLength,
+			"Post body in Markdown."),
+		"Excerpt": text(post.MinExcerptLength, post.MaxExcerptLength,
+			"Summary for feeds and listings."),
+		"TagName":      text(tag.MinTagNameLength, tag.MaxTagNameLength, ""),
+		"CategoryName": text(category.MinCategoryNameLength, category.MaxCategoryNameLength, ""),
+		"Username": {
+			Type:      "string",
+			MinLength: ptr(shared.MinUserNameLength),
+			MaxLength: ptr(shared.MaxUsernameLength),
+			Pattern:   shared.UsernamePattern,
+		},
+		"FirstName": text(shared.MinFirstNameLength, shared.MaxFirstNameLength, ""),
+		"LastName":  text(shared.MinLastNameLength, shared.MaxLastNameLength, ""),
+		"Email":     {Type: "string", Format: "email", Pattern: shared.EmailPattern},
+		"URL": {
+			Type:        "string",
+			Format:      "uri",
+			Description: "Absolute http or https URL.",
+			Pattern:     "^https?://",
+		},
+		"Locale": {
+			Type:    "string",
+			Enum:    values(shared.SupportedLocales...),
+			Default: shared.DefaultLocale.String(),
+		},
+		"PostStatus": {Type: "string", Enum: values(
+			post.StatusDraft,
+			post.StatusPublished,
+			post.StatusScheduled,
+			post.StatusArchived,
+		)},
+		"SchemaType": {Type: "string", Enum: values(
+			post.SchemaTypeArticle,
+			post.SchemaTypeBlogPosting,
+			post.SchemaTypeEducationalContent,
+			post.SchemaTypeLearningResource,
+			post.SchemaTypeHowTo,
+			post.SchemaTypeCourse,
+		)},
+		"Role": {Type: "string", Enum: values(
+			user.RoleAdmin,
+			user.RoleEditor,
+			user.RoleAuthor,
+			user.RoleSubscriber,
+			user.RoleVisitor,
+			user.RoleMachine,
+		)},
+		"Frequency": {
+			Type:    "string",
+			Enum:    values(subscription.FrequencyInstant, subscription.FrequencyWeeklyDigest),
+			Default: subscription.FrequencyInstant.String(),
+		},
+		"ErrorCode": {Type: "string", Enum: []string{
+			kernel.EInvalid,
+			kernel.ENotFound,
+			kernel.EConflict,
+			kernel.EForbidden,
+			kernel.EInternal,
+		}},
+	}
+}
+
+// resourceSchemas are the request and response bodies, built from value schemas.
+func resourceSchemas() map[string]*Schema {
+	return map[string]*Schema{
+		"Post": object([]string{"id", "slug", "title", "content", "status", "category", "tags", "createdAt", "updatedAt"}, map[string]*Schema{
+			"id":             identifier(),
+			"slug":           ref("Slug"),
+			"title":          ref("Title"),
+			"content":        ref("PostContent"),
+			"excerpt":        ref("Excerpt"),
+			"featuredImage":  ref("URL"),
+			"status":         ref("PostStatus"),
+			"seoTitle":       ref("Title"),
+			"seoDescription": ref("Description"),
+			"canonicalUrl":   ref("URL"),
+			"schemaType":     ref("SchemaType"),
+			"category":       ref("Category"),
+			"tags":           tags(),
+			"author":         ref("User"),
+			"publishedAt":    nullable(dateTime()),
+			"createdAt":      readOnly(dateTime()),
+			"updatedAt":      readOnly(dateTime()),
+		}),
+		"PostInput": object([]string{"title", "content", "categoryId"}, map[string]*Schema{
+			"title":          ref("Title"),
+			"content":        ref("PostContent"),
+			"slug":           ref("Slug"),
+			"excerpt":        ref("Excerpt"),
+			"featuredImage":  ref("URL"),
+			"seoTitle":       ref("Title"),
+			"seoDescription": ref("Description"),
+			"canonicalUrl":   ref("URL"),
+			"schemaType":     ref("SchemaType"),
+			"categoryId":     ref("ID"),
+			"tagIds":         {Type: "array", Items: ref("ID"), MaxItems: ptr(post.MaxTagsPerPost), UniqueItems: true},
+		}),
+		"PostPage": object([]string{"items", "pageInfo"}, map[string]*Schema{
+			"items":    {Type: "array", Items: ref("Post")},
+			"pageInfo": ref("PageInfo"),
+		}),
+		"PageInfo": object([]string{"page", "limit", "totalItems", "totalPages"}, map[string]*Schema{
+			"page":       {Type: "integer", Minimum: ptr(1)},
+			"limit":      {Type: "integer", Minimum: ptr(shared.MinPageLimit), Maximum: ptr(shared.MaxPageLimit)},
+			"totalItems": {Type: "integer", Minimum: ptr(0)},
+			"totalPages": {Type: "integer", Minimum: ptr(0)},
+		}),
+		"Category": object([]string{"id", "slug", "name", "path"}, map[string]*Schema{
+			"id":          identifier(),
+			"slug":        ref("Slug"),
+			"name":        ref("CategoryName"),
+			"description": ref("Description"),
+			"path": {
+				Type:        "string",
+				Description: "Slugs from the root category down, joined by slashes.",
+				ReadOnly:    true,
+			},
+			"parentId": nullable(&Schema{Type: "string"}),
+		}),
+		"Tag": object([]string{"id", "slug", "name"}, map[string]*Schema{
+			"id":   identifier(),
+			"slug": ref("Slug"),
+			"name": ref("TagName"),
+		}),
+		"User": object([]string{"id", "username"}, map[string]*Schema{
+			"id":        identifier(),
+			"username":  ref("Username"),
+			"firstName": ref("FirstName"),
+			"lastName":  ref("LastName"),
+		}),
+		"SubscriptionInput": object([]string{"email"}, map[string]*Schema{
+			"email":       ref("Email"),
+			"locale":      ref("Locale"),
+			"frequency":   ref("Frequency"),
+			"categoryIds": {Type: "array", Items: ref("ID"), UniqueItems: true},
+		}),
+		"Error": object([]string{"code", "message"}, map[string]*Schema{
+			"code":    ref("ErrorCode"),
+			"message": {Type: "string", Description: "Human-readable reason, safe to show to users."},
+			"field":   {Type: "string", Description: "Invalid field, for validation errors."},
+			"constraint": {Type: "string", Description: "Rule the field broke, for validation errors.", Enum: []string{
+				kernel.ConstraintRequired,
+				kernel.ConstraintLength,
+				kernel.ConstraintMinLength,
+				kernel.ConstraintMaxLength,
+			}},
+			"min": {Type: "integer", Description: "Lower bound of the constraint, when it has one."},
+			"max": {Type: "integer", Description: "Upper bound of the constraint, when it has one."},
+		}),
+	}
+}
+
+func text(minLen, maxLen int, description string) *Schema {
+	return &Schema{Type: "string", Description: description, MinLength: ptr(minLen), MaxLength: ptr(maxLen)}
+}
+
+func tags() *Schema {
+	return &Schema{Type: "array", Items: ref("Tag"), MaxItems: ptr(post.MaxTagsPerPost)}
+}
+
+func dateTime() *Schema {
+	return &Schema{Type: "string", Format: "date-time"}
+}
+
+func object(required []string, properties map[string]*Schema) *Schema {
+	return &Schema{Type: "object", Required: required, Properties: properties}
+}
+
+func ref(name string) *Schema {
+	return &Schema{Ref: "#/components/schemas/" + name}
+}
+
+// identifier is an ID assigned by the server. OpenAPI 3.0 ignores siblings
+// of $ref, so it cannot be a readOnly reference to the ID schema.
+func identifier() *Schema {
+	return &Schema{Type: "string", ReadOnly: true}
+}
+
+func readOnly(s *Schema) *Schema {
+	s.ReadOnly = true
+	return s
+}
+
+func nullable(s *Schema) *Schema {
+	s.Nullable = true
+	return s
+}
+
+func values[T ~string](vs ...T) []string {
+	out := make([]string, len(vs))
+	for i, v := range vs {
+		out[i] = string(v)
+	}
+	return out
+}
+
+func ptr(n int) *int { return &n }